@@ -6,6 +6,18 @@ type multiNodeContextKey int
 
 const (
 	contextKeyHeathCheckRequest multiNodeContextKey = iota + 1
+	contextKeyArchiveRequest
+	contextKeyRequestSource
+)
+
+// RequestSource identifies the service requests are made on behalf of, e.g. to weight them in the RPC rate limits.
+type RequestSource string
+
+const (
+	RequestSourceLogPoller    RequestSource = "LogPoller"
+	RequestSourceTxm          RequestSource = "Txm"
+	RequestSourceCCIP         RequestSource = "CCIP"
+	RequestSourceGasEstimator RequestSource = "GasEstimator"
 )
 
 func CtxAddHealthCheckFlag(ctx context.Context) context.Context {
@@ -15,3 +27,25 @@ func CtxAddHealthCheckFlag(ctx context.Context) context.Context {
 func CtxIsHeathCheckRequest(ctx context.Context) bool {
 	return ctx.Value(contextKeyHeathCheckRequest) != nil
 }
+
+// CtxRequireArchive marks requests made with ctx as requiring archive state, e.g. historical logs or state far
+// behind the head. The MultiNode routes such requests to nodes configured as archive nodes, if it has any.
+// Requests without the flag accept full-node state.
+func CtxRequireArchive(ctx context.Context) context.Context {
+	return context.WithValue(ctx, contextKeyArchiveRequest, struct{}{})
+}
+
+func CtxIsArchiveRequest(ctx context.Context) bool {
+	return ctx.Value(contextKeyArchiveRequest) != nil
+}
+
+// CtxWithRequestSource attributes the requests made with ctx to the given service.
+func CtxWithRequestSource(ctx context.Context, source RequestSource) context.Context {
+	return context.WithValue(ctx, contextKeyRequestSource, source)
+}
+
+// CtxRequestSource returns the service requests made with ctx are attributed to, if any.
+func CtxRequestSource(ctx context.Context) (RequestSource, bool) {
+	source, ok := ctx.Value(contextKeyRequestSource).(RequestSource)
+	return source, ok
+}
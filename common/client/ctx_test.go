@@ -14,3 +14,24 @@ func TestContext(t *testing.T) {
 	ctx = CtxAddHealthCheckFlag(ctx)
 	assert.True(t, CtxIsHeathCheckRequest(ctx), "expected context to contain the healthcheck flag")
 }
+
+func TestContext_Archive(t *testing.T) {
+	ctx := tests.Context(t)
+	assert.False(t, CtxIsArchiveRequest(ctx), "expected false for test context")
+	ctx = CtxRequireArchive(ctx)
+	assert.True(t, CtxIsArchiveRequest(ctx), "expected context to contain the archive flag")
+	assert.False(t, CtxIsHeathCheckRequest(ctx))
+}
+
+func TestContext_RequestSource(t *testing.T) {
+	ctx := tests.Context(t)
+	_, ok := CtxRequestSource(ctx)
+	assert.False(t, ok, "expected no source for test context")
+	ctx = CtxWithRequestSource(ctx, RequestSourceLogPoller)
+	source, ok := CtxRequestSource(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, RequestSourceLogPoller, source)
+	ctx = CtxWithRequestSource(ctx, RequestSourceTxm)
+	source, _ = CtxRequestSource(ctx)
+	assert.Equal(t, RequestSourceTxm, source, "expected the innermost source to win")
+}
@@ -112,6 +112,51 @@ func (_c *mockNode_ConfiguredChainID_Call[CHAIN_ID, HEAD, RPC]) RunAndReturn(run
 	return _c
 }
 
+// HealthStats provides a mock function with given fields:
+func (_m *mockNode[CHAIN_ID, HEAD, RPC]) HealthStats() NodeHealthStats {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for HealthStats")
+	}
+
+	var r0 NodeHealthStats
+	if rf, ok := ret.Get(0).(func() NodeHealthStats); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(NodeHealthStats)
+	}
+
+	return r0
+}
+
+// mockNode_HealthStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HealthStats'
+type mockNode_HealthStats_Call[CHAIN_ID types.ID, HEAD Head, RPC NodeClient[CHAIN_ID, HEAD]] struct {
+	*mock.Call
+}
+
+// HealthStats is a helper method to define mock.On call
+func (_e *mockNode_Expecter[CHAIN_ID, HEAD, RPC]) HealthStats() *mockNode_HealthStats_Call[CHAIN_ID, HEAD, RPC] {
+	return &mockNode_HealthStats_Call[CHAIN_ID, HEAD, RPC]{Call: _e.mock.On("HealthStats")}
+}
+
+func (_c *mockNode_HealthStats_Call[CHAIN_ID, HEAD, RPC]) Run(run func()) *mockNode_HealthStats_Call[CHAIN_ID, HEAD, RPC] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *mockNode_HealthStats_Call[CHAIN_ID, HEAD, RPC]) Return(_a0 NodeHealthStats) *mockNode_HealthStats_Call[CHAIN_ID, HEAD, RPC] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockNode_HealthStats_Call[CHAIN_ID, HEAD, RPC]) RunAndReturn(run func() NodeHealthStats) *mockNode_HealthStats_Call[CHAIN_ID, HEAD, RPC] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // HighestUserObservations provides a mock function with given fields:
 func (_m *mockNode[CHAIN_ID, HEAD, RPC]) HighestUserObservations() ChainInfo {
 	ret := _m.Called()
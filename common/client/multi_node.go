@@ -66,12 +66,19 @@ type MultiNode[
 		HEAD,
 		BATCH_ELEM,
 	]
+	PoolChainInfoProvider
 	Close() error
 	NodeStates() map[string]string
-	SelectNodeRPC() (RPC_CLIENT, error)
+	// HealthReport reports the pool as failing when no primary node is alive, along with each node which is not.
+	HealthReport() map[string]error
+	// SelectNodeRPC returns an RPC of an active node, routing to an archive node if ctx requires archive state.
+	SelectNodeRPC(ctx context.Context) (RPC_CLIENT, error)
 
 	BatchCallContextAll(ctx context.Context, b []BATCH_ELEM) error
 	ConfiguredChainID() CHAIN_ID
+	// SetNodes replaces the nodes of the pool. Nodes which are not part of the pool yet are started if the pool is,
+	// and the nodes which are no longer part of it are closed.
+	SetNodes(ctx context.Context, nodes []Node[CHAIN_ID, HEAD, RPC_CLIENT], sendonlys []SendOnlyNode[CHAIN_ID, RPC_CLIENT]) error
 }
 
 type multiNode[
@@ -90,13 +97,10 @@ type multiNode[
 	BATCH_ELEM any,
 ] struct {
 	services.StateMachine
-	nodes                 []Node[CHAIN_ID, HEAD, RPC_CLIENT]
-	sendonlys             []SendOnlyNode[CHAIN_ID, RPC_CLIENT]
 	chainID               CHAIN_ID
 	lggr                  logger.SugaredLogger
 	selectionMode         string
 	noNewHeadsThreshold   time.Duration
-	nodeSelector          NodeSelector[CHAIN_ID, HEAD, RPC_CLIENT]
 	leaseDuration         time.Duration
 	leaseTicker           *time.Ticker
 	chainFamily           string
@@ -104,6 +108,13 @@ type multiNode[
 	deathDeclarationDelay time.Duration
 	sendTxSoftTimeout     time.Duration // defines max waiting time from first response til responses evaluation
 
+	setNodesMu      sync.Mutex   // serializes SetNodes
+	nodesMu         sync.RWMutex // guards the nodes and their selectors, which SetNodes replaces but never modifies
+	nodes           []Node[CHAIN_ID, HEAD, RPC_CLIENT]
+	sendonlys       []SendOnlyNode[CHAIN_ID, RPC_CLIENT]
+	nodeSelector    NodeSelector[CHAIN_ID, HEAD, RPC_CLIENT]
+	archiveSelector NodeSelector[CHAIN_ID, HEAD, RPC_CLIENT] // nil unless the pool mixes full and archive nodes
+
 	activeMu   sync.RWMutex
 	activeNode Node[CHAIN_ID, HEAD, RPC_CLIENT]
 
@@ -140,7 +151,7 @@ func NewMultiNode[
 	sendTxSoftTimeout time.Duration,
 	deathDeclarationDelay time.Duration,
 ) MultiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM] {
-	nodeSelector := newNodeSelector(selectionMode, nodes)
+	nodeSelector, archiveSelector, archiveNodes := newPoolSelectors(selectionMode, nodes)
 	// Prometheus' default interval is 15s, set this to under 7.5s to avoid
 	// aliasing (see: https://en.wikipedia.org/wiki/Nyquist_frequency)
 	const reportInterval = 6500 * time.Millisecond
@@ -155,6 +166,7 @@ func NewMultiNode[
 		selectionMode:         selectionMode,
 		noNewHeadsThreshold:   noNewHeadsThreshold,
 		nodeSelector:          nodeSelector,
+		archiveSelector:       archiveSelector,
 		chStop:                make(services.StopChan),
 		leaseDuration:         leaseDuration,
 		chainFamily:           chainFamily,
@@ -165,37 +177,58 @@ func NewMultiNode[
 	}
 
 	c.lggr.Debugf("The MultiNode is configured to use NodeSelectionMode: %s", selectionMode)
+	if archiveSelector != nil {
+		c.lggr.Debugf("The MultiNode routes archive requests to %d of %d nodes", archiveNodes, len(nodes))
+	}
 
 	return c
 }
 
+// newPoolSelectors returns the NodeSelector of nodes, and a second one of its archive nodes if nodes mixes full and
+// archive nodes. Full nodes serve every request that does not require archive state, so archive nodes only get a
+// selector of their own if there is at least one full node to keep the routine traffic away from them.
+func newPoolSelectors[
+	CHAIN_ID types.ID,
+	HEAD Head,
+	RPC NodeClient[CHAIN_ID, HEAD],
+](selectionMode string, nodes []Node[CHAIN_ID, HEAD, RPC]) (nodeSelector, archiveSelector NodeSelector[CHAIN_ID, HEAD, RPC], archiveNodes int) {
+	var fullNodes, archive []Node[CHAIN_ID, HEAD, RPC]
+	for _, n := range nodes {
+		if isArchiveNode(n) {
+			archive = append(archive, n)
+		} else {
+			fullNodes = append(fullNodes, n)
+		}
+	}
+	if len(fullNodes) == 0 || len(archive) == 0 {
+		return newNodeSelector(selectionMode, nodes), nil, len(archive)
+	}
+	return newNodeSelector(selectionMode, fullNodes), newNodeSelector(selectionMode, archive), len(archive)
+}
+
+// pool returns the current nodes of the pool.
+func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) pool() ([]Node[CHAIN_ID, HEAD, RPC_CLIENT], []SendOnlyNode[CHAIN_ID, RPC_CLIENT]) {
+	c.nodesMu.RLock()
+	defer c.nodesMu.RUnlock()
+	return c.nodes, c.sendonlys
+}
+
+// selectors returns the current NodeSelector of the pool and the one of its archive nodes, which may be nil.
+func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) selectors() (nodeSelector, archiveSelector NodeSelector[CHAIN_ID, HEAD, RPC_CLIENT]) {
+	c.nodesMu.RLock()
+	defer c.nodesMu.RUnlock()
+	return c.nodeSelector, c.archiveSelector
+}
+
 // Dial starts every node in the pool
 //
 // Nodes handle their own redialing and runloops, so this function does not
 // return any error if the nodes aren't available
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) Dial(ctx context.Context) error {
 	return c.StartOnce("MultiNode", func() (merr error) {
-		if len(c.nodes) == 0 {
-			return fmt.Errorf("no available nodes for chain %s", c.chainID.String())
-		}
-		var ms services.MultiStart
-		for _, n := range c.nodes {
-			if n.ConfiguredChainID().String() != c.chainID.String() {
-				return ms.CloseBecause(fmt.Errorf("node %s has configured chain ID %s which does not match multinode configured chain ID of %s", n.String(), n.ConfiguredChainID().String(), c.chainID.String()))
-			}
-			n.SetPoolChainInfoProvider(c)
-			// node will handle its own redialing and automatic recovery
-			if err := ms.Start(ctx, n); err != nil {
-				return err
-			}
-		}
-		for _, s := range c.sendonlys {
-			if s.ConfiguredChainID().String() != c.chainID.String() {
-				return ms.CloseBecause(fmt.Errorf("sendonly node %s has configured chain ID %s which does not match multinode configured chain ID of %s", s.String(), s.ConfiguredChainID().String(), c.chainID.String()))
-			}
-			if err := ms.Start(ctx, s); err != nil {
-				return err
-			}
+		nodes, sendonlys := c.pool()
+		if err := c.startNodes(ctx, nodes, sendonlys, nil); err != nil {
+			return err
 		}
 		c.wg.Add(1)
 		go c.runLoop()
@@ -218,26 +251,114 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 		close(c.chStop)
 		c.wg.Wait()
 
-		return services.CloseAll(services.MultiCloser(c.nodes), services.MultiCloser(c.sendonlys))
+		nodes, sendonlys := c.pool()
+		return services.CloseAll(services.MultiCloser(nodes), services.MultiCloser(sendonlys))
 	})
 }
 
+// startNodes starts the nodes and sendonlys which are not running yet. Those which are already part of the running
+// pool are neither checked nor started again.
+func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) startNodes(ctx context.Context, nodes []Node[CHAIN_ID, HEAD, RPC_CLIENT], sendonlys []SendOnlyNode[CHAIN_ID, RPC_CLIENT], running map[SendOnlyNode[CHAIN_ID, RPC_CLIENT]]struct{}) error {
+	if len(nodes) == 0 {
+		return fmt.Errorf("no available nodes for chain %s", c.chainID.String())
+	}
+	var ms services.MultiStart
+	for _, n := range nodes {
+		if _, ok := running[n]; ok {
+			continue
+		}
+		if n.ConfiguredChainID().String() != c.chainID.String() {
+			return ms.CloseBecause(fmt.Errorf("node %s has configured chain ID %s which does not match multinode configured chain ID of %s", n.String(), n.ConfiguredChainID().String(), c.chainID.String()))
+		}
+		n.SetPoolChainInfoProvider(c)
+		// node will handle its own redialing and automatic recovery
+		if err := ms.Start(ctx, n); err != nil {
+			return err
+		}
+	}
+	for _, s := range sendonlys {
+		if _, ok := running[s]; ok {
+			continue
+		}
+		if s.ConfiguredChainID().String() != c.chainID.String() {
+			return ms.CloseBecause(fmt.Errorf("sendonly node %s has configured chain ID %s which does not match multinode configured chain ID of %s", s.String(), s.ConfiguredChainID().String(), c.chainID.String()))
+		}
+		if err := ms.Start(ctx, s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetNodes replaces the nodes of the running pool with nodes and sendonlys, which may reuse the nodes already part of
+// it. The new nodes are started before they join the pool, and the nodes which left it are closed afterwards.
+func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) SetNodes(ctx context.Context, nodes []Node[CHAIN_ID, HEAD, RPC_CLIENT], sendonlys []SendOnlyNode[CHAIN_ID, RPC_CLIENT]) error {
+	c.setNodesMu.Lock()
+	defer c.setNodesMu.Unlock()
+
+	oldNodes, oldSendonlys := c.pool()
+	removed := make(map[SendOnlyNode[CHAIN_ID, RPC_CLIENT]]struct{}, len(oldNodes)+len(oldSendonlys))
+	for _, n := range oldNodes {
+		removed[n] = struct{}{}
+	}
+	for _, s := range oldSendonlys {
+		removed[s] = struct{}{}
+	}
+	var err error
+	// IfStarted keeps Close from closing the pool while the new nodes are started
+	if !c.IfStarted(func() {
+		if err = c.startNodes(ctx, nodes, sendonlys, removed); err != nil {
+			return
+		}
+		nodeSelector, archiveSelector, _ := newPoolSelectors(c.selectionMode, nodes)
+		c.nodesMu.Lock()
+		c.nodes, c.sendonlys = nodes, sendonlys
+		c.nodeSelector, c.archiveSelector = nodeSelector, archiveSelector
+		c.nodesMu.Unlock()
+	}) {
+		return fmt.Errorf("cannot replace the nodes of %s: MultiNode is not running", c.Name())
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, n := range nodes {
+		delete(removed, n)
+	}
+	for _, s := range sendonlys {
+		delete(removed, s)
+	}
+	c.activeMu.Lock()
+	if _, ok := removed[c.activeNode]; ok {
+		c.activeNode = nil
+	}
+	c.activeMu.Unlock()
+
+	c.lggr.Infow("Replaced the nodes of the pool", "nodes", len(nodes), "sendonlys", len(sendonlys), "removed", len(removed))
+	var errs []error
+	for n := range removed {
+		errs = append(errs, n.Close())
+	}
+	return errors.Join(errs...)
+}
+
 // SelectNodeRPC returns an RPC of an active node. If there are no active nodes it returns an error.
 // Call this method from your chain-specific client implementation to access any chain-specific rpc calls.
-func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) SelectNodeRPC() (rpc RPC_CLIENT, err error) {
-	n, err := c.selectNode()
+func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) SelectNodeRPC(ctx context.Context) (rpc RPC_CLIENT, err error) {
+	n, err := c.selectNodeFor(ctx)
 	if err != nil {
 		return rpc, err
 	}
 	return n.RPC(), nil
 }
 
-// selectNode returns the active Node, if it is still nodeStateAlive, otherwise it selects a new one from the NodeSelector.
+// selectNode returns the active Node, if it is still nodeStateAlive and not outscored, otherwise it selects a new one
+// from the NodeSelector.
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) selectNode() (node Node[CHAIN_ID, HEAD, RPC_CLIENT], err error) {
 	c.activeMu.RLock()
 	node = c.activeNode
 	c.activeMu.RUnlock()
-	if node != nil && node.State() == nodeStateAlive {
+	if node != nil && node.State() == nodeStateAlive && !c.outscored(node) {
 		return // still alive
 	}
 
@@ -245,17 +366,18 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	c.activeMu.Lock()
 	defer c.activeMu.Unlock()
 	node = c.activeNode
-	if node != nil && node.State() == nodeStateAlive {
+	if node != nil && node.State() == nodeStateAlive && !c.outscored(node) {
 		return // another goroutine beat us here
 	}
 
 	if c.activeNode != nil {
 		c.activeNode.UnsubscribeAllExceptAliveLoop()
 	}
-	c.activeNode = c.nodeSelector.Select()
+	c.activeNode = c.selectBest()
 
 	if c.activeNode == nil {
-		c.lggr.Criticalw("No live RPC nodes available", "NodeSelectionMode", c.nodeSelector.Name())
+		nodeSelector, _ := c.selectors()
+		c.lggr.Criticalw("No live RPC nodes available", "NodeSelectionMode", nodeSelector.Name())
 		errmsg := fmt.Errorf("no live nodes available for chain %s", c.chainID.String())
 		c.SvcErrBuffer.Append(errmsg)
 		err = ErroringNodeError
@@ -264,15 +386,50 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	return c.activeNode, err
 }
 
+// outscored returns true if the HealthScore NodeSelector selects another node than the active one, which it only does
+// once the health score of the active node falls below the one of the best node by more than healthScoreHysteresis.
+func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) outscored(active Node[CHAIN_ID, HEAD, RPC_CLIENT]) bool {
+	if c.selectionMode != NodeSelectionModeHealthScore {
+		return false
+	}
+	nodeSelector, _ := c.selectors()
+	best := nodeSelector.Select()
+	return best != nil && best != active
+}
+
+// selectNodeFor returns an alive archive node if ctx requires archive state and the pool has dedicated archive nodes.
+// Every other request, and archive requests while no archive node is alive, is served by selectNode.
+func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) selectNodeFor(ctx context.Context) (Node[CHAIN_ID, HEAD, RPC_CLIENT], error) {
+	_, archiveSelector := c.selectors()
+	if archiveSelector == nil || !CtxIsArchiveRequest(ctx) {
+		return c.selectNode()
+	}
+	if node := archiveSelector.Select(); node != nil {
+		return node, nil
+	}
+	c.lggr.Warnw("No live archive RPC nodes available, falling back to full nodes", "NodeSelectionMode", archiveSelector.Name())
+	return c.selectNode()
+}
+
+// selectBest returns the best full node, and only falls back to archive nodes if none of the full nodes is alive.
+func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) selectBest() Node[CHAIN_ID, HEAD, RPC_CLIENT] {
+	nodeSelector, archiveSelector := c.selectors()
+	if node := nodeSelector.Select(); node != nil || archiveSelector == nil {
+		return node
+	}
+	return archiveSelector.Select()
+}
+
 // LatestChainInfo - returns number of live nodes available in the pool, so we can prevent the last alive node in a pool from being marked as out-of-sync.
 // Return highest ChainInfo most recently received by the alive nodes.
 // E.g. If Node A's the most recent block is 10 and highest 15 and for Node B it's - 12 and 14. This method will return 12.
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) LatestChainInfo() (int, ChainInfo) {
+	nodes, _ := c.pool()
 	var nLiveNodes int
 	ch := ChainInfo{
 		TotalDifficulty: big.NewInt(0),
 	}
-	for _, n := range c.nodes {
+	for _, n := range nodes {
 		if s, nodeChainInfo := n.StateAndLatest(); s == nodeStateAlive {
 			nLiveNodes++
 			ch.BlockNumber = max(ch.BlockNumber, nodeChainInfo.BlockNumber)
@@ -285,10 +442,11 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 
 // HighestUserObservations - returns highest ChainInfo ever observed by any user of the MultiNode
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) HighestUserObservations() ChainInfo {
+	nodes, _ := c.pool()
 	ch := ChainInfo{
 		TotalDifficulty: big.NewInt(0),
 	}
-	for _, n := range c.nodes {
+	for _, n := range nodes {
 		nodeChainInfo := n.HighestUserObservations()
 		ch.BlockNumber = max(ch.BlockNumber, nodeChainInfo.BlockNumber)
 		ch.FinalizedBlockNumber = max(ch.FinalizedBlockNumber, nodeChainInfo.FinalizedBlockNumber)
@@ -298,8 +456,9 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) checkLease() {
-	bestNode := c.nodeSelector.Select()
-	for _, n := range c.nodes {
+	nodes, _ := c.pool()
+	bestNode := c.selectBest()
+	for _, n := range nodes {
 		// Terminate client subscriptions. Services are responsible for reconnecting, which will be routed to the new
 		// best node. Only terminate connections with more than 1 subscription to account for the aliveLoop subscription
 		if n.State() == nodeStateAlive && n != bestNode && n.SubscribersCount() > 1 {
@@ -336,16 +495,8 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) runLoop() {
 	defer c.wg.Done()
 
-	nodeStates := make([]nodeWithState, len(c.nodes))
-	for i, n := range c.nodes {
-		nodeStates[i] = nodeWithState{
-			Node:      n.String(),
-			State:     n.State().String(),
-			DeadSince: nil,
-		}
-	}
-
-	c.report(nodeStates)
+	var nodeStates []nodeWithState
+	nodeStates = c.report(nodeStates)
 
 	monitor := services.NewTicker(c.reportInterval)
 	defer monitor.Stop()
@@ -353,7 +504,7 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	for {
 		select {
 		case <-monitor.C:
-			c.report(nodeStates)
+			nodeStates = c.report(nodeStates)
 		case <-c.chStop:
 			return
 		}
@@ -366,19 +517,27 @@ type nodeWithState struct {
 	DeadSince *time.Time
 }
 
-func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) report(nodesStateInfo []nodeWithState) {
+// report reports the states of the current nodes, and returns them for the next report, which carries over since when
+// each of them has been dead by node, as SetNodes may have replaced the nodes in the meantime.
+func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) report(prevStates []nodeWithState) []nodeWithState {
+	nodes, _ := c.pool()
+	deadSince := make(map[string]*time.Time, len(prevStates))
+	for _, s := range prevStates {
+		deadSince[s.Node] = s.DeadSince
+	}
 	start := time.Now()
 	var dead int
 	counts := make(map[nodeState]int)
-	for i, n := range c.nodes {
+	nodesStateInfo := make([]nodeWithState, len(nodes))
+	for i, n := range nodes {
 		state := n.State()
 		counts[state]++
-		nodesStateInfo[i].State = state.String()
+		nodesStateInfo[i] = nodeWithState{Node: n.String(), State: state.String()}
 		if state == nodeStateAlive {
-			nodesStateInfo[i].DeadSince = nil
 			continue
 		}
 
+		nodesStateInfo[i].DeadSince = deadSince[nodesStateInfo[i].Node]
 		if nodesStateInfo[i].DeadSince == nil {
 			nodesStateInfo[i].DeadSince = &start
 		}
@@ -392,7 +551,7 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 		PromMultiNodeRPCNodeStates.WithLabelValues(c.chainFamily, c.chainID.String(), state.String()).Set(float64(count))
 	}
 
-	total := len(c.nodes)
+	total := len(nodes)
 	live := total - dead
 	c.lggr.Tracew(fmt.Sprintf("MultiNode state: %d/%d nodes are alive", live, total), "nodeStates", nodesStateInfo)
 	if total == dead {
@@ -402,11 +561,12 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	} else if dead > 0 {
 		c.lggr.Errorw(fmt.Sprintf("At least one primary node is dead: %d/%d nodes are alive", live, total), "nodeStates", nodesStateInfo)
 	}
+	return nodesStateInfo
 }
 
 // ClientAPI methods
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) BalanceAt(ctx context.Context, account ADDR, blockNumber *big.Int) (*big.Int, error) {
-	n, err := c.selectNode()
+	n, err := c.selectNodeFor(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -414,7 +574,7 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) BatchCallContext(ctx context.Context, b []BATCH_ELEM) error {
-	n, err := c.selectNode()
+	n, err := c.selectNodeFor(ctx)
 	if err != nil {
 		return err
 	}
@@ -426,15 +586,16 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 // CAUTION: This should only be used for mass re-transmitting transactions, it
 // might have unexpected effects to use it for anything else.
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) BatchCallContextAll(ctx context.Context, b []BATCH_ELEM) error {
+	nodes, sendonlys := c.pool()
 	var wg sync.WaitGroup
 	defer wg.Wait()
 
 	main, selectionErr := c.selectNode()
 	var all []SendOnlyNode[CHAIN_ID, RPC_CLIENT]
-	for _, n := range c.nodes {
+	for _, n := range nodes {
 		all = append(all, n)
 	}
-	all = append(all, c.sendonlys...)
+	all = append(all, sendonlys...)
 	for _, n := range all {
 		if n == main {
 			// main node is used at the end for the return value
@@ -464,7 +625,7 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) BlockByHash(ctx context.Context, hash BLOCK_HASH) (h HEAD, err error) {
-	n, err := c.selectNode()
+	n, err := c.selectNodeFor(ctx)
 	if err != nil {
 		return h, err
 	}
@@ -472,7 +633,7 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) BlockByNumber(ctx context.Context, number *big.Int) (h HEAD, err error) {
-	n, err := c.selectNode()
+	n, err := c.selectNodeFor(ctx)
 	if err != nil {
 		return h, err
 	}
@@ -480,7 +641,7 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
-	n, err := c.selectNode()
+	n, err := c.selectNodeFor(ctx)
 	if err != nil {
 		return err
 	}
@@ -492,7 +653,7 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	attempt interface{},
 	blockNumber *big.Int,
 ) (rpcErr []byte, extractErr error) {
-	n, err := c.selectNode()
+	n, err := c.selectNodeFor(ctx)
 	if err != nil {
 		return rpcErr, err
 	}
@@ -503,7 +664,7 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	ctx context.Context,
 	attempt interface{},
 ) (rpcErr []byte, extractErr error) {
-	n, err := c.selectNode()
+	n, err := c.selectNodeFor(ctx)
 	if err != nil {
 		return rpcErr, err
 	}
@@ -513,7 +674,7 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 // ChainID makes a direct RPC call. In most cases it should be better to use the configured chain id instead by
 // calling ConfiguredChainID.
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) ChainID(ctx context.Context) (id CHAIN_ID, err error) {
-	n, err := c.selectNode()
+	n, err := c.selectNodeFor(ctx)
 	if err != nil {
 		return id, err
 	}
@@ -521,7 +682,7 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) CodeAt(ctx context.Context, account ADDR, blockNumber *big.Int) (code []byte, err error) {
-	n, err := c.selectNode()
+	n, err := c.selectNodeFor(ctx)
 	if err != nil {
 		return code, err
 	}
@@ -533,7 +694,7 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) EstimateGas(ctx context.Context, call any) (gas uint64, err error) {
-	n, err := c.selectNode()
+	n, err := c.selectNodeFor(ctx)
 	if err != nil {
 		return gas, err
 	}
@@ -541,7 +702,7 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) FilterEvents(ctx context.Context, query EVENT_OPS) (e []EVENT, err error) {
-	n, err := c.selectNode()
+	n, err := c.selectNodeFor(ctx)
 	if err != nil {
 		return e, err
 	}
@@ -549,7 +710,7 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) LatestBlockHeight(ctx context.Context) (h *big.Int, err error) {
-	n, err := c.selectNode()
+	n, err := c.selectNodeFor(ctx)
 	if err != nil {
 		return h, err
 	}
@@ -557,7 +718,7 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) LINKBalance(ctx context.Context, accountAddress ADDR, linkAddress ADDR) (b *assets.Link, err error) {
-	n, err := c.selectNode()
+	n, err := c.selectNodeFor(ctx)
 	if err != nil {
 		return b, err
 	}
@@ -565,18 +726,51 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) NodeStates() (states map[string]string) {
+	nodes, sendonlys := c.pool()
 	states = make(map[string]string)
-	for _, n := range c.nodes {
+	for _, n := range nodes {
 		states[n.Name()] = n.State().String()
 	}
-	for _, s := range c.sendonlys {
+	for _, s := range sendonlys {
 		states[s.Name()] = s.State().String()
 	}
 	return
 }
 
+func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) Name() string {
+	return c.lggr.Name()
+}
+
+func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) HealthReport() map[string]error {
+	nodes, sendonlys := c.pool()
+	name := c.Name()
+	report := make(map[string]error, 1+len(nodes)+len(sendonlys))
+	var live int
+	for _, n := range nodes {
+		if s := n.State(); s != nodeStateAlive {
+			report[name+"."+n.Name()] = fmt.Errorf("node is %s", s)
+			continue
+		}
+		report[name+"."+n.Name()] = nil
+		live++
+	}
+	for _, s := range sendonlys {
+		if state := s.State(); state != nodeStateAlive {
+			report[name+"."+s.Name()] = fmt.Errorf("send-only node is %s", state)
+			continue
+		}
+		report[name+"."+s.Name()] = nil
+	}
+	err := c.Healthy()
+	if err == nil && live == 0 && len(nodes) > 0 {
+		err = ErroringNodeError
+	}
+	report[name] = err
+	return report
+}
+
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) PendingSequenceAt(ctx context.Context, addr ADDR) (s SEQ, err error) {
-	n, err := c.selectNode()
+	n, err := c.selectNodeFor(ctx)
 	if err != nil {
 		return s, err
 	}
@@ -598,7 +792,7 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	fee FEE,
 	fromAddress ADDR,
 ) (txhash string, err error) {
-	n, err := c.selectNode()
+	n, err := c.selectNodeFor(ctx)
 	if err != nil {
 		return txhash, err
 	}
@@ -730,16 +924,17 @@ const sendTxQuorum = 0.7
 // * If there is both success and terminal error - returns success and reports invariant violation
 // * Otherwise, returns any (effectively random) of the errors.
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) SendTransaction(ctx context.Context, tx TX) error {
-	if len(c.nodes) == 0 {
+	nodes, sendonlys := c.pool()
+	if len(nodes) == 0 {
 		return ErroringNodeError
 	}
 
 	healthyNodesNum := 0
-	txResults := make(chan sendTxResult, len(c.nodes))
+	txResults := make(chan sendTxResult, len(nodes))
 	// Must wrap inside IfNotStopped to avoid waitgroup racing with Close
 	ok := c.IfNotStopped(func() {
 		// fire-n-forget, as sendOnlyNodes can not be trusted with result reporting
-		for _, n := range c.sendonlys {
+		for _, n := range sendonlys {
 			if n.State() != nodeStateAlive {
 				continue
 			}
@@ -751,8 +946,8 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 		}
 
 		var primaryBroadcastWg sync.WaitGroup
-		txResultsToReport := make(chan sendTxResult, len(c.nodes))
-		for _, n := range c.nodes {
+		txResultsToReport := make(chan sendTxResult, len(nodes))
+		for _, n := range nodes {
 			if n.State() != nodeStateAlive {
 				continue
 			}
@@ -799,7 +994,7 @@ func findFirstIn[K comparable, V any](set map[K]V, keys []K) (V, bool) {
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) SequenceAt(ctx context.Context, account ADDR, blockNumber *big.Int) (s SEQ, err error) {
-	n, err := c.selectNode()
+	n, err := c.selectNodeFor(ctx)
 	if err != nil {
 		return s, err
 	}
@@ -807,7 +1002,7 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) SimulateTransaction(ctx context.Context, tx TX) error {
-	n, err := c.selectNode()
+	n, err := c.selectNodeFor(ctx)
 	if err != nil {
 		return err
 	}
@@ -815,7 +1010,7 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) SubscribeNewHead(ctx context.Context, channel chan<- HEAD) (s types.Subscription, err error) {
-	n, err := c.selectNode()
+	n, err := c.selectNodeFor(ctx)
 	if err != nil {
 		return s, err
 	}
@@ -823,7 +1018,7 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) SubscribeToHeads(ctx context.Context) (ch <-chan HEAD, sub types.Subscription, err error) {
-	n, err := c.selectNode()
+	n, err := c.selectNodeFor(ctx)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -831,7 +1026,7 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) TokenBalance(ctx context.Context, account ADDR, tokenAddr ADDR) (b *big.Int, err error) {
-	n, err := c.selectNode()
+	n, err := c.selectNodeFor(ctx)
 	if err != nil {
 		return b, err
 	}
@@ -839,7 +1034,7 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) TransactionByHash(ctx context.Context, txHash TX_HASH) (tx TX, err error) {
-	n, err := c.selectNode()
+	n, err := c.selectNodeFor(ctx)
 	if err != nil {
 		return tx, err
 	}
@@ -847,7 +1042,7 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) TransactionReceipt(ctx context.Context, txHash TX_HASH) (txr TX_RECEIPT, err error) {
-	n, err := c.selectNode()
+	n, err := c.selectNodeFor(ctx)
 	if err != nil {
 		return txr, err
 	}
@@ -855,7 +1050,7 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) LatestFinalizedBlock(ctx context.Context) (head HEAD, err error) {
-	n, err := c.selectNode()
+	n, err := c.selectNodeFor(ctx)
 	if err != nil {
 		return head, err
 	}
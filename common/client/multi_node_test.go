@@ -214,6 +214,95 @@ func TestMultiNode_Dial(t *testing.T) {
 	})
 }
 
+func TestMultiNode_SetNodes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Fails if not running", func(t *testing.T) {
+		t.Parallel()
+		chainID := types.RandomID()
+		mn := newTestMultiNode(t, multiNodeOpts{
+			selectionMode: NodeSelectionModeRoundRobin,
+			chainID:       chainID,
+			nodes:         []Node[types.ID, types.Head[Hashable], multiNodeRPCClient]{newMockNode[types.ID, types.Head[Hashable], multiNodeRPCClient](t)},
+		})
+		err := mn.SetNodes(tests.Context(t), []Node[types.ID, types.Head[Hashable], multiNodeRPCClient]{newMockNode[types.ID, types.Head[Hashable], multiNodeRPCClient](t)}, nil)
+		assert.EqualError(t, err, "cannot replace the nodes of MultiNode: MultiNode is not running")
+	})
+	t.Run("Fails without nodes", func(t *testing.T) {
+		t.Parallel()
+		chainID := types.RandomID()
+		node := newHealthyNode(t, chainID)
+		mn := newTestMultiNode(t, multiNodeOpts{
+			selectionMode: NodeSelectionModeRoundRobin,
+			chainID:       chainID,
+			nodes:         []Node[types.ID, types.Head[Hashable], multiNodeRPCClient]{node},
+		})
+		defer func() { assert.NoError(t, mn.Close()) }()
+		require.NoError(t, mn.Dial(tests.Context(t)))
+
+		err := mn.SetNodes(tests.Context(t), nil, nil)
+		assert.EqualError(t, err, fmt.Sprintf("no available nodes for chain %s", chainID))
+		selectedNode, err := mn.selectNode()
+		require.NoError(t, err)
+		assert.Same(t, node, selectedNode)
+	})
+	t.Run("Keeps the nodes if a new node has the wrong chainID", func(t *testing.T) {
+		t.Parallel()
+		chainID := types.NewIDFromInt(10)
+		node := newHealthyNode(t, chainID)
+		mn := newTestMultiNode(t, multiNodeOpts{
+			selectionMode: NodeSelectionModeRoundRobin,
+			chainID:       chainID,
+			nodes:         []Node[types.ID, types.Head[Hashable], multiNodeRPCClient]{node},
+		})
+		defer func() { assert.NoError(t, mn.Close()) }()
+		require.NoError(t, mn.Dial(tests.Context(t)))
+
+		wrongNode := newMockNode[types.ID, types.Head[Hashable], multiNodeRPCClient](t)
+		wrongNode.On("ConfiguredChainID").Return(types.NewIDFromInt(11)).Twice()
+		wrongNode.On("String").Return("wrongNode").Once()
+		err := mn.SetNodes(tests.Context(t), []Node[types.ID, types.Head[Hashable], multiNodeRPCClient]{node, wrongNode}, nil)
+		assert.EqualError(t, err, "node wrongNode has configured chain ID 11 which does not match multinode configured chain ID of 10")
+		nodes, _ := mn.pool()
+		require.Len(t, nodes, 1)
+		assert.Same(t, node, nodes[0])
+	})
+	t.Run("Starts the new nodes and closes the removed ones", func(t *testing.T) {
+		t.Parallel()
+		chainID := types.RandomID()
+		kept := newHealthyNode(t, chainID)
+		removed := newHealthyNode(t, chainID)
+		removedSendOnly := newMockSendOnlyNode[types.ID, multiNodeRPCClient](t)
+		removedSendOnly.On("ConfiguredChainID").Return(chainID).Once()
+		removedSendOnly.On("Start", mock.Anything).Return(nil).Once()
+		removedSendOnly.On("Close").Return(nil).Once()
+		mn := newTestMultiNode(t, multiNodeOpts{
+			selectionMode: NodeSelectionModeRoundRobin,
+			chainID:       chainID,
+			nodes:         []Node[types.ID, types.Head[Hashable], multiNodeRPCClient]{removed, kept},
+			sendonlys:     []SendOnlyNode[types.ID, multiNodeRPCClient]{removedSendOnly},
+		})
+		defer func() { assert.NoError(t, mn.Close()) }()
+		require.NoError(t, mn.Dial(tests.Context(t)))
+		selectedNode, err := mn.selectNode()
+		require.NoError(t, err)
+		require.Same(t, removed, selectedNode)
+
+		added := newHealthyNode(t, chainID)
+		// the mocks fail the test if kept is started or closed twice, or removed is not closed
+		err = mn.SetNodes(tests.Context(t), []Node[types.ID, types.Head[Hashable], multiNodeRPCClient]{kept, added}, nil)
+		require.NoError(t, err)
+		nodes, sendonlys := mn.pool()
+		require.Len(t, nodes, 2)
+		assert.Same(t, kept, nodes[0])
+		assert.Same(t, added, nodes[1])
+		assert.Empty(t, sendonlys)
+		selectedNode, err = mn.selectNode()
+		require.NoError(t, err)
+		assert.NotSame(t, removed, selectedNode)
+	})
+}
+
 func TestMultiNode_Report(t *testing.T) {
 	t.Parallel()
 	t.Run("Dial starts periodical reporting", func(t *testing.T) {
@@ -258,6 +347,51 @@ func TestMultiNode_Report(t *testing.T) {
 	})
 }
 
+func TestMultiNode_HealthReport(t *testing.T) {
+	t.Parallel()
+	chainID := types.RandomID()
+	alive := newHealthyNode(t, chainID)
+	alive.On("Name").Return("alive")
+	outOfSync := newNodeWithState(t, chainID, nodeStateOutOfSync)
+	outOfSync.On("Name").Return("outOfSync")
+	sendOnly := newMockSendOnlyNode[types.ID, multiNodeRPCClient](t)
+	sendOnly.On("ConfiguredChainID").Return(chainID).Once()
+	sendOnly.On("Start", mock.Anything).Return(nil).Once()
+	sendOnly.On("Close").Return(nil).Once()
+	sendOnly.On("Name").Return("sendOnly")
+	sendOnly.On("State").Return(nodeStateUnreachable)
+	mn := newTestMultiNode(t, multiNodeOpts{
+		selectionMode: NodeSelectionModeRoundRobin,
+		chainID:       chainID,
+		nodes:         []Node[types.ID, types.Head[Hashable], multiNodeRPCClient]{alive, outOfSync},
+		sendonlys:     []SendOnlyNode[types.ID, multiNodeRPCClient]{sendOnly},
+	})
+	defer func() { assert.NoError(t, mn.Close()) }()
+	require.NoError(t, mn.Dial(tests.Context(t)))
+
+	report := mn.HealthReport()
+	name := mn.Name()
+	require.Len(t, report, 4)
+	assert.NoError(t, report[name])
+	assert.NoError(t, report[name+".alive"])
+	assert.EqualError(t, report[name+".outOfSync"], "node is OutOfSync")
+	assert.EqualError(t, report[name+".sendOnly"], "send-only node is Unreachable")
+
+	t.Run("no live nodes", func(t *testing.T) {
+		dead := newNodeWithState(t, chainID, nodeStateUnreachable)
+		dead.On("Name").Return("dead")
+		mn := newTestMultiNode(t, multiNodeOpts{
+			selectionMode: NodeSelectionModeRoundRobin,
+			chainID:       chainID,
+			nodes:         []Node[types.ID, types.Head[Hashable], multiNodeRPCClient]{dead},
+		})
+		defer func() { assert.NoError(t, mn.Close()) }()
+		require.NoError(t, mn.Dial(tests.Context(t)))
+
+		assert.ErrorIs(t, mn.HealthReport()[mn.Name()], ErroringNodeError)
+	})
+}
+
 func TestMultiNode_CheckLease(t *testing.T) {
 	t.Parallel()
 	t.Run("Round robin disables lease check", func(t *testing.T) {
@@ -410,6 +544,36 @@ func TestMultiNode_selectNode(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, newBest.String(), newActiveNode.String())
 	})
+	t.Run("Updates node if active is outscored in HealthScore mode", func(t *testing.T) {
+		t.Parallel()
+		chainID := types.RandomID()
+		oldBest := newMockNode[types.ID, types.Head[Hashable], multiNodeRPCClient](t)
+		oldBest.On("String").Return("oldBest").Maybe()
+		oldBest.On("State").Return(nodeStateAlive)
+		oldBest.On("UnsubscribeAllExceptAliveLoop").Once()
+		newBest := newMockNode[types.ID, types.Head[Hashable], multiNodeRPCClient](t)
+		newBest.On("String").Return("newBest").Maybe()
+		mn := newTestMultiNode(t, multiNodeOpts{
+			selectionMode: NodeSelectionModeHealthScore,
+			chainID:       chainID,
+			nodes:         []Node[types.ID, types.Head[Hashable], multiNodeRPCClient]{oldBest, newBest},
+		})
+		nodeSelector := newMockNodeSelector[types.ID, types.Head[Hashable], multiNodeRPCClient](t)
+		// selected, then kept while it is the best
+		nodeSelector.On("Select").Return(oldBest).Twice()
+		mn.nodeSelector = nodeSelector
+		activeNode, err := mn.selectNode()
+		require.NoError(t, err)
+		require.Equal(t, oldBest.String(), activeNode.String())
+		activeNode, err = mn.selectNode()
+		require.NoError(t, err)
+		require.Equal(t, oldBest.String(), activeNode.String())
+		// old best is still alive, but outscored
+		nodeSelector.On("Select").Return(newBest)
+		newActiveNode, err := mn.selectNode()
+		require.NoError(t, err)
+		require.Equal(t, newBest.String(), newActiveNode.String())
+	})
 	t.Run("No active nodes - reports critical error", func(t *testing.T) {
 		t.Parallel()
 		chainID := types.RandomID()
@@ -430,6 +594,107 @@ func TestMultiNode_selectNode(t *testing.T) {
 	})
 }
 
+func TestMultiNode_selectNodeFor(t *testing.T) {
+	t.Parallel()
+	newNode := func(t *testing.T, name string) *mockNode[types.ID, types.Head[Hashable], multiNodeRPCClient] {
+		node := newMockNode[types.ID, types.Head[Hashable], multiNodeRPCClient](t)
+		node.On("String").Return(name).Maybe()
+		return node
+	}
+	t.Run("Routes all requests to the same nodes if there are no archive nodes", func(t *testing.T) {
+		t.Parallel()
+		mn := newTestMultiNode(t, multiNodeOpts{
+			selectionMode: NodeSelectionModeRoundRobin,
+			chainID:       types.RandomID(),
+			nodes:         []Node[types.ID, types.Head[Hashable], multiNodeRPCClient]{newNode(t, "full")},
+		})
+		assert.Nil(t, mn.archiveSelector)
+	})
+	t.Run("Routes all requests to the same nodes if there are only archive nodes", func(t *testing.T) {
+		t.Parallel()
+		archive := NewArchiveNode[types.ID, types.Head[Hashable], multiNodeRPCClient](newNode(t, "archive"))
+		mn := newTestMultiNode(t, multiNodeOpts{
+			selectionMode: NodeSelectionModeRoundRobin,
+			chainID:       types.RandomID(),
+			nodes:         []Node[types.ID, types.Head[Hashable], multiNodeRPCClient]{archive},
+		})
+		assert.Nil(t, mn.archiveSelector)
+	})
+	t.Run("Routes archive requests to archive nodes", func(t *testing.T) {
+		t.Parallel()
+		full := newNode(t, "full")
+		full.On("State").Return(nodeStateAlive)
+		archive := NewArchiveNode[types.ID, types.Head[Hashable], multiNodeRPCClient](newNode(t, "archive"))
+		mn := newTestMultiNode(t, multiNodeOpts{
+			selectionMode: NodeSelectionModeRoundRobin,
+			chainID:       types.RandomID(),
+			nodes:         []Node[types.ID, types.Head[Hashable], multiNodeRPCClient]{full, archive},
+		})
+		require.NotNil(t, mn.archiveSelector)
+		nodeSelector := newMockNodeSelector[types.ID, types.Head[Hashable], multiNodeRPCClient](t)
+		nodeSelector.On("Select").Return(full).Once()
+		mn.nodeSelector = nodeSelector
+		archiveSelector := newMockNodeSelector[types.ID, types.Head[Hashable], multiNodeRPCClient](t)
+		archiveSelector.On("Select").Return(archive).Once()
+		mn.archiveSelector = archiveSelector
+
+		ctx := tests.Context(t)
+		node, err := mn.selectNodeFor(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "full", node.String())
+		node, err = mn.selectNodeFor(CtxRequireArchive(ctx))
+		require.NoError(t, err)
+		assert.Equal(t, "archive", node.String())
+		// archive requests do not replace the active node
+		node, err = mn.selectNodeFor(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "full", node.String())
+	})
+	t.Run("Falls back to full nodes if no archive node is alive", func(t *testing.T) {
+		t.Parallel()
+		lggr, observedLogs := logger.TestObserved(t, zap.WarnLevel)
+		full := newNode(t, "full")
+		archive := NewArchiveNode[types.ID, types.Head[Hashable], multiNodeRPCClient](newNode(t, "archive"))
+		mn := newTestMultiNode(t, multiNodeOpts{
+			logger:        lggr,
+			selectionMode: NodeSelectionModeRoundRobin,
+			chainID:       types.RandomID(),
+			nodes:         []Node[types.ID, types.Head[Hashable], multiNodeRPCClient]{full, archive},
+		})
+		nodeSelector := newMockNodeSelector[types.ID, types.Head[Hashable], multiNodeRPCClient](t)
+		nodeSelector.On("Select").Return(full).Once()
+		mn.nodeSelector = nodeSelector
+		archiveSelector := newMockNodeSelector[types.ID, types.Head[Hashable], multiNodeRPCClient](t)
+		archiveSelector.On("Select").Return(nil).Once()
+		archiveSelector.On("Name").Return("MockedNodeSelector").Once()
+		mn.archiveSelector = archiveSelector
+
+		node, err := mn.selectNodeFor(CtxRequireArchive(tests.Context(t)))
+		require.NoError(t, err)
+		assert.Equal(t, "full", node.String())
+		tests.RequireLogMessage(t, observedLogs, "No live archive RPC nodes available")
+	})
+	t.Run("Falls back to archive nodes if no full node is alive", func(t *testing.T) {
+		t.Parallel()
+		archive := NewArchiveNode[types.ID, types.Head[Hashable], multiNodeRPCClient](newNode(t, "archive"))
+		mn := newTestMultiNode(t, multiNodeOpts{
+			selectionMode: NodeSelectionModeRoundRobin,
+			chainID:       types.RandomID(),
+			nodes:         []Node[types.ID, types.Head[Hashable], multiNodeRPCClient]{newNode(t, "full"), archive},
+		})
+		nodeSelector := newMockNodeSelector[types.ID, types.Head[Hashable], multiNodeRPCClient](t)
+		nodeSelector.On("Select").Return(nil).Once()
+		mn.nodeSelector = nodeSelector
+		archiveSelector := newMockNodeSelector[types.ID, types.Head[Hashable], multiNodeRPCClient](t)
+		archiveSelector.On("Select").Return(archive).Once()
+		mn.archiveSelector = archiveSelector
+
+		node, err := mn.selectNodeFor(tests.Context(t))
+		require.NoError(t, err)
+		assert.Equal(t, "archive", node.String())
+	})
+}
+
 func TestMultiNode_ChainInfo(t *testing.T) {
 	t.Parallel()
 	type nodeParams struct {
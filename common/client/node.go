@@ -79,6 +79,8 @@ type Node[
 	UnsubscribeAllExceptAliveLoop()
 	ConfiguredChainID() CHAIN_ID
 	Order() int32
+	// HealthStats returns the poll latency and error rate observed for the node
+	HealthStats() NodeHealthStats
 	Start(context.Context) error
 	Close() error
 }
@@ -108,6 +110,9 @@ type node[
 
 	poolInfoProvider PoolChainInfoProvider
 
+	healthMu sync.RWMutex // protects health
+	health   NodeHealthStats
+
 	stopCh services.StopChan
 	// wg waits for subsidiary goroutines
 	wg sync.WaitGroup
@@ -156,6 +161,34 @@ func NewNode[
 	return n
 }
 
+// archiveNode marks a Node as serving archive state, see CtxRequireArchive.
+type archiveNode[
+	CHAIN_ID types.ID,
+	HEAD Head,
+	RPC NodeClient[CHAIN_ID, HEAD],
+] struct {
+	Node[CHAIN_ID, HEAD, RPC]
+}
+
+// NewArchiveNode wraps node so that the MultiNode routes the requests which require archive state to it, and keeps the
+// rest on full nodes.
+func NewArchiveNode[
+	CHAIN_ID types.ID,
+	HEAD Head,
+	RPC NodeClient[CHAIN_ID, HEAD],
+](node Node[CHAIN_ID, HEAD, RPC]) Node[CHAIN_ID, HEAD, RPC] {
+	return &archiveNode[CHAIN_ID, HEAD, RPC]{Node: node}
+}
+
+func isArchiveNode[
+	CHAIN_ID types.ID,
+	HEAD Head,
+	RPC NodeClient[CHAIN_ID, HEAD],
+](node Node[CHAIN_ID, HEAD, RPC]) bool {
+	_, ok := node.(*archiveNode[CHAIN_ID, HEAD, RPC])
+	return ok
+}
+
 func (n *node[CHAIN_ID, HEAD, RPC]) String() string {
 	s := fmt.Sprintf("(%s)%s:%s", Primary.String(), n.name, n.ws.String())
 	if n.http != nil {
@@ -324,6 +357,34 @@ func (n *node[CHAIN_ID, HEAD, RPC]) Order() int32 {
 	return n.order
 }
 
+func (n *node[CHAIN_ID, HEAD, RPC]) HealthStats() NodeHealthStats {
+	n.healthMu.RLock()
+	defer n.healthMu.RUnlock()
+	return n.health
+}
+
+func (n *node[CHAIN_ID, HEAD, RPC]) recordPoll(latency time.Duration, err error) {
+	n.healthMu.Lock()
+	n.health.record(latency, err)
+	n.healthMu.Unlock()
+	n.updateHealthScore()
+}
+
+// updateHealthScore reports the health score of the node with its latest poll results and head lag, so that the
+// gauge stays current while the HealthScore node selector is not asked to select a node
+func (n *node[CHAIN_ID, HEAD, RPC]) updateHealthScore() {
+	if n.nodePoolCfg.SelectionMode() != NodeSelectionModeHealthScore {
+		return
+	}
+	state, localChainInfo := n.StateAndLatest()
+	var headLag int64
+	if n.poolInfoProvider != nil {
+		_, poolChainInfo := n.poolInfoProvider.LatestChainInfo()
+		headLag = poolChainInfo.BlockNumber - localChainInfo.BlockNumber
+	}
+	promPoolRPCNodeHealthScore.WithLabelValues(n.chainID.String(), n.name).Set(healthScore(state, n.HealthStats(), headLag))
+}
+
 func (n *node[CHAIN_ID, HEAD, RPC]) newCtx() (context.Context, context.CancelFunc) {
 	ctx, cancel := n.stopCh.NewCtx()
 	ctx = CtxAddHealthCheckFlag(ctx)
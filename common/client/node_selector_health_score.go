@@ -0,0 +1,135 @@
+package client
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+var promPoolRPCNodeHealthScore = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "pool_rpc_node_health_score",
+	Help: "The health score between 0 and 1 of the given RPC node, as computed by the HealthScore node selector",
+}, []string{"chainID", "nodeName"})
+
+const (
+	// healthStatsSmoothing is the weight of the newest poll in the moving averages of NodeHealthStats
+	healthStatsSmoothing = 0.2
+	// healthScoreMaxHeadLag is the number of blocks behind the highest head at which a node gets no head lag score
+	healthScoreMaxHeadLag = 10
+	// healthScoreHysteresis is the minimum score advantage a node needs over the currently selected node to replace it
+	healthScoreHysteresis = 0.1
+
+	healthScoreLatencyWeight = 0.25
+	healthScoreErrorWeight   = 0.35
+	healthScoreHeadLagWeight = 0.4
+)
+
+// NodeHealthStats tracks exponential moving averages of a node's poll results
+type NodeHealthStats struct {
+	Latency   time.Duration
+	ErrorRate float64
+	Polls     uint64
+}
+
+func (h *NodeHealthStats) record(latency time.Duration, err error) {
+	var failed float64
+	if err != nil {
+		failed = 1
+		// a failed poll tells nothing about the latency of a healthy response, assume the worst
+		latency = QueryTimeout
+	}
+	if h.Polls == 0 {
+		h.Latency = latency
+		h.ErrorRate = failed
+	} else {
+		h.Latency = time.Duration(healthStatsSmoothing*float64(latency) + (1-healthStatsSmoothing)*float64(h.Latency))
+		h.ErrorRate = healthStatsSmoothing*failed + (1-healthStatsSmoothing)*h.ErrorRate
+	}
+	h.Polls++
+}
+
+// healthScore weighs the latency, error rate and head lag of an alive node into a score between 0 and 1.
+// Nodes that are not alive, e.g. syncing or out of sync, always score 0.
+func healthScore(state nodeState, stats NodeHealthStats, headLag int64) float64 {
+	if state != nodeStateAlive {
+		return 0
+	}
+	latencyScore := 1 - math.Min(float64(stats.Latency)/float64(QueryTimeout), 1)
+	errorScore := 1 - stats.ErrorRate
+	lagScore := 1 - math.Min(float64(max(headLag, 0))/healthScoreMaxHeadLag, 1)
+	return healthScoreLatencyWeight*latencyScore + healthScoreErrorWeight*errorScore + healthScoreHeadLagWeight*lagScore
+}
+
+type healthScoreNodeSelector[
+	CHAIN_ID types.ID,
+	HEAD Head,
+	RPC NodeClient[CHAIN_ID, HEAD],
+] struct {
+	nodes []Node[CHAIN_ID, HEAD, RPC]
+
+	mu      sync.Mutex
+	current Node[CHAIN_ID, HEAD, RPC]
+}
+
+func NewHealthScoreNodeSelector[
+	CHAIN_ID types.ID,
+	HEAD Head,
+	RPC NodeClient[CHAIN_ID, HEAD],
+](nodes []Node[CHAIN_ID, HEAD, RPC]) NodeSelector[CHAIN_ID, HEAD, RPC] {
+	return &healthScoreNodeSelector[CHAIN_ID, HEAD, RPC]{nodes: nodes}
+}
+
+// Select returns the alive node with the highest health score. The previously selected node is kept
+// unless another node outscores it by more than healthScoreHysteresis, to avoid flapping between nodes of similar health.
+func (s *healthScoreNodeSelector[CHAIN_ID, HEAD, RPC]) Select() Node[CHAIN_ID, HEAD, RPC] {
+	states := make([]nodeState, len(s.nodes))
+	chainInfos := make([]ChainInfo, len(s.nodes))
+	var highestHeadNumber int64 = math.MinInt64
+	for i, n := range s.nodes {
+		states[i], chainInfos[i] = n.StateAndLatest()
+		if states[i] == nodeStateAlive && chainInfos[i].BlockNumber > highestHeadNumber {
+			highestHeadNumber = chainInfos[i].BlockNumber
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var best Node[CHAIN_ID, HEAD, RPC]
+	bestScore := -1.0
+	currentScore := -1.0
+	for i, n := range s.nodes {
+		score := healthScore(states[i], n.HealthStats(), highestHeadNumber-chainInfos[i].BlockNumber)
+		promPoolRPCNodeHealthScore.WithLabelValues(n.ConfiguredChainID().String(), n.Name()).Set(score)
+		if states[i] != nodeStateAlive {
+			continue
+		}
+		if n == s.current {
+			currentScore = score
+		}
+		// ties are broken by the node order
+		if score > bestScore || (score == bestScore && n.Order() < best.Order()) {
+			best = n
+			bestScore = score
+		}
+	}
+
+	if best == nil {
+		s.current = nil
+		return nil
+	}
+	if currentScore >= 0 && bestScore-currentScore <= healthScoreHysteresis {
+		return s.current
+	}
+	s.current = best
+	return best
+}
+
+func (s *healthScoreNodeSelector[CHAIN_ID, HEAD, RPC]) Name() string {
+	return NodeSelectionModeHealthScore
+}
@@ -0,0 +1,136 @@
+package client
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	promtestutil "github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+func TestHealthScoreNodeSelectorName(t *testing.T) {
+	selector := newNodeSelector[types.ID, Head, NodeClient[types.ID, Head]](NodeSelectionModeHealthScore, nil)
+	assert.Equal(t, selector.Name(), NodeSelectionModeHealthScore)
+}
+
+func TestHealthScoreNodeSelector(t *testing.T) {
+	t.Parallel()
+
+	type nodeClient NodeClient[types.ID, Head]
+	chainID := types.RandomID()
+
+	newNode := func(t *testing.T, state nodeState, blockNumber int64, stats NodeHealthStats, order int32) *mockNode[types.ID, Head, nodeClient] {
+		node := newMockNode[types.ID, Head, nodeClient](t)
+		node.On("StateAndLatest").Return(state, ChainInfo{BlockNumber: blockNumber})
+		node.On("HealthStats").Return(stats)
+		node.On("ConfiguredChainID").Return(chainID)
+		node.On("Name").Return("node")
+		node.On("Order").Maybe().Return(order)
+		return node
+	}
+	healthy := NodeHealthStats{Latency: 100 * time.Millisecond, Polls: 10}
+
+	t.Run("selects the healthiest alive node", func(t *testing.T) {
+		nodes := []Node[types.ID, Head, nodeClient]{
+			newNode(t, nodeStateOutOfSync, 10, healthy, 1),
+			newNode(t, nodeStateAlive, 10, NodeHealthStats{Latency: 100 * time.Millisecond, ErrorRate: 0.8, Polls: 10}, 1),
+			newNode(t, nodeStateAlive, 10, healthy, 2),
+		}
+		selector := newNodeSelector(NodeSelectionModeHealthScore, nodes)
+		assert.Same(t, nodes[2], selector.Select())
+	})
+
+	t.Run("penalizes head lag", func(t *testing.T) {
+		nodes := []Node[types.ID, Head, nodeClient]{
+			newNode(t, nodeStateAlive, 1, healthy, 1),
+			newNode(t, nodeStateAlive, 10, healthy, 2),
+		}
+		selector := newNodeSelector(NodeSelectionModeHealthScore, nodes)
+		assert.Same(t, nodes[1], selector.Select())
+	})
+
+	t.Run("breaks ties by order", func(t *testing.T) {
+		nodes := []Node[types.ID, Head, nodeClient]{
+			newNode(t, nodeStateAlive, 10, healthy, 2),
+			newNode(t, nodeStateAlive, 10, healthy, 1),
+		}
+		selector := newNodeSelector(NodeSelectionModeHealthScore, nodes)
+		assert.Same(t, nodes[1], selector.Select())
+	})
+
+	t.Run("keeps the current node within hysteresis", func(t *testing.T) {
+		current := newMockNode[types.ID, Head, nodeClient](t)
+		current.On("StateAndLatest").Return(nodeStateAlive, ChainInfo{BlockNumber: 10})
+		current.On("HealthStats").Return(healthy).Once()
+		current.On("ConfiguredChainID").Return(chainID)
+		current.On("Name").Return("current")
+		current.On("Order").Maybe().Return(int32(1))
+		nodes := []Node[types.ID, Head, nodeClient]{current, newNode(t, nodeStateAlive, 10, NodeHealthStats{Latency: 2 * time.Second, Polls: 10}, 2)}
+
+		selector := newNodeSelector(NodeSelectionModeHealthScore, nodes)
+		assert.Same(t, current, selector.Select())
+
+		// slightly worse than the other node is not enough to switch
+		current.On("HealthStats").Return(NodeHealthStats{Latency: 2 * time.Second, ErrorRate: 0.1, Polls: 10}).Once()
+		assert.Same(t, current, selector.Select())
+
+		// significantly worse than the other node triggers a switch
+		current.On("HealthStats").Return(NodeHealthStats{Latency: 2 * time.Second, ErrorRate: 0.5, Polls: 10}).Once()
+		assert.Same(t, nodes[1], selector.Select())
+	})
+
+	t.Run("returns nil if no node is alive", func(t *testing.T) {
+		nodes := []Node[types.ID, Head, nodeClient]{
+			newNode(t, nodeStateOutOfSync, 10, healthy, 1),
+			newNode(t, nodeStateUnreachable, 10, healthy, 1),
+		}
+		selector := newNodeSelector(NodeSelectionModeHealthScore, nodes)
+		assert.Nil(t, selector.Select())
+	})
+}
+
+func TestHealthStats_Record(t *testing.T) {
+	t.Parallel()
+
+	var stats NodeHealthStats
+	stats.record(100*time.Millisecond, nil)
+	assert.Equal(t, 100*time.Millisecond, stats.Latency)
+	assert.Equal(t, 0.0, stats.ErrorRate)
+
+	stats.record(100*time.Millisecond, errors.New("poll failed"))
+	assert.InDelta(t, healthStatsSmoothing, stats.ErrorRate, 1e-9)
+	assert.Greater(t, stats.Latency, 100*time.Millisecond)
+	assert.Equal(t, uint64(2), stats.Polls)
+}
+
+func TestNode_RecordPoll_UpdatesHealthScore(t *testing.T) {
+	t.Parallel()
+
+	rpc := newMockNodeClient[types.ID, Head](t)
+	rpc.On("GetInterceptedChainInfo").Return(ChainInfo{BlockNumber: 5}, ChainInfo{})
+	node := newTestNode(t, testNodeOpts{
+		config: testNodeConfig{selectionMode: NodeSelectionModeHealthScore},
+		rpc:    rpc,
+	})
+	poolInfo := newMockPoolChainInfoProvider(t)
+	poolInfo.On("LatestChainInfo").Return(2, ChainInfo{BlockNumber: 10})
+	node.SetPoolChainInfoProvider(poolInfo)
+	node.setState(nodeStateAlive)
+	gauge := promPoolRPCNodeHealthScore.WithLabelValues(node.chainID.String(), node.name)
+
+	// the gauge is updated on poll, without a node being selected
+	node.recordPoll(100*time.Millisecond, nil)
+	expected := healthScore(nodeStateAlive, node.HealthStats(), 5)
+	assert.Greater(t, expected, 0.0)
+	assert.InDelta(t, expected, promtestutil.ToFloat64(gauge), 1e-9)
+
+	node.recordPoll(100*time.Millisecond, errors.New("poll failed"))
+	assert.Less(t, promtestutil.ToFloat64(gauge), expected)
+
+	node.setState(nodeStateUnreachable)
+	node.updateHealthScore()
+	assert.Equal(t, 0.0, promtestutil.ToFloat64(gauge))
+}
@@ -55,6 +55,7 @@ type headTracker[
 
 	log             logger.SugaredLogger
 	headBroadcaster HeadBroadcaster[HTH, BLOCK_HASH]
+	reorgBus        ReorgBus[HTH, BLOCK_HASH]
 	headSaver       HeadSaver[HTH, BLOCK_HASH]
 	mailMon         *mailbox.Monitor
 	client          htrktypes.Client[HTH, S, ID, BLOCK_HASH]
@@ -66,6 +67,8 @@ type headTracker[
 	broadcastMB  *mailbox.Mailbox[HTH]
 	headListener HeadListener[HTH, BLOCK_HASH]
 	getNilHead   func() HTH
+	// lastBackfilled is the latest chain checked for reorgs, only accessed by backfillLoop.
+	lastBackfilled HTH
 }
 
 // NewHeadTracker instantiates a new HeadTracker using HeadSaver to persist new block numbers.
@@ -80,12 +83,14 @@ func NewHeadTracker[
 	config htrktypes.Config,
 	htConfig htrktypes.HeadTrackerConfig,
 	headBroadcaster HeadBroadcaster[HTH, BLOCK_HASH],
+	reorgBus ReorgBus[HTH, BLOCK_HASH],
 	headSaver HeadSaver[HTH, BLOCK_HASH],
 	mailMon *mailbox.Monitor,
 	getNilHead func() HTH,
 ) HeadTracker[HTH, BLOCK_HASH] {
 	ht := &headTracker[HTH, S, ID, BLOCK_HASH]{
 		headBroadcaster: headBroadcaster,
+		reorgBus:        reorgBus,
 		client:          client,
 		chainID:         client.ConfiguredChainID(),
 		config:          config,
@@ -309,6 +314,8 @@ func (ht *headTracker[HTH, S, ID, BLOCK_HASH]) backfillLoop(ctx context.Context)
 						ht.log.Warnw("Unexpected error while backfilling heads", "err", err)
 					} else if ctx.Err() != nil {
 						break
+					} else {
+						ht.publishReorg(head)
 					}
 				}
 			}
@@ -316,6 +323,28 @@ func (ht *headTracker[HTH, S, ID, BLOCK_HASH]) backfillLoop(ctx context.Context)
 	}
 }
 
+// publishReorg publishes a reorg to the ReorgBus if the backfilled chain of head does not contain the previous one.
+func (ht *headTracker[HTH, S, ID, BLOCK_HASH]) publishReorg(head HTH) {
+	// reload the chain, since it only links the heads which were saved before the backfill
+	headWithChain := ht.headSaver.Chain(head.BlockHash())
+	if !headWithChain.IsValid() {
+		return
+	}
+	prev := ht.lastBackfilled
+	ht.lastBackfilled = headWithChain
+	reorg, found := findReorg[HTH, BLOCK_HASH](prev, headWithChain)
+	if !found {
+		return
+	}
+	l := ht.log.With("blockNumber", head.BlockNumber(), "blockHash", head.BlockHash(), "orphaned", len(reorg.Orphaned))
+	if reorg.CommonAncestor == nil {
+		l.Warn("Detected reorg deeper than the heads kept in memory")
+	} else {
+		l.Infow("Detected reorg", "commonAncestor", reorg.CommonAncestor.BlockNumber())
+	}
+	ht.reorgBus.PublishReorg(reorg)
+}
+
 // LatestAndFinalizedBlock - returns latest and latest finalized blocks.
 // NOTE: Returns latest finalized block as is, ignoring the FinalityTagBypass feature flag.
 // TODO: BCI-3321 use cached values instead of making RPC requests
@@ -367,21 +396,13 @@ func (ht *headTracker[HTH, S, ID, BLOCK_HASH]) getHeadAtHeight(ctx context.Conte
 // must be performed before usage.
 func (ht *headTracker[HTH, S, ID, BLOCK_HASH]) calculateLatestFinalized(ctx context.Context, currentHead HTH, finalityTagBypass bool) (HTH, error) {
 	if ht.config.FinalityTagEnabled() && !finalityTagBypass {
-		latestFinalized, err := ht.client.LatestFinalizedBlock(ctx)
-		if err != nil {
-			return latestFinalized, fmt.Errorf("failed to get latest finalized block: %w", err)
-		}
-
-		if !latestFinalized.IsValid() {
-			return latestFinalized, fmt.Errorf("failed to get valid latest finalized block")
-		}
-
-		if ht.config.FinalizedBlockOffset() == 0 {
-			return latestFinalized, nil
+		latestFinalized, err := ht.latestFinalizedByTag(ctx)
+		if err == nil || !ht.htConfig.FinalityDepthFallback() {
+			return latestFinalized, err
 		}
 
-		finalizedBlockNumber := max(latestFinalized.BlockNumber()-int64(ht.config.FinalizedBlockOffset()), 0)
-		return ht.getHeadAtHeight(ctx, latestFinalized.BlockHash(), finalizedBlockNumber)
+		ht.log.Warnw("Failed to get latest finalized block using finality tag, falling back to FinalityDepth",
+			"err", err, "finalityDepth", ht.config.FinalityDepth())
 	}
 	// no need to make an additional RPC call on chains with instant finality
 	if ht.config.FinalityDepth() == 0 && ht.config.FinalizedBlockOffset() == 0 {
@@ -394,6 +415,25 @@ func (ht *headTracker[HTH, S, ID, BLOCK_HASH]) calculateLatestFinalized(ctx cont
 	return ht.getHeadAtHeight(ctx, currentHead.BlockHash(), finalizedBlockNumber)
 }
 
+// latestFinalizedByTag - returns latest finalized block reported by the RPC adjusted by FinalizedBlockOffset.
+func (ht *headTracker[HTH, S, ID, BLOCK_HASH]) latestFinalizedByTag(ctx context.Context) (HTH, error) {
+	latestFinalized, err := ht.client.LatestFinalizedBlock(ctx)
+	if err != nil {
+		return latestFinalized, fmt.Errorf("failed to get latest finalized block: %w", err)
+	}
+
+	if !latestFinalized.IsValid() {
+		return latestFinalized, fmt.Errorf("failed to get valid latest finalized block")
+	}
+
+	if ht.config.FinalizedBlockOffset() == 0 {
+		return latestFinalized, nil
+	}
+
+	finalizedBlockNumber := max(latestFinalized.BlockNumber()-int64(ht.config.FinalizedBlockOffset()), 0)
+	return ht.getHeadAtHeight(ctx, latestFinalized.BlockHash(), finalizedBlockNumber)
+}
+
 // backfill fetches all missing heads up until the latestFinalizedHead
 func (ht *headTracker[HTH, S, ID, BLOCK_HASH]) backfill(ctx context.Context, head, latestFinalizedHead HTH) (err error) {
 	headBlockNumber := head.BlockNumber()
@@ -0,0 +1,173 @@
+package headtracker
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/mailbox"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+// Reorg describes a new longest chain which does not contain the previous one.
+type Reorg[H types.Head[BLOCK_HASH], BLOCK_HASH types.Hashable] struct {
+	// NewHead is the head of the new longest chain.
+	NewHead H
+	// CommonAncestor is the latest block of the previous chain which is still part of the new one. It is nil if the
+	// reorg is deeper than the heads kept by the HeadTracker.
+	CommonAncestor types.Head[BLOCK_HASH]
+	// Orphaned lists the blocks of the previous chain after the CommonAncestor, newest first.
+	Orphaned []types.Head[BLOCK_HASH]
+}
+
+// ReorgSubscriber is implemented by the services which have to react to reorgs, e.g. to drop the state they derived
+// from orphaned blocks.
+type ReorgSubscriber[H types.Head[BLOCK_HASH], BLOCK_HASH types.Hashable] interface {
+	OnReorg(ctx context.Context, reorg Reorg[H, BLOCK_HASH])
+}
+
+// ReorgBus relays the reorgs detected by the HeadTracker to all subscribers, so that each service does not have to
+// detect them on its own.
+type ReorgBus[H types.Head[BLOCK_HASH], BLOCK_HASH types.Hashable] interface {
+	services.Service
+	PublishReorg(Reorg[H, BLOCK_HASH])
+	Subscribe(callback ReorgSubscriber[H, BLOCK_HASH]) (unsubscribe func())
+}
+
+type reorgBus[H types.Head[BLOCK_HASH], BLOCK_HASH types.Hashable] struct {
+	services.Service
+	eng *services.Engine
+
+	subscribers      map[int]ReorgSubscriber[H, BLOCK_HASH]
+	mailbox          *mailbox.Mailbox[Reorg[H, BLOCK_HASH]]
+	mutex            sync.Mutex
+	lastSubscriberID int
+}
+
+// NewReorgBus creates a new ReorgBus
+func NewReorgBus[
+	H types.Head[BLOCK_HASH],
+	BLOCK_HASH types.Hashable,
+](
+	lggr logger.Logger,
+) ReorgBus[H, BLOCK_HASH] {
+	rb := &reorgBus[H, BLOCK_HASH]{
+		subscribers: make(map[int]ReorgSubscriber[H, BLOCK_HASH]),
+		// unlike heads, reorgs must not be skipped, since each of them orphans different blocks
+		mailbox: mailbox.NewHighCapacity[Reorg[H, BLOCK_HASH]](),
+	}
+	rb.Service, rb.eng = services.Config{
+		Name:  "ReorgBus",
+		Start: rb.start,
+		Close: rb.close,
+	}.NewServiceEngine(lggr)
+	return rb
+}
+
+func (rb *reorgBus[H, BLOCK_HASH]) start(context.Context) error {
+	rb.eng.Go(rb.run)
+	return nil
+}
+
+func (rb *reorgBus[H, BLOCK_HASH]) close() error {
+	rb.mutex.Lock()
+	rb.subscribers = make(map[int]ReorgSubscriber[H, BLOCK_HASH])
+	rb.mutex.Unlock()
+	return nil
+}
+
+func (rb *reorgBus[H, BLOCK_HASH]) PublishReorg(reorg Reorg[H, BLOCK_HASH]) {
+	rb.mailbox.Deliver(reorg)
+}
+
+// Subscribe subscribes to OnReorg until ReorgBus is closed, or unsubscribe callback is called explicitly
+func (rb *reorgBus[H, BLOCK_HASH]) Subscribe(callback ReorgSubscriber[H, BLOCK_HASH]) (unsubscribe func()) {
+	rb.mutex.Lock()
+	defer rb.mutex.Unlock()
+
+	rb.lastSubscriberID++
+	subscriberID := rb.lastSubscriberID
+	rb.subscribers[subscriberID] = callback
+	return func() {
+		rb.mutex.Lock()
+		defer rb.mutex.Unlock()
+		delete(rb.subscribers, subscriberID)
+	}
+}
+
+func (rb *reorgBus[H, BLOCK_HASH]) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-rb.mailbox.Notify():
+			for {
+				reorg, exists := rb.mailbox.Retrieve()
+				if !exists {
+					break
+				}
+				rb.executeCallbacks(ctx, reorg)
+			}
+		}
+	}
+}
+
+// executeCallbacks delivers the reorg to all subscribers and waits for them, so that they observe reorgs in order.
+func (rb *reorgBus[H, BLOCK_HASH]) executeCallbacks(ctx context.Context, reorg Reorg[H, BLOCK_HASH]) {
+	rb.mutex.Lock()
+	subscribers := make([]ReorgSubscriber[H, BLOCK_HASH], 0, len(rb.subscribers))
+	for _, s := range rb.subscribers {
+		subscribers = append(subscribers, s)
+	}
+	rb.mutex.Unlock()
+
+	rb.eng.Debugw("Initiating reorg callbacks",
+		"headNum", reorg.NewHead.BlockNumber(),
+		"orphaned", len(reorg.Orphaned),
+		"numCallbacks", len(subscribers),
+	)
+
+	var wg sync.WaitGroup
+	wg.Add(len(subscribers))
+	for _, subscriber := range subscribers {
+		go func(s ReorgSubscriber[H, BLOCK_HASH]) {
+			defer wg.Done()
+			start := time.Now()
+			cctx, cancel := context.WithTimeout(ctx, TrackableCallbackTimeout)
+			defer cancel()
+			s.OnReorg(cctx, reorg)
+			elapsed := time.Since(start)
+			rb.eng.Debugw(fmt.Sprintf("Finished reorg callback in %s", elapsed),
+				"callbackType", reflect.TypeOf(s), "blockNumber", reorg.NewHead.BlockNumber(), "time", elapsed)
+		}(subscriber)
+	}
+	wg.Wait()
+}
+
+// findReorg compares the new longest chain with the previous one, and returns false if the new chain extends it, or
+// does not reach back far enough to tell.
+func findReorg[H types.Head[BLOCK_HASH], BLOCK_HASH types.Hashable](prev, head H) (reorg Reorg[H, BLOCK_HASH], found bool) {
+	if !prev.IsValid() || !head.IsValid() {
+		return reorg, false
+	}
+	earliest := head.EarliestHeadInChain().BlockNumber()
+	if earliest > prev.BlockNumber() || head.HashAtHeight(prev.BlockNumber()) == prev.BlockHash() {
+		return reorg, false
+	}
+	reorg.NewHead = head
+	var block types.Head[BLOCK_HASH] = prev
+	for block != nil && block.IsValid() && block.BlockNumber() >= earliest {
+		if head.HashAtHeight(block.BlockNumber()) == block.BlockHash() {
+			reorg.CommonAncestor = block
+			break
+		}
+		reorg.Orphaned = append(reorg.Orphaned, block)
+		block = block.GetParent()
+	}
+	return reorg, true
+}
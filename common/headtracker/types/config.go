@@ -14,6 +14,7 @@ type HeadTrackerConfig interface {
 	MaxBufferSize() uint32
 	SamplingInterval() time.Duration
 	FinalityTagBypass() bool
+	FinalityDepthFallback() bool
 	MaxAllowedFinalityDepth() uint32
 	PersistenceEnabled() bool
 }
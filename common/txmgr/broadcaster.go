@@ -39,6 +39,10 @@ const (
 	// maxBroadcastRetries is the number of times a transaction broadcast is retried when the sequence fails to increment on Hedera
 	maxHederaBroadcastRetries = 3
 
+	// feeLimitPrefetchSize is the number of unstarted transactions of an address whose fee limits are estimated together,
+	// when the TxAttemptBuilder supports it
+	feeLimitPrefetchSize = 100
+
 	// hederaChainType is the string representation of the Hedera chain type
 	// Temporary solution until the Broadcaster is moved to the EVM code base
 	hederaChainType = "hedera"
@@ -121,6 +125,7 @@ type Broadcaster[
 	txmgrtypes.TxAttemptBuilder[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
 	sequenceTracker txmgrtypes.SequenceTracker[ADDR, SEQ]
 	resumeCallback  ResumeCallback
+	circuitBreaker  *CircuitBreaker
 	chainID         CHAIN_ID
 	chainType       string
 	config          txmgrtypes.BroadcasterChainConfig
@@ -260,6 +265,11 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) SetR
 	eb.resumeCallback = callback
 }
 
+// SetCircuitBreaker sets the circuit breaker which halts the broadcast of new transactions during RPC incidents.
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) SetCircuitBreaker(cb *CircuitBreaker) {
+	eb.circuitBreaker = cb
+}
+
 func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Name() string {
 	return eb.lggr.Name()
 }
@@ -319,7 +329,10 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) moni
 		pollDBTimer := time.NewTimer(utils.WithJitter(eb.listenerConfig.FallbackPollInterval()))
 
 		retryable, err := eb.processUnstartedTxsImpl(ctx, addr)
-		if err != nil {
+		if errors.Is(err, ErrCircuitBreakerOpen) {
+			// the breaker already logged why it opened
+			eb.lggr.Debugw("Circuit breaker is open, holding unstarted transactions", "address", addr)
+		} else if err != nil {
 			eb.lggr.Errorw("Error occurred while handling tx queue in ProcessUnstartedTxs", "err", err)
 		}
 		// On retryable errors we implement exponential backoff retries. This
@@ -378,6 +391,7 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) proc
 	if err != nil {
 		return retryable, fmt.Errorf("processUnstartedTxs failed on handleAnyInProgressTx: %w", err)
 	}
+	eb.prefetchFeeLimits(ctx, fromAddress)
 	for {
 		maxInFlightTransactions := eb.txConfig.MaxInFlight()
 		if maxInFlightTransactions > 0 {
@@ -406,6 +420,11 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) proc
 		if etx == nil {
 			return false, nil
 		}
+		// In progress transactions already hold a sequence and are always retried above, only new ones are halted.
+		// The breaker is only asked once there is a tx to send, so that idle keys do not use up the half-open probe.
+		if !eb.circuitBreaker.Allow() {
+			return true, ErrCircuitBreakerOpen
+		}
 		n++
 
 		if err, retryable := eb.handleUnstartedTx(ctx, etx); err != nil {
@@ -414,6 +433,26 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) proc
 	}
 }
 
+// prefetchFeeLimits lets a TxAttemptBuilder which supports it estimate the fee limits of the next unstarted transactions
+// of the address together, before their attempts are built one by one. Failures are only logged, as the fee limits are
+// estimated again when the attempts are built.
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) prefetchFeeLimits(ctx context.Context, fromAddress ADDR) {
+	prefetcher, ok := eb.TxAttemptBuilder.(txmgrtypes.FeeLimitPrefetcher[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE])
+	if !ok {
+		return
+	}
+	etxs, err := eb.txStore.FindUnstartedTransactionsFromAddress(ctx, fromAddress, eb.chainID, feeLimitPrefetchSize)
+	if err != nil {
+		eb.lggr.Warnw("Failed to load unstarted transactions to prefetch their fee limits", "address", fromAddress, "err", err)
+		return
+	}
+	// A single transaction is estimated when its attempt is built anyway
+	if len(etxs) < 2 {
+		return
+	}
+	prefetcher.PrefetchFeeLimits(ctx, etxs, eb.lggr)
+}
+
 // handleInProgressTx checks if there is any transaction
 // in_progress and if so, finishes the job
 func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) handleAnyInProgressTx(ctx context.Context, fromAddress ADDR) (err error, retryable bool) {
@@ -498,6 +537,14 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) hand
 		errType, err = eb.validateOnChainSequence(ctx, lgr, errType, err, etx, retryCount)
 	}
 
+	switch errType {
+	case client.Retryable, client.Unknown:
+		eb.circuitBreaker.RecordBroadcastFailure(err)
+	default:
+		// The RPC is reachable and classified the transaction
+		eb.circuitBreaker.RecordBroadcastSuccess()
+	}
+
 	if errType == client.Fatal || errType == client.TerminallyStuck {
 		eb.SvcErrBuffer.Append(err)
 		etx.Error = null.StringFrom(err.Error())
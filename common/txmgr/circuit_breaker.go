@@ -0,0 +1,224 @@
+package txmgr
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+)
+
+// ErrCircuitBreakerOpen is returned by the Broadcaster when new transactions are not broadcast because the circuit
+// breaker of the chain is open.
+var ErrCircuitBreakerOpen = errors.New("circuit breaker is open, not broadcasting new transactions")
+
+var promCircuitBreakerState = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "tx_manager_circuit_breaker_state",
+	Help: "The state of the broadcast circuit breaker: 0 closed, 1 open, 2 half-open.",
+}, []string{"chainID"})
+
+// CircuitBreakerState is the state of a CircuitBreaker.
+type CircuitBreakerState string
+
+const (
+	// CircuitBreakerClosed allows all broadcasts.
+	CircuitBreakerClosed CircuitBreakerState = "closed"
+	// CircuitBreakerOpen halts the broadcast of new transactions.
+	CircuitBreakerOpen CircuitBreakerState = "open"
+	// CircuitBreakerHalfOpen allows a single probe broadcast, which closes the breaker if it succeeds, and opens it
+	// again if it fails.
+	CircuitBreakerHalfOpen CircuitBreakerState = "half-open"
+)
+
+func (s CircuitBreakerState) gaugeValue() float64 {
+	switch s {
+	case CircuitBreakerOpen:
+		return 1
+	case CircuitBreakerHalfOpen:
+		return 2
+	default:
+		return 0
+	}
+}
+
+// CircuitBreakerStatus is a snapshot of a CircuitBreaker.
+type CircuitBreakerStatus struct {
+	State                        CircuitBreakerState
+	ConsecutiveBroadcastFailures uint32
+	ConsecutiveReceiptFailures   uint32
+	// OpenedAt is when the breaker last opened, or zero if it never did.
+	OpenedAt time.Time
+	// LastError is the failure which last opened the breaker.
+	LastError string
+}
+
+// CircuitBreaker halts the broadcast of new transactions of a chain when consecutive broadcast or receipt fetching
+// failures exceed their thresholds, which protects the sequences of the keys during RPC incidents. After ResetTimeout,
+// the breaker turns half-open and lets a single probe through, or it can be closed manually with Reset.
+//
+// A nil *CircuitBreaker is disabled, and always allows broadcasts.
+type CircuitBreaker struct {
+	lggr    logger.SugaredLogger
+	chainID string
+	cfg     txmgrtypes.CircuitBreakerConfig
+	now     func() time.Time
+
+	mu                sync.Mutex
+	state             CircuitBreakerState
+	broadcastFailures uint32
+	receiptFailures   uint32
+	openedAt          time.Time
+	probedAt          time.Time
+	lastErr           string
+}
+
+// NewCircuitBreaker returns a closed CircuitBreaker for chainID.
+func NewCircuitBreaker(lggr logger.Logger, chainID string, cfg txmgrtypes.CircuitBreakerConfig) *CircuitBreaker {
+	cb := &CircuitBreaker{
+		lggr:    logger.Sugared(logger.Named(lggr, "CircuitBreaker")),
+		chainID: chainID,
+		cfg:     cfg,
+		now:     time.Now,
+		state:   CircuitBreakerClosed,
+	}
+	promCircuitBreakerState.WithLabelValues(chainID).Set(cb.state.gaugeValue())
+	return cb
+}
+
+// Allow returns whether a new transaction may be broadcast. An open breaker turns half-open once ResetTimeout has
+// elapsed, and then allows a single probe per ResetTimeout until an outcome is recorded.
+func (cb *CircuitBreaker) Allow() bool {
+	if cb == nil {
+		return true
+	}
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	now := cb.now()
+	switch cb.state {
+	case CircuitBreakerOpen:
+		if now.Sub(cb.openedAt) < cb.cfg.ResetTimeout() {
+			return false
+		}
+		cb.setState(CircuitBreakerHalfOpen)
+		cb.lggr.Infow("Circuit breaker is half-open, probing with a broadcast", "openedAt", cb.openedAt)
+	case CircuitBreakerHalfOpen:
+		if now.Sub(cb.probedAt) < cb.cfg.ResetTimeout() {
+			return false
+		}
+	default:
+		return true
+	}
+	cb.probedAt = now
+	return true
+}
+
+// RecordBroadcastSuccess records that a transaction was accepted by the RPC.
+func (cb *CircuitBreaker) RecordBroadcastSuccess() {
+	if cb == nil {
+		return
+	}
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	cb.broadcastFailures = 0
+	cb.recordSuccess()
+}
+
+// RecordBroadcastFailure records that a transaction could not be broadcast because of an RPC error.
+func (cb *CircuitBreaker) RecordBroadcastFailure(err error) {
+	if cb == nil {
+		return
+	}
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	cb.broadcastFailures++
+	cb.recordFailure(err, cb.broadcastFailures >= cb.cfg.BroadcastFailureThreshold())
+}
+
+// RecordReceiptSuccess records that a batch of receipts was fetched. It does not close a half-open breaker, which
+// only closes once its probe broadcast succeeds.
+func (cb *CircuitBreaker) RecordReceiptSuccess() {
+	if cb == nil {
+		return
+	}
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	cb.receiptFailures = 0
+}
+
+// RecordReceiptFailure records that a batch of receipts could not be fetched.
+func (cb *CircuitBreaker) RecordReceiptFailure(err error) {
+	if cb == nil {
+		return
+	}
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	cb.receiptFailures++
+	cb.recordFailure(err, cb.receiptFailures >= cb.cfg.ReceiptFailureThreshold())
+}
+
+// Reset closes the breaker and clears its failure counters.
+func (cb *CircuitBreaker) Reset() {
+	if cb == nil {
+		return
+	}
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	cb.broadcastFailures, cb.receiptFailures = 0, 0
+	if cb.state != CircuitBreakerClosed {
+		cb.lggr.Infow("Circuit breaker was reset manually", "state", cb.state)
+		cb.setState(CircuitBreakerClosed)
+	}
+}
+
+// Status returns a snapshot of the breaker.
+func (cb *CircuitBreaker) Status() CircuitBreakerStatus {
+	if cb == nil {
+		return CircuitBreakerStatus{State: CircuitBreakerClosed}
+	}
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	return CircuitBreakerStatus{
+		State:                        cb.state,
+		ConsecutiveBroadcastFailures: cb.broadcastFailures,
+		ConsecutiveReceiptFailures:   cb.receiptFailures,
+		OpenedAt:                     cb.openedAt,
+		LastError:                    cb.lastErr,
+	}
+}
+
+// recordSuccess closes a half-open breaker after its probe broadcast succeeded. Successes while open are ignored, so
+// that the breaker only closes after a probe or a manual reset.
+func (cb *CircuitBreaker) recordSuccess() {
+	if cb.state == CircuitBreakerHalfOpen {
+		cb.lggr.Infow("Circuit breaker probe succeeded, resuming broadcasts")
+		cb.broadcastFailures, cb.receiptFailures = 0, 0
+		cb.setState(CircuitBreakerClosed)
+	}
+}
+
+func (cb *CircuitBreaker) recordFailure(err error, exceeded bool) {
+	switch {
+	case cb.state == CircuitBreakerHalfOpen:
+		cb.lggr.Warnw("Circuit breaker probe failed, halting broadcasts", "err", err)
+	case cb.state == CircuitBreakerClosed && exceeded:
+		cb.lggr.Criticalw("Circuit breaker opened after consecutive RPC failures, halting broadcasts of new transactions",
+			"broadcastFailures", cb.broadcastFailures, "receiptFailures", cb.receiptFailures, "err", err)
+	default:
+		return
+	}
+	cb.openedAt = cb.now()
+	if err != nil {
+		cb.lastErr = err.Error()
+	}
+	cb.setState(CircuitBreakerOpen)
+}
+
+func (cb *CircuitBreaker) setState(state CircuitBreakerState) {
+	cb.state = state
+	promCircuitBreakerState.WithLabelValues(cb.chainID).Set(state.gaugeValue())
+}
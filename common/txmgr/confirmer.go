@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"slices"
 	"sort"
 	"strconv"
 	"sync"
@@ -129,6 +130,7 @@ type Confirmer[
 	txmgrtypes.TxAttemptBuilder[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
 	stuckTxDetector txmgrtypes.StuckTxDetector[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
 	resumeCallback  ResumeCallback
+	circuitBreaker  *CircuitBreaker
 	chainConfig     txmgrtypes.ConfirmerChainConfig
 	feeConfig       txmgrtypes.ConfirmerFeeConfig
 	txConfig        txmgrtypes.ConfirmerTransactionsConfig
@@ -147,6 +149,12 @@ type Confirmer[
 	isReceiptNil                    func(R) bool
 
 	headTracker confirmerHeadTracker[HEAD, BLOCK_HASH]
+
+	bumpStrategiesMu sync.RWMutex
+	bumpStrategies   map[string]txmgrtypes.BumpStrategy[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
+
+	// profileBumpThresholds is the BumpThreshold of each gas estimator profile, keyed by name
+	profileBumpThresholds map[string]uint64
 }
 
 func NewConfirmer[
@@ -189,6 +197,7 @@ func NewConfirmer[
 		isReceiptNil:     isReceiptNil,
 		stuckTxDetector:  stuckTxDetector,
 		headTracker:      headTracker,
+		bumpStrategies:   make(map[string]txmgrtypes.BumpStrategy[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]),
 	}
 }
 
@@ -251,6 +260,72 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Set
 	ec.resumeCallback = callback
 }
 
+// SetCircuitBreaker sets the circuit breaker which records the failures to fetch receipts.
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) SetCircuitBreaker(cb *CircuitBreaker) {
+	ec.circuitBreaker = cb
+}
+
+// SetProfileBumpThresholds sets the BumpThreshold of each gas estimator profile, keyed by name. Txs referencing a
+// profile are bumped after its threshold instead of the chain's. It must be called before Start.
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) SetProfileBumpThresholds(thresholds map[string]uint64) {
+	ec.profileBumpThresholds = thresholds
+}
+
+// lowestBumpThreshold returns the lowest enabled BumpThreshold of the chain and its gas estimator profiles, which is
+// used to find the txs which may require a bump, or 0 if bumping is disabled for all of them.
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) lowestBumpThreshold() int64 {
+	lowest := ec.feeConfig.BumpThreshold()
+	for _, threshold := range ec.profileBumpThresholds {
+		if threshold > 0 && (lowest == 0 || threshold < lowest) {
+			lowest = threshold
+		}
+	}
+	return int64(lowest)
+}
+
+// dueForBump returns whether the attempts of etx were all broadcast at least the BumpThreshold of its gas estimator
+// profile ago, or of the chain if it does not reference a profile.
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) dueForBump(etx *txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], blockNum int64) bool {
+	threshold := ec.feeConfig.BumpThreshold()
+	if meta, err := etx.GetMeta(); err == nil && meta != nil && meta.GasEstimatorProfile != nil {
+		if t, ok := ec.profileBumpThresholds[*meta.GasEstimatorProfile]; ok {
+			threshold = t
+		}
+	}
+	if threshold == 0 {
+		return false
+	}
+	for _, attempt := range etx.TxAttempts {
+		if attempt.BroadcastBeforeBlockNum != nil && *attempt.BroadcastBeforeBlockNum > blockNum-int64(threshold) {
+			return false
+		}
+	}
+	return true
+}
+
+// SetBumpStrategy registers the strategy under the given name, replacing any strategy previously registered with it.
+// A nil strategy removes the registration.
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) SetBumpStrategy(name string, strategy txmgrtypes.BumpStrategy[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) {
+	ec.bumpStrategiesMu.Lock()
+	defer ec.bumpStrategiesMu.Unlock()
+	if strategy == nil {
+		delete(ec.bumpStrategies, name)
+		return
+	}
+	ec.bumpStrategies[name] = strategy
+}
+
+// bumpStrategyFor returns the name of the BumpStrategy requested by the tx and the strategy registered under it, if any.
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) bumpStrategyFor(etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) (string, txmgrtypes.BumpStrategy[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) {
+	meta, err := etx.GetMeta()
+	if err != nil || meta == nil || meta.BumpStrategy == nil {
+		return "", nil
+	}
+	ec.bumpStrategiesMu.RLock()
+	defer ec.bumpStrategiesMu.RUnlock()
+	return *meta.BumpStrategy, ec.bumpStrategies[*meta.BumpStrategy]
+}
+
 func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Name() string {
 	return ec.lggr.Name()
 }
@@ -662,8 +737,10 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) bat
 
 	txReceipts, txErrs, err := ec.client.BatchGetReceipts(ctx, attempts)
 	if err != nil {
+		ec.circuitBreaker.RecordReceiptFailure(err)
 		return nil, err
 	}
+	ec.circuitBreaker.RecordReceiptSuccess()
 
 	for i := range txReceipts {
 		attempt := attempts[i]
@@ -775,7 +852,7 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) reb
 		return fmt.Errorf("handleAnyInProgressAttempts failed: %w", err)
 	}
 
-	threshold := int64(ec.feeConfig.BumpThreshold())
+	threshold := ec.lowestBumpThreshold()
 	bumpDepth := int64(ec.feeConfig.BumpTxDepth())
 	maxInFlightTransactions := ec.txConfig.MaxInFlight()
 	etxs, err := ec.FindTxsRequiringRebroadcast(ctx, ec.lggr, address, blockHeight, threshold, bumpDepth, maxInFlightTransactions, ec.chainID)
@@ -846,6 +923,12 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Fin
 	} else if err != nil {
 		return nil, err
 	}
+	if len(ec.profileBumpThresholds) > 0 {
+		// the txs were found with the lowest threshold, each is only bumped after the threshold of its profile
+		etxBumps = slices.DeleteFunc(etxBumps, func(etx *txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) bool {
+			return !ec.dueForBump(etx, blockNum)
+		})
+	}
 
 	if len(etxBumps) > 0 {
 		// txes are ordered by sequence asc so the first will always be the oldest
@@ -937,6 +1020,10 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) bum
 	var bumpedFeeLimit uint64
 	bumpedAttempt, bumpedFee, bumpedFeeLimit, _, err = ec.NewBumpTxAttempt(ctx, etx, previousAttempt, previousAttempts, ec.lggr)
 
+	if err == nil {
+		bumpedAttempt, bumpedFee, err = ec.applyBumpStrategy(ctx, etx, previousAttempt, bumpedAttempt, bumpedFee, bumpedFeeLimit)
+	}
+
 	// if no error, return attempt
 	// if err, continue below
 	if err == nil {
@@ -952,6 +1039,40 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) bum
 	return bumpedAttempt, fmt.Errorf("error bumping gas: %w", err)
 }
 
+// applyBumpStrategy lets the BumpStrategy registered for the tx override the fee of the bumped attempt. The fee of the
+// strategy is raised to the minimum bump over the previous attempt, and rejected above the max configured fee.
+// Errors wrap commonfee.ErrBump, so that the previous attempt is rebroadcast instead.
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) applyBumpStrategy(
+	ctx context.Context,
+	etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE],
+	previousAttempt, bumpedAttempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE],
+	bumpedFee FEE,
+	bumpedFeeLimit uint64,
+) (txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], FEE, error) {
+	name, strategy := ec.bumpStrategyFor(etx)
+	if strategy == nil {
+		if name != "" {
+			ec.lggr.Warnw("Bump strategy is not registered, falling back to the default bump", "txID", etx.ID, "bumpStrategy", name)
+		}
+		return bumpedAttempt, bumpedFee, nil
+	}
+	// purge attempts replace the payload of the tx, which only the default bump takes care of
+	if previousAttempt.IsPurgeAttempt {
+		return bumpedAttempt, bumpedFee, nil
+	}
+
+	fee, err := strategy.BumpFee(ctx, etx, previousAttempt, bumpedFee)
+	if err != nil {
+		return bumpedAttempt, bumpedFee, fmt.Errorf("bump strategy %q: %w: %w", name, commonfee.ErrBump, err)
+	}
+
+	attempt, fee, _, err := ec.NewCustomBumpTxAttempt(ctx, etx, previousAttempt, fee, bumpedFeeLimit, ec.lggr)
+	if err != nil {
+		return bumpedAttempt, bumpedFee, fmt.Errorf("bump strategy %q: failed to build attempt: %w: %w", name, commonfee.ErrBump, err)
+	}
+	return attempt, fee, nil
+}
+
 func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) handleInProgressAttempt(ctx context.Context, lggr logger.SugaredLogger, etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], blockHeight int64) error {
 	if attempt.State != txmgrtypes.TxAttemptInProgress {
 		return fmt.Errorf("invariant violation: expected tx_attempt %v to be in_progress, it was %s", attempt.ID, attempt.State)
@@ -78,6 +78,53 @@ func (_c *TxManager_Close_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, F
 	return _c
 }
 
+// CircuitBreaker provides a mock function with given fields:
+func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) CircuitBreaker() *txmgr.CircuitBreaker {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CircuitBreaker")
+	}
+
+	var r0 *txmgr.CircuitBreaker
+	if rf, ok := ret.Get(0).(func() *txmgr.CircuitBreaker); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*txmgr.CircuitBreaker)
+		}
+	}
+
+	return r0
+}
+
+// TxManager_CircuitBreaker_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CircuitBreaker'
+type TxManager_CircuitBreaker_Call[CHAIN_ID types.ID, HEAD types.Head[BLOCK_HASH], ADDR types.Hashable, TX_HASH types.Hashable, BLOCK_HASH types.Hashable, SEQ types.Sequence, FEE feetypes.Fee] struct {
+	*mock.Call
+}
+
+// CircuitBreaker is a helper method to define mock.On call
+func (_e *TxManager_Expecter[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) CircuitBreaker() *TxManager_CircuitBreaker_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	return &TxManager_CircuitBreaker_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]{Call: _e.mock.On("CircuitBreaker")}
+}
+
+func (_c *TxManager_CircuitBreaker_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Run(run func()) *TxManager_CircuitBreaker_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TxManager_CircuitBreaker_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Return(_a0 *txmgr.CircuitBreaker) *TxManager_CircuitBreaker_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TxManager_CircuitBreaker_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) RunAndReturn(run func() *txmgr.CircuitBreaker) *TxManager_CircuitBreaker_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CountTransactionsByState provides a mock function with given fields: ctx, state
 func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) CountTransactionsByState(ctx context.Context, state txmgrtypes.TxState) (uint32, error) {
 	ret := _m.Called(ctx, state)
@@ -892,6 +939,73 @@ func (_c *TxManager_Ready_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, F
 	return _c
 }
 
+// RegisterBumpStrategy provides a mock function with given fields: name, strategy
+func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) RegisterBumpStrategy(name string, strategy txmgrtypes.BumpStrategy[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) {
+	_m.Called(name, strategy)
+}
+
+// TxManager_RegisterBumpStrategy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterBumpStrategy'
+type TxManager_RegisterBumpStrategy_Call[CHAIN_ID types.ID, HEAD types.Head[BLOCK_HASH], ADDR types.Hashable, TX_HASH types.Hashable, BLOCK_HASH types.Hashable, SEQ types.Sequence, FEE feetypes.Fee] struct {
+	*mock.Call
+}
+
+// RegisterBumpStrategy is a helper method to define mock.On call
+//   - name string
+//   - strategy txmgrtypes.BumpStrategy[CHAIN_ID,ADDR,TX_HASH,BLOCK_HASH,SEQ,FEE]
+func (_e *TxManager_Expecter[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) RegisterBumpStrategy(name interface{}, strategy interface{}) *TxManager_RegisterBumpStrategy_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	return &TxManager_RegisterBumpStrategy_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]{Call: _e.mock.On("RegisterBumpStrategy", name, strategy)}
+}
+
+func (_c *TxManager_RegisterBumpStrategy_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Run(run func(name string, strategy txmgrtypes.BumpStrategy[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE])) *TxManager_RegisterBumpStrategy_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(txmgrtypes.BumpStrategy[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]))
+	})
+	return _c
+}
+
+func (_c *TxManager_RegisterBumpStrategy_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Return() *TxManager_RegisterBumpStrategy_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *TxManager_RegisterBumpStrategy_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) RunAndReturn(run func(string, txmgrtypes.BumpStrategy[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE])) *TxManager_RegisterBumpStrategy_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RegisterFinalizedCallback provides a mock function with given fields: fn
+func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) RegisterFinalizedCallback(fn txmgrtypes.FinalizedCallback) {
+	_m.Called(fn)
+}
+
+// TxManager_RegisterFinalizedCallback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterFinalizedCallback'
+type TxManager_RegisterFinalizedCallback_Call[CHAIN_ID types.ID, HEAD types.Head[BLOCK_HASH], ADDR types.Hashable, TX_HASH types.Hashable, BLOCK_HASH types.Hashable, SEQ types.Sequence, FEE feetypes.Fee] struct {
+	*mock.Call
+}
+
+// RegisterFinalizedCallback is a helper method to define mock.On call
+//   - fn txmgrtypes.FinalizedCallback
+func (_e *TxManager_Expecter[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) RegisterFinalizedCallback(fn interface{}) *TxManager_RegisterFinalizedCallback_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	return &TxManager_RegisterFinalizedCallback_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]{Call: _e.mock.On("RegisterFinalizedCallback", fn)}
+}
+
+func (_c *TxManager_RegisterFinalizedCallback_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Run(run func(fn txmgrtypes.FinalizedCallback)) *TxManager_RegisterFinalizedCallback_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(txmgrtypes.FinalizedCallback))
+	})
+	return _c
+}
+
+func (_c *TxManager_RegisterFinalizedCallback_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Return() *TxManager_RegisterFinalizedCallback_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *TxManager_RegisterFinalizedCallback_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) RunAndReturn(run func(txmgrtypes.FinalizedCallback)) *TxManager_RegisterFinalizedCallback_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RegisterResumeCallback provides a mock function with given fields: fn
 func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) RegisterResumeCallback(fn txmgr.ResumeCallback) {
 	_m.Called(fn)
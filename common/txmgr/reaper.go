@@ -11,11 +11,18 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/common/types"
 )
 
+// MaintenanceGate defers database maintenance to maintenance windows.
+type MaintenanceGate interface {
+	// Open returns true if maintenance may run now.
+	Open() bool
+}
+
 // Reaper handles periodic database cleanup for Txm
 type Reaper[CHAIN_ID types.ID] struct {
 	store          txmgrtypes.TxHistoryReaper[CHAIN_ID]
 	txConfig       txmgrtypes.ReaperTransactionsConfig
 	chainID        CHAIN_ID
+	gate           MaintenanceGate
 	log            logger.Logger
 	latestBlockNum atomic.Int64
 	trigger        chan struct{}
@@ -23,12 +30,13 @@ type Reaper[CHAIN_ID types.ID] struct {
 	chDone         chan struct{}
 }
 
-// NewReaper instantiates a new reaper object
-func NewReaper[CHAIN_ID types.ID](lggr logger.Logger, store txmgrtypes.TxHistoryReaper[CHAIN_ID], txConfig txmgrtypes.ReaperTransactionsConfig, chainID CHAIN_ID) *Reaper[CHAIN_ID] {
+// NewReaper instantiates a new reaper object. If gate is not nil, reaping is deferred while it is not open.
+func NewReaper[CHAIN_ID types.ID](lggr logger.Logger, store txmgrtypes.TxHistoryReaper[CHAIN_ID], txConfig txmgrtypes.ReaperTransactionsConfig, chainID CHAIN_ID, gate MaintenanceGate) *Reaper[CHAIN_ID] {
 	r := &Reaper[CHAIN_ID]{
 		store,
 		txConfig,
 		chainID,
+		gate,
 		logger.Named(lggr, "Reaper"),
 		atomic.Int64{},
 		make(chan struct{}, 1),
@@ -56,28 +64,38 @@ func (r *Reaper[CHAIN_ID]) runLoop() {
 	defer close(r.chDone)
 	ticker := services.NewTicker(r.txConfig.ReaperInterval())
 	defer ticker.Stop()
+	var retry <-chan time.Time
 	for {
 		select {
 		case <-r.chStop:
 			return
 		case <-ticker.C:
-			r.work()
+			retry = r.work()
 		case <-r.trigger:
-			r.work()
+			retry = r.work()
 			ticker.Reset()
+		case <-retry:
+			retry = r.work()
 		}
 	}
 }
 
-func (r *Reaper[CHAIN_ID]) work() {
+// work reaps the old txes. If reaping is deferred to a maintenance window, it returns a channel firing when it should
+// be attempted again, sooner than the next tick so that it runs early in the window.
+func (r *Reaper[CHAIN_ID]) work() (retry <-chan time.Time) {
 	latestBlockNum := r.latestBlockNum.Load()
 	if latestBlockNum < 0 {
-		return
+		return nil
+	}
+	if r.gate != nil && !r.gate.Open() {
+		r.log.Debug("Deferring ReapTxes until the next maintenance window")
+		return time.After(r.txConfig.ReaperInterval() / 10)
 	}
 	err := r.ReapTxes(latestBlockNum)
 	if err != nil {
 		r.log.Error("unable to reap old txes: ", err)
 	}
+	return nil
 }
 
 // SetLatestBlockNum should be called on every new highest block number
@@ -49,6 +49,13 @@ type TxManager[
 	GetForwarderForEOA(ctx context.Context, eoa ADDR) (forwarder ADDR, err error)
 	GetForwarderForEOAOCR2Feeds(ctx context.Context, eoa, ocr2AggregatorID ADDR) (forwarder ADDR, err error)
 	RegisterResumeCallback(fn ResumeCallback)
+	// RegisterBumpStrategy registers a custom fee bumping strategy for transactions with TxMeta.BumpStrategy set to name.
+	RegisterBumpStrategy(name string, strategy txmgrtypes.BumpStrategy[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE])
+	// RegisterFinalizedCallback registers fn to be called with the transactions marked as finalized. It must be called
+	// before Start.
+	RegisterFinalizedCallback(fn txmgrtypes.FinalizedCallback)
+	// CircuitBreaker returns the breaker which halts broadcasts during RPC incidents, or nil if it is disabled.
+	CircuitBreaker() *CircuitBreaker
 	SendNativeToken(ctx context.Context, chainID CHAIN_ID, from, to ADDR, value big.Int, gasLimit uint64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
 	Reset(addr ADDR, abandon bool) error
 	// Find transactions by a field in the TxMeta blob and transaction states
@@ -98,6 +105,7 @@ type Txm[
 	trigger        chan ADDR
 	reset          chan reset
 	resumeCallback ResumeCallback
+	circuitBreaker *CircuitBreaker
 
 	chStop   services.StopChan
 	chSubbed chan struct{}
@@ -120,6 +128,25 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) RegisterRe
 	b.confirmer.SetResumeCallback(fn)
 }
 
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) RegisterBumpStrategy(name string, strategy txmgrtypes.BumpStrategy[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) {
+	b.confirmer.SetBumpStrategy(name, strategy)
+}
+
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) RegisterFinalizedCallback(fn txmgrtypes.FinalizedCallback) {
+	b.finalizer.RegisterFinalizedCallback(fn)
+}
+
+// SetCircuitBreaker sets the circuit breaker of the Broadcaster and Confirmer. It must be called before Start.
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) SetCircuitBreaker(cb *CircuitBreaker) {
+	b.circuitBreaker = cb
+	b.broadcaster.SetCircuitBreaker(cb)
+	b.confirmer.SetCircuitBreaker(cb)
+}
+
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) CircuitBreaker() *CircuitBreaker {
+	return b.circuitBreaker
+}
+
 // NewTxm creates a new Txm with the given configuration.
 func NewTxm[
 	CHAIN_ID types.ID,
@@ -146,6 +173,7 @@ func NewTxm[
 	tracker *Tracker[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE],
 	finalizer txmgrtypes.Finalizer[BLOCK_HASH, HEAD],
 	newErrorClassifierFunc NewErrorClassifier,
+	reaperGate MaintenanceGate,
 ) *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
 	b := Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]{
 		logger:             logger.Sugared(lggr),
@@ -174,7 +202,7 @@ func NewTxm[
 		b.logger.Info("Resender: Disabled")
 	}
 	if txCfg.ReaperThreshold() > 0 && txCfg.ReaperInterval() > 0 {
-		b.reaper = NewReaper[CHAIN_ID](lggr, b.txStore, txCfg, chainId)
+		b.reaper = NewReaper[CHAIN_ID](lggr, b.txStore, txCfg, chainId, reaperGate)
 	} else {
 		b.logger.Info("TxReaper: Disabled")
 	}
@@ -551,6 +579,14 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) CreateTran
 		return tx, fmt.Errorf("Txm#CreateTransaction: %w", err)
 	}
 
+	if limit := b.txConfig.JobSpendLimit(); limit != nil && limit.Sign() > 0 && txRequest.Meta != nil && txRequest.Meta.JobID != nil {
+		since := time.Now().Add(-b.txConfig.JobSpendWindow())
+		err = b.txStore.CheckJobSpendLimit(ctx, *txRequest.Meta.JobID, limit, since, b.chainID)
+		if err != nil {
+			return tx, fmt.Errorf("Txm#CreateTransaction: %w", err)
+		}
+	}
+
 	tx, err = b.pruneQueueAndCreateTxn(ctx, txRequest, b.chainID)
 	if err != nil {
 		return tx, err
@@ -733,6 +769,13 @@ func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Hea
 }
 func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) RegisterResumeCallback(fn ResumeCallback) {
 }
+func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) RegisterBumpStrategy(name string, strategy txmgrtypes.BumpStrategy[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) {
+}
+func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) RegisterFinalizedCallback(fn txmgrtypes.FinalizedCallback) {
+}
+func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) CircuitBreaker() *CircuitBreaker {
+	return nil
+}
 func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) FindTxesByMetaFieldAndStates(ctx context.Context, metaField string, metaValue string, states []txmgrtypes.TxState, chainID *big.Int) (txes []*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
 	return txes, errors.New(n.ErrMsg)
 }
@@ -0,0 +1,23 @@
+package types
+
+import (
+	"context"
+
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+// BumpStrategy allows products to customize the fee of replacement attempts for their transactions.
+// Strategies are registered on the TxManager under a name, and are applied to transactions which set
+// the same name in TxMeta.BumpStrategy.
+type BumpStrategy[
+	CHAIN_ID types.ID, // CHAIN_ID - chain id type
+	ADDR types.Hashable, // ADDR - chain address type
+	TX_HASH, BLOCK_HASH types.Hashable, // various chain hash types
+	SEQ types.Sequence, // SEQ - chain sequence type (nonce, utxo, etc)
+	FEE feetypes.Fee, // FEE - chain fee type
+] interface {
+	// BumpFee returns the fee to use for the replacement of previousAttempt. bumpedFee is the fee suggested by the
+	// configured fee estimator. Returning an error skips bumping the transaction until the next bump threshold is reached.
+	BumpFee(ctx context.Context, tx Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], previousAttempt TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], bumpedFee FEE) (FEE, error)
+}
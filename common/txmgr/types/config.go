@@ -1,6 +1,9 @@
 package types
 
-import "time"
+import (
+	"math/big"
+	"time"
+)
 
 type TransactionManagerChainConfig interface {
 	BroadcasterChainConfig
@@ -20,6 +23,9 @@ type TransactionManagerTransactionsConfig interface {
 
 	ForwardersEnabled() bool
 	MaxQueued() uint64
+	// JobSpendLimit is the maximum fee spend per job within JobSpendWindow. Zero disables the limit.
+	JobSpendLimit() *big.Int
+	JobSpendWindow() time.Duration
 }
 
 type BroadcasterChainConfig interface {
@@ -35,6 +41,13 @@ type BroadcasterTransactionsConfig interface {
 	MaxInFlight() uint32
 }
 
+// CircuitBreakerConfig configures the thresholds of the broadcast circuit breaker.
+type CircuitBreakerConfig interface {
+	BroadcastFailureThreshold() uint32
+	ReceiptFailureThreshold() uint32
+	ResetTimeout() time.Duration
+}
+
 type BroadcasterListenerConfig interface {
 	FallbackPollInterval() time.Duration
 }
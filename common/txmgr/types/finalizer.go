@@ -1,6 +1,8 @@
 package types
 
 import (
+	"context"
+
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	"github.com/smartcontractkit/chainlink/v2/common/types"
 )
@@ -9,4 +11,17 @@ type Finalizer[BLOCK_HASH types.Hashable, HEAD types.Head[BLOCK_HASH]] interface
 	// interfaces for running the underlying estimator
 	services.Service
 	DeliverLatestHead(head HEAD) bool
+	// RegisterFinalizedCallback registers fn to be called with the transactions marked as finalized. It must be called
+	// before Start.
+	RegisterFinalizedCallback(fn FinalizedCallback)
+}
+
+// FinalizedTx is a transaction marked as finalized by the Finalizer.
+type FinalizedTx struct {
+	ID             int64
+	IdempotencyKey *string
 }
+
+// FinalizedCallback is called by the Finalizer with the transactions it marked as finalized, once they are stored. It
+// must not block, since it is called from the loop of the Finalizer.
+type FinalizedCallback func(ctx context.Context, txs []FinalizedTx)
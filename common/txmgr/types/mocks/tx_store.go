@@ -81,6 +81,56 @@ func (_c *TxStore_Abandon_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]
 	return _c
 }
 
+// CheckJobSpendLimit provides a mock function with given fields: ctx, jobID, limit, since, chainID
+func (_m *TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) CheckJobSpendLimit(ctx context.Context, jobID int32, limit *big.Int, since time.Time, chainID CHAIN_ID) error {
+	ret := _m.Called(ctx, jobID, limit, since, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckJobSpendLimit")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, *big.Int, time.Time, CHAIN_ID) error); ok {
+		r0 = rf(ctx, jobID, limit, since, chainID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TxStore_CheckJobSpendLimit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckJobSpendLimit'
+type TxStore_CheckJobSpendLimit_Call[ADDR types.Hashable, CHAIN_ID types.ID, TX_HASH types.Hashable, BLOCK_HASH types.Hashable, R txmgrtypes.ChainReceipt[TX_HASH, BLOCK_HASH], SEQ types.Sequence, FEE feetypes.Fee] struct {
+	*mock.Call
+}
+
+// CheckJobSpendLimit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+//   - limit *big.Int
+//   - since time.Time
+//   - chainID CHAIN_ID
+func (_e *TxStore_Expecter[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) CheckJobSpendLimit(ctx interface{}, jobID interface{}, limit interface{}, since interface{}, chainID interface{}) *TxStore_CheckJobSpendLimit_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+	return &TxStore_CheckJobSpendLimit_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]{Call: _e.mock.On("CheckJobSpendLimit", ctx, jobID, limit, since, chainID)}
+}
+
+func (_c *TxStore_CheckJobSpendLimit_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Run(run func(ctx context.Context, jobID int32, limit *big.Int, since time.Time, chainID CHAIN_ID)) *TxStore_CheckJobSpendLimit_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32), args[2].(*big.Int), args[3].(time.Time), args[4].(CHAIN_ID))
+	})
+	return _c
+}
+
+func (_c *TxStore_CheckJobSpendLimit_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Return(err error) *TxStore_CheckJobSpendLimit_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *TxStore_CheckJobSpendLimit_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) RunAndReturn(run func(context.Context, int32, *big.Int, time.Time, CHAIN_ID) error) *TxStore_CheckJobSpendLimit_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CheckTxQueueCapacity provides a mock function with given fields: ctx, fromAddress, maxQueuedTransactions, chainID
 func (_m *TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) CheckTxQueueCapacity(ctx context.Context, fromAddress ADDR, maxQueuedTransactions uint64, chainID CHAIN_ID) error {
 	ret := _m.Called(ctx, fromAddress, maxQueuedTransactions, chainID)
@@ -1462,6 +1512,67 @@ func (_c *TxStore_FindTxsRequiringResubmissionDueToInsufficientFunds_Call[ADDR,
 	return _c
 }
 
+// FindUnstartedTransactionsFromAddress provides a mock function with given fields: ctx, fromAddress, chainID, limit
+func (_m *TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) FindUnstartedTransactionsFromAddress(ctx context.Context, fromAddress ADDR, chainID CHAIN_ID, limit int) ([]*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error) {
+	ret := _m.Called(ctx, fromAddress, chainID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindUnstartedTransactionsFromAddress")
+	}
+
+	var r0 []*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ADDR, CHAIN_ID, int) ([]*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error)); ok {
+		return rf(ctx, fromAddress, chainID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ADDR, CHAIN_ID, int) []*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]); ok {
+		r0 = rf(ctx, fromAddress, chainID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ADDR, CHAIN_ID, int) error); ok {
+		r1 = rf(ctx, fromAddress, chainID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TxStore_FindUnstartedTransactionsFromAddress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUnstartedTransactionsFromAddress'
+type TxStore_FindUnstartedTransactionsFromAddress_Call[ADDR types.Hashable, CHAIN_ID types.ID, TX_HASH types.Hashable, BLOCK_HASH types.Hashable, R txmgrtypes.ChainReceipt[TX_HASH, BLOCK_HASH], SEQ types.Sequence, FEE feetypes.Fee] struct {
+	*mock.Call
+}
+
+// FindUnstartedTransactionsFromAddress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromAddress ADDR
+//   - chainID CHAIN_ID
+//   - limit int
+func (_e *TxStore_Expecter[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) FindUnstartedTransactionsFromAddress(ctx interface{}, fromAddress interface{}, chainID interface{}, limit interface{}) *TxStore_FindUnstartedTransactionsFromAddress_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+	return &TxStore_FindUnstartedTransactionsFromAddress_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]{Call: _e.mock.On("FindUnstartedTransactionsFromAddress", ctx, fromAddress, chainID, limit)}
+}
+
+func (_c *TxStore_FindUnstartedTransactionsFromAddress_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Run(run func(ctx context.Context, fromAddress ADDR, chainID CHAIN_ID, limit int)) *TxStore_FindUnstartedTransactionsFromAddress_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(ADDR), args[2].(CHAIN_ID), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *TxStore_FindUnstartedTransactionsFromAddress_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Return(_a0 []*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], _a1 error) *TxStore_FindUnstartedTransactionsFromAddress_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TxStore_FindUnstartedTransactionsFromAddress_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) RunAndReturn(run func(context.Context, ADDR, CHAIN_ID, int) ([]*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error)) *TxStore_FindUnstartedTransactionsFromAddress_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAbandonedTransactionsByBatch provides a mock function with given fields: ctx, chainID, enabledAddrs, offset, limit
 func (_m *TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) GetAbandonedTransactionsByBatch(ctx context.Context, chainID CHAIN_ID, enabledAddrs []ADDR, offset uint, limit uint) ([]*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error) {
 	ret := _m.Called(ctx, chainID, enabledAddrs, offset, limit)
@@ -93,6 +93,18 @@ type TxRequest[ADDR types.Hashable, TX_HASH types.Hashable] struct {
 
 	// Mark tx requiring callback
 	SignalCallback bool
+
+	// AllowBundling marks the tx as eligible to be aggregated with other txs from the same FromAddress
+	// into a single multicall tx. Only set this if the call does not depend on msg.sender or msg.value.
+	AllowBundling bool
+
+	// BundledIdempotencyKeys are the idempotency keys of the requests aggregated into this tx by the bundler.
+	// They are persisted along with the tx, and each of them can only ever be mapped to a single tx.
+	BundledIdempotencyKeys []string
+
+	// BlobSidecar is the encoded sidecar of the blobs carried by the tx, on chains supporting blob txs (e.g. EIP-4844).
+	// Txs carrying blobs are priced and bumped with the blob fee of the chain.
+	BlobSidecar []byte
 }
 
 // TransmitCheckerSpec defines the check that should be performed before a transaction is submitted
@@ -159,6 +171,12 @@ type TxMeta[ADDR types.Hashable, TX_HASH types.Hashable] struct {
 	MessageIDs []string `json:"MessageIDs,omitempty"`
 	// SeqNumbers is used by CCIP for tx to committed sequence numbers correlation in logs
 	SeqNumbers []uint64 `json:"SeqNumbers,omitempty"`
+
+	// BumpStrategy is the name of the registered BumpStrategy used to bump fees of this tx
+	BumpStrategy *string `json:"BumpStrategy,omitempty"`
+
+	// GasEstimatorProfile is the name of the chain's gas estimator profile used to price this tx
+	GasEstimatorProfile *string `json:"GasEstimatorProfile,omitempty"`
 }
 
 type TxAttempt[
@@ -232,6 +250,9 @@ type Tx[
 	SignalCallback bool
 	// Marks tx callback as signaled
 	CallbackCompleted bool
+
+	// BlobSidecar is the encoded sidecar of the blobs carried by the tx, if any
+	BlobSidecar []byte
 }
 
 func (e *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) GetError() error {
@@ -41,6 +41,8 @@ type TxStore[
 
 	// additional methods for tx store management
 	CheckTxQueueCapacity(ctx context.Context, fromAddress ADDR, maxQueuedTransactions uint64, chainID CHAIN_ID) (err error)
+	// CheckJobSpendLimit returns an error if the fees spent on behalf of the job since the given time reached the limit
+	CheckJobSpendLimit(ctx context.Context, jobID int32, limit *big.Int, since time.Time, chainID CHAIN_ID) (err error)
 	Close()
 	Abandon(ctx context.Context, id CHAIN_ID, addr ADDR) error
 	// Find transactions by a field in the TxMeta blob and transaction states
@@ -79,6 +81,8 @@ type TransactionStore[
 	// Search for Tx using the fromAddress and sequence
 	FindTxWithSequence(ctx context.Context, fromAddress ADDR, seq SEQ) (etx *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
 	FindNextUnstartedTransactionFromAddress(ctx context.Context, fromAddress ADDR, chainID CHAIN_ID) (*Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error)
+	// FindUnstartedTransactionsFromAddress returns up to limit unstarted transactions of the address, in the order they are broadcast
+	FindUnstartedTransactionsFromAddress(ctx context.Context, fromAddress ADDR, chainID CHAIN_ID, limit int) ([]*Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error)
 
 	// FindTransactionsConfirmedInBlockRange retrieves tx with attempts and partial receipt values for optimization purpose
 	FindTransactionsConfirmedInBlockRange(ctx context.Context, highBlockNumber, lowBlockNumber int64, chainID CHAIN_ID) (etxs []*Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
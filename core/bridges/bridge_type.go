@@ -1,18 +1,23 @@
 package bridges
 
 import (
+	"crypto/ed25519"
 	"crypto/subtle"
 	"database/sql/driver"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"net/http"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/utils/crypto"
 )
 
 // BridgeTypeRequest is the incoming record used to create a BridgeType
@@ -21,6 +26,9 @@ type BridgeTypeRequest struct {
 	URL                    models.WebURL `json:"url"`
 	Confirmations          uint32        `json:"confirmations"`
 	MinimumContractPayment *assets.Link  `json:"minimumContractPayment"`
+	// ResponsePublicKey is the ed25519 public key the bridge signs its responses with. Responses are not verified if
+	// it is not set. Updates keep the key of the bridge if it is not set, and remove it if it is empty.
+	ResponsePublicKey crypto.PublicKey `json:"responsePublicKey,omitempty"`
 }
 
 // GetID returns the ID of this structure for jsonapi serialization.
@@ -48,6 +56,7 @@ type BridgeTypeAuthentication struct {
 	IncomingToken          string
 	OutgoingToken          string
 	MinimumContractPayment *assets.Link
+	ResponsePublicKey      crypto.PublicKey
 }
 
 // BridgeType is used for external adapters and has fields for
@@ -60,6 +69,7 @@ type BridgeType struct {
 	Salt                   string
 	OutgoingToken          string
 	MinimumContractPayment *assets.Link
+	ResponsePublicKey      crypto.PublicKey
 	CreatedAt              time.Time
 	UpdatedAt              time.Time
 }
@@ -78,21 +88,23 @@ func NewBridgeType(btr *BridgeTypeRequest) (*BridgeTypeAuthentication,
 	}
 
 	return &BridgeTypeAuthentication{
-			Name:                   btr.Name,
-			URL:                    btr.URL,
-			Confirmations:          btr.Confirmations,
-			IncomingToken:          incomingToken,
-			OutgoingToken:          outgoingToken,
-			MinimumContractPayment: btr.MinimumContractPayment,
-		}, &BridgeType{
-			Name:                   btr.Name,
-			URL:                    btr.URL,
-			Confirmations:          btr.Confirmations,
-			IncomingTokenHash:      hash,
-			Salt:                   salt,
-			OutgoingToken:          outgoingToken,
-			MinimumContractPayment: btr.MinimumContractPayment,
-		}, nil
+		Name:                   btr.Name,
+		URL:                    btr.URL,
+		Confirmations:          btr.Confirmations,
+		IncomingToken:          incomingToken,
+		OutgoingToken:          outgoingToken,
+		MinimumContractPayment: btr.MinimumContractPayment,
+		ResponsePublicKey:      btr.ResponsePublicKey,
+	}, &BridgeType{
+		Name:                   btr.Name,
+		URL:                    btr.URL,
+		Confirmations:          btr.Confirmations,
+		IncomingTokenHash:      hash,
+		Salt:                   salt,
+		OutgoingToken:          outgoingToken,
+		MinimumContractPayment: btr.MinimumContractPayment,
+		ResponsePublicKey:      btr.ResponsePublicKey,
+	}, nil
 }
 
 // AuthenticateBridgeType returns true if the passed token matches its
@@ -105,6 +117,72 @@ func AuthenticateBridgeType(bt *BridgeType, token string) (bool, error) {
 	return subtle.ConstantTimeCompare([]byte(hash), []byte(bt.IncomingTokenHash)) == 1, nil
 }
 
+const (
+	// ResponseSignatureHeader is the header of bridge responses carrying the hex encoded ed25519 signature of
+	// ResponseSignaturePayload.
+	ResponseSignatureHeader = "X-Chainlink-Signature"
+	// ResponseTimestampHeader is the header of bridge responses carrying the unix time in seconds they were signed at.
+	ResponseTimestampHeader = "X-Chainlink-Timestamp"
+	// RequestIDHeader is the header of the requests to bridges carrying the ID of the task run, which the responses are
+	// signed for, and which the async responses resume.
+	RequestIDHeader = "X-Chainlink-Request-ID"
+	// RequestNonceHeader is the header of the requests to bridges carrying a random nonce, which the response is signed
+	// for, so that it can't be replayed for another request.
+	RequestNonceHeader = "X-Chainlink-Nonce"
+	// ResponseSignatureMaxAge is the maximum difference between the timestamp of a signed response and the time of the
+	// node.
+	ResponseSignatureMaxAge = 5 * time.Minute
+)
+
+// ValidateResponsePublicKey checks that the response public key is either unset or an ed25519 public key.
+func ValidateResponsePublicKey(key crypto.PublicKey) error {
+	if len(key) != 0 && len(key) != ed25519.PublicKeySize {
+		return fmt.Errorf("response public key must be %d bytes, got %d", ed25519.PublicKeySize, len(key))
+	}
+	return nil
+}
+
+// ResponseSignaturePayload returns the payload bridges sign their responses for: the request ID, nonce and timestamp
+// of the response, and its body, separated by newlines. The async results sent to /v2/resume have no nonce.
+func ResponseSignaturePayload(requestID, nonce, timestamp string, body []byte) []byte {
+	payload := make([]byte, 0, len(requestID)+len(nonce)+len(timestamp)+len(body)+3)
+	payload = append(payload, requestID...)
+	payload = append(payload, '\n')
+	payload = append(payload, nonce...)
+	payload = append(payload, '\n')
+	payload = append(payload, timestamp...)
+	payload = append(payload, '\n')
+	return append(payload, body...)
+}
+
+// VerifyResponse checks the signature of a bridge response to the request with the ID and nonce, if the bridge has a
+// response public key.
+func (bt *BridgeType) VerifyResponse(requestID, nonce string, body []byte, header http.Header) error {
+	if len(bt.ResponsePublicKey) == 0 {
+		return nil
+	}
+	sigHex := header.Get(ResponseSignatureHeader)
+	if sigHex == "" {
+		return fmt.Errorf("bridge %s response is missing the %s header", bt.Name, ResponseSignatureHeader)
+	}
+	sig, err := hex.DecodeString(strings.TrimPrefix(sigHex, "0x"))
+	if err != nil {
+		return fmt.Errorf("bridge %s response signature is not hex: %w", bt.Name, err)
+	}
+	timestamp := header.Get(ResponseTimestampHeader)
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("bridge %s response has an invalid %s header: %w", bt.Name, ResponseTimestampHeader, err)
+	}
+	if age := time.Since(time.Unix(unix, 0)); age > ResponseSignatureMaxAge || age < -ResponseSignatureMaxAge {
+		return fmt.Errorf("bridge %s response was signed %s ago, more than %s", bt.Name, age, ResponseSignatureMaxAge)
+	}
+	if !ed25519.Verify(ed25519.PublicKey(bt.ResponsePublicKey), ResponseSignaturePayload(requestID, nonce, timestamp, body), sig) {
+		return fmt.Errorf("bridge %s response signature is invalid", bt.Name)
+	}
+	return nil
+}
+
 func incomingTokenHash(token, salt string) (string, error) {
 	input := fmt.Sprintf("%s-%s", token, salt)
 	hash, err := utils.Sha256(input)
@@ -1,13 +1,18 @@
 package bridges_test
 
 import (
+	"crypto/ed25519"
+	cryptorand "crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"math/big"
 	"math/rand"
+	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common/math"
 
@@ -15,6 +20,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+	"github.com/smartcontractkit/chainlink/v2/core/utils/crypto"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -64,6 +70,43 @@ func TestBridgeType_Authenticate(t *testing.T) {
 	}
 }
 
+func TestBridgeType_VerifyResponse(t *testing.T) {
+	t.Parallel()
+
+	pub, priv, err := ed25519.GenerateKey(cryptorand.Reader)
+	require.NoError(t, err)
+	body := []byte(`{"data":{"result":42}}`)
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	signed := func(sig []byte, timestamp string) http.Header {
+		h := http.Header{}
+		h.Set(bridges.ResponseSignatureHeader, hex.EncodeToString(sig))
+		h.Set(bridges.ResponseTimestampHeader, timestamp)
+		return h
+	}
+	sign := func(requestID, nonce, timestamp string, body []byte) []byte {
+		return ed25519.Sign(priv, bridges.ResponseSignaturePayload(requestID, nonce, timestamp, body))
+	}
+
+	unsigned := bridges.BridgeType{Name: "unsigned"}
+	require.NoError(t, unsigned.VerifyResponse("req", "nonce", body, http.Header{}))
+
+	bt := bridges.BridgeType{Name: "signed", ResponsePublicKey: crypto.PublicKey(pub)}
+	require.NoError(t, bt.VerifyResponse("req", "nonce", body, signed(sign("req", "nonce", now, body), now)))
+	require.ErrorContains(t, bt.VerifyResponse("req", "nonce", body, http.Header{}), "missing")
+	require.ErrorContains(t, bt.VerifyResponse("req", "nonce", []byte(`{"data":{"result":43}}`), signed(sign("req", "nonce", now, body), now)), "invalid")
+	// responses to other requests, or with another nonce, can't be replayed
+	require.ErrorContains(t, bt.VerifyResponse("other", "nonce", body, signed(sign("req", "nonce", now, body), now)), "invalid")
+	require.ErrorContains(t, bt.VerifyResponse("req", "other", body, signed(sign("req", "nonce", now, body), now)), "invalid")
+	// nor stale ones
+	stale := strconv.FormatInt(time.Now().Add(-bridges.ResponseSignatureMaxAge-time.Minute).Unix(), 10)
+	require.ErrorContains(t, bt.VerifyResponse("req", "nonce", body, signed(sign("req", "nonce", stale, body), stale)), "signed")
+	require.ErrorContains(t, bt.VerifyResponse("req", "nonce", body, signed(sign("req", "nonce", now, body), "")), "invalid X-Chainlink-Timestamp")
+
+	require.NoError(t, bridges.ValidateResponsePublicKey(nil))
+	require.NoError(t, bridges.ValidateResponsePublicKey(crypto.PublicKey(pub)))
+	require.Error(t, bridges.ValidateResponsePublicKey(crypto.PublicKey(pub[1:])))
+}
+
 func BenchmarkParseBridgeName(b *testing.B) {
 	const valid = `abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789-_`
 	for _, l := range []int{1, 10, 20, 50, 100, 1000, 10000} {
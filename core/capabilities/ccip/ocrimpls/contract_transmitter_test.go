@@ -441,6 +441,7 @@ func makeTestEvmTxm(
 		evmConfig,
 		evmConfig.HeadTrackerConfig,
 		broadcaster,
+		headtracker.NewReorgBus(logger.NullLogger),
 		headSaver,
 		mailbox.NewMonitor("contract_transmitter_test", logger.NullLogger),
 	)
@@ -463,6 +464,7 @@ func makeTestEvmTxm(
 
 	txm, err := txmgr.NewTxm(
 		db,
+		nil,
 		config,
 		config.EvmConfig.GasEstimator(),
 		config.EvmConfig.Transactions(),
@@ -474,7 +476,8 @@ func makeTestEvmTxm(
 		lp,
 		keyStore,
 		estimator,
-		ht)
+		ht,
+		nil)
 	require.NoError(t, err, "can't create tx manager")
 
 	_, unsub := broadcaster.Subscribe(txm)
@@ -519,6 +522,11 @@ func (t *TestHeadTrackerConfig) FinalityTagBypass() bool {
 	return false
 }
 
+// FinalityDepthFallback implements config.HeadTracker.
+func (t *TestHeadTrackerConfig) FinalityDepthFallback() bool {
+	return false
+}
+
 // HistoryDepth implements config.HeadTracker.
 func (t *TestHeadTrackerConfig) HistoryDepth() uint32 {
 	return 50
@@ -596,6 +604,10 @@ func (g *TestGasEstimatorConfig) FeeHistory() evmconfig.FeeHistory {
 	return &TestFeeHistoryConfig{}
 }
 
+func (g *TestGasEstimatorConfig) OnChainPriceMax() evmconfig.OnChainPriceMax {
+	return &TestOnChainPriceMaxConfig{}
+}
+
 func (g *TestGasEstimatorConfig) EIP1559DynamicFees() bool   { return false }
 func (g *TestGasEstimatorConfig) LimitDefault() uint64       { return 1e6 }
 func (g *TestGasEstimatorConfig) BumpPercent() uint16        { return 2 }
@@ -605,6 +617,7 @@ func (g *TestGasEstimatorConfig) FeeCapDefault() *assets.Wei { return assets.GWe
 func (g *TestGasEstimatorConfig) PriceDefault() *assets.Wei  { return assets.GWei(1) }
 func (g *TestGasEstimatorConfig) TipCapDefault() *assets.Wei { return assets.GWei(1) }
 func (g *TestGasEstimatorConfig) TipCapMin() *assets.Wei     { return assets.GWei(1) }
+func (g *TestGasEstimatorConfig) BlobPriceMax() *assets.Wei  { return assets.GWei(1) }
 func (g *TestGasEstimatorConfig) LimitMax() uint64           { return 0 }
 func (g *TestGasEstimatorConfig) LimitMultiplier() float32   { return 1 }
 func (g *TestGasEstimatorConfig) BumpTxDepth() uint32        { return 42 }
@@ -648,6 +661,12 @@ type TestFeeHistoryConfig struct {
 	evmconfig.FeeHistory
 }
 
+type TestOnChainPriceMaxConfig struct {
+	evmconfig.OnChainPriceMax
+}
+
+func (o *TestOnChainPriceMaxConfig) Enabled() bool { return false }
+
 type transactionsConfig struct {
 	evmconfig.Transactions
 	e         *TestEvmConfig
@@ -660,7 +679,17 @@ func (t *transactionsConfig) MaxQueued() uint64                    { return t.e.
 func (t *transactionsConfig) ReaperInterval() time.Duration        { return t.e.ReaperInterval }
 func (t *transactionsConfig) ReaperThreshold() time.Duration       { return t.e.ReaperThreshold }
 func (t *transactionsConfig) ResendAfterThreshold() time.Duration  { return t.e.ResendAfterThreshold }
+func (t *transactionsConfig) JobSpendLimit() *big.Int              { return nil }
+func (t *transactionsConfig) JobSpendWindow() time.Duration        { return 0 }
 func (t *transactionsConfig) AutoPurge() evmconfig.AutoPurgeConfig { return t.autoPurge }
+func (t *transactionsConfig) Bundler() evmconfig.BundlerConfig     { return &bundlerConfig{} }
+func (t *transactionsConfig) NonceCoordination() evmconfig.NonceCoordinationConfig {
+	return &nonceCoordinationConfig{}
+}
+func (t *transactionsConfig) Simulation() evmconfig.SimulationConfig { return &simulationConfig{} }
+func (t *transactionsConfig) CircuitBreaker() evmconfig.CircuitBreakerConfig {
+	return &circuitBreakerConfig{}
+}
 
 type autoPurgeConfig struct {
 	evmconfig.AutoPurgeConfig
@@ -668,6 +697,30 @@ type autoPurgeConfig struct {
 
 func (a *autoPurgeConfig) Enabled() bool { return false }
 
+type bundlerConfig struct {
+	evmconfig.BundlerConfig
+}
+
+func (b *bundlerConfig) Enabled() bool { return false }
+
+type nonceCoordinationConfig struct {
+	evmconfig.NonceCoordinationConfig
+}
+
+func (n *nonceCoordinationConfig) Enabled() bool { return false }
+
+type simulationConfig struct {
+	evmconfig.SimulationConfig
+}
+
+func (s *simulationConfig) Enabled() bool { return false }
+
+type circuitBreakerConfig struct {
+	evmconfig.CircuitBreakerConfig
+}
+
+func (c *circuitBreakerConfig) Enabled() bool { return false }
+
 type MockConfig struct {
 	EvmConfig           *TestEvmConfig
 	RpcDefaultBatchSize uint32
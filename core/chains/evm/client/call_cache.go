@@ -0,0 +1,185 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/patrickmn/go-cache"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+)
+
+var promEVMPoolRPCCallCacheLookups = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "evm_pool_rpc_call_cache_lookups",
+	Help: "The total number of immutable reads looked up in the call cache of the given chain, by result",
+}, []string{"evmChainID", "result"})
+
+const (
+	callCacheHit  = "hit"
+	callCacheMiss = "miss"
+)
+
+// callCache stores the results of the reads of the RPC pool which can never change, under the hash of their method and
+// arguments. Results are stored as the JSON returned by the RPC, so that callers decoding them into different types
+// share the entries. A nil *callCache is valid and never hits.
+// Given a data source, the entries are also written to the database and the misses are looked up there, so that they
+// survive restarts. The database is best effort: its errors are logged and count as misses.
+type callCache struct {
+	cache      *cache.Cache
+	expiration time.Duration
+	chainID    string
+	// latestFinalized returns the latest finalized block number known to the pool, or 0 if it doesn't know it.
+	latestFinalized func() int64
+
+	lggr logger.Logger
+	orm  *callCacheORM // nil without a data source
+	// lastPurge is the unix nano time the expired entries were last deleted from the database
+	lastPurge atomic.Int64
+}
+
+func newCallCache(expiration time.Duration, chainID *big.Int, latestFinalized func() int64, ds sqlutil.DataSource, lggr logger.Logger) *callCache {
+	if expiration <= 0 {
+		return nil
+	}
+	c := &callCache{
+		cache:           cache.New(expiration, expiration),
+		expiration:      expiration,
+		chainID:         chainID.String(),
+		latestFinalized: latestFinalized,
+		lggr:            logger.Named(lggr, "CallCache"),
+	}
+	if ds != nil {
+		c.orm = newCallCacheORM(chainID, ds)
+		c.lastPurge.Store(time.Now().UnixNano())
+	}
+	return c
+}
+
+// key returns the content-addressed key of a read, or false if its result may change and must not be cached.
+func (c *callCache) key(method string, args ...interface{}) (string, bool) {
+	if c == nil {
+		return "", false
+	}
+	switch method {
+	case "eth_chainId":
+		if len(args) != 0 {
+			return "", false
+		}
+	case "eth_call", "eth_getCode":
+		// eth_call takes state overrides as an optional third argument, those calls are not worth caching
+		if len(args) != 2 || !c.isImmutableBlock(args[1]) {
+			return "", false
+		}
+	default:
+		return "", false
+	}
+	b, err := json.Marshal(append([]interface{}{method}, args...))
+	if err != nil {
+		return "", false
+	}
+	return crypto.Keccak256Hash(b).Hex(), true
+}
+
+// isImmutableBlock returns true if the block parameter pins the state of the read. A block hash always does, while a
+// block number only does once its block is finalized. Tags like latest or finalized never do.
+func (c *callCache) isImmutableBlock(arg interface{}) bool {
+	switch b := arg.(type) {
+	case rpc.BlockNumberOrHash:
+		if _, ok := b.Hash(); ok {
+			return true
+		}
+		n, _ := b.Number()
+		return c.isFinalized(int64(n))
+	case *rpc.BlockNumberOrHash:
+		return b != nil && c.isImmutableBlock(*b)
+	case map[string]interface{}:
+		// EIP-1898 block parameter
+		_, ok := b["blockHash"]
+		return ok
+	case common.Hash:
+		return true
+	case rpc.BlockNumber:
+		return c.isFinalized(int64(b))
+	case *big.Int:
+		return b != nil && b.IsInt64() && c.isFinalized(b.Int64())
+	case string:
+		n, err := hexutil.DecodeBig(b)
+		return err == nil && n.IsInt64() && c.isFinalized(n.Int64())
+	}
+	return false
+}
+
+func (c *callCache) isFinalized(n int64) bool {
+	return n >= 0 && n <= c.latestFinalized()
+}
+
+// get decodes the cached result of key into result, and returns false if there is none.
+func (c *callCache) get(ctx context.Context, key string, result interface{}) bool {
+	if b, ok := c.lookup(ctx, key); ok && json.Unmarshal(b, result) == nil {
+		promEVMPoolRPCCallCacheLookups.WithLabelValues(c.chainID, callCacheHit).Inc()
+		return true
+	}
+	promEVMPoolRPCCallCacheLookups.WithLabelValues(c.chainID, callCacheMiss).Inc()
+	return false
+}
+
+// lookup returns the JSON result of key from memory, or else from the database, in which case it is kept in memory
+// until it expires.
+func (c *callCache) lookup(ctx context.Context, key string) ([]byte, bool) {
+	if v, ok := c.cache.Get(key); ok {
+		return v.([]byte), true
+	}
+	if c.orm == nil {
+		return nil, false
+	}
+	b, expiresAt, err := c.orm.get(ctx, key)
+	if err != nil {
+		c.lggr.Warnw("Failed to look up the call cache in the database", "err", err)
+		return nil, false
+	}
+	if b == nil {
+		return nil, false
+	}
+	c.cache.Set(key, b, time.Until(expiresAt))
+	return b, true
+}
+
+func (c *callCache) set(ctx context.Context, key string, result interface{}) {
+	b, err := json.Marshal(result)
+	if err != nil {
+		return
+	}
+	c.cache.SetDefault(key, b)
+	if c.orm == nil {
+		return
+	}
+	if err = c.orm.set(ctx, key, b, time.Now().Add(c.expiration)); err != nil {
+		c.lggr.Warnw("Failed to write the call cache to the database", "err", err)
+	}
+	c.purge(ctx)
+}
+
+// purge deletes the expired entries from the database, at most once per expiration.
+func (c *callCache) purge(ctx context.Context) {
+	last := c.lastPurge.Load()
+	now := time.Now()
+	if now.Sub(time.Unix(0, last)) < c.expiration || !c.lastPurge.CompareAndSwap(last, now.UnixNano()) {
+		return
+	}
+	n, err := c.orm.deleteExpired(ctx)
+	if err != nil {
+		c.lggr.Warnw("Failed to delete the expired entries of the call cache", "err", err)
+		return
+	}
+	c.lggr.Debugw("Deleted the expired entries of the call cache", "count", n)
+}
@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+)
+
+// callCacheORM persists the entries of a callCache, so that they survive restarts.
+type callCacheORM struct {
+	chainID ubig.Big
+	ds      sqlutil.DataSource
+}
+
+func newCallCacheORM(chainID *big.Int, ds sqlutil.DataSource) *callCacheORM {
+	return &callCacheORM{chainID: ubig.Big(*chainID), ds: ds}
+}
+
+// get returns the result stored under key along with its expiration, or a nil result if there is none which is not
+// expired.
+func (o *callCacheORM) get(ctx context.Context, key string) (result []byte, expiresAt time.Time, err error) {
+	row := struct {
+		Result    []byte    `db:"result"`
+		ExpiresAt time.Time `db:"expires_at"`
+	}{}
+	err = o.ds.GetContext(ctx, &row, `SELECT result, expires_at FROM evm.call_cache WHERE evm_chain_id = $1 AND key = $2 AND expires_at > NOW()`,
+		o.chainID, common.HexToHash(key).Bytes())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, time.Time{}, nil
+	}
+	return row.Result, row.ExpiresAt, err
+}
+
+func (o *callCacheORM) set(ctx context.Context, key string, result []byte, expiresAt time.Time) error {
+	_, err := o.ds.ExecContext(ctx, `INSERT INTO evm.call_cache (evm_chain_id, key, result, expires_at) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (evm_chain_id, key) DO UPDATE SET result = EXCLUDED.result, expires_at = EXCLUDED.expires_at`,
+		o.chainID, common.HexToHash(key).Bytes(), result, expiresAt)
+	return err
+}
+
+// deleteExpired deletes the expired entries of all chains.
+func (o *callCacheORM) deleteExpired(ctx context.Context) (int64, error) {
+	res, err := o.ds.ExecContext(ctx, `DELETE FROM evm.call_cache WHERE expires_at <= NOW()`)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
@@ -0,0 +1,117 @@
+package client
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+)
+
+func TestCallCache(t *testing.T) {
+	chainID := big.NewInt(1)
+	latestFinalized := func() int64 { return 100 }
+	call := map[string]interface{}{"to": common.HexToAddress("0x1"), "data": hexutil.Bytes{0x01}}
+	blockHash := common.HexToHash("0x2")
+
+	t.Run("disabled", func(t *testing.T) {
+		c := newCallCache(0, chainID, latestFinalized, nil, logger.Test(t))
+		require.Nil(t, c)
+		_, ok := c.key("eth_chainId")
+		assert.False(t, ok)
+	})
+
+	t.Run("only immutable reads are cached", func(t *testing.T) {
+		c := newCallCache(time.Minute, chainID, latestFinalized, nil, logger.Test(t))
+		for _, tt := range []struct {
+			name   string
+			method string
+			args   []interface{}
+			ok     bool
+		}{
+			{"chain ID", "eth_chainId", nil, true},
+			{"call at block hash", "eth_call", []interface{}{call, rpc.BlockNumberOrHashWithHash(blockHash, false)}, true},
+			{"call at EIP-1898 block hash", "eth_call", []interface{}{call, map[string]interface{}{"blockHash": blockHash}}, true},
+			{"call at finalized block", "eth_call", []interface{}{call, hexutil.EncodeUint64(100)}, true},
+			{"code at finalized block", "eth_getCode", []interface{}{common.HexToAddress("0x1"), big.NewInt(99)}, true},
+			{"call at unfinalized block", "eth_call", []interface{}{call, hexutil.EncodeUint64(101)}, false},
+			{"call at finalized tag", "eth_call", []interface{}{call, rpc.FinalizedBlockNumber}, false},
+			{"call at latest", "eth_call", []interface{}{call, "latest"}, false},
+			{"call with state overrides", "eth_call", []interface{}{call, blockHash, map[string]interface{}{}}, false},
+			{"balance", "eth_getBalance", []interface{}{common.HexToAddress("0x1"), blockHash}, false},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				_, ok := c.key(tt.method, tt.args...)
+				assert.Equal(t, tt.ok, ok)
+			})
+		}
+	})
+
+	t.Run("keys are content addressed", func(t *testing.T) {
+		c := newCallCache(time.Minute, chainID, latestFinalized, nil, logger.Test(t))
+		k1, ok := c.key("eth_call", call, blockHash)
+		require.True(t, ok)
+		k2, ok := c.key("eth_call", map[string]interface{}{"data": hexutil.Bytes{0x01}, "to": common.HexToAddress("0x1")}, blockHash)
+		require.True(t, ok)
+		assert.Equal(t, k1, k2)
+		k3, ok := c.key("eth_call", call, common.HexToHash("0x3"))
+		require.True(t, ok)
+		assert.NotEqual(t, k1, k3)
+	})
+
+	t.Run("results are shared across types", func(t *testing.T) {
+		c := newCallCache(time.Minute, chainID, latestFinalized, nil, logger.Test(t))
+		key, ok := c.key("eth_call", call, blockHash)
+		require.True(t, ok)
+
+		ctx := tests.Context(t)
+		var b hexutil.Bytes
+		assert.False(t, c.get(ctx, key, &b))
+
+		c.set(ctx, key, hexutil.Bytes{0xab, 0xcd})
+		var s string
+		require.True(t, c.get(ctx, key, &s))
+		assert.Equal(t, "0xabcd", s)
+		require.True(t, c.get(ctx, key, &b))
+		assert.Equal(t, hexutil.Bytes{0xab, 0xcd}, b)
+	})
+
+	t.Run("entries survive restarts", func(t *testing.T) {
+		ctx := tests.Context(t)
+		db := pgtest.NewSqlxDB(t)
+		c := newCallCache(time.Minute, chainID, latestFinalized, db, logger.Test(t))
+		key, ok := c.key("eth_call", call, blockHash)
+		require.True(t, ok)
+		c.set(ctx, key, hexutil.Bytes{0xab, 0xcd})
+
+		restarted := newCallCache(time.Minute, chainID, latestFinalized, db, logger.Test(t))
+		var b hexutil.Bytes
+		require.True(t, restarted.get(ctx, key, &b))
+		assert.Equal(t, hexutil.Bytes{0xab, 0xcd}, b)
+
+		otherChain := newCallCache(time.Minute, big.NewInt(2), latestFinalized, db, logger.Test(t))
+		assert.False(t, otherChain.get(ctx, key, &b))
+
+		expired := newCallCache(time.Nanosecond, chainID, latestFinalized, db, logger.Test(t))
+		key2, ok := expired.key("eth_call", call, common.HexToHash("0x3"))
+		require.True(t, ok)
+		expired.set(ctx, key2, hexutil.Bytes{0x01})
+		time.Sleep(time.Millisecond)
+		assert.False(t, restarted.get(ctx, key2, &b))
+		_, err := restarted.orm.deleteExpired(ctx)
+		require.NoError(t, err)
+		var count int
+		require.NoError(t, db.GetContext(ctx, &count, `SELECT count(*) FROM evm.call_cache WHERE evm_chain_id = $1`, ubig.New(chainID)))
+		assert.Equal(t, 1, count)
+	})
+}
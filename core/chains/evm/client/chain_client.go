@@ -3,15 +3,18 @@ package client
 import (
 	"context"
 	"math/big"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 
 	commonassets "github.com/smartcontractkit/chainlink-common/pkg/assets"
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 
 	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
@@ -121,6 +124,11 @@ type chainClient struct {
 	logger       logger.SugaredLogger
 	chainType    chaintype.ChainType
 	clientErrors evmconfig.ClientErrors
+	callCache    *callCache
+
+	nodesMu     sync.Mutex           // serializes ReloadNodes
+	nodeBuilder *nodeBuilder         // nil unless built by NewEvmClient
+	builtNodes  map[string]builtNode // by nodeConfigKey
 }
 
 func NewChainClient(
@@ -134,6 +142,8 @@ func NewChainClient(
 	chainType chaintype.ChainType,
 	clientErrors evmconfig.ClientErrors,
 	deathDeclarationDelay time.Duration,
+	callCacheExpiration time.Duration,
+	ds sqlutil.DataSource,
 ) Client {
 	multiNode := commonclient.NewMultiNode(
 		lggr,
@@ -150,10 +160,15 @@ func NewChainClient(
 		0, // use the default value provided by the implementation
 		deathDeclarationDelay,
 	)
+	latestFinalized := func() int64 {
+		_, info := multiNode.LatestChainInfo()
+		return info.FinalizedBlockNumber
+	}
 	return &chainClient{
 		multiNode:    multiNode,
 		logger:       logger.Sugared(lggr),
 		clientErrors: clientErrors,
+		callCache:    newCallCache(callCacheExpiration, chainID, latestFinalized, ds, lggr),
 	}
 }
 
@@ -168,8 +183,50 @@ func (c *chainClient) BalanceAt(ctx context.Context, account common.Address, blo
 // Note: some chains (e.g Astar) have custom finality requests, so even when FinalityTagEnabled=true, finality tag
 // might not be properly handled and returned results might have weaker finality guarantees. It's highly recommended
 // to use HeadTracker to identify latest finalized block.
+// Immutable reads are answered from the call cache, and only the remaining requests are sent.
 func (c *chainClient) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
-	return c.multiNode.BatchCallContext(ctx, b)
+	if c.callCache == nil {
+		return c.multiNode.BatchCallContext(ctx, b)
+	}
+	keys := make([]string, len(b))
+	var missing []int
+	for i := range b {
+		if key, ok := c.callCache.key(b[i].Method, b[i].Args...); ok && b[i].Result != nil {
+			if c.callCache.get(ctx, key, b[i].Result) {
+				b[i].Error = nil
+				continue
+			}
+			keys[i] = key
+		}
+		missing = append(missing, i)
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	if len(missing) == len(b) {
+		if err := c.multiNode.BatchCallContext(ctx, b); err != nil {
+			return err
+		}
+	} else {
+		// the results are written through the pointers shared with b, only the errors have to be copied back
+		reqs := make([]rpc.BatchElem, len(missing))
+		for j, i := range missing {
+			reqs[j] = b[i]
+		}
+		if err := c.multiNode.BatchCallContext(ctx, reqs); err != nil {
+			return err
+		}
+		for j, i := range missing {
+			b[i].Error = reqs[j].Error
+		}
+	}
+	for _, i := range missing {
+		if keys[i] != "" && b[i].Error == nil {
+			c.callCache.set(ctx, keys[i], b[i].Result)
+		}
+	}
+	return nil
 }
 
 // Similar to BatchCallContext, ensure the provided BatchElem slice is passed through
@@ -179,7 +236,7 @@ func (c *chainClient) BatchCallContextAll(ctx context.Context, b []rpc.BatchElem
 
 // TODO-1663: return custom Block type instead of geth's once client.go is deprecated.
 func (c *chainClient) BlockByHash(ctx context.Context, hash common.Hash) (b *types.Block, err error) {
-	rpc, err := c.multiNode.SelectNodeRPC()
+	rpc, err := c.multiNode.SelectNodeRPC(ctx)
 	if err != nil {
 		return b, err
 	}
@@ -188,23 +245,50 @@ func (c *chainClient) BlockByHash(ctx context.Context, hash common.Hash) (b *typ
 
 // TODO-1663: return custom Block type instead of geth's once client.go is deprecated.
 func (c *chainClient) BlockByNumber(ctx context.Context, number *big.Int) (b *types.Block, err error) {
-	rpc, err := c.multiNode.SelectNodeRPC()
+	rpc, err := c.multiNode.SelectNodeRPC(ctx)
 	if err != nil {
 		return b, err
 	}
 	return rpc.BlockByNumberGeth(ctx, number)
 }
 
+// CallContext answers immutable reads from the call cache, see callCache.
 func (c *chainClient) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
-	return c.multiNode.CallContext(ctx, result, method, args...)
+	key, cacheable := c.callCache.key(method, args...)
+	cacheable = cacheable && result != nil
+	if cacheable && c.callCache.get(ctx, key, result) {
+		return nil
+	}
+	if err := c.multiNode.CallContext(ctx, result, method, args...); err != nil {
+		return err
+	}
+	if cacheable {
+		c.callCache.set(ctx, key, result)
+	}
+	return nil
 }
 
+// CallContract decodes the data of reverts with the errors of the registered contracts, see RevertError. Calls at a
+// finalized block are answered from the call cache.
 func (c *chainClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
-	return c.multiNode.CallContract(ctx, msg, blockNumber)
+	key, cacheable := c.callCache.key("eth_call", ToBackwardCompatibleCallArg(msg), ToBlockNumArg(blockNumber))
+	var cached hexutil.Bytes
+	if cacheable && c.callCache.get(ctx, key, &cached) {
+		return cached, nil
+	}
+	b, err := c.multiNode.CallContract(ctx, msg, blockNumber)
+	if err != nil {
+		return b, decodeRevertError(err)
+	}
+	if cacheable {
+		c.callCache.set(ctx, key, hexutil.Bytes(b))
+	}
+	return b, nil
 }
 
 func (c *chainClient) PendingCallContract(ctx context.Context, msg ethereum.CallMsg) ([]byte, error) {
-	return c.multiNode.PendingCallContract(ctx, msg)
+	b, err := c.multiNode.PendingCallContract(ctx, msg)
+	return b, decodeRevertError(err)
 }
 
 // TODO-1663: change this to actual ChainID() call once client.go is deprecated.
@@ -217,8 +301,21 @@ func (c *chainClient) Close() {
 	c.multiNode.Close()
 }
 
+// CodeAt answers reads at a finalized block from the call cache.
 func (c *chainClient) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
-	return c.multiNode.CodeAt(ctx, account, blockNumber)
+	key, cacheable := c.callCache.key("eth_getCode", account, ToBlockNumArg(blockNumber))
+	var cached hexutil.Bytes
+	if cacheable && c.callCache.get(ctx, key, &cached) {
+		return cached, nil
+	}
+	code, err := c.multiNode.CodeAt(ctx, account, blockNumber)
+	if err != nil {
+		return code, err
+	}
+	if cacheable {
+		c.callCache.set(ctx, key, hexutil.Bytes(code))
+	}
+	return code, nil
 }
 
 func (c *chainClient) ConfiguredChainID() *big.Int {
@@ -237,7 +334,7 @@ func (c *chainClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([
 }
 
 func (c *chainClient) HeaderByHash(ctx context.Context, h common.Hash) (head *types.Header, err error) {
-	rpc, err := c.multiNode.SelectNodeRPC()
+	rpc, err := c.multiNode.SelectNodeRPC(ctx)
 	if err != nil {
 		return head, err
 	}
@@ -245,7 +342,7 @@ func (c *chainClient) HeaderByHash(ctx context.Context, h common.Hash) (head *ty
 }
 
 func (c *chainClient) HeaderByNumber(ctx context.Context, n *big.Int) (head *types.Header, err error) {
-	rpc, err := c.multiNode.SelectNodeRPC()
+	rpc, err := c.multiNode.SelectNodeRPC(ctx)
 	if err != nil {
 		return head, err
 	}
@@ -276,8 +373,13 @@ func (c *chainClient) NodeStates() map[string]string {
 	return c.multiNode.NodeStates()
 }
 
+// HealthReport reports the health of the RPC pool and each of its nodes.
+func (c *chainClient) HealthReport() map[string]error {
+	return c.multiNode.HealthReport()
+}
+
 func (c *chainClient) PendingCodeAt(ctx context.Context, account common.Address) (b []byte, err error) {
-	rpc, err := c.multiNode.SelectNodeRPC()
+	rpc, err := c.multiNode.SelectNodeRPC(ctx)
 	if err != nil {
 		return b, err
 	}
@@ -305,7 +407,7 @@ func (c *chainClient) SequenceAt(ctx context.Context, account common.Address, bl
 }
 
 func (c *chainClient) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (s ethereum.Subscription, err error) {
-	rpc, err := c.multiNode.SelectNodeRPC()
+	rpc, err := c.multiNode.SelectNodeRPC(ctx)
 	if err != nil {
 		return s, err
 	}
@@ -317,7 +419,7 @@ func (c *chainClient) SubscribeNewHead(ctx context.Context, ch chan<- *evmtypes.
 }
 
 func (c *chainClient) SuggestGasPrice(ctx context.Context) (p *big.Int, err error) {
-	rpc, err := c.multiNode.SelectNodeRPC()
+	rpc, err := c.multiNode.SelectNodeRPC(ctx)
 	if err != nil {
 		return p, err
 	}
@@ -325,7 +427,7 @@ func (c *chainClient) SuggestGasPrice(ctx context.Context) (p *big.Int, err erro
 }
 
 func (c *chainClient) SuggestGasTipCap(ctx context.Context) (t *big.Int, err error) {
-	rpc, err := c.multiNode.SelectNodeRPC()
+	rpc, err := c.multiNode.SelectNodeRPC(ctx)
 	if err != nil {
 		return t, err
 	}
@@ -342,7 +444,7 @@ func (c *chainClient) TransactionByHash(ctx context.Context, txHash common.Hash)
 
 // TODO-1663: return custom Receipt type instead of geth's once client.go is deprecated.
 func (c *chainClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (r *types.Receipt, err error) {
-	rpc, err := c.multiNode.SelectNodeRPC()
+	rpc, err := c.multiNode.SelectNodeRPC(ctx)
 	if err != nil {
 		return r, err
 	}
@@ -355,7 +457,7 @@ func (c *chainClient) LatestFinalizedBlock(ctx context.Context) (*evmtypes.Head,
 }
 
 func (c *chainClient) FeeHistory(ctx context.Context, blockCount uint64, rewardPercentiles []float64) (feeHistory *ethereum.FeeHistory, err error) {
-	rpc, err := c.multiNode.SelectNodeRPC()
+	rpc, err := c.multiNode.SelectNodeRPC(ctx)
 	if err != nil {
 		return feeHistory, err
 	}
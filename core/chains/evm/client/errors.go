@@ -5,10 +5,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 	pkgerrors "github.com/pkg/errors"
@@ -18,6 +20,7 @@ import (
 	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
 	commontypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/label"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/revert"
 )
 
 // fatal means this transaction can never be accepted even with a different nonce or higher gas price
@@ -68,6 +71,123 @@ const (
 
 type ClientErrors map[int]*regexp.Regexp
 
+// ErrorCategory groups the client error types by their cause.
+type ErrorCategory string
+
+const (
+	CategoryNonce       ErrorCategory = "nonce"
+	CategoryFee         ErrorCategory = "fee"
+	CategoryFunds       ErrorCategory = "funds"
+	CategoryMempool     ErrorCategory = "mempool"
+	CategoryInvalid     ErrorCategory = "invalid"
+	CategoryUnavailable ErrorCategory = "unavailable"
+	CategoryQuery       ErrorCategory = "query"
+)
+
+// ErrorClass describes a client error type.
+type ErrorClass struct {
+	Name     string
+	Category ErrorCategory
+	// Retryable indicates whether the same request may succeed later without being changed, e.g. once the mempool has
+	// room again or the account was funded.
+	Retryable bool
+}
+
+var errorClasses = map[int]ErrorClass{
+	NonceTooLow:                       {"NonceTooLow", CategoryNonce, false},
+	NonceTooHigh:                      {"NonceTooHigh", CategoryNonce, true},
+	ReplacementTransactionUnderpriced: {"ReplacementTransactionUnderpriced", CategoryFee, false},
+	LimitReached:                      {"LimitReached", CategoryMempool, true},
+	TransactionAlreadyInMempool:       {"TransactionAlreadyInMempool", CategoryMempool, false},
+	TerminallyUnderpriced:             {"TerminallyUnderpriced", CategoryFee, false},
+	InsufficientEth:                   {"InsufficientEth", CategoryFunds, true},
+	TxFeeExceedsCap:                   {"TxFeeExceedsCap", CategoryFee, false},
+	L2FeeTooLow:                       {"L2FeeTooLow", CategoryFee, false},
+	L2FeeTooHigh:                      {"L2FeeTooHigh", CategoryFee, false},
+	L2Full:                            {"L2Full", CategoryMempool, true},
+	TransactionAlreadyMined:           {"TransactionAlreadyMined", CategoryNonce, false},
+	Fatal:                             {"Fatal", CategoryInvalid, false},
+	ServiceUnavailable:                {"ServiceUnavailable", CategoryUnavailable, true},
+	TerminallyStuck:                   {"TerminallyStuck", CategoryInvalid, false},
+	TooManyResults:                    {"TooManyResults", CategoryQuery, false},
+}
+
+// ClassifiedError is a client error matched to an error type of the taxonomy.
+type ClassifiedError struct {
+	ErrorClass
+	Type int
+	// Provider is the name of the error table which matched the error: an RPC client like geth, or the provider
+	// configured with the custom error patterns of the node.
+	Provider string
+	Err      error
+}
+
+func (e *ClassifiedError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ClassifiedError) Unwrap() error {
+	return e.Err
+}
+
+// ProviderErrors are the error patterns of an RPC client or provider.
+type ProviderErrors struct {
+	Name   string
+	Errors ClientErrors
+}
+
+// ConfigProvider is the provider name of the custom error patterns of the node, if their provider isn't configured.
+const ConfigProvider = "config"
+
+// sendErrorTypes are the error types of ClassifySendError, in order of precedence.
+var sendErrorTypes = []int{
+	NonceTooLow,
+	TransactionAlreadyMined,
+	ReplacementTransactionUnderpriced,
+	TransactionAlreadyInMempool,
+	LimitReached,
+	TerminallyUnderpriced,
+	L2FeeTooLow,
+	L2FeeTooHigh,
+	L2Full,
+	NonceTooHigh,
+	InsufficientEth,
+	ServiceUnavailable,
+	TxFeeExceedsCap,
+	TerminallyStuck,
+}
+
+// ClassifyError returns the first of errorTypes matched by err, or nil if none is. The custom error patterns of
+// clientErrors are matched before the built-in tables of the providers.
+func ClassifyError(err error, clientErrors config.ClientErrors, errorTypes ...int) *ClassifiedError {
+	return classifyError(err, configProviderErrors(clientErrors), errorTypes...)
+}
+
+func classifyError(err error, configErrors ProviderErrors, errorTypes ...int) *ClassifiedError {
+	if err == nil {
+		return nil
+	}
+	for _, errorType := range errorTypes {
+		if configErrors.Errors.ErrIs(err, errorType) {
+			return &ClassifiedError{ErrorClass: errorClasses[errorType], Type: errorType, Provider: configErrors.Name, Err: err}
+		}
+		for _, p := range providers {
+			if p.Errors.ErrIs(err, errorType) {
+				return &ClassifiedError{ErrorClass: errorClasses[errorType], Type: errorType, Provider: p.Name, Err: err}
+			}
+		}
+	}
+	return nil
+}
+
+func configProviderErrors(errsRegex config.ClientErrors) ProviderErrors {
+	p := ProviderErrors{Name: ConfigProvider, Errors: *ClientErrorRegexes(errsRegex)}
+	if errsRegex != nil && errsRegex.Provider() != "" {
+		p.Name = errsRegex.Provider()
+	}
+	return p
+}
+
 // ErrIs returns true if err matches any provided error types
 func (e *ClientErrors) ErrIs(err error, errorTypes ...int) bool {
 	if err == nil {
@@ -288,7 +408,28 @@ var internal = ClientErrors{
 	TerminallyStuck: regexp.MustCompile(TerminallyStuckMsg),
 }
 
-var clients = []ClientErrors{parity, geth, arbitrum, metis, substrate, avalanche, nethermind, harmony, besu, erigon, klaytn, celo, zkSync, zkEvm, treasure, mantle, aStar, hedera, gnosis, internal}
+var providers = []ProviderErrors{
+	{"parity", parity},
+	{"geth", geth},
+	{"arbitrum", arbitrum},
+	{"metis", metis},
+	{"substrate", substrate},
+	{"avalanche", avalanche},
+	{"nethermind", nethermind},
+	{"harmony", harmony},
+	{"besu", besu},
+	{"erigon", erigon},
+	{"klaytn", klaytn},
+	{"celo", celo},
+	{"zksync", zkSync},
+	{"zkevm", zkEvm},
+	{"treasure", treasure},
+	{"mantle", mantle},
+	{"astar", aStar},
+	{"hedera", hedera},
+	{"gnosis", gnosis},
+	{"internal", internal},
+}
 
 // ClientErrorRegexes returns a map of compiled regexes for each error type
 func ClientErrorRegexes(errsRegex config.ClientErrors) *ClientErrors {
@@ -318,15 +459,11 @@ func (s *SendError) is(errorType int, configErrors *ClientErrors) bool {
 	if s == nil || s.err == nil {
 		return false
 	}
-	if configErrors != nil && configErrors.ErrIs(s.err, errorType) {
-		return true
-	}
-	for _, client := range clients {
-		if client.ErrIs(s.err, errorType) {
-			return true
-		}
+	var custom ProviderErrors
+	if configErrors != nil {
+		custom = ProviderErrors{Name: ConfigProvider, Errors: *configErrors}
 	}
-	return false
+	return classifyError(s.err, custom, errorType) != nil
 }
 
 // IsReplacementUnderpriced indicates that a transaction already exists in the mempool with this nonce but a different gas price or payload
@@ -459,11 +596,11 @@ func isFatalSendError(err error) bool {
 	}
 	str := pkgerrors.Cause(err).Error()
 
-	for _, client := range clients {
-		if _, ok := client[Fatal]; !ok {
+	for _, p := range providers {
+		if _, ok := p.Errors[Fatal]; !ok {
 			continue
 		}
-		if client[Fatal].MatchString(str) {
+		if p.Errors[Fatal].MatchString(str) {
 			return true
 		}
 	}
@@ -500,9 +637,69 @@ func (err JsonError) ErrorData() interface{} {
 }
 
 func (err JsonError) String() string {
+	if data, ok := err.RevertData(); ok {
+		if decoded, decErr := revert.Decode(data); decErr == nil {
+			return fmt.Sprintf("json-rpc error { Code = %d, Message = '%s', Data = '%v', Reason = '%s' }", err.Code, err.Message, err.Data, decoded)
+		}
+	}
 	return fmt.Sprintf("json-rpc error { Code = %d, Message = '%s', Data = '%v' }", err.Code, err.Message, err.Data)
 }
 
+// RevertData returns the revert data of a reverted call, if the RPC included it in the data of the error. Some RPCs
+// prefix it with "Reverted".
+func (err JsonError) RevertData() ([]byte, bool) {
+	data, ok := err.Data.(string)
+	if !ok {
+		return nil, false
+	}
+	b, decErr := hexutil.Decode(strings.TrimPrefix(data, "Reverted "))
+	if decErr != nil || len(b) < 4 {
+		return nil, false
+	}
+	return b, true
+}
+
+// RevertError is a reverted call whose revert data was decoded with the registered contract errors. It wraps the
+// error returned by the RPC, so that ExtractRPCError still finds it.
+type RevertError struct {
+	err    error
+	Reason *revert.Error
+}
+
+func (e *RevertError) Error() string {
+	return fmt.Sprintf("%s: %s", e.err.Error(), e.Reason)
+}
+
+func (e *RevertError) Unwrap() error {
+	return e.err
+}
+
+// Cause satisfies the causer interface of pkg/errors.
+func (e *RevertError) Cause() error {
+	return e.err
+}
+
+// decodeRevertError wraps err in a RevertError if it is a revert with data which decodes, and which isn't already
+// readable from the error message, like the reasons of require statements.
+func decodeRevertError(err error) error {
+	if err == nil {
+		return nil
+	}
+	jErr := ExtractRPCErrorOrNil(err)
+	if jErr == nil {
+		return err
+	}
+	data, ok := jErr.RevertData()
+	if !ok {
+		return err
+	}
+	decoded, decErr := revert.Decode(data)
+	if decErr != nil || strings.Contains(err.Error(), decoded.String()) {
+		return err
+	}
+	return &RevertError{err: err, Reason: decoded}
+}
+
 func ExtractRPCErrorOrNil(err error) *JsonError {
 	jErr, eErr := ExtractRPCError(err)
 	if eErr != nil {
@@ -555,82 +752,77 @@ func ClassifySendError(err error, clientErrors config.ClientErrors, lggr logger.
 		return commonclient.Successful
 	}
 
-	configErrors := ClientErrorRegexes(clientErrors)
+	custom := configProviderErrors(clientErrors)
 
-	if sendError.Fatal(configErrors) {
+	if sendError.Fatal(&custom.Errors) {
 		lggr.Criticalw("Fatal error sending transaction", "err", sendError, "etx", tx)
 		// Attempt is thrown away in this case; we don't need it since it never got accepted by a node
 		return commonclient.Fatal
 	}
-	if sendError.IsNonceTooLowError(configErrors) || sendError.IsTransactionAlreadyMined(configErrors) {
+
+	errType := -1
+	if classified := classifyError(sendError.err, custom, sendErrorTypes...); classified != nil {
+		errType = classified.Type
+		lggr = logger.Sugared(lggr.With("errorType", classified.Name, "errorCategory", classified.Category, "errorProvider", classified.Provider))
+	}
+	switch {
+	case errType == NonceTooLow || errType == TransactionAlreadyMined:
 		lggr.Debugw(fmt.Sprintf("Transaction already confirmed for this nonce: %d", tx.Nonce()), "err", sendError, "etx", tx)
 		// Nonce too low indicated that a transaction at this nonce was confirmed already.
 		// Mark it as TransactionAlreadyKnown.
 		return commonclient.TransactionAlreadyKnown
-	}
-	if sendError.IsReplacementUnderpriced(configErrors) {
+	case errType == ReplacementTransactionUnderpriced:
 		lggr.Errorw(fmt.Sprintf("Replacement transaction underpriced for eth_tx %x. "+
 			"Please note that using your node's private keys outside of the chainlink node is NOT SUPPORTED and can lead to missed transactions.",
 			tx.Hash()), "gasPrice", tx.GasPrice, "gasTipCap", tx.GasTipCap, "gasFeeCap", tx.GasFeeCap, "err", sendError, "etx", tx)
 
 		// Assume success and hand off to the next cycle.
 		return commonclient.Successful
-	}
-	if sendError.IsTransactionAlreadyInMempool(configErrors) {
+	case errType == TransactionAlreadyInMempool:
 		lggr.Debugw("Transaction already in mempool", "etx", tx, "err", sendError)
 		return commonclient.Successful
-	}
-	if sendError.IsTemporarilyUnderpriced(configErrors) {
+	case errType == LimitReached:
 		lggr.Infow("Transaction temporarily underpriced", "err", sendError)
 		return commonclient.Successful
-	}
-	if sendError.IsTerminallyUnderpriced(configErrors) {
+	case errType == TerminallyUnderpriced:
 		lggr.Errorw("Transaction terminally underpriced", "etx", tx, "err", sendError)
 		return commonclient.Underpriced
-	}
-	if sendError.L2FeeTooLow(configErrors) || sendError.IsL2FeeTooHigh(configErrors) || sendError.IsL2Full(configErrors) {
+	case errType == L2FeeTooLow || errType == L2FeeTooHigh || errType == L2Full:
 		if isL2 {
 			lggr.Errorw("Transaction fee out of range", "err", sendError, "etx", tx)
 			return commonclient.FeeOutOfValidRange
 		}
 		lggr.Errorw("this error type only handled for L2s", "err", sendError, "etx", tx)
 		return commonclient.Unsupported
-	}
-	if sendError.IsNonceTooHighError(configErrors) {
+	case errType == NonceTooHigh:
 		// This error occurs when the tx nonce is greater than current_nonce + tx_count_in_mempool,
 		// instead of keeping the tx in mempool. This can happen if previous transactions haven't
 		// reached the client yet. The correct thing to do is to mark it as retryable.
 		lggr.Warnw("Transaction has a nonce gap.", "err", sendError, "etx", tx)
 		return commonclient.Retryable
-	}
-	if sendError.IsInsufficientEth(configErrors) {
+	case errType == InsufficientEth:
 		lggr.Criticalw(fmt.Sprintf("Tx %x with type 0x%d was rejected due to insufficient eth: %s\n"+
 			"ACTION REQUIRED: Chainlink wallet with address 0x%x is OUT OF FUNDS",
 			tx.Hash(), tx.Type(), sendError.Error(), fromAddress,
 		), "err", sendError, "etx", tx)
 		return commonclient.InsufficientFunds
-	}
-	if sendError.IsServiceUnavailable(configErrors) {
+	case errType == ServiceUnavailable:
 		lggr.Errorw(fmt.Sprintf("service unavailable while sending transaction %x", tx.Hash()), "err", sendError, "etx", tx)
 		return commonclient.Retryable
-	}
-	if sendError.IsTimeout() {
+	case sendError.IsTimeout():
 		lggr.Errorw(fmt.Sprintf("timeout while sending transaction %x", tx.Hash()), "err", sendError, "etx", tx)
 		return commonclient.Retryable
-	}
-	if sendError.IsCanceled() {
+	case sendError.IsCanceled():
 		lggr.Errorw(fmt.Sprintf("context was canceled while sending transaction %x", tx.Hash()), "err", sendError, "etx", tx)
 		return commonclient.Retryable
-	}
-	if sendError.IsTxFeeExceedsCap(configErrors) {
+	case errType == TxFeeExceedsCap:
 		lggr.Criticalw(fmt.Sprintf("Sending transaction failed: %s", label.RPCTxFeeCapConfiguredIncorrectlyWarning),
 			"etx", tx,
 			"err", sendError,
 			"id", "RPCTxFeeCapExceeded",
 		)
 		return commonclient.ExceedsMaxFee
-	}
-	if sendError.IsTerminallyStuckConfigError(configErrors) {
+	case errType == TerminallyStuck:
 		lggr.Warnw("Transaction that would have been terminally stuck in the mempool detected on send. Marking as fatal error.", "err", sendError, "etx", tx)
 		// Attempt is thrown away in this case; we don't need it since it never got accepted by a node
 		return commonclient.TerminallyStuck
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	pkgerrors "github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
 )
@@ -451,6 +453,50 @@ func Test_Config_Errors(t *testing.T) {
 	})
 }
 
+func Test_ClassifyError(t *testing.T) {
+	testErrors := evmclient.NewTestClientErrors()
+
+	tests := []struct {
+		message   string
+		errType   int
+		category  evmclient.ErrorCategory
+		retryable bool
+		provider  string
+	}{
+		{"call failed: OldNonce", evmclient.NonceTooLow, evmclient.CategoryNonce, false, "nethermind"},
+		{"call failed: NonceGap", evmclient.NonceTooHigh, evmclient.CategoryNonce, true, "nethermind"},
+		{"replacement transaction underpriced", evmclient.ReplacementTransactionUnderpriced, evmclient.CategoryFee, false, "geth"},
+		{"insufficient funds for transfer", evmclient.InsufficientEth, evmclient.CategoryFunds, true, "geth"},
+		{"sequencer pending tx pool full, please try again", evmclient.L2Full, evmclient.CategoryMempool, true, "arbitrum"},
+		{"client error terminally underpriced", evmclient.TerminallyUnderpriced, evmclient.CategoryFee, false, "test"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.message, func(t *testing.T) {
+			err := pkgerrors.Wrap(errors.New(tt.message), "wrapped")
+			classified := evmclient.ClassifyError(err, &testErrors, evmclient.NonceTooLow, evmclient.NonceTooHigh,
+				evmclient.ReplacementTransactionUnderpriced, evmclient.InsufficientEth, evmclient.L2Full, evmclient.TerminallyUnderpriced)
+			if assert.NotNil(t, classified) {
+				assert.Equal(t, tt.errType, classified.Type)
+				assert.Equal(t, tt.category, classified.Category)
+				assert.Equal(t, tt.retryable, classified.Retryable)
+				assert.Equal(t, tt.provider, classified.Provider)
+				assert.ErrorIs(t, classified, err)
+			}
+		})
+	}
+
+	t.Run("default config provider", func(t *testing.T) {
+		classified := evmclient.ClassifyError(errors.New("some old bollocks"), nil, evmclient.NonceTooLow)
+		assert.Nil(t, classified)
+
+		classified = evmclient.ClassifyError(errors.New("nonce too low"), nil, evmclient.NonceTooHigh, evmclient.NonceTooLow)
+		if assert.NotNil(t, classified) {
+			assert.Equal(t, "NonceTooLow", classified.Name)
+			assert.Equal(t, "geth", classified.Provider)
+		}
+	})
+}
+
 func Test_IsTooManyResultsError(t *testing.T) {
 	customErrors := evmclient.NewTestClientErrors()
 
@@ -533,3 +579,48 @@ func Test_IsTooManyResultsError(t *testing.T) {
 		})
 	}
 }
+
+func Test_JsonError_Revert(t *testing.T) {
+	t.Parallel()
+
+	// Panic(0x11)
+	panicData := "0x4e487b710000000000000000000000000000000000000000000000000000000000000011"
+
+	t.Run("revert data", func(t *testing.T) {
+		for _, data := range []interface{}{panicData, "Reverted " + panicData} {
+			jErr := evmclient.JsonError{Code: 3, Message: "execution reverted", Data: data}
+			b, ok := jErr.RevertData()
+			assert.True(t, ok)
+			assert.Equal(t, hexutil.MustDecode(panicData), b)
+			assert.Equal(t, fmt.Sprintf("json-rpc error { Code = 3, Message = 'execution reverted', Data = '%s', Reason = 'panic: arithmetic underflow or overflow (0x11)' }", data), jErr.String())
+		}
+
+		for _, data := range []interface{}{nil, "KqYi", "0x1234", 42} {
+			jErr := evmclient.JsonError{Code: 3, Message: "execution reverted", Data: data}
+			_, ok := jErr.RevertData()
+			assert.False(t, ok)
+			assert.Equal(t, fmt.Sprintf("json-rpc error { Code = 3, Message = 'execution reverted', Data = '%v' }", data), jErr.String())
+		}
+	})
+
+	t.Run("decoded errors", func(t *testing.T) {
+		rpcErr := &evmclient.JsonError{Code: 3, Message: "execution reverted", Data: panicData}
+		err := evmclient.DecodeRevertError(pkgerrors.Wrap(rpcErr, "eth_call failed"))
+		var revertErr *evmclient.RevertError
+		require.ErrorAs(t, err, &revertErr)
+		assert.Equal(t, "eth_call failed: execution reverted: panic: arithmetic underflow or overflow (0x11)", err.Error())
+		// the RPC error is still extracted from the decoded error
+		assert.Equal(t, rpcErr, evmclient.ExtractRPCErrorOrNil(err))
+
+		// the reasons of require statements are in the message already
+		rpcErr = &evmclient.JsonError{Code: 3, Message: "execution reverted: too late", Data: "0x08c379a0" +
+			"0000000000000000000000000000000000000000000000000000000000000020" +
+			"0000000000000000000000000000000000000000000000000000000000000008" +
+			"746f6f206c617465000000000000000000000000000000000000000000000000"}
+		assert.Equal(t, rpcErr, evmclient.DecodeRevertError(rpcErr))
+
+		otherErr := errors.New("connection refused")
+		assert.Equal(t, otherErr, evmclient.DecodeRevertError(otherErr))
+		assert.NoError(t, evmclient.DecodeRevertError(nil))
+	})
+}
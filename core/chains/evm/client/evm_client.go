@@ -1,11 +1,17 @@
 package client
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"math/big"
 	"net/url"
 	"time"
 
+	gotoml "github.com/pelletier/go-toml/v2"
+
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 
 	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
 	evmconfig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
@@ -14,30 +20,105 @@ import (
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 )
 
-func NewEvmClient(cfg evmconfig.NodePool, chainCfg commonclient.ChainConfig, clientErrors evmconfig.ClientErrors, lggr logger.Logger, chainID *big.Int, nodes []*toml.Node, chainType chaintype.ChainType) Client {
+// NodesReloader is implemented by the Clients which can replace their nodes while running, like the ones returned by
+// NewEvmClient.
+type NodesReloader interface {
+	// ReloadNodes replaces the nodes of the client with the configured nodes. Nodes which config did not change keep
+	// running.
+	ReloadNodes(ctx context.Context, nodes []*toml.Node) error
+}
+
+func NewEvmClient(cfg evmconfig.NodePool, chainCfg commonclient.ChainConfig, clientErrors evmconfig.ClientErrors, lggr logger.Logger, chainID *big.Int, nodes []*toml.Node, chainType chaintype.ChainType, ds sqlutil.DataSource) Client {
+	b := &nodeBuilder{cfg: cfg, chainCfg: chainCfg, lggr: lggr, chainID: chainID, chainType: chainType}
+	primaries, sendonlys, built := b.build(nodes, nil)
+
+	c := NewChainClient(lggr, cfg.SelectionMode(), cfg.LeaseDuration(), chainCfg.NodeNoNewHeadsThreshold(),
+		primaries, sendonlys, chainID, chainType, clientErrors, cfg.DeathDeclarationDelay(), cfg.CallCacheExpiration(), ds).(*chainClient)
+	c.nodeBuilder, c.builtNodes = b, built
+	return c
+}
+
+// builtNode is either a primary or a sendonly node built by a nodeBuilder.
+type builtNode struct {
+	primary  commonclient.Node[*big.Int, *evmtypes.Head, RPCClient]
+	sendonly commonclient.SendOnlyNode[*big.Int, RPCClient]
+}
+
+// nodeBuilder builds the nodes of a chainClient from their config.
+type nodeBuilder struct {
+	cfg       evmconfig.NodePool
+	chainCfg  commonclient.ChainConfig
+	lggr      logger.Logger
+	chainID   *big.Int
+	chainType chaintype.ChainType
+	nextID    int32
+}
+
+// build returns the nodes configured by nodes, along with all of them by their config. The nodes of prev are reused
+// for the configs which did not change, the others are new, with IDs no other node built before has.
+func (b *nodeBuilder) build(nodes []*toml.Node, prev map[string]builtNode) (
+	primaries []commonclient.Node[*big.Int, *evmtypes.Head, RPCClient],
+	sendonlys []commonclient.SendOnlyNode[*big.Int, RPCClient],
+	built map[string]builtNode,
+) {
 	var empty url.URL
-	var primaries []commonclient.Node[*big.Int, *evmtypes.Head, RPCClient]
-	var sendonlys []commonclient.SendOnlyNode[*big.Int, RPCClient]
-	largePayloadRPCTimeout, defaultRPCTimeout := getRPCTimeouts(chainType)
-	for i, node := range nodes {
-		if node.SendOnly != nil && *node.SendOnly {
-			rpc := NewRPCClient(lggr, empty, (*url.URL)(node.HTTPURL), *node.Name, int32(i), chainID,
-				commonclient.Secondary, cfg.FinalizedBlockPollInterval(), cfg.NewHeadsPollInterval(), largePayloadRPCTimeout, defaultRPCTimeout, chainType)
-			sendonly := commonclient.NewSendOnlyNode(lggr, (url.URL)(*node.HTTPURL),
-				*node.Name, chainID, rpc)
-			sendonlys = append(sendonlys, sendonly)
+	largePayloadRPCTimeout, defaultRPCTimeout := getRPCTimeouts(b.chainType)
+	built = make(map[string]builtNode, len(nodes))
+	for _, node := range nodes {
+		key := nodeConfigKey(node)
+		n, ok := prev[key]
+		if !ok {
+			id := b.nextID
+			b.nextID++
+			if node.SendOnly != nil && *node.SendOnly {
+				rpc := NewRPCClient(b.lggr, empty, (*url.URL)(node.HTTPURL), *node.Name, id, b.chainID,
+					commonclient.Secondary, b.cfg.FinalizedBlockPollInterval(), b.cfg.NewHeadsPollInterval(), largePayloadRPCTimeout, defaultRPCTimeout, b.chainType, b.cfg.RateLimit(), b.cfg.SanityChecks())
+				n.sendonly = commonclient.NewSendOnlyNode(b.lggr, (url.URL)(*node.HTTPURL),
+					*node.Name, b.chainID, rpc)
+			} else {
+				rpc := NewRPCClient(b.lggr, (url.URL)(*node.WSURL), (*url.URL)(node.HTTPURL), *node.Name, id,
+					b.chainID, commonclient.Primary, b.cfg.FinalizedBlockPollInterval(), b.cfg.NewHeadsPollInterval(), largePayloadRPCTimeout, defaultRPCTimeout, b.chainType, b.cfg.RateLimit(), b.cfg.SanityChecks())
+				n.primary = commonclient.NewNode(b.cfg, b.chainCfg,
+					b.lggr, (url.URL)(*node.WSURL), (*url.URL)(node.HTTPURL), *node.Name, id, b.chainID, *node.Order,
+					rpc, "EVM")
+				if node.Archive != nil && *node.Archive {
+					n.primary = commonclient.NewArchiveNode(n.primary)
+				}
+			}
+		}
+		if n.sendonly != nil {
+			sendonlys = append(sendonlys, n.sendonly)
 		} else {
-			rpc := NewRPCClient(lggr, (url.URL)(*node.WSURL), (*url.URL)(node.HTTPURL), *node.Name, int32(i),
-				chainID, commonclient.Primary, cfg.FinalizedBlockPollInterval(), cfg.NewHeadsPollInterval(), largePayloadRPCTimeout, defaultRPCTimeout, chainType)
-			primaryNode := commonclient.NewNode(cfg, chainCfg,
-				lggr, (url.URL)(*node.WSURL), (*url.URL)(node.HTTPURL), *node.Name, int32(i), chainID, *node.Order,
-				rpc, "EVM")
-			primaries = append(primaries, primaryNode)
+			primaries = append(primaries, n.primary)
 		}
+		built[key] = n
+	}
+	return
+}
+
+// nodeConfigKey identifies the config of a node, which node names alone do not, as the URLs of a node may change.
+func nodeConfigKey(node *toml.Node) string {
+	b, err := gotoml.Marshal(node)
+	if err != nil {
+		// the node config was read from TOML in the first place
+		panic(fmt.Sprintf("failed to marshal node config: %v", err))
 	}
+	return string(b)
+}
 
-	return NewChainClient(lggr, cfg.SelectionMode(), cfg.LeaseDuration(), chainCfg.NodeNoNewHeadsThreshold(),
-		primaries, sendonlys, chainID, chainType, clientErrors, cfg.DeathDeclarationDelay())
+// ReloadNodes implements NodesReloader.
+func (c *chainClient) ReloadNodes(ctx context.Context, nodes []*toml.Node) error {
+	if c.nodeBuilder == nil {
+		return errors.New("the nodes of this client were not built from their config and cannot be reloaded")
+	}
+	c.nodesMu.Lock()
+	defer c.nodesMu.Unlock()
+	primaries, sendonlys, built := c.nodeBuilder.build(nodes, c.builtNodes)
+	if err := c.multiNode.SetNodes(ctx, primaries, sendonlys); err != nil {
+		return fmt.Errorf("failed to reload the nodes of chain %s: %w", c.ConfiguredChainID(), err)
+	}
+	c.builtNodes = built
+	return nil
 }
 
 func getRPCTimeouts(chainType chaintype.ChainType) (largePayload, defaultTimeout time.Duration) {
@@ -4,12 +4,18 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/maps"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
 
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	evmconfig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
 )
 
@@ -45,6 +51,42 @@ func TestNewEvmClient(t *testing.T) {
 		finalizedBlockPollInterval, newHeadsPollInterval)
 	require.NoError(t, err)
 
-	client := client.NewEvmClient(nodePool, chainCfg, nil, logger.Test(t), testutils.FixtureChainID, nodes, chaintype.ChainType(chainTypeStr))
+	client := client.NewEvmClient(nodePool, chainCfg, nil, logger.Test(t), testutils.FixtureChainID, nodes, chaintype.ChainType(chainTypeStr), nil)
 	require.NotNil(t, client)
 }
+
+func TestEvmClient_ReloadNodes(t *testing.T) {
+	t.Parallel()
+
+	// the nodes need not be reachable to be part of the pool
+	newNodeConfig := func(name string) client.NodeConfig {
+		return client.NodeConfig{Name: ptr(name), WSURL: ptr("ws://127.0.0.1:1/" + name), HTTPURL: ptr("http://127.0.0.1:1/" + name)}
+	}
+	foo, bar := newNodeConfig("foo"), newNodeConfig("bar")
+	newClientConfigs := func(nodeConfigs ...client.NodeConfig) (commonclient.ChainConfig, evmconfig.NodePool, []*toml.Node) {
+		chainCfg, nodePool, nodes, err := client.NewClientConfigs(ptr("HighestHead"), 0, "", nodeConfigs,
+			ptr(uint32(5)), 10*time.Second, ptr(uint32(5)), ptr(false), 3*time.Minute, ptr(uint32(10)),
+			ptr(true), ptr[uint32](16), ptr(true), 3*time.Second, 5*time.Second, 4*time.Second, 4*time.Second)
+		require.NoError(t, err)
+		return chainCfg, nodePool, nodes
+	}
+
+	chainCfg, nodePool, nodes := newClientConfigs(foo)
+	c := client.NewEvmClient(nodePool, chainCfg, nil, logger.Test(t), testutils.FixtureChainID, nodes, "", nil)
+	require.NoError(t, c.Dial(tests.Context(t)))
+	t.Cleanup(c.Close)
+	reloader, ok := c.(client.NodesReloader)
+	require.True(t, ok)
+
+	_, _, nodes = newClientConfigs(foo, bar)
+	require.NoError(t, reloader.ReloadNodes(tests.Context(t), nodes))
+	assert.ElementsMatch(t, []string{"foo", "bar"}, maps.Keys(c.NodeStates()))
+
+	_, _, nodes = newClientConfigs(bar)
+	require.NoError(t, reloader.ReloadNodes(tests.Context(t), nodes))
+	assert.ElementsMatch(t, []string{"bar"}, maps.Keys(c.NodeStates()))
+
+	err := reloader.ReloadNodes(tests.Context(t), nil)
+	require.ErrorContains(t, err, "no available nodes for chain")
+	assert.ElementsMatch(t, []string{"bar"}, maps.Keys(c.NodeStates()))
+}
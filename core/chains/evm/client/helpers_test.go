@@ -35,6 +35,7 @@ type TestClientErrors struct {
 	fatal                             string
 	serviceUnavailable                string
 	tooManyResults                    string
+	provider                          string
 }
 
 func NewTestClientErrors() TestClientErrors {
@@ -54,6 +55,7 @@ func NewTestClientErrors() TestClientErrors {
 		fatal:                             "client error fatal",
 		serviceUnavailable:                "client error service unavailable",
 		tooManyResults:                    "client error too many results",
+		provider:                          "test",
 	}
 }
 
@@ -80,6 +82,7 @@ func (c *TestClientErrors) TransactionAlreadyMined() string { return c.transacti
 func (c *TestClientErrors) Fatal() string                   { return c.fatal }
 func (c *TestClientErrors) ServiceUnavailable() string      { return c.serviceUnavailable }
 func (c *TestClientErrors) TooManyResults() string          { return c.serviceUnavailable }
+func (c *TestClientErrors) Provider() string                { return c.provider }
 
 type TestNodePoolConfig struct {
 	NodePollFailureThreshold       uint32
@@ -93,6 +96,9 @@ type TestNodePoolConfig struct {
 	EnforceRepeatableReadVal       bool
 	NodeDeathDeclarationDelay      time.Duration
 	NodeNewHeadsPollInterval       time.Duration
+	NodeCallCacheExpiration        time.Duration
+	NodeRateLimit                  config.RPCRateLimit
+	NodeSanityChecks               config.RPCSanityChecks
 }
 
 func (tc TestNodePoolConfig) PollFailureThreshold() uint32 { return tc.NodePollFailureThreshold }
@@ -115,6 +121,10 @@ func (tc TestNodePoolConfig) NewHeadsPollInterval() time.Duration {
 	return tc.NodeNewHeadsPollInterval
 }
 
+func (tc TestNodePoolConfig) CallCacheExpiration() time.Duration {
+	return tc.NodeCallCacheExpiration
+}
+
 func (tc TestNodePoolConfig) Errors() config.ClientErrors {
 	return tc.NodeErrors
 }
@@ -127,6 +137,14 @@ func (tc TestNodePoolConfig) DeathDeclarationDelay() time.Duration {
 	return tc.NodeDeathDeclarationDelay
 }
 
+func (tc TestNodePoolConfig) RateLimit() config.RPCRateLimit {
+	return tc.NodeRateLimit
+}
+
+func (tc TestNodePoolConfig) SanityChecks() config.RPCSanityChecks {
+	return tc.NodeSanityChecks
+}
+
 func NewChainClientWithTestNode(
 	t *testing.T,
 	nodeCfg commonclient.NodeConfig,
@@ -148,7 +166,7 @@ func NewChainClientWithTestNode(
 	}
 
 	lggr := logger.Test(t)
-	rpc := NewRPCClient(lggr, *parsed, rpcHTTPURL, "eth-primary-rpc-0", id, chainID, commonclient.Primary, 0, 0, commonclient.QueryTimeout, commonclient.QueryTimeout, "")
+	rpc := NewRPCClient(lggr, *parsed, rpcHTTPURL, "eth-primary-rpc-0", id, chainID, commonclient.Primary, 0, 0, commonclient.QueryTimeout, commonclient.QueryTimeout, "", nil, nil)
 
 	n := commonclient.NewNode[*big.Int, *evmtypes.Head, RPCClient](
 		nodeCfg, clientMocks.ChainConfig{NoNewHeadsThresholdVal: noNewHeadsThreshold}, lggr, *parsed, rpcHTTPURL, "eth-primary-node-0", id, chainID, 1, rpc, "EVM")
@@ -160,7 +178,7 @@ func NewChainClientWithTestNode(
 			return nil, pkgerrors.Errorf("sendonly ethereum rpc url scheme must be http(s): %s", u.String())
 		}
 		var empty url.URL
-		rpc := NewRPCClient(lggr, empty, &sendonlyRPCURLs[i], fmt.Sprintf("eth-sendonly-rpc-%d", i), id, chainID, commonclient.Secondary, 0, 0, commonclient.QueryTimeout, commonclient.QueryTimeout, "")
+		rpc := NewRPCClient(lggr, empty, &sendonlyRPCURLs[i], fmt.Sprintf("eth-sendonly-rpc-%d", i), id, chainID, commonclient.Secondary, 0, 0, commonclient.QueryTimeout, commonclient.QueryTimeout, "", nil, nil)
 		s := commonclient.NewSendOnlyNode[*big.Int, RPCClient](
 			lggr, u, fmt.Sprintf("eth-sendonly-%d", i), chainID, rpc)
 		sendonlys = append(sendonlys, s)
@@ -168,7 +186,7 @@ func NewChainClientWithTestNode(
 
 	var chainType chaintype.ChainType
 	clientErrors := NewTestClientErrors()
-	c := NewChainClient(lggr, nodeCfg.SelectionMode(), leaseDuration, noNewHeadsThreshold, primaries, sendonlys, chainID, chainType, &clientErrors, 0)
+	c := NewChainClient(lggr, nodeCfg.SelectionMode(), leaseDuration, noNewHeadsThreshold, primaries, sendonlys, chainID, chainType, &clientErrors, 0, 0, nil)
 	t.Cleanup(c.Close)
 	return c, nil
 }
@@ -183,11 +201,15 @@ func NewChainClientWithEmptyNode(
 	lggr := logger.Test(t)
 
 	var chainType chaintype.ChainType
-	c := NewChainClient(lggr, selectionMode, leaseDuration, noNewHeadsThreshold, nil, nil, chainID, chainType, nil, 0)
+	c := NewChainClient(lggr, selectionMode, leaseDuration, noNewHeadsThreshold, nil, nil, chainID, chainType, nil, 0, 0, nil)
 	t.Cleanup(c.Close)
 	return c
 }
 
+func DecodeRevertError(err error) error {
+	return decodeRevertError(err)
+}
+
 func NewChainClientWithMockedRpc(
 	t *testing.T,
 	selectionMode string,
@@ -209,7 +231,7 @@ func NewChainClientWithMockedRpc(
 		cfg, clientMocks.ChainConfig{NoNewHeadsThresholdVal: noNewHeadsThreshold}, lggr, *parsed, nil, "eth-primary-node-0", 1, chainID, 1, rpc, "EVM")
 	primaries := []commonclient.Node[*big.Int, *evmtypes.Head, RPCClient]{n}
 	clientErrors := NewTestClientErrors()
-	c := NewChainClient(lggr, selectionMode, leaseDuration, noNewHeadsThreshold, primaries, nil, chainID, chainType, &clientErrors, 0)
+	c := NewChainClient(lggr, selectionMode, leaseDuration, noNewHeadsThreshold, primaries, nil, chainID, chainType, &clientErrors, 0, 0, nil)
 	t.Cleanup(c.Close)
 	return c
 }
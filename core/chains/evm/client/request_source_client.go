@@ -0,0 +1,104 @@
+package client
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+// NewRequestSourceClient returns c, attributing the reads made through it to source, so that they are weighted
+// accordingly by the RPC rate limiter. Reads already attributed to another source keep it.
+func NewRequestSourceClient(c Client, source commonclient.RequestSource) Client {
+	return &requestSourceClient{Client: c, source: source}
+}
+
+type requestSourceClient struct {
+	Client
+	source commonclient.RequestSource
+}
+
+func (c *requestSourceClient) withSource(ctx context.Context) context.Context {
+	if _, ok := commonclient.CtxRequestSource(ctx); ok {
+		return ctx
+	}
+	return commonclient.CtxWithRequestSource(ctx, c.source)
+}
+
+func (c *requestSourceClient) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	return c.Client.BalanceAt(c.withSource(ctx), account, blockNumber)
+}
+
+func (c *requestSourceClient) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	return c.Client.CallContext(c.withSource(ctx), result, method, args...)
+}
+
+func (c *requestSourceClient) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	return c.Client.BatchCallContext(c.withSource(ctx), b)
+}
+
+func (c *requestSourceClient) HeadByNumber(ctx context.Context, n *big.Int) (*evmtypes.Head, error) {
+	return c.Client.HeadByNumber(c.withSource(ctx), n)
+}
+
+func (c *requestSourceClient) HeadByHash(ctx context.Context, h common.Hash) (*evmtypes.Head, error) {
+	return c.Client.HeadByHash(c.withSource(ctx), h)
+}
+
+func (c *requestSourceClient) LatestFinalizedBlock(ctx context.Context) (*evmtypes.Head, error) {
+	return c.Client.LatestFinalizedBlock(c.withSource(ctx))
+}
+
+func (c *requestSourceClient) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	return c.Client.CodeAt(c.withSource(ctx), account, blockNumber)
+}
+
+func (c *requestSourceClient) TransactionByHash(ctx context.Context, txHash common.Hash) (*types.Transaction, error) {
+	return c.Client.TransactionByHash(c.withSource(ctx), txHash)
+}
+
+func (c *requestSourceClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	return c.Client.TransactionReceipt(c.withSource(ctx), txHash)
+}
+
+func (c *requestSourceClient) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	return c.Client.BlockByNumber(c.withSource(ctx), number)
+}
+
+func (c *requestSourceClient) BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error) {
+	return c.Client.BlockByHash(c.withSource(ctx), hash)
+}
+
+func (c *requestSourceClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	return c.Client.FilterLogs(c.withSource(ctx), q)
+}
+
+func (c *requestSourceClient) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	return c.Client.EstimateGas(c.withSource(ctx), call)
+}
+
+func (c *requestSourceClient) LatestBlockHeight(ctx context.Context) (*big.Int, error) {
+	return c.Client.LatestBlockHeight(c.withSource(ctx))
+}
+
+func (c *requestSourceClient) HeaderByNumber(ctx context.Context, n *big.Int) (*types.Header, error) {
+	return c.Client.HeaderByNumber(c.withSource(ctx), n)
+}
+
+func (c *requestSourceClient) HeaderByHash(ctx context.Context, h common.Hash) (*types.Header, error) {
+	return c.Client.HeaderByHash(c.withSource(ctx), h)
+}
+
+func (c *requestSourceClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return c.Client.CallContract(c.withSource(ctx), msg, blockNumber)
+}
+
+func (c *requestSourceClient) PendingCallContract(ctx context.Context, msg ethereum.CallMsg) ([]byte, error) {
+	return c.Client.PendingCallContract(c.withSource(ctx), msg)
+}
@@ -0,0 +1,48 @@
+package client_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+)
+
+func TestRequestSourceClient(t *testing.T) {
+	ctx := tests.Context(t)
+	requestSource := func(ctx context.Context) commonclient.RequestSource {
+		source, _ := commonclient.CtxRequestSource(ctx)
+		return source
+	}
+
+	t.Run("attributes the requests to the source", func(t *testing.T) {
+		c := mocks.NewClient(t)
+		c.On("CallContract", mock.MatchedBy(func(ctx context.Context) bool {
+			return requestSource(ctx) == commonclient.RequestSourceCCIP
+		}), mock.Anything, mock.Anything).Return([]byte{1}, nil).Once()
+
+		b, err := client.NewRequestSourceClient(c, commonclient.RequestSourceCCIP).CallContract(ctx, ethereum.CallMsg{}, big.NewInt(1))
+		require.NoError(t, err)
+		assert.Equal(t, []byte{1}, b)
+	})
+
+	t.Run("keeps the source of requests already attributed", func(t *testing.T) {
+		c := mocks.NewClient(t)
+		c.On("CallContract", mock.MatchedBy(func(ctx context.Context) bool {
+			return requestSource(ctx) == commonclient.RequestSourceLogPoller
+		}), mock.Anything, mock.Anything).Return([]byte{1}, nil).Once()
+
+		lpCtx := commonclient.CtxWithRequestSource(ctx, commonclient.RequestSourceLogPoller)
+		_, err := client.NewRequestSourceClient(c, commonclient.RequestSourceCCIP).CallContract(lpCtx, ethereum.CallMsg{}, nil)
+		require.NoError(t, err)
+	})
+}
@@ -29,10 +29,12 @@ import (
 	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
 	commontypes "github.com/smartcontractkit/chainlink/v2/common/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmconfig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/chaintype"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
 	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/utils/deadlinebudget"
 )
 
 var (
@@ -125,6 +127,8 @@ type rpcClient struct {
 	finalizedBlockPollInterval time.Duration
 	newHeadsPollInterval       time.Duration
 	chainType                  chaintype.ChainType
+	rateLimiter                *rpcRateLimiter
+	sanity                     *rpcSanityChecker
 
 	ws   rawclient
 	http *rawclient
@@ -165,11 +169,14 @@ func NewRPCClient(
 	largePayloadRpcTimeout time.Duration,
 	rpcTimeout time.Duration,
 	chainType chaintype.ChainType,
+	rateLimit evmconfig.RPCRateLimit,
+	sanityChecks evmconfig.RPCSanityChecks,
 ) RPCClient {
 	r := &rpcClient{
 		largePayloadRpcTimeout: largePayloadRpcTimeout,
 		rpcTimeout:             rpcTimeout,
 		chainType:              chainType,
+		rateLimiter:            newRPCRateLimiter(rateLimit, chainID, name),
 	}
 	r.name = name
 	r.id = id
@@ -190,6 +197,10 @@ func NewRPCClient(
 		"evmChainID", chainID,
 	)
 	r.rpcLog = logger.Sugared(lggr).Named("RPC")
+	r.sanity = newRPCSanityChecker(sanityChecks, r.rpcLog, chainID, name, func() {
+		// disconnecting waits for the subscriptions to stop, which may be the caller
+		go r.DisconnectAll()
+	})
 
 	return r
 }
@@ -206,6 +217,11 @@ func (r *rpcClient) Dial(callerCtx context.Context) error {
 	}
 	lggr.Debugw("RPC dial: evmclient.Client#dial")
 
+	if err := r.sanity.quarantined(); err != nil {
+		promEVMPoolRPCNodeDialsFailed.WithLabelValues(r.chainID.String(), r.name).Inc()
+		return r.wrapRPCClientError(err)
+	}
+
 	wsrpc, err := rpc.DialWebsocket(ctx, r.ws.uri.String(), "")
 	if err != nil {
 		promEVMPoolRPCNodeDialsFailed.WithLabelValues(r.chainID.String(), r.name).Inc()
@@ -278,6 +294,7 @@ func (r *rpcClient) String() string {
 }
 
 func (r *rpcClient) logResult(
+	ctx context.Context,
 	lggr logger.Logger,
 	err error,
 	callDuration time.Duration,
@@ -286,6 +303,7 @@ func (r *rpcClient) logResult(
 	results ...interface{},
 ) {
 	lggr = logger.With(lggr, "duration", callDuration, "rpcDomain", rpcDomain, "callName", callName)
+	deadlinebudget.Spend(ctx, deadlinebudget.RPC, callDuration)
 	promEVMPoolRPCNodeCalls.WithLabelValues(r.chainID.String(), r.name).Inc()
 	if err == nil {
 		promEVMPoolRPCNodeCallsSuccess.WithLabelValues(r.chainID.String(), r.name).Inc()
@@ -408,7 +426,7 @@ func (r *rpcClient) CallContext(ctx context.Context, result interface{}, method
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "CallContext")
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "CallContext")
 
 	return err
 }
@@ -448,7 +466,7 @@ func (r *rpcClient) BatchCallContext(rootCtx context.Context, b []rpc.BatchElem)
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "BatchCallContext")
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "BatchCallContext")
 	if err != nil {
 		return err
 	}
@@ -533,11 +551,14 @@ func (r *rpcClient) SubscribeNewHead(ctx context.Context, channel chan<- *evmtyp
 	start := time.Now()
 	defer func() {
 		duration := time.Since(start)
-		r.logResult(lggr, err, duration, r.getRPCDomain(), "EthSubscribe")
+		r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "EthSubscribe")
 		err = r.wrapWS(err)
 	}()
 	subForwarder := newSubForwarder(channel, func(head *evmtypes.Head) *evmtypes.Head {
 		head.EVMChainID = ubig.New(r.chainID)
+		if !r.sanity.checkHead(head) {
+			return nil
+		}
 		r.onNewHead(ctx, chStopInFlight, head)
 		return head
 	}, r.wrapRPCClientError)
@@ -583,13 +604,16 @@ func (r *rpcClient) SubscribeToHeads(ctx context.Context) (ch <-chan *evmtypes.H
 	lggr.Debug("RPC call: evmclient.Client#EthSubscribe")
 	defer func() {
 		duration := time.Since(start)
-		r.logResult(lggr, err, duration, r.getRPCDomain(), "EthSubscribe")
+		r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "EthSubscribe")
 		err = r.wrapWS(err)
 	}()
 
 	channel := make(chan *evmtypes.Head)
 	forwarder := newSubForwarder(channel, func(head *evmtypes.Head) *evmtypes.Head {
 		head.EVMChainID = ubig.New(r.chainID)
+		if !r.sanity.checkHead(head) {
+			return nil
+		}
 		r.onNewHead(ctx, chStopInFlight, head)
 		return head
 	}, r.wrapRPCClientError)
@@ -659,7 +683,7 @@ func (r *rpcClient) TransactionReceiptGeth(ctx context.Context, txHash common.Ha
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "TransactionReceipt",
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "TransactionReceipt",
 		"receipt", receipt,
 	)
 
@@ -683,7 +707,7 @@ func (r *rpcClient) TransactionByHash(ctx context.Context, txHash common.Hash) (
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "TransactionByHash",
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "TransactionByHash",
 		"receipt", tx,
 	)
 
@@ -706,7 +730,7 @@ func (r *rpcClient) HeaderByNumber(ctx context.Context, number *big.Int) (header
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "HeaderByNumber", "header", header)
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "HeaderByNumber", "header", header)
 
 	return
 }
@@ -727,7 +751,7 @@ func (r *rpcClient) HeaderByHash(ctx context.Context, hash common.Hash) (header
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "HeaderByHash",
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "HeaderByHash",
 		"header", header,
 	)
 
@@ -808,6 +832,9 @@ func (r *rpcClient) BlockByNumber(ctx context.Context, number *big.Int) (head *e
 	head.EVMChainID = ubig.New(r.chainID)
 
 	if hexNumber == rpc.LatestBlockNumber.String() {
+		if !r.sanity.checkHead(head) {
+			return nil, r.wrapRPCClientError(errImplausibleResponse(sanityCheckBlockNumber))
+		}
 		r.onNewHead(ctx, chStopInFlight, head)
 	}
 
@@ -833,7 +860,7 @@ func (r *rpcClient) ethGetBlockByNumber(ctx context.Context, number string, resu
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "CallContext")
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "CallContext")
 	return err
 }
 
@@ -866,7 +893,7 @@ func (r *rpcClient) BlockByHashGeth(ctx context.Context, hash common.Hash) (bloc
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "BlockByHash",
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "BlockByHash",
 		"block", block,
 	)
 
@@ -889,7 +916,7 @@ func (r *rpcClient) BlockByNumberGeth(ctx context.Context, number *big.Int) (blo
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "BlockByNumber",
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "BlockByNumber",
 		"block", block,
 	)
 
@@ -911,7 +938,7 @@ func (r *rpcClient) SendTransaction(ctx context.Context, tx *types.Transaction)
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "SendTransaction")
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "SendTransaction")
 
 	return err
 }
@@ -953,7 +980,7 @@ func (r *rpcClient) PendingSequenceAt(ctx context.Context, account common.Addres
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "PendingNonceAt",
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "PendingNonceAt",
 		"nonce", nonce,
 	)
 
@@ -982,7 +1009,7 @@ func (r *rpcClient) SequenceAt(ctx context.Context, account common.Address, bloc
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "NonceAt",
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "NonceAt",
 		"nonce", nonce,
 	)
 
@@ -1005,7 +1032,7 @@ func (r *rpcClient) PendingCodeAt(ctx context.Context, account common.Address) (
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "PendingCodeAt",
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "PendingCodeAt",
 		"code", code,
 	)
 
@@ -1028,7 +1055,7 @@ func (r *rpcClient) CodeAt(ctx context.Context, account common.Address, blockNum
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "CodeAt",
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "CodeAt",
 		"code", code,
 	)
 
@@ -1052,7 +1079,7 @@ func (r *rpcClient) EstimateGas(ctx context.Context, c interface{}) (gas uint64,
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "EstimateGas",
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "EstimateGas",
 		"gas", gas,
 	)
 
@@ -1075,9 +1102,12 @@ func (r *rpcClient) SuggestGasPrice(ctx context.Context) (price *big.Int, err er
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "SuggestGasPrice",
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "SuggestGasPrice",
 		"price", price,
 	)
+	if err == nil && !r.sanity.checkGasPrice(price) {
+		return nil, r.wrapRPCClientError(errImplausibleResponse(sanityCheckGasPrice))
+	}
 
 	return
 }
@@ -1103,7 +1133,7 @@ func (r *rpcClient) CallContract(ctx context.Context, msg interface{}, blockNumb
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "CallContract",
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "CallContract",
 		"val", val,
 	)
 
@@ -1131,7 +1161,7 @@ func (r *rpcClient) PendingCallContract(ctx context.Context, msg interface{}) (v
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "PendingCallContract",
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "PendingCallContract",
 		"val", val,
 	)
 
@@ -1160,7 +1190,7 @@ func (r *rpcClient) BlockNumber(ctx context.Context) (height uint64, err error)
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "BlockNumber",
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "BlockNumber",
 		"height", height,
 	)
 
@@ -1183,9 +1213,12 @@ func (r *rpcClient) BalanceAt(ctx context.Context, account common.Address, block
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "BalanceAt",
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "BalanceAt",
 		"balance", balance,
 	)
+	if err == nil && !r.sanity.checkBalance(balance) {
+		return nil, r.wrapRPCClientError(errImplausibleResponse(sanityCheckBalance))
+	}
 
 	return
 }
@@ -1206,7 +1239,7 @@ func (r *rpcClient) FeeHistory(ctx context.Context, blockCount uint64, rewardPer
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "FeeHistory",
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "FeeHistory",
 		"feeHistory", feeHistory,
 	)
 
@@ -1239,6 +1272,9 @@ func (r *rpcClient) TokenBalance(ctx context.Context, address common.Address, co
 	if _, ok := numLinkBigInt.SetString(result, 0); !ok {
 		return nil, r.wrapRPCClientError(fmt.Errorf("failed to parse int: %s", result))
 	}
+	if !r.sanity.checkBalance(numLinkBigInt) {
+		return nil, r.wrapRPCClientError(errImplausibleResponse(sanityCheckBalance))
+	}
 	return numLinkBigInt, nil
 }
 
@@ -1271,7 +1307,7 @@ func (r *rpcClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) (l [
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "FilterLogs",
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "FilterLogs",
 		"log", l,
 	)
 
@@ -1292,7 +1328,7 @@ func (r *rpcClient) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQu
 	start := time.Now()
 	defer func() {
 		duration := time.Since(start)
-		r.logResult(lggr, err, duration, r.getRPCDomain(), "SubscribeFilterLogs")
+		r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "SubscribeFilterLogs")
 		err = r.wrapWS(err)
 	}()
 	sub := newSubForwarder(ch, nil, r.wrapRPCClientError)
@@ -1325,9 +1361,12 @@ func (r *rpcClient) SuggestGasTipCap(ctx context.Context) (tipCap *big.Int, err
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "SuggestGasTipCap",
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "SuggestGasTipCap",
 		"tipCap", tipCap,
 	)
+	if err == nil && !r.sanity.checkTipCap(tipCap) {
+		return nil, r.wrapRPCClientError(errImplausibleResponse(sanityCheckTipCap))
+	}
 
 	return
 }
@@ -1346,6 +1385,9 @@ func (r *rpcClient) ChainID(ctx context.Context) (chainID *big.Int, err error) {
 		chainID, err = ws.geth.ChainID(ctx)
 		err = r.wrapWS(err)
 	}
+	if err == nil && !r.sanity.checkChainID(chainID) {
+		return nil, r.wrapRPCClientError(errImplausibleResponse(sanityCheckChainID))
+	}
 	return
 }
 
@@ -1397,6 +1439,9 @@ func (r *rpcClient) makeLiveQueryCtxAndSafeGetClients(parentCtx context.Context,
 
 func (r *rpcClient) acquireQueryCtx(parentCtx context.Context, timeout time.Duration) (ctx context.Context, cancel context.CancelFunc,
 	chStopInFlight chan struct{}, ws rawclient, http *rawclient) {
+	// wait for the rate limit first, so that it does not eat into the request timeout
+	r.rateLimiter.wait(parentCtx)
+
 	// Need to wrap in mutex because state transition can cancel and replace the
 	// context
 	r.stateMu.RLock()
@@ -1448,7 +1493,7 @@ func (r *rpcClient) IsSyncing(ctx context.Context) (bool, error) {
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "BlockNumber",
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "BlockNumber",
 		"syncProgress", syncProgress,
 	)
 
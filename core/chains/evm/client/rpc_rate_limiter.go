@@ -0,0 +1,77 @@
+package client
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/time/rate"
+
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
+	evmconfig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
+)
+
+var promEVMPoolRPCNodeCallsRateLimited = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "evm_pool_rpc_node_calls_rate_limited",
+	Help: "The total number of RPC calls delayed by the rate limit of the given RPC node",
+}, []string{"evmChainID", "nodeName", "source"})
+
+// rpcRateLimiter is the token bucket of a single RPC endpoint. A nil *rpcRateLimiter does not limit anything.
+type rpcRateLimiter struct {
+	limiter  *rate.Limiter
+	weights  map[commonclient.RequestSource]int
+	chainID  string
+	nodeName string
+}
+
+func newRPCRateLimiter(cfg evmconfig.RPCRateLimit, chainID *big.Int, nodeName string) *rpcRateLimiter {
+	if cfg == nil || cfg.Rate() == 0 {
+		return nil
+	}
+	return &rpcRateLimiter{
+		limiter: rate.NewLimiter(rate.Limit(cfg.Rate()), int(cfg.Burst())),
+		weights: map[commonclient.RequestSource]int{
+			commonclient.RequestSourceLogPoller:    int(cfg.LogPollerWeight()),
+			commonclient.RequestSourceTxm:          int(cfg.TxmWeight()),
+			commonclient.RequestSourceCCIP:         int(cfg.CCIPWeight()),
+			commonclient.RequestSourceGasEstimator: int(cfg.GasEstimatorWeight()),
+		},
+		chainID:  chainID.String(),
+		nodeName: nodeName,
+	}
+}
+
+// wait blocks until the request made with ctx fits in the rate limit, or ctx is done. In the latter case the request
+// is let through, as it fails right away on its own. Health checks are exempt, so that a busy node is not declared
+// unreachable because its own checks are throttled.
+func (l *rpcRateLimiter) wait(ctx context.Context) {
+	if l == nil || commonclient.CtxIsHeathCheckRequest(ctx) {
+		return
+	}
+	n := 1
+	source, ok := commonclient.CtxRequestSource(ctx)
+	if ok {
+		if w, found := l.weights[source]; found {
+			n = w
+		}
+	}
+	// validation keeps weights within the burst, this only guards against requests which could never be reserved
+	n = min(n, l.limiter.Burst())
+
+	reservation := l.limiter.ReserveN(time.Now(), n)
+	delay := reservation.Delay()
+	if delay == 0 {
+		return
+	}
+	promEVMPoolRPCNodeCallsRateLimited.WithLabelValues(l.chainID, l.nodeName, string(source)).Inc()
+
+	t := time.NewTimer(delay)
+	defer t.Stop()
+	select {
+	case <-t.C:
+	case <-ctx.Done():
+		reservation.Cancel()
+	}
+}
@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
+	evmconfig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+)
+
+func newTestRPCRateLimit(rate, burst, logPollerWeight, txmWeight uint32) evmconfig.RPCRateLimit {
+	one := uint32(1)
+	cfg := &evmconfig.NodePoolConfig{C: toml.NodePool{RateLimit: toml.RPCRateLimit{
+		Rate:               &rate,
+		Burst:              &burst,
+		LogPollerWeight:    &logPollerWeight,
+		TxmWeight:          &txmWeight,
+		CCIPWeight:         &one,
+		GasEstimatorWeight: &one,
+	}}}
+	return cfg.RateLimit()
+}
+
+func TestRPCRateLimiter(t *testing.T) {
+	chainID := big.NewInt(1)
+
+	t.Run("disabled", func(t *testing.T) {
+		require.Nil(t, newRPCRateLimiter(nil, chainID, "rpc"))
+		l := newRPCRateLimiter(newTestRPCRateLimit(0, 10, 1, 1), chainID, "rpc")
+		require.Nil(t, l)
+		l.wait(tests.Context(t))
+	})
+
+	t.Run("requests consume the weight of their source", func(t *testing.T) {
+		ctx := tests.Context(t)
+		l := newRPCRateLimiter(newTestRPCRateLimit(1, 3, 2, 0), chainID, "rpc")
+		require.NotNil(t, l)
+
+		l.wait(commonclient.CtxWithRequestSource(ctx, commonclient.RequestSourceLogPoller))
+		assert.InDelta(t, 1, l.limiter.Tokens(), 0.1)
+
+		l.wait(ctx)
+		assert.InDelta(t, 0, l.limiter.Tokens(), 0.1)
+
+		start := time.Now()
+		l.wait(commonclient.CtxWithRequestSource(ctx, commonclient.RequestSourceTxm))
+		assert.Less(t, time.Since(start), 100*time.Millisecond, "expected exempt source not to wait")
+	})
+
+	t.Run("health checks are exempt", func(t *testing.T) {
+		l := newRPCRateLimiter(newTestRPCRateLimit(1, 1, 1, 1), chainID, "rpc")
+		require.NotNil(t, l)
+		ctx := tests.Context(t)
+		l.wait(ctx)
+		assert.InDelta(t, 0, l.limiter.Tokens(), 0.1)
+
+		start := time.Now()
+		l.wait(commonclient.CtxAddHealthCheckFlag(ctx))
+		assert.Less(t, time.Since(start), 100*time.Millisecond, "expected health check not to wait")
+		assert.InDelta(t, 0, l.limiter.Tokens(), 0.1)
+	})
+
+	t.Run("waiting stops with the context", func(t *testing.T) {
+		l := newRPCRateLimiter(newTestRPCRateLimit(1, 2, 2, 1), chainID, "rpc")
+		require.NotNil(t, l)
+		ctx := commonclient.CtxWithRequestSource(tests.Context(t), commonclient.RequestSourceLogPoller)
+		l.wait(ctx)
+
+		ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+		start := time.Now()
+		l.wait(ctx)
+		assert.Less(t, time.Since(start), time.Second)
+	})
+}
@@ -0,0 +1,159 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmconfig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+var promEVMPoolRPCNodeSanityViolations = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "evm_pool_rpc_node_sanity_violations",
+	Help: "The total number of implausible responses returned by the given RPC node, by failed check",
+}, []string{"evmChainID", "nodeName", "check"})
+
+// ErrRPCQuarantined is returned by the RPC endpoints quarantined after an implausible response.
+var ErrRPCQuarantined = errors.New("RPC endpoint is quarantined after an implausible response")
+
+const (
+	sanityCheckBlockNumber = "blockNumber"
+	sanityCheckChainID     = "chainID"
+	sanityCheckGasPrice    = "gasPrice"
+	sanityCheckTipCap      = "tipCap"
+	sanityCheckBalance     = "balance"
+)
+
+// rpcSanityChecker validates the responses of a single RPC endpoint. On an implausible response, the endpoint is
+// quarantined: onViolation is called to disconnect it, and it refuses to be dialed until the quarantine is over.
+// A nil *rpcSanityChecker accepts every response.
+type rpcSanityChecker struct {
+	cfg         evmconfig.RPCSanityChecks
+	lggr        logger.SugaredLogger
+	chainID     *big.Int
+	nodeName    string
+	onViolation func()
+
+	mu               sync.Mutex
+	highest          int64
+	quarantinedUntil time.Time
+}
+
+func newRPCSanityChecker(cfg evmconfig.RPCSanityChecks, lggr logger.SugaredLogger, chainID *big.Int, nodeName string, onViolation func()) *rpcSanityChecker {
+	if cfg == nil || !cfg.Enabled() {
+		return nil
+	}
+	return &rpcSanityChecker{
+		cfg:         cfg,
+		lggr:        lggr,
+		chainID:     chainID,
+		nodeName:    nodeName,
+		onViolation: onViolation,
+	}
+}
+
+// quarantined returns an error wrapping ErrRPCQuarantined if the endpoint is quarantined.
+func (s *rpcSanityChecker) quarantined() error {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if until := s.quarantinedUntil; time.Now().Before(until) {
+		return fmt.Errorf("%w until %s", ErrRPCQuarantined, until.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// checkHead returns false if the head goes back by more than MaxBlockNumberRegression blocks from the highest head
+// of the endpoint.
+func (s *rpcSanityChecker) checkHead(head *evmtypes.Head) bool {
+	if s == nil || head == nil {
+		return true
+	}
+	s.mu.Lock()
+	highest := s.highest
+	if head.Number < 0 || head.Number+int64(s.cfg.MaxBlockNumberRegression()) < highest {
+		s.mu.Unlock()
+		s.violation(sanityCheckBlockNumber, "head", head.Number, "highestHead", highest)
+		return false
+	}
+	s.highest = max(highest, head.Number)
+	s.mu.Unlock()
+	return true
+}
+
+// checkChainID returns false if the endpoint reports a different chain ID than the one it is configured for.
+func (s *rpcSanityChecker) checkChainID(chainID *big.Int) bool {
+	if s == nil || chainID == nil || chainID.Cmp(s.chainID) == 0 {
+		return true
+	}
+	s.violation(sanityCheckChainID, "chainID", chainID)
+	return false
+}
+
+// checkGasPrice returns false if the gas price is outside the [GasPriceMin, GasPriceMax] band.
+func (s *rpcSanityChecker) checkGasPrice(price *big.Int) bool {
+	if s == nil || price == nil {
+		return true
+	}
+	wei := assets.NewWei(price)
+	if (s.cfg.GasPriceMin() != nil && wei.Cmp(s.cfg.GasPriceMin()) < 0) || (s.cfg.GasPriceMax() != nil && wei.Cmp(s.cfg.GasPriceMax()) > 0) {
+		s.violation(sanityCheckGasPrice, "gasPrice", wei, "gasPriceMin", s.cfg.GasPriceMin(), "gasPriceMax", s.cfg.GasPriceMax())
+		return false
+	}
+	return true
+}
+
+// checkTipCap returns false if the tip cap is negative or above GasPriceMax. Tip caps may be lower than GasPriceMin.
+func (s *rpcSanityChecker) checkTipCap(tipCap *big.Int) bool {
+	if s == nil || tipCap == nil {
+		return true
+	}
+	wei := assets.NewWei(tipCap)
+	if tipCap.Sign() < 0 || (s.cfg.GasPriceMax() != nil && wei.Cmp(s.cfg.GasPriceMax()) > 0) {
+		s.violation(sanityCheckTipCap, "tipCap", wei, "gasPriceMax", s.cfg.GasPriceMax())
+		return false
+	}
+	return true
+}
+
+// checkBalance returns false if the balance is negative.
+func (s *rpcSanityChecker) checkBalance(balance *big.Int) bool {
+	if s == nil || balance == nil || balance.Sign() >= 0 {
+		return true
+	}
+	s.violation(sanityCheckBalance, "balance", balance)
+	return false
+}
+
+func (s *rpcSanityChecker) violation(check string, keysAndValues ...any) {
+	promEVMPoolRPCNodeSanityViolations.WithLabelValues(s.chainID.String(), s.nodeName, check).Inc()
+
+	s.mu.Lock()
+	s.quarantinedUntil = time.Now().Add(s.cfg.QuarantineDuration())
+	// the endpoint may be back on another backend after the quarantine, so its former heads are not relevant anymore
+	s.highest = 0
+	until := s.quarantinedUntil
+	s.mu.Unlock()
+
+	s.lggr.Criticalw(fmt.Sprintf("RPC endpoint returned an implausible %s, quarantining it", check),
+		append([]any{"check", check, "quarantinedUntil", until}, keysAndValues...)...)
+	if s.onViolation != nil {
+		s.onViolation()
+	}
+}
+
+// errImplausibleResponse is returned instead of a response failing the check.
+func errImplausibleResponse(check string) error {
+	return fmt.Errorf("%w: implausible %s", ErrRPCQuarantined, check)
+}
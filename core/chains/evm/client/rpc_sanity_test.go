@@ -0,0 +1,96 @@
+package client
+
+import (
+	"math/big"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmconfig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+func newTestRPCSanityChecks(enabled bool, maxRegression uint32, quarantine time.Duration) evmconfig.RPCSanityChecks {
+	cfg := &evmconfig.NodePoolConfig{C: toml.NodePool{SanityChecks: toml.RPCSanityChecks{
+		Enabled:                  &enabled,
+		MaxBlockNumberRegression: &maxRegression,
+		GasPriceMin:              assets.GWei(1),
+		GasPriceMax:              assets.GWei(1000),
+		QuarantineDuration:       commonconfig.MustNewDuration(quarantine),
+	}}}
+	return cfg.SanityChecks()
+}
+
+func TestRPCSanityChecker(t *testing.T) {
+	chainID := big.NewInt(1)
+	lggr := logger.Sugared(logger.Test(t))
+
+	t.Run("disabled", func(t *testing.T) {
+		require.Nil(t, newRPCSanityChecker(nil, lggr, chainID, "rpc", nil))
+		s := newRPCSanityChecker(newTestRPCSanityChecks(false, 10, time.Minute), lggr, chainID, "rpc", nil)
+		require.Nil(t, s)
+		assert.True(t, s.checkHead(&evmtypes.Head{Number: -1}))
+		assert.True(t, s.checkGasPrice(big.NewInt(0)))
+		assert.NoError(t, s.quarantined())
+	})
+
+	newChecker := func(t *testing.T) (*rpcSanityChecker, *atomic.Int32) {
+		var violations atomic.Int32
+		s := newRPCSanityChecker(newTestRPCSanityChecks(true, 10, time.Minute), lggr, chainID, "rpc", func() { violations.Add(1) })
+		require.NotNil(t, s)
+		return s, &violations
+	}
+
+	t.Run("block number regression", func(t *testing.T) {
+		s, violations := newChecker(t)
+		assert.True(t, s.checkHead(&evmtypes.Head{Number: 100}))
+		assert.True(t, s.checkHead(&evmtypes.Head{Number: 90}), "expected reorgs within the regression to be accepted")
+		assert.NoError(t, s.quarantined())
+
+		assert.False(t, s.checkHead(&evmtypes.Head{Number: 89}))
+		assert.Equal(t, int32(1), violations.Load())
+		assert.ErrorIs(t, s.quarantined(), ErrRPCQuarantined)
+		// the highest head is reset after a violation
+		assert.True(t, s.checkHead(&evmtypes.Head{Number: 1}))
+	})
+
+	t.Run("chain ID", func(t *testing.T) {
+		s, violations := newChecker(t)
+		assert.True(t, s.checkChainID(big.NewInt(1)))
+		assert.False(t, s.checkChainID(big.NewInt(2)))
+		assert.Equal(t, int32(1), violations.Load())
+	})
+
+	t.Run("gas price band", func(t *testing.T) {
+		s, violations := newChecker(t)
+		assert.True(t, s.checkGasPrice(assets.GWei(1).ToInt()))
+		assert.True(t, s.checkGasPrice(assets.GWei(1000).ToInt()))
+		assert.False(t, s.checkGasPrice(big.NewInt(1)))
+		assert.False(t, s.checkGasPrice(assets.GWei(1001).ToInt()))
+		assert.True(t, s.checkTipCap(big.NewInt(0)), "expected tip caps below GasPriceMin to be accepted")
+		assert.False(t, s.checkTipCap(assets.GWei(1001).ToInt()))
+		assert.Equal(t, int32(3), violations.Load())
+	})
+
+	t.Run("balance", func(t *testing.T) {
+		s, violations := newChecker(t)
+		assert.True(t, s.checkBalance(big.NewInt(0)))
+		assert.False(t, s.checkBalance(big.NewInt(-1)))
+		assert.Equal(t, int32(1), violations.Load())
+	})
+
+	t.Run("quarantine expires", func(t *testing.T) {
+		s := newRPCSanityChecker(newTestRPCSanityChecks(true, 10, time.Millisecond), lggr, chainID, "rpc", nil)
+		require.NotNil(t, s)
+		assert.False(t, s.checkBalance(big.NewInt(-1)))
+		require.Eventually(t, func() bool { return s.quarantined() == nil }, time.Second, time.Millisecond)
+	})
+}
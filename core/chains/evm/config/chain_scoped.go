@@ -29,7 +29,7 @@ func (c *ChainScoped) EVM() EVM {
 }
 
 func (c *ChainScoped) Nodes() toml.EVMNodes {
-	return c.evmConfig.C.Nodes
+	return c.evmConfig.C.NodesSnapshot()
 }
 
 func (c *ChainScoped) BlockEmissionIdleWarningThreshold() time.Duration {
@@ -73,7 +73,16 @@ func (e *EVMConfig) Workflow() Workflow {
 }
 
 func (e *EVMConfig) GasEstimator() GasEstimator {
-	return &gasEstimatorConfig{c: e.C.GasEstimator, blockDelay: e.C.RPCBlockQueryDelay, transactionsMaxInFlight: e.C.Transactions.MaxInFlight, k: e.C.KeySpecific}
+	return &gasEstimatorConfig{c: e.C.GasEstimatorSnapshot(), blockDelay: e.C.RPCBlockQueryDelay, transactionsMaxInFlight: e.C.Transactions.MaxInFlight, k: e.C.KeySpecific}
+}
+
+func (e *EVMConfig) GasEstimatorProfiles() map[string]GasEstimator {
+	profiles := make(map[string]GasEstimator, len(e.C.GasEstimatorProfiles))
+	ge := e.C.GasEstimatorSnapshot()
+	for _, p := range e.C.GasEstimatorProfiles {
+		profiles[*p.Name] = &gasEstimatorConfig{c: profileGasEstimator(ge, p), blockDelay: e.C.RPCBlockQueryDelay, transactionsMaxInFlight: e.C.Transactions.MaxInFlight, k: e.C.KeySpecific}
+	}
+	return profiles
 }
 
 func (e *EVMConfig) AutoCreateKey() bool {
@@ -16,6 +16,26 @@ type gasEstimatorConfig struct {
 	transactionsMaxInFlight *uint32
 }
 
+// profileGasEstimator returns a copy of c with the overrides of the profile applied
+func profileGasEstimator(c toml.GasEstimator, p toml.GasEstimatorProfile) toml.GasEstimator {
+	if v := p.Mode; v != nil {
+		c.Mode = v
+	}
+	if v := p.PriceMax; v != nil {
+		c.PriceMax = v
+	}
+	if v := p.BumpMin; v != nil {
+		c.BumpMin = v
+	}
+	if v := p.BumpPercent; v != nil {
+		c.BumpPercent = v
+	}
+	if v := p.BumpThreshold; v != nil {
+		c.BumpThreshold = v
+	}
+	return c
+}
+
 func (g *gasEstimatorConfig) PriceMaxKey(addr gethcommon.Address) *assets.Wei {
 	var keySpecific *assets.Wei
 	for i := range g.k {
@@ -42,6 +62,10 @@ func (g *gasEstimatorConfig) FeeHistory() FeeHistory {
 	return &feeHistoryConfig{c: g.c.FeeHistory}
 }
 
+func (g *gasEstimatorConfig) OnChainPriceMax() OnChainPriceMax {
+	return &onChainPriceMaxConfig{c: g.c.OnChainPriceMax}
+}
+
 func (g *gasEstimatorConfig) EIP1559DynamicFees() bool {
 	return *g.c.EIP1559DynamicFees
 }
@@ -69,6 +93,10 @@ func (g *gasEstimatorConfig) FeeCapDefault() *assets.Wei {
 	return g.c.FeeCapDefault
 }
 
+func (g *gasEstimatorConfig) BlobPriceMax() *assets.Wei {
+	return g.c.BlobPriceMax
+}
+
 func (g *gasEstimatorConfig) LimitDefault() uint64 {
 	return *g.c.LimitDefault
 }
@@ -190,3 +218,37 @@ type feeHistoryConfig struct {
 func (u *feeHistoryConfig) CacheTimeout() time.Duration {
 	return u.c.CacheTimeout.Duration()
 }
+
+func (u *feeHistoryConfig) RegressionLookback() uint16 {
+	return *u.c.RegressionLookback
+}
+
+func (u *feeHistoryConfig) RegressionPercentile() uint16 {
+	return *u.c.RegressionPercentile
+}
+
+type onChainPriceMaxConfig struct {
+	c toml.OnChainPriceMaxConfig
+}
+
+func (o *onChainPriceMaxConfig) Enabled() bool {
+	return *o.c.Enabled
+}
+
+func (o *onChainPriceMaxConfig) Contract() gethcommon.Address {
+	if o.c.Contract == nil {
+		return gethcommon.Address{}
+	}
+	return o.c.Contract.Address()
+}
+
+func (o *onChainPriceMaxConfig) Calldata() []byte {
+	if o.c.Calldata == nil {
+		return nil
+	}
+	return *o.c.Calldata
+}
+
+func (o *onChainPriceMaxConfig) PollInterval() time.Duration {
+	return o.c.PollInterval.Duration()
+}
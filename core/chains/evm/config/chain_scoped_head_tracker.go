@@ -26,6 +26,10 @@ func (h *headTrackerConfig) FinalityTagBypass() bool {
 	return *h.c.FinalityTagBypass
 }
 
+func (h *headTrackerConfig) FinalityDepthFallback() bool {
+	return *h.c.FinalityDepthFallback
+}
+
 func (h *headTrackerConfig) MaxAllowedFinalityDepth() uint32 {
 	return *h.c.MaxAllowedFinalityDepth
 }
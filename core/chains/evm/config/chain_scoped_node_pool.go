@@ -3,6 +3,7 @@ package config
 import (
 	"time"
 
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 )
 
@@ -51,3 +52,76 @@ func (n *NodePoolConfig) EnforceRepeatableRead() bool {
 func (n *NodePoolConfig) DeathDeclarationDelay() time.Duration {
 	return n.C.DeathDeclarationDelay.Duration()
 }
+
+func (n *NodePoolConfig) CallCacheExpiration() time.Duration {
+	// CallCacheExpiration may be unset when the node pool config is built outside the TOML config, e.g. by the config builder
+	if n.C.CallCacheExpiration == nil {
+		return 0
+	}
+	return n.C.CallCacheExpiration.Duration()
+}
+
+func (n *NodePoolConfig) RateLimit() RPCRateLimit {
+	return &rpcRateLimitConfig{c: n.C.RateLimit}
+}
+
+type rpcRateLimitConfig struct {
+	c toml.RPCRateLimit
+}
+
+func (r *rpcRateLimitConfig) Rate() uint32 {
+	// Rate may be unset when the node pool config is built outside the TOML config, e.g. by the config builder
+	if r.c.Rate == nil {
+		return 0
+	}
+	return *r.c.Rate
+}
+
+func (r *rpcRateLimitConfig) Burst() uint32 {
+	return *r.c.Burst
+}
+
+func (r *rpcRateLimitConfig) LogPollerWeight() uint32 {
+	return *r.c.LogPollerWeight
+}
+
+func (r *rpcRateLimitConfig) TxmWeight() uint32 {
+	return *r.c.TxmWeight
+}
+
+func (r *rpcRateLimitConfig) CCIPWeight() uint32 {
+	return *r.c.CCIPWeight
+}
+
+func (r *rpcRateLimitConfig) GasEstimatorWeight() uint32 {
+	return *r.c.GasEstimatorWeight
+}
+
+func (n *NodePoolConfig) SanityChecks() RPCSanityChecks {
+	return &rpcSanityChecksConfig{c: n.C.SanityChecks}
+}
+
+type rpcSanityChecksConfig struct {
+	c toml.RPCSanityChecks
+}
+
+func (r *rpcSanityChecksConfig) Enabled() bool {
+	// Enabled may be unset when the node pool config is built outside the TOML config, e.g. by the config builder
+	return r.c.Enabled != nil && *r.c.Enabled
+}
+
+func (r *rpcSanityChecksConfig) MaxBlockNumberRegression() uint32 {
+	return *r.c.MaxBlockNumberRegression
+}
+
+func (r *rpcSanityChecksConfig) GasPriceMin() *assets.Wei {
+	return r.c.GasPriceMin
+}
+
+func (r *rpcSanityChecksConfig) GasPriceMax() *assets.Wei {
+	return r.c.GasPriceMax
+}
+
+func (r *rpcSanityChecksConfig) QuarantineDuration() time.Duration {
+	return r.c.QuarantineDuration.Duration()
+}
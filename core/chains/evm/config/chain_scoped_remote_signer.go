@@ -0,0 +1,60 @@
+package config
+
+import (
+	"net/url"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+)
+
+// defaultRemoteSignerTimeout is used for remote signers without a configured Timeout
+const defaultRemoteSignerTimeout = 10 * time.Second
+
+func (e *EVMConfig) RemoteSigners() map[gethcommon.Address]RemoteSigner {
+	signers := map[gethcommon.Address]RemoteSigner{}
+	for _, ks := range e.C.KeySpecific {
+		if ks.Key == nil || ks.RemoteSigner.Type == nil {
+			continue
+		}
+		signers[ks.Key.Address()] = &remoteSignerConfig{c: ks.RemoteSigner}
+	}
+	return signers
+}
+
+type remoteSignerConfig struct {
+	c toml.KeySpecificRemoteSigner
+}
+
+func (r *remoteSignerConfig) Type() string {
+	return *r.c.Type
+}
+
+func (r *remoteSignerConfig) URL() *url.URL {
+	if r.c.URL == nil {
+		return nil
+	}
+	return r.c.URL.URL()
+}
+
+func (r *remoteSignerConfig) KeyID() string {
+	if r.c.KeyID == nil {
+		return ""
+	}
+	return *r.c.KeyID
+}
+
+func (r *remoteSignerConfig) Region() string {
+	if r.c.Region == nil {
+		return ""
+	}
+	return *r.c.Region
+}
+
+func (r *remoteSignerConfig) Timeout() time.Duration {
+	if r.c.Timeout == nil {
+		return defaultRemoteSignerTimeout
+	}
+	return r.c.Timeout.Duration()
+}
@@ -1,9 +1,12 @@
 package config
 
 import (
+	"math/big"
 	"net/url"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 )
 
@@ -35,6 +38,14 @@ func (t *transactionsConfig) MaxQueued() uint64 {
 	return uint64(*t.c.MaxQueued)
 }
 
+func (t *transactionsConfig) JobSpendLimit() *big.Int {
+	return t.c.JobSpendLimit.ToInt()
+}
+
+func (t *transactionsConfig) JobSpendWindow() time.Duration {
+	return t.c.JobSpendWindow.Duration()
+}
+
 func (t *transactionsConfig) AutoPurge() AutoPurgeConfig {
 	return &autoPurgeConfig{c: t.c.AutoPurge}
 }
@@ -55,6 +66,100 @@ func (a *autoPurgeConfig) MinAttempts() *uint32 {
 	return a.c.MinAttempts
 }
 
+func (a *autoPurgeConfig) AgeThreshold() time.Duration {
+	if a.c.AgeThreshold == nil {
+		return 0
+	}
+	return a.c.AgeThreshold.Duration()
+}
+
 func (a *autoPurgeConfig) DetectionApiUrl() *url.URL {
 	return a.c.DetectionApiUrl.URL()
 }
+
+func (t *transactionsConfig) Bundler() BundlerConfig {
+	return &bundlerConfig{c: t.c.Bundler}
+}
+
+type bundlerConfig struct {
+	c toml.BundlerConfig
+}
+
+func (b *bundlerConfig) Enabled() bool {
+	return *b.c.Enabled
+}
+
+func (b *bundlerConfig) MulticallAddress() common.Address {
+	if b.c.MulticallAddress == nil {
+		return common.Address{}
+	}
+	return b.c.MulticallAddress.Address()
+}
+
+func (b *bundlerConfig) MaxBatchSize() uint32 {
+	return *b.c.MaxBatchSize
+}
+
+func (b *bundlerConfig) FlushInterval() time.Duration {
+	return b.c.FlushInterval.Duration()
+}
+
+func (t *transactionsConfig) NonceCoordination() NonceCoordinationConfig {
+	return &nonceCoordinationConfig{c: t.c.NonceCoordination}
+}
+
+type nonceCoordinationConfig struct {
+	c toml.NonceCoordinationConfig
+}
+
+func (n *nonceCoordinationConfig) Enabled() bool {
+	return *n.c.Enabled
+}
+
+func (n *nonceCoordinationConfig) LockTimeout() time.Duration {
+	return n.c.LockTimeout.Duration()
+}
+
+func (n *nonceCoordinationConfig) ReservationTimeout() time.Duration {
+	return n.c.ReservationTimeout.Duration()
+}
+
+func (t *transactionsConfig) Simulation() SimulationConfig {
+	return &simulationConfig{c: t.c.Simulation}
+}
+
+type simulationConfig struct {
+	c toml.SimulationConfig
+}
+
+func (s *simulationConfig) Enabled() bool {
+	return *s.c.Enabled
+}
+
+func (s *simulationConfig) AbortOnRevert() bool {
+	return *s.c.AbortOnRevert
+}
+
+func (t *transactionsConfig) CircuitBreaker() CircuitBreakerConfig {
+	return &circuitBreakerConfig{c: t.c.CircuitBreaker}
+}
+
+type circuitBreakerConfig struct {
+	c toml.CircuitBreakerConfig
+}
+
+func (c *circuitBreakerConfig) Enabled() bool {
+	return *c.c.Enabled
+}
+
+func (c *circuitBreakerConfig) BroadcastFailureThreshold() uint32 {
+	return *c.c.BroadcastFailureThreshold
+}
+
+func (c *circuitBreakerConfig) ReceiptFailureThreshold() uint32 {
+	return *c.c.ReceiptFailureThreshold
+}
+
+func (c *circuitBreakerConfig) ResetTimeout() time.Duration {
+	return c.c.ResetTimeout.Duration()
+}
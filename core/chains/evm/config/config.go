@@ -19,6 +19,10 @@ type EVM interface {
 	BalanceMonitor() BalanceMonitor
 	Transactions() Transactions
 	GasEstimator() GasEstimator
+	// GasEstimatorProfiles returns the GasEstimator of each named profile, keyed by name
+	GasEstimatorProfiles() map[string]GasEstimator
+	// RemoteSigners returns the RemoteSigner of each key that is configured to be signed with remotely
+	RemoteSigners() map[gethcommon.Address]RemoteSigner
 	OCR() OCR
 	OCR2() OCR2
 	Workflow() Workflow
@@ -75,6 +79,7 @@ type HeadTracker interface {
 	MaxBufferSize() uint32
 	SamplingInterval() time.Duration
 	FinalityTagBypass() bool
+	FinalityDepthFallback() bool
 	MaxAllowedFinalityDepth() uint32
 	PersistenceEnabled() bool
 }
@@ -99,6 +104,7 @@ type ClientErrors interface {
 	Fatal() string
 	ServiceUnavailable() string
 	TooManyResults() string
+	Provider() string
 }
 
 type Transactions interface {
@@ -108,19 +114,52 @@ type Transactions interface {
 	ReaperThreshold() time.Duration
 	MaxInFlight() uint32
 	MaxQueued() uint64
+	JobSpendLimit() *big.Int
+	JobSpendWindow() time.Duration
 	AutoPurge() AutoPurgeConfig
+	Bundler() BundlerConfig
+	NonceCoordination() NonceCoordinationConfig
+	Simulation() SimulationConfig
+	CircuitBreaker() CircuitBreakerConfig
 }
 
 type AutoPurgeConfig interface {
 	Enabled() bool
 	Threshold() *uint32
 	MinAttempts() *uint32
+	AgeThreshold() time.Duration
 	DetectionApiUrl() *url.URL
 }
 
+type BundlerConfig interface {
+	Enabled() bool
+	MulticallAddress() gethcommon.Address
+	MaxBatchSize() uint32
+	FlushInterval() time.Duration
+}
+
+type NonceCoordinationConfig interface {
+	Enabled() bool
+	LockTimeout() time.Duration
+	ReservationTimeout() time.Duration
+}
+
+type SimulationConfig interface {
+	Enabled() bool
+	AbortOnRevert() bool
+}
+
+type CircuitBreakerConfig interface {
+	Enabled() bool
+	BroadcastFailureThreshold() uint32
+	ReceiptFailureThreshold() uint32
+	ResetTimeout() time.Duration
+}
+
 type GasEstimator interface {
 	BlockHistory() BlockHistory
 	FeeHistory() FeeHistory
+	OnChainPriceMax() OnChainPriceMax
 	LimitJobType() LimitJobType
 
 	EIP1559DynamicFees() bool
@@ -141,6 +180,7 @@ type GasEstimator interface {
 	Mode() string
 	PriceMaxKey(gethcommon.Address) *assets.Wei
 	EstimateLimit() bool
+	BlobPriceMax() *assets.Wei
 }
 
 type LimitJobType interface {
@@ -162,8 +202,25 @@ type BlockHistory interface {
 	TransactionPercentile() uint16
 }
 
+type RemoteSigner interface {
+	Type() string
+	URL() *url.URL
+	KeyID() string
+	Region() string
+	Timeout() time.Duration
+}
+
 type FeeHistory interface {
 	CacheTimeout() time.Duration
+	RegressionLookback() uint16
+	RegressionPercentile() uint16
+}
+
+type OnChainPriceMax interface {
+	Enabled() bool
+	Contract() gethcommon.Address
+	Calldata() []byte
+	PollInterval() time.Duration
 }
 
 type Workflow interface {
@@ -184,6 +241,33 @@ type NodePool interface {
 	EnforceRepeatableRead() bool
 	DeathDeclarationDelay() time.Duration
 	NewHeadsPollInterval() time.Duration
+	// CallCacheExpiration is how long the results of immutable reads are cached for. 0 disables the cache.
+	CallCacheExpiration() time.Duration
+	RateLimit() RPCRateLimit
+	SanityChecks() RPCSanityChecks
+}
+
+// RPCRateLimit configures the token bucket limiting the requests sent to each RPC endpoint. Requests consume the
+// weight of the service making them, or 1 if they are not attributed to any.
+type RPCRateLimit interface {
+	// Rate is the number of tokens added to the bucket per second. 0 disables the limit.
+	Rate() uint32
+	Burst() uint32
+	LogPollerWeight() uint32
+	TxmWeight() uint32
+	CCIPWeight() uint32
+	GasEstimatorWeight() uint32
+}
+
+// RPCSanityChecks configures the validation of the responses of each RPC endpoint. An endpoint returning an implausible
+// response is quarantined for QuarantineDuration, so that its responses don't corrupt the state of the node.
+type RPCSanityChecks interface {
+	Enabled() bool
+	// MaxBlockNumberRegression is the number of blocks the latest head may go back by, e.g. on reorgs.
+	MaxBlockNumberRegression() uint32
+	GasPriceMin() *assets.Wei
+	GasPriceMax() *assets.Wei
+	QuarantineDuration() time.Duration
 }
 
 // TODO BCF-2509 does the chainscopedconfig really need the entire app config?
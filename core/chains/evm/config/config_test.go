@@ -174,6 +174,29 @@ func TestChainScopedConfig(t *testing.T) {
 		})
 	})
 
+	t.Run("GasEstimatorProfiles", func(t *testing.T) {
+		cfg2 := testutils.NewTestChainScopedConfig(t, func(c *toml.EVMConfig) {
+			c.GasEstimator.PriceMax = assets.GWei(1200)
+			c.GasEstimator.BumpPercent = ptr[uint16](20)
+			c.GasEstimatorProfiles = toml.GasEstimatorProfilesConfig{
+				{Name: ptr("ccip-exec"), Mode: ptr("FeeHistory"), PriceMax: assets.GWei(500), BumpPercent: ptr[uint16](30)},
+			}
+		})
+
+		profiles := cfg2.EVM().GasEstimatorProfiles()
+		require.Len(t, profiles, 1)
+		profile := profiles["ccip-exec"]
+		require.NotNil(t, profile)
+		assert.Equal(t, "FeeHistory", profile.Mode())
+		assert.Equal(t, assets.GWei(500).String(), profile.PriceMaxKey(testutils.NewAddress()).String())
+		assert.Equal(t, uint16(30), profile.BumpPercent())
+		// fields that are not overridden are inherited from the chain
+		assert.Equal(t, cfg2.EVM().GasEstimator().BumpMin(), profile.BumpMin())
+		// the chain estimator is unaffected
+		assert.Equal(t, uint16(20), cfg2.EVM().GasEstimator().BumpPercent())
+		assert.Equal(t, assets.GWei(1200).String(), cfg2.EVM().GasEstimator().PriceMax().String())
+	})
+
 	t.Run("LinkContractAddress", func(t *testing.T) {
 		t.Run("uses chain-specific default value when nothing is set", func(t *testing.T) {
 			assert.Equal(t, "", cfg.EVM().LinkContractAddress())
@@ -342,6 +365,7 @@ func TestChainScopedConfig_HeadTracker(t *testing.T) {
 	assert.Equal(t, uint32(3), ht.MaxBufferSize())
 	assert.Equal(t, time.Second, ht.SamplingInterval())
 	assert.Equal(t, true, ht.FinalityTagBypass())
+	assert.Equal(t, false, ht.FinalityDepthFallback())
 	assert.Equal(t, uint32(10000), ht.MaxAllowedFinalityDepth())
 	assert.Equal(t, true, ht.PersistenceEnabled())
 }
@@ -382,6 +406,7 @@ func TestClientErrorsConfig(t *testing.T) {
 					Fatal:                             ptr("client error fatal"),
 					ServiceUnavailable:                ptr("client error service unavailable"),
 					TooManyResults:                    ptr("client error too many results"),
+					Provider:                          ptr("nethermind"),
 				},
 			}
 		})
@@ -402,6 +427,7 @@ func TestClientErrorsConfig(t *testing.T) {
 		assert.Equal(t, "client error fatal", errors.Fatal())
 		assert.Equal(t, "client error service unavailable", errors.ServiceUnavailable())
 		assert.Equal(t, "client error too many results", errors.TooManyResults())
+		assert.Equal(t, "nethermind", errors.Provider())
 	})
 }
 
@@ -24,6 +24,53 @@ func (_m *GasEstimator) EXPECT() *GasEstimator_Expecter {
 	return &GasEstimator_Expecter{mock: &_m.Mock}
 }
 
+// BlobPriceMax provides a mock function with given fields:
+func (_m *GasEstimator) BlobPriceMax() *assets.Wei {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlobPriceMax")
+	}
+
+	var r0 *assets.Wei
+	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Wei)
+		}
+	}
+
+	return r0
+}
+
+// GasEstimator_BlobPriceMax_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlobPriceMax'
+type GasEstimator_BlobPriceMax_Call struct {
+	*mock.Call
+}
+
+// BlobPriceMax is a helper method to define mock.On call
+func (_e *GasEstimator_Expecter) BlobPriceMax() *GasEstimator_BlobPriceMax_Call {
+	return &GasEstimator_BlobPriceMax_Call{Call: _e.mock.On("BlobPriceMax")}
+}
+
+func (_c *GasEstimator_BlobPriceMax_Call) Run(run func()) *GasEstimator_BlobPriceMax_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GasEstimator_BlobPriceMax_Call) Return(_a0 *assets.Wei) *GasEstimator_BlobPriceMax_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GasEstimator_BlobPriceMax_Call) RunAndReturn(run func() *assets.Wei) *GasEstimator_BlobPriceMax_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BlockHistory provides a mock function with given fields:
 func (_m *GasEstimator) BlockHistory() config.BlockHistory {
 	ret := _m.Called()
@@ -709,6 +756,53 @@ func (_c *GasEstimator_Mode_Call) RunAndReturn(run func() string) *GasEstimator_
 	return _c
 }
 
+// OnChainPriceMax provides a mock function with given fields:
+func (_m *GasEstimator) OnChainPriceMax() config.OnChainPriceMax {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for OnChainPriceMax")
+	}
+
+	var r0 config.OnChainPriceMax
+	if rf, ok := ret.Get(0).(func() config.OnChainPriceMax); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.OnChainPriceMax)
+		}
+	}
+
+	return r0
+}
+
+// GasEstimator_OnChainPriceMax_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OnChainPriceMax'
+type GasEstimator_OnChainPriceMax_Call struct {
+	*mock.Call
+}
+
+// OnChainPriceMax is a helper method to define mock.On call
+func (_e *GasEstimator_Expecter) OnChainPriceMax() *GasEstimator_OnChainPriceMax_Call {
+	return &GasEstimator_OnChainPriceMax_Call{Call: _e.mock.On("OnChainPriceMax")}
+}
+
+func (_c *GasEstimator_OnChainPriceMax_Call) Run(run func()) *GasEstimator_OnChainPriceMax_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GasEstimator_OnChainPriceMax_Call) Return(_a0 config.OnChainPriceMax) *GasEstimator_OnChainPriceMax_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GasEstimator_OnChainPriceMax_Call) RunAndReturn(run func() config.OnChainPriceMax) *GasEstimator_OnChainPriceMax_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PriceDefault provides a mock function with given fields:
 func (_m *GasEstimator) PriceDefault() *assets.Wei {
 	ret := _m.Called()
@@ -6,7 +6,9 @@ import (
 	"net/url"
 	"slices"
 	"strconv"
+	"sync"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/txpool/legacypool"
 	"github.com/pelletier/go-toml/v2"
 	"github.com/shopspring/decimal"
@@ -140,7 +142,7 @@ func (cs EVMConfigs) Chains(ids ...string) (r []commontypes.ChainStatus, total i
 
 func (cs EVMConfigs) Node(name string) (types.Node, error) {
 	for i := range cs {
-		for _, n := range cs[i].Nodes {
+		for _, n := range cs[i].NodesSnapshot() {
 			if n.Name != nil && *n.Name == name {
 				return legacyNode(n, cs[i].ChainID), nil
 			}
@@ -151,7 +153,7 @@ func (cs EVMConfigs) Node(name string) (types.Node, error) {
 
 func (cs EVMConfigs) NodeStatus(name string) (commontypes.NodeStatus, error) {
 	for i := range cs {
-		for _, n := range cs[i].Nodes {
+		for _, n := range cs[i].NodesSnapshot() {
 			if n.Name != nil && *n.Name == name {
 				return nodeStatus(n, cs[i].ChainID.String())
 			}
@@ -193,7 +195,7 @@ func nodeStatus(n *Node, chainID string) (commontypes.NodeStatus, error) {
 func (cs EVMConfigs) nodes(id string) (ns EVMNodes) {
 	for _, c := range cs {
 		if c.ChainID.String() == id {
-			return c.Nodes
+			return c.NodesSnapshot()
 		}
 	}
 	return nil
@@ -222,7 +224,7 @@ func (cs EVMConfigs) Nodes(chainID string) (ns []types.Node, err error) {
 func (cs EVMConfigs) NodeStatuses(chainIDs ...string) (ns []commontypes.NodeStatus, err error) {
 	if len(chainIDs) == 0 {
 		for i := range cs {
-			for _, n := range cs[i].Nodes {
+			for _, n := range cs[i].NodesSnapshot() {
 				if n == nil {
 					continue
 				}
@@ -269,6 +271,7 @@ func (ns *EVMNodes) SetFrom(fs *EVMNodes) {
 type EVMConfig struct {
 	ChainID *big.Big
 	Enabled *bool
+	Profile *string
 	Chain
 	Nodes EVMNodes
 }
@@ -284,10 +287,113 @@ func (c *EVMConfig) SetFrom(f *EVMConfig) {
 	if f.Enabled != nil {
 		c.Enabled = f.Enabled
 	}
+	if f.Profile != nil {
+		c.Profile = f.Profile
+	}
 	c.Chain.SetFrom(&f.Chain)
 	c.Nodes.SetFrom(&f.Nodes)
 }
 
+// ValidateProfiles returns an error if any of the chains reference a profile which is not in ps.
+func (cs EVMConfigs) ValidateProfiles(ps EVMProfiles) (err error) {
+	for i, c := range cs {
+		if c == nil || c.Profile == nil {
+			continue
+		}
+		if _, err1 := ps.Chains(*c.Profile); err1 != nil {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: fmt.Sprintf("%d.Profile", i), Value: *c.Profile, Msg: err1.Error()})
+		}
+	}
+	return
+}
+
+// EVMProfiles are named, partial Chain configs which EVMConfigs inherit from via Profile. Profiles may themselves
+// inherit from another profile.
+type EVMProfiles []*EVMProfile
+
+// ValidateConfig returns an error if the profiles are not uniquely named, or inherit from unknown profiles or in a
+// cycle. The Chain fields of profiles are only validated as part of the EVMConfigs they are applied to.
+func (ps EVMProfiles) ValidateConfig() (err error) {
+	names := commonconfig.UniqueStrings{}
+	for i, p := range ps {
+		if p.Name == nil || *p.Name == "" {
+			err = multierr.Append(err, commonconfig.ErrMissing{Name: fmt.Sprintf("%d.Name", i), Msg: "required for all profiles"})
+		} else if names.IsDupe(p.Name) {
+			err = multierr.Append(err, commonconfig.NewErrDuplicate(fmt.Sprintf("%d.Name", i), *p.Name))
+		}
+	}
+	for i, p := range ps {
+		if p.Profile == nil {
+			continue
+		}
+		if _, err1 := ps.Chains(*p.Profile); err1 != nil {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: fmt.Sprintf("%d.Profile", i), Value: *p.Profile, Msg: err1.Error()})
+		}
+	}
+	return
+}
+
+// SetFrom updates ps with the profiles from fs, merging those with the same Name.
+func (ps *EVMProfiles) SetFrom(fs *EVMProfiles) {
+	for _, f := range *fs {
+		if f.Name == nil {
+			*ps = append(*ps, f)
+		} else if p := ps.find(*f.Name); p == nil {
+			*ps = append(*ps, f)
+		} else {
+			p.SetFrom(f)
+		}
+	}
+}
+
+func (ps EVMProfiles) find(name string) *EVMProfile {
+	for _, p := range ps {
+		if p != nil && p.Name != nil && *p.Name == name {
+			return p
+		}
+	}
+	return nil
+}
+
+// Chains returns the Chain of the named profile, preceded by those of the profiles it inherits from, so that when
+// applied in order later Chains override earlier ones.
+func (ps EVMProfiles) Chains(name string) ([]*Chain, error) {
+	var chains []*Chain
+	seen := map[string]struct{}{}
+	for {
+		if _, ok := seen[name]; ok {
+			return nil, fmt.Errorf("profile %q is inherited in a cycle", name)
+		}
+		seen[name] = struct{}{}
+		p := ps.find(name)
+		if p == nil {
+			return nil, fmt.Errorf("profile %q: %w", name, ErrNotFound)
+		}
+		chains = append([]*Chain{&p.Chain}, chains...)
+		if p.Profile == nil {
+			return chains, nil
+		}
+		name = *p.Profile
+	}
+}
+
+// EVMProfile is a named, partial Chain config, optionally inheriting from another profile via Profile.
+type EVMProfile struct {
+	Name    *string
+	Profile *string
+	Chain
+}
+
+func (p *EVMProfile) SetFrom(f *EVMProfile) {
+	if f.Name != nil {
+		p.Name = f.Name
+	}
+	if f.Profile != nil {
+		p.Profile = f.Profile
+	}
+	p.Chain.SetFrom(&f.Chain)
+}
+
 func (c *EVMConfig) ValidateConfig() (err error) {
 	if c.ChainID == nil {
 		err = multierr.Append(err, commonconfig.ErrMissing{Name: "ChainID", Msg: "required for all chains"})
@@ -329,7 +435,43 @@ func (c *EVMConfig) ValidateConfig() (err error) {
 	return
 }
 
+// reloadMu guards the GasEstimator and the Nodes of every EVMConfig, which ReloadGasEstimator and ReloadNodes replace
+// while the node runs.
+var reloadMu sync.RWMutex
+
+// GasEstimatorSnapshot returns a copy of the GasEstimator, which is safe to read while it is reloaded.
+func (c *EVMConfig) GasEstimatorSnapshot() GasEstimator {
+	reloadMu.RLock()
+	defer reloadMu.RUnlock()
+	return c.GasEstimator
+}
+
+// ReloadGasEstimator replaces the GasEstimator with ge. The fields of the GasEstimator are replaced, never modified in
+// place, so the snapshots taken before are unaffected.
+func (c *EVMConfig) ReloadGasEstimator(ge GasEstimator) {
+	reloadMu.Lock()
+	defer reloadMu.Unlock()
+	c.GasEstimator = ge
+}
+
+// NodesSnapshot returns the Nodes, which are safe to read while they are reloaded.
+func (c *EVMConfig) NodesSnapshot() EVMNodes {
+	reloadMu.RLock()
+	defer reloadMu.RUnlock()
+	return c.Nodes
+}
+
+// ReloadNodes replaces the Nodes with nodes. Like the GasEstimator, the Nodes are replaced, never modified in place, so
+// the snapshots taken before are unaffected.
+func (c *EVMConfig) ReloadNodes(nodes EVMNodes) {
+	reloadMu.Lock()
+	defer reloadMu.Unlock()
+	c.Nodes = nodes
+}
+
 func (c *EVMConfig) TOMLString() (string, error) {
+	reloadMu.RLock()
+	defer reloadMu.RUnlock()
 	b, err := toml.Marshal(c)
 	if err != nil {
 		return "", err
@@ -362,15 +504,16 @@ type Chain struct {
 	FinalizedBlockOffset         *uint32
 	NoNewFinalizedHeadsThreshold *commonconfig.Duration
 
-	Transactions   Transactions      `toml:",omitempty"`
-	BalanceMonitor BalanceMonitor    `toml:",omitempty"`
-	GasEstimator   GasEstimator      `toml:",omitempty"`
-	HeadTracker    HeadTracker       `toml:",omitempty"`
-	KeySpecific    KeySpecificConfig `toml:",omitempty"`
-	NodePool       NodePool          `toml:",omitempty"`
-	OCR            OCR               `toml:",omitempty"`
-	OCR2           OCR2              `toml:",omitempty"`
-	Workflow       Workflow          `toml:",omitempty"`
+	Transactions         Transactions               `toml:",omitempty"`
+	BalanceMonitor       BalanceMonitor             `toml:",omitempty"`
+	GasEstimator         GasEstimator               `toml:",omitempty"`
+	GasEstimatorProfiles GasEstimatorProfilesConfig `toml:",omitempty"`
+	HeadTracker          HeadTracker                `toml:",omitempty"`
+	KeySpecific          KeySpecificConfig          `toml:",omitempty"`
+	NodePool             NodePool                   `toml:",omitempty"`
+	OCR                  OCR                        `toml:",omitempty"`
+	OCR2                 OCR2                       `toml:",omitempty"`
+	Workflow             Workflow                   `toml:",omitempty"`
 }
 
 func (c *Chain) ValidateConfig() (err error) {
@@ -453,8 +596,14 @@ type Transactions struct {
 	ReaperInterval       *commonconfig.Duration
 	ReaperThreshold      *commonconfig.Duration
 	ResendAfterThreshold *commonconfig.Duration
-
-	AutoPurge AutoPurgeConfig `toml:",omitempty"`
+	JobSpendLimit        *assets.Wei
+	JobSpendWindow       *commonconfig.Duration
+
+	AutoPurge         AutoPurgeConfig         `toml:",omitempty"`
+	Bundler           BundlerConfig           `toml:",omitempty"`
+	NonceCoordination NonceCoordinationConfig `toml:",omitempty"`
+	Simulation        SimulationConfig        `toml:",omitempty"`
+	CircuitBreaker    CircuitBreakerConfig    `toml:",omitempty"`
 }
 
 func (t *Transactions) setFrom(f *Transactions) {
@@ -476,13 +625,24 @@ func (t *Transactions) setFrom(f *Transactions) {
 	if v := f.ResendAfterThreshold; v != nil {
 		t.ResendAfterThreshold = v
 	}
+	if v := f.JobSpendLimit; v != nil {
+		t.JobSpendLimit = v
+	}
+	if v := f.JobSpendWindow; v != nil {
+		t.JobSpendWindow = v
+	}
 	t.AutoPurge.setFrom(&f.AutoPurge)
+	t.Bundler.setFrom(&f.Bundler)
+	t.NonceCoordination.setFrom(&f.NonceCoordination)
+	t.Simulation.setFrom(&f.Simulation)
+	t.CircuitBreaker.setFrom(&f.CircuitBreaker)
 }
 
 type AutoPurgeConfig struct {
 	Enabled         *bool
 	Threshold       *uint32
 	MinAttempts     *uint32
+	AgeThreshold    *commonconfig.Duration
 	DetectionApiUrl *commonconfig.URL
 }
 
@@ -496,11 +656,135 @@ func (a *AutoPurgeConfig) setFrom(f *AutoPurgeConfig) {
 	if v := f.MinAttempts; v != nil {
 		a.MinAttempts = v
 	}
+	if v := f.AgeThreshold; v != nil {
+		a.AgeThreshold = v
+	}
 	if v := f.DetectionApiUrl; v != nil {
 		a.DetectionApiUrl = v
 	}
 }
 
+type BundlerConfig struct {
+	Enabled          *bool
+	MulticallAddress *types.EIP55Address
+	MaxBatchSize     *uint32
+	FlushInterval    *commonconfig.Duration
+}
+
+func (b *BundlerConfig) setFrom(f *BundlerConfig) {
+	if v := f.Enabled; v != nil {
+		b.Enabled = v
+	}
+	if v := f.MulticallAddress; v != nil {
+		b.MulticallAddress = v
+	}
+	if v := f.MaxBatchSize; v != nil {
+		b.MaxBatchSize = v
+	}
+	if v := f.FlushInterval; v != nil {
+		b.FlushInterval = v
+	}
+}
+
+func (b *BundlerConfig) ValidateConfig() (err error) {
+	if b.Enabled == nil || !*b.Enabled {
+		return
+	}
+	if b.MulticallAddress == nil {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "MulticallAddress", Msg: "must be set if bundler is enabled"})
+	}
+	if b.MaxBatchSize != nil && *b.MaxBatchSize < 2 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "MaxBatchSize", Value: *b.MaxBatchSize, Msg: "must be greater than or equal to 2"})
+	}
+	if b.FlushInterval != nil && b.FlushInterval.Duration() <= 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "FlushInterval", Value: b.FlushInterval, Msg: "must be greater than 0"})
+	}
+	return
+}
+
+type NonceCoordinationConfig struct {
+	Enabled            *bool
+	LockTimeout        *commonconfig.Duration
+	ReservationTimeout *commonconfig.Duration
+}
+
+func (n *NonceCoordinationConfig) setFrom(f *NonceCoordinationConfig) {
+	if v := f.Enabled; v != nil {
+		n.Enabled = v
+	}
+	if v := f.LockTimeout; v != nil {
+		n.LockTimeout = v
+	}
+	if v := f.ReservationTimeout; v != nil {
+		n.ReservationTimeout = v
+	}
+}
+
+func (n *NonceCoordinationConfig) ValidateConfig() (err error) {
+	if n.Enabled == nil || !*n.Enabled {
+		return
+	}
+	if n.LockTimeout != nil && n.LockTimeout.Duration() <= 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "LockTimeout", Value: n.LockTimeout, Msg: "must be greater than 0"})
+	}
+	if n.ReservationTimeout != nil && n.ReservationTimeout.Duration() <= 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "ReservationTimeout", Value: n.ReservationTimeout, Msg: "must be greater than 0"})
+	}
+	return
+}
+
+type SimulationConfig struct {
+	Enabled       *bool
+	AbortOnRevert *bool
+}
+
+func (s *SimulationConfig) setFrom(f *SimulationConfig) {
+	if v := f.Enabled; v != nil {
+		s.Enabled = v
+	}
+	if v := f.AbortOnRevert; v != nil {
+		s.AbortOnRevert = v
+	}
+}
+
+type CircuitBreakerConfig struct {
+	Enabled                   *bool
+	BroadcastFailureThreshold *uint32
+	ReceiptFailureThreshold   *uint32
+	ResetTimeout              *commonconfig.Duration
+}
+
+func (c *CircuitBreakerConfig) setFrom(f *CircuitBreakerConfig) {
+	if v := f.Enabled; v != nil {
+		c.Enabled = v
+	}
+	if v := f.BroadcastFailureThreshold; v != nil {
+		c.BroadcastFailureThreshold = v
+	}
+	if v := f.ReceiptFailureThreshold; v != nil {
+		c.ReceiptFailureThreshold = v
+	}
+	if v := f.ResetTimeout; v != nil {
+		c.ResetTimeout = v
+	}
+}
+
+func (c *CircuitBreakerConfig) ValidateConfig() (err error) {
+	if c.Enabled == nil || !*c.Enabled {
+		return
+	}
+	if c.BroadcastFailureThreshold != nil && *c.BroadcastFailureThreshold == 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "BroadcastFailureThreshold", Value: *c.BroadcastFailureThreshold, Msg: "must be greater than 0"})
+	}
+	if c.ReceiptFailureThreshold != nil && *c.ReceiptFailureThreshold == 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "ReceiptFailureThreshold", Value: *c.ReceiptFailureThreshold, Msg: "must be greater than 0"})
+	}
+	if c.ResetTimeout != nil && c.ResetTimeout.Duration() <= 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "ResetTimeout", Value: c.ResetTimeout, Msg: "must be greater than 0"})
+	}
+	return
+}
+
 type OCR2 struct {
 	Automation Automation `toml:",omitempty"`
 }
@@ -573,8 +857,11 @@ type GasEstimator struct {
 	TipCapDefault *assets.Wei
 	TipCapMin     *assets.Wei
 
-	BlockHistory BlockHistoryEstimator `toml:",omitempty"`
-	FeeHistory   FeeHistoryEstimator   `toml:",omitempty"`
+	BlobPriceMax *assets.Wei
+
+	BlockHistory    BlockHistoryEstimator `toml:",omitempty"`
+	FeeHistory      FeeHistoryEstimator   `toml:",omitempty"`
+	OnChainPriceMax OnChainPriceMaxConfig `toml:",omitempty"`
 }
 
 func (e *GasEstimator) ValidateConfig() (err error) {
@@ -611,6 +898,10 @@ func (e *GasEstimator) ValidateConfig() (err error) {
 		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "BlockHistory.BlockHistorySize", Value: *e.BlockHistory.BlockHistorySize,
 			Msg: "must be greater than or equal to 1 with BlockHistory Mode"})
 	}
+	if *e.Mode == "FeeHistoryRegression" && *e.FeeHistory.RegressionLookback < 2 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "FeeHistory.RegressionLookback", Value: *e.FeeHistory.RegressionLookback,
+			Msg: "must be greater than or equal to 2 with FeeHistoryRegression Mode"})
+	}
 
 	return
 }
@@ -661,6 +952,9 @@ func (e *GasEstimator) setFrom(f *GasEstimator) {
 	if v := f.TipCapMin; v != nil {
 		e.TipCapMin = v
 	}
+	if v := f.BlobPriceMax; v != nil {
+		e.BlobPriceMax = v
+	}
 	if v := f.PriceMax; v != nil {
 		e.PriceMax = v
 	}
@@ -670,6 +964,7 @@ func (e *GasEstimator) setFrom(f *GasEstimator) {
 	e.LimitJobType.setFrom(&f.LimitJobType)
 	e.BlockHistory.setFrom(&f.BlockHistory)
 	e.FeeHistory.setFrom(&f.FeeHistory)
+	e.OnChainPriceMax.setFrom(&f.OnChainPriceMax)
 }
 
 type GasLimitJobType struct {
@@ -733,13 +1028,109 @@ func (e *BlockHistoryEstimator) setFrom(f *BlockHistoryEstimator) {
 }
 
 type FeeHistoryEstimator struct {
-	CacheTimeout *commonconfig.Duration
+	CacheTimeout         *commonconfig.Duration
+	RegressionLookback   *uint16
+	RegressionPercentile *uint16
 }
 
 func (u *FeeHistoryEstimator) setFrom(f *FeeHistoryEstimator) {
 	if v := f.CacheTimeout; v != nil {
 		u.CacheTimeout = v
 	}
+	if v := f.RegressionLookback; v != nil {
+		u.RegressionLookback = v
+	}
+	if v := f.RegressionPercentile; v != nil {
+		u.RegressionPercentile = v
+	}
+}
+
+type OnChainPriceMaxConfig struct {
+	Enabled      *bool
+	Contract     *types.EIP55Address
+	Calldata     *hexutil.Bytes
+	PollInterval *commonconfig.Duration
+}
+
+func (o *OnChainPriceMaxConfig) setFrom(f *OnChainPriceMaxConfig) {
+	if v := f.Enabled; v != nil {
+		o.Enabled = v
+	}
+	if v := f.Contract; v != nil {
+		o.Contract = v
+	}
+	if v := f.Calldata; v != nil {
+		o.Calldata = v
+	}
+	if v := f.PollInterval; v != nil {
+		o.PollInterval = v
+	}
+}
+
+func (o *OnChainPriceMaxConfig) ValidateConfig() (err error) {
+	if o.Enabled == nil || !*o.Enabled {
+		return
+	}
+	if o.Contract == nil {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "Contract", Msg: "must be set if the on-chain price max is enabled"})
+	}
+	if o.Calldata == nil || len(*o.Calldata) == 0 {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "Calldata", Msg: "must be set if the on-chain price max is enabled"})
+	}
+	if o.PollInterval != nil && o.PollInterval.Duration() <= 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "PollInterval", Value: o.PollInterval, Msg: "must be greater than 0"})
+	}
+	return
+}
+
+type GasEstimatorProfilesConfig []GasEstimatorProfile
+
+func (ps GasEstimatorProfilesConfig) ValidateConfig() (err error) {
+	names := map[string]struct{}{}
+	for i, p := range ps {
+		if p.Name == nil || *p.Name == "" {
+			err = multierr.Append(err, commonconfig.ErrMissing{Name: fmt.Sprintf("%d.Name", i), Msg: "required for all profiles"})
+			continue
+		}
+		if _, ok := names[*p.Name]; ok {
+			err = multierr.Append(err, commonconfig.NewErrDuplicate("Name", *p.Name))
+		} else {
+			names[*p.Name] = struct{}{}
+		}
+		if p.BumpPercent != nil && uint64(*p.BumpPercent) < legacypool.DefaultConfig.PriceBump {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: fmt.Sprintf("%d.BumpPercent", i), Value: *p.BumpPercent,
+				Msg: fmt.Sprintf("may not be less than Geth's default of %d", legacypool.DefaultConfig.PriceBump)})
+		}
+	}
+	return
+}
+
+// GasEstimatorProfile is a named set of overrides of the chain's GasEstimator, used by the transactions that reference it.
+type GasEstimatorProfile struct {
+	Name          *string
+	Mode          *string
+	PriceMax      *assets.Wei
+	BumpMin       *assets.Wei
+	BumpPercent   *uint16
+	BumpThreshold *uint32
+}
+
+func (p *GasEstimatorProfile) setFrom(f *GasEstimatorProfile) {
+	if v := f.Mode; v != nil {
+		p.Mode = v
+	}
+	if v := f.PriceMax; v != nil {
+		p.PriceMax = v
+	}
+	if v := f.BumpMin; v != nil {
+		p.BumpMin = v
+	}
+	if v := f.BumpPercent; v != nil {
+		p.BumpPercent = v
+	}
+	if v := f.BumpThreshold; v != nil {
+		p.BumpThreshold = v
+	}
 }
 
 type KeySpecificConfig []KeySpecific
@@ -760,6 +1151,7 @@ func (ks KeySpecificConfig) ValidateConfig() (err error) {
 type KeySpecific struct {
 	Key          *types.EIP55Address
 	GasEstimator KeySpecificGasEstimator `toml:",omitempty"`
+	RemoteSigner KeySpecificRemoteSigner `toml:",omitempty"`
 }
 
 type KeySpecificGasEstimator struct {
@@ -772,12 +1164,74 @@ func (e *KeySpecificGasEstimator) setFrom(f *KeySpecificGasEstimator) {
 	}
 }
 
+const (
+	// RemoteSignerTypeWeb3Signer delegates signing to a Web3Signer instance over its eth1 JSON-RPC API
+	RemoteSignerTypeWeb3Signer = "Web3Signer"
+	// RemoteSignerTypeAWSKMS delegates signing to a secp256k1 key of AWS KMS
+	RemoteSignerTypeAWSKMS = "AWSKMS"
+)
+
+// KeySpecificRemoteSigner configures an external signer holding the private key, instead of the node's keystore.
+type KeySpecificRemoteSigner struct {
+	Type    *string
+	URL     *commonconfig.URL
+	KeyID   *string
+	Region  *string
+	Timeout *commonconfig.Duration
+}
+
+func (r *KeySpecificRemoteSigner) setFrom(f *KeySpecificRemoteSigner) {
+	if v := f.Type; v != nil {
+		r.Type = v
+	}
+	if v := f.URL; v != nil {
+		r.URL = v
+	}
+	if v := f.KeyID; v != nil {
+		r.KeyID = v
+	}
+	if v := f.Region; v != nil {
+		r.Region = v
+	}
+	if v := f.Timeout; v != nil {
+		r.Timeout = v
+	}
+}
+
+func (r *KeySpecificRemoteSigner) ValidateConfig() (err error) {
+	if r.Type == nil {
+		return
+	}
+	switch *r.Type {
+	case RemoteSignerTypeWeb3Signer:
+		if r.URL == nil {
+			err = multierr.Append(err, commonconfig.ErrMissing{Name: "URL", Msg: "required for Web3Signer"})
+		}
+	case RemoteSignerTypeAWSKMS:
+		if r.KeyID == nil || *r.KeyID == "" {
+			err = multierr.Append(err, commonconfig.ErrMissing{Name: "KeyID", Msg: "required for AWSKMS"})
+		}
+		if r.Region == nil || *r.Region == "" {
+			err = multierr.Append(err, commonconfig.ErrMissing{Name: "Region", Msg: "required for AWSKMS"})
+		}
+	default:
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Type", Value: *r.Type,
+			Msg: fmt.Sprintf("must be one of %s or %s", RemoteSignerTypeWeb3Signer, RemoteSignerTypeAWSKMS)})
+	}
+	if r.Timeout != nil && r.Timeout.Duration() <= 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Timeout", Value: r.Timeout,
+			Msg: "must be greater than zero"})
+	}
+	return
+}
+
 type HeadTracker struct {
 	HistoryDepth            *uint32
 	MaxBufferSize           *uint32
 	SamplingInterval        *commonconfig.Duration
 	MaxAllowedFinalityDepth *uint32
 	FinalityTagBypass       *bool
+	FinalityDepthFallback   *bool
 	PersistenceEnabled      *bool
 }
 
@@ -797,6 +1251,9 @@ func (t *HeadTracker) setFrom(f *HeadTracker) {
 	if v := f.FinalityTagBypass; v != nil {
 		t.FinalityTagBypass = v
 	}
+	if v := f.FinalityDepthFallback; v != nil {
+		t.FinalityDepthFallback = v
+	}
 	if v := f.PersistenceEnabled; v != nil {
 		t.PersistenceEnabled = v
 	}
@@ -828,6 +1285,7 @@ type ClientErrors struct {
 	Fatal                             *string `toml:",omitempty"`
 	ServiceUnavailable                *string `toml:",omitempty"`
 	TooManyResults                    *string `toml:",omitempty"`
+	Provider                          *string `toml:",omitempty"`
 }
 
 func (r *ClientErrors) setFrom(f *ClientErrors) bool {
@@ -876,6 +1334,9 @@ func (r *ClientErrors) setFrom(f *ClientErrors) bool {
 	if v := f.TooManyResults; v != nil {
 		r.TooManyResults = v
 	}
+	if v := f.Provider; v != nil {
+		r.Provider = v
+	}
 	return true
 }
 
@@ -891,6 +1352,9 @@ type NodePool struct {
 	EnforceRepeatableRead      *bool
 	DeathDeclarationDelay      *commonconfig.Duration
 	NewHeadsPollInterval       *commonconfig.Duration
+	CallCacheExpiration        *commonconfig.Duration
+	RateLimit                  RPCRateLimit
+	SanityChecks               RPCSanityChecks
 }
 
 func (p *NodePool) setFrom(f *NodePool) {
@@ -928,7 +1392,108 @@ func (p *NodePool) setFrom(f *NodePool) {
 		p.NewHeadsPollInterval = v
 	}
 
+	if v := f.CallCacheExpiration; v != nil {
+		p.CallCacheExpiration = v
+	}
+
 	p.Errors.setFrom(&f.Errors)
+	p.RateLimit.setFrom(&f.RateLimit)
+	p.SanityChecks.setFrom(&f.SanityChecks)
+}
+
+type RPCRateLimit struct {
+	Rate               *uint32
+	Burst              *uint32
+	LogPollerWeight    *uint32
+	TxmWeight          *uint32
+	CCIPWeight         *uint32
+	GasEstimatorWeight *uint32
+}
+
+func (r *RPCRateLimit) setFrom(f *RPCRateLimit) {
+	if v := f.Rate; v != nil {
+		r.Rate = v
+	}
+	if v := f.Burst; v != nil {
+		r.Burst = v
+	}
+	if v := f.LogPollerWeight; v != nil {
+		r.LogPollerWeight = v
+	}
+	if v := f.TxmWeight; v != nil {
+		r.TxmWeight = v
+	}
+	if v := f.CCIPWeight; v != nil {
+		r.CCIPWeight = v
+	}
+	if v := f.GasEstimatorWeight; v != nil {
+		r.GasEstimatorWeight = v
+	}
+}
+
+func (r *RPCRateLimit) ValidateConfig() (err error) {
+	if r.Rate == nil || *r.Rate == 0 {
+		return
+	}
+	if r.Burst == nil {
+		return commonconfig.ErrMissing{Name: "Burst", Msg: "must be set if Rate is set"}
+	}
+	if *r.Burst == 0 {
+		return commonconfig.ErrInvalid{Name: "Burst", Value: *r.Burst, Msg: "must be greater than 0 if Rate is set"}
+	}
+	for _, w := range []struct {
+		name   string
+		weight *uint32
+	}{
+		{"LogPollerWeight", r.LogPollerWeight},
+		{"TxmWeight", r.TxmWeight},
+		{"CCIPWeight", r.CCIPWeight},
+		{"GasEstimatorWeight", r.GasEstimatorWeight},
+	} {
+		if w.weight != nil && *w.weight > *r.Burst {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: w.name, Value: *w.weight, Msg: fmt.Sprintf("must not be greater than Burst (%d)", *r.Burst)})
+		}
+	}
+	return
+}
+
+type RPCSanityChecks struct {
+	Enabled                  *bool
+	MaxBlockNumberRegression *uint32
+	GasPriceMin              *assets.Wei
+	GasPriceMax              *assets.Wei
+	QuarantineDuration       *commonconfig.Duration
+}
+
+func (r *RPCSanityChecks) setFrom(f *RPCSanityChecks) {
+	if v := f.Enabled; v != nil {
+		r.Enabled = v
+	}
+	if v := f.MaxBlockNumberRegression; v != nil {
+		r.MaxBlockNumberRegression = v
+	}
+	if v := f.GasPriceMin; v != nil {
+		r.GasPriceMin = v
+	}
+	if v := f.GasPriceMax; v != nil {
+		r.GasPriceMax = v
+	}
+	if v := f.QuarantineDuration; v != nil {
+		r.QuarantineDuration = v
+	}
+}
+
+func (r *RPCSanityChecks) ValidateConfig() (err error) {
+	if r.Enabled == nil || !*r.Enabled {
+		return
+	}
+	if r.GasPriceMin != nil && r.GasPriceMax != nil && r.GasPriceMin.Cmp(r.GasPriceMax) > 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "GasPriceMax", Value: r.GasPriceMax, Msg: fmt.Sprintf("must not be less than GasPriceMin (%s)", r.GasPriceMin)})
+	}
+	if r.QuarantineDuration == nil || r.QuarantineDuration.Duration() <= 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "QuarantineDuration", Value: r.QuarantineDuration, Msg: "must be greater than 0"})
+	}
+	return
 }
 
 type OCR struct {
@@ -967,6 +1532,7 @@ type Node struct {
 	HTTPURL  *commonconfig.URL
 	SendOnly *bool
 	Order    *int32
+	Archive  *bool
 }
 
 func (n *Node) ValidateConfig() (err error) {
@@ -1008,6 +1574,10 @@ func (n *Node) ValidateConfig() (err error) {
 		}
 	}
 
+	if sendOnly && n.Archive != nil && *n.Archive {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Archive", Value: *n.Archive, Msg: "not allowed for send only nodes"})
+	}
+
 	if n.Order != nil && (*n.Order < 1 || *n.Order > 100) {
 		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Order", Value: *n.Order, Msg: "must be between 1 and 100"})
 	} else if n.Order == nil {
@@ -1034,6 +1604,9 @@ func (n *Node) SetFrom(f *Node) {
 	if f.Order != nil {
 		n.Order = f.Order
 	}
+	if f.Archive != nil {
+		n.Archive = f.Archive
+	}
 }
 
 func ChainIDInt64(cid string) (int64, error) {
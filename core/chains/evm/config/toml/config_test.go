@@ -3,11 +3,13 @@ package toml_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/config"
 
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 )
 
@@ -29,3 +31,49 @@ func TestEVMConfig_ValidateConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestRPCRateLimit_ValidateConfig(t *testing.T) {
+	ptr := func(v uint32) *uint32 { return &v }
+	for _, tt := range []struct {
+		name   string
+		cfg    toml.RPCRateLimit
+		errMsg string
+	}{
+		{"disabled", toml.RPCRateLimit{Rate: ptr(0), Burst: ptr(0), TxmWeight: ptr(10)}, ""},
+		{"valid", toml.RPCRateLimit{Rate: ptr(10), Burst: ptr(20), TxmWeight: ptr(20), CCIPWeight: ptr(0)}, ""},
+		{"no burst", toml.RPCRateLimit{Rate: ptr(10), Burst: ptr(0)}, "Burst: invalid value (0): must be greater than 0 if Rate is set"},
+		{"weight above burst", toml.RPCRateLimit{Rate: ptr(10), Burst: ptr(5), LogPollerWeight: ptr(6)}, "LogPollerWeight: invalid value (6): must not be greater than Burst (5)"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.ValidateConfig()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.errMsg)
+		})
+	}
+}
+
+func TestRPCSanityChecks_ValidateConfig(t *testing.T) {
+	enabled, disabled := true, false
+	for _, tt := range []struct {
+		name   string
+		cfg    toml.RPCSanityChecks
+		errMsg string
+	}{
+		{"disabled", toml.RPCSanityChecks{Enabled: &disabled, GasPriceMin: assets.GWei(2), GasPriceMax: assets.GWei(1)}, ""},
+		{"valid", toml.RPCSanityChecks{Enabled: &enabled, GasPriceMin: assets.GWei(1), GasPriceMax: assets.GWei(1), QuarantineDuration: config.MustNewDuration(time.Minute)}, ""},
+		{"max below min", toml.RPCSanityChecks{Enabled: &enabled, GasPriceMin: assets.GWei(2), GasPriceMax: assets.GWei(1), QuarantineDuration: config.MustNewDuration(time.Minute)}, "GasPriceMax: invalid value (1 gwei): must not be less than GasPriceMin (2 gwei)"},
+		{"no quarantine", toml.RPCSanityChecks{Enabled: &enabled, QuarantineDuration: config.MustNewDuration(0)}, "QuarantineDuration: invalid value (0s): must be greater than 0"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.ValidateConfig()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.errMsg)
+		})
+	}
+}
@@ -245,6 +245,19 @@ func (c *Chain) SetFrom(f *Chain) {
 	c.BalanceMonitor.setFrom(&f.BalanceMonitor)
 	c.GasEstimator.setFrom(&f.GasEstimator)
 
+	if ps := f.GasEstimatorProfiles; ps != nil {
+		for i := range ps {
+			v := ps[i]
+			if i := slices.IndexFunc(c.GasEstimatorProfiles, func(p GasEstimatorProfile) bool {
+				return p.Name != nil && v.Name != nil && *p.Name == *v.Name
+			}); i == -1 {
+				c.GasEstimatorProfiles = append(c.GasEstimatorProfiles, v)
+			} else {
+				c.GasEstimatorProfiles[i].setFrom(&v)
+			}
+		}
+	}
+
 	if ks := f.KeySpecific; ks != nil {
 		for i := range ks {
 			v := ks[i]
@@ -252,6 +265,7 @@ func (c *Chain) SetFrom(f *Chain) {
 				c.KeySpecific = append(c.KeySpecific, v)
 			} else {
 				c.KeySpecific[i].GasEstimator.setFrom(&v.GasEstimator)
+				c.KeySpecific[i].RemoteSigner.setFrom(&v.RemoteSigner)
 			}
 		}
 	}
@@ -0,0 +1,72 @@
+package gas
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	commonfee "github.com/smartcontractkit/chainlink/v2/common/fee"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+)
+
+const (
+	// BlobFeeCapMultiplier is applied to the current blob base fee to get the blob fee cap of a new blob tx. The blob base
+	// fee can increase by at most 12.5% per block, so doubling it keeps the tx includable for at least 5 full blocks.
+	BlobFeeCapMultiplier = 2
+	// BlobPriceBumpPercent is the minimum percentage by which all fee caps of a blob tx must be bumped to replace it,
+	// as enforced by the geth blobpool.
+	BlobPriceBumpPercent = 100
+)
+
+var promBlobBaseFee = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "gas_estimator_blob_base_fee",
+	Help: "Current blob base fee in Wei, as reported by eth_blobBaseFee",
+},
+	[]string{"evmChainID"},
+)
+
+// GetBlobFee returns the blob fee cap for a new EIP-4844 blob tx, based on the current blob base fee.
+// It fails if even the current blob base fee exceeds maxBlobFee, since such a tx could not be included.
+func (e *evmFeeEstimator) GetBlobFee(ctx context.Context, maxBlobFee *assets.Wei) (*assets.Wei, error) {
+	baseFee, err := e.blobBaseFee(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if baseFee.Cmp(maxBlobFee) > 0 {
+		return nil, fmt.Errorf("blob base fee of %s exceeds max blob fee of %s", baseFee, maxBlobFee)
+	}
+	return assets.WeiMin(baseFee.Mul(big.NewInt(BlobFeeCapMultiplier)), maxBlobFee), nil
+}
+
+// BumpBlobFee returns the blob fee cap to replace a blob tx that was sent with originalBlobFee. Unlike regular txs,
+// a blob tx can only be replaced with fees that are at least BlobPriceBumpPercent higher, so the bump is never capped.
+func (e *evmFeeEstimator) BumpBlobFee(ctx context.Context, originalBlobFee *assets.Wei, maxBlobFee *assets.Wei) (*assets.Wei, error) {
+	if originalBlobFee == nil {
+		return nil, fmt.Errorf("%w: original blob fee is missing", commonfee.ErrBump)
+	}
+	bumped := originalBlobFee.AddPercentage(BlobPriceBumpPercent)
+	// never bump to less than what a new blob tx would be sent with
+	if current, err := e.GetBlobFee(ctx, maxBlobFee); err != nil {
+		e.lggr.Warnw("Failed to get current blob fee, bumping the original blob fee", "originalBlobFee", originalBlobFee, "err", err)
+	} else if current.Cmp(bumped) > 0 {
+		bumped = current
+	}
+	if bumped.Cmp(maxBlobFee) > 0 {
+		return nil, fmt.Errorf("%w: bumped blob fee of %s exceeds max blob fee of %s", commonfee.ErrBumpFeeExceedsLimit, bumped, maxBlobFee)
+	}
+	return bumped, nil
+}
+
+func (e *evmFeeEstimator) blobBaseFee(ctx context.Context) (*assets.Wei, error) {
+	var fee hexutil.Big
+	if err := e.ethClient.CallContext(ctx, &fee, "eth_blobBaseFee"); err != nil {
+		return nil, fmt.Errorf("failed to fetch blob base fee: %w", err)
+	}
+	baseFee := assets.NewWei(fee.ToInt())
+	promBlobBaseFee.WithLabelValues(e.ethClient.ConfiguredChainID().String()).Set(float64(baseFee.Int64()))
+	return baseFee, nil
+}
@@ -0,0 +1,74 @@
+package gas_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	commonfee "github.com/smartcontractkit/chainlink/v2/common/fee"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
+)
+
+func TestEvmFeeEstimator_BlobFee(t *testing.T) {
+	t.Parallel()
+
+	newEstimator := func(t *testing.T, blobBaseFee *assets.Wei, err error) gas.EvmFeeEstimator {
+		est := mocks.NewEvmEstimator(t)
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		ethClient.On("CallContext", mock.Anything, mock.Anything, "eth_blobBaseFee").Run(func(args mock.Arguments) {
+			res := args.Get(1).(*hexutil.Big)
+			(*big.Int)(res).Set(blobBaseFee.ToInt())
+		}).Return(err)
+		return gas.NewEvmFeeEstimator(logger.Test(t), func(logger.Logger) gas.EvmEstimator { return est }, true, gas.NewMockGasConfig(), ethClient)
+	}
+
+	t.Run("GetBlobFee doubles the blob base fee", func(t *testing.T) {
+		fee, err := newEstimator(t, assets.GWei(1), nil).GetBlobFee(tests.Context(t), assets.GWei(10))
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(2).String(), fee.String())
+	})
+
+	t.Run("GetBlobFee caps the blob fee at the max", func(t *testing.T) {
+		fee, err := newEstimator(t, assets.GWei(8), nil).GetBlobFee(tests.Context(t), assets.GWei(10))
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(10).String(), fee.String())
+	})
+
+	t.Run("GetBlobFee fails if the blob base fee exceeds the max", func(t *testing.T) {
+		_, err := newEstimator(t, assets.GWei(11), nil).GetBlobFee(tests.Context(t), assets.GWei(10))
+		require.ErrorContains(t, err, "exceeds max blob fee")
+	})
+
+	t.Run("GetBlobFee fails if the blob base fee is unavailable", func(t *testing.T) {
+		_, err := newEstimator(t, assets.GWei(1), errors.New("method not found")).GetBlobFee(tests.Context(t), assets.GWei(10))
+		require.ErrorContains(t, err, "method not found")
+	})
+
+	t.Run("BumpBlobFee bumps by 100%", func(t *testing.T) {
+		fee, err := newEstimator(t, assets.GWei(1), nil).BumpBlobFee(tests.Context(t), assets.GWei(3), assets.GWei(10))
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(6).String(), fee.String())
+	})
+
+	t.Run("BumpBlobFee bumps to the current blob fee if higher", func(t *testing.T) {
+		fee, err := newEstimator(t, assets.GWei(4), nil).BumpBlobFee(tests.Context(t), assets.GWei(3), assets.GWei(10))
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(8).String(), fee.String())
+	})
+
+	t.Run("BumpBlobFee fails if the bumped blob fee exceeds the max", func(t *testing.T) {
+		_, err := newEstimator(t, assets.GWei(1), nil).BumpBlobFee(tests.Context(t), assets.GWei(6), assets.GWei(10))
+		require.ErrorIs(t, err, commonfee.ErrBumpFeeExceedsLimit)
+	})
+}
@@ -65,6 +65,10 @@ type FeeHistoryEstimatorConfig struct {
 	EIP1559          bool
 	BlockHistorySize uint64
 	RewardPercentile float64
+
+	// Regression predicts the dynamic fees of the next block with a linear regression over the past blocks,
+	// instead of averaging their priority fees.
+	Regression bool
 }
 
 type feeHistoryEstimatorClient interface {
@@ -89,6 +93,9 @@ type FeeHistoryEstimator struct {
 	priorityFeeThresholdMu sync.RWMutex
 	priorityFeeThreshold   *assets.Wei
 
+	predictionMu sync.Mutex
+	prediction   *feeHistoryPrediction
+
 	l1Oracle rollups.L1Oracle
 
 	wg        *sync.WaitGroup
@@ -97,9 +104,13 @@ type FeeHistoryEstimator struct {
 }
 
 func NewFeeHistoryEstimator(lggr logger.Logger, client feeHistoryEstimatorClient, cfg FeeHistoryEstimatorConfig, chainID *big.Int, l1Oracle rollups.L1Oracle) *FeeHistoryEstimator {
+	name := "FeeHistoryEstimator"
+	if cfg.Regression {
+		name = "FeeHistoryRegressionEstimator"
+	}
 	return &FeeHistoryEstimator{
 		client:    client,
-		logger:    logger.Named(lggr, "FeeHistoryEstimator"),
+		logger:    logger.Named(lggr, name),
 		config:    cfg,
 		chainID:   chainID,
 		l1Oracle:  l1Oracle,
@@ -119,6 +130,10 @@ func (f *FeeHistoryEstimator) Start(context.Context) error {
 			return fmt.Errorf("RewardPercentile: %s is greater than maximum allowed percentile: %s",
 				strconv.FormatUint(uint64(f.config.RewardPercentile), 10), strconv.Itoa(ConnectivityPercentile))
 		}
+		if f.config.Regression && f.config.BlockHistorySize < 2 {
+			return fmt.Errorf("BlockHistorySize: %s is less than the minimum of 2 blocks required for the regression",
+				strconv.FormatUint(f.config.BlockHistorySize, 10))
+		}
 		f.wg.Add(1)
 		go f.run()
 
@@ -277,6 +292,9 @@ func (f *FeeHistoryEstimator) RefreshDynamicPrice() error {
 		priorityFeeThresholdWei = assets.NewWei(priorityFeeThreshold)
 		maxPriorityFeePerGas = assets.NewWei(priorityFee.Div(priorityFee, big.NewInt(nonZeroRewardsLen)))
 	}
+	if f.config.Regression {
+		nextBaseFee, maxPriorityFeePerGas = f.predictNextBlockFees(feeHistory, nextBaseFee, maxPriorityFeePerGas, priorityFeeThresholdWei)
+	}
 	// BaseFeeBufferPercentage is used as a safety to catch any fluctuations in the Base Fee during the next blocks.
 	maxFeePerGas := nextBaseFee.AddPercentage(BaseFeeBufferPercentage).Add(maxPriorityFeePerGas)
 
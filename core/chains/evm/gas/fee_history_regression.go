@@ -0,0 +1,138 @@
+package gas
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+)
+
+var (
+	promFeeHistoryRegressionBaseFeeError = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gas_estimator_fee_history_regression_base_fee_error_percent",
+		Help: "Relative error of the latest predicted base fee against the realized base fee of the same block (in percent)",
+	},
+		[]string{"evmChainID"},
+	)
+	promFeeHistoryRegressionTipError = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gas_estimator_fee_history_regression_tip_error_percent",
+		Help: "Relative error of the latest predicted priority fee against the realized priority fee percentile of the same block (in percent)",
+	},
+		[]string{"evmChainID"},
+	)
+)
+
+// feeHistoryPrediction holds the fees predicted for a block, to be compared with the realized fees once the block is mined
+type feeHistoryPrediction struct {
+	block   *big.Int
+	baseFee *assets.Wei
+	tip     *assets.Wei
+}
+
+// predictNextBlockFees fits a least squares line over the base fees and the RewardPercentile priority fees of the blocks
+// returned by eth_feeHistory, and extrapolates both to the next block. The predicted base fee is never lower than the base
+// fee of the next block reported by the RPC, and the predicted priority fee is kept between zero and the bumping threshold.
+// The average priority fee is used if there are not enough non-zero priority fees to fit a line.
+func (f *FeeHistoryEstimator) predictNextBlockFees(feeHistory *ethereum.FeeHistory, nextBaseFee, avgPriorityFee, priorityFeeThreshold *assets.Wei) (baseFee, priorityFee *assets.Wei) {
+	f.recordPredictionError(feeHistory)
+
+	n := len(feeHistory.Reward)
+	nextBlock := new(big.Int).Add(feeHistory.OldestBlock, big.NewInt(int64(n)))
+	var xs, ys []float64
+	for i := 0; i < n && i < len(feeHistory.BaseFee); i++ {
+		xs = append(xs, float64(i))
+		ys = append(ys, weiToFloat(feeHistory.BaseFee[i]))
+	}
+	baseFee = nextBaseFee
+	if len(xs) >= 2 {
+		if predicted := floatToWei(linearRegressionPredict(xs, ys, float64(n))); predicted.Cmp(baseFee) > 0 {
+			baseFee = predicted
+		}
+	}
+
+	xs, ys = xs[:0], ys[:0]
+	for i, reward := range feeHistory.Reward {
+		if len(reward) > 0 && reward[0].Sign() > 0 {
+			xs = append(xs, float64(i))
+			ys = append(ys, weiToFloat(reward[0]))
+		}
+	}
+	priorityFee = avgPriorityFee
+	if len(xs) >= 2 {
+		if predicted := floatToWei(linearRegressionPredict(xs, ys, float64(n))); !predicted.IsZero() {
+			priorityFee = assets.WeiMin(predicted, priorityFeeThreshold)
+		}
+	}
+
+	f.predictionMu.Lock()
+	f.prediction = &feeHistoryPrediction{block: nextBlock, baseFee: baseFee, tip: priorityFee}
+	f.predictionMu.Unlock()
+
+	f.logger.Debugw("Predicted next block fees", "nextBlock", nextBlock, "predictedBaseFee", baseFee, "reportedBaseFee", nextBaseFee,
+		"predictedPriorityFee", priorityFee, "averagePriorityFee", avgPriorityFee)
+	return
+}
+
+// recordPredictionError compares the previous prediction with the realized fees of its block, if the block is part of the fee history
+func (f *FeeHistoryEstimator) recordPredictionError(feeHistory *ethereum.FeeHistory) {
+	f.predictionMu.Lock()
+	prediction := f.prediction
+	f.predictionMu.Unlock()
+	if prediction == nil || feeHistory.OldestBlock == nil {
+		return
+	}
+
+	idx := new(big.Int).Sub(prediction.block, feeHistory.OldestBlock)
+	if !idx.IsInt64() || idx.Sign() < 0 || idx.Int64() >= int64(len(feeHistory.Reward)) {
+		return
+	}
+	i := idx.Int64()
+	if i < int64(len(feeHistory.BaseFee)) {
+		if realized := feeHistory.BaseFee[i]; realized.Sign() > 0 {
+			promFeeHistoryRegressionBaseFeeError.WithLabelValues(f.chainID.String()).Set(relativeErrorPercent(prediction.baseFee.ToInt(), realized))
+		}
+	}
+	if reward := feeHistory.Reward[i]; len(reward) > 0 && reward[0].Sign() > 0 {
+		promFeeHistoryRegressionTipError.WithLabelValues(f.chainID.String()).Set(relativeErrorPercent(prediction.tip.ToInt(), reward[0]))
+	}
+}
+
+// linearRegressionPredict fits y = a + b*x over the points with ordinary least squares and evaluates it at x
+func linearRegressionPredict(xs, ys []float64, x float64) float64 {
+	n := float64(len(xs))
+	var sumX, sumY, sumXY, sumXX float64
+	for i := range xs {
+		sumX += xs[i]
+		sumY += ys[i]
+		sumXY += xs[i] * ys[i]
+		sumXX += xs[i] * xs[i]
+	}
+	denom := n*sumXX - sumX*sumX
+	if denom == 0 {
+		return sumY / n
+	}
+	slope := (n*sumXY - sumX*sumY) / denom
+	intercept := (sumY - slope*sumX) / n
+	return intercept + slope*x
+}
+
+func relativeErrorPercent(predicted, realized *big.Int) float64 {
+	return (weiToFloat(predicted) - weiToFloat(realized)) / weiToFloat(realized) * 100
+}
+
+func weiToFloat(v *big.Int) float64 {
+	f, _ := new(big.Float).SetInt(v).Float64()
+	return f
+}
+
+// floatToWei converts the value to Wei, clamping negative values to zero
+func floatToWei(v float64) *assets.Wei {
+	if v <= 0 {
+		return assets.NewWeiI(0)
+	}
+	i, _ := big.NewFloat(v).Int(nil)
+	return assets.NewWei(i)
+}
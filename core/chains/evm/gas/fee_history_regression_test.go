@@ -0,0 +1,88 @@
+package gas_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/mocks"
+)
+
+func TestFeeHistoryRegressionEstimator(t *testing.T) {
+	t.Parallel()
+
+	maxPrice := assets.NewWeiI(1000)
+	chainID := big.NewInt(0)
+	cfg := gas.FeeHistoryEstimatorConfig{
+		BumpPercent:      20,
+		EIP1559:          true,
+		BlockHistorySize: 4,
+		RewardPercentile: 50,
+		Regression:       true,
+	}
+	feeHistory := func(baseFees []int64, tips []int64) *ethereum.FeeHistory {
+		h := &ethereum.FeeHistory{OldestBlock: big.NewInt(1)}
+		for _, b := range baseFees {
+			h.BaseFee = append(h.BaseFee, big.NewInt(b))
+		}
+		for _, tip := range tips {
+			h.Reward = append(h.Reward, []*big.Int{big.NewInt(tip), big.NewInt(50)}) // second one represents connectivity price
+		}
+		return h
+	}
+
+	t.Run("fails to start with less than 2 blocks of history", func(t *testing.T) {
+		cfg := cfg
+		cfg.BlockHistorySize = 1
+
+		u := gas.NewFeeHistoryEstimator(logger.Test(t), nil, cfg, chainID, nil)
+		assert.ErrorContains(t, u.Start(tests.Context(t)), "BlockHistorySize")
+	})
+
+	t.Run("extrapolates rising fees to the next block", func(t *testing.T) {
+		client := mocks.NewFeeHistoryEstimatorClient(t)
+		client.On("FeeHistory", mock.Anything, uint64(4), []float64{50, gas.ConnectivityPercentile}).
+			Return(feeHistory([]int64{10, 20, 30, 40, 45}, []int64{10, 20, 30, 40}), nil).Once()
+
+		u := gas.NewFeeHistoryEstimator(logger.Test(t), client, cfg, chainID, nil)
+		require.NoError(t, u.RefreshDynamicPrice())
+		fee, err := u.GetDynamicFee(tests.Context(t), maxPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(50), fee.TipCap)
+		assert.Equal(t, assets.NewWeiI(50).AddPercentage(gas.BaseFeeBufferPercentage).Add(assets.NewWeiI(50)), fee.FeeCap)
+	})
+
+	t.Run("falls back to the reported base fee and average tip for falling fees", func(t *testing.T) {
+		client := mocks.NewFeeHistoryEstimatorClient(t)
+		client.On("FeeHistory", mock.Anything, mock.Anything, mock.Anything).
+			Return(feeHistory([]int64{40, 30, 20, 10, 5}, []int64{40, 30, 20, 10}), nil).Once()
+
+		u := gas.NewFeeHistoryEstimator(logger.Test(t), client, cfg, chainID, nil)
+		require.NoError(t, u.RefreshDynamicPrice())
+		fee, err := u.GetDynamicFee(tests.Context(t), maxPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(25), fee.TipCap)
+		assert.Equal(t, assets.NewWeiI(5).AddPercentage(gas.BaseFeeBufferPercentage).Add(assets.NewWeiI(25)), fee.FeeCap)
+	})
+
+	t.Run("caps the predicted tip at the connectivity threshold", func(t *testing.T) {
+		client := mocks.NewFeeHistoryEstimatorClient(t)
+		client.On("FeeHistory", mock.Anything, mock.Anything, mock.Anything).
+			Return(feeHistory([]int64{10, 10, 10, 10, 10}, []int64{20, 30, 40, 50}), nil).Once()
+
+		u := gas.NewFeeHistoryEstimator(logger.Test(t), client, cfg, chainID, nil)
+		require.NoError(t, u.RefreshDynamicPrice())
+		fee, err := u.GetDynamicFee(tests.Context(t), maxPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(50), fee.TipCap)
+	})
+}
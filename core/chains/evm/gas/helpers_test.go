@@ -219,3 +219,11 @@ func (m *MockGasEstimatorConfig) Mode() string {
 func (m *MockGasEstimatorConfig) EstimateLimit() bool {
 	return m.EstimateLimitF
 }
+
+func NewTestProfiledEstimator(estimator EvmFeeEstimator, profiles map[string]EvmFeeEstimator) *ProfiledEstimator {
+	e := &ProfiledEstimator{EvmFeeEstimator: estimator, profiles: make(map[string]profileEstimator, len(profiles))}
+	for name, pe := range profiles {
+		e.profiles[name] = profileEstimator{EvmFeeEstimator: pe}
+	}
+	return e
+}
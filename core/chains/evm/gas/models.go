@@ -4,21 +4,27 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rpc"
 	pkgerrors "github.com/pkg/errors"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	bigmath "github.com/smartcontractkit/chainlink-common/pkg/utils/big_math"
 
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
 	commonfee "github.com/smartcontractkit/chainlink/v2/common/fee"
 	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
 	"github.com/smartcontractkit/chainlink/v2/common/headtracker"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
 	evmconfig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/chaintype"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/rollups"
@@ -29,6 +35,15 @@ import (
 // EstimateGasBuffer is a multiplier applied to estimated gas when the EstimateLimit feature is enabled
 const EstimateGasBuffer = float32(1.15)
 
+const (
+	// estimateGasBatchSize is the number of eth_estimateGas requests EstimateFeeLimits sends in a single JSON-RPC batch
+	estimateGasBatchSize = 50
+	// estimateGasBatchConcurrency is the number of batches EstimateFeeLimits has in flight at once
+	estimateGasBatchConcurrency = 4
+	// feeLimitEstimateTTL is how long a fee limit estimated by EstimateFeeLimits can be used by GetFee of the same call
+	feeLimitEstimateTTL = time.Minute
+)
+
 // EvmFeeEstimator provides a unified interface that wraps EvmEstimator and can determine if legacy or dynamic fee estimation should be used
 type EvmFeeEstimator interface {
 	services.Service
@@ -37,10 +52,27 @@ type EvmFeeEstimator interface {
 	// L1Oracle returns the L1 gas price oracle only if the chain has one, e.g. OP stack L2s and Arbitrum.
 	L1Oracle() rollups.L1Oracle
 	GetFee(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress, toAddress *common.Address, opts ...feetypes.Opt) (fee EvmFee, estimatedFeeLimit uint64, err error)
+	// EstimateFeeLimits estimates the fee limits of many transactions like GetFee does for one, sending their
+	// eth_estimateGas requests in JSON-RPC batches. errs[i] is set if the fee limit of calls[i] could not be estimated.
+	// The estimates are used by the next GetFee of the same call, if made shortly after.
+	EstimateFeeLimits(ctx context.Context, calls []FeeLimitCall) (estimatedFeeLimits []uint64, errs []error)
 	BumpFee(ctx context.Context, originalFee EvmFee, feeLimit uint64, maxFeePrice *assets.Wei, attempts []EvmPriorAttempt) (bumpedFee EvmFee, chainSpecificFeeLimit uint64, err error)
 
 	// GetMaxCost returns the total value = max price x fee units + transferred value
 	GetMaxCost(ctx context.Context, amount assets.Eth, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress, toAddress *common.Address, opts ...feetypes.Opt) (*big.Int, error)
+
+	// GetBlobFee returns the blob fee cap for a new EIP-4844 blob transaction
+	GetBlobFee(ctx context.Context, maxBlobFee *assets.Wei) (*assets.Wei, error)
+	// BumpBlobFee returns the blob fee cap to replace a blob transaction with
+	BumpBlobFee(ctx context.Context, originalBlobFee *assets.Wei, maxBlobFee *assets.Wei) (*assets.Wei, error)
+}
+
+// FeeLimitCall is a transaction whose fee limit is estimated by EstimateFeeLimits
+type FeeLimitCall struct {
+	Calldata    []byte
+	FeeLimit    uint64
+	FromAddress *common.Address
+	ToAddress   *common.Address
 }
 
 type feeEstimatorClient interface {
@@ -54,6 +86,43 @@ type feeEstimatorClient interface {
 	FeeHistory(ctx context.Context, blockCount uint64, rewardPercentiles []float64) (feeHistory *ethereum.FeeHistory, err error)
 }
 
+// requestSourceClient tags all requests of the estimators, so that they are weighted accordingly by the RPC rate limiter.
+type requestSourceClient struct {
+	feeEstimatorClient
+}
+
+func (c *requestSourceClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return c.feeEstimatorClient.CallContract(withRequestSource(ctx), msg, blockNumber)
+}
+
+func (c *requestSourceClient) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	return c.feeEstimatorClient.BatchCallContext(withRequestSource(ctx), b)
+}
+
+func (c *requestSourceClient) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	return c.feeEstimatorClient.CallContext(withRequestSource(ctx), result, method, args...)
+}
+
+func (c *requestSourceClient) HeadByNumber(ctx context.Context, n *big.Int) (*evmtypes.Head, error) {
+	return c.feeEstimatorClient.HeadByNumber(withRequestSource(ctx), n)
+}
+
+func (c *requestSourceClient) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	return c.feeEstimatorClient.EstimateGas(withRequestSource(ctx), call)
+}
+
+func (c *requestSourceClient) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return c.feeEstimatorClient.SuggestGasPrice(withRequestSource(ctx))
+}
+
+func (c *requestSourceClient) FeeHistory(ctx context.Context, blockCount uint64, rewardPercentiles []float64) (*ethereum.FeeHistory, error) {
+	return c.feeEstimatorClient.FeeHistory(withRequestSource(ctx), blockCount, rewardPercentiles)
+}
+
+func withRequestSource(ctx context.Context) context.Context {
+	return commonclient.CtxWithRequestSource(ctx, commonclient.RequestSourceGasEstimator)
+}
+
 // NewEstimator returns the estimator for a given config
 func NewEstimator(lggr logger.Logger, ethClient feeEstimatorClient, cfg Config, geCfg evmconfig.GasEstimator) (EvmFeeEstimator, error) {
 	bh := geCfg.BlockHistory()
@@ -79,6 +148,7 @@ func NewEstimator(lggr logger.Logger, ethClient feeEstimatorClient, cfg Config,
 		"estimateLimit", geCfg.EstimateLimit(),
 	)
 	df := geCfg.EIP1559DynamicFees()
+	ethClient = &requestSourceClient{ethClient}
 
 	// create l1Oracle only if it is supported for the chain
 	var l1Oracle rollups.L1Oracle
@@ -122,6 +192,18 @@ func NewEstimator(lggr logger.Logger, ethClient feeEstimatorClient, cfg Config,
 			}
 			return NewFeeHistoryEstimator(lggr, ethClient, ccfg, ethClient.ConfiguredChainID(), l1Oracle)
 		}
+	case "FeeHistoryRegression":
+		newEstimator = func(l logger.Logger) EvmEstimator {
+			ccfg := FeeHistoryEstimatorConfig{
+				BumpPercent:      geCfg.BumpPercent(),
+				CacheTimeout:     geCfg.FeeHistory().CacheTimeout(),
+				EIP1559:          geCfg.EIP1559DynamicFees(),
+				BlockHistorySize: uint64(geCfg.FeeHistory().RegressionLookback()),
+				RewardPercentile: float64(geCfg.FeeHistory().RegressionPercentile()),
+				Regression:       true,
+			}
+			return NewFeeHistoryEstimator(lggr, ethClient, ccfg, ethClient.ConfiguredChainID(), l1Oracle)
+		}
 
 	default:
 		lggr.Warnf("GasEstimator: unrecognised mode '%s', falling back to FixedPriceEstimator", s)
@@ -201,6 +283,29 @@ type evmFeeEstimator struct {
 	EIP1559Enabled bool
 	geCfg          GasEstimatorConfig
 	ethClient      feeEstimatorClient
+
+	feeLimitEstimatesMu sync.Mutex
+	feeLimitEstimates   map[feeLimitEstimateKey]feeLimitEstimate
+}
+
+// feeLimitEstimateKey identifies the gas estimation of a call with a provided gas limit
+type feeLimitEstimateKey struct {
+	from, to         common.Address
+	calldataHash     common.Hash
+	providedGasLimit uint64
+}
+
+func newFeeLimitEstimateKey(callMsg ethereum.CallMsg, providedGasLimit uint64) feeLimitEstimateKey {
+	key := feeLimitEstimateKey{from: callMsg.From, calldataHash: crypto.Keccak256Hash(callMsg.Data), providedGasLimit: providedGasLimit}
+	if callMsg.To != nil {
+		key.to = *callMsg.To
+	}
+	return key
+}
+
+type feeLimitEstimate struct {
+	feeLimit  uint64
+	expiresAt time.Time
 }
 
 var _ EvmFeeEstimator = (*evmFeeEstimator)(nil)
@@ -213,6 +318,8 @@ func NewEvmFeeEstimator(lggr logger.Logger, newEstimator func(logger.Logger) Evm
 		EIP1559Enabled: eip1559Enabled,
 		geCfg:          geCfg,
 		ethClient:      ethClient,
+
+		feeLimitEstimates: make(map[feeLimitEstimateKey]feeLimitEstimate),
 	}
 }
 
@@ -370,7 +477,115 @@ func (e *evmFeeEstimator) estimateFeeLimit(ctx context.Context, feeLimit uint64,
 	if !e.geCfg.EstimateLimit() {
 		return providedGasLimit, nil
 	}
-	// Create call msg for gas limit estimation
+	callMsg := newEstimateGasCallMsg(calldata, fromAddress, toAddress)
+	if estimated, ok := e.takeFeeLimitEstimate(newFeeLimitEstimateKey(callMsg, providedGasLimit)); ok {
+		return estimated, nil
+	}
+	estimatedGas, estimateErr := e.ethClient.EstimateGas(ctx, callMsg)
+	return e.applyEstimatedGas(callMsg, providedGasLimit, estimatedGas, estimateErr)
+}
+
+// EstimateFeeLimits does the fee limit estimation of GetFee for all calls, but sends their eth_estimateGas requests in
+// batches of estimateGasBatchSize, with at most estimateGasBatchConcurrency batches in flight, so that estimating a
+// large batch of transactions doesn't take one round trip per transaction. The fee limits are not adjusted by the
+// legacy estimators like GetFee does. Successful estimates are kept for feeLimitEstimateTTL, and taken by the first
+// GetFee of the same call, so that building the attempts of the transactions after a batch estimation does not
+// estimate each of them again.
+func (e *evmFeeEstimator) EstimateFeeLimits(ctx context.Context, calls []FeeLimitCall) (estimatedFeeLimits []uint64, errs []error) {
+	estimatedFeeLimits = make([]uint64, len(calls))
+	errs = make([]error, len(calls))
+	providedGasLimits := make([]uint64, len(calls))
+	callMsgs := make([]ethereum.CallMsg, len(calls))
+	var (
+		reqs    []rpc.BatchElem
+		reqIdxs []int
+	)
+	for i, call := range calls {
+		providedGasLimits[i], errs[i] = commonfee.ApplyMultiplier(call.FeeLimit, e.geCfg.LimitMultiplier())
+		if errs[i] != nil {
+			continue
+		}
+		if !e.geCfg.EstimateLimit() {
+			estimatedFeeLimits[i] = providedGasLimits[i]
+			continue
+		}
+		callMsgs[i] = newEstimateGasCallMsg(call.Calldata, call.FromAddress, call.ToAddress)
+		reqs = append(reqs, rpc.BatchElem{
+			Method: "eth_estimateGas",
+			Args:   []interface{}{client.ToBackwardCompatibleCallArg(callMsgs[i])},
+			Result: new(hexutil.Uint64),
+		})
+		reqIdxs = append(reqIdxs, i)
+	}
+	if len(reqs) == 0 {
+		return
+	}
+
+	e.batchEstimateGas(ctx, reqs)
+	estimates := make(map[feeLimitEstimateKey]uint64, len(reqIdxs))
+	for j, i := range reqIdxs {
+		estimatedGas := uint64(*reqs[j].Result.(*hexutil.Uint64))
+		estimatedFeeLimits[i], errs[i] = e.applyEstimatedGas(callMsgs[i], providedGasLimits[i], estimatedGas, reqs[j].Error)
+		// Fallbacks to the provided gas limit are not kept, so that GetFee tries to estimate those calls again
+		if reqs[j].Error == nil && errs[i] == nil {
+			estimates[newFeeLimitEstimateKey(callMsgs[i], providedGasLimits[i])] = estimatedFeeLimits[i]
+		}
+	}
+	e.putFeeLimitEstimates(estimates)
+	return
+}
+
+// putFeeLimitEstimates keeps the estimates for feeLimitEstimateTTL, and drops those which expired
+func (e *evmFeeEstimator) putFeeLimitEstimates(estimates map[feeLimitEstimateKey]uint64) {
+	now := time.Now()
+	e.feeLimitEstimatesMu.Lock()
+	defer e.feeLimitEstimatesMu.Unlock()
+	for key, estimate := range e.feeLimitEstimates {
+		if now.After(estimate.expiresAt) {
+			delete(e.feeLimitEstimates, key)
+		}
+	}
+	for key, feeLimit := range estimates {
+		e.feeLimitEstimates[key] = feeLimitEstimate{feeLimit: feeLimit, expiresAt: now.Add(feeLimitEstimateTTL)}
+	}
+}
+
+// takeFeeLimitEstimate returns and forgets the unexpired estimate of the call, if there is one
+func (e *evmFeeEstimator) takeFeeLimitEstimate(key feeLimitEstimateKey) (feeLimit uint64, ok bool) {
+	e.feeLimitEstimatesMu.Lock()
+	defer e.feeLimitEstimatesMu.Unlock()
+	estimate, ok := e.feeLimitEstimates[key]
+	if !ok {
+		return 0, false
+	}
+	delete(e.feeLimitEstimates, key)
+	if time.Now().After(estimate.expiresAt) {
+		return 0, false
+	}
+	return estimate.feeLimit, true
+}
+
+// batchEstimateGas sends the eth_estimateGas requests in concurrent batches, and sets the error of all the requests of
+// a batch which failed as a whole.
+func (e *evmFeeEstimator) batchEstimateGas(ctx context.Context, reqs []rpc.BatchElem) {
+	var g errgroup.Group
+	g.SetLimit(estimateGasBatchConcurrency)
+	for i := 0; i < len(reqs); i += estimateGasBatchSize {
+		batch := reqs[i:min(i+estimateGasBatchSize, len(reqs))]
+		g.Go(func() error {
+			if err := e.ethClient.BatchCallContext(ctx, batch); err != nil {
+				for k := range batch {
+					batch[k].Error = err
+				}
+			}
+			return nil
+		})
+	}
+	_ = g.Wait()
+}
+
+// newEstimateGasCallMsg creates the call msg for gas limit estimation
+func newEstimateGasCallMsg(calldata []byte, fromAddress, toAddress *common.Address) ethereum.CallMsg {
 	// Skip setting Gas to avoid capping the results of the estimation
 	callMsg := ethereum.CallMsg{
 		To:   toAddress,
@@ -379,7 +594,12 @@ func (e *evmFeeEstimator) estimateFeeLimit(ctx context.Context, feeLimit uint64,
 	if fromAddress != nil {
 		callMsg.From = *fromAddress
 	}
-	estimatedGas, estimateErr := e.ethClient.EstimateGas(ctx, callMsg)
+	return callMsg
+}
+
+// applyEstimatedGas checks the result of the gas estimation of callMsg against the provided gas limit, and returns the
+// estimated fee limit with EstimateGasBuffer applied.
+func (e *evmFeeEstimator) applyEstimatedGas(callMsg ethereum.CallMsg, providedGasLimit uint64, estimatedGas uint64, estimateErr error) (estimatedFeeLimit uint64, err error) {
 	if estimateErr != nil {
 		if providedGasLimit > 0 {
 			// Do not return error if estimate gas failed, we can still use the provided limit instead since it is an upper limit
@@ -3,8 +3,11 @@ package gas_test
 import (
 	"errors"
 	"math/big"
+	"sync"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
 	pkgerrors "github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -405,4 +408,120 @@ func TestWrappedEvmEstimator(t *testing.T) {
 		_, _, err = estimator.GetFee(ctx, []byte{}, 0, nil, &fromAddress, &toAddress)
 		require.Error(t, err)
 	})
+
+	t.Run("EstimateFeeLimits, estimate gas limit disabled, returns provided limits", func(t *testing.T) {
+		lggr := logger.Test(t)
+		geCfg.EstimateLimitF = false
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		estimator := gas.NewEvmFeeEstimator(lggr, getRootEst, false, geCfg, ethClient)
+		limits, errs := estimator.EstimateFeeLimits(ctx, []gas.FeeLimitCall{
+			{FeeLimit: gasLimit, FromAddress: &fromAddress, ToAddress: &toAddress},
+			{FeeLimit: 2 * gasLimit, FromAddress: &fromAddress, ToAddress: &toAddress},
+		})
+		assert.Equal(t, []uint64{uint64(float32(gasLimit) * limitMultiplier), uint64(float32(2*gasLimit) * limitMultiplier)}, limits)
+		assert.Equal(t, []error{nil, nil}, errs)
+	})
+
+	t.Run("EstimateFeeLimits, estimate gas limit enabled, batches requests", func(t *testing.T) {
+		estimatedGasLimit := uint64(5)
+		lggr := logger.Test(t)
+		geCfg.EstimateLimitF = true
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		var (
+			mu         sync.Mutex
+			batchSizes []int
+		)
+		ethClient.On("BatchCallContext", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			reqs := args.Get(1).([]rpc.BatchElem)
+			mu.Lock()
+			batchSizes = append(batchSizes, len(reqs))
+			mu.Unlock()
+			for i := range reqs {
+				assert.Equal(t, "eth_estimateGas", reqs[i].Method)
+				switch reqs[i].Args[0].(map[string]interface{})["data"].(hexutil.Bytes)[0] {
+				case 7:
+					reqs[i].Error = errors.New("execution reverted")
+				case 8:
+					*reqs[i].Result.(*hexutil.Uint64) = 100
+				default:
+					*reqs[i].Result.(*hexutil.Uint64) = hexutil.Uint64(estimatedGasLimit)
+				}
+			}
+		}).Return(nil)
+		estimator := gas.NewEvmFeeEstimator(lggr, getRootEst, false, geCfg, ethClient)
+
+		calls := make([]gas.FeeLimitCall, 120)
+		for i := range calls {
+			calls[i] = gas.FeeLimitCall{Calldata: []byte{byte(i)}, FeeLimit: gasLimit, FromAddress: &fromAddress, ToAddress: &toAddress}
+		}
+		limits, errs := estimator.EstimateFeeLimits(ctx, calls)
+		require.Len(t, limits, len(calls))
+		require.Len(t, errs, len(calls))
+		for i := range calls {
+			switch i {
+			case 7:
+				// RPC failed, falls back to the provided limit
+				require.NoError(t, errs[i])
+				assert.Equal(t, uint64(float32(gasLimit)*limitMultiplier), limits[i])
+			case 8:
+				require.ErrorIs(t, errs[i], commonfee.ErrFeeLimitTooLow)
+			default:
+				require.NoError(t, errs[i])
+				assert.Equal(t, uint64(float32(estimatedGasLimit)*gas.EstimateGasBuffer), limits[i])
+			}
+		}
+		assert.ElementsMatch(t, []int{50, 50, 20}, batchSizes)
+	})
+
+	t.Run("EstimateFeeLimits, estimate gas limit enabled, failed batch fallsback to provided limit", func(t *testing.T) {
+		lggr := logger.Test(t)
+		geCfg.EstimateLimitF = true
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		ethClient.On("BatchCallContext", mock.Anything, mock.Anything).Return(errors.New("something broke")).Once()
+		estimator := gas.NewEvmFeeEstimator(lggr, getRootEst, false, geCfg, ethClient)
+		limits, errs := estimator.EstimateFeeLimits(ctx, []gas.FeeLimitCall{
+			{FeeLimit: gasLimit, FromAddress: &fromAddress, ToAddress: &toAddress},
+			{FeeLimit: 0, FromAddress: &fromAddress, ToAddress: &toAddress},
+		})
+		require.NoError(t, errs[0])
+		assert.Equal(t, uint64(float32(gasLimit)*limitMultiplier), limits[0])
+		require.Error(t, errs[1])
+	})
+
+	t.Run("EstimateFeeLimits, estimate gas limit enabled, GetFee uses the estimates once", func(t *testing.T) {
+		batchEstimate, singleEstimate := uint64(5), uint64(8)
+		lggr := logger.Test(t)
+		geCfg.EstimateLimitF = true
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		ethClient.On("BatchCallContext", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			reqs := args.Get(1).([]rpc.BatchElem)
+			for i := range reqs {
+				*reqs[i].Result.(*hexutil.Uint64) = hexutil.Uint64(batchEstimate)
+			}
+		}).Return(nil).Once()
+		ethClient.On("EstimateGas", mock.Anything, mock.Anything).Return(singleEstimate, nil).Twice()
+		evmEstimator := mocks.NewEvmEstimator(t)
+		evmEstimator.On("GetLegacyGas", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(legacyFee, gasLimit, nil).Times(3)
+		getEst := func(logger.Logger) gas.EvmEstimator { return evmEstimator }
+		estimator := gas.NewEvmFeeEstimator(lggr, getEst, false, geCfg, ethClient)
+		_, errs := estimator.EstimateFeeLimits(ctx, []gas.FeeLimitCall{
+			{Calldata: []byte{1}, FeeLimit: gasLimit, FromAddress: &fromAddress, ToAddress: &toAddress},
+		})
+		require.NoError(t, errs[0])
+
+		// a different call is estimated on its own
+		_, limit, err := estimator.GetFee(ctx, []byte{2}, gasLimit, nil, &fromAddress, &toAddress)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(float32(singleEstimate)*gas.EstimateGasBuffer), limit)
+
+		_, limit, err = estimator.GetFee(ctx, []byte{1}, gasLimit, nil, &fromAddress, &toAddress)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(float32(batchEstimate)*gas.EstimateGasBuffer), limit)
+
+		// the estimate was taken, so the same call is estimated again
+		_, limit, err = estimator.GetFee(ctx, []byte{1}, gasLimit, nil, &fromAddress, &toAddress)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(float32(singleEstimate)*gas.EstimateGasBuffer), limit)
+	})
 }
@@ -0,0 +1,178 @@
+package gas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmconfig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
+)
+
+var promOnChainPriceMax = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "gas_estimator_onchain_price_max",
+	Help: "Maximum gas price in Wei, as last read from the configured on-chain contract",
+},
+	[]string{"evmChainID"},
+)
+
+type onChainPriceMaxClient interface {
+	CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+	ConfiguredChainID() *big.Int
+}
+
+// OnChainPriceMaxEstimator wraps an EvmFeeEstimator and caps the max fee price passed to it with a maximum gas price
+// read periodically from an on-chain contract, like a price registry or fee quoter. This lets the cap follow the market
+// without redeploying the config, while the configured PriceMax still applies whenever it is lower.
+type OnChainPriceMaxEstimator struct {
+	EvmFeeEstimator
+	sm     services.StateMachine
+	lggr   logger.SugaredLogger
+	client onChainPriceMaxClient
+	cfg    evmconfig.OnChainPriceMax
+
+	chStop services.StopChan
+	wg     sync.WaitGroup
+
+	mu       sync.RWMutex
+	priceMax *assets.Wei
+	err      error
+}
+
+var _ EvmFeeEstimator = (*OnChainPriceMaxEstimator)(nil)
+
+// NewOnChainPriceMaxEstimator wraps estimator with the on-chain price max configured by cfg
+func NewOnChainPriceMaxEstimator(lggr logger.Logger, client onChainPriceMaxClient, cfg evmconfig.OnChainPriceMax, estimator EvmFeeEstimator) *OnChainPriceMaxEstimator {
+	return &OnChainPriceMaxEstimator{
+		EvmFeeEstimator: estimator,
+		lggr:            logger.Sugared(logger.Named(lggr, "OnChainPriceMax")),
+		client:          client,
+		cfg:             cfg,
+		chStop:          make(chan struct{}),
+	}
+}
+
+func (e *OnChainPriceMaxEstimator) Start(ctx context.Context) error {
+	return e.sm.StartOnce("OnChainPriceMaxEstimator", func() error {
+		if err := e.EvmFeeEstimator.Start(ctx); err != nil {
+			return err
+		}
+		// The first read is made synchronously, so that no transaction is priced above the on-chain max after startup
+		e.refresh(ctx)
+		e.wg.Add(1)
+		go e.run()
+		return nil
+	})
+}
+
+func (e *OnChainPriceMaxEstimator) Close() error {
+	return e.sm.StopOnce("OnChainPriceMaxEstimator", func() error {
+		close(e.chStop)
+		e.wg.Wait()
+		return e.EvmFeeEstimator.Close()
+	})
+}
+
+func (e *OnChainPriceMaxEstimator) Ready() error {
+	return errors.Join(e.sm.Ready(), e.EvmFeeEstimator.Ready())
+}
+
+func (e *OnChainPriceMaxEstimator) HealthReport() map[string]error {
+	report := e.EvmFeeEstimator.HealthReport()
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	report[e.lggr.Name()] = errors.Join(e.sm.Healthy(), e.err)
+	return report
+}
+
+func (e *OnChainPriceMaxEstimator) GetFee(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress, toAddress *common.Address, opts ...feetypes.Opt) (fee EvmFee, estimatedFeeLimit uint64, err error) {
+	return e.EvmFeeEstimator.GetFee(ctx, calldata, feeLimit, e.capPrice(maxFeePrice), fromAddress, toAddress, opts...)
+}
+
+func (e *OnChainPriceMaxEstimator) BumpFee(ctx context.Context, originalFee EvmFee, feeLimit uint64, maxFeePrice *assets.Wei, attempts []EvmPriorAttempt) (bumpedFee EvmFee, chainSpecificFeeLimit uint64, err error) {
+	return e.EvmFeeEstimator.BumpFee(ctx, originalFee, feeLimit, e.capPrice(maxFeePrice), attempts)
+}
+
+func (e *OnChainPriceMaxEstimator) GetMaxCost(ctx context.Context, amount assets.Eth, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress, toAddress *common.Address, opts ...feetypes.Opt) (*big.Int, error) {
+	return e.EvmFeeEstimator.GetMaxCost(ctx, amount, calldata, feeLimit, e.capPrice(maxFeePrice), fromAddress, toAddress, opts...)
+}
+
+// PriceMax returns the last maximum gas price read from the contract, or nil if none was read yet
+func (e *OnChainPriceMaxEstimator) PriceMax() *assets.Wei {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.priceMax
+}
+
+// capPrice returns the lower of maxFeePrice and the on-chain price max
+func (e *OnChainPriceMaxEstimator) capPrice(maxFeePrice *assets.Wei) *assets.Wei {
+	priceMax := e.PriceMax()
+	if priceMax == nil || (maxFeePrice != nil && maxFeePrice.Cmp(priceMax) <= 0) {
+		return maxFeePrice
+	}
+	return priceMax
+}
+
+func (e *OnChainPriceMaxEstimator) run() {
+	defer e.wg.Done()
+	ctx, cancel := e.chStop.NewCtx()
+	defer cancel()
+
+	t := services.TickerConfig{JitterPct: services.DefaultJitter}.NewTicker(e.cfg.PollInterval())
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			e.refresh(ctx)
+		}
+	}
+}
+
+// refresh reads the price max from the contract. The last price max read is kept if it fails.
+func (e *OnChainPriceMaxEstimator) refresh(ctx context.Context) {
+	priceMax, err := e.fetch(ctx)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.err = err
+	if err != nil {
+		e.lggr.Warnw("Failed to read the on-chain price max, keeping the last one", "contract", e.cfg.Contract(), "priceMax", e.priceMax, "err", err)
+		return
+	}
+	if e.priceMax == nil || e.priceMax.Cmp(priceMax) != 0 {
+		e.lggr.Infow("Updated the on-chain price max", "contract", e.cfg.Contract(), "priceMax", priceMax, "previousPriceMax", e.priceMax)
+	}
+	e.priceMax = priceMax
+	promOnChainPriceMax.WithLabelValues(e.client.ConfiguredChainID().String()).Set(float64(priceMax.Int64()))
+}
+
+func (e *OnChainPriceMaxEstimator) fetch(ctx context.Context) (*assets.Wei, error) {
+	ctx, cancel := context.WithTimeout(ctx, commonclient.QueryTimeout)
+	defer cancel()
+	contract := e.cfg.Contract()
+	res, err := e.client.CallContract(ctx, ethereum.CallMsg{To: &contract, Data: e.cfg.Calldata()}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call contract: %w", err)
+	}
+	if len(res) < 32 {
+		return nil, fmt.Errorf("expected at least 32 bytes of return data, got %d", len(res))
+	}
+	priceMax := assets.NewWei(new(big.Int).SetBytes(res[:32]))
+	if priceMax.IsZero() {
+		return nil, errors.New("contract returned a price max of 0")
+	}
+	return priceMax, nil
+}
@@ -0,0 +1,94 @@
+package gas_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
+)
+
+type onChainPriceMaxConfig struct {
+	contract     common.Address
+	calldata     []byte
+	pollInterval time.Duration
+}
+
+func (c *onChainPriceMaxConfig) Enabled() bool               { return true }
+func (c *onChainPriceMaxConfig) Contract() common.Address    { return c.contract }
+func (c *onChainPriceMaxConfig) Calldata() []byte            { return c.calldata }
+func (c *onChainPriceMaxConfig) PollInterval() time.Duration { return c.pollInterval }
+
+func TestOnChainPriceMaxEstimator(t *testing.T) {
+	t.Parallel()
+	ctx := tests.Context(t)
+
+	cfg := &onChainPriceMaxConfig{
+		contract:     testutils.NewAddress(),
+		calldata:     []byte{0x3d, 0xe3, 0x9c, 0x11},
+		pollInterval: 10 * time.Millisecond,
+	}
+	msg := ethereum.CallMsg{To: &cfg.contract, Data: cfg.calldata}
+	priceMax := assets.GWei(50)
+
+	client := mocks.NewFeeEstimatorClient(t)
+	client.On("ConfiguredChainID").Return(big.NewInt(0)).Maybe()
+	client.On("CallContract", mock.Anything, msg, (*big.Int)(nil)).
+		Return(common.LeftPadBytes(priceMax.ToInt().Bytes(), 32), nil).Once()
+	client.On("CallContract", mock.Anything, msg, (*big.Int)(nil)).
+		Return(nil, errors.New("connection refused")).Maybe()
+
+	inner := mocks.NewEvmFeeEstimator(t)
+	inner.On("Start", mock.Anything).Return(nil).Once()
+	inner.On("Close").Return(nil).Once()
+	inner.On("HealthReport").Return(map[string]error{"inner": nil}).Maybe()
+
+	est := gas.NewOnChainPriceMaxEstimator(logger.Test(t), client, cfg, inner)
+	require.NoError(t, est.Start(ctx))
+	t.Cleanup(func() { assert.NoError(t, est.Close()) })
+	assert.Equal(t, priceMax, est.PriceMax())
+
+	from, to := testutils.NewAddress(), testutils.NewAddress()
+	fee := gas.EvmFee{Legacy: assets.GWei(10)}
+
+	t.Run("caps the max fee price above the on-chain price max", func(t *testing.T) {
+		inner.On("GetFee", mock.Anything, []byte(nil), uint64(21000), priceMax, &from, &to).Return(fee, uint64(21000), nil).Once()
+		_, _, err := est.GetFee(ctx, nil, 21000, assets.GWei(100), &from, &to)
+		require.NoError(t, err)
+
+		inner.On("BumpFee", mock.Anything, fee, uint64(21000), priceMax, []gas.EvmPriorAttempt(nil)).Return(fee, uint64(21000), nil).Once()
+		_, _, err = est.BumpFee(ctx, fee, 21000, assets.GWei(100), nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("keeps the max fee price below the on-chain price max", func(t *testing.T) {
+		inner.On("GetFee", mock.Anything, []byte(nil), uint64(21000), assets.GWei(30), &from, &to).Return(fee, uint64(21000), nil).Once()
+		_, _, err := est.GetFee(ctx, nil, 21000, assets.GWei(30), &from, &to)
+		require.NoError(t, err)
+	})
+
+	t.Run("keeps the last price max while the contract can't be called", func(t *testing.T) {
+		require.Eventually(t, func() bool {
+			for _, err := range est.HealthReport() {
+				if err != nil {
+					return true
+				}
+			}
+			return false
+		}, tests.WaitTimeout(t), cfg.pollInterval)
+		assert.Equal(t, priceMax, est.PriceMax())
+	})
+}
@@ -0,0 +1,107 @@
+package gas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	evmconfig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+type profileEstimator struct {
+	EvmFeeEstimator
+	cfg evmconfig.GasEstimator
+}
+
+// ProfiledEstimator wraps the default EvmFeeEstimator of a chain together with one estimator per named gas estimator
+// profile. Each profile estimator runs with its own mode, bump parameters and price ceiling, and is managed alongside the
+// default estimator.
+type ProfiledEstimator struct {
+	EvmFeeEstimator
+	profiles map[string]profileEstimator
+}
+
+var _ EvmFeeEstimator = (*ProfiledEstimator)(nil)
+
+// NewProfiledEstimator builds an estimator for each of the profiles and wraps them together with the default estimator
+func NewProfiledEstimator(lggr logger.Logger, ethClient feeEstimatorClient, cfg Config, estimator EvmFeeEstimator, profiles map[string]evmconfig.GasEstimator) (*ProfiledEstimator, error) {
+	e := &ProfiledEstimator{EvmFeeEstimator: estimator, profiles: make(map[string]profileEstimator, len(profiles))}
+	for name, geCfg := range profiles {
+		pe, err := NewEstimator(logger.Named(lggr, name), ethClient, cfg, geCfg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize estimator for gas estimator profile %s: %w", name, err)
+		}
+		e.profiles[name] = profileEstimator{EvmFeeEstimator: pe, cfg: geCfg}
+	}
+	return e, nil
+}
+
+// Profile returns the estimator and config of the named profile, or false if there is no such profile
+func (e *ProfiledEstimator) Profile(name string) (EvmFeeEstimator, evmconfig.GasEstimator, bool) {
+	p, ok := e.profiles[name]
+	return p.EvmFeeEstimator, p.cfg, ok
+}
+
+// BumpThresholds returns the BumpThreshold of each profile, keyed by name.
+func (e *ProfiledEstimator) BumpThresholds() map[string]uint64 {
+	thresholds := make(map[string]uint64, len(e.profiles))
+	for name, p := range e.profiles {
+		thresholds[name] = p.cfg.BumpThreshold()
+	}
+	return thresholds
+}
+
+// Start starts the default estimator and those of the profiles. If any fails to start, the ones already started are
+// closed.
+func (e *ProfiledEstimator) Start(ctx context.Context) error {
+	if err := e.EvmFeeEstimator.Start(ctx); err != nil {
+		return err
+	}
+	started := []services.Service{e.EvmFeeEstimator}
+	for name, p := range e.profiles {
+		if err := p.Start(ctx); err != nil {
+			return errors.Join(fmt.Errorf("failed to start estimator for gas estimator profile %s: %w", name, err), services.MultiCloser(started).Close())
+		}
+		started = append(started, p)
+	}
+	return nil
+}
+
+func (e *ProfiledEstimator) Close() error {
+	errs := []error{e.EvmFeeEstimator.Close()}
+	for _, p := range e.profiles {
+		errs = append(errs, p.Close())
+	}
+	return errors.Join(errs...)
+}
+
+func (e *ProfiledEstimator) Ready() error {
+	if err := e.EvmFeeEstimator.Ready(); err != nil {
+		return err
+	}
+	for _, p := range e.profiles {
+		if err := p.Ready(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *ProfiledEstimator) HealthReport() map[string]error {
+	report := e.EvmFeeEstimator.HealthReport()
+	for _, p := range e.profiles {
+		services.CopyHealth(report, p.HealthReport())
+	}
+	return report
+}
+
+func (e *ProfiledEstimator) OnNewLongestChain(ctx context.Context, head *evmtypes.Head) {
+	e.EvmFeeEstimator.OnNewLongestChain(ctx, head)
+	for _, p := range e.profiles {
+		p.OnNewLongestChain(ctx, head)
+	}
+}
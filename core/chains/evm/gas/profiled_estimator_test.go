@@ -0,0 +1,30 @@
+package gas_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/mocks"
+)
+
+func TestProfiledEstimator_Start(t *testing.T) {
+	t.Parallel()
+
+	t.Run("closes the started estimators if a profile fails to start", func(t *testing.T) {
+		defaultEstimator := mocks.NewEvmFeeEstimator(t)
+		defaultEstimator.On("Start", mock.Anything).Return(nil).Once()
+		defaultEstimator.On("Close").Return(nil).Once()
+		profileEstimator := mocks.NewEvmFeeEstimator(t)
+		profileEstimator.On("Start", mock.Anything).Return(errors.New("rpc down")).Once()
+
+		e := gas.NewTestProfiledEstimator(defaultEstimator, map[string]gas.EvmFeeEstimator{"ccip-exec": profileEstimator})
+		err := e.Start(tests.Context(t))
+		require.ErrorContains(t, err, "failed to start estimator for gas estimator profile ccip-exec: rpc down")
+	})
+}
@@ -39,6 +39,9 @@ func (h *headTrackerConfig) MaxBufferSize() uint32 {
 func (h *headTrackerConfig) FinalityTagBypass() bool {
 	return false
 }
+func (h *headTrackerConfig) FinalityDepthFallback() bool {
+	return false
+}
 func (h *headTrackerConfig) MaxAllowedFinalityDepth() uint32 {
 	return 10000
 }
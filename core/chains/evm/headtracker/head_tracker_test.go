@@ -671,6 +671,8 @@ func TestHeadTracker_SwitchesToLongestChainWithHeadSamplingDisabled(t *testing.T
 	checker := htmocks.NewHeadTrackable[*evmtypes.Head, common.Hash](t)
 	orm := headtracker.NewORM(*testutils.FixtureChainID, db)
 	ht := createHeadTrackerWithChecker(t, ethClient, config.EVM(), config.EVM().HeadTracker(), orm, checker)
+	reorgs := make(chan httypes.Reorg, 10)
+	ht.reorgBus.Subscribe(reorgSubscriberFunc(func(ctx context.Context, reorg httypes.Reorg) { reorgs <- reorg }))
 
 	chchHeaders := make(chan testutils.RawSub[*evmtypes.Head], 1)
 	mockEth := &testutils.MockEth{EthClient: ethClient}
@@ -778,6 +780,19 @@ func TestHeadTracker_SwitchesToLongestChainWithHeadSamplingDisabled(t *testing.T
 
 	// default 10s may not be sufficient, so using tests.WaitTimeout(t)
 	lastLongestChainAwaiter.AwaitOrFail(t, tests.WaitTimeout(t))
+
+	// the forked chain orphans the blocks after block 1
+	select {
+	case reorg := <-reorgs:
+		assert.Equal(t, blocksForked.Head(5).Hash, reorg.NewHead.Hash)
+		require.NotNil(t, reorg.CommonAncestor)
+		assert.Equal(t, blocks.Head(1).Hash, reorg.CommonAncestor.BlockHash())
+		require.NotEmpty(t, reorg.Orphaned)
+		assert.Equal(t, blocks.Head(2).Hash, reorg.Orphaned[len(reorg.Orphaned)-1].BlockHash())
+	case <-time.After(tests.WaitTimeout(t)):
+		t.Fatal("timed out waiting for reorg")
+	}
+
 	ht.Stop(t)
 	assert.Equal(t, int64(5), ht.headSaver.LatestChain().Number)
 
@@ -1141,10 +1156,11 @@ func TestHeadTracker_LatestAndFinalizedBlock(t *testing.T) {
 	h13.ParentHash = h12.Hash
 
 	type opts struct {
-		Heads                []*evmtypes.Head
-		FinalityTagEnabled   bool
-		FinalizedBlockOffset uint32
-		FinalityDepth        uint32
+		Heads                 []*evmtypes.Head
+		FinalityTagEnabled    bool
+		FinalizedBlockOffset  uint32
+		FinalityDepth         uint32
+		FinalityDepthFallback bool
 	}
 
 	newHeadTrackerUniverse := func(t *testing.T, opts opts) *headTrackerUniverse {
@@ -1152,6 +1168,7 @@ func TestHeadTracker_LatestAndFinalizedBlock(t *testing.T) {
 			c.FinalityTagEnabled = ptr(opts.FinalityTagEnabled)
 			c.FinalizedBlockOffset = ptr(opts.FinalizedBlockOffset)
 			c.FinalityDepth = ptr(opts.FinalityDepth)
+			c.HeadTracker.FinalityDepthFallback = ptr(opts.FinalityDepthFallback)
 		})
 
 		db := pgtest.NewSqlxDB(t)
@@ -1198,6 +1215,26 @@ func TestHeadTracker_LatestAndFinalizedBlock(t *testing.T) {
 		_, _, err := htu.headTracker.LatestAndFinalizedBlock(ctx)
 		require.ErrorContains(t, err, "failed to get valid latest finalized block")
 	})
+	t.Run("falls back to finality depth if failed to get latest finalized (finality tag with fallback)", func(t *testing.T) {
+		htu := newHeadTrackerUniverse(t, opts{FinalityTagEnabled: true, FinalityDepthFallback: true, FinalityDepth: 1, Heads: []*evmtypes.Head{h13, h12, h11}})
+		htu.ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(h13, nil).Once()
+		htu.ethClient.On("LatestFinalizedBlock", mock.Anything).Return(nil, errors.New("finalized tag is not supported")).Once()
+
+		actualL, actualLF, err := htu.headTracker.LatestAndFinalizedBlock(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, actualL.Number, h13.Number)
+		assert.Equal(t, actualLF.Number, h12.Number)
+	})
+	t.Run("falls back to finality depth if latest finalized block is not valid (finality tag with fallback)", func(t *testing.T) {
+		htu := newHeadTrackerUniverse(t, opts{FinalityTagEnabled: true, FinalityDepthFallback: true, FinalityDepth: 2, Heads: []*evmtypes.Head{h13, h12, h11}})
+		htu.ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(h13, nil).Once()
+		htu.ethClient.On("LatestFinalizedBlock", mock.Anything).Return(nil, nil).Once()
+
+		actualL, actualLF, err := htu.headTracker.LatestAndFinalizedBlock(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, actualL.Number, h13.Number)
+		assert.Equal(t, actualLF.Number, h11.Number)
+	})
 	t.Run("returns latest finalized block as is if FinalizedBlockOffset is 0 (finality tag)", func(t *testing.T) {
 		htu := newHeadTrackerUniverse(t, opts{FinalityTagEnabled: true})
 		htu.ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(h13, nil).Once()
@@ -1264,12 +1301,14 @@ func TestHeadTracker_LatestAndFinalizedBlock(t *testing.T) {
 func createHeadTracker(t testing.TB, ethClient *evmclimocks.Client, config commontypes.Config, htConfig commontypes.HeadTrackerConfig, orm headtracker.ORM) *headTrackerUniverse {
 	lggr, ob := logger.TestObserved(t, zap.DebugLevel)
 	hb := headtracker.NewHeadBroadcaster(lggr)
+	rb := headtracker.NewReorgBus(lggr)
 	hs := headtracker.NewHeadSaver(lggr, orm, config, htConfig)
 	mailMon := mailboxtest.NewMonitor(t)
 	return &headTrackerUniverse{
 		mu:              new(sync.Mutex),
-		headTracker:     headtracker.NewHeadTracker(lggr, ethClient, config, htConfig, hb, hs, mailMon),
+		headTracker:     headtracker.NewHeadTracker(lggr, ethClient, config, htConfig, hb, rb, hs, mailMon),
 		headBroadcaster: hb,
+		reorgBus:        rb,
 		headSaver:       hs,
 		mailMon:         mailMon,
 		observer:        ob,
@@ -1281,14 +1320,16 @@ func createHeadTracker(t testing.TB, ethClient *evmclimocks.Client, config commo
 func createHeadTrackerWithChecker(t *testing.T, ethClient *evmclimocks.Client, config commontypes.Config, htConfig commontypes.HeadTrackerConfig, orm headtracker.ORM, checker httypes.HeadTrackable) *headTrackerUniverse {
 	lggr, ob := logger.TestObserved(t, zap.DebugLevel)
 	hb := headtracker.NewHeadBroadcaster(lggr)
+	rb := headtracker.NewReorgBus(lggr)
 	hs := headtracker.NewHeadSaver(lggr, orm, config, htConfig)
 	hb.Subscribe(checker)
 	mailMon := mailboxtest.NewMonitor(t)
-	ht := headtracker.NewHeadTracker(lggr, ethClient, config, htConfig, hb, hs, mailMon)
+	ht := headtracker.NewHeadTracker(lggr, ethClient, config, htConfig, hb, rb, hs, mailMon)
 	return &headTrackerUniverse{
 		mu:              new(sync.Mutex),
 		headTracker:     ht,
 		headBroadcaster: hb,
+		reorgBus:        rb,
 		headSaver:       hs,
 		mailMon:         mailMon,
 		observer:        ob,
@@ -1302,6 +1343,7 @@ type headTrackerUniverse struct {
 	stopped         bool
 	headTracker     httypes.HeadTracker
 	headBroadcaster httypes.HeadBroadcaster
+	reorgBus        httypes.ReorgBus
 	headSaver       httypes.HeadSaver
 	mailMon         *mailbox.Monitor
 	observer        *observer.ObservedLogs
@@ -1318,6 +1360,7 @@ func (u *headTrackerUniverse) Start(t *testing.T) {
 	defer u.mu.Unlock()
 	ctx := tests.Context(t)
 	require.NoError(t, u.headBroadcaster.Start(ctx))
+	require.NoError(t, u.reorgBus.Start(ctx))
 	require.NoError(t, u.headTracker.Start(ctx))
 	require.NoError(t, u.mailMon.Start(ctx))
 
@@ -1340,12 +1383,17 @@ func (u *headTrackerUniverse) Stop(t *testing.T) {
 	}
 	u.stopped = true
 	require.NoError(t, u.headBroadcaster.Close())
+	require.NoError(t, u.reorgBus.Close())
 	require.NoError(t, u.headTracker.Close())
 	require.NoError(t, u.mailMon.Close())
 }
 
 func ptr[T any](t T) *T { return &t }
 
+type reorgSubscriberFunc func(ctx context.Context, reorg httypes.Reorg)
+
+func (f reorgSubscriberFunc) OnReorg(ctx context.Context, reorg httypes.Reorg) { f(ctx, reorg) }
+
 // headBuffer - stores heads in sequence, with increasing timestamps
 type headBuffer struct {
 	t     *testing.T
@@ -0,0 +1,17 @@
+package headtracker
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink/v2/common/headtracker"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+type reorgBus = headtracker.ReorgBus[*evmtypes.Head, common.Hash]
+
+func NewReorgBus(
+	lggr logger.Logger,
+) reorgBus {
+	return headtracker.NewReorgBus[*evmtypes.Head, common.Hash](lggr)
+}
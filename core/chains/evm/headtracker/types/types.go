@@ -25,5 +25,8 @@ type (
 	HeadTrackable   = headtracker.HeadTrackable[*evmtypes.Head, common.Hash]
 	HeadListener    = headtracker.HeadListener[*evmtypes.Head, common.Hash]
 	HeadBroadcaster = headtracker.HeadBroadcaster[*evmtypes.Head, common.Hash]
+	Reorg           = headtracker.Reorg[*evmtypes.Head, common.Hash]
+	ReorgSubscriber = headtracker.ReorgSubscriber[*evmtypes.Head, common.Hash]
+	ReorgBus        = headtracker.ReorgBus[*evmtypes.Head, common.Hash]
 	Client          = htrktypes.Client[*evmtypes.Head, ethereum.Subscription, *big.Int, common.Hash]
 )
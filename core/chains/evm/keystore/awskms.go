@@ -0,0 +1,145 @@
+package keystore
+
+import (
+	"context"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	secp256k1N     = crypto.S256().Params().N
+	secp256k1HalfN = new(big.Int).Div(secp256k1N, big.NewInt(2))
+)
+
+// AWSKMSClient is the subset of the AWS KMS API used by AWSKMSSigner
+type AWSKMSClient interface {
+	GetPublicKeyWithContext(ctx aws.Context, input *kms.GetPublicKeyInput, opts ...request.Option) (*kms.GetPublicKeyOutput, error)
+	SignWithContext(ctx aws.Context, input *kms.SignInput, opts ...request.Option) (*kms.SignOutput, error)
+}
+
+// AWSKMSSigner is a RemoteSigner backed by an ECC_SECG_P256K1 signing key of AWS KMS, which holds the key of address.
+// See https://docs.aws.amazon.com/kms/latest/APIReference/API_Sign.html
+type AWSKMSSigner struct {
+	client  AWSKMSClient
+	address common.Address
+	keyID   string
+	timeout time.Duration
+}
+
+var _ RemoteSigner = (*AWSKMSSigner)(nil)
+
+// NewAWSKMSSigner returns an AWSKMSSigner for the key keyID in region, with credentials loaded from the environment.
+// endpoint is optional, and selects a KMS compatible endpoint instead of the one of the region.
+func NewAWSKMSSigner(address common.Address, keyID, region string, endpoint *url.URL, timeout time.Duration) (*AWSKMSSigner, error) {
+	cfg := aws.NewConfig().WithRegion(region)
+	if endpoint != nil {
+		cfg = cfg.WithEndpoint(endpoint.String())
+	}
+	sess, err := session.NewSession(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AWS KMS session: %w", err)
+	}
+	return NewAWSKMSSignerWithClient(kms.New(sess), address, keyID, timeout), nil
+}
+
+func NewAWSKMSSignerWithClient(client AWSKMSClient, address common.Address, keyID string, timeout time.Duration) *AWSKMSSigner {
+	return &AWSKMSSigner{client: client, address: address, keyID: keyID, timeout: timeout}
+}
+
+// SignTx signs the hash of the transaction with the KMS key, and converts the DER signature of KMS to an Ethereum one
+func (k *AWSKMSSigner) SignTx(ctx context.Context, fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	if fromAddress != k.address {
+		return nil, fmt.Errorf("KMS key %s holds the key of %s, not of %s", k.keyID, k.address, fromAddress)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, k.timeout)
+	defer cancel()
+	signer := types.LatestSignerForChainID(chainID)
+	hash := signer.Hash(tx)
+	out, err := k.client.SignWithContext(ctx, &kms.SignInput{
+		KeyId:            aws.String(k.keyID),
+		Message:          hash.Bytes(),
+		MessageType:      aws.String(kms.MessageTypeDigest),
+		SigningAlgorithm: aws.String(kms.SigningAlgorithmSpecEcdsaSha256),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("KMS failed to sign: %w", err)
+	}
+	sig, err := kmsToEthSignature(out.Signature, hash.Bytes(), k.address)
+	if err != nil {
+		return nil, err
+	}
+	return tx.WithSignature(signer, sig)
+}
+
+// Ping checks that the public key of the KMS key can be read, and is the key of the address
+func (k *AWSKMSSigner) Ping(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, k.timeout)
+	defer cancel()
+	out, err := k.client.GetPublicKeyWithContext(ctx, &kms.GetPublicKeyInput{KeyId: aws.String(k.keyID)})
+	if err != nil {
+		return fmt.Errorf("failed to get public key of KMS key %s: %w", k.keyID, err)
+	}
+	// See https://datatracker.ietf.org/doc/html/rfc5480#section-2 for the encoding of the public key
+	var info struct {
+		Algorithm struct {
+			Algorithm  asn1.ObjectIdentifier
+			Parameters asn1.ObjectIdentifier
+		}
+		PublicKey asn1.BitString
+	}
+	if _, err = asn1.Unmarshal(out.PublicKey, &info); err != nil {
+		return fmt.Errorf("failed to parse public key of KMS key %s: %w", k.keyID, err)
+	}
+	pub, err := crypto.UnmarshalPubkey(info.PublicKey.Bytes)
+	if err != nil {
+		return fmt.Errorf("KMS key %s is not a secp256k1 key: %w", k.keyID, err)
+	}
+	if address := crypto.PubkeyToAddress(*pub); address != k.address {
+		return fmt.Errorf("KMS key %s holds the key of %s, not of %s", k.keyID, address, k.address)
+	}
+	return nil
+}
+
+// Close is a no-op, as the KMS client holds no connection
+func (k *AWSKMSSigner) Close() error { return nil }
+
+// kmsToEthSignature converts the DER encoded ECDSA signature of KMS to the [R || S || V] signature of Ethereum, with S in
+// the lower half of the curve order as required by EIP-2, and V recovering the address of the key
+func kmsToEthSignature(der, hash []byte, address common.Address) ([]byte, error) {
+	var rs struct {
+		R, S *big.Int
+	}
+	if _, err := asn1.Unmarshal(der, &rs); err != nil {
+		return nil, fmt.Errorf("failed to parse KMS signature: %w", err)
+	}
+	if rs.R == nil || rs.S == nil || rs.R.Sign() <= 0 || rs.S.Sign() <= 0 || rs.R.Cmp(secp256k1N) >= 0 || rs.S.Cmp(secp256k1N) >= 0 {
+		return nil, errors.New("invalid KMS signature")
+	}
+	if rs.S.Cmp(secp256k1HalfN) > 0 {
+		rs.S = new(big.Int).Sub(secp256k1N, rs.S)
+	}
+	sig := make([]byte, crypto.SignatureLength)
+	rs.R.FillBytes(sig[:32])
+	rs.S.FillBytes(sig[32:64])
+	for _, v := range []byte{0, 1} {
+		sig[64] = v
+		pub, err := crypto.SigToPub(hash, sig)
+		if err == nil && crypto.PubkeyToAddress(*pub) == address {
+			return sig, nil
+		}
+	}
+	return nil, fmt.Errorf("KMS signature does not recover the address %s", address)
+}
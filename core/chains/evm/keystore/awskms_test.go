@@ -0,0 +1,108 @@
+package keystore_test
+
+import (
+	"crypto/ecdsa"
+	"encoding/asn1"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
+)
+
+// fakeKMS holds a secp256k1 key like AWS KMS, returning DER encoded public keys and signatures
+type fakeKMS struct {
+	key *ecdsa.PrivateKey
+	// highS returns the signatures with S in the upper half of the curve order, which KMS does not normalize
+	highS bool
+	err   error
+}
+
+func (f *fakeKMS) GetPublicKeyWithContext(_ aws.Context, _ *kms.GetPublicKeyInput, _ ...request.Option) (*kms.GetPublicKeyOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	type algorithm struct {
+		Algorithm  asn1.ObjectIdentifier
+		Parameters asn1.ObjectIdentifier
+	}
+	der, err := asn1.Marshal(struct {
+		Algorithm algorithm
+		PublicKey asn1.BitString
+	}{
+		Algorithm: algorithm{Algorithm: asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}, Parameters: asn1.ObjectIdentifier{1, 3, 132, 0, 10}},
+		PublicKey: asn1.BitString{Bytes: crypto.FromECDSAPub(&f.key.PublicKey), BitLength: 65 * 8},
+	})
+	return &kms.GetPublicKeyOutput{PublicKey: der}, err
+}
+
+func (f *fakeKMS) SignWithContext(_ aws.Context, input *kms.SignInput, _ ...request.Option) (*kms.SignOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	sig, err := crypto.Sign(input.Message, f.key)
+	if err != nil {
+		return nil, err
+	}
+	r, s := new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:64])
+	if f.highS {
+		s = new(big.Int).Sub(crypto.S256().Params().N, s)
+	}
+	der, err := asn1.Marshal(struct{ R, S *big.Int }{r, s})
+	return &kms.SignOutput{Signature: der}, err
+}
+
+func TestAWSKMSSigner(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	chainID := big.NewInt(1)
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	tx := types.NewTx(&types.DynamicFeeTx{ChainID: chainID, Nonce: 3, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(2), Gas: 21000, To: &address, Value: big.NewInt(4)})
+
+	t.Run("signs with the KMS key", func(t *testing.T) {
+		for _, highS := range []bool{false, true} {
+			signer := keystore.NewAWSKMSSignerWithClient(&fakeKMS{key: key, highS: highS}, address, "key-id", time.Second)
+			signed, err := signer.SignTx(ctx, address, tx, chainID)
+			require.NoError(t, err)
+			sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+			require.NoError(t, err)
+			assert.Equal(t, address, sender)
+			_, s, _ := signed.RawSignatureValues()
+			assert.LessOrEqual(t, s.Cmp(new(big.Int).Div(crypto.S256().Params().N, big.NewInt(2))), 0)
+		}
+	})
+
+	t.Run("refuses to sign for another key", func(t *testing.T) {
+		signer := keystore.NewAWSKMSSignerWithClient(&fakeKMS{key: key}, address, "key-id", time.Second)
+		_, err := signer.SignTx(ctx, testutils.NewAddress(), tx, chainID)
+		require.ErrorContains(t, err, "holds the key of")
+	})
+
+	t.Run("fails if the KMS key is not the key of the address", func(t *testing.T) {
+		other := testutils.NewAddress()
+		signer := keystore.NewAWSKMSSignerWithClient(&fakeKMS{key: key}, other, "key-id", time.Second)
+		_, err := signer.SignTx(ctx, other, tx, chainID)
+		require.ErrorContains(t, err, "does not recover the address")
+		require.ErrorContains(t, signer.Ping(ctx), "holds the key of")
+	})
+
+	t.Run("pings the KMS key", func(t *testing.T) {
+		require.NoError(t, keystore.NewAWSKMSSignerWithClient(&fakeKMS{key: key}, address, "key-id", time.Second).Ping(ctx))
+		require.ErrorContains(t, keystore.NewAWSKMSSignerWithClient(&fakeKMS{key: key, err: errors.New("access denied")}, address, "key-id", time.Second).Ping(ctx), "access denied")
+	})
+}
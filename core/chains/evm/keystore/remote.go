@@ -0,0 +1,177 @@
+package keystore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+)
+
+// remoteSignerHealthCheckInterval is how often the health of the remote signers is checked
+const remoteSignerHealthCheckInterval = 30 * time.Second
+
+// RemoteSigner signs the transactions of sending keys held outside of the node, by Web3Signer or AWS KMS. Keys held in
+// other KMSs are used through the key stores of Web3Signer. GCP KMS isn't supported, and OCR keys always sign in the
+// node's keystore.
+type RemoteSigner interface {
+	// SignTx signs the transaction with the key of fromAddress
+	SignTx(ctx context.Context, fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+	// Ping checks that the remote signer is reachable and ready to sign
+	Ping(ctx context.Context) error
+	// Close releases the connection to the remote signer
+	Close() error
+}
+
+// KeyUsageRecorder records signatures in the key usage audit log. The wrapped keystore of RemoteSigningEth implements
+// it when it keeps an audit log, so that the signatures of the remote signers are recorded like its own.
+type KeyUsageRecorder interface {
+	RecordKeyUsage(ctx context.Context, keyType, keyID string, payloadDigest []byte)
+}
+
+// RemoteSigningEth wraps an Eth keystore to sign with the keys of the remote signers instead. The addresses of the remote
+// signers are enabled for the chain in addition to the keys of the wrapped keystore.
+type RemoteSigningEth struct {
+	services.StateMachine
+	Eth
+	lggr    logger.Logger
+	chainID *big.Int
+	signers map[common.Address]RemoteSigner
+
+	healthMu sync.RWMutex
+	health   map[common.Address]error
+
+	stopCh services.StopChan
+	wg     sync.WaitGroup
+}
+
+var _ Eth = (*RemoteSigningEth)(nil)
+
+func NewRemoteSigningEth(lggr logger.Logger, ks Eth, chainID *big.Int, signers map[common.Address]RemoteSigner) *RemoteSigningEth {
+	return &RemoteSigningEth{
+		Eth:     ks,
+		lggr:    logger.Named(lggr, "RemoteSigningEth"),
+		chainID: chainID,
+		signers: signers,
+		health:  make(map[common.Address]error, len(signers)),
+		stopCh:  make(chan struct{}),
+	}
+}
+
+func (r *RemoteSigningEth) Start(context.Context) error {
+	return r.StartOnce("RemoteSigningEth", func() error {
+		r.wg.Add(1)
+		go r.run()
+		return nil
+	})
+}
+
+func (r *RemoteSigningEth) Close() error {
+	return r.StopOnce("RemoteSigningEth", func() error {
+		close(r.stopCh)
+		r.wg.Wait()
+		var errs []error
+		for _, signer := range r.signers {
+			errs = append(errs, signer.Close())
+		}
+		return errors.Join(errs...)
+	})
+}
+
+func (r *RemoteSigningEth) Name() string { return r.lggr.Name() }
+
+func (r *RemoteSigningEth) HealthReport() map[string]error {
+	errs := []error{r.Healthy()}
+	r.healthMu.RLock()
+	for address, err := range r.health {
+		if err != nil {
+			errs = append(errs, fmt.Errorf("remote signer of %s is unhealthy: %w", address, err))
+		}
+	}
+	r.healthMu.RUnlock()
+	return map[string]error{r.Name(): errors.Join(errs...)}
+}
+
+func (r *RemoteSigningEth) run() {
+	defer r.wg.Done()
+	ctx, cancel := r.stopCh.NewCtx()
+	defer cancel()
+
+	ticker := services.NewTicker(remoteSignerHealthCheckInterval)
+	defer ticker.Stop()
+	for {
+		r.checkHealth(ctx)
+		select {
+		case <-r.stopCh:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *RemoteSigningEth) checkHealth(ctx context.Context) {
+	for address, signer := range r.signers {
+		err := signer.Ping(ctx)
+		if err != nil {
+			r.lggr.Errorw("Remote signer is unhealthy", "address", address, "err", err)
+		}
+		r.healthMu.Lock()
+		r.health[address] = err
+		r.healthMu.Unlock()
+	}
+}
+
+func (r *RemoteSigningEth) SignTx(ctx context.Context, fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	signer, ok := r.signers[fromAddress]
+	if !ok {
+		return r.Eth.SignTx(ctx, fromAddress, tx, chainID)
+	}
+	signed, err := signer.SignTx(ctx, fromAddress, tx, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("remote signer failed to sign tx from %s: %w", fromAddress, err)
+	}
+	// never trust the remote signer to have signed what was requested, with the requested key
+	txSigner := types.LatestSignerForChainID(chainID)
+	if txSigner.Hash(signed) != txSigner.Hash(tx) {
+		return nil, fmt.Errorf("remote signer of %s signed a different transaction", fromAddress)
+	}
+	sender, err := types.Sender(txSigner, signed)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature from remote signer of %s: %w", fromAddress, err)
+	}
+	if sender != fromAddress {
+		return nil, fmt.Errorf("remote signer of %s signed with %s instead", fromAddress, sender)
+	}
+	if recorder, ok := r.Eth.(KeyUsageRecorder); ok {
+		recorder.RecordKeyUsage(ctx, "EVM", fromAddress.String(), txSigner.Hash(tx).Bytes())
+	}
+	return signed, nil
+}
+
+func (r *RemoteSigningEth) CheckEnabled(ctx context.Context, address common.Address, chainID *big.Int) error {
+	if _, ok := r.signers[address]; ok && chainID.Cmp(r.chainID) == 0 {
+		return nil
+	}
+	return r.Eth.CheckEnabled(ctx, address, chainID)
+}
+
+func (r *RemoteSigningEth) EnabledAddressesForChain(ctx context.Context, chainID *big.Int) ([]common.Address, error) {
+	addresses, err := r.Eth.EnabledAddressesForChain(ctx, chainID)
+	if err != nil || chainID.Cmp(r.chainID) != 0 {
+		return addresses, err
+	}
+	for address := range r.signers {
+		if !slices.Contains(addresses, address) {
+			addresses = append(addresses, address)
+		}
+	}
+	return addresses, nil
+}
@@ -0,0 +1,162 @@
+package keystore_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/keystore/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
+)
+
+// newWeb3Signer starts a fake Web3Signer that signs the requested transactions with key
+func newWeb3Signer(t *testing.T, key *ecdsa.PrivateKey, chainID *big.Int) *url.URL {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/upcheck" {
+			_, _ = w.Write([]byte("OK"))
+			return
+		}
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+			Params []struct {
+				To                   *common.Address `json:"to"`
+				Gas                  hexutil.Uint64  `json:"gas"`
+				MaxFeePerGas         *hexutil.Big    `json:"maxFeePerGas"`
+				MaxPriorityFeePerGas *hexutil.Big    `json:"maxPriorityFeePerGas"`
+				Value                *hexutil.Big    `json:"value"`
+				Nonce                hexutil.Uint64  `json:"nonce"`
+				Data                 hexutil.Bytes   `json:"data"`
+			} `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.Equal(t, "eth_signTransaction", req.Method)
+		p := req.Params[0]
+		tx, err := types.SignNewTx(key, types.LatestSignerForChainID(chainID), &types.DynamicFeeTx{
+			ChainID: chainID, Nonce: uint64(p.Nonce), GasTipCap: p.MaxPriorityFeePerGas.ToInt(), GasFeeCap: p.MaxFeePerGas.ToInt(),
+			Gas: uint64(p.Gas), To: p.To, Value: p.Value.ToInt(), Data: p.Data,
+		})
+		require.NoError(t, err)
+		raw, err := tx.MarshalBinary()
+		require.NoError(t, err)
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": hexutil.Bytes(raw)}))
+	}))
+	t.Cleanup(srv.Close)
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	return u
+}
+
+// recordingEth is a keystore keeping an audit log of the keys it records the usage of
+type recordingEth struct {
+	*mocks.Eth
+	recorded []string
+}
+
+func (r *recordingEth) RecordKeyUsage(_ context.Context, _, keyID string, _ []byte) {
+	r.recorded = append(r.recorded, keyID)
+}
+
+func TestRemoteSigningEth(t *testing.T) {
+	t.Parallel()
+
+	chainID := big.NewInt(1337)
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	remoteAddress := crypto.PubkeyToAddress(key.PublicKey)
+	localAddress := testutils.NewAddress()
+	to := testutils.NewAddress()
+	tx := types.NewTx(&types.DynamicFeeTx{ChainID: chainID, Nonce: 7, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(10), Gas: 21000, To: &to, Value: big.NewInt(42)})
+
+	newRemoteSigningEth := func(t *testing.T, address common.Address) (*keystore.RemoteSigningEth, *recordingEth) {
+		ks := &recordingEth{Eth: mocks.NewEth(t)}
+		signer, err := keystore.NewWeb3Signer(newWeb3Signer(t, key, chainID), time.Second)
+		require.NoError(t, err)
+		r := keystore.NewRemoteSigningEth(logger.Test(t), ks, chainID, map[common.Address]keystore.RemoteSigner{address: signer})
+		t.Cleanup(func() { assert.NoError(t, signer.Close()) })
+		return r, ks
+	}
+
+	t.Run("signs with the remote signer", func(t *testing.T) {
+		r, ks := newRemoteSigningEth(t, remoteAddress)
+		for i := 0; i < 2; i++ {
+			signed, err := r.SignTx(tests.Context(t), remoteAddress, tx, chainID)
+			require.NoError(t, err)
+			sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+			require.NoError(t, err)
+			assert.Equal(t, remoteAddress, sender)
+			assert.Equal(t, tx.Nonce(), signed.Nonce())
+		}
+		// the remote signatures are recorded in the audit log of the wrapped keystore
+		assert.Equal(t, []string{remoteAddress.String(), remoteAddress.String()}, ks.recorded)
+	})
+
+	t.Run("rejects signatures with another key", func(t *testing.T) {
+		other := testutils.NewAddress()
+		r, ks := newRemoteSigningEth(t, other)
+		_, err := r.SignTx(tests.Context(t), other, tx, chainID)
+		require.ErrorContains(t, err, "signed with")
+		assert.Empty(t, ks.recorded)
+	})
+
+	t.Run("signs other keys with the wrapped keystore", func(t *testing.T) {
+		r, ks := newRemoteSigningEth(t, remoteAddress)
+		ks.On("SignTx", mock.Anything, localAddress, tx, chainID).Return(tx, nil).Once()
+		signed, err := r.SignTx(tests.Context(t), localAddress, tx, chainID)
+		require.NoError(t, err)
+		assert.Equal(t, tx, signed)
+	})
+
+	t.Run("enables the remote keys for the chain", func(t *testing.T) {
+		r, ks := newRemoteSigningEth(t, remoteAddress)
+		ks.On("EnabledAddressesForChain", mock.Anything, chainID).Return([]common.Address{localAddress}, nil).Once()
+		addresses, err := r.EnabledAddressesForChain(tests.Context(t), chainID)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []common.Address{localAddress, remoteAddress}, addresses)
+		require.NoError(t, r.CheckEnabled(tests.Context(t), remoteAddress, chainID))
+
+		otherChainID := big.NewInt(1)
+		ks.On("EnabledAddressesForChain", mock.Anything, otherChainID).Return([]common.Address{}, nil).Once()
+		addresses, err = r.EnabledAddressesForChain(tests.Context(t), otherChainID)
+		require.NoError(t, err)
+		assert.Empty(t, addresses)
+	})
+}
+
+func TestWeb3Signer_Ping(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer, err := keystore.NewWeb3Signer(newWeb3Signer(t, key, big.NewInt(1)), time.Second)
+	require.NoError(t, err)
+	require.NoError(t, signer.Ping(tests.Context(t)))
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(srv.Close)
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	signer, err = keystore.NewWeb3Signer(u, time.Second)
+	require.NoError(t, err)
+	require.ErrorContains(t, signer.Ping(tests.Context(t)), "503")
+}
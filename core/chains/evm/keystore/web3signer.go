@@ -0,0 +1,114 @@
+package keystore
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Web3Signer is a RemoteSigner backed by the eth1 JSON-RPC API of a Web3Signer instance.
+// See https://docs.web3signer.consensys.io/reference/api/json-rpc
+type Web3Signer struct {
+	url        *url.URL
+	timeout    time.Duration
+	httpClient *http.Client
+	// client is kept for the lifetime of the signer, as it only wraps httpClient over HTTP
+	client *rpc.Client
+}
+
+var _ RemoteSigner = (*Web3Signer)(nil)
+
+func NewWeb3Signer(u *url.URL, timeout time.Duration) (*Web3Signer, error) {
+	httpClient := &http.Client{Timeout: timeout}
+	client, err := rpc.DialOptions(context.Background(), u.String(), rpc.WithHTTPClient(httpClient))
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial Web3Signer: %w", err)
+	}
+	return &Web3Signer{url: u, timeout: timeout, httpClient: httpClient, client: client}, nil
+}
+
+type web3SignerTxArgs struct {
+	From                 common.Address    `json:"from"`
+	To                   *common.Address   `json:"to,omitempty"`
+	Gas                  hexutil.Uint64    `json:"gas"`
+	GasPrice             *hexutil.Big      `json:"gasPrice,omitempty"`
+	MaxFeePerGas         *hexutil.Big      `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *hexutil.Big      `json:"maxPriorityFeePerGas,omitempty"`
+	Value                *hexutil.Big      `json:"value"`
+	Nonce                hexutil.Uint64    `json:"nonce"`
+	Data                 hexutil.Bytes     `json:"data"`
+	AccessList           *types.AccessList `json:"accessList,omitempty"`
+	ChainID              *hexutil.Big      `json:"chainId"`
+}
+
+// SignTx signs the transaction with eth_signTransaction, which returns the RLP encoded signed transaction
+func (w *Web3Signer) SignTx(ctx context.Context, fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	args := web3SignerTxArgs{
+		From:    fromAddress,
+		To:      tx.To(),
+		Gas:     hexutil.Uint64(tx.Gas()),
+		Value:   (*hexutil.Big)(tx.Value()),
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		Data:    tx.Data(),
+		ChainID: (*hexutil.Big)(chainID),
+	}
+	switch tx.Type() {
+	case types.LegacyTxType:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	case types.DynamicFeeTxType:
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+		if al := tx.AccessList(); len(al) > 0 {
+			args.AccessList = &al
+		}
+	default:
+		return nil, fmt.Errorf("transaction type %d is not supported by Web3Signer", tx.Type())
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, w.timeout)
+	defer cancel()
+	var raw hexutil.Bytes
+	if err := w.client.CallContext(ctx, &raw, "eth_signTransaction", args); err != nil {
+		return nil, fmt.Errorf("eth_signTransaction failed: %w", err)
+	}
+	signed := new(types.Transaction)
+	if err := signed.UnmarshalBinary(raw); err != nil {
+		return nil, fmt.Errorf("failed to decode signed transaction: %w", err)
+	}
+	return signed, nil
+}
+
+// Ping checks the upcheck endpoint of Web3Signer
+func (w *Web3Signer) Ping(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, w.timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, w.url.JoinPath("upcheck").String(), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := w.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("upcheck returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Close closes the RPC client of the signer
+func (w *Web3Signer) Close() error {
+	w.client.Close()
+	return nil
+}
@@ -0,0 +1,434 @@
+package log
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/mailbox"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+// listenerFilterID prefixes the names of the LogPoller filters registered for LogBroadcaster subscriptions.
+const listenerFilterID = "LogBroadcaster"
+
+// ListenerFilterName returns the name of the LogPoller filter of the subscription of jobID to contract.
+func ListenerFilterName(jobID int32, contract common.Address) string {
+	return logpoller.FilterName(listenerFilterID, fmt.Sprint(jobID), contract.Hex())
+}
+
+// ListenerFilter converts the subscription of jobID to a LogPoller filter, which indexes the logs it needs.
+//
+// Topic values are allowed per position across all the event types of opts, so the filter may match more logs than
+// the subscription does. Logs are matched against opts again before they are broadcast.
+func ListenerFilter(jobID int32, opts ListenerOpts) logpoller.Filter {
+	filter := logpoller.Filter{
+		Name:      ListenerFilterName(jobID, opts.Contract),
+		Addresses: evmtypes.AddressArray{opts.Contract},
+	}
+	topics := []*evmtypes.HashArray{&filter.Topic2, &filter.Topic3, &filter.Topic4}
+	values := make([]map[common.Hash]struct{}, len(topics))
+	for i := range values {
+		values[i] = make(map[common.Hash]struct{})
+	}
+	for sig, filters := range opts.LogsWithTopics {
+		filter.EventSigs = append(filter.EventSigs, sig)
+		for i := range topics {
+			if values[i] == nil {
+				continue
+			}
+			if i >= len(filters) || len(filters[i]) == 0 {
+				// all values are allowed for this event type, so they must be for the filter
+				values[i] = nil
+				continue
+			}
+			for _, v := range filters[i] {
+				values[i][common.Hash(v)] = struct{}{}
+			}
+		}
+	}
+	compareHashes := func(a, b common.Hash) int { return bytes.Compare(a[:], b[:]) }
+	slices.SortFunc(filter.EventSigs, compareHashes)
+	for i, topic := range topics {
+		for v := range values[i] {
+			*topic = append(*topic, v)
+		}
+		slices.SortFunc(*topic, compareHashes)
+	}
+	return filter
+}
+
+type (
+	// logPollerSubscription is the state of a subscriber of the logPollerBroadcaster.
+	logPollerSubscription struct {
+		*subscriber
+		filter logpoller.Filter
+		// fromBlock is the first block which is broadcast on the next head, or -1 before the first broadcast.
+		fromBlock int64
+	}
+
+	// logPollerBroadcaster is a Broadcaster which serves the subscriptions from the LogPoller, instead of subscribing
+	// to logs on the RPC. It is a compatibility shim for the services still registering with the LogBroadcaster, and
+	// keeps its semantics:
+	//   - Each subscription is converted to a LogPoller filter with ListenerFilter, which is registered when the
+	//     subscriber is added. New filters are replayed from BlockBackfillDepth, since the LogPoller did not index their
+	//     logs before.
+	//   - On every new head, the logs with enough confirmations are sent to the subscribers, unless they were already
+	//     consumed. Consumption is tracked in the log_broadcasts table, as by the LogBroadcaster, so switching between
+	//     both does not broadcast the consumed logs again.
+	//   - Unconsumed logs are sent again on later heads, until they are older than the finality depth.
+	//   - Subscribers registered before all dependents are ready are backfilled from BlockBackfillDepth.
+	//
+	// Filters are kept when subscribers unregister, so that logs are still indexed while jobs restart. The filters of
+	// subscribers which did not register again by the time all dependents are ready are unregistered.
+	//
+	// The LogPoller does not index the roots of blocks, so they are zero in the broadcasts.
+	logPollerBroadcaster struct {
+		services.StateMachine
+		orm        ORM
+		lp         logpoller.LogPoller
+		config     Config
+		evmChainID big.Int
+		logger     logger.SugaredLogger
+
+		mailMon                *mailbox.Monitor
+		changeSubscriberStatus *mailbox.Mailbox[changeSubscriberStatus]
+		newHeads               *mailbox.Mailbox[*evmtypes.Head]
+		replayChannel          chan replayRequest
+
+		utils.DependentAwaiter
+
+		// subs is only accessed by the run loop
+		subs map[*subscriber]*logPollerSubscription
+
+		chStop services.StopChan
+		wgDone sync.WaitGroup
+	}
+)
+
+var _ Broadcaster = (*logPollerBroadcaster)(nil)
+
+// NewLogPollerBroadcaster returns a Broadcaster which serves the subscriptions from lp. See logPollerBroadcaster.
+func NewLogPollerBroadcaster(orm ORM, lp logpoller.LogPoller, evmChainID big.Int, config Config, lggr logger.Logger, mailMon *mailbox.Monitor) *logPollerBroadcaster {
+	return &logPollerBroadcaster{
+		orm:                    orm,
+		lp:                     lp,
+		config:                 config,
+		evmChainID:             evmChainID,
+		logger:                 logger.Sugared(logger.Named(lggr, "LogPollerBroadcaster")),
+		mailMon:                mailMon,
+		changeSubscriberStatus: mailbox.NewHighCapacity[changeSubscriberStatus](),
+		newHeads:               mailbox.NewSingle[*evmtypes.Head](),
+		replayChannel:          make(chan replayRequest, 1),
+		DependentAwaiter:       utils.NewDependentAwaiter(),
+		subs:                   make(map[*subscriber]*logPollerSubscription),
+		chStop:                 make(chan struct{}),
+	}
+}
+
+func (b *logPollerBroadcaster) Start(context.Context) error {
+	return b.StartOnce("LogPollerBroadcaster", func() error {
+		b.wgDone.Add(1)
+		go b.run()
+		b.mailMon.Monitor(b.changeSubscriberStatus, "LogPollerBroadcaster", "ChangeSubscriber", b.evmChainID.String())
+		return nil
+	})
+}
+
+func (b *logPollerBroadcaster) Close() error {
+	return b.StopOnce("LogPollerBroadcaster", func() error {
+		close(b.chStop)
+		b.wgDone.Wait()
+		return b.changeSubscriberStatus.Close()
+	})
+}
+
+func (b *logPollerBroadcaster) Name() string {
+	return b.logger.Name()
+}
+
+func (b *logPollerBroadcaster) HealthReport() map[string]error {
+	return map[string]error{b.Name(): b.Healthy()}
+}
+
+// ReplayFromBlock implements the Broadcaster interface. The LogPoller replays from number as well.
+func (b *logPollerBroadcaster) ReplayFromBlock(number int64, forceBroadcast bool) {
+	b.logger.Infow("Replay requested", "block number", number, "force", forceBroadcast)
+	select {
+	case b.replayChannel <- replayRequest{
+		fromBlock:      number,
+		forceBroadcast: forceBroadcast,
+	}:
+	default:
+	}
+}
+
+func (b *logPollerBroadcaster) IsConnected() bool {
+	return b.lp.Healthy() == nil
+}
+
+func (b *logPollerBroadcaster) Register(listener Listener, opts ListenerOpts) (unsubscribe func()) {
+	ok := b.IfNotStopped(func() {
+		if len(opts.LogsWithTopics) == 0 {
+			b.logger.Panic("Must supply at least 1 LogsWithTopics element to Register")
+		}
+		if opts.MinIncomingConfirmations <= 0 {
+			b.logger.Warnw(fmt.Sprintf("LogPollerBroadcaster requires that MinIncomingConfirmations must be at least 1 (got %v). MinIncomingConfirmations will be set to 1.", opts.MinIncomingConfirmations), "addr", opts.Contract.Hex(), "jobID", listener.JobID())
+			opts.MinIncomingConfirmations = 1
+		}
+
+		sub := &subscriber{listener, opts}
+		if b.changeSubscriberStatus.Deliver(changeSubscriberStatus{subscriberStatusSubscribe, sub}) {
+			b.logger.Panicf("LogPollerBroadcaster subscribe: cannot subscribe %p with job ID %v; changeSubscriberStatus channel was full", sub, sub.listener.JobID())
+		}
+		unsubscribe = func() {
+			if b.changeSubscriberStatus.Deliver(changeSubscriberStatus{subscriberStatusUnsubscribe, sub}) {
+				b.logger.Panicf("LogPollerBroadcaster unsubscribe: cannot unsubscribe %p with job ID %v; changeSubscriberStatus channel was full", sub, sub.listener.JobID())
+			}
+		}
+	})
+	if !ok {
+		b.logger.Panic("Register cannot be called on a stopped log broadcaster (this is an invariant violation because all dependent services should have unregistered themselves before logbroadcaster.Close was called)")
+	}
+	return
+}
+
+func (b *logPollerBroadcaster) OnNewLongestChain(ctx context.Context, head *evmtypes.Head) {
+	b.newHeads.Deliver(head)
+}
+
+// WasAlreadyConsumed reports whether the given consumer had already consumed the given log
+func (b *logPollerBroadcaster) WasAlreadyConsumed(ctx context.Context, lb Broadcast) (bool, error) {
+	return b.orm.WasBroadcastConsumed(ctx, lb.RawLog().BlockHash, lb.RawLog().Index, lb.JobID())
+}
+
+// MarkConsumed marks the log as having been successfully consumed by the subscriber
+func (b *logPollerBroadcaster) MarkConsumed(ctx context.Context, ds sqlutil.DataSource, lb Broadcast) error {
+	orm := b.orm
+	if ds != nil {
+		orm = orm.WithDataSource(ds)
+	}
+	return orm.MarkBroadcastConsumed(ctx, lb.RawLog().BlockHash, lb.RawLog().BlockNumber, lb.RawLog().Index, lb.JobID())
+}
+
+func (b *logPollerBroadcaster) run() {
+	defer b.wgDone.Done()
+	ctx, cancel := b.chStop.NewCtx()
+	defer cancel()
+
+	b.logger.Debug("Starting to await initial subscribers until all dependents are ready...")
+	for ready := false; !ready; {
+		select {
+		case <-b.changeSubscriberStatus.Notify():
+			b.onChangeSubscriberStatus(ctx)
+		case <-b.DependentAwaiter.AwaitDependents():
+			// ensure that any queued dependent subscriptions are registered first
+			b.onChangeSubscriberStatus(ctx)
+			b.unregisterStaleFilters(ctx)
+			ready = true
+		case <-b.chStop:
+			return
+		}
+	}
+
+	for {
+		select {
+		case <-b.changeSubscriberStatus.Notify():
+			b.onChangeSubscriberStatus(ctx)
+		case <-b.newHeads.Notify():
+			if _, exists := b.newHeads.Retrieve(); exists {
+				b.broadcastLogs(ctx)
+			}
+		case req := <-b.replayChannel:
+			b.onReplayRequest(ctx, req)
+		case <-b.chStop:
+			return
+		}
+	}
+}
+
+func (b *logPollerBroadcaster) onChangeSubscriberStatus(ctx context.Context) {
+	for {
+		change, exists := b.changeSubscriberStatus.Retrieve()
+		if !exists {
+			return
+		}
+		sub := change.sub
+		if change.newStatus == subscriberStatusSubscribe {
+			b.logger.Debugw("Subscribing listener", "requiredBlockConfirmations", sub.opts.MinIncomingConfirmations, "address", sub.opts.Contract, "jobID", sub.listener.JobID())
+			s := &logPollerSubscription{
+				subscriber: sub,
+				filter:     ListenerFilter(sub.listener.JobID(), sub.opts),
+				fromBlock:  -1,
+			}
+			b.subs[sub] = s
+			if err := b.registerFilter(ctx, s); err != nil {
+				// retried on the next head
+				b.logger.Errorw("Failed to register filter", "jobID", sub.listener.JobID(), "address", sub.opts.Contract, "err", err)
+			}
+		} else {
+			b.logger.Debugw("Unsubscribing listener", "requiredBlockConfirmations", sub.opts.MinIncomingConfirmations, "address", sub.opts.Contract, "jobID", sub.listener.JobID())
+			delete(b.subs, sub)
+		}
+	}
+}
+
+// registerFilter registers the filter of s with the LogPoller, unless it already exists. New filters are replayed
+// from the first block which is backfilled on the first broadcast to s, as the LogPoller only indexes the logs of
+// a filter from the block it was registered at.
+func (b *logPollerBroadcaster) registerFilter(ctx context.Context, s *logPollerSubscription) error {
+	if b.lp.HasFilter(s.filter.Name) {
+		return nil
+	}
+	if err := b.lp.RegisterFilter(ctx, s.filter); err != nil {
+		return fmt.Errorf("failed to register filter %s: %w", s.filter.Name, err)
+	}
+	latest, err := b.lp.LatestBlock(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get latest block from LogPoller to replay filter %s: %w", s.filter.Name, err)
+	}
+	fromBlock := latest.BlockNumber - int64(s.opts.MinIncomingConfirmations) + 1 - int64(b.config.BlockBackfillDepth())
+	if s.fromBlock >= 0 {
+		fromBlock = min(fromBlock, s.fromBlock)
+	}
+	fromBlock = max(1, fromBlock)
+	b.logger.Infow("Replaying new filter", "name", s.filter.Name, "fromBlock", fromBlock)
+	b.lp.ReplayAsync(fromBlock)
+	return nil
+}
+
+// unregisterStaleFilters unregisters the filters of subscriptions which no longer exist, e.g. of deleted jobs.
+func (b *logPollerBroadcaster) unregisterStaleFilters(ctx context.Context) {
+	current := make(map[string]struct{}, len(b.subs))
+	for _, s := range b.subs {
+		current[s.filter.Name] = struct{}{}
+	}
+	for name := range b.lp.GetFilters() {
+		if _, ok := current[name]; ok || !strings.HasPrefix(name, listenerFilterID+" - ") {
+			continue
+		}
+		b.logger.Infow("Unregistering stale filter", "name", name)
+		if err := b.lp.UnregisterFilter(ctx, name); err != nil {
+			b.logger.Errorw("Failed to unregister stale filter", "name", name, "err", err)
+		}
+	}
+}
+
+func (b *logPollerBroadcaster) onReplayRequest(ctx context.Context, req replayRequest) {
+	for _, s := range b.subs {
+		if s.opts.ReplayStartedCallback != nil {
+			s.opts.ReplayStartedCallback()
+		}
+		if s.fromBlock < 0 || req.fromBlock < s.fromBlock {
+			s.fromBlock = req.fromBlock
+		}
+	}
+	if req.forceBroadcast {
+		// Use a longer timeout in the event that a very large amount of logs need to be marked
+		// as unconsumed.
+		ctx, cancel := context.WithTimeout(sqlutil.WithoutDefaultTimeout(ctx), time.Minute)
+		defer cancel()
+		if err := b.orm.MarkBroadcastsUnconsumed(ctx, req.fromBlock); err != nil {
+			b.logger.Errorw("Error marking broadcasts as unconsumed", "err", err, "fromBlock", req.fromBlock)
+		}
+	}
+	b.lp.ReplayAsync(req.fromBlock)
+}
+
+// broadcastLogs sends the logs indexed by the LogPoller up to its latest block to the subscribers.
+func (b *logPollerBroadcaster) broadcastLogs(ctx context.Context) {
+	latest, err := b.lp.LatestBlock(ctx)
+	if err != nil {
+		b.logger.Errorw("Failed to get latest block from LogPoller", "err", err)
+		return
+	}
+	for _, s := range b.subs {
+		if err := b.broadcastToSubscriber(ctx, s, latest); err != nil {
+			b.logger.Errorw("Failed to broadcast logs", "jobID", s.listener.JobID(), "address", s.opts.Contract, "err", err)
+		}
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+func (b *logPollerBroadcaster) broadcastToSubscriber(ctx context.Context, s *logPollerSubscription, latest logpoller.LogPollerBlock) error {
+	toBlock := latest.BlockNumber - int64(s.opts.MinIncomingConfirmations) + 1
+	if s.fromBlock < 0 {
+		s.fromBlock = max(0, toBlock-int64(b.config.BlockBackfillDepth()))
+	}
+	if toBlock < s.fromBlock {
+		return nil
+	}
+	if err := b.registerFilter(ctx, s); err != nil {
+		return err
+	}
+
+	logs, err := b.lp.LogsWithSigs(ctx, s.fromBlock, toBlock, s.filter.EventSigs, s.opts.Contract)
+	if err != nil {
+		return fmt.Errorf("failed to get logs: %w", err)
+	}
+	broadcasts, err := b.orm.FindBroadcasts(ctx, s.fromBlock, toBlock)
+	if err != nil {
+		return fmt.Errorf("failed to find broadcasts: %w", err)
+	}
+	consumedByKey := make(map[LogBroadcastAsKey]bool, len(broadcasts))
+	for _, lb := range broadcasts {
+		consumedByKey[lb.AsKey()] = lb.Consumed
+	}
+
+	jobID := s.listener.JobID()
+	for _, lpLog := range logs {
+		log := lpLog.ToGethLog()
+		consumed, exists := consumedByKey[NewLogBroadcastAsKey(log, s.listener)]
+		if consumed {
+			continue
+		}
+		if filters := s.opts.LogsWithTopics[log.Topics[0]]; len(filters) > 0 && len(log.Topics) > 1 {
+			if !filtersContainValues(log.Topics[1:], filters) {
+				continue
+			}
+		}
+		decodedLog, err := s.opts.ParseLog(log)
+		if err != nil {
+			b.logger.Errorw("Could not parse contract log", "err", err)
+			continue
+		}
+		if !exists {
+			// Create unconsumed broadcast
+			if err := b.orm.CreateBroadcast(ctx, log.BlockHash, log.BlockNumber, log.Index, jobID); err != nil {
+				b.logger.Errorw("Could not create broadcast log", "blockNumber", log.BlockNumber,
+					"blockHash", log.BlockHash, "address", log.Address, "jobID", jobID, "err", err)
+				continue
+			}
+		}
+		s.listener.HandleLog(ctx, &broadcast{
+			latestBlockNumber: uint64(latest.BlockNumber),
+			latestBlockHash:   latest.BlockHash,
+			decodedLog:        decodedLog,
+			rawLog:            log,
+			jobID:             jobID,
+			evmChainID:        b.evmChainID,
+		})
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+
+	// unconsumed logs are sent again, until they are older than the finality depth
+	s.fromBlock = max(s.fromBlock, toBlock-int64(b.config.FinalityDepth())+1)
+	return nil
+}
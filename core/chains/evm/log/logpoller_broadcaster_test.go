@@ -0,0 +1,156 @@
+package log_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/mailbox/mailboxtest"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/log"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	lpmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller/mocks"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	evmutils "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+func TestListenerFilter(t *testing.T) {
+	contract := testutils.NewAddress()
+	sig1, sig2 := common.Hash{1}, common.Hash{2}
+	v1, v2, v3 := log.Topic{0xa}, log.Topic{0xb}, log.Topic{0xc}
+
+	filter := log.ListenerFilter(7, log.ListenerOpts{
+		Contract: contract,
+		LogsWithTopics: map[common.Hash][][]log.Topic{
+			sig2: {{v2}, {v3}},
+			sig1: {{v1}, {}, {v3}},
+		},
+	})
+	assert.Equal(t, logpoller.Filter{
+		Name:      "LogBroadcaster - 7:" + contract.Hex(),
+		Addresses: evmtypes.AddressArray{contract},
+		EventSigs: evmtypes.HashArray{sig1, sig2},
+		// the values of each position are merged, and any value is allowed unless all event types filter it
+		Topic2: evmtypes.HashArray{common.Hash(v1), common.Hash(v2)},
+	}, filter)
+	assert.Equal(t, log.ListenerFilterName(7, contract), filter.Name)
+}
+
+type logPollerBroadcasterConfig struct{}
+
+func (logPollerBroadcasterConfig) BlockBackfillDepth() uint64   { return 10 }
+func (logPollerBroadcasterConfig) BlockBackfillSkip() bool      { return false }
+func (logPollerBroadcasterConfig) FinalityDepth() uint32        { return 5 }
+func (logPollerBroadcasterConfig) LogBackfillBatchSize() uint32 { return 100 }
+
+type consumingListener struct {
+	jobID int32
+	lb    log.Broadcaster
+
+	mu       sync.Mutex
+	received []types.Log
+}
+
+func (l *consumingListener) JobID() int32 { return l.jobID }
+
+func (l *consumingListener) HandleLog(ctx context.Context, b log.Broadcast) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.received = append(l.received, b.RawLog())
+	if err := l.lb.MarkConsumed(ctx, nil, b); err != nil {
+		panic(err)
+	}
+}
+
+func (l *consumingListener) blockNumbers() (numbers []uint64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, lg := range l.received {
+		numbers = append(numbers, lg.BlockNumber)
+	}
+	return
+}
+
+func TestLogPollerBroadcaster(t *testing.T) {
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, addr := cltest.MustInsertRandomKey(t, ethKeyStore)
+	spec := cltest.MustInsertV2JobSpec(t, db, addr)
+	orm := log.NewORM(db, cltest.FixtureChainID)
+	mailMon := servicetest.Run(t, mailboxtest.NewMonitor(t))
+
+	contract := testutils.NewAddress()
+	sig, wanted, unwanted := common.Hash{1}, common.Hash{0xa}, common.Hash{0xb}
+	newLog := func(blockNumber int64, topic common.Hash) logpoller.Log {
+		return logpoller.Log{
+			BlockHash:   evmutils.NewHash(),
+			BlockNumber: blockNumber,
+			Topics:      pq.ByteaArray{sig[:], topic[:]},
+			EventSig:    sig,
+			Address:     contract,
+		}
+	}
+	logWanted, logUnwanted, logConsumed, logNext := newLog(9, wanted), newLog(10, unwanted), newLog(11, wanted), newLog(19, wanted)
+	consumed := logConsumed.ToGethLog()
+	require.NoError(t, orm.MarkBroadcastConsumed(ctx, consumed.BlockHash, consumed.BlockNumber, consumed.Index, spec.ID))
+
+	opts := log.ListenerOpts{
+		Contract:       contract,
+		LogsWithTopics: map[common.Hash][][]log.Topic{sig: {{log.Topic(wanted)}}},
+		ParseLog: func(log types.Log) (generated.AbigenLog, error) {
+			return nil, nil
+		},
+		MinIncomingConfirmations: 3,
+	}
+	filter := log.ListenerFilter(spec.ID, opts)
+	staleFilter := log.ListenerFilterName(spec.ID+1, contract)
+
+	lp := lpmocks.NewLogPoller(t)
+	lp.On("GetFilters").Return(map[string]logpoller.Filter{staleFilter: {}, "other": {}}).Once()
+	lp.On("UnregisterFilter", mock.Anything, staleFilter).Return(nil).Once()
+	lp.On("HasFilter", filter.Name).Return(false).Once()
+	lp.On("HasFilter", filter.Name).Return(true)
+	// the filter is registered when the subscriber is added, and replayed from the first block of the backfill
+	lp.On("RegisterFilter", mock.Anything, filter).Return(nil).Once()
+	lp.On("LatestBlock", mock.Anything).Return(logpoller.LogPollerBlock{BlockNumber: 20}, nil).Once()
+	lp.On("ReplayAsync", int64(8)).Once()
+	// the first head backfills, the next ones only rescan the logs which may be unconsumed
+	lp.On("LatestBlock", mock.Anything).Return(logpoller.LogPollerBlock{BlockNumber: 20}, nil).Once()
+	lp.On("LogsWithSigs", mock.Anything, int64(8), int64(18), []common.Hash(filter.EventSigs), contract).
+		Return([]logpoller.Log{logWanted, logUnwanted, logConsumed}, nil).Once()
+	lp.On("LatestBlock", mock.Anything).Return(logpoller.LogPollerBlock{BlockNumber: 21}, nil).Once()
+	lp.On("LogsWithSigs", mock.Anything, int64(14), int64(19), []common.Hash(filter.EventSigs), contract).
+		Return([]logpoller.Log{logNext}, nil).Once()
+
+	lb := log.NewLogPollerBroadcaster(orm, lp, cltest.FixtureChainID, logPollerBroadcasterConfig{}, logger.TestLogger(t), mailMon)
+	lb.AddDependents(1)
+	servicetest.Run(t, lb)
+	listener := &consumingListener{jobID: spec.ID, lb: lb}
+	t.Cleanup(lb.Register(listener, opts))
+	lb.DependentReady()
+
+	lb.OnNewLongestChain(ctx, &evmtypes.Head{Number: 20})
+	require.Eventually(t, func() bool { return len(listener.blockNumbers()) == 1 }, testutils.WaitTimeout(t), testutils.TestInterval)
+	lb.OnNewLongestChain(ctx, &evmtypes.Head{Number: 21})
+	require.Eventually(t, func() bool { return len(listener.blockNumbers()) == 2 }, testutils.WaitTimeout(t), testutils.TestInterval)
+	assert.Equal(t, []uint64{9, 19}, listener.blockNumbers())
+
+	wantedLog := logWanted.ToGethLog()
+	was, err := orm.WasBroadcastConsumed(ctx, wantedLog.BlockHash, wantedLog.Index, spec.ID)
+	require.NoError(t, err)
+	assert.True(t, was)
+}
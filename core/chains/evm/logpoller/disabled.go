@@ -62,6 +62,10 @@ func (disabled) LogsWithSigs(ctx context.Context, start, end int64, eventSigs []
 	return nil, ErrDisabled
 }
 
+func (disabled) LogsWithSigsPage(ctx context.Context, start, end int64, eventSigs []common.Hash, address common.Address, after LogCursor, limit int) ([]Log, error) {
+	return nil, ErrDisabled
+}
+
 func (disabled) LatestLogByEventSigWithConfs(ctx context.Context, eventSig common.Hash, address common.Address, confs evmtypes.Confirmations) (*Log, error) {
 	return nil, ErrDisabled
 }
@@ -94,6 +98,10 @@ func (disabled) LogsDataWordRange(ctx context.Context, eventSig common.Hash, add
 	return nil, ErrDisabled
 }
 
+func (disabled) LogsDataWordRangePage(ctx context.Context, eventSig common.Hash, address common.Address, wordIndex int, wordValueMin, wordValueMax common.Hash, confs evmtypes.Confirmations, after LogCursor, limit int) ([]Log, error) {
+	return nil, ErrDisabled
+}
+
 func (disabled) LogsDataWordGreaterThan(ctx context.Context, eventSig common.Hash, address common.Address, wordIndex int, wordValueMin common.Hash, confs evmtypes.Confirmations) ([]Log, error) {
 	return nil, ErrDisabled
 }
@@ -129,3 +137,11 @@ func (d disabled) FindLCA(ctx context.Context) (*LogPollerBlock, error) {
 func (d disabled) DeleteLogsAndBlocksAfter(ctx context.Context, start int64) error {
 	return ErrDisabled
 }
+
+func (d disabled) RecoverFinalityViolation(ctx context.Context) (*FinalityRecoveryReport, error) {
+	return nil, ErrDisabled
+}
+
+func (d disabled) Checkpoint(ctx context.Context) (LogPollerBlock, error) {
+	return LogPollerBlock{}, ErrDisabled
+}
@@ -0,0 +1,150 @@
+package logpoller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/mathutil"
+)
+
+// FinalityRecoveryReport describes the changes made to LogPoller's state while recovering from a finality violation.
+type FinalityRecoveryReport struct {
+	// LCA is the last block which is present both in the db and on the canonical chain.
+	LCA LogPollerBlock
+	// FromBlock and ToBlock define the range of blocks which were re-verified against the RPC.
+	FromBlock int64
+	ToBlock   int64
+	// RemovedLogs were stored in the db, but are not part of the canonical chain.
+	RemovedLogs []Log
+	// AddedLogs are part of the canonical chain, but were missing from the db.
+	AddedLogs []Log
+}
+
+type logKey struct {
+	blockHash   common.Hash
+	blockNumber int64
+	logIndex    int64
+	txHash      common.Hash
+}
+
+func keyOf(l Log) logKey {
+	return logKey{blockHash: l.BlockHash, blockNumber: l.BlockNumber, logIndex: l.LogIndex, txHash: l.TxHash}
+}
+
+type finalityRecoveryResult struct {
+	report *FinalityRecoveryReport
+	err    error
+}
+
+// RecoverFinalityViolation brings LogPoller back to a healthy state after a reorg deeper than finality was detected.
+// It finds the last common ancestor between the db and the RPC, rewrites all logs after it with the canonical ones
+// up to the latest finalized block and reports which logs have changed. Blocks past the latest finalized block are
+// left for the main poll loop to process, same as Replay does.
+// The recovery runs in the main poll loop, so that it never races with a poll, and this blocks until it is complete.
+// If ctx is cancelled after the recovery was started, it still runs to completion.
+func (lp *logPoller) RecoverFinalityViolation(ctx context.Context) (*FinalityRecoveryReport, error) {
+	resultCh := make(chan finalityRecoveryResult, 1)
+	select {
+	case lp.finalityRecoveryStart <- resultCh:
+	case <-lp.stopCh:
+		return nil, ErrLogPollerShutdown
+	case <-ctx.Done():
+		return nil, fmt.Errorf("finality violation recovery not started: %w", ctx.Err())
+	}
+	select {
+	case result := <-resultCh:
+		return result.report, result.err
+	case <-ctx.Done():
+		return nil, fmt.Errorf("finality violation recovery still in progress: %w", ctx.Err())
+	}
+}
+
+func (lp *logPoller) handleFinalityRecoveryRequest(ctx context.Context, resultCh chan<- finalityRecoveryResult) {
+	lp.pollMu.Lock()
+	report, err := lp.recoverFinalityViolation(ctx)
+	lp.pollMu.Unlock()
+	if err != nil {
+		lp.lggr.Errorw("Failed to recover from finality violation", "err", err)
+	}
+	resultCh <- finalityRecoveryResult{report: report, err: err}
+}
+
+func (lp *logPoller) recoverFinalityViolation(ctx context.Context) (*FinalityRecoveryReport, error) {
+	lca, err := lp.FindLCA(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find lca: %w", err)
+	}
+
+	latest, err := lp.orm.SelectLatestBlock(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select the latest block: %w", err)
+	}
+
+	_, latestFinalizedBlockNumber, err := lp.latestBlocks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest finalized block from RPC: %w", err)
+	}
+
+	report := &FinalityRecoveryReport{
+		LCA:       *lca,
+		FromBlock: lca.BlockNumber + 1,
+		ToBlock:   mathutil.Min(latest.BlockNumber, latestFinalizedBlockNumber),
+	}
+
+	lp.lggr.Warnw("Recovering from finality violation", "lca", lca.BlockNumber, "fromBlock", report.FromBlock,
+		"toBlock", report.ToBlock, "latestBlock", latest.BlockNumber)
+
+	var storedLogs []Log
+	if report.FromBlock <= report.ToBlock {
+		storedLogs, err = lp.orm.SelectLogsByBlockRange(ctx, report.FromBlock, report.ToBlock)
+		if err != nil {
+			return nil, fmt.Errorf("failed to select stored logs: %w", err)
+		}
+	}
+
+	if err = lp.orm.DeleteLogsAndBlocksAfter(ctx, report.FromBlock); err != nil {
+		return nil, fmt.Errorf("failed to delete logs and blocks after lca: %w", err)
+	}
+
+	var canonicalLogs []Log
+	if report.FromBlock <= report.ToBlock {
+		if err = lp.backfill(ctx, report.FromBlock, report.ToBlock); err != nil {
+			return nil, fmt.Errorf("failed to backfill canonical logs: %w", err)
+		}
+
+		canonicalLogs, err = lp.orm.SelectLogsByBlockRange(ctx, report.FromBlock, report.ToBlock)
+		if err != nil {
+			return nil, fmt.Errorf("failed to select canonical logs: %w", err)
+		}
+	}
+
+	report.RemovedLogs, report.AddedLogs = diffLogs(storedLogs, canonicalLogs)
+	lp.finalityViolated.Store(false)
+
+	lp.lggr.Infow("Recovered from finality violation", "lca", lca.BlockNumber, "removedLogs", len(report.RemovedLogs),
+		"addedLogs", len(report.AddedLogs))
+	return report, nil
+}
+
+// diffLogs returns logs which are only present in before (removed) and logs which are only present in after (added).
+func diffLogs(before, after []Log) (removed, added []Log) {
+	afterKeys := make(map[logKey]struct{}, len(after))
+	for _, l := range after {
+		afterKeys[keyOf(l)] = struct{}{}
+	}
+	beforeKeys := make(map[logKey]struct{}, len(before))
+	for _, l := range before {
+		beforeKeys[keyOf(l)] = struct{}{}
+		if _, ok := afterKeys[keyOf(l)]; !ok {
+			removed = append(removed, l)
+		}
+	}
+	for _, l := range after {
+		if _, ok := beforeKeys[keyOf(l)]; !ok {
+			added = append(added, l)
+		}
+	}
+	return removed, added
+}
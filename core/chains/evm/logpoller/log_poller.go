@@ -10,6 +10,7 @@ import (
 	"math/big"
 	"math/rand"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -27,12 +28,17 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	"github.com/smartcontractkit/chainlink-common/pkg/timeutil"
 	"github.com/smartcontractkit/chainlink-common/pkg/types/query"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/mailbox"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/mathutil"
 
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
+	"github.com/smartcontractkit/chainlink/v2/common/headtracker"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/services/alerting"
+	"github.com/smartcontractkit/chainlink/v2/core/services/maintenance"
 )
 
 type LogPoller interface {
@@ -48,10 +54,16 @@ type LogPoller interface {
 	GetBlocksRange(ctx context.Context, numbers []uint64) ([]LogPollerBlock, error)
 	FindLCA(ctx context.Context) (*LogPollerBlock, error)
 	DeleteLogsAndBlocksAfter(ctx context.Context, start int64) error
+	RecoverFinalityViolation(ctx context.Context) (*FinalityRecoveryReport, error)
+	// Checkpoint waits for the poll in progress to save its logs and stops polling new blocks, so that the logs of
+	// interrupted polls aren't fetched again after a restart. It returns the latest block saved, from which polling
+	// resumes after the restart.
+	Checkpoint(ctx context.Context) (LogPollerBlock, error)
 
 	// General querying
 	Logs(ctx context.Context, start, end int64, eventSig common.Hash, address common.Address) ([]Log, error)
 	LogsWithSigs(ctx context.Context, start, end int64, eventSigs []common.Hash, address common.Address) ([]Log, error)
+	LogsWithSigsPage(ctx context.Context, start, end int64, eventSigs []common.Hash, address common.Address, after LogCursor, limit int) ([]Log, error)
 	LogsCreatedAfter(ctx context.Context, eventSig common.Hash, address common.Address, time time.Time, confs evmtypes.Confirmations) ([]Log, error)
 	LatestLogByEventSigWithConfs(ctx context.Context, eventSig common.Hash, address common.Address, confs evmtypes.Confirmations) (*Log, error)
 	LatestLogEventSigsAddrsWithConfs(ctx context.Context, fromBlock int64, eventSigs []common.Hash, addresses []common.Address, confs evmtypes.Confirmations) ([]Log, error)
@@ -66,6 +78,7 @@ type LogPoller interface {
 	IndexedLogsTopicRange(ctx context.Context, eventSig common.Hash, address common.Address, topicIndex int, topicValueMin common.Hash, topicValueMax common.Hash, confs evmtypes.Confirmations) ([]Log, error)
 	IndexedLogsWithSigsExcluding(ctx context.Context, address common.Address, eventSigA, eventSigB common.Hash, topicIndex int, fromBlock, toBlock int64, confs evmtypes.Confirmations) ([]Log, error)
 	LogsDataWordRange(ctx context.Context, eventSig common.Hash, address common.Address, wordIndex int, wordValueMin, wordValueMax common.Hash, confs evmtypes.Confirmations) ([]Log, error)
+	LogsDataWordRangePage(ctx context.Context, eventSig common.Hash, address common.Address, wordIndex int, wordValueMin, wordValueMax common.Hash, confs evmtypes.Confirmations, after LogCursor, limit int) ([]Log, error)
 	LogsDataWordGreaterThan(ctx context.Context, eventSig common.Hash, address common.Address, wordIndex int, wordValueMin common.Hash, confs evmtypes.Confirmations) ([]Log, error)
 	LogsDataWordBetween(ctx context.Context, eventSig common.Hash, address common.Address, wordIndexMin, wordIndexMax int, wordValue common.Hash, confs evmtypes.Confirmations) ([]Log, error)
 
@@ -94,6 +107,11 @@ type HeadTracker interface {
 	LatestAndFinalizedBlock(ctx context.Context) (latest, finalized *evmtypes.Head, err error)
 }
 
+// ReorgBus delivers the reorgs detected by the HeadTracker.
+type ReorgBus interface {
+	Subscribe(callback headtracker.ReorgSubscriber[*evmtypes.Head, common.Hash]) (unsubscribe func())
+}
+
 var (
 	_                       LogPollerTest = &logPoller{}
 	ErrReplayRequestAborted               = pkgerrors.New("aborted, replay request cancelled")
@@ -116,6 +134,9 @@ type logPoller struct {
 	rpcBatchSize             int64         // batch size to use for fallback RPC calls made in GetBlocks
 	logPrunePageSize         int64
 	clientErrors             config.ClientErrors
+	maintenanceGate          *maintenance.Gate
+	reorgBus                 ReorgBus
+	reorgs                   *mailbox.Mailbox[headtracker.Reorg[*evmtypes.Head, common.Hash]]
 	backupPollerNextBlock    int64 // next block to be processed by Backup LogPoller
 	backupPollerBlockDelay   int64 // how far behind regular LogPoller should BackupLogPoller run. 0 = disabled
 
@@ -129,12 +150,17 @@ type logPoller struct {
 	replayComplete chan error
 	stopCh         services.StopChan
 	wg             sync.WaitGroup
+	// finalityRecoveryStart passes the requests of RecoverFinalityViolation to the main poll loop
+	finalityRecoveryStart chan chan<- finalityRecoveryResult
 	// This flag is raised whenever the log poller detects that the chain's finality has been violated.
 	// It can happen when reorg is deeper than the latest finalized block that LogPoller saw in a previous PollAndSave tick.
 	// Usually the only way to recover is to manually remove the offending logs and block from the database.
 	// LogPoller keeps running in infinite loop, so whenever the invalid state is removed from the database it should
 	// recover automatically without needing to restart the LogPoller.
 	finalityViolated *atomic.Bool
+
+	pollMu       sync.Mutex // held by polls, so that Checkpoint can wait for them
+	checkpointed atomic.Bool
 }
 
 type Opts struct {
@@ -147,6 +173,8 @@ type Opts struct {
 	BackupPollerBlockDelay   int64
 	LogPrunePageSize         int64
 	ClientErrors             config.ClientErrors
+	MaintenanceGate          *maintenance.Gate // pruning is deferred while not open
+	ReorgBus                 ReorgBus          // optional, rewinds as soon as the HeadTracker detects a reorg
 }
 
 // NewLogPoller creates a log poller. Note there is an assumption
@@ -168,6 +196,7 @@ func NewLogPoller(orm ORM, ec Client, lggr logger.Logger, headTracker HeadTracke
 		lggr:                     logger.Sugared(logger.Named(lggr, "LogPoller")),
 		replayStart:              make(chan int64),
 		replayComplete:           make(chan error),
+		finalityRecoveryStart:    make(chan chan<- finalityRecoveryResult),
 		pollPeriod:               opts.PollPeriod,
 		backupPollerBlockDelay:   opts.BackupPollerBlockDelay,
 		finalityDepth:            opts.FinalityDepth,
@@ -177,6 +206,9 @@ func NewLogPoller(orm ORM, ec Client, lggr logger.Logger, headTracker HeadTracke
 		keepFinalizedBlocksDepth: opts.KeepFinalizedBlocksDepth,
 		logPrunePageSize:         opts.LogPrunePageSize,
 		clientErrors:             opts.ClientErrors,
+		maintenanceGate:          opts.MaintenanceGate,
+		reorgBus:                 opts.ReorgBus,
+		reorgs:                   mailbox.NewHighCapacity[headtracker.Reorg[*evmtypes.Head, common.Hash]](),
 		filters:                  make(map[string]Filter),
 		filterDirty:              true, // Always build Filter on first call to cache an empty filter if nothing registered yet.
 		finalityViolated:         new(atomic.Bool),
@@ -184,15 +216,16 @@ func NewLogPoller(orm ORM, ec Client, lggr logger.Logger, headTracker HeadTracke
 }
 
 type Filter struct {
-	Name         string // see FilterName(id, args) below
-	Addresses    evmtypes.AddressArray
-	EventSigs    evmtypes.HashArray // list of possible values for eventsig (aka topic1)
-	Topic2       evmtypes.HashArray // list of possible values for topic2
-	Topic3       evmtypes.HashArray // list of possible values for topic3
-	Topic4       evmtypes.HashArray // list of possible values for topic4
-	Retention    time.Duration      // maximum amount of time to retain logs
-	MaxLogsKept  uint64             // maximum number of logs to retain ( 0 = unlimited )
-	LogsPerBlock uint64             // rate limit ( maximum # of logs per block, 0 = unlimited )
+	Name            string // see FilterName(id, args) below
+	Addresses       evmtypes.AddressArray
+	EventSigs       evmtypes.HashArray // list of possible values for eventsig (aka topic1)
+	Topic2          evmtypes.HashArray // list of possible values for topic2
+	Topic3          evmtypes.HashArray // list of possible values for topic3
+	Topic4          evmtypes.HashArray // list of possible values for topic4
+	Retention       time.Duration      // maximum amount of time to retain logs
+	RetentionBlocks uint64             // maximum number of blocks behind the latest block to retain logs ( 0 = unlimited )
+	MaxLogsKept     uint64             // maximum number of logs to retain ( 0 = unlimited )
+	LogsPerBlock    uint64             // rate limit ( maximum # of logs per block, 0 = unlimited )
 }
 
 // FilterName is a suggested convenience function for clients to construct unique filter names
@@ -331,15 +364,16 @@ func (lp *logPoller) GetFilters() map[string]Filter {
 	filters := make(map[string]Filter)
 	for k, v := range lp.filters {
 		deepCopyFilter := Filter{
-			Name:         v.Name,
-			Addresses:    make(evmtypes.AddressArray, len(v.Addresses)),
-			EventSigs:    make(evmtypes.HashArray, len(v.EventSigs)),
-			Topic2:       make(evmtypes.HashArray, len(v.Topic2)),
-			Topic3:       make(evmtypes.HashArray, len(v.Topic3)),
-			Topic4:       make(evmtypes.HashArray, len(v.Topic4)),
-			Retention:    v.Retention,
-			MaxLogsKept:  v.MaxLogsKept,
-			LogsPerBlock: v.LogsPerBlock,
+			Name:            v.Name,
+			Addresses:       make(evmtypes.AddressArray, len(v.Addresses)),
+			EventSigs:       make(evmtypes.HashArray, len(v.EventSigs)),
+			Topic2:          make(evmtypes.HashArray, len(v.Topic2)),
+			Topic3:          make(evmtypes.HashArray, len(v.Topic3)),
+			Topic4:          make(evmtypes.HashArray, len(v.Topic4)),
+			Retention:       v.Retention,
+			RetentionBlocks: v.RetentionBlocks,
+			MaxLogsKept:     v.MaxLogsKept,
+			LogsPerBlock:    v.LogsPerBlock,
 		}
 		copy(deepCopyFilter.Addresses, v.Addresses)
 		copy(deepCopyFilter.EventSigs, v.EventSigs)
@@ -495,6 +529,15 @@ func (lp *logPoller) ReplayAsync(fromBlock int64) {
 
 func (lp *logPoller) Start(context.Context) error {
 	return lp.StartOnce("LogPoller", func() error {
+		if lp.reorgBus != nil {
+			unsubscribe := lp.reorgBus.Subscribe(lp)
+			lp.wg.Add(1)
+			go func() {
+				defer lp.wg.Done()
+				<-lp.stopCh
+				unsubscribe()
+			}()
+		}
 		lp.wg.Add(2)
 		go lp.run()
 		go lp.backgroundWorkerRun()
@@ -514,6 +557,32 @@ func (lp *logPoller) Close() error {
 	})
 }
 
+func (lp *logPoller) Checkpoint(ctx context.Context) (LogPollerBlock, error) {
+	lp.checkpointed.Store(true)
+	polled := make(chan struct{})
+	go func() {
+		lp.pollMu.Lock()
+		defer lp.pollMu.Unlock()
+		close(polled)
+	}()
+	select {
+	case <-ctx.Done():
+		return LogPollerBlock{}, fmt.Errorf("failed to wait for the poll in progress: %w", ctx.Err())
+	case <-polled:
+	}
+	return lp.LatestBlock(ctx)
+}
+
+// pollUnlessCheckpointed runs poll, unless the log poller was checkpointed.
+func (lp *logPoller) pollUnlessCheckpointed(poll func()) {
+	lp.pollMu.Lock()
+	defer lp.pollMu.Unlock()
+	if lp.checkpointed.Load() {
+		return
+	}
+	poll()
+}
+
 func (lp *logPoller) Healthy() error {
 	if lp.finalityViolated.Load() {
 		return ErrFinalityViolated
@@ -572,6 +641,7 @@ func (lp *logPoller) run() {
 	defer lp.wg.Done()
 	ctx, cancel := lp.stopCh.NewCtx()
 	defer cancel()
+	ctx = commonclient.CtxWithRequestSource(ctx, commonclient.RequestSourceLogPoller)
 	logPollTicker := services.NewTicker(lp.pollPeriod)
 	defer logPollTicker.Stop()
 	// stagger these somewhat, so they don't all run back-to-back
@@ -588,6 +658,16 @@ func (lp *logPoller) run() {
 			return
 		case fromBlockReq := <-lp.replayStart:
 			lp.handleReplayRequest(ctx, fromBlockReq, filtersLoaded)
+		case resultCh := <-lp.finalityRecoveryStart:
+			lp.handleFinalityRecoveryRequest(ctx, resultCh)
+		case <-lp.reorgs.Notify():
+			for {
+				reorg, exists := lp.reorgs.Retrieve()
+				if !exists {
+					break
+				}
+				lp.handleReorg(ctx, reorg)
+			}
 		case <-logPollTicker.C:
 			if !filtersLoaded {
 				if err := lp.loadFilters(ctx); err != nil {
@@ -618,7 +698,7 @@ func (lp *logPoller) run() {
 			} else {
 				start = lastProcessed.BlockNumber + 1
 			}
-			lp.PollAndSaveLogs(ctx, start)
+			lp.pollUnlessCheckpointed(func() { lp.PollAndSaveLogs(ctx, start) })
 		case <-backupLogPollTicker.C:
 			if lp.backupPollerBlockDelay == 0 {
 				continue // backup poller is disabled
@@ -635,7 +715,7 @@ func (lp *logPoller) run() {
 				lp.lggr.Warnw("Backup log poller ran before filters loaded, skipping")
 				continue
 			}
-			lp.BackupPollAndSaveLogs(ctx)
+			lp.pollUnlessCheckpointed(func() { lp.BackupPollAndSaveLogs(ctx) })
 		}
 	}
 }
@@ -644,6 +724,7 @@ func (lp *logPoller) backgroundWorkerRun() {
 	defer lp.wg.Done()
 	ctx, cancel := lp.stopCh.NewCtx()
 	defer cancel()
+	ctx = commonclient.CtxWithRequestSource(ctx, commonclient.RequestSourceLogPoller)
 
 	blockPruneShortInterval := lp.pollPeriod * 100
 	blockPruneInterval := blockPruneShortInterval * 10
@@ -666,6 +747,11 @@ func (lp *logPoller) backgroundWorkerRun() {
 			return
 		case <-blockPruneTick:
 			blockPruneTick = tickWithDefaultJitter(blockPruneInterval)
+			if !lp.maintenanceGate.Open() {
+				// Check again sooner, so that pruning runs early in the next maintenance window
+				blockPruneTick = tickWithDefaultJitter(blockPruneShortInterval)
+				continue
+			}
 			if allRemoved, err := lp.PruneOldBlocks(ctx); err != nil {
 				lp.lggr.Errorw("Unable to prune old blocks", "err", err)
 			} else if !allRemoved {
@@ -674,6 +760,10 @@ func (lp *logPoller) backgroundWorkerRun() {
 			}
 		case <-logPruneTick:
 			logPruneTick = tickWithDefaultJitter(logPruneInterval)
+			if !lp.maintenanceGate.Open() {
+				logPruneTick = tickWithDefaultJitter(logPruneShortInterval)
+				continue
+			}
 			if allRemoved, err := lp.PruneExpiredLogs(ctx); err != nil {
 				lp.lggr.Errorw("Unable to prune expired logs", "err", err)
 			} else if !allRemoved {
@@ -708,7 +798,11 @@ func (lp *logPoller) handleReplayRequest(ctx context.Context, fromBlockReq int64
 		if err == nil {
 			// Serially process replay requests.
 			lp.lggr.Infow("Executing replay", "fromBlock", fromBlock, "requested", fromBlockReq)
-			lp.PollAndSaveLogs(ctx, fromBlock)
+			// Replays reach arbitrarily far back, so route them to archive nodes if there are any. They were requested
+			// explicitly, so they run even once checkpointed.
+			lp.pollMu.Lock()
+			lp.PollAndSaveLogs(commonclient.CtxRequireArchive(ctx), fromBlock)
+			lp.pollMu.Unlock()
 			lp.lggr.Infow("Executing replay finished", "fromBlock", fromBlock, "requested", fromBlockReq)
 		}
 	} else {
@@ -871,6 +965,47 @@ func (lp *logPoller) backfill(ctx context.Context, start, end int64) error {
 	return nil
 }
 
+// OnReorg queues a reorg published by the HeadTracker, to be handled by the main loop, which owns the blocks and logs.
+func (lp *logPoller) OnReorg(_ context.Context, reorg headtracker.Reorg[*evmtypes.Head, common.Hash]) {
+	lp.reorgs.Deliver(reorg)
+}
+
+// handleReorg removes the blocks and logs after the common ancestor of a reorg published by the HeadTracker, if the
+// earliest orphaned block is still saved. Polling then resumes from the block after the common ancestor, without waiting
+// to find the mismatched parent hash. The parent hash check in getCurrentBlockMaybeHandleReorg remains in place for the
+// reorgs which only the RPC used by the LogPoller observes, and for the ones deeper than the heads kept by the HeadTracker.
+// Reorgs of finalized blocks are never rewound here, so that the finality violation is detected and reported by
+// findBlockAfterLCA on the next poll.
+func (lp *logPoller) handleReorg(ctx context.Context, reorg headtracker.Reorg[*evmtypes.Head, common.Hash]) {
+	if reorg.CommonAncestor == nil || len(reorg.Orphaned) == 0 {
+		return
+	}
+	earliestOrphaned := reorg.Orphaned[len(reorg.Orphaned)-1]
+	savedFinalizedBlockNumber, err := lp.savedFinalizedBlockNumber(ctx)
+	if err != nil {
+		lp.lggr.Warnw("Unable to read latest finalized block, leaving the reorg to the next poll", "err", err)
+		return
+	}
+	if earliestOrphaned.BlockNumber() <= savedFinalizedBlockNumber {
+		lp.lggr.Warnw("Reorg published by HeadTracker orphans finalized blocks, leaving it to the next poll", "commonAncestor", reorg.CommonAncestor.BlockNumber(), "latestFinalized", savedFinalizedBlockNumber)
+		return
+	}
+	saved, err := lp.orm.SelectBlockByNumber(ctx, earliestOrphaned.BlockNumber())
+	if err != nil {
+		if !pkgerrors.Is(err, sql.ErrNoRows) {
+			lp.lggr.Warnw("Unable to read orphaned block, leaving the reorg to the next poll", "err", err, "blockNumber", earliestOrphaned.BlockNumber())
+		}
+		return
+	}
+	if saved.BlockHash != earliestOrphaned.BlockHash() {
+		return // never saved, or already rewound
+	}
+	lp.lggr.Infow("Reorg published by HeadTracker, rewinding", "commonAncestor", reorg.CommonAncestor.BlockNumber(), "orphaned", len(reorg.Orphaned))
+	if err = lp.orm.DeleteLogsAndBlocksAfter(ctx, earliestOrphaned.BlockNumber()); err != nil {
+		lp.lggr.Errorw("Unable to rewind after reorg, leaving it to the next poll", "err", err, "blockNumber", earliestOrphaned.BlockNumber())
+	}
+}
+
 // getCurrentBlockMaybeHandleReorg accepts a block number
 // and will return that block if its parent points to our last saved block.
 // One can optionally pass the block header if it has already been queried to avoid an extra RPC call.
@@ -1090,6 +1225,18 @@ func (lp *logPoller) findBlockAfterLCA(ctx context.Context, current *evmtypes.He
 		}
 	}
 	lp.lggr.Criticalw("Reorg greater than finality depth detected", "finalityTag", lp.useFinalityTag, "current", current.Number, "latestFinalized", latestFinalizedBlockNumber)
+	chainID := lp.ec.ConfiguredChainID().String()
+	alerting.Emit(alerting.Alert{
+		Type:     alerting.EventFinalityViolation,
+		Severity: alerting.SeverityCritical,
+		Key:      chainID,
+		Summary:  fmt.Sprintf("LogPoller detected a reorg greater than finality depth on chain %s", chainID),
+		Details: map[string]string{
+			"chainID":         chainID,
+			"current":         strconv.FormatInt(current.Number, 10),
+			"latestFinalized": strconv.FormatInt(latestFinalizedBlockNumber, 10),
+		},
+	})
 	rerr := pkgerrors.New("Reorg greater than finality depth")
 	lp.SvcErrBuffer.Append(rerr)
 	lp.finalityViolated.Store(true)
@@ -1148,6 +1295,11 @@ func (lp *logPoller) LogsWithSigs(ctx context.Context, start, end int64, eventSi
 	return lp.orm.SelectLogsWithSigs(ctx, start, end, address, eventSigs)
 }
 
+// LogsWithSigsPage returns a page of at most limit LogsWithSigs which follow the cursor, see PageLogs.
+func (lp *logPoller) LogsWithSigsPage(ctx context.Context, start, end int64, eventSigs []common.Hash, address common.Address, after LogCursor, limit int) ([]Log, error) {
+	return lp.orm.SelectLogsWithSigsPage(ctx, start, end, address, eventSigs, after, limit)
+}
+
 func (lp *logPoller) LogsCreatedAfter(ctx context.Context, eventSig common.Hash, address common.Address, after time.Time, confs evmtypes.Confirmations) ([]Log, error) {
 	return lp.orm.SelectLogsCreatedAfter(ctx, address, eventSig, after, confs)
 }
@@ -1180,6 +1332,11 @@ func (lp *logPoller) LogsDataWordRange(ctx context.Context, eventSig common.Hash
 	return lp.orm.SelectLogsDataWordRange(ctx, address, eventSig, wordIndex, wordValueMin, wordValueMax, confs)
 }
 
+// LogsDataWordRangePage returns a page of at most limit LogsDataWordRange which follow the cursor, see PageLogs.
+func (lp *logPoller) LogsDataWordRangePage(ctx context.Context, eventSig common.Hash, address common.Address, wordIndex int, wordValueMin, wordValueMax common.Hash, confs evmtypes.Confirmations, after LogCursor, limit int) ([]Log, error) {
+	return lp.orm.SelectLogsDataWordRangePage(ctx, address, eventSig, wordIndex, wordValueMin, wordValueMax, confs, after, limit)
+}
+
 // IndexedLogsTopicGreaterThan finds all the logs that have a topic value greater than topicValueMin at index topicIndex.
 // Only works for integer topics.
 func (lp *logPoller) IndexedLogsTopicGreaterThan(ctx context.Context, eventSig common.Hash, address common.Address, topicIndex int, topicValueMin common.Hash, confs evmtypes.Confirmations) ([]Log, error) {
@@ -1564,6 +1721,34 @@ func (lp *logPoller) FilteredLogs(ctx context.Context, queryFilter []query.Expre
 	return lp.orm.FilteredLogs(ctx, queryFilter, limitAndSort, queryName)
 }
 
+// PageLogs reads logs page by page, and calls fn with each page of at most pageSize logs, until a page isn't full.
+// page returns the logs which follow a cursor, e.g.
+//
+//	err := PageLogs(ctx, 1000, func(ctx context.Context, after LogCursor, limit int) ([]Log, error) {
+//		return lp.LogsWithSigsPage(ctx, start, end, eventSigs, address, after, limit)
+//	}, process)
+func PageLogs(ctx context.Context, pageSize int, page func(ctx context.Context, after LogCursor, limit int) ([]Log, error), fn func([]Log) error) error {
+	if pageSize <= 0 {
+		return fmt.Errorf("invalid page size %d", pageSize)
+	}
+	cursor := FirstLogCursor
+	for {
+		logs, err := page(ctx, cursor, pageSize)
+		if err != nil {
+			return err
+		}
+		if len(logs) > 0 {
+			if err = fn(logs); err != nil {
+				return err
+			}
+			cursor = logs[len(logs)-1].Cursor()
+		}
+		if len(logs) < pageSize {
+			return nil
+		}
+	}
+}
+
 // Where is a query.Where wrapper that ignores the Key and returns a slice of query.Expression rather than query.KeyFilter.
 // If no expressions are provided, or an error occurs, an empty slice is returned.
 func Where(expressions ...query.Expression) ([]query.Expression, error) {
@@ -28,7 +28,9 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
 
+	"github.com/smartcontractkit/chainlink/v2/common/headtracker"
 	htMocks "github.com/smartcontractkit/chainlink/v2/common/headtracker/mocks"
+	commontypes "github.com/smartcontractkit/chainlink/v2/common/types"
 	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
@@ -198,6 +200,26 @@ func TestLogPoller_ConvertLogs(t *testing.T) {
 	}
 }
 
+func TestDiffLogs(t *testing.T) {
+	stored := []Log{
+		{BlockNumber: 1, BlockHash: common.HexToHash("0x1"), LogIndex: 0},
+		{BlockNumber: 2, BlockHash: common.HexToHash("0x2"), LogIndex: 0},
+	}
+	canonical := []Log{
+		{BlockNumber: 1, BlockHash: common.HexToHash("0x1"), LogIndex: 0},
+		{BlockNumber: 2, BlockHash: common.HexToHash("0x22"), LogIndex: 0},
+		{BlockNumber: 2, BlockHash: common.HexToHash("0x22"), LogIndex: 1},
+	}
+
+	removed, added := diffLogs(stored, canonical)
+	assert.Equal(t, []Log{stored[1]}, removed)
+	assert.Equal(t, []Log{canonical[1], canonical[2]}, added)
+
+	removed, added = diffLogs(stored, stored)
+	assert.Empty(t, removed)
+	assert.Empty(t, added)
+}
+
 func TestFilterName(t *testing.T) {
 	t.Parallel()
 	assert.Equal(t, "a - b:c:d", FilterName("a", "b", "c", "d"))
@@ -654,6 +676,60 @@ func Test_FetchBlocks(t *testing.T) {
 	}
 }
 
+func TestLogPoller_handleReorg(t *testing.T) {
+	lggr := logger.Test(t)
+	chainID := testutils.FixtureChainID
+	db := pgtest.NewSqlxDB(t)
+	orm := NewORM(chainID, db, lggr)
+	ctx := testutils.Context(t)
+
+	lp := NewLogPoller(orm, nil, lggr, nil, Opts{PollPeriod: time.Hour, FinalityDepth: 3})
+	var heads []*evmtypes.Head
+	for i := int64(0); i < 5; i++ {
+		h := &evmtypes.Head{Number: i, Hash: utils.NewHash(), Timestamp: time.Now()}
+		if i > 0 {
+			h.ParentHash = heads[i-1].Hash
+			h.Parent.Store(heads[i-1])
+		}
+		heads = append(heads, h)
+		require.NoError(t, orm.InsertBlock(ctx, h.Hash, h.Number, h.Timestamp, max(0, i-3)))
+	}
+	newHead := &evmtypes.Head{Number: 5, Hash: utils.NewHash()}
+
+	t.Run("ignores reorgs of finalized blocks", func(t *testing.T) {
+		lp.handleReorg(ctx, headtracker.Reorg[*evmtypes.Head, common.Hash]{
+			NewHead:        newHead,
+			CommonAncestor: heads[0],
+			Orphaned:       []commontypes.Head[common.Hash]{heads[4], heads[3], heads[2], heads[1]},
+		})
+		latest, err := orm.SelectLatestBlock(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), latest.BlockNumber)
+	})
+
+	t.Run("ignores reorgs of blocks which were not saved", func(t *testing.T) {
+		lp.handleReorg(ctx, headtracker.Reorg[*evmtypes.Head, common.Hash]{
+			NewHead:        newHead,
+			CommonAncestor: heads[1],
+			Orphaned:       []commontypes.Head[common.Hash]{&evmtypes.Head{Number: 2, Hash: utils.NewHash()}},
+		})
+		latest, err := orm.SelectLatestBlock(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), latest.BlockNumber)
+	})
+
+	t.Run("removes the blocks after the common ancestor", func(t *testing.T) {
+		lp.handleReorg(ctx, headtracker.Reorg[*evmtypes.Head, common.Hash]{
+			NewHead:        newHead,
+			CommonAncestor: heads[1],
+			Orphaned:       []commontypes.Head[common.Hash]{heads[4], heads[3], heads[2]},
+		})
+		latest, err := orm.SelectLatestBlock(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, heads[1].Hash, latest.BlockHash)
+	})
+}
+
 func benchmarkFilter(b *testing.B, nFilters, nAddresses, nEvents int) {
 	lggr := logger.Test(b)
 	lpOpts := Opts{
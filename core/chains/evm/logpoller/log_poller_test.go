@@ -1131,6 +1131,108 @@ func TestLogPoller_ReorgDeeperThanFinality(t *testing.T) {
 	}
 }
 
+func TestLogPoller_RecoverFinalityViolation(t *testing.T) {
+	th := SetupTH(t, logpoller.Opts{
+		UseFinalityTag:           true,
+		BackfillBatchSize:        3,
+		RpcBatchSize:             2,
+		KeepFinalizedBlocksDepth: 1000,
+		BackupPollerBlockDelay:   100,
+	})
+	ctx := testutils.Context(t)
+	err := th.LogPoller.RegisterFilter(ctx, logpoller.Filter{
+		Name:      "Test Emitter",
+		EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID},
+		Addresses: []common.Address{th.EmitterAddress1},
+	})
+	require.NoError(t, err)
+
+	// Chain gen <- 1 <- 2 (L1_0) <- 3 (finalized, L1_1) <- 4
+	_, err = th.Emitter1.EmitLog1(th.Owner, []*big.Int{big.NewInt(0)})
+	require.NoError(t, err)
+	th.Client.Commit()
+	_, err = th.Emitter1.EmitLog1(th.Owner, []*big.Int{big.NewInt(1)})
+	require.NoError(t, err)
+	th.Client.Commit()
+	th.Client.Commit()
+	markBlockAsFinalized(t, th, 3)
+
+	firstPoll := th.PollAndSaveLogs(ctx, 1)
+	assert.Equal(t, int64(5), firstPoll)
+	require.NoError(t, th.LogPoller.Healthy())
+
+	// Fork deeper than finality
+	// Chain gen <- 1 <- 2 (L1_0) <- 3 (L1_1) <- 4
+	//                    \  3' (L1_2) <- 4' <- 5' <- 6' (finalized) <- 7' <- 8'
+	lca, err := th.Client.BlockByNumber(ctx, big.NewInt(2))
+	require.NoError(t, err)
+	require.NoError(t, th.Client.Fork(ctx, lca.Hash()))
+	_, err = th.Emitter1.EmitLog1(th.Owner, []*big.Int{big.NewInt(2)})
+	require.NoError(t, err)
+	for i := 3; i <= 8; i++ {
+		th.Client.Commit()
+	}
+	markBlockAsFinalized(t, th, 6)
+
+	secondPoll := th.PollAndSaveLogs(ctx, firstPoll)
+	assert.Equal(t, firstPoll, secondPoll)
+	require.Equal(t, logpoller.ErrFinalityViolated, th.LogPoller.Healthy())
+
+	// The recovery is run by the main poll loop
+	require.NoError(t, th.LogPoller.Start(ctx))
+	t.Cleanup(func() { assert.NoError(t, th.LogPoller.Close()) })
+	report, err := th.LogPoller.RecoverFinalityViolation(ctx)
+	require.NoError(t, err)
+	require.NoError(t, th.LogPoller.Healthy())
+	assert.Equal(t, lca.Hash(), report.LCA.BlockHash)
+	assert.Equal(t, int64(3), report.FromBlock)
+	assert.Equal(t, int64(4), report.ToBlock)
+	require.Len(t, report.RemovedLogs, 1)
+	require.Len(t, report.AddedLogs, 1)
+	assert.Equal(t, int64(3), report.RemovedLogs[0].BlockNumber)
+	assert.Equal(t, int64(3), report.AddedLogs[0].BlockNumber)
+	assert.NotEqual(t, report.RemovedLogs[0].BlockHash, report.AddedLogs[0].BlockHash)
+
+	// Main loop resumes polling on top of the canonical chain
+	recoveryPoll := th.PollAndSaveLogs(ctx, report.ToBlock+1)
+	assert.Equal(t, int64(9), recoveryPoll)
+	assert.NoError(t, th.LogPoller.Healthy())
+}
+
+func TestLogPoller_Checkpoint(t *testing.T) {
+	th := SetupTH(t, logpoller.Opts{
+		UseFinalityTag:           true,
+		PollPeriod:               10 * time.Millisecond,
+		BackfillBatchSize:        3,
+		RpcBatchSize:             2,
+		KeepFinalizedBlocksDepth: 1000,
+	})
+	ctx := testutils.Context(t)
+	for i := 0; i < 3; i++ {
+		th.Client.Commit()
+	}
+	require.NoError(t, th.LogPoller.Start(ctx))
+	t.Cleanup(func() { assert.NoError(t, th.LogPoller.Close()) })
+
+	latest, err := th.Client.BlockByNumber(ctx, nil)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		block, err := th.LogPoller.LatestBlock(ctx)
+		return err == nil && block.BlockNumber == latest.Number().Int64()
+	}, testutils.WaitTimeout(t), 10*time.Millisecond)
+
+	checkpoint, err := th.LogPoller.Checkpoint(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, latest.Number().Int64(), checkpoint.BlockNumber)
+
+	// No new blocks are polled once checkpointed
+	th.Client.Commit()
+	time.Sleep(100 * time.Millisecond)
+	block, err := th.LogPoller.LatestBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, checkpoint.BlockNumber, block.BlockNumber)
+}
+
 func TestLogPoller_PollAndSaveLogsDeepReorg(t *testing.T) {
 	t.Parallel()
 
@@ -31,6 +31,62 @@ func (_m *LogPoller) EXPECT() *LogPoller_Expecter {
 	return &LogPoller_Expecter{mock: &_m.Mock}
 }
 
+// Checkpoint provides a mock function with given fields: ctx
+func (_m *LogPoller) Checkpoint(ctx context.Context) (logpoller.LogPollerBlock, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Checkpoint")
+	}
+
+	var r0 logpoller.LogPollerBlock
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (logpoller.LogPollerBlock, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) logpoller.LogPollerBlock); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(logpoller.LogPollerBlock)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LogPoller_Checkpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Checkpoint'
+type LogPoller_Checkpoint_Call struct {
+	*mock.Call
+}
+
+// Checkpoint is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *LogPoller_Expecter) Checkpoint(ctx interface{}) *LogPoller_Checkpoint_Call {
+	return &LogPoller_Checkpoint_Call{Call: _e.mock.On("Checkpoint", ctx)}
+}
+
+func (_c *LogPoller_Checkpoint_Call) Run(run func(ctx context.Context)) *LogPoller_Checkpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *LogPoller_Checkpoint_Call) Return(_a0 logpoller.LogPollerBlock, _a1 error) *LogPoller_Checkpoint_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LogPoller_Checkpoint_Call) RunAndReturn(run func(context.Context) (logpoller.LogPollerBlock, error)) *LogPoller_Checkpoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function with given fields:
 func (_m *LogPoller) Close() error {
 	ret := _m.Called()
@@ -1484,6 +1540,72 @@ func (_c *LogPoller_LogsDataWordRange_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// LogsDataWordRangePage provides a mock function with given fields: ctx, eventSig, address, wordIndex, wordValueMin, wordValueMax, confs, after, limit
+func (_m *LogPoller) LogsDataWordRangePage(ctx context.Context, eventSig common.Hash, address common.Address, wordIndex int, wordValueMin common.Hash, wordValueMax common.Hash, confs types.Confirmations, after logpoller.LogCursor, limit int) ([]logpoller.Log, error) {
+	ret := _m.Called(ctx, eventSig, address, wordIndex, wordValueMin, wordValueMax, confs, after, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogsDataWordRangePage")
+	}
+
+	var r0 []logpoller.Log
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Hash, common.Address, int, common.Hash, common.Hash, types.Confirmations, logpoller.LogCursor, int) ([]logpoller.Log, error)); ok {
+		return rf(ctx, eventSig, address, wordIndex, wordValueMin, wordValueMax, confs, after, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Hash, common.Address, int, common.Hash, common.Hash, types.Confirmations, logpoller.LogCursor, int) []logpoller.Log); ok {
+		r0 = rf(ctx, eventSig, address, wordIndex, wordValueMin, wordValueMax, confs, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]logpoller.Log)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Hash, common.Address, int, common.Hash, common.Hash, types.Confirmations, logpoller.LogCursor, int) error); ok {
+		r1 = rf(ctx, eventSig, address, wordIndex, wordValueMin, wordValueMax, confs, after, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LogPoller_LogsDataWordRangePage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogsDataWordRangePage'
+type LogPoller_LogsDataWordRangePage_Call struct {
+	*mock.Call
+}
+
+// LogsDataWordRangePage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventSig common.Hash
+//   - address common.Address
+//   - wordIndex int
+//   - wordValueMin common.Hash
+//   - wordValueMax common.Hash
+//   - confs types.Confirmations
+//   - after logpoller.LogCursor
+//   - limit int
+func (_e *LogPoller_Expecter) LogsDataWordRangePage(ctx interface{}, eventSig interface{}, address interface{}, wordIndex interface{}, wordValueMin interface{}, wordValueMax interface{}, confs interface{}, after interface{}, limit interface{}) *LogPoller_LogsDataWordRangePage_Call {
+	return &LogPoller_LogsDataWordRangePage_Call{Call: _e.mock.On("LogsDataWordRangePage", ctx, eventSig, address, wordIndex, wordValueMin, wordValueMax, confs, after, limit)}
+}
+
+func (_c *LogPoller_LogsDataWordRangePage_Call) Run(run func(ctx context.Context, eventSig common.Hash, address common.Address, wordIndex int, wordValueMin common.Hash, wordValueMax common.Hash, confs types.Confirmations, after logpoller.LogCursor, limit int)) *LogPoller_LogsDataWordRangePage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(common.Hash), args[2].(common.Address), args[3].(int), args[4].(common.Hash), args[5].(common.Hash), args[6].(types.Confirmations), args[7].(logpoller.LogCursor), args[8].(int))
+	})
+	return _c
+}
+
+func (_c *LogPoller_LogsDataWordRangePage_Call) Return(_a0 []logpoller.Log, _a1 error) *LogPoller_LogsDataWordRangePage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LogPoller_LogsDataWordRangePage_Call) RunAndReturn(run func(context.Context, common.Hash, common.Address, int, common.Hash, common.Hash, types.Confirmations, logpoller.LogCursor, int) ([]logpoller.Log, error)) *LogPoller_LogsDataWordRangePage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // LogsWithSigs provides a mock function with given fields: ctx, start, end, eventSigs, address
 func (_m *LogPoller) LogsWithSigs(ctx context.Context, start int64, end int64, eventSigs []common.Hash, address common.Address) ([]logpoller.Log, error) {
 	ret := _m.Called(ctx, start, end, eventSigs, address)
@@ -1546,6 +1668,70 @@ func (_c *LogPoller_LogsWithSigs_Call) RunAndReturn(run func(context.Context, in
 	return _c
 }
 
+// LogsWithSigsPage provides a mock function with given fields: ctx, start, end, eventSigs, address, after, limit
+func (_m *LogPoller) LogsWithSigsPage(ctx context.Context, start int64, end int64, eventSigs []common.Hash, address common.Address, after logpoller.LogCursor, limit int) ([]logpoller.Log, error) {
+	ret := _m.Called(ctx, start, end, eventSigs, address, after, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogsWithSigsPage")
+	}
+
+	var r0 []logpoller.Log
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, []common.Hash, common.Address, logpoller.LogCursor, int) ([]logpoller.Log, error)); ok {
+		return rf(ctx, start, end, eventSigs, address, after, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, []common.Hash, common.Address, logpoller.LogCursor, int) []logpoller.Log); ok {
+		r0 = rf(ctx, start, end, eventSigs, address, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]logpoller.Log)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, []common.Hash, common.Address, logpoller.LogCursor, int) error); ok {
+		r1 = rf(ctx, start, end, eventSigs, address, after, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LogPoller_LogsWithSigsPage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogsWithSigsPage'
+type LogPoller_LogsWithSigsPage_Call struct {
+	*mock.Call
+}
+
+// LogsWithSigsPage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - start int64
+//   - end int64
+//   - eventSigs []common.Hash
+//   - address common.Address
+//   - after logpoller.LogCursor
+//   - limit int
+func (_e *LogPoller_Expecter) LogsWithSigsPage(ctx interface{}, start interface{}, end interface{}, eventSigs interface{}, address interface{}, after interface{}, limit interface{}) *LogPoller_LogsWithSigsPage_Call {
+	return &LogPoller_LogsWithSigsPage_Call{Call: _e.mock.On("LogsWithSigsPage", ctx, start, end, eventSigs, address, after, limit)}
+}
+
+func (_c *LogPoller_LogsWithSigsPage_Call) Run(run func(ctx context.Context, start int64, end int64, eventSigs []common.Hash, address common.Address, after logpoller.LogCursor, limit int)) *LogPoller_LogsWithSigsPage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].([]common.Hash), args[4].(common.Address), args[5].(logpoller.LogCursor), args[6].(int))
+	})
+	return _c
+}
+
+func (_c *LogPoller_LogsWithSigsPage_Call) Return(_a0 []logpoller.Log, _a1 error) *LogPoller_LogsWithSigsPage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LogPoller_LogsWithSigsPage_Call) RunAndReturn(run func(context.Context, int64, int64, []common.Hash, common.Address, logpoller.LogCursor, int) ([]logpoller.Log, error)) *LogPoller_LogsWithSigsPage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Name provides a mock function with given fields:
 func (_m *LogPoller) Name() string {
 	ret := _m.Called()
@@ -1636,6 +1822,64 @@ func (_c *LogPoller_Ready_Call) RunAndReturn(run func() error) *LogPoller_Ready_
 	return _c
 }
 
+// RecoverFinalityViolation provides a mock function with given fields: ctx
+func (_m *LogPoller) RecoverFinalityViolation(ctx context.Context) (*logpoller.FinalityRecoveryReport, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecoverFinalityViolation")
+	}
+
+	var r0 *logpoller.FinalityRecoveryReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*logpoller.FinalityRecoveryReport, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *logpoller.FinalityRecoveryReport); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*logpoller.FinalityRecoveryReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LogPoller_RecoverFinalityViolation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecoverFinalityViolation'
+type LogPoller_RecoverFinalityViolation_Call struct {
+	*mock.Call
+}
+
+// RecoverFinalityViolation is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *LogPoller_Expecter) RecoverFinalityViolation(ctx interface{}) *LogPoller_RecoverFinalityViolation_Call {
+	return &LogPoller_RecoverFinalityViolation_Call{Call: _e.mock.On("RecoverFinalityViolation", ctx)}
+}
+
+func (_c *LogPoller_RecoverFinalityViolation_Call) Run(run func(ctx context.Context)) *LogPoller_RecoverFinalityViolation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *LogPoller_RecoverFinalityViolation_Call) Return(_a0 *logpoller.FinalityRecoveryReport, _a1 error) *LogPoller_RecoverFinalityViolation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LogPoller_RecoverFinalityViolation_Call) RunAndReturn(run func(context.Context) (*logpoller.FinalityRecoveryReport, error)) *LogPoller_RecoverFinalityViolation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RegisterFilter provides a mock function with given fields: ctx, filter
 func (_m *LogPoller) RegisterFilter(ctx context.Context, filter logpoller.Filter) error {
 	ret := _m.Called(ctx, filter)
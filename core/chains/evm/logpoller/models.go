@@ -45,6 +45,11 @@ func (l *Log) GetTopics() []common.Hash {
 	return tps
 }
 
+// Cursor returns the position of the log, to read the page of logs which follow it.
+func (l *Log) Cursor() LogCursor {
+	return LogCursor{BlockNumber: l.BlockNumber, LogIndex: l.LogIndex}
+}
+
 func (l *Log) ToGethLog() types.Log {
 	return types.Log{
 		Data:        l.Data,
@@ -57,6 +62,16 @@ func (l *Log) ToGethLog() types.Log {
 	}
 }
 
+// LogCursor is the position of a log in the chain. Paginated queries return the logs which follow a cursor, ordered by
+// position, so that large ranges of logs can be read page by page with index scans rather than OFFSET scans.
+type LogCursor struct {
+	BlockNumber int64
+	LogIndex    int64
+}
+
+// FirstLogCursor precedes the position of every log, to read the first page of logs.
+var FirstLogCursor = LogCursor{BlockNumber: -1, LogIndex: -1}
+
 func NewLogPollerBlock(blockHash common.Hash, blockNumber int64, timestamp time.Time, finalizedBlockNumber int64) LogPollerBlock {
 	return LogPollerBlock{
 		BlockHash:            blockHash,
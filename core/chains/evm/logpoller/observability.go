@@ -10,6 +10,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promauto"
 
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
@@ -90,6 +91,11 @@ func NewObservedORM(chainID *big.Int, ds sqlutil.DataSource, lggr logger.Logger)
 	}
 }
 
+// SetBulkInserter makes the ORM insert logs with COPY through bulk.
+func (o *ObservedORM) SetBulkInserter(bulk *pg.BulkInserter) {
+	o.ORM.(*DSORM).SetBulkInserter(bulk)
+}
+
 func (o *ObservedORM) InsertLogs(ctx context.Context, logs []Log) error {
 	err := withObservedExec(o, "InsertLogs", create, func() error {
 		return o.ORM.InsertLogs(ctx, logs)
@@ -184,6 +190,12 @@ func (o *ObservedORM) SelectLogsWithSigs(ctx context.Context, start, end int64,
 	})
 }
 
+func (o *ObservedORM) SelectLogsWithSigsPage(ctx context.Context, start, end int64, address common.Address, eventSigs []common.Hash, after LogCursor, limit int) ([]Log, error) {
+	return withObservedQueryAndResults(o, "SelectLogsWithSigsPage", func() ([]Log, error) {
+		return o.ORM.SelectLogsWithSigsPage(ctx, start, end, address, eventSigs, after, limit)
+	})
+}
+
 func (o *ObservedORM) SelectLogsCreatedAfter(ctx context.Context, address common.Address, eventSig common.Hash, after time.Time, confs evmtypes.Confirmations) ([]Log, error) {
 	return withObservedQueryAndResults(o, "SelectLogsCreatedAfter", func() ([]Log, error) {
 		return o.ORM.SelectLogsCreatedAfter(ctx, address, eventSig, after, confs)
@@ -250,6 +262,12 @@ func (o *ObservedORM) SelectLogsDataWordRange(ctx context.Context, address commo
 	})
 }
 
+func (o *ObservedORM) SelectLogsDataWordRangePage(ctx context.Context, address common.Address, eventSig common.Hash, wordIndex int, wordValueMin, wordValueMax common.Hash, confs evmtypes.Confirmations, after LogCursor, limit int) ([]Log, error) {
+	return withObservedQueryAndResults(o, "SelectLogsDataWordRangePage", func() ([]Log, error) {
+		return o.ORM.SelectLogsDataWordRangePage(ctx, address, eventSig, wordIndex, wordValueMin, wordValueMax, confs, after, limit)
+	})
+}
+
 func (o *ObservedORM) SelectLogsDataWordGreaterThan(ctx context.Context, address common.Address, eventSig common.Hash, wordIndex int, wordValueMin common.Hash, confs evmtypes.Confirmations) ([]Log, error) {
 	return withObservedQueryAndResults(o, "SelectLogsDataWordGreaterThan", func() ([]Log, error) {
 		return o.ORM.SelectLogsDataWordGreaterThan(ctx, address, eventSig, wordIndex, wordValueMin, confs)
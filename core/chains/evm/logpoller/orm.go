@@ -21,6 +21,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 )
 
 // ORM represents the persistent data access layer used by the log poller. At this moment, it's a bit leaky abstraction, because
@@ -49,6 +50,7 @@ type ORM interface {
 
 	SelectLogs(ctx context.Context, start, end int64, address common.Address, eventSig common.Hash) ([]Log, error)
 	SelectLogsWithSigs(ctx context.Context, start, end int64, address common.Address, eventSigs []common.Hash) ([]Log, error)
+	SelectLogsWithSigsPage(ctx context.Context, start, end int64, address common.Address, eventSigs []common.Hash, after LogCursor, limit int) ([]Log, error)
 	SelectLogsCreatedAfter(ctx context.Context, address common.Address, eventSig common.Hash, after time.Time, confs evmtypes.Confirmations) ([]Log, error)
 	SelectLatestLogByEventSigWithConfs(ctx context.Context, eventSig common.Hash, address common.Address, confs evmtypes.Confirmations) (*Log, error)
 	SelectLatestLogEventSigsAddrsWithConfs(ctx context.Context, fromBlock int64, addresses []common.Address, eventSigs []common.Hash, confs evmtypes.Confirmations) ([]Log, error)
@@ -63,6 +65,7 @@ type ORM interface {
 	SelectIndexedLogsWithSigsExcluding(ctx context.Context, sigA, sigB common.Hash, topicIndex int, address common.Address, startBlock, endBlock int64, confs evmtypes.Confirmations) ([]Log, error)
 	SelectIndexedLogsByTxHash(ctx context.Context, address common.Address, eventSig common.Hash, txHash common.Hash) ([]Log, error)
 	SelectLogsDataWordRange(ctx context.Context, address common.Address, eventSig common.Hash, wordIndex int, wordValueMin, wordValueMax common.Hash, confs evmtypes.Confirmations) ([]Log, error)
+	SelectLogsDataWordRangePage(ctx context.Context, address common.Address, eventSig common.Hash, wordIndex int, wordValueMin, wordValueMax common.Hash, confs evmtypes.Confirmations, after LogCursor, limit int) ([]Log, error)
 	SelectLogsDataWordGreaterThan(ctx context.Context, address common.Address, eventSig common.Hash, wordIndex int, wordValueMin common.Hash, confs evmtypes.Confirmations) ([]Log, error)
 	SelectLogsDataWordBetween(ctx context.Context, address common.Address, eventSig common.Hash, wordIndexMin int, wordIndexMax int, wordValue common.Hash, confs evmtypes.Confirmations) ([]Log, error)
 
@@ -74,6 +77,7 @@ type DSORM struct {
 	chainID *big.Int
 	ds      sqlutil.DataSource
 	lggr    logger.Logger
+	bulk    *pg.BulkInserter
 }
 
 var _ ORM = &DSORM{}
@@ -94,6 +98,10 @@ func (o *DSORM) Transact(ctx context.Context, fn func(*DSORM) error) (err error)
 // new returns a NewORM like o, but backed by ds.
 func (o *DSORM) new(ds sqlutil.DataSource) *DSORM { return NewORM(o.chainID, ds, o.lggr) }
 
+// SetBulkInserter makes o insert logs with COPY through bulk. The ORMs of transactions don't, since COPY runs in
+// transactions of its own.
+func (o *DSORM) SetBulkInserter(bulk *pg.BulkInserter) { o.bulk = bulk }
+
 // InsertBlock is idempotent to support replays.
 func (o *DSORM) InsertBlock(ctx context.Context, blockHash common.Hash, blockNumber int64, blockTimestamp time.Time, finalizedBlock int64) error {
 	args, err := newQueryArgs(o.chainID).
@@ -122,6 +130,7 @@ func (o *DSORM) InsertFilter(ctx context.Context, filter Filter) (err error) {
 	args, err := newQueryArgs(o.chainID).
 		withField("name", filter.Name).
 		withRetention(filter.Retention).
+		withRetentionBlocks(filter.RetentionBlocks).
 		withMaxLogsKept(filter.MaxLogsKept).
 		withLogsPerBlock(filter.LogsPerBlock).
 		withAddressArray(filter.Addresses).
@@ -143,14 +152,14 @@ func (o *DSORM) InsertFilter(ctx context.Context, filter Filter) (err error) {
 	// https://github.com/jmoiron/sqlx/issues/91, https://github.com/jmoiron/sqlx/issues/428
 	query := fmt.Sprintf(`
 		INSERT INTO evm.log_poller_filters
-	  		(name, evm_chain_id, retention, max_logs_kept, logs_per_block, created_at, address, event %s)
+	  		(name, evm_chain_id, retention, retention_blocks, max_logs_kept, logs_per_block, created_at, address, event %s)
 		SELECT * FROM
-			(SELECT :name, :evm_chain_id ::::NUMERIC, :retention ::::BIGINT, :max_logs_kept ::::NUMERIC, :logs_per_block ::::NUMERIC, NOW()) x,
+			(SELECT :name, :evm_chain_id ::::NUMERIC, :retention ::::BIGINT, :retention_blocks ::::BIGINT, :max_logs_kept ::::NUMERIC, :logs_per_block ::::NUMERIC, NOW()) x,
 			(SELECT unnest(:address_array ::::BYTEA[]) addr) a,
 			(SELECT unnest(:event_sig_array ::::BYTEA[]) ev) e
 			%s
 		ON CONFLICT  (evm.f_log_poller_filter_hash(name, evm_chain_id, address, event, topic2, topic3, topic4))
-		DO UPDATE SET retention=:retention ::::BIGINT, max_logs_kept=:max_logs_kept ::::NUMERIC, logs_per_block=:logs_per_block ::::NUMERIC`,
+		DO UPDATE SET retention=:retention ::::BIGINT, retention_blocks=:retention_blocks ::::BIGINT, max_logs_kept=:max_logs_kept ::::NUMERIC, logs_per_block=:logs_per_block ::::NUMERIC`,
 		topicsColumns.String(),
 		topicsSql.String())
 
@@ -176,6 +185,7 @@ func (o *DSORM) LoadFilters(ctx context.Context) (map[string]Filter, error) {
 			ARRAY_AGG(DISTINCT topic4 ORDER BY topic4) FILTER(WHERE topic4 IS NOT NULL) AS topic4,
 			MAX(logs_per_block) AS logs_per_block,
 			MAX(retention) AS retention,
+			MAX(retention_blocks) AS retention_blocks,
 			MAX(max_logs_kept) AS max_logs_kept
 		FROM evm.log_poller_filters WHERE evm_chain_id = $1
 		GROUP BY name`
@@ -225,6 +235,9 @@ func withConfs(query string, tableAlias string, confs evmtypes.Confirmations) st
 		ORDER BY block_number DESC LIMIT 1)`, query, tablePrefix, lastConfirmedBlock)
 }
 
+// cursorClause selects the logs which follow the cursor of queryArgs.withCursor.
+const cursorClause = `(block_number > :cursor_block_number OR (block_number = :cursor_block_number AND log_index > :cursor_log_index))`
+
 func logsQueryWithConfs(clause string, confs evmtypes.Confirmations) string {
 	return withConfs(logsQuery(clause), "", confs)
 }
@@ -405,10 +418,32 @@ func (o *DSORM) SelectUnmatchedLogIDs(ctx context.Context, limit int64) (ids []u
 	return ids, err
 }
 
-// DeleteExpiredLogs removes any logs which either:
-//   - don't match any currently registered filters, or
-//   - have a timestamp older than any matching filter's retention, UNLESS there is at
-//     least one matching filter with retention=0
+// DeleteExpiredLogs removes any logs which have outlived the retention policy of every matching filter.
+// A filter retains a log while the log is within both its time based retention and its block based retention,
+// where a value of 0 means unbounded. A filter with both set to 0 therefore retains matching logs forever.
+// Logs which don't match any currently registered filters are handled by SelectUnmatchedLogIDs instead.
+//
+// Rather than checking every log of the filtered events against its filters, each filter is joined with the logs it
+// has outlived, and a log is expired once it was joined with all the filters of its event. The joins on the block
+// based retention are range scans of idx_logs_chain_address_event_block_logindex, the ones on the time based retention
+// scan the same index for the address and event of the filter. The expected plan, for the filters of a chain:
+//
+//	Delete on logs
+//	  ->  Nested Loop
+//	        ->  HashAggregate (group by rows_to_delete.id)
+//	              ->  Limit
+//	                    ->  HashAggregate (group by l.id, filters.matching; filter: count(*) = filters.matching)
+//	                          ->  HashAggregate (union)
+//	                                ->  Nested Loop
+//	                                      ->  CTE Scan on filters (filter: retention > 0)
+//	                                      ->  Index Scan using idx_logs_chain_address_event_block_logindex on logs l
+//	                                            Index Cond: evm_chain_id, address and event_sig
+//	                                            Filter: block_timestamp <= statement_timestamp() - retention
+//	                                ->  Nested Loop
+//	                                      ->  CTE Scan on filters (filter: retention_blocks > 0)
+//	                                      ->  Index Scan using idx_logs_chain_address_event_block_logindex on logs l
+//	                                            Index Cond: evm_chain_id, address, event_sig and block_number <= latest - retention_blocks
+//	        ->  Index Scan using logs_pkey on logs (Index Cond: id = rows_to_delete.id)
 func (o *DSORM) DeleteExpiredLogs(ctx context.Context, limit int64) (int64, error) {
 	limitClause := ""
 	if limit > 0 {
@@ -416,16 +451,28 @@ func (o *DSORM) DeleteExpiredLogs(ctx context.Context, limit int64) (int64, erro
 	}
 
 	query := fmt.Sprintf(`
-		WITH rows_to_delete AS (
-			SELECT l.id
-			FROM evm.logs l JOIN (
-				SELECT evm_chain_id, address, event, MAX(retention) AS retention
-				FROM evm.log_poller_filters
-				WHERE evm_chain_id = $1
-				GROUP BY evm_chain_id, address, event
-				HAVING MIN(retention) > 0
-			) r ON l.evm_chain_id = r.evm_chain_id AND l.address = r.address AND l.event_sig = r.event AND
-				l.block_timestamp <= STATEMENT_TIMESTAMP() - (r.retention / 10^9 * interval '1 second') %s
+		WITH latest AS (
+			SELECT COALESCE(MAX(block_number), 0) AS block_number
+			FROM evm.log_poller_blocks
+			WHERE evm_chain_id = $1
+		), filters AS MATERIALIZED (
+			SELECT id, address, event, retention, retention_blocks, COUNT(*) OVER (PARTITION BY address, event) AS matching
+			FROM evm.log_poller_filters
+			WHERE evm_chain_id = $1
+		), expired AS (
+			SELECT f.id AS filter_id, f.matching, l.id
+			FROM filters f JOIN evm.logs l ON l.evm_chain_id = $1 AND l.address = f.address AND l.event_sig = f.event
+			WHERE f.retention > 0 AND l.block_timestamp <= STATEMENT_TIMESTAMP() - (f.retention / 10^9 * interval '1 second')
+			UNION
+			SELECT f.id AS filter_id, f.matching, l.id
+			FROM filters f CROSS JOIN latest JOIN evm.logs l ON l.evm_chain_id = $1 AND l.address = f.address AND l.event_sig = f.event
+			WHERE f.retention_blocks > 0 AND l.block_number <= latest.block_number - f.retention_blocks
+		), rows_to_delete AS (
+			SELECT id
+			FROM expired
+			GROUP BY id, matching
+			HAVING COUNT(*) = matching
+			%s
 		) DELETE FROM evm.logs WHERE id IN (SELECT id FROM rows_to_delete)`, limitClause)
 	result, err := o.ds.ExecContext(ctx, query, ubig.New(o.chainID))
 	if err != nil {
@@ -439,9 +486,7 @@ func (o *DSORM) InsertLogs(ctx context.Context, logs []Log) error {
 	if err := o.validateLogs(logs); err != nil {
 		return err
 	}
-	return o.Transact(ctx, func(orm *DSORM) error {
-		return orm.insertLogsWithinTx(ctx, logs, orm.ds)
-	})
+	return o.insertLogs(ctx, logs, nil)
 }
 
 func (o *DSORM) InsertLogsWithBlock(ctx context.Context, logs []Log, block LogPollerBlock) error {
@@ -455,15 +500,52 @@ func (o *DSORM) InsertLogsWithBlock(ctx context.Context, logs []Log, block LogPo
 	}
 
 	// Block and logs goes with the same TX to ensure atomicity
-	return o.Transact(ctx, func(orm *DSORM) error {
-		err := orm.InsertBlock(ctx, block.BlockHash, block.BlockNumber, block.BlockTimestamp, block.FinalizedBlockNumber)
-		if err != nil {
+	return o.insertLogs(ctx, logs, &block)
+}
+
+// insertLogs inserts logs, and block if not nil, in a transaction. Logs are copied if o has a BulkInserter whose
+// driver supports COPY and there are enough of them, and inserted with INSERT statements otherwise.
+func (o *DSORM) insertLogs(ctx context.Context, logs []Log, block *LogPollerBlock) error {
+	if o.bulk.Copies(len(logs)) {
+		err := o.bulk.Transact(ctx, func(tx *pg.BulkTx) error {
+			orm := o.new(tx)
+			if block != nil {
+				if err := orm.InsertBlock(ctx, block.BlockHash, block.BlockNumber, block.BlockTimestamp, block.FinalizedBlockNumber); err != nil {
+					return err
+				}
+			}
+			return copyLogs(ctx, tx, logs)
+		})
+		if !errors.Is(err, pg.ErrCopyUnsupported) {
 			return err
 		}
+	}
+
+	return o.Transact(ctx, func(orm *DSORM) error {
+		if block != nil {
+			if err := orm.InsertBlock(ctx, block.BlockHash, block.BlockNumber, block.BlockTimestamp, block.FinalizedBlockNumber); err != nil {
+				return err
+			}
+		}
 		return orm.insertLogsWithinTx(ctx, logs, orm.ds)
 	})
 }
 
+var logColumns = []string{"evm_chain_id", "log_index", "block_hash", "block_number", "block_timestamp", "address", "event_sig", "topics", "tx_hash", "data"}
+
+func copyLogs(ctx context.Context, tx *pg.BulkTx, logs []Log) error {
+	rows := make([][]any, len(logs))
+	for i, l := range logs {
+		rows[i] = []any{l.EvmChainId.ToInt(), l.LogIndex, l.BlockHash.Bytes(), l.BlockNumber, l.BlockTimestamp, l.Address.Bytes(),
+			l.EventSig.Bytes(), [][]byte(l.Topics), l.TxHash.Bytes(), l.Data}
+	}
+	return tx.CopyFrom(ctx, "evm.logs", logColumns, rows, `INSERT INTO evm.logs
+			(evm_chain_id, log_index, block_hash, block_number, block_timestamp, address, event_sig, topics, tx_hash, data, created_at)
+		SELECT evm_chain_id, log_index, block_hash, block_number, block_timestamp, address, event_sig, topics, tx_hash, data, NOW()
+		FROM %s
+		ON CONFLICT DO NOTHING`)
+}
+
 func (o *DSORM) insertLogsWithinTx(ctx context.Context, logs []Log, tx sqlutil.DataSource) error {
 	batchInsertSize := 4000
 	for i := 0; i < len(logs); i += batchInsertSize {
@@ -621,6 +703,41 @@ func (o *DSORM) SelectLogsWithSigs(ctx context.Context, start, end int64, addres
 	return logs, err
 }
 
+// SelectLogsWithSigsPage is like SelectLogsWithSigs, but returns at most limit logs which follow the cursor.
+func (o *DSORM) SelectLogsWithSigsPage(ctx context.Context, start, end int64, address common.Address, eventSigs []common.Hash, after LogCursor, limit int) ([]Log, error) {
+	args, err := newQueryArgs(o.chainID).
+		withAddress(address).
+		withEventSigArray(eventSigs).
+		withStartBlock(start).
+		withEndBlock(end).
+		withCursor(after).
+		withLimit(limit).
+		toArgs()
+	if err != nil {
+		return nil, err
+	}
+
+	query := logsQuery(`
+		WHERE evm_chain_id = :evm_chain_id
+		AND address = :address
+		AND event_sig = ANY(:event_sig_array)
+		AND block_number BETWEEN :start_block AND :end_block
+		AND ` + cursorClause + `
+		ORDER BY block_number, log_index
+		LIMIT :limit`)
+
+	query, sqlArgs, err := o.ds.BindNamed(query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	var logs []Log
+	if err = o.ds.SelectContext(ctx, &logs, query, sqlArgs...); err != nil {
+		return nil, err
+	}
+	return logs, nil
+}
+
 func (o *DSORM) GetBlocksRange(ctx context.Context, start int64, end int64) ([]LogPollerBlock, error) {
 	args, err := newQueryArgs(o.chainID).
 		withStartBlock(start).
@@ -745,6 +862,40 @@ func (o *DSORM) SelectLogsDataWordRange(ctx context.Context, address common.Addr
 	return logs, nil
 }
 
+// SelectLogsDataWordRangePage is like SelectLogsDataWordRange, but returns at most limit logs which follow the cursor.
+func (o *DSORM) SelectLogsDataWordRangePage(ctx context.Context, address common.Address, eventSig common.Hash, wordIndex int, wordValueMin, wordValueMax common.Hash, confs evmtypes.Confirmations, after LogCursor, limit int) ([]Log, error) {
+	args, err := newQueryArgsForEvent(o.chainID, address, eventSig).
+		withWordIndex(wordIndex).
+		withWordValueMin(wordValueMin).
+		withWordValueMax(wordValueMax).
+		withConfs(confs).
+		withCursor(after).
+		withLimit(limit).
+		toArgs()
+	if err != nil {
+		return nil, err
+	}
+
+	query := logsQueryWithConfs(`WHERE evm_chain_id = :evm_chain_id
+		AND address = :address
+		AND event_sig = :event_sig
+		AND substring(data from 32*:word_index+1 for 32) >= :word_value_min
+		AND substring(data from 32*:word_index+1 for 32) <= :word_value_max
+		AND `+cursorClause+` AND `, confs) +
+		`ORDER BY block_number, log_index LIMIT :limit`
+
+	var logs []Log
+	query, sqlArgs, err := o.ds.BindNamed(query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := o.ds.SelectContext(ctx, &logs, query, sqlArgs...); err != nil {
+		return nil, err
+	}
+	return logs, nil
+}
+
 func (o *DSORM) SelectLogsDataWordGreaterThan(ctx context.Context, address common.Address, eventSig common.Hash, wordIndex int, wordValueMin common.Hash, confs evmtypes.Confirmations) ([]Log, error) {
 	args, err := newQueryArgsForEvent(o.chainID, address, eventSig).
 		withWordIndex(wordIndex).
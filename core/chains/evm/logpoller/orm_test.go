@@ -29,6 +29,8 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
 	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/testutils/heavyweight"
 )
 
@@ -815,6 +817,28 @@ func TestORM_IndexedLogs(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, 3, len(lgs))
 
+	lgs, err = o1.FilteredLogs(ctx, []query.Expression{
+		logpoller.NewAddressFilter(addr),
+		logpoller.NewEventSigFilter(eventSig),
+		logpoller.NewEventByTopicRangeFilter(1, logpoller.EvmWord(2), logpoller.EvmWord(4)),
+		query.Confidence(primitives.Unconfirmed),
+	}, limiter, "")
+	require.NoError(t, err)
+	require.Equal(t, 3, len(lgs))
+	assert.Equal(t, logpoller.EvmWord(2).Bytes(), lgs[0].GetTopics()[1].Bytes())
+	assert.Equal(t, logpoller.EvmWord(4).Bytes(), lgs[2].GetTopics()[1].Bytes())
+
+	lgs, err = o1.FilteredLogs(ctx, []query.Expression{
+		logpoller.NewAddressFilter(addr),
+		logpoller.NewEventSigFilter(eventSig),
+		logpoller.NewEventByTopicValuesFilter(1, []common.Hash{logpoller.EvmWord(1), logpoller.EvmWord(3), logpoller.EvmWord(5)}),
+		query.Confidence(primitives.Unconfirmed),
+	}, limiter, "")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(lgs))
+	assert.Equal(t, logpoller.EvmWord(1).Bytes(), lgs[0].GetTopics()[1].Bytes())
+	assert.Equal(t, logpoller.EvmWord(3).Bytes(), lgs[1].GetTopics()[1].Bytes())
+
 	// Check confirmations work as expected.
 	require.NoError(t, o1.InsertBlock(ctx, common.HexToHash("0x2"), 2, time.Now(), 0))
 	lgs, err = o1.SelectIndexedLogsTopicRange(ctx, addr, eventSig, 1, logpoller.EvmWord(4), logpoller.EvmWord(4), 1)
@@ -1193,6 +1217,64 @@ func TestORM_DeleteBlocksBefore(t *testing.T) {
 	require.Equal(t, err, sql.ErrNoRows)
 }
 
+func TestORM_DeleteExpiredLogs_RetentionBlocks(t *testing.T) {
+	th := SetupTH(t, lpOpts)
+	o1 := th.ORM
+	ctx := testutils.Context(t)
+
+	addr := common.HexToAddress("0x1234")
+	highVolumeSig := common.HexToHash("0x1599").Bytes()
+	rootSig := common.HexToHash("0x1600").Bytes()
+
+	for i := int64(1); i <= 10; i++ {
+		blockHash := common.BigToHash(big.NewInt(i)).Hex()
+		require.NoError(t, o1.InsertBlock(ctx, common.HexToHash(blockHash), i, time.Now(), 0))
+		require.NoError(t, o1.InsertLogs(ctx, []logpoller.Log{
+			GenLog(th.ChainID, 1, i, blockHash, highVolumeSig, addr),
+			GenLog(th.ChainID, 2, i, blockHash, rootSig, addr),
+		}))
+	}
+
+	// high volume events are only needed for the last 3 blocks
+	require.NoError(t, o1.InsertFilter(ctx, logpoller.Filter{
+		Name:            "short block retention filter",
+		Addresses:       []common.Address{addr},
+		EventSigs:       types.HashArray{common.BytesToHash(highVolumeSig)},
+		RetentionBlocks: 3,
+	}))
+	// roots are kept for a long time, even though they are also referenced by a filter with a short block retention
+	require.NoError(t, o1.InsertFilter(ctx, logpoller.Filter{
+		Name:            "long time retention filter",
+		Addresses:       []common.Address{addr},
+		EventSigs:       types.HashArray{common.BytesToHash(rootSig)},
+		Retention:       time.Hour,
+		RetentionBlocks: 0,
+	}))
+	require.NoError(t, o1.InsertFilter(ctx, logpoller.Filter{
+		Name:            "short block retention filter for roots",
+		Addresses:       []common.Address{addr},
+		EventSigs:       types.HashArray{common.BytesToHash(rootSig)},
+		RetentionBlocks: 1,
+	}))
+
+	filters, err := o1.LoadFilters(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), filters["short block retention filter"].RetentionBlocks)
+
+	deleted, err := o1.DeleteExpiredLogs(ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), deleted)
+
+	logs, err := o1.SelectLogs(ctx, 0, 10, addr, common.BytesToHash(highVolumeSig))
+	require.NoError(t, err)
+	require.Len(t, logs, 3)
+	assert.Equal(t, int64(8), logs[0].BlockNumber)
+
+	logs, err = o1.SelectLogs(ctx, 0, 10, addr, common.BytesToHash(rootSig))
+	require.NoError(t, err)
+	assert.Len(t, logs, 10)
+}
+
 func TestLogPoller_Logs(t *testing.T) {
 	t.Parallel()
 	ctx := testutils.Context(t)
@@ -2194,3 +2276,130 @@ func TestSelectOldestBlock(t *testing.T) {
 		require.Equal(t, block.BlockHash, common.HexToHash("0x1233"))
 	})
 }
+
+func TestORM_SelectLogsPages(t *testing.T) {
+	th := SetupTH(t, lpOpts)
+	o := th.ORM
+	ctx := testutils.Context(t)
+	eventSig := common.HexToHash("0x1599")
+	otherSig := common.HexToHash("0x1600")
+	addr := common.HexToAddress("0x1234")
+
+	require.NoError(t, o.InsertBlock(ctx, utils.RandomBytes32(), 10, time.Now(), 0))
+	require.NoError(t, o.InsertLogs(ctx, []logpoller.Log{
+		GenLogWithData(th.ChainID, addr, eventSig, 0, 1, logpoller.EvmWord(1).Bytes()),
+		GenLogWithData(th.ChainID, addr, eventSig, 1, 1, logpoller.EvmWord(2).Bytes()),
+		GenLogWithData(th.ChainID, addr, otherSig, 2, 1, logpoller.EvmWord(3).Bytes()),
+		GenLogWithData(th.ChainID, addr, eventSig, 0, 2, logpoller.EvmWord(4).Bytes()),
+		GenLogWithData(th.ChainID, addr, eventSig, 5, 3, logpoller.EvmWord(5).Bytes()),
+		GenLogWithData(th.ChainID, addr, eventSig, 0, 9, logpoller.EvmWord(6).Bytes()),
+	}))
+
+	cursors := func(logs []logpoller.Log) (cs []logpoller.LogCursor) {
+		for _, l := range logs {
+			cs = append(cs, l.Cursor())
+		}
+		return cs
+	}
+
+	t.Run("logs with sigs", func(t *testing.T) {
+		logs, err := o.SelectLogsWithSigsPage(ctx, 1, 3, addr, []common.Hash{eventSig, otherSig}, logpoller.FirstLogCursor, 2)
+		require.NoError(t, err)
+		assert.Equal(t, []logpoller.LogCursor{{BlockNumber: 1, LogIndex: 0}, {BlockNumber: 1, LogIndex: 1}}, cursors(logs))
+
+		logs, err = o.SelectLogsWithSigsPage(ctx, 1, 3, addr, []common.Hash{eventSig, otherSig}, logs[1].Cursor(), 2)
+		require.NoError(t, err)
+		assert.Equal(t, []logpoller.LogCursor{{BlockNumber: 1, LogIndex: 2}, {BlockNumber: 2, LogIndex: 0}}, cursors(logs))
+
+		logs, err = o.SelectLogsWithSigsPage(ctx, 1, 3, addr, []common.Hash{eventSig, otherSig}, logs[1].Cursor(), 2)
+		require.NoError(t, err)
+		assert.Equal(t, []logpoller.LogCursor{{BlockNumber: 3, LogIndex: 5}}, cursors(logs))
+	})
+
+	t.Run("data word range with confirmations", func(t *testing.T) {
+		var pages [][]logpoller.LogCursor
+		err := logpoller.PageLogs(ctx, 2, func(ctx context.Context, after logpoller.LogCursor, limit int) ([]logpoller.Log, error) {
+			return o.SelectLogsDataWordRangePage(ctx, addr, eventSig, 0, logpoller.EvmWord(2), logpoller.EvmWord(6), 2, after, limit)
+		}, func(logs []logpoller.Log) error {
+			pages = append(pages, cursors(logs))
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, [][]logpoller.LogCursor{
+			{{BlockNumber: 1, LogIndex: 1}, {BlockNumber: 2, LogIndex: 0}},
+			{{BlockNumber: 3, LogIndex: 5}},
+		}, pages)
+	})
+
+	t.Run("full last page", func(t *testing.T) {
+		var pages int
+		err := logpoller.PageLogs(ctx, 2, func(ctx context.Context, after logpoller.LogCursor, limit int) ([]logpoller.Log, error) {
+			return o.SelectLogsWithSigsPage(ctx, 1, 3, addr, []common.Hash{eventSig}, after, limit)
+		}, func(logs []logpoller.Log) error {
+			pages++
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, pages)
+
+		err = logpoller.PageLogs(ctx, 0, nil, nil)
+		require.ErrorContains(t, err, "invalid page size 0")
+	})
+}
+
+func TestInsertLogsWithBulkInserter(t *testing.T) {
+	chainID := testutils.NewRandomEVMChainID()
+	event := utils.RandomBytes32()
+	address := utils.RandomAddress()
+	ctx := testutils.Context(t)
+
+	// We need full db here, because COPY is not supported by the driver of pgtest.NewSqlxDB(t).
+	cfg, db := heavyweight.FullTestDBV2(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		enabled, flushSize, minRows := true, uint32(1000), uint32(1)
+		c.Database.BulkInsert.Enabled = &enabled
+		c.Database.BulkInsert.FlushSize = &flushSize
+		c.Database.BulkInsert.MinRows = &minRows
+	})
+	o := logpoller.NewORM(chainID, db, logger.Test(t))
+	o.SetBulkInserter(pg.NewBulkInserter(db, logger.Test(t), cfg.Database().BulkInsert()))
+
+	logs := make([]logpoller.Log, 2500)
+	for i := range logs {
+		logs[i] = GenLog(chainID, int64(i+1), 1, utils.RandomAddress().String(), event[:], address)
+	}
+	block := logpoller.NewLogPollerBlock(utils.RandomBytes32(), 1, time.Now(), 1)
+
+	t.Run("copies logs in batches", func(t *testing.T) {
+		require.NoError(t, o.InsertLogsWithBlock(ctx, logs, block))
+
+		stored, err := o.SelectLogs(ctx, 0, math.MaxInt, address, event)
+		require.NoError(t, err)
+		require.Len(t, stored, len(logs))
+		assert.Equal(t, logs[0].BlockHash, stored[0].BlockHash)
+		assert.Equal(t, logs[0].Topics, stored[0].Topics)
+		assert.Equal(t, logs[0].Data, stored[0].Data)
+		assert.Equal(t, logs[0].EvmChainId.String(), stored[0].EvmChainId.String())
+
+		latest, err := o.SelectLatestBlock(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, block.BlockHash, latest.BlockHash)
+	})
+
+	t.Run("ignores logs which are already stored", func(t *testing.T) {
+		require.NoError(t, o.InsertLogs(ctx, logs[:10]))
+
+		stored, err := o.SelectLogs(ctx, 0, math.MaxInt, address, event)
+		require.NoError(t, err)
+		require.Len(t, stored, len(logs))
+	})
+
+	t.Run("rolls back when a log is invalid", func(t *testing.T) {
+		invalidLog := GenLog(chainID, -10, -10, utils.RandomAddress().String(), event[:], address)
+		newLog := GenLog(chainID, 1, 2, utils.RandomAddress().String(), event[:], address)
+		require.Error(t, o.InsertLogs(ctx, []logpoller.Log{newLog, invalidLog}))
+
+		stored, err := o.SelectLogs(ctx, 2, 2, address, event)
+		require.NoError(t, err)
+		assert.Empty(t, stored)
+	})
+}
@@ -194,6 +194,27 @@ func (v *pgDSLParser) VisitEventTopicsByValueFilter(p *eventByTopicFilter) {
 	}
 }
 
+func (v *pgDSLParser) VisitEventTopicsByValuesFilter(p *eventByTopicValuesFilter) {
+	if !(p.Topic == 1 || p.Topic == 2 || p.Topic == 3) {
+		v.err = fmt.Errorf("invalid index for topic: %d", p.Topic)
+
+		return
+	}
+
+	if len(p.Values) == 0 {
+		v.err = errors.New("topic values filter requires at least one value")
+
+		return
+	}
+
+	// Add 1 since postgresql arrays are 1-indexed.
+	v.expression = fmt.Sprintf(
+		"topics[:%s] = ANY(:%s)",
+		v.args.withIndexedField("topic_index", p.Topic+1),
+		v.args.withIndexedField("topic_values", p.Values),
+	)
+}
+
 func (v *pgDSLParser) VisitConfirmationsFilter(p *confirmationsFilter) {
 	switch p.Confirmations {
 	case evmtypes.Finalized:
@@ -546,6 +567,42 @@ func (f *eventByTopicFilter) Accept(visitor primitives.Visitor) {
 	}
 }
 
+// NewEventByTopicRangeFilter matches logs where the indexed topic at topicIndex is within [valueMin, valueMax].
+func NewEventByTopicRangeFilter(topicIndex uint64, valueMin, valueMax common.Hash) query.Expression {
+	return NewEventByTopicFilter(topicIndex, []HashedValueComparator{
+		{Value: valueMin, Operator: primitives.Gte},
+		{Value: valueMax, Operator: primitives.Lte},
+	})
+}
+
+type eventByTopicValuesFilter struct {
+	Topic  uint64
+	Values []common.Hash
+}
+
+// NewEventByTopicValuesFilter matches logs where the indexed topic at topicIndex equals any of the provided values.
+func NewEventByTopicValuesFilter(topicIndex uint64, values []common.Hash) query.Expression {
+	return query.Expression{Primitive: &eventByTopicValuesFilter{
+		Topic:  topicIndex,
+		Values: values,
+	}}
+}
+
+func (f *eventByTopicValuesFilter) Accept(visitor primitives.Visitor) {
+	switch v := visitor.(type) {
+	case *pgDSLParser:
+		v.VisitEventTopicsByValuesFilter(f)
+	}
+}
+
+// NewEventByWordRangeFilter matches logs where the data word at wordIndex is within [valueMin, valueMax].
+func NewEventByWordRangeFilter(wordIndex int, valueMin, valueMax common.Hash) query.Expression {
+	return NewEventByWordFilter(wordIndex, []HashedValueComparator{
+		{Value: valueMin, Operator: primitives.Gte},
+		{Value: valueMax, Operator: primitives.Lte},
+	})
+}
+
 type confirmationsFilter struct {
 	Confirmations evmtypes.Confirmations
 }
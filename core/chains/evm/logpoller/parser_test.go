@@ -270,6 +270,68 @@ func TestDSLParser(t *testing.T) {
 		assertArgs(t, args, 4)
 	})
 
+	t.Run("query for event topic range", func(t *testing.T) {
+		t.Parallel()
+
+		parser := &pgDSLParser{}
+		chainID := big.NewInt(1)
+		expressions := []query.Expression{
+			NewEventByTopicRangeFilter(1, EvmWord(10), EvmWord(20)),
+		}
+		limiter := query.LimitAndSort{}
+
+		result, args, err := parser.buildQuery(chainID, expressions, limiter)
+		expected := logsQuery(
+			" WHERE evm_chain_id = :evm_chain_id " +
+				"AND topics[:topic_index_0] >= :topic_value_0 AND topics[:topic_index_0] <= :topic_value_1 ORDER BY " + defaultSort)
+
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+
+		assertArgs(t, args, 4)
+	})
+
+	t.Run("query for event topic values combined with or", func(t *testing.T) {
+		t.Parallel()
+
+		parser := &pgDSLParser{}
+		chainID := big.NewInt(1)
+		expressions := []query.Expression{
+			NewEventSigFilter(common.HexToHash("0x21")),
+			query.Or(
+				NewEventByTopicValuesFilter(1, []common.Hash{common.HexToHash("a"), common.HexToHash("b")}),
+				NewEventByTopicRangeFilter(2, EvmWord(1), EvmWord(5)),
+			),
+		}
+		limiter := query.LimitAndSort{}
+
+		result, args, err := parser.buildQuery(chainID, expressions, limiter)
+		expected := logsQuery(
+			" WHERE evm_chain_id = :evm_chain_id " +
+				"AND (event_sig = :event_sig_0 " +
+				"AND (topics[:topic_index_0] = ANY(:topic_values_0) " +
+				"OR topics[:topic_index_1] >= :topic_value_0 AND topics[:topic_index_1] <= :topic_value_1)) ORDER BY " + defaultSort)
+
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+
+		assertArgs(t, args, 7)
+	})
+
+	t.Run("query for event topic values rejects invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		parser := &pgDSLParser{}
+		chainID := big.NewInt(1)
+		limiter := query.LimitAndSort{}
+
+		_, _, err := parser.buildQuery(chainID, []query.Expression{NewEventByTopicValuesFilter(4, []common.Hash{{}})}, limiter)
+		require.Error(t, err)
+
+		_, _, err = parser.buildQuery(chainID, []query.Expression{NewEventByTopicValuesFilter(1, nil)}, limiter)
+		require.Error(t, err)
+	})
+
 	// nested query -> a & (b || c)
 	t.Run("nested query", func(t *testing.T) {
 		t.Parallel()
@@ -129,6 +129,15 @@ func (q *queryArgs) withEndBlock(endBlock int64) *queryArgs {
 	return q.withField("end_block", endBlock)
 }
 
+func (q *queryArgs) withCursor(cursor LogCursor) *queryArgs {
+	return q.withField("cursor_block_number", cursor.BlockNumber).
+		withField("cursor_log_index", cursor.LogIndex)
+}
+
+func (q *queryArgs) withLimit(limit int) *queryArgs {
+	return q.withField("limit", limit)
+}
+
 func (q *queryArgs) withWordIndex(wordIndex int) *queryArgs {
 	return q.withField("word_index", wordIndex)
 }
@@ -190,6 +199,10 @@ func (q *queryArgs) withRetention(retention time.Duration) *queryArgs {
 	return q.withField("retention", retention)
 }
 
+func (q *queryArgs) withRetentionBlocks(retentionBlocks uint64) *queryArgs {
+	return q.withField("retention_blocks", retentionBlocks)
+}
+
 func (q *queryArgs) withLogsPerBlock(logsPerBlock uint64) *queryArgs {
 	return q.withField("logs_per_block", logsPerBlock)
 }
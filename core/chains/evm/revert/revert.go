@@ -0,0 +1,214 @@
+// Package revert decodes the revert data of failed calls and transactions into readable errors, using the ABI errors
+// of registered contracts.
+package revert
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+var (
+	// ErrNoData is returned when there is no revert data to decode.
+	ErrNoData = errors.New("no revert data")
+	// ErrUnknownError is returned when the selector of the revert data matches no registered error.
+	ErrUnknownError = errors.New("unknown revert error")
+)
+
+// The builtin errors of solidity, see https://docs.soliditylang.org/en/latest/control-structures.html#panic-via-assert-and-error-via-require
+var (
+	errorError = mustError("Error", "string")
+	panicError = mustError("Panic", "uint256")
+)
+
+var panicReasons = map[uint64]string{
+	0x00: "generic panic",
+	0x01: "assertion failed",
+	0x11: "arithmetic underflow or overflow",
+	0x12: "division or modulo by zero",
+	0x21: "invalid enum value",
+	0x22: "invalid storage byte array",
+	0x31: "pop on empty array",
+	0x32: "array index out of bounds",
+	0x41: "out of memory",
+	0x51: "call to zero-initialized function",
+}
+
+// Error is a decoded revert.
+type Error struct {
+	Name string
+	Args []Arg
+	// Contracts are the names of the registered contracts which declare the error, or empty for the builtin errors.
+	Contracts []string
+}
+
+// Arg is an argument of a decoded revert.
+type Arg struct {
+	Name  string
+	Value interface{}
+}
+
+// String returns the message of Error(string) reverts, the reason of Panic(uint256) reverts, and the name and arguments
+// of custom errors, e.g. InvalidTokenPoolConfig(token: 0xabc..., pool: 0xdef...).
+func (e *Error) String() string {
+	switch {
+	case e.Contracts == nil && e.Name == errorError.Name && len(e.Args) == 1:
+		return fmt.Sprint(e.Args[0].Value)
+	case e.Contracts == nil && e.Name == panicError.Name && len(e.Args) == 1:
+		code, _ := e.Args[0].Value.(*big.Int)
+		if code != nil && code.IsUint64() {
+			if reason, ok := panicReasons[code.Uint64()]; ok {
+				return fmt.Sprintf("panic: %s (0x%x)", reason, code)
+			}
+		}
+		return fmt.Sprintf("panic: %v", e.Args[0].Value)
+	}
+	args := make([]string, len(e.Args))
+	for i, arg := range e.Args {
+		value := formatValue(arg.Value)
+		if arg.Name == "" {
+			args[i] = value
+		} else {
+			args[i] = arg.Name + ": " + value
+		}
+	}
+	return fmt.Sprintf("%s(%s)", e.Name, strings.Join(args, ", "))
+}
+
+func formatValue(v interface{}) string {
+	switch v := v.(type) {
+	case []byte:
+		return hexutil.Encode(v)
+	case [32]byte:
+		return hexutil.Encode(v[:])
+	case fmt.Stringer:
+		return v.String()
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+type registeredError struct {
+	abi       abi.Error
+	contracts []string
+}
+
+// Registry maintains the ABI errors of contracts, by selector.
+type Registry struct {
+	mu     sync.RWMutex
+	errors map[[4]byte]*registeredError
+}
+
+// NewRegistry returns a Registry of the builtin errors.
+func NewRegistry() *Registry {
+	r := &Registry{errors: map[[4]byte]*registeredError{}}
+	for _, e := range []abi.Error{errorError, panicError} {
+		r.errors[selector(e)] = &registeredError{abi: e}
+	}
+	return r
+}
+
+// Register registers the errors declared in the ABI of contract. Errors which have the same signature in several
+// contracts are registered once, and list all of them.
+func (r *Registry) Register(contract string, contractABI string) error {
+	parsed, err := abi.JSON(strings.NewReader(contractABI))
+	if err != nil {
+		return fmt.Errorf("failed to parse ABI of %s: %w", contract, err)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, e := range parsed.Errors {
+		sel := selector(e)
+		registered, ok := r.errors[sel]
+		if !ok {
+			r.errors[sel] = &registeredError{abi: e, contracts: []string{contract}}
+			continue
+		}
+		if registered.abi.Sig != e.Sig {
+			return fmt.Errorf("error %s of %s collides with %s of %s", e.Sig, contract, registered.abi.Sig, strings.Join(registered.contracts, ", "))
+		}
+		if registered.contracts != nil && !slices.Contains(registered.contracts, contract) {
+			registered.contracts = append(registered.contracts, contract)
+			slices.Sort(registered.contracts)
+		}
+	}
+	return nil
+}
+
+// Decode decodes revert data, which is the selector of the error followed by its ABI encoded arguments.
+func (r *Registry) Decode(data []byte) (*Error, error) {
+	if len(data) < 4 {
+		return nil, ErrNoData
+	}
+	r.mu.RLock()
+	registered, ok := r.errors[[4]byte(data[:4])]
+	var contracts []string
+	if ok {
+		contracts = slices.Clone(registered.contracts)
+	}
+	r.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w with selector %s", ErrUnknownError, hexutil.Encode(data[:4]))
+	}
+
+	values, err := registered.abi.Inputs.Unpack(data[4:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack arguments of %s: %w", registered.abi.Sig, err)
+	}
+	decoded := &Error{Name: registered.abi.Name, Args: make([]Arg, len(values)), Contracts: contracts}
+	for i, v := range values {
+		decoded.Args[i] = Arg{Name: registered.abi.Inputs[i].Name, Value: v}
+	}
+	return decoded, nil
+}
+
+// Reason returns the decoded revert data, or the revert data as hex if it can't be decoded.
+func (r *Registry) Reason(data []byte) string {
+	decoded, err := r.Decode(data)
+	if err != nil {
+		return hexutil.Encode(data)
+	}
+	return decoded.String()
+}
+
+var defaultRegistry = NewRegistry()
+
+// Register registers the errors declared in the ABI of contract in the default Registry.
+func Register(contract string, contractABI string) error {
+	return defaultRegistry.Register(contract, contractABI)
+}
+
+// MustRegister is like Register, but panics on errors. It is meant to be called by the init functions of the packages
+// which own the contracts.
+func MustRegister(contract string, contractABI string) {
+	if err := Register(contract, contractABI); err != nil {
+		panic(err)
+	}
+}
+
+// Decode decodes revert data with the default Registry.
+func Decode(data []byte) (*Error, error) {
+	return defaultRegistry.Decode(data)
+}
+
+// Reason returns the revert data decoded with the default Registry, or as hex if it can't be decoded.
+func Reason(data []byte) string {
+	return defaultRegistry.Reason(data)
+}
+
+func selector(e abi.Error) [4]byte {
+	return [4]byte(e.ID[:4])
+}
+
+func mustError(name string, inputType string) abi.Error {
+	t, err := abi.NewType(inputType, "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return abi.NewError(name, abi.Arguments{{Type: t}})
+}
@@ -0,0 +1,89 @@
+package revert
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	rampABI = `[
+		{"type":"error","name":"InvalidToken","inputs":[{"name":"token","type":"address"},{"name":"amount","type":"uint256"}]},
+		{"type":"error","name":"OnlyCallableByOwner","inputs":[]}
+	]`
+	routerABI = `[
+		{"type":"error","name":"OnlyCallableByOwner","inputs":[]},
+		{"type":"error","name":"InvalidMsgValue","inputs":[]}
+	]`
+)
+
+func pack(t *testing.T, contractABI string, name string, args ...interface{}) []byte {
+	parsed, err := abi.JSON(strings.NewReader(contractABI))
+	require.NoError(t, err)
+	e := parsed.Errors[name]
+	packed, err := e.Inputs.Pack(args...)
+	require.NoError(t, err)
+	return append(e.ID[:4:4], packed...)
+}
+
+func TestRegistry_Decode(t *testing.T) {
+	r := NewRegistry()
+	require.NoError(t, r.Register("EVM2EVMOffRamp", rampABI))
+	require.NoError(t, r.Register("Router", routerABI))
+	require.NoError(t, r.Register("Router", routerABI))
+
+	token := common.HexToAddress("0x5FbDB2315678afecb367f032d93F642f64180aa3")
+
+	t.Run("custom error", func(t *testing.T) {
+		decoded, err := r.Decode(pack(t, rampABI, "InvalidToken", token, big.NewInt(42)))
+		require.NoError(t, err)
+		assert.Equal(t, "InvalidToken", decoded.Name)
+		assert.Equal(t, []Arg{{Name: "token", Value: token}, {Name: "amount", Value: big.NewInt(42)}}, decoded.Args)
+		assert.Equal(t, []string{"EVM2EVMOffRamp"}, decoded.Contracts)
+		assert.Equal(t, "InvalidToken(token: 0x5FbDB2315678afecb367f032d93F642f64180aa3, amount: 42)", decoded.String())
+	})
+
+	t.Run("custom error of several contracts", func(t *testing.T) {
+		decoded, err := r.Decode(pack(t, routerABI, "OnlyCallableByOwner"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"EVM2EVMOffRamp", "Router"}, decoded.Contracts)
+		assert.Equal(t, "OnlyCallableByOwner()", decoded.String())
+	})
+
+	t.Run("builtin errors", func(t *testing.T) {
+		packed, err := errorError.Inputs.Pack("too late")
+		require.NoError(t, err)
+		decoded, err := r.Decode(append(errorError.ID[:4:4], packed...))
+		require.NoError(t, err)
+		assert.Nil(t, decoded.Contracts)
+		assert.Equal(t, "too late", decoded.String())
+
+		packed, err = panicError.Inputs.Pack(big.NewInt(0x11))
+		require.NoError(t, err)
+		assert.Equal(t, "panic: arithmetic underflow or overflow (0x11)", r.Reason(append(panicError.ID[:4:4], packed...)))
+	})
+
+	t.Run("unknown error", func(t *testing.T) {
+		_, err := r.Decode([]byte{1, 2, 3, 4})
+		require.ErrorIs(t, err, ErrUnknownError)
+		assert.Equal(t, "0x01020304", r.Reason([]byte{1, 2, 3, 4}))
+
+		_, err = r.Decode([]byte{1, 2})
+		require.ErrorIs(t, err, ErrNoData)
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		data := pack(t, rampABI, "InvalidToken", token, big.NewInt(42))
+		_, err := r.Decode(data[:20])
+		require.ErrorContains(t, err, "failed to unpack arguments of InvalidToken(address,uint256)")
+	})
+
+	t.Run("invalid ABI", func(t *testing.T) {
+		require.ErrorContains(t, r.Register("Broken", "{"), "failed to parse ABI of Broken")
+	})
+}
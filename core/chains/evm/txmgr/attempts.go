@@ -3,20 +3,26 @@ package txmgr
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/holiman/uint256"
 	pkgerrors "github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	commonfee "github.com/smartcontractkit/chainlink/v2/common/fee"
 	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
 	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	commontypes "github.com/smartcontractkit/chainlink/v2/common/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmconfig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/audit"
 )
 
 type TxAttemptSigner[ADDR commontypes.Hashable] interface {
@@ -36,12 +42,70 @@ type evmTxAttemptBuilderFeeConfig interface {
 	EIP1559DynamicFees() bool
 	PriceMaxKey(common.Address) *assets.Wei
 	LimitDefault() uint64
+	BlobPriceMax() *assets.Wei
+	BumpPercent() uint16
+	BumpMin() *assets.Wei
 }
 
 func NewEvmTxAttemptBuilder(chainID big.Int, feeConfig evmTxAttemptBuilderFeeConfig, keystore TxAttemptSigner[common.Address], estimator gas.EvmFeeEstimator) *evmTxAttemptBuilder {
 	return &evmTxAttemptBuilder{chainID, feeConfig, keystore, estimator}
 }
 
+type profiledFeeEstimator interface {
+	Profile(name string) (gas.EvmFeeEstimator, evmconfig.GasEstimator, bool)
+}
+
+// feeEstimator returns the estimator and the max fee price of the gas estimator profile referenced by the tx,
+// falling back to the default estimator of the chain if the tx does not reference a known profile
+func (c *evmTxAttemptBuilder) feeEstimator(etx Tx, lggr logger.Logger) (gas.EvmFeeEstimator, *assets.Wei) {
+	estimator, maxGasPriceWei := c.EvmFeeEstimator, c.feeConfig.PriceMaxKey(etx.FromAddress)
+	meta, err := etx.GetMeta()
+	if err != nil || meta == nil || meta.GasEstimatorProfile == nil {
+		return estimator, maxGasPriceWei
+	}
+	profiled, ok := c.EvmFeeEstimator.(profiledFeeEstimator)
+	if !ok {
+		lggr.Warnw("Gas estimator profiles are not configured, using the default estimator", "profile", *meta.GasEstimatorProfile, "txID", etx.ID)
+		return estimator, maxGasPriceWei
+	}
+	pe, cfg, ok := profiled.Profile(*meta.GasEstimatorProfile)
+	if !ok {
+		lggr.Warnw("Unknown gas estimator profile, using the default estimator", "profile", *meta.GasEstimatorProfile, "txID", etx.ID)
+		return estimator, maxGasPriceWei
+	}
+	return pe, cfg.PriceMaxKey(etx.FromAddress)
+}
+
+var _ txmgrtypes.FeeLimitPrefetcher[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee] = (*evmTxAttemptBuilder)(nil)
+
+// PrefetchFeeLimits estimates the fee limits of the txs with batched eth_estimateGas requests, which the estimators keep
+// for the GetFee calls of NewTxAttempt. Blob txs are left out, as are CCIP exec txs, whose gas limits are derived from
+// the gas limits of the executed messages, which all the oracles must agree on.
+func (c *evmTxAttemptBuilder) PrefetchFeeLimits(ctx context.Context, etxs []*Tx, lggr logger.Logger) {
+	calls := make(map[gas.EvmFeeEstimator][]gas.FeeLimitCall)
+	for _, etx := range etxs {
+		if len(etx.BlobSidecar) > 0 {
+			continue
+		}
+		if meta, err := etx.GetMeta(); err == nil && meta != nil && len(meta.MessageIDs) > 0 {
+			continue
+		}
+		estimator, _ := c.feeEstimator(*etx, lggr)
+		calls[estimator] = append(calls[estimator], gas.FeeLimitCall{
+			Calldata:    etx.EncodedPayload,
+			FeeLimit:    etx.FeeLimit,
+			FromAddress: &etx.FromAddress,
+			ToAddress:   &etx.ToAddress,
+		})
+	}
+	for estimator, estimatorCalls := range calls {
+		_, errs := estimator.EstimateFeeLimits(ctx, estimatorCalls)
+		if err := errors.Join(errs...); err != nil {
+			lggr.Debugw("Failed to prefetch the fee limits of some transactions, they are estimated when broadcast", "err", err)
+		}
+	}
+}
+
 // NewTxAttempt builds an new attempt using the configured fee estimator + using the EIP1559 config to determine tx type
 // used for when a brand new transaction is being created in the txm
 func (c *evmTxAttemptBuilder) NewTxAttempt(ctx context.Context, etx Tx, lggr logger.Logger, opts ...feetypes.Opt) (attempt TxAttempt, fee gas.EvmFee, feeLimit uint64, retryable bool, err error) {
@@ -54,9 +118,13 @@ func (c *evmTxAttemptBuilder) NewTxAttempt(ctx context.Context, etx Tx, lggr log
 
 // NewTxAttemptWithType builds a new attempt with a new fee estimation where the txType can be specified by the caller
 // used for L2 re-estimation on broadcasting (note EIP1559 must be disabled otherwise this will fail with mismatched fees + tx type)
+// Txs carrying blobs always get a blob attempt, regardless of txType
 func (c *evmTxAttemptBuilder) NewTxAttemptWithType(ctx context.Context, etx Tx, lggr logger.Logger, txType int, opts ...feetypes.Opt) (attempt TxAttempt, fee gas.EvmFee, feeLimit uint64, retryable bool, err error) {
-	keySpecificMaxGasPriceWei := c.feeConfig.PriceMaxKey(etx.FromAddress)
-	fee, feeLimit, err = c.EvmFeeEstimator.GetFee(ctx, etx.EncodedPayload, etx.FeeLimit, keySpecificMaxGasPriceWei, &etx.FromAddress, &etx.ToAddress, opts...)
+	if len(etx.BlobSidecar) > 0 {
+		return c.newBlobTxAttempt(ctx, etx, lggr, opts...)
+	}
+	estimator, keySpecificMaxGasPriceWei := c.feeEstimator(etx, lggr)
+	fee, feeLimit, err = estimator.GetFee(ctx, etx.EncodedPayload, etx.FeeLimit, keySpecificMaxGasPriceWei, &etx.FromAddress, &etx.ToAddress, opts...)
 	if err != nil {
 		return attempt, fee, feeLimit, true, pkgerrors.Wrap(err, "failed to get fee") // estimator errors are retryable
 	}
@@ -68,9 +136,9 @@ func (c *evmTxAttemptBuilder) NewTxAttemptWithType(ctx context.Context, etx Tx,
 // NewBumpTxAttempt builds a new attempt with a bumped fee - based on the previous attempt tx type
 // used in the txm broadcaster + confirmer when tx ix rejected for too low fee or is not included in a timely manner
 func (c *evmTxAttemptBuilder) NewBumpTxAttempt(ctx context.Context, etx Tx, previousAttempt TxAttempt, priorAttempts []TxAttempt, lggr logger.Logger) (attempt TxAttempt, bumpedFee gas.EvmFee, bumpedFeeLimit uint64, retryable bool, err error) {
-	keySpecificMaxGasPriceWei := c.feeConfig.PriceMaxKey(etx.FromAddress)
+	estimator, keySpecificMaxGasPriceWei := c.feeEstimator(etx, lggr)
 	// Use the fee limit from the previous attempt to maintain limits adjusted for 2D fees or by estimation
-	bumpedFee, bumpedFeeLimit, err = c.EvmFeeEstimator.BumpFee(ctx, previousAttempt.TxFee, previousAttempt.ChainSpecificFeeLimit, keySpecificMaxGasPriceWei, newEvmPriorAttempts(priorAttempts))
+	bumpedFee, bumpedFeeLimit, err = estimator.BumpFee(ctx, previousAttempt.TxFee, previousAttempt.ChainSpecificFeeLimit, keySpecificMaxGasPriceWei, newEvmPriorAttempts(priorAttempts))
 	if err != nil {
 		return attempt, bumpedFee, bumpedFeeLimit, true, pkgerrors.Wrap(err, "failed to bump fee") // estimator errors are retryable
 	}
@@ -80,7 +148,11 @@ func (c *evmTxAttemptBuilder) NewBumpTxAttempt(ctx context.Context, etx Tx, prev
 		etx.Value = *big.NewInt(0)
 		bumpedFeeLimit = c.feeConfig.LimitDefault()
 	}
-	attempt, retryable, err = c.NewCustomTxAttempt(ctx, etx, bumpedFee, bumpedFeeLimit, previousAttempt.TxType, lggr)
+	if previousAttempt.TxType == types.BlobTxType {
+		attempt, bumpedFee, retryable, err = c.replaceBlobTxAttempt(ctx, etx, previousAttempt, bumpedFee, bumpedFeeLimit, lggr)
+	} else {
+		attempt, retryable, err = c.NewCustomTxAttempt(ctx, etx, bumpedFee, bumpedFeeLimit, previousAttempt.TxType, lggr)
+	}
 	// If transaction's previous attempt is marked for purge, ensure the new bumped attempt is also marked for purge
 	if previousAttempt.IsPurgeAttempt {
 		attempt.IsPurgeAttempt = true
@@ -88,20 +160,83 @@ func (c *evmTxAttemptBuilder) NewBumpTxAttempt(ctx context.Context, etx Tx, prev
 	return attempt, bumpedFee, bumpedFeeLimit, retryable, err
 }
 
+// NewCustomBumpTxAttempt builds a replacement of the previous attempt with the passed in fee, e.g. returned by a BumpStrategy.
+// Like the fees bumped by the estimator, the fee is raised to at least the configured bump over the previous attempt for
+// the replacement to be accepted by the mempool, and is rejected if it would exceed the max configured gas price
+func (c *evmTxAttemptBuilder) NewCustomBumpTxAttempt(ctx context.Context, etx Tx, previousAttempt TxAttempt, fee gas.EvmFee, feeLimit uint64, lggr logger.Logger) (attempt TxAttempt, bumpedFee gas.EvmFee, retryable bool, err error) {
+	_, keySpecificMaxGasPriceWei := c.feeEstimator(etx, lggr)
+	bumpedFee, err = c.limitCustomBumpedFee(previousAttempt, fee, keySpecificMaxGasPriceWei, lggr)
+	if err != nil {
+		return attempt, bumpedFee, false, err
+	}
+	if previousAttempt.TxType == types.BlobTxType {
+		return c.replaceBlobTxAttempt(ctx, etx, previousAttempt, bumpedFee, feeLimit, lggr)
+	}
+	attempt, retryable, err = c.NewCustomTxAttempt(ctx, etx, bumpedFee, feeLimit, previousAttempt.TxType, lggr)
+	return attempt, bumpedFee, retryable, err
+}
+
+// limitCustomBumpedFee raises the fee to the minimum bump over the fee of the previous attempt, and errors if it exceeds
+// the max gas price
+func (c *evmTxAttemptBuilder) limitCustomBumpedFee(previousAttempt TxAttempt, fee gas.EvmFee, maxGasPrice *assets.Wei, lggr logger.Logger) (gas.EvmFee, error) {
+	minBump := func(previous *assets.Wei) *assets.Wei {
+		return assets.MaxWei(previous.AddPercentage(c.feeConfig.BumpPercent()), previous.Add(c.feeConfig.BumpMin()))
+	}
+	atLeast := func(feeType string, bumped, min *assets.Wei) (*assets.Wei, error) {
+		if bumped.Cmp(min) < 0 {
+			logger.Sugared(lggr).Warnw(fmt.Sprintf("Custom bumped %s is below the minimum bump, raising it", feeType), "bumped", bumped, "minimum", min)
+			bumped = min
+		}
+		if bumped.Cmp(maxGasPrice) > 0 {
+			return bumped, pkgerrors.Wrapf(commonfee.ErrBumpFeeExceedsLimit, "custom bumped %s of %s would exceed configured max gas price of %s", feeType, bumped, maxGasPrice)
+		}
+		return bumped, nil
+	}
+
+	previousFee := previousAttempt.TxFee
+	var err error
+	switch previousAttempt.TxType {
+	case 0x0: // legacy
+		if fee.Legacy == nil || previousFee.Legacy == nil {
+			return fee, pkgerrors.Errorf("attempt %v is a type 0 transaction but custom bumped fee is not a legacy fee", previousAttempt.ID)
+		}
+		bumped := gas.EvmFee{}
+		bumped.Legacy, err = atLeast("gas price", fee.Legacy, minBump(previousFee.Legacy))
+		return bumped, err
+	case 0x2, types.BlobTxType: // dynamic, EIP1559 and blob, EIP4844
+		if !fee.ValidDynamic() || !previousFee.ValidDynamic() {
+			return fee, pkgerrors.Errorf("attempt %v is a type %v transaction but custom bumped fee is not a dynamic fee", previousAttempt.ID, previousAttempt.TxType)
+		}
+		bumped := gas.EvmFee{}
+		if bumped.DynamicTipCap, err = atLeast("tip cap", fee.DynamicTipCap, minBump(previousFee.DynamicTipCap)); err != nil {
+			return bumped, err
+		}
+		bumped.DynamicFeeCap, err = atLeast("fee cap", fee.DynamicFeeCap, minBump(previousFee.DynamicFeeCap))
+		return bumped, err
+	default:
+		return fee, pkgerrors.Errorf("invariant violation: attempt %v had unrecognised transaction type %v", previousAttempt.ID, previousAttempt.TxType)
+	}
+}
+
 func (c *evmTxAttemptBuilder) NewPurgeTxAttempt(ctx context.Context, etx Tx, lggr logger.Logger) (attempt TxAttempt, err error) {
 	// Use the LimitDefault since this is an empty tx
 	gasLimit := c.feeConfig.LimitDefault()
 	// Transactions being purged will always have a previous attempt since it had to have been broadcasted before at least once
 	previousAttempt := etx.TxAttempts[0]
-	keySpecificMaxGasPriceWei := c.feeConfig.PriceMaxKey(etx.FromAddress)
-	bumpedFee, _, err := c.EvmFeeEstimator.BumpFee(ctx, previousAttempt.TxFee, etx.FeeLimit, keySpecificMaxGasPriceWei, newEvmPriorAttempts(etx.TxAttempts))
+	estimator, keySpecificMaxGasPriceWei := c.feeEstimator(etx, lggr)
+	bumpedFee, _, err := estimator.BumpFee(ctx, previousAttempt.TxFee, etx.FeeLimit, keySpecificMaxGasPriceWei, newEvmPriorAttempts(etx.TxAttempts))
 	if err != nil {
 		return attempt, fmt.Errorf("failed to bump previous fee to use for the purge attempt: %w", err)
 	}
 	// Set empty payload and 0 value for purge attempts
 	etx.EncodedPayload = []byte{}
 	etx.Value = *big.NewInt(0)
-	attempt, _, err = c.NewCustomTxAttempt(ctx, etx, bumpedFee, gasLimit, previousAttempt.TxType, lggr)
+	if previousAttempt.TxType == types.BlobTxType {
+		// a blob tx can only be replaced by another blob tx
+		attempt, _, _, err = c.replaceBlobTxAttempt(ctx, etx, previousAttempt, bumpedFee, gasLimit, lggr)
+	} else {
+		attempt, _, err = c.NewCustomTxAttempt(ctx, etx, bumpedFee, gasLimit, previousAttempt.TxType, lggr)
+	}
 	if err != nil {
 		return attempt, fmt.Errorf("failed to create purge attempt: %w", err)
 	}
@@ -198,6 +333,107 @@ func (c *evmTxAttemptBuilder) newDynamicFeeAttempt(ctx context.Context, etx Tx,
 	return attempt, nil
 }
 
+// newBlobTxAttempt builds a new EIP-4844 blob attempt carrying the blobs of the tx sidecar, with a new fee and blob fee estimation.
+// Blob txs require EIP-1559 dynamic fees.
+func (c *evmTxAttemptBuilder) newBlobTxAttempt(ctx context.Context, etx Tx, lggr logger.Logger, opts ...feetypes.Opt) (attempt TxAttempt, fee gas.EvmFee, feeLimit uint64, retryable bool, err error) {
+	sidecar, err := DecodeBlobSidecar(etx.BlobSidecar)
+	if err != nil {
+		return attempt, fee, feeLimit, false, err
+	}
+	estimator, keySpecificMaxGasPriceWei := c.feeEstimator(etx, lggr)
+	fee, feeLimit, err = estimator.GetFee(ctx, etx.EncodedPayload, etx.FeeLimit, keySpecificMaxGasPriceWei, &etx.FromAddress, &etx.ToAddress, opts...)
+	if err != nil {
+		return attempt, fee, feeLimit, true, pkgerrors.Wrap(err, "failed to get fee") // estimator errors are retryable
+	}
+	if !fee.ValidDynamic() {
+		return attempt, fee, feeLimit, false, pkgerrors.New("blob transactions require EIP-1559 dynamic fees")
+	}
+	blobFee, err := estimator.GetBlobFee(ctx, c.feeConfig.BlobPriceMax())
+	if err != nil {
+		return attempt, fee, feeLimit, true, pkgerrors.Wrap(err, "failed to get blob fee")
+	}
+
+	attempt, err = c.newBlobAttempt(ctx, etx, gas.DynamicFee{FeeCap: fee.DynamicFeeCap, TipCap: fee.DynamicTipCap}, blobFee, feeLimit, sidecar)
+	return attempt, fee, feeLimit, true, err
+}
+
+// replaceBlobTxAttempt builds an attempt replacing the previous blob attempt with the bumped fee, carrying the same blobs.
+// Replacing a blob tx requires all of its fee caps, including the blob fee cap, to be bumped by at least gas.BlobPriceBumpPercent
+func (c *evmTxAttemptBuilder) replaceBlobTxAttempt(ctx context.Context, etx Tx, previousAttempt TxAttempt, bumpedFee gas.EvmFee, feeLimit uint64, lggr logger.Logger) (attempt TxAttempt, fee gas.EvmFee, retryable bool, err error) {
+	previousTx, err := GetGethSignedTx(previousAttempt.SignedRawTx)
+	if err != nil {
+		return attempt, bumpedFee, false, pkgerrors.Wrap(err, "failed to decode previous blob attempt")
+	}
+	if previousTx.Type() != types.BlobTxType || previousTx.BlobTxSidecar() == nil || !previousAttempt.TxFee.ValidDynamic() || !bumpedFee.ValidDynamic() {
+		return attempt, bumpedFee, false, pkgerrors.Errorf("attempt %v is not a blob transaction with blobs and dynamic fees", previousAttempt.ID)
+	}
+
+	estimator, keySpecificMaxGasPriceWei := c.feeEstimator(etx, lggr)
+	bumpedFee.DynamicFeeCap = assets.WeiMax(bumpedFee.DynamicFeeCap, previousAttempt.TxFee.DynamicFeeCap.AddPercentage(gas.BlobPriceBumpPercent))
+	bumpedFee.DynamicTipCap = assets.WeiMax(bumpedFee.DynamicTipCap, previousAttempt.TxFee.DynamicTipCap.AddPercentage(gas.BlobPriceBumpPercent))
+	if bumpedFee.DynamicFeeCap.Cmp(keySpecificMaxGasPriceWei) > 0 {
+		return attempt, bumpedFee, true, pkgerrors.Wrapf(commonfee.ErrBumpFeeExceedsLimit, "bumped blob tx fee cap of %s exceeds max gas price of %s", bumpedFee.DynamicFeeCap, keySpecificMaxGasPriceWei)
+	}
+	bumpedBlobFee, err := estimator.BumpBlobFee(ctx, assets.NewWei(previousTx.BlobGasFeeCap()), c.feeConfig.BlobPriceMax())
+	if err != nil {
+		return attempt, bumpedFee, true, pkgerrors.Wrap(err, "failed to bump blob fee")
+	}
+
+	attempt, err = c.newBlobAttempt(ctx, etx, gas.DynamicFee{FeeCap: bumpedFee.DynamicFeeCap, TipCap: bumpedFee.DynamicTipCap}, bumpedBlobFee, feeLimit, previousTx.BlobTxSidecar())
+	return attempt, bumpedFee, true, err
+}
+
+func (c *evmTxAttemptBuilder) newBlobAttempt(ctx context.Context, etx Tx, fee gas.DynamicFee, blobFee *assets.Wei, gasLimit uint64, sidecar *types.BlobTxSidecar) (attempt TxAttempt, err error) {
+	if err = validateDynamicFeeGas(c.feeConfig, fee, etx); err != nil {
+		return attempt, pkgerrors.Wrap(err, "error validating gas")
+	}
+	if max := c.feeConfig.BlobPriceMax(); blobFee.Cmp(max) > 0 {
+		return attempt, pkgerrors.Errorf("cannot create tx attempt: specified blob fee cap of %s would exceed max configured blob price of %s", blobFee, max)
+	}
+	if sidecar == nil || len(sidecar.Blobs) == 0 {
+		return attempt, pkgerrors.New("cannot create blob tx attempt without blobs")
+	}
+
+	b := types.BlobTx{
+		ChainID:    uint256.MustFromBig(&c.chainID),
+		Nonce:      uint64(*etx.Sequence),
+		GasTipCap:  uint256.MustFromBig(fee.TipCap.ToInt()),
+		GasFeeCap:  uint256.MustFromBig(fee.FeeCap.ToInt()),
+		Gas:        gasLimit,
+		To:         etx.ToAddress,
+		Value:      uint256.MustFromBig(&etx.Value),
+		Data:       etx.EncodedPayload,
+		BlobFeeCap: uint256.MustFromBig(blobFee.ToInt()),
+		BlobHashes: sidecar.BlobHashes(),
+		Sidecar:    sidecar,
+	}
+	attempt, err = c.newSignedAttempt(ctx, etx, types.NewTx(&b))
+	if err != nil {
+		return attempt, err
+	}
+	attempt.TxFee = gas.EvmFee{
+		DynamicFeeCap: fee.FeeCap,
+		DynamicTipCap: fee.TipCap,
+	}
+	attempt.ChainSpecificFeeLimit = gasLimit
+	attempt.TxType = types.BlobTxType
+	return attempt, nil
+}
+
+// EncodeBlobSidecar encodes the sidecar of the blobs to be carried by a tx, as expected in TxRequest.BlobSidecar
+func EncodeBlobSidecar(sidecar *types.BlobTxSidecar) ([]byte, error) {
+	return rlp.EncodeToBytes(sidecar)
+}
+
+// DecodeBlobSidecar decodes the sidecar of the blobs carried by a tx, as encoded by EncodeBlobSidecar
+func DecodeBlobSidecar(b []byte) (*types.BlobTxSidecar, error) {
+	var sidecar types.BlobTxSidecar
+	if err := rlp.DecodeBytes(b, &sidecar); err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to decode blob sidecar")
+	}
+	return &sidecar, nil
+}
+
 var Max256BitUInt = big.NewInt(0).Exp(big.NewInt(2), big.NewInt(256), nil)
 
 type keySpecificEstimator interface {
@@ -295,7 +531,11 @@ func validateLegacyGas(kse keySpecificEstimator, gasPrice *assets.Wei, etx Tx) e
 }
 
 func (c *evmTxAttemptBuilder) newSignedAttempt(ctx context.Context, etx Tx, tx *types.Transaction) (attempt TxAttempt, err error) {
-	hash, signedTxBytes, err := c.SignTx(ctx, etx.FromAddress, tx)
+	requester := audit.Requester{Service: "EVM.TxManager"}
+	if meta, metaErr := etx.GetMeta(); metaErr == nil && meta != nil {
+		requester.JobID = meta.JobID
+	}
+	hash, signedTxBytes, err := c.SignTx(audit.WithRequester(ctx, requester), etx.FromAddress, tx)
 	if err != nil {
 		return attempt, pkgerrors.Wrapf(err, "error using account %s to sign transaction %v", etx.FromAddress.String(), etx.ID)
 	}
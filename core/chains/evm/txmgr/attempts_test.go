@@ -1,6 +1,7 @@
 package txmgr_test
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"testing"
@@ -9,15 +10,19 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
 	pkgerrors "github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
 
+	commonfee "github.com/smartcontractkit/chainlink/v2/common/fee"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmconfig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
 	gasmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/mocks"
@@ -36,7 +41,10 @@ type feeConfig struct {
 	tipCapMin          *assets.Wei
 	priceMin           *assets.Wei
 	priceMax           *assets.Wei
+	blobPriceMax       *assets.Wei
 	limitDefault       uint64
+	bumpPercent        uint16
+	bumpMin            *assets.Wei
 }
 
 func newFeeConfig() *feeConfig {
@@ -44,6 +52,7 @@ func newFeeConfig() *feeConfig {
 		tipCapMin: assets.NewWeiI(0),
 		priceMin:  assets.NewWeiI(0),
 		priceMax:  assets.NewWeiI(0),
+		bumpMin:   assets.NewWeiI(0),
 	}
 }
 
@@ -51,7 +60,10 @@ func (g *feeConfig) EIP1559DynamicFees() bool                        { return g.
 func (g *feeConfig) TipCapMin() *assets.Wei                          { return g.tipCapMin }
 func (g *feeConfig) PriceMin() *assets.Wei                           { return g.priceMin }
 func (g *feeConfig) PriceMaxKey(addr gethcommon.Address) *assets.Wei { return g.priceMax }
+func (g *feeConfig) BlobPriceMax() *assets.Wei                       { return g.blobPriceMax }
 func (g *feeConfig) LimitDefault() uint64                            { return g.limitDefault }
+func (g *feeConfig) BumpPercent() uint16                             { return g.bumpPercent }
+func (g *feeConfig) BumpMin() *assets.Wei                            { return g.bumpMin }
 
 func TestTxm_SignTx(t *testing.T) {
 	t.Parallel()
@@ -334,6 +346,63 @@ func TestTxm_NewCustomTxAttempt_NonRetryableErrors(t *testing.T) {
 	})
 }
 
+func TestTxm_NewCustomBumpTxAttempt(t *testing.T) {
+	t.Parallel()
+
+	addr := NewEvmAddress()
+	kst := ksmocks.NewEth(t)
+	kst.On("SignTx", mock.Anything, addr, mock.Anything, big.NewInt(1)).Return(types.NewTx(&types.LegacyTx{}), nil)
+	gc := newFeeConfig()
+	gc.priceMax = assets.GWei(50)
+	gc.bumpPercent = 10
+	gc.bumpMin = assets.GWei(5)
+	cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), gc, kst, nil)
+	lggr := logger.Test(t)
+	n := evmtypes.Nonce(0)
+	etx := txmgr.Tx{Sequence: &n, FromAddress: addr}
+
+	t.Run("raises legacy fee to the minimum bump", func(t *testing.T) {
+		prev := txmgr.TxAttempt{TxType: 0x0, TxFee: gas.EvmFee{Legacy: assets.GWei(20)}}
+		a, fee, _, err := cks.NewCustomBumpTxAttempt(tests.Context(t), etx, prev, gas.EvmFee{Legacy: assets.GWei(21)}, 100, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(25).String(), fee.Legacy.String())
+		assert.Equal(t, assets.GWei(25).String(), a.TxFee.Legacy.String())
+	})
+
+	t.Run("keeps legacy fee above the minimum bump", func(t *testing.T) {
+		prev := txmgr.TxAttempt{TxType: 0x0, TxFee: gas.EvmFee{Legacy: assets.GWei(20)}}
+		_, fee, _, err := cks.NewCustomBumpTxAttempt(tests.Context(t), etx, prev, gas.EvmFee{Legacy: assets.GWei(40)}, 100, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(40).String(), fee.Legacy.String())
+	})
+
+	t.Run("rejects legacy fee above the max gas price", func(t *testing.T) {
+		prev := txmgr.TxAttempt{TxType: 0x0, TxFee: gas.EvmFee{Legacy: assets.GWei(20)}}
+		_, _, _, err := cks.NewCustomBumpTxAttempt(tests.Context(t), etx, prev, gas.EvmFee{Legacy: assets.GWei(60)}, 100, lggr)
+		require.ErrorIs(t, err, commonfee.ErrBumpFeeExceedsLimit)
+	})
+
+	t.Run("rejects legacy minimum bump above the max gas price", func(t *testing.T) {
+		prev := txmgr.TxAttempt{TxType: 0x0, TxFee: gas.EvmFee{Legacy: assets.GWei(48)}}
+		_, _, _, err := cks.NewCustomBumpTxAttempt(tests.Context(t), etx, prev, gas.EvmFee{Legacy: assets.GWei(49)}, 100, lggr)
+		require.ErrorIs(t, err, commonfee.ErrBumpFeeExceedsLimit)
+	})
+
+	t.Run("raises dynamic fee to the minimum bump", func(t *testing.T) {
+		prev := txmgr.TxAttempt{TxType: 0x2, TxFee: gas.EvmFee{DynamicTipCap: assets.GWei(2), DynamicFeeCap: assets.GWei(30)}}
+		_, fee, _, err := cks.NewCustomBumpTxAttempt(tests.Context(t), etx, prev, gas.EvmFee{DynamicTipCap: assets.GWei(3), DynamicFeeCap: assets.GWei(40)}, 100, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(7).String(), fee.DynamicTipCap.String())
+		assert.Equal(t, assets.GWei(40).String(), fee.DynamicFeeCap.String())
+	})
+
+	t.Run("rejects fee of another tx type", func(t *testing.T) {
+		prev := txmgr.TxAttempt{TxType: 0x2, TxFee: gas.EvmFee{DynamicTipCap: assets.GWei(2), DynamicFeeCap: assets.GWei(30)}}
+		_, _, _, err := cks.NewCustomBumpTxAttempt(tests.Context(t), etx, prev, gas.EvmFee{Legacy: assets.GWei(40)}, 100, lggr)
+		require.ErrorContains(t, err, "custom bumped fee is not a dynamic fee")
+	})
+}
+
 func TestTxm_EvmTxAttemptBuilder_RetryableEstimatorError(t *testing.T) {
 	est := gasmocks.NewEvmFeeEstimator(t)
 	est.On("GetFee", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(gas.EvmFee{}, uint64(0), pkgerrors.New("fail"))
@@ -363,3 +432,196 @@ func TestTxm_EvmTxAttemptBuilder_RetryableEstimatorError(t *testing.T) {
 		assert.True(t, retryable)
 	})
 }
+
+type profiledFeeEstimator struct {
+	gas.EvmFeeEstimator
+	profiles map[string]gas.EvmFeeEstimator
+}
+
+func (e *profiledFeeEstimator) Profile(name string) (gas.EvmFeeEstimator, evmconfig.GasEstimator, bool) {
+	p, ok := e.profiles[name]
+	return p, &txmgr.TestGasEstimatorConfig{}, ok
+}
+
+func TestTxm_EvmTxAttemptBuilder_GasEstimatorProfile(t *testing.T) {
+	defaultEst := gasmocks.NewEvmFeeEstimator(t)
+	profileEst := gasmocks.NewEvmFeeEstimator(t)
+	est := &profiledFeeEstimator{EvmFeeEstimator: defaultEst, profiles: map[string]gas.EvmFeeEstimator{"ccip-exec": profileEst}}
+
+	kst := ksmocks.NewEth(t)
+	lggr := logger.Test(t)
+	ctx := tests.Context(t)
+	cfg := newFeeConfig()
+	cfg.priceMax = assets.NewWeiI(100)
+	cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), cfg, kst, est)
+
+	newTx := func(t *testing.T, profile string) txmgr.Tx {
+		etx := txmgr.Tx{}
+		if profile != "" {
+			meta := sqlutil.JSON(fmt.Sprintf(`{"GasEstimatorProfile":"%s"}`, profile))
+			etx.Meta = &meta
+		}
+		return etx
+	}
+
+	t.Run("uses the estimator and price max of the referenced profile", func(t *testing.T) {
+		profileEst.On("GetFee", mock.Anything, mock.Anything, mock.Anything, assets.NewWeiI(42), mock.Anything, mock.Anything).Return(gas.EvmFee{}, uint64(0), pkgerrors.New("profile")).Once()
+		_, _, _, _, err := cks.NewTxAttempt(ctx, newTx(t, "ccip-exec"), lggr)
+		require.ErrorContains(t, err, "profile")
+	})
+
+	t.Run("falls back to the default estimator", func(t *testing.T) {
+		defaultEst.On("GetFee", mock.Anything, mock.Anything, mock.Anything, assets.NewWeiI(100), mock.Anything, mock.Anything).Return(gas.EvmFee{}, uint64(0), pkgerrors.New("default")).Twice()
+		_, _, _, _, err := cks.NewTxAttempt(ctx, newTx(t, ""), lggr)
+		require.ErrorContains(t, err, "default")
+		_, _, _, _, err = cks.NewTxAttempt(ctx, newTx(t, "unknown"), lggr)
+		require.ErrorContains(t, err, "default")
+	})
+}
+
+func TestTxm_EvmTxAttemptBuilder_PrefetchFeeLimits(t *testing.T) {
+	defaultEst := gasmocks.NewEvmFeeEstimator(t)
+	profileEst := gasmocks.NewEvmFeeEstimator(t)
+	est := &profiledFeeEstimator{EvmFeeEstimator: defaultEst, profiles: map[string]gas.EvmFeeEstimator{"fast": profileEst}}
+
+	kst := ksmocks.NewEth(t)
+	lggr := logger.Test(t)
+	ctx := tests.Context(t)
+	cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), newFeeConfig(), kst, est)
+
+	from, to := NewEvmAddress(), NewEvmAddress()
+	newTx := func(payload byte, meta string) *txmgr.Tx {
+		etx := &txmgr.Tx{FromAddress: from, ToAddress: to, EncodedPayload: []byte{payload}, FeeLimit: 100}
+		if meta != "" {
+			m := sqlutil.JSON(meta)
+			etx.Meta = &m
+		}
+		return etx
+	}
+	blobTx := newTx(4, "")
+	blobTx.BlobSidecar = []byte{1}
+	etxs := []*txmgr.Tx{
+		newTx(1, ""),
+		newTx(2, `{"GasEstimatorProfile":"fast"}`),
+		newTx(3, `{"MessageIDs":["0x01"]}`),
+		blobTx,
+		newTx(5, ""),
+	}
+
+	call := func(payload byte) gas.FeeLimitCall {
+		return gas.FeeLimitCall{Calldata: []byte{payload}, FeeLimit: 100, FromAddress: &from, ToAddress: &to}
+	}
+	defaultEst.On("EstimateFeeLimits", mock.Anything, []gas.FeeLimitCall{call(1), call(5)}).Return([]uint64{50, 0}, []error{nil, pkgerrors.New("fail")}).Once()
+	profileEst.On("EstimateFeeLimits", mock.Anything, []gas.FeeLimitCall{call(2)}).Return([]uint64{50}, []error{nil}).Once()
+
+	// CCIP exec and blob txs are not estimated
+	cks.PrefetchFeeLimits(ctx, etxs, lggr)
+}
+
+func TestTxm_EvmTxAttemptBuilder_BlobTx(t *testing.T) {
+	addr := NewEvmAddress()
+	kst := ksmocks.NewEth(t)
+	kst.On("SignTx", mock.Anything, addr, mock.Anything, big.NewInt(1)).Return(
+		func(_ context.Context, _ gethcommon.Address, tx *types.Transaction, _ *big.Int) (*types.Transaction, error) {
+			return tx, nil
+		})
+	est := gasmocks.NewEvmFeeEstimator(t)
+	lggr := logger.Test(t)
+	ctx := tests.Context(t)
+
+	cfg := newFeeConfig()
+	cfg.priceMax = assets.GWei(100)
+	cfg.blobPriceMax = assets.GWei(10)
+	cfg.limitDefault = 21000
+	cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), cfg, kst, est)
+
+	sidecar := &types.BlobTxSidecar{
+		Blobs:       []kzg4844.Blob{{}},
+		Commitments: []kzg4844.Commitment{{}},
+		Proofs:      []kzg4844.Proof{{}},
+	}
+	encodedSidecar, err := txmgr.EncodeBlobSidecar(sidecar)
+	require.NoError(t, err)
+	var n evmtypes.Nonce
+	etx := txmgr.Tx{Sequence: &n, FromAddress: addr, EncodedPayload: []byte{1, 2, 3}, BlobSidecar: encodedSidecar}
+	fee := gas.EvmFee{DynamicFeeCap: assets.GWei(10), DynamicTipCap: assets.GWei(1)}
+
+	t.Run("creates a blob attempt for a tx carrying blobs", func(t *testing.T) {
+		est.On("GetFee", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(fee, uint64(100), nil).Once()
+		est.On("GetBlobFee", mock.Anything, assets.GWei(10)).Return(assets.GWei(2), nil).Once()
+
+		a, _, _, _, err := cks.NewTxAttempt(ctx, etx, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, types.BlobTxType, a.TxType)
+		signed, err := txmgr.GetGethSignedTx(a.SignedRawTx)
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(2).ToInt(), signed.BlobGasFeeCap())
+		assert.Equal(t, sidecar.BlobHashes(), signed.BlobHashes())
+		require.NotNil(t, signed.BlobTxSidecar())
+
+		t.Run("bumps all fee caps by at least 100%", func(t *testing.T) {
+			est.On("BumpFee", mock.Anything, fee, uint64(100), mock.Anything, mock.Anything).Return(gas.EvmFee{DynamicFeeCap: assets.GWei(11), DynamicTipCap: assets.GWei(1)}, uint64(100), nil).Once()
+			est.On("BumpBlobFee", mock.Anything, assets.GWei(2), assets.GWei(10)).Return(assets.GWei(4), nil).Once()
+
+			bumped, bumpedFee, _, _, err := cks.NewBumpTxAttempt(ctx, etx, a, nil, lggr)
+			require.NoError(t, err)
+			assert.Equal(t, types.BlobTxType, bumped.TxType)
+			assert.Equal(t, assets.GWei(20).String(), bumpedFee.DynamicFeeCap.String())
+			assert.Equal(t, assets.GWei(2).String(), bumpedFee.DynamicTipCap.String())
+			signed, err := txmgr.GetGethSignedTx(bumped.SignedRawTx)
+			require.NoError(t, err)
+			assert.Equal(t, assets.GWei(4).ToInt(), signed.BlobGasFeeCap())
+			assert.Equal(t, sidecar.BlobHashes(), signed.BlobHashes())
+		})
+
+		t.Run("bumps with a custom fee", func(t *testing.T) {
+			est.On("BumpBlobFee", mock.Anything, assets.GWei(2), assets.GWei(10)).Return(assets.GWei(4), nil).Once()
+
+			bumped, bumpedFee, _, err := cks.NewCustomBumpTxAttempt(ctx, etx, a, gas.EvmFee{DynamicFeeCap: assets.GWei(30), DynamicTipCap: assets.GWei(3)}, 100, lggr)
+			require.NoError(t, err)
+			assert.Equal(t, types.BlobTxType, bumped.TxType)
+			assert.Equal(t, assets.GWei(30).String(), bumpedFee.DynamicFeeCap.String())
+			assert.Equal(t, assets.GWei(3).String(), bumpedFee.DynamicTipCap.String())
+		})
+
+		t.Run("purges with a blob attempt", func(t *testing.T) {
+			est.On("BumpFee", mock.Anything, fee, mock.Anything, mock.Anything, mock.Anything).Return(gas.EvmFee{DynamicFeeCap: assets.GWei(20), DynamicTipCap: assets.GWei(2)}, uint64(100), nil).Once()
+			est.On("BumpBlobFee", mock.Anything, assets.GWei(2), assets.GWei(10)).Return(assets.GWei(4), nil).Once()
+
+			purgeTx := etx
+			purgeTx.TxAttempts = []txmgr.TxAttempt{a}
+			purge, err := cks.NewPurgeTxAttempt(ctx, purgeTx, lggr)
+			require.NoError(t, err)
+			assert.True(t, purge.IsPurgeAttempt)
+			assert.Equal(t, types.BlobTxType, purge.TxType)
+			assert.Equal(t, uint64(21000), purge.ChainSpecificFeeLimit)
+			signed, err := txmgr.GetGethSignedTx(purge.SignedRawTx)
+			require.NoError(t, err)
+			assert.Empty(t, signed.Data())
+			assert.Equal(t, sidecar.BlobHashes(), signed.BlobHashes())
+		})
+
+		t.Run("fails if the bumped fee cap exceeds the max gas price", func(t *testing.T) {
+			est.On("BumpFee", mock.Anything, fee, uint64(100), mock.Anything, mock.Anything).Return(gas.EvmFee{DynamicFeeCap: assets.GWei(101), DynamicTipCap: assets.GWei(1)}, uint64(100), nil).Once()
+
+			_, _, _, _, err := cks.NewBumpTxAttempt(ctx, etx, a, nil, lggr)
+			require.ErrorIs(t, err, commonfee.ErrBumpFeeExceedsLimit)
+		})
+	})
+
+	t.Run("requires dynamic fees", func(t *testing.T) {
+		est.On("GetFee", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(gas.EvmFee{Legacy: assets.GWei(10)}, uint64(100), nil).Once()
+
+		_, _, _, retryable, err := cks.NewTxAttempt(ctx, etx, lggr)
+		require.ErrorContains(t, err, "require EIP-1559 dynamic fees")
+		assert.False(t, retryable)
+	})
+
+	t.Run("fails if the blob fee exceeds the max blob price", func(t *testing.T) {
+		est.On("GetFee", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(fee, uint64(100), nil).Once()
+		est.On("GetBlobFee", mock.Anything, assets.GWei(10)).Return(assets.GWei(11), nil).Once()
+
+		_, _, _, _, err := cks.NewTxAttempt(ctx, etx, lggr)
+		require.ErrorContains(t, err, "would exceed max configured blob price")
+	})
+}
@@ -1721,6 +1721,78 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_GasEstimationError(t *testing.T)
 	})
 }
 
+func TestEthBroadcaster_ProcessUnstartedEthTxs_CircuitBreaker(t *testing.T) {
+	toAddress := testutils.NewAddress()
+	value := big.Int(assets.NewEthValue(142))
+	gasLimit := uint64(242)
+	encodedPayload := []byte{0, 1}
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+	ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+	ethClient.On("PendingNonceAt", mock.Anything, fromAddress).Return(uint64(0), nil).Once()
+	lggr := logger.Test(t)
+	nonceTracker := txmgr.NewNonceTracker(lggr, txStore, txmgr.NewEvmTxmClient(ethClient, nil))
+	eb := NewTestEthBroadcaster(t, txStore, ethClient, ethKeyStore, cfg, evmcfg, &testCheckerFactory{}, false, nonceTracker)
+	cb := txmgrcommon.NewCircuitBreaker(lggr, testutils.FixtureChainID.String(), testCircuitBreakerConfig{
+		broadcastFailureThreshold: 1,
+		receiptFailureThreshold:   1,
+		resetTimeout:              time.Hour,
+	})
+	eb.SetCircuitBreaker(cb)
+	ctx := tests.Context(t)
+
+	nonce := func(n uint64) interface{} {
+		return mock.MatchedBy(func(tx *gethTypes.Transaction) bool { return tx.Nonce() == n })
+	}
+
+	etx1 := mustCreateUnstartedTx(t, txStore, fromAddress, toAddress, encodedPayload, gasLimit, value, testutils.FixtureChainID)
+	ethClient.On("SendTransactionReturnCode", mock.Anything, nonce(0), fromAddress).Return(commonclient.Retryable, errors.New("connection reset")).Once()
+
+	retryable, err := eb.ProcessUnstartedTxs(ctx, fromAddress)
+	require.Error(t, err)
+	assert.True(t, retryable)
+	assert.Equal(t, txmgrcommon.CircuitBreakerOpen, cb.Status().State)
+
+	// The in progress transaction is still retried, but no new transaction is broadcast
+	etx2 := mustCreateUnstartedTx(t, txStore, fromAddress, toAddress, encodedPayload, gasLimit, value, testutils.FixtureChainID)
+	ethClient.On("SendTransactionReturnCode", mock.Anything, nonce(0), fromAddress).Return(commonclient.Successful, nil).Once()
+
+	retryable, err = eb.ProcessUnstartedTxs(ctx, fromAddress)
+	require.ErrorIs(t, err, txmgrcommon.ErrCircuitBreakerOpen)
+	assert.True(t, retryable)
+
+	dbEtx, err := txStore.FindTxWithAttempts(ctx, etx1.ID)
+	require.NoError(t, err)
+	assert.Equal(t, txmgrcommon.TxUnconfirmed, dbEtx.State)
+	dbEtx, err = txStore.FindTxWithAttempts(ctx, etx2.ID)
+	require.NoError(t, err)
+	assert.Equal(t, txmgrcommon.TxUnstarted, dbEtx.State)
+
+	cb.Reset()
+	ethClient.On("SendTransactionReturnCode", mock.Anything, nonce(1), fromAddress).Return(commonclient.Successful, nil).Once()
+
+	retryable, err = eb.ProcessUnstartedTxs(ctx, fromAddress)
+	require.NoError(t, err)
+	assert.False(t, retryable)
+
+	dbEtx, err = txStore.FindTxWithAttempts(ctx, etx2.ID)
+	require.NoError(t, err)
+	assert.Equal(t, txmgrcommon.TxUnconfirmed, dbEtx.State)
+
+	// Keys without unstarted transactions do not ask the breaker, so they neither fail nor use up its probe
+	cb.RecordBroadcastFailure(errors.New("connection reset"))
+	require.Equal(t, txmgrcommon.CircuitBreakerOpen, cb.Status().State)
+	retryable, err = eb.ProcessUnstartedTxs(ctx, fromAddress)
+	require.NoError(t, err)
+	assert.False(t, retryable)
+}
+
 func TestEthBroadcaster_ProcessUnstartedEthTxs_KeystoreErrors(t *testing.T) {
 	toAddress := gethCommon.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411")
 	value := big.Int(assets.NewEthValue(142))
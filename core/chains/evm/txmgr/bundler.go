@@ -0,0 +1,389 @@
+package txmgr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
+)
+
+// bundleGasOverhead is added on top of the summed gas limits of the bundled calls to pay for the
+// multicall dispatch itself and the calldata growth.
+const bundleGasOverhead = 30_000
+
+// multicall3ABI contains only the aggregate3 method of the canonical Multicall3 contract.
+const multicall3ABI = `[{"inputs":[{"components":[{"internalType":"address","name":"target","type":"address"},{"internalType":"bool","name":"allowFailure","type":"bool"},{"internalType":"bytes","name":"callData","type":"bytes"}],"internalType":"struct Multicall3.Call3[]","name":"calls","type":"tuple[]"}],"name":"aggregate3","outputs":[{"components":[{"internalType":"bool","name":"success","type":"bool"},{"internalType":"bytes","name":"returnData","type":"bytes"}],"internalType":"struct Multicall3.Result[]","name":"returnData","type":"tuple[]"}],"stateMutability":"payable","type":"function"}]`
+
+// Multicall3 is the parsed ABI of the aggregate3 method of the canonical Multicall3 contract.
+var Multicall3 = func() abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(multicall3ABI))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}()
+
+// Multicall3Call is a call of aggregate3.
+type Multicall3Call struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+var errBundlerStopped = errors.New("tx bundler stopped")
+
+type bundleResult struct {
+	tx  Tx
+	err error
+}
+
+type bundleRequest struct {
+	// ctx is the one of the caller, requests whose caller gave up are dropped from the bundle
+	ctx    context.Context
+	req    TxRequest
+	respCh chan bundleResult
+}
+
+type bundlerTxStore interface {
+	FindBundleIdempotencyKey(ctx context.Context, idempotencyKey string) (bundleKey *string, err error)
+}
+
+// Bundler wraps a TxManager and aggregates compatible transactions sent from the same address
+// into a single Multicall3 aggregate3 call, reducing the number of eth_sendRawTransaction calls and
+// the per-tx base cost. Requests that are not explicitly marked with AllowBundling are passed through.
+// The calls of a bundle are not allowed to fail individually: if any of them reverts, the whole bundle
+// reverts and every bundled tx fails along with it.
+type Bundler struct {
+	TxManager
+	services.StateMachine
+	lggr    logger.SugaredLogger
+	cfg     config.BundlerConfig
+	txStore bundlerTxStore
+
+	mu      sync.Mutex
+	pending map[common.Address][]bundleRequest
+	// flushMu serializes the flushes, so that the idempotency keys bundled by a flush are persisted before the next
+	// one looks them up
+	flushMu sync.Mutex
+
+	stopCh services.StopChan
+	wg     sync.WaitGroup
+}
+
+var _ TxManager = (*Bundler)(nil)
+
+// NewBundler returns a TxManager that bundles compatible txs before passing them to txm.
+// The idempotency keys of bundled requests are persisted along with their bundle, and looked up in txStore.
+func NewBundler(txm TxManager, txStore bundlerTxStore, cfg config.BundlerConfig, lggr logger.Logger) *Bundler {
+	return &Bundler{
+		TxManager: txm,
+		lggr:      logger.Sugared(logger.Named(lggr, "Bundler")),
+		cfg:       cfg,
+		txStore:   txStore,
+		pending:   make(map[common.Address][]bundleRequest),
+		stopCh:    make(chan struct{}),
+	}
+}
+
+// Start starts the underlying TxManager and the periodic flush loop
+func (b *Bundler) Start(ctx context.Context) error {
+	return b.StartOnce("Bundler", func() error {
+		if err := b.TxManager.Start(ctx); err != nil {
+			return err
+		}
+		b.lggr.Debugw("started Bundler", "multicallAddress", b.cfg.MulticallAddress(), "maxBatchSize", b.cfg.MaxBatchSize(), "flushInterval", b.cfg.FlushInterval())
+		b.wg.Add(1)
+		go b.runLoop()
+		return nil
+	})
+}
+
+// Close fails any pending requests and closes the underlying TxManager
+func (b *Bundler) Close() error {
+	return b.StopOnce("Bundler", func() error {
+		close(b.stopCh)
+		b.wg.Wait()
+
+		b.mu.Lock()
+		for from, reqs := range b.pending {
+			for _, r := range reqs {
+				r.respCh <- bundleResult{err: errBundlerStopped}
+			}
+			delete(b.pending, from)
+		}
+		b.mu.Unlock()
+
+		return b.TxManager.Close()
+	})
+}
+
+func (b *Bundler) Name() string {
+	return b.lggr.Name()
+}
+
+func (b *Bundler) Ready() error {
+	if err := b.StateMachine.Ready(); err != nil {
+		return err
+	}
+	return b.TxManager.Ready()
+}
+
+func (b *Bundler) HealthReport() map[string]error {
+	report := b.TxManager.HealthReport()
+	report[b.Name()] = b.Healthy()
+	return report
+}
+
+func (b *Bundler) runLoop() {
+	defer b.wg.Done()
+	ctx, cancel := b.stopCh.NewCtx()
+	defer cancel()
+	ticker := time.NewTicker(b.cfg.FlushInterval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			b.flushAll(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// CreateTransaction queues the request for bundling if it is eligible, otherwise it is passed
+// straight to the underlying TxManager. Bundled requests block until their bundle is created and
+// all of them receive the same bundle Tx, which only succeeds if every bundled call succeeds.
+func (b *Bundler) CreateTransaction(ctx context.Context, txRequest TxRequest) (Tx, error) {
+	if !bundleable(txRequest) {
+		return b.TxManager.CreateTransaction(ctx, txRequest)
+	}
+	if txRequest.IdempotencyKey != nil {
+		if tx, found, err := b.existingBundle(ctx, txRequest); found {
+			return tx, err
+		}
+	}
+
+	r := bundleRequest{ctx: ctx, req: txRequest, respCh: make(chan bundleResult, 1)}
+	var batch []bundleRequest
+	b.mu.Lock()
+	b.pending[txRequest.FromAddress] = append(b.pending[txRequest.FromAddress], r)
+	if len(b.pending[txRequest.FromAddress]) >= int(b.cfg.MaxBatchSize()) {
+		batch = b.pending[txRequest.FromAddress]
+		delete(b.pending, txRequest.FromAddress)
+	}
+	b.mu.Unlock()
+
+	if batch != nil {
+		// the bundle is shared by all requests of the batch, so it must not be tied to the ctx of this caller
+		flushCtx, cancel := b.stopCh.NewCtx()
+		b.flush(flushCtx, batch)
+		cancel()
+	}
+
+	select {
+	case res := <-r.respCh:
+		return res.tx, res.err
+	case <-ctx.Done():
+		return Tx{}, ctx.Err()
+	case <-b.stopCh:
+		return Tx{}, errBundlerStopped
+	}
+}
+
+func (b *Bundler) flushAll(ctx context.Context) {
+	b.mu.Lock()
+	batches := b.pending
+	b.pending = make(map[common.Address][]bundleRequest)
+	b.mu.Unlock()
+
+	for _, batch := range batches {
+		b.flush(ctx, batch)
+	}
+}
+
+// existingBundle returns the bundle a request with the same idempotency key was already aggregated into, if any.
+// found is also true if the lookup failed, as the request must not be bundled again in that case.
+func (b *Bundler) existingBundle(ctx context.Context, req TxRequest) (tx Tx, found bool, err error) {
+	bundleKey, err := b.txStore.FindBundleIdempotencyKey(ctx, *req.IdempotencyKey)
+	if err != nil {
+		return Tx{}, true, fmt.Errorf("failed to look up bundle of idempotency key %s: %w", *req.IdempotencyKey, err)
+	}
+	if bundleKey == nil {
+		return Tx{}, false, nil
+	}
+	// the underlying TxManager returns the existing bundle for its idempotency key
+	req.IdempotencyKey = bundleKey
+	tx, err = b.TxManager.CreateTransaction(ctx, req)
+	return tx, true, err
+}
+
+func (b *Bundler) flush(ctx context.Context, batch []bundleRequest) {
+	b.flushMu.Lock()
+	defer b.flushMu.Unlock()
+	// Do not send requests whose caller already gave up, it received an error and may retry them
+	batch = slices.DeleteFunc(batch, func(r bundleRequest) bool {
+		return r.ctx.Err() != nil
+	})
+	// Requests retried after an earlier flush bundled them receive that bundle, rather than failing the whole batch on
+	// the idempotency key already persisted
+	batch = slices.DeleteFunc(batch, func(r bundleRequest) bool {
+		if r.req.IdempotencyKey == nil {
+			return false
+		}
+		tx, found, err := b.existingBundle(ctx, r.req)
+		if found {
+			r.respCh <- bundleResult{tx: tx, err: err}
+		}
+		return found
+	})
+	// Requests retried with the same idempotency key before the bundle was created are only bundled once,
+	// and receive the same bundle
+	var duplicates []bundleRequest
+	seen := make(map[string]struct{})
+	batch = slices.DeleteFunc(batch, func(r bundleRequest) bool {
+		if r.req.IdempotencyKey == nil {
+			return false
+		}
+		if _, ok := seen[*r.req.IdempotencyKey]; ok {
+			duplicates = append(duplicates, r)
+			return true
+		}
+		seen[*r.req.IdempotencyKey] = struct{}{}
+		return false
+	})
+	switch len(batch) {
+	case 0:
+		return
+	case 1:
+		tx, err := b.TxManager.CreateTransaction(ctx, batch[0].req)
+		for _, r := range append(batch, duplicates...) {
+			r.respCh <- bundleResult{tx: tx, err: err}
+		}
+		return
+	default:
+	}
+
+	req, err := b.buildBundle(batch)
+	var tx Tx
+	if err == nil {
+		tx, err = b.TxManager.CreateTransaction(ctx, req)
+	}
+	if err != nil {
+		b.lggr.Errorw("Failed to create bundled transaction", "fromAddress", req.FromAddress, "size", len(batch), "err", err)
+	} else {
+		b.lggr.Debugw("Created bundled transaction", "fromAddress", req.FromAddress, "size", len(batch), "txID", tx.ID)
+	}
+	for _, r := range append(batch, duplicates...) {
+		r.respCh <- bundleResult{tx: tx, err: err}
+	}
+}
+
+// GetTransactionStatus returns the status of the bundle for bundled requests, and passes through the others.
+// Bundled requests can be looked up until their bundle is reaped.
+func (b *Bundler) GetTransactionStatus(ctx context.Context, transactionID string) (commontypes.TransactionStatus, error) {
+	bundleKey, err := b.txStore.FindBundleIdempotencyKey(ctx, transactionID)
+	if err != nil {
+		return commontypes.Unknown, fmt.Errorf("failed to look up bundle of idempotency key %s: %w", transactionID, err)
+	}
+	if bundleKey == nil {
+		return b.TxManager.GetTransactionStatus(ctx, transactionID)
+	}
+	return b.TxManager.GetTransactionStatus(ctx, *bundleKey)
+}
+
+// buildBundle combines the requests into a single aggregate3 call to the configured multicall contract
+func (b *Bundler) buildBundle(batch []bundleRequest) (TxRequest, error) {
+	calls := make([]Multicall3Call, len(batch))
+	meta := &TxMeta{}
+	var feeLimit uint64 = bundleGasOverhead
+	var minConfirmations uint32
+	var jobID *int32
+	sharedJobID := true
+	var profile *string
+	sharedProfile := true
+	var idempotencyKeys []string
+	for i, r := range batch {
+		calls[i] = Multicall3Call{Target: r.req.ToAddress, CallData: r.req.EncodedPayload}
+		if r.req.IdempotencyKey != nil {
+			idempotencyKeys = append(idempotencyKeys, *r.req.IdempotencyKey)
+		}
+		feeLimit += r.req.FeeLimit
+		if r.req.MinConfirmations.Valid && r.req.MinConfirmations.Uint32 > minConfirmations {
+			minConfirmations = r.req.MinConfirmations.Uint32
+		}
+		m := r.req.Meta
+		if m == nil || m.JobID == nil || (jobID != nil && *jobID != *m.JobID) {
+			sharedJobID = false
+		} else {
+			jobID = m.JobID
+		}
+		if m == nil || m.GasEstimatorProfile == nil || (profile != nil && *profile != *m.GasEstimatorProfile) {
+			sharedProfile = false
+		} else {
+			profile = m.GasEstimatorProfile
+		}
+		if m != nil {
+			meta.MessageIDs = append(meta.MessageIDs, m.MessageIDs...)
+			meta.SeqNumbers = append(meta.SeqNumbers, m.SeqNumbers...)
+		}
+	}
+	// Only attribute the bundle to a job if every bundled tx belongs to it
+	if sharedJobID {
+		meta.JobID = jobID
+	}
+	// Likewise only price the bundle with a gas estimator profile if every bundled tx references it
+	if sharedProfile {
+		meta.GasEstimatorProfile = profile
+	}
+
+	payload, err := Multicall3.Pack("aggregate3", calls)
+	if err != nil {
+		return TxRequest{}, fmt.Errorf("failed to encode aggregate3 call: %w", err)
+	}
+
+	req := TxRequest{
+		FromAddress:    batch[0].req.FromAddress,
+		ToAddress:      b.cfg.MulticallAddress(),
+		EncodedPayload: payload,
+		FeeLimit:       feeLimit,
+		Meta:           meta,
+		Strategy:       txmgr.NewSendEveryStrategy(),
+	}
+	if minConfirmations > 0 {
+		req.MinConfirmations.SetValid(minConfirmations)
+	}
+	// The status of bundled requests is looked up by their idempotency key, which is mapped to the one of the bundle.
+	// The keys are persisted in the same DB transaction as the bundle, so that no request is ever bundled twice.
+	if len(idempotencyKeys) > 0 {
+		bundleKey := uuid.New().String()
+		req.IdempotencyKey = &bundleKey
+		req.BundledIdempotencyKeys = idempotencyKeys
+	}
+	return req, nil
+}
+
+// bundleable returns true if the request opted into bundling and carries nothing that a multicall
+// cannot preserve.
+func bundleable(req TxRequest) bool {
+	return req.AllowBundling &&
+		req.Value.Sign() == 0 &&
+		req.ForwarderAddress == (common.Address{}) &&
+		req.PipelineTaskRunID == nil &&
+		req.Checker.CheckerType == "" &&
+		!req.SignalCallback &&
+		len(req.BlobSidecar) == 0
+}
@@ -0,0 +1,354 @@
+package txmgr_test
+
+import (
+	"context"
+	"math/big"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
+)
+
+type testBundlerConfig struct {
+	multicall     common.Address
+	maxBatchSize  uint32
+	flushInterval time.Duration
+}
+
+func (c *testBundlerConfig) Enabled() bool                    { return true }
+func (c *testBundlerConfig) MulticallAddress() common.Address { return c.multicall }
+func (c *testBundlerConfig) MaxBatchSize() uint32             { return c.maxBatchSize }
+func (c *testBundlerConfig) FlushInterval() time.Duration     { return c.flushInterval }
+
+// testBundlerTxStore keeps the bundled idempotency keys in memory, keyed by request and mapped to the bundle key
+type testBundlerTxStore struct {
+	mu   sync.Mutex
+	keys map[string]string
+	// lookups receives the looked up idempotency keys, if set
+	lookups chan string
+}
+
+func newTestBundlerTxStore() *testBundlerTxStore {
+	return &testBundlerTxStore{keys: map[string]string{}}
+}
+
+// createTransaction persists the bundled idempotency keys of the request like the txstore does
+func (s *testBundlerTxStore) createTransaction(req txmgr.TxRequest) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, k := range req.BundledIdempotencyKeys {
+		s.keys[k] = *req.IdempotencyKey
+	}
+}
+
+func (s *testBundlerTxStore) FindBundleIdempotencyKey(_ context.Context, idempotencyKey string) (*string, error) {
+	if s.lookups != nil {
+		s.lookups <- idempotencyKey
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	bundleKey, ok := s.keys[idempotencyKey]
+	if !ok {
+		return nil, nil
+	}
+	return &bundleKey, nil
+}
+
+func newTestBundler(t *testing.T, txm txmgr.TxManager, txStore *testBundlerTxStore, maxBatchSize uint32, flushInterval time.Duration) (*txmgr.Bundler, common.Address) {
+	multicall := testutils.NewAddress()
+	return txmgr.NewBundler(txm, txStore, &testBundlerConfig{multicall: multicall, maxBatchSize: maxBatchSize, flushInterval: flushInterval}, logger.Test(t)), multicall
+}
+
+func TestBundler_CreateTransaction(t *testing.T) {
+	t.Parallel()
+
+	fromAddress := testutils.NewAddress()
+
+	t.Run("passes through requests that did not opt in", func(t *testing.T) {
+		txm := mocks.NewMockEvmTxManager(t)
+		b, _ := newTestBundler(t, txm, newTestBundlerTxStore(), 2, time.Hour)
+
+		req := txmgr.TxRequest{FromAddress: fromAddress, ToAddress: testutils.NewAddress(), FeeLimit: 1000}
+		txm.On("CreateTransaction", mock.Anything, req).Return(txmgr.Tx{ID: 1}, nil).Once()
+
+		tx, err := b.CreateTransaction(tests.Context(t), req)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), tx.ID)
+	})
+
+	t.Run("passes through requests carrying value", func(t *testing.T) {
+		txm := mocks.NewMockEvmTxManager(t)
+		b, _ := newTestBundler(t, txm, newTestBundlerTxStore(), 2, time.Hour)
+
+		req := txmgr.TxRequest{FromAddress: fromAddress, ToAddress: testutils.NewAddress(), Value: *big.NewInt(1), AllowBundling: true}
+		txm.On("CreateTransaction", mock.Anything, req).Return(txmgr.Tx{ID: 2}, nil).Once()
+
+		tx, err := b.CreateTransaction(tests.Context(t), req)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), tx.ID)
+	})
+
+	t.Run("bundles requests once max batch size is reached", func(t *testing.T) {
+		txm := mocks.NewMockEvmTxManager(t)
+		b, multicall := newTestBundler(t, txm, newTestBundlerTxStore(), 2, time.Hour)
+
+		jobID := int32(7)
+		txm.On("CreateTransaction", mock.Anything, mock.MatchedBy(func(req txmgr.TxRequest) bool {
+			calls := unpackAggregate3(t, req.EncodedPayload)
+			return req.ToAddress == multicall &&
+				len(calls) == 2 && !calls[0].AllowFailure && !calls[1].AllowFailure &&
+				req.IdempotencyKey == nil &&
+				req.FromAddress == fromAddress &&
+				req.FeeLimit == 1000+2000+30_000 &&
+				req.Meta != nil && req.Meta.JobID != nil && *req.Meta.JobID == jobID &&
+				assert.ElementsMatch(t, []uint64{1, 2}, req.Meta.SeqNumbers)
+		})).Return(txmgr.Tx{ID: 3}, nil).Once()
+
+		var wg sync.WaitGroup
+		for i, feeLimit := range []uint64{1000, 2000} {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				tx, err := b.CreateTransaction(tests.Context(t), txmgr.TxRequest{
+					FromAddress:    fromAddress,
+					ToAddress:      testutils.NewAddress(),
+					EncodedPayload: []byte{1, 2, 3},
+					FeeLimit:       feeLimit,
+					Meta:           &txmgr.TxMeta{JobID: &jobID, SeqNumbers: []uint64{uint64(i + 1)}},
+					AllowBundling:  true,
+				})
+				assert.NoError(t, err)
+				assert.Equal(t, int64(3), tx.ID)
+			}()
+		}
+		wg.Wait()
+	})
+
+	t.Run("flushes a single pending request unbundled on interval", func(t *testing.T) {
+		txm := mocks.NewMockEvmTxManager(t)
+		b, _ := newTestBundler(t, txm, newTestBundlerTxStore(), 10, 10*time.Millisecond)
+
+		req := txmgr.TxRequest{FromAddress: fromAddress, ToAddress: testutils.NewAddress(), FeeLimit: 1000, AllowBundling: true}
+		txm.On("Start", mock.Anything).Return(nil).Once()
+		txm.On("Close").Return(nil).Once()
+		txm.On("CreateTransaction", mock.Anything, req).Return(txmgr.Tx{ID: 4}, nil).Once()
+
+		require.NoError(t, b.Start(tests.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, b.Close()) })
+
+		tx, err := b.CreateTransaction(tests.Context(t), req)
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), tx.ID)
+	})
+
+	t.Run("drops requests whose caller gave up", func(t *testing.T) {
+		txm := mocks.NewMockEvmTxManager(t)
+		b, _ := newTestBundler(t, txm, newTestBundlerTxStore(), 2, time.Hour)
+
+		cancelledCtx, cancel := context.WithCancel(tests.Context(t))
+		cancelled := txmgr.TxRequest{FromAddress: fromAddress, ToAddress: testutils.NewAddress(), FeeLimit: 1000, AllowBundling: true}
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			_, err := b.CreateTransaction(cancelledCtx, cancelled)
+			assert.ErrorIs(t, err, context.Canceled)
+		}()
+		cancel()
+		<-done
+
+		// only the live request is sent, unbundled
+		req := txmgr.TxRequest{FromAddress: fromAddress, ToAddress: testutils.NewAddress(), FeeLimit: 2000, AllowBundling: true}
+		txm.On("CreateTransaction", mock.Anything, req).Return(txmgr.Tx{ID: 6}, nil).Once()
+
+		tx, err := b.CreateTransaction(tests.Context(t), req)
+		require.NoError(t, err)
+		assert.Equal(t, int64(6), tx.ID)
+	})
+
+	t.Run("looks up the status of bundled requests by the bundle", func(t *testing.T) {
+		txm := mocks.NewMockEvmTxManager(t)
+		txStore := newTestBundlerTxStore()
+		b, _ := newTestBundler(t, txm, txStore, 2, time.Hour)
+
+		var bundleKey string
+		txm.On("CreateTransaction", mock.Anything, mock.MatchedBy(func(req txmgr.TxRequest) bool {
+			return req.IdempotencyKey != nil && sameKeys(req.BundledIdempotencyKeys, "write-1", "write-2")
+		})).Run(func(args mock.Arguments) {
+			req := args.Get(1).(txmgr.TxRequest)
+			bundleKey = *req.IdempotencyKey
+			txStore.createTransaction(req)
+		}).Return(txmgr.Tx{ID: 5}, nil).Once()
+
+		var wg sync.WaitGroup
+		for _, key := range []string{"write-1", "write-2"} {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := b.CreateTransaction(tests.Context(t), txmgr.TxRequest{
+					FromAddress:    fromAddress,
+					ToAddress:      testutils.NewAddress(),
+					IdempotencyKey: &key,
+					AllowBundling:  true,
+				})
+				assert.NoError(t, err)
+			}()
+		}
+		wg.Wait()
+		require.NotEmpty(t, bundleKey)
+		assert.NotEqual(t, "write-1", bundleKey)
+		assert.NotEqual(t, "write-2", bundleKey)
+
+		txm.On("GetTransactionStatus", mock.Anything, bundleKey).Return(commontypes.Unconfirmed, nil).Once()
+		status, err := b.GetTransactionStatus(tests.Context(t), "write-1")
+		require.NoError(t, err)
+		assert.Equal(t, commontypes.Unconfirmed, status)
+
+		txm.On("GetTransactionStatus", mock.Anything, bundleKey).Return(commontypes.Finalized, nil).Twice()
+		status, err = b.GetTransactionStatus(tests.Context(t), "write-2")
+		require.NoError(t, err)
+		assert.Equal(t, commontypes.Finalized, status)
+
+		// the mapping is persisted, so it survives a restart
+		restarted, _ := newTestBundler(t, txm, txStore, 2, time.Hour)
+		status, err = restarted.GetTransactionStatus(tests.Context(t), "write-1")
+		require.NoError(t, err)
+		assert.Equal(t, commontypes.Finalized, status)
+
+		// requests already bundled return the bundle instead of being bundled again
+		txm.On("CreateTransaction", mock.Anything, mock.MatchedBy(func(req txmgr.TxRequest) bool {
+			return req.IdempotencyKey != nil && *req.IdempotencyKey == bundleKey
+		})).Return(txmgr.Tx{ID: 5}, nil).Once()
+		key := "write-1"
+		tx, err := restarted.CreateTransaction(tests.Context(t), txmgr.TxRequest{FromAddress: fromAddress, ToAddress: testutils.NewAddress(), IdempotencyKey: &key, AllowBundling: true})
+		require.NoError(t, err)
+		assert.Equal(t, int64(5), tx.ID)
+
+		txm.On("GetTransactionStatus", mock.Anything, "other").Return(commontypes.Pending, nil).Once()
+		status, err = b.GetTransactionStatus(tests.Context(t), "other")
+		require.NoError(t, err)
+		assert.Equal(t, commontypes.Pending, status)
+	})
+
+	t.Run("bundles requests retried with the same idempotency key once", func(t *testing.T) {
+		txm := mocks.NewMockEvmTxManager(t)
+		b, _ := newTestBundler(t, txm, newTestBundlerTxStore(), 3, time.Hour)
+
+		txm.On("CreateTransaction", mock.Anything, mock.MatchedBy(func(req txmgr.TxRequest) bool {
+			return len(unpackAggregate3(t, req.EncodedPayload)) == 2 && sameKeys(req.BundledIdempotencyKeys, "write-1", "write-2")
+		})).Return(txmgr.Tx{ID: 7}, nil).Once()
+
+		var wg sync.WaitGroup
+		for _, key := range []string{"write-1", "write-2", "write-1"} {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				tx, err := b.CreateTransaction(tests.Context(t), txmgr.TxRequest{
+					FromAddress:    fromAddress,
+					ToAddress:      testutils.NewAddress(),
+					IdempotencyKey: &key,
+					AllowBundling:  true,
+				})
+				assert.NoError(t, err)
+				assert.Equal(t, int64(7), tx.ID)
+			}()
+		}
+		wg.Wait()
+	})
+
+	t.Run("returns the bundle of requests retried while their first attempt was being bundled", func(t *testing.T) {
+		txm := mocks.NewMockEvmTxManager(t)
+		txStore := newTestBundlerTxStore()
+		txStore.lookups = make(chan string, 16)
+		b, _ := newTestBundler(t, txm, txStore, 2, time.Hour)
+
+		// the first bundle is persisted only once released
+		started, release := make(chan struct{}), make(chan struct{})
+		var bundleKey string
+		txm.On("CreateTransaction", mock.Anything, mock.MatchedBy(func(req txmgr.TxRequest) bool {
+			return sameKeys(req.BundledIdempotencyKeys, "write-1", "write-2")
+		})).Run(func(args mock.Arguments) {
+			close(started)
+			<-release
+			req := args.Get(1).(txmgr.TxRequest)
+			bundleKey = *req.IdempotencyKey
+			txStore.createTransaction(req)
+		}).Return(txmgr.Tx{ID: 7}, nil).Once()
+
+		newRequest := func(key string) txmgr.TxRequest {
+			return txmgr.TxRequest{FromAddress: fromAddress, ToAddress: testutils.NewAddress(), IdempotencyKey: &key, AllowBundling: true}
+		}
+		gaveUpCtx, giveUp := context.WithCancel(tests.Context(t))
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			// the caller gives up, unless it is the one flushing the batch
+			_, _ = b.CreateTransaction(gaveUpCtx, newRequest("write-1"))
+		}()
+		go func() {
+			defer wg.Done()
+			tx, err := b.CreateTransaction(tests.Context(t), newRequest("write-2"))
+			assert.NoError(t, err)
+			assert.Equal(t, int64(7), tx.ID)
+		}()
+		<-started
+		giveUp()
+
+		// the retry lands in the next batch, along with a new request
+		txm.On("CreateTransaction", mock.Anything, mock.MatchedBy(func(req txmgr.TxRequest) bool {
+			return req.IdempotencyKey != nil && *req.IdempotencyKey == bundleKey
+		})).Return(txmgr.Tx{ID: 7}, nil).Once()
+		txm.On("CreateTransaction", mock.Anything, mock.MatchedBy(func(req txmgr.TxRequest) bool {
+			return req.IdempotencyKey != nil && *req.IdempotencyKey == "write-3"
+		})).Return(txmgr.Tx{ID: 8}, nil).Once()
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			tx, err := b.CreateTransaction(tests.Context(t), newRequest("write-1"))
+			assert.NoError(t, err)
+			assert.Equal(t, int64(7), tx.ID)
+		}()
+		go func() {
+			defer wg.Done()
+			tx, err := b.CreateTransaction(tests.Context(t), newRequest("write-3"))
+			assert.NoError(t, err)
+			assert.Equal(t, int64(8), tx.ID)
+		}()
+		// both were looked up before the first bundle was persisted, write-1 also by the first flush
+		lookedUp := map[string]int{}
+		for lookedUp["write-1"] < 3 || lookedUp["write-3"] < 1 {
+			lookedUp[<-txStore.lookups]++
+		}
+		close(release)
+		wg.Wait()
+	})
+}
+
+func unpackAggregate3(t *testing.T, payload []byte) []txmgr.Multicall3Call {
+	require.Greater(t, len(payload), 4)
+	unpacked, err := txmgr.Multicall3.Methods["aggregate3"].Inputs.Unpack(payload[4:])
+	require.NoError(t, err)
+	require.Len(t, unpacked, 1)
+	return *abi.ConvertType(unpacked[0], new([]txmgr.Multicall3Call)).(*[]txmgr.Multicall3Call)
+}
+
+// sameKeys reports whether keys holds exactly the wanted idempotency keys, in any order
+func sameKeys(keys []string, want ...string) bool {
+	keys = slices.Clone(keys)
+	slices.Sort(keys)
+	return slices.Equal(keys, want)
+}
@@ -0,0 +1,125 @@
+package txmgr_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+)
+
+type testCircuitBreakerConfig struct {
+	broadcastFailureThreshold uint32
+	receiptFailureThreshold   uint32
+	resetTimeout              time.Duration
+}
+
+func (c testCircuitBreakerConfig) BroadcastFailureThreshold() uint32 {
+	return c.broadcastFailureThreshold
+}
+
+func (c testCircuitBreakerConfig) ReceiptFailureThreshold() uint32 {
+	return c.receiptFailureThreshold
+}
+
+func (c testCircuitBreakerConfig) ResetTimeout() time.Duration {
+	return c.resetTimeout
+}
+
+func newTestCircuitBreaker(t *testing.T) (*txmgrcommon.CircuitBreaker, *time.Time) {
+	cb := txmgrcommon.NewCircuitBreaker(logger.Test(t), "0", testCircuitBreakerConfig{
+		broadcastFailureThreshold: 3,
+		receiptFailureThreshold:   2,
+		resetTimeout:              time.Minute,
+	})
+	now := time.Unix(1700000000, 0)
+	cb.XXXTestSetNow(func() time.Time { return now })
+	return cb, &now
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	rpcErr := errors.New("connection refused")
+
+	t.Run("nil breaker is disabled", func(t *testing.T) {
+		var cb *txmgrcommon.CircuitBreaker
+		cb.RecordBroadcastFailure(rpcErr)
+		assert.True(t, cb.Allow())
+		assert.Equal(t, txmgrcommon.CircuitBreakerClosed, cb.Status().State)
+	})
+
+	t.Run("opens after consecutive broadcast failures", func(t *testing.T) {
+		cb, _ := newTestCircuitBreaker(t)
+		cb.RecordBroadcastFailure(rpcErr)
+		cb.RecordBroadcastFailure(rpcErr)
+		cb.RecordBroadcastSuccess()
+		cb.RecordBroadcastFailure(rpcErr)
+		cb.RecordBroadcastFailure(rpcErr)
+		assert.True(t, cb.Allow())
+
+		cb.RecordBroadcastFailure(rpcErr)
+		assert.False(t, cb.Allow())
+		status := cb.Status()
+		assert.Equal(t, txmgrcommon.CircuitBreakerOpen, status.State)
+		assert.Equal(t, uint32(3), status.ConsecutiveBroadcastFailures)
+		assert.Equal(t, rpcErr.Error(), status.LastError)
+	})
+
+	t.Run("opens after consecutive receipt failures", func(t *testing.T) {
+		cb, _ := newTestCircuitBreaker(t)
+		cb.RecordReceiptFailure(rpcErr)
+		cb.RecordBroadcastSuccess()
+		cb.RecordReceiptFailure(rpcErr)
+		assert.False(t, cb.Allow())
+		assert.Equal(t, uint32(2), cb.Status().ConsecutiveReceiptFailures)
+	})
+
+	t.Run("half-open probe", func(t *testing.T) {
+		cb, now := newTestCircuitBreaker(t)
+		cb.RecordReceiptFailure(rpcErr)
+		cb.RecordReceiptFailure(rpcErr)
+
+		// Successes don't close an open breaker
+		cb.RecordReceiptSuccess()
+		*now = now.Add(time.Minute - time.Second)
+		assert.False(t, cb.Allow())
+
+		*now = now.Add(time.Second)
+		assert.True(t, cb.Allow())
+		assert.Equal(t, txmgrcommon.CircuitBreakerHalfOpen, cb.Status().State)
+		// A single probe is allowed until it completes or ResetTimeout elapses
+		assert.False(t, cb.Allow())
+		// Only the probe closes a half-open breaker
+		cb.RecordReceiptSuccess()
+		assert.Equal(t, txmgrcommon.CircuitBreakerHalfOpen, cb.Status().State)
+
+		cb.RecordBroadcastFailure(rpcErr)
+		assert.Equal(t, txmgrcommon.CircuitBreakerOpen, cb.Status().State)
+		assert.Equal(t, *now, cb.Status().OpenedAt)
+		assert.False(t, cb.Allow())
+
+		*now = now.Add(time.Minute)
+		assert.True(t, cb.Allow())
+		cb.RecordBroadcastSuccess()
+		status := cb.Status()
+		assert.Equal(t, txmgrcommon.CircuitBreakerClosed, status.State)
+		assert.Zero(t, status.ConsecutiveBroadcastFailures)
+		assert.Zero(t, status.ConsecutiveReceiptFailures)
+		assert.True(t, cb.Allow())
+	})
+
+	t.Run("reset", func(t *testing.T) {
+		cb, _ := newTestCircuitBreaker(t)
+		cb.RecordReceiptFailure(rpcErr)
+		cb.RecordReceiptFailure(rpcErr)
+		assert.False(t, cb.Allow())
+
+		cb.Reset()
+		assert.True(t, cb.Allow())
+		assert.Equal(t, txmgrcommon.CircuitBreakerClosed, cb.Status().State)
+		assert.Zero(t, cb.Status().ConsecutiveReceiptFailures)
+	})
+}
@@ -58,6 +58,7 @@ func (c *evmTxmClient) BatchSendTransactions(
 	codes = make([]commonclient.SendTxReturnCode, len(attempts))
 	txErrs = make([]error, len(attempts))
 
+	ctx = withTxmRequestSource(ctx)
 	reqs, broadcastTime, successfulTxIDs, batchErr := batchSendTransactions(ctx, attempts, batchSize, lggr, c.client)
 	err = errors.Join(err, batchErr) // this error does not block processing
 
@@ -101,11 +102,11 @@ func (c *evmTxmClient) SendTransactionReturnCode(ctx context.Context, etx Tx, at
 		lggr.Criticalw("Fatal error signing transaction", "err", err, "etx", etx)
 		return commonclient.Fatal, err
 	}
-	return c.client.SendTransactionReturnCode(ctx, signedTx, etx.FromAddress)
+	return c.client.SendTransactionReturnCode(withTxmRequestSource(ctx), signedTx, etx.FromAddress)
 }
 
 func (c *evmTxmClient) PendingNonceAt(ctx context.Context, fromAddress common.Address) (n evmtypes.Nonce, err error) {
-	nextNonce, err := c.client.PendingNonceAt(ctx, fromAddress)
+	nextNonce, err := c.client.PendingNonceAt(withTxmRequestSource(ctx), fromAddress)
 	if err != nil {
 		return n, err
 	}
@@ -117,7 +118,7 @@ func (c *evmTxmClient) PendingNonceAt(ctx context.Context, fromAddress common.Ad
 }
 
 func (c *evmTxmClient) SequenceAt(ctx context.Context, addr common.Address, blockNum *big.Int) (evmtypes.Nonce, error) {
-	return c.client.SequenceAt(ctx, addr, blockNum)
+	return c.client.SequenceAt(withTxmRequestSource(ctx), addr, blockNum)
 }
 
 func (c *evmTxmClient) BatchGetReceipts(ctx context.Context, attempts []TxAttempt) (txReceipt []*evmtypes.Receipt, txErr []error, funcErr error) {
@@ -133,16 +134,36 @@ func (c *evmTxmClient) BatchGetReceipts(ctx context.Context, attempts []TxAttemp
 		reqs = append(reqs, req)
 	}
 
-	if err := c.client.BatchCallContext(ctx, reqs); err != nil {
+	if err := c.client.BatchCallContext(withTxmRequestSource(ctx), reqs); err != nil {
 		return nil, nil, fmt.Errorf("EthConfirmer#batchFetchReceipts error fetching receipts with BatchCallContext: %w", err)
 	}
 
-	for _, req := range reqs {
+	for i, req := range reqs {
 		txErr = append(txErr, req.Error)
+		if req.Error == nil {
+			c.replayRevert(ctx, attempts[i], txReceipt[i])
+		}
 	}
 	return txReceipt, txErr, nil
 }
 
+// replayRevert replays a reverted transaction at its block to attach its revert data to the receipt, so that the
+// reason of the revert is saved with the receipt. RPCs other than Hedera don't include it in receipts.
+func (c *evmTxmClient) replayRevert(ctx context.Context, attempt TxAttempt, receipt *evmtypes.Receipt) {
+	if receipt.IsZero() || receipt.IsUnmined() || receipt.Status != 0 || receipt.BlockNumber == nil || len(receipt.RevertReason) > 0 {
+		return
+	}
+	rpcErr, err := c.CallContract(ctx, attempt, receipt.BlockNumber)
+	if err != nil {
+		return
+	}
+	if jErr, ok := rpcErr.(*client.JsonError); ok {
+		if data, ok := jErr.RevertData(); ok {
+			receipt.RevertReason = data
+		}
+	}
+}
+
 // sendEmptyTransaction sends a transaction with 0 Eth and an empty payload to the burn address
 // May be useful for clearing stuck nonces
 func (c *evmTxmClient) SendEmptyTransaction(
@@ -165,12 +186,12 @@ func (c *evmTxmClient) SendEmptyTransaction(
 		return txhash, err
 	}
 
-	_, err = c.client.SendTransactionReturnCode(ctx, signedTx, fromAddress)
+	_, err = c.client.SendTransactionReturnCode(withTxmRequestSource(ctx), signedTx, fromAddress)
 	return signedTx.Hash().String(), err
 }
 
 func (c *evmTxmClient) CallContract(ctx context.Context, a TxAttempt, blockNumber *big.Int) (rpcErr fmt.Stringer, extractErr error) {
-	_, errCall := c.client.CallContract(ctx, ethereum.CallMsg{
+	_, errCall := c.client.CallContract(withTxmRequestSource(ctx), ethereum.CallMsg{
 		From:       a.Tx.FromAddress,
 		To:         &a.Tx.ToAddress,
 		Gas:        a.Tx.FeeLimit,
@@ -185,5 +206,11 @@ func (c *evmTxmClient) CallContract(ctx context.Context, a TxAttempt, blockNumbe
 }
 
 func (c *evmTxmClient) HeadByHash(ctx context.Context, hash common.Hash) (*evmtypes.Head, error) {
-	return c.client.HeadByHash(ctx, hash)
+	return c.client.HeadByHash(withTxmRequestSource(ctx), hash)
+}
+
+// withTxmRequestSource tags the requests made on behalf of the TXM, so that they are weighted accordingly by the RPC
+// rate limiter.
+func withTxmRequestSource(ctx context.Context) context.Context {
+	return commonclient.CtxWithRequestSource(ctx, commonclient.RequestSourceTxm)
 }
@@ -29,6 +29,7 @@ import (
 	commonfee "github.com/smartcontractkit/chainlink/v2/common/fee"
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
 	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+	txmgrtypesmocks "github.com/smartcontractkit/chainlink/v2/common/txmgr/types/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
 	evmconfig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
@@ -581,10 +582,12 @@ func TestEthConfirmer_CheckForReceipts(t *testing.T) {
 		data, err := utils.ABIEncode(`[{"type":"uint256"}]`, big.NewInt(10))
 		require.NoError(t, err)
 		sig := utils.Keccak256Fixed([]byte(`MyError(uint256)`))
+		revertData := hexutil.Encode(utils.ConcatBytes(sig[:4], data))
+		// the transaction is replayed once to save its revert data with the receipt
 		ethClient.On("CallContract", mock.Anything, mock.Anything, mock.Anything).Return(nil, &client.JsonError{
 			Code:    1,
 			Message: "reverted",
-			Data:    utils.ConcatBytes(sig[:4], data),
+			Data:    revertData,
 		}).Once()
 
 		// Do the thing
@@ -601,6 +604,8 @@ func TestEthConfirmer_CheckForReceipts(t *testing.T) {
 		require.NotNil(t, attempt5_1.BroadcastBeforeBlockNum)
 		// Check receipts
 		require.Len(t, attempt5_1.Receipts, 1)
+		require.NotNil(t, attempt5_1.Receipts[0].GetRevertReason())
+		assert.Equal(t, revertData, *attempt5_1.Receipts[0].GetRevertReason())
 	})
 }
 
@@ -1811,6 +1816,142 @@ func TestEthConfirmer_RebroadcastWhereNecessary_MaxFeeScenario(t *testing.T) {
 	})
 }
 
+func TestEthConfirmer_RebroadcastWhereNecessary_BumpStrategy(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewGeneralConfig(t, nil)
+	txStore := cltest.NewTestTxStore(t, db)
+	ctx := tests.Context(t)
+
+	ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+
+	kst := ksmocks.NewEth(t)
+	addresses := []gethCommon.Address{fromAddress}
+	kst.On("EnabledAddressesForChain", mock.Anything, &cltest.FixtureChainID).Return(addresses, nil).Maybe()
+	ec := newEthConfirmer(t, txStore, ethClient, cfg, evmcfg, kst, nil)
+	currentHead := int64(30)
+	oldEnough := int64(19)
+
+	originalBroadcastAt := time.Unix(1616509100, 0)
+	etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 0, fromAddress, originalBroadcastAt)
+	attempt1 := etx.TxAttempts[0]
+	_, err := db.Exec(`UPDATE evm.tx_attempts SET broadcast_before_block_num=$1 WHERE id=$2`, oldEnough, attempt1.ID)
+	require.NoError(t, err)
+	_, err = db.Exec(`UPDATE evm.txes SET meta='{"BumpStrategy": "custom"}' WHERE id=$1`, etx.ID)
+	require.NoError(t, err)
+
+	customPrice := assets.GWei(42)
+	strategy := txmgrtypesmocks.NewBumpStrategy[*big.Int, gethCommon.Address, gethCommon.Hash, gethCommon.Hash, evmtypes.Nonce, gas.EvmFee](t)
+	strategy.On("BumpFee", mock.Anything, mock.MatchedBy(func(tx txmgr.Tx) bool { return tx.ID == etx.ID }), mock.Anything, mock.Anything).
+		Return(gas.EvmFee{Legacy: customPrice}, nil).Once()
+	ec.SetBumpStrategy("custom", strategy)
+
+	ethTx := *types.NewTx(&types.LegacyTx{})
+	kst.On("SignTx", mock.Anything, fromAddress, mock.MatchedBy(func(tx *types.Transaction) bool {
+		if tx.GasPrice().Cmp(customPrice.ToInt()) != 0 {
+			return false
+		}
+		ethTx = *tx
+		return true
+	}), mock.Anything).Return(&ethTx, nil).Once()
+	ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(func(tx *types.Transaction) bool {
+		return tx.GasPrice().Cmp(customPrice.ToInt()) == 0
+	}), fromAddress).Return(commonclient.Successful, nil).Once()
+
+	require.NoError(t, ec.RebroadcastWhereNecessary(ctx, currentHead))
+
+	etx, err = txStore.FindTxWithAttempts(ctx, etx.ID)
+	require.NoError(t, err)
+	require.Len(t, etx.TxAttempts, 2)
+	assert.Equal(t, customPrice.String(), etx.TxAttempts[0].TxFee.Legacy.String())
+}
+
+func TestEthConfirmer_FindTxsRequiringRebroadcast_GasEstimatorProfile(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ctx := tests.Context(t)
+
+	ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+	lggr := logger.Test(t)
+
+	ec := newEthConfirmer(t, txStore, ethClient, cfg, evmcfg, ethKeyStore, nil)
+	ec.SetProfileBumpThresholds(map[string]uint64{"slow": 10})
+
+	etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 0, fromAddress, time.Unix(1616509100, 0))
+	_, err := db.Exec(`UPDATE evm.tx_attempts SET broadcast_before_block_num=$1 WHERE id=$2`, 25, etx.TxAttempts[0].ID)
+	require.NoError(t, err)
+	_, err = db.Exec(`UPDATE evm.txes SET meta='{"GasEstimatorProfile": "slow"}' WHERE id=$1`, etx.ID)
+	require.NoError(t, err)
+
+	// due under the lowest threshold, but not under the one of its profile yet
+	etxs, err := ec.FindTxsRequiringRebroadcast(ctx, lggr, fromAddress, 30, 3, 10, 0, &cltest.FixtureChainID)
+	require.NoError(t, err)
+	assert.Empty(t, etxs)
+
+	etxs, err = ec.FindTxsRequiringRebroadcast(ctx, lggr, fromAddress, 35, 3, 10, 0, &cltest.FixtureChainID)
+	require.NoError(t, err)
+	require.Len(t, etxs, 1)
+	assert.Equal(t, etx.ID, etxs[0].ID)
+}
+
+func TestEthConfirmer_RebroadcastWhereNecessary_BumpStrategyError(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewGeneralConfig(t, nil)
+	txStore := cltest.NewTestTxStore(t, db)
+	ctx := tests.Context(t)
+
+	ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+
+	kst := ksmocks.NewEth(t)
+	addresses := []gethCommon.Address{fromAddress}
+	kst.On("EnabledAddressesForChain", mock.Anything, &cltest.FixtureChainID).Return(addresses, nil).Maybe()
+	ec := newEthConfirmer(t, txStore, ethClient, cfg, evmcfg, kst, nil)
+	currentHead := int64(30)
+	oldEnough := int64(19)
+
+	originalBroadcastAt := time.Unix(1616509100, 0)
+	etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 0, fromAddress, originalBroadcastAt)
+	attempt1 := etx.TxAttempts[0]
+	_, err := db.Exec(`UPDATE evm.tx_attempts SET broadcast_before_block_num=$1 WHERE id=$2`, oldEnough, attempt1.ID)
+	require.NoError(t, err)
+	_, err = db.Exec(`UPDATE evm.txes SET meta='{"BumpStrategy": "custom"}' WHERE id=$1`, etx.ID)
+	require.NoError(t, err)
+
+	strategy := txmgrtypesmocks.NewBumpStrategy[*big.Int, gethCommon.Address, gethCommon.Hash, gethCommon.Hash, evmtypes.Nonce, gas.EvmFee](t)
+	strategy.On("BumpFee", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(gas.EvmFee{}, errors.New("oracle unavailable")).Once()
+	ec.SetBumpStrategy("custom", strategy)
+
+	// the previous attempt is rebroadcast as is, without signing a new one with the keystore
+	ethClient.On("SendTransactionReturnCode", mock.Anything, mock.Anything, fromAddress).Return(commonclient.Successful, nil).Once()
+
+	require.NoError(t, ec.RebroadcastWhereNecessary(ctx, currentHead))
+
+	etx, err = txStore.FindTxWithAttempts(ctx, etx.ID)
+	require.NoError(t, err)
+	require.Len(t, etx.TxAttempts, 1)
+	assert.Equal(t, attempt1.ID, etx.TxAttempts[0].ID)
+}
+
 func TestEthConfirmer_RebroadcastWhereNecessary(t *testing.T) {
 	t.Parallel()
 
@@ -3262,7 +3403,8 @@ func TestEthConfirmer_ProcessStuckTransactions(t *testing.T) {
 
 		ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(&head, nil).Once()
 		ethClient.On("LatestFinalizedBlock", mock.Anything).Return(&head, nil).Once()
-		ethClient.On("SequenceAt", mock.Anything, mock.Anything, mock.Anything).Return(evmtypes.Nonce(0), nil).Once()
+		// Called by the confirmer and by the stuck tx detector's nonce gap check
+		ethClient.On("SequenceAt", mock.Anything, mock.Anything, mock.Anything).Return(evmtypes.Nonce(0), nil).Twice()
 		ethClient.On("BatchCallContext", mock.Anything, mock.Anything).Return(nil).Once()
 
 		// First call to ProcessHead should:
@@ -30,6 +30,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/label"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 )
 
 var (
@@ -47,7 +48,10 @@ type EvmTxStore interface {
 
 	// methods used solely in EVM components
 	FindConfirmedTxesReceipts(ctx context.Context, finalizedBlockNum int64, chainID *big.Int) (receipts []Receipt, err error)
-	UpdateTxStatesToFinalizedUsingReceiptIds(ctx context.Context, etxIDs []int64, chainId *big.Int) error
+	UpdateTxStatesToFinalizedUsingReceiptIds(ctx context.Context, etxIDs []int64, chainId *big.Int) ([]txmgrtypes.FinalizedTx, error)
+	SaveNonceGap(ctx context.Context, etxID int64, onChainNonce evmtypes.Nonce) error
+	ClearNonceGaps(ctx context.Context, fromAddress common.Address, chainID *big.Int, onChainNonce evmtypes.Nonce) error
+	FindBundleIdempotencyKey(ctx context.Context, idempotencyKey string) (bundleKey *string, err error)
 }
 
 // TxStoreWebApi encapsulates the methods that are not used by the txmgr and only used by the various web controllers, readers, or evm specific components
@@ -57,9 +61,11 @@ type TxStoreWebApi interface {
 	Transactions(ctx context.Context, offset, limit int) ([]Tx, int, error)
 	TxAttempts(ctx context.Context, offset, limit int) ([]TxAttempt, int, error)
 	TransactionsWithAttempts(ctx context.Context, offset, limit int) ([]Tx, int, error)
+	StuckTransactions(ctx context.Context, offset, limit int) ([]Tx, int, error)
 	FindTxAttempt(ctx context.Context, hash common.Hash) (*TxAttempt, error)
 	FindTxWithAttempts(ctx context.Context, etxID int64) (etx Tx, err error)
 	FindTxsByStateAndFromAddresses(ctx context.Context, addresses []common.Address, state txmgrtypes.TxState, chainID *big.Int) (txs []*Tx, err error)
+	FindJobSpend(ctx context.Context, jobID int32, since time.Time, chainID *big.Int) (JobSpend, error)
 }
 
 type TestEvmTxStore interface {
@@ -83,6 +89,9 @@ type evmTxStore struct {
 	q      sqlutil.DataSource
 	logger logger.SugaredLogger
 	stopCh services.StopChan
+	// bulk copies receipts with COPY if not nil. The stores of transactions don't have it, since COPY runs in
+	// transactions of its own.
+	bulk *pg.BulkInserter
 }
 
 var _ EvmTxStore = (*evmTxStore)(nil)
@@ -199,6 +208,10 @@ type DbEthTx struct {
 	SignalCallback bool
 	// Marks tx callback as signaled
 	CallbackCompleted bool
+	// FinalizedAt is set when the tx is finalized, for the tx audit export
+	FinalizedAt *time.Time
+	// BlobSidecar is the RLP encoded sidecar of the blobs carried by an EIP-4844 blob tx
+	BlobSidecar []byte
 }
 
 func (db *DbEthTx) FromTx(tx *Tx) {
@@ -221,6 +234,7 @@ func (db *DbEthTx) FromTx(tx *Tx) {
 	db.InitialBroadcastAt = tx.InitialBroadcastAt
 	db.SignalCallback = tx.SignalCallback
 	db.CallbackCompleted = tx.CallbackCompleted
+	db.BlobSidecar = tx.BlobSidecar
 
 	if tx.ChainID != nil {
 		db.EVMChainID = *ubig.New(tx.ChainID)
@@ -256,6 +270,7 @@ func (db DbEthTx) ToTx(tx *Tx) {
 	tx.InitialBroadcastAt = db.InitialBroadcastAt
 	tx.SignalCallback = db.SignalCallback
 	tx.CallbackCompleted = db.CallbackCompleted
+	tx.BlobSidecar = db.BlobSidecar
 }
 
 func dbEthTxsToEvmEthTxs(dbEthTxs []DbEthTx) []Tx {
@@ -469,6 +484,27 @@ func (o *evmTxStore) TransactionsWithAttempts(ctx context.Context, offset, limit
 	return
 }
 
+// StuckTransactions returns the unconfirmed transactions which have been detected as terminally stuck,
+// either being purged or blocked by a nonce gap, sorted by id descending.
+func (o *evmTxStore) StuckTransactions(ctx context.Context, offset, limit int) (txs []Tx, count int, err error) {
+	const stuck = `state = 'unconfirmed' AND (
+		id IN (SELECT DISTINCT eth_tx_id FROM evm.tx_attempts WHERE is_purge_attempt) OR
+		id IN (SELECT tx_id FROM evm.tx_nonce_gaps))`
+	sql := `SELECT count(*) FROM evm.txes WHERE ` + stuck
+	if err = o.q.GetContext(ctx, &count, sql); err != nil {
+		return
+	}
+
+	sql = `SELECT * FROM evm.txes WHERE ` + stuck + ` ORDER BY id desc LIMIT $1 OFFSET $2`
+	var dbTxs []DbEthTx
+	if err = o.q.SelectContext(ctx, &dbTxs, sql, limit, offset); err != nil {
+		return
+	}
+	txs = dbEthTxsToEvmEthTxs(dbTxs)
+	err = o.preloadTxAttempts(ctx, txs)
+	return
+}
+
 // TxAttempts returns the last tx attempts sorted by created_at descending.
 func (o *evmTxStore) TxAttempts(ctx context.Context, offset, limit int) (txs []TxAttempt, count int, err error) {
 	sql := `SELECT count(*) FROM evm.tx_attempts`
@@ -531,8 +567,8 @@ func (o *evmTxStore) InsertTx(ctx context.Context, etx *Tx) error {
 	if etx.CreatedAt == (time.Time{}) {
 		etx.CreatedAt = time.Now()
 	}
-	const insertEthTxSQL = `INSERT INTO evm.txes (nonce, from_address, to_address, encoded_payload, value, gas_limit, error, broadcast_at, initial_broadcast_at, created_at, state, meta, subject, pipeline_task_run_id, min_confirmations, evm_chain_id, transmit_checker, idempotency_key, signal_callback, callback_completed) VALUES (
-:nonce, :from_address, :to_address, :encoded_payload, :value, :gas_limit, :error, :broadcast_at, :initial_broadcast_at, :created_at, :state, :meta, :subject, :pipeline_task_run_id, :min_confirmations, :evm_chain_id, :transmit_checker, :idempotency_key, :signal_callback, :callback_completed
+	const insertEthTxSQL = `INSERT INTO evm.txes (nonce, from_address, to_address, encoded_payload, value, gas_limit, error, broadcast_at, initial_broadcast_at, created_at, state, meta, subject, pipeline_task_run_id, min_confirmations, evm_chain_id, transmit_checker, idempotency_key, signal_callback, callback_completed, blob_sidecar) VALUES (
+:nonce, :from_address, :to_address, :encoded_payload, :value, :gas_limit, :error, :broadcast_at, :initial_broadcast_at, :created_at, :state, :meta, :subject, :pipeline_task_run_id, :min_confirmations, :evm_chain_id, :transmit_checker, :idempotency_key, :signal_callback, :callback_completed, :blob_sidecar
 ) RETURNING *`
 	var dbTx DbEthTx
 	dbTx.FromTx(etx)
@@ -937,6 +973,7 @@ func (o *evmTxStore) SaveFetchedReceipts(ctx context.Context, r []*evmtypes.Rece
 	//
 	var valueStrs []string
 	var valueArgs []interface{}
+	var rows [][]any
 	for _, r := range receipts {
 		var receiptJSON []byte
 		receiptJSON, err = json.Marshal(r)
@@ -945,14 +982,15 @@ func (o *evmTxStore) SaveFetchedReceipts(ctx context.Context, r []*evmtypes.Rece
 		}
 		valueStrs = append(valueStrs, "(?,?,?,?,?,NOW())")
 		valueArgs = append(valueArgs, r.TxHash, r.BlockHash, r.BlockNumber.Int64(), r.TransactionIndex, receiptJSON)
+		rows = append(rows, []any{r.TxHash.Bytes(), r.BlockHash.Bytes(), r.BlockNumber.Int64(), int64(r.TransactionIndex), receiptJSON})
 	}
-	valueArgs = append(valueArgs, state, errorMsg, chainID.String())
+	updateArgs := []any{state, errorMsg, chainID.String()}
 
 	/* #nosec G201 */
 	sql := `
 	WITH inserted_receipts AS (
 		INSERT INTO evm.receipts (tx_hash, block_hash, block_number, transaction_index, receipt, created_at)
-		VALUES %s
+		%s
 		ON CONFLICT (tx_hash, block_hash) DO UPDATE SET
 			block_number = EXCLUDED.block_number,
 			transaction_index = EXCLUDED.transaction_index,
@@ -975,12 +1013,73 @@ func (o *evmTxStore) SaveFetchedReceipts(ctx context.Context, r []*evmtypes.Rece
 	AND evm_chain_id = ?
 	`
 
-	stmt := fmt.Sprintf(sql, strings.Join(valueStrs, ","))
+	if o.bulk.Copies(len(rows)) {
+		// receipts are copied into a temporary table, which the receipts are inserted from
+		stmt := sqlx.Rebind(sqlx.DOLLAR, fmt.Sprintf(sql, "SELECT tx_hash, block_hash, block_number, transaction_index, receipt, NOW() FROM %s"))
+		err = o.bulk.Transact(ctx, func(tx *pg.BulkTx) error {
+			if err := tx.CopyFrom(ctx, "evm.receipts", receiptColumns, rows, stmt, updateArgs...); err != nil {
+				return pkgerrors.Wrap(err, "SaveFetchedReceipts failed to copy receipts")
+			}
+			return pkgerrors.Wrap(o.new(tx).updateJobSpendMined(ctx, receipts), "SaveFetchedReceipts failed")
+		})
+		if !errors.Is(err, pg.ErrCopyUnsupported) {
+			return err
+		}
+	}
+
+	stmt := fmt.Sprintf(sql, "VALUES "+strings.Join(valueStrs, ","))
 
 	stmt = sqlx.Rebind(sqlx.DOLLAR, stmt)
 
-	_, err = o.q.ExecContext(ctx, stmt, valueArgs...)
-	return pkgerrors.Wrap(err, "SaveFetchedReceipts failed to save receipts")
+	return o.Transact(ctx, false, func(orm *evmTxStore) error {
+		if _, err = orm.q.ExecContext(ctx, stmt, append(valueArgs, updateArgs...)...); err != nil {
+			return pkgerrors.Wrap(err, "SaveFetchedReceipts failed to save receipts")
+		}
+		return pkgerrors.Wrap(orm.updateJobSpendMined(ctx, receipts), "SaveFetchedReceipts failed")
+	})
+}
+
+var receiptColumns = []string{"tx_hash", "block_hash", "block_number", "transaction_index", "receipt"}
+
+// upsertJobSpendAttempted records the highest possible fee across all broadcast attempts of a transaction created on
+// behalf of a job. Transactions without a JobID in their meta are ignored.
+func (o *evmTxStore) upsertJobSpendAttempted(ctx context.Context, etxID int64) error {
+	_, err := o.q.ExecContext(ctx, `
+INSERT INTO evm.tx_job_spend (tx_id, evm_chain_id, job_id, attempted_fee, created_at, updated_at)
+SELECT evm.txes.id, evm.txes.evm_chain_id, (evm.txes.meta->>'JobID')::integer,
+	MAX(evm.tx_attempts.chain_specific_gas_limit * COALESCE(evm.tx_attempts.gas_price, evm.tx_attempts.gas_fee_cap)),
+	evm.txes.created_at, NOW()
+FROM evm.txes
+JOIN evm.tx_attempts ON evm.tx_attempts.eth_tx_id = evm.txes.id
+WHERE evm.txes.id = $1 AND evm.txes.meta->>'JobID' IS NOT NULL AND evm.tx_attempts.state = 'broadcast'
+GROUP BY evm.txes.id
+ON CONFLICT (tx_id) DO UPDATE SET
+	attempted_fee = GREATEST(evm.tx_job_spend.attempted_fee, EXCLUDED.attempted_fee),
+	updated_at = NOW()`, etxID)
+	return pkgerrors.Wrap(err, "failed to record attempted job spend")
+}
+
+// updateJobSpendMined records the fee of mined transactions created on behalf of a job, using the effective gas price
+// of their receipt. Receipts of RPCs which do not return it fall back to the gas price (or fee cap for dynamic fee
+// transactions) of the mined attempt.
+func (o *evmTxStore) updateJobSpendMined(ctx context.Context, receipts []rawOnchainReceipt) error {
+	hashes := make([][]byte, len(receipts))
+	gasUsed := make([]int64, len(receipts))
+	effectiveGasPrices := make([]sql.NullString, len(receipts))
+	for i, r := range receipts {
+		hashes[i] = r.TxHash.Bytes()
+		gasUsed[i] = int64(r.GasUsed)
+		if r.EffectiveGasPrice != nil {
+			effectiveGasPrices[i] = sql.NullString{String: r.EffectiveGasPrice.String(), Valid: true}
+		}
+	}
+	_, err := o.q.ExecContext(ctx, `
+UPDATE evm.tx_job_spend
+SET mined_fee = r.gas_used * COALESCE(r.effective_gas_price, evm.tx_attempts.gas_price, evm.tx_attempts.gas_fee_cap), updated_at = NOW()
+FROM UNNEST($1::bytea[], $2::bigint[], $3::numeric[]) AS r(tx_hash, gas_used, effective_gas_price)
+JOIN evm.tx_attempts ON evm.tx_attempts.hash = r.tx_hash
+WHERE evm.tx_attempts.eth_tx_id = evm.tx_job_spend.tx_id`, pq.Array(hashes), pq.Array(gasUsed), pq.Array(effectiveGasPrices))
+	return pkgerrors.Wrap(err, "failed to record mined job spend")
 }
 
 // MarkAllConfirmedMissingReceipt
@@ -1260,8 +1359,10 @@ func (o *evmTxStore) saveAttemptWithNewState(ctx context.Context, attempt TxAtte
 		if _, err := orm.q.ExecContext(ctx, `UPDATE evm.txes SET broadcast_at = $1 WHERE id = $2 AND broadcast_at < $1`, broadcastAt, dbAttempt.EthTxID); err != nil {
 			return pkgerrors.Wrap(err, "saveAttemptWithNewState failed to update evm.txes")
 		}
-		_, err := orm.q.ExecContext(ctx, `UPDATE evm.tx_attempts SET state=$1 WHERE id=$2`, dbAttempt.State, dbAttempt.ID)
-		return pkgerrors.Wrap(err, "saveAttemptWithNewState failed to update evm.tx_attempts")
+		if _, err := orm.q.ExecContext(ctx, `UPDATE evm.tx_attempts SET state=$1 WHERE id=$2`, dbAttempt.State, dbAttempt.ID); err != nil {
+			return pkgerrors.Wrap(err, "saveAttemptWithNewState failed to update evm.tx_attempts")
+		}
+		return pkgerrors.Wrap(orm.upsertJobSpendAttempted(ctx, dbAttempt.EthTxID), "saveAttemptWithNewState failed")
 	})
 }
 
@@ -1606,6 +1707,22 @@ func (o *evmTxStore) FindNextUnstartedTransactionFromAddress(ctx context.Context
 	return etx, nil
 }
 
+// FindUnstartedTransactionsFromAddress returns up to limit unstarted transactions of the given address, in the same
+// order as FindNextUnstartedTransactionFromAddress finds them
+func (o *evmTxStore) FindUnstartedTransactionsFromAddress(ctx context.Context, fromAddress common.Address, chainID *big.Int, limit int) (etxs []*Tx, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	var dbEtxs []DbEthTx
+	err = o.q.SelectContext(ctx, &dbEtxs, `SELECT * FROM evm.txes WHERE from_address = $1 AND state = 'unstarted' AND evm_chain_id = $2 ORDER BY value ASC, created_at ASC, id ASC LIMIT $3`, fromAddress, chainID.String(), limit)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to FindUnstartedTransactionsFromAddress")
+	}
+	etxs = make([]*Tx, len(dbEtxs))
+	dbEthTxsToEvmEthTxPtrs(dbEtxs, etxs)
+	return etxs, nil
+}
+
 func (o *evmTxStore) UpdateTxFatalError(ctx context.Context, etx *Tx) error {
 	var cancel context.CancelFunc
 	ctx, cancel = o.stopCh.Ctx(ctx)
@@ -1666,7 +1783,7 @@ func (o *evmTxStore) UpdateTxAttemptInProgressToBroadcast(ctx context.Context, e
 		if err := orm.q.GetContext(ctx, &dbAttempt, `UPDATE evm.tx_attempts SET state = $1 WHERE id = $2 RETURNING *`, dbAttempt.State, dbAttempt.ID); err != nil {
 			return pkgerrors.Wrap(err, "SaveEthTxAttempt failed to save eth_tx_attempt")
 		}
-		return nil
+		return pkgerrors.Wrap(orm.upsertJobSpendAttempted(ctx, dbEtx.ID), "SaveEthTxAttempt failed")
 	})
 }
 
@@ -1832,6 +1949,46 @@ func (o *evmTxStore) CheckTxQueueCapacity(ctx context.Context, fromAddress commo
 	return
 }
 
+func (o *evmTxStore) CheckJobSpendLimit(ctx context.Context, jobID int32, limit *big.Int, since time.Time, chainID *big.Int) (err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	if limit == nil || limit.Sign() <= 0 {
+		return nil
+	}
+	spend, err := o.FindJobSpend(ctx, jobID, since, chainID)
+	if err != nil {
+		return pkgerrors.Wrap(err, "CheckJobSpendLimit query failed")
+	}
+
+	if spend.Total().Cmp(limit) >= 0 {
+		err = pkgerrors.Errorf("cannot create transaction; job %d has reached its spend limit (%s/%s) since %s", jobID, spend.Total(), limit, since)
+	}
+	return
+}
+
+// FindJobSpend returns fees spent by transactions created on behalf of the job since the given time.
+func (o *evmTxStore) FindJobSpend(ctx context.Context, jobID int32, since time.Time, chainID *big.Int) (spend JobSpend, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	var dbSpend struct {
+		Attempted *ubig.Big
+		Mined     *ubig.Big
+	}
+	err = o.q.GetContext(ctx, &dbSpend, `
+SELECT COALESCE(SUM(attempted_fee) FILTER (WHERE mined_fee IS NULL), 0) AS attempted, COALESCE(SUM(mined_fee), 0) AS mined
+FROM evm.tx_job_spend
+WHERE evm_chain_id = $1 AND job_id = $2 AND created_at > $3`, chainID.String(), jobID, since)
+	if err != nil {
+		return spend, pkgerrors.Wrap(err, "FindJobSpend failed")
+	}
+	spend.JobID = jobID
+	spend.Attempted = dbSpend.Attempted.ToInt()
+	spend.Mined = dbSpend.Mined.ToInt()
+	return spend, nil
+}
+
 func (o *evmTxStore) CreateTransaction(ctx context.Context, txRequest TxRequest, chainID *big.Int) (tx Tx, err error) {
 	var cancel context.CancelFunc
 	ctx, cancel = o.stopCh.Ctx(ctx)
@@ -1850,15 +2007,22 @@ func (o *evmTxStore) CreateTransaction(ctx context.Context, txRequest TxRequest,
 			}
 		}
 		err = orm.q.GetContext(ctx, &dbEtx, `
-INSERT INTO evm.txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, transmit_checker, idempotency_key, signal_callback)
+INSERT INTO evm.txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, transmit_checker, idempotency_key, signal_callback, blob_sidecar)
 VALUES (
-$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11,$12,$13
+$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11,$12,$13,$14
 )
 RETURNING "txes".*
-`, txRequest.FromAddress, txRequest.ToAddress, txRequest.EncodedPayload, assets.Eth(txRequest.Value), txRequest.FeeLimit, txRequest.Meta, txRequest.Strategy.Subject(), chainID.String(), txRequest.MinConfirmations, txRequest.PipelineTaskRunID, txRequest.Checker, txRequest.IdempotencyKey, txRequest.SignalCallback)
+`, txRequest.FromAddress, txRequest.ToAddress, txRequest.EncodedPayload, assets.Eth(txRequest.Value), txRequest.FeeLimit, txRequest.Meta, txRequest.Strategy.Subject(), chainID.String(), txRequest.MinConfirmations, txRequest.PipelineTaskRunID, txRequest.Checker, txRequest.IdempotencyKey, txRequest.SignalCallback, txRequest.BlobSidecar)
 		if err != nil {
 			return pkgerrors.Wrap(err, "CreateEthTransaction failed to insert evm tx")
 		}
+		if len(txRequest.BundledIdempotencyKeys) > 0 {
+			_, err = orm.q.ExecContext(ctx, `INSERT INTO evm.tx_bundle_keys (idempotency_key, bundle_tx_id) SELECT unnest($1::text[]), $2`,
+				pq.Array(txRequest.BundledIdempotencyKeys), dbEtx.ID)
+			if err != nil {
+				return pkgerrors.Wrap(err, "CreateEthTransaction failed to insert bundled idempotency keys")
+			}
+		}
 		return nil
 	})
 	var etx Tx
@@ -2116,7 +2280,7 @@ func (o *evmTxStore) FindConfirmedTxesReceipts(ctx context.Context, finalizedBlo
 	defer cancel()
 
 	// note the receipts are partially loaded for performance reason
-	query := `SELECT evm.receipts.id, evm.receipts.tx_hash, evm.receipts.block_hash, evm.receipts.block_number FROM evm.receipts
+	query := `SELECT evm.receipts.id, evm.receipts.tx_hash, evm.receipts.block_hash, evm.receipts.block_number, evm.receipts.created_at FROM evm.receipts
 		INNER JOIN evm.tx_attempts ON evm.tx_attempts.hash = evm.receipts.tx_hash
 		INNER JOIN evm.txes ON evm.txes.id = evm.tx_attempts.eth_tx_id
 		WHERE evm.txes.state = 'confirmed' AND evm.receipts.block_number <= $1 AND evm.txes.evm_chain_id = $2`
@@ -2124,20 +2288,67 @@ func (o *evmTxStore) FindConfirmedTxesReceipts(ctx context.Context, finalizedBlo
 	return receipts, err
 }
 
-// Mark transactions corresponding to receipt IDs as finalized
-func (o *evmTxStore) UpdateTxStatesToFinalizedUsingReceiptIds(ctx context.Context, receiptIDs []int64, chainId *big.Int) error {
+// SaveNonceGap records that the transaction is blocked by a lower nonce which was never mined, so that it is listed as stuck
+func (o *evmTxStore) SaveNonceGap(ctx context.Context, etxID int64, onChainNonce evmtypes.Nonce) error {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	_, err := o.q.ExecContext(ctx, `INSERT INTO evm.tx_nonce_gaps (tx_id, on_chain_nonce, detected_at) VALUES ($1, $2, NOW())
+ON CONFLICT (tx_id) DO UPDATE SET on_chain_nonce = EXCLUDED.on_chain_nonce, detected_at = EXCLUDED.detected_at`, etxID, onChainNonce.Int64())
+	return err
+}
+
+// ClearNonceGaps deletes the nonce gaps of the transactions of fromAddress which are no longer unconfirmed, or which the on-chain nonce has caught up with
+func (o *evmTxStore) ClearNonceGaps(ctx context.Context, fromAddress common.Address, chainID *big.Int, onChainNonce evmtypes.Nonce) error {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	_, err := o.q.ExecContext(ctx, `DELETE FROM evm.tx_nonce_gaps g USING evm.txes t
+WHERE g.tx_id = t.id AND t.from_address = $1 AND t.evm_chain_id = $2 AND (t.state <> 'unconfirmed' OR t.nonce <= $3)`, fromAddress, chainID.String(), onChainNonce.Int64())
+	return err
+}
+
+// FindBundleIdempotencyKey returns the idempotency key of the bundle transaction the request was aggregated into, or nil if it was not bundled
+func (o *evmTxStore) FindBundleIdempotencyKey(ctx context.Context, idempotencyKey string) (bundleKey *string, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	err = o.q.GetContext(ctx, &bundleKey, `SELECT evm.txes.idempotency_key FROM evm.tx_bundle_keys
+	INNER JOIN evm.txes ON evm.txes.id = evm.tx_bundle_keys.bundle_tx_id
+	WHERE evm.tx_bundle_keys.idempotency_key = $1`, idempotencyKey)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return bundleKey, err
+}
+
+// Mark transactions corresponding to receipt IDs as finalized, and return them
+func (o *evmTxStore) UpdateTxStatesToFinalizedUsingReceiptIds(ctx context.Context, receiptIDs []int64, chainId *big.Int) ([]txmgrtypes.FinalizedTx, error) {
 	if len(receiptIDs) == 0 {
-		return nil
+		return nil, nil
 	}
 	var cancel context.CancelFunc
 	ctx, cancel = o.stopCh.Ctx(ctx)
 	defer cancel()
 	sql := `
-UPDATE evm.txes SET state = 'finalized' WHERE evm.txes.evm_chain_id = $1 AND evm.txes.id IN (SELECT evm.txes.id FROM evm.txes
+UPDATE evm.txes SET state = 'finalized', finalized_at = NOW() WHERE evm.txes.evm_chain_id = $1 AND evm.txes.id IN (SELECT evm.txes.id FROM evm.txes
 	INNER JOIN evm.tx_attempts ON evm.tx_attempts.eth_tx_id = evm.txes.id
 	INNER JOIN evm.receipts ON evm.receipts.tx_hash = evm.tx_attempts.hash
 	WHERE evm.receipts.id = ANY($2))
+RETURNING evm.txes.id, evm.txes.idempotency_key
 `
-	_, err := o.q.ExecContext(ctx, sql, chainId.String(), pq.Array(receiptIDs))
-	return err
+	var finalized []txmgrtypes.FinalizedTx
+	rows, err := o.q.QueryContext(ctx, sql, chainId.String(), pq.Array(receiptIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var tx txmgrtypes.FinalizedTx
+		if err = rows.Scan(&tx.ID, &tx.IdempotencyKey); err != nil {
+			return nil, err
+		}
+		finalized = append(finalized, tx)
+	}
+	return finalized, rows.Err()
 }
@@ -720,6 +720,63 @@ func Test_FindTxWithIdempotencyKey(t *testing.T) {
 	})
 }
 
+func TestORM_BundledIdempotencyKeys(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+	ctx := tests.Context(t)
+
+	bundleKey := "bundle"
+	etx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, big.NewInt(0), txRequestWithIdempotencyKey(bundleKey), func(req *txmgr.TxRequest) {
+		req.BundledIdempotencyKeys = []string{"write-1", "write-2"}
+	})
+
+	t.Run("returns nil if the request was not bundled", func(t *testing.T) {
+		key, err := txStore.FindBundleIdempotencyKey(ctx, "write-3")
+		require.NoError(t, err)
+		assert.Nil(t, key)
+	})
+
+	t.Run("returns the idempotency key of the bundle", func(t *testing.T) {
+		for _, k := range []string{"write-1", "write-2"} {
+			key, err := txStore.FindBundleIdempotencyKey(ctx, k)
+			require.NoError(t, err)
+			require.NotNil(t, key)
+			assert.Equal(t, bundleKey, *key)
+		}
+	})
+
+	t.Run("does not create a second bundle of a request", func(t *testing.T) {
+		otherBundleKey := "other-bundle"
+		_, err := txStore.CreateTransaction(ctx, txmgr.TxRequest{
+			FromAddress:            fromAddress,
+			IdempotencyKey:         &otherBundleKey,
+			Strategy:               txmgrcommon.NewSendEveryStrategy(),
+			BundledIdempotencyKeys: []string{"write-3", "write-1"},
+		}, big.NewInt(0))
+		require.Error(t, err)
+
+		etx, err := txStore.FindTxWithIdempotencyKey(ctx, otherBundleKey, big.NewInt(0))
+		require.NoError(t, err)
+		assert.Nil(t, etx)
+		key, err := txStore.FindBundleIdempotencyKey(ctx, "write-3")
+		require.NoError(t, err)
+		assert.Nil(t, key)
+	})
+
+	t.Run("removes the keys along with the bundle", func(t *testing.T) {
+		_, err := db.ExecContext(ctx, `DELETE FROM evm.txes WHERE id = $1`, etx.ID)
+		require.NoError(t, err)
+
+		key, err := txStore.FindBundleIdempotencyKey(ctx, "write-1")
+		require.NoError(t, err)
+		assert.Nil(t, key)
+	})
+}
+
 func TestORM_FindTxWithSequence(t *testing.T) {
 	t.Parallel()
 
@@ -1255,6 +1312,32 @@ func TestORM_FindNextUnstartedTransactionFromAddress(t *testing.T) {
 	})
 }
 
+func TestORM_FindUnstartedTransactionsFromAddress(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	ctx := tests.Context(t)
+
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+	mustInsertInProgressEthTxWithAttempt(t, txStore, 13, fromAddress)
+
+	etxs, err := txStore.FindUnstartedTransactionsFromAddress(ctx, fromAddress, testutils.FixtureChainID, 2)
+	require.NoError(t, err)
+	assert.Empty(t, etxs)
+
+	etx1 := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID)
+	etx2 := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID)
+	mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID)
+
+	etxs, err = txStore.FindUnstartedTransactionsFromAddress(ctx, fromAddress, testutils.FixtureChainID, 2)
+	require.NoError(t, err)
+	require.Len(t, etxs, 2)
+	assert.Equal(t, etx1.ID, etxs[0].ID)
+	assert.Equal(t, etx2.ID, etxs[1].ID)
+}
+
 func TestORM_UpdateTxFatalError(t *testing.T) {
 	t.Parallel()
 
@@ -1367,7 +1450,7 @@ func TestORM_UpdateTxUnstartedToInProgress(t *testing.T) {
 		evmTxmCfg := txmgr.NewEvmTxmConfig(ccfg.EVM())
 		ec := evmtest.NewEthClientMockWithDefaultChain(t)
 		txMgr := txmgr.NewEvmTxm(ec.ConfiguredChainID(), evmTxmCfg, ccfg.EVM().Transactions(), nil, logger.Test(t), nil, nil,
-			nil, txStore, nil, nil, nil, nil, nil)
+			nil, txStore, nil, nil, nil, nil, nil, nil)
 		err := txMgr.XXXTestAbandon(fromAddress) // mark transaction as abandoned
 		require.NoError(t, err)
 
@@ -1798,6 +1881,24 @@ func TestORM_CreateTransaction(t *testing.T) {
 		assert.Equal(t, fromAddress, dbEthTx.FromAddress)
 		assert.Equal(t, true, dbEthTx.SignalCallback)
 	})
+
+	t.Run("persists the blob sidecar", func(t *testing.T) {
+		sidecar := []byte{4, 5, 6}
+		etx, err := txStore.CreateTransaction(tests.Context(t), txmgr.TxRequest{
+			FromAddress:    fromAddress,
+			ToAddress:      toAddress,
+			EncodedPayload: payload,
+			FeeLimit:       gasLimit,
+			Strategy:       txmgrcommon.NewSendEveryStrategy(),
+			BlobSidecar:    sidecar,
+		}, ethClient.ConfiguredChainID())
+		require.NoError(t, err)
+		assert.Equal(t, sidecar, etx.BlobSidecar)
+
+		found, err := txStore.FindTxWithAttempts(tests.Context(t), etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, sidecar, found.BlobSidecar)
+	})
 }
 
 func TestORM_PruneUnstartedTxQueue(t *testing.T) {
@@ -1906,10 +2007,80 @@ func TestORM_UpdateTxesFinalized(t *testing.T) {
 		err = txStore.InsertTxAttempt(ctx, &attempt)
 		require.NoError(t, err)
 		receipt := mustInsertEthReceipt(t, txStore, 100, testutils.NewHash(), attempt.Hash)
-		err = txStore.UpdateTxStatesToFinalizedUsingReceiptIds(ctx, []int64{receipt.ID}, testutils.FixtureChainID)
+		finalized, err := txStore.UpdateTxStatesToFinalizedUsingReceiptIds(ctx, []int64{receipt.ID}, testutils.FixtureChainID)
 		require.NoError(t, err)
+		require.Len(t, finalized, 1)
+		require.Equal(t, tx.ID, finalized[0].ID)
 		etx, err := txStore.FindTxWithAttempts(ctx, tx.ID)
 		require.NoError(t, err)
 		require.Equal(t, txmgrcommon.TxFinalized, etx.State)
 	})
 }
+
+func TestORM_JobSpend(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+	ctx := tests.Context(t)
+	const jobID = int32(7)
+	since := time.Now().Add(-time.Hour)
+
+	etx := cltest.NewEthTx(fromAddress)
+	nonce := evmtypes.Nonce(1)
+	etx.Sequence = &nonce
+	etx.State = txmgrcommon.TxInProgress
+	meta := sqlutil.JSON(fmt.Sprintf(`{"JobID": %d}`, jobID))
+	etx.Meta = &meta
+	require.NoError(t, txStore.InsertTx(ctx, &etx))
+	attempt := cltest.NewLegacyEthTxAttempt(t, etx.ID)
+	require.NoError(t, txStore.InsertTxAttempt(ctx, &attempt))
+
+	t.Run("no spend before broadcast", func(t *testing.T) {
+		spend, err := txStore.FindJobSpend(ctx, jobID, since, testutils.FixtureChainID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), spend.Total().Int64())
+		require.NoError(t, txStore.CheckJobSpendLimit(ctx, jobID, big.NewInt(1), since, testutils.FixtureChainID))
+	})
+
+	t.Run("records attempted spend on broadcast", func(t *testing.T) {
+		now := time.Now()
+		etx.BroadcastAt = &now
+		etx.InitialBroadcastAt = &now
+		require.NoError(t, txStore.UpdateTxAttemptInProgressToBroadcast(ctx, &etx, attempt, txmgrtypes.TxAttemptBroadcast))
+
+		spend, err := txStore.FindJobSpend(ctx, jobID, since, testutils.FixtureChainID)
+		require.NoError(t, err)
+		// gas limit 42 * gas price 1 wei
+		assert.Equal(t, int64(42), spend.Attempted.Int64())
+		assert.Equal(t, int64(0), spend.Mined.Int64())
+	})
+
+	t.Run("records mined spend on receipt", func(t *testing.T) {
+		receipt := evmtypes.Receipt{
+			TxHash:            attempt.Hash,
+			BlockHash:         utils.NewHash(),
+			BlockNumber:       big.NewInt(42),
+			TransactionIndex:  uint(1),
+			GasUsed:           21,
+			EffectiveGasPrice: big.NewInt(3),
+		}
+		require.NoError(t, txStore.SaveFetchedReceipts(ctx, []*evmtypes.Receipt{&receipt}, txmgrcommon.TxConfirmed, nil, testutils.FixtureChainID))
+
+		spend, err := txStore.FindJobSpend(ctx, jobID, since, testutils.FixtureChainID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), spend.Attempted.Int64())
+		// gas used 21 * effective gas price 3 wei
+		assert.Equal(t, int64(63), spend.Mined.Int64())
+	})
+
+	t.Run("enforces limit", func(t *testing.T) {
+		require.NoError(t, txStore.CheckJobSpendLimit(ctx, jobID, big.NewInt(64), since, testutils.FixtureChainID))
+		require.ErrorContains(t, txStore.CheckJobSpendLimit(ctx, jobID, big.NewInt(63), since, testutils.FixtureChainID), "has reached its spend limit")
+		require.NoError(t, txStore.CheckJobSpendLimit(ctx, jobID+1, big.NewInt(63), since, testutils.FixtureChainID))
+		require.NoError(t, txStore.CheckJobSpendLimit(ctx, jobID, big.NewInt(63), time.Now().Add(time.Hour), testutils.FixtureChainID))
+		require.NoError(t, txStore.CheckJobSpendLimit(ctx, jobID, nil, since, testutils.FixtureChainID))
+	})
+}
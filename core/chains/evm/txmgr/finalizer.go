@@ -9,11 +9,15 @@ import (
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/mailbox"
 
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/chaintype"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
 )
@@ -23,9 +27,35 @@ var _ Finalizer = (*evmFinalizer)(nil)
 // processHeadTimeout represents a sanity limit on how long ProcessHead should take to complete
 const processHeadTimeout = 10 * time.Minute
 
+var (
+	promNumFinalizedTxs = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tx_manager_num_finalized_transactions",
+		Help: "Total number of transactions marked as finalized",
+	}, []string{"chainID"})
+	promTimeUntilTxFinalized = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "tx_manager_time_until_tx_finalized",
+		Help: "The amount of time elapsed from a transaction receipt being stored to the transaction being marked as finalized, in seconds.",
+		Buckets: []float64{
+			time.Second.Seconds(),
+			(15 * time.Second).Seconds(),
+			(30 * time.Second).Seconds(),
+			time.Minute.Seconds(),
+			(2 * time.Minute).Seconds(),
+			(5 * time.Minute).Seconds(),
+			(10 * time.Minute).Seconds(),
+			(20 * time.Minute).Seconds(),
+			(40 * time.Minute).Seconds(),
+			time.Hour.Seconds(),
+			(2 * time.Hour).Seconds(),
+			(6 * time.Hour).Seconds(),
+			(24 * time.Hour).Seconds(),
+		},
+	}, []string{"chainID"})
+)
+
 type finalizerTxStore interface {
 	FindConfirmedTxesReceipts(ctx context.Context, finalizedBlockNum int64, chainID *big.Int) ([]Receipt, error)
-	UpdateTxStatesToFinalizedUsingReceiptIds(ctx context.Context, txs []int64, chainId *big.Int) error
+	UpdateTxStatesToFinalizedUsingReceiptIds(ctx context.Context, txs []int64, chainId *big.Int) ([]txmgrtypes.FinalizedTx, error)
 }
 
 type finalizerChainClient interface {
@@ -37,10 +67,15 @@ type finalizerHeadTracker interface {
 }
 
 // Finalizer handles processing new finalized blocks and marking transactions as finalized accordingly in the TXM DB
+//
+// A transaction is finalized once its receipt is in a finalized block still in the canonical chain. On chains where
+// the finalized block doesn't guarantee the finality of the L1 batch which includes it, the batch must also be proven
+// on L1, which is checked with chain-specific RPCs.
 type evmFinalizer struct {
 	services.StateMachine
 	lggr         logger.SugaredLogger
 	chainId      *big.Int
+	chainType    chaintype.ChainType
 	rpcBatchSize int
 
 	txStore     finalizerTxStore
@@ -52,11 +87,13 @@ type evmFinalizer struct {
 	wg     sync.WaitGroup
 
 	lastProcessedFinalizedBlockNum int64
+	finalizedCallbacks             []txmgrtypes.FinalizedCallback
 }
 
 func NewEvmFinalizer(
 	lggr logger.Logger,
 	chainId *big.Int,
+	chainType chaintype.ChainType,
 	rpcBatchSize uint32,
 	txStore finalizerTxStore,
 	client finalizerChainClient,
@@ -66,6 +103,7 @@ func NewEvmFinalizer(
 	return &evmFinalizer{
 		lggr:         logger.Sugared(lggr),
 		chainId:      chainId,
+		chainType:    chainType,
 		rpcBatchSize: int(rpcBatchSize),
 		txStore:      txStore,
 		client:       client,
@@ -130,6 +168,12 @@ func (f *evmFinalizer) runLoop() {
 	}
 }
 
+// RegisterFinalizedCallback registers fn to be called with the transactions marked as finalized. It must be called
+// before Start.
+func (f *evmFinalizer) RegisterFinalizedCallback(fn txmgrtypes.FinalizedCallback) {
+	f.finalizedCallbacks = append(f.finalizedCallbacks, fn)
+}
+
 func (f *evmFinalizer) DeliverLatestHead(head *evmtypes.Head) bool {
 	return f.mb.Deliver(head)
 }
@@ -204,12 +248,19 @@ func (f *evmFinalizer) processFinalizedHead(ctx context.Context, latestFinalized
 	validatedReceipts := f.batchCheckReceiptHashesOnchain(ctx, blockNumToReceiptsMap)
 	finalizedReceipts = append(finalizedReceipts, validatedReceipts...)
 
+	// Check the L1 finality of the receipts on chains which require it
+	finalizedReceipts, err = f.checkL1Finality(ctx, finalizedReceipts)
+	if err != nil {
+		return fmt.Errorf("failed to check the L1 finality of receipts: %w", err)
+	}
+
 	receiptIDs := f.buildReceiptIdList(finalizedReceipts)
 
-	err = f.txStore.UpdateTxStatesToFinalizedUsingReceiptIds(ctx, receiptIDs, f.chainId)
+	finalizedTxs, err := f.txStore.UpdateTxStatesToFinalizedUsingReceiptIds(ctx, receiptIDs, f.chainId)
 	if err != nil {
 		return fmt.Errorf("failed to update transactions as finalized: %w", err)
 	}
+	f.observeFinalized(ctx, finalizedReceipts, finalizedTxs)
 	// Update lastProcessedFinalizedBlockNum after processing has completed to allow failed processing to retry on subsequent heads
 	// Does not need to be protected with mutex lock because the Finalizer only runs in a single loop
 	f.lastProcessedFinalizedBlockNum = latestFinalizedHead.BlockNumber()
@@ -280,6 +331,77 @@ func (f *evmFinalizer) batchCheckReceiptHashesOnchain(ctx context.Context, block
 	return finalizedReceipts
 }
 
+// checkL1Finality returns the receipts whose L1 batch is final, on the chains where the finalized block doesn't imply it
+func (f *evmFinalizer) checkL1Finality(ctx context.Context, receipts []Receipt) ([]Receipt, error) {
+	if len(receipts) == 0 {
+		return receipts, nil
+	}
+	switch f.chainType {
+	case chaintype.ChainZkEvm, chaintype.ChainXLayer:
+		return f.checkL1FinalityZkEVM(ctx, receipts)
+	default:
+		return receipts, nil
+	}
+}
+
+// checkL1FinalityZkEVM returns the receipts whose block is consolidated, that is whose batch was verified on L1 with
+// a validity proof.
+func (f *evmFinalizer) checkL1FinalityZkEVM(ctx context.Context, receipts []Receipt) ([]Receipt, error) {
+	blockNums := make(map[int64]*bool)
+	var reqs []rpc.BatchElem
+	for _, receipt := range receipts {
+		if _, ok := blockNums[receipt.BlockNumber]; ok {
+			continue
+		}
+		consolidated := new(bool)
+		blockNums[receipt.BlockNumber] = consolidated
+		reqs = append(reqs, rpc.BatchElem{
+			Method: "zkevm_isBlockConsolidated",
+			Args:   []any{hexutil.EncodeBig(big.NewInt(receipt.BlockNumber))},
+			Result: consolidated,
+		})
+	}
+	for i := 0; i < len(reqs); i += f.rpcBatchSize {
+		batch := reqs[i:min(i+f.rpcBatchSize, len(reqs))]
+		if err := f.client.BatchCallContext(ctx, batch); err != nil {
+			return nil, err
+		}
+		for _, req := range batch {
+			if req.Error != nil {
+				// The block is considered not consolidated, and is checked again with the next finalized head
+				f.lggr.Errorw("failed to check whether block is consolidated", "blockNum", req.Args[0], "error", req.Error)
+			}
+		}
+	}
+	var final []Receipt
+	for _, receipt := range receipts {
+		if *blockNums[receipt.BlockNumber] {
+			final = append(final, receipt)
+		} else {
+			f.lggr.Debugw("transaction in finalized block not final on L1 yet", "txHash", receipt.TxHash.String(), "receiptBlockNum", receipt.BlockNumber)
+		}
+	}
+	return final, nil
+}
+
+// observeFinalized reports the finalization metrics of the receipts and calls the finalized callbacks.
+func (f *evmFinalizer) observeFinalized(ctx context.Context, receipts []Receipt, txs []txmgrtypes.FinalizedTx) {
+	now := time.Now()
+	chainID := f.chainId.String()
+	for _, receipt := range receipts {
+		if !receipt.CreatedAt.IsZero() {
+			promTimeUntilTxFinalized.WithLabelValues(chainID).Observe(now.Sub(receipt.CreatedAt).Seconds())
+		}
+	}
+	promNumFinalizedTxs.WithLabelValues(chainID).Add(float64(len(txs)))
+	if len(txs) == 0 {
+		return
+	}
+	for _, fn := range f.finalizedCallbacks {
+		fn(ctx, txs)
+	}
+}
+
 // Build list of transaction IDs
 func (f *evmFinalizer) buildReceiptIdList(finalizedReceipts []Receipt) []int64 {
 	receiptIds := make([]int64, len(finalizedReceipts))
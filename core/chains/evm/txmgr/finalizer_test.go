@@ -1,6 +1,7 @@
 package txmgr_test
 
 import (
+	"context"
 	"errors"
 	"math/big"
 	"testing"
@@ -18,7 +19,9 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
 
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/chaintype"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
@@ -51,7 +54,7 @@ func TestFinalizer_MarkTxFinalized(t *testing.T) {
 	head.Parent.Store(h99)
 
 	t.Run("returns not finalized for tx with receipt newer than finalized block", func(t *testing.T) {
-		finalizer := txmgr.NewEvmFinalizer(logger.Test(t), testutils.FixtureChainID, rpcBatchSize, txStore, ethClient, ht)
+		finalizer := txmgr.NewEvmFinalizer(logger.Test(t), testutils.FixtureChainID, "", rpcBatchSize, txStore, ethClient, ht)
 		servicetest.Run(t, finalizer)
 
 		idempotencyKey := uuid.New().String()
@@ -81,7 +84,7 @@ func TestFinalizer_MarkTxFinalized(t *testing.T) {
 	})
 
 	t.Run("returns not finalized for tx with receipt re-org'd out", func(t *testing.T) {
-		finalizer := txmgr.NewEvmFinalizer(logger.Test(t), testutils.FixtureChainID, rpcBatchSize, txStore, ethClient, ht)
+		finalizer := txmgr.NewEvmFinalizer(logger.Test(t), testutils.FixtureChainID, "", rpcBatchSize, txStore, ethClient, ht)
 		servicetest.Run(t, finalizer)
 
 		idempotencyKey := uuid.New().String()
@@ -111,7 +114,11 @@ func TestFinalizer_MarkTxFinalized(t *testing.T) {
 	})
 
 	t.Run("returns finalized for tx with receipt in a finalized block", func(t *testing.T) {
-		finalizer := txmgr.NewEvmFinalizer(logger.Test(t), testutils.FixtureChainID, rpcBatchSize, txStore, ethClient, ht)
+		finalizer := txmgr.NewEvmFinalizer(logger.Test(t), testutils.FixtureChainID, "", rpcBatchSize, txStore, ethClient, ht)
+		var finalized []txmgrtypes.FinalizedTx
+		finalizer.RegisterFinalizedCallback(func(_ context.Context, txs []txmgrtypes.FinalizedTx) {
+			finalized = append(finalized, txs...)
+		})
 		servicetest.Run(t, finalizer)
 
 		idempotencyKey := uuid.New().String()
@@ -138,10 +145,50 @@ func TestFinalizer_MarkTxFinalized(t *testing.T) {
 		tx, err = txStore.FindTxWithIdempotencyKey(ctx, idempotencyKey, testutils.FixtureChainID)
 		require.NoError(t, err)
 		require.Equal(t, txmgrcommon.TxFinalized, tx.State)
+		require.Len(t, finalized, 1)
+		require.Equal(t, tx.ID, finalized[0].ID)
+		require.Equal(t, idempotencyKey, *finalized[0].IdempotencyKey)
+	})
+
+	t.Run("returns not finalized for zkEVM tx with receipt in a finalized block not consolidated on L1", func(t *testing.T) {
+		finalizer := txmgr.NewEvmFinalizer(logger.Test(t), testutils.FixtureChainID, chaintype.ChainZkEvm, rpcBatchSize, txStore, ethClient, ht)
+		servicetest.Run(t, finalizer)
+
+		idempotencyKey := uuid.New().String()
+		_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+		nonce := evmtypes.Nonce(0)
+		broadcast := time.Now()
+		tx := &txmgr.Tx{
+			Sequence:           &nonce,
+			IdempotencyKey:     &idempotencyKey,
+			FromAddress:        fromAddress,
+			EncodedPayload:     []byte{1, 2, 3},
+			FeeLimit:           feeLimit,
+			State:              txmgrcommon.TxConfirmed,
+			BroadcastAt:        &broadcast,
+			InitialBroadcastAt: &broadcast,
+		}
+		attemptHash := insertTxAndAttemptWithIdempotencyKey(t, txStore, tx, idempotencyKey)
+		// Insert receipt for finalized block num
+		mustInsertEthReceipt(t, txStore, head.Parent.Load().Number, head.Parent.Load().Hash, attemptHash)
+		ethClient.On("BatchCallContext", mock.Anything, mock.IsType([]rpc.BatchElem{})).Run(func(args mock.Arguments) {
+			rpcElements := args.Get(1).([]rpc.BatchElem)
+			require.Equal(t, 1, len(rpcElements))
+			require.Equal(t, "zkevm_isBlockConsolidated", rpcElements[0].Method)
+			require.Equal(t, hexutil.EncodeBig(big.NewInt(head.Parent.Load().Number)), rpcElements[0].Args[0])
+			*rpcElements[0].Result.(*bool) = false
+		}).Return(nil).Once()
+		ethClient.On("HeadByNumber", mock.Anything, mock.Anything).Return(head, nil).Once()
+		ethClient.On("LatestFinalizedBlock", mock.Anything).Return(head.Parent.Load(), nil).Once()
+		err := finalizer.ProcessHead(ctx, head)
+		require.NoError(t, err)
+		tx, err = txStore.FindTxWithIdempotencyKey(ctx, idempotencyKey, testutils.FixtureChainID)
+		require.NoError(t, err)
+		require.Equal(t, txmgrcommon.TxConfirmed, tx.State)
 	})
 
 	t.Run("returns finalized for tx with receipt older than block history depth", func(t *testing.T) {
-		finalizer := txmgr.NewEvmFinalizer(logger.Test(t), testutils.FixtureChainID, rpcBatchSize, txStore, ethClient, ht)
+		finalizer := txmgr.NewEvmFinalizer(logger.Test(t), testutils.FixtureChainID, "", rpcBatchSize, txStore, ethClient, ht)
 		servicetest.Run(t, finalizer)
 
 		idempotencyKey := uuid.New().String()
@@ -209,7 +256,7 @@ func TestFinalizer_MarkTxFinalized(t *testing.T) {
 	})
 
 	t.Run("returns error if failed to retrieve latest head in headtracker", func(t *testing.T) {
-		finalizer := txmgr.NewEvmFinalizer(logger.Test(t), testutils.FixtureChainID, rpcBatchSize, txStore, ethClient, ht)
+		finalizer := txmgr.NewEvmFinalizer(logger.Test(t), testutils.FixtureChainID, "", rpcBatchSize, txStore, ethClient, ht)
 		servicetest.Run(t, finalizer)
 
 		ethClient.On("HeadByNumber", mock.Anything, mock.Anything).Return(nil, errors.New("failed to get latest head")).Once()
@@ -218,7 +265,7 @@ func TestFinalizer_MarkTxFinalized(t *testing.T) {
 	})
 
 	t.Run("returns error if failed to calculate latest finalized head in headtracker", func(t *testing.T) {
-		finalizer := txmgr.NewEvmFinalizer(logger.Test(t), testutils.FixtureChainID, rpcBatchSize, txStore, ethClient, ht)
+		finalizer := txmgr.NewEvmFinalizer(logger.Test(t), testutils.FixtureChainID, "", rpcBatchSize, txStore, ethClient, ht)
 		servicetest.Run(t, finalizer)
 
 		ethClient.On("HeadByNumber", mock.Anything, mock.Anything).Return(head, nil).Once()
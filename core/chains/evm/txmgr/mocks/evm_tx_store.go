@@ -86,6 +86,56 @@ func (_c *EvmTxStore_Abandon_Call) RunAndReturn(run func(context.Context, *big.I
 	return _c
 }
 
+// CheckJobSpendLimit provides a mock function with given fields: ctx, jobID, limit, since, chainID
+func (_m *EvmTxStore) CheckJobSpendLimit(ctx context.Context, jobID int32, limit *big.Int, since time.Time, chainID *big.Int) error {
+	ret := _m.Called(ctx, jobID, limit, since, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckJobSpendLimit")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, *big.Int, time.Time, *big.Int) error); ok {
+		r0 = rf(ctx, jobID, limit, since, chainID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EvmTxStore_CheckJobSpendLimit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckJobSpendLimit'
+type EvmTxStore_CheckJobSpendLimit_Call struct {
+	*mock.Call
+}
+
+// CheckJobSpendLimit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+//   - limit *big.Int
+//   - since time.Time
+//   - chainID *big.Int
+func (_e *EvmTxStore_Expecter) CheckJobSpendLimit(ctx interface{}, jobID interface{}, limit interface{}, since interface{}, chainID interface{}) *EvmTxStore_CheckJobSpendLimit_Call {
+	return &EvmTxStore_CheckJobSpendLimit_Call{Call: _e.mock.On("CheckJobSpendLimit", ctx, jobID, limit, since, chainID)}
+}
+
+func (_c *EvmTxStore_CheckJobSpendLimit_Call) Run(run func(ctx context.Context, jobID int32, limit *big.Int, since time.Time, chainID *big.Int)) *EvmTxStore_CheckJobSpendLimit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32), args[2].(*big.Int), args[3].(time.Time), args[4].(*big.Int))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_CheckJobSpendLimit_Call) Return(err error) *EvmTxStore_CheckJobSpendLimit_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *EvmTxStore_CheckJobSpendLimit_Call) RunAndReturn(run func(context.Context, int32, *big.Int, time.Time, *big.Int) error) *EvmTxStore_CheckJobSpendLimit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CheckTxQueueCapacity provides a mock function with given fields: ctx, fromAddress, maxQueuedTransactions, chainID
 func (_m *EvmTxStore) CheckTxQueueCapacity(ctx context.Context, fromAddress common.Address, maxQueuedTransactions uint64, chainID *big.Int) error {
 	ret := _m.Called(ctx, fromAddress, maxQueuedTransactions, chainID)
@@ -135,6 +185,55 @@ func (_c *EvmTxStore_CheckTxQueueCapacity_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// ClearNonceGaps provides a mock function with given fields: ctx, fromAddress, chainID, onChainNonce
+func (_m *EvmTxStore) ClearNonceGaps(ctx context.Context, fromAddress common.Address, chainID *big.Int, onChainNonce evmtypes.Nonce) error {
+	ret := _m.Called(ctx, fromAddress, chainID, onChainNonce)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClearNonceGaps")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int, evmtypes.Nonce) error); ok {
+		r0 = rf(ctx, fromAddress, chainID, onChainNonce)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EvmTxStore_ClearNonceGaps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClearNonceGaps'
+type EvmTxStore_ClearNonceGaps_Call struct {
+	*mock.Call
+}
+
+// ClearNonceGaps is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromAddress common.Address
+//   - chainID *big.Int
+//   - onChainNonce evmtypes.Nonce
+func (_e *EvmTxStore_Expecter) ClearNonceGaps(ctx interface{}, fromAddress interface{}, chainID interface{}, onChainNonce interface{}) *EvmTxStore_ClearNonceGaps_Call {
+	return &EvmTxStore_ClearNonceGaps_Call{Call: _e.mock.On("ClearNonceGaps", ctx, fromAddress, chainID, onChainNonce)}
+}
+
+func (_c *EvmTxStore_ClearNonceGaps_Call) Run(run func(ctx context.Context, fromAddress common.Address, chainID *big.Int, onChainNonce evmtypes.Nonce)) *EvmTxStore_ClearNonceGaps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(common.Address), args[2].(*big.Int), args[3].(evmtypes.Nonce))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_ClearNonceGaps_Call) Return(_a0 error) *EvmTxStore_ClearNonceGaps_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EvmTxStore_ClearNonceGaps_Call) RunAndReturn(run func(context.Context, common.Address, *big.Int, evmtypes.Nonce) error) *EvmTxStore_ClearNonceGaps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function with given fields:
 func (_m *EvmTxStore) Close() {
 	_m.Called()
@@ -446,6 +545,65 @@ func (_c *EvmTxStore_DeleteInProgressAttempt_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// FindBundleIdempotencyKey provides a mock function with given fields: ctx, idempotencyKey
+func (_m *EvmTxStore) FindBundleIdempotencyKey(ctx context.Context, idempotencyKey string) (*string, error) {
+	ret := _m.Called(ctx, idempotencyKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindBundleIdempotencyKey")
+	}
+
+	var r0 *string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*string, error)); ok {
+		return rf(ctx, idempotencyKey)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *string); ok {
+		r0 = rf(ctx, idempotencyKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, idempotencyKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_FindBundleIdempotencyKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindBundleIdempotencyKey'
+type EvmTxStore_FindBundleIdempotencyKey_Call struct {
+	*mock.Call
+}
+
+// FindBundleIdempotencyKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - idempotencyKey string
+func (_e *EvmTxStore_Expecter) FindBundleIdempotencyKey(ctx interface{}, idempotencyKey interface{}) *EvmTxStore_FindBundleIdempotencyKey_Call {
+	return &EvmTxStore_FindBundleIdempotencyKey_Call{Call: _e.mock.On("FindBundleIdempotencyKey", ctx, idempotencyKey)}
+}
+
+func (_c *EvmTxStore_FindBundleIdempotencyKey_Call) Run(run func(ctx context.Context, idempotencyKey string)) *EvmTxStore_FindBundleIdempotencyKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_FindBundleIdempotencyKey_Call) Return(bundleKey *string, err error) *EvmTxStore_FindBundleIdempotencyKey_Call {
+	_c.Call.Return(bundleKey, err)
+	return _c
+}
+
+func (_c *EvmTxStore_FindBundleIdempotencyKey_Call) RunAndReturn(run func(context.Context, string) (*string, error)) *EvmTxStore_FindBundleIdempotencyKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindConfirmedTxesReceipts provides a mock function with given fields: ctx, finalizedBlockNum, chainID
 func (_m *EvmTxStore) FindConfirmedTxesReceipts(ctx context.Context, finalizedBlockNum int64, chainID *big.Int) ([]txmgr.DbReceipt, error) {
 	ret := _m.Called(ctx, finalizedBlockNum, chainID)
@@ -620,6 +778,65 @@ func (_c *EvmTxStore_FindEarliestUnconfirmedTxAttemptBlock_Call) RunAndReturn(ru
 	return _c
 }
 
+// FindJobSpend provides a mock function with given fields: ctx, jobID, since, chainID
+func (_m *EvmTxStore) FindJobSpend(ctx context.Context, jobID int32, since time.Time, chainID *big.Int) (txmgr.JobSpend, error) {
+	ret := _m.Called(ctx, jobID, since, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindJobSpend")
+	}
+
+	var r0 txmgr.JobSpend
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, time.Time, *big.Int) (txmgr.JobSpend, error)); ok {
+		return rf(ctx, jobID, since, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32, time.Time, *big.Int) txmgr.JobSpend); ok {
+		r0 = rf(ctx, jobID, since, chainID)
+	} else {
+		r0 = ret.Get(0).(txmgr.JobSpend)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32, time.Time, *big.Int) error); ok {
+		r1 = rf(ctx, jobID, since, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_FindJobSpend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindJobSpend'
+type EvmTxStore_FindJobSpend_Call struct {
+	*mock.Call
+}
+
+// FindJobSpend is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+//   - since time.Time
+//   - chainID *big.Int
+func (_e *EvmTxStore_Expecter) FindJobSpend(ctx interface{}, jobID interface{}, since interface{}, chainID interface{}) *EvmTxStore_FindJobSpend_Call {
+	return &EvmTxStore_FindJobSpend_Call{Call: _e.mock.On("FindJobSpend", ctx, jobID, since, chainID)}
+}
+
+func (_c *EvmTxStore_FindJobSpend_Call) Run(run func(ctx context.Context, jobID int32, since time.Time, chainID *big.Int)) *EvmTxStore_FindJobSpend_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32), args[2].(time.Time), args[3].(*big.Int))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_FindJobSpend_Call) Return(_a0 txmgr.JobSpend, _a1 error) *EvmTxStore_FindJobSpend_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EvmTxStore_FindJobSpend_Call) RunAndReturn(run func(context.Context, int32, time.Time, *big.Int) (txmgr.JobSpend, error)) *EvmTxStore_FindJobSpend_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindLatestSequence provides a mock function with given fields: ctx, fromAddress, chainId
 func (_m *EvmTxStore) FindLatestSequence(ctx context.Context, fromAddress common.Address, chainId *big.Int) (evmtypes.Nonce, error) {
 	ret := _m.Called(ctx, fromAddress, chainId)
@@ -1822,6 +2039,67 @@ func (_c *EvmTxStore_FindTxsRequiringResubmissionDueToInsufficientFunds_Call) Ru
 	return _c
 }
 
+// FindUnstartedTransactionsFromAddress provides a mock function with given fields: ctx, fromAddress, chainID, limit
+func (_m *EvmTxStore) FindUnstartedTransactionsFromAddress(ctx context.Context, fromAddress common.Address, chainID *big.Int, limit int) ([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
+	ret := _m.Called(ctx, fromAddress, chainID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindUnstartedTransactionsFromAddress")
+	}
+
+	var r0 []*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int, int) ([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)); ok {
+		return rf(ctx, fromAddress, chainID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int, int) []*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]); ok {
+		r0 = rf(ctx, fromAddress, chainID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, *big.Int, int) error); ok {
+		r1 = rf(ctx, fromAddress, chainID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_FindUnstartedTransactionsFromAddress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUnstartedTransactionsFromAddress'
+type EvmTxStore_FindUnstartedTransactionsFromAddress_Call struct {
+	*mock.Call
+}
+
+// FindUnstartedTransactionsFromAddress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromAddress common.Address
+//   - chainID *big.Int
+//   - limit int
+func (_e *EvmTxStore_Expecter) FindUnstartedTransactionsFromAddress(ctx interface{}, fromAddress interface{}, chainID interface{}, limit interface{}) *EvmTxStore_FindUnstartedTransactionsFromAddress_Call {
+	return &EvmTxStore_FindUnstartedTransactionsFromAddress_Call{Call: _e.mock.On("FindUnstartedTransactionsFromAddress", ctx, fromAddress, chainID, limit)}
+}
+
+func (_c *EvmTxStore_FindUnstartedTransactionsFromAddress_Call) Run(run func(ctx context.Context, fromAddress common.Address, chainID *big.Int, limit int)) *EvmTxStore_FindUnstartedTransactionsFromAddress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(common.Address), args[2].(*big.Int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_FindUnstartedTransactionsFromAddress_Call) Return(_a0 []*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], _a1 error) *EvmTxStore_FindUnstartedTransactionsFromAddress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EvmTxStore_FindUnstartedTransactionsFromAddress_Call) RunAndReturn(run func(context.Context, common.Address, *big.Int, int) ([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)) *EvmTxStore_FindUnstartedTransactionsFromAddress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAbandonedTransactionsByBatch provides a mock function with given fields: ctx, chainID, enabledAddrs, offset, limit
 func (_m *EvmTxStore) GetAbandonedTransactionsByBatch(ctx context.Context, chainID *big.Int, enabledAddrs []common.Address, offset uint, limit uint) ([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
 	ret := _m.Called(ctx, chainID, enabledAddrs, offset, limit)
@@ -2613,6 +2891,54 @@ func (_c *EvmTxStore_SaveInsufficientFundsAttempt_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// SaveNonceGap provides a mock function with given fields: ctx, etxID, onChainNonce
+func (_m *EvmTxStore) SaveNonceGap(ctx context.Context, etxID int64, onChainNonce evmtypes.Nonce) error {
+	ret := _m.Called(ctx, etxID, onChainNonce)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveNonceGap")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, evmtypes.Nonce) error); ok {
+		r0 = rf(ctx, etxID, onChainNonce)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EvmTxStore_SaveNonceGap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveNonceGap'
+type EvmTxStore_SaveNonceGap_Call struct {
+	*mock.Call
+}
+
+// SaveNonceGap is a helper method to define mock.On call
+//   - ctx context.Context
+//   - etxID int64
+//   - onChainNonce evmtypes.Nonce
+func (_e *EvmTxStore_Expecter) SaveNonceGap(ctx interface{}, etxID interface{}, onChainNonce interface{}) *EvmTxStore_SaveNonceGap_Call {
+	return &EvmTxStore_SaveNonceGap_Call{Call: _e.mock.On("SaveNonceGap", ctx, etxID, onChainNonce)}
+}
+
+func (_c *EvmTxStore_SaveNonceGap_Call) Run(run func(ctx context.Context, etxID int64, onChainNonce evmtypes.Nonce)) *EvmTxStore_SaveNonceGap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(evmtypes.Nonce))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_SaveNonceGap_Call) Return(_a0 error) *EvmTxStore_SaveNonceGap_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EvmTxStore_SaveNonceGap_Call) RunAndReturn(run func(context.Context, int64, evmtypes.Nonce) error) *EvmTxStore_SaveNonceGap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SaveReplacementInProgressAttempt provides a mock function with given fields: ctx, oldAttempt, replacementAttempt
 func (_m *EvmTxStore) SaveReplacementInProgressAttempt(ctx context.Context, oldAttempt types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], replacementAttempt *types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) error {
 	ret := _m.Called(ctx, oldAttempt, replacementAttempt)
@@ -2758,6 +3084,73 @@ func (_c *EvmTxStore_SetBroadcastBeforeBlockNum_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// StuckTransactions provides a mock function with given fields: ctx, offset, limit
+func (_m *EvmTxStore) StuckTransactions(ctx context.Context, offset int, limit int) ([]types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], int, error) {
+	ret := _m.Called(ctx, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StuckTransactions")
+	}
+
+	var r0 []types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) ([]types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], int, error)); ok {
+		return rf(ctx, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) []types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]); ok {
+		r0 = rf(ctx, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) int); ok {
+		r1 = rf(ctx, offset, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int, int) error); ok {
+		r2 = rf(ctx, offset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// EvmTxStore_StuckTransactions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StuckTransactions'
+type EvmTxStore_StuckTransactions_Call struct {
+	*mock.Call
+}
+
+// StuckTransactions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - offset int
+//   - limit int
+func (_e *EvmTxStore_Expecter) StuckTransactions(ctx interface{}, offset interface{}, limit interface{}) *EvmTxStore_StuckTransactions_Call {
+	return &EvmTxStore_StuckTransactions_Call{Call: _e.mock.On("StuckTransactions", ctx, offset, limit)}
+}
+
+func (_c *EvmTxStore_StuckTransactions_Call) Run(run func(ctx context.Context, offset int, limit int)) *EvmTxStore_StuckTransactions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_StuckTransactions_Call) Return(_a0 []types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], _a1 int, _a2 error) *EvmTxStore_StuckTransactions_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *EvmTxStore_StuckTransactions_Call) RunAndReturn(run func(context.Context, int, int) ([]types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], int, error)) *EvmTxStore_StuckTransactions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Transactions provides a mock function with given fields: ctx, offset, limit
 func (_m *EvmTxStore) Transactions(ctx context.Context, offset int, limit int) ([]types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], int, error) {
 	ret := _m.Called(ctx, offset, limit)
@@ -3200,21 +3593,33 @@ func (_c *EvmTxStore_UpdateTxForRebroadcast_Call) RunAndReturn(run func(context.
 }
 
 // UpdateTxStatesToFinalizedUsingReceiptIds provides a mock function with given fields: ctx, etxIDs, chainId
-func (_m *EvmTxStore) UpdateTxStatesToFinalizedUsingReceiptIds(ctx context.Context, etxIDs []int64, chainId *big.Int) error {
+func (_m *EvmTxStore) UpdateTxStatesToFinalizedUsingReceiptIds(ctx context.Context, etxIDs []int64, chainId *big.Int) ([]types.FinalizedTx, error) {
 	ret := _m.Called(ctx, etxIDs, chainId)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateTxStatesToFinalizedUsingReceiptIds")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, []int64, *big.Int) error); ok {
+	var r0 []types.FinalizedTx
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64, *big.Int) ([]types.FinalizedTx, error)); ok {
+		return rf(ctx, etxIDs, chainId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int64, *big.Int) []types.FinalizedTx); ok {
 		r0 = rf(ctx, etxIDs, chainId)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.FinalizedTx)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, []int64, *big.Int) error); ok {
+		r1 = rf(ctx, etxIDs, chainId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // EvmTxStore_UpdateTxStatesToFinalizedUsingReceiptIds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTxStatesToFinalizedUsingReceiptIds'
@@ -3237,12 +3642,12 @@ func (_c *EvmTxStore_UpdateTxStatesToFinalizedUsingReceiptIds_Call) Run(run func
 	return _c
 }
 
-func (_c *EvmTxStore_UpdateTxStatesToFinalizedUsingReceiptIds_Call) Return(_a0 error) *EvmTxStore_UpdateTxStatesToFinalizedUsingReceiptIds_Call {
-	_c.Call.Return(_a0)
+func (_c *EvmTxStore_UpdateTxStatesToFinalizedUsingReceiptIds_Call) Return(_a0 []types.FinalizedTx, _a1 error) *EvmTxStore_UpdateTxStatesToFinalizedUsingReceiptIds_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *EvmTxStore_UpdateTxStatesToFinalizedUsingReceiptIds_Call) RunAndReturn(run func(context.Context, []int64, *big.Int) error) *EvmTxStore_UpdateTxStatesToFinalizedUsingReceiptIds_Call {
+func (_c *EvmTxStore_UpdateTxStatesToFinalizedUsingReceiptIds_Call) RunAndReturn(run func(context.Context, []int64, *big.Int) ([]types.FinalizedTx, error)) *EvmTxStore_UpdateTxStatesToFinalizedUsingReceiptIds_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -39,6 +39,7 @@ type (
 	Receipt                = DbReceipt // DbReceipt is the exported DB table model for receipts
 	ReceiptPlus            = txmgrtypes.ReceiptPlus[*evmtypes.Receipt]
 	StuckTxDetector        = txmgrtypes.StuckTxDetector[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
+	BumpStrategy           = txmgrtypes.BumpStrategy[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
 	TxmClient              = txmgrtypes.TxmClient[*big.Int, common.Address, common.Hash, common.Hash, *evmtypes.Receipt, evmtypes.Nonce, gas.EvmFee]
 	TransactionClient      = txmgrtypes.TransactionClient[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
 	ChainReceipt           = txmgrtypes.ChainReceipt[common.Hash, common.Hash]
@@ -74,3 +75,17 @@ func GetGethSignedTx(signedRawTx []byte) (*types.Transaction, error) {
 	}
 	return signedTx, nil
 }
+
+// JobSpend is the amount of wei spent on transaction fees on behalf of a job.
+type JobSpend struct {
+	JobID int32
+	// Attempted is the highest possible fee of broadcast transactions which are not mined yet.
+	Attempted *big.Int
+	// Mined is the fee of mined transactions.
+	Mined *big.Int
+}
+
+// Total returns the sum of attempted and mined spend.
+func (s JobSpend) Total() *big.Int {
+	return new(big.Int).Add(s.Attempted, s.Mined)
+}
@@ -0,0 +1,115 @@
+package txmgr
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+// NonceCoordinator reserves the nonces of keys which may be shared with other nodes, so that no two nodes ever assign the
+// same nonce of a key.
+type NonceCoordinator interface {
+	// ReserveNonce reserves the next nonce of the address, which is never lower than the next nonce known locally
+	ReserveNonce(ctx context.Context, address common.Address, chainID *big.Int, localNextNonce evmtypes.Nonce) (evmtypes.Nonce, error)
+}
+
+// ErrNonceReservationPending is returned while the next nonce of a key is reserved by another node, which has not
+// assigned it yet.
+var ErrNonceReservationPending = errors.New("nonce is reserved by another node and not assigned yet")
+
+type dbNonceCoordinator struct {
+	ds                 sqlutil.DataSource
+	lggr               logger.SugaredLogger
+	owner              uuid.UUID
+	lockTimeout        time.Duration
+	reservationTimeout time.Duration
+}
+
+var _ NonceCoordinator = (*dbNonceCoordinator)(nil)
+
+// NewDBNonceCoordinator returns a NonceCoordinator which reserves nonces in the evm.key_nonce_reservations table, under
+// an advisory lock per key. It only coordinates nodes sharing the same database. A nonce reserved by another node, such
+// as a node which crashed before assigning it, is only reused once no tx of the key was assigned it for reservationTimeout.
+func NewDBNonceCoordinator(ds sqlutil.DataSource, lggr logger.Logger, lockTimeout, reservationTimeout time.Duration) *dbNonceCoordinator {
+	owner := uuid.New()
+	return &dbNonceCoordinator{
+		ds:                 ds,
+		lggr:               logger.Sugared(logger.With(logger.Named(lggr, "NonceCoordinator"), "owner", owner)),
+		owner:              owner,
+		lockTimeout:        lockTimeout,
+		reservationTimeout: reservationTimeout,
+	}
+}
+
+type nonceReservation struct {
+	NextNonce evmtypes.Nonce `db:"next_nonce"`
+	Owner     uuid.UUID      `db:"owner"`
+	Age       time.Duration  `db:"age"`
+}
+
+func (c *dbNonceCoordinator) ReserveNonce(ctx context.Context, address common.Address, chainID *big.Int, localNextNonce evmtypes.Nonce) (nonce evmtypes.Nonce, err error) {
+	err = sqlutil.TransactDataSource(ctx, c.ds, nil, func(tx sqlutil.DataSource) error {
+		if _, err = tx.ExecContext(ctx, `SELECT set_config('lock_timeout', $1, true)`, fmt.Sprintf("%dms", c.lockTimeout.Milliseconds())); err != nil {
+			return fmt.Errorf("failed to set lock timeout: %w", err)
+		}
+		lockKey := fmt.Sprintf("evm.key_nonce_reservations:%s:%s", chainID, address)
+		if _, err = tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtextextended($1, 0))`, lockKey); err != nil {
+			return fmt.Errorf("failed to acquire nonce lock of %s: %w", address, err)
+		}
+
+		nonce = localNextNonce
+		var reservation nonceReservation
+		err = tx.GetContext(ctx, &reservation, `SELECT next_nonce, owner, (EXTRACT(EPOCH FROM NOW() - updated_at) * 1e9)::bigint AS age FROM evm.key_nonce_reservations WHERE evm_chain_id = $1 AND address = $2`, chainID.String(), address)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+		case err != nil:
+			return fmt.Errorf("failed to load nonce reservation of %s: %w", address, err)
+		case reservation.Owner == c.owner:
+			// the previous reservation is ours, so it can be reused if it was not assigned in the end
+			nonce = max(nonce, reservation.NextNonce-1)
+		case reservation.NextNonce <= nonce:
+			// the previous reservation belongs to another node, and was already assigned
+		default:
+			// the previous reservation belongs to another node, which may have broadcast it already. Its txs are in the
+			// same database, so it is skipped once assigned to one of them, and reused if the node never assigned it.
+			var assigned bool
+			if err = tx.GetContext(ctx, &assigned, `SELECT EXISTS (SELECT 1 FROM evm.txes WHERE evm_chain_id = $1 AND from_address = $2 AND nonce >= $3)`,
+				chainID.String(), address, int64(reservation.NextNonce-1)); err != nil {
+				return fmt.Errorf("failed to check the nonce reservation of %s: %w", address, err)
+			}
+			switch {
+			case assigned:
+				c.lggr.Warnw("Nonce was reserved by another node, skipping ahead", "address", address, "localNextNonce", localNextNonce,
+					"reservedNextNonce", reservation.NextNonce, "reservedBy", reservation.Owner)
+				nonce = reservation.NextNonce
+			case reservation.Age < c.reservationTimeout:
+				// the other node may be assigning it right now
+				return fmt.Errorf("nonce %d of %s reserved by %s: %w", reservation.NextNonce-1, address, reservation.Owner, ErrNonceReservationPending)
+			default:
+				c.lggr.Warnw("Nonce reserved by another node was never assigned, reusing it", "address", address, "localNextNonce", localNextNonce,
+					"reservedNextNonce", reservation.NextNonce, "reservedBy", reservation.Owner, "reservedFor", reservation.Age)
+			}
+		}
+
+		_, err = tx.ExecContext(ctx, `INSERT INTO evm.key_nonce_reservations (address, evm_chain_id, next_nonce, owner, updated_at)
+VALUES ($1, $2, $3, $4, NOW())
+ON CONFLICT (evm_chain_id, address) DO UPDATE SET next_nonce = EXCLUDED.next_nonce, owner = EXCLUDED.owner, updated_at = EXCLUDED.updated_at`,
+			address, chainID.String(), nonce+1, c.owner)
+		if err != nil {
+			return fmt.Errorf("failed to reserve nonce %d of %s: %w", nonce, address, err)
+		}
+		return nil
+	})
+	return
+}
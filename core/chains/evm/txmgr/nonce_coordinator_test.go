@@ -0,0 +1,98 @@
+package txmgr_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+)
+
+func TestDBNonceCoordinator_ReserveNonce(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	_, addr := cltest.MustInsertRandomKey(t, cltest.NewKeyStore(t, db).Eth())
+	chainID := testutils.FixtureChainID
+
+	active := txmgr.NewDBNonceCoordinator(db, logger.Test(t), time.Second, time.Hour)
+	standby := txmgr.NewDBNonceCoordinator(db, logger.Test(t), time.Second, time.Hour)
+
+	// first reservation of a key uses the local nonce
+	nonce, err := active.ReserveNonce(ctx, addr, chainID, 3)
+	require.NoError(t, err)
+	require.Equal(t, types.Nonce(3), nonce)
+
+	// an unassigned reservation is reused by the same node
+	nonce, err = active.ReserveNonce(ctx, addr, chainID, 3)
+	require.NoError(t, err)
+	require.Equal(t, types.Nonce(3), nonce)
+
+	nonce, err = active.ReserveNonce(ctx, addr, chainID, 4)
+	require.NoError(t, err)
+	require.Equal(t, types.Nonce(4), nonce)
+
+	// the standby waits for the active node to assign the nonce it reserved
+	_, err = standby.ReserveNonce(ctx, addr, chainID, 2)
+	require.ErrorIs(t, err, txmgr.ErrNonceReservationPending)
+
+	// and never reuses it once assigned, even if it is behind
+	mustInsertConfirmedEthTx(t, txStore, 4, addr)
+	nonce, err = standby.ReserveNonce(ctx, addr, chainID, 2)
+	require.NoError(t, err)
+	require.Equal(t, types.Nonce(5), nonce)
+
+	// and neither does the active node once the standby took over
+	mustInsertConfirmedEthTx(t, txStore, 5, addr)
+	nonce, err = active.ReserveNonce(ctx, addr, chainID, 5)
+	require.NoError(t, err)
+	require.Equal(t, types.Nonce(6), nonce)
+
+	// keys are coordinated separately per chain
+	nonce, err = standby.ReserveNonce(ctx, addr, testutils.SimulatedChainID, 0)
+	require.NoError(t, err)
+	require.Equal(t, types.Nonce(0), nonce)
+}
+
+func TestDBNonceCoordinator_ReserveNonce_Restart(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	_, addr := cltest.MustInsertRandomKey(t, cltest.NewKeyStore(t, db).Eth())
+	chainID := testutils.FixtureChainID
+
+	// the node reserves nonce 3 and crashes before assigning it
+	crashed := txmgr.NewDBNonceCoordinator(db, logger.Test(t), time.Second, time.Hour)
+	mustInsertConfirmedEthTx(t, txStore, 2, addr)
+	nonce, err := crashed.ReserveNonce(ctx, addr, chainID, 3)
+	require.NoError(t, err)
+	require.Equal(t, types.Nonce(3), nonce)
+
+	// after a restart, or on failover, the reservation belongs to another process, which waits for it to be assigned
+	restarted := txmgr.NewDBNonceCoordinator(db, logger.Test(t), time.Second, time.Hour)
+	_, err = restarted.ReserveNonce(ctx, addr, chainID, 3)
+	require.ErrorIs(t, err, txmgr.ErrNonceReservationPending)
+
+	// and reuses it once it was never assigned for the reservation timeout, instead of leaving a nonce gap
+	_, err = db.ExecContext(ctx, `UPDATE evm.key_nonce_reservations SET updated_at = NOW() - interval '2 hours'`)
+	require.NoError(t, err)
+	nonce, err = restarted.ReserveNonce(ctx, addr, chainID, 3)
+	require.NoError(t, err)
+	require.Equal(t, types.Nonce(3), nonce)
+
+	nonce, err = restarted.ReserveNonce(ctx, addr, chainID, 4)
+	require.NoError(t, err)
+	require.Equal(t, types.Nonce(4), nonce)
+}
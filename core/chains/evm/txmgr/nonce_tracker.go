@@ -32,6 +32,8 @@ type nonceTracker struct {
 	chainID          *big.Int
 	client           NonceTrackerClient
 	enabledAddresses []common.Address
+	// coordinator, if set, reserves every nonce before it is assigned, for keys shared with other nodes
+	coordinator NonceCoordinator
 
 	sequenceLock sync.RWMutex
 }
@@ -46,6 +48,13 @@ func NewNonceTracker(lggr logger.Logger, txStore NonceTrackerTxStore, client Non
 	}
 }
 
+// NewCoordinatedNonceTracker returns a nonce tracker which reserves every nonce with the coordinator before assigning it
+func NewCoordinatedNonceTracker(lggr logger.Logger, txStore NonceTrackerTxStore, client NonceTrackerClient, coordinator NonceCoordinator) *nonceTracker {
+	s := NewNonceTracker(lggr, txStore, client)
+	s.coordinator = coordinator
+	return s
+}
+
 func (s *nonceTracker) LoadNextSequences(ctx context.Context, addresses []common.Address) {
 	s.sequenceLock.Lock()
 	defer s.sequenceLock.Unlock()
@@ -87,7 +96,10 @@ func (s *nonceTracker) SyncSequence(ctx context.Context, addr common.Address, ch
 		Jitter: true,
 	}
 
-	localSequence, err := s.GetNextSequence(ctx, addr)
+	// The sequence is not reserved with the coordinator, since syncing does not assign it
+	s.sequenceLock.Lock()
+	localSequence, err := s.getNextSequence(ctx, addr)
+	s.sequenceLock.Unlock()
 	// Address not found in map so skip sync
 	if err != nil {
 		s.lggr.Criticalw("Failed to retrieve local next sequence for address", "address", addr.String(), "err", err)
@@ -141,6 +153,15 @@ func (s *nonceTracker) SyncOnChain(ctx context.Context, addr common.Address, loc
 func (s *nonceTracker) GetNextSequence(ctx context.Context, address common.Address) (seq evmtypes.Nonce, err error) {
 	s.sequenceLock.Lock()
 	defer s.sequenceLock.Unlock()
+	seq, err = s.getNextSequence(ctx, address)
+	if err != nil {
+		return seq, err
+	}
+	return s.reserveSequence(ctx, address, seq)
+}
+
+// getNextSequence returns the local next sequence of the address, loading it if it is missing. Caller must hold sequenceLock.
+func (s *nonceTracker) getNextSequence(ctx context.Context, address common.Address) (seq evmtypes.Nonce, err error) {
 	// Get next sequence from map
 	seq, exists := s.nextSequenceMap[address]
 	if exists {
@@ -166,6 +187,23 @@ func (s *nonceTracker) GetNextSequence(ctx context.Context, address common.Addre
 	return foundSeq, nil
 }
 
+// reserveSequence reserves the sequence with the coordinator, if any, and fast-forwards the local sequence if the
+// coordinator reserved a higher one. Caller must hold sequenceLock.
+func (s *nonceTracker) reserveSequence(ctx context.Context, address common.Address, seq evmtypes.Nonce) (evmtypes.Nonce, error) {
+	if s.coordinator == nil {
+		return seq, nil
+	}
+	reserved, err := s.coordinator.ReserveNonce(ctx, address, s.chainID, seq)
+	if err != nil {
+		return seq, fmt.Errorf("failed to reserve next sequence for address %s: %w", address, err)
+	}
+	if reserved != seq {
+		s.lggr.Infow("Fast-forward sequence to coordinated reservation", "address", address, "newNextSequence", reserved, "oldNextSequence", seq)
+		s.nextSequenceMap[address] = reserved
+	}
+	return reserved, nil
+}
+
 func (s *nonceTracker) GenerateNextSequence(address common.Address, nonceUsed evmtypes.Nonce) {
 	s.sequenceLock.Lock()
 	defer s.sequenceLock.Unlock()
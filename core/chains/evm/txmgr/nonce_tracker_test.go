@@ -1,10 +1,12 @@
 package txmgr_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -19,6 +21,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	txstoremock "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 )
@@ -285,3 +288,102 @@ func Test_SetNonceAfterInit(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, randNonce, int64(nonce))
 }
+
+type fakeNonceCoordinator struct {
+	reserved types.Nonce
+	err      error
+}
+
+func (f *fakeNonceCoordinator) ReserveNonce(_ context.Context, _ common.Address, _ *big.Int, localNextNonce types.Nonce) (types.Nonce, error) {
+	if f.err != nil {
+		return 0, f.err
+	}
+	return max(localNextNonce, f.reserved), nil
+}
+
+func TestNonceTracker_Coordinated(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	chainID := big.NewInt(0)
+	txStore := txstoremock.NewEvmTxStore(t)
+	client := clientmock.NewClient(t)
+	client.On("ConfiguredChainID").Return(chainID)
+
+	addr := common.HexToAddress("0xd5e099c71b797516c10ed0f0d895f429c2781142")
+	coordinator := &fakeNonceCoordinator{}
+	nonceTracker := txmgr.NewCoordinatedNonceTracker(logger.Test(t), txStore, txmgr.NewEvmTxmClient(client, nil), coordinator)
+
+	txStore.On("FindLatestSequence", mock.Anything, addr, chainID).Return(types.Nonce(4), nil).Once()
+	nonceTracker.LoadNextSequences(ctx, []common.Address{addr})
+
+	t.Run("uses local nonce if not reserved elsewhere", func(t *testing.T) {
+		seq, err := nonceTracker.GetNextSequence(ctx, addr)
+		require.NoError(t, err)
+		require.Equal(t, types.Nonce(5), seq)
+	})
+
+	t.Run("fast-forwards to nonce reserved by coordinator", func(t *testing.T) {
+		coordinator.reserved = 8
+		seq, err := nonceTracker.GetNextSequence(ctx, addr)
+		require.NoError(t, err)
+		require.Equal(t, types.Nonce(8), seq)
+
+		nonceTracker.GenerateNextSequence(addr, seq)
+		coordinator.reserved = 0
+		seq, err = nonceTracker.GetNextSequence(ctx, addr)
+		require.NoError(t, err)
+		require.Equal(t, types.Nonce(9), seq)
+	})
+
+	t.Run("fails to assign nonce if coordinator fails", func(t *testing.T) {
+		coordinator.err = errors.New("database unreachable")
+		_, err := nonceTracker.GetNextSequence(ctx, addr)
+		require.ErrorContains(t, err, "database unreachable")
+	})
+}
+
+func TestNonceTracker_CoordinatedStandbyStartup(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	chainID := testutils.FixtureChainID
+	_, addr := cltest.MustInsertRandomKey(t, cltest.NewKeyStore(t, db).Eth())
+
+	newTracker := func() (*txstoremock.EvmTxStore, *clientmock.Client, txmgr.NonceTracker) {
+		txStore := txstoremock.NewEvmTxStore(t)
+		client := clientmock.NewClient(t)
+		client.On("ConfiguredChainID").Return(chainID)
+		coordinator := txmgr.NewDBNonceCoordinator(db, logger.Test(t), time.Second, time.Hour)
+		return txStore, client, txmgr.NewCoordinatedNonceTracker(logger.Test(t), txStore, txmgr.NewEvmTxmClient(client, nil), coordinator)
+	}
+
+	activeTxStore, _, active := newTracker()
+	activeTxStore.On("FindLatestSequence", mock.Anything, addr, chainID).Return(types.Nonce(4), nil).Once()
+	active.LoadNextSequences(ctx, []common.Address{addr})
+	seq, err := active.GetNextSequence(ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, types.Nonce(5), seq)
+	active.GenerateNextSequence(addr, seq)
+
+	// the standby starts and syncs while the active node keeps assigning nonces
+	standbyTxStore, standbyClient, standby := newTracker()
+	standbyTxStore.On("FindLatestSequence", mock.Anything, addr, chainID).Return(types.Nonce(5), nil).Once()
+	standby.LoadNextSequences(ctx, []common.Address{addr})
+	standbyClient.On("PendingNonceAt", mock.Anything, addr).Return(uint64(6), nil).Once()
+	var chStop services.StopChan
+	standby.SyncSequence(ctx, addr, chStop)
+
+	// syncing does not reserve, so the active node does not skip a nonce
+	seq, err = active.GetNextSequence(ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, types.Nonce(6), seq)
+	active.GenerateNextSequence(addr, seq)
+	mustInsertConfirmedEthTx(t, cltest.NewTestTxStore(t, db), int64(seq), addr)
+
+	// once the standby takes over, it does not reuse the nonce assigned by the active node
+	seq, err = standby.GetNextSequence(ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, types.Nonce(7), seq)
+}
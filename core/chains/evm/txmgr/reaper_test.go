@@ -1,7 +1,9 @@
 package txmgr_test
 
 import (
+	"context"
 	"math/big"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -18,7 +20,7 @@ import (
 )
 
 func newReaperWithChainID(t *testing.T, db txmgrtypes.TxHistoryReaper[*big.Int], txConfig txmgrtypes.ReaperTransactionsConfig, cid *big.Int) *txmgr.Reaper {
-	return txmgr.NewEvmReaper(logger.Test(t), db, txConfig, cid)
+	return txmgr.NewEvmReaper(logger.Test(t), db, txConfig, cid, nil)
 }
 
 func newReaper(t *testing.T, db txmgrtypes.TxHistoryReaper[*big.Int], txConfig txmgrtypes.ReaperTransactionsConfig) *txmgr.Reaper {
@@ -38,6 +40,54 @@ func (r *reaperConfig) ReaperThreshold() time.Duration {
 	return r.reaperThreshold
 }
 
+type testReaperStore struct {
+	reaped chan struct{}
+}
+
+func (s *testReaperStore) ReapTxHistory(context.Context, time.Time, *big.Int) error {
+	select {
+	case s.reaped <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+type testMaintenanceGate struct {
+	open atomic.Bool
+}
+
+func (g *testMaintenanceGate) Open() bool {
+	return g.open.Load()
+}
+
+func TestReaper_MaintenanceGate(t *testing.T) {
+	t.Parallel()
+
+	store := &testReaperStore{reaped: make(chan struct{}, 1)}
+	gate := &testMaintenanceGate{}
+	// deferred reaping is retried every tenth of the interval
+	tc := &reaperConfig{reaperInterval: 10 * time.Second, reaperThreshold: time.Hour}
+	r := txmgr.NewEvmReaper(logger.Test(t), store, tc, &cltest.FixtureChainID, gate)
+	r.Start()
+	t.Cleanup(r.Stop)
+
+	// the run on startup is deferred while the gate is closed
+	r.SetLatestBlockNum(42)
+	select {
+	case <-store.reaped:
+		t.Fatal("reaped while the gate was closed")
+	case <-time.After(1500 * time.Millisecond):
+	}
+
+	// and retried well before the next tick once it opens
+	gate.open.Store(true)
+	select {
+	case <-store.reaped:
+	case <-time.After(5 * time.Second):
+		t.Fatal("deferred reaping was not retried")
+	}
+}
+
 func TestReaper_ReapTxes(t *testing.T) {
 	t.Parallel()
 
@@ -9,7 +9,9 @@ import (
 	"math/big"
 	"net/http"
 	"net/url"
+	"strconv"
 	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -22,6 +24,8 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/chaintype"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/services/alerting"
 )
 
 type stuckTxDetectorGasEstimator interface {
@@ -30,16 +34,20 @@ type stuckTxDetectorGasEstimator interface {
 
 type stuckTxDetectorClient interface {
 	BatchCallContext(ctx context.Context, b []rpc.BatchElem) error
+	SequenceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (evmtypes.Nonce, error)
 }
 
 type stuckTxDetectorTxStore interface {
 	FindTxsByStateAndFromAddresses(ctx context.Context, addresses []common.Address, state types.TxState, chainID *big.Int) (txs []*Tx, err error)
+	SaveNonceGap(ctx context.Context, etxID int64, onChainNonce evmtypes.Nonce) error
+	ClearNonceGaps(ctx context.Context, fromAddress common.Address, chainID *big.Int, onChainNonce evmtypes.Nonce) error
 }
 
 type stuckTxDetectorConfig interface {
 	Enabled() bool
 	Threshold() *uint32
 	MinAttempts() *uint32
+	AgeThreshold() time.Duration
 	DetectionApiUrl() *url.URL
 }
 
@@ -57,6 +65,15 @@ type stuckTxDetector struct {
 
 	purgeBlockNumLock sync.RWMutex
 	purgeBlockNumMap  map[common.Address]int64 // Tracks the last block num a tx was purged for each from address if the PurgeOverflowTxs feature is enabled
+
+	nonceGapLock sync.Mutex
+	nonceGapMap  map[common.Address]nonceGapCheck // Tracks the last nonce gap check of each from address
+}
+
+type nonceGapCheck struct {
+	blockNum     int64
+	onChainNonce evmtypes.Nonce
+	gappedTxIDs  map[int64]struct{} // The transactions which were recorded and alerted on for the onChainNonce
 }
 
 func NewStuckTxDetector(lggr logger.Logger, chainID *big.Int, chainType chaintype.ChainType, maxPrice *assets.Wei, cfg stuckTxDetectorConfig, gasEstimator stuckTxDetectorGasEstimator, txStore stuckTxDetectorTxStore, chainClient stuckTxDetectorClient) *stuckTxDetector {
@@ -74,6 +91,7 @@ func NewStuckTxDetector(lggr logger.Logger, chainID *big.Int, chainType chaintyp
 		chainClient:      chainClient,
 		httpClient:       httpClient,
 		purgeBlockNumMap: make(map[common.Address]int64),
+		nonceGapMap:      make(map[common.Address]nonceGapCheck),
 	}
 }
 
@@ -125,14 +143,41 @@ func (d *stuckTxDetector) DetectStuckTransactions(ctx context.Context, enabledAd
 		return nil, nil
 	}
 
+	var stuckTxs []Tx
 	switch d.chainType {
 	case chaintype.ChainScroll:
-		return d.detectStuckTransactionsScroll(ctx, txs)
+		stuckTxs, err = d.detectStuckTransactionsScroll(ctx, txs)
 	case chaintype.ChainZkEvm, chaintype.ChainXLayer:
-		return d.detectStuckTransactionsZkEVM(ctx, txs)
+		stuckTxs, err = d.detectStuckTransactionsZkEVM(ctx, txs)
 	default:
-		return d.detectStuckTransactionsHeuristic(ctx, txs, blockNum)
+		stuckTxs, err = d.detectStuckTransactionsHeuristic(ctx, txs, blockNum)
 	}
+	if err != nil {
+		return nil, err
+	}
+	for _, tx := range stuckTxs {
+		d.alertStuckTx(tx)
+	}
+	return stuckTxs, nil
+}
+
+func (d *stuckTxDetector) alertStuckTx(tx Tx) {
+	var sequence string
+	if tx.Sequence != nil {
+		sequence = tx.Sequence.String()
+	}
+	alerting.Emit(alerting.Alert{
+		Type:     alerting.EventTxStuck,
+		Severity: alerting.SeverityCritical,
+		Key:      fmt.Sprintf("%s/%s/%s", d.chainID, tx.FromAddress, sequence),
+		Summary:  fmt.Sprintf("Transaction from %s with nonce %s is terminally stuck on chain %s", tx.FromAddress, sequence, d.chainID),
+		Details: map[string]string{
+			"chainID":     d.chainID.String(),
+			"fromAddress": tx.FromAddress.String(),
+			"nonce":       sequence,
+			"txID":        strconv.FormatInt(tx.ID, 10),
+		},
+	})
 }
 
 // Finds the lowest nonce Unconfirmed transaction for each enabled address
@@ -184,11 +229,14 @@ func (d *stuckTxDetector) FindUnconfirmedTxWithLowestNonce(ctx context.Context,
 
 // Uses a heuristic to determine a stuck transaction potentially due to overflow
 // This method can be unreliable and may result in false positives but it is best effort to keep the TXM from getting blocked
-// 1. Check if Threshold amount of blocks have passed since the last purge of a tx for the same fromAddress
-// 2. If 1 is true, check if Threshold amount of blocks have passed since the initial broadcast
-// 3. If 2 is true, check if the transaction has at least MinAttempts amount of broadcasted attempts
-// 4. If 3 is true, check if the latest attempt's gas price is higher than what our gas estimator's GetFee method returns
-// 5. If 4 is true, the transaction is likely stuck due to overflow
+// 1. Check if Threshold amount of blocks have passed since the initial broadcast
+// 2. If 1 is true, check if the on-chain nonce of the fromAddress is lower than the transaction's nonce
+// 3. If 2 is true, the transaction is blocked by a nonce gap. A purge attempt cannot be mined either, so it is recorded and alerted instead of returned
+// 4. If 2 is false, check if Threshold amount of blocks have passed since the last purge of a tx for the same fromAddress
+// 5. If 4 is true, check if the transaction has at least MinAttempts amount of broadcasted attempts
+// 6. If 5 is true, check if AgeThreshold amount of time has passed since the initial broadcast, if configured. If so, the transaction is stuck
+// 7. If 6 is false, check if the latest attempt's gas price is higher than what our gas estimator's GetFee method returns
+// 8. If 7 is true, the transaction is likely stuck due to overflow
 func (d *stuckTxDetector) detectStuckTransactionsHeuristic(ctx context.Context, txs []Tx, blockNum int64) ([]Tx, error) {
 	if d.cfg.Threshold() == nil || d.cfg.MinAttempts() == nil {
 		err := errors.New("missing required configs for the stuck transaction heuristic. Transactions.AutoPurge.Threshold and Transactions.AutoPurge.MinAttempts are required")
@@ -205,7 +253,22 @@ func (d *stuckTxDetector) detectStuckTransactionsHeuristic(ctx context.Context,
 	}
 	var stuckTxs []Tx
 	for _, tx := range txs {
-		// 1. Check if Threshold amount of blocks have passed since the last purge of a tx for the same fromAddress
+		// Tx attempts are loaded from newest to oldest
+		oldestBroadcastAttempt, newestBroadcastAttempt, broadcastedAttemptsCount := findBroadcastedAttempts(tx)
+		// 1. Check if Threshold amount of blocks have passed since the oldest attempt's broadcast block num
+		if *oldestBroadcastAttempt.BroadcastBeforeBlockNum > blockNum-int64(*d.cfg.Threshold()) {
+			continue
+		}
+		// 2. Check if the transaction is waiting on a lower nonce which was never mined
+		gapped, err := d.detectNonceGap(ctx, tx, blockNum)
+		if err != nil {
+			return nil, err
+		}
+		// 3. Skip the transaction since purging it would not unblock the fromAddress
+		if gapped {
+			continue
+		}
+		// 4. Check if Threshold amount of blocks have passed since the last purge of a tx for the same fromAddress
 		// Used to rate limit purging to prevent a potential valid tx that was stuck behind an overflow tx from also getting purged without having enough time to be confirmed
 		d.purgeBlockNumLock.RLock()
 		lastPurgeBlockNum := d.purgeBlockNumMap[tx.FromAddress]
@@ -213,26 +276,81 @@ func (d *stuckTxDetector) detectStuckTransactionsHeuristic(ctx context.Context,
 		if lastPurgeBlockNum > blockNum-int64(*d.cfg.Threshold()) {
 			continue
 		}
-		// Tx attempts are loaded from newest to oldest
-		oldestBroadcastAttempt, newestBroadcastAttempt, broadcastedAttemptsCount := findBroadcastedAttempts(tx)
-		// 2. Check if Threshold amount of blocks have passed since the oldest attempt's broadcast block num
-		if *oldestBroadcastAttempt.BroadcastBeforeBlockNum > blockNum-int64(*d.cfg.Threshold()) {
+		// 5. Check if the transaction has at least MinAttempts amount of broadcasted attempts
+		if broadcastedAttemptsCount < *d.cfg.MinAttempts() {
 			continue
 		}
-		// 3. Check if the transaction has at least MinAttempts amount of broadcasted attempts
-		if broadcastedAttemptsCount < *d.cfg.MinAttempts() {
+		// 6. Return the transaction if AgeThreshold amount of time has passed since the initial broadcast
+		if ageThreshold := d.cfg.AgeThreshold(); ageThreshold > 0 && tx.InitialBroadcastAt != nil && time.Since(*tx.InitialBroadcastAt) >= ageThreshold {
+			stuckTxs = append(stuckTxs, tx)
 			continue
 		}
-		// 4. Check if the newest broadcasted attempt's gas price is higher than what our gas estimator's GetFee method returns
+		// 7. Check if the newest broadcasted attempt's gas price is higher than what our gas estimator's GetFee method returns
 		if compareGasFees(newestBroadcastAttempt.TxFee, marketGasPrice) <= 0 {
 			continue
 		}
-		// 5. Return the transaction since it is likely stuck due to overflow
+		// 8. Return the transaction since it is likely stuck due to overflow
 		stuckTxs = append(stuckTxs, tx)
 	}
 	return stuckTxs, nil
 }
 
+// Checks if the on-chain nonce of the transaction's fromAddress is lower than its nonce, meaning that a lower nonce was never mined
+// and the transaction can neither be confirmed nor purged until it is. Such transactions are recorded in the txstore to be listed
+// as stuck, and alerted on once. The on-chain nonce of a fromAddress is checked at most once every Threshold blocks, and the gaps of
+// its transactions are cleared once it is past them.
+func (d *stuckTxDetector) detectNonceGap(ctx context.Context, tx Tx, blockNum int64) (bool, error) {
+	if tx.Sequence == nil {
+		return false, nil
+	}
+	d.nonceGapLock.Lock()
+	defer d.nonceGapLock.Unlock()
+	last, checked := d.nonceGapMap[tx.FromAddress]
+	if !checked || last.blockNum <= blockNum-int64(*d.cfg.Threshold()) {
+		onChainNonce, err := d.chainClient.SequenceAt(ctx, tx.FromAddress, nil)
+		if err != nil {
+			// Best effort, fall through to the other checks
+			d.lggr.Warnw("Failed to get on-chain nonce for nonce gap detection", "fromAddress", tx.FromAddress, "err", err)
+			return false, nil
+		}
+		// Gaps may have been recorded before a restart, so they are cleared on the first check too
+		if !checked || last.onChainNonce != onChainNonce {
+			if err = d.txStore.ClearNonceGaps(ctx, tx.FromAddress, d.chainID, onChainNonce); err != nil {
+				return false, fmt.Errorf("failed to clear nonce gaps of %s: %w", tx.FromAddress, err)
+			}
+			last.gappedTxIDs = make(map[int64]struct{})
+		}
+		last.blockNum = blockNum
+		last.onChainNonce = onChainNonce
+		d.nonceGapMap[tx.FromAddress] = last
+	}
+	if last.onChainNonce >= *tx.Sequence {
+		return false, nil
+	}
+	if _, alerted := last.gappedTxIDs[tx.ID]; alerted {
+		return true, nil
+	}
+	d.lggr.Criticalw("Detected nonce gap, transaction is blocked by a lower nonce which was never mined", "txID", tx.ID, "fromAddress", tx.FromAddress, "nonce", *tx.Sequence, "onChainNonce", last.onChainNonce)
+	if err := d.txStore.SaveNonceGap(ctx, tx.ID, last.onChainNonce); err != nil {
+		return false, fmt.Errorf("failed to save nonce gap for transaction %d: %w", tx.ID, err)
+	}
+	alerting.Emit(alerting.Alert{
+		Type:     alerting.EventTxNonceGap,
+		Severity: alerting.SeverityCritical,
+		Key:      fmt.Sprintf("%s/%s/%s", d.chainID, tx.FromAddress, tx.Sequence.String()),
+		Summary:  fmt.Sprintf("Transaction from %s with nonce %s is blocked by unmined nonce %s on chain %s", tx.FromAddress, tx.Sequence.String(), last.onChainNonce.String(), d.chainID),
+		Details: map[string]string{
+			"chainID":      d.chainID.String(),
+			"fromAddress":  tx.FromAddress.String(),
+			"nonce":        tx.Sequence.String(),
+			"onChainNonce": last.onChainNonce.String(),
+			"txID":         strconv.FormatInt(tx.ID, 10),
+		},
+	})
+	last.gappedTxIDs[tx.ID] = struct{}{}
+	return true, nil
+}
+
 func compareGasFees(attemptGas gas.EvmFee, marketGas gas.EvmFee) int {
 	if attemptGas.Legacy != nil && marketGas.Legacy != nil {
 		return attemptGas.Legacy.Cmp(marketGas.Legacy)
@@ -70,6 +70,7 @@ func TestStuckTxDetector_LoadPurgeBlockNumMap(t *testing.T) {
 
 	lggr := logger.Test(t)
 	ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+	ethClient.On("SequenceAt", mock.Anything, mock.Anything, mock.Anything).Return(types.Nonce(0), nil).Maybe()
 	feeEstimator := gasmocks.NewEvmFeeEstimator(t)
 	marketGasPrice := assets.GWei(15)
 	fee := gas.EvmFee{Legacy: marketGasPrice}
@@ -196,6 +197,8 @@ func TestStuckTxDetector_DetectStuckTransactionsHeuristic(t *testing.T) {
 	fee := gas.EvmFee{Legacy: marketGasPrice}
 	feeEstimator.On("GetFee", mock.Anything, []byte{}, uint64(0), mock.Anything, mock.Anything, mock.Anything).Return(fee, uint64(0), nil)
 	ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+	// Every from address has its nonce 0 unmined on-chain
+	ethClient.On("SequenceAt", mock.Anything, mock.Anything, mock.Anything).Return(types.Nonce(0), nil).Maybe()
 	autoPurgeThreshold := uint32(5)
 	autoPurgeMinAttempts := uint32(3)
 	autoPurgeCfg := testAutoPurgeConfig{
@@ -278,6 +281,81 @@ func TestStuckTxDetector_DetectStuckTransactionsHeuristic(t *testing.T) {
 		require.NoError(t, err)
 		require.Len(t, txs, 1)
 	})
+
+	t.Run("detects stuck transaction, AgeThreshold amount of time has passed since initial broadcast", func(t *testing.T) {
+		ageCfg := autoPurgeCfg
+		ageCfg.ageThreshold = time.Hour
+		ageStuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, "", assets.NewWei(assets.NewEth(100).ToInt()), ageCfg, feeEstimator, txStore, ethClient)
+		_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+		enabledAddresses := []common.Address{fromAddress}
+		// Create attempts so that the latest has a lower gas price than the market, so only the age check detects the transaction
+		tx := mustInsertUnconfirmedTxWithBroadcastAttempts(t, txStore, 0, fromAddress, autoPurgeMinAttempts, blockNum-int64(autoPurgeThreshold), marketGasPrice.Sub(oneGwei))
+
+		txs, err := ageStuckTxDetector.DetectStuckTransactions(ctx, enabledAddresses, blockNum)
+		require.NoError(t, err)
+		require.Len(t, txs, 0)
+
+		pgtest.MustExec(t, db, `UPDATE evm.txes SET initial_broadcast_at = $1 WHERE id = $2`, time.Now().Add(-2*time.Hour), tx.ID)
+		txs, err = ageStuckTxDetector.DetectStuckTransactions(ctx, enabledAddresses, blockNum)
+		require.NoError(t, err)
+		require.Len(t, txs, 1)
+		require.Equal(t, tx.ID, txs[0].ID)
+	})
+
+	t.Run("not stuck, transaction blocked by a nonce gap is listed instead of purged", func(t *testing.T) {
+		_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+		enabledAddresses := []common.Address{fromAddress}
+		// Create a transaction which passes every other check with nonce 1, while nonce 0 was never mined
+		tx := mustInsertUnconfirmedTxWithBroadcastAttempts(t, txStore, 1, fromAddress, autoPurgeMinAttempts, blockNum-int64(autoPurgeThreshold), marketGasPrice.Add(oneGwei))
+
+		txs, err := stuckTxDetector.DetectStuckTransactions(ctx, enabledAddresses, blockNum)
+		require.NoError(t, err)
+		require.Len(t, txs, 0)
+
+		stuckTxs, _, err := txStore.StuckTransactions(ctx, 0, 100)
+		require.NoError(t, err)
+		var listed bool
+		for _, stuckTx := range stuckTxs {
+			listed = listed || stuckTx.ID == tx.ID
+		}
+		require.True(t, listed)
+	})
+
+	t.Run("nonce gap is checked once every Threshold blocks and cleared once the on-chain nonce catches up", func(t *testing.T) {
+		gapEthClient := testutils.NewEthClientMockWithDefaultChain(t)
+		gapStuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, "", assets.NewWei(assets.NewEth(100).ToInt()), autoPurgeCfg, feeEstimator, txStore, gapEthClient)
+		_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+		enabledAddresses := []common.Address{fromAddress}
+		// Create transactions with a lower gas price than the market so that they are only listed for their nonce gaps
+		tx1 := mustInsertUnconfirmedTxWithBroadcastAttempts(t, txStore, 1, fromAddress, autoPurgeMinAttempts, blockNum-int64(autoPurgeThreshold), marketGasPrice.Sub(oneGwei))
+		tx2 := mustInsertUnconfirmedTxWithBroadcastAttempts(t, txStore, 2, fromAddress, autoPurgeMinAttempts, blockNum-int64(autoPurgeThreshold), marketGasPrice.Sub(oneGwei))
+		isListed := func(tx txmgr.Tx) bool {
+			stuckTxs, _, err := txStore.StuckTransactions(ctx, 0, 100)
+			require.NoError(t, err)
+			for _, stuckTx := range stuckTxs {
+				if stuckTx.ID == tx.ID {
+					return true
+				}
+			}
+			return false
+		}
+
+		gapEthClient.On("SequenceAt", mock.Anything, fromAddress, mock.Anything).Return(types.Nonce(0), nil).Once()
+		for i := int64(0); i < int64(autoPurgeThreshold); i++ {
+			txs, err := gapStuckTxDetector.DetectStuckTransactions(ctx, enabledAddresses, blockNum+i)
+			require.NoError(t, err)
+			require.Len(t, txs, 0)
+		}
+		require.True(t, isListed(tx1))
+		require.True(t, isListed(tx2))
+
+		gapEthClient.On("SequenceAt", mock.Anything, fromAddress, mock.Anything).Return(types.Nonce(2), nil).Once()
+		txs, err := gapStuckTxDetector.DetectStuckTransactions(ctx, enabledAddresses, blockNum+int64(autoPurgeThreshold))
+		require.NoError(t, err)
+		require.Len(t, txs, 0)
+		require.False(t, isListed(tx1))
+		require.False(t, isListed(tx2))
+	})
 }
 
 func TestStuckTxDetector_DetectStuckTransactionsZkEVM(t *testing.T) {
@@ -479,10 +557,12 @@ type testAutoPurgeConfig struct {
 	enabled         bool
 	threshold       *uint32
 	minAttempts     *uint32
+	ageThreshold    time.Duration
 	detectionApiUrl *url.URL
 }
 
-func (t testAutoPurgeConfig) Enabled() bool             { return t.enabled }
-func (t testAutoPurgeConfig) Threshold() *uint32        { return t.threshold }
-func (t testAutoPurgeConfig) MinAttempts() *uint32      { return t.minAttempts }
-func (t testAutoPurgeConfig) DetectionApiUrl() *url.URL { return t.detectionApiUrl }
+func (t testAutoPurgeConfig) Enabled() bool               { return t.enabled }
+func (t testAutoPurgeConfig) Threshold() *uint32          { return t.threshold }
+func (t testAutoPurgeConfig) MinAttempts() *uint32        { return t.minAttempts }
+func (t testAutoPurgeConfig) AgeThreshold() time.Duration { return t.ageThreshold }
+func (t testAutoPurgeConfig) DetectionApiUrl() *url.URL   { return t.detectionApiUrl }
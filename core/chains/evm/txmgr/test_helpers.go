@@ -1,6 +1,7 @@
 package txmgr
 
 import (
+	"math/big"
 	"net/url"
 	"testing"
 	"time"
@@ -80,6 +81,10 @@ func (g *TestGasEstimatorConfig) FeeHistory() evmconfig.FeeHistory {
 	return &TestFeeHistoryConfig{}
 }
 
+func (g *TestGasEstimatorConfig) OnChainPriceMax() evmconfig.OnChainPriceMax {
+	return &TestOnChainPriceMaxConfig{}
+}
+
 func (g *TestGasEstimatorConfig) EIP1559DynamicFees() bool   { return false }
 func (g *TestGasEstimatorConfig) LimitDefault() uint64       { return 42 }
 func (g *TestGasEstimatorConfig) BumpPercent() uint16        { return 42 }
@@ -89,6 +94,7 @@ func (g *TestGasEstimatorConfig) FeeCapDefault() *assets.Wei { return assets.New
 func (g *TestGasEstimatorConfig) PriceDefault() *assets.Wei  { return assets.NewWeiI(42) }
 func (g *TestGasEstimatorConfig) TipCapDefault() *assets.Wei { return assets.NewWeiI(42) }
 func (g *TestGasEstimatorConfig) TipCapMin() *assets.Wei     { return assets.NewWeiI(42) }
+func (g *TestGasEstimatorConfig) BlobPriceMax() *assets.Wei  { return assets.NewWeiI(42) }
 func (g *TestGasEstimatorConfig) LimitMax() uint64           { return 0 }
 func (g *TestGasEstimatorConfig) LimitMultiplier() float32   { return 0 }
 func (g *TestGasEstimatorConfig) BumpTxDepth() uint32        { return 42 }
@@ -134,6 +140,12 @@ type TestFeeHistoryConfig struct {
 
 func (b *TestFeeHistoryConfig) CacheTimeout() time.Duration { return 0 * time.Second }
 
+type TestOnChainPriceMaxConfig struct {
+	evmconfig.OnChainPriceMax
+}
+
+func (o *TestOnChainPriceMaxConfig) Enabled() bool { return false }
+
 type transactionsConfig struct {
 	evmconfig.Transactions
 	e         *TestEvmConfig
@@ -146,7 +158,17 @@ func (t *transactionsConfig) MaxQueued() uint64                    { return t.e.
 func (t *transactionsConfig) ReaperInterval() time.Duration        { return t.e.ReaperInterval }
 func (t *transactionsConfig) ReaperThreshold() time.Duration       { return t.e.ReaperThreshold }
 func (t *transactionsConfig) ResendAfterThreshold() time.Duration  { return t.e.ResendAfterThreshold }
+func (t *transactionsConfig) JobSpendLimit() *big.Int              { return nil }
+func (t *transactionsConfig) JobSpendWindow() time.Duration        { return 0 }
 func (t *transactionsConfig) AutoPurge() evmconfig.AutoPurgeConfig { return t.autoPurge }
+func (t *transactionsConfig) Bundler() evmconfig.BundlerConfig     { return &bundlerConfig{} }
+func (t *transactionsConfig) NonceCoordination() evmconfig.NonceCoordinationConfig {
+	return &nonceCoordinationConfig{}
+}
+func (t *transactionsConfig) Simulation() evmconfig.SimulationConfig { return &simulationConfig{} }
+func (t *transactionsConfig) CircuitBreaker() evmconfig.CircuitBreakerConfig {
+	return &circuitBreakerConfig{}
+}
 
 type autoPurgeConfig struct {
 	evmconfig.AutoPurgeConfig
@@ -154,6 +176,30 @@ type autoPurgeConfig struct {
 
 func (a *autoPurgeConfig) Enabled() bool { return false }
 
+type bundlerConfig struct {
+	evmconfig.BundlerConfig
+}
+
+func (b *bundlerConfig) Enabled() bool { return false }
+
+type nonceCoordinationConfig struct {
+	evmconfig.NonceCoordinationConfig
+}
+
+func (n *nonceCoordinationConfig) Enabled() bool { return false }
+
+type simulationConfig struct {
+	evmconfig.SimulationConfig
+}
+
+func (s *simulationConfig) Enabled() bool { return false }
+
+type circuitBreakerConfig struct {
+	evmconfig.CircuitBreakerConfig
+}
+
+func (c *circuitBreakerConfig) Enabled() bool { return false }
+
 type MockConfig struct {
 	EvmConfig          *TestEvmConfig
 	finalityDepth      uint32
@@ -18,7 +18,9 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/common/txmgr"
 	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/revert"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	v1 "github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/solidity_vrf_coordinator_interface"
@@ -37,6 +39,7 @@ var (
 
 	_ TransmitCheckerFactory = &CheckerFactory{}
 	_ TransmitChecker        = &SimulateChecker{}
+	_ TransmitChecker        = &ForwarderSimulateChecker{}
 	_ TransmitChecker        = &VRFV1Checker{}
 	_ TransmitChecker        = &VRFV2Checker{}
 )
@@ -44,10 +47,24 @@ var (
 // CheckerFactory is a real implementation of TransmitCheckerFactory.
 type CheckerFactory struct {
 	Client evmclient.Client
+	// Simulation of the transactions routed through forwarders, disabled if nil.
+	Simulation config.SimulationConfig
 }
 
 // BuildChecker satisfies the TransmitCheckerFactory interface.
 func (c *CheckerFactory) BuildChecker(spec TransmitCheckerSpec) (TransmitChecker, error) {
+	checker, err := c.buildChecker(spec)
+	if err != nil || c.Simulation == nil || !c.Simulation.Enabled() || spec.CheckerType == TransmitCheckerTypeSimulate {
+		return checker, err
+	}
+	return &ForwarderSimulateChecker{
+		Client:        c.Client,
+		AbortOnRevert: c.Simulation.AbortOnRevert(),
+		Checker:       checker,
+	}, nil
+}
+
+func (c *CheckerFactory) buildChecker(spec TransmitCheckerSpec) (TransmitChecker, error) {
 	switch spec.CheckerType {
 	case TransmitCheckerTypeSimulate:
 		return &SimulateChecker{c.Client}, nil
@@ -161,6 +178,92 @@ func (s *SimulateChecker) Check(
 	return nil
 }
 
+// simulationBalance overrides the balance of senders in simulations, so that they afford any fees.
+var simulationBalance = new(big.Int).Lsh(big.NewInt(1), 128)
+
+// ForwarderSimulateChecker simulates the transactions routed through forwarders, after the checker of the transaction.
+// Unlike SimulateChecker, the fees of the attempt are included, since contracts may depend on them (e.g. keeper
+// registries pay for performs by tx.gasprice), and the balance of the sender is overridden, so that the simulation does
+// not fail for lack of funds.
+//
+// Reverts are logged with their reason, and only produce an error if AbortOnRevert is set. The forwarders bubble the
+// reverts of the forwarded calls, so the reason is the one of the destination contract.
+type ForwarderSimulateChecker struct {
+	Client        evmclient.Client
+	AbortOnRevert bool
+	// Checker is the checker of the transaction, which runs first.
+	Checker TransmitChecker
+}
+
+// Check satisfies the TransmitChecker interface.
+func (s *ForwarderSimulateChecker) Check(
+	ctx context.Context,
+	l logger.SugaredLogger,
+	tx Tx,
+	a TxAttempt,
+) error {
+	if err := s.Checker.Check(ctx, l, tx, a); err != nil {
+		return err
+	}
+	meta, err := tx.GetMeta()
+	if err != nil || meta == nil || meta.FwdrDestAddress == nil {
+		return nil
+	}
+
+	callArg := map[string]interface{}{
+		"from":  tx.FromAddress,
+		"to":    &tx.ToAddress,
+		"gas":   hexutil.Uint64(a.ChainSpecificFeeLimit),
+		"value": (*hexutil.Big)(&tx.Value),
+		"data":  hexutil.Bytes(tx.EncodedPayload),
+	}
+	if a.TxFee.Legacy != nil {
+		callArg["gasPrice"] = (*hexutil.Big)(a.TxFee.Legacy.ToInt())
+	} else if a.TxFee.DynamicFeeCap != nil && a.TxFee.DynamicTipCap != nil {
+		callArg["maxFeePerGas"] = (*hexutil.Big)(a.TxFee.DynamicFeeCap.ToInt())
+		callArg["maxPriorityFeePerGas"] = (*hexutil.Big)(a.TxFee.DynamicTipCap.ToInt())
+	}
+	overrides := map[common.Address]interface{}{
+		tx.FromAddress: map[string]interface{}{"balance": (*hexutil.Big)(simulationBalance)},
+	}
+	var b hexutil.Bytes
+	err = s.Client.CallContext(ctx, &b, "eth_call", callArg, evmclient.ToBlockNumArg(nil), overrides)
+	if err == nil {
+		l.Debugw("Forwarded transaction simulation succeeded",
+			"ethTxAttemptID", a.ID, "txHash", a.Hash, "fwdrDestAddress", *meta.FwdrDestAddress)
+		return nil
+	}
+	jErr := evmclient.ExtractRPCErrorOrNil(err)
+	if jErr == nil {
+		l.Warnw("Forwarded transaction simulation failed, will attempt to send anyway",
+			"ethTxAttemptID", a.ID, "txHash", a.Hash, "err", err)
+		return nil
+	}
+	reason := revertReason(jErr)
+	if !s.AbortOnRevert {
+		l.Criticalw("Forwarded transaction reverted during simulation, will attempt to send anyway",
+			"ethTxAttemptID", a.ID, "txHash", a.Hash, "fwdrDestAddress", *meta.FwdrDestAddress, "reason", reason, "rpcErr", jErr.String())
+		return nil
+	}
+	l.Criticalw("Forwarded transaction reverted during simulation",
+		"ethTxAttemptID", a.ID, "txHash", a.Hash, "fwdrDestAddress", *meta.FwdrDestAddress, "reason", reason, "rpcErr", jErr.String())
+	return pkgerrors.Errorf("forwarded transaction reverted during simulation: %s", reason)
+}
+
+// revertReason returns the reason of a revert decoded from the data of jErr, or the raw data or message if it cannot be
+// decoded.
+func revertReason(jErr *evmclient.JsonError) string {
+	if data, ok := jErr.RevertData(); ok {
+		if decoded, err := revert.Decode(data); err == nil {
+			return decoded.String()
+		}
+	}
+	if data, ok := jErr.Data.(string); ok && data != "" {
+		return data
+	}
+	return jErr.Message
+}
+
 // VRFV1Checker is an implementation of TransmitChecker that checks whether a VRF V1 fulfillment
 // has already been fulfilled.
 type VRFV1Checker struct {
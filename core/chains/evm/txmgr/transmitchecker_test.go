@@ -8,9 +8,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rpc"
 	pkgerrors "github.com/pkg/errors"
 	"github.com/stretchr/testify/mock"
@@ -24,6 +27,7 @@ import (
 	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
@@ -93,6 +97,25 @@ func TestFactory(t *testing.T) {
 		require.Equal(t, &txmgr.SimulateChecker{Client: client}, c)
 	})
 
+	t.Run("forwarder simulation", func(t *testing.T) {
+		factory := &txmgr.CheckerFactory{Client: client, Simulation: testSimulationConfig{enabled: true, abortOnRevert: true}}
+		c, err := factory.BuildChecker(txmgr.TransmitCheckerSpec{})
+		require.NoError(t, err)
+		require.Equal(t, &txmgr.ForwarderSimulateChecker{Client: client, AbortOnRevert: true, Checker: txmgr.NoChecker}, c)
+
+		// transactions simulated by their own checker are not simulated again
+		c, err = factory.BuildChecker(txmgr.TransmitCheckerSpec{
+			CheckerType: txmgr.TransmitCheckerTypeSimulate,
+		})
+		require.NoError(t, err)
+		require.Equal(t, &txmgr.SimulateChecker{Client: client}, c)
+
+		factory.Simulation = testSimulationConfig{}
+		c, err = factory.BuildChecker(txmgr.TransmitCheckerSpec{})
+		require.NoError(t, err)
+		require.Equal(t, txmgr.NoChecker, c)
+	})
+
 	t.Run("invalid checker type", func(t *testing.T) {
 		_, err := factory.BuildChecker(txmgr.TransmitCheckerSpec{
 			CheckerType: "invalid",
@@ -170,6 +193,73 @@ func TestTransmitCheckers(t *testing.T) {
 		})
 	})
 
+	t.Run("forwarder simulate", func(t *testing.T) {
+		from := common.HexToAddress("0xfe0629509E6CB8dfa7a99214ae58Ceb465d5b5A9")
+		dest := common.HexToAddress("0xff0Aac13eab788cb9a2D662D3FB661Aa5f58FA21")
+		b, err := json.Marshal(txmgr.TxMeta{FwdrDestAddress: &dest})
+		require.NoError(t, err)
+		meta := sqlutil.JSON(b)
+		tx := txmgr.Tx{
+			FromAddress:    from,
+			ToAddress:      testutils.NewAddress(),
+			EncodedPayload: []byte{42, 0, 0},
+			Value:          big.Int(assets.NewEthValue(642)),
+			FeeLimit:       1e9,
+			CreatedAt:      time.Unix(0, 0),
+			State:          txmgrcommon.TxUnstarted,
+			Meta:           &meta,
+		}
+		attempt := txmgr.TxAttempt{
+			Tx:        tx,
+			TxFee:     gas.EvmFee{Legacy: assets.GWei(7)},
+			CreatedAt: tx.CreatedAt,
+			State:     txmgrtypes.TxAttemptInProgress,
+		}
+		matchCall := mock.MatchedBy(func(callarg map[string]interface{}) bool {
+			return fmt.Sprintf("%s", callarg["gasPrice"]) == "0x1a13b8600" // 7 gwei
+		})
+		matchOverrides := mock.MatchedBy(func(overrides map[common.Address]interface{}) bool {
+			_, ok := overrides[from]
+			return ok
+		})
+		stringType, err := abi.NewType("string", "", nil)
+		require.NoError(t, err)
+		packed, err := abi.Arguments{{Type: stringType}}.Pack("too late")
+		require.NoError(t, err)
+		revert := &evmclient.JsonError{
+			Code:    3,
+			Message: "execution reverted: too late",
+			Data:    hexutil.Encode(append(crypto.Keccak256([]byte("Error(string)"))[:4], packed...)),
+		}
+
+		t.Run("success", func(t *testing.T) {
+			checker := txmgr.ForwarderSimulateChecker{Client: client, AbortOnRevert: true, Checker: txmgr.NoChecker}
+			client.On("CallContext", mock.Anything, mock.AnythingOfType("*hexutil.Bytes"), "eth_call", matchCall, "latest", matchOverrides).
+				Return(nil).Once()
+			require.NoError(t, checker.Check(ctx, log, tx, attempt))
+		})
+
+		t.Run("revert", func(t *testing.T) {
+			checker := txmgr.ForwarderSimulateChecker{Client: client, Checker: txmgr.NoChecker}
+			client.On("CallContext", mock.Anything, mock.AnythingOfType("*hexutil.Bytes"), "eth_call", matchCall, "latest", matchOverrides).
+				Return(revert).Once()
+			// sent anyway
+			require.NoError(t, checker.Check(ctx, log, tx, attempt))
+
+			checker.AbortOnRevert = true
+			client.On("CallContext", mock.Anything, mock.AnythingOfType("*hexutil.Bytes"), "eth_call", matchCall, "latest", matchOverrides).
+				Return(revert).Once()
+			require.EqualError(t, checker.Check(ctx, log, tx, attempt), "forwarded transaction reverted during simulation: too late")
+		})
+
+		t.Run("not forwarded", func(t *testing.T) {
+			checker := txmgr.ForwarderSimulateChecker{Client: client, AbortOnRevert: true, Checker: txmgr.NoChecker}
+			tx := tx
+			tx.Meta = nil
+			require.NoError(t, checker.Check(ctx, log, tx, attempt))
+		})
+	})
+
 	t.Run("VRF V1", func(t *testing.T) {
 		testDefaultSubID := uint64(2)
 		testDefaultMaxLink := "1000000000000000000"
@@ -370,3 +460,10 @@ func TestTransmitCheckers(t *testing.T) {
 		})
 	})
 }
+
+type testSimulationConfig struct {
+	enabled, abortOnRevert bool
+}
+
+func (s testSimulationConfig) Enabled() bool       { return s.enabled }
+func (s testSimulationConfig) AbortOnRevert() bool { return s.abortOnRevert }
@@ -75,6 +75,7 @@ func makeTestEvmTxm(
 
 	return txmgr.NewTxm(
 		db,
+		nil,
 		ccfg,
 		fcfg,
 		txConfig,
@@ -86,7 +87,8 @@ func makeTestEvmTxm(
 		lp,
 		keyStore,
 		estimator,
-		ht)
+		ht,
+		nil)
 }
 
 func TestTxm_SendNativeToken_DoesNotSendToZero(t *testing.T) {
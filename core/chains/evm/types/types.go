@@ -16,6 +16,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
 
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/revert"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
 	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 )
@@ -60,10 +61,11 @@ type Receipt struct {
 	TxHash            common.Hash     `json:"transactionHash"`
 	ContractAddress   common.Address  `json:"contractAddress"`
 	GasUsed           uint64          `json:"gasUsed"`
+	EffectiveGasPrice *big.Int        `json:"effectiveGasPrice,omitempty"`
 	BlockHash         common.Hash     `json:"blockHash,omitempty"`
 	BlockNumber       *big.Int        `json:"blockNumber,omitempty"`
 	TransactionIndex  uint            `json:"transactionIndex"`
-	RevertReason      []byte          `json:"revertReason,omitempty"` // Only provided by Hedera
+	RevertReason      []byte          `json:"revertReason,omitempty"` // Provided by Hedera, or replayed by the TXM
 }
 
 // FromGethReceipt converts a gethTypes.Receipt to a Receipt
@@ -84,6 +86,7 @@ func FromGethReceipt(gr *gethTypes.Receipt) *Receipt {
 		gr.TxHash,
 		gr.ContractAddress,
 		gr.GasUsed,
+		gr.EffectiveGasPrice,
 		gr.BlockHash,
 		gr.BlockNumber,
 		gr.TransactionIndex,
@@ -118,6 +121,7 @@ func (r Receipt) MarshalJSON() ([]byte, error) {
 		TxHash            common.Hash     `json:"transactionHash"`
 		ContractAddress   common.Address  `json:"contractAddress"`
 		GasUsed           hexutil.Uint64  `json:"gasUsed"`
+		EffectiveGasPrice *hexutil.Big    `json:"effectiveGasPrice,omitempty"`
 		BlockHash         common.Hash     `json:"blockHash,omitempty"`
 		BlockNumber       *hexutil.Big    `json:"blockNumber,omitempty"`
 		TransactionIndex  hexutil.Uint    `json:"transactionIndex"`
@@ -132,6 +136,7 @@ func (r Receipt) MarshalJSON() ([]byte, error) {
 	enc.TxHash = r.TxHash
 	enc.ContractAddress = r.ContractAddress
 	enc.GasUsed = hexutil.Uint64(r.GasUsed)
+	enc.EffectiveGasPrice = (*hexutil.Big)(r.EffectiveGasPrice)
 	enc.BlockHash = r.BlockHash
 	enc.BlockNumber = (*hexutil.Big)(r.BlockNumber)
 	enc.TransactionIndex = hexutil.Uint(r.TransactionIndex)
@@ -150,6 +155,7 @@ func (r *Receipt) UnmarshalJSON(input []byte) error {
 		TxHash            *common.Hash     `json:"transactionHash"`
 		ContractAddress   *common.Address  `json:"contractAddress"`
 		GasUsed           *hexutil.Uint64  `json:"gasUsed"`
+		EffectiveGasPrice *hexutil.Big     `json:"effectiveGasPrice,omitempty"`
 		BlockHash         *common.Hash     `json:"blockHash,omitempty"`
 		BlockNumber       *hexutil.Big     `json:"blockNumber,omitempty"`
 		TransactionIndex  *hexutil.Uint    `json:"transactionIndex"`
@@ -181,6 +187,9 @@ func (r *Receipt) UnmarshalJSON(input []byte) error {
 	if dec.GasUsed != nil {
 		r.GasUsed = uint64(*dec.GasUsed)
 	}
+	if dec.EffectiveGasPrice != nil {
+		r.EffectiveGasPrice = (*big.Int)(dec.EffectiveGasPrice)
+	}
 	if dec.BlockHash != nil {
 		r.BlockHash = *dec.BlockHash
 	}
@@ -233,11 +242,13 @@ func (r *Receipt) GetBlockHash() common.Hash {
 	return r.BlockHash
 }
 
+// GetRevertReason returns the revert data decoded with the errors of the registered contracts, or as hex if it can't be
+// decoded.
 func (r *Receipt) GetRevertReason() *string {
 	if len(r.RevertReason) == 0 {
 		return nil
 	}
-	revertReason := string(r.RevertReason)
+	revertReason := revert.Reason(r.RevertReason)
 	return &revertReason
 }
 
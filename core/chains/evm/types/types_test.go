@@ -53,6 +53,7 @@ var (
 		TxHash:            common.HexToHash("0x1020304050"),
 		ContractAddress:   common.HexToAddress("0x1122334455"),
 		GasUsed:           123,
+		EffectiveGasPrice: big.NewInt(1_000_000_007),
 		BlockHash:         common.HexToHash("0x11111111111111"),
 		BlockNumber:       big.NewInt(555),
 		TransactionIndex:  777,
@@ -76,6 +77,7 @@ func TestFromGethReceipt(t *testing.T) {
 	assert.Equal(t, testGethReceipt.TxHash, receipt.TxHash)
 	assert.Equal(t, testGethReceipt.ContractAddress, receipt.ContractAddress)
 	assert.Equal(t, testGethReceipt.GasUsed, receipt.GasUsed)
+	assert.Equal(t, testGethReceipt.EffectiveGasPrice, receipt.EffectiveGasPrice)
 	assert.Equal(t, testGethReceipt.BlockHash, receipt.BlockHash)
 	assert.Equal(t, testGethReceipt.BlockNumber, receipt.BlockNumber)
 	assert.Equal(t, testGethReceipt.TransactionIndex, receipt.TransactionIndex)
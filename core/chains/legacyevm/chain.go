@@ -7,6 +7,7 @@ import (
 	"math/big"
 	"strconv"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	gotoml "github.com/pelletier/go-toml/v2"
 	"go.uber.org/multierr"
 
@@ -32,6 +33,8 @@ import (
 	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/maintenance"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 )
 
 type Chain interface {
@@ -42,6 +45,7 @@ type Chain interface {
 	Config() evmconfig.ChainScopedConfig
 	LogBroadcaster() log.Broadcaster
 	HeadBroadcaster() httypes.HeadBroadcaster
+	ReorgBus() httypes.ReorgBus
 	TxManager() txmgr.TxManager
 	HeadTracker() httypes.HeadTracker
 	Logger() logger.Logger
@@ -109,10 +113,12 @@ type chain struct {
 	txm             txmgr.TxManager
 	logger          logger.Logger
 	headBroadcaster httypes.HeadBroadcaster
+	reorgBus        httypes.ReorgBus
 	headTracker     httypes.HeadTracker
 	logBroadcaster  log.Broadcaster
 	logPoller       logpoller.LogPoller
 	balanceMonitor  monitor.BalanceMonitor
+	remoteSigning   *keystore.RemoteSigningEth
 	keyStore        keystore.Eth
 	gasEstimator    gas.EvmFeeEstimator
 }
@@ -159,6 +165,8 @@ type ChainOpts struct {
 	GasEstimator gas.EvmFeeEstimator
 
 	DS sqlutil.DataSource
+	// BulkInserter inserts the logs of the LogPoller and the receipts of the TxManager with COPY, if not nil.
+	BulkInserter *pg.BulkInserter
 
 	// TODO BCF-2513 remove test code from the API
 	// Gen-functions are useful for dependency injection by tests
@@ -210,12 +218,13 @@ func newChain(ctx context.Context, cfg *evmconfig.ChainScoped, nodes []*toml.Nod
 	if !opts.AppConfig.EVMRPCEnabled() {
 		client = evmclient.NewNullClient(chainID, l)
 	} else if opts.GenEthClient == nil {
-		client = evmclient.NewEvmClient(cfg.EVM().NodePool(), cfg.EVM(), cfg.EVM().NodePool().Errors(), l, chainID, nodes, cfg.EVM().ChainType())
+		client = evmclient.NewEvmClient(cfg.EVM().NodePool(), cfg.EVM(), cfg.EVM().NodePool().Errors(), l, chainID, nodes, cfg.EVM().ChainType(), opts.DS)
 	} else {
 		client = opts.GenEthClient(chainID)
 	}
 
 	headBroadcaster := headtracker.NewHeadBroadcaster(l)
+	reorgBus := headtracker.NewReorgBus(l)
 	headSaver := headtracker.NullSaver
 	var headTracker httypes.HeadTracker
 	if !opts.AppConfig.EVMRPCEnabled() {
@@ -228,11 +237,12 @@ func newChain(ctx context.Context, cfg *evmconfig.ChainScoped, nodes []*toml.Nod
 			orm = headtracker.NewNullORM()
 		}
 		headSaver = headtracker.NewHeadSaver(l, orm, cfg.EVM(), cfg.EVM().HeadTracker())
-		headTracker = headtracker.NewHeadTracker(l, client, cfg.EVM(), cfg.EVM().HeadTracker(), headBroadcaster, headSaver, opts.MailMon)
+		headTracker = headtracker.NewHeadTracker(l, client, cfg.EVM(), cfg.EVM().HeadTracker(), headBroadcaster, reorgBus, headSaver, opts.MailMon)
 	} else {
 		headTracker = opts.GenHeadTracker(chainID, headBroadcaster)
 	}
 
+	scheduler := maintenance.NewScheduler(opts.AppConfig.Maintenance())
 	logPoller := logpoller.LogPollerDisabled
 	if opts.AppConfig.Feature().LogPoller() {
 		if opts.GenLogPoller != nil {
@@ -248,13 +258,42 @@ func newChain(ctx context.Context, cfg *evmconfig.ChainScoped, nodes []*toml.Nod
 				LogPrunePageSize:         int64(cfg.EVM().LogPrunePageSize()),
 				BackupPollerBlockDelay:   int64(cfg.EVM().BackupLogPollerBlockDelay()),
 				ClientErrors:             cfg.EVM().NodePool().Errors(),
+				MaintenanceGate:          scheduler.Gate(maintenance.LogPollerPruning),
+				ReorgBus:                 reorgBus,
 			}
-			logPoller = logpoller.NewLogPoller(logpoller.NewObservedORM(chainID, opts.DS, l), client, l, headTracker, lpOpts)
+			lpORM := logpoller.NewObservedORM(chainID, opts.DS, l)
+			lpORM.SetBulkInserter(opts.BulkInserter)
+			logPoller = logpoller.NewLogPoller(lpORM, client, l, headTracker, lpOpts)
 		}
 	}
 
+	var remoteSigning *keystore.RemoteSigningEth
+	if remoteSigners := cfg.EVM().RemoteSigners(); len(remoteSigners) > 0 {
+		signers := make(map[gethcommon.Address]keystore.RemoteSigner, len(remoteSigners))
+		for address, rs := range remoteSigners {
+			switch rs.Type() {
+			case toml.RemoteSignerTypeWeb3Signer:
+				signer, err2 := keystore.NewWeb3Signer(rs.URL(), rs.Timeout())
+				if err2 != nil {
+					return nil, fmt.Errorf("failed to create remote signer for key %s: %w", address, err2)
+				}
+				signers[address] = signer
+			case toml.RemoteSignerTypeAWSKMS:
+				signer, err2 := keystore.NewAWSKMSSigner(address, rs.KeyID(), rs.Region(), rs.URL(), rs.Timeout())
+				if err2 != nil {
+					return nil, fmt.Errorf("failed to create remote signer for key %s: %w", address, err2)
+				}
+				signers[address] = signer
+			default:
+				return nil, fmt.Errorf("unsupported remote signer type %s for key %s", rs.Type(), address)
+			}
+		}
+		remoteSigning = keystore.NewRemoteSigningEth(l, opts.KeyStore, chainID, signers)
+		opts.KeyStore = remoteSigning
+	}
+
 	// note: gas estimator is started as a part of the txm
-	txm, gasEstimator, err := newEvmTxm(opts.DS, cfg.EVM(), opts.AppConfig.EVMRPCEnabled(), opts.AppConfig.Database(), opts.AppConfig.Database().Listener(), client, l, logPoller, opts, headTracker)
+	txm, gasEstimator, err := newEvmTxm(opts.DS, cfg.EVM(), opts.AppConfig.EVMRPCEnabled(), opts.AppConfig.Database(), opts.AppConfig.Database().Listener(), client, l, logPoller, opts, headTracker, scheduler.Gate(maintenance.TxmReaper))
 	if err != nil {
 		return nil, fmt.Errorf("failed to instantiate EvmTxm for chain with ID %s: %w", chainID.String(), err)
 	}
@@ -278,6 +317,9 @@ func newChain(ctx context.Context, cfg *evmconfig.ChainScoped, nodes []*toml.Nod
 		logBroadcaster = &log.NullBroadcaster{ErrMsg: fmt.Sprintf("Ethereum is disabled for chain %d", chainID)}
 	} else if !cfg.EVM().LogBroadcasterEnabled() {
 		logBroadcaster = &log.NullBroadcaster{ErrMsg: fmt.Sprintf("LogBroadcaster disabled for chain %d", chainID)}
+	} else if opts.GenLogBroadcaster == nil && opts.AppConfig.Feature().LogPollerBroadcaster() {
+		logORM := log.NewORM(opts.DS, *chainID)
+		logBroadcaster = log.NewLogPollerBroadcaster(logORM, logPoller, *chainID, cfg.EVM(), l, opts.MailMon)
 	} else if opts.GenLogBroadcaster == nil {
 		logORM := log.NewORM(opts.DS, *chainID)
 		logBroadcaster = log.NewBroadcaster(logORM, client, cfg.EVM(), l, highestSeenHead, opts.MailMon)
@@ -299,10 +341,12 @@ func newChain(ctx context.Context, cfg *evmconfig.ChainScoped, nodes []*toml.Nod
 		txm:             txm,
 		logger:          l,
 		headBroadcaster: headBroadcaster,
+		reorgBus:        reorgBus,
 		headTracker:     headTracker,
 		logBroadcaster:  logBroadcaster,
 		logPoller:       logPoller,
 		balanceMonitor:  balanceMonitor,
+		remoteSigning:   remoteSigning,
 		keyStore:        opts.KeyStore,
 		gasEstimator:    gasEstimator,
 	}, nil
@@ -323,7 +367,12 @@ func (c *chain) Start(ctx context.Context) error {
 		// We do not start the log poller here, it gets
 		// started after the jobs so they have a chance to apply their filters.
 		var ms services.MultiStart
-		if err := ms.Start(ctx, c.txm, c.headBroadcaster, c.headTracker, c.logBroadcaster); err != nil {
+		if c.remoteSigning != nil {
+			if err := ms.Start(ctx, c.remoteSigning); err != nil {
+				return err
+			}
+		}
+		if err := ms.Start(ctx, c.txm, c.headBroadcaster, c.reorgBus, c.headTracker, c.logBroadcaster); err != nil {
 			return err
 		}
 		if c.balanceMonitor != nil {
@@ -348,10 +397,16 @@ func (c *chain) Close() error {
 		merr = multierr.Combine(merr, c.logBroadcaster.Close())
 		c.logger.Debug("Chain: stopping headTracker")
 		merr = multierr.Combine(merr, c.headTracker.Close())
+		c.logger.Debug("Chain: stopping reorgBus")
+		merr = multierr.Combine(merr, c.reorgBus.Close())
 		c.logger.Debug("Chain: stopping headBroadcaster")
 		merr = multierr.Combine(merr, c.headBroadcaster.Close())
 		c.logger.Debug("Chain: stopping evmTxm")
 		merr = multierr.Combine(merr, c.txm.Close())
+		if c.remoteSigning != nil {
+			c.logger.Debug("Chain: stopping remote signing")
+			merr = multierr.Combine(merr, c.remoteSigning.Close())
+		}
 		c.logger.Debug("Chain: stopping client")
 		c.client.Close()
 		c.logger.Debug("Chain: stopped")
@@ -364,12 +419,16 @@ func (c *chain) Ready() (merr error) {
 		c.StateMachine.Ready(),
 		c.txm.Ready(),
 		c.headBroadcaster.Ready(),
+		c.reorgBus.Ready(),
 		c.headTracker.Ready(),
 		c.logBroadcaster.Ready(),
 	)
 	if c.balanceMonitor != nil {
 		merr = multierr.Combine(merr, c.balanceMonitor.Ready())
 	}
+	if c.remoteSigning != nil {
+		merr = multierr.Combine(merr, c.remoteSigning.Ready())
+	}
 	return
 }
 
@@ -381,16 +440,28 @@ func (c *chain) HealthReport() map[string]error {
 	report := map[string]error{c.Name(): c.Healthy()}
 	services.CopyHealth(report, c.txm.HealthReport())
 	services.CopyHealth(report, c.headBroadcaster.HealthReport())
+	services.CopyHealth(report, c.reorgBus.HealthReport())
 	services.CopyHealth(report, c.headTracker.HealthReport())
 	services.CopyHealth(report, c.logBroadcaster.HealthReport())
 
 	if c.balanceMonitor != nil {
 		services.CopyHealth(report, c.balanceMonitor.HealthReport())
 	}
+	if c.remoteSigning != nil {
+		services.CopyHealth(report, c.remoteSigning.HealthReport())
+	}
+	if pool, ok := c.client.(rpcPoolHealthReporter); ok {
+		services.CopyHealth(report, pool.HealthReport())
+	}
 
 	return report
 }
 
+// rpcPoolHealthReporter is implemented by clients backed by a pool of RPC nodes.
+type rpcPoolHealthReporter interface {
+	HealthReport() map[string]error
+}
+
 func (c *chain) Transact(ctx context.Context, from, to string, amount *big.Int, balanceCheck bool) error {
 	return chains.ErrLOOPPUnsupported
 }
@@ -475,6 +546,7 @@ func (c *chain) Config() evmconfig.ChainScopedConfig      { return c.cfg }
 func (c *chain) LogBroadcaster() log.Broadcaster          { return c.logBroadcaster }
 func (c *chain) LogPoller() logpoller.LogPoller           { return c.logPoller }
 func (c *chain) HeadBroadcaster() httypes.HeadBroadcaster { return c.headBroadcaster }
+func (c *chain) ReorgBus() httypes.ReorgBus               { return c.reorgBus }
 func (c *chain) TxManager() txmgr.TxManager               { return c.txm }
 func (c *chain) HeadTracker() httypes.HeadTracker         { return c.headTracker }
 func (c *chain) Logger() logger.Logger                    { return c.logger }
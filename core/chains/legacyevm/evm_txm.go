@@ -11,6 +11,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/maintenance"
 )
 
 func newEvmTxm(
@@ -24,6 +25,7 @@ func newEvmTxm(
 	logPoller logpoller.LogPoller,
 	opts ChainRelayExtenderConfig,
 	headTracker httypes.HeadTracker,
+	reaperGate *maintenance.Gate,
 ) (txm txmgr.TxManager,
 	estimator gas.EvmFeeEstimator,
 	err error,
@@ -48,6 +50,14 @@ func newEvmTxm(
 		if estimator, err = gas.NewEstimator(lggr, client, cfg, cfg.GasEstimator()); err != nil {
 			return nil, nil, fmt.Errorf("failed to initialize estimator: %w", err)
 		}
+		if priceMaxCfg := cfg.GasEstimator().OnChainPriceMax(); priceMaxCfg.Enabled() {
+			estimator = gas.NewOnChainPriceMaxEstimator(lggr, client, priceMaxCfg, estimator)
+		}
+		if profiles := cfg.GasEstimatorProfiles(); len(profiles) > 0 {
+			if estimator, err = gas.NewProfiledEstimator(lggr, client, cfg, estimator, profiles); err != nil {
+				return nil, nil, err
+			}
+		}
 	} else {
 		estimator = opts.GenGasEstimator(chainID)
 	}
@@ -55,6 +65,7 @@ func newEvmTxm(
 	if opts.GenTxManager == nil {
 		txm, err = txmgr.NewTxm(
 			ds,
+			opts.BulkInserter,
 			cfg,
 			txmgr.NewEvmTxmFeeConfig(cfg.GasEstimator()),
 			cfg.Transactions(),
@@ -66,7 +77,8 @@ func newEvmTxm(
 			logPoller,
 			opts.KeyStore,
 			estimator,
-			headTracker)
+			headTracker,
+			reaperGate)
 	} else {
 		txm = opts.GenTxManager(chainID)
 	}
@@ -884,6 +884,53 @@ func (_c *Chain_Ready_Call) RunAndReturn(run func() error) *Chain_Ready_Call {
 	return _c
 }
 
+// ReorgBus provides a mock function with given fields:
+func (_m *Chain) ReorgBus() headtracker.ReorgBus[*evmtypes.Head, common.Hash] {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReorgBus")
+	}
+
+	var r0 headtracker.ReorgBus[*evmtypes.Head, common.Hash]
+	if rf, ok := ret.Get(0).(func() headtracker.ReorgBus[*evmtypes.Head, common.Hash]); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(headtracker.ReorgBus[*evmtypes.Head, common.Hash])
+		}
+	}
+
+	return r0
+}
+
+// Chain_ReorgBus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReorgBus'
+type Chain_ReorgBus_Call struct {
+	*mock.Call
+}
+
+// ReorgBus is a helper method to define mock.On call
+func (_e *Chain_Expecter) ReorgBus() *Chain_ReorgBus_Call {
+	return &Chain_ReorgBus_Call{Call: _e.mock.On("ReorgBus")}
+}
+
+func (_c *Chain_ReorgBus_Call) Run(run func()) *Chain_ReorgBus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Chain_ReorgBus_Call) Return(_a0 headtracker.ReorgBus[*evmtypes.Head, common.Hash]) *Chain_ReorgBus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Chain_ReorgBus_Call) RunAndReturn(run func() headtracker.ReorgBus[*evmtypes.Head, common.Hash]) *Chain_ReorgBus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *Chain) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -16,11 +17,14 @@ import (
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
+	"gopkg.in/guregu/null.v4"
 
 	cutils "github.com/smartcontractkit/chainlink-common/pkg/utils"
 
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/apitokens"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
@@ -31,6 +35,17 @@ func initAdminSubCmds(s *Shell) []cli.Command {
 			Usage:  "Change your API password remotely",
 			Action: s.ChangePassword,
 		},
+		{
+			Name:   "drain",
+			Usage:  "Drains the node, finishing its in-flight work before it exits",
+			Action: s.Drain,
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  "status",
+					Usage: "only show the status of the drain, without starting it",
+				},
+			},
+		},
 		{
 			Name:   "login",
 			Usage:  "Login to remote client by creating a session cookie",
@@ -129,6 +144,61 @@ func initAdminSubCmds(s *Shell) []cli.Command {
 						},
 					},
 				},
+				{
+					Name:   "revoke-sessions",
+					Usage:  "Log an API user out of all of their sessions",
+					Action: s.RevokeUserSessions,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:     "email",
+							Usage:    "Email of API user to log out",
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:  "tokens",
+			Usage: "Create, list, or delete your scoped API tokens",
+			Subcommands: cli.Commands{
+				{
+					Name:   "list",
+					Usage:  "Lists your scoped API tokens",
+					Action: s.ListAPITokens,
+				},
+				{
+					Name:   "create",
+					Usage:  "Create a new scoped API token, printing its secret once",
+					Action: s.CreateAPIToken,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:     "name",
+							Usage:    "Name of the new token",
+							Required: true,
+						},
+						cli.StringSliceFlag{
+							Name:  "scope",
+							Usage: "Scope granted to the token, can be repeated. Options: 'read-only', 'job-management', 'key-management', 'tx-management'. Tokens are always granted 'read-only'.",
+						},
+						cli.DurationFlag{
+							Name:  "expires-in",
+							Usage: "Duration after which the token expires, e.g. 720h. The token never expires if unset",
+						},
+					},
+				},
+				{
+					Name:   "delete",
+					Usage:  "Delete a scoped API token",
+					Action: s.DeleteAPIToken,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:     "id",
+							Usage:    "ID of the token to delete",
+							Required: true,
+						},
+					},
+				},
 			},
 		},
 	}
@@ -302,6 +372,157 @@ func (s *Shell) DeleteUser(c *cli.Context) (err error) {
 	return s.renderAPIResponse(response, &AdminUsersPresenter{}, "Successfully deleted API user")
 }
 
+// RevokeUserSessions logs an API user out of all of their sessions by email
+func (s *Shell) RevokeUserSessions(c *cli.Context) (err error) {
+	email := c.String("email")
+	if email == "" {
+		return s.errorOut(errors.New("email flag is empty, must specify an email"))
+	}
+
+	response, err := s.HTTP.Delete(s.ctx(), fmt.Sprintf("/v2/users/%s/sessions", email))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := response.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(response, &AdminUsersPresenter{}, "Successfully revoked API user sessions")
+}
+
+type APITokenPresenter struct {
+	JAID
+	presenters.APITokenResource
+}
+
+var apiTokensTableHeaders = []string{"ID", "Name", "Access key", "Scopes", "Expires at", "Created at"}
+
+func (p *APITokenPresenter) ToRow() []string {
+	expiresAt := "never"
+	if p.ExpiresAt.Valid {
+		expiresAt = p.ExpiresAt.Time.String()
+	}
+	row := []string{
+		p.ID,
+		p.Name,
+		p.AccessKey,
+		strings.Join(p.Scopes, ", "),
+		expiresAt,
+		p.CreatedAt.String(),
+	}
+	return row
+}
+
+// RenderTable implements TableRenderer
+func (p *APITokenPresenter) RenderTable(rt RendererTable) error {
+	rows := [][]string{p.ToRow()}
+
+	renderList(apiTokensTableHeaders, rows, rt.Writer)
+	if p.Secret != "" {
+		if _, err := rt.Write([]byte(fmt.Sprintf("\nSecret: %s\nThe secret is not stored and will not be shown again.\n", p.Secret))); err != nil {
+			return err
+		}
+	}
+
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+type APITokenPresenters []APITokenPresenter
+
+// RenderTable implements TableRenderer
+func (ps APITokenPresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	if _, err := rt.Write([]byte("API Tokens\n")); err != nil {
+		return err
+	}
+	renderList(apiTokensTableHeaders, rows, rt.Writer)
+
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+// ListAPITokens renders the scoped API tokens of the logged in user
+func (s *Shell) ListAPITokens(_ *cli.Context) (err error) {
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/user/api_tokens", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &APITokenPresenters{})
+}
+
+// CreateAPIToken creates a scoped API token for the logged in user, prompting for their password
+func (s *Shell) CreateAPIToken(c *cli.Context) (err error) {
+	request := web.CreateScopedAPITokenRequest{
+		Name:   c.String("name"),
+		Scopes: c.StringSlice("scope"),
+	}
+	for _, scope := range request.Scopes {
+		if _, err = apitokens.ParseScope(scope); err != nil {
+			return s.errorOut(err)
+		}
+	}
+	if expiresIn := c.Duration("expires-in"); expiresIn > 0 {
+		request.ExpiresAt = null.TimeFrom(time.Now().Add(expiresIn))
+	}
+
+	fmt.Println("Password:")
+	request.Password = s.PasswordPrompter.Prompt()
+
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	buf := bytes.NewBuffer(requestData)
+	response, err := s.HTTP.Post(s.ctx(), "/v2/user/api_tokens", buf)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := response.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(response, &APITokenPresenter{}, "Successfully created new API token")
+}
+
+// DeleteAPIToken deletes a scoped API token of the logged in user
+func (s *Shell) DeleteAPIToken(c *cli.Context) (err error) {
+	id := c.String("id")
+	if id == "" {
+		return s.errorOut(errors.New("id flag is empty, must specify an id"))
+	}
+
+	response, err := s.HTTP.Delete(s.ctx(), "/v2/user/api_tokens/"+id)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := response.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+	if _, err = s.parseResponse(response); err != nil {
+		return s.errorOut(err)
+	}
+
+	fmt.Printf("API token %v deleted\n", id)
+	return nil
+}
+
 // Status will display the health of various services
 func (s *Shell) Status(c *cli.Context) error {
 	resp, err := s.HTTP.Get(s.ctx(), "/health?full=1", nil)
@@ -418,3 +639,72 @@ func (s *Shell) Profile(c *cli.Context) error {
 	}
 	return nil
 }
+
+type DrainPresenter struct {
+	JAID
+	presenters.DrainResource
+}
+
+var drainTableHeaders = []string{"Phase", "Started at", "Deadline", "Finished at", "Stopped jobs", "In-flight runs", "Pending transactions", "Timed out"}
+
+func (p *DrainPresenter) ToRow() []string {
+	formatTime := func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.String()
+	}
+	return []string{
+		string(p.Phase),
+		formatTime(p.StartedAt),
+		formatTime(p.Deadline),
+		formatTime(p.FinishedAt),
+		strconv.Itoa(p.StoppedJobs),
+		strconv.Itoa(p.InFlightRuns),
+		strconv.Itoa(p.PendingTransactions),
+		strconv.FormatBool(p.TimedOut),
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *DrainPresenter) RenderTable(rt RendererTable) error {
+	renderList(drainTableHeaders, [][]string{p.ToRow()}, rt.Writer)
+
+	if len(p.Checkpoints) > 0 {
+		rows := [][]string{}
+		for _, c := range p.Checkpoints {
+			rows = append(rows, []string{c.ChainID, strconv.FormatInt(c.BlockNumber, 10)})
+		}
+		if _, err := rt.Write([]byte("\nLog poller checkpoints\n")); err != nil {
+			return err
+		}
+		renderList([]string{"Chain ID", "Block number"}, rows, rt.Writer)
+	}
+	for _, e := range p.Errors {
+		if _, err := rt.Write([]byte(fmt.Sprintf("\nError: %s", e))); err != nil {
+			return err
+		}
+	}
+
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+// Drain starts draining the node, which exits once drained, and renders the status of the drain
+func (s *Shell) Drain(c *cli.Context) (err error) {
+	var resp *http.Response
+	if c.Bool("status") {
+		resp, err = s.HTTP.Get(s.ctx(), "/v2/drain")
+	} else {
+		resp, err = s.HTTP.Post(s.ctx(), "/v2/drain", nil)
+	}
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &DrainPresenter{})
+}
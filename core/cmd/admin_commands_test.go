@@ -17,6 +17,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/cmd"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/drain"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
@@ -135,6 +136,40 @@ func TestShell_DeleteUser(t *testing.T) {
 	}
 }
 
+func TestShell_RevokeUserSessions(t *testing.T) {
+	ctx := testutils.Context(t)
+	app := startNewApplicationV2(t, nil)
+	client, _ := app.NewShellAndRenderer()
+	user := cltest.MustRandomUser(t)
+	require.NoError(t, app.BasicAdminUsersORM().CreateUser(ctx, &user))
+
+	tests := []struct {
+		name  string
+		email string
+		err   string
+	}{
+		{"No email", "", "must specify an email"},
+		{"Unknown email", "foo", "specified user not found"},
+		{"Valid params", user.Email, ""},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			set := flag.NewFlagSet("test", 0)
+			flagSetApplyFromAction(client.RevokeUserSessions, set, "")
+
+			require.NoError(t, set.Set("email", test.email))
+			c := cli.NewContext(nil, set, nil)
+			if test.err != "" {
+				assert.ErrorContains(t, client.RevokeUserSessions(c), test.err)
+			} else {
+				assert.NoError(t, client.RevokeUserSessions(c))
+			}
+		})
+	}
+}
+
 func TestShell_ListUsers(t *testing.T) {
 	ctx := testutils.Context(t)
 	app := startNewApplicationV2(t, nil)
@@ -199,6 +234,54 @@ func TestAdminUsersPresenter_RenderTable(t *testing.T) {
 	assert.Contains(t, output, user.UpdatedAt.String())
 }
 
+func TestShell_Drain(t *testing.T) {
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewShellAndRenderer()
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.Drain, set, "")
+	require.NoError(t, set.Set("status", "true"))
+	require.NoError(t, client.Drain(cli.NewContext(nil, set, nil)))
+	require.Len(t, r.Renders, 1)
+	assert.Equal(t, drain.PhaseNotDraining, r.Renders[0].(*cmd.DrainPresenter).Phase)
+
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.Drain, set, "")
+	require.NoError(t, client.Drain(cli.NewContext(nil, set, nil)))
+	require.Len(t, r.Renders, 2)
+	status := r.Renders[1].(*cmd.DrainPresenter)
+	assert.NotEqual(t, drain.PhaseNotDraining, status.Phase)
+	assert.NotNil(t, status.StartedAt)
+}
+
+func TestDrainPresenter_RenderTable(t *testing.T) {
+	startedAt := time.Now()
+	presenter := cmd.DrainPresenter{
+		JAID: cmd.JAID{ID: "drain"},
+		DrainResource: presenters.DrainResource{
+			JAID: presenters.JAID{ID: "drain"},
+			Status: drain.Status{
+				Phase:       drain.PhaseDrained,
+				StartedAt:   &startedAt,
+				StoppedJobs: 3,
+				Checkpoints: []drain.Checkpoint{{ChainID: "1", BlockNumber: 42}},
+				TimedOut:    true,
+				Errors:      []string{"failed to checkpoint"},
+			},
+		},
+	}
+
+	buffer := bytes.NewBufferString("")
+	require.NoError(t, presenter.RenderTable(cmd.RendererTable{Writer: buffer}))
+
+	output := buffer.String()
+	assert.Contains(t, output, string(drain.PhaseDrained))
+	assert.Contains(t, output, startedAt.String())
+	assert.Contains(t, output, "42")
+	assert.Contains(t, output, "true")
+	assert.Contains(t, output, "Error: failed to checkpoint")
+}
+
 type testRenderer struct {
 	presenters []cmd.AdminUsersPresenter
 }
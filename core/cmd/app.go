@@ -47,6 +47,10 @@ func NewApp(s *Shell) *cli.App {
 			Name:  "admin-credentials-file",
 			Usage: fmt.Sprintf("optional, applies only in client mode when making remote API calls. If provided, `FILE` containing admin credentials will be used for logging in, allowing to avoid an additional login step. If `FILE` is missing, it will be ignored. Defaults to %s", filepath.Join("<RootDir>", "apicredentials")),
 		},
+		cli.StringFlag{
+			Name:  "api-token-file",
+			Usage: "optional, applies only in client mode when making remote API calls. If provided, the scoped API token in `FILE`, holding its access key and secret on separate lines, will be used to authenticate instead of a session",
+		},
 		cli.StringFlag{
 			Name:  "remote-node-url",
 			Usage: "optional, applies only in client mode when making remote API calls. If provided, `URL` will be used as the remote Chainlink API endpoint",
@@ -115,6 +119,13 @@ func NewApp(s *Shell) *cli.App {
 		}
 
 		s.HTTP = NewAuthenticatedHTTPClient(s.Logger, clientOpts, cookieAuth, sr)
+		if tokenFile := c.String("api-token-file"); tokenFile != "" {
+			apiToken, err := APITokenFromFile(tokenFile)
+			if err != nil {
+				return errors.Wrapf(err, "failed to load API token from file %s", tokenFile)
+			}
+			s.HTTP = NewAPITokenHTTPClient(s.Logger, clientOpts, apiToken)
+		}
 		s.CookieAuthenticator = cookieAuth
 		s.FileSessionRequestBuilder = sessionRequestBuilder
 
@@ -158,6 +169,11 @@ func NewApp(s *Shell) *cli.App {
 			Usage:       "Commands for Bridges communicating with External Adapters",
 			Subcommands: initBrideSubCmds(s),
 		},
+		{
+			Name:        "ccip",
+			Usage:       "Commands for inspecting CCIP lanes",
+			Subcommands: initCCIPSubCmds(s),
+		},
 		{
 			Name:        "config",
 			Usage:       "Commands for the node's configuration",
@@ -199,6 +215,8 @@ func NewApp(s *Shell) *cli.App {
 				keysCommand("Solana", NewSolanaKeysClient(s)),
 				keysCommand("StarkNet", NewStarkNetKeysClient(s)),
 				keysCommand("Aptos", NewAptosKeysClient(s)),
+				keysCommand("Tron", NewTronKeysClient(s)),
+				keysCommand("Report", NewReportKeysClient(s)),
 
 				initVRFKeysSubCmd(s),
 			},
@@ -278,6 +296,11 @@ func NewApp(s *Shell) *cli.App {
 			Usage:       "Commands for managing External Initiators",
 			Subcommands: initInitiatorsSubCmds(s),
 		},
+		{
+			Name:        "p2p",
+			Usage:       "Commands for inspecting the P2P networking of the node",
+			Subcommands: initP2PSubCmds(s),
+		},
 		{
 			Name:  "txs",
 			Usage: "Commands for handling transactions",
@@ -49,13 +49,14 @@ var (
 	}
 )
 
-func withDefaults(t *testing.T, c chainlink.Config, s chainlink.Secrets) chainlink.GeneralConfig {
-	cfg, err := chainlink.GeneralConfigOpts{Config: c, Secrets: s}.New()
+func withDefaults(t *testing.T, c chainlink.Config, s chainlink.Secrets, configFiles ...string) chainlink.GeneralConfig {
+	cfg, err := chainlink.GeneralConfigOpts{Config: c, Secrets: s, ConfigFiles: configFiles}.New()
 	require.NoError(t, err)
 	return cfg
 }
 
 func Test_initServerConfig(t *testing.T) {
+	configFile := configtest.WriteTOMLFile(t, testConfigFileContents, "test.toml")
 	type args struct {
 		opts         *chainlink.GeneralConfigOpts
 		fileNames    []string
@@ -88,9 +89,9 @@ func Test_initServerConfig(t *testing.T) {
 			name: "files only",
 			args: args{
 				opts:      new(chainlink.GeneralConfigOpts),
-				fileNames: []string{configtest.WriteTOMLFile(t, testConfigFileContents, "test.toml")},
+				fileNames: []string{configFile},
 			},
-			wantCfg: withDefaults(t, testConfigFileContents, chainlink.Secrets{}),
+			wantCfg: withDefaults(t, testConfigFileContents, chainlink.Secrets{}, configFile),
 		},
 		{
 			name: "file error",
@@ -104,7 +105,7 @@ func Test_initServerConfig(t *testing.T) {
 			name: "env overlay of file",
 			args: args{
 				opts:      new(chainlink.GeneralConfigOpts),
-				fileNames: []string{configtest.WriteTOMLFile(t, testConfigFileContents, "test.toml")},
+				fileNames: []string{configFile},
 				envVar:    testEnvContents,
 			},
 			wantCfg: withDefaults(t, chainlink.Config{
@@ -118,7 +119,7 @@ func Test_initServerConfig(t *testing.T) {
 						},
 					},
 				},
-			}, chainlink.Secrets{}),
+			}, chainlink.Secrets{}, configFile),
 		},
 		{
 			name: "failed to read secrets",
@@ -133,10 +134,10 @@ func Test_initServerConfig(t *testing.T) {
 			name: "reading secrets",
 			args: args{
 				opts:         new(chainlink.GeneralConfigOpts),
-				fileNames:    []string{configtest.WriteTOMLFile(t, testConfigFileContents, "test.toml")},
+				fileNames:    []string{configFile},
 				secretsFiles: []string{configtest.WriteTOMLFile(t, testSecretsFileContents, "test_secrets.toml")},
 			},
-			wantCfg: withDefaults(t, testConfigFileContents, testSecretsRedactedContents),
+			wantCfg: withDefaults(t, testConfigFileContents, testSecretsRedactedContents, configFile),
 		},
 		{
 			name: "reading multiple secrets",
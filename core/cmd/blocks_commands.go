@@ -48,6 +48,18 @@ func initBlocksSubCmds(s *Shell) []cli.Command {
 				},
 			},
 		},
+		{
+			Name:   "recover-finality",
+			Usage:  "Re-verify LogPoller state against the RPC after a finality violation and rewrite non-canonical logs",
+			Action: s.RecoverFinality,
+			Flags: []cli.Flag{
+				cli.Int64Flag{
+					Name:     "evm-chain-id",
+					Usage:    "Chain ID of the EVM-based blockchain",
+					Required: true,
+				},
+			},
+		},
 	}
 }
 
@@ -133,3 +145,68 @@ func (s *Shell) FindLCA(c *cli.Context) (err error) {
 
 	return s.renderAPIResponse(resp, &LCAPresenter{}, "Last Common Ancestor")
 }
+
+// FinalityRecoveryPresenter implements TableRenderer for a FinalityRecoveryResponse.
+type FinalityRecoveryPresenter struct {
+	web.FinalityRecoveryResponse
+}
+
+// RenderTable implements TableRenderer
+func (p FinalityRecoveryPresenter) RenderTable(rt RendererTable) error {
+	renderList(
+		[]string{"ChainID", "LCA Block Hash", "LCA Block Number", "From Block", "To Block", "Removed Logs", "Added Logs"},
+		[][]string{{
+			p.EVMChainID.String(),
+			p.LCAHash,
+			strconv.FormatInt(p.LCABlockNumber, 10),
+			strconv.FormatInt(p.FromBlock, 10),
+			strconv.FormatInt(p.ToBlock, 10),
+			strconv.Itoa(len(p.RemovedLogs)),
+			strconv.Itoa(len(p.AddedLogs)),
+		}},
+		rt.Writer,
+	)
+
+	changes := make([][]string, 0, len(p.RemovedLogs)+len(p.AddedLogs))
+	for _, l := range p.RemovedLogs {
+		changes = append(changes, changedLogRow("removed", l))
+	}
+	for _, l := range p.AddedLogs {
+		changes = append(changes, changedLogRow("added", l))
+	}
+	if len(changes) > 0 {
+		renderList([]string{"Change", "Block Number", "Block Hash", "Log Index", "Tx Hash", "Address", "Event Sig"}, changes, rt.Writer)
+	}
+
+	return nil
+}
+
+func changedLogRow(change string, l web.ChangedLog) []string {
+	return []string{change, strconv.FormatInt(l.BlockNumber, 10), l.BlockHash, strconv.FormatInt(l.LogIndex, 10), l.TxHash, l.Address, l.EventSig}
+}
+
+// RecoverFinality rewrites LogPoller state after a finality violation and reports which logs have changed.
+func (s *Shell) RecoverFinality(c *cli.Context) (err error) {
+	v := url.Values{}
+
+	if c.IsSet("evm-chain-id") {
+		v.Add("evmChainID", fmt.Sprintf("%d", c.Int64("evm-chain-id")))
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(),
+		fmt.Sprintf(
+			"/v2/recover_finality?%s",
+			v.Encode(),
+		), bytes.NewBufferString("{}"))
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &FinalityRecoveryPresenter{}, "Finality Recovery")
+}
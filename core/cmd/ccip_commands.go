@@ -0,0 +1,314 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/simulator"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initCCIPSubCmds(s *Shell) []cli.Command {
+	return []cli.Command{
+		{
+			Name:   "lanes",
+			Usage:  "Show the status of the CCIP lanes served by the node",
+			Action: s.ListCCIPLanes,
+		},
+		{
+			Name:  "discover",
+			Usage: "Discover the contracts of a CCIP lane from the router of its dest chain, and generate its job specs",
+			Description: "Reads the offRamp, commit store and onRamp of the lane onchain, checks that their configs agree, " +
+				"and prints them along with the skeletons of the commit and execution job specs of the lane. " +
+				"Both chains must be configured on the node.",
+			Action: s.DiscoverCCIPLane,
+			Flags: []cli.Flag{
+				cli.Uint64Flag{Name: "source-chain-selector", Usage: "chain selector of the source chain"},
+				cli.Uint64Flag{Name: "dest-chain-selector", Usage: "chain selector of the dest chain"},
+				cli.StringFlag{Name: "router", Usage: "address of the router of the dest chain"},
+				cli.StringFlag{Name: "commit-spec", Usage: "write the commit job spec to this file"},
+				cli.StringFlag{Name: "exec-spec", Usage: "write the execution job spec to this file"},
+			},
+		},
+		{
+			Name:  "simulate",
+			Usage: "Run a CCIP lane on two simulated chains for local end-to-end testing",
+			Description: "Deploys the contracts of a lane on two in-memory EVM chains, and serves each chain over HTTP and websocket " +
+				"until interrupted. Blocks are only produced as configured, and the sim_mine, sim_increaseTime and sim_setAutoMine " +
+				"RPC methods control them at runtime. The OCR2 configs of the commit store and the offramp are left to be set.",
+			Action: s.SimulateCCIPLane,
+			Flags: []cli.Flag{
+				cli.Uint64Flag{Name: "source-chain-id", Usage: "chain ID of the source chain", Value: 1000},
+				cli.Uint64Flag{Name: "source-chain-selector", Usage: "chain selector of the source chain", Value: 11787463284727550157},
+				cli.StringFlag{Name: "source-rpc-addr", Usage: "listen address of the RPC server of the source chain", Value: "127.0.0.1:8545"},
+				cli.Uint64Flag{Name: "dest-chain-id", Usage: "chain ID of the dest chain", Value: 1337},
+				cli.Uint64Flag{Name: "dest-chain-selector", Usage: "chain selector of the dest chain", Value: 3379446385462418246},
+				cli.StringFlag{Name: "dest-rpc-addr", Usage: "listen address of the RPC server of the dest chain", Value: "127.0.0.1:8546"},
+				cli.StringFlag{Name: "seed", Usage: "seed of the keys deploying the contracts, which determines their addresses", Value: "chainlink"},
+				cli.StringFlag{Name: "genesis-time", Usage: "timestamp of the first block, in RFC3339, defaults to a day ago"},
+				cli.DurationFlag{Name: "block-time", Usage: "time between the timestamps of consecutive blocks, in whole seconds", Value: 2 * time.Second},
+				cli.DurationFlag{Name: "mine-interval", Usage: "mine a block periodically, disabled if zero"},
+				cli.BoolFlag{Name: "auto-mine", Usage: "mine a block after each transaction"},
+				cli.UintFlag{Name: "finality-depth", Usage: "depth of the blocks reported as finalized", Value: 10},
+				cli.StringFlag{Name: "output, o", Usage: "write the addresses of the contracts to this file, as JSON"},
+			},
+		},
+	}
+}
+
+type CCIPLanePresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.CCIPLaneResource
+}
+
+var ccipLanesHeaders = []string{
+	"Source Chain Selector", "Dest Chain Selector",
+	"Latest Committed", "Pending Commit", "Commit Observed At",
+	"Latest Executed", "Executable", "Unexpired Roots", "Exec Observed At",
+	"Source Gas Price", "Source Gas Price USD", "Gas Price Observed At",
+	"Gas Price Updated At", "Token Prices Updated At",
+}
+
+// ToRow presents the CCIPLaneResource as a slice of strings.
+func (p *CCIPLanePresenter) ToRow() []string {
+	formatTime := func(t null.Time) string {
+		if !t.Valid {
+			return ""
+		}
+		return t.Time.Format(time.RFC3339)
+	}
+	var gasPrice, gasPriceUSD string
+	if p.SourceGasPrice != nil {
+		gasPrice = p.SourceGasPrice.String()
+	}
+	if p.SourceGasPriceUSD != nil {
+		gasPriceUSD = p.SourceGasPriceUSD.String()
+	}
+
+	return []string{
+		strconv.FormatUint(p.SourceChainSelector, 10),
+		strconv.FormatUint(p.DestChainSelector, 10),
+		strconv.FormatUint(p.LatestCommittedSeqNr, 10),
+		strconv.Itoa(p.PendingCommitMessages),
+		formatTime(p.CommitObservedAt),
+		strconv.FormatUint(p.LatestExecutedSeqNr, 10),
+		strconv.Itoa(p.ExecutableMessages),
+		strconv.Itoa(p.UnexpiredCommitRoots),
+		formatTime(p.ExecObservedAt),
+		gasPrice,
+		gasPriceUSD,
+		formatTime(p.GasPriceObservedAt),
+		formatTime(p.GasPriceUpdatedAt),
+		formatTime(p.TokenPricesUpdatedAt),
+	}
+}
+
+// CCIPLanePresenters implements TableRenderer for a slice of CCIPLanePresenter.
+type CCIPLanePresenters []CCIPLanePresenter
+
+// RenderTable implements TableRenderer
+func (ps CCIPLanePresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+	renderList(ccipLanesHeaders, rows, rt.Writer)
+	return nil
+}
+
+// ListCCIPLanes shows the status of the CCIP lanes served by the node: the latest committed and executed sequence
+// numbers, the pending messages, the state of the gas price estimator and when the prices were last updated.
+func (s *Shell) ListCCIPLanes(_ *cli.Context) (err error) {
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/ccip/lanes")
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &CCIPLanePresenters{})
+}
+
+// CCIPLaneDiscoveryPresenter presents the contracts and the job specs of a discovered CCIP lane.
+type CCIPLaneDiscoveryPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.CCIPLaneDiscoveryResource
+}
+
+// RenderTable implements TableRenderer
+func (p *CCIPLaneDiscoveryPresenter) RenderTable(rt RendererTable) error {
+	rows := [][]string{
+		{"Source Chain Selector", strconv.FormatUint(p.SourceChainSelector, 10)},
+		{"Source Chain ID", strconv.FormatUint(p.SourceChainID, 10)},
+		{"Source Router", p.SourceRouter.Hex()},
+		{"OnRamp", p.OnRamp.Hex()},
+		{"Source Price Registry", p.SourcePriceRegistry.Hex()},
+		{"Source Wrapped Native", p.SourceWrappedNative.Hex()},
+		{"Dest Chain Selector", strconv.FormatUint(p.DestChainSelector, 10)},
+		{"Dest Chain ID", strconv.FormatUint(p.DestChainID, 10)},
+		{"Dest Router", p.DestRouter.Hex()},
+		{"OffRamp", p.OffRamp.Hex()},
+		{"Commit Store", p.CommitStore.Hex()},
+		{"Dest Price Registry", p.DestPriceRegistry.Hex()},
+	}
+	for _, token := range p.DestFeeTokens {
+		rows = append(rows, []string{"Dest Fee Token", token.Hex()})
+	}
+	renderList([]string{"Name", "Value"}, rows, rt.Writer)
+	_, err := fmt.Fprintf(rt.Writer, "\nCommit job spec:\n%s\nExecution job spec:\n%s", p.CommitJobSpec, p.ExecJobSpec)
+	return err
+}
+
+// DiscoverCCIPLane reads the contracts of a CCIP lane onchain from the router of its dest chain, and shows them along
+// with the skeletons of the job specs of the lane, optionally writing the specs to files.
+func (s *Shell) DiscoverCCIPLane(c *cli.Context) (err error) {
+	v := url.Values{}
+	v.Add("sourceChainSelector", strconv.FormatUint(c.Uint64("source-chain-selector"), 10))
+	v.Add("destChainSelector", strconv.FormatUint(c.Uint64("dest-chain-selector"), 10))
+	v.Add("router", c.String("router"))
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/ccip/lanes/discover?"+v.Encode())
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	var p CCIPLaneDiscoveryPresenter
+	if err = s.renderAPIResponse(resp, &p); err != nil {
+		return err
+	}
+	specs := []struct{ file, spec string }{
+		{c.String("commit-spec"), p.CommitJobSpec},
+		{c.String("exec-spec"), p.ExecJobSpec},
+	}
+	for _, spec := range specs {
+		if spec.file == "" {
+			continue
+		}
+		if err = utils.WriteFileWithMaxPerms(spec.file, []byte(spec.spec), 0o600); err != nil {
+			return s.errorOut(err)
+		}
+	}
+	return nil
+}
+
+// CCIPSimulatorPresenter presents the lane and the RPC URLs of a CCIP simulator.
+type CCIPSimulatorPresenter struct {
+	simulator.Lane
+	SourceHTTPURL string `json:"sourceHTTPURL"`
+	SourceWSURL   string `json:"sourceWSURL"`
+	DestHTTPURL   string `json:"destHTTPURL"`
+	DestWSURL     string `json:"destWSURL"`
+}
+
+// RenderTable implements TableRenderer
+func (p CCIPSimulatorPresenter) RenderTable(rt RendererTable) error {
+	type contract struct {
+		name string
+		addr common.Address
+	}
+	chainRows := func(chain string, cc simulator.ChainContracts, httpURL, wsURL string, contracts ...contract) [][]string {
+		rows := [][]string{
+			{chain, "Chain ID", strconv.FormatUint(cc.ChainID, 10)},
+			{chain, "Chain Selector", strconv.FormatUint(cc.ChainSelector, 10)},
+			{chain, "HTTP URL", httpURL},
+			{chain, "WS URL", wsURL},
+		}
+		contracts = append([]contract{
+			{"Owner", cc.Owner}, {"RMN", cc.RMN}, {"RMN Proxy", cc.RMNProxy}, {"Token Admin Registry", cc.TokenAdminRegistry},
+			{"LINK Token", cc.LinkToken}, {"Wrapped Native", cc.WrappedNative}, {"Router", cc.Router},
+			{"Price Registry", cc.PriceRegistry}, {"LINK Token Pool", cc.LinkTokenPool}, {"Wrapped Native Pool", cc.WrappedNativePool},
+		}, contracts...)
+		for _, c := range contracts {
+			rows = append(rows, []string{chain, c.name, c.addr.Hex()})
+		}
+		return rows
+	}
+	rows := chainRows("Source", p.Source.ChainContracts, p.SourceHTTPURL, p.SourceWSURL, contract{"OnRamp", p.Source.OnRamp})
+	rows = append(rows, chainRows("Dest", p.Dest.ChainContracts, p.DestHTTPURL, p.DestWSURL,
+		contract{"Commit Store", p.Dest.CommitStore}, contract{"OffRamp", p.Dest.OffRamp}, contract{"Receiver", p.Dest.Receiver})...)
+	renderList([]string{"Chain", "Name", "Value"}, rows, rt.Writer)
+	return nil
+}
+
+// SimulateCCIPLane deploys a CCIP lane on two simulated chains, and serves them over RPC until interrupted.
+func (s *Shell) SimulateCCIPLane(c *cli.Context) error {
+	genesisTime := time.Now().Add(-24 * time.Hour).Truncate(time.Second)
+	if v := c.String("genesis-time"); v != "" {
+		var err error
+		if genesisTime, err = time.Parse(time.RFC3339, v); err != nil {
+			return s.errorOut(fmt.Errorf("invalid genesis time: %w", err))
+		}
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	sim, err := simulator.New(ctx, s.Logger, simulator.Config{
+		Source: simulator.ChainConfig{
+			ChainID:    c.Uint64("source-chain-id"),
+			Selector:   c.Uint64("source-chain-selector"),
+			ListenAddr: c.String("source-rpc-addr"),
+		},
+		Dest: simulator.ChainConfig{
+			ChainID:    c.Uint64("dest-chain-id"),
+			Selector:   c.Uint64("dest-chain-selector"),
+			ListenAddr: c.String("dest-rpc-addr"),
+		},
+		Seed: c.String("seed"),
+		Block: simulator.BlockConfig{
+			GenesisTime:   genesisTime,
+			BlockTime:     c.Duration("block-time"),
+			Interval:      c.Duration("mine-interval"),
+			AutoMine:      c.Bool("auto-mine"),
+			FinalityDepth: uint32(c.Uint("finality-depth")),
+		},
+	})
+	if err != nil {
+		return s.errorOut(err)
+	}
+	if err = sim.Start(ctx); err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := sim.Close(); cerr != nil {
+			s.Logger.Errorw("Failed to close CCIP simulator", "err", cerr)
+		}
+	}()
+
+	p := CCIPSimulatorPresenter{Lane: sim.Lane}
+	p.SourceHTTPURL, p.SourceWSURL, p.DestHTTPURL, p.DestWSURL = sim.URLs()
+	if out := c.String("output"); out != "" {
+		b, err := json.MarshalIndent(p, "", "  ")
+		if err != nil {
+			return s.errorOut(err)
+		}
+		if err = utils.WriteFileWithMaxPerms(out, b, 0o600); err != nil {
+			return s.errorOut(err)
+		}
+	}
+	if err = s.Renderer.Render(p); err != nil {
+		return s.errorOut(err)
+	}
+
+	<-ctx.Done()
+	return nil
+}
@@ -0,0 +1,151 @@
+package cmd_test
+
+import (
+	"bytes"
+	"math/big"
+	"math/rand"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/discovery"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/simulator"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestCCIPLanePresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		observedAt = time.Now()
+		buffer     = bytes.NewBufferString("")
+		r          = cmd.RendererTable{Writer: buffer}
+	)
+
+	ps := cmd.CCIPLanePresenters{{
+		CCIPLaneResource: presenters.CCIPLaneResource{
+			SourceChainSelector:   16015286601757825753,
+			DestChainSelector:     3478487238524512106,
+			CommitObservedAt:      null.TimeFrom(observedAt),
+			LatestCommittedSeqNr:  41,
+			PendingCommitMessages: 3,
+			SourceGasPrice:        ubig.NewI(2_000_000_000),
+		},
+	}}
+	require.NoError(t, ps.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "16015286601757825753")
+	assert.Contains(t, output, "3478487238524512106")
+	assert.Contains(t, output, "41")
+	assert.Contains(t, output, "2000000000")
+	assert.Contains(t, output, observedAt.Format(time.RFC3339))
+}
+
+func TestCCIPLaneDiscoveryPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		buffer = bytes.NewBufferString("")
+		r      = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.CCIPLaneDiscoveryPresenter{
+		CCIPLaneDiscoveryResource: presenters.CCIPLaneDiscoveryResource{
+			Lane: discovery.Lane{
+				SourceChainSelector: 16015286601757825753,
+				DestChainSelector:   3478487238524512106,
+				OffRamp:             common.HexToAddress("0x1"),
+				DestFeeTokens:       []common.Address{common.HexToAddress("0x2")},
+			},
+			CommitJobSpec: `pluginType = "ccip-commit"`,
+			ExecJobSpec:   `pluginType = "ccip-execution"`,
+		},
+	}
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "16015286601757825753")
+	assert.Contains(t, output, "3478487238524512106")
+	assert.Contains(t, output, common.HexToAddress("0x1").Hex())
+	assert.Contains(t, output, common.HexToAddress("0x2").Hex())
+	assert.Contains(t, output, "Commit job spec:\npluginType = \"ccip-commit\"")
+	assert.Contains(t, output, "Execution job spec:\npluginType = \"ccip-execution\"")
+}
+
+func TestCCIPSimulatorPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		buffer = bytes.NewBufferString("")
+		r      = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.CCIPSimulatorPresenter{
+		Lane: simulator.Lane{
+			Source: simulator.SourceContracts{
+				ChainContracts: simulator.ChainContracts{ChainID: 1000, ChainSelector: 11787463284727550157},
+				OnRamp:         common.HexToAddress("0x1"),
+			},
+			Dest: simulator.DestContracts{
+				ChainContracts: simulator.ChainContracts{ChainID: 1337, ChainSelector: 3379446385462418246},
+				OffRamp:        common.HexToAddress("0x2"),
+			},
+		},
+		SourceHTTPURL: "http://127.0.0.1:8545",
+		DestWSURL:     "ws://127.0.0.1:8546",
+	}
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "11787463284727550157")
+	assert.Contains(t, output, "3379446385462418246")
+	assert.Contains(t, output, "http://127.0.0.1:8545")
+	assert.Contains(t, output, "ws://127.0.0.1:8546")
+	assert.Contains(t, output, common.HexToAddress("0x1").Hex())
+	assert.Contains(t, output, common.HexToAddress("0x2").Hex())
+}
+
+func TestShell_ListCCIPLanes(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewShellAndRenderer()
+
+	// lanes are process wide, so use selectors no other test uses
+	source, dest := rand.Uint64(), rand.Uint64()
+	ccip.Lanes.Acquire(source, dest)
+	t.Cleanup(func() { ccip.Lanes.Release(source, dest) })
+	ccip.Lanes.Update(source, dest, func(status *ccip.LaneStatus) {
+		status.LatestCommittedSeqNr = 10
+		status.LatestExecutedSeqNr = 8
+		status.SourceGasPrice = big.NewInt(1e9)
+	})
+
+	require.NoError(t, client.ListCCIPLanes(cltest.EmptyCLIContext()))
+	require.Len(t, r.Renders, 1)
+	lanes := *r.Renders[0].(*cmd.CCIPLanePresenters)
+
+	var found bool
+	for _, lane := range lanes {
+		if lane.SourceChainSelector != source || lane.DestChainSelector != dest {
+			continue
+		}
+		found = true
+		assert.Equal(t, strconv.FormatUint(source, 10)+"-"+strconv.FormatUint(dest, 10), lane.ID)
+		assert.Equal(t, uint64(10), lane.LatestCommittedSeqNr)
+		assert.Equal(t, uint64(8), lane.LatestExecutedSeqNr)
+		assert.Equal(t, "1000000000", lane.SourceGasPrice.String())
+		assert.False(t, lane.GasPriceUpdatedAt.Valid)
+	}
+	assert.True(t, found)
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"net/url"
 
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
@@ -44,6 +45,10 @@ func initEVMTxSubCmd(s *Shell) cli.Command {
 						Name:  "id",
 						Usage: "chain ID",
 					},
+					cli.StringFlag{
+						Name:  "idempotency-key",
+						Usage: "optional key to safely retry the command: the transaction already created with the key is returned instead of sending again",
+					},
 				},
 			},
 			{
@@ -62,6 +67,21 @@ func initEVMTxSubCmd(s *Shell) cli.Command {
 				Usage:  "get information on a specific Ethereum Transaction",
 				Action: s.ShowTransaction,
 			},
+			{
+				Name:   "job-spend",
+				Usage:  "Show the fees spent on transactions created on behalf of job <jobID>",
+				Action: s.ShowJobSpend,
+				Flags: []cli.Flag{
+					cli.Int64Flag{
+						Name:  "id",
+						Usage: "chain ID",
+					},
+					cli.StringFlag{
+						Name:  "since",
+						Usage: "RFC3339 time to total the fees from, defaults to the JobSpendWindow of the chain",
+					},
+				},
+			},
 		},
 	}
 }
@@ -131,6 +151,53 @@ func (s *Shell) ShowTransaction(c *cli.Context) (err error) {
 	return err
 }
 
+type EVMJobSpendPresenter struct {
+	JAID
+	presenters.EVMJobSpendResource
+}
+
+// RenderTable implements TableRenderer
+func (p *EVMJobSpendPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Job ID", "Chain ID", "Since", "Attempted", "Mined", "Total"})
+	table.Append([]string{
+		fmt.Sprint(p.JobID),
+		p.EVMChainID.String(),
+		p.Since.String(),
+		p.Attempted,
+		p.Mined,
+		p.Total,
+	})
+
+	render("EVM Job Spend (wei)", table)
+	return nil
+}
+
+// ShowJobSpend returns the fees spent on transactions created on behalf of the given job
+func (s *Shell) ShowJobSpend(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the job id"))
+	}
+	query := url.Values{}
+	if c.IsSet("id") {
+		query.Set("evmChainID", c.String("id"))
+	}
+	if c.IsSet("since") {
+		query.Set("since", c.String("since"))
+	}
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/transactions/evm/job_spend/"+url.PathEscape(c.Args().First())+"?"+query.Encode())
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	err = s.renderAPIResponse(resp, &EVMJobSpendPresenter{})
+	return err
+}
+
 // SendEther transfers ETH from the node's account to a specified address.
 func (s *Shell) SendEther(c *cli.Context) (err error) {
 	if c.NArg() < 3 {
@@ -190,6 +257,10 @@ func (s *Shell) SendEther(c *cli.Context) (err error) {
 		EVMChainID:         (*ubig.Big)(evmChainID),
 		AllowHigherAmounts: c.IsSet("force"),
 	}
+	if c.IsSet("idempotency-key") {
+		key := c.String("idempotency-key")
+		request.IdempotencyKey = &key
+	}
 
 	requestData, err := json.Marshal(request)
 	if err != nil {
@@ -89,6 +89,31 @@ func TestShell_ShowTransaction(t *testing.T) {
 	assert.Equal(t, &tx.FromAddress, renderedTx.From)
 }
 
+func TestShell_ShowJobSpend(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewShellAndRenderer()
+
+	_, err := app.GetDB().ExecContext(testutils.Context(t), `INSERT INTO evm.tx_job_spend (tx_id, evm_chain_id, job_id, attempted_fee, mined_fee, created_at, updated_at)
+		VALUES (1, $1, 7, 100, 40, NOW(), NOW())`, testutils.FixtureChainID.String())
+	require.NoError(t, err)
+
+	set := flag.NewFlagSet("test job spend", 0)
+	flagSetApplyFromAction(client.ShowJobSpend, set, "")
+
+	require.NoError(t, set.Set("id", testutils.FixtureChainID.String()))
+	require.NoError(t, set.Parse([]string{"7"}))
+
+	c := cli.NewContext(nil, set, nil)
+	require.NoError(t, client.ShowJobSpend(c))
+
+	renderedSpend := *r.Renders[0].(*cmd.EVMJobSpendPresenter)
+	assert.Equal(t, int32(7), renderedSpend.JobID)
+	assert.Equal(t, "40", renderedSpend.Mined)
+	assert.Equal(t, "40", renderedSpend.Total)
+}
+
 func TestShell_IndexTxAttempts(t *testing.T) {
 	t.Parallel()
 
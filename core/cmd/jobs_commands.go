@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"sort"
 	"strings"
 	"time"
 
@@ -12,6 +13,9 @@ import (
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
@@ -40,11 +44,26 @@ func initJobsSubCmds(s *Shell) []cli.Command {
 			Usage:  "Create a job",
 			Action: s.CreateJob,
 		},
+		{
+			Name:   "validate",
+			Usage:  "Validate a job spec without creating the job",
+			Action: s.ValidateJob,
+		},
+		{
+			Name:   "schema",
+			Usage:  "Show the schema of the specs of a job type, or of all the job types if none is given",
+			Action: s.ShowJobSpecSchema,
+		},
 		{
 			Name:   "delete",
 			Usage:  "Delete a job",
 			Action: s.DeleteJob,
 		},
+		{
+			Name:   "promote",
+			Usage:  "Promote an OCR2 job held in standby to active",
+			Action: s.PromoteJob,
+		},
 		{
 			Name:   "run",
 			Usage:  "Trigger a job run",
@@ -209,6 +228,58 @@ func (ps JobPresenters) RenderTable(rt RendererTable) error {
 	return nil
 }
 
+// JobSpecSchemaPresenter wraps the JSONAPI JobSpecSchema Resource and adds rendering functionality
+type JobSpecSchemaPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.JobSpecSchemaResource
+}
+
+// RenderTable implements TableRenderer
+func (p *JobSpecSchemaPresenter) RenderTable(rt RendererTable) error {
+	headers := []string{"Field", "Type", "Required", "Allowed Values"}
+	table := rt.newTable(headers)
+	for _, r := range specFieldRows("", p.Fields) {
+		table.Append(r)
+	}
+	render(fmt.Sprintf("Job Spec Schema (%s)", p.ID), table)
+
+	pluginTypes := make([]string, 0, len(p.PluginConfigs))
+	for t := range p.PluginConfigs {
+		pluginTypes = append(pluginTypes, string(t))
+	}
+	sort.Strings(pluginTypes)
+	for _, t := range pluginTypes {
+		table = rt.newTable(headers)
+		for _, r := range specFieldRows("pluginConfig.", p.PluginConfigs[types.OCR2PluginType(t)]) {
+			table.Append(r)
+		}
+		render(fmt.Sprintf("Plugin Config Schema (%s)", t), table)
+	}
+	return nil
+}
+
+// specFieldRows returns a row per field, including the fields of tables, which are prefixed with the name of the table.
+func specFieldRows(prefix string, fields []job.SpecField) [][]string {
+	var rows [][]string
+	for _, f := range fields {
+		rows = append(rows, []string{prefix + f.Name, f.Type, fmt.Sprintf("%t", f.Required), strings.Join(f.Enum, ", ")})
+		rows = append(rows, specFieldRows(prefix+f.Name+".", f.Fields)...)
+	}
+	return rows
+}
+
+type JobSpecSchemaPresenters []JobSpecSchemaPresenter
+
+// RenderTable implements TableRenderer
+func (ps JobSpecSchemaPresenters) RenderTable(rt RendererTable) error {
+	for _, p := range ps {
+		if err := p.RenderTable(rt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // ListJobs lists all jobs
 func (s *Shell) ListJobs(c *cli.Context) (err error) {
 	return s.getPage("/v2/jobs", c.Int("page"), &JobPresenters{})
@@ -276,6 +347,59 @@ func (s *Shell) CreateJob(c *cli.Context) (err error) {
 	return err
 }
 
+// ValidateJob validates a job spec without creating the job
+// Valid input is a TOML string or a path to TOML file
+func (s *Shell) ValidateJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass in TOML or filepath"))
+	}
+
+	tomlString, err := getTOMLString(c.Args().First())
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	request, err := json.Marshal(web.CreateJobRequest{
+		TOML: tomlString,
+	})
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/validate", bytes.NewReader(request))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &JobPresenter{}, "Job spec is valid")
+}
+
+// ShowJobSpecSchema displays the schema of the specs of a job type, or of all the job types
+func (s *Shell) ShowJobSpecSchema(c *cli.Context) (err error) {
+	url := "/v2/jobs/schemas"
+	var presenter interface{} = &JobSpecSchemaPresenters{}
+	if c.Args().Present() {
+		url += "/" + c.Args().First()
+		presenter = &JobSpecSchemaPresenter{}
+	}
+	resp, err := s.HTTP.Get(s.ctx(), url)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, presenter)
+}
+
 // DeleteJob deletes a job
 func (s *Shell) DeleteJob(c *cli.Context) error {
 	if !c.Args().Present() {
@@ -294,6 +418,24 @@ func (s *Shell) DeleteJob(c *cli.Context) error {
 	return nil
 }
 
+// PromoteJob promotes an OCR2 job held in standby to active
+func (s *Shell) PromoteJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the job id to be promoted"))
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/"+c.Args().First()+"/promote", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &JobPresenter{}, "Job promoted")
+}
+
 // TriggerPipelineRun triggers a job run based on a job ID
 func (s *Shell) TriggerPipelineRun(c *cli.Context) error {
 	if !c.Args().Present() {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
 
 	"github.com/pkg/errors"
@@ -74,6 +75,36 @@ func initOCR2KeysSubCmd(s *Shell) cli.Command {
 				},
 				Action: s.ExportOCR2Key,
 			},
+			{
+				Name:  "import-bundles",
+				Usage: format(`Imports all the OCR2 key bundles of an encrypted envelope, or none of them if any fails`),
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "old-password, oldpassword, p",
+						Usage: "`FILE` containing the password used to encrypt the envelope",
+					},
+				},
+				Action: s.ImportOCR2KeyBundles,
+			},
+			{
+				Name:  "export-bundles",
+				Usage: format(`Exports OCR2 key bundles of all chain families to an encrypted envelope`),
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "new-password, newpassword, p",
+						Usage: "`FILE` containing the password to encrypt the envelope (required)",
+					},
+					cli.StringFlag{
+						Name:  "output, o",
+						Usage: "`FILE` where the envelope will be saved (required)",
+					},
+					cli.StringSliceFlag{
+						Name:  "id",
+						Usage: "`ID` of a key bundle to export, may be repeated (default: all key bundles)",
+					},
+				},
+				Action: s.ExportOCR2KeyBundles,
+			},
 		},
 	}
 }
@@ -286,3 +317,93 @@ func (s *Shell) ExportOCR2Key(c *cli.Context) (err error) {
 
 	return nil
 }
+
+// ImportOCR2KeyBundles imports the OCR2 key bundles of an encrypted envelope
+func (s *Shell) ImportOCR2KeyBundles(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("Must pass the filepath of the envelope to be imported"))
+	}
+
+	oldPasswordFile := c.String("old-password")
+	if len(oldPasswordFile) == 0 {
+		return s.errorOut(errors.New("Must specify --old-password/-p flag"))
+	}
+	oldPassword, err := os.ReadFile(oldPasswordFile)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "Could not read password file"))
+	}
+
+	filepath := c.Args().Get(0)
+	envelopeJSON, err := os.ReadFile(filepath)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	normalizedPassword := normalizePassword(string(oldPassword))
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/keys/ocr2/bundles/import?oldpassword="+normalizedPassword, bytes.NewReader(envelopeJSON))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	var presenters OCR2KeyBundlePresenters
+	return s.renderAPIResponse(resp, &presenters, "Imported OCR key bundles")
+}
+
+// ExportOCR2KeyBundles exports OCR2 key bundles to an encrypted envelope
+func (s *Shell) ExportOCR2KeyBundles(c *cli.Context) (err error) {
+	newPasswordFile := c.String("new-password")
+	if len(newPasswordFile) == 0 {
+		return s.errorOut(errors.New("Must specify --new-password/-p flag"))
+	}
+	newPassword, err := os.ReadFile(newPasswordFile)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "Could not read password file"))
+	}
+
+	filepath := c.String("output")
+	if len(filepath) == 0 {
+		return s.errorOut(errors.New("Must specify --output/-o flag"))
+	}
+
+	query := url.Values{}
+	for _, id := range c.StringSlice("id") {
+		query.Add("id", id)
+	}
+
+	normalizedPassword := normalizePassword(string(newPassword))
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/keys/ocr2/bundles/export?newpassword="+normalizedPassword+"&"+query.Encode(), nil)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "Could not make HTTP request"))
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return s.errorOut(fmt.Errorf("error exporting: %w", httpError(resp)))
+	}
+
+	envelopeJSON, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "Could not read response body"))
+	}
+
+	err = utils.WriteFileWithMaxPerms(filepath, envelopeJSON, 0o600)
+	if err != nil {
+		return s.errorOut(errors.Wrapf(err, "Could not write %v", filepath))
+	}
+
+	_, err = os.Stderr.WriteString(fmt.Sprintf("Exported OCR key bundles to %s", filepath))
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	return nil
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
@@ -190,6 +191,49 @@ func TestShell_OCR2Keys(t *testing.T) {
 
 		requireOCR2KeyCount(t, app, 1)
 	})
+
+	t.Run("ImportExportOCR2KeyBundles", func(tt *testing.T) {
+		defer cleanup()
+		defer deleteKeyExportFile(t)
+		ctx := testutils.Context(t)
+		client, r := app.NewShellAndRenderer()
+
+		for _, chain := range chaintype.SupportedChainTypes {
+			_, err := app.GetKeyStore().OCR2().Create(ctx, chain)
+			require.NoError(t, err)
+		}
+		keys := requireOCR2KeyCount(t, app, len(chaintype.SupportedChainTypes))
+		keyName := keyNameForTest(t)
+
+		set := flag.NewFlagSet("test OCR2 export bundles", 0)
+		flagSetApplyFromAction(client.ExportOCR2KeyBundles, set, "")
+
+		require.NoError(tt, set.Set("new-password", "../internal/fixtures/new_password.txt"))
+		require.NoError(tt, set.Set("output", keyName))
+
+		c := cli.NewContext(nil, set, nil)
+		require.NoError(t, client.ExportOCR2KeyBundles(c))
+		require.NoError(t, utils.JustError(os.Stat(keyName)))
+
+		for _, key := range keys {
+			require.NoError(t, app.GetKeyStore().OCR2().Delete(ctx, key.ID()))
+		}
+		requireOCR2KeyCount(t, app, 0)
+
+		set = flag.NewFlagSet("test OCR2 import bundles", 0)
+		flagSetApplyFromAction(client.ImportOCR2KeyBundles, set, "")
+
+		require.NoError(tt, set.Parse([]string{keyName}))
+		require.NoError(tt, set.Set("old-password", "../internal/fixtures/new_password.txt"))
+
+		c = cli.NewContext(nil, set, nil)
+		require.NoError(t, client.ImportOCR2KeyBundles(c))
+
+		requireOCR2KeyCount(t, app, len(keys))
+		require.Equal(t, 1, len(r.Renders))
+		output := *r.Renders[0].(*cmd.OCR2KeyBundlePresenters)
+		require.Len(t, output, len(keys))
+	})
 }
 
 func requireOCR2KeyCount(t *testing.T, app chainlink.Application, length int) []ocr2key.KeyBundle {
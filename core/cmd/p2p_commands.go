@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initP2PSubCmds(s *Shell) []cli.Command {
+	return []cli.Command{
+		{
+			Name:   "diagnostics",
+			Usage:  "Show the state of the OCR peer and its remote peers",
+			Action: s.ShowP2PDiagnostics,
+		},
+	}
+}
+
+type P2PDiagnosticsPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.P2PDiagnosticsResource
+}
+
+var (
+	p2pDiagnosticsHeaders = []string{"Peer ID", "Announce Addresses", "Discovery Table Size", "Connected Peers"}
+	p2pPeerStatusHeaders  = []string{"Remote Peer ID", "Connected", "Metrics", "Dial Failures", "Last Dial Error", "Last Dial Failure At"}
+)
+
+// RenderTable implements TableRenderer
+func (p *P2PDiagnosticsPresenter) RenderTable(rt RendererTable) error {
+	renderList(p2pDiagnosticsHeaders, [][]string{{
+		p.PeerID,
+		strings.Join(p.AnnounceAddresses, ", "),
+		strconv.Itoa(p.DiscoveryTableSize),
+		strconv.Itoa(p.ConnectedPeers),
+	}}, rt.Writer)
+
+	var rows [][]string
+	for _, peer := range p.Peers {
+		var metrics []string
+		for _, m := range peer.Metrics {
+			metrics = append(metrics, fmt.Sprintf("%s: %g (%.2f/s)", m.Name, m.Value, m.Rate))
+		}
+		var lastDialFailureAt string
+		if peer.LastDialFailureAt.Valid {
+			lastDialFailureAt = peer.LastDialFailureAt.Time.Format(time.RFC3339)
+		}
+		rows = append(rows, []string{
+			peer.PeerID,
+			strconv.FormatBool(peer.Connected),
+			strings.Join(metrics, "\n"),
+			strconv.FormatUint(peer.DialFailures, 10),
+			peer.LastDialError,
+			lastDialFailureAt,
+		})
+	}
+	if _, err := rt.Write([]byte("\nRemote Peers\n")); err != nil {
+		return err
+	}
+	renderList(p2pPeerStatusHeaders, rows, rt.Writer)
+	return nil
+}
+
+// ShowP2PDiagnostics shows the state of the OCR peer of the node, to diagnose OCR network formation problems: its
+// remote peers and their traffic rates since the previous call, the dial failures and the size of the discovery table.
+func (s *Shell) ShowP2PDiagnostics(_ *cli.Context) (err error) {
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/p2p/diagnostics")
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &P2PDiagnosticsPresenter{})
+}
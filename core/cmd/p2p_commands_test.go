@@ -0,0 +1,51 @@
+package cmd_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestP2PDiagnosticsPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		failedAt = time.Now()
+		buffer   = bytes.NewBufferString("")
+		r        = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.P2PDiagnosticsPresenter{
+		P2PDiagnosticsResource: presenters.P2PDiagnosticsResource{
+			PeerID:             "12D3KooWPjceQrSwdWXPyLLeABRXmuqt69Rg3sBYbU1Nft9HyQ6X",
+			AnnounceAddresses:  []string{"127.0.0.1:6690"},
+			DiscoveryTableSize: 4,
+			ConnectedPeers:     1,
+			Peers: []presenters.P2PPeerStatus{{
+				PeerID:    "12D3KooWEBVwbfdhKnicois7FTYVsBFGFcoMhMCKXQC57BQyZMhz",
+				Connected: true,
+				Metrics:   []presenters.P2PPeerMetric{{Name: "ragep2p_peer_conn_written_bytes_total", Value: 1500, Rate: 12.5}},
+			}, {
+				PeerID:            "12D3KooWJ5Fs6b6f1Yp2Z5C8F2Xr1mQmCrQjgrDdfqHeFHR8nMWy",
+				DialFailures:      3,
+				LastDialError:     "Dial failed: connection refused",
+				LastDialFailureAt: null.TimeFrom(failedAt),
+			}},
+		},
+	}
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "12D3KooWPjceQrSwdWXPyLLeABRXmuqt69Rg3sBYbU1Nft9HyQ6X")
+	assert.Contains(t, output, "127.0.0.1:6690")
+	assert.Contains(t, output, "ragep2p_peer_conn_written_bytes_total: 1500 (12.50/s)")
+	assert.Contains(t, output, "Dial failed: connection refused")
+	assert.Contains(t, output, failedAt.Format(time.RFC3339))
+}
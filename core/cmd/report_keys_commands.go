@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"github.com/smartcontractkit/chainlink-common/pkg/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/reportkey"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+type ReportKeyPresenter struct {
+	JAID
+	presenters.ReportKeyResource
+}
+
+// RenderTable implements TableRenderer
+func (p ReportKeyPresenter) RenderTable(rt RendererTable) error {
+	headers := []string{"ID"}
+	rows := [][]string{p.ToRow()}
+
+	if _, err := rt.Write([]byte("🔑 Report Encryption Keys\n")); err != nil {
+		return err
+	}
+	renderList(headers, rows, rt.Writer)
+
+	return utils.JustError(rt.Write([]byte("\n")))
+}
+
+func (p *ReportKeyPresenter) ToRow() []string {
+	row := []string{
+		p.ID,
+	}
+
+	return row
+}
+
+type ReportKeyPresenters []ReportKeyPresenter
+
+// RenderTable implements TableRenderer
+func (ps ReportKeyPresenters) RenderTable(rt RendererTable) error {
+	headers := []string{"ID"}
+	rows := [][]string{}
+
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	if _, err := rt.Write([]byte("🔑 Report Encryption Keys\n")); err != nil {
+		return err
+	}
+	renderList(headers, rows, rt.Writer)
+
+	return utils.JustError(rt.Write([]byte("\n")))
+}
+
+func NewReportKeysClient(s *Shell) KeysClient {
+	return newKeysClient[reportkey.Key, ReportKeyPresenter, ReportKeyPresenters]("Report", s)
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"database/sql"
 	"encoding/json"
 	"fmt"
@@ -36,6 +37,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/loop"
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/mailbox"
+	"github.com/smartcontractkit/chainlink/v2/core/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/build"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
@@ -46,6 +48,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/periodicbackup"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc/cache"
 	"github.com/smartcontractkit/chainlink/v2/core/services/versioning"
@@ -54,8 +57,10 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/static"
 	"github.com/smartcontractkit/chainlink/v2/core/store/migrate"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/utils/deadlinebudget"
 	clhttp "github.com/smartcontractkit/chainlink/v2/core/utils/http"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
+	webauth "github.com/smartcontractkit/chainlink/v2/core/web/auth"
 	"github.com/smartcontractkit/chainlink/v2/plugins"
 )
 
@@ -195,7 +200,14 @@ func (n ChainlinkAppFactory) NewApplication(ctx context.Context, cfg chainlink.G
 		return nil, err
 	}
 
-	ds := sqlutil.WrapDataSource(db, appLggr, sqlutil.TimeoutHook(cfg.Database().DefaultQueryTimeout), sqlutil.MonitorHook(cfg.Database().LogSQL))
+	hooks := []sqlutil.QueryHook{pg.QueryBudgetHook, sqlutil.TimeoutHook(cfg.Database().DefaultQueryTimeout), sqlutil.MonitorHook(cfg.Database().LogSQL), deadlinebudget.QueryHook}
+	var slowQueries *pg.SlowQueryRecorder
+	if slowQueriesCfg := cfg.Database().SlowQueries(); slowQueriesCfg.Enabled() {
+		// the recorder flushes without its own hook, so that its queries are not recorded
+		slowQueries = pg.NewSlowQueryRecorder(appLggr, sqlutil.WrapDataSource(db, appLggr, hooks...), slowQueriesCfg.Threshold())
+		hooks = append(hooks, slowQueries.Hook)
+	}
+	ds := sqlutil.WrapDataSource(db, appLggr, hooks...)
 
 	keyStore := keystore.New(ds, utils.GetScryptParams(cfg), appLggr)
 	mailMon := mailbox.NewMonitor(cfg.AppID().String(), appLggr.Named("Mailbox"))
@@ -223,7 +235,7 @@ func (n ChainlinkAppFactory) NewApplication(ctx context.Context, cfg chainlink.G
 
 	evmFactoryCfg := chainlink.EVMFactoryConfig{
 		CSAETHKeystore:     keyStore,
-		ChainOpts:          legacyevm.ChainOpts{AppConfig: cfg, MailMon: mailMon, DS: ds},
+		ChainOpts:          legacyevm.ChainOpts{AppConfig: cfg, MailMon: mailMon, DS: ds, BulkInserter: pg.NewBulkInserter(db, appLggr, cfg.Database().BulkInsert(), hooks...)},
 		MercuryTransmitter: cfg.Mercury().Transmitter(),
 	}
 	// evm always enabled for backward compatibility
@@ -290,6 +302,7 @@ func (n ChainlinkAppFactory) NewApplication(ctx context.Context, cfg chainlink.G
 		GRPCOpts:                   grpcOpts,
 		MercuryPool:                mercuryPool,
 		CapabilitiesRegistry:       capabilitiesRegistry,
+		SlowQueryRecorder:          slowQueries,
 	})
 }
 
@@ -414,14 +427,19 @@ func (n ChainlinkRunner) Run(ctx context.Context, app chainlink.Application) err
 		})
 	}
 
-	tls := config.WebServer().TLS()
-	if tls.HTTPSPort() != 0 {
+	tlsCfg := config.WebServer().TLS()
+	if tlsCfg.HTTPSPort() != 0 {
+		serverTLSConfig, err := newServerTLSConfig(tlsCfg, app.GetKeyStore().TLS())
+		if err != nil {
+			return errors.Wrap(err, "failed to configure TLS server")
+		}
 		go tryRunServerUntilCancelled(gCtx, app.GetLogger(), serverStartTimeoutDuration, func() error {
 			return server.runTLS(
-				tls.ListenIP(),
-				tls.HTTPSPort(),
-				tls.CertFile(),
-				tls.KeyFile(),
+				tlsCfg.ListenIP(),
+				tlsCfg.HTTPSPort(),
+				tlsCfg.CertFile(),
+				tlsCfg.KeyFile(),
+				serverTLSConfig,
 				config.WebServer().HTTPWriteTimeout())
 		})
 	}
@@ -507,14 +525,45 @@ func (s *server) run(ip net.IP, port uint16, writeTimeout time.Duration) error {
 	return errors.Wrap(err, "failed to run plaintext HTTP server")
 }
 
-func (s *server) runTLS(ip net.IP, port uint16, certFile, keyFile string, requestTimeout time.Duration) error {
+func (s *server) runTLS(ip net.IP, port uint16, certFile, keyFile string, tlsConfig *tls.Config, requestTimeout time.Duration) error {
 	addr := fmt.Sprintf("%s:%d", ip.String(), port)
 	s.lggr.Infow(fmt.Sprintf("Listening and serving HTTPS on %s", addr), "ip", ip, "port", port)
 	s.tlsServer = createServer(s.handler, addr, requestTimeout)
+	s.tlsServer.TLSConfig = tlsConfig
+	if tlsConfig != nil && tlsConfig.GetCertificate != nil {
+		// the certificate is served by the keystore
+		certFile, keyFile = "", ""
+	}
 	err := s.tlsServer.ListenAndServeTLS(certFile, keyFile)
 	return errors.Wrap(err, "failed to run TLS server (NOTE: you can disable TLS server completely and silence these errors by setting WebServer.TLS.HTTPSPort=0 in your config)")
 }
 
+// newServerTLSConfig returns the TLS config of the HTTPS server, which serves the TLS key of the keystore if
+// KeystoreIdentity is set, and requires client certificates issued by the CAs of ClientCAFile if it is set. It returns
+// nil if neither is set, to serve the certificate and key files.
+func newServerTLSConfig(cfg config.TLS, ks keystore.TLS) (*tls.Config, error) {
+	if !cfg.KeystoreIdentity() && cfg.ClientCAFile() == "" {
+		return nil, nil
+	}
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if cfg.KeystoreIdentity() {
+		tlsConfig.GetCertificate = ks.GetCertificate
+	}
+	if path := cfg.ClientCAFile(); path != "" {
+		caPEM, err := os.ReadFile(path)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read client CA file")
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, errors.Errorf("no certificate found in client CA file %s", path)
+		}
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return tlsConfig, nil
+}
+
 func createServer(handler *gin.Engine, addr string, requestTimeout time.Duration) *http.Server {
 	s := &http.Server{
 		Addr:           addr,
@@ -540,6 +589,7 @@ type authenticatedHTTPClient struct {
 	client         *http.Client
 	cookieAuth     CookieAuthenticator
 	sessionRequest sessions.SessionRequest
+	apiToken       *auth.Token
 	remoteNodeURL  url.URL
 }
 
@@ -554,6 +604,16 @@ func NewAuthenticatedHTTPClient(lggr logger.Logger, clientOpts ClientOpts, cooki
 	}
 }
 
+// NewAPITokenHTTPClient authenticates all HTTP API requests with the API token,
+// instead of a session.
+func NewAPITokenHTTPClient(lggr logger.Logger, clientOpts ClientOpts, apiToken *auth.Token) HTTPClient {
+	return &authenticatedHTTPClient{
+		client:        newHttpClient(lggr, clientOpts.InsecureSkipVerify),
+		apiToken:      apiToken,
+		remoteNodeURL: clientOpts.RemoteNodeURL,
+	}
+}
+
 func newHttpClient(lggr logger.Logger, insecureSkipVerify bool) *http.Client {
 	tr := &http.Transport{
 		// User enables this at their own risk!
@@ -608,6 +668,11 @@ func (h *authenticatedHTTPClient) doRequest(ctx context.Context, verb, path stri
 	for key, value := range headers {
 		request.Header.Add(key, value)
 	}
+	if h.apiToken != nil {
+		request.Header.Set(webauth.APIKey, h.apiToken.AccessKey)
+		request.Header.Set(webauth.APISecret, h.apiToken.Secret)
+		return h.client.Do(request)
+	}
 	cookie, err := h.cookieAuth.Cookie()
 	if err != nil {
 		return nil, err
@@ -1002,6 +1067,23 @@ func credentialsFromFile(file string, lggr logger.Logger) (sessions.SessionReque
 	return credentials, nil
 }
 
+// APITokenFromFile reads an API token from file, which holds its access key
+// and secret on separate lines.
+func APITokenFromFile(file string) (*auth.Token, error) {
+	dat, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	lines := strings.Split(string(dat), "\n")
+	if len(lines) < 2 {
+		return nil, fmt.Errorf("malformed API token file does not have at least two lines at %s", file)
+	}
+	return &auth.Token{
+		AccessKey: strings.TrimSpace(lines[0]),
+		Secret:    strings.TrimSpace(lines[1]),
+	}, nil
+}
+
 // ChangePasswordPrompter is an interface primarily used for DI to obtain a
 // password change request from the User.
 type ChangePasswordPrompter interface {
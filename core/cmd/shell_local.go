@@ -11,12 +11,15 @@ import (
 	"net/url"
 	"os"
 	"os/exec"
+	"os/signal"
 	"path"
 	"path/filepath"
 	"runtime"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"syscall"
 	"time"
 
 	gethCommon "github.com/ethereum/go-ethereum/common"
@@ -41,8 +44,11 @@ import (
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/drain"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/services/txaudit"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/shutdown"
 	"github.com/smartcontractkit/chainlink/v2/core/static"
@@ -214,6 +220,18 @@ func initLocalSubCmds(s *Shell, safe bool) []cli.Command {
 					Usage:  "Migrate the database to the latest version.",
 					Action: s.MigrateDatabase,
 					Before: s.validateDB,
+					Flags: []cli.Flag{
+						cli.BoolFlag{
+							Name:  "dry-run",
+							Usage: "print the SQL of the pending migrations instead of applying them",
+						},
+					},
+				},
+				{
+					Name:   "drift",
+					Usage:  "Compare the database schema with the schema created by its applied migrations, and report the tables, columns, indexes, constraints, triggers and enum types which differ. The migrations are applied to a scratch database, which requires the CREATEDB privilege. Tables converted by the partition command are reported as changed.",
+					Action: s.DriftDatabase,
+					Before: s.validateDB,
 					Flags:  []cli.Flag{},
 				},
 				{
@@ -259,6 +277,19 @@ func initLocalSubCmds(s *Shell, safe bool) []cli.Command {
 						},
 					},
 				},
+				{
+					Name:   "partition",
+					Usage:  "Convert the LogPoller logs or the pipeline task runs to a partitioned table, whose partitions are managed by the node when Database.Partitioning is enabled. The node must be stopped.",
+					Action: s.PartitionDatabase,
+					Before: s.validateDB,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "table",
+							Usage: "the table to convert, either evm.logs or pipeline_task_runs",
+							Value: "evm.logs",
+						},
+					},
+				},
 			},
 		},
 		{
@@ -278,6 +309,30 @@ func initLocalSubCmds(s *Shell, safe bool) []cli.Command {
 				},
 			},
 		},
+		{
+			Name:   "export-tx-attempts",
+			Usage:  "Exports the attempt histories of the transactions finalized within a UTC day",
+			Action: s.ExportTxAttempts,
+			Flags: []cli.Flag{
+				cli.Int64Flag{
+					Name:     "evm-chain-id",
+					Usage:    "Chain ID of the EVM-based blockchain",
+					Required: true,
+				},
+				cli.StringFlag{
+					Name:  "date",
+					Usage: "UTC day to export, as YYYY-MM-DD. Defaults to yesterday",
+				},
+				cli.StringFlag{
+					Name:  "format",
+					Usage: "format of the exported file: csv or parquet. Defaults to TxAuditExport.Format",
+				},
+				cli.StringFlag{
+					Name:  "dir",
+					Usage: "directory of the exported file. Defaults to TxAuditExport.Dir",
+				},
+			},
+		},
 	}
 }
 
@@ -346,7 +401,15 @@ func (s *Shell) runNode(c *cli.Context) error {
 		}
 	}()
 
+	// drainer is set once the application is created, to drain it on SIGTERM if Drain.OnSIGTERM is set
+	var drainer atomic.Pointer[drain.Drainer]
+
 	go shutdown.HandleShutdown(func(sig string) {
+		if d := drainer.Load(); d != nil && sig == syscall.SIGTERM.String() && s.Config.Drain().OnSIGTERM() {
+			lggr.Infof("Draining due to %s signal received...", sig)
+			d.Drain()
+			<-d.Done()
+		}
 		lggr.Infof("Shutting down due to %s signal received...", sig)
 
 		shutdownStartTime = time.Now()
@@ -386,6 +449,8 @@ func (s *Shell) runNode(c *cli.Context) error {
 		return s.errorOut(errors.Wrap(err, "fatal error instantiating application"))
 	}
 
+	drainer.Store(app.GetDrainer())
+
 	// Local shell initialization always uses local auth users table for admin auth
 	authProviderORM := app.BasicAdminUsersORM()
 	keyStore := app.GetKeyStore()
@@ -478,6 +543,13 @@ func (s *Shell) runNode(c *cli.Context) error {
 		return errors.Wrap(err2, "failed to ensure CSA key")
 	}
 
+	if tlsCfg := s.Config.WebServer().TLS(); tlsCfg.HTTPSPort() != 0 && tlsCfg.KeystoreIdentity() {
+		err2 = app.GetKeyStore().TLS().EnsureKey(rootCtx)
+		if err2 != nil {
+			return errors.Wrap(err2, "failed to ensure TLS key")
+		}
+	}
+
 	if e := checkFilePermissions(lggr, s.Config.RootDir()); e != nil {
 		lggr.Warn(e)
 	}
@@ -527,9 +599,42 @@ func (s *Shell) runNode(c *cli.Context) error {
 		return errInternal
 	})
 
+	grp.Go(func() error {
+		reloadConfigOnSignal(grpCtx, app)
+		return nil
+	})
+
+	grp.Go(func() error {
+		// the node exits once drained, whether on SIGTERM or through the API
+		select {
+		case <-grpCtx.Done():
+		case <-app.GetDrainer().Done():
+			lggr.Info("Shutting down the drained node...")
+			cancelRootCtx()
+		}
+		return nil
+	})
+
 	return grp.Wait()
 }
 
+// reloadConfigOnSignal reloads the config of app each time SIGHUP is received, until ctx is done.
+func reloadConfigOnSignal(ctx context.Context, app chainlink.Application) {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, syscall.SIGHUP)
+	defer signal.Stop(ch)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ch:
+			// the outcome is logged by the app
+			_, _ = app.ReloadConfig(ctx)
+		}
+	}
+}
+
 func checkFilePermissions(lggr logger.Logger, rootDir string) error {
 	// Ensure tls sub directory (and children) permissions are <= `ownerPermsMask``
 	tlsDir := filepath.Join(rootDir, "tls")
@@ -925,7 +1030,7 @@ func (s *Shell) PrepareTestDatabaseUserOnly(c *cli.Context) error {
 }
 
 // MigrateDatabase migrates the database
-func (s *Shell) MigrateDatabase(_ *cli.Context) error {
+func (s *Shell) MigrateDatabase(c *cli.Context) error {
 	ctx := s.ctx()
 	cfg := s.Config.Database()
 	parsed := cfg.URL()
@@ -938,6 +1043,20 @@ func (s *Shell) MigrateDatabase(_ *cli.Context) error {
 		return err
 	}
 
+	if c.Bool("dry-run") {
+		db, err := newConnection(cfg)
+		if err != nil {
+			return s.errorOut(fmt.Errorf("failed to initialize orm: %v", err))
+		}
+		defer db.Close()
+		pending, err := migrate.DryRun(ctx, db.DB, os.Stdout)
+		if err != nil {
+			return s.errorOut(fmt.Errorf("dry run failed: %v", err))
+		}
+		s.Logger.Infof("%d migrations pending for database: %#v", pending, parsed.String())
+		return nil
+	}
+
 	s.Logger.Infof("Migrating database: %#v", parsed.String())
 	if err := migrateDB(ctx, cfg); err != nil {
 		return s.errorOut(err)
@@ -945,6 +1064,56 @@ func (s *Shell) MigrateDatabase(_ *cli.Context) error {
 	return nil
 }
 
+// DriftDatabase reports the differences between the database schema and the schema created by its applied migrations,
+// which are applied to a scratch database to that end.
+func (s *Shell) DriftDatabase(_ *cli.Context) error {
+	ctx := s.ctx()
+	cfg := s.Config.Database()
+	parsed := cfg.URL()
+	if parsed.String() == "" {
+		return s.errorOut(errDBURLMissing)
+	}
+	if err := migrate.SetMigrationENVVars(s.Config); err != nil {
+		return err
+	}
+
+	db, err := newConnection(cfg)
+	if err != nil {
+		return s.errorOut(fmt.Errorf("failed to initialize orm: %v", err))
+	}
+	defer db.Close()
+
+	scratchURL := parsed
+	scratchURL.Path = parsed.Path + "_drift"
+	s.Logger.Debugf("Creating scratch database: %#v", scratchURL.String())
+	if err = dropAndCreateDB(scratchURL, false); err != nil {
+		return s.errorOut(fmt.Errorf("failed to create scratch database: %v", err))
+	}
+	drifts, err := func() ([]migrate.Drift, error) {
+		scratch, err := pg.NewConnection(scratchURL.String(), cfg.Dialect(), cfg)
+		if err != nil {
+			return nil, err
+		}
+		defer scratch.Close()
+		return migrate.DetectDrift(ctx, db.DB, scratch.DB)
+	}()
+	if derr := dropDB(scratchURL); derr != nil {
+		s.Logger.Errorw("Failed to drop scratch database", "db", scratchURL.String(), "err", derr)
+	}
+	if err != nil {
+		return s.errorOut(fmt.Errorf("drift detection failed: %v", err))
+	}
+
+	for _, d := range drifts {
+		fmt.Println(d)
+	}
+	if len(drifts) > 0 {
+		return s.errorOut(fmt.Errorf("database schema drifted from its migrations in %d objects", len(drifts)))
+	}
+	s.Logger.Infof("Database schema matches its migrations: %#v", parsed.String())
+	return nil
+}
+
 // RollbackDatabase rolls back the database via down migrations.
 func (s *Shell) RollbackDatabase(c *cli.Context) error {
 	ctx := s.ctx()
@@ -1022,6 +1191,34 @@ func (s *Shell) CreateMigration(c *cli.Context) error {
 	return nil
 }
 
+// PartitionDatabase converts the LogPoller logs or the pipeline task runs to a partitioned table.
+func (s *Shell) PartitionDatabase(c *cli.Context) error {
+	ctx := s.ctx()
+	cfg := s.Config.Database()
+	var table pg.PartitionedTable
+	switch name := c.String("table"); name {
+	case "evm.logs":
+		table = pg.EVMLogsTable(cfg.Partitioning().LogsBlockRange(), cfg.Partitioning().LogsRetention())
+	case "pipeline_task_runs":
+		table = pg.PipelineTaskRunsTable(cfg.Partitioning().PipelineRunsRange(), cfg.Partitioning().PipelineRunsRetention())
+	default:
+		return s.errorOut(fmt.Errorf("cannot partition %s: only evm.logs and pipeline_task_runs can be partitioned", name))
+	}
+
+	db, err := newConnection(cfg)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "error connecting to the database"))
+	}
+	defer db.Close()
+
+	s.Logger.Infof("Converting %s to a partitioned table by ranges of %d %s. This may take a while.", table, table.Range, table.Key)
+	if err = table.Convert(ctx, db); err != nil {
+		return s.errorOut(err)
+	}
+	s.Logger.Infof("Converted %s to a partitioned table", table)
+	return nil
+}
+
 // CleanupChainTables deletes database table rows based on chain type and chain id input.
 func (s *Shell) CleanupChainTables(c *cli.Context) error {
 	cfg := s.Config.Database()
@@ -1127,6 +1324,25 @@ func dropAndCreateDB(parsed url.URL, force bool) (err error) {
 	return nil
 }
 
+func dropDB(parsed url.URL) (err error) {
+	dbname := parsed.Path[1:]
+	parsed.Path = "/template1"
+	db, err := sql.Open(string(dialects.Postgres), parsed.String())
+	if err != nil {
+		return fmt.Errorf("unable to open postgres database: %+v", err)
+	}
+	defer func() {
+		if cerr := db.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+	_, err = db.Exec(fmt.Sprintf(`DROP DATABASE IF EXISTS "%s"`, dbname))
+	if err != nil {
+		return fmt.Errorf("unable to drop postgres database: %v", err)
+	}
+	return nil
+}
+
 func dropAndCreatePristineDB(db *sqlx.DB, template string) (err error) {
 	_, err = db.Exec(fmt.Sprintf(`DROP DATABASE IF EXISTS "%s"`, testdb.PristineDBName))
 	if err != nil {
@@ -1282,3 +1498,37 @@ func (s *Shell) RemoveBlocks(c *cli.Context) error {
 
 	return nil
 }
+
+// ExportTxAttempts exports the attempt histories of the transactions of a chain, which were finalized within a UTC day.
+func (s *Shell) ExportTxAttempts(c *cli.Context) error {
+	chainID := big.NewInt(c.Int64("evm-chain-id"))
+	date := time.Now().UTC().AddDate(0, 0, -1)
+	if c.IsSet("date") {
+		var err error
+		if date, err = time.Parse(time.DateOnly, c.String("date")); err != nil {
+			return s.errorOut(fmt.Errorf("invalid date, must be YYYY-MM-DD: %w", err))
+		}
+	}
+	cfg := s.Config.TxAuditExport()
+	format := cfg.Format()
+	if c.IsSet("format") {
+		format = c.String("format")
+	}
+	dir := cfg.Dir()
+	if c.IsSet("dir") {
+		dir = c.String("dir")
+	}
+
+	db, err := newConnection(s.Config.Database())
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "error connecting to the database"))
+	}
+	defer db.Close()
+
+	path, n, err := txaudit.Export(s.ctx(), txaudit.NewORM(db), dir, chainID, date, format)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	s.Logger.Infof("Exported %d finalized transaction attempts to %s", n, path)
+	return nil
+}
@@ -68,6 +68,11 @@ func initRemoteConfigSubCmds(s *Shell) []cli.Command {
 				},
 			},
 		},
+		{
+			Name:   "reload",
+			Usage:  "Reload the hot-reloadable configuration from the config files of the node, without restarting it",
+			Action: s.ReloadConfig,
+		},
 		{
 			Name:  "validate",
 			Usage: "DEPRECATED. Use `chainlink node validate`",
@@ -398,6 +403,40 @@ func (s *Shell) SetLogSQL(c *cli.Context) (err error) {
 	return err
 }
 
+// ConfigReloadPresenter implements TableRenderer for a ConfigReloadResource.
+type ConfigReloadPresenter struct {
+	web.ConfigReloadResource
+}
+
+// RenderTable implements TableRenderer
+func (p *ConfigReloadPresenter) RenderTable(rt RendererTable) error {
+	if len(p.Changed) == 0 {
+		_, err := rt.Write([]byte("Config reloaded, nothing changed\n"))
+		return err
+	}
+	var rows [][]string
+	for _, field := range p.Changed {
+		rows = append(rows, []string{field})
+	}
+	renderList([]string{"Changed"}, rows, rt.Writer)
+	return nil
+}
+
+// ReloadConfig reloads the hot-reloadable configuration of the node from its config files
+func (s *Shell) ReloadConfig(_ *cli.Context) (err error) {
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/config/reload", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &ConfigReloadPresenter{})
+}
+
 func getBufferFromJSON(s string) (*bytes.Buffer, error) {
 	if gjson.Valid(s) {
 		return bytes.NewBufferString(s), nil
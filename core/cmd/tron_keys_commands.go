@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"github.com/smartcontractkit/chainlink-common/pkg/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/tronkey"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+type TronKeyPresenter struct {
+	JAID
+	presenters.TronKeyResource
+}
+
+// RenderTable implements TableRenderer
+func (p TronKeyPresenter) RenderTable(rt RendererTable) error {
+	headers := []string{"ID", "Address", "Tron Public Key"}
+	rows := [][]string{p.ToRow()}
+
+	if _, err := rt.Write([]byte("🔑 Tron Keys\n")); err != nil {
+		return err
+	}
+	renderList(headers, rows, rt.Writer)
+
+	return utils.JustError(rt.Write([]byte("\n")))
+}
+
+func (p *TronKeyPresenter) ToRow() []string {
+	row := []string{
+		p.ID,
+		p.Address,
+		p.PubKey,
+	}
+
+	return row
+}
+
+type TronKeyPresenters []TronKeyPresenter
+
+// RenderTable implements TableRenderer
+func (ps TronKeyPresenters) RenderTable(rt RendererTable) error {
+	headers := []string{"ID", "Address", "Tron Public Key"}
+	rows := [][]string{}
+
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	if _, err := rt.Write([]byte("🔑 Tron Keys\n")); err != nil {
+		return err
+	}
+	renderList(headers, rows, rt.Writer)
+
+	return utils.JustError(rt.Write([]byte("\n")))
+}
+
+func NewTronKeysClient(s *Shell) KeysClient {
+	return newKeysClient[tronkey.Key, TronKeyPresenter, TronKeyPresenters]("Tron", s)
+}
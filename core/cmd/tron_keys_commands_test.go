@@ -0,0 +1,180 @@
+package cmd_test
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/tronkey"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestTronKeyPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		id      = "1"
+		address = "someaddress"
+		pubKey  = "somepubkey"
+		buffer  = bytes.NewBufferString("")
+		r       = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.TronKeyPresenter{
+		JAID: cmd.JAID{ID: id},
+		TronKeyResource: presenters.TronKeyResource{
+			JAID:    presenters.NewJAID(id),
+			Address: address,
+			PubKey:  pubKey,
+		},
+	}
+
+	// Render a single resource
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, id)
+	assert.Contains(t, output, address)
+	assert.Contains(t, output, pubKey)
+
+	// Render many resources
+	buffer.Reset()
+	ps := cmd.TronKeyPresenters{p}
+	require.NoError(t, ps.RenderTable(r))
+
+	output = buffer.String()
+	assert.Contains(t, output, id)
+	assert.Contains(t, output, address)
+	assert.Contains(t, output, pubKey)
+}
+
+func TestShell_TronKeys(t *testing.T) {
+	app := startNewApplicationV2(t, nil)
+	ks := app.GetKeyStore().Tron()
+	cleanup := func() {
+		ctx := context.Background()
+		keys, err := ks.GetAll()
+		require.NoError(t, err)
+		for _, key := range keys {
+			require.NoError(t, utils.JustError(ks.Delete(ctx, key.ID())))
+		}
+		requireTronKeyCount(t, app, 0)
+	}
+
+	t.Run("ListTronKeys", func(tt *testing.T) {
+		defer cleanup()
+		ctx := testutils.Context(t)
+		client, r := app.NewShellAndRenderer()
+		key, err := app.GetKeyStore().Tron().Create(ctx)
+		require.NoError(t, err)
+		requireTronKeyCount(t, app, 1)
+		assert.Nil(t, cmd.NewTronKeysClient(client).ListKeys(cltest.EmptyCLIContext()))
+		require.Equal(t, 1, len(r.Renders))
+		keys := *r.Renders[0].(*cmd.TronKeyPresenters)
+		assert.Equal(t, key.Base58Address(), keys[0].Address)
+		assert.Equal(t, key.PublicKeyStr(), keys[0].PubKey)
+	})
+
+	t.Run("CreateTronKey", func(tt *testing.T) {
+		defer cleanup()
+		client, _ := app.NewShellAndRenderer()
+		require.NoError(t, cmd.NewTronKeysClient(client).CreateKey(nilContext))
+		keys, err := app.GetKeyStore().Tron().GetAll()
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+	})
+
+	t.Run("DeleteTronKey", func(tt *testing.T) {
+		defer cleanup()
+		ctx := testutils.Context(t)
+		client, _ := app.NewShellAndRenderer()
+		key, err := app.GetKeyStore().Tron().Create(ctx)
+		require.NoError(t, err)
+		requireTronKeyCount(t, app, 1)
+		set := flag.NewFlagSet("test", 0)
+		flagSetApplyFromAction(cmd.NewTronKeysClient(client).DeleteKey, set, "tron")
+
+		require.NoError(tt, set.Set("yes", "true"))
+
+		strID := key.ID()
+		err = set.Parse([]string{strID})
+		require.NoError(t, err)
+		c := cli.NewContext(nil, set, nil)
+		err = cmd.NewTronKeysClient(client).DeleteKey(c)
+		require.NoError(t, err)
+		requireTronKeyCount(t, app, 0)
+	})
+
+	t.Run("ImportExportTronKey", func(tt *testing.T) {
+		defer cleanup()
+		defer deleteKeyExportFile(t)
+		ctx := testutils.Context(t)
+		client, _ := app.NewShellAndRenderer()
+
+		_, err := app.GetKeyStore().Tron().Create(ctx)
+		require.NoError(t, err)
+
+		keys := requireTronKeyCount(t, app, 1)
+		key := keys[0]
+		keyName := keyNameForTest(t)
+
+		// Export test invalid id
+		set := flag.NewFlagSet("test Tron export", 0)
+		flagSetApplyFromAction(cmd.NewTronKeysClient(client).ExportKey, set, "tron")
+
+		require.NoError(tt, set.Parse([]string{"0"}))
+		require.NoError(tt, set.Set("new-password", "../internal/fixtures/incorrect_password.txt"))
+		require.NoError(tt, set.Set("output", keyName))
+
+		c := cli.NewContext(nil, set, nil)
+		err = cmd.NewTronKeysClient(client).ExportKey(c)
+		require.Error(t, err, "Error exporting")
+		require.Error(t, utils.JustError(os.Stat(keyName)))
+
+		// Export test
+		set = flag.NewFlagSet("test Tron export", 0)
+		flagSetApplyFromAction(cmd.NewTronKeysClient(client).ExportKey, set, "tron")
+
+		require.NoError(tt, set.Parse([]string{fmt.Sprint(key.ID())}))
+		require.NoError(tt, set.Set("new-password", "../internal/fixtures/incorrect_password.txt"))
+		require.NoError(tt, set.Set("output", keyName))
+
+		c = cli.NewContext(nil, set, nil)
+
+		require.NoError(t, cmd.NewTronKeysClient(client).ExportKey(c))
+		require.NoError(t, utils.JustError(os.Stat(keyName)))
+
+		require.NoError(t, utils.JustError(app.GetKeyStore().Tron().Delete(ctx, key.ID())))
+		requireTronKeyCount(t, app, 0)
+
+		set = flag.NewFlagSet("test Tron import", 0)
+		flagSetApplyFromAction(cmd.NewTronKeysClient(client).ImportKey, set, "tron")
+
+		require.NoError(tt, set.Parse([]string{keyName}))
+		require.NoError(tt, set.Set("old-password", "../internal/fixtures/incorrect_password.txt"))
+		c = cli.NewContext(nil, set, nil)
+		require.NoError(t, cmd.NewTronKeysClient(client).ImportKey(c))
+
+		requireTronKeyCount(t, app, 1)
+	})
+}
+
+func requireTronKeyCount(t *testing.T, app chainlink.Application, length int) []tronkey.Key {
+	t.Helper()
+	keys, err := app.GetKeyStore().Tron().GetAll()
+	require.NoError(t, err)
+	require.Len(t, keys, length)
+	return keys
+}
@@ -0,0 +1,23 @@
+package config
+
+import (
+	"net/url"
+	"time"
+)
+
+type Alerting interface {
+	Enabled() bool
+	DedupWindow() time.Duration
+	MaxPerMinute() uint32
+	SendTimeout() time.Duration
+	Webhooks() []AlertingWebhook
+}
+
+type AlertingWebhook interface {
+	Name() string
+	Type() string
+	// URL and RoutingKey are read from the secrets of the webhook.
+	URL() *url.URL
+	RoutingKey() string
+	MinSeverity() string
+}
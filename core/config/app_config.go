@@ -34,16 +34,22 @@ type AppConfig interface {
 	SetLogSQL(logSQL bool)
 	SetPasswords(keystore, vrf *string)
 
+	Alerting() Alerting
 	AuditLogger() AuditLogger
 	AutoPprof() AutoPprof
+	BlobStore() BlobStore
+	CCIP() CCIP
 	Capabilities() Capabilities
 	Database() Database
+	Drain() Drain
 	Feature() Feature
 	FluxMonitor() FluxMonitor
 	Insecure() Insecure
+	JobDistributor() JobDistributor
 	JobPipeline() JobPipeline
 	Keeper() Keeper
 	Log() Log
+	Maintenance() Maintenance
 	Mercury() Mercury
 	OCR() OCR
 	OCR2() OCR2
@@ -54,6 +60,7 @@ type AppConfig interface {
 	Sentry() Sentry
 	TelemetryIngress() TelemetryIngress
 	Threshold() Threshold
+	TxAuditExport() TxAuditExport
 	WebServer() WebServer
 	Tracing() Tracing
 	Telemetry() Telemetry
@@ -0,0 +1,20 @@
+package config
+
+import "time"
+
+const (
+	BlobStoreBackendLocal = "local"
+	BlobStoreBackendS3    = "s3"
+	BlobStoreBackendGCS   = "gcs"
+)
+
+type BlobStore interface {
+	Backend() string
+	Dir() string
+	Bucket() string
+	Prefix() string
+	Region() string
+	Endpoint() string
+	MaxAge() time.Duration
+	GCInterval() time.Duration
+}
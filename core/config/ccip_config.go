@@ -0,0 +1,8 @@
+package config
+
+import "time"
+
+type CCIP interface {
+	GasPriceUpdateInterval() time.Duration
+	TokenPriceUpdateInterval() time.Duration
+}
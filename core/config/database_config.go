@@ -27,10 +27,33 @@ type Listener interface {
 	FallbackPollInterval() time.Duration
 }
 
+type Partitioning interface {
+	Enabled() bool
+	CheckInterval() time.Duration
+	LogsBlockRange() uint64
+	LogsRetention() time.Duration
+	PipelineRunsRange() uint64
+	PipelineRunsRetention() time.Duration
+}
+
+type SlowQueries interface {
+	Enabled() bool
+	Threshold() time.Duration
+}
+
+type BulkInsert interface {
+	Enabled() bool
+	FlushSize() uint32
+	MinRows() uint32
+}
+
 type Database interface {
 	Backup() Backup
 	Listener() Listener
 	Lock() Lock
+	Partitioning() Partitioning
+	SlowQueries() SlowQueries
+	BulkInsert() BulkInsert
 
 	DefaultIdleInTxSessionTimeout() time.Duration
 	DefaultLockTimeout() time.Duration
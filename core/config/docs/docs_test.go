@@ -38,6 +38,12 @@ func TestDoc(t *testing.T) {
 		require.NoError(t, err)
 	}
 
+	// EVMProfiles only document their own fields, as they otherwise accept the same fields as EVM
+	require.Len(t, c.EVMProfiles, 1)
+	require.NotNil(t, c.EVMProfiles[0].Name)
+	require.NotNil(t, c.EVMProfiles[0].Profile)
+	c.EVMProfiles = nil
+
 	cfgtest.AssertFieldsNotNil(t, c)
 
 	var defaults chainlink.Config
@@ -53,10 +59,18 @@ func TestDoc(t *testing.T) {
 		// clean up KeySpecific as a special case
 		require.Equal(t, 1, len(docDefaults.KeySpecific))
 		ks := evmcfg.KeySpecific{Key: new(types.EIP55Address),
-			GasEstimator: evmcfg.KeySpecificGasEstimator{PriceMax: new(assets.Wei)}}
+			GasEstimator: evmcfg.KeySpecificGasEstimator{PriceMax: new(assets.Wei)},
+			RemoteSigner: evmcfg.KeySpecificRemoteSigner{Type: new(string), URL: new(config.URL), KeyID: new(string), Region: new(string), Timeout: new(config.Duration)}}
 		require.Equal(t, ks, docDefaults.KeySpecific[0])
 		docDefaults.KeySpecific = nil
 
+		// clean up GasEstimatorProfiles as a special case
+		require.Equal(t, 1, len(docDefaults.GasEstimatorProfiles))
+		gp := evmcfg.GasEstimatorProfile{Name: new(string), Mode: new(string), PriceMax: new(assets.Wei),
+			BumpMin: new(assets.Wei), BumpPercent: new(uint16), BumpThreshold: new(uint32)}
+		require.Equal(t, gp, docDefaults.GasEstimatorProfiles[0])
+		docDefaults.GasEstimatorProfiles = nil
+
 		// EVM.GasEstimator.BumpTxDepth doesn't have a constant default - it is derived from another field
 		require.Zero(t, *docDefaults.GasEstimator.BumpTxDepth)
 		docDefaults.GasEstimator.BumpTxDepth = nil
@@ -95,6 +109,14 @@ func TestDoc(t *testing.T) {
 		docDefaults.Transactions.AutoPurge.DetectionApiUrl = nil
 		docDefaults.Transactions.AutoPurge.Threshold = nil
 		docDefaults.Transactions.AutoPurge.MinAttempts = nil
+		docDefaults.Transactions.AutoPurge.AgeThreshold = nil
+
+		// Transactions.Bundler.MulticallAddress is only set if the feature is enabled
+		docDefaults.Transactions.Bundler.MulticallAddress = nil
+
+		// GasEstimator.OnChainPriceMax.Contract and Calldata are only set if the feature is enabled
+		docDefaults.GasEstimator.OnChainPriceMax.Contract = nil
+		docDefaults.GasEstimator.OnChainPriceMax.Calldata = nil
 
 		assertTOML(t, fallbackDefaults, docDefaults)
 	})
@@ -0,0 +1,8 @@
+package config
+
+import "time"
+
+type Drain interface {
+	OnSIGTERM() bool
+	Timeout() time.Duration
+}
@@ -25,10 +25,12 @@ var (
 
 // LOOPP commands and vars
 var (
-	MedianPlugin   = NewPlugin("median")
-	MercuryPlugin  = NewPlugin("mercury")
-	SolanaPlugin   = NewPlugin("solana")
-	StarknetPlugin = NewPlugin("starknet")
+	CCIPCommitPlugin = NewPlugin("ccipcommit")
+	CCIPExecPlugin   = NewPlugin("ccipexec")
+	MedianPlugin     = NewPlugin("median")
+	MercuryPlugin    = NewPlugin("mercury")
+	SolanaPlugin     = NewPlugin("solana")
+	StarknetPlugin   = NewPlugin("starknet")
 	// PrometheusDiscoveryHostName is the externally accessible hostname
 	// published by the node in the `/discovery` endpoint. Generally, it is expected to match
 	// the public hostname of node.
@@ -44,6 +46,9 @@ var (
 	MinOCR2MaxDurationQuery = Var("CL_MIN_OCR2_MAX_DURATION_QUERY")
 	// PipelineOvertime is an undocumented escape hatch for overriding the default padding in pipeline executions.
 	PipelineOvertime = Var("CL_PIPELINE_OVERTIME")
+	// AlertingLOOPConfig passes the alerting config of the node, secrets included, to its LOOPPs, so that they deliver
+	// the alerts emitted in their process.
+	AlertingLOOPConfig = Var("CL_ALERTING_LOOP_CONFIG")
 )
 
 type Var string
@@ -5,4 +5,5 @@ type Feature interface {
 	UICSAKeys() bool
 	LogPoller() bool
 	MultiFeedsManagers() bool
+	LogPollerBroadcaster() bool
 }
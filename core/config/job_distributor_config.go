@@ -0,0 +1,11 @@
+package config
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type JobDistributor interface {
+	CCIPAutoApprove() bool
+	CCIPChainSelectors() []uint64
+	CCIPContractAddresses() []common.Address
+}
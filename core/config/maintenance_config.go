@@ -0,0 +1,10 @@
+package config
+
+import (
+	"github.com/smartcontractkit/chainlink/v2/core/services/maintenance"
+)
+
+type Maintenance interface {
+	Windows() []maintenance.Window
+	Services() []string
+}
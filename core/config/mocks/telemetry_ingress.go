@@ -67,6 +67,53 @@ func (_c *TelemetryIngress_BufferSize_Call) RunAndReturn(run func() uint) *Telem
 	return _c
 }
 
+// DiskBuffer provides a mock function with given fields:
+func (_m *TelemetryIngress) DiskBuffer() config.TelemetryIngressDiskBuffer {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DiskBuffer")
+	}
+
+	var r0 config.TelemetryIngressDiskBuffer
+	if rf, ok := ret.Get(0).(func() config.TelemetryIngressDiskBuffer); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.TelemetryIngressDiskBuffer)
+		}
+	}
+
+	return r0
+}
+
+// TelemetryIngress_DiskBuffer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiskBuffer'
+type TelemetryIngress_DiskBuffer_Call struct {
+	*mock.Call
+}
+
+// DiskBuffer is a helper method to define mock.On call
+func (_e *TelemetryIngress_Expecter) DiskBuffer() *TelemetryIngress_DiskBuffer_Call {
+	return &TelemetryIngress_DiskBuffer_Call{Call: _e.mock.On("DiskBuffer")}
+}
+
+func (_c *TelemetryIngress_DiskBuffer_Call) Run(run func()) *TelemetryIngress_DiskBuffer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TelemetryIngress_DiskBuffer_Call) Return(_a0 config.TelemetryIngressDiskBuffer) *TelemetryIngress_DiskBuffer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TelemetryIngress_DiskBuffer_Call) RunAndReturn(run func() config.TelemetryIngressDiskBuffer) *TelemetryIngress_DiskBuffer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Endpoints provides a mock function with given fields:
 func (_m *TelemetryIngress) Endpoints() []config.TelemetryIngressEndpoint {
 	ret := _m.Called()
@@ -3,6 +3,8 @@ package config
 import (
 	"net/url"
 	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 type TelemetryIngress interface {
@@ -13,9 +15,18 @@ type TelemetryIngress interface {
 	SendInterval() time.Duration
 	SendTimeout() time.Duration
 	UseBatchSend() bool
+	DiskBuffer() TelemetryIngressDiskBuffer
 	Endpoints() []TelemetryIngressEndpoint
 }
 
+type TelemetryIngressDiskBuffer interface {
+	Enabled() bool
+	Dir() string
+	MaxSize() utils.FileSize
+	MaxAge() time.Duration
+	ReplayRate() uint
+}
+
 type TelemetryIngressEndpoint interface {
 	Network() string
 	ChainID() string
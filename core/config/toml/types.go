@@ -7,6 +7,8 @@ import (
 	"net/url"
 	"reflect"
 	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/google/uuid"
@@ -22,6 +24,8 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/config/parse"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/maintenance"
+	"github.com/smartcontractkit/chainlink/v2/core/services/txaudit"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/store/dialects"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
@@ -60,6 +64,13 @@ type Core struct {
 	Mercury          Mercury          `toml:",omitempty"`
 	Capabilities     Capabilities     `toml:",omitempty"`
 	Telemetry        Telemetry        `toml:",omitempty"`
+	JobDistributor   JobDistributor   `toml:",omitempty"`
+	Maintenance      Maintenance      `toml:",omitempty"`
+	BlobStore        BlobStore        `toml:",omitempty"`
+	TxAuditExport    TxAuditExport    `toml:",omitempty"`
+	Alerting         Alerting         `toml:",omitempty"`
+	Drain            Drain            `toml:",omitempty"`
+	CCIP             CCIP             `toml:",omitempty"`
 }
 
 // SetFrom updates c with any non-nil values from f. (currently TOML field only!)
@@ -97,6 +108,13 @@ func (c *Core) SetFrom(f *Core) {
 	c.Insecure.setFrom(&f.Insecure)
 	c.Tracing.setFrom(&f.Tracing)
 	c.Telemetry.setFrom(&f.Telemetry)
+	c.JobDistributor.setFrom(&f.JobDistributor)
+	c.Maintenance.setFrom(&f.Maintenance)
+	c.BlobStore.setFrom(&f.BlobStore)
+	c.TxAuditExport.setFrom(&f.TxAuditExport)
+	c.Alerting.setFrom(&f.Alerting)
+	c.Drain.setFrom(&f.Drain)
+	c.CCIP.setFrom(&f.CCIP)
 }
 
 func (c *Core) ValidateConfig() (err error) {
@@ -126,6 +144,7 @@ type Secrets struct {
 	Prometheus PrometheusSecrets        `toml:",omitempty"`
 	Mercury    MercurySecrets           `toml:",omitempty"`
 	Threshold  ThresholdKeyShareSecrets `toml:",omitempty"`
+	Alerting   AlertingSecrets          `toml:",omitempty"`
 }
 
 func dbURLPasswordComplexity(err error) string {
@@ -310,11 +329,19 @@ func (p *PrometheusSecrets) validateMerge(f *PrometheusSecrets) (err error) {
 }
 
 type Feature struct {
-	FeedsManager       *bool
-	LogPoller          *bool
-	UICSAKeys          *bool
-	CCIP               *bool
-	MultiFeedsManagers *bool
+	FeedsManager         *bool
+	LogPoller            *bool
+	UICSAKeys            *bool
+	CCIP                 *bool
+	MultiFeedsManagers   *bool
+	LogPollerBroadcaster *bool
+}
+
+func (f *Feature) ValidateConfig() (err error) {
+	if f.LogPollerBroadcaster != nil && *f.LogPollerBroadcaster && (f.LogPoller == nil || !*f.LogPoller) {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "LogPollerBroadcaster", Value: true, Msg: "requires LogPoller to be enabled"})
+	}
+	return
 }
 
 func (f *Feature) setFrom(f2 *Feature) {
@@ -333,6 +360,9 @@ func (f *Feature) setFrom(f2 *Feature) {
 	if v := f2.MultiFeedsManagers; v != nil {
 		f.MultiFeedsManagers = v
 	}
+	if v := f2.LogPollerBroadcaster; v != nil {
+		f.LogPollerBroadcaster = v
+	}
 }
 
 type Database struct {
@@ -345,9 +375,12 @@ type Database struct {
 	MaxOpenConns                  *int64
 	MigrateOnStartup              *bool
 
-	Backup   DatabaseBackup   `toml:",omitempty"`
-	Listener DatabaseListener `toml:",omitempty"`
-	Lock     DatabaseLock     `toml:",omitempty"`
+	Backup       DatabaseBackup       `toml:",omitempty"`
+	Listener     DatabaseListener     `toml:",omitempty"`
+	Lock         DatabaseLock         `toml:",omitempty"`
+	Partitioning DatabasePartitioning `toml:",omitempty"`
+	SlowQueries  DatabaseSlowQueries  `toml:",omitempty"`
+	BulkInsert   DatabaseBulkInsert   `toml:",omitempty"`
 }
 
 func (d *Database) setFrom(f *Database) {
@@ -376,6 +409,9 @@ func (d *Database) setFrom(f *Database) {
 	d.Backup.setFrom(&f.Backup)
 	d.Listener.setFrom(&f.Listener)
 	d.Lock.setFrom(&f.Lock)
+	d.Partitioning.setFrom(&f.Partitioning)
+	d.SlowQueries.setFrom(&f.SlowQueries)
+	d.BulkInsert.setFrom(&f.BulkInsert)
 }
 
 type DatabaseListener struct {
@@ -429,6 +465,98 @@ func (l *DatabaseLock) setFrom(f *DatabaseLock) {
 	}
 }
 
+// DatabasePartitioning manages the partitions of the tables which were converted to partitioned tables.
+type DatabasePartitioning struct {
+	Enabled               *bool
+	CheckInterval         *commonconfig.Duration
+	LogsBlockRange        *uint64
+	LogsRetention         *commonconfig.Duration
+	PipelineRunsRange     *uint64
+	PipelineRunsRetention *commonconfig.Duration
+}
+
+func (p *DatabasePartitioning) ValidateConfig() (err error) {
+	if p.CheckInterval.Duration() <= 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "CheckInterval", Value: p.CheckInterval.String(), Msg: "must be positive"})
+	}
+	if *p.LogsBlockRange == 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "LogsBlockRange", Value: *p.LogsBlockRange, Msg: "must be positive"})
+	}
+	if *p.PipelineRunsRange == 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "PipelineRunsRange", Value: *p.PipelineRunsRange, Msg: "must be positive"})
+	}
+	return
+}
+
+func (p *DatabasePartitioning) setFrom(f *DatabasePartitioning) {
+	if v := f.Enabled; v != nil {
+		p.Enabled = v
+	}
+	if v := f.CheckInterval; v != nil {
+		p.CheckInterval = v
+	}
+	if v := f.LogsBlockRange; v != nil {
+		p.LogsBlockRange = v
+	}
+	if v := f.LogsRetention; v != nil {
+		p.LogsRetention = v
+	}
+	if v := f.PipelineRunsRange; v != nil {
+		p.PipelineRunsRange = v
+	}
+	if v := f.PipelineRunsRetention; v != nil {
+		p.PipelineRunsRetention = v
+	}
+}
+
+// DatabaseSlowQueries records the queries which are slow, or time out.
+type DatabaseSlowQueries struct {
+	Enabled   *bool
+	Threshold *commonconfig.Duration
+}
+
+func (s *DatabaseSlowQueries) ValidateConfig() (err error) {
+	if s.Threshold.Duration() <= 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "Threshold", Value: s.Threshold.String(), Msg: "must be positive"})
+	}
+	return
+}
+
+func (s *DatabaseSlowQueries) setFrom(f *DatabaseSlowQueries) {
+	if v := f.Enabled; v != nil {
+		s.Enabled = v
+	}
+	if v := f.Threshold; v != nil {
+		s.Threshold = v
+	}
+}
+
+// DatabaseBulkInsert inserts the EVM logs and receipts with COPY.
+type DatabaseBulkInsert struct {
+	Enabled   *bool
+	FlushSize *uint32
+	MinRows   *uint32
+}
+
+func (b *DatabaseBulkInsert) ValidateConfig() (err error) {
+	if b.FlushSize != nil && *b.FlushSize == 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "FlushSize", Value: *b.FlushSize, Msg: "must be greater than 0"})
+	}
+	return
+}
+
+func (b *DatabaseBulkInsert) setFrom(f *DatabaseBulkInsert) {
+	if v := f.Enabled; v != nil {
+		b.Enabled = v
+	}
+	if v := f.FlushSize; v != nil {
+		b.FlushSize = v
+	}
+	if v := f.MinRows; v != nil {
+		b.MinRows = v
+	}
+}
+
 // DatabaseBackup
 //
 // Note: url is stored in Secrets.DatabaseBackupURL
@@ -462,9 +590,43 @@ type TelemetryIngress struct {
 	SendInterval *commonconfig.Duration
 	SendTimeout  *commonconfig.Duration
 	UseBatchSend *bool
+	DiskBuffer   TelemetryIngressDiskBuffer `toml:",omitempty"`
 	Endpoints    []TelemetryIngressEndpoint `toml:",omitempty"`
 }
 
+type TelemetryIngressDiskBuffer struct {
+	Enabled    *bool
+	Dir        *string
+	MaxSize    *utils.FileSize
+	MaxAge     *commonconfig.Duration
+	ReplayRate *uint16
+}
+
+func (t *TelemetryIngressDiskBuffer) setFrom(f *TelemetryIngressDiskBuffer) {
+	if v := f.Enabled; v != nil {
+		t.Enabled = v
+	}
+	if v := f.Dir; v != nil {
+		t.Dir = v
+	}
+	if v := f.MaxSize; v != nil {
+		t.MaxSize = v
+	}
+	if v := f.MaxAge; v != nil {
+		t.MaxAge = v
+	}
+	if v := f.ReplayRate; v != nil {
+		t.ReplayRate = v
+	}
+}
+
+func (t *TelemetryIngressDiskBuffer) ValidateConfig() (err error) {
+	if t.ReplayRate != nil && *t.ReplayRate == 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "ReplayRate", Value: 0, Msg: "must be greater than zero"})
+	}
+	return
+}
+
 type TelemetryIngressEndpoint struct {
 	Network      *string
 	ChainID      *string
@@ -494,6 +656,7 @@ func (t *TelemetryIngress) setFrom(f *TelemetryIngress) {
 	if v := f.UseBatchSend; v != nil {
 		t.UseBatchSend = v
 	}
+	t.DiskBuffer.setFrom(&f.DiskBuffer)
 	if v := f.Endpoints; v != nil {
 		t.Endpoints = v
 	}
@@ -611,6 +774,7 @@ type WebServer struct {
 	ListenIP                *net.IP
 
 	LDAP      WebServerLDAP      `toml:",omitempty"`
+	OIDC      WebServerOIDC      `toml:",omitempty"`
 	MFA       WebServerMFA       `toml:",omitempty"`
 	RateLimit WebServerRateLimit `toml:",omitempty"`
 	TLS       WebServerTLS       `toml:",omitempty"`
@@ -655,16 +819,24 @@ func (w *WebServer) setFrom(f *WebServer) {
 	}
 
 	w.LDAP.setFrom(&f.LDAP)
+	w.OIDC.setFrom(&f.OIDC)
 	w.MFA.setFrom(&f.MFA)
 	w.RateLimit.setFrom(&f.RateLimit)
 	w.TLS.setFrom(&f.TLS)
 }
 
 func (w *WebServer) ValidateConfig() (err error) {
-	// Validate LDAP fields when authentication method is LDAPAuth
-	if *w.AuthenticationMethod != string(sessions.LDAPAuth) {
-		return
+	switch sessions.AuthenticationProviderName(*w.AuthenticationMethod) {
+	case sessions.LDAPAuth:
+		return w.validateLDAP()
+	case sessions.OIDCAuth:
+		return w.OIDC.validate()
 	}
+	return
+}
+
+// validateLDAP validates the LDAP fields when authentication method is LDAPAuth
+func (w *WebServer) validateLDAP() (err error) {
 
 	// Assert LDAP fields when AuthMethod set to LDAP
 	if *w.LDAP.BaseDN == "" {
@@ -731,18 +903,23 @@ func (w *WebServerRateLimit) setFrom(f *WebServerRateLimit) {
 }
 
 type WebServerTLS struct {
-	CertPath      *string
-	ForceRedirect *bool
-	Host          *string
-	HTTPSPort     *uint16
-	KeyPath       *string
-	ListenIP      *net.IP
+	CertPath         *string
+	ClientCAPath     *string
+	ForceRedirect    *bool
+	Host             *string
+	HTTPSPort        *uint16
+	KeyPath          *string
+	KeystoreIdentity *bool
+	ListenIP         *net.IP
 }
 
 func (w *WebServerTLS) setFrom(f *WebServerTLS) {
 	if v := f.CertPath; v != nil {
 		w.CertPath = v
 	}
+	if v := f.ClientCAPath; v != nil {
+		w.ClientCAPath = v
+	}
 	if v := f.ForceRedirect; v != nil {
 		w.ForceRedirect = v
 	}
@@ -755,6 +932,9 @@ func (w *WebServerTLS) setFrom(f *WebServerTLS) {
 	if v := f.KeyPath; v != nil {
 		w.KeyPath = v
 	}
+	if v := f.KeystoreIdentity; v != nil {
+		w.KeystoreIdentity = v
+	}
 	if v := f.ListenIP; v != nil {
 		w.ListenIP = v
 	}
@@ -837,6 +1017,105 @@ func (w *WebServerLDAP) setFrom(f *WebServerLDAP) {
 	}
 }
 
+type WebServerOIDC struct {
+	IssuerURL      *commonconfig.URL
+	ClientID       *string
+	RedirectURL    *commonconfig.URL
+	Scopes         *[]string
+	EmailClaim     *string
+	GroupsClaim    *string
+	AdminUserGroup *string
+	EditUserGroup  *string
+	RunUserGroup   *string
+	ReadUserGroup  *string
+	SessionTimeout *commonconfig.Duration
+	QueryTimeout   *commonconfig.Duration
+}
+
+func (w *WebServerOIDC) setFrom(f *WebServerOIDC) {
+	if v := f.IssuerURL; v != nil {
+		w.IssuerURL = v
+	}
+	if v := f.ClientID; v != nil {
+		w.ClientID = v
+	}
+	if v := f.RedirectURL; v != nil {
+		w.RedirectURL = v
+	}
+	if v := f.Scopes; v != nil {
+		w.Scopes = v
+	}
+	if v := f.EmailClaim; v != nil {
+		w.EmailClaim = v
+	}
+	if v := f.GroupsClaim; v != nil {
+		w.GroupsClaim = v
+	}
+	if v := f.AdminUserGroup; v != nil {
+		w.AdminUserGroup = v
+	}
+	if v := f.EditUserGroup; v != nil {
+		w.EditUserGroup = v
+	}
+	if v := f.RunUserGroup; v != nil {
+		w.RunUserGroup = v
+	}
+	if v := f.ReadUserGroup; v != nil {
+		w.ReadUserGroup = v
+	}
+	if v := f.SessionTimeout; v != nil {
+		w.SessionTimeout = v
+	}
+	if v := f.QueryTimeout; v != nil {
+		w.QueryTimeout = v
+	}
+}
+
+// validate validates the OIDC fields when authentication method is OIDCAuth
+func (w *WebServerOIDC) validate() (err error) {
+	if w.IssuerURL == nil || w.IssuerURL.IsZero() {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "OIDC.IssuerURL", Msg: "must be set when AuthenticationMethod is oidc"})
+	}
+	if w.ClientID == nil || *w.ClientID == "" {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "OIDC.ClientID", Msg: "must be set when AuthenticationMethod is oidc"})
+	}
+	if w.RedirectURL == nil || w.RedirectURL.IsZero() {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "OIDC.RedirectURL", Msg: "must be set when AuthenticationMethod is oidc"})
+	}
+	if w.Scopes != nil && !slices.Contains(*w.Scopes, "openid") {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "OIDC.Scopes", Value: *w.Scopes, Msg: "must include openid"})
+	}
+	if w.EmailClaim == nil || *w.EmailClaim == "" {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "OIDC.EmailClaim", Msg: "OIDC EmailClaim can not be empty"})
+	}
+	if w.GroupsClaim == nil || *w.GroupsClaim == "" {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "OIDC.GroupsClaim", Msg: "OIDC GroupsClaim can not be empty"})
+	}
+	if w.AdminUserGroup == nil || *w.AdminUserGroup == "" {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "OIDC.AdminUserGroup", Msg: "OIDC AdminUserGroup can not be empty"})
+	}
+	if w.EditUserGroup == nil || *w.EditUserGroup == "" {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "OIDC.EditUserGroup", Msg: "OIDC EditUserGroup can not be empty"})
+	}
+	if w.RunUserGroup == nil || *w.RunUserGroup == "" {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "OIDC.RunUserGroup", Msg: "OIDC RunUserGroup can not be empty"})
+	}
+	if w.ReadUserGroup == nil || *w.ReadUserGroup == "" {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "OIDC.ReadUserGroup", Msg: "OIDC ReadUserGroup can not be empty"})
+	}
+	return err
+}
+
+type WebServerOIDCSecrets struct {
+	ClientSecret *models.Secret
+}
+
+func (w *WebServerOIDCSecrets) setFrom(f *WebServerOIDCSecrets) {
+	if v := f.ClientSecret; v != nil {
+		w.ClientSecret = v
+	}
+}
+
 type WebServerLDAPSecrets struct {
 	ServerAddress     *models.SecretURL
 	ReadOnlyUserLogin *models.Secret
@@ -857,10 +1136,12 @@ func (w *WebServerLDAPSecrets) setFrom(f *WebServerLDAPSecrets) {
 
 type WebServerSecrets struct {
 	LDAP WebServerLDAPSecrets `toml:",omitempty"`
+	OIDC WebServerOIDCSecrets `toml:",omitempty"`
 }
 
 func (w *WebServerSecrets) SetFrom(f *WebServerSecrets) error {
 	w.LDAP.setFrom(&f.LDAP)
+	w.OIDC.setFrom(&f.OIDC)
 	return nil
 }
 
@@ -1750,3 +2031,340 @@ func isValidHostname(hostname string) bool {
 func isValidFilePath(path string) bool {
 	return len(path) > 0 && len(path) < 4096
 }
+
+// JobDistributor holds settings for the job proposals of the Job Distributor (feeds manager).
+type JobDistributor struct {
+	CCIPAutoApprove       *bool
+	CCIPChainSelectors    *[]string
+	CCIPContractAddresses *[]types.EIP55Address
+}
+
+func (j *JobDistributor) setFrom(f *JobDistributor) {
+	if v := f.CCIPAutoApprove; v != nil {
+		j.CCIPAutoApprove = v
+	}
+	if v := f.CCIPChainSelectors; v != nil {
+		j.CCIPChainSelectors = v
+	}
+	if v := f.CCIPContractAddresses; v != nil {
+		j.CCIPContractAddresses = v
+	}
+}
+
+func (j *JobDistributor) ValidateConfig() (err error) {
+	if j.CCIPChainSelectors != nil {
+		for i, sel := range *j.CCIPChainSelectors {
+			if _, perr := strconv.ParseUint(sel, 10, 64); perr != nil {
+				err = multierr.Append(err, configutils.ErrInvalid{Name: fmt.Sprintf("CCIPChainSelectors[%d]", i), Value: sel, Msg: "must be a chain selector"})
+			}
+		}
+	}
+	return err
+}
+
+// Maintenance holds the windows for heavy database maintenance, and the services which opt in to them.
+type Maintenance struct {
+	Windows          *[]maintenance.Window
+	LogPollerPruning *bool
+	TxmReaper        *bool
+	CCIPPriceCleanup *bool
+}
+
+func (m *Maintenance) setFrom(f *Maintenance) {
+	if v := f.Windows; v != nil {
+		m.Windows = v
+	}
+	if v := f.LogPollerPruning; v != nil {
+		m.LogPollerPruning = v
+	}
+	if v := f.TxmReaper; v != nil {
+		m.TxmReaper = v
+	}
+	if v := f.CCIPPriceCleanup; v != nil {
+		m.CCIPPriceCleanup = v
+	}
+}
+
+// BlobStore configures the store of large artifacts.
+type BlobStore struct {
+	Backend    *string
+	Dir        *string
+	Bucket     *string
+	Prefix     *string
+	Region     *string
+	Endpoint   *string
+	MaxAge     *commonconfig.Duration
+	GCInterval *commonconfig.Duration
+}
+
+func (b *BlobStore) setFrom(f *BlobStore) {
+	if v := f.Backend; v != nil {
+		b.Backend = v
+	}
+	if v := f.Dir; v != nil {
+		b.Dir = v
+	}
+	if v := f.Bucket; v != nil {
+		b.Bucket = v
+	}
+	if v := f.Prefix; v != nil {
+		b.Prefix = v
+	}
+	if v := f.Region; v != nil {
+		b.Region = v
+	}
+	if v := f.Endpoint; v != nil {
+		b.Endpoint = v
+	}
+	if v := f.MaxAge; v != nil {
+		b.MaxAge = v
+	}
+	if v := f.GCInterval; v != nil {
+		b.GCInterval = v
+	}
+}
+
+func (b *BlobStore) ValidateConfig() (err error) {
+	if b.Backend == nil || *b.Backend == "" {
+		return
+	}
+	switch *b.Backend {
+	case config.BlobStoreBackendLocal:
+	case config.BlobStoreBackendS3, config.BlobStoreBackendGCS:
+		if b.Bucket == nil || *b.Bucket == "" {
+			err = multierr.Append(err, configutils.ErrMissing{Name: "Bucket", Msg: fmt.Sprintf("must be set for backend %s", *b.Backend)})
+		}
+		if *b.Backend == config.BlobStoreBackendS3 && (b.Region == nil || *b.Region == "") {
+			err = multierr.Append(err, configutils.ErrMissing{Name: "Region", Msg: "must be set for backend s3"})
+		}
+	default:
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "Backend", Value: *b.Backend, Msg: "must be one of 'local', 's3' or 'gcs'"})
+	}
+	if b.Endpoint != nil && *b.Endpoint != "" {
+		if _, perr := url.ParseRequestURI(*b.Endpoint); perr != nil {
+			err = multierr.Append(err, configutils.ErrInvalid{Name: "Endpoint", Value: *b.Endpoint, Msg: "must be a URL"})
+		}
+	}
+	if b.MaxAge != nil && b.MaxAge.Duration() <= 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "MaxAge", Value: b.MaxAge.String(), Msg: "must be greater than 0"})
+	}
+	if b.GCInterval != nil && b.GCInterval.Duration() <= 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "GCInterval", Value: b.GCInterval.String(), Msg: "must be greater than 0"})
+	}
+	return
+}
+
+// TxAuditExport configures the export of the attempt histories of finalized transactions.
+type TxAuditExport struct {
+	Enabled *bool
+	Dir     *string
+	Format  *string
+	Delay   *commonconfig.Duration
+}
+
+func (t *TxAuditExport) setFrom(f *TxAuditExport) {
+	if v := f.Enabled; v != nil {
+		t.Enabled = v
+	}
+	if v := f.Dir; v != nil {
+		t.Dir = v
+	}
+	if v := f.Format; v != nil {
+		t.Format = v
+	}
+	if v := f.Delay; v != nil {
+		t.Delay = v
+	}
+}
+
+func (t *TxAuditExport) ValidateConfig() (err error) {
+	if t.Format != nil && *t.Format != txaudit.FormatCSV && *t.Format != txaudit.FormatParquet {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "Format", Value: *t.Format, Msg: "must be 'csv' or 'parquet'"})
+	}
+	return
+}
+
+// Alerting configures the delivery of the alerts emitted by services to webhooks.
+type Alerting struct {
+	Enabled      *bool
+	DedupWindow  *commonconfig.Duration
+	MaxPerMinute *uint32
+	SendTimeout  *commonconfig.Duration
+	Webhooks     []AlertingWebhook `toml:",omitempty"`
+}
+
+func (a *Alerting) setFrom(f *Alerting) {
+	if v := f.Enabled; v != nil {
+		a.Enabled = v
+	}
+	if v := f.DedupWindow; v != nil {
+		a.DedupWindow = v
+	}
+	if v := f.MaxPerMinute; v != nil {
+		a.MaxPerMinute = v
+	}
+	if v := f.SendTimeout; v != nil {
+		a.SendTimeout = v
+	}
+	if v := f.Webhooks; v != nil {
+		a.Webhooks = v
+	}
+}
+
+func (a *Alerting) ValidateConfig() (err error) {
+	if a.DedupWindow != nil && a.DedupWindow.Duration() <= 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "DedupWindow", Value: a.DedupWindow.String(), Msg: "must be greater than 0"})
+	}
+	if a.MaxPerMinute != nil && *a.MaxPerMinute == 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "MaxPerMinute", Value: 0, Msg: "must be greater than 0"})
+	}
+	if a.SendTimeout != nil && a.SendTimeout.Duration() <= 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "SendTimeout", Value: a.SendTimeout.String(), Msg: "must be greater than 0"})
+	}
+	if a.Enabled != nil && *a.Enabled && len(a.Webhooks) == 0 {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "Webhooks", Msg: "must be set when alerting is enabled"})
+	}
+	names := make(map[string]struct{}, len(a.Webhooks))
+	for _, w := range a.Webhooks {
+		if w.Name == nil {
+			continue
+		}
+		if _, ok := names[*w.Name]; ok {
+			err = multierr.Append(err, configutils.ErrInvalid{Name: "Webhooks.Name", Value: *w.Name, Msg: "must be unique"})
+		}
+		names[*w.Name] = struct{}{}
+	}
+	return
+}
+
+// AlertingWebhook is a webhook alerts are delivered to. Its URL and PagerDuty routing key are secrets, see
+// AlertingWebhookSecrets.
+type AlertingWebhook struct {
+	Name        *string
+	Type        *string
+	MinSeverity *string
+}
+
+func (w *AlertingWebhook) ValidateConfig() (err error) {
+	if w.Name == nil || *w.Name == "" {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "Name", Msg: "must be set"})
+	}
+	var typ string
+	if w.Type != nil {
+		typ = *w.Type
+	}
+	switch typ {
+	case "webhook", "pagerduty":
+	default:
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "Type", Value: typ, Msg: "must be 'webhook' or 'pagerduty'"})
+	}
+	if w.MinSeverity != nil {
+		switch *w.MinSeverity {
+		case "info", "warning", "critical":
+		default:
+			err = multierr.Append(err, configutils.ErrInvalid{Name: "MinSeverity", Value: *w.MinSeverity, Msg: "must be 'info', 'warning' or 'critical'"})
+		}
+	}
+	return
+}
+
+// AlertingSecrets holds the secrets of the alerting webhooks, by the Name of their webhook.
+type AlertingSecrets struct {
+	Webhooks map[string]AlertingWebhookSecrets
+}
+
+// AlertingWebhookSecrets holds the secrets of an alerting webhook, whose URLs often embed tokens.
+type AlertingWebhookSecrets struct {
+	// URL is the URL the alerts are posted to.
+	URL *models.SecretURL
+	// RoutingKey is the integration key of the PagerDuty service.
+	RoutingKey *models.Secret
+}
+
+func (a *AlertingSecrets) SetFrom(f *AlertingSecrets) (err error) {
+	err = a.validateMerge(f)
+	if err != nil {
+		return err
+	}
+
+	if a.Webhooks != nil && f.Webhooks != nil {
+		for k, v := range f.Webhooks {
+			a.Webhooks[k] = v
+		}
+	} else if v := f.Webhooks; v != nil {
+		a.Webhooks = v
+	}
+
+	return nil
+}
+
+func (a *AlertingSecrets) validateMerge(f *AlertingSecrets) (err error) {
+	if a.Webhooks != nil && f.Webhooks != nil {
+		for k := range f.Webhooks {
+			if _, exists := a.Webhooks[k]; exists {
+				err = multierr.Append(err, configutils.ErrOverride{Name: fmt.Sprintf("Webhooks[\"%s\"]", k)})
+			}
+		}
+	}
+
+	return err
+}
+
+func (a *AlertingSecrets) ValidateConfig() (err error) {
+	for name, w := range a.Webhooks {
+		if name == "" {
+			err = multierr.Append(err, configutils.ErrEmpty{Name: "Name", Msg: "must be provided and non-empty"})
+		}
+		if w.URL != nil && w.URL.URL() == nil {
+			err = multierr.Append(err, configutils.ErrMissing{Name: "URL", Msg: "must be a valid URL"})
+		}
+	}
+	return err
+}
+
+// Drain configures the draining of the node before it exits.
+type Drain struct {
+	OnSIGTERM *bool
+	Timeout   *commonconfig.Duration
+}
+
+func (d *Drain) setFrom(f *Drain) {
+	if v := f.OnSIGTERM; v != nil {
+		d.OnSIGTERM = v
+	}
+	if v := f.Timeout; v != nil {
+		d.Timeout = v
+	}
+}
+
+func (d *Drain) ValidateConfig() (err error) {
+	if d.Timeout != nil && d.Timeout.Duration() <= 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "Timeout", Value: d.Timeout.String(), Msg: "must be greater than 0"})
+	}
+	return
+}
+
+// CCIP configures the CCIP jobs of the node.
+type CCIP struct {
+	GasPriceUpdateInterval   *commonconfig.Duration
+	TokenPriceUpdateInterval *commonconfig.Duration
+}
+
+func (c *CCIP) setFrom(f *CCIP) {
+	if v := f.GasPriceUpdateInterval; v != nil {
+		c.GasPriceUpdateInterval = v
+	}
+	if v := f.TokenPriceUpdateInterval; v != nil {
+		c.TokenPriceUpdateInterval = v
+	}
+}
+
+func (c *CCIP) ValidateConfig() (err error) {
+	if c.GasPriceUpdateInterval != nil && c.GasPriceUpdateInterval.Duration() <= 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "GasPriceUpdateInterval", Value: c.GasPriceUpdateInterval.String(), Msg: "must be greater than 0"})
+	}
+	if c.TokenPriceUpdateInterval != nil && c.TokenPriceUpdateInterval.Duration() <= 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "TokenPriceUpdateInterval", Value: c.TokenPriceUpdateInterval.String(), Msg: "must be greater than 0"})
+	}
+	return
+}
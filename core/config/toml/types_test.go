@@ -5,8 +5,10 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink/v2/core/build"
@@ -532,6 +534,171 @@ func TestTracing_ValidateMode(t *testing.T) {
 	}
 }
 
+func TestBlobStore_ValidateConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		cfg    BlobStore
+		errMsg string
+	}{
+		{"disabled", BlobStore{Backend: ptr("")}, ""},
+		{"local", BlobStore{Backend: ptr("local"), MaxAge: commonconfig.MustNewDuration(time.Hour)}, ""},
+		{"s3", BlobStore{Backend: ptr("s3"), Bucket: ptr("b"), Region: ptr("us-east-1"), Endpoint: ptr("http://localhost:9000")}, ""},
+		{"gcs", BlobStore{Backend: ptr("gcs"), Bucket: ptr("b")}, ""},
+		{"unknown backend", BlobStore{Backend: ptr("ftp")}, "Backend: invalid value (ftp): must be one of 'local', 's3' or 'gcs'"},
+		{"s3 without bucket and region", BlobStore{Backend: ptr("s3")}, "Bucket: missing: must be set for backend s3; Region: missing: must be set for backend s3"},
+		{"invalid endpoint", BlobStore{Backend: ptr("s3"), Bucket: ptr("b"), Region: ptr("r"), Endpoint: ptr("localhost")}, "Endpoint: invalid value (localhost): must be a URL"},
+		{"zero max age", BlobStore{Backend: ptr("local"), MaxAge: commonconfig.MustNewDuration(0)}, "MaxAge: invalid value (0s): must be greater than 0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.ValidateConfig()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, tt.errMsg, err.Error())
+		})
+	}
+}
+
+func TestTxAuditExport_ValidateConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		cfg    TxAuditExport
+		errMsg string
+	}{
+		{"csv", TxAuditExport{Format: ptr("csv"), Delay: commonconfig.MustNewDuration(time.Hour)}, ""},
+		{"parquet", TxAuditExport{Format: ptr("parquet"), Delay: commonconfig.MustNewDuration(0)}, ""},
+		{"unknown format", TxAuditExport{Format: ptr("json")}, "Format: invalid value (json): must be 'csv' or 'parquet'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.ValidateConfig()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, tt.errMsg, err.Error())
+		})
+	}
+}
+
+func TestAlerting_ValidateConfig(t *testing.T) {
+	webhook := AlertingWebhook{Name: ptr("ops"), Type: ptr("webhook")}
+	pagerDuty := AlertingWebhook{Name: ptr("on-call"), Type: ptr("pagerduty"), MinSeverity: ptr("critical")}
+	tests := []struct {
+		name   string
+		cfg    Alerting
+		errMsg string
+	}{
+		{"disabled", Alerting{Enabled: ptr(false)}, ""},
+		{"webhooks", Alerting{Enabled: ptr(true), Webhooks: []AlertingWebhook{webhook, pagerDuty}}, ""},
+		{"enabled without webhooks", Alerting{Enabled: ptr(true)}, "Webhooks: missing: must be set when alerting is enabled"},
+		{"duplicate names", Alerting{Webhooks: []AlertingWebhook{webhook, webhook}}, "Webhooks.Name: invalid value (ops): must be unique"},
+		{"zero rate", Alerting{MaxPerMinute: ptr[uint32](0)}, "MaxPerMinute: invalid value (0): must be greater than 0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.ValidateConfig()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, tt.errMsg, err.Error())
+		})
+	}
+}
+
+func TestAlertingWebhook_ValidateConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		cfg    AlertingWebhook
+		errMsg string
+	}{
+		{"webhook", AlertingWebhook{Name: ptr("ops"), Type: ptr("webhook")}, ""},
+		{"pagerduty", AlertingWebhook{Name: ptr("on-call"), Type: ptr("pagerduty")}, ""},
+		{"without name", AlertingWebhook{Type: ptr("webhook")}, "Name: missing: must be set"},
+		{"unknown type", AlertingWebhook{Name: ptr("ops"), Type: ptr("slack")}, "Type: invalid value (slack): must be 'webhook' or 'pagerduty'"},
+		{"unknown severity", AlertingWebhook{Name: ptr("on-call"), Type: ptr("pagerduty"), MinSeverity: ptr("fatal")}, "MinSeverity: invalid value (fatal): must be 'info', 'warning' or 'critical'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.ValidateConfig()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, tt.errMsg, err.Error())
+		})
+	}
+}
+
+func TestAlertingSecrets(t *testing.T) {
+	a := AlertingSecrets{Webhooks: map[string]AlertingWebhookSecrets{"ops": {URL: models.NewSecretURL(commonconfig.MustParseURL("https://alerts.example.com/token"))}}}
+	require.NoError(t, a.ValidateConfig())
+
+	err := a.SetFrom(&AlertingSecrets{Webhooks: map[string]AlertingWebhookSecrets{"ops": {}}})
+	require.EqualError(t, err, `Webhooks["ops"]: overrides (duplicate keys or list elements) are not allowed for multiple secrets files`)
+
+	require.NoError(t, a.SetFrom(&AlertingSecrets{Webhooks: map[string]AlertingWebhookSecrets{"on-call": {RoutingKey: models.NewSecret("key")}}}))
+	assert.Len(t, a.Webhooks, 2)
+
+	a.Webhooks[""] = AlertingWebhookSecrets{}
+	require.EqualError(t, a.ValidateConfig(), "Name: empty: must be provided and non-empty")
+}
+
+func TestWebServer_ValidateConfig(t *testing.T) {
+	oidc := WebServerOIDC{
+		IssuerURL:      commonconfig.MustParseURL("https://idp.example.com"),
+		ClientID:       ptr("chainlink-node"),
+		RedirectURL:    commonconfig.MustParseURL("https://node.example.com/oidc/callback"),
+		Scopes:         &[]string{"openid", "email", "groups"},
+		EmailClaim:     ptr("email"),
+		GroupsClaim:    ptr("groups"),
+		AdminUserGroup: ptr("NodeAdmins"),
+		EditUserGroup:  ptr("NodeEditors"),
+		RunUserGroup:   ptr("NodeRunners"),
+		ReadUserGroup:  ptr("NodeReadOnly"),
+	}
+	withoutClient := oidc
+	withoutClient.IssuerURL, withoutClient.ClientID, withoutClient.RedirectURL = nil, nil, nil
+	withoutOpenID := oidc
+	withoutOpenID.Scopes = &[]string{"email"}
+	withoutGroups := oidc
+	withoutGroups.GroupsClaim = ptr("")
+	withoutGroups.AdminUserGroup = nil
+
+	tests := []struct {
+		name   string
+		cfg    WebServer
+		errMsg string
+	}{
+		{"local", WebServer{AuthenticationMethod: ptr("local")}, ""},
+		{"oidc", WebServer{AuthenticationMethod: ptr("oidc"), OIDC: oidc}, ""},
+		{"oidc not validated for local", WebServer{AuthenticationMethod: ptr("local"), OIDC: withoutOpenID}, ""},
+		{"oidc without client", WebServer{AuthenticationMethod: ptr("oidc"), OIDC: withoutClient},
+			"OIDC.IssuerURL: missing: must be set when AuthenticationMethod is oidc; OIDC.ClientID: missing: must be set when AuthenticationMethod is oidc; OIDC.RedirectURL: missing: must be set when AuthenticationMethod is oidc"},
+		{"oidc without openid scope", WebServer{AuthenticationMethod: ptr("oidc"), OIDC: withoutOpenID}, "OIDC.Scopes: invalid value ([email]): must include openid"},
+		{"oidc without groups", WebServer{AuthenticationMethod: ptr("oidc"), OIDC: withoutGroups},
+			"OIDC.GroupsClaim: invalid value (<nil>): OIDC GroupsClaim can not be empty; OIDC.AdminUserGroup: invalid value (<nil>): OIDC AdminUserGroup can not be empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.ValidateConfig()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, tt.errMsg, err.Error())
+		})
+	}
+}
+
 func TestMercuryTLS_ValidateTLSCertPath(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -0,0 +1,10 @@
+package config
+
+import "time"
+
+type TxAuditExport interface {
+	Enabled() bool
+	Dir() string
+	Format() string
+	Delay() time.Duration
+}
@@ -16,6 +16,10 @@ type TLS interface {
 	ForceRedirect() bool
 	CertFile() string
 	KeyFile() string
+	// KeystoreIdentity is true if the TLS key of the keystore is served instead of CertFile and KeyFile.
+	KeystoreIdentity() bool
+	// ClientCAFile is the file of the CAs of the client certificates, which are required if it is set.
+	ClientCAFile() string
 	HTTPSPort() uint16
 	ListenIP() net.IP
 }
@@ -55,6 +59,22 @@ type LDAP interface {
 	UpstreamSyncRateLimit() commonconfig.Duration
 }
 
+type OIDC interface {
+	IssuerURL() *url.URL
+	ClientID() string
+	ClientSecret() string
+	RedirectURL() *url.URL
+	Scopes() []string
+	EmailClaim() string
+	GroupsClaim() string
+	AdminUserGroup() string
+	EditUserGroup() string
+	RunUserGroup() string
+	ReadUserGroup() string
+	SessionTimeout() commonconfig.Duration
+	QueryTimeout() time.Duration
+}
+
 type WebServer interface {
 	AuthenticationMethod() string
 	AllowOrigins() string
@@ -74,4 +94,5 @@ type WebServer interface {
 	RateLimit() RateLimit
 	MFA() MFA
 	LDAP() LDAP
+	OIDC() OIDC
 }
@@ -3,9 +3,12 @@
 package mocks
 
 import (
+	audit "github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	apitokens "github.com/smartcontractkit/chainlink/v2/core/sessions/apitokens"
+
 	big "math/big"
 
-	audit "github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	blobstore "github.com/smartcontractkit/chainlink/v2/core/services/blobstore"
 
 	bridges "github.com/smartcontractkit/chainlink/v2/core/bridges"
 
@@ -13,6 +16,8 @@ import (
 
 	context "context"
 
+	drain "github.com/smartcontractkit/chainlink/v2/core/services/drain"
+
 	feeds "github.com/smartcontractkit/chainlink/v2/core/services/feeds"
 
 	job "github.com/smartcontractkit/chainlink/v2/core/services/job"
@@ -27,10 +32,14 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	ocrcommon "github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
+
 	pipeline "github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 
 	plugins "github.com/smartcontractkit/chainlink/v2/plugins"
 
+	reports "github.com/smartcontractkit/chainlink/v2/core/services/reports"
+
 	services "github.com/smartcontractkit/chainlink/v2/core/services"
 
 	sessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
@@ -61,6 +70,53 @@ func (_m *Application) EXPECT() *Application_Expecter {
 	return &Application_Expecter{mock: &_m.Mock}
 }
 
+// APITokensORM provides a mock function with given fields:
+func (_m *Application) APITokensORM() apitokens.ORM {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for APITokensORM")
+	}
+
+	var r0 apitokens.ORM
+	if rf, ok := ret.Get(0).(func() apitokens.ORM); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apitokens.ORM)
+		}
+	}
+
+	return r0
+}
+
+// Application_APITokensORM_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'APITokensORM'
+type Application_APITokensORM_Call struct {
+	*mock.Call
+}
+
+// APITokensORM is a helper method to define mock.On call
+func (_e *Application_Expecter) APITokensORM() *Application_APITokensORM_Call {
+	return &Application_APITokensORM_Call{Call: _e.mock.On("APITokensORM")}
+}
+
+func (_c *Application_APITokensORM_Call) Run(run func()) *Application_APITokensORM_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_APITokensORM_Call) Return(_a0 apitokens.ORM) *Application_APITokensORM_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_APITokensORM_Call) RunAndReturn(run func() apitokens.ORM) *Application_APITokensORM_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddJobV2 provides a mock function with given fields: ctx, _a1
 func (_m *Application) AddJobV2(ctx context.Context, _a1 *job.Job) error {
 	ret := _m.Called(ctx, _a1)
@@ -497,6 +553,53 @@ func (_c *Application_GetAuditLogger_Call) RunAndReturn(run func() audit.AuditLo
 	return _c
 }
 
+// GetBlobStore provides a mock function with given fields:
+func (_m *Application) GetBlobStore() *blobstore.Service {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlobStore")
+	}
+
+	var r0 *blobstore.Service
+	if rf, ok := ret.Get(0).(func() *blobstore.Service); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*blobstore.Service)
+		}
+	}
+
+	return r0
+}
+
+// Application_GetBlobStore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlobStore'
+type Application_GetBlobStore_Call struct {
+	*mock.Call
+}
+
+// GetBlobStore is a helper method to define mock.On call
+func (_e *Application_Expecter) GetBlobStore() *Application_GetBlobStore_Call {
+	return &Application_GetBlobStore_Call{Call: _e.mock.On("GetBlobStore")}
+}
+
+func (_c *Application_GetBlobStore_Call) Run(run func()) *Application_GetBlobStore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_GetBlobStore_Call) Return(_a0 *blobstore.Service) *Application_GetBlobStore_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_GetBlobStore_Call) RunAndReturn(run func() *blobstore.Service) *Application_GetBlobStore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetConfig provides a mock function with given fields:
 func (_m *Application) GetConfig() chainlink.GeneralConfig {
 	ret := _m.Called()
@@ -638,6 +741,53 @@ func (_c *Application_GetExternalInitiatorManager_Call) RunAndReturn(run func()
 	return _c
 }
 
+// GetDrainer provides a mock function with given fields:
+func (_m *Application) GetDrainer() *drain.Drainer {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDrainer")
+	}
+
+	var r0 *drain.Drainer
+	if rf, ok := ret.Get(0).(func() *drain.Drainer); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*drain.Drainer)
+		}
+	}
+
+	return r0
+}
+
+// Application_GetDrainer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDrainer'
+type Application_GetDrainer_Call struct {
+	*mock.Call
+}
+
+// GetDrainer is a helper method to define mock.On call
+func (_e *Application_Expecter) GetDrainer() *Application_GetDrainer_Call {
+	return &Application_GetDrainer_Call{Call: _e.mock.On("GetDrainer")}
+}
+
+func (_c *Application_GetDrainer_Call) Run(run func()) *Application_GetDrainer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_GetDrainer_Call) Return(_a0 *drain.Drainer) *Application_GetDrainer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_GetDrainer_Call) RunAndReturn(run func() *drain.Drainer) *Application_GetDrainer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetFeedsService provides a mock function with given fields:
 func (_m *Application) GetFeedsService() feeds.Service {
 	ret := _m.Called()
@@ -920,6 +1070,53 @@ func (_c *Application_GetLoopRegistry_Call) RunAndReturn(run func() *plugins.Loo
 	return _c
 }
 
+// GetPeerWrapper provides a mock function with given fields:
+func (_m *Application) GetPeerWrapper() *ocrcommon.SingletonPeerWrapper {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPeerWrapper")
+	}
+
+	var r0 *ocrcommon.SingletonPeerWrapper
+	if rf, ok := ret.Get(0).(func() *ocrcommon.SingletonPeerWrapper); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ocrcommon.SingletonPeerWrapper)
+		}
+	}
+
+	return r0
+}
+
+// Application_GetPeerWrapper_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPeerWrapper'
+type Application_GetPeerWrapper_Call struct {
+	*mock.Call
+}
+
+// GetPeerWrapper is a helper method to define mock.On call
+func (_e *Application_Expecter) GetPeerWrapper() *Application_GetPeerWrapper_Call {
+	return &Application_GetPeerWrapper_Call{Call: _e.mock.On("GetPeerWrapper")}
+}
+
+func (_c *Application_GetPeerWrapper_Call) Run(run func()) *Application_GetPeerWrapper_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_GetPeerWrapper_Call) Return(_a0 *ocrcommon.SingletonPeerWrapper) *Application_GetPeerWrapper_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_GetPeerWrapper_Call) RunAndReturn(run func() *ocrcommon.SingletonPeerWrapper) *Application_GetPeerWrapper_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetRelayers provides a mock function with given fields:
 func (_m *Application) GetRelayers() chainlink.RelayerChainInteroperators {
 	ret := _m.Called()
@@ -967,6 +1164,53 @@ func (_c *Application_GetRelayers_Call) RunAndReturn(run func() chainlink.Relaye
 	return _c
 }
 
+// GetReportRunner provides a mock function with given fields:
+func (_m *Application) GetReportRunner() *reports.Runner {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReportRunner")
+	}
+
+	var r0 *reports.Runner
+	if rf, ok := ret.Get(0).(func() *reports.Runner); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*reports.Runner)
+		}
+	}
+
+	return r0
+}
+
+// Application_GetReportRunner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReportRunner'
+type Application_GetReportRunner_Call struct {
+	*mock.Call
+}
+
+// GetReportRunner is a helper method to define mock.On call
+func (_e *Application_Expecter) GetReportRunner() *Application_GetReportRunner_Call {
+	return &Application_GetReportRunner_Call{Call: _e.mock.On("GetReportRunner")}
+}
+
+func (_c *Application_GetReportRunner_Call) Run(run func()) *Application_GetReportRunner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_GetReportRunner_Call) Return(_a0 *reports.Runner) *Application_GetReportRunner_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_GetReportRunner_Call) RunAndReturn(run func() *reports.Runner) *Application_GetReportRunner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetWebAuthnConfiguration provides a mock function with given fields:
 func (_m *Application) GetWebAuthnConfiguration() sessions.WebAuthnConfiguration {
 	ret := _m.Called()
@@ -1153,6 +1397,53 @@ func (_c *Application_JobSpawner_Call) RunAndReturn(run func() job.Spawner) *App
 	return _c
 }
 
+// PauseJob provides a mock function with given fields: ctx, jobID
+func (_m *Application) PauseJob(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PauseJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Application_PauseJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseJob'
+type Application_PauseJob_Call struct {
+	*mock.Call
+}
+
+// PauseJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+func (_e *Application_Expecter) PauseJob(ctx interface{}, jobID interface{}) *Application_PauseJob_Call {
+	return &Application_PauseJob_Call{Call: _e.mock.On("PauseJob", ctx, jobID)}
+}
+
+func (_c *Application_PauseJob_Call) Run(run func(ctx context.Context, jobID int32)) *Application_PauseJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *Application_PauseJob_Call) Return(_a0 error) *Application_PauseJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_PauseJob_Call) RunAndReturn(run func(context.Context, int32) error) *Application_PauseJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PipelineORM provides a mock function with given fields:
 func (_m *Application) PipelineORM() pipeline.ORM {
 	ret := _m.Called()
@@ -1200,6 +1491,170 @@ func (_c *Application_PipelineORM_Call) RunAndReturn(run func() pipeline.ORM) *A
 	return _c
 }
 
+// PromoteJob provides a mock function with given fields: ctx, jobID
+func (_m *Application) PromoteJob(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PromoteJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Application_PromoteJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PromoteJob'
+type Application_PromoteJob_Call struct {
+	*mock.Call
+}
+
+// PromoteJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+func (_e *Application_Expecter) PromoteJob(ctx interface{}, jobID interface{}) *Application_PromoteJob_Call {
+	return &Application_PromoteJob_Call{Call: _e.mock.On("PromoteJob", ctx, jobID)}
+}
+
+func (_c *Application_PromoteJob_Call) Run(run func(ctx context.Context, jobID int32)) *Application_PromoteJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *Application_PromoteJob_Call) Return(_a0 error) *Application_PromoteJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_PromoteJob_Call) RunAndReturn(run func(context.Context, int32) error) *Application_PromoteJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecoverLogPollerFinalityViolation provides a mock function with given fields: ctx, chainID
+func (_m *Application) RecoverLogPollerFinalityViolation(ctx context.Context, chainID *big.Int) (*logpoller.FinalityRecoveryReport, error) {
+	ret := _m.Called(ctx, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecoverLogPollerFinalityViolation")
+	}
+
+	var r0 *logpoller.FinalityRecoveryReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int) (*logpoller.FinalityRecoveryReport, error)); ok {
+		return rf(ctx, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int) *logpoller.FinalityRecoveryReport); ok {
+		r0 = rf(ctx, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*logpoller.FinalityRecoveryReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *big.Int) error); ok {
+		r1 = rf(ctx, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Application_RecoverLogPollerFinalityViolation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecoverLogPollerFinalityViolation'
+type Application_RecoverLogPollerFinalityViolation_Call struct {
+	*mock.Call
+}
+
+// RecoverLogPollerFinalityViolation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chainID *big.Int
+func (_e *Application_Expecter) RecoverLogPollerFinalityViolation(ctx interface{}, chainID interface{}) *Application_RecoverLogPollerFinalityViolation_Call {
+	return &Application_RecoverLogPollerFinalityViolation_Call{Call: _e.mock.On("RecoverLogPollerFinalityViolation", ctx, chainID)}
+}
+
+func (_c *Application_RecoverLogPollerFinalityViolation_Call) Run(run func(ctx context.Context, chainID *big.Int)) *Application_RecoverLogPollerFinalityViolation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*big.Int))
+	})
+	return _c
+}
+
+func (_c *Application_RecoverLogPollerFinalityViolation_Call) Return(_a0 *logpoller.FinalityRecoveryReport, _a1 error) *Application_RecoverLogPollerFinalityViolation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Application_RecoverLogPollerFinalityViolation_Call) RunAndReturn(run func(context.Context, *big.Int) (*logpoller.FinalityRecoveryReport, error)) *Application_RecoverLogPollerFinalityViolation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReloadConfig provides a mock function with given fields: ctx
+func (_m *Application) ReloadConfig(ctx context.Context) ([]string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReloadConfig")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Application_ReloadConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReloadConfig'
+type Application_ReloadConfig_Call struct {
+	*mock.Call
+}
+
+// ReloadConfig is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Application_Expecter) ReloadConfig(ctx interface{}) *Application_ReloadConfig_Call {
+	return &Application_ReloadConfig_Call{Call: _e.mock.On("ReloadConfig", ctx)}
+}
+
+func (_c *Application_ReloadConfig_Call) Run(run func(ctx context.Context)) *Application_ReloadConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Application_ReloadConfig_Call) Return(_a0 []string, _a1 error) *Application_ReloadConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Application_ReloadConfig_Call) RunAndReturn(run func(context.Context) ([]string, error)) *Application_ReloadConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReplayFromBlock provides a mock function with given fields: chainID, number, forceBroadcast
 func (_m *Application) ReplayFromBlock(chainID *big.Int, number uint64, forceBroadcast bool) error {
 	ret := _m.Called(chainID, number, forceBroadcast)
@@ -1248,6 +1703,53 @@ func (_c *Application_ReplayFromBlock_Call) RunAndReturn(run func(*big.Int, uint
 	return _c
 }
 
+// ResumeJob provides a mock function with given fields: ctx, jobID
+func (_m *Application) ResumeJob(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResumeJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Application_ResumeJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeJob'
+type Application_ResumeJob_Call struct {
+	*mock.Call
+}
+
+// ResumeJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+func (_e *Application_Expecter) ResumeJob(ctx interface{}, jobID interface{}) *Application_ResumeJob_Call {
+	return &Application_ResumeJob_Call{Call: _e.mock.On("ResumeJob", ctx, jobID)}
+}
+
+func (_c *Application_ResumeJob_Call) Run(run func(ctx context.Context, jobID int32)) *Application_ResumeJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *Application_ResumeJob_Call) Return(_a0 error) *Application_ResumeJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_ResumeJob_Call) RunAndReturn(run func(context.Context, int32) error) *Application_ResumeJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ResumeJobV2 provides a mock function with given fields: ctx, taskID, result
 func (_m *Application) ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error {
 	ret := _m.Called(ctx, taskID, result)
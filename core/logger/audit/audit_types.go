@@ -11,6 +11,7 @@ const (
 	AuthLoginSuccessNo2FA   EventID = "AUTH_LOGIN_SUCCESS_NO_2FA"
 	Auth2FAEnrolled         EventID = "AUTH_2FA_ENROLLED"
 	AuthSessionDeleted      EventID = "SESSION_DELETED"
+	AuthSessionsRevoked     EventID = "SESSIONS_REVOKED"
 
 	PasswordResetAttemptFailedMismatch EventID = "PASSWORD_RESET_ATTEMPT_FAILED_MISMATCH"
 	PasswordResetSuccess               EventID = "PASSWORD_RESET_SUCCESS"
@@ -20,6 +21,10 @@ const (
 	APITokenDeleteAttemptPasswordMismatch EventID = "API_TOKEN_DELETE_ATTEMPT_PASSWORD_MISMATCH"
 	APITokenDeleted                       EventID = "API_TOKEN_DELETED"
 
+	ScopedAPITokenCreateAttemptPasswordMismatch EventID = "SCOPED_API_TOKEN_CREATE_ATTEMPT_PASSWORD_MISMATCH"
+	ScopedAPITokenCreated                       EventID = "SCOPED_API_TOKEN_CREATED"
+	ScopedAPITokenDeleted                       EventID = "SCOPED_API_TOKEN_DELETED"
+
 	FeedsManCreated EventID = "FEEDS_MAN_CREATED"
 	FeedsManUpdated EventID = "FEEDS_MAN_UPDATED"
 
@@ -37,10 +42,12 @@ const (
 	OCRKeyBundleExported EventID = "OCR_KEY_BUNDLE_EXPORTED"
 	OCRKeyBundleDeleted  EventID = "OCR_KEY_BUNDLE_DELETED"
 
-	OCR2KeyBundleCreated  EventID = "OCR2_KEY_BUNDLE_CREATED"
-	OCR2KeyBundleImported EventID = "OCR2_KEY_BUNDLE_IMPORTED"
-	OCR2KeyBundleExported EventID = "OCR2_KEY_BUNDLE_EXPORTED"
-	OCR2KeyBundleDeleted  EventID = "OCR2_KEY_BUNDLE_DELETED"
+	OCR2KeyBundleCreated   EventID = "OCR2_KEY_BUNDLE_CREATED"
+	OCR2KeyBundleImported  EventID = "OCR2_KEY_BUNDLE_IMPORTED"
+	OCR2KeyBundleExported  EventID = "OCR2_KEY_BUNDLE_EXPORTED"
+	OCR2KeyBundleDeleted   EventID = "OCR2_KEY_BUNDLE_DELETED"
+	OCR2KeyBundlesImported EventID = "OCR2_KEY_BUNDLES_IMPORTED"
+	OCR2KeyBundlesExported EventID = "OCR2_KEY_BUNDLES_EXPORTED"
 
 	KeyCreated  EventID = "KEY_CREATED"
 	KeyUpdated  EventID = "KEY_UPDATED"
@@ -52,8 +59,12 @@ const (
 	CosmosTransactionCreated EventID = "COSMOS_TRANSACTION_CREATED"
 	SolanaTransactionCreated EventID = "SOLANA_TRANSACTION_CREATED"
 
-	JobCreated EventID = "JOB_CREATED"
-	JobDeleted EventID = "JOB_DELETED"
+	JobCreated    EventID = "JOB_CREATED"
+	JobDeleted    EventID = "JOB_DELETED"
+	JobPromoted   EventID = "JOB_PROMOTED"
+	JobPaused     EventID = "JOB_PAUSED"
+	JobResumed    EventID = "JOB_RESUMED"
+	JobRolledBack EventID = "JOB_ROLLED_BACK"
 
 	ChainAdded       EventID = "CHAIN_ADDED"
 	ChainSpecUpdated EventID = "CHAIN_SPEC_UPDATED"
@@ -72,6 +83,12 @@ const (
 	ExternalInitiatorCreated EventID = "EXTERNAL_INITIATOR_CREATED"
 	ExternalInitiatorDeleted EventID = "EXTERNAL_INITIATOR_DELETED"
 
+	FunctionsQuotaUpdated EventID = "FUNCTIONS_QUOTA_UPDATED"
+	FunctionsQuotaDeleted EventID = "FUNCTIONS_QUOTA_DELETED"
+
+	BootstrapperOverrideUpdated EventID = "BOOTSTRAPPER_OVERRIDE_UPDATED"
+	BootstrapperOverrideDeleted EventID = "BOOTSTRAPPER_OVERRIDE_DELETED"
+
 	JobProposalSpecApproved EventID = "JOB_PROPOSAL_SPEC_APPROVED"
 	JobProposalSpecUpdated  EventID = "JOB_PROPOSAL_SPEC_UPDATED"
 	JobProposalSpecCanceled EventID = "JOB_PROPOSAL_SPEC_CANCELED"
@@ -81,6 +98,8 @@ const (
 	ConfigSqlLoggingEnabled  EventID = "CONFIG_SQL_LOGGING_ENABLED"
 	ConfigSqlLoggingDisabled EventID = "CONFIG_SQL_LOGGING_DISABLED"
 	GlobalLogLevelSet        EventID = "GLOBAL_LOG_LEVEL_SET"
+	JobLogCaptureStarted     EventID = "JOB_LOG_CAPTURE_STARTED"
+	JobLogCaptureStopped     EventID = "JOB_LOG_CAPTURE_STOPPED"
 
 	JobErrorDismissed EventID = "JOB_ERROR_DISMISSED"
 	JobRunSet         EventID = "JOB_RUN_SET"
@@ -0,0 +1,243 @@
+package logger
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+)
+
+const (
+	// DebugCaptureMaxDuration is the maximum duration of a debug capture.
+	DebugCaptureMaxDuration = 24 * time.Hour
+	// DebugCaptureMaxLines is the number of log lines kept by a debug capture, past which the oldest lines are dropped.
+	DebugCaptureMaxLines = 10_000
+)
+
+// jobDebugCaptures are the debug captures of the loggers created with Config.New.
+var jobDebugCaptures = NewDebugCaptures()
+
+// JobDebugCaptures returns the debug captures of the loggers created with Config.New.
+func JobDebugCaptures() *DebugCaptures {
+	return jobDebugCaptures
+}
+
+// DebugCaptureStatus is the status of the debug capture of a job.
+type DebugCaptureStatus struct {
+	JobID     int32
+	Level     zapcore.Level
+	StartedAt time.Time
+	Until     time.Time
+	Lines     int
+	Dropped   uint64
+}
+
+// Active returns true if the capture is still capturing logs at now.
+func (s DebugCaptureStatus) Active(now time.Time) bool {
+	return now.Before(s.Until)
+}
+
+// DebugCaptures raise the log level of single jobs for a bounded duration. The logs of a job at or above the level of
+// its capture are kept in a ring buffer of DebugCaptureMaxLines lines, while the log output keeps the global level, so
+// that debugging a job doesn't flood the log output. Only the loggers scoped to a job, i.e. created With a "jobID" field, are captured.
+// The captured logs are kept after the capture expires, until the capture is stopped or restarted.
+type DebugCaptures struct {
+	// n is the number of captures, to avoid locking when nothing is captured.
+	n atomic.Int32
+
+	mu    sync.RWMutex
+	byJob map[int32]*debugCapture
+}
+
+func NewDebugCaptures() *DebugCaptures {
+	return &DebugCaptures{byJob: make(map[int32]*debugCapture)}
+}
+
+// Start starts capturing the logs of the job at lvl for dur, replacing any previous capture of the job.
+func (d *DebugCaptures) Start(jobID int32, lvl zapcore.Level, dur time.Duration) (DebugCaptureStatus, error) {
+	if dur <= 0 || dur > DebugCaptureMaxDuration {
+		return DebugCaptureStatus{}, fmt.Errorf("duration must be positive and at most %s, got %s", DebugCaptureMaxDuration, dur)
+	}
+	now := time.Now()
+	c := &debugCapture{
+		jobID:     jobID,
+		level:     lvl,
+		startedAt: now,
+		until:     now.Add(dur),
+		lines:     make([][]byte, 0, 64),
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.byJob[jobID] = c
+	d.n.Store(int32(len(d.byJob)))
+	return c.status(), nil
+}
+
+// Stop stops the capture of the job and drops its logs. It returns false if the job isn't captured.
+func (d *DebugCaptures) Stop(jobID int32) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	_, ok := d.byJob[jobID]
+	delete(d.byJob, jobID)
+	d.n.Store(int32(len(d.byJob)))
+	return ok
+}
+
+// Status returns the status of the capture of the job, or false if the job isn't captured.
+func (d *DebugCaptures) Status(jobID int32) (DebugCaptureStatus, bool) {
+	c := d.get(jobID)
+	if c == nil {
+		return DebugCaptureStatus{}, false
+	}
+	return c.status(), true
+}
+
+// Logs returns the captured logs of the job, oldest first, one JSON object per line, or false if the job isn't captured.
+func (d *DebugCaptures) Logs(jobID int32) ([]byte, bool) {
+	c := d.get(jobID)
+	if c == nil {
+		return nil, false
+	}
+	return c.logs(), true
+}
+
+func (d *DebugCaptures) get(jobID int32) *debugCapture {
+	if d.n.Load() == 0 {
+		return nil
+	}
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.byJob[jobID]
+}
+
+type debugCapture struct {
+	jobID            int32
+	level            zapcore.Level
+	startedAt, until time.Time
+
+	mu      sync.Mutex
+	lines   [][]byte
+	next    int // index of the oldest line, once the buffer is full
+	dropped uint64
+}
+
+func (c *debugCapture) enabled(lvl zapcore.Level) bool {
+	return lvl >= c.level && time.Now().Before(c.until)
+}
+
+func (c *debugCapture) write(line []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.lines) < DebugCaptureMaxLines {
+		c.lines = append(c.lines, line)
+		return
+	}
+	c.lines[c.next] = line
+	c.next = (c.next + 1) % DebugCaptureMaxLines
+	c.dropped++
+}
+
+func (c *debugCapture) logs() []byte {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var b bytes.Buffer
+	for i := range c.lines {
+		b.Write(c.lines[(c.next+i)%len(c.lines)])
+	}
+	return b.Bytes()
+}
+
+func (c *debugCapture) status() DebugCaptureStatus {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return DebugCaptureStatus{
+		JobID:     c.jobID,
+		Level:     c.level,
+		StartedAt: c.startedAt,
+		Until:     c.until,
+		Lines:     len(c.lines),
+		Dropped:   c.dropped,
+	}
+}
+
+var _ zapcore.Core = &debugCaptureCore{}
+
+// debugCaptureCore writes the logs of the loggers scoped to a job to the debug capture of the job, if any.
+type debugCaptureCore struct {
+	captures *DebugCaptures
+	enc      zapcore.Encoder
+
+	jobID    int32
+	hasJobID bool
+}
+
+func newDebugCaptureCore(captures *DebugCaptures, encoderConfig zapcore.EncoderConfig) *debugCaptureCore {
+	return &debugCaptureCore{captures: captures, enc: zapcore.NewJSONEncoder(encoderConfig)}
+}
+
+func (c *debugCaptureCore) Enabled(lvl zapcore.Level) bool {
+	if !c.hasJobID {
+		return false
+	}
+	capture := c.captures.get(c.jobID)
+	return capture != nil && capture.enabled(lvl)
+}
+
+func (c *debugCaptureCore) With(fields []zapcore.Field) zapcore.Core {
+	clone := *c
+	clone.enc = c.enc.Clone()
+	for _, f := range fields {
+		f.AddTo(clone.enc)
+		if f.Key != "jobID" {
+			continue
+		}
+		if jobID, ok := jobIDFromField(f); ok {
+			clone.jobID, clone.hasJobID = jobID, true
+		}
+	}
+	return &clone
+}
+
+func (c *debugCaptureCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(ent.Level) {
+		return ce.AddCore(ent, c)
+	}
+	return ce
+}
+
+func (c *debugCaptureCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	capture := c.captures.get(c.jobID)
+	if capture == nil {
+		return nil
+	}
+	buf, err := c.enc.EncodeEntry(ent, fields)
+	if err != nil {
+		return err
+	}
+	capture.write(bytes.Clone(buf.Bytes()))
+	buf.Free()
+	return nil
+}
+
+func (c *debugCaptureCore) Sync() error { return nil }
+
+// jobIDFromField returns the job ID of a "jobID" field, which is logged as an integer, or as a string by some loggers.
+func jobIDFromField(f zapcore.Field) (int32, bool) {
+	switch f.Type {
+	case zapcore.Int64Type, zapcore.Int32Type, zapcore.Int16Type, zapcore.Int8Type,
+		zapcore.Uint64Type, zapcore.Uint32Type, zapcore.Uint16Type, zapcore.Uint8Type:
+		return int32(f.Integer), true
+	case zapcore.StringType:
+		id, err := strconv.ParseInt(f.String, 10, 32)
+		return int32(id), err == nil
+	case zapcore.StringerType:
+		id, err := strconv.ParseInt(fmt.Sprint(f.Interface), 10, 32)
+		return int32(id), err == nil
+	}
+	return 0, false
+}
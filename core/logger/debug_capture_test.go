@@ -0,0 +1,97 @@
+package logger
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func newDebugCaptureTestLogger(t *testing.T, captures *DebugCaptures) (*zap.SugaredLogger, *observer.ObservedLogs) {
+	t.Helper()
+	obsCore, obsLogs := observer.New(zapcore.InfoLevel)
+	core := zapcore.NewTee(obsCore, newDebugCaptureCore(captures, newZapConfigBase().EncoderConfig))
+	return zap.New(core).Sugar(), obsLogs
+}
+
+func captureMessages(t *testing.T, captures *DebugCaptures, jobID int32) (msgs []string) {
+	t.Helper()
+	logs, ok := captures.Logs(jobID)
+	require.True(t, ok)
+	s := bufio.NewScanner(bytes.NewReader(logs))
+	for s.Scan() {
+		var line map[string]any
+		require.NoError(t, json.Unmarshal(s.Bytes(), &line))
+		msgs = append(msgs, line["msg"].(string))
+	}
+	return
+}
+
+func TestDebugCaptures(t *testing.T) {
+	t.Parallel()
+	captures := NewDebugCaptures()
+	lggr, obsLogs := newDebugCaptureTestLogger(t, captures)
+	job1 := lggr.Named("OCR2").With("jobID", int32(1))
+	job2 := lggr.With("jobID", "2")
+
+	job1.Debug("not captured")
+	_, ok := captures.Logs(1)
+	assert.False(t, ok)
+
+	_, err := captures.Start(1, zapcore.DebugLevel, 2*DebugCaptureMaxDuration)
+	require.ErrorContains(t, err, "duration must be positive")
+	status, err := captures.Start(1, zapcore.DebugLevel, time.Hour)
+	require.NoError(t, err)
+	assert.True(t, status.Active(time.Now()))
+
+	job1.Debugw("debug", "key", "value")
+	job1.Infow("info")
+	job2.Debugw("other job")
+	lggr.Debugw("no job")
+
+	assert.Equal(t, []string{"debug", "info"}, captureMessages(t, captures, 1))
+	_, ok = captures.Logs(2)
+	assert.False(t, ok)
+	// the log output keeps the global level
+	assert.Equal(t, 1, obsLogs.FilterMessage("info").Len())
+	assert.Equal(t, 0, obsLogs.FilterMessage("debug").Len())
+
+	t.Run("ring buffer", func(t *testing.T) {
+		_, err := captures.Start(2, zapcore.DebugLevel, time.Hour)
+		require.NoError(t, err)
+		for i := 0; i < DebugCaptureMaxLines+2; i++ {
+			job2.Debug(i)
+		}
+		msgs := captureMessages(t, captures, 2)
+		require.Len(t, msgs, DebugCaptureMaxLines)
+		assert.Equal(t, "2", msgs[0])
+		assert.Equal(t, "10001", msgs[len(msgs)-1])
+
+		status, ok := captures.Status(2)
+		require.True(t, ok)
+		assert.Equal(t, uint64(2), status.Dropped)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		captures.mu.Lock()
+		captures.byJob[1].until = time.Now()
+		captures.mu.Unlock()
+
+		job1.Debug("expired")
+		assert.Equal(t, []string{"debug", "info"}, captureMessages(t, captures, 1))
+	})
+
+	t.Run("stop", func(t *testing.T) {
+		assert.True(t, captures.Stop(1))
+		assert.False(t, captures.Stop(1))
+		_, ok := captures.Status(1)
+		assert.False(t, ok)
+	})
+}
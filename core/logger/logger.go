@@ -262,6 +262,7 @@ func newLoggerForCore(zcfg zap.Config, core zapcore.Core) (*zapLogger, func(), e
 		return nil, nil, err
 	}
 
+	core = zapcore.NewTee(core, newDebugCaptureCore(jobDebugCaptures, zcfg.EncoderConfig))
 	return &zapLogger{
 		level:         zcfg.Level,
 		SugaredLogger: zap.New(core, zap.ErrorOutput(errSink), zap.AddCaller(), zap.AddStacktrace(zapcore.ErrorLevel)).Sugar(),
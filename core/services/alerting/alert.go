@@ -0,0 +1,82 @@
+// alerting delivers the alerts emitted by services on critical events, like a LogPoller finality violation or a
+// terminally stuck transaction, to the webhooks configured in `[Alerting]`.
+package alerting
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// Severity is the severity of an alert. Webhooks only receive the alerts of at least their MinSeverity.
+type Severity string
+
+const (
+	SeverityInfo     Severity = "info"
+	SeverityWarning  Severity = "warning"
+	SeverityCritical Severity = "critical"
+)
+
+func (s Severity) rank() int {
+	switch s {
+	case SeverityInfo:
+		return 1
+	case SeverityWarning:
+		return 2
+	case SeverityCritical:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// ParseSeverity returns the Severity named s.
+func ParseSeverity(s string) (Severity, error) {
+	if sev := Severity(s); sev.rank() > 0 {
+		return sev, nil
+	}
+	return "", fmt.Errorf("unknown severity %q", s)
+}
+
+// EventType is the type of event an alert is emitted for.
+type EventType string
+
+const (
+	// EventPriceServiceFailure is emitted when the CCIP price service fails to update the gas or token prices of a lane.
+	EventPriceServiceFailure EventType = "ccip_price_service_failure"
+	// EventTxStuck is emitted when the transaction manager detects a terminally stuck transaction.
+	EventTxStuck EventType = "txm_stuck_transaction"
+	// EventTxNonceGap is emitted when the transaction manager detects a transaction blocked by a nonce which was never mined.
+	EventTxNonceGap EventType = "txm_nonce_gap"
+	// EventFinalityViolation is emitted when the LogPoller detects a reorg deeper than the finality depth.
+	EventFinalityViolation EventType = "logpoller_finality_violation"
+	// EventOracleDown is emitted when the services of an OCR job, including its oracle, fail to start.
+	EventOracleDown EventType = "ocr_oracle_down"
+)
+
+// Alert is an event emitted by a service.
+type Alert struct {
+	Type     EventType
+	Severity Severity
+	// Key identifies the source of the alert, e.g. the chain and address of a stuck transaction. Alerts of the same
+	// Type and Key are deduplicated.
+	Key     string
+	Summary string
+	Details map[string]string
+	// Time defaults to the time the alert is emitted.
+	Time time.Time
+}
+
+func (a Alert) dedupKey() string {
+	return string(a.Type) + "/" + a.Key
+}
+
+var global atomic.Pointer[Service]
+
+// Emit emits the alert to the Service of the process: the Service of the node, or the one of a LOOPP created by
+// NewLOOPService. It never blocks, and alerts are dropped if alerting is disabled.
+func Emit(a Alert) {
+	if s := global.Load(); s != nil {
+		s.Emit(a)
+	}
+}
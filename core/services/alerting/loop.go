@@ -0,0 +1,101 @@
+package alerting
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/config/env"
+)
+
+// loopConfig is the config of the alerting of the node, as passed to its LOOPPs by LOOPEnv. The Service of the node
+// only receives the alerts emitted in its own process, so each LOOPP delivers its alerts with a Service of its own.
+type loopConfig struct {
+	DedupWindowValue  time.Duration `json:"dedupWindow"`
+	MaxPerMinuteValue uint32        `json:"maxPerMinute"`
+	SendTimeoutValue  time.Duration `json:"sendTimeout"`
+	WebhookValues     []loopWebhook `json:"webhooks"`
+}
+
+type loopWebhook struct {
+	NameValue        string `json:"name"`
+	TypeValue        string `json:"type"`
+	URLValue         string `json:"url,omitempty"`
+	RoutingKeyValue  string `json:"routingKey,omitempty"`
+	MinSeverityValue string `json:"minSeverity"`
+}
+
+var _ config.Alerting = (*loopConfig)(nil)
+
+func (c *loopConfig) Enabled() bool              { return true }
+func (c *loopConfig) DedupWindow() time.Duration { return c.DedupWindowValue }
+func (c *loopConfig) MaxPerMinute() uint32       { return c.MaxPerMinuteValue }
+func (c *loopConfig) SendTimeout() time.Duration { return c.SendTimeoutValue }
+
+func (c *loopConfig) Webhooks() []config.AlertingWebhook {
+	webhooks := make([]config.AlertingWebhook, len(c.WebhookValues))
+	for i := range c.WebhookValues {
+		webhooks[i] = &c.WebhookValues[i]
+	}
+	return webhooks
+}
+
+func (w *loopWebhook) Name() string        { return w.NameValue }
+func (w *loopWebhook) Type() string        { return w.TypeValue }
+func (w *loopWebhook) RoutingKey() string  { return w.RoutingKeyValue }
+func (w *loopWebhook) MinSeverity() string { return w.MinSeverityValue }
+
+func (w *loopWebhook) URL() *url.URL {
+	if w.URLValue == "" {
+		return nil
+	}
+	u, err := url.Parse(w.URLValue)
+	if err != nil {
+		return nil
+	}
+	return u
+}
+
+// LOOPEnv returns the environment variable passing cfg to the LOOPPs of the node, for NewLOOPService.
+func LOOPEnv(cfg config.Alerting) (string, error) {
+	c := loopConfig{
+		DedupWindowValue:  cfg.DedupWindow(),
+		MaxPerMinuteValue: cfg.MaxPerMinute(),
+		SendTimeoutValue:  cfg.SendTimeout(),
+	}
+	for _, w := range cfg.Webhooks() {
+		lw := loopWebhook{
+			NameValue:        w.Name(),
+			TypeValue:        w.Type(),
+			RoutingKeyValue:  w.RoutingKey(),
+			MinSeverityValue: w.MinSeverity(),
+		}
+		if u := w.URL(); u != nil {
+			lw.URLValue = u.String()
+		}
+		c.WebhookValues = append(c.WebhookValues, lw)
+	}
+	b, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return string(env.AlertingLOOPConfig) + "=" + string(b), nil
+}
+
+// NewLOOPService returns the Service delivering the alerts emitted in a LOOPP, from the config passed by the node, or
+// nil if the node has alerting disabled.
+func NewLOOPService(lggr logger.Logger) (*Service, error) {
+	v := env.AlertingLOOPConfig.Get()
+	if v == "" {
+		return nil, nil
+	}
+	var c loopConfig
+	if err := json.Unmarshal([]byte(v), &c); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", env.AlertingLOOPConfig, err)
+	}
+	return NewService(lggr, &c)
+}
@@ -0,0 +1,176 @@
+package alerting
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/time/rate"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+)
+
+// queueSize is the number of emitted alerts waiting to be delivered, past which alerts are dropped.
+const queueSize = 100
+
+var (
+	promAlerts = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "alerting_alerts_total",
+		Help: "The total number of alerts emitted, by type and result: delivered, deduplicated, rate_limited or dropped",
+	}, []string{"type", "result"})
+	promDeliveryErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "alerting_delivery_errors_total",
+		Help: "The total number of alerts which failed to be delivered to a webhook",
+	}, []string{"webhook"})
+)
+
+// Service delivers the emitted alerts to the webhooks of the config. Alerts of the same Type and Key are only
+// delivered once per DedupWindow, and at most MaxPerMinute alerts are delivered per minute, the others are dropped.
+type Service struct {
+	services.Service
+	eng *services.Engine
+
+	sinks       []sink
+	dedupWindow time.Duration
+	sendTimeout time.Duration
+	limiter     *rate.Limiter
+	queue       chan Alert
+
+	mu        sync.Mutex
+	delivered map[string]time.Time // by dedupKey
+}
+
+func NewService(lggr logger.Logger, cfg config.Alerting) (*Service, error) {
+	source, err := os.Hostname()
+	if err != nil || source == "" {
+		source = "chainlink"
+	}
+	client := &http.Client{}
+
+	s := &Service{
+		dedupWindow: cfg.DedupWindow(),
+		sendTimeout: cfg.SendTimeout(),
+		limiter:     rate.NewLimiter(rate.Limit(float64(cfg.MaxPerMinute())/60), int(cfg.MaxPerMinute())),
+		queue:       make(chan Alert, queueSize),
+		delivered:   make(map[string]time.Time),
+	}
+	for _, w := range cfg.Webhooks() {
+		minSeverity, err := ParseSeverity(w.MinSeverity())
+		if err != nil {
+			return nil, fmt.Errorf("webhook %s: %w", w.Name(), err)
+		}
+		switch w.Type() {
+		case TypeWebhook:
+			if w.URL() == nil || w.URL().String() == "" {
+				return nil, fmt.Errorf("webhook %s: URL must be set in the secrets of the webhook", w.Name())
+			}
+			s.sinks = append(s.sinks, &webhookSink{webhook: w.Name(), url: w.URL().String(), min: minSeverity, client: client})
+		case TypePagerDuty:
+			if w.RoutingKey() == "" {
+				return nil, fmt.Errorf("webhook %s: RoutingKey must be set in the secrets of the webhook", w.Name())
+			}
+			url := PagerDutyEventsURL
+			if u := w.URL(); u != nil && u.String() != "" {
+				url = u.String()
+			}
+			s.sinks = append(s.sinks, &pagerDutySink{webhook: w.Name(), url: url, routingKey: w.RoutingKey(), source: source, min: minSeverity, client: client})
+		default:
+			return nil, fmt.Errorf("webhook %s: unknown type %q", w.Name(), w.Type())
+		}
+	}
+
+	s.Service, s.eng = services.Config{
+		Name:  "Alerting",
+		Start: s.start,
+		Close: s.close,
+	}.NewServiceEngine(lggr)
+	return s, nil
+}
+
+func (s *Service) start(context.Context) error {
+	s.eng.Go(s.run)
+	s.eng.GoTick(services.NewTicker(s.dedupWindow), s.prune)
+	global.Store(s)
+	return nil
+}
+
+func (s *Service) close() error {
+	global.CompareAndSwap(s, nil)
+	return nil
+}
+
+// Emit queues the alert to be delivered, or drops it if the queue is full.
+func (s *Service) Emit(a Alert) {
+	if a.Time.IsZero() {
+		a.Time = time.Now()
+	}
+	select {
+	case s.queue <- a:
+	default:
+		promAlerts.WithLabelValues(string(a.Type), "dropped").Inc()
+		s.eng.Warnw("Alert queue is full, dropping alert", "type", a.Type, "key", a.Key, "summary", a.Summary)
+	}
+}
+
+func (s *Service) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case a := <-s.queue:
+			s.deliver(ctx, a)
+		}
+	}
+}
+
+func (s *Service) deliver(ctx context.Context, a Alert) {
+	key := a.dedupKey()
+	s.mu.Lock()
+	last, seen := s.delivered[key]
+	if seen && a.Time.Sub(last) < s.dedupWindow {
+		s.mu.Unlock()
+		promAlerts.WithLabelValues(string(a.Type), "deduplicated").Inc()
+		return
+	}
+	if !s.limiter.Allow() {
+		s.mu.Unlock()
+		promAlerts.WithLabelValues(string(a.Type), "rate_limited").Inc()
+		s.eng.Warnw("Alert rate limit exceeded, dropping alert", "type", a.Type, "key", a.Key, "summary", a.Summary)
+		return
+	}
+	s.delivered[key] = a.Time
+	s.mu.Unlock()
+
+	promAlerts.WithLabelValues(string(a.Type), "delivered").Inc()
+	for _, sk := range s.sinks {
+		if a.Severity.rank() < sk.minSeverity().rank() {
+			continue
+		}
+		sendCtx, cancel := context.WithTimeout(ctx, s.sendTimeout)
+		err := sk.send(sendCtx, a)
+		cancel()
+		if err != nil {
+			promDeliveryErrors.WithLabelValues(sk.name()).Inc()
+			s.eng.Errorw("Failed to deliver alert", "webhook", sk.name(), "type", a.Type, "key", a.Key, "err", err)
+		}
+	}
+}
+
+// prune forgets the alerts delivered before the DedupWindow.
+func (s *Service) prune(context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, t := range s.delivered {
+		if time.Since(t) >= s.dedupWindow {
+			delete(s.delivered, key)
+		}
+	}
+}
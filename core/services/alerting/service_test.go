@@ -0,0 +1,173 @@
+package alerting
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+type testConfig struct {
+	maxPerMinute uint32
+	webhooks     []config.AlertingWebhook
+}
+
+func (c testConfig) Enabled() bool                      { return true }
+func (c testConfig) DedupWindow() time.Duration         { return time.Hour }
+func (c testConfig) MaxPerMinute() uint32               { return c.maxPerMinute }
+func (c testConfig) SendTimeout() time.Duration         { return time.Second }
+func (c testConfig) Webhooks() []config.AlertingWebhook { return c.webhooks }
+
+type testWebhook struct {
+	name, typ, url, routingKey, minSeverity string
+}
+
+func (w testWebhook) Name() string        { return w.name }
+func (w testWebhook) Type() string        { return w.typ }
+func (w testWebhook) RoutingKey() string  { return w.routingKey }
+func (w testWebhook) MinSeverity() string { return w.minSeverity }
+func (w testWebhook) URL() *url.URL {
+	u, _ := url.Parse(w.url)
+	return u
+}
+
+type receiver struct {
+	mu     sync.Mutex
+	bodies []map[string]any
+}
+
+func newReceiver(t *testing.T) (*receiver, string) {
+	r := &receiver{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		r.mu.Lock()
+		r.bodies = append(r.bodies, body)
+		r.mu.Unlock()
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	t.Cleanup(srv.Close)
+	return r, srv.URL
+}
+
+func (r *receiver) received() []map[string]any {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]map[string]any(nil), r.bodies...)
+}
+
+func TestService_deliver(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	webhook, webhookURL := newReceiver(t)
+	pagerDuty, pagerDutyURL := newReceiver(t)
+	s, err := NewService(logger.TestLogger(t), testConfig{maxPerMinute: 3, webhooks: []config.AlertingWebhook{
+		testWebhook{name: "ops", typ: TypeWebhook, url: webhookURL, minSeverity: "warning"},
+		testWebhook{name: "on-call", typ: TypePagerDuty, url: pagerDutyURL, routingKey: "routing-key", minSeverity: "critical"},
+	}})
+	require.NoError(t, err)
+
+	now := time.Now()
+	s.deliver(ctx, Alert{Type: EventPriceServiceFailure, Severity: SeverityWarning, Key: "1-2/gas", Summary: "gas", Time: now})
+	s.deliver(ctx, Alert{Type: EventTxStuck, Severity: SeverityCritical, Key: "1/0xabc/7", Summary: "stuck", Time: now})
+	// deduplicated
+	s.deliver(ctx, Alert{Type: EventTxStuck, Severity: SeverityCritical, Key: "1/0xabc/7", Summary: "stuck", Time: now.Add(time.Minute)})
+
+	require.Len(t, webhook.received(), 2)
+	assert.Equal(t, "ccip_price_service_failure", webhook.received()[0]["type"])
+	assert.Equal(t, "txm_stuck_transaction", webhook.received()[1]["type"])
+
+	require.Len(t, pagerDuty.received(), 1)
+	event := pagerDuty.received()[0]
+	assert.Equal(t, "routing-key", event["routing_key"])
+	assert.Equal(t, "trigger", event["event_action"])
+	assert.Equal(t, "txm_stuck_transaction/1/0xabc/7", event["dedup_key"])
+	assert.Equal(t, "critical", event["payload"].(map[string]any)["severity"])
+
+	t.Run("rate limited", func(t *testing.T) {
+		s.deliver(ctx, Alert{Type: EventOracleDown, Severity: SeverityWarning, Key: "1", Time: now})
+		s.deliver(ctx, Alert{Type: EventOracleDown, Severity: SeverityWarning, Key: "2", Time: now})
+		assert.Len(t, webhook.received(), 3)
+	})
+
+	t.Run("dedup window", func(t *testing.T) {
+		s.limiter = rate.NewLimiter(rate.Inf, 0)
+		s.deliver(ctx, Alert{Type: EventTxStuck, Severity: SeverityWarning, Key: "1/0xabc/7", Time: now.Add(2 * time.Hour)})
+		assert.Len(t, webhook.received(), 4)
+	})
+}
+
+func TestEmit(t *testing.T) {
+	webhook, webhookURL := newReceiver(t)
+	s, err := NewService(logger.TestLogger(t), testConfig{maxPerMinute: 10, webhooks: []config.AlertingWebhook{
+		testWebhook{name: "ops", typ: TypeWebhook, url: webhookURL, minSeverity: "info"},
+	}})
+	require.NoError(t, err)
+
+	// dropped, as alerting isn't started
+	Emit(Alert{Type: EventFinalityViolation, Severity: SeverityCritical, Key: "1"})
+
+	servicetest.Run(t, s)
+	Emit(Alert{Type: EventFinalityViolation, Severity: SeverityCritical, Key: "1"})
+	require.Eventually(t, func() bool { return len(webhook.received()) == 1 }, testutils.WaitTimeout(t), 10*time.Millisecond)
+	assert.Equal(t, "logpoller_finality_violation", webhook.received()[0]["type"])
+}
+
+func TestNewService_unknownSeverity(t *testing.T) {
+	_, err := NewService(logger.TestLogger(t), testConfig{maxPerMinute: 10, webhooks: []config.AlertingWebhook{
+		testWebhook{name: "ops", typ: TypeWebhook, url: "http://localhost", minSeverity: "fatal"},
+	}})
+	require.ErrorContains(t, err, `webhook ops: unknown severity "fatal"`)
+}
+
+func TestNewService_missingSecrets(t *testing.T) {
+	_, err := NewService(logger.TestLogger(t), testConfig{maxPerMinute: 10, webhooks: []config.AlertingWebhook{
+		testWebhook{name: "ops", typ: TypeWebhook, minSeverity: "warning"},
+	}})
+	require.EqualError(t, err, "webhook ops: URL must be set in the secrets of the webhook")
+
+	_, err = NewService(logger.TestLogger(t), testConfig{maxPerMinute: 10, webhooks: []config.AlertingWebhook{
+		testWebhook{name: "on-call", typ: TypePagerDuty, minSeverity: "warning"},
+	}})
+	require.EqualError(t, err, "webhook on-call: RoutingKey must be set in the secrets of the webhook")
+}
+
+func TestNewLOOPService(t *testing.T) {
+	s, err := NewLOOPService(logger.TestLogger(t))
+	require.NoError(t, err)
+	assert.Nil(t, s, "alerting is disabled without the config of the node")
+
+	e, err := LOOPEnv(testConfig{maxPerMinute: 10, webhooks: []config.AlertingWebhook{
+		testWebhook{name: "ops", typ: TypeWebhook, url: "https://alerts.example.com/token", minSeverity: "info"},
+		testWebhook{name: "on-call", typ: TypePagerDuty, routingKey: "routing-key", minSeverity: "critical"},
+	}})
+	require.NoError(t, err)
+	name, value, ok := strings.Cut(e, "=")
+	require.True(t, ok)
+	t.Setenv(name, value)
+
+	s, err = NewLOOPService(logger.TestLogger(t))
+	require.NoError(t, err)
+	require.NotNil(t, s)
+	assert.Equal(t, time.Hour, s.dedupWindow)
+	require.Len(t, s.sinks, 2)
+	assert.Equal(t, &webhookSink{webhook: "ops", url: "https://alerts.example.com/token", min: SeverityInfo, client: s.sinks[0].(*webhookSink).client}, s.sinks[0])
+	pagerDuty := s.sinks[1].(*pagerDutySink)
+	assert.Equal(t, PagerDutyEventsURL, pagerDuty.url)
+	assert.Equal(t, "routing-key", pagerDuty.routingKey)
+	assert.Equal(t, SeverityCritical, pagerDuty.min)
+}
@@ -0,0 +1,125 @@
+package alerting
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	TypeWebhook   = "webhook"
+	TypePagerDuty = "pagerduty"
+
+	// PagerDutyEventsURL is the URL of the PagerDuty Events API v2, used by the pagerduty webhooks without a URL.
+	PagerDutyEventsURL = "https://events.pagerduty.com/v2/enqueue"
+)
+
+// sink delivers alerts to a webhook.
+type sink interface {
+	name() string
+	minSeverity() Severity
+	send(ctx context.Context, a Alert) error
+}
+
+type webhookPayload struct {
+	Type     EventType         `json:"type"`
+	Severity Severity          `json:"severity"`
+	Key      string            `json:"key"`
+	Summary  string            `json:"summary"`
+	Details  map[string]string `json:"details,omitempty"`
+	Time     time.Time         `json:"time"`
+}
+
+// webhookSink posts the alerts as JSON to its URL.
+type webhookSink struct {
+	webhook string
+	url     string
+	min     Severity
+	client  *http.Client
+}
+
+func (w *webhookSink) name() string          { return w.webhook }
+func (w *webhookSink) minSeverity() Severity { return w.min }
+
+func (w *webhookSink) send(ctx context.Context, a Alert) error {
+	return post(ctx, w.client, w.url, webhookPayload{
+		Type:     a.Type,
+		Severity: a.Severity,
+		Key:      a.Key,
+		Summary:  a.Summary,
+		Details:  a.Details,
+		Time:     a.Time,
+	})
+}
+
+// pagerDutyEvent is a trigger event of the PagerDuty Events API v2.
+type pagerDutyEvent struct {
+	RoutingKey  string           `json:"routing_key"`
+	EventAction string           `json:"event_action"`
+	DedupKey    string           `json:"dedup_key"`
+	Payload     pagerDutyPayload `json:"payload"`
+}
+
+type pagerDutyPayload struct {
+	Summary       string            `json:"summary"`
+	Source        string            `json:"source"`
+	Severity      Severity          `json:"severity"`
+	Timestamp     time.Time         `json:"timestamp"`
+	Class         EventType         `json:"class"`
+	CustomDetails map[string]string `json:"custom_details,omitempty"`
+}
+
+// pagerDutySink triggers a PagerDuty incident per alert, which PagerDuty deduplicates by the Type and Key of the alert.
+type pagerDutySink struct {
+	webhook    string
+	url        string
+	routingKey string
+	source     string
+	min        Severity
+	client     *http.Client
+}
+
+func (p *pagerDutySink) name() string          { return p.webhook }
+func (p *pagerDutySink) minSeverity() Severity { return p.min }
+
+func (p *pagerDutySink) send(ctx context.Context, a Alert) error {
+	return post(ctx, p.client, p.url, pagerDutyEvent{
+		RoutingKey:  p.routingKey,
+		EventAction: "trigger",
+		DedupKey:    a.dedupKey(),
+		Payload: pagerDutyPayload{
+			Summary:       a.Summary,
+			Source:        p.source,
+			Severity:      a.Severity,
+			Timestamp:     a.Time,
+			Class:         a.Type,
+			CustomDetails: a.Details,
+		},
+	})
+}
+
+func post(ctx context.Context, client *http.Client, url string, body any) error {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("unexpected status %s: %s", resp.Status, msg)
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/iterator"
+)
+
+type gcsStore struct {
+	client *storage.Client
+	bucket *storage.BucketHandle
+	prefix string
+}
+
+// NewGCSStore returns a Store which keeps artifacts in a GCS bucket, with the application default credentials.
+func NewGCSStore(ctx context.Context, bucket, prefix string) (Store, error) {
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCS client: %w", err)
+	}
+	return &gcsStore{client: client, bucket: client.Bucket(bucket), prefix: prefix}, nil
+}
+
+func (s *gcsStore) object(key Key) *storage.ObjectHandle {
+	return s.bucket.Object(path.Join(s.prefix, string(key)))
+}
+
+func (s *gcsStore) Put(ctx context.Context, data []byte) (Key, error) {
+	return put(ctx, s, data)
+}
+
+func (s *gcsStore) PutReader(ctx context.Context, r io.Reader) (Key, error) {
+	// the key is only known once all the content is read, so it is spooled to disk first
+	f, key, err := spool("", r)
+	if err != nil {
+		return "", fmt.Errorf("failed to spool blob: %w", err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+	w := s.object(key).NewWriter(ctx)
+	if _, err = io.Copy(w, f); err != nil {
+		w.Close()
+		return "", fmt.Errorf("failed to put blob %s: %w", key, err)
+	}
+	if err = w.Close(); err != nil {
+		return "", fmt.Errorf("failed to put blob %s: %w", key, err)
+	}
+	return key, nil
+}
+
+func (s *gcsStore) Get(ctx context.Context, key Key) ([]byte, error) {
+	return get(ctx, s, key)
+}
+
+func (s *gcsStore) Open(ctx context.Context, key Key) (io.ReadCloser, error) {
+	if err := key.validate(); err != nil {
+		return nil, err
+	}
+	r, err := s.object(key).NewReader(ctx)
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get blob %s: %w", key, err)
+	}
+	return newVerifyingReader(key, r), nil
+}
+
+func (s *gcsStore) Delete(ctx context.Context, key Key) error {
+	if err := key.validate(); err != nil {
+		return err
+	}
+	if err := s.object(key).Delete(ctx); err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+		return fmt.Errorf("failed to delete blob %s: %w", key, err)
+	}
+	return nil
+}
+
+func (s *gcsStore) List(ctx context.Context) (objects []Object, err error) {
+	q := &storage.Query{}
+	if s.prefix != "" {
+		q.Prefix = strings.TrimSuffix(s.prefix, "/") + "/"
+	}
+	it := s.bucket.Objects(ctx, q)
+	for {
+		attrs, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			return objects, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to list blobs: %w", err)
+		}
+		key, err := ParseKey(path.Base(attrs.Name))
+		if err != nil {
+			continue // not ours
+		}
+		objects = append(objects, Object{Key: key, Size: attrs.Size, Updated: attrs.Updated})
+	}
+}
+
+func (s *gcsStore) Close() error {
+	return s.client.Close()
+}
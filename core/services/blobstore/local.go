@@ -0,0 +1,123 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const tmpPrefix = ".tmp-"
+
+type localStore struct {
+	dir string
+}
+
+// NewLocalStore returns a Store which keeps artifacts in dir, sharded by the first byte of their key.
+func NewLocalStore(dir string) (Store, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create blob store directory: %w", err)
+	}
+	return &localStore{dir: dir}, nil
+}
+
+func (s *localStore) path(key Key) (string, error) {
+	if err := key.validate(); err != nil {
+		return "", err
+	}
+	return filepath.Join(s.dir, string(key[:2]), string(key)), nil
+}
+
+func (s *localStore) Put(ctx context.Context, data []byte) (Key, error) {
+	return put(ctx, s, data)
+}
+
+func (s *localStore) PutReader(_ context.Context, r io.Reader) (Key, error) {
+	// write to a temporary file first, so that readers never observe partial artifacts
+	f, key, err := spool(s.dir, r)
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(f.Name())
+	if err = f.Close(); err != nil {
+		return "", err
+	}
+	p, err := s.path(key)
+	if err != nil {
+		return "", err
+	}
+	now := time.Now()
+	if err = os.Chtimes(p, now, now); err == nil {
+		return key, nil
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return "", err
+	}
+	if err = os.MkdirAll(filepath.Dir(p), 0700); err != nil {
+		return "", err
+	}
+	if err = os.Rename(f.Name(), p); err != nil {
+		return "", err
+	}
+	return key, nil
+}
+
+func (s *localStore) Get(ctx context.Context, key Key) ([]byte, error) {
+	return get(ctx, s, key)
+}
+
+func (s *localStore) Open(_ context.Context, key Key) (io.ReadCloser, error) {
+	p, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(p)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	return newVerifyingReader(key, f), nil
+}
+
+func (s *localStore) Delete(_ context.Context, key Key) error {
+	p, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err = os.Remove(p); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (s *localStore) List(ctx context.Context) (objects []Object, err error) {
+	err = filepath.WalkDir(s.dir, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if d.IsDir() || strings.HasPrefix(d.Name(), tmpPrefix) {
+			return nil
+		}
+		key, err := ParseKey(d.Name())
+		if err != nil {
+			return nil // not ours
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		objects = append(objects, Object{Key: key, Size: info.Size(), Updated: info.ModTime()})
+		return nil
+	})
+	return
+}
+
+func (s *localStore) Close() error { return nil }
@@ -0,0 +1,102 @@
+package blobstore
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+func TestLocalStore(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	s, err := NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+
+	data := []byte("large offchain config")
+	key, err := s.Put(ctx, data)
+	require.NoError(t, err)
+	assert.Equal(t, KeyOf(data), key)
+
+	got, err := s.Get(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, data, got)
+
+	path := func(key Key) string {
+		p, err := s.(*localStore).path(key)
+		require.NoError(t, err)
+		return p
+	}
+
+	t.Run("put again refreshes the age", func(t *testing.T) {
+		old := time.Now().Add(-time.Hour)
+		require.NoError(t, os.Chtimes(path(key), old, old))
+		_, err := s.Put(ctx, data)
+		require.NoError(t, err)
+
+		objects, err := s.List(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+		assert.Equal(t, key, objects[0].Key)
+		assert.Equal(t, int64(len(data)), objects[0].Size)
+		assert.True(t, objects[0].Updated.After(old))
+	})
+
+	t.Run("corrupted", func(t *testing.T) {
+		other, err := s.Put(ctx, []byte("other"))
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(path(other), []byte("tampered"), 0600))
+		_, err = s.Get(ctx, other)
+		require.ErrorContains(t, err, "is corrupted")
+		require.NoError(t, s.Delete(ctx, other))
+	})
+
+	t.Run("streamed", func(t *testing.T) {
+		streamed, err := s.PutReader(ctx, strings.NewReader("large report"))
+		require.NoError(t, err)
+		assert.Equal(t, KeyOf([]byte("large report")), streamed)
+		r, err := s.Open(ctx, streamed)
+		require.NoError(t, err)
+		got, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+		assert.Equal(t, "large report", string(got))
+		require.NoError(t, s.Delete(ctx, streamed))
+	})
+
+	t.Run("invalid keys", func(t *testing.T) {
+		for _, k := range []Key{"", "a", "../" + key} {
+			_, err := s.Get(ctx, k)
+			require.ErrorContains(t, err, "invalid blob key")
+			require.ErrorContains(t, s.Delete(ctx, k), "invalid blob key")
+		}
+	})
+
+	require.NoError(t, s.Delete(ctx, key))
+	require.NoError(t, s.Delete(ctx, key))
+	_, err = s.Get(ctx, key)
+	require.ErrorIs(t, err, ErrNotFound)
+	objects, err := s.List(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, objects)
+}
+
+func TestParseKey(t *testing.T) {
+	t.Parallel()
+
+	key := KeyOf([]byte("data"))
+	parsed, err := ParseKey(string(key))
+	require.NoError(t, err)
+	assert.Equal(t, key, parsed)
+
+	for _, s := range []string{"", "zz", string(key[:10])} {
+		_, err = ParseKey(s)
+		assert.Error(t, err, s)
+	}
+}
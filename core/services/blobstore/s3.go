@@ -0,0 +1,122 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+type s3Store struct {
+	client *s3.S3
+	bucket string
+	prefix string
+}
+
+// NewS3Store returns a Store which keeps artifacts in an S3 bucket, with credentials loaded from the environment.
+// endpoint is optional, and selects an S3 compatible store instead of AWS.
+func NewS3Store(bucket, prefix, region, endpoint string) (Store, error) {
+	cfg := aws.NewConfig().WithRegion(region)
+	if endpoint != "" {
+		cfg = cfg.WithEndpoint(endpoint).WithS3ForcePathStyle(true)
+	}
+	sess, err := session.NewSession(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create S3 session: %w", err)
+	}
+	return &s3Store{client: s3.New(sess), bucket: bucket, prefix: prefix}, nil
+}
+
+func (s *s3Store) name(key Key) string {
+	return path.Join(s.prefix, string(key))
+}
+
+func (s *s3Store) Put(ctx context.Context, data []byte) (Key, error) {
+	return put(ctx, s, data)
+}
+
+func (s *s3Store) PutReader(ctx context.Context, r io.Reader) (Key, error) {
+	// the key is only known once all the content is read, so it is spooled to disk first
+	f, key, err := spool("", r)
+	if err != nil {
+		return "", fmt.Errorf("failed to spool blob: %w", err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+	_, err = s.client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.name(key)),
+		Body:   f,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to put blob %s: %w", key, err)
+	}
+	return key, nil
+}
+
+func (s *s3Store) Get(ctx context.Context, key Key) ([]byte, error) {
+	return get(ctx, s, key)
+}
+
+func (s *s3Store) Open(ctx context.Context, key Key) (io.ReadCloser, error) {
+	if err := key.validate(); err != nil {
+		return nil, err
+	}
+	out, err := s.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.name(key)),
+	})
+	var aerr awserr.Error
+	if errors.As(err, &aerr) && aerr.Code() == s3.ErrCodeNoSuchKey {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get blob %s: %w", key, err)
+	}
+	return newVerifyingReader(key, out.Body), nil
+}
+
+func (s *s3Store) Delete(ctx context.Context, key Key) error {
+	if err := key.validate(); err != nil {
+		return err
+	}
+	// S3 does not report missing keys on delete
+	_, err := s.client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.name(key)),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete blob %s: %w", key, err)
+	}
+	return nil
+}
+
+func (s *s3Store) List(ctx context.Context) (objects []Object, err error) {
+	in := &s3.ListObjectsV2Input{Bucket: aws.String(s.bucket)}
+	if s.prefix != "" {
+		in.Prefix = aws.String(strings.TrimSuffix(s.prefix, "/") + "/")
+	}
+	err = s.client.ListObjectsV2PagesWithContext(ctx, in, func(page *s3.ListObjectsV2Output, _ bool) bool {
+		for _, o := range page.Contents {
+			key, perr := ParseKey(path.Base(aws.StringValue(o.Key)))
+			if perr != nil {
+				continue // not ours
+			}
+			objects = append(objects, Object{Key: key, Size: aws.Int64Value(o.Size), Updated: aws.TimeValue(o.LastModified)})
+		}
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list blobs: %w", err)
+	}
+	return
+}
+
+func (s *s3Store) Close() error { return nil }
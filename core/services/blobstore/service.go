@@ -0,0 +1,167 @@
+package blobstore
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+)
+
+var promBlobsCollected = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "blob_store_gc_deleted_total",
+	Help: "The total number of unreferenced artifacts deleted from the blob store",
+})
+
+// Referencer is implemented by the services which keep keys of stored artifacts, so that they are not garbage
+// collected while in use. Every service which puts artifacts must register one, see Service.
+type Referencer interface {
+	ReferencedBlobs(ctx context.Context) ([]Key, error)
+}
+
+// New returns the Store selected by cfg, or nil if the blob store is disabled.
+func New(cfg config.BlobStore) (Store, error) {
+	switch b := cfg.Backend(); b {
+	case "":
+		return nil, nil
+	case config.BlobStoreBackendLocal:
+		return NewLocalStore(cfg.Dir())
+	case config.BlobStoreBackendS3:
+		return NewS3Store(cfg.Bucket(), cfg.Prefix(), cfg.Region(), cfg.Endpoint())
+	case config.BlobStoreBackendGCS:
+		// the context is only used to look up the credentials, not by later requests
+		return NewGCSStore(context.Background(), cfg.Bucket(), cfg.Prefix())
+	default:
+		return nil, fmt.Errorf("unknown blob store backend: %s", b)
+	}
+}
+
+// Service is a Store which periodically deletes the artifacts that are older than MaxAge and not referenced by any
+// registered Referencer.
+//
+// The references of a service are unknown until it registers its Referencer, so each service using the store is a
+// dependent of the Service: it must be added with AddDependents when the Service is created, and call DependentReady
+// once its Referencer is registered. Garbage is only collected once all dependents are ready.
+type Service struct {
+	services.Service
+	eng *services.Engine
+	Store
+	utils.DependentAwaiter
+
+	maxAge   time.Duration
+	interval time.Duration
+
+	mu          sync.Mutex
+	referencers map[int]Referencer
+	lastID      int
+}
+
+func NewService(lggr logger.Logger, store Store, maxAge, interval time.Duration) *Service {
+	s := &Service{
+		Store:            store,
+		DependentAwaiter: utils.NewDependentAwaiter(),
+		maxAge:           maxAge,
+		interval:         interval,
+		referencers:      make(map[int]Referencer),
+	}
+	s.Service, s.eng = services.Config{
+		Name:  "BlobStore",
+		Start: s.start,
+		Close: s.close,
+	}.NewServiceEngine(lggr)
+	return s
+}
+
+func (s *Service) start(context.Context) error {
+	s.eng.Go(func(ctx context.Context) {
+		select {
+		case <-s.AwaitDependents():
+		case <-ctx.Done():
+			return
+		}
+		s.eng.GoTick(services.NewTicker(s.interval), s.collect)
+	})
+	return nil
+}
+
+func (s *Service) close() error {
+	return s.Store.Close()
+}
+
+// Close overrides the Close of the embedded Store, which is closed along with the Service.
+func (s *Service) Close() error {
+	return s.Service.Close()
+}
+
+// RegisterReferencer protects the artifacts referenced by r from garbage collection, until unregister is called.
+func (s *Service) RegisterReferencer(r Referencer) (unregister func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastID++
+	id := s.lastID
+	s.referencers[id] = r
+	return func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		delete(s.referencers, id)
+	}
+}
+
+func (s *Service) collect(ctx context.Context) {
+	deleted, err := s.CollectGarbage(ctx)
+	if err != nil {
+		s.eng.Errorw("Failed to collect garbage", "err", err)
+		return
+	}
+	if deleted > 0 {
+		s.eng.Infow("Deleted unreferenced artifacts", "count", deleted)
+	}
+}
+
+// CollectGarbage deletes the artifacts which are older than MaxAge and not referenced, and returns how many were
+// deleted. Nothing is deleted if any Referencer fails, since its references are unknown.
+func (s *Service) CollectGarbage(ctx context.Context) (deleted int, err error) {
+	s.mu.Lock()
+	referencers := make([]Referencer, 0, len(s.referencers))
+	for _, r := range s.referencers {
+		referencers = append(referencers, r)
+	}
+	s.mu.Unlock()
+
+	// list before collecting the references, so that artifacts put in between are not old enough to be deleted
+	objects, err := s.List(ctx)
+	if err != nil {
+		return 0, err
+	}
+	referenced := make(map[Key]struct{})
+	for _, r := range referencers {
+		keys, err := r.ReferencedBlobs(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to collect references: %w", err)
+		}
+		for _, k := range keys {
+			referenced[k] = struct{}{}
+		}
+	}
+
+	cutoff := time.Now().Add(-s.maxAge)
+	for _, o := range objects {
+		if _, ok := referenced[o.Key]; ok || o.Updated.After(cutoff) {
+			continue
+		}
+		if err := s.Delete(ctx, o.Key); err != nil {
+			return deleted, err
+		}
+		deleted++
+		promBlobsCollected.Inc()
+	}
+	return deleted, nil
+}
@@ -0,0 +1,71 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+type referencerFunc func(ctx context.Context) ([]Key, error)
+
+func (f referencerFunc) ReferencedBlobs(ctx context.Context) ([]Key, error) { return f(ctx) }
+
+func TestService_CollectGarbage(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	store, err := NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+	s := NewService(logger.TestLogger(t), store, time.Hour, time.Hour)
+
+	put := func(data string, age time.Duration) Key {
+		key, err := s.Put(ctx, []byte(data))
+		require.NoError(t, err)
+		updated := time.Now().Add(-age)
+		p, err := store.(*localStore).path(key)
+		require.NoError(t, err)
+		require.NoError(t, os.Chtimes(p, updated, updated))
+		return key
+	}
+	fresh := put("fresh", time.Minute)
+	referenced := put("referenced", 2*time.Hour)
+	unreferenced := put("unreferenced", 2*time.Hour)
+
+	unregister := s.RegisterReferencer(referencerFunc(func(context.Context) ([]Key, error) {
+		return []Key{referenced}, nil
+	}))
+
+	t.Run("failing referencer", func(t *testing.T) {
+		unregisterFailing := s.RegisterReferencer(referencerFunc(func(context.Context) ([]Key, error) {
+			return nil, errors.New("db down")
+		}))
+		defer unregisterFailing()
+		deleted, err := s.CollectGarbage(ctx)
+		require.ErrorContains(t, err, "db down")
+		assert.Zero(t, deleted)
+	})
+
+	deleted, err := s.CollectGarbage(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+	_, err = s.Get(ctx, unreferenced)
+	require.ErrorIs(t, err, ErrNotFound)
+	for _, key := range []Key{fresh, referenced} {
+		_, err = s.Get(ctx, key)
+		require.NoError(t, err)
+	}
+
+	unregister()
+	deleted, err = s.CollectGarbage(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+	_, err = s.Get(ctx, referenced)
+	require.ErrorIs(t, err, ErrNotFound)
+}
@@ -0,0 +1,122 @@
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"time"
+)
+
+// ErrNotFound is returned by Store.Get for unknown keys.
+var ErrNotFound = errors.New("blob not found")
+
+// Key identifies an artifact by the hex encoded sha256 hash of its content.
+type Key string
+
+// KeyOf returns the Key of data.
+func KeyOf(data []byte) Key {
+	sum := sha256.Sum256(data)
+	return Key(hex.EncodeToString(sum[:]))
+}
+
+// ParseKey validates s as a Key.
+func ParseKey(s string) (Key, error) {
+	b, err := hex.DecodeString(s)
+	if err != nil || len(b) != sha256.Size {
+		return "", fmt.Errorf("invalid blob key %q: must be a hex encoded sha256 hash", s)
+	}
+	return Key(s), nil
+}
+
+// Object describes a stored artifact.
+type Object struct {
+	Key  Key
+	Size int64
+	// Updated is the time the artifact was last put.
+	Updated time.Time
+}
+
+// Store persists large artifacts by the hash of their content, so that identical artifacts are stored once.
+type Store interface {
+	// Put stores data and returns its Key. Putting an existing artifact again only refreshes its Updated time.
+	Put(ctx context.Context, data []byte) (Key, error)
+	// PutReader stores the content read from r until EOF and returns its Key, without holding it in memory.
+	PutReader(ctx context.Context, r io.Reader) (Key, error)
+	// Get returns the artifact stored with key, or ErrNotFound.
+	Get(ctx context.Context, key Key) ([]byte, error)
+	// Open returns a reader of the artifact stored with key, or ErrNotFound. The reader fails at EOF if the content
+	// does not match key.
+	Open(ctx context.Context, key Key) (io.ReadCloser, error)
+	// Delete removes the artifact stored with key. Deleting an unknown key is not an error.
+	Delete(ctx context.Context, key Key) error
+	// List returns all stored artifacts.
+	List(ctx context.Context) ([]Object, error)
+	Close() error
+}
+
+// validate checks that k is a Key before it is used to name a stored artifact.
+func (k Key) validate() error {
+	_, err := ParseKey(string(k))
+	return err
+}
+
+func put(ctx context.Context, s Store, data []byte) (Key, error) {
+	return s.PutReader(ctx, bytes.NewReader(data))
+}
+
+func get(ctx context.Context, s Store, key Key) ([]byte, error) {
+	r, err := s.Open(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+// spool copies r to a temporary file in dir while hashing it, so that content of unknown size and key can be stored
+// without holding it in memory. The returned file is positioned at its start, and must be closed and removed by the
+// caller.
+func spool(dir string, r io.Reader) (*os.File, Key, error) {
+	f, err := os.CreateTemp(dir, tmpPrefix+"*")
+	if err != nil {
+		return nil, "", err
+	}
+	h := sha256.New()
+	if _, err = io.Copy(io.MultiWriter(f, h), r); err == nil {
+		_, err = f.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return nil, "", err
+	}
+	return f, Key(hex.EncodeToString(h.Sum(nil))), nil
+}
+
+// verifyingReader checks that the content read from a backend still matches its key, once it is read to the end.
+type verifyingReader struct {
+	io.ReadCloser
+	key  Key
+	hash hash.Hash
+}
+
+func newVerifyingReader(key Key, r io.ReadCloser) *verifyingReader {
+	return &verifyingReader{ReadCloser: r, key: key, hash: sha256.New()}
+}
+
+func (r *verifyingReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.hash.Write(p[:n])
+	if errors.Is(err, io.EOF) {
+		if got := Key(hex.EncodeToString(r.hash.Sum(nil))); got != r.key {
+			return n, fmt.Errorf("blob %s is corrupted: content hashes to %s", r.key, got)
+		}
+	}
+	return n, err
+}
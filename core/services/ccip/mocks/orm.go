@@ -10,6 +10,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	time "time"
+
+	uuid "github.com/google/uuid"
 )
 
 // ORM is an autogenerated mock type for the ORM type
@@ -25,6 +27,241 @@ func (_m *ORM) EXPECT() *ORM_Expecter {
 	return &ORM_Expecter{mock: &_m.Mock}
 }
 
+// ClearStalePricesForDestChain provides a mock function with given fields: ctx, destChainSelector, interval, expireThreshold
+func (_m *ORM) ClearStalePricesForDestChain(ctx context.Context, destChainSelector uint64, interval time.Duration, expireThreshold time.Duration) (ccip.PriceCleanup, error) {
+	ret := _m.Called(ctx, destChainSelector, interval, expireThreshold)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClearStalePricesForDestChain")
+	}
+
+	var r0 ccip.PriceCleanup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Duration, time.Duration) (ccip.PriceCleanup, error)); ok {
+		return rf(ctx, destChainSelector, interval, expireThreshold)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Duration, time.Duration) ccip.PriceCleanup); ok {
+		r0 = rf(ctx, destChainSelector, interval, expireThreshold)
+	} else {
+		r0 = ret.Get(0).(ccip.PriceCleanup)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, time.Duration, time.Duration) error); ok {
+		r1 = rf(ctx, destChainSelector, interval, expireThreshold)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_ClearStalePricesForDestChain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClearStalePricesForDestChain'
+type ORM_ClearStalePricesForDestChain_Call struct {
+	*mock.Call
+}
+
+// ClearStalePricesForDestChain is a helper method to define mock.On call
+//   - ctx context.Context
+//   - destChainSelector uint64
+//   - interval time.Duration
+//   - expireThreshold time.Duration
+func (_e *ORM_Expecter) ClearStalePricesForDestChain(ctx interface{}, destChainSelector interface{}, interval interface{}, expireThreshold interface{}) *ORM_ClearStalePricesForDestChain_Call {
+	return &ORM_ClearStalePricesForDestChain_Call{Call: _e.mock.On("ClearStalePricesForDestChain", ctx, destChainSelector, interval, expireThreshold)}
+}
+
+func (_c *ORM_ClearStalePricesForDestChain_Call) Run(run func(ctx context.Context, destChainSelector uint64, interval time.Duration, expireThreshold time.Duration)) *ORM_ClearStalePricesForDestChain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(time.Duration), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *ORM_ClearStalePricesForDestChain_Call) Return(_a0 ccip.PriceCleanup, _a1 error) *ORM_ClearStalePricesForDestChain_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_ClearStalePricesForDestChain_Call) RunAndReturn(run func(context.Context, uint64, time.Duration, time.Duration) (ccip.PriceCleanup, error)) *ORM_ClearStalePricesForDestChain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteExpiredExecInflightReports provides a mock function with given fields: ctx, destChainSelector
+func (_m *ORM) DeleteExpiredExecInflightReports(ctx context.Context, destChainSelector uint64) (int64, error) {
+	ret := _m.Called(ctx, destChainSelector)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpiredExecInflightReports")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (int64, error)); ok {
+		return rf(ctx, destChainSelector)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) int64); ok {
+		r0 = rf(ctx, destChainSelector)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, destChainSelector)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_DeleteExpiredExecInflightReports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpiredExecInflightReports'
+type ORM_DeleteExpiredExecInflightReports_Call struct {
+	*mock.Call
+}
+
+// DeleteExpiredExecInflightReports is a helper method to define mock.On call
+//   - ctx context.Context
+//   - destChainSelector uint64
+func (_e *ORM_Expecter) DeleteExpiredExecInflightReports(ctx interface{}, destChainSelector interface{}) *ORM_DeleteExpiredExecInflightReports_Call {
+	return &ORM_DeleteExpiredExecInflightReports_Call{Call: _e.mock.On("DeleteExpiredExecInflightReports", ctx, destChainSelector)}
+}
+
+func (_c *ORM_DeleteExpiredExecInflightReports_Call) Run(run func(ctx context.Context, destChainSelector uint64)) *ORM_DeleteExpiredExecInflightReports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *ORM_DeleteExpiredExecInflightReports_Call) Return(_a0 int64, _a1 error) *ORM_DeleteExpiredExecInflightReports_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_DeleteExpiredExecInflightReports_Call) RunAndReturn(run func(context.Context, uint64) (int64, error)) *ORM_DeleteExpiredExecInflightReports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteMessageExecGasBefore provides a mock function with given fields: ctx, destChainSelector, offRampAddr, before
+func (_m *ORM) DeleteMessageExecGasBefore(ctx context.Context, destChainSelector uint64, offRampAddr string, before time.Time) (int64, error) {
+	ret := _m.Called(ctx, destChainSelector, offRampAddr, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMessageExecGasBefore")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string, time.Time) (int64, error)); ok {
+		return rf(ctx, destChainSelector, offRampAddr, before)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string, time.Time) int64); ok {
+		r0 = rf(ctx, destChainSelector, offRampAddr, before)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, string, time.Time) error); ok {
+		r1 = rf(ctx, destChainSelector, offRampAddr, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_DeleteMessageExecGasBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMessageExecGasBefore'
+type ORM_DeleteMessageExecGasBefore_Call struct {
+	*mock.Call
+}
+
+// DeleteMessageExecGasBefore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - destChainSelector uint64
+//   - offRampAddr string
+//   - before time.Time
+func (_e *ORM_Expecter) DeleteMessageExecGasBefore(ctx interface{}, destChainSelector interface{}, offRampAddr interface{}, before interface{}) *ORM_DeleteMessageExecGasBefore_Call {
+	return &ORM_DeleteMessageExecGasBefore_Call{Call: _e.mock.On("DeleteMessageExecGasBefore", ctx, destChainSelector, offRampAddr, before)}
+}
+
+func (_c *ORM_DeleteMessageExecGasBefore_Call) Run(run func(ctx context.Context, destChainSelector uint64, offRampAddr string, before time.Time)) *ORM_DeleteMessageExecGasBefore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(string), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *ORM_DeleteMessageExecGasBefore_Call) Return(_a0 int64, _a1 error) *ORM_DeleteMessageExecGasBefore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_DeleteMessageExecGasBefore_Call) RunAndReturn(run func(context.Context, uint64, string, time.Time) (int64, error)) *ORM_DeleteMessageExecGasBefore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetExecInflightReports provides a mock function with given fields: ctx, destChainSelector, offRampAddr
+func (_m *ORM) GetExecInflightReports(ctx context.Context, destChainSelector uint64, offRampAddr string) ([]ccip.ExecInflightReport, error) {
+	ret := _m.Called(ctx, destChainSelector, offRampAddr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExecInflightReports")
+	}
+
+	var r0 []ccip.ExecInflightReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string) ([]ccip.ExecInflightReport, error)); ok {
+		return rf(ctx, destChainSelector, offRampAddr)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string) []ccip.ExecInflightReport); ok {
+		r0 = rf(ctx, destChainSelector, offRampAddr)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ccip.ExecInflightReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, string) error); ok {
+		r1 = rf(ctx, destChainSelector, offRampAddr)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_GetExecInflightReports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExecInflightReports'
+type ORM_GetExecInflightReports_Call struct {
+	*mock.Call
+}
+
+// GetExecInflightReports is a helper method to define mock.On call
+//   - ctx context.Context
+//   - destChainSelector uint64
+//   - offRampAddr string
+func (_e *ORM_Expecter) GetExecInflightReports(ctx interface{}, destChainSelector interface{}, offRampAddr interface{}) *ORM_GetExecInflightReports_Call {
+	return &ORM_GetExecInflightReports_Call{Call: _e.mock.On("GetExecInflightReports", ctx, destChainSelector, offRampAddr)}
+}
+
+func (_c *ORM_GetExecInflightReports_Call) Run(run func(ctx context.Context, destChainSelector uint64, offRampAddr string)) *ORM_GetExecInflightReports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ORM_GetExecInflightReports_Call) Return(_a0 []ccip.ExecInflightReport, _a1 error) *ORM_GetExecInflightReports_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_GetExecInflightReports_Call) RunAndReturn(run func(context.Context, uint64, string) ([]ccip.ExecInflightReport, error)) *ORM_GetExecInflightReports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetGasPricesByDestChain provides a mock function with given fields: ctx, destChainSelector
 func (_m *ORM) GetGasPricesByDestChain(ctx context.Context, destChainSelector uint64) ([]ccip.GasPrice, error) {
 	ret := _m.Called(ctx, destChainSelector)
@@ -84,6 +321,243 @@ func (_c *ORM_GetGasPricesByDestChain_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// GetMessageExecGas provides a mock function with given fields: ctx, destChainSelector, offRampAddr, minSeqNum, maxSeqNum
+func (_m *ORM) GetMessageExecGas(ctx context.Context, destChainSelector uint64, offRampAddr string, minSeqNum uint64, maxSeqNum uint64) ([]ccip.MessageExecGas, error) {
+	ret := _m.Called(ctx, destChainSelector, offRampAddr, minSeqNum, maxSeqNum)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMessageExecGas")
+	}
+
+	var r0 []ccip.MessageExecGas
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string, uint64, uint64) ([]ccip.MessageExecGas, error)); ok {
+		return rf(ctx, destChainSelector, offRampAddr, minSeqNum, maxSeqNum)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string, uint64, uint64) []ccip.MessageExecGas); ok {
+		r0 = rf(ctx, destChainSelector, offRampAddr, minSeqNum, maxSeqNum)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ccip.MessageExecGas)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, string, uint64, uint64) error); ok {
+		r1 = rf(ctx, destChainSelector, offRampAddr, minSeqNum, maxSeqNum)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_GetMessageExecGas_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMessageExecGas'
+type ORM_GetMessageExecGas_Call struct {
+	*mock.Call
+}
+
+// GetMessageExecGas is a helper method to define mock.On call
+//   - ctx context.Context
+//   - destChainSelector uint64
+//   - offRampAddr string
+//   - minSeqNum uint64
+//   - maxSeqNum uint64
+func (_e *ORM_Expecter) GetMessageExecGas(ctx interface{}, destChainSelector interface{}, offRampAddr interface{}, minSeqNum interface{}, maxSeqNum interface{}) *ORM_GetMessageExecGas_Call {
+	return &ORM_GetMessageExecGas_Call{Call: _e.mock.On("GetMessageExecGas", ctx, destChainSelector, offRampAddr, minSeqNum, maxSeqNum)}
+}
+
+func (_c *ORM_GetMessageExecGas_Call) Run(run func(ctx context.Context, destChainSelector uint64, offRampAddr string, minSeqNum uint64, maxSeqNum uint64)) *ORM_GetMessageExecGas_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(string), args[3].(uint64), args[4].(uint64))
+	})
+	return _c
+}
+
+func (_c *ORM_GetMessageExecGas_Call) Return(_a0 []ccip.MessageExecGas, _a1 error) *ORM_GetMessageExecGas_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_GetMessageExecGas_Call) RunAndReturn(run func(context.Context, uint64, string, uint64, uint64) ([]ccip.MessageExecGas, error)) *ORM_GetMessageExecGas_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMessageExecGasCursor provides a mock function with given fields: ctx, destChainSelector, offRampAddr
+func (_m *ORM) GetMessageExecGasCursor(ctx context.Context, destChainSelector uint64, offRampAddr string) (uint64, error) {
+	ret := _m.Called(ctx, destChainSelector, offRampAddr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMessageExecGasCursor")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string) (uint64, error)); ok {
+		return rf(ctx, destChainSelector, offRampAddr)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string) uint64); ok {
+		r0 = rf(ctx, destChainSelector, offRampAddr)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, string) error); ok {
+		r1 = rf(ctx, destChainSelector, offRampAddr)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_GetMessageExecGasCursor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMessageExecGasCursor'
+type ORM_GetMessageExecGasCursor_Call struct {
+	*mock.Call
+}
+
+// GetMessageExecGasCursor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - destChainSelector uint64
+//   - offRampAddr string
+func (_e *ORM_Expecter) GetMessageExecGasCursor(ctx interface{}, destChainSelector interface{}, offRampAddr interface{}) *ORM_GetMessageExecGasCursor_Call {
+	return &ORM_GetMessageExecGasCursor_Call{Call: _e.mock.On("GetMessageExecGasCursor", ctx, destChainSelector, offRampAddr)}
+}
+
+func (_c *ORM_GetMessageExecGasCursor_Call) Run(run func(ctx context.Context, destChainSelector uint64, offRampAddr string)) *ORM_GetMessageExecGasCursor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ORM_GetMessageExecGasCursor_Call) Return(_a0 uint64, _a1 error) *ORM_GetMessageExecGasCursor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_GetMessageExecGasCursor_Call) RunAndReturn(run func(context.Context, uint64, string) (uint64, error)) *ORM_GetMessageExecGasCursor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPriceUpdateTimes provides a mock function with given fields: ctx, destChainSelector, sourceChainSelector
+func (_m *ORM) GetPriceUpdateTimes(ctx context.Context, destChainSelector uint64, sourceChainSelector uint64) (ccip.PriceUpdateTimes, error) {
+	ret := _m.Called(ctx, destChainSelector, sourceChainSelector)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriceUpdateTimes")
+	}
+
+	var r0 ccip.PriceUpdateTimes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) (ccip.PriceUpdateTimes, error)); ok {
+		return rf(ctx, destChainSelector, sourceChainSelector)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) ccip.PriceUpdateTimes); ok {
+		r0 = rf(ctx, destChainSelector, sourceChainSelector)
+	} else {
+		r0 = ret.Get(0).(ccip.PriceUpdateTimes)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64) error); ok {
+		r1 = rf(ctx, destChainSelector, sourceChainSelector)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_GetPriceUpdateTimes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPriceUpdateTimes'
+type ORM_GetPriceUpdateTimes_Call struct {
+	*mock.Call
+}
+
+// GetPriceUpdateTimes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - destChainSelector uint64
+//   - sourceChainSelector uint64
+func (_e *ORM_Expecter) GetPriceUpdateTimes(ctx interface{}, destChainSelector interface{}, sourceChainSelector interface{}) *ORM_GetPriceUpdateTimes_Call {
+	return &ORM_GetPriceUpdateTimes_Call{Call: _e.mock.On("GetPriceUpdateTimes", ctx, destChainSelector, sourceChainSelector)}
+}
+
+func (_c *ORM_GetPriceUpdateTimes_Call) Run(run func(ctx context.Context, destChainSelector uint64, sourceChainSelector uint64)) *ORM_GetPriceUpdateTimes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *ORM_GetPriceUpdateTimes_Call) Return(_a0 ccip.PriceUpdateTimes, _a1 error) *ORM_GetPriceUpdateTimes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_GetPriceUpdateTimes_Call) RunAndReturn(run func(context.Context, uint64, uint64) (ccip.PriceUpdateTimes, error)) *ORM_GetPriceUpdateTimes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTokenPoolLiquidity provides a mock function with given fields: ctx, destChainSelector
+func (_m *ORM) GetTokenPoolLiquidity(ctx context.Context, destChainSelector uint64) ([]ccip.TokenPoolLiquidity, error) {
+	ret := _m.Called(ctx, destChainSelector)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTokenPoolLiquidity")
+	}
+
+	var r0 []ccip.TokenPoolLiquidity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]ccip.TokenPoolLiquidity, error)); ok {
+		return rf(ctx, destChainSelector)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []ccip.TokenPoolLiquidity); ok {
+		r0 = rf(ctx, destChainSelector)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ccip.TokenPoolLiquidity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, destChainSelector)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_GetTokenPoolLiquidity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTokenPoolLiquidity'
+type ORM_GetTokenPoolLiquidity_Call struct {
+	*mock.Call
+}
+
+// GetTokenPoolLiquidity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - destChainSelector uint64
+func (_e *ORM_Expecter) GetTokenPoolLiquidity(ctx interface{}, destChainSelector interface{}) *ORM_GetTokenPoolLiquidity_Call {
+	return &ORM_GetTokenPoolLiquidity_Call{Call: _e.mock.On("GetTokenPoolLiquidity", ctx, destChainSelector)}
+}
+
+func (_c *ORM_GetTokenPoolLiquidity_Call) Run(run func(ctx context.Context, destChainSelector uint64)) *ORM_GetTokenPoolLiquidity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *ORM_GetTokenPoolLiquidity_Call) Return(_a0 []ccip.TokenPoolLiquidity, _a1 error) *ORM_GetTokenPoolLiquidity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_GetTokenPoolLiquidity_Call) RunAndReturn(run func(context.Context, uint64) ([]ccip.TokenPoolLiquidity, error)) *ORM_GetTokenPoolLiquidity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTokenPricesByDestChain provides a mock function with given fields: ctx, destChainSelector
 func (_m *ORM) GetTokenPricesByDestChain(ctx context.Context, destChainSelector uint64) ([]ccip.TokenPrice, error) {
 	ret := _m.Called(ctx, destChainSelector)
@@ -143,6 +617,233 @@ func (_c *ORM_GetTokenPricesByDestChain_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// InsertMessageExecGas provides a mock function with given fields: ctx, destChainSelector, offRampAddr, nextBlock, gas
+func (_m *ORM) InsertMessageExecGas(ctx context.Context, destChainSelector uint64, offRampAddr string, nextBlock uint64, gas []ccip.MessageExecGas) (int64, error) {
+	ret := _m.Called(ctx, destChainSelector, offRampAddr, nextBlock, gas)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertMessageExecGas")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string, uint64, []ccip.MessageExecGas) (int64, error)); ok {
+		return rf(ctx, destChainSelector, offRampAddr, nextBlock, gas)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string, uint64, []ccip.MessageExecGas) int64); ok {
+		r0 = rf(ctx, destChainSelector, offRampAddr, nextBlock, gas)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, string, uint64, []ccip.MessageExecGas) error); ok {
+		r1 = rf(ctx, destChainSelector, offRampAddr, nextBlock, gas)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_InsertMessageExecGas_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertMessageExecGas'
+type ORM_InsertMessageExecGas_Call struct {
+	*mock.Call
+}
+
+// InsertMessageExecGas is a helper method to define mock.On call
+//   - ctx context.Context
+//   - destChainSelector uint64
+//   - offRampAddr string
+//   - nextBlock uint64
+//   - gas []ccip.MessageExecGas
+func (_e *ORM_Expecter) InsertMessageExecGas(ctx interface{}, destChainSelector interface{}, offRampAddr interface{}, nextBlock interface{}, gas interface{}) *ORM_InsertMessageExecGas_Call {
+	return &ORM_InsertMessageExecGas_Call{Call: _e.mock.On("InsertMessageExecGas", ctx, destChainSelector, offRampAddr, nextBlock, gas)}
+}
+
+func (_c *ORM_InsertMessageExecGas_Call) Run(run func(ctx context.Context, destChainSelector uint64, offRampAddr string, nextBlock uint64, gas []ccip.MessageExecGas)) *ORM_InsertMessageExecGas_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(string), args[3].(uint64), args[4].([]ccip.MessageExecGas))
+	})
+	return _c
+}
+
+func (_c *ORM_InsertMessageExecGas_Call) Return(_a0 int64, _a1 error) *ORM_InsertMessageExecGas_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_InsertMessageExecGas_Call) RunAndReturn(run func(context.Context, uint64, string, uint64, []ccip.MessageExecGas) (int64, error)) *ORM_InsertMessageExecGas_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleasePriceServiceLeader provides a mock function with given fields: ctx, jobID, destChainSelector, owner
+func (_m *ORM) ReleasePriceServiceLeader(ctx context.Context, jobID int32, destChainSelector uint64, owner uuid.UUID) error {
+	ret := _m.Called(ctx, jobID, destChainSelector, owner)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleasePriceServiceLeader")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, uint64, uuid.UUID) error); ok {
+		r0 = rf(ctx, jobID, destChainSelector, owner)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ORM_ReleasePriceServiceLeader_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleasePriceServiceLeader'
+type ORM_ReleasePriceServiceLeader_Call struct {
+	*mock.Call
+}
+
+// ReleasePriceServiceLeader is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+//   - destChainSelector uint64
+//   - owner uuid.UUID
+func (_e *ORM_Expecter) ReleasePriceServiceLeader(ctx interface{}, jobID interface{}, destChainSelector interface{}, owner interface{}) *ORM_ReleasePriceServiceLeader_Call {
+	return &ORM_ReleasePriceServiceLeader_Call{Call: _e.mock.On("ReleasePriceServiceLeader", ctx, jobID, destChainSelector, owner)}
+}
+
+func (_c *ORM_ReleasePriceServiceLeader_Call) Run(run func(ctx context.Context, jobID int32, destChainSelector uint64, owner uuid.UUID)) *ORM_ReleasePriceServiceLeader_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32), args[2].(uint64), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ORM_ReleasePriceServiceLeader_Call) Return(_a0 error) *ORM_ReleasePriceServiceLeader_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ORM_ReleasePriceServiceLeader_Call) RunAndReturn(run func(context.Context, int32, uint64, uuid.UUID) error) *ORM_ReleasePriceServiceLeader_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TryAcquirePriceServiceLeader provides a mock function with given fields: ctx, jobID, destChainSelector, owner, lease
+func (_m *ORM) TryAcquirePriceServiceLeader(ctx context.Context, jobID int32, destChainSelector uint64, owner uuid.UUID, lease time.Duration) (bool, error) {
+	ret := _m.Called(ctx, jobID, destChainSelector, owner, lease)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TryAcquirePriceServiceLeader")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, uint64, uuid.UUID, time.Duration) (bool, error)); ok {
+		return rf(ctx, jobID, destChainSelector, owner, lease)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32, uint64, uuid.UUID, time.Duration) bool); ok {
+		r0 = rf(ctx, jobID, destChainSelector, owner, lease)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32, uint64, uuid.UUID, time.Duration) error); ok {
+		r1 = rf(ctx, jobID, destChainSelector, owner, lease)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_TryAcquirePriceServiceLeader_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TryAcquirePriceServiceLeader'
+type ORM_TryAcquirePriceServiceLeader_Call struct {
+	*mock.Call
+}
+
+// TryAcquirePriceServiceLeader is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+//   - destChainSelector uint64
+//   - owner uuid.UUID
+//   - lease time.Duration
+func (_e *ORM_Expecter) TryAcquirePriceServiceLeader(ctx interface{}, jobID interface{}, destChainSelector interface{}, owner interface{}, lease interface{}) *ORM_TryAcquirePriceServiceLeader_Call {
+	return &ORM_TryAcquirePriceServiceLeader_Call{Call: _e.mock.On("TryAcquirePriceServiceLeader", ctx, jobID, destChainSelector, owner, lease)}
+}
+
+func (_c *ORM_TryAcquirePriceServiceLeader_Call) Run(run func(ctx context.Context, jobID int32, destChainSelector uint64, owner uuid.UUID, lease time.Duration)) *ORM_TryAcquirePriceServiceLeader_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32), args[2].(uint64), args[3].(uuid.UUID), args[4].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *ORM_TryAcquirePriceServiceLeader_Call) Return(_a0 bool, _a1 error) *ORM_TryAcquirePriceServiceLeader_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_TryAcquirePriceServiceLeader_Call) RunAndReturn(run func(context.Context, int32, uint64, uuid.UUID, time.Duration) (bool, error)) *ORM_TryAcquirePriceServiceLeader_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertExecInflightReport provides a mock function with given fields: ctx, destChainSelector, report
+func (_m *ORM) UpsertExecInflightReport(ctx context.Context, destChainSelector uint64, report ccip.ExecInflightReport) (int64, error) {
+	ret := _m.Called(ctx, destChainSelector, report)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertExecInflightReport")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, ccip.ExecInflightReport) (int64, error)); ok {
+		return rf(ctx, destChainSelector, report)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, ccip.ExecInflightReport) int64); ok {
+		r0 = rf(ctx, destChainSelector, report)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, ccip.ExecInflightReport) error); ok {
+		r1 = rf(ctx, destChainSelector, report)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_UpsertExecInflightReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertExecInflightReport'
+type ORM_UpsertExecInflightReport_Call struct {
+	*mock.Call
+}
+
+// UpsertExecInflightReport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - destChainSelector uint64
+//   - report ccip.ExecInflightReport
+func (_e *ORM_Expecter) UpsertExecInflightReport(ctx interface{}, destChainSelector interface{}, report interface{}) *ORM_UpsertExecInflightReport_Call {
+	return &ORM_UpsertExecInflightReport_Call{Call: _e.mock.On("UpsertExecInflightReport", ctx, destChainSelector, report)}
+}
+
+func (_c *ORM_UpsertExecInflightReport_Call) Run(run func(ctx context.Context, destChainSelector uint64, report ccip.ExecInflightReport)) *ORM_UpsertExecInflightReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(ccip.ExecInflightReport))
+	})
+	return _c
+}
+
+func (_c *ORM_UpsertExecInflightReport_Call) Return(_a0 int64, _a1 error) *ORM_UpsertExecInflightReport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_UpsertExecInflightReport_Call) RunAndReturn(run func(context.Context, uint64, ccip.ExecInflightReport) (int64, error)) *ORM_UpsertExecInflightReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpsertGasPricesForDestChain provides a mock function with given fields: ctx, destChainSelector, gasPrices
 func (_m *ORM) UpsertGasPricesForDestChain(ctx context.Context, destChainSelector uint64, gasPrices []ccip.GasPrice) (int64, error) {
 	ret := _m.Called(ctx, destChainSelector, gasPrices)
@@ -201,6 +902,64 @@ func (_c *ORM_UpsertGasPricesForDestChain_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// UpsertTokenPoolLiquidity provides a mock function with given fields: ctx, destChainSelector, liquidity
+func (_m *ORM) UpsertTokenPoolLiquidity(ctx context.Context, destChainSelector uint64, liquidity []ccip.TokenPoolLiquidity) (int64, error) {
+	ret := _m.Called(ctx, destChainSelector, liquidity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertTokenPoolLiquidity")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, []ccip.TokenPoolLiquidity) (int64, error)); ok {
+		return rf(ctx, destChainSelector, liquidity)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, []ccip.TokenPoolLiquidity) int64); ok {
+		r0 = rf(ctx, destChainSelector, liquidity)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, []ccip.TokenPoolLiquidity) error); ok {
+		r1 = rf(ctx, destChainSelector, liquidity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_UpsertTokenPoolLiquidity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertTokenPoolLiquidity'
+type ORM_UpsertTokenPoolLiquidity_Call struct {
+	*mock.Call
+}
+
+// UpsertTokenPoolLiquidity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - destChainSelector uint64
+//   - liquidity []ccip.TokenPoolLiquidity
+func (_e *ORM_Expecter) UpsertTokenPoolLiquidity(ctx interface{}, destChainSelector interface{}, liquidity interface{}) *ORM_UpsertTokenPoolLiquidity_Call {
+	return &ORM_UpsertTokenPoolLiquidity_Call{Call: _e.mock.On("UpsertTokenPoolLiquidity", ctx, destChainSelector, liquidity)}
+}
+
+func (_c *ORM_UpsertTokenPoolLiquidity_Call) Run(run func(ctx context.Context, destChainSelector uint64, liquidity []ccip.TokenPoolLiquidity)) *ORM_UpsertTokenPoolLiquidity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].([]ccip.TokenPoolLiquidity))
+	})
+	return _c
+}
+
+func (_c *ORM_UpsertTokenPoolLiquidity_Call) Return(_a0 int64, _a1 error) *ORM_UpsertTokenPoolLiquidity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_UpsertTokenPoolLiquidity_Call) RunAndReturn(run func(context.Context, uint64, []ccip.TokenPoolLiquidity) (int64, error)) *ORM_UpsertTokenPoolLiquidity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpsertTokenPricesForDestChain provides a mock function with given fields: ctx, destChainSelector, tokenPrices, interval
 func (_m *ORM) UpsertTokenPricesForDestChain(ctx context.Context, destChainSelector uint64, tokenPrices []ccip.TokenPrice, interval time.Duration) (int64, error) {
 	ret := _m.Called(ctx, destChainSelector, tokenPrices, interval)
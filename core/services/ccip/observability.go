@@ -7,6 +7,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 
+	"github.com/google/uuid"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 
@@ -72,6 +73,12 @@ func (o *observedORM) GetTokenPricesByDestChain(ctx context.Context, destChainSe
 	})
 }
 
+func (o *observedORM) GetPriceUpdateTimes(ctx context.Context, destChainSelector uint64, sourceChainSelector uint64) (PriceUpdateTimes, error) {
+	return withObservedQuery(o, "GetPriceUpdateTimes", destChainSelector, func() (PriceUpdateTimes, error) {
+		return o.ORM.GetPriceUpdateTimes(ctx, destChainSelector, sourceChainSelector)
+	})
+}
+
 func (o *observedORM) UpsertGasPricesForDestChain(ctx context.Context, destChainSelector uint64, gasPrices []GasPrice) (int64, error) {
 	return withObservedQueryAndRowsAffected(o, "UpsertGasPricesForDestChain", destChainSelector, func() (int64, error) {
 		return o.ORM.UpsertGasPricesForDestChain(ctx, destChainSelector, gasPrices)
@@ -84,6 +91,79 @@ func (o *observedORM) UpsertTokenPricesForDestChain(ctx context.Context, destCha
 	})
 }
 
+func (o *observedORM) ClearStalePricesForDestChain(ctx context.Context, destChainSelector uint64, interval, expireThreshold time.Duration) (PriceCleanup, error) {
+	return withObservedQuery(o, "ClearStalePricesForDestChain", destChainSelector, func() (PriceCleanup, error) {
+		return o.ORM.ClearStalePricesForDestChain(ctx, destChainSelector, interval, expireThreshold)
+	})
+}
+
+func (o *observedORM) GetTokenPoolLiquidity(ctx context.Context, destChainSelector uint64) ([]TokenPoolLiquidity, error) {
+	return withObservedQueryAndResults(o, "GetTokenPoolLiquidity", destChainSelector, func() ([]TokenPoolLiquidity, error) {
+		return o.ORM.GetTokenPoolLiquidity(ctx, destChainSelector)
+	})
+}
+
+func (o *observedORM) UpsertTokenPoolLiquidity(ctx context.Context, destChainSelector uint64, liquidity []TokenPoolLiquidity) (int64, error) {
+	return withObservedQueryAndRowsAffected(o, "UpsertTokenPoolLiquidity", destChainSelector, func() (int64, error) {
+		return o.ORM.UpsertTokenPoolLiquidity(ctx, destChainSelector, liquidity)
+	})
+}
+
+func (o *observedORM) GetExecInflightReports(ctx context.Context, destChainSelector uint64, offRampAddr string) ([]ExecInflightReport, error) {
+	return withObservedQueryAndResults(o, "GetExecInflightReports", destChainSelector, func() ([]ExecInflightReport, error) {
+		return o.ORM.GetExecInflightReports(ctx, destChainSelector, offRampAddr)
+	})
+}
+
+func (o *observedORM) UpsertExecInflightReport(ctx context.Context, destChainSelector uint64, report ExecInflightReport) (int64, error) {
+	return withObservedQueryAndRowsAffected(o, "UpsertExecInflightReport", destChainSelector, func() (int64, error) {
+		return o.ORM.UpsertExecInflightReport(ctx, destChainSelector, report)
+	})
+}
+
+func (o *observedORM) DeleteExpiredExecInflightReports(ctx context.Context, destChainSelector uint64) (int64, error) {
+	return withObservedQueryAndRowsAffected(o, "DeleteExpiredExecInflightReports", destChainSelector, func() (int64, error) {
+		return o.ORM.DeleteExpiredExecInflightReports(ctx, destChainSelector)
+	})
+}
+
+func (o *observedORM) GetMessageExecGas(ctx context.Context, destChainSelector uint64, offRampAddr string, minSeqNum, maxSeqNum uint64) ([]MessageExecGas, error) {
+	return withObservedQueryAndResults(o, "GetMessageExecGas", destChainSelector, func() ([]MessageExecGas, error) {
+		return o.ORM.GetMessageExecGas(ctx, destChainSelector, offRampAddr, minSeqNum, maxSeqNum)
+	})
+}
+
+func (o *observedORM) GetMessageExecGasCursor(ctx context.Context, destChainSelector uint64, offRampAddr string) (uint64, error) {
+	return withObservedQuery(o, "GetMessageExecGasCursor", destChainSelector, func() (uint64, error) {
+		return o.ORM.GetMessageExecGasCursor(ctx, destChainSelector, offRampAddr)
+	})
+}
+
+func (o *observedORM) InsertMessageExecGas(ctx context.Context, destChainSelector uint64, offRampAddr string, nextBlock uint64, gas []MessageExecGas) (int64, error) {
+	return withObservedQueryAndRowsAffected(o, "InsertMessageExecGas", destChainSelector, func() (int64, error) {
+		return o.ORM.InsertMessageExecGas(ctx, destChainSelector, offRampAddr, nextBlock, gas)
+	})
+}
+
+func (o *observedORM) DeleteMessageExecGasBefore(ctx context.Context, destChainSelector uint64, offRampAddr string, before time.Time) (int64, error) {
+	return withObservedQueryAndRowsAffected(o, "DeleteMessageExecGasBefore", destChainSelector, func() (int64, error) {
+		return o.ORM.DeleteMessageExecGasBefore(ctx, destChainSelector, offRampAddr, before)
+	})
+}
+
+func (o *observedORM) TryAcquirePriceServiceLeader(ctx context.Context, jobID int32, destChainSelector uint64, owner uuid.UUID, lease time.Duration) (bool, error) {
+	return withObservedQuery(o, "TryAcquirePriceServiceLeader", destChainSelector, func() (bool, error) {
+		return o.ORM.TryAcquirePriceServiceLeader(ctx, jobID, destChainSelector, owner, lease)
+	})
+}
+
+func (o *observedORM) ReleasePriceServiceLeader(ctx context.Context, jobID int32, destChainSelector uint64, owner uuid.UUID) error {
+	_, err := withObservedQuery(o, "ReleasePriceServiceLeader", destChainSelector, func() (struct{}, error) {
+		return struct{}{}, o.ORM.ReleasePriceServiceLeader(ctx, jobID, destChainSelector, owner)
+	})
+	return err
+}
+
 func withObservedQueryAndRowsAffected(o *observedORM, queryName string, chainSelector uint64, query func() (int64, error)) (int64, error) {
 	rowsAffected, err := withObservedQuery(o, queryName, chainSelector, query)
 	if err == nil {
@@ -2,12 +2,18 @@ package ccip
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/google/uuid"
+	"gopkg.in/guregu/null.v4"
+
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 )
 
@@ -21,12 +27,119 @@ type TokenPrice struct {
 	TokenPrice *assets.Wei
 }
 
+// PriceUpdateTimes are the times at which the prices of a lane were last updated.
+type PriceUpdateTimes struct {
+	GasPrice    null.Time `db:"gas_price"`
+	TokenPrices null.Time `db:"token_prices"`
+}
+
+// PriceCleanupSkipReason is why a cleanup of stale prices was skipped.
+type PriceCleanupSkipReason string
+
+const (
+	// PriceCleanupLocked is when another job is cleaning up the prices of the dest chain.
+	PriceCleanupLocked PriceCleanupSkipReason = "locked"
+	// PriceCleanupRecent is when another job cleaned up the prices of the dest chain within the interval.
+	PriceCleanupRecent PriceCleanupSkipReason = "recent"
+)
+
+// PriceCleanup is the result of a cleanup of stale prices.
+type PriceCleanup struct {
+	// Skipped is why the cleanup was skipped, or empty if it ran.
+	Skipped            PriceCleanupSkipReason
+	GasPricesDeleted   int64
+	TokenPricesDeleted int64
+}
+
+// TokenPoolLiquidity is the liquidity and the inbound rate limiter state of a token pool of a lane, as last recorded
+// by the execution plugin of the lane.
+type TokenPoolLiquidity struct {
+	SourceChainSelector uint64
+	PoolAddr            string
+	TokenAddr           string
+	// Balance is the balance of the pool in its token, which is the amount locked by a lock/release pool.
+	Balance           *ubig.Big
+	RateLimitEnabled  bool
+	RateLimitTokens   *ubig.Big
+	RateLimitCapacity *ubig.Big
+	UpdatedAt         time.Time
+}
+
+// ExecInflightReport is a report accepted for transmission by the execution plugin of a lane, persisted so that the
+// plugin keeps accounting for its messages as inflight after a restart.
+type ExecInflightReport struct {
+	OffRampAddr string `db:"offramp_addr"`
+	// MinSeqNum is the sequence number of the first message of the report, which identifies it.
+	MinSeqNum uint64 `db:"min_seq_num"`
+	// Messages are the JSON encoded messages of the report.
+	Messages  []byte    `db:"messages"`
+	CreatedAt time.Time `db:"created_at"`
+	ExpiresAt time.Time `db:"expires_at"`
+}
+
+// MessageExecGas is the gas used to execute a message, as attributed within its execution transaction by the execution
+// plugin of its lane. Its cost is (ExecGasUsed + OverheadGasUsed) * EffectiveGasPrice.
+type MessageExecGas struct {
+	OffRampAddr string `db:"offramp_addr"`
+	SeqNum      uint64 `db:"seq_num"`
+	TxHash      string `db:"tx_hash"`
+	MessageID   string `db:"message_id"`
+	BlockNumber uint64 `db:"block_number"`
+	// ExecGasUsed is the gas used by the execution of the message itself, including its receiver and token transfers,
+	// or 0 if the transaction couldn't be traced.
+	ExecGasUsed uint64 `db:"exec_gas_used"`
+	// OverheadGasUsed is the share of the message in the rest of the gas used by the transaction, like its intrinsic
+	// gas and the verification of the merkle proofs, or in all its gas if it couldn't be traced.
+	OverheadGasUsed   uint64    `db:"overhead_gas_used"`
+	EffectiveGasPrice *ubig.Big `db:"effective_gas_price"`
+	Traced            bool      `db:"traced"`
+	CreatedAt         time.Time `db:"created_at"`
+}
+
 type ORM interface {
 	GetGasPricesByDestChain(ctx context.Context, destChainSelector uint64) ([]GasPrice, error)
 	GetTokenPricesByDestChain(ctx context.Context, destChainSelector uint64) ([]TokenPrice, error)
+	// GetPriceUpdateTimes returns when the gas price of the source chain and the token prices for the dest chain were last updated.
+	GetPriceUpdateTimes(ctx context.Context, destChainSelector uint64, sourceChainSelector uint64) (PriceUpdateTimes, error)
 
 	UpsertGasPricesForDestChain(ctx context.Context, destChainSelector uint64, gasPrices []GasPrice) (int64, error)
 	UpsertTokenPricesForDestChain(ctx context.Context, destChainSelector uint64, tokenPrices []TokenPrice, interval time.Duration) (int64, error)
+
+	// ClearStalePricesForDestChain deletes the gas and token prices for the dest chain which weren't updated within
+	// expireThreshold. The jobs of all lanes to the dest chain share the prices, so the cleanup is skipped if another
+	// job is running it, or ran it within interval.
+	ClearStalePricesForDestChain(ctx context.Context, destChainSelector uint64, interval, expireThreshold time.Duration) (PriceCleanup, error)
+
+	// GetTokenPoolLiquidity returns the token pool liquidity recorded for the lanes to the dest chain.
+	GetTokenPoolLiquidity(ctx context.Context, destChainSelector uint64) ([]TokenPoolLiquidity, error)
+	// UpsertTokenPoolLiquidity records the latest liquidity of the token pools, replacing the previous one of each pool
+	// and lane.
+	UpsertTokenPoolLiquidity(ctx context.Context, destChainSelector uint64, liquidity []TokenPoolLiquidity) (int64, error)
+
+	// GetExecInflightReports returns the unexpired inflight reports of the offramp on the dest chain.
+	GetExecInflightReports(ctx context.Context, destChainSelector uint64, offRampAddr string) ([]ExecInflightReport, error)
+	// UpsertExecInflightReport records an inflight report, replacing the previous one with the same first message.
+	UpsertExecInflightReport(ctx context.Context, destChainSelector uint64, report ExecInflightReport) (int64, error)
+	// DeleteExpiredExecInflightReports deletes the expired inflight reports of all the offramps on the dest chain.
+	DeleteExpiredExecInflightReports(ctx context.Context, destChainSelector uint64) (int64, error)
+
+	// GetMessageExecGas returns the gas used by the messages of the offramp on the dest chain with sequence numbers
+	// between minSeqNum and maxSeqNum, inclusive.
+	GetMessageExecGas(ctx context.Context, destChainSelector uint64, offRampAddr string, minSeqNum, maxSeqNum uint64) ([]MessageExecGas, error)
+	// GetMessageExecGasCursor returns the next block to attribute the gas of for the offramp on the dest chain, as
+	// recorded by InsertMessageExecGas, or 0 if none was.
+	GetMessageExecGasCursor(ctx context.Context, destChainSelector uint64, offRampAddr string) (uint64, error)
+	// InsertMessageExecGas records the gas used by the messages of the offramp, ignoring the ones already recorded for
+	// the same transaction, and moves its cursor to nextBlock in the same transaction.
+	InsertMessageExecGas(ctx context.Context, destChainSelector uint64, offRampAddr string, nextBlock uint64, gas []MessageExecGas) (int64, error)
+	// DeleteMessageExecGasBefore deletes the gas used by the messages of the offramp which was recorded before before.
+	DeleteMessageExecGasBefore(ctx context.Context, destChainSelector uint64, offRampAddr string, before time.Time) (int64, error)
+
+	// TryAcquirePriceServiceLeader makes owner the leader of the price services of the job and dest chain for lease,
+	// unless another owner holds an unexpired lease. The leader renews its lease by calling it again before it expires.
+	TryAcquirePriceServiceLeader(ctx context.Context, jobID int32, destChainSelector uint64, owner uuid.UUID, lease time.Duration) (bool, error)
+	// ReleasePriceServiceLeader ends the lease of owner, if it holds it, so that another owner can take over right away.
+	ReleasePriceServiceLeader(ctx context.Context, jobID int32, destChainSelector uint64, owner uuid.UUID) error
 }
 
 type orm struct {
@@ -76,6 +189,17 @@ func (o *orm) GetTokenPricesByDestChain(ctx context.Context, destChainSelector u
 	return tokenPrices, nil
 }
 
+func (o *orm) GetPriceUpdateTimes(ctx context.Context, destChainSelector uint64, sourceChainSelector uint64) (PriceUpdateTimes, error) {
+	var times PriceUpdateTimes
+	stmt := `
+		SELECT
+			(SELECT MAX(updated_at) FROM ccip.observed_gas_prices WHERE chain_selector = $1 AND source_chain_selector = $2) AS gas_price,
+			(SELECT MAX(updated_at) FROM ccip.observed_token_prices WHERE chain_selector = $1) AS token_prices;
+	`
+	err := o.ds.GetContext(ctx, &times, stmt, destChainSelector, sourceChainSelector)
+	return times, err
+}
+
 func (o *orm) UpsertGasPricesForDestChain(ctx context.Context, destChainSelector uint64, gasPrices []GasPrice) (int64, error) {
 	if len(gasPrices) == 0 {
 		return 0, nil
@@ -194,6 +318,273 @@ func (o *orm) pickOnlyRelevantTokensForUpdate(
 	return tokenPricesToUpdate, nil
 }
 
+func (o *orm) ClearStalePricesForDestChain(ctx context.Context, destChainSelector uint64, interval, expireThreshold time.Duration) (PriceCleanup, error) {
+	var cleanup PriceCleanup
+	err := sqlutil.TransactDataSource(ctx, o.ds, nil, func(tx sqlutil.DataSource) error {
+		lockKey := fmt.Sprintf("ccip.price_cleanups:%d", destChainSelector)
+		var locked bool
+		if err := tx.GetContext(ctx, &locked, `SELECT pg_try_advisory_xact_lock(hashtextextended($1, 0))`, lockKey); err != nil {
+			return fmt.Errorf("failed to acquire price cleanup lock: %w", err)
+		}
+		if !locked {
+			cleanup.Skipped = PriceCleanupLocked
+			return nil
+		}
+
+		var recent bool
+		pgInterval := fmt.Sprintf("%d milliseconds", interval.Milliseconds())
+		err := tx.GetContext(ctx, &recent, `SELECT EXISTS (SELECT 1 FROM ccip.price_cleanups
+			WHERE chain_selector = $1 AND cleaned_at >= statement_timestamp() - $2::interval)`, destChainSelector, pgInterval)
+		if err != nil {
+			return fmt.Errorf("failed to get last price cleanup: %w", err)
+		}
+		if recent {
+			cleanup.Skipped = PriceCleanupRecent
+			return nil
+		}
+
+		pgThreshold := fmt.Sprintf("%d milliseconds", expireThreshold.Milliseconds())
+		result, err := tx.ExecContext(ctx, `DELETE FROM ccip.observed_gas_prices
+			WHERE chain_selector = $1 AND updated_at < statement_timestamp() - $2::interval`, destChainSelector, pgThreshold)
+		if err != nil {
+			return fmt.Errorf("error deleting gas prices %w", err)
+		}
+		if cleanup.GasPricesDeleted, err = result.RowsAffected(); err != nil {
+			return err
+		}
+		result, err = tx.ExecContext(ctx, `DELETE FROM ccip.observed_token_prices
+			WHERE chain_selector = $1 AND updated_at < statement_timestamp() - $2::interval`, destChainSelector, pgThreshold)
+		if err != nil {
+			return fmt.Errorf("error deleting token prices %w", err)
+		}
+		if cleanup.TokenPricesDeleted, err = result.RowsAffected(); err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `INSERT INTO ccip.price_cleanups (chain_selector, cleaned_at)
+			VALUES ($1, statement_timestamp())
+			ON CONFLICT (chain_selector) DO UPDATE SET cleaned_at = EXCLUDED.cleaned_at`, destChainSelector)
+		if err != nil {
+			return fmt.Errorf("error recording price cleanup %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return PriceCleanup{}, err
+	}
+	return cleanup, nil
+}
+
+func (o *orm) GetTokenPoolLiquidity(ctx context.Context, destChainSelector uint64) ([]TokenPoolLiquidity, error) {
+	var liquidity []TokenPoolLiquidity
+	stmt := `
+		SELECT source_chain_selector, pool_addr, token_addr, balance, rate_limit_enabled, rate_limit_tokens, rate_limit_capacity, updated_at
+		FROM ccip.token_pool_liquidity
+		WHERE chain_selector = $1
+		ORDER BY source_chain_selector, pool_addr;
+	`
+	err := o.ds.SelectContext(ctx, &liquidity, stmt, destChainSelector)
+	if err != nil {
+		return nil, err
+	}
+	return liquidity, nil
+}
+
+func (o *orm) UpsertTokenPoolLiquidity(ctx context.Context, destChainSelector uint64, liquidity []TokenPoolLiquidity) (int64, error) {
+	if len(liquidity) == 0 {
+		return 0, nil
+	}
+
+	insertData := make([]map[string]interface{}, 0, len(liquidity))
+	for _, l := range liquidity {
+		insertData = append(insertData, map[string]interface{}{
+			"chain_selector":        destChainSelector,
+			"source_chain_selector": l.SourceChainSelector,
+			"pool_addr":             l.PoolAddr,
+			"token_addr":            l.TokenAddr,
+			"balance":               l.Balance,
+			"rate_limit_enabled":    l.RateLimitEnabled,
+			"rate_limit_tokens":     l.RateLimitTokens,
+			"rate_limit_capacity":   l.RateLimitCapacity,
+		})
+	}
+
+	stmt := `INSERT INTO ccip.token_pool_liquidity (chain_selector, source_chain_selector, pool_addr, token_addr, balance,
+			rate_limit_enabled, rate_limit_tokens, rate_limit_capacity, updated_at)
+		VALUES (:chain_selector, :source_chain_selector, :pool_addr, :token_addr, :balance,
+			:rate_limit_enabled, :rate_limit_tokens, :rate_limit_capacity, statement_timestamp())
+		ON CONFLICT (chain_selector, source_chain_selector, pool_addr)
+		DO UPDATE SET token_addr = EXCLUDED.token_addr, balance = EXCLUDED.balance,
+			rate_limit_enabled = EXCLUDED.rate_limit_enabled, rate_limit_tokens = EXCLUDED.rate_limit_tokens,
+			rate_limit_capacity = EXCLUDED.rate_limit_capacity, updated_at = EXCLUDED.updated_at;`
+
+	result, err := o.ds.NamedExecContext(ctx, stmt, insertData)
+	if err != nil {
+		return 0, fmt.Errorf("error inserting token pool liquidity %w", err)
+	}
+	return result.RowsAffected()
+}
+
+func (o *orm) GetExecInflightReports(ctx context.Context, destChainSelector uint64, offRampAddr string) ([]ExecInflightReport, error) {
+	var reports []ExecInflightReport
+	stmt := `
+		SELECT offramp_addr, min_seq_num, messages, created_at, expires_at
+		FROM ccip.exec_inflight_reports
+		WHERE chain_selector = $1 AND offramp_addr = $2 AND expires_at > statement_timestamp()
+		ORDER BY min_seq_num;
+	`
+	err := o.ds.SelectContext(ctx, &reports, stmt, destChainSelector, offRampAddr)
+	if err != nil {
+		return nil, err
+	}
+	return reports, nil
+}
+
+func (o *orm) UpsertExecInflightReport(ctx context.Context, destChainSelector uint64, report ExecInflightReport) (int64, error) {
+	stmt := `INSERT INTO ccip.exec_inflight_reports (chain_selector, offramp_addr, min_seq_num, messages, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (chain_selector, offramp_addr, min_seq_num)
+		DO UPDATE SET messages = EXCLUDED.messages, created_at = EXCLUDED.created_at, expires_at = EXCLUDED.expires_at;`
+
+	result, err := o.ds.ExecContext(ctx, stmt, destChainSelector, report.OffRampAddr, report.MinSeqNum, report.Messages, report.CreatedAt, report.ExpiresAt)
+	if err != nil {
+		return 0, fmt.Errorf("error inserting exec inflight report %w", err)
+	}
+	return result.RowsAffected()
+}
+
+func (o *orm) DeleteExpiredExecInflightReports(ctx context.Context, destChainSelector uint64) (int64, error) {
+	result, err := o.ds.ExecContext(ctx, `DELETE FROM ccip.exec_inflight_reports WHERE chain_selector = $1 AND expires_at <= statement_timestamp();`, destChainSelector)
+	if err != nil {
+		return 0, fmt.Errorf("error deleting expired exec inflight reports %w", err)
+	}
+	return result.RowsAffected()
+}
+
+func (o *orm) GetMessageExecGas(ctx context.Context, destChainSelector uint64, offRampAddr string, minSeqNum, maxSeqNum uint64) ([]MessageExecGas, error) {
+	var gas []MessageExecGas
+	stmt := `
+		SELECT offramp_addr, seq_num, tx_hash, message_id, block_number, exec_gas_used, overhead_gas_used,
+			effective_gas_price, traced, created_at
+		FROM ccip.message_exec_gas
+		WHERE chain_selector = $1 AND offramp_addr = $2 AND seq_num BETWEEN $3 AND $4
+		ORDER BY seq_num, block_number;
+	`
+	err := o.ds.SelectContext(ctx, &gas, stmt, destChainSelector, offRampAddr, minSeqNum, maxSeqNum)
+	if err != nil {
+		return nil, err
+	}
+	return gas, nil
+}
+
+func (o *orm) GetMessageExecGasCursor(ctx context.Context, destChainSelector uint64, offRampAddr string) (uint64, error) {
+	var block uint64
+	stmt := `SELECT next_block FROM ccip.message_exec_gas_cursors WHERE chain_selector = $1 AND offramp_addr = $2;`
+	err := o.ds.GetContext(ctx, &block, stmt, destChainSelector, offRampAddr)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	return block, err
+}
+
+func (o *orm) InsertMessageExecGas(ctx context.Context, destChainSelector uint64, offRampAddr string, nextBlock uint64, gas []MessageExecGas) (int64, error) {
+	insertData := make([]map[string]interface{}, 0, len(gas))
+	for _, g := range gas {
+		insertData = append(insertData, map[string]interface{}{
+			"chain_selector":      destChainSelector,
+			"offramp_addr":        offRampAddr,
+			"seq_num":             g.SeqNum,
+			"tx_hash":             g.TxHash,
+			"message_id":          g.MessageID,
+			"block_number":        g.BlockNumber,
+			"exec_gas_used":       g.ExecGasUsed,
+			"overhead_gas_used":   g.OverheadGasUsed,
+			"effective_gas_price": g.EffectiveGasPrice,
+			"traced":              g.Traced,
+		})
+	}
+
+	var inserted int64
+	err := sqlutil.TransactDataSource(ctx, o.ds, nil, func(tx sqlutil.DataSource) error {
+		if len(insertData) > 0 {
+			stmt := `INSERT INTO ccip.message_exec_gas (chain_selector, offramp_addr, seq_num, tx_hash, message_id, block_number,
+					exec_gas_used, overhead_gas_used, effective_gas_price, traced, created_at)
+				VALUES (:chain_selector, :offramp_addr, :seq_num, :tx_hash, :message_id, :block_number,
+					:exec_gas_used, :overhead_gas_used, :effective_gas_price, :traced, statement_timestamp())
+				ON CONFLICT (chain_selector, offramp_addr, seq_num, tx_hash) DO NOTHING;`
+			result, err := tx.NamedExecContext(ctx, stmt, insertData)
+			if err != nil {
+				return fmt.Errorf("error inserting message exec gas %w", err)
+			}
+			if inserted, err = result.RowsAffected(); err != nil {
+				return err
+			}
+		}
+
+		_, err := tx.ExecContext(ctx, `INSERT INTO ccip.message_exec_gas_cursors (chain_selector, offramp_addr, next_block, updated_at)
+			VALUES ($1, $2, $3, statement_timestamp())
+			ON CONFLICT (chain_selector, offramp_addr) DO UPDATE SET next_block = EXCLUDED.next_block, updated_at = EXCLUDED.updated_at`,
+			destChainSelector, offRampAddr, nextBlock)
+		if err != nil {
+			return fmt.Errorf("error recording message exec gas cursor %w", err)
+		}
+		return nil
+	})
+	return inserted, err
+}
+
+func (o *orm) DeleteMessageExecGasBefore(ctx context.Context, destChainSelector uint64, offRampAddr string, before time.Time) (int64, error) {
+	result, err := o.ds.ExecContext(ctx, `DELETE FROM ccip.message_exec_gas WHERE chain_selector = $1 AND offramp_addr = $2 AND created_at < $3;`,
+		destChainSelector, offRampAddr, before)
+	if err != nil {
+		return 0, fmt.Errorf("error deleting message exec gas %w", err)
+	}
+	return result.RowsAffected()
+}
+
+func (o *orm) TryAcquirePriceServiceLeader(ctx context.Context, jobID int32, destChainSelector uint64, owner uuid.UUID, lease time.Duration) (bool, error) {
+	var leader bool
+	err := sqlutil.TransactDataSource(ctx, o.ds, nil, func(tx sqlutil.DataSource) error {
+		// the replicas elect their leader one at a time
+		lockKey := fmt.Sprintf("ccip.price_service_leaders:%d:%d", jobID, destChainSelector)
+		if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtextextended($1, 0))`, lockKey); err != nil {
+			return fmt.Errorf("failed to acquire price service leader lock: %w", err)
+		}
+
+		var current uuid.UUID
+		err := tx.GetContext(ctx, &current, `SELECT owner FROM ccip.price_service_leaders
+			WHERE job_id = $1 AND chain_selector = $2 AND expires_at > statement_timestamp()`, jobID, destChainSelector)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+		case err != nil:
+			return fmt.Errorf("failed to get price service leader: %w", err)
+		case current != owner:
+			return nil
+		}
+
+		pgLease := fmt.Sprintf("%d milliseconds", lease.Milliseconds())
+		_, err = tx.ExecContext(ctx, `INSERT INTO ccip.price_service_leaders (job_id, chain_selector, owner, expires_at)
+			VALUES ($1, $2, $3, statement_timestamp() + $4::interval)
+			ON CONFLICT (job_id, chain_selector) DO UPDATE SET owner = EXCLUDED.owner, expires_at = EXCLUDED.expires_at`,
+			jobID, destChainSelector, owner, pgLease)
+		if err != nil {
+			return fmt.Errorf("error recording price service leader %w", err)
+		}
+		leader = true
+		return nil
+	})
+	return leader, err
+}
+
+func (o *orm) ReleasePriceServiceLeader(ctx context.Context, jobID int32, destChainSelector uint64, owner uuid.UUID) error {
+	_, err := o.ds.ExecContext(ctx, `DELETE FROM ccip.price_service_leaders WHERE job_id = $1 AND chain_selector = $2 AND owner = $3`,
+		jobID, destChainSelector, owner)
+	if err != nil {
+		return fmt.Errorf("error releasing price service leader %w", err)
+	}
+	return nil
+}
+
 func toTokensByAddress(tokens []TokenPrice) map[string]*assets.Wei {
 	tokensByAddr := make(map[string]*assets.Wei, len(tokens))
 	for _, tk := range tokens {
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -13,6 +14,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
@@ -240,6 +242,36 @@ func TestORM_UpsertGasPrices(t *testing.T) {
 	assert.Equal(t, numSourceChainSelectors, getGasTableRowCount(t, db))
 }
 
+func TestORM_GetPriceUpdateTimes(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	orm, _ := setupORM(t)
+	destSelector := uint64(1)
+	sourceSelector := uint64(2)
+
+	times, err := orm.GetPriceUpdateTimes(ctx, destSelector, sourceSelector)
+	require.NoError(t, err)
+	assert.False(t, times.GasPrice.Valid)
+	assert.False(t, times.TokenPrices.Valid)
+
+	_, err = orm.UpsertGasPricesForDestChain(ctx, destSelector, generateGasPrices(sourceSelector, 1))
+	require.NoError(t, err)
+	_, err = orm.UpsertTokenPricesForDestChain(ctx, destSelector, generateRandomTokenPrices(generateTokenAddresses(2)), time.Minute)
+	require.NoError(t, err)
+
+	times, err = orm.GetPriceUpdateTimes(ctx, destSelector, sourceSelector)
+	require.NoError(t, err)
+	assert.True(t, times.GasPrice.Valid)
+	assert.True(t, times.TokenPrices.Valid)
+
+	// other lanes are not affected
+	times, err = orm.GetPriceUpdateTimes(ctx, destSelector, sourceSelector+1)
+	require.NoError(t, err)
+	assert.False(t, times.GasPrice.Valid)
+	assert.True(t, times.TokenPrices.Valid)
+}
+
 func TestORM_InsertAndGetTokenPrices(t *testing.T) {
 	t.Parallel()
 	ctx := testutils.Context(t)
@@ -356,6 +388,290 @@ func TestORM_InsertTokenPricesWhenExpired(t *testing.T) {
 	}
 }
 
+func TestORM_ClearStalePricesForDestChain(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	orm, ds := setupORM(t)
+
+	destSelector := rand.Uint64()
+	otherDestSelector := rand.Uint64()
+	sourceSelectors := generateChainSelectors(2)
+	addrs := generateTokenAddresses(2)
+	for _, dest := range []uint64{destSelector, otherDestSelector} {
+		for _, source := range sourceSelectors {
+			_, err := orm.UpsertGasPricesForDestChain(ctx, dest, generateGasPrices(source, 1))
+			require.NoError(t, err)
+		}
+		_, err := orm.UpsertTokenPricesForDestChain(ctx, dest, generateRandomTokenPrices(addrs), time.Minute)
+		require.NoError(t, err)
+	}
+
+	// the prices of the first source chain and token weren't updated for two hours
+	_, err := ds.ExecContext(ctx, `UPDATE ccip.observed_gas_prices SET updated_at = NOW() - interval '2 hours' WHERE source_chain_selector = $1`, sourceSelectors[0])
+	require.NoError(t, err)
+	_, err = ds.ExecContext(ctx, `UPDATE ccip.observed_token_prices SET updated_at = NOW() - interval '2 hours' WHERE token_addr = $1`, []byte(addrs[0]))
+	require.NoError(t, err)
+
+	cleanup, err := orm.ClearStalePricesForDestChain(ctx, destSelector, time.Minute, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, PriceCleanup{GasPricesDeleted: 1, TokenPricesDeleted: 1}, cleanup)
+
+	gasPrices, err := orm.GetGasPricesByDestChain(ctx, destSelector)
+	require.NoError(t, err)
+	require.Len(t, gasPrices, 1)
+	assert.Equal(t, sourceSelectors[1], gasPrices[0].SourceChainSelector)
+	tokenPrices, err := orm.GetTokenPricesByDestChain(ctx, destSelector)
+	require.NoError(t, err)
+	require.Len(t, tokenPrices, 1)
+	assert.Equal(t, addrs[1], tokenPrices[0].TokenAddr)
+
+	// the prices of other dest chains are cleaned up separately
+	assert.Equal(t, 3, getGasTableRowCount(t, ds))
+	assert.Equal(t, 3, getTokenTableRowCount(t, ds))
+
+	// another job cleaning up within the interval is skipped
+	cleanup, err = orm.ClearStalePricesForDestChain(ctx, destSelector, time.Minute, time.Millisecond)
+	require.NoError(t, err)
+	assert.Equal(t, PriceCleanup{Skipped: PriceCleanupRecent}, cleanup)
+	assert.Equal(t, 3, getGasTableRowCount(t, ds))
+
+	cleanup, err = orm.ClearStalePricesForDestChain(ctx, otherDestSelector, time.Minute, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, PriceCleanup{GasPricesDeleted: 1, TokenPricesDeleted: 1}, cleanup)
+	assert.Equal(t, 2, getGasTableRowCount(t, ds))
+	assert.Equal(t, 2, getTokenTableRowCount(t, ds))
+}
+
+func TestORM_UpsertAndGetTokenPoolLiquidity(t *testing.T) {
+	ctx := testutils.Context(t)
+	orm, _ := setupORM(t)
+
+	destSelector := rand.Uint64()
+	sourceSelectors := generateChainSelectors(2)
+	pool, token := utils.RandomAddress().Hex(), utils.RandomAddress().Hex()
+
+	liquidity, err := orm.GetTokenPoolLiquidity(ctx, destSelector)
+	require.NoError(t, err)
+	assert.Empty(t, liquidity)
+
+	rowsAffected, err := orm.UpsertTokenPoolLiquidity(ctx, destSelector, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), rowsAffected)
+
+	newLiquidity := func(source uint64, balance int64) TokenPoolLiquidity {
+		return TokenPoolLiquidity{
+			SourceChainSelector: source,
+			PoolAddr:            pool,
+			TokenAddr:           token,
+			Balance:             ubig.NewI(balance),
+			RateLimitEnabled:    true,
+			RateLimitTokens:     ubig.NewI(10),
+			RateLimitCapacity:   ubig.NewI(100),
+		}
+	}
+	// the rate limiters of a pool are per lane
+	rowsAffected, err = orm.UpsertTokenPoolLiquidity(ctx, destSelector, []TokenPoolLiquidity{newLiquidity(sourceSelectors[0], 1000), newLiquidity(sourceSelectors[1], 1000)})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), rowsAffected)
+	_, err = orm.UpsertTokenPoolLiquidity(ctx, rand.Uint64(), []TokenPoolLiquidity{newLiquidity(sourceSelectors[0], 1)})
+	require.NoError(t, err)
+
+	rowsAffected, err = orm.UpsertTokenPoolLiquidity(ctx, destSelector, []TokenPoolLiquidity{newLiquidity(sourceSelectors[0], 500)})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), rowsAffected)
+
+	liquidity, err = orm.GetTokenPoolLiquidity(ctx, destSelector)
+	require.NoError(t, err)
+	require.Len(t, liquidity, 2)
+	balances := map[uint64]int64{}
+	for _, l := range liquidity {
+		assert.Equal(t, pool, l.PoolAddr)
+		assert.Equal(t, token, l.TokenAddr)
+		assert.True(t, l.RateLimitEnabled)
+		assert.Equal(t, int64(10), l.RateLimitTokens.Int64())
+		assert.Equal(t, int64(100), l.RateLimitCapacity.Int64())
+		assert.False(t, l.UpdatedAt.IsZero())
+		balances[l.SourceChainSelector] = l.Balance.Int64()
+	}
+	assert.Equal(t, map[uint64]int64{sourceSelectors[0]: 500, sourceSelectors[1]: 1000}, balances)
+}
+
+func TestORM_ExecInflightReports(t *testing.T) {
+	ctx := testutils.Context(t)
+	orm, _ := setupORM(t)
+
+	destSelector := rand.Uint64()
+	offRamp := utils.RandomAddress().Hex()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	newReport := func(minSeqNum uint64, createdAt time.Time) ExecInflightReport {
+		return ExecInflightReport{
+			OffRampAddr: offRamp,
+			MinSeqNum:   minSeqNum,
+			Messages:    []byte(`[{"SequenceNumber":1}]`),
+			CreatedAt:   createdAt,
+			ExpiresAt:   createdAt.Add(time.Hour),
+		}
+	}
+
+	reports, err := orm.GetExecInflightReports(ctx, destSelector, offRamp)
+	require.NoError(t, err)
+	assert.Empty(t, reports)
+
+	for _, r := range []ExecInflightReport{newReport(2, now), newReport(1, now.Add(-2*time.Hour)), newReport(3, now)} {
+		rowsAffected, err2 := orm.UpsertExecInflightReport(ctx, destSelector, r)
+		require.NoError(t, err2)
+		assert.Equal(t, int64(1), rowsAffected)
+	}
+	// a report of another offramp
+	other := newReport(2, now)
+	other.OffRampAddr = utils.RandomAddress().Hex()
+	_, err = orm.UpsertExecInflightReport(ctx, destSelector, other)
+	require.NoError(t, err)
+	// replaced when added again
+	_, err = orm.UpsertExecInflightReport(ctx, destSelector, newReport(3, now.Add(time.Minute)))
+	require.NoError(t, err)
+
+	// the expired report isn't returned
+	reports, err = orm.GetExecInflightReports(ctx, destSelector, offRamp)
+	require.NoError(t, err)
+	require.Len(t, reports, 2)
+	assert.Equal(t, uint64(2), reports[0].MinSeqNum)
+	assert.Equal(t, uint64(3), reports[1].MinSeqNum)
+	assert.JSONEq(t, `[{"SequenceNumber":1}]`, string(reports[0].Messages))
+	assert.True(t, now.Equal(reports[0].CreatedAt))
+	assert.True(t, now.Add(time.Minute).Equal(reports[1].CreatedAt))
+
+	deleted, err := orm.DeleteExpiredExecInflightReports(ctx, destSelector)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+	deleted, err = orm.DeleteExpiredExecInflightReports(ctx, destSelector)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), deleted)
+}
+
+func TestORM_MessageExecGas(t *testing.T) {
+	ctx := testutils.Context(t)
+	orm, _ := setupORM(t)
+
+	destSelector := rand.Uint64()
+	offRamp := utils.RandomAddress().Hex()
+	newGas := func(seqNum uint64, txHash string, blockNumber uint64) MessageExecGas {
+		return MessageExecGas{
+			SeqNum:            seqNum,
+			TxHash:            txHash,
+			MessageID:         utils.RandomHash().Hex(),
+			BlockNumber:       blockNumber,
+			ExecGasUsed:       100_000,
+			OverheadGasUsed:   20_000,
+			EffectiveGasPrice: ubig.NewI(1e9),
+			Traced:            true,
+		}
+	}
+
+	cursor, err := orm.GetMessageExecGasCursor(ctx, destSelector, offRamp)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), cursor)
+
+	tx1, tx2 := utils.RandomHash().Hex(), utils.RandomHash().Hex()
+	inserted, err := orm.InsertMessageExecGas(ctx, destSelector, offRamp, 13, []MessageExecGas{newGas(1, tx1, 10), newGas(2, tx1, 10), newGas(3, tx2, 12)})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), inserted)
+	// a message failed in tx1 and executed again manually
+	retried := newGas(2, utils.RandomHash().Hex(), 15)
+	retried.Traced = false
+	inserted, err = orm.InsertMessageExecGas(ctx, destSelector, offRamp, 16, []MessageExecGas{retried, newGas(1, tx1, 10)})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), inserted, "the gas recorded for the same transaction is kept")
+	// the gas of another offramp
+	otherOffRamp := utils.RandomAddress().Hex()
+	_, err = orm.InsertMessageExecGas(ctx, destSelector, otherOffRamp, 21, []MessageExecGas{newGas(4, utils.RandomHash().Hex(), 20)})
+	require.NoError(t, err)
+
+	cursor, err = orm.GetMessageExecGasCursor(ctx, destSelector, offRamp)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(16), cursor)
+
+	// the cursor moves without any messages executed
+	inserted, err = orm.InsertMessageExecGas(ctx, destSelector, offRamp, 1016, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), inserted)
+	cursor, err = orm.GetMessageExecGasCursor(ctx, destSelector, offRamp)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1016), cursor)
+	cursor, err = orm.GetMessageExecGasCursor(ctx, destSelector, otherOffRamp)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(21), cursor)
+
+	gas, err := orm.GetMessageExecGas(ctx, destSelector, offRamp, 2, 4)
+	require.NoError(t, err)
+	require.Len(t, gas, 3)
+	assert.Equal(t, uint64(2), gas[0].SeqNum)
+	assert.Equal(t, offRamp, gas[0].OffRampAddr)
+	assert.Equal(t, tx1, gas[0].TxHash)
+	assert.True(t, gas[0].Traced)
+	assert.Equal(t, retried.TxHash, gas[1].TxHash)
+	assert.False(t, gas[1].Traced)
+	assert.Equal(t, uint64(3), gas[2].SeqNum)
+	assert.Equal(t, uint64(100_000), gas[2].ExecGasUsed)
+	assert.Equal(t, uint64(20_000), gas[2].OverheadGasUsed)
+	assert.Equal(t, int64(1e9), gas[2].EffectiveGasPrice.Int64())
+
+	// nothing was recorded an hour ago
+	deleted, err := orm.DeleteMessageExecGasBefore(ctx, destSelector, offRamp, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), deleted)
+	deleted, err = orm.DeleteMessageExecGasBefore(ctx, destSelector, offRamp, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), deleted)
+	gas, err = orm.GetMessageExecGas(ctx, destSelector, otherOffRamp, 0, 10)
+	require.NoError(t, err)
+	assert.Len(t, gas, 1, "the gas of other offramps is kept")
+}
+
+func TestORM_PriceServiceLeader(t *testing.T) {
+	ctx := testutils.Context(t)
+	orm, db := setupORM(t)
+
+	destSelector := rand.Uint64()
+	jobID := rand.Int31()
+	replica1, replica2 := uuid.New(), uuid.New()
+
+	leader, err := orm.TryAcquirePriceServiceLeader(ctx, jobID, destSelector, replica1, time.Minute)
+	require.NoError(t, err)
+	assert.True(t, leader)
+	// the leader renews its lease
+	leader, err = orm.TryAcquirePriceServiceLeader(ctx, jobID, destSelector, replica1, time.Minute)
+	require.NoError(t, err)
+	assert.True(t, leader)
+	leader, err = orm.TryAcquirePriceServiceLeader(ctx, jobID, destSelector, replica2, time.Minute)
+	require.NoError(t, err)
+	assert.False(t, leader)
+	// another job has its own leader
+	leader, err = orm.TryAcquirePriceServiceLeader(ctx, jobID+1, destSelector, replica2, time.Minute)
+	require.NoError(t, err)
+	assert.True(t, leader)
+
+	// the follower takes over once the lease expires
+	_, err = db.ExecContext(ctx, `UPDATE ccip.price_service_leaders SET expires_at = NOW() - INTERVAL '1 second' WHERE job_id = $1`, jobID)
+	require.NoError(t, err)
+	leader, err = orm.TryAcquirePriceServiceLeader(ctx, jobID, destSelector, replica2, time.Minute)
+	require.NoError(t, err)
+	assert.True(t, leader)
+	leader, err = orm.TryAcquirePriceServiceLeader(ctx, jobID, destSelector, replica1, time.Minute)
+	require.NoError(t, err)
+	assert.False(t, leader)
+
+	// or right away once the leader releases it
+	require.NoError(t, orm.ReleasePriceServiceLeader(ctx, jobID, destSelector, replica1))
+	leader, err = orm.TryAcquirePriceServiceLeader(ctx, jobID, destSelector, replica1, time.Minute)
+	require.NoError(t, err)
+	assert.False(t, leader)
+	require.NoError(t, orm.ReleasePriceServiceLeader(ctx, jobID, destSelector, replica2))
+	leader, err = orm.TryAcquirePriceServiceLeader(ctx, jobID, destSelector, replica1, time.Minute)
+	require.NoError(t, err)
+	assert.True(t, leader)
+}
+
 func Benchmark_UpsertsTheSameTokenPrices(b *testing.B) {
 	db := pgtest.NewSqlxDB(b)
 	orm, err := NewORM(db, logger.NullLogger)
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math/big"
 	"net/http"
+	"slices"
 	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -34,6 +35,8 @@ import (
 	gatewayconnector "github.com/smartcontractkit/chainlink/v2/core/capabilities/gateway_connector"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/remote"
 	remotetypes "github.com/smartcontractkit/chainlink/v2/core/capabilities/remote/types"
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	evmcfg "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
@@ -42,10 +45,13 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services"
+	"github.com/smartcontractkit/chainlink/v2/core/services/alerting"
+	"github.com/smartcontractkit/chainlink/v2/core/services/blobstore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/blockhashstore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/blockheaderfeeder"
 	"github.com/smartcontractkit/chainlink/v2/core/services/cron"
 	"github.com/smartcontractkit/chainlink/v2/core/services/directrequest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/drain"
 	"github.com/smartcontractkit/chainlink/v2/core/services/feeds"
 	"github.com/smartcontractkit/chainlink/v2/core/services/fluxmonitorv2"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
@@ -60,20 +66,25 @@ import (
 	p2ptypes "github.com/smartcontractkit/chainlink/v2/core/services/p2p/types"
 	externalp2p "github.com/smartcontractkit/chainlink/v2/core/services/p2p/wrapper"
 	"github.com/smartcontractkit/chainlink/v2/core/services/periodicbackup"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/registrysyncer"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc"
+	"github.com/smartcontractkit/chainlink/v2/core/services/reports"
 	"github.com/smartcontractkit/chainlink/v2/core/services/standardcapabilities"
 	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
 	"github.com/smartcontractkit/chainlink/v2/core/services/telemetry"
+	"github.com/smartcontractkit/chainlink/v2/core/services/txaudit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
 	workflowstore "github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/apitokens"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions/ldapauth"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions/localauth"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/oidcauth"
 	"github.com/smartcontractkit/chainlink/v2/core/static"
 	"github.com/smartcontractkit/chainlink/v2/plugins"
 )
@@ -88,6 +99,9 @@ type Application interface {
 	GetDB() sqlutil.DataSource
 	GetConfig() GeneralConfig
 	SetLogLevel(lvl zapcore.Level) error
+	// ReloadConfig re-reads the config files and applies changes to the hot-reloadable fields, returning the names of
+	// those which changed.
+	ReloadConfig(ctx context.Context) ([]string, error)
 	GetKeyStore() keystore.Master
 	WakeSessionReaper()
 	GetWebAuthnConfiguration() sessions.WebAuthnConfiguration
@@ -96,6 +110,14 @@ type Application interface {
 	GetRelayers() RelayerChainInteroperators
 	GetLoopRegistry() *plugins.LoopRegistry
 	GetLoopRegistrarConfig() plugins.RegistrarConfig
+	// GetBlobStore returns the store of large artifacts, or nil if it is disabled.
+	GetBlobStore() *blobstore.Service
+	// GetPeerWrapper returns the libocr peer, or nil if the P2P stack is not needed.
+	GetPeerWrapper() *ocrcommon.SingletonPeerWrapper
+	// GetReportRunner returns the runner of the reports generated in the background.
+	GetReportRunner() *reports.Runner
+	// GetDrainer returns the drainer of the node.
+	GetDrainer() *drain.Drainer
 
 	// V2 Jobs (TOML specified)
 	JobSpawner() job.Spawner
@@ -105,9 +127,16 @@ type Application interface {
 	BridgeORM() bridges.ORM
 	BasicAdminUsersORM() sessions.BasicAdminUsersORM
 	AuthenticationProvider() sessions.AuthenticationProvider
+	APITokensORM() apitokens.ORM
 	TxmStorageService() txmgr.EvmTxStore
 	AddJobV2(ctx context.Context, job *job.Job) error
 	DeleteJob(ctx context.Context, jobID int32) error
+	// PromoteJob promotes an OCR2 job held in standby to active.
+	PromoteJob(ctx context.Context, jobID int32) error
+	// PauseJob stops the services of a job, until it is resumed.
+	PauseJob(ctx context.Context, jobID int32) error
+	// ResumeJob starts the services of a paused job.
+	ResumeJob(ctx context.Context, jobID int32) error
 	RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta jsonserializable.JSONSerializable) (int64, error)
 	ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error
 	// Testing only
@@ -129,6 +158,8 @@ type Application interface {
 	FindLCA(ctx context.Context, chainID *big.Int) (*logpoller.LogPollerBlock, error)
 	// DeleteLogPollerDataAfter - delete LogPoller state starting from the specified block
 	DeleteLogPollerDataAfter(ctx context.Context, chainID *big.Int, start int64) error
+	// RecoverLogPollerFinalityViolation - rewrites LogPoller state after a finality violation and reports changed logs
+	RecoverLogPollerFinalityViolation(ctx context.Context, chainID *big.Int) (*logpoller.FinalityRecoveryReport, error)
 }
 
 // ChainlinkApplication contains fields for the JobSubscriber, Scheduler,
@@ -143,9 +174,11 @@ type ChainlinkApplication struct {
 	bridgeORM                bridges.ORM
 	localAdminUsersORM       sessions.BasicAdminUsersORM
 	authenticationProvider   sessions.AuthenticationProvider
+	apiTokensORM             apitokens.ORM
 	txmStorageService        txmgr.EvmTxStore
 	FeedsService             feeds.Service
 	webhookJobRunner         webhook.JobRunner
+	ocr2Delegate             *ocr2.Delegate
 	Config                   GeneralConfig
 	KeyStore                 keystore.Master
 	ExternalInitiatorManager webhook.ExternalInitiatorManager
@@ -161,6 +194,10 @@ type ChainlinkApplication struct {
 	profiler                 *pyroscope.Profiler
 	loopRegistry             *plugins.LoopRegistry
 	loopRegistrarConfig      plugins.RegistrarConfig
+	blobStore                *blobstore.Service
+	peerWrapper              *ocrcommon.SingletonPeerWrapper
+	reportRunner             *reports.Runner
+	drainer                  *drain.Drainer
 
 	started     bool
 	startStopMu sync.Mutex
@@ -186,6 +223,7 @@ type ApplicationOpts struct {
 	CapabilitiesRegistry       *capabilities.Registry
 	CapabilitiesDispatcher     remotetypes.Dispatcher
 	CapabilitiesPeerWrapper    p2ptypes.PeerWrapper
+	SlowQueryRecorder          *pg.SlowQueryRecorder
 }
 
 // NewApplication initializes a new store if one is not already
@@ -205,6 +243,9 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	restrictedHTTPClient := opts.RestrictedHTTPClient
 	unrestrictedHTTPClient := opts.UnrestrictedHTTPClient
 
+	// started first and closed last, so that the key usages of all the other services are recorded
+	srvcs = append(srvcs, keyStore.KeyUsageAuditor())
+
 	if opts.CapabilitiesRegistry == nil {
 		// for tests only, in prod Registry should always be set at this point
 		opts.CapabilitiesRegistry = capabilities.NewRegistry(globalLogger)
@@ -330,6 +371,48 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		globalLogger.Info("DatabaseBackup: periodic database backups are disabled. To enable automatic backups, set Database.Backup.Mode=lite or Database.Backup.Mode=full")
 	}
 
+	if partitioningCfg := cfg.Database().Partitioning(); partitioningCfg.Enabled() {
+		srvcs = append(srvcs, pg.NewPartitionManager(globalLogger, opts.DS, partitioningCfg.CheckInterval(),
+			pg.EVMLogsTable(partitioningCfg.LogsBlockRange(), partitioningCfg.LogsRetention()),
+			pg.PipelineTaskRunsTable(partitioningCfg.PipelineRunsRange(), partitioningCfg.PipelineRunsRetention())))
+	}
+
+	if opts.SlowQueryRecorder != nil {
+		srvcs = append(srvcs, opts.SlowQueryRecorder)
+	}
+
+	// alerting is started before the services emitting alerts, and stopped after them
+	if alertingCfg := cfg.Alerting(); alertingCfg.Enabled() {
+		alertingService, err := alerting.NewService(globalLogger, alertingCfg)
+		if err != nil {
+			return nil, fmt.Errorf("NewApplication: failed to initialize alerting: %w", err)
+		}
+		srvcs = append(srvcs, alertingService)
+		// the LOOPPs deliver the alerts emitted in their processes themselves
+		loopEnv, err := alerting.LOOPEnv(alertingCfg)
+		if err != nil {
+			return nil, fmt.Errorf("NewApplication: failed to pass the alerting config to LOOPPs: %w", err)
+		}
+		loopRegistry.AddEnv(loopEnv)
+	}
+
+	var blobStore *blobstore.Service
+	if blobStoreCfg := cfg.BlobStore(); blobStoreCfg.Backend() != "" {
+		store, err := blobstore.New(blobStoreCfg)
+		if err != nil {
+			return nil, fmt.Errorf("NewApplication: failed to initialize blob store: %w", err)
+		}
+		blobStore = blobstore.NewService(globalLogger, store, blobStoreCfg.MaxAge(), blobStoreCfg.GCInterval())
+		srvcs = append(srvcs, blobStore)
+	}
+
+	reportRunner := reports.NewRunner(globalLogger, opts.DS)
+	srvcs = append(srvcs, reportRunner)
+
+	if txAuditCfg := cfg.TxAuditExport(); txAuditCfg.Enabled() {
+		srvcs = append(srvcs, txaudit.NewExporter(globalLogger, txaudit.NewORM(opts.DS), txAuditCfg.Dir(), txAuditCfg.Format(), txAuditCfg.Delay()))
+	}
+
 	// pool must be started before all relayers and stopped after them
 	if opts.MercuryPool != nil {
 		srvcs = append(srvcs, opts.MercuryPool)
@@ -351,7 +434,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	localAdminUsersORM := localauth.NewORM(opts.DS, cfg.WebServer().SessionTimeout().Duration(), globalLogger, auditLogger)
 
 	// Initialize Sessions ORM based on environment configured authenticator
-	// localDB auth or remote LDAP auth
+	// localDB auth, remote LDAP auth or OIDC identity provider auth
 	authMethod := cfg.WebServer().AuthenticationMethod()
 	var authenticationProvider sessions.AuthenticationProvider
 	var sessionReaper *utils.SleeperTask
@@ -366,11 +449,20 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 			return nil, errors.Wrap(err, "NewApplication: failed to initialize LDAP Authentication module")
 		}
 		sessionReaper = ldapauth.NewLDAPServerStateSync(opts.DS, cfg.WebServer().LDAP(), globalLogger)
+	case sessions.OIDCAuth:
+		var err error
+		authenticationProvider, err = oidcauth.NewOIDCAuthenticator(
+			opts.DS, cfg.WebServer().OIDC(), localAdminUsersORM, cfg.Insecure().DevWebServer(), globalLogger, auditLogger,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "NewApplication: failed to initialize OIDC Authentication module")
+		}
+		sessionReaper = oidcauth.NewSessionReaper(opts.DS, cfg.WebServer(), globalLogger)
 	case sessions.LocalAuth:
 		authenticationProvider = localauth.NewORM(opts.DS, cfg.WebServer().SessionTimeout().Duration(), globalLogger, auditLogger)
 		sessionReaper = localauth.NewSessionReaper(opts.DS, cfg.WebServer(), globalLogger)
 	default:
-		return nil, errors.Errorf("NewApplication: Unexpected 'AuthenticationMethod': %s supported values: %s, %s", authMethod, sessions.LocalAuth, sessions.LDAPAuth)
+		return nil, errors.Errorf("NewApplication: Unexpected 'AuthenticationMethod': %s supported values: %s, %s, %s", authMethod, sessions.LocalAuth, sessions.LDAPAuth, sessions.OIDCAuth)
 	}
 
 	var (
@@ -431,6 +523,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 				globalLogger),
 			job.Cron: cron.NewDelegate(
 				pipelineRunner,
+				opts.DS,
 				globalLogger),
 			job.BlockhashStore: blockhashstore.NewDelegate(
 				cfg,
@@ -489,6 +582,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	}
 
 	var peerWrapper *ocrcommon.SingletonPeerWrapper
+	var ocr2Delegate *ocr2.Delegate
 	if !cfg.OCR().Enabled() && !cfg.OCR2().Enabled() {
 		globalLogger.Debug("P2P stack not needed")
 	} else if cfg.P2P().Enabled() {
@@ -521,9 +615,9 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	if cfg.OCR2().Enabled() {
 		globalLogger.Debug("Off-chain reporting v2 enabled")
 
-		ocr2DelegateConfig := ocr2.NewDelegateConfig(cfg.OCR2(), cfg.Mercury(), cfg.Threshold(), cfg.Insecure(), cfg.JobPipeline(), loopRegistrarConfig)
+		ocr2DelegateConfig := ocr2.NewDelegateConfig(cfg.OCR2(), cfg.Mercury(), cfg.Threshold(), cfg.Insecure(), cfg.JobPipeline(), cfg.Database(), cfg.Maintenance(), cfg.CCIP(), loopRegistrarConfig)
 
-		delegates[job.OffchainReporting2] = ocr2.NewDelegate(
+		ocr2Delegate = ocr2.NewDelegate(
 			opts.DS,
 			jobORM,
 			bridgeORM,
@@ -537,10 +631,12 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 			ocr2DelegateConfig,
 			keyStore.OCR2(),
 			keyStore.Eth(),
+			keyStore.Report(),
 			opts.RelayerChainInteroperators,
 			mailMon,
 			opts.CapabilitiesRegistry,
 		)
+		delegates[job.OffchainReporting2] = ocr2Delegate
 		delegates[job.Bootstrap] = ocrbootstrap.NewDelegateBootstrap(
 			opts.DS,
 			jobORM,
@@ -583,6 +679,17 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		}
 	}
 
+	var drainChains []drain.Chain
+	for _, c := range legacyEVMChains.Slice() {
+		dc := drain.Chain{ID: c.ID()}
+		if cfg.Feature().LogPoller() {
+			dc.LogPoller = c.LogPoller()
+		}
+		drainChains = append(drainChains, dc)
+	}
+	drainer := drain.NewDrainer(globalLogger, cfg.Drain().Timeout(), jobSpawner, pipelineRunner, txmORM, drainChains)
+	srvcs = append(srvcs, drainer)
+
 	var feedsService feeds.Service
 	if cfg.Feature().FeedsManager() {
 		feedsORM := feeds.NewORM(opts.DS)
@@ -598,6 +705,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 			cfg.JobPipeline(),
 			cfg.OCR(),
 			cfg.OCR2(),
+			cfg.JobDistributor(),
 			legacyEVMChains,
 			globalLogger,
 			opts.Version,
@@ -615,6 +723,9 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 			return nil, err
 		}
 	}
+	if err := healthChecker.Register(pg.NewHealthReporter(opts.DS)); err != nil {
+		return nil, err
+	}
 
 	return &ChainlinkApplication{
 		relayers:                 opts.RelayerChainInteroperators,
@@ -625,10 +736,12 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		bridgeORM:                bridgeORM,
 		localAdminUsersORM:       localAdminUsersORM,
 		authenticationProvider:   authenticationProvider,
+		apiTokensORM:             apitokens.NewORM(opts.DS),
 		txmStorageService:        txmORM,
 		FeedsService:             feedsService,
 		Config:                   cfg,
 		webhookJobRunner:         webhookJobRunner,
+		ocr2Delegate:             ocr2Delegate,
 		KeyStore:                 keyStore,
 		SessionReaper:            sessionReaper,
 		ExternalInitiatorManager: externalInitiatorManager,
@@ -640,6 +753,10 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		profiler:                 profiler,
 		loopRegistry:             loopRegistry,
 		loopRegistrarConfig:      loopRegistrarConfig,
+		blobStore:                blobStore,
+		peerWrapper:              peerWrapper,
+		reportRunner:             reportRunner,
+		drainer:                  drainer,
 
 		ds: opts.DS,
 
@@ -656,6 +773,41 @@ func (app *ChainlinkApplication) SetLogLevel(lvl zapcore.Level) error {
 	return nil
 }
 
+func (app *ChainlinkApplication) ReloadConfig(ctx context.Context) ([]string, error) {
+	changed, err := app.Config.ReloadConfig()
+	if err != nil {
+		app.logger.Errorw("Failed to reload config", "err", err)
+		return nil, err
+	}
+	if slices.Contains(changed, "Log.Level") {
+		app.logger.SetLogLevel(app.Config.Log().Level())
+	}
+	app.logger.Infow("Reloaded config", "changed", changed)
+
+	for _, c := range app.Config.EVMConfigs() {
+		if slices.Contains(changed, fmt.Sprintf("EVM.%s.Nodes", c.ChainID)) && c.IsEnabled() {
+			err = multierr.Append(err, app.reloadEVMNodes(ctx, c))
+		}
+	}
+	if err != nil {
+		app.logger.Errorw("Failed to apply the reloaded config", "err", err)
+	}
+	return changed, err
+}
+
+// reloadEVMNodes replaces the nodes of the client of the chain c configures with its reloaded Nodes.
+func (app *ChainlinkApplication) reloadEVMNodes(ctx context.Context, c *evmcfg.EVMConfig) error {
+	chain, err := app.GetRelayers().LegacyEVMChains().Get(c.ChainID.String())
+	if err != nil {
+		return err
+	}
+	reloader, ok := chain.Client().(evmclient.NodesReloader)
+	if !ok {
+		return fmt.Errorf("the nodes of chain %s cannot be reloaded", c.ChainID)
+	}
+	return reloader.ReloadNodes(ctx, c.NodesSnapshot())
+}
+
 // Start all necessary services. If successful, nil will be returned.
 // Start sequence is aborted if the context gets cancelled.
 func (app *ChainlinkApplication) Start(ctx context.Context) error {
@@ -721,6 +873,22 @@ func (app *ChainlinkApplication) GetLoopRegistrarConfig() plugins.RegistrarConfi
 	return app.loopRegistrarConfig
 }
 
+func (app *ChainlinkApplication) GetBlobStore() *blobstore.Service {
+	return app.blobStore
+}
+
+func (app *ChainlinkApplication) GetPeerWrapper() *ocrcommon.SingletonPeerWrapper {
+	return app.peerWrapper
+}
+
+func (app *ChainlinkApplication) GetReportRunner() *reports.Runner {
+	return app.reportRunner
+}
+
+func (app *ChainlinkApplication) GetDrainer() *drain.Drainer {
+	return app.drainer
+}
+
 // Stop allows the application to exit by halting schedules, closing
 // logs, and closing the DB connection.
 func (app *ChainlinkApplication) Stop() error {
@@ -811,6 +979,10 @@ func (app *ChainlinkApplication) AuthenticationProvider() sessions.Authenticatio
 	return app.authenticationProvider
 }
 
+func (app *ChainlinkApplication) APITokensORM() apitokens.ORM {
+	return app.apiTokensORM
+}
+
 // TODO BCF-2516 remove this all together remove EVM specifics
 func (app *ChainlinkApplication) EVMORM() evmtypes.Configs {
 	return app.GetRelayers().LegacyEVMChains().ChainNodeConfigs()
@@ -855,6 +1027,25 @@ func (app *ChainlinkApplication) DeleteJob(ctx context.Context, jobID int32) err
 	return app.jobSpawner.DeleteJob(ctx, nil, jobID)
 }
 
+func (app *ChainlinkApplication) PromoteJob(ctx context.Context, jobID int32) error {
+	if app.ocr2Delegate == nil {
+		return errors.New("OCR2 is disabled")
+	}
+	jb, err := app.jobORM.FindJob(ctx, jobID)
+	if err != nil {
+		return errors.Wrapf(err, "job ID %v", jobID)
+	}
+	return app.ocr2Delegate.PromoteJob(ctx, jb)
+}
+
+func (app *ChainlinkApplication) PauseJob(ctx context.Context, jobID int32) error {
+	return app.jobSpawner.PauseJob(ctx, jobID)
+}
+
+func (app *ChainlinkApplication) ResumeJob(ctx context.Context, jobID int32) error {
+	return app.jobSpawner.ResumeJob(ctx, jobID)
+}
+
 func (app *ChainlinkApplication) RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta jsonserializable.JSONSerializable) (int64, error) {
 	return app.webhookJobRunner.RunJob(ctx, jobUUID, requestBody, meta)
 }
@@ -1015,3 +1206,21 @@ func (app *ChainlinkApplication) DeleteLogPollerDataAfter(ctx context.Context, c
 
 	return nil
 }
+
+// RecoverLogPollerFinalityViolation - re-verifies LogPoller state against the RPC and rewrites non-canonical logs
+func (app *ChainlinkApplication) RecoverLogPollerFinalityViolation(ctx context.Context, chainID *big.Int) (*logpoller.FinalityRecoveryReport, error) {
+	chain, err := app.GetRelayers().LegacyEVMChains().Get(chainID.String())
+	if err != nil {
+		return nil, err
+	}
+	if !app.Config.Feature().LogPoller() {
+		return nil, fmt.Errorf("RecoverLogPollerFinalityViolation is only available if LogPoller is enabled")
+	}
+
+	report, err := chain.LogPoller().RecoverFinalityViolation(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to recover LogPoller from finality violation: %w", err)
+	}
+
+	return report, nil
+}
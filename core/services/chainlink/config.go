@@ -37,6 +37,8 @@ type Config struct {
 
 	EVM evmcfg.EVMConfigs `toml:",omitempty"`
 
+	EVMProfiles evmcfg.EVMProfiles `toml:",omitempty"`
+
 	Cosmos coscfg.TOMLConfigs `toml:",omitempty"`
 
 	Solana solcfg.TOMLConfigs `toml:",omitempty"`
@@ -124,7 +126,13 @@ func (c *Config) deprecationWarnings() (err error) {
 // Validate returns an error if the Config is not valid for use, as-is.
 // This is typically used after defaults have been applied.
 func (c *Config) Validate() error {
-	if err := commonconfig.Validate(c); err != nil {
+	// EVMProfiles are partial, so their Chain fields are validated as part of the EVM chains they are applied to.
+	withoutProfiles := *c
+	withoutProfiles.EVMProfiles = nil
+	err := errors.Join(commonconfig.Validate(&withoutProfiles),
+		commonconfig.NamedMultiErrorList(c.EVMProfiles.ValidateConfig(), "EVMProfiles"),
+		commonconfig.NamedMultiErrorList(c.EVM.ValidateProfiles(c.EVMProfiles), "EVM"))
+	if _, err = commonconfig.MultiErrorList(err); err != nil {
 		return fmt.Errorf("invalid configuration: %w", err)
 	}
 	return nil
@@ -140,7 +148,7 @@ func (c *Config) setDefaults() {
 		if input := c.EVM[i]; input == nil {
 			c.EVM[i] = &evmcfg.EVMConfig{Chain: evmcfg.Defaults(nil)}
 		} else {
-			input.Chain = evmcfg.Defaults(input.ChainID, &input.Chain)
+			input.Chain = evmcfg.Defaults(input.ChainID, append(c.evmProfileChains(input), &input.Chain)...)
 		}
 	}
 
@@ -166,6 +174,19 @@ func (c *Config) setDefaults() {
 	}
 }
 
+// evmProfileChains returns the Chains of the profile input inherits from, if any, in the order they apply. Invalid
+// profile references are ignored here, and reported by Validate.
+func (c *Config) evmProfileChains(input *evmcfg.EVMConfig) []*evmcfg.Chain {
+	if input.Profile == nil {
+		return nil
+	}
+	chains, err := c.EVMProfiles.Chains(*input.Profile)
+	if err != nil {
+		return nil
+	}
+	return chains
+}
+
 func (c *Config) SetFrom(f *Config) (err error) {
 	c.Core.SetFrom(&f.Core)
 
@@ -173,6 +194,8 @@ func (c *Config) SetFrom(f *Config) (err error) {
 		err = multierr.Append(err, commonconfig.NamedMultiErrorList(err1, "EVM"))
 	}
 
+	c.EVMProfiles.SetFrom(&f.EVMProfiles)
+
 	if err2 := c.Cosmos.SetFrom(&f.Cosmos); err2 != nil {
 		err = multierr.Append(err, commonconfig.NamedMultiErrorList(err2, "Cosmos"))
 	}
@@ -226,6 +249,10 @@ func (s *Secrets) SetFrom(f *Secrets) (err error) {
 		err = multierr.Append(err, commonconfig.NamedMultiErrorList(err2, "Threshold"))
 	}
 
+	if err2 := s.Alerting.SetFrom(&f.Alerting); err2 != nil {
+		err = multierr.Append(err, commonconfig.NamedMultiErrorList(err2, "Alerting"))
+	}
+
 	_, err = commonconfig.MultiErrorList(err)
 
 	return err
@@ -0,0 +1,75 @@
+package chainlink
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/services/alerting"
+)
+
+var _ config.Alerting = (*alertingConfig)(nil)
+
+type alertingConfig struct {
+	c toml.Alerting
+	s toml.AlertingSecrets
+}
+
+func (a *alertingConfig) Enabled() bool {
+	return *a.c.Enabled
+}
+
+func (a *alertingConfig) DedupWindow() time.Duration {
+	return a.c.DedupWindow.Duration()
+}
+
+func (a *alertingConfig) MaxPerMinute() uint32 {
+	return *a.c.MaxPerMinute
+}
+
+func (a *alertingConfig) SendTimeout() time.Duration {
+	return a.c.SendTimeout.Duration()
+}
+
+func (a *alertingConfig) Webhooks() []config.AlertingWebhook {
+	var webhooks []config.AlertingWebhook
+	for _, w := range a.c.Webhooks {
+		webhooks = append(webhooks, &alertingWebhookConfig{c: w, s: a.s.Webhooks[*w.Name]})
+	}
+	return webhooks
+}
+
+type alertingWebhookConfig struct {
+	c toml.AlertingWebhook
+	s toml.AlertingWebhookSecrets
+}
+
+func (w *alertingWebhookConfig) Name() string {
+	return *w.c.Name
+}
+
+func (w *alertingWebhookConfig) Type() string {
+	return *w.c.Type
+}
+
+func (w *alertingWebhookConfig) URL() *url.URL {
+	if w.s.URL == nil {
+		return nil
+	}
+	return w.s.URL.URL()
+}
+
+func (w *alertingWebhookConfig) RoutingKey() string {
+	if w.s.RoutingKey == nil {
+		return ""
+	}
+	return string(*w.s.RoutingKey)
+}
+
+func (w *alertingWebhookConfig) MinSeverity() string {
+	if w.c.MinSeverity == nil {
+		return string(alerting.SeverityWarning)
+	}
+	return *w.c.MinSeverity
+}
@@ -0,0 +1,41 @@
+package chainlink
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlertingConfig(t *testing.T) {
+	opts := GeneralConfigOpts{
+		ConfigStrings:  []string{fullTOML},
+		SecretsStrings: []string{secretsFullTOML},
+	}
+	cfg, err := opts.New()
+	require.NoError(t, err)
+
+	a := cfg.Alerting()
+	assert.True(t, a.Enabled())
+	assert.Equal(t, 30*time.Minute, a.DedupWindow())
+	assert.Equal(t, uint32(5), a.MaxPerMinute())
+	assert.Equal(t, 5*time.Second, a.SendTimeout())
+	require.Len(t, a.Webhooks(), 1)
+	w := a.Webhooks()[0]
+	assert.Equal(t, "on-call", w.Name())
+	assert.Equal(t, "pagerduty", w.Type())
+	assert.Nil(t, w.URL(), "the PagerDuty Events API is used by default")
+	assert.Equal(t, "routing-key", w.RoutingKey())
+	assert.Equal(t, "critical", w.MinSeverity())
+
+	opts = GeneralConfigOpts{}
+	cfg, err = opts.New()
+	require.NoError(t, err)
+
+	a = cfg.Alerting()
+	assert.False(t, a.Enabled())
+	assert.Equal(t, 10*time.Minute, a.DedupWindow())
+	assert.Equal(t, uint32(10), a.MaxPerMinute())
+	assert.Empty(t, a.Webhooks())
+}
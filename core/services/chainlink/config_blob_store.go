@@ -0,0 +1,52 @@
+package chainlink
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+)
+
+var _ config.BlobStore = (*blobStoreConfig)(nil)
+
+type blobStoreConfig struct {
+	c       toml.BlobStore
+	rootDir func() string
+}
+
+func (b *blobStoreConfig) Backend() string {
+	return *b.c.Backend
+}
+
+func (b *blobStoreConfig) Dir() string {
+	s := *b.c.Dir
+	if s == "" {
+		s = filepath.Join(b.rootDir(), "blobs")
+	}
+	return s
+}
+
+func (b *blobStoreConfig) Bucket() string {
+	return *b.c.Bucket
+}
+
+func (b *blobStoreConfig) Prefix() string {
+	return *b.c.Prefix
+}
+
+func (b *blobStoreConfig) Region() string {
+	return *b.c.Region
+}
+
+func (b *blobStoreConfig) Endpoint() string {
+	return *b.c.Endpoint
+}
+
+func (b *blobStoreConfig) MaxAge() time.Duration {
+	return b.c.MaxAge.Duration()
+}
+
+func (b *blobStoreConfig) GCInterval() time.Duration {
+	return b.c.GCInterval.Duration()
+}
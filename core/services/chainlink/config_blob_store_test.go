@@ -0,0 +1,37 @@
+package chainlink
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobStoreConfig(t *testing.T) {
+	opts := GeneralConfigOpts{
+		ConfigStrings: []string{fullTOML},
+	}
+	cfg, err := opts.New()
+	require.NoError(t, err)
+
+	b := cfg.BlobStore()
+	assert.Equal(t, "s3", b.Backend())
+	assert.Equal(t, "/var/lib/chainlink/blobs", b.Dir())
+	assert.Equal(t, "chainlink-artifacts", b.Bucket())
+	assert.Equal(t, "node-1", b.Prefix())
+	assert.Equal(t, "us-east-1", b.Region())
+	assert.Equal(t, "http://localhost:9000", b.Endpoint())
+	assert.Equal(t, 168*time.Hour, b.MaxAge())
+	assert.Equal(t, 30*time.Minute, b.GCInterval())
+
+	opts = GeneralConfigOpts{}
+	cfg, err = opts.New()
+	require.NoError(t, err)
+
+	b = cfg.BlobStore()
+	assert.Empty(t, b.Backend())
+	assert.Equal(t, filepath.Join(cfg.RootDir(), "blobs"), b.Dir())
+	assert.Equal(t, 720*time.Hour, b.MaxAge())
+}
@@ -0,0 +1,22 @@
+package chainlink
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+)
+
+var _ config.CCIP = (*ccipConfig)(nil)
+
+type ccipConfig struct {
+	c func() toml.CCIP // read on every call, since ReloadConfig replaces it
+}
+
+func (c *ccipConfig) GasPriceUpdateInterval() time.Duration {
+	return c.c().GasPriceUpdateInterval.Duration()
+}
+
+func (c *ccipConfig) TokenPriceUpdateInterval() time.Duration {
+	return c.c().TokenPriceUpdateInterval.Duration()
+}
@@ -0,0 +1,29 @@
+package chainlink
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCCIPConfig(t *testing.T) {
+	opts := GeneralConfigOpts{
+		ConfigStrings: []string{fullTOML},
+	}
+	cfg, err := opts.New()
+	require.NoError(t, err)
+
+	c := cfg.CCIP()
+	assert.Equal(t, 2*time.Minute, c.GasPriceUpdateInterval())
+	assert.Equal(t, 15*time.Minute, c.TokenPriceUpdateInterval())
+
+	opts = GeneralConfigOpts{}
+	cfg, err = opts.New()
+	require.NoError(t, err)
+
+	c = cfg.CCIP()
+	assert.Equal(t, time.Minute, c.GasPriceUpdateInterval())
+	assert.Equal(t, 10*time.Minute, c.TokenPriceUpdateInterval())
+}
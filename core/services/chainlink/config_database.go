@@ -66,6 +66,62 @@ func (l *listenerConfig) FallbackPollInterval() time.Duration {
 	return l.c.FallbackPollInterval.Duration()
 }
 
+type partitioningConfig struct {
+	c toml.DatabasePartitioning
+}
+
+func (p *partitioningConfig) Enabled() bool {
+	return *p.c.Enabled
+}
+
+func (p *partitioningConfig) CheckInterval() time.Duration {
+	return p.c.CheckInterval.Duration()
+}
+
+func (p *partitioningConfig) LogsBlockRange() uint64 {
+	return *p.c.LogsBlockRange
+}
+
+func (p *partitioningConfig) LogsRetention() time.Duration {
+	return p.c.LogsRetention.Duration()
+}
+
+func (p *partitioningConfig) PipelineRunsRange() uint64 {
+	return *p.c.PipelineRunsRange
+}
+
+func (p *partitioningConfig) PipelineRunsRetention() time.Duration {
+	return p.c.PipelineRunsRetention.Duration()
+}
+
+type slowQueriesConfig struct {
+	c toml.DatabaseSlowQueries
+}
+
+func (s *slowQueriesConfig) Enabled() bool {
+	return *s.c.Enabled
+}
+
+func (s *slowQueriesConfig) Threshold() time.Duration {
+	return s.c.Threshold.Duration()
+}
+
+type bulkInsertConfig struct {
+	c toml.DatabaseBulkInsert
+}
+
+func (b *bulkInsertConfig) Enabled() bool {
+	return *b.c.Enabled
+}
+
+func (b *bulkInsertConfig) FlushSize() uint32 {
+	return *b.c.FlushSize
+}
+
+func (b *bulkInsertConfig) MinRows() uint32 {
+	return *b.c.MinRows
+}
+
 var _ config.Database = (*databaseConfig)(nil)
 
 type databaseConfig struct {
@@ -93,6 +149,24 @@ func (d *databaseConfig) Listener() config.Listener {
 	}
 }
 
+func (d *databaseConfig) Partitioning() config.Partitioning {
+	return &partitioningConfig{
+		c: d.c.Partitioning,
+	}
+}
+
+func (d *databaseConfig) SlowQueries() config.SlowQueries {
+	return &slowQueriesConfig{
+		c: d.c.SlowQueries,
+	}
+}
+
+func (d *databaseConfig) BulkInsert() config.BulkInsert {
+	return &bulkInsertConfig{
+		c: d.c.BulkInsert,
+	}
+}
+
 func (d *databaseConfig) DefaultIdleInTxSessionTimeout() time.Duration {
 	return d.c.DefaultIdleInTxSessionTimeout.Duration()
 }
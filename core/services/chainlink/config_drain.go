@@ -0,0 +1,22 @@
+package chainlink
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+)
+
+var _ config.Drain = (*drainConfig)(nil)
+
+type drainConfig struct {
+	c toml.Drain
+}
+
+func (d *drainConfig) OnSIGTERM() bool {
+	return *d.c.OnSIGTERM
+}
+
+func (d *drainConfig) Timeout() time.Duration {
+	return d.c.Timeout.Duration()
+}
@@ -0,0 +1,29 @@
+package chainlink
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDrainConfig(t *testing.T) {
+	opts := GeneralConfigOpts{
+		ConfigStrings: []string{fullTOML},
+	}
+	cfg, err := opts.New()
+	require.NoError(t, err)
+
+	d := cfg.Drain()
+	assert.True(t, d.OnSIGTERM())
+	assert.Equal(t, 5*time.Minute, d.Timeout())
+
+	opts = GeneralConfigOpts{}
+	cfg, err = opts.New()
+	require.NoError(t, err)
+
+	d = cfg.Drain()
+	assert.False(t, d.OnSIGTERM())
+	assert.Equal(t, 2*time.Minute, d.Timeout())
+}
@@ -21,3 +21,7 @@ func (f *featureConfig) UICSAKeys() bool {
 func (f *featureConfig) MultiFeedsManagers() bool {
 	return *f.c.MultiFeedsManagers
 }
+
+func (f *featureConfig) LogPollerBroadcaster() bool {
+	return *f.c.LogPollerBroadcaster
+}
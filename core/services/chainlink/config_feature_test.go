@@ -19,4 +19,5 @@ func TestFeatureConfig(t *testing.T) {
 	assert.True(t, f.FeedsManager())
 	assert.True(t, f.UICSAKeys())
 	assert.True(t, f.MultiFeedsManagers())
+	assert.True(t, f.LogPollerBroadcaster())
 }
@@ -44,9 +44,12 @@ type generalConfig struct {
 
 	appIDOnce sync.Once
 
-	logMu sync.RWMutex // for the mutable fields Log.Level & Log.SQL
+	logMu sync.RWMutex // for the mutable fields Log.Level, Log.SQL & CCIP
 
 	passwordMu sync.RWMutex // passwords are set after initialization
+
+	configFiles []string     // the files the config was loaded from, see ReloadConfig
+	reloadMu    sync.RWMutex // for inputTOML & effectiveTOML, and to serialize reloads
 }
 
 // GeneralConfigOpts holds configuration options for creating a coreconfig.GeneralConfig via New().
@@ -63,23 +66,19 @@ type GeneralConfigOpts struct {
 	OverrideFn func(*Config, *Secrets)
 
 	SkipEnv bool
+
+	// ConfigFiles are the files ConfigStrings were read from by Setup, which are read again to reload the config.
+	ConfigFiles []string
 }
 
 func (o *GeneralConfigOpts) Setup(configFiles []string, secretsFiles []string) error {
-	configs := []string{}
-	for _, fileName := range configFiles {
-		b, err := os.ReadFile(fileName)
-		if err != nil {
-			return errors.Wrapf(err, "failed to read config file: %s", fileName)
-		}
-		configs = append(configs, string(b))
-	}
-
-	if configTOML := env.Config.Get(); configTOML != "" {
-		configs = append(configs, configTOML)
+	configs, err := readConfigFiles(configFiles)
+	if err != nil {
+		return err
 	}
 
 	o.ConfigStrings = configs
+	o.ConfigFiles = configFiles
 
 	secrets := []string{}
 	for _, fileName := range secretsFiles {
@@ -94,6 +93,23 @@ func (o *GeneralConfigOpts) Setup(configFiles []string, secretsFiles []string) e
 	return nil
 }
 
+// readConfigFiles reads the config TOML from configFiles, followed by any from the environment.
+func readConfigFiles(configFiles []string) ([]string, error) {
+	configs := []string{}
+	for _, fileName := range configFiles {
+		b, err := os.ReadFile(fileName)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read config file: %s", fileName)
+		}
+		configs = append(configs, string(b))
+	}
+
+	if configTOML := env.Config.Get(); configTOML != "" {
+		configs = append(configs, configTOML)
+	}
+	return configs, nil
+}
+
 // parseConfig sets Config from the given TOML string, overriding any existing duplicate Config fields.
 func (o *GeneralConfigOpts) parseConfig(config string) error {
 	var c Config
@@ -166,6 +182,7 @@ func (o GeneralConfigOpts) New() (GeneralConfig, error) {
 		c:             &o.Config,
 		secrets:       &o.Secrets,
 		warning:       warning,
+		configFiles:   o.ConfigFiles,
 	}
 	if lvl := o.Config.Log.Level; lvl != nil {
 		cfg.logLevelDefault = zapcore.Level(*lvl)
@@ -261,6 +278,8 @@ func validateEnv() (err error) {
 }
 
 func (g *generalConfig) LogConfiguration(log, warn coreconfig.LogfFn) {
+	g.reloadMu.RLock()
+	defer g.reloadMu.RUnlock()
 	log("# Secrets:\n%s\n", g.secretsTOML)
 	log("# Input Configuration:\n%s\n", g.inputTOML)
 	log("# Effective Configuration, with defaults applied:\n%s\n", g.effectiveTOML)
@@ -271,6 +290,8 @@ func (g *generalConfig) LogConfiguration(log, warn coreconfig.LogfFn) {
 
 // ConfigTOML implements chainlink.ConfigV2
 func (g *generalConfig) ConfigTOML() (user, effective string) {
+	g.reloadMu.RLock()
+	defer g.reloadMu.RUnlock()
 	return g.inputTOML, g.effectiveTOML
 }
 
@@ -302,6 +323,22 @@ func (g *generalConfig) AutoPprof() config.AutoPprof {
 	return &autoPprofConfig{c: g.c.AutoPprof, rootDir: g.RootDir}
 }
 
+func (g *generalConfig) Alerting() config.Alerting {
+	return &alertingConfig{c: g.c.Alerting, s: g.secrets.Alerting}
+}
+
+func (g *generalConfig) BlobStore() config.BlobStore {
+	return &blobStoreConfig{c: g.c.BlobStore, rootDir: g.RootDir}
+}
+
+func (g *generalConfig) CCIP() config.CCIP {
+	return &ccipConfig{c: g.ccip}
+}
+
+func (g *generalConfig) Drain() config.Drain {
+	return &drainConfig{c: g.c.Drain}
+}
+
 func (g *generalConfig) EVMEnabled() bool {
 	for _, c := range g.c.EVM {
 		if c.IsEnabled() {
@@ -489,7 +526,8 @@ func (g *generalConfig) RootDir() string {
 
 func (g *generalConfig) TelemetryIngress() coreconfig.TelemetryIngress {
 	return &telemetryIngressConfig{
-		c: g.c.TelemetryIngress,
+		c:       g.c.TelemetryIngress,
+		rootDir: g.RootDir,
 	}
 }
 
@@ -528,4 +566,16 @@ func (g *generalConfig) Telemetry() coreconfig.Telemetry {
 	return &telemetryConfig{s: g.c.Telemetry}
 }
 
+func (g *generalConfig) TxAuditExport() coreconfig.TxAuditExport {
+	return &txAuditExportConfig{c: g.c.TxAuditExport, rootDir: g.RootDir}
+}
+
+func (g *generalConfig) JobDistributor() coreconfig.JobDistributor {
+	return &jobDistributorConfig{c: g.c.JobDistributor}
+}
+
+func (g *generalConfig) Maintenance() coreconfig.Maintenance {
+	return &maintenanceConfig{c: g.c.Maintenance}
+}
+
 var zeroSha256Hash = models.Sha256Hash{}
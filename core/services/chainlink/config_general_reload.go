@@ -0,0 +1,259 @@
+package chainlink
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"strings"
+
+	gotoml "github.com/pelletier/go-toml/v2"
+	"github.com/pkg/errors"
+	"golang.org/x/exp/maps"
+
+	evmcfg "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+)
+
+// ReloadableFields lists the fields of Config which ReloadConfig applies to a running node. Changes to any other
+// field require a restart.
+var ReloadableFields = []string{
+	"Log.Level",
+	"Database.LogQueries",
+	"EVM.Nodes",
+	"EVM.GasEstimator.PriceDefault",
+	"EVM.GasEstimator.PriceMax",
+	"EVM.GasEstimator.PriceMin",
+	"EVM.GasEstimator.LimitDefault",
+	"EVM.GasEstimator.LimitMax",
+	"EVM.GasEstimator.LimitMultiplier",
+	"EVM.GasEstimator.LimitTransfer",
+	"CCIP.GasPriceUpdateInterval",
+	"CCIP.TokenPriceUpdateInterval",
+}
+
+// ReloadConfig re-reads the config files and applies changes to the ReloadableFields, returning the names of those
+// which changed. The new config must be valid, and must not change any other field, otherwise an error is returned
+// and nothing is applied. Services observe the new values the next time they read them, except for the EVM.Nodes,
+// which the application passes on to the chain clients.
+func (g *generalConfig) ReloadConfig() ([]string, error) {
+	if len(g.configFiles) == 0 {
+		return nil, errors.New("config cannot be reloaded: not loaded from files")
+	}
+
+	g.reloadMu.Lock()
+	defer g.reloadMu.Unlock()
+
+	configs, err := readConfigFiles(g.configFiles)
+	if err != nil {
+		return nil, err
+	}
+	next, input, err := parseReloadedConfig(configs)
+	if err != nil {
+		return nil, err
+	}
+	if err = next.Validate(); err != nil {
+		return nil, err
+	}
+	if err = g.checkReloadable(configs); err != nil {
+		return nil, err
+	}
+
+	var changed []string
+	g.logMu.Lock()
+	if *g.c.Log.Level != *next.Log.Level {
+		g.c.Log.Level = next.Log.Level
+		changed = append(changed, "Log.Level")
+	}
+	if *g.c.Database.LogQueries != *next.Database.LogQueries {
+		g.c.Database.LogQueries = next.Database.LogQueries
+		changed = append(changed, "Database.LogQueries")
+	}
+	if g.c.CCIP.GasPriceUpdateInterval.Duration() != next.CCIP.GasPriceUpdateInterval.Duration() {
+		g.c.CCIP.GasPriceUpdateInterval = next.CCIP.GasPriceUpdateInterval
+		changed = append(changed, "CCIP.GasPriceUpdateInterval")
+	}
+	if g.c.CCIP.TokenPriceUpdateInterval.Duration() != next.CCIP.TokenPriceUpdateInterval.Duration() {
+		g.c.CCIP.TokenPriceUpdateInterval = next.CCIP.TokenPriceUpdateInterval
+		changed = append(changed, "CCIP.TokenPriceUpdateInterval")
+	}
+	g.logMu.Unlock()
+
+	for _, n := range next.EVM {
+		// the chains read their GasEstimator concurrently, so it is replaced by an updated copy
+		c := findEVMConfig(g.c.EVM, n)
+		ge := c.GasEstimatorSnapshot()
+		names := reloadGasEstimator(&ge, &n.GasEstimator)
+		if len(names) == 0 {
+			continue
+		}
+		c.ReloadGasEstimator(ge)
+		for _, name := range names {
+			changed = append(changed, fmt.Sprintf("EVM.%s.GasEstimator.%s", n.ChainID, name))
+		}
+	}
+	for _, n := range next.EVM {
+		c := findEVMConfig(g.c.EVM, n)
+		if !nodesEqual(c.NodesSnapshot(), n.Nodes) {
+			c.ReloadNodes(n.Nodes)
+			changed = append(changed, fmt.Sprintf("EVM.%s.Nodes", n.ChainID))
+		}
+	}
+
+	g.logMu.RLock()
+	effective, err := g.c.TOMLString()
+	g.logMu.RUnlock()
+	if err != nil {
+		return changed, err
+	}
+	g.inputTOML, g.effectiveTOML = input, effective
+
+	return changed, nil
+}
+
+// parseReloadedConfig returns the Config from configs with defaults applied, along with the input TOML.
+func parseReloadedConfig(configs []string) (*Config, string, error) {
+	o := GeneralConfigOpts{ConfigStrings: configs}
+	for _, c := range o.ConfigStrings {
+		if err := o.parseConfig(c); err != nil {
+			return nil, "", err
+		}
+	}
+	input, err := o.Config.TOMLString()
+	if err != nil {
+		return nil, "", err
+	}
+	o.Config.setDefaults()
+	return &o.Config, input, nil
+}
+
+// checkReloadable returns an error if configs differ from the current Config other than in the ReloadableFields.
+func (g *generalConfig) checkReloadable(configs []string) error {
+	masked, _, err := parseReloadedConfig(configs)
+	if err != nil {
+		return err
+	}
+	if len(masked.EVM) != len(g.c.EVM) {
+		return errors.New("restart required to apply changes to the EVM chains")
+	}
+	for _, m := range masked.EVM {
+		c := findEVMConfig(g.c.EVM, m)
+		if c == nil {
+			return errors.Errorf("restart required to apply changes to the EVM chains: chain %s added", m.ChainID)
+		}
+		current := c.GasEstimatorSnapshot()
+		reloadGasEstimator(&m.GasEstimator, &current)
+		m.Nodes = c.NodesSnapshot()
+	}
+
+	g.logMu.RLock()
+	masked.Log.Level = g.c.Log.Level
+	masked.Database.LogQueries = g.c.Database.LogQueries
+	masked.CCIP = g.c.CCIP
+	current, err := g.c.TOMLString()
+	g.logMu.RUnlock()
+	if err != nil {
+		return err
+	}
+	next, err := masked.TOMLString()
+	if err != nil {
+		return err
+	}
+	if diff := diffTOML(current, next); len(diff) > 0 {
+		return errors.Errorf("restart required to apply changes to: %s", strings.Join(diff, ", "))
+	}
+	return nil
+}
+
+func findEVMConfig(cs evmcfg.EVMConfigs, c *evmcfg.EVMConfig) *evmcfg.EVMConfig {
+	for _, e := range cs {
+		if e.ChainID.Cmp(c.ChainID) == 0 {
+			return e
+		}
+	}
+	return nil
+}
+
+// nodesEqual returns true if a and b configure the same nodes in the same order.
+func nodesEqual(a, b evmcfg.EVMNodes) bool {
+	return slices.EqualFunc(a, b, func(x, y *evmcfg.Node) bool {
+		xb, xerr := gotoml.Marshal(x)
+		yb, yerr := gotoml.Marshal(y)
+		return xerr == nil && yerr == nil && bytes.Equal(xb, yb)
+	})
+}
+
+// reloadGasEstimator sets the reloadable fields of dst to those of src, and returns the names of those which changed.
+func reloadGasEstimator(dst, src *evmcfg.GasEstimator) (changed []string) {
+	if dst.PriceDefault.Cmp(src.PriceDefault) != 0 {
+		dst.PriceDefault = src.PriceDefault
+		changed = append(changed, "PriceDefault")
+	}
+	if dst.PriceMax.Cmp(src.PriceMax) != 0 {
+		dst.PriceMax = src.PriceMax
+		changed = append(changed, "PriceMax")
+	}
+	if dst.PriceMin.Cmp(src.PriceMin) != 0 {
+		dst.PriceMin = src.PriceMin
+		changed = append(changed, "PriceMin")
+	}
+	if *dst.LimitDefault != *src.LimitDefault {
+		dst.LimitDefault = src.LimitDefault
+		changed = append(changed, "LimitDefault")
+	}
+	if *dst.LimitMax != *src.LimitMax {
+		dst.LimitMax = src.LimitMax
+		changed = append(changed, "LimitMax")
+	}
+	if !dst.LimitMultiplier.Equal(*src.LimitMultiplier) {
+		dst.LimitMultiplier = src.LimitMultiplier
+		changed = append(changed, "LimitMultiplier")
+	}
+	if *dst.LimitTransfer != *src.LimitTransfer {
+		dst.LimitTransfer = src.LimitTransfer
+		changed = append(changed, "LimitTransfer")
+	}
+	return
+}
+
+// diffTOML returns the keys, qualified by their table, of the lines which differ between a and b.
+func diffTOML(a, b string) []string {
+	al, bl := tomlLines(a), tomlLines(b)
+	seen := map[string]bool{}
+	add := func(lines, other map[string]string) {
+		for l, key := range lines {
+			if _, ok := other[l]; !ok {
+				seen[key] = true
+			}
+		}
+	}
+	add(al, bl)
+	add(bl, al)
+	keys := maps.Keys(seen)
+	slices.Sort(keys)
+	return keys
+}
+
+// tomlLines returns the lines of s, each prefixed by the table it is in and indexed by the occurrence of that table,
+// mapped to their key qualified by the table.
+func tomlLines(s string) map[string]string {
+	lines := map[string]string{}
+	tables := map[string]int{}
+	var table, indexed string
+	for _, l := range strings.Split(s, "\n") {
+		l = strings.TrimSpace(l)
+		switch {
+		case l == "":
+		case strings.HasPrefix(l, "["):
+			table = strings.Trim(l, "[]")
+			indexed = fmt.Sprintf("%s#%d", table, tables[table])
+			tables[table]++
+			lines[indexed] = table
+		default:
+			key := strings.TrimSpace(strings.SplitN(l, "=", 2)[0])
+			if table != "" {
+				key = table + "." + key
+			}
+			lines[indexed+"."+l] = key
+		}
+	}
+	return lines
+}
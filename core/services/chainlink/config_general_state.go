@@ -45,6 +45,13 @@ func (g *generalConfig) SetLogSQL(logSQL bool) {
 	g.logMu.Unlock()
 }
 
+func (g *generalConfig) ccip() (c toml.CCIP) {
+	g.logMu.RLock()
+	c = g.c.CCIP
+	g.logMu.RUnlock()
+	return
+}
+
 func (g *generalConfig) SetPasswords(keystore, vrf *string) {
 	g.passwordMu.Lock()
 	defer g.passwordMu.Unlock()
@@ -0,0 +1,41 @@
+package chainlink
+
+import (
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+)
+
+type jobDistributorConfig struct {
+	c toml.JobDistributor
+}
+
+func (j *jobDistributorConfig) CCIPAutoApprove() bool {
+	return *j.c.CCIPAutoApprove
+}
+
+func (j *jobDistributorConfig) CCIPChainSelectors() (selectors []uint64) {
+	if j.c.CCIPChainSelectors == nil {
+		return nil
+	}
+	for _, s := range *j.c.CCIPChainSelectors {
+		sel, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			continue // validated
+		}
+		selectors = append(selectors, sel)
+	}
+	return selectors
+}
+
+func (j *jobDistributorConfig) CCIPContractAddresses() (addresses []common.Address) {
+	if j.c.CCIPContractAddresses == nil {
+		return nil
+	}
+	for _, a := range *j.c.CCIPContractAddresses {
+		addresses = append(addresses, a.Address())
+	}
+	return addresses
+}
@@ -0,0 +1,30 @@
+package chainlink
+
+import (
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/services/maintenance"
+)
+
+type maintenanceConfig struct {
+	c toml.Maintenance
+}
+
+func (m *maintenanceConfig) Windows() []maintenance.Window {
+	if m.c.Windows == nil {
+		return nil
+	}
+	return *m.c.Windows
+}
+
+func (m *maintenanceConfig) Services() (services []string) {
+	if *m.c.LogPollerPruning {
+		services = append(services, maintenance.LogPollerPruning)
+	}
+	if *m.c.TxmReaper {
+		services = append(services, maintenance.TxmReaper)
+	}
+	if *m.c.CCIPPriceCleanup {
+		services = append(services, maintenance.CCIPPriceCleanup)
+	}
+	return
+}
@@ -0,0 +1,25 @@
+package chainlink
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/maintenance"
+)
+
+func TestMaintenanceConfig(t *testing.T) {
+	opts := GeneralConfigOpts{
+		ConfigStrings: []string{fullTOML},
+	}
+	cfg, err := opts.New()
+	require.NoError(t, err)
+
+	m := cfg.Maintenance()
+	windows := m.Windows()
+	require.Len(t, windows, 2)
+	assert.Equal(t, "02:00-04:00", windows[0].String())
+	assert.Equal(t, "22:30-23:00", windows[1].String())
+	assert.Equal(t, []string{maintenance.LogPollerPruning, maintenance.TxmReaper, maintenance.CCIPPriceCleanup}, m.Services())
+}
@@ -2,16 +2,24 @@ package chainlink
 
 import (
 	"net/url"
+	"path/filepath"
 	"time"
 
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 var _ config.TelemetryIngress = (*telemetryIngressConfig)(nil)
 
 type telemetryIngressConfig struct {
-	c toml.TelemetryIngress
+	c       toml.TelemetryIngress
+	rootDir func() string
+}
+
+type telemetryIngressDiskBufferConfig struct {
+	c       toml.TelemetryIngressDiskBuffer
+	rootDir func() string
 }
 
 type telemetryIngressEndpointConfig struct {
@@ -46,6 +54,10 @@ func (t *telemetryIngressConfig) UseBatchSend() bool {
 	return *t.c.UseBatchSend
 }
 
+func (t *telemetryIngressConfig) DiskBuffer() config.TelemetryIngressDiskBuffer {
+	return &telemetryIngressDiskBufferConfig{c: t.c.DiskBuffer, rootDir: t.rootDir}
+}
+
 func (t *telemetryIngressConfig) Endpoints() []config.TelemetryIngressEndpoint {
 	var endpoints []config.TelemetryIngressEndpoint
 	for _, e := range t.c.Endpoints {
@@ -56,6 +68,30 @@ func (t *telemetryIngressConfig) Endpoints() []config.TelemetryIngressEndpoint {
 	return endpoints
 }
 
+func (b *telemetryIngressDiskBufferConfig) Enabled() bool {
+	return *b.c.Enabled
+}
+
+func (b *telemetryIngressDiskBufferConfig) Dir() string {
+	s := *b.c.Dir
+	if s == "" {
+		s = filepath.Join(b.rootDir(), "telemetry")
+	}
+	return s
+}
+
+func (b *telemetryIngressDiskBufferConfig) MaxSize() utils.FileSize {
+	return *b.c.MaxSize
+}
+
+func (b *telemetryIngressDiskBufferConfig) MaxAge() time.Duration {
+	return b.c.MaxAge.Duration()
+}
+
+func (b *telemetryIngressDiskBufferConfig) ReplayRate() uint {
+	return uint(*b.c.ReplayRate)
+}
+
 func (t *telemetryIngressEndpointConfig) Network() string {
 	return *t.c.Network
 }
@@ -1,11 +1,14 @@
 package chainlink
 
 import (
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 func TestTelemetryIngressConfig(t *testing.T) {
@@ -32,3 +35,28 @@ func TestTelemetryIngressConfig(t *testing.T) {
 	assert.Equal(t, "prom.test", tec[0].URL().String())
 	assert.Equal(t, "test-pub-key", tec[0].ServerPubKey())
 }
+
+func TestTelemetryIngressDiskBufferConfig(t *testing.T) {
+	opts := GeneralConfigOpts{
+		ConfigStrings: []string{fullTOML},
+	}
+	cfg, err := opts.New()
+	require.NoError(t, err)
+
+	b := cfg.TelemetryIngress().DiskBuffer()
+	assert.True(t, b.Enabled())
+	assert.Equal(t, "/my/telemetry/directory", b.Dir())
+	assert.Equal(t, utils.FileSize(50*utils.MB), b.MaxSize())
+	assert.Equal(t, 12*time.Hour, b.MaxAge())
+	assert.Equal(t, uint(25), b.ReplayRate())
+
+	opts = GeneralConfigOpts{}
+	cfg, err = opts.New()
+	require.NoError(t, err)
+	b = cfg.TelemetryIngress().DiskBuffer()
+	assert.False(t, b.Enabled())
+	assert.Equal(t, filepath.Join(cfg.RootDir(), "telemetry"), b.Dir())
+	assert.Equal(t, utils.FileSize(100*utils.MB), b.MaxSize())
+	assert.Equal(t, 24*time.Hour, b.MaxAge())
+	assert.Equal(t, uint(10), b.ReplayRate())
+}
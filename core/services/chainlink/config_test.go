@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/kylelemons/godebug/diff"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
@@ -37,6 +38,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink/cfgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/maintenance"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
@@ -211,6 +213,11 @@ func TestConfig_Marshal(t *testing.T) {
 		require.NoError(t, err)
 		return &d
 	}
+	mustWindow := func(s string) maintenance.Window {
+		w, err := maintenance.ParseWindow(s)
+		require.NoError(t, err)
+		return w
+	}
 	mustAddress := func(s string) *types.EIP55Address {
 		a, err := types.NewEIP55Address(s)
 		require.NoError(t, err)
@@ -258,11 +265,12 @@ func TestConfig_Marshal(t *testing.T) {
 	}
 
 	full.Feature = toml.Feature{
-		FeedsManager:       ptr(true),
-		LogPoller:          ptr(true),
-		UICSAKeys:          ptr(true),
-		CCIP:               ptr(true),
-		MultiFeedsManagers: ptr(true),
+		FeedsManager:         ptr(true),
+		LogPoller:            ptr(true),
+		UICSAKeys:            ptr(true),
+		CCIP:                 ptr(true),
+		MultiFeedsManagers:   ptr(true),
+		LogPollerBroadcaster: ptr(true),
 	}
 	full.Database = toml.Database{
 		DefaultIdleInTxSessionTimeout: commoncfg.MustNewDuration(time.Minute),
@@ -282,6 +290,23 @@ func TestConfig_Marshal(t *testing.T) {
 			LeaseDuration:        &minute,
 			LeaseRefreshInterval: &second,
 		},
+		Partitioning: toml.DatabasePartitioning{
+			Enabled:               ptr(true),
+			CheckInterval:         commoncfg.MustNewDuration(30 * time.Minute),
+			LogsBlockRange:        ptr[uint64](500_000),
+			LogsRetention:         commoncfg.MustNewDuration(30 * 24 * time.Hour),
+			PipelineRunsRange:     ptr[uint64](100_000),
+			PipelineRunsRetention: commoncfg.MustNewDuration(7 * 24 * time.Hour),
+		},
+		SlowQueries: toml.DatabaseSlowQueries{
+			Enabled:   ptr(true),
+			Threshold: commoncfg.MustNewDuration(500 * time.Millisecond),
+		},
+		BulkInsert: toml.DatabaseBulkInsert{
+			Enabled:   ptr(true),
+			FlushSize: ptr[uint32](1000),
+			MinRows:   ptr[uint32](100),
+		},
 		Backup: toml.DatabaseBackup{
 			Dir:              ptr("test/backup/dir"),
 			Frequency:        &hour,
@@ -297,6 +322,13 @@ func TestConfig_Marshal(t *testing.T) {
 		SendInterval: commoncfg.MustNewDuration(time.Minute),
 		SendTimeout:  commoncfg.MustNewDuration(5 * time.Second),
 		UseBatchSend: ptr(true),
+		DiskBuffer: toml.TelemetryIngressDiskBuffer{
+			Enabled:    ptr(true),
+			Dir:        ptr("/my/telemetry/directory"),
+			MaxSize:    ptr[utils.FileSize](50 * utils.MB),
+			MaxAge:     commoncfg.MustNewDuration(12 * time.Hour),
+			ReplayRate: ptr[uint16](25),
+		},
 		Endpoints: []toml.TelemetryIngressEndpoint{{
 			Network:      ptr("EVM"),
 			ChainID:      ptr("1"),
@@ -352,6 +384,20 @@ func TestConfig_Marshal(t *testing.T) {
 			UpstreamSyncInterval:        commoncfg.MustNewDuration(0 * time.Second),
 			UpstreamSyncRateLimit:       commoncfg.MustNewDuration(2 * time.Minute),
 		},
+		OIDC: toml.WebServerOIDC{
+			IssuerURL:      mustURL("https://idp.example.com"),
+			ClientID:       ptr("chainlink-node"),
+			RedirectURL:    mustURL("https://node.example.com/oidc/callback"),
+			Scopes:         &[]string{"openid", "email", "groups"},
+			EmailClaim:     ptr("email"),
+			GroupsClaim:    ptr("groups"),
+			AdminUserGroup: ptr("NodeAdmins"),
+			EditUserGroup:  ptr("NodeEditors"),
+			RunUserGroup:   ptr("NodeRunners"),
+			ReadUserGroup:  ptr("NodeReadOnly"),
+			SessionTimeout: commoncfg.MustNewDuration(30 * time.Minute),
+			QueryTimeout:   commoncfg.MustNewDuration(10 * time.Second),
+		},
 		RateLimit: toml.WebServerRateLimit{
 			Authenticated:         ptr[int64](42),
 			AuthenticatedPeriod:   commoncfg.MustNewDuration(time.Second),
@@ -359,12 +405,14 @@ func TestConfig_Marshal(t *testing.T) {
 			UnauthenticatedPeriod: commoncfg.MustNewDuration(time.Minute),
 		},
 		TLS: toml.WebServerTLS{
-			CertPath:      ptr("tls/cert/path"),
-			Host:          ptr("tls-host"),
-			KeyPath:       ptr("tls/key/path"),
-			HTTPSPort:     ptr[uint16](6789),
-			ForceRedirect: ptr(true),
-			ListenIP:      mustIP("192.158.1.38"),
+			CertPath:         ptr("tls/cert/path"),
+			ClientCAPath:     ptr("tls/client-ca/path"),
+			Host:             ptr("tls-host"),
+			KeyPath:          ptr("tls/key/path"),
+			KeystoreIdentity: ptr(true),
+			HTTPSPort:        ptr[uint16](6789),
+			ForceRedirect:    ptr(true),
+			ListenIP:         mustIP("192.158.1.38"),
 		},
 	}
 	full.JobPipeline = toml.JobPipeline{
@@ -521,10 +569,57 @@ func TestConfig_Marshal(t *testing.T) {
 		ResourceAttributes: map[string]string{"Baz": "test", "Foo": "bar"},
 		TraceSampleRatio:   ptr(0.01),
 	}
+	full.JobDistributor = toml.JobDistributor{
+		CCIPAutoApprove:       ptr(true),
+		CCIPChainSelectors:    &[]string{"5009297550715157269"},
+		CCIPContractAddresses: &[]types.EIP55Address{types.MustEIP55Address("0xa0Ee7A142d267C1f36714E4a8F75612F20a79720")},
+	}
+	full.Maintenance = toml.Maintenance{
+		Windows:          &[]maintenance.Window{mustWindow("02:00-04:00"), mustWindow("22:30-23:00")},
+		LogPollerPruning: ptr(true),
+		TxmReaper:        ptr(true),
+		CCIPPriceCleanup: ptr(true),
+	}
+	full.BlobStore = toml.BlobStore{
+		Backend:    ptr("s3"),
+		Dir:        ptr("/var/lib/chainlink/blobs"),
+		Bucket:     ptr("chainlink-artifacts"),
+		Prefix:     ptr("node-1"),
+		Region:     ptr("us-east-1"),
+		Endpoint:   ptr("http://localhost:9000"),
+		MaxAge:     commoncfg.MustNewDuration(168 * time.Hour),
+		GCInterval: commoncfg.MustNewDuration(30 * time.Minute),
+	}
+	full.TxAuditExport = toml.TxAuditExport{
+		Enabled: ptr(true),
+		Dir:     ptr("/var/lib/chainlink/tx-audit"),
+		Format:  ptr("parquet"),
+		Delay:   commoncfg.MustNewDuration(2 * time.Hour),
+	}
+	full.Alerting = toml.Alerting{
+		Enabled:      ptr(true),
+		DedupWindow:  commoncfg.MustNewDuration(30 * time.Minute),
+		MaxPerMinute: ptr[uint32](5),
+		SendTimeout:  commoncfg.MustNewDuration(5 * time.Second),
+		Webhooks: []toml.AlertingWebhook{{
+			Name:        ptr("on-call"),
+			Type:        ptr("pagerduty"),
+			MinSeverity: ptr("critical"),
+		}},
+	}
+	full.Drain = toml.Drain{
+		OnSIGTERM: ptr(true),
+		Timeout:   commoncfg.MustNewDuration(5 * time.Minute),
+	}
+	full.CCIP = toml.CCIP{
+		GasPriceUpdateInterval:   commoncfg.MustNewDuration(2 * time.Minute),
+		TokenPriceUpdateInterval: commoncfg.MustNewDuration(15 * time.Minute),
+	}
 	full.EVM = []*evmcfg.EVMConfig{
 		{
 			ChainID: ubig.NewI(1),
 			Enabled: ptr(false),
+			Profile: ptr("testnet-defaults"),
 			Chain: evmcfg.Chain{
 				AutoCreateKey: ptr(false),
 				BalanceMonitor: evmcfg.BalanceMonitor{
@@ -553,6 +648,7 @@ func TestConfig_Marshal(t *testing.T) {
 					EstimateLimit:      ptr(false),
 					TipCapDefault:      assets.NewWeiI(2),
 					TipCapMin:          assets.NewWeiI(1),
+					BlobPriceMax:       assets.GWei(50),
 					PriceDefault:       assets.NewWeiI(math.MaxInt64),
 					PriceMax:           assets.NewWei(mustHexToBig(t, "FFFFFFFFFFFF")),
 					PriceMin:           assets.NewWeiI(13),
@@ -575,7 +671,26 @@ func TestConfig_Marshal(t *testing.T) {
 						TransactionPercentile:     ptr[uint16](15),
 					},
 					FeeHistory: evmcfg.FeeHistoryEstimator{
-						CacheTimeout: &second,
+						CacheTimeout:         &second,
+						RegressionLookback:   ptr[uint16](10),
+						RegressionPercentile: ptr[uint16](40),
+					},
+					OnChainPriceMax: evmcfg.OnChainPriceMaxConfig{
+						Enabled:      ptr(true),
+						Contract:     ptr(types.MustEIP55Address("0x2a3e23c6f242F5345320814aC8a1b4E58707D292")),
+						Calldata:     ptr(hexutil.Bytes{0x3d, 0xe3, 0x9c, 0x11}),
+						PollInterval: commoncfg.MustNewDuration(30 * time.Second),
+					},
+				},
+
+				GasEstimatorProfiles: []evmcfg.GasEstimatorProfile{
+					{
+						Name:          ptr("ccip-exec"),
+						Mode:          ptr("FeeHistory"),
+						PriceMax:      assets.GWei(500),
+						BumpMin:       assets.GWei(10),
+						BumpPercent:   ptr[uint16](30),
+						BumpThreshold: ptr[uint32](2),
 					},
 				},
 
@@ -585,6 +700,13 @@ func TestConfig_Marshal(t *testing.T) {
 						GasEstimator: evmcfg.KeySpecificGasEstimator{
 							PriceMax: assets.NewWei(mustHexToBig(t, "FFFFFFFFFFFFFFFFFFFFFFFF")),
 						},
+						RemoteSigner: evmcfg.KeySpecificRemoteSigner{
+							Type:    ptr(evmcfg.RemoteSignerTypeWeb3Signer),
+							URL:     commoncfg.MustParseURL("http://web3signer:9000"),
+							KeyID:   ptr("key-id"),
+							Region:  ptr("us-east-1"),
+							Timeout: commoncfg.MustNewDuration(5 * time.Second),
+						},
 					},
 				},
 
@@ -610,10 +732,33 @@ func TestConfig_Marshal(t *testing.T) {
 					ReaperInterval:       &minute,
 					ReaperThreshold:      &minute,
 					ResendAfterThreshold: &hour,
+					JobSpendLimit:        assets.Ether(1),
+					JobSpendWindow:       &hour,
 					ForwardersEnabled:    ptr(true),
 					AutoPurge: evmcfg.AutoPurgeConfig{
 						Enabled: ptr(false),
 					},
+					Bundler: evmcfg.BundlerConfig{
+						Enabled:          ptr(true),
+						MulticallAddress: mustAddress("0xcA11bde05977b3631167028862bE2a173976CA11"),
+						MaxBatchSize:     ptr[uint32](5),
+						FlushInterval:    &minute,
+					},
+					NonceCoordination: evmcfg.NonceCoordinationConfig{
+						Enabled:            ptr(true),
+						LockTimeout:        commoncfg.MustNewDuration(10 * time.Second),
+						ReservationTimeout: &minute,
+					},
+					Simulation: evmcfg.SimulationConfig{
+						Enabled:       ptr(true),
+						AbortOnRevert: ptr(true),
+					},
+					CircuitBreaker: evmcfg.CircuitBreakerConfig{
+						Enabled:                   ptr(true),
+						BroadcastFailureThreshold: ptr[uint32](5),
+						ReceiptFailureThreshold:   ptr[uint32](20),
+						ResetTimeout:              commoncfg.MustNewDuration(2 * time.Minute),
+					},
 				},
 
 				HeadTracker: evmcfg.HeadTracker{
@@ -621,6 +766,7 @@ func TestConfig_Marshal(t *testing.T) {
 					MaxBufferSize:           ptr[uint32](17),
 					SamplingInterval:        &hour,
 					FinalityTagBypass:       ptr[bool](false),
+					FinalityDepthFallback:   ptr(true),
 					MaxAllowedFinalityDepth: ptr[uint32](1500),
 					PersistenceEnabled:      ptr(false),
 				},
@@ -636,6 +782,7 @@ func TestConfig_Marshal(t *testing.T) {
 					EnforceRepeatableRead:      ptr(true),
 					DeathDeclarationDelay:      &minute,
 					NewHeadsPollInterval:       &zeroSeconds,
+					CallCacheExpiration:        &minute,
 					Errors: evmcfg.ClientErrors{
 						NonceTooLow:                       ptr[string]("(: |^)nonce too low"),
 						NonceTooHigh:                      ptr[string]("(: |^)nonce too high"),
@@ -652,6 +799,22 @@ func TestConfig_Marshal(t *testing.T) {
 						Fatal:                             ptr[string]("(: |^)fatal"),
 						ServiceUnavailable:                ptr[string]("(: |^)service unavailable"),
 						TooManyResults:                    ptr[string]("(: |^)too many results"),
+						Provider:                          ptr[string]("nethermind"),
+					},
+					RateLimit: evmcfg.RPCRateLimit{
+						Rate:               ptr[uint32](50),
+						Burst:              ptr[uint32](200),
+						LogPollerWeight:    ptr[uint32](5),
+						TxmWeight:          ptr[uint32](0),
+						CCIPWeight:         ptr[uint32](2),
+						GasEstimatorWeight: ptr[uint32](1),
+					},
+					SanityChecks: evmcfg.RPCSanityChecks{
+						Enabled:                  ptr(true),
+						MaxBlockNumberRegression: ptr[uint32](50),
+						GasPriceMin:              assets.GWei(1),
+						GasPriceMax:              assets.GWei(5000),
+						QuarantineDuration:       commoncfg.MustNewDuration(10 * time.Minute),
 					},
 				},
 				OCR: evmcfg.OCR{
@@ -681,6 +844,7 @@ func TestConfig_Marshal(t *testing.T) {
 					Name:    ptr("bar"),
 					HTTPURL: mustURL("https://bar.com"),
 					WSURL:   mustURL("wss://web.socket/test/bar"),
+					Archive: ptr(true),
 				},
 				{
 					Name:     ptr("broadcast"),
@@ -689,6 +853,17 @@ func TestConfig_Marshal(t *testing.T) {
 				},
 			}},
 	}
+	full.EVMProfiles = []*evmcfg.EVMProfile{
+		{
+			Name: ptr("testnet-defaults"),
+			Chain: evmcfg.Chain{
+				FinalityDepth: ptr[uint32](10),
+				GasEstimator: evmcfg.GasEstimator{
+					PriceMax: assets.GWei(100),
+				},
+			},
+		},
+	}
 	full.Solana = []*solcfg.TOMLConfig{
 		{
 			ChainID: ptr("mainnet"),
@@ -816,6 +991,7 @@ LogPoller = true
 UICSAKeys = true
 CCIP = true
 MultiFeedsManagers = true
+LogPollerBroadcaster = true
 `},
 		{"Database", Config{Core: toml.Core{Database: full.Database}}, `[Database]
 DefaultIdleInTxSessionTimeout = '1m0s'
@@ -841,6 +1017,23 @@ FallbackPollInterval = '2m0s'
 Enabled = false
 LeaseDuration = '1m0s'
 LeaseRefreshInterval = '1s'
+
+[Database.Partitioning]
+Enabled = true
+CheckInterval = '30m0s'
+LogsBlockRange = 500000
+LogsRetention = '720h0m0s'
+PipelineRunsRange = 100000
+PipelineRunsRetention = '168h0m0s'
+
+[Database.SlowQueries]
+Enabled = true
+Threshold = '500ms'
+
+[Database.BulkInsert]
+Enabled = true
+FlushSize = 1000
+MinRows = 100
 `},
 		{"TelemetryIngress", Config{Core: toml.Core{TelemetryIngress: full.TelemetryIngress}}, `[TelemetryIngress]
 UniConn = false
@@ -851,6 +1044,13 @@ SendInterval = '1m0s'
 SendTimeout = '5s'
 UseBatchSend = true
 
+[TelemetryIngress.DiskBuffer]
+Enabled = true
+Dir = '/my/telemetry/directory'
+MaxSize = '50.00mb'
+MaxAge = '12h0m0s'
+ReplayRate = 25
+
 [[TelemetryIngress.Endpoints]]
 Network = 'EVM'
 ChainID = '1'
@@ -902,6 +1102,20 @@ UserAPITokenDuration = '240h0m0s'
 UpstreamSyncInterval = '0s'
 UpstreamSyncRateLimit = '2m0s'
 
+[WebServer.OIDC]
+IssuerURL = 'https://idp.example.com'
+ClientID = 'chainlink-node'
+RedirectURL = 'https://node.example.com/oidc/callback'
+Scopes = ['openid', 'email', 'groups']
+EmailClaim = 'email'
+GroupsClaim = 'groups'
+AdminUserGroup = 'NodeAdmins'
+EditUserGroup = 'NodeEditors'
+RunUserGroup = 'NodeRunners'
+ReadUserGroup = 'NodeReadOnly'
+SessionTimeout = '30m0s'
+QueryTimeout = '10s'
+
 [WebServer.MFA]
 RPID = 'test-rpid'
 RPOrigin = 'test-rp-origin'
@@ -914,10 +1128,12 @@ UnauthenticatedPeriod = '1m0s'
 
 [WebServer.TLS]
 CertPath = 'tls/cert/path'
+ClientCAPath = 'tls/client-ca/path'
 ForceRedirect = true
 Host = 'tls-host'
 HTTPSPort = 6789
 KeyPath = 'tls/key/path'
+KeystoreIdentity = true
 ListenIP = '192.158.1.38'
 `},
 		{"FluxMonitor", Config{Core: toml.Core{FluxMonitor: full.FluxMonitor}}, `[FluxMonitor]
@@ -1021,6 +1237,7 @@ Release = 'v1.2.3'
 		{"EVM", Config{EVM: full.EVM}, `[[EVM]]
 ChainID = '1'
 Enabled = false
+Profile = 'testnet-defaults'
 AutoCreateKey = false
 BlockBackfillDepth = 100
 BlockBackfillSkip = true
@@ -1052,10 +1269,33 @@ MaxQueued = 99
 ReaperInterval = '1m0s'
 ReaperThreshold = '1m0s'
 ResendAfterThreshold = '1h0m0s'
+JobSpendLimit = '1 ether'
+JobSpendWindow = '1h0m0s'
 
 [EVM.Transactions.AutoPurge]
 Enabled = false
 
+[EVM.Transactions.Bundler]
+Enabled = true
+MulticallAddress = '0xcA11bde05977b3631167028862bE2a173976CA11'
+MaxBatchSize = 5
+FlushInterval = '1m0s'
+
+[EVM.Transactions.NonceCoordination]
+Enabled = true
+LockTimeout = '10s'
+ReservationTimeout = '1m0s'
+
+[EVM.Transactions.Simulation]
+Enabled = true
+AbortOnRevert = true
+
+[EVM.Transactions.CircuitBreaker]
+Enabled = true
+BroadcastFailureThreshold = 5
+ReceiptFailureThreshold = 20
+ResetTimeout = '2m0s'
+
 [EVM.BalanceMonitor]
 Enabled = true
 
@@ -1077,6 +1317,7 @@ EIP1559DynamicFees = true
 FeeCapDefault = '9.223372036854775807 ether'
 TipCapDefault = '2 wei'
 TipCapMin = '1 wei'
+BlobPriceMax = '50 gwei'
 
 [EVM.GasEstimator.LimitJobType]
 OCR = 1001
@@ -1096,6 +1337,22 @@ TransactionPercentile = 15
 
 [EVM.GasEstimator.FeeHistory]
 CacheTimeout = '1s'
+RegressionLookback = 10
+RegressionPercentile = 40
+
+[EVM.GasEstimator.OnChainPriceMax]
+Enabled = true
+Contract = '0x2a3e23c6f242F5345320814aC8a1b4E58707D292'
+Calldata = '0x3de39c11'
+PollInterval = '30s'
+
+[[EVM.GasEstimatorProfiles]]
+Name = 'ccip-exec'
+Mode = 'FeeHistory'
+PriceMax = '500 gwei'
+BumpMin = '10 gwei'
+BumpPercent = 30
+BumpThreshold = 2
 
 [EVM.HeadTracker]
 HistoryDepth = 15
@@ -1103,6 +1360,7 @@ MaxBufferSize = 17
 SamplingInterval = '1h0m0s'
 MaxAllowedFinalityDepth = 1500
 FinalityTagBypass = false
+FinalityDepthFallback = true
 PersistenceEnabled = false
 
 [[EVM.KeySpecific]]
@@ -1111,6 +1369,13 @@ Key = '0x2a3e23c6f242F5345320814aC8a1b4E58707D292'
 [EVM.KeySpecific.GasEstimator]
 PriceMax = '79.228162514264337593543950335 gether'
 
+[EVM.KeySpecific.RemoteSigner]
+Type = 'Web3Signer'
+URL = 'http://web3signer:9000'
+KeyID = 'key-id'
+Region = 'us-east-1'
+Timeout = '5s'
+
 [EVM.NodePool]
 PollFailureThreshold = 5
 PollInterval = '1m0s'
@@ -1122,6 +1387,7 @@ FinalizedBlockPollInterval = '1s'
 EnforceRepeatableRead = true
 DeathDeclarationDelay = '1m0s'
 NewHeadsPollInterval = '0s'
+CallCacheExpiration = '1m0s'
 
 [EVM.NodePool.Errors]
 NonceTooLow = '(: |^)nonce too low'
@@ -1139,6 +1405,22 @@ TransactionAlreadyMined = '(: |^)transaction already mined'
 Fatal = '(: |^)fatal'
 ServiceUnavailable = '(: |^)service unavailable'
 TooManyResults = '(: |^)too many results'
+Provider = 'nethermind'
+
+[EVM.NodePool.RateLimit]
+Rate = 50
+Burst = 200
+LogPollerWeight = 5
+TxmWeight = 0
+CCIPWeight = 2
+GasEstimatorWeight = 1
+
+[EVM.NodePool.SanityChecks]
+Enabled = true
+MaxBlockNumberRegression = 50
+GasPriceMin = '1 gwei'
+GasPriceMax = '5 micro'
+QuarantineDuration = '10m0s'
 
 [EVM.OCR]
 ContractConfirmations = 11
@@ -1164,11 +1446,19 @@ HTTPURL = 'https://foo.web'
 Name = 'bar'
 WSURL = 'wss://web.socket/test/bar'
 HTTPURL = 'https://bar.com'
+Archive = true
 
 [[EVM.Nodes]]
 Name = 'broadcast'
 HTTPURL = 'http://broadcast.mirror'
 SendOnly = true
+`},
+		{"EVMProfiles", Config{EVMProfiles: full.EVMProfiles}, `[[EVMProfiles]]
+Name = 'testnet-defaults'
+FinalityDepth = 10
+
+[EVMProfiles.GasEstimator]
+PriceMax = '100 gwei'
 `},
 		{"Cosmos", Config{Cosmos: full.Cosmos}, `[[Cosmos]]
 ChainID = 'Malaga-420'
@@ -1247,6 +1537,47 @@ ConfirmationPoll = '42s'
 Name = 'primary'
 URL = 'http://stark.node'
 APIKey = 'key'
+`},
+		{"Maintenance", Config{Core: toml.Core{Maintenance: full.Maintenance}}, `[Maintenance]
+Windows = ['02:00-04:00', '22:30-23:00']
+LogPollerPruning = true
+TxmReaper = true
+CCIPPriceCleanup = true
+`},
+		{"BlobStore", Config{Core: toml.Core{BlobStore: full.BlobStore}}, `[BlobStore]
+Backend = 's3'
+Dir = '/var/lib/chainlink/blobs'
+Bucket = 'chainlink-artifacts'
+Prefix = 'node-1'
+Region = 'us-east-1'
+Endpoint = 'http://localhost:9000'
+MaxAge = '168h0m0s'
+GCInterval = '30m0s'
+`},
+		{"TxAuditExport", Config{Core: toml.Core{TxAuditExport: full.TxAuditExport}}, `[TxAuditExport]
+Enabled = true
+Dir = '/var/lib/chainlink/tx-audit'
+Format = 'parquet'
+Delay = '2h0m0s'
+`},
+		{"Alerting", Config{Core: toml.Core{Alerting: full.Alerting}}, `[Alerting]
+Enabled = true
+DedupWindow = '30m0s'
+MaxPerMinute = 5
+SendTimeout = '5s'
+
+[[Alerting.Webhooks]]
+Name = 'on-call'
+Type = 'pagerduty'
+MinSeverity = 'critical'
+`},
+		{"Drain", Config{Core: toml.Core{Drain: full.Drain}}, `[Drain]
+OnSIGTERM = true
+Timeout = '5m0s'
+`},
+		{"CCIP", Config{Core: toml.Core{CCIP: full.CCIP}}, `[CCIP]
+GasPriceUpdateInterval = '2m0s'
+TokenPriceUpdateInterval = '15m0s'
 `},
 		{"Mercury", Config{Core: toml.Core{Mercury: full.Mercury}}, `[Mercury]
 VerboseLogging = true
@@ -1285,6 +1616,9 @@ TransmitTimeout = '3m54s'
 func TestConfig_full(t *testing.T) {
 	var got Config
 	require.NoError(t, config.DecodeTOML(strings.NewReader(fullTOML), &got))
+	// Except for EVMProfiles, which are partial.
+	require.Len(t, got.EVMProfiles, 1)
+	got.EVMProfiles = nil
 	// Except for some EVM node fields.
 	for c := range got.EVM {
 		addr, err := types.NewEIP55Address("0x2a3e23c6f242F5345320814aC8a1b4E58707D292")
@@ -1308,6 +1642,9 @@ func TestConfig_full(t *testing.T) {
 			if got.EVM[c].Nodes[n].Order == nil {
 				got.EVM[c].Nodes[n].Order = ptr(int32(100))
 			}
+			if got.EVM[c].Nodes[n].Archive == nil {
+				got.EVM[c].Nodes[n].Archive = ptr(false)
+			}
 		}
 		if got.EVM[c].Transactions.AutoPurge.Threshold == nil {
 			got.EVM[c].Transactions.AutoPurge.Threshold = ptr(uint32(0))
@@ -1315,9 +1652,15 @@ func TestConfig_full(t *testing.T) {
 		if got.EVM[c].Transactions.AutoPurge.MinAttempts == nil {
 			got.EVM[c].Transactions.AutoPurge.MinAttempts = ptr(uint32(0))
 		}
+		if got.EVM[c].Transactions.AutoPurge.AgeThreshold == nil {
+			got.EVM[c].Transactions.AutoPurge.AgeThreshold = new(commoncfg.Duration)
+		}
 		if got.EVM[c].Transactions.AutoPurge.DetectionApiUrl == nil {
 			got.EVM[c].Transactions.AutoPurge.DetectionApiUrl = new(commoncfg.URL)
 		}
+		if got.EVM[c].Transactions.Bundler.MulticallAddress == nil {
+			got.EVM[c].Transactions.Bundler.MulticallAddress = &addr
+		}
 	}
 
 	cfgtest.AssertFieldsNotNil(t, got)
@@ -1662,6 +2005,61 @@ func TestConfig_setDefaults(t *testing.T) {
 	cfgtest.AssertFieldsNotNil(t, c.Core)
 }
 
+func TestConfig_setDefaults_EVMProfiles(t *testing.T) {
+	var c Config
+	c.EVMProfiles = evmcfg.EVMProfiles{
+		{Name: ptr("testnet-defaults"), Chain: evmcfg.Chain{
+			BlockBackfillDepth: ptr[uint32](7),
+			FinalityDepth:      ptr[uint32](10),
+		}},
+		{Name: ptr("l2-opstack-defaults"), Profile: ptr("testnet-defaults"), Chain: evmcfg.Chain{
+			FinalityDepth: ptr[uint32](20),
+			GasEstimator:  evmcfg.GasEstimator{PriceMax: assets.GWei(100)},
+		}},
+	}
+	c.EVM = evmcfg.EVMConfigs{
+		{ChainID: ubig.NewI(11155420), Profile: ptr("l2-opstack-defaults"), Chain: evmcfg.Chain{
+			GasEstimator: evmcfg.GasEstimator{PriceMax: assets.GWei(200)},
+		}},
+		{ChainID: ubig.NewI(11155111), Profile: ptr("testnet-defaults")},
+		{ChainID: ubig.NewI(1)},
+	}
+	c.setDefaults()
+
+	opSepolia, sepolia, mainnet := c.EVM[0], c.EVM[1], c.EVM[2]
+	defaults := evmcfg.Defaults(opSepolia.ChainID)
+	assert.Equal(t, uint32(7), *opSepolia.BlockBackfillDepth)
+	assert.Equal(t, uint32(20), *opSepolia.FinalityDepth)
+	assert.Equal(t, assets.GWei(200), opSepolia.GasEstimator.PriceMax)
+	assert.Equal(t, defaults.ChainType, opSepolia.ChainType)
+	assert.Equal(t, uint32(7), *sepolia.BlockBackfillDepth)
+	assert.Equal(t, uint32(10), *sepolia.FinalityDepth)
+	assert.Equal(t, evmcfg.Defaults(mainnet.ChainID), mainnet.Chain)
+}
+
+func TestConfig_Validate_EVMProfiles(t *testing.T) {
+	var c Config
+	c.EVMProfiles = evmcfg.EVMProfiles{
+		{Name: ptr("a"), Profile: ptr("b")},
+		{Name: ptr("b"), Profile: ptr("a")},
+		{Name: ptr("b")},
+		{Profile: ptr("c")},
+	}
+	c.EVM = evmcfg.EVMConfigs{{ChainID: ubig.NewI(1), Profile: ptr("missing"), Nodes: evmcfg.EVMNodes{
+		{Name: ptr("primary"), WSURL: mustURL("wss://foo.bar"), HTTPURL: mustURL("https://foo.bar")},
+	}}}
+	c.setDefaults()
+
+	assertValidationError(t, &c, `invalid configuration: 2 errors:
+	- EVMProfiles: 5 errors:
+		- 2.Name: invalid value (b): duplicate - must be unique
+		- 3.Name: missing: required for all profiles
+		- 0.Profile: invalid value (b): profile "b" is inherited in a cycle
+		- 1.Profile: invalid value (a): profile "a" is inherited in a cycle
+		- 3.Profile: invalid value (c): profile "c": not found
+	- EVM.0.Profile: invalid value (missing): profile "missing": not found`)
+}
+
 func Test_validateEnv(t *testing.T) {
 	t.Setenv("LOG_LEVEL", "warn")
 	t.Setenv("DATABASE_URL", "foo")
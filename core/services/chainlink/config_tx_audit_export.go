@@ -0,0 +1,36 @@
+package chainlink
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+)
+
+var _ config.TxAuditExport = (*txAuditExportConfig)(nil)
+
+type txAuditExportConfig struct {
+	c       toml.TxAuditExport
+	rootDir func() string
+}
+
+func (t *txAuditExportConfig) Enabled() bool {
+	return *t.c.Enabled
+}
+
+func (t *txAuditExportConfig) Dir() string {
+	s := *t.c.Dir
+	if s == "" {
+		s = filepath.Join(t.rootDir(), "tx-audit")
+	}
+	return s
+}
+
+func (t *txAuditExportConfig) Format() string {
+	return *t.c.Format
+}
+
+func (t *txAuditExportConfig) Delay() time.Duration {
+	return t.c.Delay.Duration()
+}
@@ -0,0 +1,34 @@
+package chainlink
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxAuditExportConfig(t *testing.T) {
+	opts := GeneralConfigOpts{
+		ConfigStrings: []string{fullTOML},
+	}
+	cfg, err := opts.New()
+	require.NoError(t, err)
+
+	e := cfg.TxAuditExport()
+	assert.True(t, e.Enabled())
+	assert.Equal(t, "/var/lib/chainlink/tx-audit", e.Dir())
+	assert.Equal(t, "parquet", e.Format())
+	assert.Equal(t, 2*time.Hour, e.Delay())
+
+	opts = GeneralConfigOpts{}
+	cfg, err = opts.New()
+	require.NoError(t, err)
+
+	e = cfg.TxAuditExport()
+	assert.False(t, e.Enabled())
+	assert.Equal(t, filepath.Join(cfg.RootDir(), "tx-audit"), e.Dir())
+	assert.Equal(t, "csv", e.Format())
+	assert.Equal(t, time.Hour, e.Delay())
+}
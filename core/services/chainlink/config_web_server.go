@@ -60,6 +60,14 @@ func (t *tlsConfig) KeyFile() string {
 	return t.keyPath()
 }
 
+func (t *tlsConfig) KeystoreIdentity() bool {
+	return *t.c.KeystoreIdentity
+}
+
+func (t *tlsConfig) ClientCAFile() string {
+	return *t.c.ClientCAPath
+}
+
 func (t *tlsConfig) ListenIP() net.IP {
 	return *t.c.ListenIP
 }
@@ -118,6 +126,10 @@ func (w *webServerConfig) LDAP() config.LDAP {
 	return &ldapConfig{c: w.c.LDAP, s: w.s.LDAP}
 }
 
+func (w *webServerConfig) OIDC() config.OIDC {
+	return &oidcConfig{c: w.c.OIDC, s: w.s.OIDC}
+}
+
 func (w *webServerConfig) AuthenticationMethod() string {
 	return *w.c.AuthenticationMethod
 }
@@ -313,3 +325,93 @@ func (l *ldapConfig) UpstreamSyncRateLimit() commonconfig.Duration {
 	}
 	return *l.c.UpstreamSyncRateLimit
 }
+
+type oidcConfig struct {
+	c toml.WebServerOIDC
+	s toml.WebServerOIDCSecrets
+}
+
+func (o *oidcConfig) IssuerURL() *url.URL {
+	if o.c.IssuerURL == nil || o.c.IssuerURL.IsZero() {
+		return nil
+	}
+	return o.c.IssuerURL.URL()
+}
+
+func (o *oidcConfig) ClientID() string {
+	if o.c.ClientID == nil {
+		return ""
+	}
+	return *o.c.ClientID
+}
+
+func (o *oidcConfig) ClientSecret() string {
+	if o.s.ClientSecret == nil {
+		return ""
+	}
+	return string(*o.s.ClientSecret)
+}
+
+func (o *oidcConfig) RedirectURL() *url.URL {
+	if o.c.RedirectURL == nil || o.c.RedirectURL.IsZero() {
+		return nil
+	}
+	return o.c.RedirectURL.URL()
+}
+
+func (o *oidcConfig) Scopes() []string {
+	if o.c.Scopes == nil {
+		return nil
+	}
+	return *o.c.Scopes
+}
+
+func (o *oidcConfig) EmailClaim() string {
+	if o.c.EmailClaim == nil {
+		return ""
+	}
+	return *o.c.EmailClaim
+}
+
+func (o *oidcConfig) GroupsClaim() string {
+	if o.c.GroupsClaim == nil {
+		return ""
+	}
+	return *o.c.GroupsClaim
+}
+
+func (o *oidcConfig) AdminUserGroup() string {
+	if o.c.AdminUserGroup == nil {
+		return ""
+	}
+	return *o.c.AdminUserGroup
+}
+
+func (o *oidcConfig) EditUserGroup() string {
+	if o.c.EditUserGroup == nil {
+		return ""
+	}
+	return *o.c.EditUserGroup
+}
+
+func (o *oidcConfig) RunUserGroup() string {
+	if o.c.RunUserGroup == nil {
+		return ""
+	}
+	return *o.c.RunUserGroup
+}
+
+func (o *oidcConfig) ReadUserGroup() string {
+	if o.c.ReadUserGroup == nil {
+		return ""
+	}
+	return *o.c.ReadUserGroup
+}
+
+func (o *oidcConfig) SessionTimeout() commonconfig.Duration {
+	return *o.c.SessionTimeout
+}
+
+func (o *oidcConfig) QueryTimeout() time.Duration {
+	return o.c.QueryTimeout.Duration()
+}
@@ -36,6 +36,53 @@ func (_m *GeneralConfig) EXPECT() *GeneralConfig_Expecter {
 	return &GeneralConfig_Expecter{mock: &_m.Mock}
 }
 
+// Alerting provides a mock function with given fields:
+func (_m *GeneralConfig) Alerting() config.Alerting {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Alerting")
+	}
+
+	var r0 config.Alerting
+	if rf, ok := ret.Get(0).(func() config.Alerting); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.Alerting)
+		}
+	}
+
+	return r0
+}
+
+// GeneralConfig_Alerting_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Alerting'
+type GeneralConfig_Alerting_Call struct {
+	*mock.Call
+}
+
+// Alerting is a helper method to define mock.On call
+func (_e *GeneralConfig_Expecter) Alerting() *GeneralConfig_Alerting_Call {
+	return &GeneralConfig_Alerting_Call{Call: _e.mock.On("Alerting")}
+}
+
+func (_c *GeneralConfig_Alerting_Call) Run(run func()) *GeneralConfig_Alerting_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GeneralConfig_Alerting_Call) Return(_a0 config.Alerting) *GeneralConfig_Alerting_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GeneralConfig_Alerting_Call) RunAndReturn(run func() config.Alerting) *GeneralConfig_Alerting_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AppID provides a mock function with given fields:
 func (_m *GeneralConfig) AppID() uuid.UUID {
 	ret := _m.Called()
@@ -269,6 +316,100 @@ func (_c *GeneralConfig_AutoPprof_Call) RunAndReturn(run func() config.AutoPprof
 	return _c
 }
 
+// BlobStore provides a mock function with given fields:
+func (_m *GeneralConfig) BlobStore() config.BlobStore {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlobStore")
+	}
+
+	var r0 config.BlobStore
+	if rf, ok := ret.Get(0).(func() config.BlobStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.BlobStore)
+		}
+	}
+
+	return r0
+}
+
+// GeneralConfig_BlobStore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlobStore'
+type GeneralConfig_BlobStore_Call struct {
+	*mock.Call
+}
+
+// BlobStore is a helper method to define mock.On call
+func (_e *GeneralConfig_Expecter) BlobStore() *GeneralConfig_BlobStore_Call {
+	return &GeneralConfig_BlobStore_Call{Call: _e.mock.On("BlobStore")}
+}
+
+func (_c *GeneralConfig_BlobStore_Call) Run(run func()) *GeneralConfig_BlobStore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GeneralConfig_BlobStore_Call) Return(_a0 config.BlobStore) *GeneralConfig_BlobStore_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GeneralConfig_BlobStore_Call) RunAndReturn(run func() config.BlobStore) *GeneralConfig_BlobStore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CCIP provides a mock function with given fields:
+func (_m *GeneralConfig) CCIP() config.CCIP {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CCIP")
+	}
+
+	var r0 config.CCIP
+	if rf, ok := ret.Get(0).(func() config.CCIP); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.CCIP)
+		}
+	}
+
+	return r0
+}
+
+// GeneralConfig_CCIP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CCIP'
+type GeneralConfig_CCIP_Call struct {
+	*mock.Call
+}
+
+// CCIP is a helper method to define mock.On call
+func (_e *GeneralConfig_Expecter) CCIP() *GeneralConfig_CCIP_Call {
+	return &GeneralConfig_CCIP_Call{Call: _e.mock.On("CCIP")}
+}
+
+func (_c *GeneralConfig_CCIP_Call) Run(run func()) *GeneralConfig_CCIP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GeneralConfig_CCIP_Call) Return(_a0 config.CCIP) *GeneralConfig_CCIP_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GeneralConfig_CCIP_Call) RunAndReturn(run func() config.CCIP) *GeneralConfig_CCIP_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Capabilities provides a mock function with given fields:
 func (_m *GeneralConfig) Capabilities() config.Capabilities {
 	ret := _m.Called()
@@ -510,6 +651,53 @@ func (_c *GeneralConfig_Database_Call) RunAndReturn(run func() config.Database)
 	return _c
 }
 
+// Drain provides a mock function with given fields:
+func (_m *GeneralConfig) Drain() config.Drain {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Drain")
+	}
+
+	var r0 config.Drain
+	if rf, ok := ret.Get(0).(func() config.Drain); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.Drain)
+		}
+	}
+
+	return r0
+}
+
+// GeneralConfig_Drain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Drain'
+type GeneralConfig_Drain_Call struct {
+	*mock.Call
+}
+
+// Drain is a helper method to define mock.On call
+func (_e *GeneralConfig_Expecter) Drain() *GeneralConfig_Drain_Call {
+	return &GeneralConfig_Drain_Call{Call: _e.mock.On("Drain")}
+}
+
+func (_c *GeneralConfig_Drain_Call) Run(run func()) *GeneralConfig_Drain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GeneralConfig_Drain_Call) Return(_a0 config.Drain) *GeneralConfig_Drain_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GeneralConfig_Drain_Call) RunAndReturn(run func() config.Drain) *GeneralConfig_Drain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // EVMConfigs provides a mock function with given fields:
 func (_m *GeneralConfig) EVMConfigs() toml.EVMConfigs {
 	ret := _m.Called()
@@ -833,6 +1021,53 @@ func (_c *GeneralConfig_InsecureFastScrypt_Call) RunAndReturn(run func() bool) *
 	return _c
 }
 
+// JobDistributor provides a mock function with given fields:
+func (_m *GeneralConfig) JobDistributor() config.JobDistributor {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for JobDistributor")
+	}
+
+	var r0 config.JobDistributor
+	if rf, ok := ret.Get(0).(func() config.JobDistributor); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.JobDistributor)
+		}
+	}
+
+	return r0
+}
+
+// GeneralConfig_JobDistributor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JobDistributor'
+type GeneralConfig_JobDistributor_Call struct {
+	*mock.Call
+}
+
+// JobDistributor is a helper method to define mock.On call
+func (_e *GeneralConfig_Expecter) JobDistributor() *GeneralConfig_JobDistributor_Call {
+	return &GeneralConfig_JobDistributor_Call{Call: _e.mock.On("JobDistributor")}
+}
+
+func (_c *GeneralConfig_JobDistributor_Call) Run(run func()) *GeneralConfig_JobDistributor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GeneralConfig_JobDistributor_Call) Return(_a0 config.JobDistributor) *GeneralConfig_JobDistributor_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GeneralConfig_JobDistributor_Call) RunAndReturn(run func() config.JobDistributor) *GeneralConfig_JobDistributor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // JobPipeline provides a mock function with given fields:
 func (_m *GeneralConfig) JobPipeline() config.JobPipeline {
 	ret := _m.Called()
@@ -1008,6 +1243,53 @@ func (_c *GeneralConfig_LogConfiguration_Call) RunAndReturn(run func(config.Logf
 	return _c
 }
 
+// Maintenance provides a mock function with given fields:
+func (_m *GeneralConfig) Maintenance() config.Maintenance {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Maintenance")
+	}
+
+	var r0 config.Maintenance
+	if rf, ok := ret.Get(0).(func() config.Maintenance); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.Maintenance)
+		}
+	}
+
+	return r0
+}
+
+// GeneralConfig_Maintenance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Maintenance'
+type GeneralConfig_Maintenance_Call struct {
+	*mock.Call
+}
+
+// Maintenance is a helper method to define mock.On call
+func (_e *GeneralConfig_Expecter) Maintenance() *GeneralConfig_Maintenance_Call {
+	return &GeneralConfig_Maintenance_Call{Call: _e.mock.On("Maintenance")}
+}
+
+func (_c *GeneralConfig_Maintenance_Call) Run(run func()) *GeneralConfig_Maintenance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GeneralConfig_Maintenance_Call) Return(_a0 config.Maintenance) *GeneralConfig_Maintenance_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GeneralConfig_Maintenance_Call) RunAndReturn(run func() config.Maintenance) *GeneralConfig_Maintenance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Mercury provides a mock function with given fields:
 func (_m *GeneralConfig) Mercury() config.Mercury {
 	ret := _m.Called()
@@ -1337,6 +1619,63 @@ func (_c *GeneralConfig_Pyroscope_Call) RunAndReturn(run func() config.Pyroscope
 	return _c
 }
 
+// ReloadConfig provides a mock function with given fields:
+func (_m *GeneralConfig) ReloadConfig() ([]string, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReloadConfig")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]string, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GeneralConfig_ReloadConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReloadConfig'
+type GeneralConfig_ReloadConfig_Call struct {
+	*mock.Call
+}
+
+// ReloadConfig is a helper method to define mock.On call
+func (_e *GeneralConfig_Expecter) ReloadConfig() *GeneralConfig_ReloadConfig_Call {
+	return &GeneralConfig_ReloadConfig_Call{Call: _e.mock.On("ReloadConfig")}
+}
+
+func (_c *GeneralConfig_ReloadConfig_Call) Run(run func()) *GeneralConfig_ReloadConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GeneralConfig_ReloadConfig_Call) Return(_a0 []string, _a1 error) *GeneralConfig_ReloadConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GeneralConfig_ReloadConfig_Call) RunAndReturn(run func() ([]string, error)) *GeneralConfig_ReloadConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RootDir provides a mock function with given fields:
 func (_m *GeneralConfig) RootDir() string {
 	ret := _m.Called()
@@ -1959,6 +2298,53 @@ func (_c *GeneralConfig_Tracing_Call) RunAndReturn(run func() config.Tracing) *G
 	return _c
 }
 
+// TxAuditExport provides a mock function with given fields:
+func (_m *GeneralConfig) TxAuditExport() config.TxAuditExport {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for TxAuditExport")
+	}
+
+	var r0 config.TxAuditExport
+	if rf, ok := ret.Get(0).(func() config.TxAuditExport); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.TxAuditExport)
+		}
+	}
+
+	return r0
+}
+
+// GeneralConfig_TxAuditExport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TxAuditExport'
+type GeneralConfig_TxAuditExport_Call struct {
+	*mock.Call
+}
+
+// TxAuditExport is a helper method to define mock.On call
+func (_e *GeneralConfig_Expecter) TxAuditExport() *GeneralConfig_TxAuditExport_Call {
+	return &GeneralConfig_TxAuditExport_Call{Call: _e.mock.On("TxAuditExport")}
+}
+
+func (_c *GeneralConfig_TxAuditExport_Call) Run(run func()) *GeneralConfig_TxAuditExport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GeneralConfig_TxAuditExport_Call) Return(_a0 config.TxAuditExport) *GeneralConfig_TxAuditExport_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GeneralConfig_TxAuditExport_Call) RunAndReturn(run func() config.TxAuditExport) *GeneralConfig_TxAuditExport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Validate provides a mock function with given fields:
 func (_m *GeneralConfig) Validate() error {
 	ret := _m.Called()
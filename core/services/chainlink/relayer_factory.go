@@ -88,6 +88,7 @@ func (r *RelayerFactory) NewEVM(ctx context.Context, config EVMFactoryConfig) (m
 			TransmitterConfig:    config.MercuryTransmitter,
 			CapabilitiesRegistry: r.CapabilitiesRegistry,
 			HTTPClient:           r.HTTPClient,
+			LegacyChains:         legacyChains,
 		}
 		relayer, err2 := evmrelay.NewRelayer(lggr.Named(relayID.ChainID), chain, relayerOpts)
 		if err2 != nil {
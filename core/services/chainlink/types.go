@@ -18,4 +18,7 @@ type GeneralConfig interface {
 	AptosConfigs() RawConfigs
 	// ConfigTOML returns both the user provided and effective configuration as TOML.
 	ConfigTOML() (user, effective string)
+	// ReloadConfig re-reads the config files and applies changes to the ReloadableFields, returning the names of those
+	// which changed.
+	ReloadConfig() ([]string, error)
 }
@@ -3,6 +3,10 @@ package cron
 import (
 	"context"
 	"fmt"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/robfig/cron/v3"
 
@@ -13,19 +17,31 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 )
 
+// scheduleParser parses the schedules like the cron runner, with seconds.
+var scheduleParser = cron.NewParser(cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
 // Cron runs a cron jobSpec from a CronSpec
 type Cron struct {
 	cronRunner     *cron.Cron
 	logger         logger.Logger
 	jobSpec        job.Job
 	pipelineRunner pipeline.Runner
+	orm            ORM
 	chStop         services.StopChan
+	wg             sync.WaitGroup
+
+	// running is set while a run is in progress, to skip the overlapping runs if PreventOverlap is set.
+	running atomic.Bool
+
+	lastRunAtMu sync.Mutex
+	lastRunAt   time.Time
 }
 
 // NewCronFromJobSpec instantiates a job that executes on a predefined schedule.
 func NewCronFromJobSpec(
 	jobSpec job.Job,
 	pipelineRunner pipeline.Runner,
+	orm ORM,
 	logger logger.Logger,
 ) (*Cron, error) {
 	cronLogger := logger.Named("Cron").With(
@@ -36,24 +52,40 @@ func NewCronFromJobSpec(
 		cronLogger = logger.With("evmChainID", id)
 	}
 
-	return &Cron{
+	cr := &Cron{
 		cronRunner:     cronRunner(),
 		logger:         cronLogger,
 		jobSpec:        jobSpec,
 		pipelineRunner: pipelineRunner,
+		orm:            orm,
 		chStop:         make(chan struct{}),
-	}, nil
+	}
+	if lastRunAt := jobSpec.CronSpec.LastRunAt; lastRunAt != nil {
+		cr.lastRunAt = *lastRunAt
+	}
+	return cr, nil
 }
 
 // Start implements the job.Service interface.
 func (cr *Cron) Start(context.Context) error {
 	cr.logger.Debug("Starting")
 
-	_, err := cr.cronRunner.AddFunc(cr.jobSpec.CronSpec.CronSchedule, cr.runPipeline)
+	schedule, err := scheduleParser.Parse(cr.jobSpec.CronSpec.CronSchedule)
 	if err != nil {
 		cr.logger.Errorw(fmt.Sprintf("Error running cron job %d", cr.jobSpec.ID), "err", err)
 		return err
 	}
+	cr.cronRunner.Schedule(schedule, cron.FuncJob(func() {
+		cr.run(time.Now())
+	}))
+
+	if missed := cr.missedRuns(schedule, time.Now()); len(missed) > 0 {
+		cr.wg.Add(1)
+		go func() {
+			defer cr.wg.Done()
+			cr.catchUp(missed)
+		}()
+	}
 	cr.cronRunner.Start()
 	return nil
 }
@@ -62,14 +94,91 @@ func (cr *Cron) Start(context.Context) error {
 // running and cleans up resources.
 func (cr *Cron) Close() error {
 	cr.logger.Debug("Closing")
-	cr.cronRunner.Stop()
+	close(cr.chStop)
+	<-cr.cronRunner.Stop().Done()
+	cr.wg.Wait()
 	return nil
 }
 
-func (cr *Cron) runPipeline() {
+// missedRuns returns the times the job was scheduled to run since it was last scheduled to run before now, up to the
+// latest job.MaxCronCatchUpRuns.
+func (cr *Cron) missedRuns(schedule cron.Schedule, now time.Time) []time.Time {
+	lastRunAt := cr.jobSpec.CronSpec.LastRunAt
+	if lastRunAt == nil {
+		return nil
+	}
+	var missed []time.Time
+	for t := schedule.Next(*lastRunAt); !t.IsZero() && !t.After(now); t = schedule.Next(t) {
+		missed = append(missed, t)
+		if len(missed) > job.MaxCronCatchUpRuns {
+			missed = missed[1:]
+		}
+	}
+	return missed
+}
+
+// catchUp runs the missed runs according to the CatchUp policy of the spec.
+func (cr *Cron) catchUp(missed []time.Time) {
+	switch cr.jobSpec.CronSpec.CatchUp {
+	case job.CronCatchUpRunOnce:
+		cr.logger.Infow("Catching up on missed runs with a single run", "missedRuns", len(missed), "lastRunAt", cr.jobSpec.CronSpec.LastRunAt)
+		cr.run(missed[len(missed)-1])
+	case job.CronCatchUpRunAll:
+		cr.logger.Infow("Catching up on missed runs", "missedRuns", len(missed), "lastRunAt", cr.jobSpec.CronSpec.LastRunAt)
+		for _, scheduledAt := range missed {
+			select {
+			case <-cr.chStop:
+				return
+			default:
+			}
+			cr.run(scheduledAt)
+		}
+	default:
+		cr.logger.Infow("Skipping missed runs", "missedRuns", len(missed), "lastRunAt", cr.jobSpec.CronSpec.LastRunAt)
+	}
+}
+
+// run runs the pipeline scheduled at scheduledAt, after the jitter of the spec.
+func (cr *Cron) run(scheduledAt time.Time) {
+	if cr.jobSpec.CronSpec.PreventOverlap {
+		if !cr.running.CompareAndSwap(false, true) {
+			cr.logger.Warnw("Skipping run, the previous run is still in progress", "scheduledAt", scheduledAt)
+			return
+		}
+		defer cr.running.Store(false)
+	}
+
 	ctx, cancel := cr.chStop.NewCtx()
 	defer cancel()
 
+	cr.setLastRunAt(ctx, scheduledAt)
+
+	if jitter := cr.jobSpec.CronSpec.Jitter.Duration(); jitter > 0 {
+		delay := time.Duration(rand.Int63n(int64(jitter)))
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+	}
+
+	cr.runPipeline(ctx)
+}
+
+// setLastRunAt records the time the job was last scheduled to run, unless a later run was already recorded.
+func (cr *Cron) setLastRunAt(ctx context.Context, scheduledAt time.Time) {
+	cr.lastRunAtMu.Lock()
+	defer cr.lastRunAtMu.Unlock()
+	if !scheduledAt.After(cr.lastRunAt) {
+		return
+	}
+	cr.lastRunAt = scheduledAt
+	if err := cr.orm.SetLastRunAt(ctx, cr.jobSpec.CronSpec.ID, scheduledAt); err != nil {
+		cr.logger.Errorw("Failed to record the last run", "scheduledAt", scheduledAt, "err", err)
+	}
+}
+
+func (cr *Cron) runPipeline(ctx context.Context) {
 	jobSpec := map[string]interface{}{
 		"databaseID":    cr.jobSpec.ID,
 		"externalJobID": cr.jobSpec.ExternalJobID,
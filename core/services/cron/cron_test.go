@@ -1,7 +1,11 @@
 package cron_test
 
 import (
+	"context"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -38,7 +42,7 @@ func TestCronV2Pipeline(t *testing.T) {
 		PipelineSpec:  &pipeline.Spec{},
 		ExternalJobID: uuid.New(),
 	}
-	delegate := cron.NewDelegate(runner, lggr)
+	delegate := cron.NewDelegate(runner, db, lggr)
 
 	require.NoError(t, jobORM.CreateJob(testutils.Context(t), jb))
 	serviceArray, err := delegate.ServicesForSpec(testutils.Context(t), *jb)
@@ -67,7 +71,7 @@ func TestCronV2Schedule(t *testing.T) {
 		Return(false, nil).
 		Once()
 
-	service, err := cron.NewCronFromJobSpec(spec, runner, logger.TestLogger(t))
+	service, err := cron.NewCronFromJobSpec(spec, runner, &fakeORM{}, logger.TestLogger(t))
 	require.NoError(t, err)
 	err = service.Start(testutils.Context(t))
 	require.NoError(t, err)
@@ -75,3 +79,100 @@ func TestCronV2Schedule(t *testing.T) {
 
 	awaiter.AwaitOrFail(t)
 }
+
+type fakeORM struct {
+	mu         sync.Mutex
+	lastRunAts []time.Time
+}
+
+func (o *fakeORM) SetLastRunAt(_ context.Context, _ int32, lastRunAt time.Time) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.lastRunAts = append(o.lastRunAts, lastRunAt)
+	return nil
+}
+
+func (o *fakeORM) recorded() []time.Time {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return append([]time.Time(nil), o.lastRunAts...)
+}
+
+func TestCronV2CatchUp(t *testing.T) {
+	t.Parallel()
+
+	lastRunAt := time.Now().Add(-3*time.Hour - 30*time.Minute)
+	for _, tc := range []struct {
+		catchUp job.CronCatchUp
+		runs    int
+	}{
+		{job.CronCatchUpSkip, 0},
+		{job.CronCatchUpRunOnce, 1},
+		{job.CronCatchUpRunAll, 3},
+	} {
+		t.Run(string(tc.catchUp), func(t *testing.T) {
+			spec := job.Job{
+				Type:          job.Cron,
+				SchemaVersion: 1,
+				CronSpec:      &job.CronSpec{CronSchedule: "@every 1h", CatchUp: tc.catchUp, LastRunAt: &lastRunAt},
+				PipelineSpec:  &pipeline.Spec{},
+			}
+			runner := pipelinemocks.NewRunner(t)
+			var runs atomic.Int32
+			if tc.runs > 0 {
+				runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
+					Run(func(args mock.Arguments) { runs.Add(1) }).
+					Return(false, nil).
+					Times(tc.runs)
+			}
+			orm := &fakeORM{}
+
+			service, err := cron.NewCronFromJobSpec(spec, runner, orm, logger.TestLogger(t))
+			require.NoError(t, err)
+			require.NoError(t, service.Start(testutils.Context(t)))
+			defer func() { assert.NoError(t, service.Close()) }()
+
+			require.Eventually(t, func() bool { return int(runs.Load()) == tc.runs && len(orm.recorded()) == tc.runs }, testutils.WaitTimeout(t), 10*time.Millisecond)
+			if tc.runs > 0 {
+				recorded := orm.recorded()
+				assert.Equal(t, lastRunAt.Add(3*time.Hour).Truncate(time.Second), recorded[len(recorded)-1].Truncate(time.Second))
+			}
+		})
+	}
+}
+
+func TestCronV2PreventOverlap(t *testing.T) {
+	t.Parallel()
+
+	spec := job.Job{
+		Type:          job.Cron,
+		SchemaVersion: 1,
+		CronSpec:      &job.CronSpec{CronSchedule: "@every 1s", PreventOverlap: true},
+		PipelineSpec:  &pipeline.Spec{},
+	}
+	runner := pipelinemocks.NewRunner(t)
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var runs atomic.Int32
+	runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			if runs.Add(1) == 1 {
+				close(started)
+				<-release
+			}
+		}).
+		Return(false, nil)
+	orm := &fakeORM{}
+
+	service, err := cron.NewCronFromJobSpec(spec, runner, orm, logger.TestLogger(t))
+	require.NoError(t, err)
+	require.NoError(t, service.Start(testutils.Context(t)))
+	defer func() { assert.NoError(t, service.Close()) }()
+
+	<-started
+	// the runs scheduled while the first run is in progress are skipped
+	time.Sleep(2500 * time.Millisecond)
+	assert.Equal(t, int32(1), runs.Load())
+	assert.Len(t, orm.recorded(), 1)
+	close(release)
+}
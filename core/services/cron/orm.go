@@ -0,0 +1,28 @@
+package cron
+
+import (
+	"context"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+)
+
+type ORM interface {
+	// SetLastRunAt records the time the cron spec was last scheduled to run, to catch up on the missed runs on restart.
+	SetLastRunAt(ctx context.Context, cronSpecID int32, lastRunAt time.Time) error
+}
+
+type orm struct {
+	ds sqlutil.DataSource
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(ds sqlutil.DataSource) ORM {
+	return &orm{ds: ds}
+}
+
+func (o *orm) SetLastRunAt(ctx context.Context, cronSpecID int32, lastRunAt time.Time) error {
+	_, err := o.ds.ExecContext(ctx, `UPDATE cron_specs SET last_run_at = $2 WHERE id = $1`, cronSpecID, lastRunAt)
+	return err
+}
@@ -37,6 +37,16 @@ func ValidatedCronSpec(tomlString string) (job.Job, error) {
 	if err := utils.ValidateCronSchedule(spec.CronSchedule); err != nil {
 		return jb, errors.Wrapf(err, "while validating cron schedule '%v'", spec.CronSchedule)
 	}
+	if spec.Jitter.Duration() < 0 {
+		return jb, errors.Errorf("jitter must not be negative, got %s", spec.Jitter.Duration())
+	}
+	switch spec.CatchUp {
+	case "":
+		spec.CatchUp = job.CronCatchUpSkip
+	case job.CronCatchUpSkip, job.CronCatchUpRunOnce, job.CronCatchUpRunAll:
+	default:
+		return jb, errors.Errorf("unknown catchUp policy '%v', must be one of %s, %s or %s", spec.CatchUp, job.CronCatchUpSkip, job.CronCatchUpRunOnce, job.CronCatchUpRunAll)
+	}
 
 	return jb, nil
 }
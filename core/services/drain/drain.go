@@ -0,0 +1,282 @@
+// Package drain drains the node before it exits, so that rollouts don't interrupt OCR rounds, like CCIP executions,
+// midway.
+package drain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+)
+
+const (
+	// pollInterval is the interval between checks of the runs and transactions in flight.
+	pollInterval = time.Second
+	// checkpointTimeout bounds the checkpoint of the log pollers, which runs even once the drain timed out.
+	checkpointTimeout = 30 * time.Second
+)
+
+// Phase is the phase of a drain.
+type Phase string
+
+const (
+	PhaseNotDraining            Phase = "not_draining"
+	PhaseWaitingForRuns         Phase = "waiting_for_runs"
+	PhaseWaitingForTransactions Phase = "waiting_for_transactions"
+	PhaseStoppingJobs           Phase = "stopping_jobs"
+	PhaseCheckpointing          Phase = "checkpointing"
+	PhaseDrained                Phase = "drained"
+)
+
+// Status reports the progress of a drain.
+type Status struct {
+	Phase     Phase      `json:"phase"`
+	StartedAt *time.Time `json:"startedAt,omitempty"`
+	// Deadline is when the drain stops waiting for runs and transactions, and moves on to stopping the jobs.
+	Deadline            *time.Time   `json:"deadline,omitempty"`
+	FinishedAt          *time.Time   `json:"finishedAt,omitempty"`
+	StoppedJobs         int          `json:"stoppedJobs"`
+	InFlightRuns        int          `json:"inFlightRuns"`
+	PendingTransactions int          `json:"pendingTransactions"`
+	Checkpoints         []Checkpoint `json:"checkpoints"`
+	// TimedOut is set if runs or transactions were still in flight at the deadline.
+	TimedOut bool     `json:"timedOut"`
+	Errors   []string `json:"errors"`
+}
+
+// Checkpoint is the latest block saved by the log poller of a chain, from which it resumes after the restart.
+type Checkpoint struct {
+	ChainID     string `json:"chainID"`
+	BlockNumber int64  `json:"blockNumber"`
+}
+
+// Jobs is the job.Spawner.
+type Jobs interface {
+	Drain()
+	StopServices() int
+}
+
+// Runs is the pipeline.Runner.
+type Runs interface {
+	Drain()
+	InFlightRuns() int
+}
+
+// TxStore is the store of the transactions, which are still sent while draining.
+type TxStore interface {
+	CountTransactionsByState(ctx context.Context, state txmgrtypes.TxState, chainID *big.Int) (count uint32, err error)
+}
+
+// Chain is an EVM chain of the node.
+type Chain struct {
+	ID        *big.Int
+	LogPoller logpoller.LogPoller // nil if the log poller is disabled
+}
+
+// pendingStates are the states of the transactions in flight. Transactions which are confirmed, but not finalized yet,
+// are left to the next run of the node.
+var pendingStates = []txmgrtypes.TxState{txmgrcommon.TxUnstarted, txmgrcommon.TxInProgress, txmgrcommon.TxUnconfirmed}
+
+// Drainer drains the node in phases:
+//  1. it refuses new pipeline runs, which the observations of new OCR rounds need, the transmissions of OCR reports,
+//     which the other oracles of the DONs transmit instead, and new job services,
+//  2. waits for the pipeline runs in flight to finish,
+//  3. waits for the transactions in flight to be confirmed,
+//  4. stops the services of all jobs, which were kept running for the reports in flight to be transmitted,
+//  5. checkpoints the log pollers, waiting for their polls in progress, including backfills, to save their logs.
+//
+// The waits are bounded by the timeout, past which the drain moves on to stopping the jobs. The rest of the node keeps
+// running while draining, so that transactions are still sent and confirmed, until it exits once drained.
+type Drainer struct {
+	services.Service
+	eng *services.Engine
+
+	timeout      time.Duration
+	pollInterval time.Duration
+	jobs         Jobs
+	runs         Runs
+	txStore      TxStore
+	chains       []Chain
+
+	startOnce sync.Once
+	done      chan struct{}
+
+	mu     sync.RWMutex
+	status Status
+}
+
+func NewDrainer(lggr logger.Logger, timeout time.Duration, jobs Jobs, runs Runs, txStore TxStore, chains []Chain) *Drainer {
+	d := &Drainer{
+		timeout:      timeout,
+		pollInterval: pollInterval,
+		jobs:         jobs,
+		runs:         runs,
+		txStore:      txStore,
+		chains:       chains,
+		done:         make(chan struct{}),
+		status:       Status{Phase: PhaseNotDraining},
+	}
+	d.Service, d.eng = services.Config{
+		Name: "Drainer",
+	}.NewServiceEngine(lggr)
+	return d
+}
+
+// Drain starts draining the node in the background, unless it is already draining, and returns its status.
+func (d *Drainer) Drain() Status {
+	d.startOnce.Do(func() {
+		now := time.Now()
+		deadline := now.Add(d.timeout)
+		d.update(func(s *Status) {
+			s.Phase = PhaseWaitingForRuns
+			s.StartedAt = &now
+			s.Deadline = &deadline
+		})
+		d.eng.Infow("Draining the node", "timeout", d.timeout)
+		d.eng.Go(func(ctx context.Context) {
+			defer close(d.done)
+			d.drain(ctx, deadline)
+		})
+	})
+	return d.Status()
+}
+
+// Done is closed once the node is drained.
+func (d *Drainer) Done() <-chan struct{} {
+	return d.done
+}
+
+// Status returns the status of the drain.
+func (d *Drainer) Status() Status {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	s := d.status
+	s.Checkpoints = append([]Checkpoint(nil), s.Checkpoints...)
+	s.Errors = append([]string(nil), s.Errors...)
+	return s
+}
+
+func (d *Drainer) update(fn func(*Status)) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	fn(&d.status)
+}
+
+func (d *Drainer) addError(err error) {
+	d.eng.Errorw("Error while draining", "err", err)
+	d.update(func(s *Status) { s.Errors = append(s.Errors, err.Error()) })
+}
+
+func (d *Drainer) drain(ctx context.Context, deadline time.Time) {
+	d.runs.Drain()
+	d.jobs.Drain()
+	d.eng.Infow("Draining: refusing new pipeline runs, OCR transmissions and job services")
+
+	waitCtx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+	timedOut := !d.waitFor(waitCtx, "pipeline runs", func(ctx context.Context) (int, error) {
+		n := d.runs.InFlightRuns()
+		d.update(func(s *Status) { s.InFlightRuns = n })
+		return n, nil
+	})
+	if !timedOut {
+		d.update(func(s *Status) { s.Phase = PhaseWaitingForTransactions })
+		timedOut = !d.waitFor(waitCtx, "transactions", func(ctx context.Context) (int, error) {
+			n, err := d.pendingTransactions(ctx)
+			if err != nil {
+				return 0, err
+			}
+			d.update(func(s *Status) { s.PendingTransactions = n })
+			return n, nil
+		})
+	}
+	if ctx.Err() != nil {
+		return
+	}
+	if timedOut {
+		d.eng.Warnw("Draining: timed out waiting for the runs and transactions in flight", "timeout", d.timeout)
+		d.update(func(s *Status) { s.TimedOut = true })
+	}
+
+	d.update(func(s *Status) { s.Phase = PhaseStoppingJobs })
+	stopped := d.jobs.StopServices()
+	d.update(func(s *Status) {
+		s.StoppedJobs = stopped
+		s.Phase = PhaseCheckpointing
+	})
+	d.eng.Infow("Draining: stopped the services of all jobs", "count", stopped)
+
+	d.checkpoint(ctx)
+
+	now := time.Now()
+	d.update(func(s *Status) {
+		s.Phase = PhaseDrained
+		s.FinishedAt = &now
+	})
+	d.eng.Infow("Drained the node", "status", d.Status())
+}
+
+// waitFor polls count until it is zero, and returns false if ctx is done first.
+func (d *Drainer) waitFor(ctx context.Context, what string, count func(context.Context) (int, error)) bool {
+	ticker := time.NewTicker(d.pollInterval)
+	defer ticker.Stop()
+	for {
+		n, err := count(ctx)
+		if err != nil && ctx.Err() == nil {
+			d.addError(fmt.Errorf("failed to count %s in flight: %w", what, err))
+		} else if err == nil && n == 0 {
+			return true
+		} else if err == nil {
+			d.eng.Infow("Draining: waiting for "+what+" in flight", "count", n)
+		}
+		select {
+		case <-ctx.Done():
+			return false
+		case <-ticker.C:
+		}
+	}
+}
+
+func (d *Drainer) pendingTransactions(ctx context.Context) (int, error) {
+	var total int
+	var errs []error
+	for _, c := range d.chains {
+		for _, state := range pendingStates {
+			n, err := d.txStore.CountTransactionsByState(ctx, state, c.ID)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("chain %s: %w", c.ID, err))
+				continue
+			}
+			total += int(n)
+		}
+	}
+	return total, errors.Join(errs...)
+}
+
+func (d *Drainer) checkpoint(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, checkpointTimeout)
+	defer cancel()
+	for _, c := range d.chains {
+		if c.LogPoller == nil {
+			continue
+		}
+		block, err := c.LogPoller.Checkpoint(ctx)
+		if err != nil {
+			d.addError(fmt.Errorf("failed to checkpoint the log poller of chain %s: %w", c.ID, err))
+			continue
+		}
+		d.eng.Infow("Draining: checkpointed log poller", "chainID", c.ID, "blockNumber", block.BlockNumber)
+		d.update(func(s *Status) {
+			s.Checkpoints = append(s.Checkpoints, Checkpoint{ChainID: c.ID.String(), BlockNumber: block.BlockNumber})
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package drain
+
+import (
+	"context"
+	"math/big"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	lpmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+type fakeJobs struct{ drained, stopped atomic.Bool }
+
+func (j *fakeJobs) Drain() { j.drained.Store(true) }
+
+func (j *fakeJobs) StopServices() int {
+	j.stopped.Store(true)
+	return 2
+}
+
+type fakeRuns struct {
+	drained  atomic.Bool
+	inFlight atomic.Int64
+}
+
+func (r *fakeRuns) Drain() { r.drained.Store(true) }
+
+func (r *fakeRuns) InFlightRuns() int { return int(r.inFlight.Load()) }
+
+type fakeTxStore struct{ pending atomic.Int64 }
+
+func (s *fakeTxStore) CountTransactionsByState(ctx context.Context, state txmgrtypes.TxState, chainID *big.Int) (uint32, error) {
+	return uint32(s.pending.Load()), nil
+}
+
+func newTestDrainer(t *testing.T, timeout time.Duration, chains []Chain) (*Drainer, *fakeJobs, *fakeRuns, *fakeTxStore) {
+	jobs, runs, txStore := &fakeJobs{}, &fakeRuns{}, &fakeTxStore{}
+	d := NewDrainer(logger.Test(t), timeout, jobs, runs, txStore, chains)
+	d.pollInterval = 10 * time.Millisecond
+	servicetest.Run(t, d)
+	return d, jobs, runs, txStore
+}
+
+func TestDrainer_Drain(t *testing.T) {
+	lp := lpmocks.NewLogPoller(t)
+	lp.On("Checkpoint", mock.Anything).Return(logpoller.LogPollerBlock{BlockNumber: 42}, nil).Once()
+	d, jobs, runs, txStore := newTestDrainer(t, time.Minute, []Chain{{ID: big.NewInt(1), LogPoller: lp}, {ID: big.NewInt(2)}})
+	runs.inFlight.Store(1)
+	txStore.pending.Store(1)
+
+	assert.Equal(t, PhaseNotDraining, d.Status().Phase)
+	status := d.Drain()
+	require.NotNil(t, status.StartedAt)
+	require.NotNil(t, status.Deadline)
+	assert.Equal(t, time.Minute, status.Deadline.Sub(*status.StartedAt))
+
+	require.Eventually(t, func() bool { return d.Status().Phase == PhaseWaitingForRuns }, testutils.WaitTimeout(t), 10*time.Millisecond)
+	assert.True(t, jobs.drained.Load())
+	assert.True(t, runs.drained.Load())
+	// the job services keep running while work is in flight
+	assert.False(t, jobs.stopped.Load())
+
+	runs.inFlight.Store(0)
+	require.Eventually(t, func() bool { return d.Status().Phase == PhaseWaitingForTransactions }, testutils.WaitTimeout(t), 10*time.Millisecond)
+	// 1 pending transaction per state and chain
+	require.Eventually(t, func() bool { return d.Status().PendingTransactions == 6 }, testutils.WaitTimeout(t), 10*time.Millisecond)
+	assert.False(t, jobs.stopped.Load())
+
+	txStore.pending.Store(0)
+	select {
+	case <-d.Done():
+	case <-time.After(testutils.WaitTimeout(t)):
+		t.Fatal("timed out waiting for the drain")
+	}
+	status = d.Status()
+	assert.Equal(t, PhaseDrained, status.Phase)
+	assert.True(t, jobs.stopped.Load())
+	assert.Equal(t, 2, status.StoppedJobs)
+	assert.False(t, status.TimedOut)
+	assert.Empty(t, status.Errors)
+	assert.Equal(t, []Checkpoint{{ChainID: "1", BlockNumber: 42}}, status.Checkpoints)
+	require.NotNil(t, status.FinishedAt)
+
+	// draining again is a no-op
+	assert.Equal(t, status, d.Drain())
+}
+
+func TestDrainer_Timeout(t *testing.T) {
+	d, jobs, runs, _ := newTestDrainer(t, 100*time.Millisecond, nil)
+	runs.inFlight.Store(1)
+
+	d.Drain()
+	select {
+	case <-d.Done():
+	case <-time.After(testutils.WaitTimeout(t)):
+		t.Fatal("timed out waiting for the drain")
+	}
+	status := d.Status()
+	assert.Equal(t, PhaseDrained, status.Phase)
+	assert.True(t, status.TimedOut)
+	assert.Equal(t, 1, status.InFlightRuns)
+	// the jobs are stopped even if the drain timed out
+	assert.True(t, jobs.stopped.Load())
+}
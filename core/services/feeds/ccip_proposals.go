@@ -0,0 +1,172 @@
+package feeds
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pelletier/go-toml/v2"
+	"github.com/pkg/errors"
+
+	chainselectors "github.com/smartcontractkit/chain-selectors"
+
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+// isJobSpecTemplate returns true if the spec has lane parameters to be substituted by the node.
+func isJobSpecTemplate(spec string) bool {
+	return strings.Contains(spec, "{{")
+}
+
+// renderJobSpecTemplate substitutes the lane parameters of a templated spec with the values of this node, as configured
+// in the chain configs the proposing feeds manager holds for it. Parameters are text/template actions calling:
+//
+//	accountAddress "<chain id>"   the transmitter account address of the chain
+//	adminAddress "<chain id>"     the admin address of the chain
+//	ocr2KeyBundleID "<chain id>"  the OCR2 key bundle ID of the chain
+//	p2pPeerID "<chain id>"        the OCR2 P2P peer ID of the chain
+//	chainID "<chain selector>"    the chain ID of a chain selector
+func renderJobSpecTemplate(spec string, chainConfigs []ChainConfig) (string, error) {
+	findChainConfig := func(chainID string) (ChainConfig, error) {
+		for _, cfg := range chainConfigs {
+			if cfg.ChainID == chainID {
+				return cfg, nil
+			}
+		}
+		return ChainConfig{}, fmt.Errorf("no chain config for chain %s", chainID)
+	}
+	funcs := template.FuncMap{
+		"accountAddress": func(chainID string) (string, error) {
+			cfg, err := findChainConfig(chainID)
+			return cfg.AccountAddress, err
+		},
+		"adminAddress": func(chainID string) (string, error) {
+			cfg, err := findChainConfig(chainID)
+			return cfg.AdminAddress, err
+		},
+		"ocr2KeyBundleID": func(chainID string) (string, error) {
+			cfg, err := findChainConfig(chainID)
+			if err != nil {
+				return "", err
+			}
+			if !cfg.OCR2Config.KeyBundleID.Valid {
+				return "", fmt.Errorf("no OCR2 key bundle ID for chain %s", chainID)
+			}
+			return cfg.OCR2Config.KeyBundleID.String, nil
+		},
+		"p2pPeerID": func(chainID string) (string, error) {
+			cfg, err := findChainConfig(chainID)
+			if err != nil {
+				return "", err
+			}
+			if !cfg.OCR2Config.P2PPeerID.Valid {
+				return "", fmt.Errorf("no P2P peer ID for chain %s", chainID)
+			}
+			return cfg.OCR2Config.P2PPeerID.String, nil
+		},
+		"chainID": func(selector string) (string, error) {
+			sel, err := strconv.ParseUint(selector, 10, 64)
+			if err != nil {
+				return "", fmt.Errorf("invalid chain selector %s: %w", selector, err)
+			}
+			chainID, err := chainselectors.ChainIdFromSelector(sel)
+			if err != nil {
+				return "", err
+			}
+			return strconv.FormatUint(chainID, 10), nil
+		},
+	}
+
+	tmpl, err := template.New("spec").Funcs(funcs).Option("missingkey=error").Parse(spec)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to parse job spec template")
+	}
+	var b strings.Builder
+	if err = tmpl.Execute(&b, nil); err != nil {
+		return "", errors.Wrap(err, "failed to render job spec template")
+	}
+	return b.String(), nil
+}
+
+// ccipLane is the lane of a CCIP offRamp, as configured on chain.
+type ccipLane struct {
+	ChainSelector       uint64
+	SourceChainSelector uint64
+	OnRamp              common.Address
+	CommitStore         common.Address
+}
+
+// ccipLaneReader reads the lane of the offRamp deployed on the chain.
+type ccipLaneReader func(ctx context.Context, chainID uint64, offRamp common.Address) (ccipLane, error)
+
+// checkCCIPSpecAllowlist returns true if spec is a CCIP commit or execution spec, in which case err is nil only if the
+// chain selectors and the contract addresses of its lane are all allowed by cfg. The lane is read from the offRamp on
+// chain, since the source chain, the onRamp and the commitStore of execution specs are not part of the spec.
+func checkCCIPSpecAllowlist(ctx context.Context, cfg JobDistributorConfig, spec string, readLane ccipLaneReader) (isCCIP bool, err error) {
+	jobType, err := job.ValidateSpec(spec)
+	if err != nil || jobType != job.OffchainReporting2 {
+		return false, nil
+	}
+	var ocr2Spec struct {
+		ContractID   string                     `toml:"contractID"`
+		PluginType   commontypes.OCR2PluginType `toml:"pluginType"`
+		RelayConfig  map[string]interface{}     `toml:"relayConfig"`
+		PluginConfig map[string]interface{}     `toml:"pluginConfig"`
+	}
+	if err = toml.Unmarshal([]byte(spec), &ocr2Spec); err != nil {
+		return false, nil
+	}
+	if ocr2Spec.PluginType != commontypes.CCIPCommit && ocr2Spec.PluginType != commontypes.CCIPExecution {
+		return false, nil
+	}
+
+	chainID, err := strconv.ParseUint(fmt.Sprint(ocr2Spec.RelayConfig["chainID"]), 10, 64)
+	if err != nil {
+		return true, errors.Wrap(err, "invalid relayConfig chainID")
+	}
+	selector, err := chainselectors.SelectorFromChainId(chainID)
+	if err != nil {
+		return true, err
+	}
+	if !slices.Contains(cfg.CCIPChainSelectors(), selector) {
+		return true, errors.Errorf("chain selector %d is not allowed", selector)
+	}
+
+	// commit specs reference the offRamp of their lane, execution specs are the offRamp
+	offRamp := ocr2Spec.ContractID
+	if ocr2Spec.PluginType == commontypes.CCIPCommit {
+		offRamp, _ = ocr2Spec.PluginConfig["offRamp"].(string)
+	}
+	for _, address := range []string{ocr2Spec.ContractID, offRamp} {
+		if !common.IsHexAddress(address) {
+			return true, errors.Errorf("invalid contract address %q", address)
+		}
+	}
+
+	lane, err := readLane(ctx, chainID, common.HexToAddress(offRamp))
+	if err != nil {
+		return true, errors.Wrapf(err, "failed to read the lane of offRamp %s", offRamp)
+	}
+	if lane.ChainSelector != selector {
+		return true, errors.Errorf("offRamp %s is deployed for chain selector %d, not %d", offRamp, lane.ChainSelector, selector)
+	}
+	if !slices.Contains(cfg.CCIPChainSelectors(), lane.SourceChainSelector) {
+		return true, errors.Errorf("source chain selector %d is not allowed", lane.SourceChainSelector)
+	}
+	if ocr2Spec.PluginType == commontypes.CCIPCommit && common.HexToAddress(ocr2Spec.ContractID) != lane.CommitStore {
+		return true, errors.Errorf("commitStore %s is not the one of offRamp %s", ocr2Spec.ContractID, offRamp)
+	}
+
+	for _, address := range []common.Address{common.HexToAddress(offRamp), lane.CommitStore, lane.OnRamp} {
+		if !slices.Contains(cfg.CCIPContractAddresses(), address) {
+			return true, errors.Errorf("contract address %s is not allowed", address)
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,258 @@
+package feeds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+type jobDistributorConfig struct {
+	selectors []uint64
+	addresses []common.Address
+}
+
+func (c jobDistributorConfig) CCIPAutoApprove() bool                   { return true }
+func (c jobDistributorConfig) CCIPChainSelectors() []uint64            { return c.selectors }
+func (c jobDistributorConfig) CCIPContractAddresses() []common.Address { return c.addresses }
+
+const ccipCommitSpecTemplate = `
+type = "offchainreporting2"
+schemaVersion = 1
+name = "ccip-commit"
+contractID = "%s"
+ocrKeyBundleID = "{{ ocr2KeyBundleID "11155111" }}"
+transmitterID = "{{ accountAddress "11155111" }}"
+relay = "evm"
+pluginType = "ccip-commit"
+
+[relayConfig]
+chainID = {{ chainID "16015286601757825753" }}
+
+[pluginConfig]
+offRamp = "%s"
+`
+
+func Test_renderJobSpecTemplate(t *testing.T) {
+	t.Parallel()
+
+	chainConfigs := []ChainConfig{{
+		ChainID:        "11155111",
+		AccountAddress: "0x0000000000000000000000000000000000000001",
+		OCR2Config: OCR2ConfigModel{
+			KeyBundleID: null.StringFrom("bundle"),
+		},
+	}}
+
+	spec, err := renderJobSpecTemplate(fmt.Sprintf(ccipCommitSpecTemplate, "0xa", "0xb"), chainConfigs)
+	require.NoError(t, err)
+	assert.Contains(t, spec, `ocrKeyBundleID = "bundle"`)
+	assert.Contains(t, spec, `transmitterID = "0x0000000000000000000000000000000000000001"`)
+	assert.Contains(t, spec, `chainID = 11155111`)
+
+	_, err = renderJobSpecTemplate(`name = "{{ accountAddress "1" }}"`, chainConfigs)
+	require.ErrorContains(t, err, "no chain config for chain 1")
+
+	_, err = renderJobSpecTemplate(`name = "{{ p2pPeerID "11155111" }}"`, chainConfigs)
+	require.ErrorContains(t, err, "no P2P peer ID for chain 11155111")
+
+	_, err = renderJobSpecTemplate(`name = "{{ unknown }}"`, chainConfigs)
+	require.ErrorContains(t, err, "failed to parse job spec template")
+}
+
+func Test_checkCCIPSpecAllowlist(t *testing.T) {
+	t.Parallel()
+
+	var (
+		commitStore = "0xa0Ee7A142d267C1f36714E4a8F75612F20a79720"
+		offRamp     = "0x2222222222222222222222222222222222222222"
+		onRamp      = common.HexToAddress("0x3333333333333333333333333333333333333333")
+		// the selectors of chains 11155111 and 43113
+		selector       = uint64(16015286601757825753)
+		sourceSelector = uint64(14767482510784806043)
+		commitSpec     = fmt.Sprintf(`
+type = "offchainreporting2"
+schemaVersion = 1
+name = "ccip-commit"
+contractID = "%s"
+relay = "evm"
+pluginType = "ccip-commit"
+
+[relayConfig]
+chainID = 11155111
+
+[pluginConfig]
+offRamp = "%s"
+`, commitStore, offRamp)
+		execSpec = fmt.Sprintf(`
+type = "offchainreporting2"
+schemaVersion = 1
+name = "ccip-exec"
+contractID = "%s"
+relay = "evm"
+pluginType = "ccip-execution"
+
+[relayConfig]
+chainID = 11155111
+`, offRamp)
+		lane = ccipLane{
+			ChainSelector:       selector,
+			SourceChainSelector: sourceSelector,
+			OnRamp:              onRamp,
+			CommitStore:         common.HexToAddress(commitStore),
+		}
+		allowed = jobDistributorConfig{
+			selectors: []uint64{selector, sourceSelector},
+			addresses: []common.Address{common.HexToAddress(commitStore), common.HexToAddress(offRamp), onRamp},
+		}
+	)
+
+	tests := []struct {
+		name          string
+		spec          string
+		cfg           jobDistributorConfig
+		lane          ccipLane
+		wantCCIP      bool
+		wantErrString string
+	}{
+		{
+			name:     "allowed commit",
+			spec:     commitSpec,
+			cfg:      allowed,
+			lane:     lane,
+			wantCCIP: true,
+		},
+		{
+			name:     "allowed exec",
+			spec:     execSpec,
+			cfg:      allowed,
+			lane:     lane,
+			wantCCIP: true,
+		},
+		{
+			name: "chain selector not allowed",
+			spec: commitSpec,
+			cfg: jobDistributorConfig{
+				selectors: []uint64{sourceSelector},
+				addresses: allowed.addresses,
+			},
+			lane:          lane,
+			wantCCIP:      true,
+			wantErrString: "chain selector 16015286601757825753 is not allowed",
+		},
+		{
+			name: "source chain selector not allowed",
+			spec: execSpec,
+			cfg: jobDistributorConfig{
+				selectors: []uint64{selector},
+				addresses: allowed.addresses,
+			},
+			lane:          lane,
+			wantCCIP:      true,
+			wantErrString: "source chain selector 14767482510784806043 is not allowed",
+		},
+		{
+			name: "offRamp not allowed",
+			spec: commitSpec,
+			cfg: jobDistributorConfig{
+				selectors: allowed.selectors,
+				addresses: []common.Address{common.HexToAddress(commitStore), onRamp},
+			},
+			lane:          lane,
+			wantCCIP:      true,
+			wantErrString: "contract address " + offRamp + " is not allowed",
+		},
+		{
+			name: "onRamp not allowed",
+			spec: execSpec,
+			cfg: jobDistributorConfig{
+				selectors: allowed.selectors,
+				addresses: []common.Address{common.HexToAddress(commitStore), common.HexToAddress(offRamp)},
+			},
+			lane:          lane,
+			wantCCIP:      true,
+			wantErrString: "contract address " + onRamp.String() + " is not allowed",
+		},
+		{
+			name: "commitStore not allowed",
+			spec: execSpec,
+			cfg: jobDistributorConfig{
+				selectors: allowed.selectors,
+				addresses: []common.Address{common.HexToAddress(offRamp), onRamp},
+			},
+			lane:          lane,
+			wantCCIP:      true,
+			wantErrString: "contract address " + commitStore + " is not allowed",
+		},
+		{
+			name: "commitStore of another lane",
+			spec: commitSpec,
+			cfg:  allowed,
+			lane: ccipLane{
+				ChainSelector:       selector,
+				SourceChainSelector: sourceSelector,
+				OnRamp:              onRamp,
+				CommitStore:         common.HexToAddress("0x4444444444444444444444444444444444444444"),
+			},
+			wantCCIP:      true,
+			wantErrString: "commitStore " + commitStore + " is not the one of offRamp " + offRamp,
+		},
+		{
+			name: "offRamp of another chain",
+			spec: commitSpec,
+			cfg:  allowed,
+			lane: ccipLane{
+				ChainSelector:       sourceSelector,
+				SourceChainSelector: selector,
+				OnRamp:              onRamp,
+				CommitStore:         common.HexToAddress(commitStore),
+			},
+			wantCCIP:      true,
+			wantErrString: "is deployed for chain selector 14767482510784806043",
+		},
+		{
+			name: "not a CCIP spec",
+			spec: `type = "offchainreporting2"
+schemaVersion = 1
+pluginType = "median"`,
+		},
+		{
+			name: "invalid spec",
+			spec: "invalid",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			readLane := func(_ context.Context, chainID uint64, address common.Address) (ccipLane, error) {
+				assert.Equal(t, uint64(11155111), chainID)
+				assert.Equal(t, common.HexToAddress(offRamp), address)
+				return tc.lane, nil
+			}
+			isCCIP, err := checkCCIPSpecAllowlist(testutils.Context(t), tc.cfg, tc.spec, readLane)
+			assert.Equal(t, tc.wantCCIP, isCCIP)
+			if tc.wantErrString != "" {
+				require.ErrorContains(t, err, tc.wantErrString)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("lane can not be read", func(t *testing.T) {
+		readLane := func(context.Context, uint64, common.Address) (ccipLane, error) {
+			return ccipLane{}, errors.New("no contract code")
+		}
+		isCCIP, err := checkCCIPSpecAllowlist(testutils.Context(t), allowed, commitSpec, readLane)
+		assert.True(t, isCCIP)
+		require.ErrorContains(t, err, "failed to read the lane of offRamp "+offRamp+": no contract code")
+	})
+}
@@ -3,6 +3,8 @@ package feeds
 import (
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	coreconfig "github.com/smartcontractkit/chainlink/v2/core/config"
 )
@@ -39,3 +41,9 @@ type OCR2Config interface {
 	SimulateTransactions() bool
 	TraceLogging() bool
 }
+
+type JobDistributorConfig interface {
+	CCIPAutoApprove() bool
+	CCIPChainSelectors() []uint64
+	CCIPContractAddresses() []common.Address
+}
@@ -94,6 +94,8 @@ func (mgr *connectionsManager) Connect(opts ConnectOpts) {
 
 		mgr.lggr.Infow("Connecting to Feeds Manager...", "feedsManagerID", opts.FeedsManagerID)
 
+		// wsrpc only dials with the ed25519 CSA key of the node, pinned by the Feeds Manager, and its public key, so the
+		// connection can't be authenticated with the TLS keys of the keystore.
 		clientConn, err := wsrpc.DialWithContext(ctx, opts.URI,
 			wsrpc.WithTransportCreds(opts.Privkey, ed25519.PublicKey(opts.Pubkey)),
 			wsrpc.WithBlock(),
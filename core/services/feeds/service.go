@@ -5,7 +5,9 @@ import (
 	"database/sql"
 	"encoding/hex"
 	"fmt"
+	"strconv"
 
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/google/uuid"
 	"github.com/lib/pq"
@@ -24,6 +26,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_offramp_1_2_0"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	pb "github.com/smartcontractkit/chainlink/v2/core/services/feeds/proto"
 	"github.com/smartcontractkit/chainlink/v2/core/services/fluxmonitorv2"
@@ -67,6 +70,11 @@ var (
 		Help: "Metric to track workflow failed auto approvals",
 	})
 
+	promCCIPApprovals = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "feeds_ccip_approvals",
+		Help: "Metric to track CCIP spec successful auto approvals",
+	})
+
 	promJobProposalCounts = promauto.NewGaugeVec(prometheus.GaugeOpts{
 		Name: "feeds_job_proposal_count",
 		Help: "Number of job proposals for the node partitioned by status.",
@@ -131,6 +139,7 @@ type service struct {
 	jobCfg              JobConfig
 	ocrCfg              OCRConfig
 	ocr2cfg             OCR2Config
+	jdCfg               JobDistributorConfig
 	connMgr             ConnectionsManager
 	legacyChains        legacyevm.LegacyChainContainer
 	lggr                logger.Logger
@@ -151,6 +160,7 @@ func NewService(
 	jobCfg JobConfig,
 	ocrCfg OCRConfig,
 	ocr2Cfg OCR2Config,
+	jdCfg JobDistributorConfig,
 	legacyChains legacyevm.LegacyChainContainer,
 	lggr logger.Logger,
 	version string,
@@ -172,6 +182,7 @@ func NewService(
 		jobCfg:              jobCfg,
 		ocrCfg:              ocrCfg,
 		ocr2cfg:             ocr2Cfg,
+		jdCfg:               jdCfg,
 		connMgr:             newConnectionsManager(lggr),
 		legacyChains:        legacyChains,
 		lggr:                lggr,
@@ -550,6 +561,21 @@ type ProposeJobArgs struct {
 // generated by another feeds manager or they maliciously send an existing uuid
 // belonging to another feeds manager, we do not update it.
 func (s *service) ProposeJob(ctx context.Context, args *ProposeJobArgs) (int64, error) {
+	// Substitute the lane parameters of templated specs
+	if isJobSpecTemplate(args.Spec) {
+		chainConfigs, err := s.orm.ListChainConfigsByManagerIDs(ctx, []int64{args.FeedsManagerID})
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to list chain configs")
+		}
+		spec, err := renderJobSpecTemplate(args.Spec, chainConfigs)
+		if err != nil {
+			return 0, err
+		}
+		rendered := *args
+		rendered.Spec = spec
+		args = &rendered
+	}
+
 	// Validate the args
 	if err := s.validateProposeJobArgs(ctx, *args); err != nil {
 		return 0, err
@@ -638,6 +664,10 @@ func (s *service) ProposeJob(ctx context.Context, args *ProposeJobArgs) (int64,
 	} else {
 		// Track the given job proposal request
 		promJobProposalRequest.Inc()
+
+		if s.jdCfg.CCIPAutoApprove() {
+			s.autoApproveCCIPSpec(ctx, logger, id, specID, args.Spec)
+		}
 	}
 
 	if err = s.observeJobProposalCounts(ctx); err != nil {
@@ -657,6 +687,51 @@ func isWFSpec(lggr logger.Logger, spec string) bool {
 	return jobType == job.Workflow
 }
 
+// autoApproveCCIPSpec approves a CCIP spec if its lane is allowed by the job distributor config. Specs which are not
+// approved remain pending, for the node operator to review.
+func (s *service) autoApproveCCIPSpec(ctx context.Context, lggr logger.Logger, id int64, specID int64, spec string) {
+	isCCIP, err := checkCCIPSpecAllowlist(ctx, s.jdCfg, spec, s.readCCIPLane)
+	if !isCCIP {
+		return
+	}
+	if err != nil {
+		lggr.Infow("CCIP spec is not allowed for auto approval", "id", id, "err", err)
+		return
+	}
+	if err = s.ApproveSpec(ctx, specID, false); err != nil {
+		lggr.Errorw("Failed to auto approve CCIP spec", "id", id, "err", err)
+		return
+	}
+	lggr.Infow("Successful CCIP spec auto approval", "id", id)
+	promCCIPApprovals.Inc()
+}
+
+// readCCIPLane reads the lane of the offRamp from its static config. The static config of v1.5 offRamps extends the one
+// of v1.2 offRamps, so both are read with the v1.2 ABI.
+func (s *service) readCCIPLane(ctx context.Context, chainID uint64, offRamp common.Address) (ccipLane, error) {
+	if s.legacyChains == nil {
+		return ccipLane{}, errors.New("EVM is disabled")
+	}
+	chain, err := s.legacyChains.Get(strconv.FormatUint(chainID, 10))
+	if err != nil {
+		return ccipLane{}, err
+	}
+	caller, err := evm_2_evm_offramp_1_2_0.NewEVM2EVMOffRampCaller(offRamp, chain.Client())
+	if err != nil {
+		return ccipLane{}, err
+	}
+	cfg, err := caller.GetStaticConfig(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return ccipLane{}, err
+	}
+	return ccipLane{
+		ChainSelector:       cfg.ChainSelector,
+		SourceChainSelector: cfg.SourceChainSelector,
+		OnRamp:              cfg.OnRamp,
+		CommitStore:         cfg.CommitStore,
+	}, nil
+}
+
 // GetJobProposal gets a job proposal by id.
 func (s *service) GetJobProposal(ctx context.Context, id int64) (*JobProposal, error) {
 	return s.orm.GetJobProposal(ctx, id)
@@ -102,6 +102,11 @@ var (
 		Name: "functions_request_pruned",
 		Help: "Metric to track number of requests pruned from the DB",
 	}, []string{"router"})
+
+	promRequestQuotaExceeded = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "functions_request_quota_exceeded",
+		Help: "Metric to track number of requests rejected by subscription quotas",
+	}, []string{"router", "reason"})
 )
 
 const (
@@ -137,6 +142,7 @@ type functionsListener struct {
 	urlsMonEndpoint    commontypes.MonitoringEndpoint
 	decryptor          threshold.Decryptor
 	logPollerWrapper   evmrelayTypes.LogPollerWrapper
+	quotas             *SubscriptionQuotas
 }
 
 var _ FunctionsListener = &functionsListener{}
@@ -163,6 +169,7 @@ func NewFunctionsListener(
 	urlsMonEndpoint commontypes.MonitoringEndpoint,
 	decryptor threshold.Decryptor,
 	logPollerWrapper evmrelayTypes.LogPollerWrapper,
+	quotas *SubscriptionQuotas,
 ) *functionsListener {
 	return &functionsListener{
 		client:             client,
@@ -177,6 +184,7 @@ func NewFunctionsListener(
 		urlsMonEndpoint:    urlsMonEndpoint,
 		decryptor:          decryptor,
 		logPollerWrapper:   logPollerWrapper,
+		quotas:             quotas,
 	}
 }
 
@@ -396,6 +404,22 @@ func (l *functionsListener) handleRequest(ctx context.Context, requestID Request
 	requestIDStr := formatRequestId(requestID)
 	l.logger.Infow("processing request", "requestID", requestIDStr)
 
+	var computeReservation ComputeReservation
+	if l.quotas != nil {
+		var err error
+		if computeReservation, err = l.quotas.Admit(ctx, subscriptionId); err != nil {
+			if errors.Is(err, ErrRequestRateLimitExceeded) || errors.Is(err, ErrComputeQuotaExceeded) {
+				l.logger.Debugw("request rejected by subscription quota", "requestID", requestIDStr, "subscriptionID", subscriptionId, "err", err)
+				promRequestQuotaExceeded.WithLabelValues(l.contractAddressHex, err.Error()).Inc()
+				l.setError(ctx, requestID, USER_ERROR, []byte(err.Error()))
+				return nil // user error
+			}
+			l.logger.Errorw("failed to check subscription quota", "requestID", requestIDStr, "subscriptionID", subscriptionId, "err", err)
+			l.setError(ctx, requestID, INTERNAL_ERROR, []byte(err.Error()))
+			return err
+		}
+	}
+
 	eaClient, err := l.bridgeAccessor.NewExternalAdapterClient(ctx)
 	if err != nil {
 		l.logger.Errorw("failed to create ExternalAdapterClient", "requestID", requestIDStr, "err", err)
@@ -422,7 +446,13 @@ func (l *functionsListener) handleRequest(ctx context.Context, requestID Request
 		return nil // user error
 	}
 
+	computationStart := time.Now()
 	computationResult, computationError, domains, err := eaClient.RunComputation(ctx, requestIDStr, l.job.Name.ValueOrZero(), subscriptionOwner.Hex(), subscriptionId, flags, nodeProvidedSecrets, requestData)
+	if l.quotas != nil {
+		if err2 := l.quotas.RecordCompute(ctx, computeReservation, time.Since(computationStart)); err2 != nil {
+			l.logger.Errorw("failed to record subscription compute usage", "requestID", requestIDStr, "subscriptionID", subscriptionId, "err", err2)
+		}
+	}
 
 	if err != nil {
 		l.logger.Errorw("internal adapter error", "requestID", requestIDStr, "err", err)
@@ -533,6 +563,13 @@ func (l *functionsListener) pruneRequests() {
 			ctx, cancel := l.getNewHandlerContext()
 			startTime := time.Now()
 			nTotal, nPruned, err := l.pluginORM.PruneOldestRequests(ctx, maxStoredRequests, batchSize)
+			if l.quotas != nil {
+				if nUsage, err2 := l.quotas.PruneComputeUsage(ctx); err2 != nil {
+					l.logger.Errorw("error when pruning subscription compute usage", "err", err2)
+				} else if nUsage > 0 {
+					l.logger.Debugw("pruned subscription compute usage from the DB", "nPruned", nUsage)
+				}
+			}
 			cancel()
 			elapsedMillis := time.Since(startTime).Milliseconds()
 			if err != nil {
@@ -132,7 +132,7 @@ func NewFunctionsListenerUniverse(t *testing.T, timeoutSec int, pruneFrequencySe
 	s4Storage := s4_mocks.NewStorage(t)
 	client := chain.Client()
 	logPollerWrapper := evmrelay_mocks.NewLogPollerWrapper(t)
-	functionsListener := functions_service.NewFunctionsListener(jb, client, contractAddress, bridgeAccessor, pluginORM, pluginConfig, s4Storage, lggr, monEndpoint, decryptor, logPollerWrapper)
+	functionsListener := functions_service.NewFunctionsListener(jb, client, contractAddress, bridgeAccessor, pluginORM, pluginConfig, s4Storage, lggr, monEndpoint, decryptor, logPollerWrapper, nil)
 
 	return &FunctionsListenerUniverse{
 		service:          functionsListener,
@@ -0,0 +1,121 @@
+package functions
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/functions/config"
+)
+
+var (
+	ErrRequestRateLimitExceeded = errors.New("subscription request rate limit exceeded")
+	ErrComputeQuotaExceeded     = errors.New("subscription monthly compute quota exceeded")
+)
+
+// SubscriptionQuotas enforces the request rate limits and monthly compute quotas of subscriptions. Request rates are
+// limited in memory, while compute time is persisted, so that it is accounted across restarts.
+type SubscriptionQuotas struct {
+	orm      QuotaORM
+	defaults config.SubscriptionQuotasConfig
+	now      func() time.Time
+
+	mu       sync.Mutex
+	limiters map[uint64]*rate.Limiter
+}
+
+func NewSubscriptionQuotas(orm QuotaORM, defaults config.SubscriptionQuotasConfig) *SubscriptionQuotas {
+	return &SubscriptionQuotas{
+		orm:      orm,
+		defaults: defaults,
+		now:      time.Now,
+		limiters: make(map[uint64]*rate.Limiter),
+	}
+}
+
+// ComputeReservation is the compute time reserved by an admitted request, which RecordCompute settles.
+type ComputeReservation struct {
+	SubscriptionID uint64
+	Month          time.Time
+	Reserved       time.Duration
+}
+
+// computeReservation is reserved by each admitted request of a subscription with a compute quota, so that the check
+// of the quota and its update are a single atomic statement.
+const computeReservation = time.Millisecond
+
+// Admit returns ErrComputeQuotaExceeded if the subscription has used its compute quota for the month, or
+// ErrRequestRateLimitExceeded if it has exceeded its request rate. Any other error is internal. Admitted requests
+// reserve compute time, which must be settled with RecordCompute.
+func (q *SubscriptionQuotas) Admit(ctx context.Context, subscriptionID uint64) (ComputeReservation, error) {
+	reservation := ComputeReservation{SubscriptionID: subscriptionID, Month: StartOfMonth(q.now())}
+	quota, err := q.orm.GetQuota(ctx, subscriptionID)
+	if err != nil {
+		return reservation, errors.Wrap(err, "failed to get subscription quota")
+	}
+	requestsPerMinute, computeMillis := q.defaults.RequestsPerMinute, q.defaults.MonthlyComputeMillis
+	if quota != nil {
+		if quota.RequestsPerMinute != nil {
+			requestsPerMinute = *quota.RequestsPerMinute
+		}
+		if quota.MonthlyComputeMillis != nil {
+			computeMillis = *quota.MonthlyComputeMillis
+		}
+	}
+
+	if requestsPerMinute > 0 && !q.limiter(subscriptionID, requestsPerMinute).AllowN(q.now(), 1) {
+		return reservation, ErrRequestRateLimitExceeded
+	}
+	if computeMillis > 0 {
+		ok, err := q.orm.ReserveComputeUsage(ctx, subscriptionID, reservation.Month, computeReservation, time.Duration(computeMillis)*time.Millisecond)
+		if err != nil {
+			return reservation, errors.Wrap(err, "failed to reserve subscription compute usage")
+		}
+		if !ok {
+			return reservation, ErrComputeQuotaExceeded
+		}
+		reservation.Reserved = computeReservation
+	}
+	return reservation, nil
+}
+
+// RecordCompute adds the compute time used by an admitted request to the usage of its subscription in the month it
+// was admitted in, less the time it reserved.
+func (q *SubscriptionQuotas) RecordCompute(ctx context.Context, reservation ComputeReservation, used time.Duration) error {
+	if used <= reservation.Reserved {
+		return nil
+	}
+	return q.orm.AddComputeUsage(ctx, reservation.SubscriptionID, reservation.Month, used-reservation.Reserved)
+}
+
+// PruneComputeUsage deletes the compute usage of the months before the previous one, and returns the number of rows
+// deleted.
+func (q *SubscriptionQuotas) PruneComputeUsage(ctx context.Context) (int64, error) {
+	return q.orm.DeleteComputeUsageBefore(ctx, StartOfMonth(q.now()).AddDate(0, -1, 0))
+}
+
+// limiter returns the rate limiter of the subscription, updated to requestsPerMinute.
+func (q *SubscriptionQuotas) limiter(subscriptionID uint64, requestsPerMinute uint32) *rate.Limiter {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	limit := rate.Limit(float64(requestsPerMinute) / time.Minute.Seconds())
+	l, ok := q.limiters[subscriptionID]
+	if !ok {
+		l = rate.NewLimiter(limit, int(requestsPerMinute))
+		q.limiters[subscriptionID] = l
+	} else if l.Burst() != int(requestsPerMinute) {
+		now := q.now()
+		l.SetLimitAt(now, limit)
+		l.SetBurstAt(now, int(requestsPerMinute))
+	}
+	return l
+}
+
+// StartOfMonth returns the start of the calendar month (UTC) of t, which compute usage is accounted per.
+func StartOfMonth(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,154 @@
+package functions
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+)
+
+// SubscriptionQuota overrides the default quotas of a subscription. A nil limit uses the default.
+type SubscriptionQuota struct {
+	SubscriptionID       uint64    `db:"subscription_id"`
+	RequestsPerMinute    *uint32   `db:"requests_per_minute"`
+	MonthlyComputeMillis *uint64   `db:"monthly_compute_millis"`
+	UpdatedAt            time.Time `db:"updated_at"`
+}
+
+type QuotaORM interface {
+	// GetQuota returns the quota of a subscription, or nil if it uses the defaults.
+	GetQuota(ctx context.Context, subscriptionID uint64) (*SubscriptionQuota, error)
+	ListQuotas(ctx context.Context) ([]SubscriptionQuota, error)
+	UpsertQuota(ctx context.Context, quota SubscriptionQuota) error
+	DeleteQuota(ctx context.Context, subscriptionID uint64) error
+
+	// GetComputeUsage returns the compute time used by a subscription in the month starting at month.
+	GetComputeUsage(ctx context.Context, subscriptionID uint64, month time.Time) (time.Duration, error)
+	// ReserveComputeUsage atomically adds reserved to the compute time used by a subscription in the month starting at
+	// month, unless it would exceed quota, in which case it returns false.
+	ReserveComputeUsage(ctx context.Context, subscriptionID uint64, month time.Time, reserved, quota time.Duration) (bool, error)
+	AddComputeUsage(ctx context.Context, subscriptionID uint64, month time.Time, used time.Duration) error
+	// DeleteComputeUsageBefore deletes the compute usage of the months before month, and returns the number of rows
+	// deleted.
+	DeleteComputeUsageBefore(ctx context.Context, month time.Time) (int64, error)
+}
+
+type quotaORM struct {
+	ds              sqlutil.DataSource
+	contractAddress common.Address
+}
+
+var _ QuotaORM = (*quotaORM)(nil)
+
+func NewQuotaORM(ds sqlutil.DataSource, contractAddress common.Address) QuotaORM {
+	return &quotaORM{
+		ds:              ds,
+		contractAddress: contractAddress,
+	}
+}
+
+func (o *quotaORM) GetQuota(ctx context.Context, subscriptionID uint64) (*SubscriptionQuota, error) {
+	var quota SubscriptionQuota
+	stmt := `SELECT subscription_id, requests_per_minute, monthly_compute_millis, updated_at FROM functions_subscription_quotas
+		WHERE contract_address = $1 AND subscription_id = $2;`
+	if err := o.ds.GetContext(ctx, &quota, stmt, o.contractAddress, subscriptionID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &quota, nil
+}
+
+func (o *quotaORM) ListQuotas(ctx context.Context) ([]SubscriptionQuota, error) {
+	var quotas []SubscriptionQuota
+	stmt := `SELECT subscription_id, requests_per_minute, monthly_compute_millis, updated_at FROM functions_subscription_quotas
+		WHERE contract_address = $1 ORDER BY subscription_id;`
+	if err := o.ds.SelectContext(ctx, &quotas, stmt, o.contractAddress); err != nil {
+		return nil, err
+	}
+	return quotas, nil
+}
+
+func (o *quotaORM) UpsertQuota(ctx context.Context, quota SubscriptionQuota) error {
+	stmt := `INSERT INTO functions_subscription_quotas (contract_address, subscription_id, requests_per_minute, monthly_compute_millis, updated_at)
+		VALUES ($1, $2, $3, $4, NOW())
+		ON CONFLICT (contract_address, subscription_id) DO UPDATE SET
+		requests_per_minute = EXCLUDED.requests_per_minute,
+		monthly_compute_millis = EXCLUDED.monthly_compute_millis,
+		updated_at = EXCLUDED.updated_at;`
+	_, err := o.ds.ExecContext(ctx, stmt, o.contractAddress, quota.SubscriptionID, quota.RequestsPerMinute, quota.MonthlyComputeMillis)
+	return err
+}
+
+func (o *quotaORM) DeleteQuota(ctx context.Context, subscriptionID uint64) error {
+	stmt := `DELETE FROM functions_subscription_quotas WHERE contract_address = $1 AND subscription_id = $2;`
+	res, err := o.ds.ExecContext(ctx, stmt, o.contractAddress, subscriptionID)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (o *quotaORM) GetComputeUsage(ctx context.Context, subscriptionID uint64, month time.Time) (time.Duration, error) {
+	var millis int64
+	stmt := `SELECT compute_millis FROM functions_subscription_usage
+		WHERE contract_address = $1 AND subscription_id = $2 AND month = $3;`
+	if err := o.ds.GetContext(ctx, &millis, stmt, o.contractAddress, subscriptionID, month); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return time.Duration(millis) * time.Millisecond, nil
+}
+
+func (o *quotaORM) ReserveComputeUsage(ctx context.Context, subscriptionID uint64, month time.Time, reserved, quota time.Duration) (bool, error) {
+	if reserved > quota {
+		return false, nil
+	}
+	// the usage is only updated if it stays within the quota, so concurrent requests can't exceed it
+	stmt := `INSERT INTO functions_subscription_usage (contract_address, subscription_id, month, compute_millis)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (contract_address, subscription_id, month) DO UPDATE SET
+		compute_millis = functions_subscription_usage.compute_millis + EXCLUDED.compute_millis
+		WHERE functions_subscription_usage.compute_millis + EXCLUDED.compute_millis <= $5;`
+	res, err := o.ds.ExecContext(ctx, stmt, o.contractAddress, subscriptionID, month, reserved.Milliseconds(), quota.Milliseconds())
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (o *quotaORM) AddComputeUsage(ctx context.Context, subscriptionID uint64, month time.Time, used time.Duration) error {
+	stmt := `INSERT INTO functions_subscription_usage (contract_address, subscription_id, month, compute_millis)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (contract_address, subscription_id, month) DO UPDATE SET
+		compute_millis = functions_subscription_usage.compute_millis + EXCLUDED.compute_millis;`
+	_, err := o.ds.ExecContext(ctx, stmt, o.contractAddress, subscriptionID, month, used.Milliseconds())
+	return err
+}
+
+func (o *quotaORM) DeleteComputeUsageBefore(ctx context.Context, month time.Time) (int64, error) {
+	stmt := `DELETE FROM functions_subscription_usage WHERE contract_address = $1 AND month < $2;`
+	res, err := o.ds.ExecContext(ctx, stmt, o.contractAddress, month)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
@@ -0,0 +1,117 @@
+package functions_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/functions"
+	functions_mocks "github.com/smartcontractkit/chainlink/v2/core/services/functions/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/functions/config"
+)
+
+func TestSubscriptionQuotas_Admit(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	admit := func(quotas *functions.SubscriptionQuotas, subscriptionID uint64) error {
+		_, err := quotas.Admit(ctx, subscriptionID)
+		return err
+	}
+
+	t.Run("no limits", func(t *testing.T) {
+		orm := functions_mocks.NewQuotaORM(t)
+		orm.On("GetQuota", mock.Anything, uint64(1)).Return(nil, nil)
+		quotas := functions.NewSubscriptionQuotas(orm, config.SubscriptionQuotasConfig{})
+
+		for i := 0; i < 10; i++ {
+			require.NoError(t, admit(quotas, 1))
+		}
+	})
+
+	t.Run("default request rate", func(t *testing.T) {
+		orm := functions_mocks.NewQuotaORM(t)
+		orm.On("GetQuota", mock.Anything, mock.Anything).Return(nil, nil)
+		quotas := functions.NewSubscriptionQuotas(orm, config.SubscriptionQuotasConfig{RequestsPerMinute: 2})
+
+		require.NoError(t, admit(quotas, 1))
+		require.NoError(t, admit(quotas, 1))
+		require.ErrorIs(t, admit(quotas, 1), functions.ErrRequestRateLimitExceeded)
+		// Subscriptions are limited independently.
+		require.NoError(t, admit(quotas, 2))
+	})
+
+	t.Run("overridden request rate", func(t *testing.T) {
+		orm := functions_mocks.NewQuotaORM(t)
+		rpm := uint32(1)
+		orm.On("GetQuota", mock.Anything, uint64(1)).Return(&functions.SubscriptionQuota{SubscriptionID: 1, RequestsPerMinute: &rpm}, nil)
+		quotas := functions.NewSubscriptionQuotas(orm, config.SubscriptionQuotasConfig{RequestsPerMinute: 100})
+
+		require.NoError(t, admit(quotas, 1))
+		require.ErrorIs(t, admit(quotas, 1), functions.ErrRequestRateLimitExceeded)
+	})
+
+	t.Run("compute quota", func(t *testing.T) {
+		orm := functions_mocks.NewQuotaORM(t)
+		computeMillis := uint64(1000)
+		orm.On("GetQuota", mock.Anything, uint64(1)).Return(&functions.SubscriptionQuota{SubscriptionID: 1, MonthlyComputeMillis: &computeMillis}, nil)
+		orm.On("ReserveComputeUsage", mock.Anything, uint64(1), mock.Anything, time.Millisecond, time.Second).Return(true, nil).Once()
+		orm.On("ReserveComputeUsage", mock.Anything, uint64(1), mock.Anything, time.Millisecond, time.Second).Return(false, nil).Once()
+		quotas := functions.NewSubscriptionQuotas(orm, config.SubscriptionQuotasConfig{})
+
+		reservation, err := quotas.Admit(ctx, 1)
+		require.NoError(t, err)
+		require.Equal(t, time.Millisecond, reservation.Reserved)
+		_, err = quotas.Admit(ctx, 1)
+		require.ErrorIs(t, err, functions.ErrComputeQuotaExceeded)
+	})
+
+	t.Run("ORM error", func(t *testing.T) {
+		orm := functions_mocks.NewQuotaORM(t)
+		orm.On("GetQuota", mock.Anything, uint64(1)).Return(nil, errors.New("boom"))
+		quotas := functions.NewSubscriptionQuotas(orm, config.SubscriptionQuotasConfig{})
+
+		_, err := quotas.Admit(ctx, 1)
+		require.Error(t, err)
+		require.NotErrorIs(t, err, functions.ErrRequestRateLimitExceeded)
+		require.NotErrorIs(t, err, functions.ErrComputeQuotaExceeded)
+	})
+}
+
+func TestSubscriptionQuotas_RecordCompute(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	month := functions.StartOfMonth(time.Now())
+	orm := functions_mocks.NewQuotaORM(t)
+	orm.On("AddComputeUsage", mock.Anything, uint64(1), month, 1499*time.Millisecond).Return(nil).Once()
+	quotas := functions.NewSubscriptionQuotas(orm, config.SubscriptionQuotasConfig{})
+
+	reservation := functions.ComputeReservation{SubscriptionID: 1, Month: month, Reserved: time.Millisecond}
+	require.NoError(t, quotas.RecordCompute(ctx, reservation, 1500*time.Millisecond))
+	// requests shorter than their reservation are charged the reservation
+	require.NoError(t, quotas.RecordCompute(ctx, reservation, 0))
+}
+
+func TestSubscriptionQuotas_PruneComputeUsage(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	orm := functions_mocks.NewQuotaORM(t)
+	orm.On("DeleteComputeUsageBefore", mock.Anything, functions.StartOfMonth(time.Now()).AddDate(0, -1, 0)).Return(int64(2), nil).Once()
+	quotas := functions.NewSubscriptionQuotas(orm, config.SubscriptionQuotasConfig{})
+
+	n, err := quotas.PruneComputeUsage(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), n)
+}
+
+func TestStartOfMonth(t *testing.T) {
+	t.Parallel()
+
+	loc := time.FixedZone("UTC+10", 10*60*60)
+	require.Equal(t, time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), functions.StartOfMonth(time.Date(2024, time.February, 1, 5, 0, 0, 0, loc)))
+}
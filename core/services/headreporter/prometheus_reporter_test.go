@@ -124,6 +124,7 @@ func newLegacyChainContainer(t *testing.T, db *sqlx.DB) legacyevm.LegacyChainCon
 
 	txm, err := txmgr.NewTxm(
 		db,
+		nil,
 		evmConfig,
 		evmConfig.GasEstimator(),
 		evmConfig.Transactions(),
@@ -135,7 +136,8 @@ func newLegacyChainContainer(t *testing.T, db *sqlx.DB) legacyevm.LegacyChainCon
 		lp,
 		keyStore,
 		estimator,
-		ht)
+		ht,
+		nil)
 	require.NoError(t, err)
 
 	cfg := configtest.NewGeneralConfig(t, nil)
@@ -230,6 +230,64 @@ func (_c *ORM_CreateJob_Call) RunAndReturn(run func(context.Context, *job.Job) e
 	return _c
 }
 
+// CreateSpecVersion provides a mock function with given fields: ctx, jobID, toml
+func (_m *ORM) CreateSpecVersion(ctx context.Context, jobID int32, toml string) (job.SpecVersion, error) {
+	ret := _m.Called(ctx, jobID, toml)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSpecVersion")
+	}
+
+	var r0 job.SpecVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, string) (job.SpecVersion, error)); ok {
+		return rf(ctx, jobID, toml)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32, string) job.SpecVersion); ok {
+		r0 = rf(ctx, jobID, toml)
+	} else {
+		r0 = ret.Get(0).(job.SpecVersion)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32, string) error); ok {
+		r1 = rf(ctx, jobID, toml)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_CreateSpecVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSpecVersion'
+type ORM_CreateSpecVersion_Call struct {
+	*mock.Call
+}
+
+// CreateSpecVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+//   - toml string
+func (_e *ORM_Expecter) CreateSpecVersion(ctx interface{}, jobID interface{}, toml interface{}) *ORM_CreateSpecVersion_Call {
+	return &ORM_CreateSpecVersion_Call{Call: _e.mock.On("CreateSpecVersion", ctx, jobID, toml)}
+}
+
+func (_c *ORM_CreateSpecVersion_Call) Run(run func(ctx context.Context, jobID int32, toml string)) *ORM_CreateSpecVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ORM_CreateSpecVersion_Call) Return(_a0 job.SpecVersion, _a1 error) *ORM_CreateSpecVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_CreateSpecVersion_Call) RunAndReturn(run func(context.Context, int32, string) (job.SpecVersion, error)) *ORM_CreateSpecVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DataSource provides a mock function with given fields:
 func (_m *ORM) DataSource() sqlutil.DataSource {
 	ret := _m.Called()
@@ -1307,6 +1365,123 @@ func (_c *ORM_FindSpecErrorsByJobIDs_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// FindSpecVersion provides a mock function with given fields: ctx, jobID, version
+func (_m *ORM) FindSpecVersion(ctx context.Context, jobID int32, version int32) (job.SpecVersion, error) {
+	ret := _m.Called(ctx, jobID, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSpecVersion")
+	}
+
+	var r0 job.SpecVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, int32) (job.SpecVersion, error)); ok {
+		return rf(ctx, jobID, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32, int32) job.SpecVersion); ok {
+		r0 = rf(ctx, jobID, version)
+	} else {
+		r0 = ret.Get(0).(job.SpecVersion)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32, int32) error); ok {
+		r1 = rf(ctx, jobID, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_FindSpecVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSpecVersion'
+type ORM_FindSpecVersion_Call struct {
+	*mock.Call
+}
+
+// FindSpecVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+//   - version int32
+func (_e *ORM_Expecter) FindSpecVersion(ctx interface{}, jobID interface{}, version interface{}) *ORM_FindSpecVersion_Call {
+	return &ORM_FindSpecVersion_Call{Call: _e.mock.On("FindSpecVersion", ctx, jobID, version)}
+}
+
+func (_c *ORM_FindSpecVersion_Call) Run(run func(ctx context.Context, jobID int32, version int32)) *ORM_FindSpecVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32), args[2].(int32))
+	})
+	return _c
+}
+
+func (_c *ORM_FindSpecVersion_Call) Return(_a0 job.SpecVersion, _a1 error) *ORM_FindSpecVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_FindSpecVersion_Call) RunAndReturn(run func(context.Context, int32, int32) (job.SpecVersion, error)) *ORM_FindSpecVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindSpecVersions provides a mock function with given fields: ctx, jobID
+func (_m *ORM) FindSpecVersions(ctx context.Context, jobID int32) ([]job.SpecVersion, error) {
+	ret := _m.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSpecVersions")
+	}
+
+	var r0 []job.SpecVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) ([]job.SpecVersion, error)); ok {
+		return rf(ctx, jobID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) []job.SpecVersion); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]job.SpecVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, jobID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_FindSpecVersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSpecVersions'
+type ORM_FindSpecVersions_Call struct {
+	*mock.Call
+}
+
+// FindSpecVersions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+func (_e *ORM_Expecter) FindSpecVersions(ctx interface{}, jobID interface{}) *ORM_FindSpecVersions_Call {
+	return &ORM_FindSpecVersions_Call{Call: _e.mock.On("FindSpecVersions", ctx, jobID)}
+}
+
+func (_c *ORM_FindSpecVersions_Call) Run(run func(ctx context.Context, jobID int32)) *ORM_FindSpecVersions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *ORM_FindSpecVersions_Call) Return(_a0 []job.SpecVersion, _a1 error) *ORM_FindSpecVersions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_FindSpecVersions_Call) RunAndReturn(run func(context.Context, int32) ([]job.SpecVersion, error)) *ORM_FindSpecVersions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindTaskResultByRunIDAndTaskName provides a mock function with given fields: ctx, runID, taskName
 func (_m *ORM) FindTaskResultByRunIDAndTaskName(ctx context.Context, runID int64, taskName string) ([]byte, error) {
 	ret := _m.Called(ctx, runID, taskName)
@@ -1577,6 +1752,65 @@ func (_c *ORM_RecordError_Call) RunAndReturn(run func(context.Context, int32, st
 	return _c
 }
 
+// SubscribeSpecErrors provides a mock function with given fields:
+func (_m *ORM) SubscribeSpecErrors() (<-chan job.SpecError, func()) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribeSpecErrors")
+	}
+
+	var r0 <-chan job.SpecError
+	var r1 func()
+	if rf, ok := ret.Get(0).(func() (<-chan job.SpecError, func())); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() <-chan job.SpecError); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan job.SpecError)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() func()); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+
+	return r0, r1
+}
+
+// ORM_SubscribeSpecErrors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeSpecErrors'
+type ORM_SubscribeSpecErrors_Call struct {
+	*mock.Call
+}
+
+// SubscribeSpecErrors is a helper method to define mock.On call
+func (_e *ORM_Expecter) SubscribeSpecErrors() *ORM_SubscribeSpecErrors_Call {
+	return &ORM_SubscribeSpecErrors_Call{Call: _e.mock.On("SubscribeSpecErrors")}
+}
+
+func (_c *ORM_SubscribeSpecErrors_Call) Run(run func()) *ORM_SubscribeSpecErrors_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ORM_SubscribeSpecErrors_Call) Return(_a0 <-chan job.SpecError, _a1 func()) *ORM_SubscribeSpecErrors_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_SubscribeSpecErrors_Call) RunAndReturn(run func() (<-chan job.SpecError, func())) *ORM_SubscribeSpecErrors_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TryRecordError provides a mock function with given fields: ctx, jobID, description
 func (_m *ORM) TryRecordError(ctx context.Context, jobID int32, description string) {
 	_m.Called(ctx, jobID, description)
@@ -1612,6 +1846,102 @@ func (_c *ORM_TryRecordError_Call) RunAndReturn(run func(context.Context, int32,
 	return _c
 }
 
+// UpdateJobPaused provides a mock function with given fields: ctx, jobID, paused
+func (_m *ORM) UpdateJobPaused(ctx context.Context, jobID int32, paused bool) error {
+	ret := _m.Called(ctx, jobID, paused)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateJobPaused")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, bool) error); ok {
+		r0 = rf(ctx, jobID, paused)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ORM_UpdateJobPaused_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateJobPaused'
+type ORM_UpdateJobPaused_Call struct {
+	*mock.Call
+}
+
+// UpdateJobPaused is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+//   - paused bool
+func (_e *ORM_Expecter) UpdateJobPaused(ctx interface{}, jobID interface{}, paused interface{}) *ORM_UpdateJobPaused_Call {
+	return &ORM_UpdateJobPaused_Call{Call: _e.mock.On("UpdateJobPaused", ctx, jobID, paused)}
+}
+
+func (_c *ORM_UpdateJobPaused_Call) Run(run func(ctx context.Context, jobID int32, paused bool)) *ORM_UpdateJobPaused_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *ORM_UpdateJobPaused_Call) Return(_a0 error) *ORM_UpdateJobPaused_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ORM_UpdateJobPaused_Call) RunAndReturn(run func(context.Context, int32, bool) error) *ORM_UpdateJobPaused_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateOCR2Standby provides a mock function with given fields: ctx, specID, standby
+func (_m *ORM) UpdateOCR2Standby(ctx context.Context, specID int32, standby bool) error {
+	ret := _m.Called(ctx, specID, standby)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOCR2Standby")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, bool) error); ok {
+		r0 = rf(ctx, specID, standby)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ORM_UpdateOCR2Standby_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOCR2Standby'
+type ORM_UpdateOCR2Standby_Call struct {
+	*mock.Call
+}
+
+// UpdateOCR2Standby is a helper method to define mock.On call
+//   - ctx context.Context
+//   - specID int32
+//   - standby bool
+func (_e *ORM_Expecter) UpdateOCR2Standby(ctx interface{}, specID interface{}, standby interface{}) *ORM_UpdateOCR2Standby_Call {
+	return &ORM_UpdateOCR2Standby_Call{Call: _e.mock.On("UpdateOCR2Standby", ctx, specID, standby)}
+}
+
+func (_c *ORM_UpdateOCR2Standby_Call) Run(run func(ctx context.Context, specID int32, standby bool)) *ORM_UpdateOCR2Standby_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *ORM_UpdateOCR2Standby_Call) Return(_a0 error) *ORM_UpdateOCR2Standby_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ORM_UpdateOCR2Standby_Call) RunAndReturn(run func(context.Context, int32, bool) error) *ORM_UpdateOCR2Standby_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WithDataSource provides a mock function with given fields: source
 func (_m *ORM) WithDataSource(source sqlutil.DataSource) job.ORM {
 	ret := _m.Called(source)
@@ -193,7 +193,11 @@ type Job struct {
 	ForwardingAllowed             bool          `toml:"forwardingAllowed"`
 	Name                          null.String   `toml:"name"`
 	MaxTaskDuration               models.Interval
+	MaxSuccessfulRuns             clnull.Uint32     `toml:"maxSuccessfulRuns"`   // overrides JobPipeline.MaxSuccessfulRuns, only errored runs are kept if 0
+	RunRetention                  models.Interval   `toml:"runRetention"`        // overrides JobPipeline.ReaperThreshold if not 0
+	ErroredRunRetention           models.Interval   `toml:"erroredRunRetention"` // how long errored runs are kept if not 0, defaults to RunRetention
 	Pipeline                      pipeline.Pipeline `toml:"observationSource"`
+	Paused                        bool              `toml:"-"` // paused jobs are not started until resumed
 	CreatedAt                     time.Time
 }
 
@@ -244,6 +248,14 @@ type SpecError struct {
 	UpdatedAt   time.Time
 }
 
+// SpecVersion is the TOML a job was created or updated with. Versions are numbered from 1 for each job.
+type SpecVersion struct {
+	JobID     int32
+	Version   int32
+	TOML      string `db:"toml"`
+	CreatedAt time.Time
+}
+
 // SetID takes the id as a string and attempts to convert it to an int32. If
 // it succeeds, it will set it as the id on the job
 func (j *SpecError) SetID(value string) error {
@@ -382,13 +394,18 @@ type OCR2OracleSpec struct {
 	BlockchainTimeout                 models.Interval      `toml:"blockchainTimeout"`
 	ContractConfigTrackerPollInterval models.Interval      `toml:"contractConfigTrackerPollInterval"`
 	ContractConfigConfirmations       uint16               `toml:"contractConfigConfirmations"`
+	ObservationTimeout                models.Interval      `toml:"observationTimeout"`
+	ReportTimeout                     models.Interval      `toml:"reportTimeout"`
 	OnchainSigningStrategy            JSONConfig           `toml:"onchainSigningStrategy"`
+	TransmissionSchedule              JSONConfig           `toml:"transmissionSchedule"`
 	PluginConfig                      JSONConfig           `toml:"pluginConfig"`
 	PluginType                        types.OCR2PluginType `toml:"pluginType"`
 	CreatedAt                         time.Time            `toml:"-"`
 	UpdatedAt                         time.Time            `toml:"-"`
 	CaptureEATelemetry                bool                 `toml:"captureEATelemetry"`
 	CaptureAutomationCustomTelemetry  bool                 `toml:"captureAutomationCustomTelemetry"`
+	Standby                           bool                 `toml:"standby"`
+	ReportEncryptionKeyID             null.String          `toml:"reportEncryptionKeyID"`
 }
 
 func validateRelayID(id types.RelayID) error {
@@ -497,12 +514,35 @@ type DirectRequestSpec struct {
 	UpdatedAt                time.Time                `toml:"-"`
 }
 
+// CronCatchUp is the policy of a cron job for the runs missed while it wasn't running, e.g. while the node was down.
+type CronCatchUp string
+
+const (
+	// CronCatchUpSkip skips the missed runs. It is the default.
+	CronCatchUpSkip CronCatchUp = "skip"
+	// CronCatchUpRunOnce runs once on start if any run was missed.
+	CronCatchUpRunOnce CronCatchUp = "run-once"
+	// CronCatchUpRunAll runs once on start for each missed run, up to MaxCronCatchUpRuns.
+	CronCatchUpRunAll CronCatchUp = "run-all"
+)
+
+// MaxCronCatchUpRuns is the maximum number of missed runs of a cron job with CronCatchUpRunAll run on start.
+const MaxCronCatchUpRuns = 100
+
 type CronSpec struct {
-	ID           int32     `toml:"-"`
-	CronSchedule string    `toml:"schedule"`
-	EVMChainID   *big.Big  `toml:"evmChainID"`
-	CreatedAt    time.Time `toml:"-"`
-	UpdatedAt    time.Time `toml:"-"`
+	ID           int32    `toml:"-"`
+	CronSchedule string   `toml:"schedule"`
+	EVMChainID   *big.Big `toml:"evmChainID"`
+	// Jitter delays each run by a random duration up to Jitter, so that the nodes running the same schedule don't run
+	// at the same time.
+	Jitter  models.Interval `toml:"jitter"`
+	CatchUp CronCatchUp     `toml:"catchUp"`
+	// PreventOverlap skips the runs scheduled while the previous run is still in progress.
+	PreventOverlap bool `toml:"preventOverlap"`
+	// LastRunAt is the time the job was last scheduled to run.
+	LastRunAt *time.Time `toml:"-"`
+	CreatedAt time.Time  `toml:"-"`
+	UpdatedAt time.Time  `toml:"-"`
 }
 
 func (s CronSpec) GetID() string {
@@ -565,6 +605,12 @@ type VRFSpec struct {
 	// BatchFulfillmentGasMultiplier is used to determine the final gas estimate for the batch
 	// fulfillment.
 	BatchFulfillmentGasMultiplier tomlutils.Float64 `toml:"batchFulfillmentGasMultiplier"`
+	// BatchFulfillmentMaxWaitBlocks is the maximum number of blocks that a confirmed request is
+	// held back, so that it is fulfilled in a batch together with the requests of later blocks.
+	// Requests are fulfilled earlier once they fill a batch. Requires batchFulfillmentEnabled.
+	//
+	// Optional, V2Plus only. Requests are not held back if 0.
+	BatchFulfillmentMaxWaitBlocks uint32 `toml:"batchFulfillmentMaxWaitBlocks"`
 
 	// VRFOwnerAddress is the address of the VRFOwner address to use.
 	//
@@ -0,0 +1,77 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+)
+
+// oracleErrorTTL is how long an error logged by an oracle fails its health check.
+const oracleErrorTTL = 5 * time.Minute
+
+var (
+	_ services.HealthReporter = (*OracleService)(nil)
+	_ Promotable              = (*OracleService)(nil)
+)
+
+// OracleService wraps an OCR oracle, which has no health checks of its own. The oracle is reported as failing while
+// it is not running, and for a while after it logged an error.
+type OracleService struct {
+	services.StateMachine
+	name   string
+	oracle ServiceCtx
+	now    func() time.Time
+
+	mu        sync.RWMutex
+	lastErr   error
+	lastErrAt time.Time
+}
+
+// NewOracleService returns an OracleService reporting the health of oracle under name.
+func NewOracleService(name string, oracle ServiceCtx) *OracleService {
+	return &OracleService{name: name, oracle: oracle, now: time.Now}
+}
+
+func (o *OracleService) Start(ctx context.Context) error {
+	return o.StartOnce(o.name, func() error {
+		return o.oracle.Start(ctx)
+	})
+}
+
+func (o *OracleService) Close() error {
+	return o.StopOnce(o.name, o.oracle.Close)
+}
+
+// Promote promotes the wrapped oracle, if it is held in standby.
+func (o *OracleService) Promote(ctx context.Context) error {
+	if p, ok := o.oracle.(Promotable); ok {
+		return p.Promote(ctx)
+	}
+	return nil
+}
+
+func (o *OracleService) Name() string { return o.name }
+
+func (o *OracleService) HealthReport() map[string]error {
+	err := o.Healthy()
+	o.mu.RLock()
+	if o.lastErr != nil && o.now().Sub(o.lastErrAt) < oracleErrorTTL {
+		err = errors.Join(err, o.lastErr)
+	}
+	o.mu.RUnlock()
+	return map[string]error{o.name: err}
+}
+
+// RecordError records an error logged by the oracle. It is safe to call on a nil OracleService.
+func (o *OracleService) RecordError(msg string) {
+	if o == nil {
+		return
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.lastErr = errors.New(msg)
+	o.lastErrAt = o.now()
+}
@@ -0,0 +1,46 @@
+package job_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+func TestOracleService(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	srv := &fakeService{}
+	o := job.NewOracleService("OCR2.1.Median", srv)
+	assert.Error(t, o.HealthReport()["OCR2.1.Median"], "not started")
+
+	require.NoError(t, o.Start(ctx))
+	assert.Equal(t, 1, srv.starts)
+	assert.Equal(t, map[string]error{"OCR2.1.Median": nil}, o.HealthReport())
+
+	o.RecordError("failed to transmit")
+	assert.ErrorContains(t, o.HealthReport()["OCR2.1.Median"], "failed to transmit")
+
+	require.NoError(t, o.Close())
+	assert.Equal(t, 1, srv.closes)
+	assert.Error(t, o.HealthReport()["OCR2.1.Median"])
+
+	(*job.OracleService)(nil).RecordError("ignored")
+
+	t.Run("standby", func(t *testing.T) {
+		srv := &fakeService{}
+		o := job.NewOracleService("OCR2.2.Median", job.NewStandbyService(logger.TestLogger(t), srv))
+
+		require.NoError(t, o.Start(ctx))
+		assert.Equal(t, 0, srv.starts)
+		assert.NoError(t, o.HealthReport()["OCR2.2.Median"])
+
+		require.NoError(t, o.Promote(ctx))
+		assert.Equal(t, 1, srv.starts)
+	})
+}
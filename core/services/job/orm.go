@@ -33,6 +33,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 var (
@@ -57,8 +58,19 @@ type ORM interface {
 	RecordError(ctx context.Context, jobID int32, description string) error
 	// TryRecordError is a helper which calls RecordError and logs the returned error if present.
 	TryRecordError(ctx context.Context, jobID int32, description string)
+	// SubscribeSpecErrors subscribes to the spec errors recorded from now on. The returned function unsubscribes.
+	SubscribeSpecErrors() (<-chan SpecError, func())
 	DismissError(ctx context.Context, errorID int64) error
 	FindSpecError(ctx context.Context, id int64) (SpecError, error)
+	// UpdateOCR2Standby sets whether the OCR2 oracle spec is held in standby.
+	UpdateOCR2Standby(ctx context.Context, specID int32, standby bool) error
+	// UpdateJobPaused sets whether the job is paused.
+	UpdateJobPaused(ctx context.Context, jobID int32, paused bool) error
+	// CreateSpecVersion records toml as the next version of the job spec.
+	CreateSpecVersion(ctx context.Context, jobID int32, toml string) (SpecVersion, error)
+	// FindSpecVersions returns the recorded versions of the job spec, oldest first.
+	FindSpecVersions(ctx context.Context, jobID int32) ([]SpecVersion, error)
+	FindSpecVersion(ctx context.Context, jobID int32, version int32) (SpecVersion, error)
 	Close() error
 	PipelineRuns(ctx context.Context, jobID *int32, offset, size int) ([]pipeline.Run, int, error)
 
@@ -92,8 +104,12 @@ type orm struct {
 	pipelineORM pipeline.ORM
 	lggr        logger.SugaredLogger
 	bridgeORM   bridges.ORM
+	specErrors  *utils.Broadcaster[SpecError]
 }
 
+// specErrorsBufferSize is the number of spec errors buffered for each subscriber, beyond which they are dropped.
+const specErrorsBufferSize = 100
+
 var _ ORM = (*orm)(nil)
 
 func NewORM(ds sqlutil.DataSource, pipelineORM pipeline.ORM, bridgeORM bridges.ORM, keyStore keystore.Master, lggr logger.Logger) *orm {
@@ -104,6 +120,7 @@ func NewORM(ds sqlutil.DataSource, pipelineORM pipeline.ORM, bridgeORM bridges.O
 		pipelineORM: pipelineORM,
 		bridgeORM:   bridgeORM,
 		lggr:        namedLogger,
+		specErrors:  new(utils.Broadcaster[SpecError]),
 	}
 }
 
@@ -119,9 +136,10 @@ func (o *orm) WithDataSource(ds sqlutil.DataSource) ORM { return o.withDataSourc
 
 func (o *orm) withDataSource(ds sqlutil.DataSource) *orm {
 	n := &orm{
-		ds:       ds,
-		lggr:     o.lggr,
-		keyStore: o.keyStore,
+		ds:         ds,
+		lggr:       o.lggr,
+		keyStore:   o.keyStore,
+		specErrors: o.specErrors,
 	}
 	if o.bridgeORM != nil {
 		n.bridgeORM = o.bridgeORM.WithDataSource(ds)
@@ -513,10 +531,10 @@ func (o *orm) insertOCROracleSpec(ctx context.Context, spec *OCROracleSpec) (spe
 
 func (o *orm) insertOCR2OracleSpec(ctx context.Context, spec *OCR2OracleSpec) (specID int32, err error) {
 	return o.prepareQuerySpecID(ctx, `INSERT INTO ocr2_oracle_specs (contract_id, feed_id, relay, relay_config, plugin_type, plugin_config, onchain_signing_strategy, p2pv2_bootstrappers, ocr_key_bundle_id, transmitter_id,
-					blockchain_timeout, contract_config_tracker_poll_interval, contract_config_confirmations,
+					blockchain_timeout, contract_config_tracker_poll_interval, contract_config_confirmations, observation_timeout, report_timeout, standby, transmission_schedule, report_encryption_key_id,
 					created_at, updated_at)
 			VALUES (:contract_id, :feed_id, :relay, :relay_config, :plugin_type, :plugin_config, :onchain_signing_strategy, :p2pv2_bootstrappers, :ocr_key_bundle_id, :transmitter_id,
-					 :blockchain_timeout, :contract_config_tracker_poll_interval, :contract_config_confirmations,
+					 :blockchain_timeout, :contract_config_tracker_poll_interval, :contract_config_confirmations, :observation_timeout, :report_timeout, :standby, :transmission_schedule, :report_encryption_key_id,
 					NOW(), NOW())
 			RETURNING id;`, spec)
 }
@@ -528,8 +546,8 @@ func (o *orm) insertKeeperSpec(ctx context.Context, spec *KeeperSpec) (specID in
 }
 
 func (o *orm) insertCronSpec(ctx context.Context, spec *CronSpec) (specID int32, err error) {
-	return o.prepareQuerySpecID(ctx, `INSERT INTO cron_specs (cron_schedule, evm_chain_id, created_at, updated_at)
-			VALUES (:cron_schedule, :evm_chain_id, NOW(), NOW())
+	return o.prepareQuerySpecID(ctx, `INSERT INTO cron_specs (cron_schedule, evm_chain_id, jitter, catch_up, prevent_overlap, created_at, updated_at)
+			VALUES (:cron_schedule, :evm_chain_id, :jitter, :catch_up, :prevent_overlap, NOW(), NOW())
 			RETURNING id;`, spec)
 }
 
@@ -539,14 +557,14 @@ func (o *orm) insertVRFSpec(ctx context.Context, spec *VRFSpec) (specID int32, e
 				evm_chain_id, from_addresses, poll_period, requested_confs_delay,
 				request_timeout, chunk_size, batch_coordinator_address, batch_fulfillment_enabled,
 				batch_fulfillment_gas_multiplier, backoff_initial_delay, backoff_max_delay, gas_lane_price,
-                vrf_owner_address, custom_reverts_pipeline_enabled,
+                vrf_owner_address, custom_reverts_pipeline_enabled, batch_fulfillment_max_wait_blocks,
 				created_at, updated_at)
 			VALUES (
 				:coordinator_address, :public_key, :min_incoming_confirmations,
 				:evm_chain_id, :from_addresses, :poll_period, :requested_confs_delay,
 				:request_timeout, :chunk_size, :batch_coordinator_address, :batch_fulfillment_enabled,
 				:batch_fulfillment_gas_multiplier, :backoff_initial_delay, :backoff_max_delay, :gas_lane_price,
-			    :vrf_owner_address, :custom_reverts_pipeline_enabled,
+			    :vrf_owner_address, :custom_reverts_pipeline_enabled, :batch_fulfillment_max_wait_blocks,
 				NOW(), NOW())
 			RETURNING id;`, toVRFSpecRow(spec))
 }
@@ -672,18 +690,22 @@ func (o *orm) InsertJob(ctx context.Context, job *Job) error {
 		if job.ID == 0 {
 			query = `INSERT INTO jobs (name, stream_id, schema_version, type, max_task_duration, ocr_oracle_spec_id, ocr2_oracle_spec_id, direct_request_spec_id, flux_monitor_spec_id,
 				keeper_spec_id, cron_spec_id, vrf_spec_id, webhook_spec_id, blockhash_store_spec_id, bootstrap_spec_id, block_header_feeder_spec_id, gateway_spec_id,
-                legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, workflow_spec_id, standard_capabilities_spec_id, ccip_spec_id, external_job_id, gas_limit, forwarding_allowed, created_at)
+                legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, workflow_spec_id, standard_capabilities_spec_id, ccip_spec_id, external_job_id, gas_limit, forwarding_allowed,
+				max_successful_runs, run_retention, errored_run_retention, created_at)
 		VALUES (:name, :stream_id, :schema_version, :type, :max_task_duration, :ocr_oracle_spec_id, :ocr2_oracle_spec_id, :direct_request_spec_id, :flux_monitor_spec_id,
 				:keeper_spec_id, :cron_spec_id, :vrf_spec_id, :webhook_spec_id, :blockhash_store_spec_id, :bootstrap_spec_id, :block_header_feeder_spec_id, :gateway_spec_id,
-				:legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :workflow_spec_id, :standard_capabilities_spec_id, :ccip_spec_id, :external_job_id, :gas_limit, :forwarding_allowed, NOW())
+				:legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :workflow_spec_id, :standard_capabilities_spec_id, :ccip_spec_id, :external_job_id, :gas_limit, :forwarding_allowed,
+				:max_successful_runs, :run_retention, :errored_run_retention, NOW())
 		RETURNING *;`
 		} else {
 			query = `INSERT INTO jobs (id, name, stream_id, schema_version, type, max_task_duration, ocr_oracle_spec_id, ocr2_oracle_spec_id, direct_request_spec_id, flux_monitor_spec_id,
 			keeper_spec_id, cron_spec_id, vrf_spec_id, webhook_spec_id, blockhash_store_spec_id, bootstrap_spec_id, block_header_feeder_spec_id, gateway_spec_id,
-                  legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, workflow_spec_id, standard_capabilities_spec_id, ccip_spec_id, external_job_id, gas_limit, forwarding_allowed, created_at)
+                  legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, workflow_spec_id, standard_capabilities_spec_id, ccip_spec_id, external_job_id, gas_limit, forwarding_allowed,
+				max_successful_runs, run_retention, errored_run_retention, created_at)
 		VALUES (:id, :name, :stream_id, :schema_version, :type, :max_task_duration, :ocr_oracle_spec_id, :ocr2_oracle_spec_id, :direct_request_spec_id, :flux_monitor_spec_id,
 				:keeper_spec_id, :cron_spec_id, :vrf_spec_id, :webhook_spec_id, :blockhash_store_spec_id, :bootstrap_spec_id, :block_header_feeder_spec_id, :gateway_spec_id,
-				:legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :workflow_spec_id, :standard_capabilities_spec_id, :ccip_spec_id, :external_job_id, :gas_limit, :forwarding_allowed, NOW())
+				:legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :workflow_spec_id, :standard_capabilities_spec_id, :ccip_spec_id, :external_job_id, :gas_limit, :forwarding_allowed,
+				:max_successful_runs, :run_retention, :errored_run_retention, NOW())
 		RETURNING *;`
 		}
 		query, args, err := tx.ds.BindNamed(query, job)
@@ -799,8 +821,10 @@ func (o *orm) RecordError(ctx context.Context, jobID int32, description string)
 	VALUES ($1, $2, 1, $3, $3)
 	ON CONFLICT (job_id, description) DO UPDATE SET
 	occurrences = job_spec_errors.occurrences + 1,
-	updated_at = excluded.updated_at`
-	_, err := o.ds.ExecContext(ctx, sql, jobID, description, time.Now())
+	updated_at = excluded.updated_at
+	RETURNING *`
+	var specErr SpecError
+	err := o.ds.GetContext(ctx, &specErr, sql, jobID, description, time.Now())
 	// Noop if the job has been deleted.
 	var pqErr *pgconn.PgError
 	ok := errors.As(err, &pqErr)
@@ -809,7 +833,15 @@ func (o *orm) RecordError(ctx context.Context, jobID int32, description string)
 			return nil
 		}
 	}
-	return err
+	if err != nil {
+		return err
+	}
+	o.specErrors.Publish(specErr)
+	return nil
+}
+
+func (o *orm) SubscribeSpecErrors() (<-chan SpecError, func()) {
+	return o.specErrors.Subscribe(specErrorsBufferSize)
 }
 func (o *orm) TryRecordError(ctx context.Context, jobID int32, description string) {
 	err := o.RecordError(ctx, jobID, description)
@@ -831,6 +863,56 @@ func (o *orm) DismissError(ctx context.Context, ID int64) error {
 	return nil
 }
 
+func (o *orm) UpdateOCR2Standby(ctx context.Context, specID int32, standby bool) error {
+	res, err := o.ds.ExecContext(ctx, "UPDATE ocr2_oracle_specs SET standby = $1, updated_at = NOW() WHERE id = $2", standby, specID)
+	if err != nil {
+		return errors.Wrap(err, "failed to update standby")
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to update standby")
+	}
+	if n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (o *orm) UpdateJobPaused(ctx context.Context, jobID int32, paused bool) error {
+	res, err := o.ds.ExecContext(ctx, "UPDATE jobs SET paused = $1 WHERE id = $2", paused, jobID)
+	if err != nil {
+		return errors.Wrap(err, "failed to update paused")
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to update paused")
+	}
+	if n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (o *orm) CreateSpecVersion(ctx context.Context, jobID int32, toml string) (version SpecVersion, err error) {
+	stmt := `INSERT INTO job_spec_versions (job_id, version, toml, created_at)
+	SELECT $1, COALESCE(MAX(version), 0) + 1, $2, NOW() FROM job_spec_versions WHERE job_id = $1
+	RETURNING *;`
+	err = o.ds.GetContext(ctx, &version, stmt, jobID, toml)
+	return version, errors.Wrap(err, "failed to create job spec version")
+}
+
+func (o *orm) FindSpecVersions(ctx context.Context, jobID int32) (versions []SpecVersion, err error) {
+	stmt := `SELECT * FROM job_spec_versions WHERE job_id = $1 ORDER BY version ASC;`
+	err = o.ds.SelectContext(ctx, &versions, stmt, jobID)
+	return versions, errors.Wrap(err, "FindSpecVersions failed")
+}
+
+func (o *orm) FindSpecVersion(ctx context.Context, jobID int32, version int32) (specVersion SpecVersion, err error) {
+	stmt := `SELECT * FROM job_spec_versions WHERE job_id = $1 AND version = $2;`
+	err = o.ds.GetContext(ctx, &specVersion, stmt, jobID, version)
+	return specVersion, errors.Wrap(err, "FindSpecVersion failed")
+}
+
 func (o *orm) FindSpecError(ctx context.Context, id int64) (SpecError, error) {
 	stmt := `SELECT * FROM job_spec_errors WHERE id = $1;`
 
@@ -0,0 +1,207 @@
+package job
+
+import (
+	"encoding"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	clnull "github.com/smartcontractkit/chainlink/v2/core/null"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+// The types of the fields of a SpecSchema.
+const (
+	FieldTypeString   = "string"
+	FieldTypeInteger  = "integer"
+	FieldTypeNumber   = "number"
+	FieldTypeBoolean  = "boolean"
+	FieldTypeDuration = "duration"
+	FieldTypeArray    = "array"
+	FieldTypeTable    = "table"
+	// FieldTypePipeline is a pipeline in the DOT format.
+	FieldTypePipeline = "pipeline"
+)
+
+// SpecField describes a field of a job spec.
+type SpecField struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Required bool   `json:"required"`
+	// Enum are the allowed values of the field, if restricted.
+	Enum []string `json:"enum,omitempty"`
+	// Fields are the fields of a table, if known.
+	Fields []SpecField `json:"fields,omitempty"`
+}
+
+// SpecSchema describes the TOML spec of a job type. Specs are still validated by the validators of their type, so the
+// schema only reflects the constraints which can be checked without a node, like the required fields.
+type SpecSchema struct {
+	Type   Type
+	Fields []SpecField
+}
+
+// specs are the spec structs of the job types which can be created through the API, or nil if the type only has the
+// common fields.
+var specs = map[Type]any{
+	BlockHeaderFeeder:    BlockHeaderFeederSpec{},
+	BlockhashStore:       BlockhashStoreSpec{},
+	Bootstrap:            BootstrapSpec{},
+	CCIP:                 CCIPSpec{},
+	Cron:                 CronSpec{},
+	DirectRequest:        DirectRequestSpec{},
+	FluxMonitor:          FluxMonitorSpec{},
+	Gateway:              GatewaySpec{},
+	Keeper:               KeeperSpec{},
+	OffchainReporting:    OCROracleSpec{},
+	OffchainReporting2:   OCR2OracleSpec{},
+	StandardCapabilities: StandardCapabilitiesSpec{},
+	Stream:               nil,
+	VRF:                  VRFSpec{},
+	Webhook:              WebhookSpec{},
+	Workflow:             WorkflowSpec{},
+}
+
+// requiredFields are the fields which must be set in the specs of a job type, besides type and schemaVersion. They
+// mirror the checks of the validators of each type.
+var requiredFields = map[Type][]string{
+	BlockHeaderFeeder:  {"blockhashStoreAddress", "batchBlockhashStoreAddress", "evmChainID"},
+	BlockhashStore:     {"blockhashStoreAddress", "evmChainID"},
+	Bootstrap:          {"contractID", "relay", "relayConfig"},
+	CCIP:               {"capabilityLabelledName", "capabilityVersion", "p2pKeyID"},
+	Cron:               {"schedule"},
+	OffchainReporting:  {"contractAddress", "isBootstrapPeer"},
+	OffchainReporting2: {"contractID", "relay", "relayConfig", "pluginType"},
+	Stream:             {"streamID"},
+	VRF:                {"publicKey", "coordinatorAddress"},
+}
+
+// SpecSchemas returns the schemas of all the job types which can be created through the API, sorted by type.
+func SpecSchemas() []SpecSchema {
+	schemas := make([]SpecSchema, 0, len(specs))
+	for t := range specs {
+		schema, _ := SchemaOf(t)
+		schemas = append(schemas, schema)
+	}
+	slices.SortFunc(schemas, func(a, b SpecSchema) int { return strings.Compare(string(a.Type), string(b.Type)) })
+	return schemas
+}
+
+// SchemaOf returns the schema of a job type, or false if jobs of this type can't be created through the API.
+func SchemaOf(t Type) (SpecSchema, bool) {
+	spec, ok := specs[t]
+	if !ok {
+		return SpecSchema{}, false
+	}
+
+	required := append([]string{"type", "schemaVersion"}, requiredFields[t]...)
+	fields := StructFields(reflect.TypeOf(Job{}), "toml", required)
+	// MaxTaskDuration has no toml tag, it is decoded by its field name.
+	fields = append(fields, SpecField{Name: "maxTaskDuration", Type: FieldTypeDuration})
+	if t != Stream {
+		fields = slices.DeleteFunc(fields, func(f SpecField) bool { return f.Name == "streamID" })
+	}
+	if spec != nil {
+		fields = append(fields, StructFields(reflect.TypeOf(spec), "toml", required)...)
+	}
+	for i := range fields {
+		if fields[i].Name == "type" {
+			fields[i].Enum = []string{string(t)}
+		}
+	}
+	slices.SortStableFunc(fields, func(a, b SpecField) int { return strings.Compare(a.Name, b.Name) })
+	return SpecSchema{Type: t, Fields: fields}, true
+}
+
+var (
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	// fieldTypes are the types whose kind doesn't match how they are written in specs.
+	fieldTypes = map[reflect.Type]string{
+		reflect.TypeOf(models.Interval(0)):  FieldTypeDuration,
+		reflect.TypeOf(time.Duration(0)):    FieldTypeDuration,
+		reflect.TypeOf(pipeline.Pipeline{}): FieldTypePipeline,
+		reflect.TypeOf(big.Big{}):           FieldTypeInteger,
+		reflect.TypeOf(clnull.Uint32{}):     FieldTypeInteger,
+		reflect.TypeOf(clnull.Int64{}):      FieldTypeInteger,
+		reflect.TypeOf(null.Int{}):          FieldTypeInteger,
+		reflect.TypeOf(null.Bool{}):         FieldTypeBoolean,
+		reflect.TypeOf(null.Float{}):        FieldTypeNumber,
+	}
+)
+
+// StructFields returns the fields of a struct decoded from a spec, named after their tag, like toml or json. Fields
+// without the tag are named after the field, unless the tag is toml, and fields tagged with "-" are skipped. The
+// fields named in required are marked as required.
+func StructFields(t reflect.Type, tag string, required []string) []SpecField {
+	t = indirect(t)
+	var fields []SpecField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(f.Tag.Get(tag), ",")
+		if name == "" && f.Anonymous && indirect(f.Type).Kind() == reflect.Struct {
+			// the fields of embedded structs are decoded as if they were fields of the outer struct
+			fields = append(fields, StructFields(f.Type, tag, required)...)
+			continue
+		}
+		if name == "-" || (name == "" && tag == "toml") {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		field := SpecField{Name: name, Type: fieldType(f.Type), Required: slices.Contains(required, name)}
+		if field.Type == FieldTypeTable {
+			if ft := indirect(f.Type); ft.Kind() == reflect.Struct {
+				field.Fields = StructFields(ft, tag, nil)
+			}
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+func fieldType(t reflect.Type) string {
+	t = indirect(t)
+	if name, ok := fieldTypes[t]; ok {
+		return name
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return FieldTypeString
+	case reflect.Bool:
+		return FieldTypeBoolean
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return FieldTypeInteger
+	case reflect.Float32, reflect.Float64:
+		return FieldTypeNumber
+	case reflect.Slice, reflect.Array:
+		// byte arrays, like addresses and hashes, are written as hex strings
+		if t.Elem().Kind() == reflect.Uint8 {
+			return FieldTypeString
+		}
+		return FieldTypeArray
+	case reflect.Map:
+		return FieldTypeTable
+	default:
+		if reflect.PointerTo(t).Implements(textUnmarshalerType) {
+			return FieldTypeString
+		}
+		return FieldTypeTable
+	}
+}
+
+func indirect(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
@@ -0,0 +1,62 @@
+package job
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func field(t *testing.T, schema SpecSchema, name string) SpecField {
+	for _, f := range schema.Fields {
+		if f.Name == name {
+			return f
+		}
+	}
+	t.Fatalf("field %s not found in the schema of %s", name, schema.Type)
+	return SpecField{}
+}
+
+func TestSchemaOf(t *testing.T) {
+	t.Run("cron", func(t *testing.T) {
+		schema, ok := SchemaOf(Cron)
+		require.True(t, ok)
+		assert.Equal(t, SpecField{Name: "type", Type: FieldTypeString, Required: true, Enum: []string{"cron"}}, field(t, schema, "type"))
+		assert.Equal(t, SpecField{Name: "schedule", Type: FieldTypeString, Required: true}, field(t, schema, "schedule"))
+		assert.Equal(t, SpecField{Name: "evmChainID", Type: FieldTypeInteger}, field(t, schema, "evmChainID"))
+		assert.Equal(t, SpecField{Name: "externalJobID", Type: FieldTypeString}, field(t, schema, "externalJobID"))
+		assert.Equal(t, SpecField{Name: "observationSource", Type: FieldTypePipeline}, field(t, schema, "observationSource"))
+		assert.Equal(t, SpecField{Name: "maxTaskDuration", Type: FieldTypeDuration}, field(t, schema, "maxTaskDuration"))
+		for _, f := range schema.Fields {
+			assert.NotEqual(t, "streamID", f.Name)
+		}
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		schema, ok := SchemaOf(Stream)
+		require.True(t, ok)
+		assert.Equal(t, SpecField{Name: "streamID", Type: FieldTypeInteger, Required: true}, field(t, schema, "streamID"))
+	})
+
+	t.Run("offchainreporting2", func(t *testing.T) {
+		schema, ok := SchemaOf(OffchainReporting2)
+		require.True(t, ok)
+		assert.True(t, field(t, schema, "pluginType").Required)
+		assert.Equal(t, FieldTypeTable, field(t, schema, "pluginConfig").Type)
+		assert.Equal(t, FieldTypeArray, field(t, schema, "p2pv2Bootstrappers").Type)
+		assert.Equal(t, FieldTypeDuration, field(t, schema, "blockchainTimeout").Type)
+	})
+
+	t.Run("unknown type", func(t *testing.T) {
+		_, ok := SchemaOf(Type("unknown"))
+		assert.False(t, ok)
+	})
+}
+
+func TestSpecSchemas(t *testing.T) {
+	schemas := SpecSchemas()
+	require.Len(t, schemas, len(specs))
+	for i := 1; i < len(schemas); i++ {
+		assert.Less(t, string(schemas[i-1].Type), string(schemas[i].Type))
+	}
+}
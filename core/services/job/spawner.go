@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"math"
 	"reflect"
+	"strconv"
 	"sync"
+	"sync/atomic"
 
 	pkgerrors "github.com/pkg/errors"
 
@@ -14,6 +16,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/utils"
 
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/alerting"
 )
 
 type (
@@ -28,8 +31,19 @@ type (
 		CreateJob(ctx context.Context, ds sqlutil.DataSource, jb *Job) (err error)
 		// DeleteJob deletes a job and stops any active services.
 		DeleteJob(ctx context.Context, ds sqlutil.DataSource, jobID int32) error
+		// PauseJob pauses a job and stops any active services, until it is resumed.
+		PauseJob(ctx context.Context, jobID int32) error
+		// ResumeJob resumes a paused job and starts its services.
+		ResumeJob(ctx context.Context, jobID int32) error
+		// RestartJob stops the services of an active job and starts them again from its stored spec.
+		RestartJob(ctx context.Context, jobID int32) error
 		// ActiveJobs returns a map of jobs with active services (started without error).
 		ActiveJobs() map[int32]Job
+		// Drain refuses to start job services with ErrSpawnerDraining, while the services already started keep running
+		// until StopServices, for the work in flight to finish. Delegates which are Drainable are drained too.
+		Drain()
+		// StopServices stops the services of all jobs, and returns the number of jobs stopped.
+		StopServices() int
 
 		// StartService starts services for the given job spec.
 		// NOTE: Prefer to use CreateJob, this is only publicly exposed for use in tests
@@ -54,6 +68,7 @@ type (
 
 		chStop              services.StopChan
 		lbDependentAwaiters []utils.DependentAwaiter
+		draining            atomic.Bool
 	}
 
 	// TODO(spook): I can't wait for Go generics
@@ -76,6 +91,11 @@ type (
 		OnDeleteJob(ctx context.Context, jb Job) error
 	}
 
+	// Drainable is a Delegate which stops new work of its jobs, like OCR transmissions, while the node is draining.
+	Drainable interface {
+		Drain()
+	}
+
 	activeJob struct {
 		delegate Delegate
 		spec     Job
@@ -85,6 +105,10 @@ type (
 
 var _ Spawner = (*spawner)(nil)
 
+// ErrSpawnerDraining is returned when starting the services of a job while the node is draining. The job starts with
+// the next run of the node.
+var ErrSpawnerDraining = pkgerrors.New("node is draining, job services are started after restart")
+
 func NewSpawner(orm ORM, config Config, checker Checker, jobTypeDelegates map[Type]Delegate, lggr logger.Logger, lbDependentAwaiters []utils.DependentAwaiter) *spawner {
 	namedLogger := lggr.Named("JobSpawner")
 	s := &spawner{
@@ -135,6 +159,10 @@ func (js *spawner) startAllServices(ctx context.Context) {
 	}
 
 	for _, spec := range specs {
+		if spec.Paused {
+			js.lggr.Infow("Skipping paused job", "jobID", spec.ID)
+			continue
+		}
 		if err = js.StartService(ctx, spec); err != nil {
 			js.lggr.Errorf("Couldn't start service %q: %v", spec.Name.ValueOrZero(), err)
 		}
@@ -188,6 +216,10 @@ func (js *spawner) StartService(ctx context.Context, jb Job) error {
 	js.activeJobsMu.Lock()
 	defer js.activeJobsMu.Unlock()
 
+	if js.draining.Load() {
+		return ErrSpawnerDraining
+	}
+
 	delegate, exists := js.jobTypeDelegates[jb.Type]
 	if !exists {
 		lggr.Errorw("Job type has not been registered with job.Spawner", "type", jb.Type)
@@ -206,6 +238,9 @@ func (js *spawner) StartService(ctx context.Context, jb Job) error {
 	if jb.GasLimit.Valid {
 		jb.PipelineSpec.GasLimit = &jb.GasLimit.Uint32
 	}
+	if jb.MaxSuccessfulRuns.Valid {
+		jb.PipelineSpec.MaxSuccessfulRuns = &jb.MaxSuccessfulRuns.Uint32
+	}
 
 	srvs, err := delegate.ServicesForSpec(ctx, jb)
 	if err != nil {
@@ -224,6 +259,20 @@ func (js *spawner) StartService(ctx context.Context, jb Job) error {
 		err = ms.Start(ctx, srv)
 		if err != nil {
 			lggr.Criticalw("Error starting service for job", "err", err)
+			if jb.Type == OffchainReporting || jb.Type == OffchainReporting2 {
+				alerting.Emit(alerting.Alert{
+					Type:     alerting.EventOracleDown,
+					Severity: alerting.SeverityCritical,
+					Key:      strconv.Itoa(int(jb.ID)),
+					Summary:  fmt.Sprintf("OCR oracle of job %d (%s) failed to start", jb.ID, jb.Name.ValueOrZero()),
+					Details: map[string]string{
+						"jobID":   strconv.Itoa(int(jb.ID)),
+						"jobName": jb.Name.ValueOrZero(),
+						"jobType": string(jb.Type),
+						"error":   err.Error(),
+					},
+				})
+			}
 			return err
 		}
 		if c, ok := srv.(services.HealthReporter); ok {
@@ -342,6 +391,63 @@ func (js *spawner) DeleteJob(ctx context.Context, ds sqlutil.DataSource, jobID i
 	return err
 }
 
+// Should not get called before Start()
+func (js *spawner) PauseJob(ctx context.Context, jobID int32) error {
+	if err := js.orm.UpdateJobPaused(ctx, jobID, true); err != nil {
+		return pkgerrors.Wrapf(err, "failed to pause job %d", jobID)
+	}
+
+	js.activeJobsMu.RLock()
+	_, exists := js.activeJobs[jobID]
+	js.activeJobsMu.RUnlock()
+	if exists {
+		js.stopService(jobID)
+	}
+	js.lggr.Infow("Paused job", "jobID", jobID)
+	return nil
+}
+
+// Should not get called before Start()
+func (js *spawner) ResumeJob(ctx context.Context, jobID int32) error {
+	jb, err := js.orm.FindJob(ctx, jobID)
+	if err != nil {
+		return pkgerrors.Wrapf(err, "job %d not found", jobID)
+	}
+	if !jb.Paused {
+		return nil
+	}
+	if err = js.orm.UpdateJobPaused(ctx, jobID, false); err != nil {
+		return pkgerrors.Wrapf(err, "failed to resume job %d", jobID)
+	}
+	if err = js.StartService(ctx, jb); err != nil {
+		js.lggr.Errorw("Error starting job services", "type", jb.Type, "jobID", jb.ID, "err", err)
+		return err
+	}
+	js.lggr.Infow("Resumed job", "type", jb.Type, "jobID", jb.ID)
+	return nil
+}
+
+// Should not get called before Start()
+func (js *spawner) RestartJob(ctx context.Context, jobID int32) error {
+	js.activeJobsMu.RLock()
+	_, exists := js.activeJobs[jobID]
+	js.activeJobsMu.RUnlock()
+	if !exists {
+		return pkgerrors.Errorf("job %d is not active", jobID)
+	}
+	jb, err := js.orm.FindJob(ctx, jobID)
+	if err != nil {
+		return pkgerrors.Wrapf(err, "job %d not found", jobID)
+	}
+	js.stopService(jobID)
+	if err = js.StartService(ctx, jb); err != nil {
+		js.lggr.Errorw("Error starting job services", "type", jb.Type, "jobID", jb.ID, "err", err)
+		return err
+	}
+	js.lggr.Infow("Restarted job", "type", jb.Type, "jobID", jb.ID)
+	return nil
+}
+
 func (js *spawner) ActiveJobs() map[int32]Job {
 	js.activeJobsMu.RLock()
 	defer js.activeJobsMu.RUnlock()
@@ -353,6 +459,24 @@ func (js *spawner) ActiveJobs() map[int32]Job {
 	return m
 }
 
+func (js *spawner) Drain() {
+	js.draining.Store(true)
+	for _, delegate := range js.jobTypeDelegates {
+		if d, ok := delegate.(Drainable); ok {
+			d.Drain()
+		}
+	}
+}
+
+func (js *spawner) StopServices() int {
+	jobIDs := js.activeJobIDs()
+	js.lggr.Infow("Stopping the services of all jobs", "count", len(jobIDs))
+	for _, jobID := range jobIDs {
+		js.stopService(jobID)
+	}
+	return len(jobIDs)
+}
+
 func (js *spawner) activeJobIDs() []int32 {
 	js.activeJobsMu.RLock()
 	defer js.activeJobsMu.RUnlock()
@@ -61,6 +61,15 @@ func (d delegate) ServicesForSpec(ctx context.Context, js job.Job) ([]job.Servic
 	return d.services, nil
 }
 
+type drainableDelegate struct {
+	*delegate
+	drained bool
+}
+
+func (d *drainableDelegate) Drain() {
+	d.drained = true
+}
+
 func clearDB(t *testing.T, db *sqlx.DB) {
 	cltest.ClearDBTables(t, db, "jobs", "pipeline_runs", "pipeline_specs", "pipeline_task_runs")
 }
@@ -275,6 +284,48 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 		clearDB(t, db)
 	})
 
+	t.Run("stops job services on 'Drain()' and refuses to start them afterwards", func(t *testing.T) {
+		jobA := makeOCRJobSpec(t, address, bridge.Name.String(), bridge2.Name.String())
+
+		eventuallyStart := cltest.NewAwaiter()
+		serviceA1 := mocks.NewServiceCtx(t)
+		serviceA2 := mocks.NewServiceCtx(t)
+		serviceA1.On("Start", mock.Anything).Return(nil).Once()
+		serviceA2.On("Start", mock.Anything).Return(nil).Once().Run(func(mock.Arguments) { eventuallyStart.ItHappened() })
+
+		lggr := logger.TestLogger(t)
+		orm := NewTestORM(t, db, pipeline.NewORM(db, lggr, config.JobPipeline().MaxSuccessfulRuns()), bridges.NewORM(db), keyStore)
+		mailMon := servicetest.Run(t, mailboxtest.NewMonitor(t))
+		d := ocr.NewDelegate(nil, orm, nil, nil, nil, monitoringEndpoint, legacyChains, logger.TestLogger(t), config, mailMon)
+		delegateA := &drainableDelegate{delegate: &delegate{jobA.Type, []job.ServiceCtx{serviceA1, serviceA2}, 0, nil, d}}
+		spawner := job.NewSpawner(orm, config.Database(), noopChecker{}, map[job.Type]job.Delegate{
+			jobA.Type: delegateA,
+		}, lggr, nil)
+
+		ctx := testutils.Context(t)
+		require.NoError(t, orm.CreateJob(ctx, jobA))
+		delegateA.jobID = jobA.ID
+
+		require.NoError(t, spawner.Start(ctx))
+		defer func() { assert.NoError(t, spawner.Close()) }()
+
+		eventuallyStart.AwaitOrFail(t)
+
+		serviceA1.On("Close").Return(nil).Once()
+		serviceA2.On("Close").Return(nil).Once()
+
+		// the services keep running while draining, until stopped
+		spawner.Drain()
+		assert.True(t, delegateA.drained)
+		assert.Len(t, spawner.ActiveJobs(), 1)
+		assert.Equal(t, 1, spawner.StopServices())
+		assert.Empty(t, spawner.ActiveJobs())
+		require.ErrorIs(t, spawner.StartService(ctx, *jobA), job.ErrSpawnerDraining)
+		assert.Empty(t, spawner.ActiveJobs())
+
+		clearDB(t, db)
+	})
+
 	t.Run("Unregisters filters on 'DeleteJob()'", func(t *testing.T) {
 		config = configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
 			c.Feature.LogPoller = func(b bool) *bool { return &b }(true)
@@ -313,10 +364,10 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 		mailMon := servicetest.Run(t, mailboxtest.NewMonitor(t))
 
 		processConfig := plugins.NewRegistrarConfig(loop.GRPCOpts{}, func(name string) (*plugins.RegisteredLoop, error) { return nil, nil }, func(loopId string) {})
-		ocr2DelegateConfig := ocr2.NewDelegateConfig(config.OCR2(), config.Mercury(), config.Threshold(), config.Insecure(), config.JobPipeline(), processConfig)
+		ocr2DelegateConfig := ocr2.NewDelegateConfig(config.OCR2(), config.Mercury(), config.Threshold(), config.Insecure(), config.JobPipeline(), config.Database(), config.Maintenance(), config.CCIP(), processConfig)
 
 		d := ocr2.NewDelegate(nil, orm, nil, nil, nil, nil, nil, monitoringEndpoint, legacyChains, lggr, ocr2DelegateConfig,
-			keyStore.OCR2(), ethKeyStore, testRelayGetter, mailMon, capabilities.NewRegistry(lggr))
+			keyStore.OCR2(), ethKeyStore, keyStore.Report(), testRelayGetter, mailMon, capabilities.NewRegistry(lggr))
 		delegateOCR2 := &delegate{jobOCR2Keeper.Type, []job.ServiceCtx{}, 0, nil, d}
 
 		spawner := job.NewSpawner(orm, config.Database(), noopChecker{}, map[job.Type]job.Delegate{
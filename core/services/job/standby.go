@@ -0,0 +1,73 @@
+package job
+
+import (
+	"context"
+	"sync"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// Promotable is implemented by services which may be held in standby, and started later on command.
+type Promotable interface {
+	// Promote starts the service held in standby. It is a no-op if the service is already active.
+	Promote(ctx context.Context) error
+}
+
+// StandbyService holds a service in standby: Start does not start the wrapped service, but only records that it was
+// requested, so that everything around it may warm up. The wrapped service is started once promoted.
+type StandbyService struct {
+	lggr    logger.Logger
+	service ServiceCtx
+
+	mu      sync.Mutex
+	started bool // Start was called
+	active  bool // the wrapped service was started
+}
+
+var _ Promotable = (*StandbyService)(nil)
+
+// NewStandbyService returns a StandbyService holding service in standby.
+func NewStandbyService(lggr logger.Logger, service ServiceCtx) *StandbyService {
+	return &StandbyService{lggr: lggr.Named("Standby"), service: service}
+}
+
+// Start records that the service was started, and only starts the wrapped service if it was already promoted.
+func (s *StandbyService) Start(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.started = true
+	if s.active {
+		return s.service.Start(ctx)
+	}
+	s.lggr.Info("Service is in standby until promoted")
+	return nil
+}
+
+// Promote starts the wrapped service. If the service was not started yet, the wrapped service will be started once
+// it is.
+func (s *StandbyService) Promote(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.active {
+		return nil
+	}
+	if s.started {
+		if err := s.service.Start(ctx); err != nil {
+			return err
+		}
+	}
+	s.active = true
+	s.lggr.Info("Service promoted from standby")
+	return nil
+}
+
+// Close closes the wrapped service, if it is active.
+func (s *StandbyService) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.started || !s.active {
+		return nil
+	}
+	s.started = false
+	return s.service.Close()
+}
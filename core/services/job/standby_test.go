@@ -0,0 +1,69 @@
+package job_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+type fakeService struct {
+	starts, closes int
+}
+
+func (f *fakeService) Start(context.Context) error {
+	f.starts++
+	return nil
+}
+
+func (f *fakeService) Close() error {
+	f.closes++
+	return nil
+}
+
+func TestStandbyService(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	t.Run("promoted after start", func(t *testing.T) {
+		srv := &fakeService{}
+		s := job.NewStandbyService(logger.TestLogger(t), srv)
+
+		require.NoError(t, s.Start(ctx))
+		assert.Equal(t, 0, srv.starts)
+
+		require.NoError(t, s.Promote(ctx))
+		assert.Equal(t, 1, srv.starts)
+		require.NoError(t, s.Promote(ctx))
+		assert.Equal(t, 1, srv.starts)
+
+		require.NoError(t, s.Close())
+		assert.Equal(t, 1, srv.closes)
+	})
+
+	t.Run("promoted before start", func(t *testing.T) {
+		srv := &fakeService{}
+		s := job.NewStandbyService(logger.TestLogger(t), srv)
+
+		require.NoError(t, s.Promote(ctx))
+		assert.Equal(t, 0, srv.starts)
+
+		require.NoError(t, s.Start(ctx))
+		assert.Equal(t, 1, srv.starts)
+	})
+
+	t.Run("closed in standby", func(t *testing.T) {
+		srv := &fakeService{}
+		s := job.NewStandbyService(logger.TestLogger(t), srv)
+
+		require.NoError(t, s.Start(ctx))
+		require.NoError(t, s.Close())
+		assert.Equal(t, 0, srv.starts)
+		assert.Equal(t, 0, srv.closes)
+	})
+}
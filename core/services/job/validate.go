@@ -67,6 +67,9 @@ func ValidateSpec(ts string) (Type, error) {
 	if jb.Pipeline.RequiresPreInsert() && !jb.Type.SupportsAsync() {
 		return "", errors.Errorf("async=true tasks are not supported for %v", jb.Type)
 	}
+	if jb.RunRetention.Duration() < 0 || jb.ErroredRunRetention.Duration() < 0 {
+		return "", errors.New("runRetention and erroredRunRetention must not be negative")
+	}
 	// spec.CustomRevertsPipelineEnabled == false, default is custom reverted txns pipeline disabled
 
 	if strings.Contains(ts, "<{}>") {
@@ -90,6 +90,36 @@ ds [type=bridge async=true]
 				require.Error(t, err)
 			},
 		},
+		{
+			name: "negative run retention",
+			spec: `
+type="vrf"
+schemaVersion=1
+erroredRunRetention="-1h"
+observationSource="""
+ds [type=http]
+"""
+`,
+			assertion: func(t *testing.T, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "run retention",
+			spec: `
+type="vrf"
+schemaVersion=1
+maxSuccessfulRuns=0
+runRetention="24h"
+erroredRunRetention="720h"
+observationSource="""
+ds [type=http]
+"""
+`,
+			assertion: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
 		{
 			name: "happy path",
 			spec: `
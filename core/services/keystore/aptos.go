@@ -143,12 +143,17 @@ func (ks *aptos) EnsureKey(ctx context.Context) error {
 	return ks.safeAddKey(ctx, key)
 }
 
-func (ks *aptos) Sign(_ context.Context, id string, msg []byte) (signature []byte, err error) {
+func (ks *aptos) Sign(ctx context.Context, id string, msg []byte) (signature []byte, err error) {
 	k, err := ks.Get(id)
 	if err != nil {
 		return nil, err
 	}
-	return k.Sign(msg)
+	signature, err = k.Sign(msg)
+	if err != nil {
+		return nil, err
+	}
+	ks.RecordKeyUsage(ctx, "Aptos", id, sha256Digest(msg))
+	return signature, nil
 }
 
 func (ks *aptos) getByID(id string) (aptoskey.Key, error) {
@@ -0,0 +1,23 @@
+// Package audit identifies the requesters of key usages, to be recorded in the key usage audit log of the keystore.
+package audit
+
+import "context"
+
+// Requester identifies the service, and optionally the job, on whose behalf a key is used
+type Requester struct {
+	Service string
+	JobID   *int32
+}
+
+type requesterKey struct{}
+
+// WithRequester returns a copy of ctx carrying the requester of the key usages made with it
+func WithRequester(ctx context.Context, r Requester) context.Context {
+	return context.WithValue(ctx, requesterKey{}, r)
+}
+
+// RequesterFromContext returns the requester carried by ctx, if any
+func RequesterFromContext(ctx context.Context) (Requester, bool) {
+	r, ok := ctx.Value(requesterKey{}).(Requester)
+	return r, ok
+}
@@ -0,0 +1,20 @@
+package audit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequesterFromContext(t *testing.T) {
+	_, ok := RequesterFromContext(context.Background())
+	assert.False(t, ok)
+
+	jobID := int32(1)
+	ctx := WithRequester(context.Background(), Requester{Service: "EVM.TxManager", JobID: &jobID})
+	r, ok := RequesterFromContext(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, "EVM.TxManager", r.Service)
+	assert.Equal(t, &jobID, r.JobID)
+}
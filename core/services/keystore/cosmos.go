@@ -163,7 +163,14 @@ func (lk *CosmosLoopKeystore) Sign(ctx context.Context, id string, hash []byte)
 		return nil, nil
 	}
 
-	return k.ToPrivKey().Sign(hash)
+	signature, err := k.ToPrivKey().Sign(hash)
+	if err != nil {
+		return nil, err
+	}
+	if recorder, ok := lk.Cosmos.(keyUsageRecorder); ok {
+		recorder.RecordKeyUsage(ctx, "Cosmos", id, hash)
+	}
+	return signature, nil
 }
 
 func (lk *CosmosLoopKeystore) Accounts(ctx context.Context) ([]string, error) {
@@ -308,6 +308,16 @@ func (ks *eth) SubscribeToKeyChanges(ctx context.Context) (ch chan struct{}, uns
 }
 
 func (ks *eth) SignTx(ctx context.Context, address common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	signer := types.LatestSignerForChainID(chainID)
+	signed, err := ks.signTx(address, tx, signer)
+	if err != nil {
+		return nil, err
+	}
+	ks.RecordKeyUsage(ctx, "EVM", address.String(), signer.Hash(tx).Bytes())
+	return signed, nil
+}
+
+func (ks *eth) signTx(address common.Address, tx *types.Transaction, signer types.Signer) (*types.Transaction, error) {
 	ks.lock.RLock()
 	defer ks.lock.RUnlock()
 	if ks.isLocked() {
@@ -317,7 +327,6 @@ func (ks *eth) SignTx(ctx context.Context, address common.Address, tx *types.Tra
 	if err != nil {
 		return nil, err
 	}
-	signer := types.LatestSignerForChainID(chainID)
 	return types.SignTx(tx, signer, key.ToEcdsaPrivKey())
 }
 
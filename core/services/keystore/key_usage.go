@@ -0,0 +1,205 @@
+package keystore
+
+import (
+	"context"
+	"crypto/sha256"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/audit"
+)
+
+// keyUsageAuditLogMaxSize is the number of key usages kept in the audit log, older entries are deleted as new ones are recorded
+const keyUsageAuditLogMaxSize = 100_000
+
+const (
+	// keyUsageBatchSize is the number of key usages recorded at most in a single insert
+	keyUsageBatchSize = 100
+	// keyUsageFlushInterval is how often the buffered key usages are recorded. This spares the database an insert per
+	// signature.
+	keyUsageFlushInterval = time.Second
+	// keyUsageBufferSize is the number of key usages buffered at most, further usages are dropped until the buffer is
+	// flushed, so that signing never waits on the database
+	keyUsageBufferSize   = 5_000
+	keyUsageFlushTimeout = 10 * time.Second
+)
+
+var promKeyUsagesDropped = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "keystore_key_usages_dropped",
+	Help: "The number of key usages not recorded in the audit log because its buffer was full",
+})
+
+// unknownRequester is recorded as the service of key usages whose context does not carry a requester
+const unknownRequester = "unknown"
+
+// KeyUsage is an entry of the key usage audit log, recorded for every signing operation of the keystore
+type KeyUsage struct {
+	ID            int64
+	KeyType       string
+	KeyID         string
+	Service       string
+	JobID         *int32
+	PayloadDigest []byte
+	CreatedAt     time.Time
+}
+
+type keyUsageORM interface {
+	insertKeyUsages(ctx context.Context, usages []KeyUsage) error
+	findKeyUsages(ctx context.Context, offset, limit int) ([]KeyUsage, int, error)
+}
+
+// keyUsageRecorder is implemented by every keystore, so that the signers wrapping them can record their signatures
+type keyUsageRecorder interface {
+	RecordKeyUsage(ctx context.Context, keyType, keyID string, payloadDigest []byte)
+}
+
+// keyUsageAuditor buffers the key usages of the signers, and records them in the audit log in the background
+type keyUsageAuditor struct {
+	services.StateMachine
+	orm    keyUsageORM
+	lggr   logger.Logger
+	usages chan KeyUsage
+	// flushMu serializes the flushes, so that batches are recorded in order
+	flushMu sync.Mutex
+
+	chStop services.StopChan
+	wg     sync.WaitGroup
+}
+
+func newKeyUsageAuditor(orm keyUsageORM, lggr logger.Logger) *keyUsageAuditor {
+	return &keyUsageAuditor{
+		orm:    orm,
+		lggr:   logger.Named(lggr, "KeyUsageAuditor"),
+		usages: make(chan KeyUsage, keyUsageBufferSize),
+		chStop: make(chan struct{}),
+	}
+}
+
+func (a *keyUsageAuditor) Name() string {
+	return a.lggr.Name()
+}
+
+func (a *keyUsageAuditor) Start(context.Context) error {
+	return a.StartOnce("KeyUsageAuditor", func() error {
+		a.wg.Add(1)
+		go a.run()
+		return nil
+	})
+}
+
+// Close stops the flushes and records the usages still buffered
+func (a *keyUsageAuditor) Close() error {
+	return a.StopOnce("KeyUsageAuditor", func() error {
+		close(a.chStop)
+		a.wg.Wait()
+		ctx, cancel := context.WithTimeout(context.Background(), keyUsageFlushTimeout)
+		defer cancel()
+		return a.flush(ctx)
+	})
+}
+
+func (a *keyUsageAuditor) HealthReport() map[string]error {
+	return map[string]error{a.Name(): a.Healthy()}
+}
+
+func (a *keyUsageAuditor) run() {
+	defer a.wg.Done()
+	ticker := time.NewTicker(keyUsageFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-a.chStop:
+			return
+		case <-ticker.C:
+			// not cancelled by Close, which waits for the batch being recorded
+			ctx, cancel := context.WithTimeout(context.Background(), keyUsageFlushTimeout)
+			if err := a.flush(ctx); err != nil {
+				a.lggr.Errorw("Failed to record key usages in audit log", "err", err)
+			}
+			cancel()
+		}
+	}
+}
+
+// record buffers the usage without blocking, or drops it if the buffer is full
+func (a *keyUsageAuditor) record(usage KeyUsage) {
+	select {
+	case a.usages <- usage:
+	default:
+		promKeyUsagesDropped.Inc()
+	}
+}
+
+// flush records the buffered key usages, in batches of keyUsageBatchSize
+func (a *keyUsageAuditor) flush(ctx context.Context) error {
+	a.flushMu.Lock()
+	defer a.flushMu.Unlock()
+	for {
+		batch := make([]KeyUsage, 0, keyUsageBatchSize)
+	drain:
+		for len(batch) < keyUsageBatchSize {
+			select {
+			case usage := <-a.usages:
+				batch = append(batch, usage)
+			default:
+				break drain
+			}
+		}
+		if len(batch) == 0 {
+			return nil
+		}
+		if err := a.orm.insertKeyUsages(ctx, batch); err != nil {
+			return errors.Wrapf(err, "failed to record %d key usages", len(batch))
+		}
+	}
+}
+
+// RecordKeyUsage records the signing of the payload digest with the key in the audit log, on behalf of the requester
+// carried by ctx. Usages are buffered and recorded in the background by the KeyUsageAuditor, so this never blocks the
+// signing operation. Usages which don't fit in the buffer are dropped and counted.
+func (km *keyManager) RecordKeyUsage(ctx context.Context, keyType, keyID string, payloadDigest []byte) {
+	if km.keyUsages == nil {
+		return
+	}
+	usage := KeyUsage{
+		KeyType:       keyType,
+		KeyID:         keyID,
+		Service:       unknownRequester,
+		PayloadDigest: payloadDigest,
+		CreatedAt:     time.Now(),
+	}
+	if requester, ok := audit.RequesterFromContext(ctx); ok {
+		usage.Service = requester.Service
+		usage.JobID = requester.JobID
+	}
+	km.keyUsages.record(usage)
+}
+
+// KeyUsageAuditor returns the service recording the key usages in the audit log
+func (km *keyManager) KeyUsageAuditor() services.Service {
+	return km.keyUsages
+}
+
+// KeyUsages returns a page of the key usage audit log, most recent first, along with the total number of entries
+func (km *keyManager) KeyUsages(ctx context.Context, offset, limit int) ([]KeyUsage, int, error) {
+	if km.keyUsages == nil {
+		return nil, 0, errors.New("key usage audit log is not available")
+	}
+	// include the usages still buffered
+	if err := km.keyUsages.flush(ctx); err != nil {
+		return nil, 0, err
+	}
+	return km.keyUsages.orm.findKeyUsages(ctx, offset, limit)
+}
+
+func sha256Digest(msg []byte) []byte {
+	digest := sha256.Sum256(msg)
+	return digest[:]
+}
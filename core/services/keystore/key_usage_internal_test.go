@@ -0,0 +1,94 @@
+package keystore
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
+)
+
+// blockingKeyUsageORM blocks the inserts until unblock is closed
+type blockingKeyUsageORM struct {
+	inserting chan struct{}
+	unblock   chan struct{}
+
+	mu       sync.Mutex
+	recorded []KeyUsage
+}
+
+func (o *blockingKeyUsageORM) insertKeyUsages(ctx context.Context, usages []KeyUsage) error {
+	select {
+	case o.inserting <- struct{}{}:
+	default:
+	}
+	select {
+	case <-o.unblock:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.recorded = append(o.recorded, usages...)
+	return nil
+}
+
+func (o *blockingKeyUsageORM) findKeyUsages(ctx context.Context, offset, limit int) ([]KeyUsage, int, error) {
+	return nil, 0, nil
+}
+
+func (o *blockingKeyUsageORM) count() int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return len(o.recorded)
+}
+
+func Test_KeyUsageAuditor_SignDoesNotWaitOnDB(t *testing.T) {
+	orm := &blockingKeyUsageORM{inserting: make(chan struct{}, 1), unblock: make(chan struct{})}
+	auditor := newKeyUsageAuditor(orm, logger.TestLogger(t))
+	km := &keyManager{keyUsages: auditor}
+	require.NoError(t, auditor.Start(tests.Context(t)))
+
+	key, err := ocr2key.New(chaintype.EVM)
+	require.NoError(t, err)
+	bundle := &auditedKeyBundle{KeyBundle: key, recorder: km}
+
+	// the first usage is flushed by the auditor, whose insert then blocks
+	_, err = bundle.OffchainSign([]byte("first"))
+	require.NoError(t, err)
+	select {
+	case <-orm.inserting:
+	case <-time.After(tests.WaitTimeout(t)):
+		t.Fatal("timed out waiting for the key usages to be flushed")
+	}
+
+	dropped := testutil.ToFloat64(promKeyUsagesDropped)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < keyUsageBufferSize+10; i++ {
+			_, err := bundle.OffchainSign([]byte("msg"))
+			assert.NoError(t, err)
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(tests.WaitTimeout(t)):
+		t.Fatal("signing waited on the blocked database")
+	}
+	assert.Equal(t, float64(10), testutil.ToFloat64(promKeyUsagesDropped)-dropped)
+
+	// the buffered usages are recorded once the database is unblocked, at the latest on close
+	close(orm.unblock)
+	require.NoError(t, auditor.Close())
+	assert.Equal(t, 1+keyUsageBufferSize, orm.count())
+}
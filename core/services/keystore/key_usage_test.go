@@ -0,0 +1,110 @@
+package keystore_test
+
+import (
+	"crypto/sha256"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+)
+
+func Test_KeyUsageAuditLog(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	ctx := testutils.Context(t)
+
+	keyStore := keystore.ExposedNewMaster(t, db)
+	require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+
+	ethKey, err := keyStore.Eth().Create(ctx, testutils.FixtureChainID)
+	require.NoError(t, err)
+	solKey, err := keyStore.Solana().Create(ctx)
+	require.NoError(t, err)
+
+	jobID := int32(42)
+	tx := types.NewTransaction(0, common.Address{}, big.NewInt(0), 21000, big.NewInt(1), nil)
+	_, err = keyStore.Eth().SignTx(audit.WithRequester(ctx, audit.Requester{Service: "EVM.TxManager", JobID: &jobID}), ethKey.Address, tx, testutils.FixtureChainID)
+	require.NoError(t, err)
+	msg := []byte("hello")
+	_, err = keyStore.Solana().Sign(ctx, solKey.ID(), msg)
+	require.NoError(t, err)
+
+	usages, count, err := keyStore.KeyUsages(ctx, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+	require.Len(t, usages, 2)
+
+	// most recent first
+	assert.Equal(t, "Solana", usages[0].KeyType)
+	assert.Equal(t, solKey.ID(), usages[0].KeyID)
+	assert.Equal(t, "unknown", usages[0].Service)
+	assert.Nil(t, usages[0].JobID)
+	digest := sha256.Sum256(msg)
+	assert.Equal(t, digest[:], usages[0].PayloadDigest)
+
+	assert.Equal(t, "EVM", usages[1].KeyType)
+	assert.Equal(t, ethKey.Address.String(), usages[1].KeyID)
+	assert.Equal(t, "EVM.TxManager", usages[1].Service)
+	require.NotNil(t, usages[1].JobID)
+	assert.Equal(t, jobID, *usages[1].JobID)
+	assert.Equal(t, types.LatestSignerForChainID(testutils.FixtureChainID).Hash(tx).Bytes(), usages[1].PayloadDigest)
+
+	usages, count, err = keyStore.KeyUsages(ctx, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	require.Len(t, usages, 1)
+	assert.Equal(t, "EVM", usages[0].KeyType)
+}
+
+func Test_KeyUsageAuditLog_Keyrings(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	ctx := testutils.Context(t)
+
+	keyStore := keystore.ExposedNewMaster(t, db)
+	require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+
+	ocr2Key, err := keyStore.OCR2().Create(ctx, chaintype.EVM)
+	require.NoError(t, err)
+	vrfKey, err := keyStore.VRF().Create(ctx)
+	require.NoError(t, err)
+	cosmosKey, err := keyStore.Cosmos().Create(ctx)
+	require.NoError(t, err)
+
+	// bundles returned by any getter record their signatures
+	bundle, err := keyStore.OCR2().Get(ocr2Key.ID())
+	require.NoError(t, err)
+	msg := []byte("observation")
+	_, err = bundle.OffchainSign(msg)
+	require.NoError(t, err)
+	_, err = keyStore.VRF().GenerateProof(vrfKey.ID(), big.NewInt(1))
+	require.NoError(t, err)
+	hash := sha256.Sum256([]byte("cosmos tx"))
+	_, err = (&keystore.CosmosLoopKeystore{Cosmos: keyStore.Cosmos()}).Sign(ctx, cosmosKey.ID(), hash[:])
+	require.NoError(t, err)
+
+	usages, count, err := keyStore.KeyUsages(ctx, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+	require.Len(t, usages, 3)
+
+	assert.Equal(t, "Cosmos", usages[0].KeyType)
+	assert.Equal(t, cosmosKey.ID(), usages[0].KeyID)
+	assert.Equal(t, hash[:], usages[0].PayloadDigest)
+
+	assert.Equal(t, "VRF", usages[1].KeyType)
+	assert.Equal(t, vrfKey.ID(), usages[1].KeyID)
+
+	assert.Equal(t, "OCR2Offchain", usages[2].KeyType)
+	assert.Equal(t, ocr2Key.ID(), usages[2].KeyID)
+	digest := sha256.Sum256(msg)
+	assert.Equal(t, digest[:], usages[2].PayloadDigest)
+}
@@ -0,0 +1,140 @@
+package ocr2key
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+const (
+	envelopeKeyType = "OCR2Bundles"
+	envelopeVersion = 1
+)
+
+// EncryptedBundleEnvelope is a password encrypted export of several OCR2 key bundles of any chain family, to migrate
+// them between nodes at once. The bundles are listed in the clear so that the envelope can be reviewed before it is
+// imported, and the checksum of the list is encrypted along with the keys so that the list can't be altered.
+type EncryptedBundleEnvelope struct {
+	KeyType string              `json:"keyType"`
+	Version int                 `json:"version"`
+	Bundles []EnvelopeBundle    `json:"bundles"`
+	Crypto  keystore.CryptoJSON `json:"crypto"`
+}
+
+// EnvelopeBundle describes a key bundle of an EncryptedBundleEnvelope.
+type EnvelopeBundle struct {
+	ID                string              `json:"id"`
+	ChainType         chaintype.ChainType `json:"chainType"`
+	OnchainPublicKey  string              `json:"onchainPublicKey"`
+	OffChainPublicKey string              `json:"offchainPublicKey"`
+	ConfigPublicKey   string              `json:"configPublicKey"`
+}
+
+// envelopePayload is the encrypted content of an EncryptedBundleEnvelope.
+type envelopePayload struct {
+	Checksum string `json:"checksum"`
+	Keys     []Raw  `json:"keys"`
+}
+
+func newEnvelopeBundle(kb KeyBundle) EnvelopeBundle {
+	pubKeyConfig := kb.ConfigEncryptionPublicKey()
+	pubKey := kb.OffchainPublicKey()
+	return EnvelopeBundle{
+		ID:                kb.ID(),
+		ChainType:         kb.ChainType(),
+		OnchainPublicKey:  kb.OnChainPublicKey(),
+		OffChainPublicKey: hex.EncodeToString(pubKey[:]),
+		ConfigPublicKey:   hex.EncodeToString(pubKeyConfig[:]),
+	}
+}
+
+func bundlesChecksum(bundles []EnvelopeBundle) (string, error) {
+	b, err := json.Marshal(bundles)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// ToEncryptedEnvelope returns an EncryptedBundleEnvelope of the key bundles, encrypted with password.
+func ToEncryptedEnvelope(kbs []KeyBundle, password string, scryptParams utils.ScryptParams) ([]byte, error) {
+	envelope := EncryptedBundleEnvelope{
+		KeyType: envelopeKeyType,
+		Version: envelopeVersion,
+		Bundles: make([]EnvelopeBundle, len(kbs)),
+	}
+	payload := envelopePayload{Keys: make([]Raw, len(kbs))}
+	for i, kb := range kbs {
+		envelope.Bundles[i] = newEnvelopeBundle(kb)
+		payload.Keys[i] = kb.Raw()
+	}
+	var err error
+	if payload.Checksum, err = bundlesChecksum(envelope.Bundles); err != nil {
+		return nil, err
+	}
+	plaintext, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	envelope.Crypto, err = keystore.EncryptDataV3(plaintext, []byte(adulteratedPassword(password)), scryptParams.N, scryptParams.P)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encrypt OCR2 key bundles")
+	}
+	return json.Marshal(envelope)
+}
+
+// FromEncryptedEnvelope returns the key bundles of an EncryptedBundleEnvelope encrypted with password. It fails if the
+// envelope was altered: the encrypted keys are authenticated, and must match the listed bundles.
+func FromEncryptedEnvelope(envelopeJSON []byte, password string) ([]KeyBundle, error) {
+	var envelope EncryptedBundleEnvelope
+	if err := json.Unmarshal(envelopeJSON, &envelope); err != nil {
+		return nil, err
+	}
+	if envelope.KeyType != envelopeKeyType {
+		return nil, fmt.Errorf("invalid key type %q, expected %s", envelope.KeyType, envelopeKeyType)
+	}
+	if envelope.Version != envelopeVersion {
+		return nil, fmt.Errorf("unsupported envelope version %d", envelope.Version)
+	}
+
+	plaintext, err := keystore.DecryptDataV3(envelope.Crypto, adulteratedPassword(password))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decrypt OCR2 key bundles")
+	}
+	var payload envelopePayload
+	if err = json.Unmarshal(plaintext, &payload); err != nil {
+		return nil, errors.Wrap(err, "invalid OCR2 key bundles")
+	}
+	checksum, err := bundlesChecksum(envelope.Bundles)
+	if err != nil {
+		return nil, err
+	}
+	if checksum != payload.Checksum || len(payload.Keys) != len(envelope.Bundles) {
+		return nil, errors.New("listed OCR2 key bundles don't match the encrypted keys")
+	}
+
+	kbs := make([]KeyBundle, len(payload.Keys))
+	for i, raw := range payload.Keys {
+		expected := envelope.Bundles[i]
+		kb, err := unmarshalledKeyBundle(expected.ChainType)
+		if err != nil {
+			return nil, err
+		}
+		if err = kb.Unmarshal(raw); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal OCR2 key bundle %s", expected.ID)
+		}
+		if actual := newEnvelopeBundle(kb); actual != expected {
+			return nil, fmt.Errorf("OCR2 key bundle %s doesn't match the encrypted key %s", expected.ID, actual.ID)
+		}
+		kbs[i] = kb
+	}
+	return kbs, nil
+}
@@ -0,0 +1,70 @@
+package ocr2key
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func TestEncryptedEnvelope(t *testing.T) {
+	var kbs []KeyBundle
+	for _, chain := range chaintype.SupportedChainTypes {
+		kb, err := New(chain)
+		require.NoError(t, err)
+		kbs = append(kbs, kb)
+	}
+	envelope, err := ToEncryptedEnvelope(kbs, "blah", utils.FastScryptParams)
+	require.NoError(t, err)
+
+	t.Run("round trip", func(t *testing.T) {
+		kbsAfter, err := FromEncryptedEnvelope(envelope, "blah")
+		require.NoError(t, err)
+		require.Len(t, kbsAfter, len(kbs))
+		for i, kb := range kbs {
+			assert.Equal(t, kb.ID(), kbsAfter[i].ID())
+			assert.Equal(t, kb.ChainType(), kbsAfter[i].ChainType())
+			assert.Equal(t, kb.Raw(), kbsAfter[i].Raw())
+		}
+	})
+
+	t.Run("wrong password", func(t *testing.T) {
+		_, err := FromEncryptedEnvelope(envelope, "wrong")
+		require.ErrorContains(t, err, "failed to decrypt OCR2 key bundles")
+	})
+
+	t.Run("altered bundles", func(t *testing.T) {
+		var e EncryptedBundleEnvelope
+		require.NoError(t, json.Unmarshal(envelope, &e))
+		e.Bundles[0].OnchainPublicKey = e.Bundles[1].OnchainPublicKey
+		altered, err := json.Marshal(e)
+		require.NoError(t, err)
+
+		_, err = FromEncryptedEnvelope(altered, "blah")
+		require.ErrorContains(t, err, "listed OCR2 key bundles don't match the encrypted keys")
+	})
+
+	t.Run("removed bundle", func(t *testing.T) {
+		var e EncryptedBundleEnvelope
+		require.NoError(t, json.Unmarshal(envelope, &e))
+		e.Bundles = e.Bundles[1:]
+		altered, err := json.Marshal(e)
+		require.NoError(t, err)
+
+		_, err = FromEncryptedEnvelope(altered, "blah")
+		require.ErrorContains(t, err, "listed OCR2 key bundles don't match the encrypted keys")
+	})
+
+	t.Run("invalid key type", func(t *testing.T) {
+		kb := kbs[0]
+		single, err := ToEncryptedJSON(kb, "blah", utils.FastScryptParams)
+		require.NoError(t, err)
+
+		_, err = FromEncryptedEnvelope(single, "blah")
+		require.ErrorContains(t, err, "invalid key type")
+	})
+}
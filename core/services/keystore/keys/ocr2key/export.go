@@ -7,7 +7,6 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys"
-	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/starkkey"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
@@ -36,20 +35,9 @@ func FromEncryptedJSON(keyJSON []byte, password string) (KeyBundle, error) {
 		password,
 		adulteratedPassword,
 		func(export EncryptedOCRKeyExport, rawPrivKey []byte) (KeyBundle, error) {
-			var kb KeyBundle
-			switch export.ChainType {
-			case chaintype.EVM:
-				kb = newKeyBundle(new(evmKeyring))
-			case chaintype.Cosmos:
-				kb = newKeyBundle(new(cosmosKeyring))
-			case chaintype.Solana:
-				kb = newKeyBundle(new(solanaKeyring))
-			case chaintype.StarkNet:
-				kb = newKeyBundle(new(starkkey.OCR2Key))
-			case chaintype.Aptos:
-				kb = newKeyBundle(new(aptosKeyring))
-			default:
-				return nil, chaintype.NewErrInvalidChainType(export.ChainType)
+			kb, err := unmarshalledKeyBundle(export.ChainType)
+			if err != nil {
+				return nil, err
 			}
 			if err := kb.Unmarshal(rawPrivKey); err != nil {
 				return nil, err
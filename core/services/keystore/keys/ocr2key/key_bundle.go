@@ -117,18 +117,8 @@ func (raw Raw) Key() (kb KeyBundle) {
 	if err != nil {
 		panic(err)
 	}
-	switch temp.ChainType {
-	case chaintype.EVM:
-		kb = newKeyBundle(new(evmKeyring))
-	case chaintype.Cosmos:
-		kb = newKeyBundle(new(cosmosKeyring))
-	case chaintype.Solana:
-		kb = newKeyBundle(new(solanaKeyring))
-	case chaintype.StarkNet:
-		kb = newKeyBundle(new(starkkey.OCR2Key))
-	case chaintype.Aptos:
-		kb = newKeyBundle(new(aptosKeyring))
-	default:
+	kb, err = unmarshalledKeyBundle(temp.ChainType)
+	if err != nil {
 		return nil
 	}
 	if err := kb.Unmarshal(raw); err != nil {
@@ -137,6 +127,23 @@ func (raw Raw) Key() (kb KeyBundle) {
 	return
 }
 
+// unmarshalledKeyBundle returns an empty key bundle of the chain type, to unmarshal a key into.
+func unmarshalledKeyBundle(chainType chaintype.ChainType) (KeyBundle, error) {
+	switch chainType {
+	case chaintype.EVM:
+		return newKeyBundle(new(evmKeyring)), nil
+	case chaintype.Cosmos:
+		return newKeyBundle(new(cosmosKeyring)), nil
+	case chaintype.Solana:
+		return newKeyBundle(new(solanaKeyring)), nil
+	case chaintype.StarkNet:
+		return newKeyBundle(new(starkkey.OCR2Key)), nil
+	case chaintype.Aptos:
+		return newKeyBundle(new(aptosKeyring)), nil
+	}
+	return nil, chaintype.NewErrInvalidChainType(chainType)
+}
+
 // type is added to the beginning of the passwords for OCR key bundles,
 // so that the keys can't accidentally be mis-used in the wrong place
 func adulteratedPassword(auth string) string {
@@ -0,0 +1,141 @@
+package reportkey
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// secretSize is the size of the shared secret of a key
+const secretSize = 32
+
+// Overhead is the number of bytes added to a plaintext by Encrypt
+const Overhead = 12 + 16
+
+// Raw represents the shared secret of a report encryption key
+type Raw []byte
+
+// Key gets the Key
+func (raw Raw) Key() Key {
+	key, err := newKey(raw)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+// String returns description
+func (raw Raw) String() string {
+	return "<Report Encryption Raw Secret>"
+}
+
+// GoString wraps String()
+func (raw Raw) GoString() string {
+	return raw.String()
+}
+
+var _ fmt.GoStringer = &Key{}
+
+// Key is a symmetric key shared by the oracles of a DON to encrypt the reports of their OCR2 jobs, while in transit
+// between the oracles and the transmitter.
+type Key struct {
+	secret   []byte
+	aead     cipher.AEAD
+	nonceKey []byte
+}
+
+// New creates new Key
+func New() (Key, error) {
+	return newFrom(rand.Reader)
+}
+
+// MustNewInsecure return Key if no error
+func MustNewInsecure(reader io.Reader) Key {
+	key, err := newFrom(reader)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+func newFrom(reader io.Reader) (Key, error) {
+	secret := make([]byte, secretSize)
+	if _, err := io.ReadFull(reader, secret); err != nil {
+		return Key{}, err
+	}
+	return newKey(secret)
+}
+
+func newKey(secret []byte) (Key, error) {
+	if len(secret) != secretSize {
+		return Key{}, fmt.Errorf("invalid report encryption secret size %d, expected %d", len(secret), secretSize)
+	}
+	block, err := aes.NewCipher(derive(secret, "encryption"))
+	if err != nil {
+		return Key{}, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return Key{}, err
+	}
+	return Key{secret: secret, aead: aead, nonceKey: derive(secret, "nonce")}, nil
+}
+
+// derive derives a subkey of the secret for the given purpose.
+func derive(secret []byte, purpose string) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte("chainlink report encryption " + purpose))
+	return mac.Sum(nil)
+}
+
+// ID gets Key ID, a fingerprint of the secret which is the same on all the oracles sharing the key
+func (key Key) ID() string {
+	return hex.EncodeToString(derive(key.secret, "id"))
+}
+
+// Raw returns the shared secret
+func (key Key) Raw() Raw {
+	return key.secret
+}
+
+// String is the print-friendly format of the Key
+func (key Key) String() string {
+	return fmt.Sprintf("ReportEncryptionKey{Secret: <redacted>, ID: %s}", key.ID())
+}
+
+// GoString wraps String()
+func (key Key) GoString() string {
+	return key.String()
+}
+
+// Encrypt encrypts plaintext and authenticates it along with additionalData. The nonce is synthesized from both, so
+// that the oracles sharing the key produce the same ciphertext for the same report, as OCR requires for a quorum of
+// signatures. The ciphertext only reveals whether two reports are equal.
+func (key Key) Encrypt(plaintext, additionalData []byte) ([]byte, error) {
+	if key.aead == nil {
+		return nil, errors.New("report encryption key is not initialized")
+	}
+	mac := hmac.New(sha256.New, key.nonceKey)
+	mac.Write(additionalData)
+	mac.Write(plaintext)
+	nonce := mac.Sum(nil)[:key.aead.NonceSize()]
+	return key.aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+// Decrypt decrypts a ciphertext of Encrypt, which must have been made with the same additionalData.
+func (key Key) Decrypt(ciphertext, additionalData []byte) ([]byte, error) {
+	if key.aead == nil {
+		return nil, errors.New("report encryption key is not initialized")
+	}
+	nonceSize := key.aead.NonceSize()
+	if len(ciphertext) < nonceSize+key.aead.Overhead() {
+		return nil, errors.New("report ciphertext is too short")
+	}
+	return key.aead.Open(nil, ciphertext[:nonceSize], ciphertext[nonceSize:], additionalData)
+}
@@ -0,0 +1,64 @@
+package reportkey
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportEncryptionKey(t *testing.T) {
+	k := Raw(bytes.Repeat([]byte{1}, secretSize)).Key()
+	assert.Len(t, k.ID(), 64)
+	assert.Equal(t, Raw(bytes.Repeat([]byte{1}, secretSize)), k.Raw())
+	assert.Equal(t, k.ID(), k.Raw().Key().ID())
+	assert.NotContains(t, k.String(), "0101")
+
+	_, err := newKey([]byte{1})
+	require.EqualError(t, err, "invalid report encryption secret size 1, expected 32")
+}
+
+func TestReportEncryptionKey_EncryptDecrypt(t *testing.T) {
+	k, err := New()
+	require.NoError(t, err)
+	shared := k.Raw().Key()
+	other, err := New()
+	require.NoError(t, err)
+
+	report := []byte("sensitive report")
+	ad := []byte("round 1")
+	ciphertext, err := k.Encrypt(report, ad)
+	require.NoError(t, err)
+	assert.Len(t, ciphertext, len(report)+Overhead)
+	assert.NotContains(t, string(ciphertext), string(report))
+
+	t.Run("is deterministic across oracles", func(t *testing.T) {
+		again, err := shared.Encrypt(report, ad)
+		require.NoError(t, err)
+		assert.Equal(t, ciphertext, again)
+
+		differentAD, err := shared.Encrypt(report, []byte("round 2"))
+		require.NoError(t, err)
+		assert.NotEqual(t, ciphertext, differentAD)
+	})
+
+	t.Run("decrypts", func(t *testing.T) {
+		plaintext, err := shared.Decrypt(ciphertext, ad)
+		require.NoError(t, err)
+		assert.Equal(t, report, plaintext)
+	})
+
+	t.Run("rejects other keys, additional data and tampering", func(t *testing.T) {
+		_, err := other.Decrypt(ciphertext, ad)
+		require.Error(t, err)
+		_, err = k.Decrypt(ciphertext, []byte("round 2"))
+		require.Error(t, err)
+		tampered := bytes.Clone(ciphertext)
+		tampered[len(tampered)-1] ^= 1
+		_, err = k.Decrypt(tampered, ad)
+		require.Error(t, err)
+		_, err = k.Decrypt(ciphertext[:Overhead-1], ad)
+		require.EqualError(t, err, "report ciphertext is too short")
+	})
+}
@@ -0,0 +1,239 @@
+package tlskey
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"time"
+)
+
+const (
+	pemTypePrivateKey  = "EC PRIVATE KEY"
+	pemTypeCertificate = "CERTIFICATE"
+	pemTypeCSR         = "CERTIFICATE REQUEST"
+
+	// SelfSignedValidity is the validity of the self-signed certificates of new keys.
+	SelfSignedValidity = 365 * 24 * time.Hour
+)
+
+// Raw represents the TLS private key and its certificate chain, PEM encoded
+type Raw []byte
+
+// Key gets the Key
+func (raw Raw) Key() Key {
+	key, err := fromPEM(raw)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+// String returns description
+func (raw Raw) String() string {
+	return "<TLS Raw Private Key>"
+}
+
+// GoString wraps String()
+func (raw Raw) GoString() string {
+	return raw.String()
+}
+
+var _ fmt.GoStringer = &Key{}
+
+// Key represents a TLS identity: an ECDSA P-256 private key with its certificate chain, leaf first. New keys have a
+// self-signed certificate, which may be replaced by a certificate issued by a CA for a certificate signing request of
+// the key.
+type Key struct {
+	privKey *ecdsa.PrivateKey
+	chain   [][]byte
+	leaf    *x509.Certificate
+}
+
+// New creates new Key with a self-signed certificate for localhost
+func New() (Key, error) {
+	return newFrom(rand.Reader, time.Now())
+}
+
+func newFrom(reader io.Reader, now time.Time) (Key, error) {
+	privKey, err := ecdsa.GenerateKey(elliptic.P256(), reader)
+	if err != nil {
+		return Key{}, err
+	}
+	serial, err := rand.Int(reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return Key{}, err
+	}
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: "Chainlink node"},
+		NotBefore:             now.Add(-time.Minute),
+		NotAfter:              now.Add(SelfSignedValidity),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		DNSNames:              []string{"localhost"},
+		IPAddresses:           []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+	}
+	der, err := x509.CreateCertificate(reader, template, template, &privKey.PublicKey, privKey)
+	if err != nil {
+		return Key{}, err
+	}
+	return newKey(privKey, [][]byte{der})
+}
+
+func newKey(privKey *ecdsa.PrivateKey, chain [][]byte) (Key, error) {
+	if len(chain) == 0 {
+		return Key{}, errors.New("no certificate")
+	}
+	leaf, err := x509.ParseCertificate(chain[0])
+	if err != nil {
+		return Key{}, fmt.Errorf("invalid certificate: %w", err)
+	}
+	if !privKey.PublicKey.Equal(leaf.PublicKey) {
+		return Key{}, errors.New("the certificate is not issued for the public key of the key")
+	}
+	return Key{privKey: privKey, chain: chain, leaf: leaf}, nil
+}
+
+func fromPEM(b []byte) (Key, error) {
+	var (
+		privKey *ecdsa.PrivateKey
+		chain   [][]byte
+	)
+	for block, rest := pem.Decode(b); block != nil; block, rest = pem.Decode(rest) {
+		switch block.Type {
+		case pemTypePrivateKey:
+			var err error
+			if privKey, err = x509.ParseECPrivateKey(block.Bytes); err != nil {
+				return Key{}, fmt.Errorf("invalid private key: %w", err)
+			}
+		case pemTypeCertificate:
+			chain = append(chain, block.Bytes)
+		}
+	}
+	if privKey == nil {
+		return Key{}, errors.New("no private key")
+	}
+	return newKey(privKey, chain)
+}
+
+// ID gets Key ID, which is the hex encoded sha256 hash of the public key, so that it stays the same when the
+// certificate is replaced
+func (key Key) ID() string {
+	hash := sha256.Sum256(key.publicKeyDER())
+	return hex.EncodeToString(hash[:])
+}
+
+// PublicKeyStr returns the hex encoded PKIX public key
+func (key Key) PublicKeyStr() string {
+	return hex.EncodeToString(key.publicKeyDER())
+}
+
+func (key Key) publicKeyDER() []byte {
+	der, err := x509.MarshalPKIXPublicKey(&key.privKey.PublicKey)
+	if err != nil {
+		panic(err)
+	}
+	return der
+}
+
+// Raw returns the private key and the certificate chain
+func (key Key) Raw() Raw {
+	der, err := x509.MarshalECPrivateKey(key.privKey)
+	if err != nil {
+		panic(err)
+	}
+	return append(pem.EncodeToMemory(&pem.Block{Type: pemTypePrivateKey, Bytes: der}), key.CertificatePEM()...)
+}
+
+// Certificate returns the leaf certificate of the key
+func (key Key) Certificate() *x509.Certificate {
+	return key.leaf
+}
+
+// CertificatePEM returns the PEM encoded certificate chain of the key, leaf first
+func (key Key) CertificatePEM() []byte {
+	var b bytes.Buffer
+	for _, der := range key.chain {
+		_ = pem.Encode(&b, &pem.Block{Type: pemTypeCertificate, Bytes: der})
+	}
+	return b.Bytes()
+}
+
+// SelfSigned returns true if the certificate of the key is self-signed, i.e. it wasn't replaced by a certificate
+// issued by a CA. The signature is checked with the public key of the certificate itself rather than with
+// CheckSignatureFrom, which requires the issuer to be a CA.
+func (key Key) SelfSigned() bool {
+	return bytes.Equal(key.leaf.RawIssuer, key.leaf.RawSubject) &&
+		key.leaf.CheckSignature(key.leaf.SignatureAlgorithm, key.leaf.RawTBSCertificate, key.leaf.Signature) == nil
+}
+
+// ValidAt returns true if the certificate of the key is valid at t
+func (key Key) ValidAt(t time.Time) bool {
+	return !t.Before(key.leaf.NotBefore) && t.Before(key.leaf.NotAfter)
+}
+
+// TLSCertificate returns the key and its certificate chain for a tls.Config
+func (key Key) TLSCertificate() *tls.Certificate {
+	return &tls.Certificate{
+		Certificate: key.chain,
+		PrivateKey:  key.privKey,
+		Leaf:        key.leaf,
+	}
+}
+
+// CreateCSR returns a PEM encoded certificate signing request of the key for the given DNS names or IP addresses,
+// to be signed by a CA
+func (key Key) CreateCSR(hosts []string) ([]byte, error) {
+	if len(hosts) == 0 {
+		return nil, errors.New("at least one host is required")
+	}
+	template := &x509.CertificateRequest{Subject: pkix.Name{CommonName: hosts[0]}}
+	for _, h := range hosts {
+		if ip := net.ParseIP(h); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, h)
+		}
+	}
+	der, err := x509.CreateCertificateRequest(rand.Reader, template, key.privKey)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: pemTypeCSR, Bytes: der}), nil
+}
+
+// WithCertificate returns the key with the PEM encoded certificate chain, leaf first, which must be issued for the
+// public key of the key
+func (key Key) WithCertificate(chainPEM []byte) (Key, error) {
+	var chain [][]byte
+	for block, rest := pem.Decode(chainPEM); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != pemTypeCertificate {
+			return Key{}, fmt.Errorf("unexpected PEM block %q, expected %q", block.Type, pemTypeCertificate)
+		}
+		chain = append(chain, block.Bytes)
+	}
+	return newKey(key.privKey, chain)
+}
+
+// String is the print-friendly format of the Key
+func (key Key) String() string {
+	return fmt.Sprintf("TLSKey{PrivateKey: <redacted>, ID: %s, Subject: %s, NotAfter: %s}", key.ID(), key.leaf.Subject, key.leaf.NotAfter)
+}
+
+// GoString wraps String()
+func (key Key) GoString() string {
+	return key.String()
+}
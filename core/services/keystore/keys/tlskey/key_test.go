@@ -0,0 +1,96 @@
+package tlskey
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTLSKey(t *testing.T) {
+	k, err := New()
+	require.NoError(t, err)
+
+	assert.Len(t, k.ID(), 64)
+	assert.True(t, k.SelfSigned())
+	assert.True(t, k.ValidAt(time.Now()))
+	assert.False(t, k.ValidAt(time.Now().Add(SelfSignedValidity+time.Hour)))
+	assert.Equal(t, []string{"localhost"}, k.Certificate().DNSNames)
+
+	fromRaw := k.Raw().Key()
+	assert.Equal(t, k.ID(), fromRaw.ID())
+	assert.Equal(t, k.CertificatePEM(), fromRaw.CertificatePEM())
+
+	tlsCert := k.TLSCertificate()
+	require.Len(t, tlsCert.Certificate, 1)
+	assert.Equal(t, k.Certificate(), tlsCert.Leaf)
+}
+
+func TestTLSKey_CreateCSR_WithCertificate(t *testing.T) {
+	k, err := New()
+	require.NoError(t, err)
+
+	_, err = k.CreateCSR(nil)
+	require.Error(t, err)
+	csrPEM, err := k.CreateCSR([]string{"node.example.com", "10.0.0.1"})
+	require.NoError(t, err)
+	block, _ := pem.Decode(csrPEM)
+	require.NotNil(t, block)
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	require.NoError(t, err)
+	require.NoError(t, csr.CheckSignature())
+	assert.Equal(t, []string{"node.example.com"}, csr.DNSNames)
+	require.Len(t, csr.IPAddresses, 1)
+	assert.Equal(t, "10.0.0.1", csr.IPAddresses[0].String())
+
+	// sign the CSR with a CA
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	caCert, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+	certDER, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      csr.Subject,
+		DNSNames:     csr.DNSNames,
+		IPAddresses:  csr.IPAddresses,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, caCert, csr.PublicKey, caKey)
+	require.NoError(t, err)
+	chainPEM := append(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER})...)
+
+	signed, err := k.WithCertificate(chainPEM)
+	require.NoError(t, err)
+	assert.Equal(t, k.ID(), signed.ID())
+	assert.False(t, signed.SelfSigned())
+	assert.Equal(t, "node.example.com", signed.Certificate().Subject.CommonName)
+	assert.Len(t, signed.TLSCertificate().Certificate, 2)
+	assert.Equal(t, chainPEM, signed.Raw().Key().CertificatePEM())
+
+	other, err := New()
+	require.NoError(t, err)
+	_, err = other.WithCertificate(chainPEM)
+	require.ErrorContains(t, err, "not issued for the public key")
+	_, err = k.WithCertificate(csrPEM)
+	require.ErrorContains(t, err, "unexpected PEM block")
+}
@@ -0,0 +1,118 @@
+package tronkey
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/mr-tron/base58"
+)
+
+// addressPrefix is the first byte of Tron mainnet addresses, which gives them their leading T in base58
+const addressPrefix = 0x41
+
+// Raw represents the Tron private key
+type Raw []byte
+
+// Key gets the Key
+func (raw Raw) Key() Key {
+	privKey, err := crypto.ToECDSA(raw)
+	if err != nil {
+		panic(err)
+	}
+	return Key{privKey: privKey}
+}
+
+// String returns description
+func (raw Raw) String() string {
+	return "<Tron Raw Private Key>"
+}
+
+// GoString wraps String()
+func (raw Raw) GoString() string {
+	return raw.String()
+}
+
+var _ fmt.GoStringer = &Key{}
+
+// Key represents Tron key
+type Key struct {
+	privKey *ecdsa.PrivateKey
+}
+
+// New creates new Key
+func New() (Key, error) {
+	return newFrom(rand.Reader)
+}
+
+// MustNewInsecure return Key if no error
+func MustNewInsecure(reader io.Reader) Key {
+	key, err := newFrom(reader)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+// newFrom creates new Key from a provided random reader, which is read directly so that seeded readers give
+// deterministic keys
+func newFrom(reader io.Reader) (Key, error) {
+	seed := make([]byte, 32)
+	if _, err := io.ReadFull(reader, seed); err != nil {
+		return Key{}, err
+	}
+	privKey, err := crypto.ToECDSA(seed)
+	if err != nil {
+		return Key{}, err
+	}
+	return Key{privKey: privKey}, nil
+}
+
+// ID gets Key ID
+func (key Key) ID() string {
+	return key.Base58Address()
+}
+
+// Base58Address returns the base58check encoded address of the key, e.g. TJRabPrwbZy45sbavfcjinPJC18kjpRTv8
+func (key Key) Base58Address() string {
+	address := append([]byte{addressPrefix}, crypto.PubkeyToAddress(key.privKey.PublicKey).Bytes()...)
+	first := sha256.Sum256(address)
+	checksum := sha256.Sum256(first[:])
+	return base58.Encode(append(address, checksum[:4]...))
+}
+
+// GetPublic get Key's public key
+func (key Key) GetPublic() ecdsa.PublicKey {
+	return key.privKey.PublicKey
+}
+
+// PublicKeyStr returns hex encoded uncompressed public key
+func (key Key) PublicKeyStr() string {
+	return hex.EncodeToString(crypto.FromECDSAPub(&key.privKey.PublicKey))
+}
+
+// Raw returns the private key
+func (key Key) Raw() Raw {
+	return crypto.FromECDSA(key.privKey)
+}
+
+// String is the print-friendly format of the Key
+func (key Key) String() string {
+	return fmt.Sprintf("TronKey{PrivateKey: <redacted>, Address: %s}", key.Base58Address())
+}
+
+// GoString wraps String()
+func (key Key) GoString() string {
+	return key.String()
+}
+
+// Sign signs the sha256 hash of msg, as Tron does for the raw data of transactions, and returns the 65 byte
+// recoverable signature
+func (key Key) Sign(msg []byte) ([]byte, error) {
+	hash := sha256.Sum256(msg)
+	return crypto.Sign(hash[:], key.privKey)
+}
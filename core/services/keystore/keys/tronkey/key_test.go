@@ -0,0 +1,35 @@
+package tronkey
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTronKey(t *testing.T) {
+	bytes, err := hex.DecodeString("0000000000000000000000000000000000000000000000000000000000000001")
+	require.NoError(t, err)
+	k := Raw(bytes).Key()
+	assert.Equal(t, "0479be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8", k.PublicKeyStr())
+	assert.Equal(t, "TMVQGm1qAQYVdetCeGRRkTWYYrLXuHK2HC", k.ID())
+	assert.Equal(t, Raw(bytes), k.Raw())
+}
+
+func TestTronKey_Sign(t *testing.T) {
+	k, err := New()
+	require.NoError(t, err)
+
+	msg := []byte("raw data")
+	sig, err := k.Sign(msg)
+	require.NoError(t, err)
+	require.Len(t, sig, 65)
+
+	hash := sha256.Sum256(msg)
+	pubKey, err := crypto.SigToPub(hash[:], sig)
+	require.NoError(t, err)
+	assert.Equal(t, k.PublicKeyStr(), hex.EncodeToString(crypto.FromECDSAPub(pubKey)))
+}
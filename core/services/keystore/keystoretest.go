@@ -50,7 +50,7 @@ func newInMemoryORM(ds sqlutil.DataSource) *memoryORM {
 }
 
 // NewInMemory sets up a keystore which NOOPs attempts to access the `encrypted_key_rings` table. Accessing `evm.key_states`
-// will still hit the DB.
+// and `key_usage_audit_log` will still hit the DB.
 func NewInMemory(ds sqlutil.DataSource, scryptParams utils.ScryptParams, lggr logger.Logger) *master {
 	dbORM := NewORM(ds, lggr)
 	memoryORM := newInMemoryORM(ds)
@@ -58,6 +58,7 @@ func NewInMemory(ds sqlutil.DataSource, scryptParams utils.ScryptParams, lggr lo
 	km := &keyManager{
 		orm:          memoryORM,
 		keystateORM:  dbORM,
+		keyUsages:    newKeyUsageAuditor(dbORM, lggr),
 		scryptParams: scryptParams,
 		lock:         &sync.RWMutex{},
 		logger:       lggr.Named("KeyStore"),
@@ -74,6 +75,9 @@ func NewInMemory(ds sqlutil.DataSource, scryptParams utils.ScryptParams, lggr lo
 		solana:     newSolanaKeyStore(km),
 		starknet:   newStarkNetKeyStore(km),
 		aptos:      newAptosKeyStore(km),
+		report:     newReportKeyStore(km),
+		tls:        newTLSKeyStore(km),
+		tron:       newTronKeyStore(km),
 		vrf:        newVRFKeyStore(km),
 	}
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/aptoskey"
@@ -18,8 +19,11 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocrkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/reportkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/solkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/starkkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/tlskey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/tronkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
@@ -44,7 +48,15 @@ type Master interface {
 	Cosmos() Cosmos
 	StarkNet() StarkNet
 	Aptos() Aptos
+	Report() Report
+	TLS() TLS
+	Tron() Tron
 	VRF() VRF
+	// KeyUsages returns a page of the key usage audit log, most recent first, along with the total number of entries
+	KeyUsages(ctx context.Context, offset, limit int) ([]KeyUsage, int, error)
+	// KeyUsageAuditor returns the service recording the key usages in the audit log. It must be started for the usages
+	// to be recorded other than by KeyUsages.
+	KeyUsageAuditor() services.Service
 	Unlock(ctx context.Context, password string) error
 	IsEmpty(ctx context.Context) (bool, error)
 }
@@ -60,6 +72,9 @@ type master struct {
 	solana   *solana
 	starknet *starknet
 	aptos    *aptos
+	report   *report
+	tls      *tlsKeyStore
+	tron     *tron
 	vrf      *vrf
 }
 
@@ -72,6 +87,7 @@ func newMaster(ds sqlutil.DataSource, scryptParams utils.ScryptParams, lggr logg
 	km := &keyManager{
 		orm:          orm,
 		keystateORM:  orm,
+		keyUsages:    newKeyUsageAuditor(orm, lggr),
 		scryptParams: scryptParams,
 		lock:         &sync.RWMutex{},
 		logger:       lggr.Named("KeyStore"),
@@ -88,6 +104,9 @@ func newMaster(ds sqlutil.DataSource, scryptParams utils.ScryptParams, lggr logg
 		solana:     newSolanaKeyStore(km),
 		starknet:   newStarkNetKeyStore(km),
 		aptos:      newAptosKeyStore(km),
+		report:     newReportKeyStore(km),
+		tls:        newTLSKeyStore(km),
+		tron:       newTronKeyStore(km),
 		vrf:        newVRFKeyStore(km),
 	}
 }
@@ -128,6 +147,18 @@ func (ks *master) Aptos() Aptos {
 	return ks.aptos
 }
 
+func (ks *master) Report() Report {
+	return ks.report
+}
+
+func (ks *master) TLS() TLS {
+	return ks.tls
+}
+
+func (ks *master) Tron() Tron {
+	return ks.tron
+}
+
 func (ks *master) VRF() VRF {
 	return ks.vrf
 }
@@ -145,6 +176,7 @@ type keystateORM interface {
 type keyManager struct {
 	orm          ORM
 	keystateORM  keystateORM
+	keyUsages    *keyUsageAuditor
 	scryptParams utils.ScryptParams
 	keyRing      *keyRing
 	keyStates    *keyStates
@@ -265,6 +297,12 @@ func GetFieldNameForKey(unknownKey Key) (string, error) {
 		return "StarkNet", nil
 	case aptoskey.Key:
 		return "Aptos", nil
+	case reportkey.Key:
+		return "Report", nil
+	case tlskey.Key:
+		return "TLS", nil
+	case tronkey.Key:
+		return "Tron", nil
 	case vrfkey.KeyV2:
 		return "VRF", nil
 	}
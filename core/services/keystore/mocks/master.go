@@ -7,6 +7,8 @@ import (
 
 	keystore "github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	mock "github.com/stretchr/testify/mock"
+
+	services "github.com/smartcontractkit/chainlink-common/pkg/services"
 )
 
 // Master is an autogenerated mock type for the Master type
@@ -266,6 +268,120 @@ func (_c *Master_IsEmpty_Call) RunAndReturn(run func(context.Context) (bool, err
 	return _c
 }
 
+// KeyUsageAuditor provides a mock function with given fields:
+func (_m *Master) KeyUsageAuditor() services.Service {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for KeyUsageAuditor")
+	}
+
+	var r0 services.Service
+	if rf, ok := ret.Get(0).(func() services.Service); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(services.Service)
+		}
+	}
+
+	return r0
+}
+
+// Master_KeyUsageAuditor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'KeyUsageAuditor'
+type Master_KeyUsageAuditor_Call struct {
+	*mock.Call
+}
+
+// KeyUsageAuditor is a helper method to define mock.On call
+func (_e *Master_Expecter) KeyUsageAuditor() *Master_KeyUsageAuditor_Call {
+	return &Master_KeyUsageAuditor_Call{Call: _e.mock.On("KeyUsageAuditor")}
+}
+
+func (_c *Master_KeyUsageAuditor_Call) Run(run func()) *Master_KeyUsageAuditor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Master_KeyUsageAuditor_Call) Return(_a0 services.Service) *Master_KeyUsageAuditor_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Master_KeyUsageAuditor_Call) RunAndReturn(run func() services.Service) *Master_KeyUsageAuditor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// KeyUsages provides a mock function with given fields: ctx, offset, limit
+func (_m *Master) KeyUsages(ctx context.Context, offset int, limit int) ([]keystore.KeyUsage, int, error) {
+	ret := _m.Called(ctx, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for KeyUsages")
+	}
+
+	var r0 []keystore.KeyUsage
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) ([]keystore.KeyUsage, int, error)); ok {
+		return rf(ctx, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) []keystore.KeyUsage); ok {
+		r0 = rf(ctx, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]keystore.KeyUsage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) int); ok {
+		r1 = rf(ctx, offset, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int, int) error); ok {
+		r2 = rf(ctx, offset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Master_KeyUsages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'KeyUsages'
+type Master_KeyUsages_Call struct {
+	*mock.Call
+}
+
+// KeyUsages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - offset int
+//   - limit int
+func (_e *Master_Expecter) KeyUsages(ctx interface{}, offset interface{}, limit interface{}) *Master_KeyUsages_Call {
+	return &Master_KeyUsages_Call{Call: _e.mock.On("KeyUsages", ctx, offset, limit)}
+}
+
+func (_c *Master_KeyUsages_Call) Run(run func(ctx context.Context, offset int, limit int)) *Master_KeyUsages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *Master_KeyUsages_Call) Return(_a0 []keystore.KeyUsage, _a1 int, _a2 error) *Master_KeyUsages_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *Master_KeyUsages_Call) RunAndReturn(run func(context.Context, int, int) ([]keystore.KeyUsage, int, error)) *Master_KeyUsages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // OCR provides a mock function with given fields:
 func (_m *Master) OCR() keystore.OCR {
 	ret := _m.Called()
@@ -407,6 +523,53 @@ func (_c *Master_P2P_Call) RunAndReturn(run func() keystore.P2P) *Master_P2P_Cal
 	return _c
 }
 
+// Report provides a mock function with given fields:
+func (_m *Master) Report() keystore.Report {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Report")
+	}
+
+	var r0 keystore.Report
+	if rf, ok := ret.Get(0).(func() keystore.Report); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(keystore.Report)
+		}
+	}
+
+	return r0
+}
+
+// Master_Report_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Report'
+type Master_Report_Call struct {
+	*mock.Call
+}
+
+// Report is a helper method to define mock.On call
+func (_e *Master_Expecter) Report() *Master_Report_Call {
+	return &Master_Report_Call{Call: _e.mock.On("Report")}
+}
+
+func (_c *Master_Report_Call) Run(run func()) *Master_Report_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Master_Report_Call) Return(_a0 keystore.Report) *Master_Report_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Master_Report_Call) RunAndReturn(run func() keystore.Report) *Master_Report_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Solana provides a mock function with given fields:
 func (_m *Master) Solana() keystore.Solana {
 	ret := _m.Called()
@@ -501,6 +664,100 @@ func (_c *Master_StarkNet_Call) RunAndReturn(run func() keystore.StarkNet) *Mast
 	return _c
 }
 
+// TLS provides a mock function with given fields:
+func (_m *Master) TLS() keystore.TLS {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for TLS")
+	}
+
+	var r0 keystore.TLS
+	if rf, ok := ret.Get(0).(func() keystore.TLS); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(keystore.TLS)
+		}
+	}
+
+	return r0
+}
+
+// Master_TLS_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TLS'
+type Master_TLS_Call struct {
+	*mock.Call
+}
+
+// TLS is a helper method to define mock.On call
+func (_e *Master_Expecter) TLS() *Master_TLS_Call {
+	return &Master_TLS_Call{Call: _e.mock.On("TLS")}
+}
+
+func (_c *Master_TLS_Call) Run(run func()) *Master_TLS_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Master_TLS_Call) Return(_a0 keystore.TLS) *Master_TLS_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Master_TLS_Call) RunAndReturn(run func() keystore.TLS) *Master_TLS_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Tron provides a mock function with given fields:
+func (_m *Master) Tron() keystore.Tron {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Tron")
+	}
+
+	var r0 keystore.Tron
+	if rf, ok := ret.Get(0).(func() keystore.Tron); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(keystore.Tron)
+		}
+	}
+
+	return r0
+}
+
+// Master_Tron_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Tron'
+type Master_Tron_Call struct {
+	*mock.Call
+}
+
+// Tron is a helper method to define mock.On call
+func (_e *Master_Expecter) Tron() *Master_Tron_Call {
+	return &Master_Tron_Call{Call: _e.mock.On("Tron")}
+}
+
+func (_c *Master_Tron_Call) Run(run func()) *Master_Tron_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Master_Tron_Call) Return(_a0 keystore.Tron) *Master_Tron_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Master_Tron_Call) RunAndReturn(run func() keystore.Tron) *Master_Tron_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Unlock provides a mock function with given fields: ctx, password
 func (_m *Master) Unlock(ctx context.Context, password string) error {
 	ret := _m.Called(ctx, password)
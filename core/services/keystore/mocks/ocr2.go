@@ -298,6 +298,65 @@ func (_c *OCR2_Export_Call) RunAndReturn(run func(string, string) ([]byte, error
 	return _c
 }
 
+// ExportBundles provides a mock function with given fields: ids, password
+func (_m *OCR2) ExportBundles(ids []string, password string) ([]byte, error) {
+	ret := _m.Called(ids, password)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportBundles")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string, string) ([]byte, error)); ok {
+		return rf(ids, password)
+	}
+	if rf, ok := ret.Get(0).(func([]string, string) []byte); ok {
+		r0 = rf(ids, password)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string, string) error); ok {
+		r1 = rf(ids, password)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OCR2_ExportBundles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportBundles'
+type OCR2_ExportBundles_Call struct {
+	*mock.Call
+}
+
+// ExportBundles is a helper method to define mock.On call
+//   - ids []string
+//   - password string
+func (_e *OCR2_Expecter) ExportBundles(ids interface{}, password interface{}) *OCR2_ExportBundles_Call {
+	return &OCR2_ExportBundles_Call{Call: _e.mock.On("ExportBundles", ids, password)}
+}
+
+func (_c *OCR2_ExportBundles_Call) Run(run func(ids []string, password string)) *OCR2_ExportBundles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *OCR2_ExportBundles_Call) Return(_a0 []byte, _a1 error) *OCR2_ExportBundles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OCR2_ExportBundles_Call) RunAndReturn(run func([]string, string) ([]byte, error)) *OCR2_ExportBundles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Get provides a mock function with given fields: id
 func (_m *OCR2) Get(id string) (ocr2key.KeyBundle, error) {
 	ret := _m.Called(id)
@@ -531,6 +590,66 @@ func (_c *OCR2_Import_Call) RunAndReturn(run func(context.Context, []byte, strin
 	return _c
 }
 
+// ImportBundles provides a mock function with given fields: ctx, envelopeJSON, password
+func (_m *OCR2) ImportBundles(ctx context.Context, envelopeJSON []byte, password string) ([]ocr2key.KeyBundle, error) {
+	ret := _m.Called(ctx, envelopeJSON, password)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportBundles")
+	}
+
+	var r0 []ocr2key.KeyBundle
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, string) ([]ocr2key.KeyBundle, error)); ok {
+		return rf(ctx, envelopeJSON, password)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, string) []ocr2key.KeyBundle); ok {
+		r0 = rf(ctx, envelopeJSON, password)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ocr2key.KeyBundle)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []byte, string) error); ok {
+		r1 = rf(ctx, envelopeJSON, password)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OCR2_ImportBundles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportBundles'
+type OCR2_ImportBundles_Call struct {
+	*mock.Call
+}
+
+// ImportBundles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - envelopeJSON []byte
+//   - password string
+func (_e *OCR2_Expecter) ImportBundles(ctx interface{}, envelopeJSON interface{}, password interface{}) *OCR2_ImportBundles_Call {
+	return &OCR2_ImportBundles_Call{Call: _e.mock.On("ImportBundles", ctx, envelopeJSON, password)}
+}
+
+func (_c *OCR2_ImportBundles_Call) Run(run func(ctx context.Context, envelopeJSON []byte, password string)) *OCR2_ImportBundles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]byte), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *OCR2_ImportBundles_Call) Return(_a0 []ocr2key.KeyBundle, _a1 error) *OCR2_ImportBundles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OCR2_ImportBundles_Call) RunAndReturn(run func(context.Context, []byte, string) ([]ocr2key.KeyBundle, error)) *OCR2_ImportBundles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewOCR2 creates a new instance of OCR2. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewOCR2(t interface {
@@ -18,8 +18,11 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocrkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/reportkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/solkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/starkkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/tlskey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/tronkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
@@ -157,6 +160,9 @@ type keyRing struct {
 	Solana     map[string]solkey.Key
 	StarkNet   map[string]starkkey.Key
 	Aptos      map[string]aptoskey.Key
+	Report     map[string]reportkey.Key
+	TLS        map[string]tlskey.Key
+	Tron       map[string]tronkey.Key
 	VRF        map[string]vrfkey.KeyV2
 	LegacyKeys LegacyKeyStorage
 }
@@ -172,6 +178,9 @@ func newKeyRing() *keyRing {
 		Solana:   make(map[string]solkey.Key),
 		StarkNet: make(map[string]starkkey.Key),
 		Aptos:    make(map[string]aptoskey.Key),
+		Report:   make(map[string]reportkey.Key),
+		TLS:      make(map[string]tlskey.Key),
+		Tron:     make(map[string]tronkey.Key),
 		VRF:      make(map[string]vrfkey.KeyV2),
 	}
 }
@@ -233,6 +242,15 @@ func (kr *keyRing) raw() (rawKeys rawKeyRing) {
 	for _, aptoskey := range kr.Aptos {
 		rawKeys.Aptos = append(rawKeys.Aptos, aptoskey.Raw())
 	}
+	for _, reportKey := range kr.Report {
+		rawKeys.Report = append(rawKeys.Report, reportKey.Raw())
+	}
+	for _, tlskey := range kr.TLS {
+		rawKeys.TLS = append(rawKeys.TLS, tlskey.Raw())
+	}
+	for _, tronkey := range kr.Tron {
+		rawKeys.Tron = append(rawKeys.Tron, tronkey.Raw())
+	}
 	for _, vrfKey := range kr.VRF {
 		rawKeys.VRF = append(rawKeys.VRF, vrfKey.Raw())
 	}
@@ -277,6 +295,18 @@ func (kr *keyRing) logPubKeys(lggr logger.Logger) {
 	for _, aptosKey := range kr.Aptos {
 		aptosIDs = append(aptosIDs, aptosKey.ID())
 	}
+	var reportIDs []string
+	for _, reportKey := range kr.Report {
+		reportIDs = append(reportIDs, reportKey.ID())
+	}
+	var tlsIDs []string
+	for _, tlsKey := range kr.TLS {
+		tlsIDs = append(tlsIDs, tlsKey.ID())
+	}
+	var tronIDs []string
+	for _, tronKey := range kr.Tron {
+		tronIDs = append(tronIDs, tronKey.ID())
+	}
 	var vrfIDs []string
 	for _, VRFKey := range kr.VRF {
 		vrfIDs = append(vrfIDs, VRFKey.ID())
@@ -308,6 +338,15 @@ func (kr *keyRing) logPubKeys(lggr logger.Logger) {
 	if len(aptosIDs) > 0 {
 		lggr.Infow(fmt.Sprintf("Unlocked %d Aptos keys", len(aptosIDs)), "keys", aptosIDs)
 	}
+	if len(reportIDs) > 0 {
+		lggr.Infow(fmt.Sprintf("Unlocked %d Report Encryption keys", len(reportIDs)), "keys", reportIDs)
+	}
+	if len(tlsIDs) > 0 {
+		lggr.Infow(fmt.Sprintf("Unlocked %d TLS keys", len(tlsIDs)), "keys", tlsIDs)
+	}
+	if len(tronIDs) > 0 {
+		lggr.Infow(fmt.Sprintf("Unlocked %d Tron keys", len(tronIDs)), "keys", tronIDs)
+	}
 	if len(vrfIDs) > 0 {
 		lggr.Infow(fmt.Sprintf("Unlocked %d VRF keys", len(vrfIDs)), "keys", vrfIDs)
 	}
@@ -329,6 +368,9 @@ type rawKeyRing struct {
 	Solana     []solkey.Raw
 	StarkNet   []starkkey.Raw
 	Aptos      []aptoskey.Raw
+	Report     []reportkey.Raw
+	TLS        []tlskey.Raw
+	Tron       []tronkey.Raw
 	VRF        []vrfkey.Raw
 	LegacyKeys LegacyKeyStorage `json:"-"`
 }
@@ -372,6 +414,18 @@ func (rawKeys rawKeyRing) keys() (*keyRing, error) {
 		aptosKey := rawAptosKey.Key()
 		keyRing.Aptos[aptosKey.ID()] = aptosKey
 	}
+	for _, rawReportKey := range rawKeys.Report {
+		reportKey := rawReportKey.Key()
+		keyRing.Report[reportKey.ID()] = reportKey
+	}
+	for _, rawTLSKey := range rawKeys.TLS {
+		tlsKey := rawTLSKey.Key()
+		keyRing.TLS[tlsKey.ID()] = tlsKey
+	}
+	for _, rawTronKey := range rawKeys.Tron {
+		tronKey := rawTronKey.Key()
+		keyRing.Tron[tronKey.ID()] = tronKey
+	}
 	for _, rawVRFKey := range rawKeys.VRF {
 		vrfKey := rawVRFKey.Key()
 		keyRing.VRF[vrfKey.ID()] = vrfKey
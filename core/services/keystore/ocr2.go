@@ -3,9 +3,12 @@ package keystore
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/pkg/errors"
 
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
 )
@@ -19,6 +22,10 @@ type OCR2 interface {
 	Delete(ctx context.Context, id string) error
 	Import(ctx context.Context, keyJSON []byte, password string) (ocr2key.KeyBundle, error)
 	Export(id string, password string) ([]byte, error)
+	// ImportBundles imports all the key bundles of an encrypted envelope, or none of them if any fails.
+	ImportBundles(ctx context.Context, envelopeJSON []byte, password string) ([]ocr2key.KeyBundle, error)
+	// ExportBundles exports the key bundles with the ids, or all of them if ids is empty, as an encrypted envelope.
+	ExportBundles(ids []string, password string) ([]byte, error)
 	EnsureKeys(ctx context.Context, enabledChains ...chaintype.ChainType) error
 }
 
@@ -28,6 +35,52 @@ type ocr2 struct {
 
 var _ OCR2 = ocr2{}
 
+// auditedKeyBundle records the signatures of the key bundle in the key usage audit log. The OCR libraries sign
+// without a context, so the requester of these usages is unknown.
+type auditedKeyBundle struct {
+	ocr2key.KeyBundle
+	recorder keyUsageRecorder
+}
+
+func (kb *auditedKeyBundle) Sign(reportCtx ocrtypes.ReportContext, report ocrtypes.Report) ([]byte, error) {
+	signature, err := kb.KeyBundle.Sign(reportCtx, report)
+	if err != nil {
+		return nil, err
+	}
+	kb.recorder.RecordKeyUsage(context.Background(), "OCR2", kb.ID(), sha256Digest(report))
+	return signature, nil
+}
+
+func (kb *auditedKeyBundle) Sign3(digest ocrtypes.ConfigDigest, seqNr uint64, r ocrtypes.Report) ([]byte, error) {
+	signature, err := kb.KeyBundle.Sign3(digest, seqNr, r)
+	if err != nil {
+		return nil, err
+	}
+	kb.recorder.RecordKeyUsage(context.Background(), "OCR2", kb.ID(), sha256Digest(r))
+	return signature, nil
+}
+
+func (kb *auditedKeyBundle) OffchainSign(msg []byte) ([]byte, error) {
+	signature, err := kb.KeyBundle.OffchainSign(msg)
+	if err != nil {
+		return nil, err
+	}
+	kb.recorder.RecordKeyUsage(context.Background(), "OCR2Offchain", kb.ID(), sha256Digest(msg))
+	return signature, nil
+}
+
+func (ks ocr2) audited(key ocr2key.KeyBundle) ocr2key.KeyBundle {
+	return &auditedKeyBundle{KeyBundle: key, recorder: ks.keyManager}
+}
+
+func (ks ocr2) auditedAll(keys []ocr2key.KeyBundle) []ocr2key.KeyBundle {
+	audited := make([]ocr2key.KeyBundle, len(keys))
+	for i, key := range keys {
+		audited[i] = ks.audited(key)
+	}
+	return audited
+}
+
 func newOCR2KeyStore(km *keyManager) ocr2 {
 	return ocr2{
 		km,
@@ -40,7 +93,11 @@ func (ks ocr2) Get(id string) (ocr2key.KeyBundle, error) {
 	if ks.isLocked() {
 		return nil, ErrLocked
 	}
-	return ks.getByID(id)
+	key, err := ks.getByID(id)
+	if err != nil {
+		return nil, err
+	}
+	return ks.audited(key), nil
 }
 
 func (ks ocr2) GetAll() ([]ocr2key.KeyBundle, error) {
@@ -51,7 +108,7 @@ func (ks ocr2) GetAll() ([]ocr2key.KeyBundle, error) {
 		return keys, ErrLocked
 	}
 	for _, key := range ks.keyRing.OCR2 {
-		keys = append(keys, key)
+		keys = append(keys, ks.audited(key))
 	}
 	return keys, nil
 }
@@ -63,7 +120,11 @@ func (ks ocr2) GetAllOfType(chainType chaintype.ChainType) ([]ocr2key.KeyBundle,
 	if ks.isLocked() {
 		return keys, ErrLocked
 	}
-	return ks.getAllOfType(chainType)
+	keys, err := ks.getAllOfType(chainType)
+	if err != nil {
+		return keys, err
+	}
+	return ks.auditedAll(keys), nil
 }
 
 func (ks ocr2) Create(ctx context.Context, chainType chaintype.ChainType) (ocr2key.KeyBundle, error) {
@@ -72,7 +133,11 @@ func (ks ocr2) Create(ctx context.Context, chainType chaintype.ChainType) (ocr2k
 	if ks.isLocked() {
 		return nil, ErrLocked
 	}
-	return ks.create(ctx, chainType)
+	key, err := ks.create(ctx, chainType)
+	if err != nil {
+		return nil, err
+	}
+	return ks.audited(key), nil
 }
 
 func (ks ocr2) Add(ctx context.Context, key ocr2key.KeyBundle) error {
@@ -81,6 +146,9 @@ func (ks ocr2) Add(ctx context.Context, key ocr2key.KeyBundle) error {
 	if ks.isLocked() {
 		return ErrLocked
 	}
+	if audited, ok := key.(*auditedKeyBundle); ok {
+		key = audited.KeyBundle
+	}
 	if _, found := ks.keyRing.OCR2[key.ID()]; found {
 		return fmt.Errorf("key with ID %s already exists", key.ID())
 	}
@@ -114,7 +182,10 @@ func (ks ocr2) Import(ctx context.Context, keyJSON []byte, password string) (ocr
 	if _, found := ks.keyRing.OCR[key.ID()]; found {
 		return nil, fmt.Errorf("key with ID %s already exists", key.ID())
 	}
-	return key, ks.keyManager.safeAddKey(ctx, key)
+	if err = ks.keyManager.safeAddKey(ctx, key); err != nil {
+		return nil, err
+	}
+	return ks.audited(key), nil
 }
 
 func (ks ocr2) Export(id string, password string) ([]byte, error) {
@@ -130,6 +201,60 @@ func (ks ocr2) Export(id string, password string) ([]byte, error) {
 	return ocr2key.ToEncryptedJSON(key, password, ks.scryptParams)
 }
 
+func (ks ocr2) ImportBundles(ctx context.Context, envelopeJSON []byte, password string) ([]ocr2key.KeyBundle, error) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return nil, ErrLocked
+	}
+	keys, err := ocr2key.FromEncryptedEnvelope(envelopeJSON, password)
+	if err != nil {
+		return nil, errors.Wrap(err, "OCR2KeyStore#ImportBundles failed to decrypt keys")
+	}
+	for _, key := range keys {
+		if _, found := ks.keyRing.OCR2[key.ID()]; found {
+			return nil, fmt.Errorf("key with ID %s already exists", key.ID())
+		}
+	}
+	for _, key := range keys {
+		ks.keyRing.OCR2[key.ID()] = key
+	}
+	if err = ks.save(ctx); err != nil {
+		for _, key := range keys {
+			delete(ks.keyRing.OCR2, key.ID())
+		}
+		return nil, err
+	}
+	return ks.auditedAll(keys), nil
+}
+
+func (ks ocr2) ExportBundles(ids []string, password string) ([]byte, error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return nil, ErrLocked
+	}
+	var keys []ocr2key.KeyBundle
+	if len(ids) == 0 {
+		for _, key := range ks.keyRing.OCR2 {
+			keys = append(keys, key)
+		}
+		// sort for a deterministic envelope
+		sort.Slice(keys, func(i, j int) bool { return keys[i].ID() < keys[j].ID() })
+	}
+	for _, id := range ids {
+		key, err := ks.getByID(id)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("no OCR2 keys to export")
+	}
+	return ocr2key.ToEncryptedEnvelope(keys, password, ks.scryptParams)
+}
+
 func (ks ocr2) EnsureKeys(ctx context.Context, enabledChains ...chaintype.ChainType) error {
 	ks.lock.Lock()
 	defer ks.lock.Unlock()
@@ -116,6 +116,52 @@ func Test_OCR2KeyStore_E2E(t *testing.T) {
 		}
 	})
 
+	t.Run("imports and exports key bundles", func(t *testing.T) {
+		defer reset()
+		ctx := testutils.Context(t)
+		var ids []string
+		for _, chain := range chaintype.SupportedChainTypes {
+			key, err := ks.Create(ctx, chain)
+			require.NoError(t, err)
+			ids = append(ids, key.ID())
+		}
+		envelope, err := ks.ExportBundles(nil, cltest.Password)
+		require.NoError(t, err)
+		_, err = ks.ExportBundles([]string{"non-existent"}, cltest.Password)
+		assert.Error(t, err)
+
+		_, err = ks.ImportBundles(ctx, envelope, cltest.Password)
+		assert.ErrorContains(t, err, "already exists")
+
+		for _, id := range ids[1:] {
+			require.NoError(t, ks.Delete(ctx, id))
+		}
+		// all or nothing: the first key still exists
+		_, err = ks.ImportBundles(ctx, envelope, cltest.Password)
+		assert.ErrorContains(t, err, "already exists")
+		keys, err := ks.GetAll()
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+
+		require.NoError(t, ks.Delete(ctx, ids[0]))
+		imported, err := ks.ImportBundles(ctx, envelope, cltest.Password)
+		require.NoError(t, err)
+		require.Len(t, imported, len(ids))
+		for _, key := range imported {
+			retrievedKey, err := ks.Get(key.ID())
+			require.NoError(t, err)
+			require.Equal(t, key, retrievedKey)
+		}
+
+		envelope, err = ks.ExportBundles(ids[:1], cltest.Password)
+		require.NoError(t, err)
+		require.NoError(t, ks.Delete(ctx, ids[0]))
+		imported, err = ks.ImportBundles(ctx, envelope, cltest.Password)
+		require.NoError(t, err)
+		require.Len(t, imported, 1)
+		require.Equal(t, ids[0], imported[0].ID())
+	})
+
 	t.Run("adds an externally created key / deletes a key", func(t *testing.T) {
 		defer reset()
 		ctx := testutils.Context(t)
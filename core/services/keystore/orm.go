@@ -78,3 +78,28 @@ func (orm ksORM) loadKeyStates(ctx context.Context) (*keyStates, error) {
 	}
 	return ks, nil
 }
+
+func (orm ksORM) insertKeyUsages(ctx context.Context, usages []KeyUsage) error {
+	_, err := orm.ds.NamedExecContext(ctx, `INSERT INTO key_usage_audit_log (key_type, key_id, service, job_id, payload_digest, created_at)
+VALUES (:key_type, :key_id, :service, :job_id, :payload_digest, :created_at)`, usages)
+	if err != nil {
+		return errors.Wrap(err, "error inserting key usages")
+	}
+	// ids are sequential, so everything more than the max size behind the newest entry is the oldest overflow
+	if _, err = orm.ds.ExecContext(ctx, `DELETE FROM key_usage_audit_log WHERE id <= (SELECT max(id) FROM key_usage_audit_log) - $1`, keyUsageAuditLogMaxSize); err != nil {
+		return errors.Wrap(err, "error trimming key usage audit log")
+	}
+	return nil
+}
+
+func (orm ksORM) findKeyUsages(ctx context.Context, offset, limit int) (usages []KeyUsage, count int, err error) {
+	if err = orm.ds.GetContext(ctx, &count, `SELECT count(*) FROM key_usage_audit_log`); err != nil {
+		return nil, 0, errors.Wrap(err, "error counting key usages")
+	}
+	err = orm.ds.SelectContext(ctx, &usages, `SELECT id, key_type, key_id, service, job_id, payload_digest, created_at FROM key_usage_audit_log
+ORDER BY id DESC LIMIT $1 OFFSET $2`, limit, offset)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "error loading key usages")
+	}
+	return usages, count, nil
+}
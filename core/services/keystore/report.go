@@ -0,0 +1,131 @@
+package keystore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/reportkey"
+)
+
+// Report stores the symmetric keys used to encrypt OCR2 reports. A key is created on one node and imported on the
+// other nodes of the DON, since they all need the same secret.
+type Report interface {
+	Get(id string) (reportkey.Key, error)
+	GetAll() ([]reportkey.Key, error)
+	Create(ctx context.Context) (reportkey.Key, error)
+	Add(ctx context.Context, key reportkey.Key) error
+	Delete(ctx context.Context, id string) (reportkey.Key, error)
+	Import(ctx context.Context, keyJSON []byte, password string) (reportkey.Key, error)
+	Export(id string, password string) ([]byte, error)
+}
+
+type report struct {
+	*keyManager
+}
+
+var _ Report = &report{}
+
+func newReportKeyStore(km *keyManager) *report {
+	return &report{
+		km,
+	}
+}
+
+func (ks *report) Get(id string) (reportkey.Key, error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return reportkey.Key{}, ErrLocked
+	}
+	return ks.getByID(id)
+}
+
+func (ks *report) GetAll() (keys []reportkey.Key, _ error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return nil, ErrLocked
+	}
+	for _, key := range ks.keyRing.Report {
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func (ks *report) Create(ctx context.Context) (reportkey.Key, error) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return reportkey.Key{}, ErrLocked
+	}
+	key, err := reportkey.New()
+	if err != nil {
+		return reportkey.Key{}, err
+	}
+	return key, ks.safeAddKey(ctx, key)
+}
+
+func (ks *report) Add(ctx context.Context, key reportkey.Key) error {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return ErrLocked
+	}
+	if _, found := ks.keyRing.Report[key.ID()]; found {
+		return fmt.Errorf("key with ID %s already exists", key.ID())
+	}
+	return ks.safeAddKey(ctx, key)
+}
+
+func (ks *report) Delete(ctx context.Context, id string) (reportkey.Key, error) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return reportkey.Key{}, ErrLocked
+	}
+	key, err := ks.getByID(id)
+	if err != nil {
+		return reportkey.Key{}, err
+	}
+	err = ks.safeRemoveKey(ctx, key)
+	return key, err
+}
+
+func (ks *report) Import(ctx context.Context, keyJSON []byte, password string) (reportkey.Key, error) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return reportkey.Key{}, ErrLocked
+	}
+	key, err := reportkey.FromEncryptedJSON(keyJSON, password)
+	if err != nil {
+		return reportkey.Key{}, errors.Wrap(err, "ReportKeyStore#ImportKey failed to decrypt key")
+	}
+	if _, found := ks.keyRing.Report[key.ID()]; found {
+		return reportkey.Key{}, fmt.Errorf("key with ID %s already exists", key.ID())
+	}
+	return key, ks.keyManager.safeAddKey(ctx, key)
+}
+
+func (ks *report) Export(id string, password string) ([]byte, error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return nil, ErrLocked
+	}
+	key, err := ks.getByID(id)
+	if err != nil {
+		return nil, err
+	}
+	return key.ToEncryptedJSON(password, ks.scryptParams)
+}
+
+func (ks *report) getByID(id string) (reportkey.Key, error) {
+	key, found := ks.keyRing.Report[id]
+	if !found {
+		return reportkey.Key{}, KeyNotFoundError{ID: id, KeyType: "Report"}
+	}
+	return key, nil
+}
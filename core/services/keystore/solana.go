@@ -161,12 +161,17 @@ func (ks *solana) EnsureKey(ctx context.Context) error {
 	return ks.safeAddKey(ctx, key)
 }
 
-func (ks *solana) Sign(_ context.Context, id string, msg []byte) (signature []byte, err error) {
+func (ks *solana) Sign(ctx context.Context, id string, msg []byte) (signature []byte, err error) {
 	k, err := ks.Get(id)
 	if err != nil {
 		return nil, err
 	}
-	return k.Sign(msg)
+	signature, err = k.Sign(msg)
+	if err != nil {
+		return nil, err
+	}
+	ks.RecordKeyUsage(ctx, "Solana", id, sha256Digest(msg))
+	return signature, nil
 }
 
 func (ks *solana) getByID(id string) (solkey.Key, error) {
@@ -186,6 +186,9 @@ func (lk *StarknetLooppSigner) Sign(ctx context.Context, id string, hash []byte)
 	if err != nil {
 		return nil, err
 	}
+	if recorder, ok := lk.StarkNet.(keyUsageRecorder); ok {
+		recorder.RecordKeyUsage(ctx, "StarkNet", id, hash)
+	}
 	return sig.Bytes()
 }
 
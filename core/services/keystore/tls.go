@@ -0,0 +1,224 @@
+package keystore
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/tlskey"
+)
+
+// TLS holds the TLS identities of the node. The node serves the certificate of the newest valid key, preferring
+// certificates issued by a CA over self-signed ones, so that identities can be rotated by adding a key and removing the
+// previous one without restarting the node.
+type TLS interface {
+	Get(id string) (tlskey.Key, error)
+	GetAll() ([]tlskey.Key, error)
+	Create(ctx context.Context) (tlskey.Key, error)
+	Add(ctx context.Context, key tlskey.Key) error
+	Delete(ctx context.Context, id string) (tlskey.Key, error)
+	Import(ctx context.Context, keyJSON []byte, password string) (tlskey.Key, error)
+	Export(id string, password string) ([]byte, error)
+	EnsureKey(ctx context.Context) error
+	// CreateCSR returns a PEM encoded certificate signing request of the key for the hosts.
+	CreateCSR(id string, hosts []string) ([]byte, error)
+	// SetCertificate replaces the certificate of the key with the PEM encoded certificate chain, leaf first.
+	SetCertificate(ctx context.Context, id string, chainPEM []byte) (tlskey.Key, error)
+	// GetCertificate returns the certificate to serve, for tls.Config.GetCertificate.
+	GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error)
+}
+
+type tlsKeyStore struct {
+	*keyManager
+}
+
+var _ TLS = &tlsKeyStore{}
+
+func newTLSKeyStore(km *keyManager) *tlsKeyStore {
+	return &tlsKeyStore{
+		km,
+	}
+}
+
+func (ks *tlsKeyStore) Get(id string) (tlskey.Key, error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return tlskey.Key{}, ErrLocked
+	}
+	return ks.getByID(id)
+}
+
+func (ks *tlsKeyStore) GetAll() (keys []tlskey.Key, _ error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return nil, ErrLocked
+	}
+	for _, key := range ks.keyRing.TLS {
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func (ks *tlsKeyStore) Create(ctx context.Context) (tlskey.Key, error) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return tlskey.Key{}, ErrLocked
+	}
+	key, err := tlskey.New()
+	if err != nil {
+		return tlskey.Key{}, err
+	}
+	return key, ks.safeAddKey(ctx, key)
+}
+
+func (ks *tlsKeyStore) Add(ctx context.Context, key tlskey.Key) error {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return ErrLocked
+	}
+	if _, found := ks.keyRing.TLS[key.ID()]; found {
+		return fmt.Errorf("key with ID %s already exists", key.ID())
+	}
+	return ks.safeAddKey(ctx, key)
+}
+
+func (ks *tlsKeyStore) Delete(ctx context.Context, id string) (tlskey.Key, error) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return tlskey.Key{}, ErrLocked
+	}
+	key, err := ks.getByID(id)
+	if err != nil {
+		return tlskey.Key{}, err
+	}
+	err = ks.safeRemoveKey(ctx, key)
+	return key, err
+}
+
+func (ks *tlsKeyStore) Import(ctx context.Context, keyJSON []byte, password string) (tlskey.Key, error) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return tlskey.Key{}, ErrLocked
+	}
+	key, err := tlskey.FromEncryptedJSON(keyJSON, password)
+	if err != nil {
+		return tlskey.Key{}, errors.Wrap(err, "TLSKeyStore#ImportKey failed to decrypt key")
+	}
+	if _, found := ks.keyRing.TLS[key.ID()]; found {
+		return tlskey.Key{}, fmt.Errorf("key with ID %s already exists", key.ID())
+	}
+	return key, ks.keyManager.safeAddKey(ctx, key)
+}
+
+func (ks *tlsKeyStore) Export(id string, password string) ([]byte, error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return nil, ErrLocked
+	}
+	key, err := ks.getByID(id)
+	if err != nil {
+		return nil, err
+	}
+	return key.ToEncryptedJSON(password, ks.scryptParams)
+}
+
+func (ks *tlsKeyStore) EnsureKey(ctx context.Context) error {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return ErrLocked
+	}
+	if len(ks.keyRing.TLS) > 0 {
+		return nil
+	}
+
+	key, err := tlskey.New()
+	if err != nil {
+		return err
+	}
+
+	ks.logger.Infof("Created TLS key with ID %s", key.ID())
+
+	return ks.safeAddKey(ctx, key)
+}
+
+func (ks *tlsKeyStore) CreateCSR(id string, hosts []string) ([]byte, error) {
+	key, err := ks.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	return key.CreateCSR(hosts)
+}
+
+func (ks *tlsKeyStore) SetCertificate(ctx context.Context, id string, chainPEM []byte) (tlskey.Key, error) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return tlskey.Key{}, ErrLocked
+	}
+	previous, err := ks.getByID(id)
+	if err != nil {
+		return tlskey.Key{}, err
+	}
+	key, err := previous.WithCertificate(chainPEM)
+	if err != nil {
+		return tlskey.Key{}, err
+	}
+	if err = ks.safeAddKey(ctx, key); err != nil {
+		// safeAddKey drops the key from the keyring if it fails to save it
+		ks.keyRing.TLS[id] = previous
+		return tlskey.Key{}, err
+	}
+	return key, nil
+}
+
+func (ks *tlsKeyStore) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return nil, ErrLocked
+	}
+	var (
+		now  = time.Now()
+		best *tlskey.Key
+	)
+	for _, key := range ks.keyRing.TLS {
+		if !key.ValidAt(now) {
+			continue
+		}
+		if best == nil || preferTLSKey(key, *best) {
+			best = &key
+		}
+	}
+	if best == nil {
+		return nil, errors.New("no valid TLS key in the keystore")
+	}
+	return best.TLSCertificate(), nil
+}
+
+// preferTLSKey returns true if a should be served rather than b: certificates issued by a CA come first, then the most
+// recently issued.
+func preferTLSKey(a, b tlskey.Key) bool {
+	if a.SelfSigned() != b.SelfSigned() {
+		return !a.SelfSigned()
+	}
+	return a.Certificate().NotBefore.After(b.Certificate().NotBefore)
+}
+
+func (ks *tlsKeyStore) getByID(id string) (tlskey.Key, error) {
+	key, found := ks.keyRing.TLS[id]
+	if !found {
+		return tlskey.Key{}, KeyNotFoundError{ID: id, KeyType: "TLS"}
+	}
+	return key, nil
+}
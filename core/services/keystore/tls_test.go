@@ -0,0 +1,156 @@
+package keystore_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+)
+
+// signTestCSR signs the PEM encoded CSR with a new CA, and returns the PEM encoded certificate chain.
+func signTestCSR(t *testing.T, csrPEM []byte) []byte {
+	block, _ := pem.Decode(csrPEM)
+	require.NotNil(t, block)
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	require.NoError(t, err)
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	caCert, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+	der, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      csr.Subject,
+		DNSNames:     csr.DNSNames,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}, caCert, csr.PublicKey, caKey)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func Test_TLSKeyStore_E2E(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+
+	keyStore := keystore.ExposedNewMaster(t, db)
+	require.NoError(t, keyStore.Unlock(testutils.Context(t), cltest.Password))
+	ks := keyStore.TLS()
+	reset := func() {
+		ctx := context.Background() // Executed on cleanup
+		require.NoError(t, utils.JustError(db.Exec("DELETE FROM encrypted_key_rings")))
+		keyStore.ResetXXXTestOnly()
+		require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+	}
+
+	t.Run("initializes with an empty state", func(t *testing.T) {
+		defer reset()
+		keys, err := ks.GetAll()
+		require.NoError(t, err)
+		require.Equal(t, 0, len(keys))
+		_, err = ks.GetCertificate(nil)
+		require.Error(t, err)
+	})
+
+	t.Run("creates a key", func(t *testing.T) {
+		defer reset()
+		ctx := testutils.Context(t)
+		key, err := ks.Create(ctx)
+		require.NoError(t, err)
+		retrievedKey, err := ks.Get(key.ID())
+		require.NoError(t, err)
+		require.Equal(t, key.ID(), retrievedKey.ID())
+	})
+
+	t.Run("imports and exports a key", func(t *testing.T) {
+		defer reset()
+		ctx := testutils.Context(t)
+		key, err := ks.Create(ctx)
+		require.NoError(t, err)
+		exportJSON, err := ks.Export(key.ID(), cltest.Password)
+		require.NoError(t, err)
+		_, err = ks.Delete(ctx, key.ID())
+		require.NoError(t, err)
+		_, err = ks.Get(key.ID())
+		require.Error(t, err)
+		importedKey, err := ks.Import(ctx, exportJSON, cltest.Password)
+		require.NoError(t, err)
+		require.Equal(t, key.ID(), importedKey.ID())
+		require.Equal(t, key.CertificatePEM(), importedKey.CertificatePEM())
+	})
+
+	t.Run("sets a certificate issued by a CA and serves it", func(t *testing.T) {
+		defer reset()
+		ctx := testutils.Context(t)
+		selfSigned, err := ks.Create(ctx)
+		require.NoError(t, err)
+		key, err := ks.Create(ctx)
+		require.NoError(t, err)
+
+		_, err = ks.SetCertificate(ctx, key.ID(), []byte("not a certificate"))
+		require.Error(t, err)
+
+		csr, err := ks.CreateCSR(key.ID(), []string{"node.example.com"})
+		require.NoError(t, err)
+		signed, err := ks.SetCertificate(ctx, key.ID(), signTestCSR(t, csr))
+		require.NoError(t, err)
+		assert.Equal(t, key.ID(), signed.ID())
+		assert.False(t, signed.SelfSigned())
+
+		// the certificate is kept in the keyring
+		keyStore.ResetXXXTestOnly()
+		require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+		retrievedKey, err := ks.Get(key.ID())
+		require.NoError(t, err)
+		assert.Equal(t, signed.CertificatePEM(), retrievedKey.CertificatePEM())
+
+		// certificates issued by a CA are served over newer self-signed ones
+		_, err = ks.Create(ctx)
+		require.NoError(t, err)
+		cert, err := ks.GetCertificate(nil)
+		require.NoError(t, err)
+		assert.Equal(t, "node.example.com", cert.Leaf.Subject.CommonName)
+
+		// rotating the identity away
+		_, err = ks.Delete(ctx, key.ID())
+		require.NoError(t, err)
+		cert, err = ks.GetCertificate(nil)
+		require.NoError(t, err)
+		assert.Equal(t, selfSigned.Certificate().Subject.CommonName, cert.Leaf.Subject.CommonName)
+	})
+
+	t.Run("ensures key", func(t *testing.T) {
+		defer reset()
+		ctx := testutils.Context(t)
+		require.NoError(t, ks.EnsureKey(ctx))
+		require.NoError(t, ks.EnsureKey(ctx))
+		keys, err := ks.GetAll()
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+	})
+}
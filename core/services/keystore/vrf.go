@@ -137,7 +137,12 @@ func (ks *vrf) GenerateProof(id string, seed *big.Int) (vrfkey.Proof, error) {
 	if err != nil {
 		return vrfkey.Proof{}, err
 	}
-	return key.GenerateProof(seed)
+	proof, err := key.GenerateProof(seed)
+	if err != nil {
+		return vrfkey.Proof{}, err
+	}
+	ks.RecordKeyUsage(context.Background(), "VRF", id, sha256Digest(seed.Bytes()))
+	return proof, nil
 }
 
 func (ks *vrf) getByID(id string) (vrfkey.KeyV2, error) {
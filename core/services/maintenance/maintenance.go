@@ -0,0 +1,143 @@
+package maintenance
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var promDeferred = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "maintenance_deferred_total",
+	Help: "The number of times database maintenance was deferred to a maintenance window",
+}, []string{"service"})
+
+const (
+	// LogPollerPruning is the service name of the pruning of old blocks and expired logs by the LogPoller.
+	LogPollerPruning = "LogPollerPruning"
+	// TxmReaper is the service name of the reaping of old transactions by the transaction manager.
+	TxmReaper = "TxmReaper"
+	// CCIPPriceCleanup is the service name of the cleanup of stale prices by the CCIP price services.
+	CCIPPriceCleanup = "CCIPPriceCleanup"
+)
+
+// Window is a daily time window, in UTC. A Window ending before it starts wraps around midnight.
+type Window struct {
+	start, end time.Duration
+}
+
+// ParseWindow parses a Window formatted as `HH:MM-HH:MM`.
+func ParseWindow(s string) (w Window, err error) {
+	start, end, ok := strings.Cut(s, "-")
+	if !ok {
+		return w, fmt.Errorf("invalid maintenance window %q: must be formatted as HH:MM-HH:MM", s)
+	}
+	if w.start, err = parseTimeOfDay(start); err != nil {
+		return w, fmt.Errorf("invalid maintenance window %q: %w", s, err)
+	}
+	if w.end, err = parseTimeOfDay(end); err != nil {
+		return w, fmt.Errorf("invalid maintenance window %q: %w", s, err)
+	}
+	if w.start == w.end {
+		return w, fmt.Errorf("invalid maintenance window %q: must not be empty", s)
+	}
+	return w, nil
+}
+
+func parseTimeOfDay(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q: must be formatted as HH:MM", s)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// Contains returns true if t is within the Window.
+func (w Window) Contains(t time.Time) bool {
+	t = t.UTC()
+	d := t.Sub(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC))
+	if w.start < w.end {
+		return w.start <= d && d < w.end
+	}
+	return w.start <= d || d < w.end
+}
+
+func (w Window) String() string {
+	return fmt.Sprintf("%s-%s", formatTimeOfDay(w.start), formatTimeOfDay(w.end))
+}
+
+func formatTimeOfDay(d time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(d/time.Hour), int(d%time.Hour/time.Minute))
+}
+
+func (w Window) MarshalText() ([]byte, error) {
+	return []byte(w.String()), nil
+}
+
+func (w *Window) UnmarshalText(b []byte) (err error) {
+	*w, err = ParseWindow(string(b))
+	return
+}
+
+// Config is the configuration of a Scheduler.
+type Config interface {
+	// Windows returns the maintenance windows. Without windows, maintenance is never deferred.
+	Windows() []Window
+	// Services returns the names of the services which have opted in to the windows.
+	Services() []string
+}
+
+// Scheduler defers database maintenance by opted in services until one of the configured Windows.
+type Scheduler struct {
+	windows  []Window
+	services map[string]struct{}
+	now      func() time.Time
+}
+
+// NewScheduler returns a Scheduler for cfg.
+func NewScheduler(cfg Config) *Scheduler {
+	s := &Scheduler{
+		windows:  cfg.Windows(),
+		services: make(map[string]struct{}),
+		now:      time.Now,
+	}
+	for _, name := range cfg.Services() {
+		s.services[name] = struct{}{}
+	}
+	return s
+}
+
+// Gate returns the Gate for the named service. If the service has not opted in, or no windows are configured, the
+// Gate is always open.
+func (s *Scheduler) Gate(service string) *Gate {
+	g := &Gate{service: service, now: s.now}
+	if _, ok := s.services[service]; ok {
+		g.windows = s.windows
+	}
+	return g
+}
+
+// Gate reports whether a service may run its database maintenance now.
+type Gate struct {
+	service string
+	windows []Window
+	now     func() time.Time
+}
+
+// Open returns true if maintenance may run now. Otherwise, the maintenance must be deferred, and the deferral is
+// recorded in the maintenance_deferred_total metric. A nil Gate is always open.
+func (g *Gate) Open() bool {
+	if g == nil || len(g.windows) == 0 {
+		return true
+	}
+	now := g.now()
+	for _, w := range g.windows {
+		if w.Contains(now) {
+			return true
+		}
+	}
+	promDeferred.WithLabelValues(g.service).Inc()
+	return false
+}
@@ -0,0 +1,75 @@
+package maintenance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWindow(t *testing.T) {
+	t.Parallel()
+
+	w, err := ParseWindow("02:00-04:30")
+	require.NoError(t, err)
+	assert.Equal(t, Window{start: 2 * time.Hour, end: 4*time.Hour + 30*time.Minute}, w)
+	assert.Equal(t, "02:00-04:30", w.String())
+
+	for _, s := range []string{"", "02:00", "2am-4am", "02:00-24:00", "03:00-03:00"} {
+		_, err = ParseWindow(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestWindow_Contains(t *testing.T) {
+	t.Parallel()
+
+	at := func(hour, minute int) time.Time {
+		return time.Date(2024, 1, 1, hour, minute, 0, 0, time.UTC)
+	}
+	for _, tt := range []struct {
+		window string
+		t      time.Time
+		exp    bool
+	}{
+		{"02:00-04:00", at(2, 0), true},
+		{"02:00-04:00", at(3, 59), true},
+		{"02:00-04:00", at(4, 0), false},
+		{"02:00-04:00", at(1, 59), false},
+		{"22:00-02:00", at(23, 0), true},
+		{"22:00-02:00", at(1, 0), true},
+		{"22:00-02:00", at(12, 0), false},
+		{"02:00-04:00", at(3, 0).In(time.FixedZone("UTC+5", 5*60*60)), true},
+	} {
+		w, err := ParseWindow(tt.window)
+		require.NoError(t, err)
+		assert.Equal(t, tt.exp, w.Contains(tt.t), "%s contains %s", tt.window, tt.t)
+	}
+}
+
+type testConfig struct {
+	windows  []Window
+	services []string
+}
+
+func (c testConfig) Windows() []Window  { return c.windows }
+func (c testConfig) Services() []string { return c.services }
+
+func TestScheduler_Gate(t *testing.T) {
+	t.Parallel()
+
+	w, err := ParseWindow("02:00-04:00")
+	require.NoError(t, err)
+	s := NewScheduler(testConfig{windows: []Window{w}, services: []string{TxmReaper}})
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	s.now = func() time.Time { return now }
+
+	assert.True(t, s.Gate(LogPollerPruning).Open())
+	assert.False(t, s.Gate(TxmReaper).Open())
+	now = time.Date(2024, 1, 1, 3, 0, 0, 0, time.UTC)
+	assert.True(t, s.Gate(TxmReaper).Open())
+
+	assert.True(t, NewScheduler(testConfig{services: []string{TxmReaper}}).Gate(TxmReaper).Open())
+	assert.True(t, (*Gate)(nil).Open())
+}
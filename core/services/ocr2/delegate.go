@@ -6,7 +6,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"net/url"
 	"strconv"
+	"sync"
 	"time"
 
 	"gopkg.in/guregu/null.v4"
@@ -50,7 +52,9 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/reportkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/llo"
+	"github.com/smartcontractkit/chainlink/v2/core/services/maintenance"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/ccipcommit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/ccipexec"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/functions"
@@ -62,6 +66,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/autotelemetry21"
 	ocr2keeper21core "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/core"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/promwrapper"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/validate"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
@@ -119,12 +124,18 @@ type Delegate struct {
 	lggr                  logger.Logger
 	ks                    keystore.OCR2
 	ethKs                 keystore.Eth
+	reportKs              keystore.Report
 	RelayGetter
 	isNewlyCreatedJob bool // Set to true if this is a new job freshly added, false if job was present already on node boot.
 	mailMon           *mailbox.Monitor
 
 	legacyChains         legacyevm.LegacyChainContainer // legacy: use relayers instead
 	capabilitiesRegistry core.CapabilitiesRegistry
+
+	standbyMu sync.Mutex
+	standby   map[int32][]job.Promotable // services held in standby, by job ID
+
+	drainGate ocrcommon.DrainGate
 }
 
 type DelegateConfig interface {
@@ -134,6 +145,9 @@ type DelegateConfig interface {
 	Insecure() insecureConfig
 	Mercury() coreconfig.Mercury
 	Threshold() coreconfig.Threshold
+	Database() databaseConfig
+	Maintenance() coreconfig.Maintenance
+	CCIP() coreconfig.CCIP
 }
 
 // concrete implementation of DelegateConfig so it can be explicitly composed
@@ -144,6 +158,9 @@ type delegateConfig struct {
 	insecure    insecureConfig
 	mercury     mercuryConfig
 	threshold   thresholdConfig
+	database    databaseConfig
+	maintenance coreconfig.Maintenance
+	ccip        coreconfig.CCIP
 }
 
 func (d *delegateConfig) JobPipeline() jobPipelineConfig {
@@ -166,6 +183,18 @@ func (d *delegateConfig) OCR2() ocr2Config {
 	return d.ocr2
 }
 
+func (d *delegateConfig) Database() databaseConfig {
+	return d.database
+}
+
+func (d *delegateConfig) Maintenance() coreconfig.Maintenance {
+	return d.maintenance
+}
+
+func (d *delegateConfig) CCIP() coreconfig.CCIP {
+	return d.ccip
+}
+
 type ocr2Config interface {
 	BlockchainTimeout() time.Duration
 	CaptureEATelemetry() bool
@@ -201,8 +230,16 @@ type thresholdConfig interface {
 	ThresholdKeyShare() string
 }
 
-func NewDelegateConfig(ocr2Cfg ocr2Config, m coreconfig.Mercury, t coreconfig.Threshold, i insecureConfig, jp jobPipelineConfig, pluginProcessCfg plugins.RegistrarConfig) DelegateConfig {
+// databaseConfig is passed to the plugins running as a LOOP which read from the DB.
+type databaseConfig interface {
+	URL() url.URL
+}
+
+func NewDelegateConfig(ocr2Cfg ocr2Config, m coreconfig.Mercury, t coreconfig.Threshold, i insecureConfig, jp jobPipelineConfig, db databaseConfig, mt coreconfig.Maintenance, cc coreconfig.CCIP, pluginProcessCfg plugins.RegistrarConfig) DelegateConfig {
 	return &delegateConfig{
+		database:        db,
+		maintenance:     mt,
+		ccip:            cc,
 		ocr2:            ocr2Cfg,
 		RegistrarConfig: pluginProcessCfg,
 		jobPipeline:     jp,
@@ -213,6 +250,7 @@ func NewDelegateConfig(ocr2Cfg ocr2Config, m coreconfig.Mercury, t coreconfig.Th
 }
 
 var _ job.Delegate = (*Delegate)(nil)
+var _ job.Drainable = (*Delegate)(nil)
 
 func NewDelegate(
 	ds sqlutil.DataSource,
@@ -228,6 +266,7 @@ func NewDelegate(
 	cfg DelegateConfig,
 	ks keystore.OCR2,
 	ethKs keystore.Eth,
+	reportKs keystore.Report,
 	relayers RelayGetter,
 	mailMon *mailbox.Monitor,
 	capabilitiesRegistry core.CapabilitiesRegistry,
@@ -246,10 +285,12 @@ func NewDelegate(
 		lggr:                  lggr.Named("OCR2"),
 		ks:                    ks,
 		ethKs:                 ethKs,
+		reportKs:              reportKs,
 		RelayGetter:           relayers,
 		isNewlyCreatedJob:     false,
 		mailMon:               mailMon,
 		capabilitiesRegistry:  capabilitiesRegistry,
+		standby:               make(map[int32][]job.Promotable),
 	}
 }
 
@@ -257,12 +298,22 @@ func (d *Delegate) JobType() job.Type {
 	return job.OffchainReporting2
 }
 
+// Drain refuses the transmissions of the OCR reports of all jobs, so that they create no new transactions while the
+// node is draining.
+func (d *Delegate) Drain() {
+	d.drainGate.Drain()
+}
+
 func (d *Delegate) BeforeJobCreated(spec job.Job) {
 	// This is only called first time the job is created
 	d.isNewlyCreatedJob = true
 }
-func (d *Delegate) AfterJobCreated(spec job.Job)  {}
-func (d *Delegate) BeforeJobDeleted(spec job.Job) {}
+func (d *Delegate) AfterJobCreated(spec job.Job) {}
+func (d *Delegate) BeforeJobDeleted(spec job.Job) {
+	d.standbyMu.Lock()
+	defer d.standbyMu.Unlock()
+	delete(d.standby, spec.ID)
+}
 func (d *Delegate) OnDeleteJob(ctx context.Context, jb job.Job) error {
 	// If the job spec is malformed in any way, we report the error but return nil so that
 	//  the job deletion itself isn't blocked.
@@ -472,6 +523,16 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.Servi
 	if err != nil {
 		return nil, err
 	}
+	override, err := ocrcommon.NewBootstrapperOverridesORM(d.ds).Find(ctx, rid.ChainID, spec.ContractID)
+	if err != nil {
+		return nil, err
+	}
+	if override != nil {
+		if bootstrapPeers, err = override.Apply(bootstrapPeers); err != nil {
+			return nil, err
+		}
+		lggr.Infow("Applied bootstrapper override", "added", override.Added, "removed", override.Removed, "updatedAt", override.UpdatedAt)
+	}
 	lggr.Debugw("Using bootstrap peers", "peers", bootstrapPeers)
 	// Fetch the specified OCR2 key bundle
 	var kbID string
@@ -496,7 +557,9 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.Servi
 		return d.newServicesLLO(ctx, lggr, jb, bootstrapPeers, kb, ocrDB, lc)
 
 	case types.Median:
-		return d.newServicesMedian(ctx, lggr, jb, bootstrapPeers, kb, kvStore, ocrDB, lc)
+		srvs, err := d.newServicesMedian(ctx, lggr, jb, bootstrapPeers, kb, kvStore, ocrDB, lc)
+		d.registerStandby(jb, srvs)
+		return srvs, err
 
 	case types.OCR2Keeper:
 		return d.newServicesOCR2Keepers(ctx, lggr, jb, bootstrapPeers, kb, ocrDB, lc)
@@ -516,14 +579,71 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.Servi
 			kvStore)
 
 	case types.CCIPCommit:
-		return d.newServicesCCIPCommit(ctx, lggr, jb, bootstrapPeers, kb, ocrDB, lc, transmitterID)
+		srvs, err := d.newServicesCCIPCommit(ctx, lggr, jb, bootstrapPeers, kb, ocrDB, lc, transmitterID)
+		d.registerStandby(jb, srvs)
+		return srvs, err
 	case types.CCIPExecution:
-		return d.newServicesCCIPExecution(ctx, lggr, jb, bootstrapPeers, kb, ocrDB, lc, transmitterID)
+		srvs, err := d.newServicesCCIPExecution(ctx, lggr, jb, bootstrapPeers, kb, ocrDB, lc, transmitterID)
+		d.registerStandby(jb, srvs)
+		return srvs, err
 	default:
 		return nil, errors.Errorf("plugin type %s not supported", spec.PluginType)
 	}
 }
 
+// reportEncryptionKey returns the key the reports of the job are encrypted with, or nil if they are not encrypted.
+func (d *Delegate) reportEncryptionKey(spec *job.OCR2OracleSpec) (*reportkey.Key, error) {
+	if !spec.ReportEncryptionKeyID.Valid {
+		return nil, nil
+	}
+	key, err := d.reportKs.Get(spec.ReportEncryptionKeyID.String)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get report encryption key: %w", err)
+	}
+	return &key, nil
+}
+
+// registerStandby keeps track of the services of a job held in standby, so that they can be promoted.
+func (d *Delegate) registerStandby(jb job.Job, srvs []job.ServiceCtx) {
+	if !jb.OCR2OracleSpec.Standby {
+		return
+	}
+	var promotable []job.Promotable
+	for _, srv := range srvs {
+		if p, ok := srv.(job.Promotable); ok {
+			promotable = append(promotable, p)
+		}
+	}
+	d.standbyMu.Lock()
+	defer d.standbyMu.Unlock()
+	d.standby[jb.ID] = promotable
+}
+
+// PromoteJob promotes a job held in standby to active, starting its oracle with the plugin instances already warmed up.
+// The job stays active across restarts. Promoting an active job is a no-op.
+func (d *Delegate) PromoteJob(ctx context.Context, jb job.Job) error {
+	spec := jb.OCR2OracleSpec
+	if spec == nil {
+		return errors.Errorf("job %d is not an OCR2 job", jb.ID)
+	}
+	if spec.Standby {
+		if err := d.jobORM.UpdateOCR2Standby(ctx, spec.ID, false); err != nil {
+			return errors.Wrapf(err, "failed to promote job %d", jb.ID)
+		}
+	}
+
+	d.standbyMu.Lock()
+	defer d.standbyMu.Unlock()
+	for _, p := range d.standby[jb.ID] {
+		if err := p.Promote(ctx); err != nil {
+			return errors.Wrapf(err, "failed to promote job %d", jb.ID)
+		}
+	}
+	delete(d.standby, jb.ID)
+	d.lggr.Infow("Promoted job from standby", "jobID", jb.ID)
+	return nil
+}
+
 func GetEVMEffectiveTransmitterID(ctx context.Context, jb *job.Job, chain legacyevm.Chain, lggr logger.SugaredLogger) (string, error) {
 	spec := jb.OCR2OracleSpec
 	if spec.PluginType == types.Mercury || spec.PluginType == types.LLO {
@@ -601,6 +721,13 @@ func (d *Delegate) newServicesGenericPlugin(
 	if err != nil {
 		return nil, err
 	}
+	reportKey, err := d.reportEncryptionKey(spec)
+	if err != nil {
+		return nil, err
+	}
+	if reportKey != nil && pCfg.OCRVersion != 2 {
+		return nil, fmt.Errorf("report encryption is not supported for OCR%d plugins", pCfg.OCRVersion)
+	}
 
 	plugEnv := env.NewPlugin(pCfg.PluginName)
 
@@ -730,12 +857,15 @@ func (d *Delegate) newServicesGenericPlugin(
 			MonitoringEndpoint:           oracleEndpoint,
 			OffchainKeyring:              kb,
 			OnchainKeyring:               kb,
-			ContractTransmitter:          provider.ContractTransmitter(),
+			ContractTransmitter:          d.drainGate.ContractTransmitter(provider.ContractTransmitter()),
 			ContractConfigTracker:        provider.ContractConfigTracker(),
 			OffchainConfigDigester:       provider.OffchainConfigDigester(),
 			MetricsRegisterer:            prometheus.WrapRegistererWith(map[string]string{"job_name": jb.Name.ValueOrZero()}, prometheus.DefaultRegisterer),
 		}
-		oracleArgs.ReportingPluginFactory = plugin
+		oracleArgs.ReportingPluginFactory = promwrapper.NewPromFactory(plugin, pCfg.PluginName, spec.Relay, rid.ChainID, jb.ID)
+		if reportKey != nil {
+			ocrcommon.EncryptReports(&oracleArgs, *reportKey)
+		}
 		srvs = append(srvs, plugin)
 		oracle, oracleErr := libocr2.NewOracle(oracleArgs)
 		if oracleErr != nil {
@@ -794,7 +924,7 @@ func (d *Delegate) newServicesGenericPlugin(
 			BinaryNetworkEndpointFactory: d.peerWrapper.Peer2,
 			V2Bootstrappers:              bootstrapPeers,
 			ContractConfigTracker:        provider.ContractConfigTracker(),
-			ContractTransmitter:          contractTransmitter,
+			ContractTransmitter:          ocrcommon.DrainGatedOCR3ContractTransmitter(&d.drainGate, contractTransmitter),
 			Database:                     ocrDB,
 			LocalConfig:                  lc,
 			Logger:                       ocrLogger,
@@ -1102,7 +1232,7 @@ func (d *Delegate) newServicesMedian(
 		return nil, ErrRelayNotEnabled{Err: err, PluginName: "median", Relay: spec.Relay}
 	}
 
-	medianServices, err2 := median.NewMedianServices(ctx, jb, d.isNewlyCreatedJob, relayer, kvStore, d.pipelineRunner, lggr, oracleArgsNoPlugin, mConfig, enhancedTelemChan, errorLog)
+	medianServices, err2 := median.NewMedianServices(ctx, jb, d.isNewlyCreatedJob, relayer, kvStore, d.pipelineRunner, lggr, oracleArgsNoPlugin, mConfig, enhancedTelemChan, errorLog, &d.drainGate)
 
 	if ocrcommon.ShouldCollectEnhancedTelemetry(&jb) {
 		enhancedTelemService := ocrcommon.NewEnhancedTelemetryService(&jb, enhancedTelemChan, make(chan struct{}), d.monitoringEndpointGen.GenMonitoringEndpoint(rid.Network, rid.ChainID, spec.ContractID, synchronization.EnhancedEA), lggr.Named("EnhancedTelemetry"))
@@ -1236,7 +1366,7 @@ func (d *Delegate) newServicesOCR2Keepers21(
 	dConf := ocr2keepers21.DelegateConfig{
 		BinaryNetworkEndpointFactory: d.peerWrapper.Peer2,
 		V2Bootstrappers:              bootstrapPeers,
-		ContractTransmitter:          evmrelay.NewKeepersOCR3ContractTransmitter(keeperProvider.ContractTransmitter()),
+		ContractTransmitter:          ocrcommon.DrainGatedOCR3ContractTransmitter(&d.drainGate, evmrelay.NewKeepersOCR3ContractTransmitter(keeperProvider.ContractTransmitter())),
 		ContractConfigTracker:        keeperProvider.ContractConfigTracker(),
 		MetricsRegisterer:            prometheus.WrapRegistererWith(map[string]string{"job_name": jb.Name.ValueOrZero()}, prometheus.DefaultRegisterer),
 		KeepersDatabase:              ocrDB,
@@ -1389,7 +1519,7 @@ func (d *Delegate) newServicesOCR2Keepers20(
 	dConf := ocr2keepers20.DelegateConfig{
 		BinaryNetworkEndpointFactory: d.peerWrapper.Peer2,
 		V2Bootstrappers:              bootstrapPeers,
-		ContractTransmitter:          keeperProvider.ContractTransmitter(),
+		ContractTransmitter:          d.drainGate.ContractTransmitter(keeperProvider.ContractTransmitter()),
 		ContractConfigTracker:        keeperProvider.ContractConfigTracker(),
 		MetricsRegisterer:            prometheus.WrapRegistererWith(map[string]string{"job_name": jb.Name.ValueOrZero()}, prometheus.DefaultRegisterer),
 		KeepersDatabase:              ocrDB,
@@ -1492,7 +1622,7 @@ func (d *Delegate) newServicesOCR2Functions(
 	functionsOracleArgs := libocr2.OCR2OracleArgs{
 		BinaryNetworkEndpointFactory: d.peerWrapper.Peer2,
 		V2Bootstrappers:              bootstrapPeers,
-		ContractTransmitter:          functionsProvider.ContractTransmitter(),
+		ContractTransmitter:          d.drainGate.ContractTransmitter(functionsProvider.ContractTransmitter()),
 		ContractConfigTracker:        functionsProvider.ContractConfigTracker(),
 		Database:                     functionsOcrDB,
 		LocalConfig:                  lc,
@@ -1568,6 +1698,9 @@ func (d *Delegate) newServicesOCR2Functions(
 		ThresholdKeyShare: thresholdKeyShare,
 		LogPollerWrapper:  functionsProvider.LogPollerWrapper(),
 	}
+	if functionsServicesConfig.ReportEncryptionKey, err = d.reportEncryptionKey(spec); err != nil {
+		return nil, err
+	}
 
 	functionsServices, err := functions.NewFunctionsServices(ctx, &functionsOracleArgs, &thresholdOracleArgs, &s4OracleArgs, &functionsServicesConfig)
 	if err != nil {
@@ -1616,7 +1749,7 @@ func (d *Delegate) newServicesCCIPCommit(ctx context.Context, lggr logger.Sugare
 	oracleArgsNoPlugin := libocr2.OCR2OracleArgs{
 		BinaryNetworkEndpointFactory: d.peerWrapper.Peer2,
 		V2Bootstrappers:              bootstrapPeers,
-		ContractTransmitter:          dstProvider.ContractTransmitter(),
+		ContractTransmitter:          d.drainGate.ContractTransmitter(dstProvider.ContractTransmitter()),
 		ContractConfigTracker:        dstProvider.ContractConfigTracker(),
 		Database:                     ocrDB,
 		LocalConfig:                  lc,
@@ -1632,7 +1765,8 @@ func (d *Delegate) newServicesCCIPCommit(ctx context.Context, lggr logger.Sugare
 		MetricsRegisterer:      prometheus.WrapRegistererWith(map[string]string{"job_name": jb.Name.ValueOrZero()}, prometheus.DefaultRegisterer),
 	}
 
-	return ccipcommit.NewCommitServices(ctx, d.ds, srcProvider, dstProvider, d.legacyChains, jb, lggr, d.pipelineRunner, oracleArgsNoPlugin, d.isNewlyCreatedJob, int64(srcChainID), dstChainID, logError)
+	cleanupGate := maintenance.NewScheduler(d.cfg.Maintenance()).Gate(maintenance.CCIPPriceCleanup)
+	return ccipcommit.NewCommitServices(ctx, d.ds, d.cfg, d.cfg.Database().URL(), srcProvider, dstProvider, d.legacyChains, jb, lggr, d.pipelineRunner, oracleArgsNoPlugin, d.isNewlyCreatedJob, int64(srcChainID), dstChainID, cleanupGate, d.cfg.CCIP(), logError)
 }
 
 func newCCIPCommitPluginBytes(isSourceProvider bool, sourceStartBlock uint64, destStartBlock uint64) config.CommitPluginConfig {
@@ -1655,7 +1789,9 @@ func (d *Delegate) ccipCommitGetDstProvider(ctx context.Context, jb job.Job, plu
 	}
 
 	// Write PluginConfig bytes to send source/dest relayer provider + info outside of top level rargs/pargs over the wire
-	dstConfigBytes, err := newCCIPCommitPluginBytes(false, pluginJobSpecConfig.SourceStartBlock, pluginJobSpecConfig.DestStartBlock).Encode()
+	dstConfig := newCCIPCommitPluginBytes(false, pluginJobSpecConfig.SourceStartBlock, pluginJobSpecConfig.DestStartBlock)
+	dstConfig.PriceUpdateFilter = pluginJobSpecConfig.PriceUpdateFilter
+	dstConfigBytes, err := dstConfig.Encode()
 	if err != nil {
 		return nil, err
 	}
@@ -1784,7 +1920,7 @@ func (d *Delegate) newServicesCCIPExecution(ctx context.Context, lggr logger.Sug
 	oracleArgsNoPlugin2 := libocr2.OCR2OracleArgs{
 		BinaryNetworkEndpointFactory: d.peerWrapper.Peer2,
 		V2Bootstrappers:              bootstrapPeers,
-		ContractTransmitter:          dstProvider.ContractTransmitter(),
+		ContractTransmitter:          d.drainGate.ContractTransmitter(dstProvider.ContractTransmitter()),
 		ContractConfigTracker:        dstProvider.ContractConfigTracker(),
 		Database:                     ocrDB,
 		LocalConfig:                  lc,
@@ -1800,7 +1936,7 @@ func (d *Delegate) newServicesCCIPExecution(ctx context.Context, lggr logger.Sug
 		MetricsRegisterer:      prometheus.WrapRegistererWith(map[string]string{"job_name": jb.Name.ValueOrZero()}, prometheus.DefaultRegisterer),
 	}
 
-	return ccipexec.NewExecServices(ctx, lggr, jb, srcProvider, dstProvider, int64(srcChainID), dstChainID, d.isNewlyCreatedJob, oracleArgsNoPlugin2, logError)
+	return ccipexec.NewExecServices(ctx, d.ds, lggr, d.cfg, jb, srcProvider, dstProvider, int64(srcChainID), dstChainID, d.isNewlyCreatedJob, oracleArgsNoPlugin2, logError)
 }
 
 func (d *Delegate) ccipExecGetDstProvider(ctx context.Context, jb job.Job, pluginJobSpecConfig ccipconfig.ExecPluginJobSpecConfig, transmitterID string) (types.CCIPExecProvider, error) {
@@ -0,0 +1,81 @@
+package addrcodec
+
+import (
+	"fmt"
+	"sync"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
+)
+
+// Codec converts the addresses of a chain family between their raw bytes and their canonical string format.
+type Codec interface {
+	// Decode parses addr and returns its raw bytes.
+	Decode(addr cciptypes.Address) ([]byte, error)
+	// Encode renders raw address bytes in the canonical format of the chain family.
+	Encode(raw []byte) (cciptypes.Address, error)
+}
+
+var (
+	mu     sync.RWMutex
+	codecs = map[string]Codec{
+		relay.NetworkEVM:    EVM,
+		relay.NetworkSolana: Solana,
+		relay.NetworkAptos:  Aptos,
+	}
+)
+
+// Register sets the Codec of a chain family, replacing any previous one.
+func Register(family string, codec Codec) {
+	mu.Lock()
+	defer mu.Unlock()
+	codecs[family] = codec
+}
+
+// Unregister removes the Codec of a chain family.
+func Unregister(family string) {
+	mu.Lock()
+	defer mu.Unlock()
+	delete(codecs, family)
+}
+
+// ForFamily returns the Codec of a chain family, as named by the relay of a job.
+func ForFamily(family string) (Codec, error) {
+	mu.RLock()
+	defer mu.RUnlock()
+	codec, ok := codecs[family]
+	if !ok {
+		return nil, fmt.Errorf("no address codec for chain family %q", family)
+	}
+	return codec, nil
+}
+
+// Normalize returns the canonical format of addr, e.g. the EIP-55 checksummed format for EVM addresses, so that
+// addresses can be compared and used as map keys.
+func Normalize(codec Codec, addr cciptypes.Address) (cciptypes.Address, error) {
+	raw, err := codec.Decode(addr)
+	if err != nil {
+		return "", err
+	}
+	return codec.Encode(raw)
+}
+
+// NormalizeAll returns the canonical format of addrs.
+func NormalizeAll(codec Codec, addrs ...cciptypes.Address) ([]cciptypes.Address, error) {
+	res := make([]cciptypes.Address, 0, len(addrs))
+	for _, addr := range addrs {
+		normalized, err := Normalize(codec, addr)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, normalized)
+	}
+	return res, nil
+}
+
+// Validate returns an error if addr is not a valid address for codec.
+func Validate(codec Codec, addr cciptypes.Address) error {
+	_, err := codec.Decode(addr)
+	return err
+}
@@ -0,0 +1,63 @@
+package addrcodec_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/addrcodec"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
+)
+
+func TestNormalize(t *testing.T) {
+	testCases := []struct {
+		name   string
+		family string
+		addr   cciptypes.Address
+		exp    cciptypes.Address
+		expErr bool
+	}{
+		{name: "evm checksummed", family: relay.NetworkEVM, addr: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", exp: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"},
+		{name: "evm lowercase", family: relay.NetworkEVM, addr: "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", exp: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"},
+		{name: "evm too short", family: relay.NetworkEVM, addr: "0x1", expErr: true},
+		{name: "evm not hex", family: relay.NetworkEVM, addr: "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaez", expErr: true},
+		{name: "solana", family: relay.NetworkSolana, addr: "So11111111111111111111111111111111111111112", exp: "So11111111111111111111111111111111111111112"},
+		{name: "solana not base58", family: relay.NetworkSolana, addr: "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", expErr: true},
+		{name: "solana too short", family: relay.NetworkSolana, addr: "3yZe7d", expErr: true},
+		{name: "aptos special short", family: relay.NetworkAptos, addr: "0x1", exp: "0x1"},
+		{name: "aptos special long", family: relay.NetworkAptos, addr: "0x0000000000000000000000000000000000000000000000000000000000000001", exp: "0x1"},
+		{name: "aptos padded", family: relay.NetworkAptos, addr: "0xA550C18", exp: "0x000000000000000000000000000000000000000000000000000000000a550c18"},
+		{name: "aptos without prefix", family: relay.NetworkAptos, addr: "a550c18", expErr: true},
+		{name: "aptos too long", family: relay.NetworkAptos, addr: "0x10000000000000000000000000000000000000000000000000000000000000001", expErr: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			codec, err := addrcodec.ForFamily(tc.family)
+			require.NoError(t, err)
+
+			normalized, err := addrcodec.Normalize(codec, tc.addr)
+			if tc.expErr {
+				assert.Error(t, err)
+				assert.Error(t, addrcodec.Validate(codec, tc.addr))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.exp, normalized)
+			assert.NoError(t, addrcodec.Validate(codec, tc.addr))
+		})
+	}
+}
+
+func TestForFamily(t *testing.T) {
+	_, err := addrcodec.ForFamily("unknown")
+	assert.EqualError(t, err, `no address codec for chain family "unknown"`)
+
+	addrcodec.Register("unknown", addrcodec.EVM)
+	t.Cleanup(func() { addrcodec.Unregister("unknown") })
+	codec, err := addrcodec.ForFamily("unknown")
+	require.NoError(t, err)
+	assert.Equal(t, addrcodec.EVM, codec)
+}
@@ -0,0 +1,93 @@
+package addrcodec
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mr-tron/base58"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+)
+
+var (
+	// EVM addresses are 20 bytes, rendered as EIP-55 checksummed hex.
+	EVM Codec = evmCodec{}
+	// Solana addresses are 32 byte public keys, rendered in base58.
+	Solana Codec = solanaCodec{}
+	// Aptos addresses are 32 bytes, rendered as 0x prefixed lowercase hex. Special addresses 0x0 to 0xf are rendered
+	// in their short form, as defined by AIP-40.
+	Aptos Codec = aptosCodec{}
+)
+
+type evmCodec struct{}
+
+func (evmCodec) Decode(addr cciptypes.Address) ([]byte, error) {
+	if !common.IsHexAddress(string(addr)) {
+		return nil, fmt.Errorf("%q is not an evm address", addr)
+	}
+	return common.HexToAddress(string(addr)).Bytes(), nil
+}
+
+func (evmCodec) Encode(raw []byte) (cciptypes.Address, error) {
+	if len(raw) != common.AddressLength {
+		return "", fmt.Errorf("evm address must be %d bytes, got %d", common.AddressLength, len(raw))
+	}
+	return cciptypes.Address(common.BytesToAddress(raw).String()), nil
+}
+
+const solanaAddressLength = 32
+
+type solanaCodec struct{}
+
+func (solanaCodec) Decode(addr cciptypes.Address) ([]byte, error) {
+	raw, err := base58.Decode(string(addr))
+	if err != nil || len(raw) != solanaAddressLength {
+		return nil, fmt.Errorf("%q is not a solana address", addr)
+	}
+	return raw, nil
+}
+
+func (solanaCodec) Encode(raw []byte) (cciptypes.Address, error) {
+	if len(raw) != solanaAddressLength {
+		return "", fmt.Errorf("solana address must be %d bytes, got %d", solanaAddressLength, len(raw))
+	}
+	return cciptypes.Address(base58.Encode(raw)), nil
+}
+
+const aptosAddressLength = 32
+
+type aptosCodec struct{}
+
+func (aptosCodec) Decode(addr cciptypes.Address) ([]byte, error) {
+	s, ok := strings.CutPrefix(strings.ToLower(string(addr)), "0x")
+	if !ok || s == "" || len(s) > 2*aptosAddressLength {
+		return nil, fmt.Errorf("%q is not an aptos address", addr)
+	}
+	// short addresses are left padded with zeros
+	raw, err := hex.DecodeString(strings.Repeat("0", 2*aptosAddressLength-len(s)) + s)
+	if err != nil {
+		return nil, fmt.Errorf("%q is not an aptos address: %w", addr, err)
+	}
+	return raw, nil
+}
+
+func (aptosCodec) Encode(raw []byte) (cciptypes.Address, error) {
+	if len(raw) != aptosAddressLength {
+		return "", fmt.Errorf("aptos address must be %d bytes, got %d", aptosAddressLength, len(raw))
+	}
+	if isSpecialAptosAddress(raw) {
+		return cciptypes.Address(fmt.Sprintf("0x%x", raw[aptosAddressLength-1])), nil
+	}
+	return cciptypes.Address("0x" + hex.EncodeToString(raw)), nil
+}
+
+func isSpecialAptosAddress(raw []byte) bool {
+	for _, b := range raw[:aptosAddressLength-1] {
+		if b != 0 {
+			return false
+		}
+	}
+	return raw[aptosAddressLength-1] < 0x10
+}
@@ -133,6 +133,8 @@ func (rf *CommitReportingPluginFactory) NewReportingPluginFn(config types.Report
 			metricsCollector:        rf.config.metricsCollector,
 			chainHealthcheck:        rf.config.chainHealthcheck,
 			priceService:            rf.config.priceService,
+
+			priceReportSuppressionWindow: rf.config.priceReportSuppressionWindow,
 		}
 
 		pluginInfo := types.ReportingPluginInfo{
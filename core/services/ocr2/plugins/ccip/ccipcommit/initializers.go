@@ -4,7 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"math/big"
+	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -14,35 +15,46 @@ import (
 	"github.com/Masterminds/semver/v3"
 	"github.com/ethereum/go-ethereum/common"
 	libocr2 "github.com/smartcontractkit/libocr/offchainreporting2plus"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
 	"go.uber.org/multierr"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 
 	commonlogger "github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/loop"
 	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
 
 	cciporm "github.com/smartcontractkit/chainlink/v2/core/services/ccip"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/cache"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
 	db "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdb"
 
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink/v2/core/config/env"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/addrcodec"
 	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/ccipdataprovider"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/factory"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/observability"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/oraclelib"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/deadlinewrapper"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/promwrapper"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/transmitschedule"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/plugins"
 )
 
 var defaultNewReportingPluginRetryConfig = ccipdata.RetryConfig{InitialDelay: time.Second, MaxDelay: 5 * time.Minute}
 
-func NewCommitServices(ctx context.Context, ds sqlutil.DataSource, srcProvider commontypes.CCIPCommitProvider, dstProvider commontypes.CCIPCommitProvider, chainSet legacyevm.LegacyChainContainer, jb job.Job, lggr logger.Logger, pr pipeline.Runner, argsNoPlugin libocr2.OCR2OracleArgs, new bool, sourceChainID int64, destChainID int64, logError func(string)) ([]job.ServiceCtx, error) {
+func NewCommitServices(ctx context.Context, ds sqlutil.DataSource, rc plugins.RegistrarConfig, databaseURL url.URL, srcProvider commontypes.CCIPCommitProvider, dstProvider commontypes.CCIPCommitProvider, chainSet legacyevm.LegacyChainContainer, jb job.Job, lggr logger.Logger, pr pipeline.Runner, argsNoPlugin libocr2.OCR2OracleArgs, new bool, sourceChainID int64, destChainID int64, cleanupGate db.MaintenanceGate, priceIntervals db.UpdateIntervals, logError func(string)) ([]job.ServiceCtx, error) {
 	spec := jb.OCR2OracleSpec
 
 	var pluginConfig ccipconfig.CommitPluginJobSpecConfig
@@ -51,28 +63,17 @@ func NewCommitServices(ctx context.Context, ds sqlutil.DataSource, srcProvider c
 		return nil, err
 	}
 
-	commitStoreAddress := common.HexToAddress(spec.ContractID)
-
-	// commit store contract doesn't exist on the source chain, but we have an implementation of it
-	// to get access to a gas estimator on the source chain
-	srcCommitStore, err := srcProvider.NewCommitStoreReader(ctx, ccipcalc.EvmAddrToGeneric(commitStoreAddress))
+	commitStoreAddress := ccipcalc.EvmAddrToGeneric(common.HexToAddress(spec.ContractID))
+	destAddressCodec, err := addrcodec.ForFamily(spec.Relay)
 	if err != nil {
 		return nil, err
 	}
-
-	dstCommitStore, err := dstProvider.NewCommitStoreReader(ctx, ccipcalc.EvmAddrToGeneric(commitStoreAddress))
-	if err != nil {
-		return nil, err
-	}
-
-	var commitStoreReader ccipdata.CommitStoreReader
-	commitStoreReader = ccip.NewProviderProxyCommitStoreReader(srcCommitStore, dstCommitStore)
 	commitLggr := lggr.Named("CCIPCommit").With("sourceChain", sourceChainID, "destChain", destChainID)
 
 	var priceGetter pricegetter.AllTokensPriceGetter
 	withPipeline := strings.Trim(pluginConfig.TokenPricesUSDPipeline, "\n\t ") != ""
 	if withPipeline {
-		priceGetter, err = pricegetter.NewPipelineGetter(pluginConfig.TokenPricesUSDPipeline, pr, jb.ID, jb.ExternalJobID, jb.Name.ValueOrZero(), lggr)
+		priceGetter, err = pricegetter.NewPipelineGetter(pluginConfig.TokenPricesUSDPipeline, pr, jb.ID, jb.ExternalJobID, jb.Name.ValueOrZero(), lggr, destAddressCodec)
 		if err != nil {
 			return nil, fmt.Errorf("creating pipeline price getter: %w", err)
 		}
@@ -94,7 +95,7 @@ func NewCommitServices(ctx context.Context, ds sqlutil.DataSource, srcProvider c
 			}
 			caller := rpclib.NewDynamicLimitedBatchCaller(
 				lggr,
-				chain.Client(),
+				evmclient.NewRequestSourceClient(chain.Client(), commonclient.RequestSourceCCIP),
 				rpclib.DefaultRpcBatchSizeLimit,
 				rpclib.DefaultRpcBatchBackOffMultiplier,
 				rpclib.DefaultMaxParallelRpcCalls,
@@ -108,108 +109,222 @@ func NewCommitServices(ctx context.Context, ds sqlutil.DataSource, srcProvider c
 		}
 	}
 
-	offRampReader, err := dstProvider.NewOffRampReader(ctx, pluginConfig.OffRamp)
+	readers, err := newCommitReaders(ctx, commitStoreAddress, pluginConfig.OffRamp, srcProvider, dstProvider, sourceChainID, destChainID)
+	if err != nil {
+		return nil, err
+	}
+	if pluginConfig.QuoteCurrency != nil {
+		priceGetter, err = pricegetter.NewQuoteCurrencyPriceGetter(priceGetter, *pluginConfig.QuoteCurrency, readers.sourceNative, destAddressCodec)
+		if err != nil {
+			return nil, fmt.Errorf("creating quote currency price getter: %w", err)
+		}
+	}
+
+	orm, err := cciporm.NewObservedORM(ds, lggr)
 	if err != nil {
 		return nil, err
 	}
 
-	staticConfig, err := commitStoreReader.GetCommitStoreStaticConfig(ctx)
+	// The price service writes the prices from the node, even if the plugin runs as a LOOP, since its price getters
+	// depend on the pipeline runner and the chains of the node.
+	priceService := db.NewPriceService(
+		lggr,
+		orm,
+		jb.ID,
+		readers.staticConfig.ChainSelector,
+		readers.staticConfig.SourceChainSelector,
+		readers.sourceNative,
+		priceGetter,
+		readers.offRamp,
+		destAddressCodec,
+		cleanupGate,
+		priceIntervals,
+	)
+
+	var wrappedPluginFactory ocrtypes.ReportingPluginFactory
+	var srvs []job.ServiceCtx
+	if cmdName := env.CCIPCommitPlugin.Cmd.Get(); cmdName != "" {
+		// use unique logger names so we can use it to register a loop
+		loopLggr := lggr.Named("CCIPCommit").Named(spec.ContractID).Named(spec.GetID())
+		envVars, err2 := plugins.ParseEnvFile(env.CCIPCommitPlugin.Env.Get())
+		if err2 != nil {
+			return nil, fmt.Errorf("failed to parse ccip commit env file: %w", err2)
+		}
+		cfg, err2 := json.Marshal(loopConfig{
+			CommitStoreAddress: commitStoreAddress,
+			SourceChainID:      sourceChainID,
+			DestChainID:        destChainID,
+			PluginConfig:       pluginConfig,
+		})
+		if err2 != nil {
+			return nil, err2
+		}
+		envVars = append(envVars, string(LOOPConfigEnv)+"="+string(cfg), string(env.DatabaseURL)+"="+databaseURL.String())
+		cmdFn, grpcOpts, err2 := rc.RegisterLOOP(plugins.CmdConfig{
+			ID:  loopLggr.Name(),
+			Cmd: cmdName,
+			Env: envVars,
+		})
+		if err2 != nil {
+			return nil, fmt.Errorf("failed to register loop: %w", err2)
+		}
+		commitService := loop.NewCommitService(loopLggr, grpcOpts, cmdFn, newLaneProvider(srcProvider, dstProvider))
+		wrappedPluginFactory = newPriceConfigFactory(commitService, readers.commitStore, ccip.NewChainAgnosticPriceRegistry(dstProvider), priceService)
+		srvs = append(srvs, commitService)
+	} else {
+		factory, factorySrvs := newCommitPluginFactory(lggr, readers, pluginConfig, ccip.NewChainAgnosticPriceRegistry(dstProvider), priceService, sourceChainID, destChainID)
+		wrappedPluginFactory = factory
+		srvs = append(srvs, factorySrvs...)
+	}
+
+	promFactory := promwrapper.NewPromFactory(wrappedPluginFactory, "CCIPCommit", jb.OCR2OracleSpec.Relay, strconv.FormatInt(destChainID, 10), jb.ID)
+	deadlineFactory := deadlinewrapper.NewDeadlineFactory(promFactory, commitLggr, "CCIPCommit", jb.OCR2OracleSpec.Relay, strconv.FormatInt(destChainID, 10),
+		jb.OCR2OracleSpec.ObservationTimeout.Duration(), jb.OCR2OracleSpec.ReportTimeout.Duration())
+	scheduleCfg, err := transmitschedule.ParseConfig(jb.OCR2OracleSpec.TransmissionSchedule)
+	if err != nil {
+		return nil, err
+	}
+	scheduleFactory := transmitschedule.NewScheduleFactory(deadlineFactory, commitLggr, scheduleCfg, "CCIPCommit", jb.OCR2OracleSpec.Relay, strconv.FormatInt(destChainID, 10))
+	argsNoPlugin.ReportingPluginFactory = ccip.NewCommitLaneStatusFactory(scheduleFactory, readers.staticConfig.SourceChainSelector, readers.staticConfig.ChainSelector)
+	var oracleHealth *job.OracleService
+	argsNoPlugin.Logger = commonlogger.NewOCRWrapper(commitLggr, true, func(msg string) {
+		logError(msg)
+		oracleHealth.RecordError(msg)
+	})
+	oracle, err := libocr2.NewOracle(argsNoPlugin)
 	if err != nil {
 		return nil, err
 	}
+	var oracleService job.ServiceCtx = job.NewServiceAdapter(oracle)
+	if jb.OCR2OracleSpec.Standby {
+		oracleService = job.NewStandbyService(commitLggr, oracleService)
+	}
+	// If this is a brand-new job, then we make use of the start blocks. If not then we're rebooting and log poller will pick up where we left off.
+	if new {
+		oracleService = oraclelib.NewChainAgnosticBackFilledOracle(
+			lggr,
+			srcProvider,
+			dstProvider,
+			oracleService,
+		)
+	}
+	oracleHealth = job.NewOracleService(fmt.Sprintf("OCR2.%d.CCIPCommit", jb.ID), oracleService)
+	rootVerifier := NewRootVerifier(commitLggr, readers.onRamp, readers.commitStore, sourceChainID, destChainID, readers.onRampAddress)
+	return append([]job.ServiceCtx{
+		oracleHealth,
+		priceService,
+		rootVerifier,
+	}, srvs...), nil
+}
+
+// commitReaders are the readers of the commit plugin, created either in-process or in the LOOP.
+type commitReaders struct {
+	commitStoreAddress cciptypes.Address
+	offRampAddress     cciptypes.Address
+	onRampAddress      cciptypes.Address
+	staticConfig       cciptypes.CommitStoreStaticConfig
+	sourceNative       cciptypes.Address
+
+	onRamp      ccipdata.OnRampReader
+	commitStore ccipdata.CommitStoreReader
+	offRamp     ccipdata.OffRampReader
+}
+
+func newCommitReaders(ctx context.Context, commitStoreAddress cciptypes.Address, offRampAddress cciptypes.Address, srcProvider commontypes.CCIPCommitProvider, dstProvider commontypes.CCIPCommitProvider, sourceChainID int64, destChainID int64) (commitReaders, error) {
+	// commit store contract doesn't exist on the source chain, but we have an implementation of it
+	// to get access to a gas estimator on the source chain
+	srcCommitStore, err := srcProvider.NewCommitStoreReader(ctx, commitStoreAddress)
+	if err != nil {
+		return commitReaders{}, err
+	}
+
+	dstCommitStore, err := dstProvider.NewCommitStoreReader(ctx, commitStoreAddress)
+	if err != nil {
+		return commitReaders{}, err
+	}
+
+	var commitStoreReader ccipdata.CommitStoreReader = ccip.NewProviderProxyCommitStoreReader(srcCommitStore, dstCommitStore)
+
+	offRampReader, err := dstProvider.NewOffRampReader(ctx, offRampAddress)
+	if err != nil {
+		return commitReaders{}, err
+	}
+
+	staticConfig, err := commitStoreReader.GetCommitStoreStaticConfig(ctx)
+	if err != nil {
+		return commitReaders{}, err
+	}
 	onRampAddress := staticConfig.OnRamp
 
 	onRampReader, err := srcProvider.NewOnRampReader(ctx, onRampAddress, staticConfig.SourceChainSelector, staticConfig.ChainSelector)
 	if err != nil {
-		return nil, err
+		return commitReaders{}, err
 	}
 
 	onRampRouterAddr, err := onRampReader.RouterAddress(ctx)
 	if err != nil {
-		return nil, err
+		return commitReaders{}, err
 	}
 	sourceNative, err := srcProvider.SourceNativeToken(ctx, onRampRouterAddr)
 	if err != nil {
-		return nil, err
+		return commitReaders{}, err
 	}
+
 	// Prom wrappers
-	onRampReader = observability.NewObservedOnRampReader(onRampReader, sourceChainID, ccip.CommitPluginLabel)
-	commitStoreReader = observability.NewObservedCommitStoreReader(commitStoreReader, destChainID, ccip.CommitPluginLabel)
-	offRampReader = observability.NewObservedOffRampReader(offRampReader, destChainID, ccip.CommitPluginLabel)
-	metricsCollector := ccip.NewPluginMetricsCollector(ccip.CommitPluginLabel, sourceChainID, destChainID)
+	return commitReaders{
+		commitStoreAddress: commitStoreAddress,
+		offRampAddress:     offRampAddress,
+		onRampAddress:      onRampAddress,
+		staticConfig:       staticConfig,
+		sourceNative:       sourceNative,
+		onRamp:             observability.NewObservedOnRampReader(onRampReader, sourceChainID, ccip.CommitPluginLabel),
+		commitStore:        observability.NewObservedCommitStoreReader(commitStoreReader, destChainID, ccip.CommitPluginLabel),
+		offRamp:            observability.NewObservedOffRampReader(offRampReader, destChainID, ccip.CommitPluginLabel),
+	}, nil
+}
 
+// newCommitPluginFactory creates the commit reporting plugin factory from the readers, along with the services it
+// depends on, so that it can be created either in-process or out-of-process as a LOOP.
+func newCommitPluginFactory(lggr logger.Logger, readers commitReaders, pluginConfig ccipconfig.CommitPluginJobSpecConfig, priceRegistryProvider ccipdataprovider.PriceRegistry, priceService db.PriceService, sourceChainID int64, destChainID int64) (*CommitReportingPluginFactory, []job.ServiceCtx) {
 	chainHealthCheck := cache.NewObservedChainHealthCheck(
 		cache.NewChainHealthcheck(
 			// Adding more details to Logger to make healthcheck logs more informative
 			// It's safe because healthcheck logs only in case of unhealthy state
 			lggr.With(
-				"onramp", onRampAddress,
-				"commitStore", commitStoreAddress,
-				"offramp", pluginConfig.OffRamp,
+				"onramp", readers.onRampAddress,
+				"commitStore", readers.commitStoreAddress,
+				"offramp", readers.offRampAddress,
 			),
-			onRampReader,
-			commitStoreReader,
+			readers.onRamp,
+			readers.commitStore,
 		),
 		ccip.CommitPluginLabel,
 		sourceChainID, // assuming this is the chain id?
 		destChainID,
-		onRampAddress,
+		readers.onRampAddress,
 	)
 
-	orm, err := cciporm.NewObservedORM(ds, lggr)
-	if err != nil {
-		return nil, err
+	var priceReportSuppressionWindow time.Duration
+	if pluginConfig.PriceReportSuppressionWindow != nil {
+		priceReportSuppressionWindow = pluginConfig.PriceReportSuppressionWindow.Duration()
 	}
 
-	priceService := db.NewPriceService(
-		lggr,
-		orm,
-		jb.ID,
-		staticConfig.ChainSelector,
-		staticConfig.SourceChainSelector,
-		sourceNative,
-		priceGetter,
-		offRampReader,
-	)
-
-	wrappedPluginFactory := NewCommitReportingPluginFactory(CommitPluginStaticConfig{
+	factory := NewCommitReportingPluginFactory(CommitPluginStaticConfig{
 		lggr:                          lggr,
 		newReportingPluginRetryConfig: defaultNewReportingPluginRetryConfig,
-		onRampReader:                  onRampReader,
-		sourceChainSelector:           staticConfig.SourceChainSelector,
-		sourceNative:                  sourceNative,
-		offRamp:                       offRampReader,
-		commitStore:                   commitStoreReader,
-		destChainSelector:             staticConfig.ChainSelector,
-		priceRegistryProvider:         ccip.NewChainAgnosticPriceRegistry(dstProvider),
-		metricsCollector:              metricsCollector,
+		onRampReader:                  readers.onRamp,
+		sourceChainSelector:           readers.staticConfig.SourceChainSelector,
+		sourceNative:                  readers.sourceNative,
+		offRamp:                       readers.offRamp,
+		commitStore:                   readers.commitStore,
+		destChainSelector:             readers.staticConfig.ChainSelector,
+		priceRegistryProvider:         priceRegistryProvider,
+		metricsCollector:              ccip.NewPluginMetricsCollector(ccip.CommitPluginLabel, sourceChainID, destChainID),
 		chainHealthcheck:              chainHealthCheck,
 		priceService:                  priceService,
+		priceReportSuppressionWindow:  priceReportSuppressionWindow,
 	})
-	argsNoPlugin.ReportingPluginFactory = promwrapper.NewPromFactory(wrappedPluginFactory, "CCIPCommit", jb.OCR2OracleSpec.Relay, big.NewInt(0).SetInt64(destChainID))
-	argsNoPlugin.Logger = commonlogger.NewOCRWrapper(commitLggr, true, logError)
-	oracle, err := libocr2.NewOracle(argsNoPlugin)
-	if err != nil {
-		return nil, err
-	}
-	// If this is a brand-new job, then we make use of the start blocks. If not then we're rebooting and log poller will pick up where we left off.
-	if new {
-		return []job.ServiceCtx{
-			oraclelib.NewChainAgnosticBackFilledOracle(
-				lggr,
-				srcProvider,
-				dstProvider,
-				job.NewServiceAdapter(oracle),
-			),
-			chainHealthCheck,
-			priceService,
-		}, nil
-	}
-	return []job.ServiceCtx{
-		job.NewServiceAdapter(oracle),
-		chainHealthCheck,
-		priceService,
-	}, nil
+	return factory, []job.ServiceCtx{chainHealthCheck}
 }
 
 func CommitReportToEthTxMeta(typ ccipconfig.ContractType, ver semver.Version) (func(report []byte) (*txmgr.TxMeta, error), error) {
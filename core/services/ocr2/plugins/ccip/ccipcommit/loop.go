@@ -0,0 +1,196 @@
+package ccipcommit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config/env"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	cciporm "github.com/smartcontractkit/chainlink/v2/core/services/ccip"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip"
+	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcommon"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/ccipdataprovider"
+	db "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdb"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/store/dialects"
+)
+
+// LOOPConfigEnv passes the config of the job to the commit plugin running as a LOOP, since
+// [commontypes.CCIPCommitFactoryGenerator] only carries the provider.
+var LOOPConfigEnv = env.Var("CL_CCIPCOMMIT_LOOP_CONFIG")
+
+type loopConfig struct {
+	CommitStoreAddress cciptypes.Address                    `json:"commitStoreAddress"`
+	SourceChainID      int64                                `json:"sourceChainID"`
+	DestChainID        int64                                `json:"destChainID"`
+	PluginConfig       ccipconfig.CommitPluginJobSpecConfig `json:"pluginConfig"`
+}
+
+var _ commontypes.CCIPCommitFactoryGenerator = (*FactoryGenerator)(nil)
+
+// FactoryGenerator creates commit reporting plugin factories within a LOOP. The provider, and so all the readers of
+// the plugin, are served by the node over gRPC, while the prices written by the price service of the node are read
+// from the DB.
+type FactoryGenerator struct {
+	lggr logger.Logger
+}
+
+func NewFactoryGenerator(lggr logger.Logger) *FactoryGenerator {
+	return &FactoryGenerator{lggr: lggr}
+}
+
+func (g *FactoryGenerator) NewCommitFactory(ctx context.Context, provider commontypes.CCIPCommitProvider) (commontypes.ReportingPluginFactory, error) {
+	var cfg loopConfig
+	if err := json.Unmarshal([]byte(LOOPConfigEnv.Get()), &cfg); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", LOOPConfigEnv, err)
+	}
+	databaseURL := env.DatabaseURL.Get()
+	if databaseURL == "" {
+		return nil, fmt.Errorf("%s is not set", env.DatabaseURL)
+	}
+
+	ds, err := pg.NewConnection(string(databaseURL), dialects.Postgres, loopDBConfig{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the database: %w", err)
+	}
+	srvs := []job.ServiceCtx{dbCloser{ds}}
+	orm, err := cciporm.NewORM(ds, g.lggr)
+	if err != nil {
+		return nil, err
+	}
+	priceReader := db.NewPriceReader(orm)
+	srvs = append(srvs, priceReader)
+
+	// the provider serves the readers of both chains, see laneProvider
+	readers, err := newCommitReaders(ctx, cfg.CommitStoreAddress, cfg.PluginConfig.OffRamp, provider, provider, cfg.SourceChainID, cfg.DestChainID)
+	if err != nil {
+		return nil, err
+	}
+	factory, factorySrvs := newCommitPluginFactory(g.lggr, readers, cfg.PluginConfig, ccip.NewChainAgnosticPriceRegistry(provider), priceReader, cfg.SourceChainID, cfg.DestChainID)
+	return ccipcommon.NewFactoryService(g.lggr.Named("CCIPCommitFactory"), factory, append(srvs, factorySrvs...)), nil
+}
+
+// loopDBConfig configures the connection of the LOOP to the DB, which is only used to read the prices once per round.
+type loopDBConfig struct{}
+
+func (loopDBConfig) DefaultIdleInTxSessionTimeout() time.Duration { return time.Hour }
+func (loopDBConfig) DefaultLockTimeout() time.Duration            { return 15 * time.Second }
+func (loopDBConfig) MaxOpenConns() int                            { return 2 }
+func (loopDBConfig) MaxIdleConns() int                            { return 1 }
+
+// dbCloser closes the connection of the LOOP to the DB along with the factory.
+type dbCloser struct {
+	db *sqlx.DB
+}
+
+func (dbCloser) Start(context.Context) error { return nil }
+
+func (c dbCloser) Close() error { return c.db.Close() }
+
+// laneProvider is the provider of the commit plugin running as a LOOP, which takes a single provider. It serves the
+// readers of the source chain from the source provider, and the others from the dest provider.
+type laneProvider struct {
+	commontypes.CCIPCommitProvider
+	src commontypes.CCIPCommitProvider
+}
+
+var _ commontypes.CCIPCommitProvider = (*laneProvider)(nil)
+
+func newLaneProvider(src, dst commontypes.CCIPCommitProvider) *laneProvider {
+	return &laneProvider{CCIPCommitProvider: dst, src: src}
+}
+
+// NewCommitStoreReader combines the commit store reader of the source provider, which estimates the gas prices of the
+// source chain, with the one of the dest provider.
+func (p *laneProvider) NewCommitStoreReader(ctx context.Context, addr cciptypes.Address) (cciptypes.CommitStoreReader, error) {
+	src, err := p.src.NewCommitStoreReader(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	dst, err := p.CCIPCommitProvider.NewCommitStoreReader(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	return ccip.NewProviderProxyCommitStoreReader(src, dst), nil
+}
+
+func (p *laneProvider) NewOnRampReader(ctx context.Context, addr cciptypes.Address, sourceSelector uint64, destSelector uint64) (cciptypes.OnRampReader, error) {
+	return p.src.NewOnRampReader(ctx, addr, sourceSelector, destSelector)
+}
+
+func (p *laneProvider) SourceNativeToken(ctx context.Context, addr cciptypes.Address) (cciptypes.Address, error) {
+	return p.src.SourceNativeToken(ctx, addr)
+}
+
+// priceConfigFactory keeps the price service of the node up to date with the config of the commit plugin running as a
+// LOOP, as the CommitReportingPluginFactory does in-process, before creating the plugin in the LOOP.
+type priceConfigFactory struct {
+	ocrtypes.ReportingPluginFactory
+	commitStore           ccipdata.CommitStoreReader
+	priceRegistryProvider ccipdataprovider.PriceRegistry
+	priceService          db.PriceService
+
+	mu                 sync.Mutex
+	destPriceRegAddr   cciptypes.Address
+	destPriceRegReader ccipdata.PriceRegistryReader
+}
+
+func newPriceConfigFactory(factory ocrtypes.ReportingPluginFactory, commitStore ccipdata.CommitStoreReader, priceRegistryProvider ccipdataprovider.PriceRegistry, priceService db.PriceService) *priceConfigFactory {
+	return &priceConfigFactory{
+		ReportingPluginFactory: factory,
+		commitStore:            commitStore,
+		priceRegistryProvider:  priceRegistryProvider,
+		priceService:           priceService,
+	}
+}
+
+func (f *priceConfigFactory) NewReportingPlugin(config ocrtypes.ReportingPluginConfig) (ocrtypes.ReportingPlugin, ocrtypes.ReportingPluginInfo, error) {
+	_, err := ccipcommon.RetryUntilSuccess(func() (struct{}, error) {
+		return struct{}{}, f.updatePriceConfig(config)
+	}, defaultNewReportingPluginRetryConfig.InitialDelay, defaultNewReportingPluginRetryConfig.MaxDelay)
+	if err != nil {
+		return nil, ocrtypes.ReportingPluginInfo{}, err
+	}
+	return f.ReportingPluginFactory.NewReportingPlugin(config)
+}
+
+func (f *priceConfigFactory) updatePriceConfig(config ocrtypes.ReportingPluginConfig) error {
+	ctx := context.Background()
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	destPriceRegAddr, err := f.commitStore.ChangeConfig(ctx, config.OnchainConfig, config.OffchainConfig)
+	if err != nil {
+		return err
+	}
+	if f.destPriceRegReader == nil || destPriceRegAddr != f.destPriceRegAddr {
+		if f.destPriceRegReader != nil {
+			if err = f.destPriceRegReader.Close(); err != nil {
+				return err
+			}
+			f.destPriceRegReader = nil
+		}
+		f.destPriceRegReader, err = f.priceRegistryProvider.NewPriceRegistryReader(ctx, destPriceRegAddr)
+		if err != nil {
+			return fmt.Errorf("init dynamic price registry: %w", err)
+		}
+		f.destPriceRegAddr = destPriceRegAddr
+	}
+	gasPriceEstimator, err := f.commitStore.GasPriceEstimator(ctx)
+	if err != nil {
+		return err
+	}
+	return f.priceService.UpdateDynamicConfig(ctx, gasPriceEstimator, f.destPriceRegReader)
+}
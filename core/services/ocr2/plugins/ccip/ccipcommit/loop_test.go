@@ -0,0 +1,69 @@
+package ccipcommit
+
+import (
+	"testing"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	ccipdataprovidermocks "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/ccipdataprovider/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/mocks"
+	dbMocks "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdb/mocks"
+)
+
+func TestFactoryGenerator_NewCommitFactory(t *testing.T) {
+	g := NewFactoryGenerator(logger.TestLogger(t))
+
+	t.Run("missing config", func(t *testing.T) {
+		t.Setenv(string(LOOPConfigEnv), "")
+		_, err := g.NewCommitFactory(testutils.Context(t), nil)
+		require.ErrorContains(t, err, "invalid CL_CCIPCOMMIT_LOOP_CONFIG")
+	})
+
+	t.Run("missing database url", func(t *testing.T) {
+		t.Setenv(string(LOOPConfigEnv), `{"commitStoreAddress":"0x7c6e4F0BDe29f83BC394B75a7f313B7E5DbD2d77"}`)
+		t.Setenv("CL_DATABASE_URL", "")
+		_, err := g.NewCommitFactory(testutils.Context(t), nil)
+		require.ErrorContains(t, err, "CL_DATABASE_URL is not set")
+	})
+}
+
+type stubFactory struct {
+	newPluginCalls int
+}
+
+func (f *stubFactory) NewReportingPlugin(types.ReportingPluginConfig) (types.ReportingPlugin, types.ReportingPluginInfo, error) {
+	f.newPluginCalls++
+	return nil, types.ReportingPluginInfo{}, nil
+}
+
+// Assert that the price service of the node is updated with the config of the commit plugin running as a LOOP before
+// the plugin is created, and that the price registry reader is only recreated when its address changes.
+func TestPriceConfigFactory_NewReportingPlugin(t *testing.T) {
+	addr := ccip.Address("0x7c6e4F0BDe29f83BC394B75a7f313B7E5DbD2d77")
+
+	commitStore := mocks.NewCommitStoreReader(t)
+	commitStore.On("ChangeConfig", mock.Anything, mock.Anything, mock.Anything).Return(addr, nil).Twice()
+	commitStore.On("GasPriceEstimator", mock.Anything).Return(nil, nil).Twice()
+
+	priceRegReader := mocks.NewPriceRegistryReader(t)
+	priceRegistryProvider := ccipdataprovidermocks.NewPriceRegistry(t)
+	priceRegistryProvider.On("NewPriceRegistryReader", mock.Anything, addr).Return(priceRegReader, nil).Once()
+
+	priceService := dbMocks.NewPriceService(t)
+	priceService.On("UpdateDynamicConfig", mock.Anything, mock.Anything, priceRegReader).Return(nil).Twice()
+
+	inner := &stubFactory{}
+	factory := newPriceConfigFactory(inner, commitStore, priceRegistryProvider, priceService)
+
+	for i := 1; i <= 2; i++ {
+		_, _, err := factory.NewReportingPlugin(types.ReportingPluginConfig{OnchainConfig: []byte{1}, OffchainConfig: []byte{1}})
+		require.NoError(t, err)
+		require.Equal(t, i, inner.newPluginCalls)
+	}
+}
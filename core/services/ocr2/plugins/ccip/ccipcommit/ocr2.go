@@ -10,6 +10,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/hashutil"
 	"github.com/smartcontractkit/chainlink-common/pkg/merklemulti"
@@ -25,6 +26,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/ccipdataprovider"
 	db "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdb"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/prices"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/promwrapper"
 )
 
 const (
@@ -42,6 +44,7 @@ const (
 var (
 	_ types.ReportingPluginFactory = &CommitReportingPluginFactory{}
 	_ types.ReportingPlugin        = &CommitReportingPlugin{}
+	_ promwrapper.MetricsReporter  = &CommitReportingPlugin{}
 )
 
 type update struct {
@@ -65,6 +68,8 @@ type CommitPluginStaticConfig struct {
 	metricsCollector ccip.PluginMetricsCollector
 	chainHealthcheck cache.ChainHealthcheck
 	priceService     db.PriceService
+	// priceReportSuppressionWindow is the PriceReportSuppressionWindow of the job spec, zero if unset.
+	priceReportSuppressionWindow time.Duration
 }
 
 type CommitReportingPlugin struct {
@@ -87,6 +92,31 @@ type CommitReportingPlugin struct {
 	chainHealthcheck cache.ChainHealthcheck
 	// DB
 	priceService db.PriceService
+	// priceReportSuppressionWindow suppresses the price-only reports without due heartbeats, disabled if zero.
+	priceReportSuppressionWindow time.Duration
+	// Metrics registered by the promwrapper, nil until then
+	reportedRoots        prometheus.Counter
+	reportedPriceUpdates prometheus.Counter
+}
+
+// RegisterMetrics registers the number of merkle roots and price updates of the reports built.
+func (r *CommitReportingPlugin) RegisterMetrics(m *promwrapper.PluginMetrics) (err error) {
+	r.reportedRoots, err = m.NewCounter("ccip_commit_reported_merkle_roots", "The number of merkle roots of the commit reports built")
+	if err != nil {
+		return err
+	}
+	r.reportedPriceUpdates, err = m.NewCounter("ccip_commit_reported_price_updates", "The number of gas and token price updates of the commit reports built")
+	return err
+}
+
+func (r *CommitReportingPlugin) observeReport(report cciptypes.CommitStoreReport) {
+	if r.reportedRoots == nil {
+		return
+	}
+	if report.Interval.Max != 0 {
+		r.reportedRoots.Inc()
+	}
+	r.reportedPriceUpdates.Add(float64(len(report.GasPrices) + len(report.TokenPrices)))
 }
 
 // Query is not used by the CCIP Commit plugin.
@@ -287,7 +317,7 @@ func (r *CommitReportingPlugin) Report(ctx context.Context, epochAndRound types.
 		return false, nil, err
 	}
 
-	gasPrices, tokenPrices, err := r.selectPriceUpdates(ctx, now, gasPriceObs, tokenPriceObs)
+	gasPrices, tokenPrices, err := r.selectPriceUpdates(ctx, lggr, now, agreedInterval.Max > 0, gasPriceObs, tokenPriceObs)
 	if err != nil {
 		return false, nil, err
 	}
@@ -307,6 +337,7 @@ func (r *CommitReportingPlugin) Report(ctx context.Context, epochAndRound types.
 	}
 	r.metricsCollector.SequenceNumber(ccip.Report, report.Interval.Max)
 	r.metricsCollector.NumberOfMessagesBasedOnInterval(ccip.Report, report.Interval.Min, report.Interval.Max)
+	r.observeReport(report)
 	lggr.Infow("Report",
 		"merkleRoot", hex.EncodeToString(report.MerkleRoot[:]),
 		"minSeqNr", report.Interval.Min,
@@ -436,8 +467,9 @@ func extractObservationData(lggr logger.Logger, f int, sourceChainSelector uint6
 	return intervals, gasPrices, tokenPrices, nil
 }
 
-// selectPriceUpdates filters out gas and token price updates that are already inflight
-func (r *CommitReportingPlugin) selectPriceUpdates(ctx context.Context, now time.Time, gasPriceObs map[uint64][]*big.Int, tokenPriceObs map[cciptypes.Address][]*big.Int) ([]cciptypes.GasPrice, []cciptypes.TokenPrice, error) {
+// selectPriceUpdates filters out gas and token price updates that are already inflight.
+// Without new messages, the price updates are suppressed within the price report suppression window.
+func (r *CommitReportingPlugin) selectPriceUpdates(ctx context.Context, lggr logger.Logger, now time.Time, hasNewMessages bool, gasPriceObs map[uint64][]*big.Int, tokenPriceObs map[cciptypes.Address][]*big.Int) ([]cciptypes.GasPrice, []cciptypes.TokenPrice, error) {
 	// If price reporting is disabled, there is no need to select price updates.
 	if r.offchainConfig.PriceReportingDisabled {
 		return nil, nil, nil
@@ -453,7 +485,46 @@ func (r *CommitReportingPlugin) selectPriceUpdates(ctx context.Context, now time
 		return nil, nil, err
 	}
 
-	return r.calculatePriceUpdates(gasPriceObs, tokenPriceObs, latestGasPrice, latestTokenPrices)
+	gasPrices, tokenPrices, err := r.calculatePriceUpdates(gasPriceObs, tokenPriceObs, latestGasPrice, latestTokenPrices)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !hasNewMessages && r.isPriceReportSuppressed(now, gasPrices, tokenPrices, latestGasPrice, latestTokenPrices) {
+		lggr.Infow("Price-only report within the suppression window, skipping the price updates",
+			"suppressionWindow", r.priceReportSuppressionWindow, "gasPriceUpdates", gasPrices, "tokenPriceUpdates", tokenPrices)
+		return nil, nil, nil
+	}
+	return gasPrices, tokenPrices, nil
+}
+
+// isPriceReportSuppressed returns true if the latest price update is within the suppression window, and none of the
+// price updates is due to the gas or token price heartbeats, i.e. they are all due to price deviations.
+func (r *CommitReportingPlugin) isPriceReportSuppressed(now time.Time, gasPrices []cciptypes.GasPrice, tokenPrices []cciptypes.TokenPrice, latestGasPrice map[uint64]update, latestTokenPrices map[cciptypes.Address]update) bool {
+	if r.priceReportSuppressionWindow == 0 || (len(gasPrices) == 0 && len(tokenPrices) == 0) {
+		return false
+	}
+
+	var lastUpdate time.Time
+	for _, gasPrice := range gasPrices {
+		latest, exists := latestGasPrice[gasPrice.DestChainSelector]
+		if !exists || now.Sub(latest.timestamp) >= r.offchainConfig.GasPriceHeartBeat {
+			return false
+		}
+		if latest.timestamp.After(lastUpdate) {
+			lastUpdate = latest.timestamp
+		}
+	}
+	for _, tokenPrice := range tokenPrices {
+		latest, exists := latestTokenPrices[tokenPrice.Token]
+		if !exists || now.Sub(latest.timestamp) >= r.offchainConfig.TokenPriceHeartBeat {
+			return false
+		}
+		if latest.timestamp.After(lastUpdate) {
+			lastUpdate = latest.timestamp
+		}
+	}
+	return now.Sub(lastUpdate) < r.priceReportSuppressionWindow
 }
 
 // Note priceUpdates must be deterministic.
@@ -1442,6 +1442,88 @@ func TestCommitReportingPlugin_calculatePriceUpdates(t *testing.T) {
 	}
 }
 
+func TestCommitReportingPlugin_isPriceReportSuppressed(t *testing.T) {
+	const sourceChainSelector = 10
+	token := ccipcalc.HexToAddress("0xa")
+	now := time.Now()
+
+	gasUpdates := []cciptypes.GasPrice{{DestChainSelector: sourceChainSelector, Value: big.NewInt(2)}}
+	tokenUpdates := []cciptypes.TokenPrice{{Token: token, Value: big.NewInt(2)}}
+
+	testCases := []struct {
+		name              string
+		window            time.Duration
+		gasUpdates        []cciptypes.GasPrice
+		tokenUpdates      []cciptypes.TokenPrice
+		latestGasPrice    map[uint64]update
+		latestTokenPrices map[cciptypes.Address]update
+		expSuppressed     bool
+	}{
+		{
+			name:           "disabled",
+			gasUpdates:     gasUpdates,
+			latestGasPrice: map[uint64]update{sourceChainSelector: {timestamp: now.Add(-time.Minute)}},
+		},
+		{
+			name:   "no updates",
+			window: 10 * time.Minute,
+		},
+		{
+			name:              "deviations within the window",
+			window:            10 * time.Minute,
+			gasUpdates:        gasUpdates,
+			tokenUpdates:      tokenUpdates,
+			latestGasPrice:    map[uint64]update{sourceChainSelector: {timestamp: now.Add(-20 * time.Minute)}},
+			latestTokenPrices: map[cciptypes.Address]update{token: {timestamp: now.Add(-time.Minute)}},
+			expSuppressed:     true,
+		},
+		{
+			name:              "deviations after the window",
+			window:            10 * time.Minute,
+			gasUpdates:        gasUpdates,
+			tokenUpdates:      tokenUpdates,
+			latestGasPrice:    map[uint64]update{sourceChainSelector: {timestamp: now.Add(-20 * time.Minute)}},
+			latestTokenPrices: map[cciptypes.Address]update{token: {timestamp: now.Add(-15 * time.Minute)}},
+		},
+		{
+			name:              "gas price heartbeat",
+			window:            10 * time.Minute,
+			gasUpdates:        gasUpdates,
+			latestGasPrice:    map[uint64]update{sourceChainSelector: {timestamp: now.Add(-time.Hour)}},
+			latestTokenPrices: map[cciptypes.Address]update{token: {timestamp: now.Add(-time.Minute)}},
+		},
+		{
+			name:              "token price heartbeat",
+			window:            10 * time.Minute,
+			gasUpdates:        gasUpdates,
+			tokenUpdates:      tokenUpdates,
+			latestGasPrice:    map[uint64]update{sourceChainSelector: {timestamp: now.Add(-time.Minute)}},
+			latestTokenPrices: map[cciptypes.Address]update{token: {timestamp: now.Add(-3 * time.Hour)}},
+		},
+		{
+			name:           "first token price",
+			window:         10 * time.Minute,
+			gasUpdates:     gasUpdates,
+			tokenUpdates:   tokenUpdates,
+			latestGasPrice: map[uint64]update{sourceChainSelector: {timestamp: now.Add(-time.Minute)}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &CommitReportingPlugin{
+				offchainConfig: cciptypes.CommitOffchainConfig{
+					GasPriceHeartBeat:   time.Hour,
+					TokenPriceHeartBeat: 2 * time.Hour,
+				},
+				priceReportSuppressionWindow: tc.window,
+			}
+			got := r.isPriceReportSuppressed(now, tc.gasUpdates, tc.tokenUpdates, tc.latestGasPrice, tc.latestTokenPrices)
+			assert.Equal(t, tc.expSuppressed, got)
+		})
+	}
+}
+
 func TestCommitReportingPlugin_isStaleReport(t *testing.T) {
 	ctx := context.Background()
 	lggr := logger.TestLogger(t)
@@ -0,0 +1,89 @@
+package ccipcommit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/semver/v3"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	commonlogger "github.com/smartcontractkit/chainlink-common/pkg/logger"
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/factory"
+)
+
+// SimulatingTransmitter is the transmitter of the commit reports to the CommitStore, which can simulate their
+// transmission.
+type SimulatingTransmitter interface {
+	ocrtypes.ContractTransmitter
+	// Simulate calls the transmit method of the CommitStore with the report without sending a transaction, and returns
+	// the error of the call if it reverts.
+	Simulate(ctx context.Context, reportCtx ocrtypes.ReportContext, report ocrtypes.Report, signatures []ocrtypes.AttributedOnchainSignature) error
+}
+
+var _ ocrtypes.ContractTransmitter = (*priceUpdateTransmitter)(nil)
+
+// priceUpdateTransmitter transmits the commit reports without a merkle root, which only update prices, to the
+// CommitStore only if they change the PriceRegistry, and after simulating their transmission. The simulation runs the
+// checks of the CommitStore, i.e. the signatures of the report, the curse of the lane and the staleness of the prices,
+// so that a report which would revert isn't paid for. The gas and token prices of a report are updated in a single
+// transaction, as for any commit report. The other reports are transmitted as they are.
+type priceUpdateTransmitter struct {
+	SimulatingTransmitter
+	lggr         commonlogger.Logger
+	filter       ccipdata.PriceUpdateFilter
+	decodeReport func(report []byte) (cciptypes.CommitStoreReport, error)
+}
+
+// NewPriceUpdateTransmitter wraps the transmitter of the commit reports of a CommitStore to filter the price-only
+// reports with the filter and simulate their transmission.
+func NewPriceUpdateTransmitter(
+	lggr commonlogger.Logger,
+	transmitter SimulatingTransmitter,
+	filter ccipdata.PriceUpdateFilter,
+	typ ccipconfig.ContractType,
+	ver semver.Version,
+) (ocrtypes.ContractTransmitter, error) {
+	if ver.LessThan(semver.MustParse(ccipdata.V1_2_0)) {
+		return nil, fmt.Errorf("filtering the price updates is not supported by CommitStore %s", ver.String())
+	}
+	decodeReport, err := factory.CommitReportDecoder(typ, ver)
+	if err != nil {
+		return nil, err
+	}
+	return &priceUpdateTransmitter{
+		SimulatingTransmitter: transmitter,
+		lggr:                  commonlogger.Named(lggr, "PriceUpdateTransmitter"),
+		filter:                filter,
+		decodeReport:          decodeReport,
+	}, nil
+}
+
+func (t *priceUpdateTransmitter) Transmit(ctx context.Context, reportCtx ocrtypes.ReportContext, report ocrtypes.Report, signatures []ocrtypes.AttributedOnchainSignature) error {
+	commitReport, err := t.decodeReport(report)
+	if err != nil {
+		return fmt.Errorf("decode commit report: %w", err)
+	}
+	if commitReport.MerkleRoot != [32]byte{} {
+		return t.SimulatingTransmitter.Transmit(ctx, reportCtx, report, signatures)
+	}
+
+	hasUpdates, err := t.filter.HasUpdates(ctx, commitReport.GasPrices, commitReport.TokenPrices)
+	if err != nil {
+		// the report is transmitted anyway, the CommitStore checking its prices
+		t.lggr.Warnw("Failed to check the prices against the PriceRegistry, transmitting the price-only report",
+			"epoch", reportCtx.Epoch, "round", reportCtx.Round, "err", err)
+	} else if !hasUpdates {
+		t.lggr.Infow("Skipped the price-only report, its prices are already in the PriceRegistry",
+			"epoch", reportCtx.Epoch, "round", reportCtx.Round)
+		return nil
+	}
+
+	if err = t.Simulate(ctx, reportCtx, report, signatures); err != nil {
+		return fmt.Errorf("simulate the transmission of the price-only report: %w", err)
+	}
+	return t.SimulatingTransmitter.Transmit(ctx, reportCtx, report, signatures)
+}
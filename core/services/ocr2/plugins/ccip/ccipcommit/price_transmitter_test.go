@@ -0,0 +1,115 @@
+package ccipcommit
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+)
+
+type fakeContractTransmitter struct {
+	types.ContractTransmitter
+	simulationErr error
+	simulated     []types.Report
+	transmitted   []types.Report
+}
+
+func (t *fakeContractTransmitter) Simulate(_ context.Context, _ types.ReportContext, report types.Report, _ []types.AttributedOnchainSignature) error {
+	t.simulated = append(t.simulated, report)
+	return t.simulationErr
+}
+
+func (t *fakeContractTransmitter) Transmit(_ context.Context, _ types.ReportContext, report types.Report, _ []types.AttributedOnchainSignature) error {
+	t.transmitted = append(t.transmitted, report)
+	return nil
+}
+
+type fakePriceUpdateFilter struct {
+	hasUpdates bool
+	err        error
+	checks     int
+}
+
+func (f *fakePriceUpdateFilter) HasUpdates(context.Context, []cciptypes.GasPrice, []cciptypes.TokenPrice) (bool, error) {
+	f.checks++
+	return f.hasUpdates, f.err
+}
+
+func TestPriceUpdateTransmitter_Transmit(t *testing.T) {
+	ctx := testutils.Context(t)
+	prices := cciptypes.CommitStoreReport{
+		GasPrices:   []cciptypes.GasPrice{{DestChainSelector: 1, Value: big.NewInt(100)}},
+		TokenPrices: []cciptypes.TokenPrice{{Token: ccipcalc.HexToAddress("0x1"), Value: big.NewInt(10)}},
+	}
+	priceOnlyReport, err := encodeCommitReport(prices)
+	require.NoError(t, err)
+	rootReport := prices
+	rootReport.MerkleRoot = [32]byte{1}
+	rootReport.Interval = cciptypes.CommitStoreInterval{Min: 1, Max: 2}
+	merkleRootReport, err := encodeCommitReport(rootReport)
+	require.NoError(t, err)
+
+	newTransmitter := func(t *testing.T, filter *fakePriceUpdateFilter, simulationErr error) (types.ContractTransmitter, *fakeContractTransmitter) {
+		contractTransmitter := &fakeContractTransmitter{simulationErr: simulationErr}
+		transmitter, err := NewPriceUpdateTransmitter(logger.TestLogger(t), contractTransmitter, filter, ccipconfig.CommitStore, *semver.MustParse("1.5.0"))
+		require.NoError(t, err)
+		return transmitter, contractTransmitter
+	}
+
+	tests := []struct {
+		name              string
+		report            []byte
+		filter            *fakePriceUpdateFilter
+		simulationErr     error
+		expectedChecks    int
+		expectSimulated   bool
+		expectTransmitted bool
+		expectedErr       string
+	}{
+		{"price-only report with updates is transmitted", priceOnlyReport, &fakePriceUpdateFilter{hasUpdates: true}, nil, 1, true, true, ""},
+		{"price-only report already in the PriceRegistry is skipped", priceOnlyReport, &fakePriceUpdateFilter{}, nil, 1, false, false, ""},
+		{"price-only report failing to be checked is transmitted", priceOnlyReport, &fakePriceUpdateFilter{err: errors.New("get the token prices: timeout")}, nil, 1, true, true, ""},
+		{"price-only report failing the simulation isn't transmitted", priceOnlyReport, &fakePriceUpdateFilter{hasUpdates: true}, errors.New("execution reverted: CursedByRMN"), 1, true, false, "CursedByRMN"},
+		{"merkle root report is transmitted", merkleRootReport, &fakePriceUpdateFilter{}, nil, 0, false, true, ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			transmitter, contractTransmitter := newTransmitter(t, tc.filter, tc.simulationErr)
+			err := transmitter.Transmit(ctx, types.ReportContext{}, tc.report, nil)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectedChecks, tc.filter.checks)
+			if tc.expectSimulated {
+				assert.Equal(t, []types.Report{tc.report}, contractTransmitter.simulated)
+			} else {
+				assert.Empty(t, contractTransmitter.simulated)
+			}
+			if tc.expectTransmitted {
+				assert.Equal(t, []types.Report{tc.report}, contractTransmitter.transmitted)
+			} else {
+				assert.Empty(t, contractTransmitter.transmitted)
+			}
+		})
+	}
+
+	t.Run("unsupported commit store version", func(t *testing.T) {
+		_, err := NewPriceUpdateTransmitter(logger.TestLogger(t), &fakeContractTransmitter{}, &fakePriceUpdateFilter{}, ccipconfig.CommitStore, *semver.MustParse("1.0.0"))
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,177 @@
+package ccipcommit
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/hashutil"
+	"github.com/smartcontractkit/chainlink-common/pkg/merklemulti"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+var (
+	commitRootsVerified = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ccip_commit_root_verifications_total",
+		Help: "Number of merkle roots of accepted commit reports recomputed from the OnRamp logs",
+	}, []string{"source", "dest", "onramp"})
+	commitRootMismatches = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ccip_commit_root_mismatches_total",
+		Help: "Number of accepted commit reports whose merkle root differs from the one recomputed from the OnRamp logs",
+	}, []string{"source", "dest", "onramp"})
+)
+
+const (
+	rootVerificationInterval = 5 * time.Minute
+	// rootVerificationLookback bounds how far back accepted reports are verified after a start.
+	rootVerificationLookback = 24 * time.Hour
+)
+
+var _ job.ServiceCtx = (*RootVerifier)(nil)
+
+// RootVerifier recomputes the merkle roots of the commit reports accepted on chain from the finalized OnRamp logs, and
+// reports mismatches with a critical log and the ccip_commit_root_mismatches_total metric. It is a defense in depth
+// check, independent of the RMN.
+type RootVerifier struct {
+	lggr              logger.Logger
+	onRampReader      ccipdata.OnRampReader
+	commitStoreReader ccipdata.CommitStoreReader
+	interval          time.Duration
+
+	// verifiedSince is the block timestamp of the last verified report, and verifiedSeqNr the max sequence number of
+	// its interval. Reports are verified in order, so that those whose messages are not finalized yet are retried.
+	verifiedSince time.Time
+	verifiedSeqNr uint64
+
+	verified   prometheus.Counter
+	mismatches prometheus.Counter
+
+	services.StateMachine
+	wg               sync.WaitGroup
+	backgroundCtx    context.Context //nolint:containedctx
+	backgroundCancel context.CancelFunc
+}
+
+func NewRootVerifier(
+	lggr logger.Logger,
+	onRampReader ccipdata.OnRampReader,
+	commitStoreReader ccipdata.CommitStoreReader,
+	sourceChainID int64,
+	destChainID int64,
+	onRampAddress cciptypes.Address,
+) *RootVerifier {
+	ctx, cancel := context.WithCancel(context.Background())
+	labels := []string{strconv.FormatInt(sourceChainID, 10), strconv.FormatInt(destChainID, 10), string(onRampAddress)}
+	return &RootVerifier{
+		lggr:              lggr.Named("RootVerifier"),
+		onRampReader:      onRampReader,
+		commitStoreReader: commitStoreReader,
+		interval:          rootVerificationInterval,
+		verifiedSince:     time.Now().Add(-rootVerificationLookback),
+		verified:          commitRootsVerified.WithLabelValues(labels...),
+		mismatches:        commitRootMismatches.WithLabelValues(labels...),
+		backgroundCtx:     ctx,
+		backgroundCancel:  cancel,
+	}
+}
+
+func (v *RootVerifier) Start(context.Context) error {
+	return v.StateMachine.StartOnce("RootVerifier", func() error {
+		v.lggr.Info("Starting RootVerifier")
+		v.wg.Add(1)
+		go v.run()
+		return nil
+	})
+}
+
+func (v *RootVerifier) Close() error {
+	return v.StateMachine.StopOnce("RootVerifier", func() error {
+		v.lggr.Info("Closing RootVerifier")
+		v.backgroundCancel()
+		v.wg.Wait()
+		return nil
+	})
+}
+
+func (v *RootVerifier) run() {
+	defer v.wg.Done()
+	ticker := time.NewTicker(utils.WithJitter(v.interval))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-v.backgroundCtx.Done():
+			return
+		case <-ticker.C:
+			if err := v.verify(v.backgroundCtx); err != nil {
+				v.lggr.Errorw("Failed to verify commit roots", "err", err)
+			}
+		}
+	}
+}
+
+// verify checks the reports accepted since the last verified one.
+func (v *RootVerifier) verify(ctx context.Context) error {
+	reports, err := v.commitStoreReader.GetAcceptedCommitReportsGteTimestamp(ctx, v.verifiedSince, 0)
+	if err != nil {
+		return fmt.Errorf("get accepted commit reports: %w", err)
+	}
+	sort.Slice(reports, func(i, j int) bool {
+		return reports[i].CommitStoreReport.Interval.Min < reports[j].CommitStoreReport.Interval.Min
+	})
+
+	for _, report := range reports {
+		interval := report.CommitStoreReport.Interval
+		// reports with only price updates have no root
+		if interval.Min == 0 || interval.Max <= v.verifiedSeqNr {
+			continue
+		}
+		sendRequests, err := v.onRampReader.GetSendRequestsBetweenSeqNums(ctx, interval.Min, interval.Max, true)
+		if err != nil {
+			return fmt.Errorf("get send requests in [%d, %d]: %w", interval.Min, interval.Max, err)
+		}
+		leaves := make([][32]byte, 0, len(sendRequests))
+		seqNrs := make([]uint64, 0, len(sendRequests))
+		for _, req := range sendRequests {
+			leaves = append(leaves, req.Hash)
+			seqNrs = append(seqNrs, req.SequenceNumber)
+		}
+		if !ccipcalc.ContiguousReqs(v.lggr, interval.Min, interval.Max, seqNrs) {
+			// the messages may not be finalized on the source chain yet
+			v.lggr.Debugw("Messages of the commit report are not finalized yet, retrying later",
+				"minSeqNr", interval.Min, "maxSeqNr", interval.Max, "messages", len(sendRequests))
+			return nil
+		}
+		tree, err := merklemulti.NewTree(hashutil.NewKeccak(), leaves)
+		if err != nil {
+			return fmt.Errorf("build tree of [%d, %d]: %w", interval.Min, interval.Max, err)
+		}
+
+		v.verified.Inc()
+		if root := tree.Root(); root != report.CommitStoreReport.MerkleRoot {
+			v.mismatches.Inc()
+			v.lggr.Criticalw("Merkle root of accepted commit report does not match the OnRamp messages",
+				"minSeqNr", interval.Min,
+				"maxSeqNr", interval.Max,
+				"onchainRoot", cciptypes.Hash(report.CommitStoreReport.MerkleRoot).String(),
+				"recomputedRoot", cciptypes.Hash(root).String(),
+				"txHash", report.TxHash,
+			)
+		}
+		v.verifiedSeqNr = interval.Max
+		v.verifiedSince = time.UnixMilli(report.BlockTimestampUnixMilli)
+	}
+	return nil
+}
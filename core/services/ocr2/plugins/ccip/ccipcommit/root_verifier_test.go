@@ -0,0 +1,137 @@
+package ccipcommit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/hashutil"
+	"github.com/smartcontractkit/chainlink-common/pkg/merklemulti"
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+	ccipdatamocks "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/mocks"
+)
+
+func TestRootVerifier_verify(t *testing.T) {
+	sendReqs := func(min, max uint64) []cciptypes.EVM2EVMMessageWithTxMeta {
+		var reqs []cciptypes.EVM2EVMMessageWithTxMeta
+		for seqNr := min; seqNr <= max; seqNr++ {
+			reqs = append(reqs, cciptypes.EVM2EVMMessageWithTxMeta{
+				EVM2EVMMessage: cciptypes.EVM2EVMMessage{SequenceNumber: seqNr, Hash: cciptypes.Hash{byte(seqNr)}},
+			})
+		}
+		return reqs
+	}
+	rootOf := func(reqs []cciptypes.EVM2EVMMessageWithTxMeta) [32]byte {
+		leaves := make([][32]byte, 0, len(reqs))
+		for _, req := range reqs {
+			leaves = append(leaves, req.Hash)
+		}
+		tree, err := merklemulti.NewTree(hashutil.NewKeccak(), leaves)
+		require.NoError(t, err)
+		return tree.Root()
+	}
+	report := func(min, max uint64, root [32]byte, ts int64) cciptypes.CommitStoreReportWithTxMeta {
+		return cciptypes.CommitStoreReportWithTxMeta{
+			TxMeta: cciptypes.TxMeta{BlockTimestampUnixMilli: ts, TxHash: "0xabc"},
+			CommitStoreReport: cciptypes.CommitStoreReport{
+				Interval:   cciptypes.CommitStoreInterval{Min: min, Max: max},
+				MerkleRoot: root,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		reports       []cciptypes.CommitStoreReportWithTxMeta
+		sendReqs      map[uint64][]cciptypes.EVM2EVMMessageWithTxMeta
+		expVerified   float64
+		expMismatches float64
+		expSeqNr      uint64
+	}{
+		{
+			name:        "matching roots",
+			reports:     []cciptypes.CommitStoreReportWithTxMeta{report(4, 6, rootOf(sendReqs(4, 6)), 2000), report(1, 3, rootOf(sendReqs(1, 3)), 1000)},
+			sendReqs:    map[uint64][]cciptypes.EVM2EVMMessageWithTxMeta{1: sendReqs(1, 3), 4: sendReqs(4, 6)},
+			expVerified: 2,
+			expSeqNr:    6,
+		},
+		{
+			name:          "mismatching root",
+			reports:       []cciptypes.CommitStoreReportWithTxMeta{report(1, 3, rootOf(sendReqs(1, 2)), 1000)},
+			sendReqs:      map[uint64][]cciptypes.EVM2EVMMessageWithTxMeta{1: sendReqs(1, 3)},
+			expVerified:   1,
+			expMismatches: 1,
+			expSeqNr:      3,
+		},
+		{
+			name:     "messages not finalized yet",
+			reports:  []cciptypes.CommitStoreReportWithTxMeta{report(1, 3, rootOf(sendReqs(1, 3)), 1000), report(4, 6, rootOf(sendReqs(4, 6)), 2000)},
+			sendReqs: map[uint64][]cciptypes.EVM2EVMMessageWithTxMeta{1: sendReqs(1, 2)},
+		},
+		{
+			name:    "price updates only",
+			reports: []cciptypes.CommitStoreReportWithTxMeta{report(0, 0, [32]byte{}, 1000)},
+		},
+	}
+
+	ctx := testutils.Context(t)
+	for i, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			commitStoreReader := ccipdatamocks.NewCommitStoreReader(t)
+			commitStoreReader.On("GetAcceptedCommitReportsGteTimestamp", ctx, mock.Anything, 0).Return(tc.reports, nil)
+			onRampReader := ccipdatamocks.NewOnRampReader(t)
+			for _, r := range tc.reports {
+				if reqs, ok := tc.sendReqs[r.Interval.Min]; ok {
+					onRampReader.On("GetSendRequestsBetweenSeqNums", ctx, r.Interval.Min, r.Interval.Max, true).Return(reqs, nil)
+				}
+			}
+
+			v := NewRootVerifier(logger.TestLogger(t), onRampReader, commitStoreReader, int64(i), 1, ccipcalc.HexToAddress("1000"))
+			since := v.verifiedSince
+			require.NoError(t, v.verify(ctx))
+
+			assert.Equal(t, tc.expVerified, testutil.ToFloat64(v.verified))
+			assert.Equal(t, tc.expMismatches, testutil.ToFloat64(v.mismatches))
+			assert.Equal(t, tc.expSeqNr, v.verifiedSeqNr)
+			if tc.expSeqNr == 0 {
+				assert.Equal(t, since, v.verifiedSince)
+			} else {
+				assert.NotEqual(t, since, v.verifiedSince)
+			}
+		})
+	}
+}
+
+func TestRootVerifier_verify_resumes(t *testing.T) {
+	ctx := testutils.Context(t)
+	reqs := []cciptypes.EVM2EVMMessageWithTxMeta{{EVM2EVMMessage: cciptypes.EVM2EVMMessage{SequenceNumber: 1, Hash: cciptypes.Hash{1}}}}
+	tree, err := merklemulti.NewTree(hashutil.NewKeccak(), [][32]byte{reqs[0].Hash})
+	require.NoError(t, err)
+	report := cciptypes.CommitStoreReportWithTxMeta{
+		TxMeta: cciptypes.TxMeta{BlockTimestampUnixMilli: 1000},
+		CommitStoreReport: cciptypes.CommitStoreReport{
+			Interval:   cciptypes.CommitStoreInterval{Min: 1, Max: 1},
+			MerkleRoot: tree.Root(),
+		},
+	}
+
+	commitStoreReader := ccipdatamocks.NewCommitStoreReader(t)
+	commitStoreReader.On("GetAcceptedCommitReportsGteTimestamp", ctx, mock.Anything, 0).Return([]cciptypes.CommitStoreReportWithTxMeta{report}, nil).Once()
+	commitStoreReader.On("GetAcceptedCommitReportsGteTimestamp", ctx, time.UnixMilli(1000), 0).Return([]cciptypes.CommitStoreReportWithTxMeta{report}, nil).Once()
+	onRampReader := ccipdatamocks.NewOnRampReader(t)
+	// the already verified report must not be fetched again
+	onRampReader.On("GetSendRequestsBetweenSeqNums", ctx, uint64(1), uint64(1), true).Return(reqs, nil).Once()
+
+	v := NewRootVerifier(logger.TestLogger(t), onRampReader, commitStoreReader, 100, 1, ccipcalc.HexToAddress("1000"))
+	require.NoError(t, v.verify(ctx))
+	require.NoError(t, v.verify(ctx))
+	assert.Equal(t, float64(1), testutil.ToFloat64(v.verified))
+}
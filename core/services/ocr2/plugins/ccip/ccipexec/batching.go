@@ -18,6 +18,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/abihelpers"
+	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/prices"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/tokendata"
@@ -43,6 +44,8 @@ type BatchContext struct {
 	gasPriceEstimator          prices.GasPriceEstimatorExec
 	destWrappedNative          cciptypes.Address
 	offchainConfig             cciptypes.ExecOffchainConfig
+	// feeBooster boosts the fees of waiting messages, waitBoostedFee is used if nil.
+	feeBooster feeBooster
 }
 
 type BatchingStrategy interface {
@@ -55,19 +58,68 @@ type ZKOverflowBatchingStrategy struct {
 	statuschecker statuschecker.CCIPTransactionStatusChecker
 }
 
-func NewBatchingStrategy(batchingStrategyID uint32, statusChecker statuschecker.CCIPTransactionStatusChecker) (BatchingStrategy, error) {
-	var batchingStrategy BatchingStrategy
-	switch batchingStrategyID {
-	case 0:
-		batchingStrategy = &BestEffortBatchingStrategy{}
-	case 1:
-		batchingStrategy = &ZKOverflowBatchingStrategy{
-			statuschecker: statusChecker,
-		}
-	default:
+type CostMinimizingBatchingStrategy struct {
+	base         BatchingStrategy
+	minBatchSize int
+	maxWait      time.Duration
+	// now is the clock the wait of the messages is measured with.
+	now func() time.Time
+}
+
+// BatchingStrategyDeps are the dependencies of the plugin available to the batching strategies.
+type BatchingStrategyDeps struct {
+	StatusChecker statuschecker.CCIPTransactionStatusChecker
+}
+
+// BatchingStrategyFactory creates the batching strategy of a BatchingStrategyID of the offchain config.
+type BatchingStrategyFactory func(deps BatchingStrategyDeps) (BatchingStrategy, error)
+
+// batchingStrategyFactories are the batching strategies by the BatchingStrategyID of the offchain config.
+var batchingStrategyFactories = map[uint32]BatchingStrategyFactory{
+	0: func(BatchingStrategyDeps) (BatchingStrategy, error) {
+		return &BestEffortBatchingStrategy{}, nil
+	},
+	1: func(deps BatchingStrategyDeps) (BatchingStrategy, error) {
+		return &ZKOverflowBatchingStrategy{statuschecker: deps.StatusChecker}, nil
+	},
+}
+
+// RegisterBatchingStrategy registers a batching strategy, so that the offchain config can select it by its
+// BatchingStrategyID. It is meant to be called from init functions, and panics if a strategy is already registered
+// with the ID.
+func RegisterBatchingStrategy(batchingStrategyID uint32, factory BatchingStrategyFactory) {
+	if _, ok := batchingStrategyFactories[batchingStrategyID]; ok {
+		panic(fmt.Sprintf("batching strategy ID %d is already registered", batchingStrategyID))
+	}
+	batchingStrategyFactories[batchingStrategyID] = factory
+}
+
+// NewBatchingStrategy creates the batching strategy of the BatchingStrategyID of the offchain config. The batching
+// config of the job spec can only narrow it, by holding back its batches of less than MinBatchSize messages.
+func NewBatchingStrategy(batchingStrategyID uint32, cfg ccipconfig.BatchingConfig, statusChecker statuschecker.CCIPTransactionStatusChecker) (BatchingStrategy, error) {
+	factory, ok := batchingStrategyFactories[batchingStrategyID]
+	if !ok {
 		return nil, errors.Errorf("unknown batching strategy ID %d", batchingStrategyID)
 	}
-	return batchingStrategy, nil
+	strategy, err := factory(BatchingStrategyDeps{StatusChecker: statusChecker})
+	if err != nil {
+		return nil, err
+	}
+	if cfg == (ccipconfig.BatchingConfig{}) {
+		return strategy, nil
+	}
+	if err = cfg.Validate(); err != nil {
+		return nil, err
+	}
+	if _, ok := strategy.(*ZKOverflowBatchingStrategy); ok {
+		return nil, errors.New("MinBatchSize is not supported with the zk-overflow batching strategy, which executes messages one by one")
+	}
+	return &CostMinimizingBatchingStrategy{
+		base:         strategy,
+		minBatchSize: int(cfg.MinBatchSize),
+		maxWait:      time.Duration(cfg.MaxWaitSeconds) * time.Second,
+		now:          time.Now,
+	}, nil
 }
 
 // BestEffortBatchingStrategy is a batching strategy that tries to batch as many messages as possible (up to certain limits).
@@ -167,6 +219,45 @@ func (bs ZKOverflowBatchingStrategy) BuildBatch(
 	return batchBuilder.batch, batchBuilder.statuses
 }
 
+// CostMinimizingBatchingStrategy is a batching strategy that holds back the batches of the strategy of the offchain
+// config with less than minBatchSize messages, so that the fixed costs of an execution, like the verification of the
+// merkle proof, are shared among more messages. Smaller batches are executed once their oldest message has waited for
+// maxWait. It only ever executes a subset of the messages of the strategy of the offchain config.
+func (bs *CostMinimizingBatchingStrategy) BuildBatch(
+	ctx context.Context,
+	batchCtx *BatchContext,
+) ([]ccip.ObservedMessage, []messageExecStatus) {
+	batch, statuses := bs.base.BuildBatch(ctx, batchCtx)
+	if len(batch) == 0 || len(batch) >= bs.minBatchSize {
+		return batch, statuses
+	}
+
+	inBatch := mapset.NewSet[uint64]()
+	for _, msg := range batch {
+		inBatch.Add(msg.SeqNr)
+	}
+	var oldest time.Time
+	for _, msg := range batchCtx.report.sendRequestsWithMeta {
+		if inBatch.Contains(msg.SequenceNumber) && (oldest.IsZero() || msg.BlockTimestamp.Before(oldest)) {
+			oldest = msg.BlockTimestamp
+		}
+	}
+	if waited := bs.now().Sub(oldest); waited >= bs.maxWait {
+		batchCtx.lggr.Infow("Executing batch below the minimum size - max wait reached",
+			"batchSize", len(batch), "minBatchSize", bs.minBatchSize, "waited", waited)
+		return batch, statuses
+	}
+
+	batchCtx.lggr.Infow("Holding back batch below the minimum size",
+		"batchSize", len(batch), "minBatchSize", bs.minBatchSize, "oldestMessageTimestamp", oldest)
+	for i := range statuses {
+		if statuses[i].Status == AddedToBatch {
+			statuses[i].Status = BatchBelowMinSize
+		}
+	}
+	return []ccip.ObservedMessage{}, statuses
+}
+
 func performCommonChecks(
 	ctx context.Context,
 	batchCtx *BatchContext,
@@ -264,7 +355,15 @@ func performCommonChecks(
 
 	availableFee := big.NewInt(0).Mul(msg.FeeTokenAmount, sourceFeeTokenPrice)
 	availableFee = availableFee.Div(availableFee, big.NewInt(1e18))
-	availableFeeUsd := waitBoostedFee(time.Since(msg.BlockTimestamp), availableFee, batchCtx.offchainConfig.RelativeBoostPerWaitHour)
+	boostFee := batchCtx.feeBooster
+	if boostFee == nil {
+		boostFee = waitBoostedFee
+	}
+	availableFeeUsd := boostFee(time.Since(msg.BlockTimestamp), availableFee, batchCtx.offchainConfig.RelativeBoostPerWaitHour)
+	if availableFeeUsd == nil {
+		msgLggr.Errorw("Failed to boost the message fee", "availableFee", availableFee, "waitTime", time.Since(msg.BlockTimestamp))
+		return "", 0, nil, nil, errors.New("failed to boost the message fee")
+	}
 	if availableFeeUsd.Cmp(execCostUsd) < 0 {
 		msgLggr.Infow(
 			"Skipping message - insufficient remaining fee",
@@ -494,6 +593,7 @@ const (
 	TXMCheckError                        messageStatus = "txm_check_error"
 	TXMFatalStatus                       messageStatus = "txm_fatal_status"
 	SkippedInflight                      messageStatus = "skipped_inflight"
+	BatchBelowMinSize                    messageStatus = "batch_below_min_size"
 )
 
 func (m messageStatus) shouldBeSkipped() bool {
@@ -13,12 +13,14 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
 	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip"
+	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/prices"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/tokendata"
@@ -47,7 +49,7 @@ func Test_NewBatchingStrategy(t *testing.T) {
 	testCases := []int{0, 1, 2}
 
 	for _, batchingStrategyId := range testCases {
-		factory, err := NewBatchingStrategy(uint32(batchingStrategyId), mockStatusChecker)
+		factory, err := NewBatchingStrategy(uint32(batchingStrategyId), ccipconfig.BatchingConfig{}, mockStatusChecker)
 		if batchingStrategyId == 2 {
 			assert.Error(t, err)
 		} else {
@@ -55,6 +57,37 @@ func Test_NewBatchingStrategy(t *testing.T) {
 			assert.NoError(t, err)
 		}
 	}
+
+	t.Run("job spec narrows offchain config", func(t *testing.T) {
+		strategy, err := NewBatchingStrategy(0, ccipconfig.BatchingConfig{MinBatchSize: 5, MaxWaitSeconds: 60}, mockStatusChecker)
+		require.NoError(t, err)
+		require.IsType(t, &CostMinimizingBatchingStrategy{}, strategy)
+		costMinimizing := strategy.(*CostMinimizingBatchingStrategy)
+		assert.IsType(t, &BestEffortBatchingStrategy{}, costMinimizing.base)
+		assert.Equal(t, 5, costMinimizing.minBatchSize)
+		assert.Equal(t, time.Minute, costMinimizing.maxWait)
+
+		_, err = NewBatchingStrategy(1, ccipconfig.BatchingConfig{MinBatchSize: 5, MaxWaitSeconds: 60}, mockStatusChecker)
+		assert.EqualError(t, err, "MinBatchSize is not supported with the zk-overflow batching strategy, which executes messages one by one")
+		_, err = NewBatchingStrategy(0, ccipconfig.BatchingConfig{MinBatchSize: 5}, mockStatusChecker)
+		assert.EqualError(t, err, "MaxWaitSeconds is required with MinBatchSize")
+	})
+}
+
+// Test_RegisterBatchingStrategy isn't parallel, as it registers a strategy.
+func Test_RegisterBatchingStrategy(t *testing.T) {
+	RegisterBatchingStrategy(100, func(BatchingStrategyDeps) (BatchingStrategy, error) {
+		return &ZKOverflowBatchingStrategy{}, nil
+	})
+	t.Cleanup(func() { delete(batchingStrategyFactories, 100) })
+
+	strategy, err := NewBatchingStrategy(100, ccipconfig.BatchingConfig{}, nil)
+	require.NoError(t, err)
+	assert.IsType(t, &ZKOverflowBatchingStrategy{}, strategy)
+
+	assert.Panics(t, func() {
+		RegisterBatchingStrategy(0, nil)
+	})
 }
 
 func Test_validateSendRequests(t *testing.T) {
@@ -793,6 +826,73 @@ func TestBatchingStrategies(t *testing.T) {
 		}
 		runBatchingStrategyTests(t, strategy, 1_000_000, append(testCases, specificZkOverflowTestCases...))
 	})
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	recentMsg1 := createTestMessage(1, sender1, 1, srcNative, big.NewInt(1e9), false, nil)
+	recentMsg1.BlockTimestamp = now.Add(-time.Minute)
+	recentMsg2 := createTestMessage(2, sender1, 2, srcNative, big.NewInt(1e9), false, nil)
+	recentMsg2.BlockTimestamp = now.Add(-time.Minute)
+	oldMsg1 := createTestMessage(1, sender1, 1, srcNative, big.NewInt(1e9), false, nil)
+	oldMsg1.BlockTimestamp = now.Add(-time.Hour)
+
+	costMinimizingTestCases := []testCase{
+		{
+			name:                   "holds back a batch below the minimum size",
+			reqs:                   []cciptypes.EVM2EVMOnRampCCIPSendRequestedWithMeta{recentMsg1},
+			inflight:               []InflightInternalExecutionReport{},
+			inflightAggregateValue: big.NewInt(0),
+			tokenLimit:             big.NewInt(0),
+			destGasPrice:           big.NewInt(10),
+			srcPrices:              map[cciptypes.Address]*big.Int{srcNative: big.NewInt(1)},
+			dstPrices:              map[cciptypes.Address]*big.Int{destNative: big.NewInt(1)},
+			offRampNoncesBySender:  map[cciptypes.Address]uint64{sender1: 0},
+			expectedSeqNrs:         []ccip.ObservedMessage{},
+			expectedStates: []messageExecStatus{
+				newMessageExecState(recentMsg1.SequenceNumber, recentMsg1.MessageID, BatchBelowMinSize),
+			},
+		},
+		{
+			name:                   "executes a batch below the minimum size after the max wait",
+			reqs:                   []cciptypes.EVM2EVMOnRampCCIPSendRequestedWithMeta{oldMsg1},
+			inflight:               []InflightInternalExecutionReport{},
+			inflightAggregateValue: big.NewInt(0),
+			tokenLimit:             big.NewInt(0),
+			destGasPrice:           big.NewInt(10),
+			srcPrices:              map[cciptypes.Address]*big.Int{srcNative: big.NewInt(1)},
+			dstPrices:              map[cciptypes.Address]*big.Int{destNative: big.NewInt(1)},
+			offRampNoncesBySender:  map[cciptypes.Address]uint64{sender1: 0},
+			expectedSeqNrs:         []ccip.ObservedMessage{{SeqNr: uint64(1)}},
+			expectedStates: []messageExecStatus{
+				newMessageExecState(oldMsg1.SequenceNumber, oldMsg1.MessageID, AddedToBatch),
+			},
+		},
+		{
+			name:                   "executes a batch of the minimum size",
+			reqs:                   []cciptypes.EVM2EVMOnRampCCIPSendRequestedWithMeta{recentMsg1, recentMsg2},
+			inflight:               []InflightInternalExecutionReport{},
+			inflightAggregateValue: big.NewInt(0),
+			tokenLimit:             big.NewInt(0),
+			destGasPrice:           big.NewInt(10),
+			srcPrices:              map[cciptypes.Address]*big.Int{srcNative: big.NewInt(1)},
+			dstPrices:              map[cciptypes.Address]*big.Int{destNative: big.NewInt(1)},
+			offRampNoncesBySender:  map[cciptypes.Address]uint64{sender1: 0},
+			expectedSeqNrs:         []ccip.ObservedMessage{{SeqNr: uint64(1)}, {SeqNr: uint64(2)}},
+			expectedStates: []messageExecStatus{
+				newMessageExecState(recentMsg1.SequenceNumber, recentMsg1.MessageID, AddedToBatch),
+				newMessageExecState(recentMsg2.SequenceNumber, recentMsg2.MessageID, AddedToBatch),
+			},
+		},
+	}
+
+	t.Run("CostMinimizingBatchingStrategy", func(t *testing.T) {
+		strategy := &CostMinimizingBatchingStrategy{
+			base:         &BestEffortBatchingStrategy{},
+			minBatchSize: 2,
+			maxWait:      time.Hour,
+			now:          func() time.Time { return now },
+		}
+		runBatchingStrategyTests(t, strategy, 1_000_000, costMinimizingTestCases)
+	})
 }
 
 // Function to set up and run tests for a given batching strategy
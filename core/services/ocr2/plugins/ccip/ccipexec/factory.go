@@ -112,7 +112,7 @@ func (rf *ExecutionReportingPluginFactory) NewReportingPluginFn(config types.Rep
 			return reportingPluginAndInfo{}, fmt.Errorf("get onchain config from offramp: %w", err)
 		}
 
-		batchingStrategy, err := NewBatchingStrategy(offchainConfig.BatchingStrategyID, rf.config.txmStatusChecker)
+		batchingStrategy, err := NewBatchingStrategy(offchainConfig.BatchingStrategyID, rf.config.batching, rf.config.txmStatusChecker)
 		if err != nil {
 			return reportingPluginAndInfo{}, fmt.Errorf("get batching strategy: %w", err)
 		}
@@ -125,6 +125,18 @@ func (rf *ExecutionReportingPluginFactory) NewReportingPluginFn(config types.Rep
 		rf.config.lggr.Infof("MessageVisibilityInterval set to: %s", msgVisibilityInterval)
 
 		lggr := rf.config.lggr.Named("ExecutionReportingPlugin")
+		inflightReports := newInflightExecReportsContainer(offchainConfig.InflightCacheExpiry.Duration())
+		if rf.config.inflightORM != nil {
+			offRampAddress, err2 := rf.config.offRampReader.Address(ctx)
+			if err2 != nil {
+				return reportingPluginAndInfo{}, fmt.Errorf("get offramp address: %w", err2)
+			}
+			inflightReports, err = newPersistedInflightExecReportsContainer(ctx, lggr, offchainConfig.InflightCacheExpiry.Duration(),
+				rf.config.inflightORM, rf.config.sourceChainSelector, rf.config.destChainSelector, offRampAddress)
+			if err != nil {
+				return reportingPluginAndInfo{}, fmt.Errorf("load inflight reports: %w", err)
+			}
+		}
 		plugin := &ExecutionReportingPlugin{
 			F:                           config.F,
 			lggr:                        lggr,
@@ -134,18 +146,22 @@ func (rf *ExecutionReportingPluginFactory) NewReportingPluginFn(config types.Rep
 			sourcePriceRegistryProvider: rf.config.sourcePriceRegistryProvider,
 			sourcePriceRegistryLock:     sync.RWMutex{},
 			sourceWrappedNativeToken:    rf.config.sourceWrappedNativeToken,
+			sourceChainSelector:         rf.config.sourceChainSelector,
 			onRampReader:                rf.config.onRampReader,
+			destChainSelector:           rf.config.destChainSelector,
 			commitStoreReader:           rf.config.commitStoreReader,
 			destPriceRegistry:           rf.destPriceRegReader,
 			destWrappedNative:           destWrappedNative,
 			onchainConfig:               onchainConfig,
 			offRampReader:               rf.config.offRampReader,
 			tokenPoolBatchedReader:      rf.config.tokenPoolBatchedReader,
-			inflightReports:             newInflightExecReportsContainer(offchainConfig.InflightCacheExpiry.Duration()),
+			inflightReports:             inflightReports,
 			commitRootsCache:            cache.NewCommitRootsCache(lggr, rf.config.commitStoreReader, msgVisibilityInterval, offchainConfig.RootSnoozeTime.Duration()),
 			metricsCollector:            rf.config.metricsCollector,
 			chainHealthcheck:            rf.config.chainHealthcheck,
 			batchingStrategy:            batchingStrategy,
+			feeBooster:                  newFeeBooster(rf.config.feeBoosting),
+			observedPrices:              rf.config.observedPrices,
 		}
 
 		pluginInfo := types.ReportingPluginInfo{
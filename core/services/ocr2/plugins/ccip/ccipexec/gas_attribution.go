@@ -0,0 +1,179 @@
+package ccipexec
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	cciporm "github.com/smartcontractkit/chainlink/v2/core/services/ccip"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+const (
+	defaultGasAttributionInterval  = time.Minute
+	defaultGasAttributionRetention = 30 * 24 * time.Hour
+	// maxGasAttributionBlocks bounds the blocks attributed at once, so that catching up is recorded in steps.
+	maxGasAttributionBlocks = 1000
+)
+
+var _ job.ServiceCtx = (*gasAttributor)(nil)
+
+// gasAttributor periodically attributes the gas used by the finalized execution transactions of the offRamp to the
+// messages they executed, and records it in the DB for the accounting of the cost of each message. On its first start,
+// it starts from the latest finalized block, then resumes from the cursor it records in the DB with the gas. The gas is
+// deleted once older than the retention.
+type gasAttributor struct {
+	lggr              logger.Logger
+	reader            ccipdata.ExecGasReader
+	orm               cciporm.ORM
+	offRamp           cciptypes.Address
+	destChainSelector uint64
+	interval          time.Duration
+	retention         time.Duration
+	// nextBlock is the next block to attribute, or 0 until it is read from the DB.
+	nextBlock uint64
+
+	services.StateMachine
+	wg               sync.WaitGroup
+	backgroundCtx    context.Context //nolint:containedctx
+	backgroundCancel context.CancelFunc
+}
+
+func newGasAttributor(
+	lggr logger.Logger,
+	reader ccipdata.ExecGasReader,
+	orm cciporm.ORM,
+	offRamp cciptypes.Address,
+	destChainSelector uint64,
+	interval time.Duration,
+	retention time.Duration,
+) *gasAttributor {
+	if interval == 0 {
+		interval = defaultGasAttributionInterval
+	}
+	if retention == 0 {
+		retention = defaultGasAttributionRetention
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &gasAttributor{
+		lggr:              lggr.Named("GasAttributor"),
+		reader:            reader,
+		orm:               orm,
+		offRamp:           offRamp,
+		destChainSelector: destChainSelector,
+		interval:          interval,
+		retention:         retention,
+		backgroundCtx:     ctx,
+		backgroundCancel:  cancel,
+	}
+}
+
+func (a *gasAttributor) Start(context.Context) error {
+	return a.StateMachine.StartOnce("GasAttributor", func() error {
+		a.lggr.Infow("Starting GasAttributor", "offRamp", a.offRamp, "interval", a.interval)
+		a.wg.Add(1)
+		go a.run()
+		return nil
+	})
+}
+
+func (a *gasAttributor) Close() error {
+	return a.StateMachine.StopOnce("GasAttributor", func() error {
+		a.lggr.Info("Closing GasAttributor")
+		a.backgroundCancel()
+		a.wg.Wait()
+		return nil
+	})
+}
+
+func (a *gasAttributor) run() {
+	defer a.wg.Done()
+	ticker := time.NewTicker(utils.WithJitter(a.interval))
+	defer ticker.Stop()
+	for {
+		if err := a.attribute(a.backgroundCtx); err != nil {
+			a.lggr.Errorw("Failed to attribute the gas of execution transactions", "err", err)
+		}
+		if err := a.prune(a.backgroundCtx); err != nil {
+			a.lggr.Errorw("Failed to delete the expired gas of execution transactions", "err", err)
+		}
+		select {
+		case <-a.backgroundCtx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// attribute records the gas used by the messages executed in the blocks finalized since the last call.
+func (a *gasAttributor) attribute(ctx context.Context) error {
+	finalized, err := a.reader.LatestFinalizedBlock(ctx)
+	if err != nil {
+		return fmt.Errorf("get latest finalized block: %w", err)
+	}
+	if a.nextBlock == 0 {
+		next, err2 := a.orm.GetMessageExecGasCursor(ctx, a.destChainSelector, string(a.offRamp))
+		if err2 != nil {
+			return fmt.Errorf("get message exec gas cursor: %w", err2)
+		}
+		if next == 0 {
+			next = finalized + 1
+			if _, err2 = a.orm.InsertMessageExecGas(ctx, a.destChainSelector, string(a.offRamp), next, nil); err2 != nil {
+				return fmt.Errorf("record message exec gas cursor: %w", err2)
+			}
+		}
+		a.nextBlock = next
+		a.lggr.Infow("Attributing the gas of execution transactions", "fromBlock", a.nextBlock)
+	}
+
+	for a.nextBlock <= finalized {
+		toBlock := min(finalized, a.nextBlock+maxGasAttributionBlocks-1)
+		gas, err := a.reader.GetMessageExecGas(ctx, a.nextBlock, toBlock)
+		if err != nil {
+			return fmt.Errorf("get message exec gas of blocks %d to %d: %w", a.nextBlock, toBlock, err)
+		}
+		rows := make([]cciporm.MessageExecGas, 0, len(gas))
+		for _, g := range gas {
+			rows = append(rows, cciporm.MessageExecGas{
+				SeqNum:            g.SequenceNumber,
+				TxHash:            g.TxHash.Hex(),
+				MessageID:         hexutil.Encode(g.MessageID[:]),
+				BlockNumber:       g.BlockNumber,
+				ExecGasUsed:       g.ExecGasUsed,
+				OverheadGasUsed:   g.OverheadGasUsed,
+				EffectiveGasPrice: ubig.New(g.EffectiveGasPrice),
+				Traced:            g.Traced,
+			})
+		}
+		if _, err = a.orm.InsertMessageExecGas(ctx, a.destChainSelector, string(a.offRamp), toBlock+1, rows); err != nil {
+			return fmt.Errorf("record message exec gas: %w", err)
+		}
+		if len(rows) > 0 {
+			a.lggr.Debugw("Attributed the gas of execution transactions", "fromBlock", a.nextBlock, "toBlock", toBlock, "messages", len(rows))
+		}
+		a.nextBlock = toBlock + 1
+	}
+	return nil
+}
+
+// prune deletes the gas recorded before the retention.
+func (a *gasAttributor) prune(ctx context.Context) error {
+	deleted, err := a.orm.DeleteMessageExecGasBefore(ctx, a.destChainSelector, string(a.offRamp), time.Now().Add(-a.retention))
+	if err != nil {
+		return err
+	}
+	if deleted > 0 {
+		a.lggr.Debugw("Deleted the expired gas of execution transactions", "messages", deleted)
+	}
+	return nil
+}
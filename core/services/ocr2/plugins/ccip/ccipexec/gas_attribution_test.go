@@ -0,0 +1,111 @@
+package ccipexec
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	cciporm "github.com/smartcontractkit/chainlink/v2/core/services/ccip"
+	ccipmocks "github.com/smartcontractkit/chainlink/v2/core/services/ccip/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
+)
+
+type fakeExecGasReader struct {
+	finalized uint64
+	gas       []ccipdata.MessageExecGas
+	ranges    [][2]uint64
+}
+
+func (r *fakeExecGasReader) LatestFinalizedBlock(context.Context) (uint64, error) {
+	return r.finalized, nil
+}
+
+func (r *fakeExecGasReader) GetMessageExecGas(_ context.Context, fromBlock, toBlock uint64) ([]ccipdata.MessageExecGas, error) {
+	r.ranges = append(r.ranges, [2]uint64{fromBlock, toBlock})
+	var gas []ccipdata.MessageExecGas
+	for _, g := range r.gas {
+		if g.BlockNumber >= fromBlock && g.BlockNumber <= toBlock {
+			gas = append(gas, g)
+		}
+	}
+	return gas, nil
+}
+
+func TestGasAttributor_attribute(t *testing.T) {
+	const destChainSelector = uint64(2)
+	ctx := testutils.Context(t)
+	offRamp := ccipcalc.HexToAddress("0x1")
+	txHash := common.HexToHash("0xabc")
+
+	t.Run("starts from the latest finalized block", func(t *testing.T) {
+		reader := &fakeExecGasReader{finalized: 100}
+		orm := ccipmocks.NewORM(t)
+		orm.On("GetMessageExecGasCursor", mock.Anything, destChainSelector, string(offRamp)).Return(uint64(0), nil).Once()
+		orm.On("InsertMessageExecGas", mock.Anything, destChainSelector, string(offRamp), uint64(101), []cciporm.MessageExecGas(nil)).Return(int64(0), nil).Once()
+		attributor := newGasAttributor(logger.TestLogger(t), reader, orm, offRamp, destChainSelector, time.Minute, 0)
+
+		require.NoError(t, attributor.attribute(ctx))
+		assert.Empty(t, reader.ranges)
+		assert.Equal(t, uint64(101), attributor.nextBlock)
+	})
+
+	t.Run("resumes from the recorded cursor in steps", func(t *testing.T) {
+		reader := &fakeExecGasReader{finalized: 1500, gas: []ccipdata.MessageExecGas{{
+			SequenceNumber:    7,
+			MessageID:         [32]byte{1},
+			TxHash:            txHash,
+			BlockNumber:       1200,
+			ExecGasUsed:       90_000,
+			OverheadGasUsed:   30_000,
+			EffectiveGasPrice: big.NewInt(1e9),
+			Traced:            true,
+		}}}
+		orm := ccipmocks.NewORM(t)
+		orm.On("GetMessageExecGasCursor", mock.Anything, destChainSelector, string(offRamp)).Return(uint64(11), nil).Once()
+		orm.On("InsertMessageExecGas", mock.Anything, destChainSelector, string(offRamp), uint64(1011), []cciporm.MessageExecGas{}).Return(int64(0), nil).Once()
+		orm.On("InsertMessageExecGas", mock.Anything, destChainSelector, string(offRamp), uint64(1501), mock.Anything).Return(int64(1), nil).Run(func(args mock.Arguments) {
+			rows := args.Get(4).([]cciporm.MessageExecGas)
+			require.Len(t, rows, 1)
+			assert.Equal(t, uint64(7), rows[0].SeqNum)
+			assert.Equal(t, txHash.Hex(), rows[0].TxHash)
+			assert.Equal(t, "0x0100000000000000000000000000000000000000000000000000000000000000", rows[0].MessageID)
+			assert.Equal(t, uint64(1200), rows[0].BlockNumber)
+			assert.Equal(t, uint64(90_000), rows[0].ExecGasUsed)
+			assert.Equal(t, uint64(30_000), rows[0].OverheadGasUsed)
+			assert.Equal(t, int64(1e9), rows[0].EffectiveGasPrice.Int64())
+			assert.True(t, rows[0].Traced)
+		}).Once()
+		attributor := newGasAttributor(logger.TestLogger(t), reader, orm, offRamp, destChainSelector, time.Minute, 0)
+
+		require.NoError(t, attributor.attribute(ctx))
+		assert.Equal(t, [][2]uint64{{11, 1010}, {1011, 1500}}, reader.ranges)
+		assert.Equal(t, uint64(1501), attributor.nextBlock)
+
+		// nothing new is finalized
+		require.NoError(t, attributor.attribute(ctx))
+		assert.Len(t, reader.ranges, 2)
+	})
+}
+
+func TestGasAttributor_prune(t *testing.T) {
+	const destChainSelector = uint64(2)
+	ctx := testutils.Context(t)
+	offRamp := ccipcalc.HexToAddress("0x1")
+
+	orm := ccipmocks.NewORM(t)
+	orm.On("DeleteMessageExecGasBefore", mock.Anything, destChainSelector, string(offRamp), mock.MatchedBy(func(before time.Time) bool {
+		return time.Since(before) >= 24*time.Hour && time.Since(before) < 25*time.Hour
+	})).Return(int64(3), nil).Once()
+	attributor := newGasAttributor(logger.TestLogger(t), &fakeExecGasReader{}, orm, offRamp, destChainSelector, time.Minute, 24*time.Hour)
+
+	require.NoError(t, attributor.prune(ctx))
+}
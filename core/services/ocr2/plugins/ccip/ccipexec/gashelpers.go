@@ -4,6 +4,8 @@ import (
 	"math"
 	"math/big"
 	"time"
+
+	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
 )
 
 const (
@@ -76,6 +78,43 @@ func maxGasOverHeadGas(numMsgs, dataLength, numTokens int) uint64 {
 func waitBoostedFee(waitTime time.Duration, fee *big.Int, relativeBoostPerWaitHour float64) *big.Int {
 	k := 1.0 + waitTime.Hours()*relativeBoostPerWaitHour
 
+	return boostFee(fee, k)
+}
+
+// feeBooster boosts the given fee according to the time passed since the msg was sent, see waitBoostedFee.
+type feeBooster func(waitTime time.Duration, fee *big.Int, relativeBoostPerWaitHour float64) *big.Int
+
+// newFeeBooster returns the feeBooster of the given boost curve.
+//
+// linear:      (1 + hours * RELATIVE_BOOST_PER_WAIT_HOUR) * fee(m)
+// exponential: (1 + RELATIVE_BOOST_PER_WAIT_HOUR) ^ hours * fee(m)
+// capped:      min(1 + hours * RELATIVE_BOOST_PER_WAIT_HOUR, MAX_MULTIPLIER) * fee(m)
+func newFeeBooster(cfg ccipconfig.FeeBoostingConfig) feeBooster {
+	switch cfg.Curve {
+	case ccipconfig.FeeBoostCurveExponential:
+		return func(waitTime time.Duration, fee *big.Int, relativeBoostPerWaitHour float64) *big.Int {
+			return boostFee(fee, math.Pow(1.0+relativeBoostPerWaitHour, waitTime.Hours()))
+		}
+	case ccipconfig.FeeBoostCurveCapped:
+		return func(waitTime time.Duration, fee *big.Int, relativeBoostPerWaitHour float64) *big.Int {
+			return boostFee(fee, math.Min(1.0+waitTime.Hours()*relativeBoostPerWaitHour, cfg.MaxMultiplier))
+		}
+	default:
+		return waitBoostedFee
+	}
+}
+
+// maxFeeBoost bounds the boost of the fees, which the exponential curve makes overflow to +Inf after long enough waits.
+// Messages waiting for such a boost pay for their execution whatever their fee.
+const maxFeeBoost = 1e12
+
+func boostFee(fee *big.Int, k float64) *big.Int {
+	switch {
+	case math.IsNaN(k):
+		k = 1
+	case k > maxFeeBoost:
+		k = maxFeeBoost
+	}
 	boostedFee := big.NewFloat(0).Mul(big.NewFloat(k), new(big.Float).SetInt(fee))
 	res, _ := boostedFee.Int(nil)
 
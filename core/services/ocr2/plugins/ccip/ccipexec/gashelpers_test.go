@@ -1,12 +1,16 @@
 package ccipexec
 
 import (
+	"math"
 	"math/big"
 	"reflect"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
 )
 
 func TestOverheadGas(t *testing.T) {
@@ -177,3 +181,38 @@ func TestWaitBoostedFee(t *testing.T) {
 		})
 	}
 }
+
+func TestFeeBooster(t *testing.T) {
+	fee := big.NewInt(1e18)
+	tests := []struct {
+		name     string
+		cfg      ccipconfig.FeeBoostingConfig
+		waitTime time.Duration
+		expected *big.Int
+	}{
+		{"default is linear", ccipconfig.FeeBoostingConfig{}, 2 * time.Hour, big.NewInt(3e18)},
+		{"linear", ccipconfig.FeeBoostingConfig{Curve: ccipconfig.FeeBoostCurveLinear}, 3 * time.Hour, big.NewInt(4e18)},
+		{"exponential", ccipconfig.FeeBoostingConfig{Curve: ccipconfig.FeeBoostCurveExponential}, 3 * time.Hour, big.NewInt(8e18)},
+		{"capped below max", ccipconfig.FeeBoostingConfig{Curve: ccipconfig.FeeBoostCurveCapped, MaxMultiplier: 2.5}, time.Hour, big.NewInt(2e18)},
+		{"capped above max", ccipconfig.FeeBoostingConfig{Curve: ccipconfig.FeeBoostCurveCapped, MaxMultiplier: 2.5}, 3 * time.Hour, big.NewInt(25e17)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, newFeeBooster(tc.cfg)(tc.waitTime, fee, 1))
+		})
+	}
+}
+
+func TestBoostFee_Bounded(t *testing.T) {
+	fee := big.NewInt(1e18)
+	maxBoosted := boostFee(fee, maxFeeBoost)
+	require.NotNil(t, maxBoosted)
+	assert.Equal(t, 1, maxBoosted.Cmp(new(big.Int).Mul(fee, big.NewInt(1e11))))
+
+	// 2^5000 overflows a float64
+	assert.Equal(t, maxBoosted, newFeeBooster(ccipconfig.FeeBoostingConfig{Curve: ccipconfig.FeeBoostCurveExponential})(5000*time.Hour, fee, 1))
+	assert.Equal(t, maxBoosted, boostFee(fee, math.Inf(1)))
+	assert.Equal(t, fee, boostFee(fee, math.NaN()))
+	assert.Zero(t, boostFee(big.NewInt(0), math.Inf(1)).Sign())
+}
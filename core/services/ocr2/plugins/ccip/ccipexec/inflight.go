@@ -1,15 +1,26 @@
 package ccipexec
 
 import (
+	"context"
+	"encoding/json"
+	"strconv"
 	"sync"
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 
 	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	cciporm "github.com/smartcontractkit/chainlink/v2/core/services/ccip"
 )
 
+var inflightCacheSize = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "ccip_exec_inflight_cache_size",
+	Help: "Number of execution reports of the lane in the inflight cache of the execution plugin",
+}, []string{"source", "dest"})
+
 // InflightInternalExecutionReport serves the same purpose as InflightCommitReport
 // see the comment on that struct for context.
 type InflightInternalExecutionReport struct {
@@ -25,6 +36,12 @@ type inflightExecReportsContainer struct {
 	reports []InflightInternalExecutionReport
 
 	cacheExpiry time.Duration
+
+	// orm persists the reports across restarts, nil if they are only kept in memory.
+	orm               cciporm.ORM
+	destChainSelector uint64
+	offRamp           cciptypes.Address
+	size              prometheus.Gauge
 }
 
 func newInflightExecReportsContainer(inflightCacheExpiry time.Duration) *inflightExecReportsContainer {
@@ -35,6 +52,44 @@ func newInflightExecReportsContainer(inflightCacheExpiry time.Duration) *infligh
 	}
 }
 
+// newPersistedInflightExecReportsContainer returns a container persisting its reports with orm, starting with the
+// unexpired reports persisted by the earlier plugins of the offramp, so that their messages are not executed again
+// after a restart.
+func newPersistedInflightExecReportsContainer(
+	ctx context.Context,
+	lggr logger.Logger,
+	inflightCacheExpiry time.Duration,
+	orm cciporm.ORM,
+	sourceChainSelector, destChainSelector uint64,
+	offRamp cciptypes.Address,
+) (*inflightExecReportsContainer, error) {
+	container := newInflightExecReportsContainer(inflightCacheExpiry)
+	container.orm = orm
+	container.destChainSelector = destChainSelector
+	container.offRamp = offRamp
+	container.size = inflightCacheSize.WithLabelValues(strconv.FormatUint(sourceChainSelector, 10), strconv.FormatUint(destChainSelector, 10))
+
+	persisted, err := orm.GetExecInflightReports(ctx, destChainSelector, string(offRamp))
+	if err != nil {
+		return nil, errors.Wrap(err, "get persisted inflight reports")
+	}
+	for _, report := range persisted {
+		var messages []cciptypes.EVM2EVMMessage
+		if err = json.Unmarshal(report.Messages, &messages); err != nil {
+			return nil, errors.Wrapf(err, "decode persisted inflight report %d", report.MinSeqNum)
+		}
+		container.reports = append(container.reports, InflightInternalExecutionReport{
+			createdAt: report.CreatedAt,
+			messages:  messages,
+		})
+	}
+	if len(persisted) > 0 {
+		lggr.Infow("Restored persisted inflight reports", "reports", len(persisted))
+	}
+	container.size.Set(float64(len(container.reports)))
+	return container, nil
+}
+
 func (container *inflightExecReportsContainer) getAll() []InflightInternalExecutionReport {
 	container.locker.RLock()
 	defer container.locker.RUnlock()
@@ -45,24 +100,37 @@ func (container *inflightExecReportsContainer) getAll() []InflightInternalExecut
 	return reports
 }
 
-func (container *inflightExecReportsContainer) expire(lggr logger.Logger) {
+func (container *inflightExecReportsContainer) expire(ctx context.Context, lggr logger.Logger) {
 	container.locker.Lock()
 	defer container.locker.Unlock()
 	// Reap old inflight txs and check if any messages in the report are inflight.
 	var stillInFlight []InflightInternalExecutionReport
+	var expired int
 	for _, report := range container.reports {
 		if time.Since(report.createdAt) > container.cacheExpiry {
 			// Happy path: inflight report was successfully transmitted onchain, we remove it from inflight and onchain state reflects inflight.
 			// Sad path: inflight report reverts onchain, we remove it from inflight, onchain state does not reflect the change so we retry.
 			lggr.Infow("Inflight report expired", "messages", report.messages)
+			expired++
 		} else {
 			stillInFlight = append(stillInFlight, report)
 		}
 	}
 	container.reports = stillInFlight
+
+	if container.orm == nil {
+		return
+	}
+	container.size.Set(float64(len(container.reports)))
+	if expired > 0 {
+		// the reports of all the offramps on the dest chain expire in the DB, they are not loaded past their expiry anyway
+		if _, err := container.orm.DeleteExpiredExecInflightReports(ctx, container.destChainSelector); err != nil {
+			lggr.Errorw("Failed to delete expired persisted inflight reports", "err", err)
+		}
+	}
 }
 
-func (container *inflightExecReportsContainer) add(lggr logger.Logger, messages []cciptypes.EVM2EVMMessage) error {
+func (container *inflightExecReportsContainer) add(ctx context.Context, lggr logger.Logger, messages []cciptypes.EVM2EVMMessage) error {
 	container.locker.Lock()
 	defer container.locker.Unlock()
 
@@ -74,9 +142,33 @@ func (container *inflightExecReportsContainer) add(lggr logger.Logger, messages
 
 	// Otherwise not already in flight, add it.
 	lggr.Info("Inflight report added")
-	container.reports = append(container.reports, InflightInternalExecutionReport{
+	report := InflightInternalExecutionReport{
 		createdAt: time.Now(),
 		messages:  messages,
-	})
+	}
+	container.reports = append(container.reports, report)
+
+	if container.orm != nil {
+		container.size.Set(float64(len(container.reports)))
+		// the report stays inflight in memory if it fails to be persisted, only restarts are affected
+		if err := container.persist(ctx, report); err != nil {
+			lggr.Errorw("Failed to persist inflight report", "err", err)
+		}
+	}
 	return nil
 }
+
+func (container *inflightExecReportsContainer) persist(ctx context.Context, report InflightInternalExecutionReport) error {
+	encoded, err := json.Marshal(report.messages)
+	if err != nil {
+		return err
+	}
+	_, err = container.orm.UpsertExecInflightReport(ctx, container.destChainSelector, cciporm.ExecInflightReport{
+		OffRampAddr: string(container.offRamp),
+		MinSeqNum:   report.messages[0].SequenceNumber,
+		Messages:    encoded,
+		CreatedAt:   report.createdAt,
+		ExpiresAt:   report.createdAt.Add(container.cacheExpiry),
+	})
+	return err
+}
@@ -1,24 +1,32 @@
 package ccipexec
 
 import (
+	"encoding/json"
+	"errors"
+	"math/big"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	cciporm "github.com/smartcontractkit/chainlink/v2/core/services/ccip"
+	ccipmocks "github.com/smartcontractkit/chainlink/v2/core/services/ccip/mocks"
 )
 
 func TestInflightReportsContainer_add(t *testing.T) {
 	lggr := logger.TestLogger(t)
 	container := newInflightExecReportsContainer(time.Second)
 
-	err := container.add(lggr, []cciptypes.EVM2EVMMessage{
+	err := container.add(testutils.Context(t), lggr, []cciptypes.EVM2EVMMessage{
 		{SequenceNumber: 1}, {SequenceNumber: 2}, {SequenceNumber: 3},
 	})
 	require.NoError(t, err)
-	err = container.add(lggr, []cciptypes.EVM2EVMMessage{
+	err = container.add(testutils.Context(t), lggr, []cciptypes.EVM2EVMMessage{
 		{SequenceNumber: 1},
 	})
 	require.Error(t, err)
@@ -30,13 +38,60 @@ func TestInflightReportsContainer_expire(t *testing.T) {
 	lggr := logger.TestLogger(t)
 	container := newInflightExecReportsContainer(time.Second)
 
-	err := container.add(lggr, []cciptypes.EVM2EVMMessage{
+	err := container.add(testutils.Context(t), lggr, []cciptypes.EVM2EVMMessage{
 		{SequenceNumber: 1}, {SequenceNumber: 2}, {SequenceNumber: 3},
 	})
 	require.NoError(t, err)
 	container.reports[0].createdAt = time.Now().Add(-time.Second * 5)
 	require.Equal(t, 1, len(container.getAll()))
 
-	container.expire(lggr)
+	container.expire(testutils.Context(t), lggr)
 	require.Equal(t, 0, len(container.getAll()))
 }
+
+func TestInflightReportsContainer_persisted(t *testing.T) {
+	ctx := testutils.Context(t)
+	lggr := logger.TestLogger(t)
+	offRamp := cciptypes.Address("0x7a2dd2a3ba5aab8bd47cbd3b5d3b2b2a20e7b0c1")
+	destChainSelector := uint64(2)
+
+	persistedMessages := []cciptypes.EVM2EVMMessage{{
+		SequenceNumber: 5,
+		MessageID:      cciptypes.Hash{1},
+		TokenAmounts:   []cciptypes.TokenAmount{{Token: "0x1", Amount: big.NewInt(100)}},
+	}}
+	encoded, err := json.Marshal(persistedMessages)
+	require.NoError(t, err)
+	createdAt := time.Now().Add(-time.Minute).UTC().Truncate(time.Microsecond)
+
+	orm := ccipmocks.NewORM(t)
+	orm.On("GetExecInflightReports", ctx, destChainSelector, string(offRamp)).
+		Return([]cciporm.ExecInflightReport{{OffRampAddr: string(offRamp), MinSeqNum: 5, Messages: encoded, CreatedAt: createdAt}}, nil).Once()
+	container, err := newPersistedInflightExecReportsContainer(ctx, lggr, time.Hour, orm, 1, destChainSelector, offRamp)
+	require.NoError(t, err)
+
+	// the reports of the previous run are still inflight
+	reports := container.getAll()
+	require.Len(t, reports, 1)
+	assert.Equal(t, createdAt, reports[0].createdAt)
+	assert.Equal(t, persistedMessages, reports[0].messages)
+	require.ErrorContains(t, container.add(ctx, lggr, persistedMessages), "report is already in flight")
+
+	orm.On("UpsertExecInflightReport", ctx, destChainSelector, mock.MatchedBy(func(r cciporm.ExecInflightReport) bool {
+		return r.OffRampAddr == string(offRamp) && r.MinSeqNum == 6 && r.ExpiresAt.Sub(r.CreatedAt) == time.Hour
+	})).Return(int64(1), nil).Once()
+	require.NoError(t, container.add(ctx, lggr, []cciptypes.EVM2EVMMessage{{SequenceNumber: 6}, {SequenceNumber: 7}}))
+	assert.Len(t, container.getAll(), 2)
+
+	// failing to persist a report keeps it inflight in memory
+	orm.On("UpsertExecInflightReport", ctx, destChainSelector, mock.Anything).Return(int64(0), errors.New("db down")).Once()
+	require.NoError(t, container.add(ctx, lggr, []cciptypes.EVM2EVMMessage{{SequenceNumber: 8}}))
+	assert.Len(t, container.getAll(), 3)
+
+	// nothing expired, nothing deleted
+	container.expire(ctx, lggr)
+	container.reports[0].createdAt = time.Now().Add(-2 * time.Hour)
+	orm.On("DeleteExpiredExecInflightReports", ctx, destChainSelector).Return(int64(1), nil).Once()
+	container.expire(ctx, lggr)
+	assert.Len(t, container.getAll(), 2)
+}
@@ -4,17 +4,22 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"math/big"
+	"math"
+	"strconv"
 	"time"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
 
 	"github.com/Masterminds/semver/v3"
+	chainselectors "github.com/smartcontractkit/chain-selectors"
 	"go.uber.org/multierr"
 
 	libocr2 "github.com/smartcontractkit/libocr/offchainreporting2plus"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
 
 	commonlogger "github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/loop"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 
 	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
 
@@ -23,16 +28,23 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/statuschecker"
 
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/config/env"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	cciporm "github.com/smartcontractkit/chainlink/v2/core/services/ccip"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/chainsdk"
 	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/batchreader"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/factory"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/observability"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/oraclelib"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/tokendata"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/deadlinewrapper"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/promwrapper"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/transmitschedule"
+	"github.com/smartcontractkit/chainlink/v2/plugins"
 )
 
 var (
@@ -48,7 +60,7 @@ var (
 
 var defaultNewReportingPluginRetryConfig = ccipdata.RetryConfig{InitialDelay: time.Second, MaxDelay: 5 * time.Minute}
 
-func NewExecServices(ctx context.Context, lggr logger.Logger, jb job.Job, srcProvider types.CCIPExecProvider, dstProvider types.CCIPExecProvider, srcChainID int64, dstChainID int64, new bool, argsNoPlugin libocr2.OCR2OracleArgs, logError func(string)) ([]job.ServiceCtx, error) {
+func NewExecServices(ctx context.Context, ds sqlutil.DataSource, lggr logger.Logger, rc plugins.RegistrarConfig, jb job.Job, srcProvider types.CCIPExecProvider, dstProvider types.CCIPExecProvider, srcChainID int64, dstChainID int64, new bool, argsNoPlugin libocr2.OCR2OracleArgs, logError func(string)) ([]job.ServiceCtx, error) {
 	if jb.OCR2OracleSpec == nil {
 		return nil, fmt.Errorf("spec is nil")
 	}
@@ -60,41 +72,200 @@ func NewExecServices(ctx context.Context, lggr logger.Logger, jb job.Job, srcPro
 	}
 
 	offRampAddress := ccipcalc.HexToAddress(spec.ContractID)
+	var usdcSourceTokenAddress cciptypes.Address
+	if pluginConfig.USDCConfig.AttestationAPI != "" {
+		lggr.Infof("USDC token data provider enabled")
+		if err2 := pluginConfig.USDCConfig.ValidateUSDCConfig(); err2 != nil {
+			return nil, err2
+		}
+		usdcSourceTokenAddress = ccip.EvmAddrToGeneric(pluginConfig.USDCConfig.SourceTokenAddress)
+	}
+
+	if err = pluginConfig.FeeBoosting.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid FeeBoosting config: %w", err)
+	}
+	if err = pluginConfig.TokenPoolLiquidity.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid TokenPoolLiquidity config: %w", err)
+	}
+	if err = pluginConfig.GasAttribution.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid GasAttribution config: %w", err)
+	}
+	if err = pluginConfig.Batching.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid Batching config: %w", err)
+	}
+
+	var wrappedPluginFactory ocrtypes.ReportingPluginFactory
+	var srvs []job.ServiceCtx
+	if cmdName := env.CCIPExecPlugin.Cmd.Get(); cmdName != "" {
+		if pluginConfig.FeeBoosting != (ccipconfig.FeeBoostingConfig{}) {
+			return nil, fmt.Errorf("FeeBoosting is not supported when running the execution plugin as a LOOP")
+		}
+		if len(pluginConfig.TokenPoolLiquidity.Pools) != 0 {
+			return nil, fmt.Errorf("TokenPoolLiquidity is not supported when running the execution plugin as a LOOP")
+		}
+		if pluginConfig.Batching != (ccipconfig.BatchingConfig{}) {
+			return nil, fmt.Errorf("Batching is not supported when running the execution plugin as a LOOP")
+		}
+		if pluginConfig.GasAttribution.Enabled {
+			return nil, fmt.Errorf("GasAttribution is not supported when running the execution plugin as a LOOP")
+		}
+		// use unique logger names so we can use it to register a loop
+		execLggr := lggr.Named("CCIPExecution").Named(spec.ContractID).Named(spec.GetID())
+		envVars, err2 := plugins.ParseEnvFile(env.CCIPExecPlugin.Env.Get())
+		if err2 != nil {
+			return nil, fmt.Errorf("failed to parse ccip exec env file: %w", err2)
+		}
+		envVars = append(envVars, string(OffRampAddressEnv)+"="+string(offRampAddress))
+		cmdFn, grpcOpts, err2 := rc.RegisterLOOP(plugins.CmdConfig{
+			ID:  execLggr.Name(),
+			Cmd: cmdName,
+			Env: envVars,
+		})
+		if err2 != nil {
+			return nil, fmt.Errorf("failed to register loop: %w", err2)
+		}
+		if srcChainID > math.MaxUint32 || dstChainID > math.MaxUint32 {
+			return nil, fmt.Errorf("chain IDs %d and %d must fit in 32 bits to run the execution plugin as a LOOP", srcChainID, dstChainID)
+		}
+		execService := loop.NewExecutionService(execLggr, grpcOpts, cmdFn, srcProvider, dstProvider, uint32(srcChainID), uint32(dstChainID), string(usdcSourceTokenAddress))
+		wrappedPluginFactory = execService
+		srvs = append(srvs, execService)
+	} else {
+		inflightORM, err2 := cciporm.NewObservedORM(ds, lggr)
+		if err2 != nil {
+			return nil, err2
+		}
+		var observedPrices *observedPriceReader
+		if maxAge := pluginConfig.FeeBoosting.PriceServiceMaxAgeSeconds; maxAge > 0 {
+			orm, err2 := cciporm.NewObservedORM(ds, lggr)
+			if err2 != nil {
+				return nil, err2
+			}
+			observedPrices = newObservedPriceReader(orm, time.Duration(maxAge)*time.Second)
+		}
+		factory, factorySrvs, err2 := newExecutionPluginFactory(ctx, lggr, offRampAddress, usdcSourceTokenAddress, srcProvider, dstProvider, srcChainID, dstChainID, pluginConfig.FeeBoosting, pluginConfig.Batching, observedPrices, inflightORM)
+		if err2 != nil {
+			return nil, err2
+		}
+		wrappedPluginFactory = factory
+		srvs = append(srvs, factorySrvs...)
+
+		if liquidityConfig := pluginConfig.TokenPoolLiquidity; len(liquidityConfig.Pools) != 0 {
+			reader, ok := factory.config.tokenPoolBatchedReader.(batchreader.TokenPoolLiquidityReader)
+			if !ok {
+				return nil, fmt.Errorf("TokenPoolLiquidity is not supported by the token pool reader %T", factory.config.tokenPoolBatchedReader)
+			}
+			orm, err2 := cciporm.NewObservedORM(ds, lggr)
+			if err2 != nil {
+				return nil, err2
+			}
+			pools := make([]cciptypes.Address, 0, len(liquidityConfig.Pools))
+			for _, pool := range liquidityConfig.Pools {
+				pools = append(pools, ccipcalc.EvmAddrToGeneric(pool))
+			}
+			srvs = append(srvs, newTokenPoolLiquidityMonitor(lggr, reader, orm, pools,
+				factory.config.sourceChainSelector, factory.config.destChainSelector, srcChainID, dstChainID,
+				time.Duration(liquidityConfig.IntervalSeconds)*time.Second))
+		}
+
+		if gasConfig := pluginConfig.GasAttribution; gasConfig.Enabled {
+			provider, ok := dstProvider.(chainsdk.ExecGasReaderProvider)
+			if !ok {
+				return nil, fmt.Errorf("GasAttribution is not supported by the dest provider %T", dstProvider)
+			}
+			reader, err2 := provider.NewExecGasReader(ctx, offRampAddress)
+			if err2 != nil {
+				return nil, fmt.Errorf("create exec gas reader: %w", err2)
+			}
+			orm, err2 := cciporm.NewObservedORM(ds, lggr)
+			if err2 != nil {
+				return nil, err2
+			}
+			srvs = append(srvs, newGasAttributor(lggr, reader, orm, offRampAddress, factory.config.destChainSelector,
+				time.Duration(gasConfig.IntervalSeconds)*time.Second, time.Duration(gasConfig.RetentionHours)*time.Hour))
+		}
+	}
+
+	promFactory := promwrapper.NewPromFactory(wrappedPluginFactory, "CCIPExecution", jb.OCR2OracleSpec.Relay, strconv.FormatInt(dstChainID, 10), jb.ID)
+	deadlineFactory := deadlinewrapper.NewDeadlineFactory(promFactory, lggr, "CCIPExecution", jb.OCR2OracleSpec.Relay, strconv.FormatInt(dstChainID, 10),
+		jb.OCR2OracleSpec.ObservationTimeout.Duration(), jb.OCR2OracleSpec.ReportTimeout.Duration())
+	scheduleCfg, err := transmitschedule.ParseConfig(jb.OCR2OracleSpec.TransmissionSchedule)
+	if err != nil {
+		return nil, err
+	}
+	argsNoPlugin.ReportingPluginFactory = transmitschedule.NewScheduleFactory(deadlineFactory, lggr, scheduleCfg, "CCIPExecution", jb.OCR2OracleSpec.Relay, strconv.FormatInt(dstChainID, 10))
+	srcChainSelector, srcErr := chainselectors.SelectorFromChainId(uint64(srcChainID))
+	dstChainSelector, dstErr := chainselectors.SelectorFromChainId(uint64(dstChainID))
+	if srcErr == nil && dstErr == nil {
+		argsNoPlugin.ReportingPluginFactory = ccip.NewExecLaneStatusFactory(argsNoPlugin.ReportingPluginFactory, srcChainSelector, dstChainSelector)
+	} else {
+		lggr.Warnw("Lane status is not reported for chains without a selector", "srcChainID", srcChainID, "dstChainID", dstChainID, "err", multierr.Combine(srcErr, dstErr))
+	}
+	var oracleHealth *job.OracleService
+	argsNoPlugin.Logger = commonlogger.NewOCRWrapper(lggr, true, func(msg string) {
+		logError(msg)
+		oracleHealth.RecordError(msg)
+	})
+	oracle, err := libocr2.NewOracle(argsNoPlugin)
+	if err != nil {
+		return nil, err
+	}
+	var oracleService job.ServiceCtx = job.NewServiceAdapter(oracle)
+	if jb.OCR2OracleSpec.Standby {
+		oracleService = job.NewStandbyService(lggr, oracleService)
+	}
+	// If this is a brand-new job, then we make use of the start blocks. If not then we're rebooting and log poller will pick up where we left off.
+	if new {
+		oracleService = oraclelib.NewChainAgnosticBackFilledOracle(
+			lggr,
+			srcProvider,
+			dstProvider,
+			oracleService,
+		)
+	}
+	oracleHealth = job.NewOracleService(fmt.Sprintf("OCR2.%d.CCIPExecution", jb.ID), oracleService)
+	return append([]job.ServiceCtx{oracleHealth}, srvs...), nil
+}
+
+// newExecutionPluginFactory creates the execution reporting plugin factory from the providers alone, along with the
+// services it depends on, so that it can be created either in-process or out-of-process as a LOOP.
+// The USDC token data provider is only enabled if usdcSourceTokenAddress is set.
+func newExecutionPluginFactory(ctx context.Context, lggr logger.Logger, offRampAddress cciptypes.Address, usdcSourceTokenAddress cciptypes.Address, srcProvider types.CCIPExecProvider, dstProvider types.CCIPExecProvider, srcChainID int64, dstChainID int64, feeBoosting ccipconfig.FeeBoostingConfig, batching ccipconfig.BatchingConfig, observedPrices *observedPriceReader, inflightORM cciporm.ORM) (*ExecutionReportingPluginFactory, []job.ServiceCtx, error) {
 	offRampReader, err := dstProvider.NewOffRampReader(ctx, offRampAddress)
 	if err != nil {
-		return nil, fmt.Errorf("create offRampReader: %w", err)
+		return nil, nil, fmt.Errorf("create offRampReader: %w", err)
 	}
 
 	offRampConfig, err := offRampReader.GetStaticConfig(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("get offRamp static config: %w", err)
+		return nil, nil, fmt.Errorf("get offRamp static config: %w", err)
 	}
 
 	srcChainSelector := offRampConfig.SourceChainSelector
 	dstChainSelector := offRampConfig.ChainSelector
 	onRampReader, err := srcProvider.NewOnRampReader(ctx, offRampConfig.OnRamp, srcChainSelector, dstChainSelector)
 	if err != nil {
-		return nil, fmt.Errorf("create onRampReader: %w", err)
+		return nil, nil, fmt.Errorf("create onRampReader: %w", err)
 	}
 
 	dynamicOnRampConfig, err := onRampReader.GetDynamicConfig(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("get onramp dynamic config: %w", err)
+		return nil, nil, fmt.Errorf("get onramp dynamic config: %w", err)
 	}
 
 	sourceWrappedNative, err := srcProvider.SourceNativeToken(ctx, dynamicOnRampConfig.Router)
 	if err != nil {
-		return nil, fmt.Errorf("get source wrapped native token: %w", err)
+		return nil, nil, fmt.Errorf("get source wrapped native token: %w", err)
 	}
 
 	srcCommitStore, err := srcProvider.NewCommitStoreReader(ctx, offRampConfig.CommitStore)
 	if err != nil {
-		return nil, fmt.Errorf("could not create src commitStoreReader reader: %w", err)
+		return nil, nil, fmt.Errorf("could not create src commitStoreReader reader: %w", err)
 	}
 
 	dstCommitStore, err := dstProvider.NewCommitStoreReader(ctx, offRampConfig.CommitStore)
 	if err != nil {
-		return nil, fmt.Errorf("could not create dst commitStoreReader reader: %w", err)
+		return nil, nil, fmt.Errorf("could not create dst commitStoreReader reader: %w", err)
 	}
 
 	var commitStoreReader ccipdata.CommitStoreReader
@@ -102,18 +273,12 @@ func NewExecServices(ctx context.Context, lggr logger.Logger, jb job.Job, srcPro
 
 	tokenDataProviders := make(map[cciptypes.Address]tokendata.Reader)
 	// init usdc token data provider
-	if pluginConfig.USDCConfig.AttestationAPI != "" {
-		lggr.Infof("USDC token data provider enabled")
-		err2 := pluginConfig.USDCConfig.ValidateUSDCConfig()
-		if err2 != nil {
-			return nil, err2
-		}
-
-		usdcReader, err2 := srcProvider.NewTokenDataReader(ctx, ccip.EvmAddrToGeneric(pluginConfig.USDCConfig.SourceTokenAddress))
+	if usdcSourceTokenAddress != "" {
+		usdcReader, err2 := srcProvider.NewTokenDataReader(ctx, usdcSourceTokenAddress)
 		if err2 != nil {
-			return nil, fmt.Errorf("new usdc reader: %w", err2)
+			return nil, nil, fmt.Errorf("new usdc reader: %w", err2)
 		}
-		tokenDataProviders[cciptypes.Address(pluginConfig.USDCConfig.SourceTokenAddress.String())] = usdcReader
+		tokenDataProviders[usdcSourceTokenAddress] = usdcReader
 	}
 
 	// Prom wrappers
@@ -124,7 +289,7 @@ func NewExecServices(ctx context.Context, lggr logger.Logger, jb job.Job, srcPro
 
 	tokenPoolBatchedReader, err := dstProvider.NewTokenPoolBatchedReader(ctx, offRampAddress, srcChainSelector)
 	if err != nil {
-		return nil, fmt.Errorf("new token pool batched reader: %w", err)
+		return nil, nil, fmt.Errorf("new token pool batched reader: %w", err)
 	}
 
 	chainHealthcheck := cache.NewObservedChainHealthCheck(
@@ -152,13 +317,14 @@ func NewExecServices(ctx context.Context, lggr logger.Logger, jb job.Job, srcPro
 		2*tokenDataWorkerTimeout,
 	)
 
-	wrappedPluginFactory := NewExecutionReportingPluginFactory(ExecutionPluginStaticConfig{
+	factory := NewExecutionReportingPluginFactory(ExecutionPluginStaticConfig{
 		lggr:                          lggr,
 		onRampReader:                  onRampReader,
 		commitStoreReader:             commitStoreReader,
 		offRampReader:                 offRampReader,
 		sourcePriceRegistryProvider:   ccip.NewChainAgnosticPriceRegistry(srcProvider),
 		sourceWrappedNativeToken:      sourceWrappedNative,
+		sourceChainSelector:           srcChainSelector,
 		destChainSelector:             dstChainSelector,
 		priceRegistryProvider:         ccip.NewChainAgnosticPriceRegistry(dstProvider),
 		tokenPoolBatchedReader:        tokenPoolBatchedReader,
@@ -167,32 +333,12 @@ func NewExecServices(ctx context.Context, lggr logger.Logger, jb job.Job, srcPro
 		chainHealthcheck:              chainHealthcheck,
 		newReportingPluginRetryConfig: defaultNewReportingPluginRetryConfig,
 		txmStatusChecker:              statuschecker.NewTxmStatusChecker(dstProvider.GetTransactionStatus),
+		feeBoosting:                   feeBoosting,
+		batching:                      batching,
+		observedPrices:                observedPrices,
+		inflightORM:                   inflightORM,
 	})
-
-	argsNoPlugin.ReportingPluginFactory = promwrapper.NewPromFactory(wrappedPluginFactory, "CCIPExecution", jb.OCR2OracleSpec.Relay, big.NewInt(0).SetInt64(dstChainID))
-	argsNoPlugin.Logger = commonlogger.NewOCRWrapper(lggr, true, logError)
-	oracle, err := libocr2.NewOracle(argsNoPlugin)
-	if err != nil {
-		return nil, err
-	}
-	// If this is a brand-new job, then we make use of the start blocks. If not then we're rebooting and log poller will pick up where we left off.
-	if new {
-		return []job.ServiceCtx{
-			oraclelib.NewChainAgnosticBackFilledOracle(
-				lggr,
-				srcProvider,
-				dstProvider,
-				job.NewServiceAdapter(oracle),
-			),
-			chainHealthcheck,
-			tokenBackgroundWorker,
-		}, nil
-	}
-	return []job.ServiceCtx{
-		job.NewServiceAdapter(oracle),
-		chainHealthcheck,
-		tokenBackgroundWorker,
-	}, nil
+	return factory, []job.ServiceCtx{chainHealthcheck, tokenBackgroundWorker}, nil
 }
 
 // UnregisterExecPluginLpFilters unregisters all the registered filters for both source and dest chains.
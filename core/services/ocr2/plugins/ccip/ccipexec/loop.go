@@ -0,0 +1,42 @@
+package ccipexec
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config/env"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcommon"
+)
+
+// OffRampAddressEnv passes the OffRamp address of the job to the execution plugin running as a LOOP, since
+// [types.CCIPExecutionFactoryGenerator] does not carry it.
+var OffRampAddressEnv = env.Var("CL_CCIPEXEC_OFFRAMP_ADDRESS")
+
+var _ types.CCIPExecutionFactoryGenerator = (*FactoryGenerator)(nil)
+
+// FactoryGenerator creates execution reporting plugin factories within a LOOP. The providers, and so all the readers
+// of the plugin, are served by the node over gRPC.
+type FactoryGenerator struct {
+	lggr logger.Logger
+}
+
+func NewFactoryGenerator(lggr logger.Logger) *FactoryGenerator {
+	return &FactoryGenerator{lggr: lggr}
+}
+
+func (g *FactoryGenerator) NewExecutionFactory(ctx context.Context, srcProvider types.CCIPExecProvider, dstProvider types.CCIPExecProvider, srcChainID int64, dstChainID int64, sourceTokenAddress string) (types.ReportingPluginFactory, error) {
+	offRampAddress := OffRampAddressEnv.Get()
+	if offRampAddress == "" {
+		return nil, fmt.Errorf("%s is not set", OffRampAddressEnv)
+	}
+	factory, srvs, err := newExecutionPluginFactory(ctx, g.lggr, cciptypes.Address(offRampAddress), cciptypes.Address(sourceTokenAddress), srcProvider, dstProvider, srcChainID, dstChainID, ccipconfig.FeeBoostingConfig{}, ccipconfig.BatchingConfig{}, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return ccipcommon.NewFactoryService(g.lggr.Named("CCIPExecutionFactory"), factory, srvs), nil
+}
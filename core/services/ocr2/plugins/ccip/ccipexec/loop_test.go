@@ -0,0 +1,16 @@
+package ccipexec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+func TestFactoryGenerator_MissingOffRampAddress(t *testing.T) {
+	t.Setenv(string(OffRampAddressEnv), "")
+	_, err := NewFactoryGenerator(logger.TestLogger(t)).NewExecutionFactory(testutils.Context(t), nil, nil, 1, 2, "")
+	require.ErrorContains(t, err, "CL_CCIPEXEC_OFFRAMP_ADDRESS is not set")
+}
@@ -0,0 +1,59 @@
+package ccipexec
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	cciporm "github.com/smartcontractkit/chainlink/v2/core/services/ccip"
+)
+
+// observedPriceReader reads the token prices stored by the PriceService of the commit plugins running on the node.
+// These are the prices the commit plugins are about to report, so they are fresher than the ones of the price
+// registries.
+type observedPriceReader struct {
+	orm    cciporm.ORM
+	maxAge time.Duration
+}
+
+func newObservedPriceReader(orm cciporm.ORM, maxAge time.Duration) *observedPriceReader {
+	return &observedPriceReader{orm: orm, maxAge: maxAge}
+}
+
+// tokenPrices returns the prices of tokens observed for the price registry of chainSelector, with the same
+// denomination as the ones of the price registry. It returns false if a price is missing, or if no price of the chain
+// was updated in the last maxAge, which means no commit plugin is observing them anymore.
+// laneChainSelector is the other chain of the lane, it doesn't affect the token prices.
+func (o *observedPriceReader) tokenPrices(ctx context.Context, chainSelector, laneChainSelector uint64, tokens []cciptypes.Address) (map[cciptypes.Address]*big.Int, bool, error) {
+	updateTimes, err := o.orm.GetPriceUpdateTimes(ctx, chainSelector, laneChainSelector)
+	if err != nil {
+		return nil, false, fmt.Errorf("get price update times: %w", err)
+	}
+	if !updateTimes.TokenPrices.Valid || time.Since(updateTimes.TokenPrices.Time) > o.maxAge {
+		return nil, false, nil
+	}
+
+	observed, err := o.orm.GetTokenPricesByDestChain(ctx, chainSelector)
+	if err != nil {
+		return nil, false, fmt.Errorf("get token prices: %w", err)
+	}
+	observedByToken := make(map[cciptypes.Address]*big.Int, len(observed))
+	for _, price := range observed {
+		if price.TokenPrice != nil {
+			observedByToken[cciptypes.Address(price.TokenAddr)] = price.TokenPrice.ToInt()
+		}
+	}
+
+	tokenPrices := make(map[cciptypes.Address]*big.Int, len(tokens))
+	for _, token := range tokens {
+		price, ok := observedByToken[token]
+		if !ok || price.Sign() <= 0 {
+			return nil, false, nil
+		}
+		tokenPrices[token] = price
+	}
+	return tokenPrices, true, nil
+}
@@ -0,0 +1,74 @@
+package ccipexec
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	cciporm "github.com/smartcontractkit/chainlink/v2/core/services/ccip"
+	ccipmocks "github.com/smartcontractkit/chainlink/v2/core/services/ccip/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+	ccipdatamocks "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/mocks"
+)
+
+func TestObservedPriceReader_tokenPrices(t *testing.T) {
+	const chainSelector, laneChainSelector = uint64(1), uint64(2)
+	link := ccipcalc.HexToAddress("0x1")
+	weth := ccipcalc.HexToAddress("0x2")
+	observed := []cciporm.TokenPrice{
+		{TokenAddr: string(link), TokenPrice: assets.NewWeiI(10)},
+		{TokenAddr: string(weth), TokenPrice: assets.NewWeiI(2000)},
+	}
+
+	testCases := []struct {
+		name      string
+		updatedAt null.Time
+		tokens    []cciptypes.Address
+		expOK     bool
+	}{
+		{name: "fresh", updatedAt: null.TimeFrom(time.Now().Add(-time.Minute)), tokens: []cciptypes.Address{link, weth}, expOK: true},
+		{name: "stale", updatedAt: null.TimeFrom(time.Now().Add(-time.Hour)), tokens: []cciptypes.Address{link, weth}},
+		{name: "never updated", tokens: []cciptypes.Address{link}},
+		{name: "missing token", updatedAt: null.TimeFrom(time.Now()), tokens: []cciptypes.Address{link, ccipcalc.HexToAddress("0x3")}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := testutils.Context(t)
+			orm := ccipmocks.NewORM(t)
+			orm.On("GetPriceUpdateTimes", ctx, chainSelector, laneChainSelector).Return(cciporm.PriceUpdateTimes{TokenPrices: tc.updatedAt}, nil)
+			orm.On("GetTokenPricesByDestChain", ctx, chainSelector).Return(observed, nil).Maybe()
+
+			prices, ok, err := newObservedPriceReader(orm, 10*time.Minute).tokenPrices(ctx, chainSelector, laneChainSelector, tc.tokens)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expOK, ok)
+			if tc.expOK {
+				assert.Equal(t, map[cciptypes.Address]*big.Int{link: big.NewInt(10), weth: big.NewInt(2000)}, prices)
+			}
+		})
+	}
+}
+
+func TestExecutionReportingPlugin_tokenPrices(t *testing.T) {
+	ctx := testutils.Context(t)
+	link := ccipcalc.HexToAddress("0x1")
+
+	orm := ccipmocks.NewORM(t)
+	orm.On("GetPriceUpdateTimes", ctx, uint64(1), uint64(2)).Return(cciporm.PriceUpdateTimes{}, nil)
+	priceRegistry := ccipdatamocks.NewPriceRegistryReader(t)
+	priceRegistry.On("GetTokenPrices", ctx, []cciptypes.Address{link}).
+		Return([]cciptypes.TokenPriceUpdate{{TokenPrice: cciptypes.TokenPrice{Token: link, Value: big.NewInt(5)}}}, nil)
+
+	p := &ExecutionReportingPlugin{lggr: logger.TestLogger(t), observedPrices: newObservedPriceReader(orm, time.Minute)}
+	prices, err := p.tokenPrices(ctx, 1, 2, priceRegistry, []cciptypes.Address{link})
+	require.NoError(t, err)
+	assert.Equal(t, map[cciptypes.Address]*big.Int{link: big.NewInt(5)}, prices)
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
@@ -18,7 +19,9 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/hashutil"
 	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	cciporm "github.com/smartcontractkit/chainlink/v2/core/services/ccip"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip"
+	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/cache"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcommon"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
@@ -26,6 +29,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/ccipdataprovider"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/prices"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/tokendata"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/promwrapper"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/statuschecker"
 )
 
@@ -47,6 +51,7 @@ const (
 var (
 	_ types.ReportingPluginFactory = &ExecutionReportingPluginFactory{}
 	_ types.ReportingPlugin        = &ExecutionReportingPlugin{}
+	_ promwrapper.MetricsReporter  = &ExecutionReportingPlugin{}
 )
 
 type ExecutionPluginStaticConfig struct {
@@ -56,6 +61,7 @@ type ExecutionPluginStaticConfig struct {
 	commitStoreReader             ccipdata.CommitStoreReader
 	sourcePriceRegistryProvider   ccipdataprovider.PriceRegistry
 	sourceWrappedNativeToken      cciptypes.Address
+	sourceChainSelector           uint64
 	tokenDataWorker               tokendata.Worker
 	destChainSelector             uint64
 	priceRegistryProvider         ccipdataprovider.PriceRegistry // destination price registry provider.
@@ -64,6 +70,12 @@ type ExecutionPluginStaticConfig struct {
 	chainHealthcheck              cache.ChainHealthcheck
 	newReportingPluginRetryConfig ccipdata.RetryConfig
 	txmStatusChecker              statuschecker.CCIPTransactionStatusChecker
+	feeBoosting                   ccipconfig.FeeBoostingConfig
+	batching                      ccipconfig.BatchingConfig
+	// observedPrices reads the token prices observed by the commit plugins of the node, nil if disabled.
+	observedPrices *observedPriceReader
+	// inflightORM persists the inflight reports across restarts, nil if they are only kept in memory.
+	inflightORM cciporm.ORM
 }
 
 type ExecutionReportingPlugin struct {
@@ -74,6 +86,11 @@ type ExecutionReportingPlugin struct {
 	tokenDataWorker  tokendata.Worker
 	metricsCollector ccip.PluginMetricsCollector
 	batchingStrategy BatchingStrategy
+	feeBooster       feeBooster
+	observedPrices   *observedPriceReader
+	// batchSize and skippedMessages are registered by the promwrapper, nil until then.
+	batchSize       prometheus.Observer
+	skippedMessages prometheus.Counter
 
 	// Source
 	gasPriceEstimator           prices.GasPriceEstimatorExec
@@ -81,9 +98,11 @@ type ExecutionReportingPlugin struct {
 	sourcePriceRegistryProvider ccipdataprovider.PriceRegistry
 	sourcePriceRegistryLock     sync.RWMutex
 	sourceWrappedNativeToken    cciptypes.Address
+	sourceChainSelector         uint64
 	onRampReader                ccipdata.OnRampReader
 
 	// Dest
+	destChainSelector      uint64
 	commitStoreReader      ccipdata.CommitStoreReader
 	destPriceRegistry      ccipdata.PriceRegistryReader
 	destWrappedNative      cciptypes.Address
@@ -115,7 +134,7 @@ func (r *ExecutionReportingPlugin) Observation(ctx context.Context, timestamp ty
 	}
 
 	// Expire any inflight reports.
-	r.inflightReports.expire(lggr)
+	r.inflightReports.expire(ctx, lggr)
 	inFlight := r.inflightReports.getAll()
 
 	executableObservations, err := r.getExecutableObservations(ctx, lggr, inFlight)
@@ -148,6 +167,9 @@ func (r *ExecutionReportingPlugin) getExecutableObservations(ctx context.Context
 		return nil, err
 	}
 	r.metricsCollector.UnexpiredCommitRoots(len(unexpiredReports))
+	ccip.Lanes.Update(r.sourceChainSelector, r.destChainSelector, func(status *ccip.LaneStatus) {
+		status.UnexpiredCommitRoots = len(unexpiredReports)
+	})
 
 	if len(unexpiredReports) == 0 {
 		return []ccip.ObservedMessage{}, nil
@@ -220,6 +242,7 @@ func (r *ExecutionReportingPlugin) getExecutableObservations(ctx context.Context
 				tokenExecData.destTokenPrices,
 				tokenExecData.gasPrice,
 				tokenExecData.sourceToDestTokens)
+			r.observeBatch(batch, msgExecStates)
 			if len(batch) != 0 {
 				lggr.Infow("Execution batch created", "batchSize", len(batch), "messageStates", msgExecStates)
 				return batch, nil
@@ -250,6 +273,33 @@ func (r *ExecutionReportingPlugin) getExecutedSeqNrsInRange(ctx context.Context,
 	return executedMp, nil
 }
 
+// RegisterMetrics registers the size of the execution batches and the number of messages skipped while building them.
+func (r *ExecutionReportingPlugin) RegisterMetrics(m *promwrapper.PluginMetrics) (err error) {
+	r.batchSize, err = m.NewHistogram("ccip_exec_batch_size", "The number of messages in the execution batches observed", []float64{1, 2, 5, 10, 20, 50, 100, 200})
+	if err != nil {
+		return err
+	}
+	r.skippedMessages, err = m.NewCounter("ccip_exec_messages_skipped", "The number of messages skipped while building execution batches, excluding the already executed ones")
+	return err
+}
+
+// observeBatch reports a batch built from a commit report, and the messages of the report which were skipped.
+func (r *ExecutionReportingPlugin) observeBatch(batch []ccip.ObservedMessage, states []messageExecStatus) {
+	if r.batchSize == nil {
+		return
+	}
+	if len(batch) != 0 {
+		r.batchSize.Observe(float64(len(batch)))
+	}
+	var skipped int
+	for _, state := range states {
+		if state.Status != AddedToBatch && state.Status != AlreadyExecuted {
+			skipped++
+		}
+	}
+	r.skippedMessages.Add(float64(skipped))
+}
+
 // Builds a batch of transactions that can be executed, takes into account
 // the available gas, rate limiting, execution state, nonce state, and
 // profitability of execution.
@@ -300,6 +350,7 @@ func (r *ExecutionReportingPlugin) buildBatch(
 		r.gasPriceEstimator,
 		r.destWrappedNative,
 		r.offchainConfig,
+		r.feeBooster,
 	}
 
 	return r.batchingStrategy.BuildBatch(ctx, batchCtx)
@@ -582,11 +633,14 @@ func (r *ExecutionReportingPlugin) ShouldAcceptFinalizedReport(ctx context.Conte
 		return false, nil
 	}
 	// Else just assume in flight
-	if err = r.inflightReports.add(lggr, execReport.Messages); err != nil {
+	if err = r.inflightReports.add(ctx, lggr, execReport.Messages); err != nil {
 		return false, err
 	}
 	if len(execReport.Messages) > 0 {
 		r.metricsCollector.SequenceNumber(ccip.ShouldAccept, execReport.Messages[len(execReport.Messages)-1].SequenceNumber)
+		ccip.Lanes.Update(r.sourceChainSelector, r.destChainSelector, func(status *ccip.LaneStatus) {
+			status.LatestExecutedSeqNr = max(status.LatestExecutedSeqNr, execReport.Messages[len(execReport.Messages)-1].SequenceNumber)
+		})
 	}
 	lggr.Info("Accepting finalized report")
 	return true, nil
@@ -704,6 +758,22 @@ func getTokensPrices(ctx context.Context, priceRegistry ccipdata.PriceRegistryRe
 	return tokenPrices, nil
 }
 
+// tokenPrices returns the token prices observed by the commit plugins of the node for the price registry of
+// chainSelector if enabled and fresh, or the token prices of the price registry otherwise.
+func (r *ExecutionReportingPlugin) tokenPrices(ctx context.Context, chainSelector, laneChainSelector uint64, priceRegistry ccipdata.PriceRegistryReader, tokens []cciptypes.Address) (map[cciptypes.Address]*big.Int, error) {
+	if r.observedPrices != nil {
+		observed, ok, err := r.observedPrices.tokenPrices(ctx, chainSelector, laneChainSelector, tokens)
+		if err != nil {
+			r.lggr.Warnw("Failed to read observed token prices, falling back to the price registry", "chainSelector", chainSelector, "err", err)
+		} else if ok {
+			return observed, nil
+		} else {
+			r.lggr.Debugw("Observed token prices are missing or stale, falling back to the price registry", "chainSelector", chainSelector)
+		}
+	}
+	return getTokensPrices(ctx, priceRegistry, tokens)
+}
+
 type execTokenData struct {
 	rateLimiterTokenBucket cciptypes.TokenBucketRateLimit
 	sourceTokenPrices      map[cciptypes.Address]*big.Int
@@ -730,8 +800,10 @@ func (r *ExecutionReportingPlugin) prepareTokenExecData(ctx context.Context) (ex
 	if err != nil {
 		return execTokenData{}, fmt.Errorf("get source fee tokens: %w", err)
 	}
-	sourceTokensPrices, err := getTokensPrices(
+	sourceTokensPrices, err := r.tokenPrices(
 		ctx,
+		r.sourceChainSelector,
+		r.destChainSelector,
 		r.sourcePriceRegistry,
 		ccipcommon.FlattenUniqueSlice(
 			sourceFeeTokens,
@@ -746,8 +818,10 @@ func (r *ExecutionReportingPlugin) prepareTokenExecData(ctx context.Context) (ex
 	if err != nil {
 		return execTokenData{}, fmt.Errorf("get destination tokens: %w", err)
 	}
-	destTokenPrices, err := getTokensPrices(
+	destTokenPrices, err := r.tokenPrices(
 		ctx,
+		r.destChainSelector,
+		r.sourceChainSelector,
 		r.destPriceRegistry,
 		ccipcommon.FlattenUniqueSlice(
 			destFeeTokens,
@@ -0,0 +1,177 @@
+package ccipexec
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	cciporm "github.com/smartcontractkit/chainlink/v2/core/services/ccip"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/batchreader"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+var (
+	tokenPoolBalance = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ccip_token_pool_balance",
+		Help: "Balance of the dest token pool in its token, which is the amount locked by a lock/release pool",
+	}, []string{"source", "dest", "pool", "token"})
+	tokenPoolRateLimitTokens = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ccip_token_pool_rate_limit_tokens",
+		Help: "Tokens left in the inbound rate limiter of the dest token pool, only reported if it is enabled",
+	}, []string{"source", "dest", "pool", "token"})
+	tokenPoolAvailableLiquidity = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ccip_token_pool_available_liquidity",
+		Help: "Amount the dest token pool can release right now, limited by both its balance and its inbound rate limiter",
+	}, []string{"source", "dest", "pool", "token"})
+)
+
+const defaultTokenPoolLiquidityInterval = time.Minute
+
+var _ job.ServiceCtx = (*tokenPoolLiquidityMonitor)(nil)
+
+// tokenPoolLiquidityMonitor periodically reads the liquidity and the inbound rate limiter state of the dest token pools
+// of the lane, records them in the DB and reports them with the ccip_token_pool_* metrics, so that a pool running dry
+// is noticed before messages fail to execute.
+type tokenPoolLiquidityMonitor struct {
+	lggr                logger.Logger
+	reader              batchreader.TokenPoolLiquidityReader
+	orm                 cciporm.ORM
+	pools               []cciptypes.Address
+	sourceChainSelector uint64
+	destChainSelector   uint64
+	sourceChainID       string
+	destChainID         string
+	interval            time.Duration
+
+	services.StateMachine
+	wg               sync.WaitGroup
+	backgroundCtx    context.Context //nolint:containedctx
+	backgroundCancel context.CancelFunc
+}
+
+func newTokenPoolLiquidityMonitor(
+	lggr logger.Logger,
+	reader batchreader.TokenPoolLiquidityReader,
+	orm cciporm.ORM,
+	pools []cciptypes.Address,
+	sourceChainSelector, destChainSelector uint64,
+	sourceChainID, destChainID int64,
+	interval time.Duration,
+) *tokenPoolLiquidityMonitor {
+	if interval == 0 {
+		interval = defaultTokenPoolLiquidityInterval
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &tokenPoolLiquidityMonitor{
+		lggr:                lggr.Named("TokenPoolLiquidityMonitor"),
+		reader:              reader,
+		orm:                 orm,
+		pools:               pools,
+		sourceChainSelector: sourceChainSelector,
+		destChainSelector:   destChainSelector,
+		sourceChainID:       strconv.FormatInt(sourceChainID, 10),
+		destChainID:         strconv.FormatInt(destChainID, 10),
+		interval:            interval,
+		backgroundCtx:       ctx,
+		backgroundCancel:    cancel,
+	}
+}
+
+func (m *tokenPoolLiquidityMonitor) Start(context.Context) error {
+	return m.StateMachine.StartOnce("TokenPoolLiquidityMonitor", func() error {
+		m.lggr.Infow("Starting TokenPoolLiquidityMonitor", "pools", m.pools, "interval", m.interval)
+		m.wg.Add(1)
+		go m.run()
+		return nil
+	})
+}
+
+func (m *tokenPoolLiquidityMonitor) Close() error {
+	return m.StateMachine.StopOnce("TokenPoolLiquidityMonitor", func() error {
+		m.lggr.Info("Closing TokenPoolLiquidityMonitor")
+		m.backgroundCancel()
+		m.wg.Wait()
+		return nil
+	})
+}
+
+func (m *tokenPoolLiquidityMonitor) run() {
+	defer m.wg.Done()
+	ticker := time.NewTicker(utils.WithJitter(m.interval))
+	defer ticker.Stop()
+	for {
+		if err := m.check(m.backgroundCtx); err != nil {
+			m.lggr.Errorw("Failed to check token pool liquidity", "err", err)
+		}
+		select {
+		case <-m.backgroundCtx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// check reads the liquidity of the pools, then records and reports it.
+func (m *tokenPoolLiquidityMonitor) check(ctx context.Context) error {
+	liquidity, err := m.reader.GetTokenPoolLiquidity(ctx, m.pools)
+	if err != nil {
+		return fmt.Errorf("get token pool liquidity: %w", err)
+	}
+
+	rows := make([]cciporm.TokenPoolLiquidity, 0, len(liquidity))
+	for _, l := range liquidity {
+		labels := []string{m.sourceChainID, m.destChainID, string(l.Pool), string(l.Token)}
+		available := l.Available()
+		tokenPoolBalance.WithLabelValues(labels...).Set(toFloat(l.Balance))
+		if l.RateLimit.IsEnabled {
+			tokenPoolRateLimitTokens.WithLabelValues(labels...).Set(toFloat(l.RateLimit.Tokens))
+		}
+		tokenPoolAvailableLiquidity.WithLabelValues(labels...).Set(toFloat(available))
+		if available.Sign() == 0 {
+			m.lggr.Warnw("Token pool has no liquidity available", "pool", l.Pool, "poolType", l.PoolType, "token", l.Token,
+				"balance", l.Balance, "rateLimitTokens", l.RateLimit.Tokens)
+		}
+
+		rows = append(rows, cciporm.TokenPoolLiquidity{
+			SourceChainSelector: m.sourceChainSelector,
+			PoolAddr:            string(l.Pool),
+			TokenAddr:           string(l.Token),
+			Balance:             ubig.New(l.Balance),
+			RateLimitEnabled:    l.RateLimit.IsEnabled,
+			RateLimitTokens:     ubig.New(nonNilBig(l.RateLimit.Tokens)),
+			RateLimitCapacity:   ubig.New(nonNilBig(l.RateLimit.Capacity)),
+		})
+	}
+
+	if _, err = m.orm.UpsertTokenPoolLiquidity(ctx, m.destChainSelector, rows); err != nil {
+		return fmt.Errorf("record token pool liquidity: %w", err)
+	}
+	return nil
+}
+
+func toFloat(v *big.Int) float64 {
+	if v == nil {
+		return 0
+	}
+	f, _ := new(big.Float).SetInt(v).Float64()
+	return f
+}
+
+func nonNilBig(v *big.Int) *big.Int {
+	if v == nil {
+		return big.NewInt(0)
+	}
+	return v
+}
@@ -0,0 +1,67 @@
+package ccipexec
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	cciporm "github.com/smartcontractkit/chainlink/v2/core/services/ccip"
+	ccipmocks "github.com/smartcontractkit/chainlink/v2/core/services/ccip/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/batchreader"
+)
+
+type fakeTokenPoolLiquidityReader []batchreader.TokenPoolLiquidity
+
+func (r fakeTokenPoolLiquidityReader) GetTokenPoolLiquidity(context.Context, []cciptypes.Address) ([]batchreader.TokenPoolLiquidity, error) {
+	return r, nil
+}
+
+func TestTokenPoolLiquidityMonitor_check(t *testing.T) {
+	const sourceChainSelector, destChainSelector = uint64(1), uint64(2)
+	pool, token := ccipcalc.HexToAddress("0x1"), ccipcalc.HexToAddress("0x2")
+	reader := fakeTokenPoolLiquidityReader{{
+		Pool:     pool,
+		PoolType: "LockReleaseTokenPool",
+		Token:    token,
+		Balance:  big.NewInt(500),
+		RateLimit: cciptypes.TokenBucketRateLimit{
+			Tokens:    big.NewInt(100),
+			Capacity:  big.NewInt(1000),
+			Rate:      big.NewInt(1),
+			IsEnabled: true,
+		},
+	}}
+
+	orm := ccipmocks.NewORM(t)
+	orm.On("UpsertTokenPoolLiquidity", mock.Anything, destChainSelector, mock.Anything).Return(int64(1), nil).Run(func(args mock.Arguments) {
+		rows := args.Get(2).([]cciporm.TokenPoolLiquidity)
+		require.Len(t, rows, 1)
+		assert.Equal(t, sourceChainSelector, rows[0].SourceChainSelector)
+		assert.Equal(t, string(pool), rows[0].PoolAddr)
+		assert.Equal(t, string(token), rows[0].TokenAddr)
+		assert.Equal(t, int64(500), rows[0].Balance.Int64())
+		assert.True(t, rows[0].RateLimitEnabled)
+		assert.Equal(t, int64(100), rows[0].RateLimitTokens.Int64())
+		assert.Equal(t, int64(1000), rows[0].RateLimitCapacity.Int64())
+	}).Once()
+
+	monitor := newTokenPoolLiquidityMonitor(logger.TestLogger(t), reader, orm, []cciptypes.Address{pool},
+		sourceChainSelector, destChainSelector, 1337, 2337, time.Minute)
+	require.NoError(t, monitor.check(testutils.Context(t)))
+
+	labels := []string{"1337", "2337", string(pool), string(token)}
+	assert.Equal(t, float64(500), testutil.ToFloat64(tokenPoolBalance.WithLabelValues(labels...)))
+	assert.Equal(t, float64(100), testutil.ToFloat64(tokenPoolRateLimitTokens.WithLabelValues(labels...)))
+	assert.Equal(t, float64(100), testutil.ToFloat64(tokenPoolAvailableLiquidity.WithLabelValues(labels...)))
+}
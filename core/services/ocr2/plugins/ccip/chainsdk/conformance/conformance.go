@@ -0,0 +1,206 @@
+// Package conformance tests the implementations of a chain family against the expectations of the CCIP plugins. The
+// tests of a family call its functions with the readers of its providers, built against a chain in the given state.
+package conformance
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/chainsdk"
+)
+
+// TestAddressCodec tests that codec decodes the valid addresses and renders them in a canonical format which decodes to
+// the same bytes and renders to itself, and that it rejects the invalid addresses.
+func TestAddressCodec(t *testing.T, codec chainsdk.AddressCodec, valid, invalid []cciptypes.Address) {
+	for _, addr := range valid {
+		raw, err := codec.Decode(addr)
+		require.NoError(t, err, "decode valid address %q", addr)
+		canonical, err := codec.Encode(raw)
+		require.NoError(t, err, "encode address %q", addr)
+		canonicalRaw, err := codec.Decode(canonical)
+		require.NoError(t, err, "decode canonical address %q", canonical)
+		assert.Equal(t, raw, canonicalRaw, "canonical address %q of %q decodes to other bytes", canonical, addr)
+		again, err := codec.Encode(canonicalRaw)
+		require.NoError(t, err)
+		assert.Equal(t, canonical, again, "canonical format of %q is not stable", addr)
+	}
+	for _, addr := range invalid {
+		_, err := codec.Decode(addr)
+		assert.Error(t, err, "decode invalid address %q", addr)
+	}
+}
+
+// TestCommitReportCodec tests that the commit reports encoded by the reader decode to the same reports. token must be
+// an address in the canonical format of the family.
+func TestCommitReportCodec(t *testing.T, reader chainsdk.CommitStoreReader, token cciptypes.Address) {
+	ctx := tests.Context(t)
+	prices := cciptypes.CommitStoreReport{
+		TokenPrices: []cciptypes.TokenPrice{{Token: token, Value: big.NewInt(1e18)}},
+		GasPrices:   []cciptypes.GasPrice{{DestChainSelector: 1, Value: big.NewInt(2e9)}},
+	}
+	withRoot := prices
+	withRoot.Interval = cciptypes.CommitStoreInterval{Min: 1, Max: 10}
+	withRoot.MerkleRoot = [32]byte{1, 2, 3}
+
+	for _, report := range []cciptypes.CommitStoreReport{prices, withRoot} {
+		encoded, err := reader.EncodeCommitReport(ctx, report)
+		require.NoError(t, err)
+		decoded, err := reader.DecodeCommitReport(ctx, encoded)
+		require.NoError(t, err)
+		assert.Equal(t, report, decoded)
+	}
+}
+
+// TestGasPriceEstimatorCommit tests the properties of the gas price estimator which the commit plugin relies on, the
+// current gas price being denoted in USD with the given price of the wrapped native token.
+func TestGasPriceEstimatorCommit(t *testing.T, estimator chainsdk.GasPriceEstimatorCommit, wrappedNativePrice *big.Int) {
+	price := testCommonGasPriceEstimator(t, estimator, wrappedNativePrice)
+
+	deviates, err := estimator.Deviates(price, price)
+	require.NoError(t, err)
+	assert.False(t, deviates, "gas price %s deviates from itself", price)
+}
+
+// TestGasPriceEstimatorExec tests the properties of the gas price estimator which the execution plugin relies on, the
+// current gas price being denoted in USD with the given price of the wrapped native token.
+func TestGasPriceEstimatorExec(t *testing.T, estimator chainsdk.GasPriceEstimatorExec, wrappedNativePrice *big.Int) {
+	price := testCommonGasPriceEstimator(t, estimator, wrappedNativePrice)
+
+	msg := cciptypes.EVM2EVMOnRampCCIPSendRequestedWithMeta{EVM2EVMMessage: cciptypes.EVM2EVMMessage{GasLimit: big.NewInt(200_000)}}
+	cost, err := estimator.EstimateMsgCostUSD(price, wrappedNativePrice, msg)
+	require.NoError(t, err)
+	require.NotNil(t, cost)
+	assert.GreaterOrEqual(t, cost.Sign(), 0, "negative message cost %s", cost)
+
+	msg.GasLimit = big.NewInt(400_000)
+	higherCost, err := estimator.EstimateMsgCostUSD(price, wrappedNativePrice, msg)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, higherCost.Cmp(cost), 0, "message cost %s decreases with its gas limit to %s", cost, higherCost)
+}
+
+func testCommonGasPriceEstimator(t *testing.T, estimator cciptypes.CommonGasPriceEstimator, wrappedNativePrice *big.Int) *big.Int {
+	ctx := tests.Context(t)
+	price, err := estimator.GetGasPrice(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, price)
+	require.GreaterOrEqual(t, price.Sign(), 0, "negative gas price %s", price)
+
+	median, err := estimator.Median([]*big.Int{price})
+	require.NoError(t, err)
+	assert.Equal(t, 0, median.Cmp(price), "median %s of the single gas price %s", median, price)
+
+	double, triple := new(big.Int).Mul(price, big.NewInt(2)), new(big.Int).Mul(price, big.NewInt(3))
+	median, err = estimator.Median([]*big.Int{triple, price, double})
+	require.NoError(t, err)
+	sortedMedian, err := estimator.Median([]*big.Int{price, double, triple})
+	require.NoError(t, err)
+	assert.Equal(t, 0, median.Cmp(sortedMedian), "median depends on the order of the gas prices")
+
+	usd, err := estimator.DenoteInUSD(price, wrappedNativePrice)
+	require.NoError(t, err)
+	require.NotNil(t, usd)
+	assert.GreaterOrEqual(t, usd.Sign(), 0, "negative USD gas price %s", usd)
+	return price
+}
+
+// OnRamp is the state of an onRamp which sent the finalized messages of sequence numbers SeqNumMin to SeqNumMax, or no
+// message if SeqNumMax is 0, as sequence numbers start at 1.
+type OnRamp struct {
+	Address              cciptypes.Address
+	SeqNumMin, SeqNumMax uint64
+}
+
+// TestOnRampReader tests that the reader returns the sent messages in sequence number order.
+func TestOnRampReader(t *testing.T, reader chainsdk.OnRampReader, onRamp OnRamp) {
+	ctx := tests.Context(t)
+
+	addr, err := reader.Address(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, onRamp.Address, addr)
+
+	if onRamp.SeqNumMax == 0 {
+		msgs, err2 := reader.GetSendRequestsBetweenSeqNums(ctx, 1, 10, true)
+		require.NoError(t, err2)
+		assert.Empty(t, msgs)
+		return
+	}
+	require.LessOrEqual(t, onRamp.SeqNumMin, onRamp.SeqNumMax)
+
+	msgs, err := reader.GetSendRequestsBetweenSeqNums(ctx, onRamp.SeqNumMin, onRamp.SeqNumMax, true)
+	require.NoError(t, err)
+	require.Len(t, msgs, int(onRamp.SeqNumMax-onRamp.SeqNumMin+1))
+	for i, msg := range msgs {
+		assert.Equal(t, onRamp.SeqNumMin+uint64(i), msg.SequenceNumber, "message %d out of sequence number order", i)
+	}
+
+	msgs, err = reader.GetSendRequestsBetweenSeqNums(ctx, onRamp.SeqNumMax, onRamp.SeqNumMax, true)
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+	assert.Equal(t, onRamp.SeqNumMax, msgs[0].SequenceNumber)
+}
+
+// OffRamp is the state of an offRamp.
+type OffRamp struct {
+	Address      cciptypes.Address
+	StaticConfig cciptypes.OffRampStaticConfig
+}
+
+// TestOffRampReader tests that the reader returns the config and the tokens of the offRamp.
+func TestOffRampReader(t *testing.T, reader chainsdk.OffRampReader, offRamp OffRamp) {
+	ctx := tests.Context(t)
+
+	addr, err := reader.Address(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, offRamp.Address, addr)
+
+	staticConfig, err := reader.GetStaticConfig(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, offRamp.StaticConfig, staticConfig)
+
+	tokens, err := reader.GetTokens(ctx)
+	require.NoError(t, err)
+	mapping, err := reader.GetSourceToDestTokensMapping(ctx)
+	require.NoError(t, err)
+	for source, dest := range mapping {
+		assert.Contains(t, tokens.DestinationTokens, dest, "dest token %s of source token %s is not a token of the offRamp", dest, source)
+	}
+}
+
+// PriceRegistry is the state of a price registry with the given priced tokens.
+type PriceRegistry struct {
+	Address cciptypes.Address
+	Tokens  []cciptypes.Address
+	// Decimals are the decimals of the tokens, only checked if set, as the tokens may not be deployed.
+	Decimals []uint8
+}
+
+// TestPriceRegistryReader tests that the reader returns the prices and decimals of the tokens in their order.
+func TestPriceRegistryReader(t *testing.T, reader chainsdk.PriceRegistryReader, priceRegistry PriceRegistry) {
+	ctx := tests.Context(t)
+
+	addr, err := reader.Address(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, priceRegistry.Address, addr)
+
+	prices, err := reader.GetTokenPrices(ctx, priceRegistry.Tokens)
+	require.NoError(t, err)
+	require.Len(t, prices, len(priceRegistry.Tokens))
+	for i, price := range prices {
+		assert.Equal(t, priceRegistry.Tokens[i], price.Token, "token price %d out of order", i)
+	}
+
+	if priceRegistry.Decimals != nil {
+		decimals, err2 := reader.GetTokensDecimals(ctx, priceRegistry.Tokens)
+		require.NoError(t, err2)
+		assert.Equal(t, priceRegistry.Decimals, decimals)
+	}
+
+	_, err = reader.GetFeeTokens(ctx)
+	require.NoError(t, err)
+}
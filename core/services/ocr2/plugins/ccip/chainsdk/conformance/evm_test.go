@@ -0,0 +1,74 @@
+package conformance_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	gasmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/mocks"
+	lpmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/addrcodec"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/chainsdk"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/chainsdk/conformance"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/v1_2_0"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/v1_5_0"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/prices"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
+)
+
+func TestEVMConformance(t *testing.T) {
+	token := ccipcalc.EvmAddrToGeneric(utils.RandomAddress())
+	wrappedNativePrice := assets.Ether(2000).ToInt()
+
+	t.Run("address codec", func(t *testing.T) {
+		family, err := chainsdk.Lookup(relay.NetworkEVM)
+		require.NoError(t, err)
+		conformance.TestAddressCodec(t, family.AddressCodec,
+			[]cciptypes.Address{"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", token},
+			[]cciptypes.Address{"0x1", "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaez", "So11111111111111111111111111111111111111112"})
+	})
+
+	t.Run("commit report codec", func(t *testing.T) {
+		v120, err := v1_2_0.NewCommitStore(logger.TestLogger(t), utils.RandomAddress(), evmclimocks.NewClient(t), lpmocks.NewLogPoller(t))
+		require.NoError(t, err)
+		conformance.TestCommitReportCodec(t, v120, token)
+
+		v150, err := v1_5_0.NewCommitStore(logger.TestLogger(t), utils.RandomAddress(), evmclimocks.NewClient(t), lpmocks.NewLogPoller(t))
+		require.NoError(t, err)
+		conformance.TestCommitReportCodec(t, v150, token)
+	})
+
+	t.Run("gas price estimators", func(t *testing.T) {
+		feeEstimator := gasmocks.NewEvmFeeEstimator(t)
+		feeEstimator.On("GetFee", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(gas.EvmFee{Legacy: assets.GWei(10)}, uint64(0), nil)
+		feeEstimator.On("L1Oracle").Return(nil)
+
+		exec := prices.NewExecGasPriceEstimator(feeEstimator, big.NewInt(1e12), 1e8)
+		conformance.TestGasPriceEstimatorCommit(t, exec, wrappedNativePrice)
+		conformance.TestGasPriceEstimatorExec(t, exec, wrappedNativePrice)
+
+		da := prices.NewDAGasPriceEstimator(feeEstimator, big.NewInt(1e12), 1e8, 2e8)
+		conformance.TestGasPriceEstimatorCommit(t, da, wrappedNativePrice)
+		conformance.TestGasPriceEstimatorExec(t, da, wrappedNativePrice)
+	})
+}
+
+func TestNonEVMAddressCodecs(t *testing.T) {
+	conformance.TestAddressCodec(t, addrcodec.Solana,
+		[]cciptypes.Address{"So11111111111111111111111111111111111111112"},
+		[]cciptypes.Address{"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", "3yZe7d"})
+	conformance.TestAddressCodec(t, addrcodec.Aptos,
+		[]cciptypes.Address{"0x1", "0xA550C18", "0x0000000000000000000000000000000000000000000000000000000000000001"},
+		[]cciptypes.Address{"a550c18", "0x10000000000000000000000000000000000000000000000000000000000000001"})
+}
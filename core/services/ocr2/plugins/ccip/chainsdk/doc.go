@@ -0,0 +1,38 @@
+// Package chainsdk defines what a chain family, e.g. Aptos, Tron or TON, implements to run the CCIP commit and
+// execution plugins on its lanes, and registers the families the plugins support.
+//
+// # Providers
+//
+// The plugins read and write the chains of a lane only through the providers of their relayers, i.e. the
+// commontypes.PluginProvider returned by Relayer.NewPluginProvider for the CCIPCommit and CCIPExecution provider types.
+// A relayer builds a source or a dest provider, depending on the IsSourceProvider field of the config.CommitPluginConfig
+// or config.ExecPluginConfig it is given as plugin config, as some contracts of a lane are only deployed on one chain:
+//
+//   - CommitProvider: the commit plugin builds OnRampReader on the source provider, and CommitStoreReader,
+//     OffRampReader, PriceRegistryReader and PriceGetter on the dest provider.
+//   - ExecProvider: the execution plugin builds OnRampReader, PriceRegistryReader and TokenDataReader on the source
+//     provider, and CommitStoreReader, OffRampReader and TokenPoolBatchedReader on the dest provider.
+//
+// The readers return the gas price estimators of the lane: GasPriceEstimatorCommit from CommitStoreReader and
+// GasPriceEstimatorExec from OffRampReader. Gas prices are opaque *big.Int values which the estimators alone compute
+// with, so a family whose fees have several components can pack them into a single value.
+//
+// # Optional features
+//
+// Some plugin features need more than the readers, and are enabled only with the dest providers implementing their
+// interface, e.g. ExecGasReaderProvider for the GasAttribution of the execution plugin.
+//
+// # Addresses
+//
+// Addresses are strings in the canonical format of their family, which the plugins compare and use as map keys. The
+// AddressCodec of a family decodes and renders them, and the readers must return addresses in its canonical format.
+//
+// # Integration
+//
+// A family is integrated by implementing a relayer returning the providers, and calling Register with its Family from
+// the package of its integration, e.g. in an init function. The conformance package tests the implementations of a
+// family against the expectations of the plugins.
+//
+// The CCIP jobs of the node still only run on EVM chains, as their services are built from the EVM chains of the node:
+// registering a family makes its address codec and conformance available, but not its jobs.
+package chainsdk
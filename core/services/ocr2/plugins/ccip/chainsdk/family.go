@@ -0,0 +1,106 @@
+package chainsdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/addrcodec"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
+)
+
+// The providers and readers implemented by a chain family.
+type (
+	CommitProvider          = commontypes.CCIPCommitProvider
+	ExecProvider            = commontypes.CCIPExecProvider
+	CommitStoreReader       = cciptypes.CommitStoreReader
+	OffRampReader           = cciptypes.OffRampReader
+	OnRampReader            = cciptypes.OnRampReader
+	PriceRegistryReader     = cciptypes.PriceRegistryReader
+	PriceGetter             = cciptypes.PriceGetter
+	TokenDataReader         = cciptypes.TokenDataReader
+	TokenPoolBatchedReader  = cciptypes.TokenPoolBatchedReader
+	GasPriceEstimatorCommit = cciptypes.GasPriceEstimatorCommit
+	GasPriceEstimatorExec   = cciptypes.GasPriceEstimatorExec
+	AddressCodec            = addrcodec.Codec
+)
+
+// The optional features of the dest providers.
+type (
+	ExecGasReader  = ccipdata.ExecGasReader
+	MessageExecGas = ccipdata.MessageExecGas
+
+	// ExecGasReaderProvider is implemented by the dest ExecProvider which can read the gas used by execution
+	// transactions, for the GasAttribution of the execution plugin.
+	ExecGasReaderProvider interface {
+		NewExecGasReader(ctx context.Context, offRampAddress cciptypes.Address) (ExecGasReader, error)
+	}
+)
+
+// Family is a chain family supported by the CCIP plugins.
+type Family struct {
+	// Name is the network of the relayers of the family, as set in the relay field of the jobs, e.g. relay.NetworkEVM.
+	Name string
+	// AddressCodec converts the addresses of the family between their raw bytes and their canonical format.
+	AddressCodec AddressCodec
+}
+
+var (
+	mu       sync.RWMutex
+	families = map[string]Family{
+		relay.NetworkEVM: {Name: relay.NetworkEVM, AddressCodec: addrcodec.EVM},
+	}
+)
+
+// Register adds a chain family to the ones supported by the CCIP plugins, replacing any previous one of the same name,
+// and registers its address codec.
+func Register(family Family) error {
+	if family.Name == "" {
+		return errors.New("chain family name is empty")
+	}
+	if family.AddressCodec == nil {
+		return fmt.Errorf("chain family %q has no address codec", family.Name)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	families[family.Name] = family
+	addrcodec.Register(family.Name, family.AddressCodec)
+	return nil
+}
+
+// Unregister removes a chain family, and its address codec, from the ones supported by the CCIP plugins.
+func Unregister(name string) {
+	mu.Lock()
+	defer mu.Unlock()
+	delete(families, name)
+	addrcodec.Unregister(name)
+}
+
+// Lookup returns the registered chain family of the given name.
+func Lookup(name string) (Family, error) {
+	mu.RLock()
+	defer mu.RUnlock()
+	family, ok := families[name]
+	if !ok {
+		return Family{}, fmt.Errorf("chain family %q is not supported by CCIP", name)
+	}
+	return family, nil
+}
+
+// Supported returns the names of the registered chain families, sorted.
+func Supported() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	names := make([]string, 0, len(families))
+	for name := range families {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
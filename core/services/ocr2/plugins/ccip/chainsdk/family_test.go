@@ -0,0 +1,40 @@
+package chainsdk_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/addrcodec"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/chainsdk"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
+)
+
+func TestRegister(t *testing.T) {
+	evm, err := chainsdk.Lookup(relay.NetworkEVM)
+	require.NoError(t, err)
+	assert.Equal(t, addrcodec.EVM, evm.AddressCodec)
+
+	_, err = chainsdk.Lookup("testfamily")
+	require.EqualError(t, err, `chain family "testfamily" is not supported by CCIP`)
+
+	require.EqualError(t, chainsdk.Register(chainsdk.Family{AddressCodec: addrcodec.Aptos}), "chain family name is empty")
+	require.EqualError(t, chainsdk.Register(chainsdk.Family{Name: "testfamily"}), `chain family "testfamily" has no address codec`)
+
+	require.NoError(t, chainsdk.Register(chainsdk.Family{Name: "testfamily", AddressCodec: addrcodec.Aptos}))
+	t.Cleanup(func() { chainsdk.Unregister("testfamily") })
+	family, err := chainsdk.Lookup("testfamily")
+	require.NoError(t, err)
+	assert.Equal(t, "testfamily", family.Name)
+	assert.Contains(t, chainsdk.Supported(), "testfamily")
+	codec, err := addrcodec.ForFamily("testfamily")
+	require.NoError(t, err)
+	assert.Equal(t, addrcodec.Aptos, codec)
+
+	chainsdk.Unregister("testfamily")
+	_, err = chainsdk.Lookup("testfamily")
+	require.Error(t, err)
+	_, err = addrcodec.ForFamily("testfamily")
+	require.Error(t, err)
+}
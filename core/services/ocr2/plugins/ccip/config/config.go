@@ -9,6 +9,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/config"
 	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/bytes"
 
@@ -25,11 +26,29 @@ type CommitPluginJobSpecConfig struct {
 	TokenPricesUSDPipeline string `json:"tokenPricesUSDPipeline,omitempty"`
 	// PriceGetterConfig defines where to get the token prices from (i.e. static or aggregator source).
 	PriceGetterConfig *DynamicPriceGetterConfig `json:"priceGetterConfig,omitempty"`
+	// QuoteCurrency denominates the observed token and gas prices in another currency than USD, for lanes whose fee
+	// quoting contracts expect it. Prices are observed in USD if unset.
+	QuoteCurrency *QuoteCurrencyConfig `json:"quoteCurrency,omitempty"`
+	// PriceReportSuppressionWindow is the minimum time between price updates for the reports without new messages,
+	// unless a gas or token price heartbeat is due, so that quiet lanes don't commit a report for each price deviation.
+	// Price-only reports are not suppressed if unset.
+	PriceReportSuppressionWindow *config.Duration `json:"priceReportSuppressionWindow,omitempty"`
+	// PriceUpdateFilter enables skipping the price-only reports whose prices are already in the PriceRegistry, and
+	// simulating the transmission of the others to the CommitStore before sending it.
+	PriceUpdateFilter *PriceUpdateFilterConfig `json:"priceUpdateFilter,omitempty"`
+}
+
+// PriceUpdateFilterConfig configures the filtering of the price-only reports against the PriceRegistry.
+type PriceUpdateFilterConfig struct {
+	// RefreshInterval is the age after which a price equal to the one of the PriceRegistry is updated anyway, to refresh
+	// its timestamp. It must be lower than the gas and token price heartbeats. Defaults to 5 minutes.
+	RefreshInterval config.Duration `json:"refreshInterval"`
 }
 
 type CommitPluginConfig struct {
 	IsSourceProvider                 bool
 	SourceStartBlock, DestStartBlock uint64
+	PriceUpdateFilter                *PriceUpdateFilterConfig `json:",omitempty"`
 }
 
 func (c CommitPluginConfig) Encode() ([]byte, error) {
@@ -104,10 +123,152 @@ func (c *DynamicPriceGetterConfig) Validate() error {
 	return nil
 }
 
+const (
+	QuoteCurrencyUSD    = "USD"
+	QuoteCurrencyEUR    = "EUR"
+	QuoteCurrencyETH    = "ETH"
+	QuoteCurrencyNative = "native"
+)
+
+// QuoteCurrencyConfig specifies the currency of the observed prices, and where to get its USD exchange rate from.
+type QuoteCurrencyConfig struct {
+	// Currency is one of USD, EUR, ETH or native, i.e. the native token of the source chain.
+	Currency string `json:"currency"`
+	// PriceToken is the key under which the price getter resolves the USD price of one unit of the currency, e.g. the
+	// address configured with the EUR/USD aggregator. It is only used to convert prices and is never reported as a
+	// token price. It must be unset for native, which is converted with the price of the source native token.
+	PriceToken cciptypes.Address `json:"priceToken,omitempty"`
+}
+
+func (c *QuoteCurrencyConfig) Validate() error {
+	switch c.Currency {
+	case QuoteCurrencyUSD, QuoteCurrencyNative:
+		if c.PriceToken != "" {
+			return fmt.Errorf("priceToken must not be set for quote currency %s", c.Currency)
+		}
+	case QuoteCurrencyEUR, QuoteCurrencyETH:
+		if c.PriceToken == "" {
+			return fmt.Errorf("priceToken is required for quote currency %s", c.Currency)
+		}
+	default:
+		return fmt.Errorf("unsupported quote currency %q, must be one of %s, %s, %s or %s",
+			c.Currency, QuoteCurrencyUSD, QuoteCurrencyEUR, QuoteCurrencyETH, QuoteCurrencyNative)
+	}
+	return nil
+}
+
 // ExecPluginJobSpecConfig contains the plugin specific variables for the ccip.CCIPExecution plugin.
 type ExecPluginJobSpecConfig struct {
 	SourceStartBlock, DestStartBlock uint64 // Only for first time job add.
 	USDCConfig                       USDCConfig
+	FeeBoosting                      FeeBoostingConfig
+	TokenPoolLiquidity               TokenPoolLiquidityConfig
+	Batching                         BatchingConfig
+	GasAttribution                   GasAttributionConfig
+}
+
+const (
+	FeeBoostCurveLinear      = "linear"
+	FeeBoostCurveExponential = "exponential"
+	FeeBoostCurveCapped      = "capped"
+)
+
+// FeeBoostingConfig configures how the fees of messages waiting to be executed are boosted, and which prices are used
+// to compare them with the execution costs.
+type FeeBoostingConfig struct {
+	// Curve shapes the boost of the fee of a message by its wait time, with the RelativeBoostPerWaitHour of the
+	// offchain config: linear (the default) multiplies the fee by 1+hours*boost, exponential by (1+boost)^hours, and
+	// capped like linear, up to MaxMultiplier.
+	Curve string
+	// MaxMultiplier caps the fee multiplier of the capped curve.
+	MaxMultiplier float64
+	// PriceServiceMaxAgeSeconds enables reading the token prices from the prices observed by the commit plugins of the
+	// node, as long as they were updated in the last PriceServiceMaxAgeSeconds. The prices of the price registries are
+	// used otherwise.
+	PriceServiceMaxAgeSeconds uint
+}
+
+func (c *FeeBoostingConfig) Validate() error {
+	switch c.Curve {
+	case "", FeeBoostCurveLinear, FeeBoostCurveExponential:
+		if c.MaxMultiplier != 0 {
+			return fmt.Errorf("MaxMultiplier must not be set for fee boost curve %s", c.Curve)
+		}
+	case FeeBoostCurveCapped:
+		if c.MaxMultiplier <= 1 {
+			return errors.New("MaxMultiplier must be greater than 1 for the capped fee boost curve")
+		}
+	default:
+		return fmt.Errorf("unsupported fee boost curve %q, must be one of %s, %s or %s",
+			c.Curve, FeeBoostCurveLinear, FeeBoostCurveExponential, FeeBoostCurveCapped)
+	}
+	return nil
+}
+
+// TokenPoolLiquidityConfig configures the monitoring of the liquidity of the dest token pools of the lane.
+type TokenPoolLiquidityConfig struct {
+	// Pools are the token pools to monitor, usually the lock/release ones. Monitoring is disabled if empty.
+	Pools []common.Address
+	// IntervalSeconds is how often the liquidity of the pools is read, or 0 for a default interval.
+	IntervalSeconds uint
+}
+
+func (c *TokenPoolLiquidityConfig) Validate() error {
+	if c.IntervalSeconds != 0 && len(c.Pools) == 0 {
+		return errors.New("IntervalSeconds must not be set without Pools")
+	}
+	for _, pool := range c.Pools {
+		if pool == utils.ZeroAddress {
+			return errors.New("Pools must not contain the zero address")
+		}
+	}
+	return nil
+}
+
+// BatchingConfig narrows the batching strategy of the offchain config of the DON, by holding back its batches of less
+// than MinBatchSize messages, to share the fixed costs of an execution among more messages. The batches of the strategy
+// of the offchain config are executed as they are if empty.
+type BatchingConfig struct {
+	// MinBatchSize is the minimum number of messages of the executed batches.
+	MinBatchSize uint
+	// MaxWaitSeconds bounds how long a message is held back: smaller batches are executed once their oldest message has
+	// waited for MaxWaitSeconds since it was sent.
+	MaxWaitSeconds uint
+}
+
+func (c *BatchingConfig) Validate() error {
+	if *c == (BatchingConfig{}) {
+		return nil
+	}
+	if c.MinBatchSize < 2 {
+		return errors.New("MinBatchSize must be at least 2")
+	}
+	if c.MaxWaitSeconds == 0 {
+		return errors.New("MaxWaitSeconds is required with MinBatchSize")
+	}
+	return nil
+}
+
+// GasAttributionConfig configures the attribution of the gas used by the execution transactions of the lane to the
+// messages they executed, from their traces when the RPC of the dest chain supports debug_traceTransaction.
+type GasAttributionConfig struct {
+	// Enabled enables the attribution.
+	Enabled bool
+	// IntervalSeconds is how often the newly finalized execution transactions are attributed, or 0 for a default
+	// interval.
+	IntervalSeconds uint
+	// RetentionHours is how long the attributed gas is kept, or 0 for a default retention.
+	RetentionHours uint
+}
+
+func (c *GasAttributionConfig) Validate() error {
+	if c.IntervalSeconds != 0 && !c.Enabled {
+		return errors.New("IntervalSeconds must not be set unless Enabled")
+	}
+	if c.RetentionHours != 0 && !c.Enabled {
+		return errors.New("RetentionHours must not be set unless Enabled")
+	}
+	return nil
 }
 
 type USDCConfig struct {
@@ -5,10 +5,13 @@ import (
 	"fmt"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/config"
+
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
 )
@@ -44,6 +47,7 @@ func TestCommitConfig(t *testing.T) {
 						},
 					},
 				},
+				PriceUpdateFilter: &PriceUpdateFilterConfig{RefreshInterval: *config.MustNewDuration(time.Minute)},
 			},
 			expectedValidationError: nil,
 		},
@@ -129,6 +133,134 @@ func TestCommitConfig(t *testing.T) {
 	}
 }
 
+func TestQuoteCurrencyConfig(t *testing.T) {
+	eurUSD := ccipcalc.HexToAddress("0xe0")
+	tests := []struct {
+		cfg    QuoteCurrencyConfig
+		errMsg string
+	}{
+		{QuoteCurrencyConfig{Currency: QuoteCurrencyUSD}, ""},
+		{QuoteCurrencyConfig{Currency: QuoteCurrencyNative}, ""},
+		{QuoteCurrencyConfig{Currency: QuoteCurrencyEUR, PriceToken: eurUSD}, ""},
+		{QuoteCurrencyConfig{Currency: QuoteCurrencyETH}, "priceToken is required for quote currency ETH"},
+		{QuoteCurrencyConfig{Currency: QuoteCurrencyNative, PriceToken: eurUSD}, "priceToken must not be set for quote currency native"},
+		{QuoteCurrencyConfig{Currency: "GBP"}, `unsupported quote currency "GBP", must be one of USD, EUR, ETH or native`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.cfg.Currency, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.errMsg == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tt.errMsg)
+		})
+	}
+
+	var cfg CommitPluginJobSpecConfig
+	require.NoError(t, json.Unmarshal([]byte(`{"quoteCurrency":{"currency":"EUR","priceToken":"0x00000000000000000000000000000000000000E0"}}`), &cfg))
+	require.Equal(t, &QuoteCurrencyConfig{Currency: QuoteCurrencyEUR, PriceToken: eurUSD}, cfg.QuoteCurrency)
+}
+
+func TestFeeBoostingConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		cfg    FeeBoostingConfig
+		errMsg string
+	}{
+		{"default", FeeBoostingConfig{}, ""},
+		{"exponential", FeeBoostingConfig{Curve: FeeBoostCurveExponential, PriceServiceMaxAgeSeconds: 60}, ""},
+		{"capped", FeeBoostingConfig{Curve: FeeBoostCurveCapped, MaxMultiplier: 3}, ""},
+		{"capped without max", FeeBoostingConfig{Curve: FeeBoostCurveCapped}, "MaxMultiplier must be greater than 1 for the capped fee boost curve"},
+		{"linear with max", FeeBoostingConfig{Curve: FeeBoostCurveLinear, MaxMultiplier: 3}, "MaxMultiplier must not be set for fee boost curve linear"},
+		{"unknown", FeeBoostingConfig{Curve: "quadratic"}, `unsupported fee boost curve "quadratic", must be one of linear, exponential or capped`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.errMsg == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tt.errMsg)
+		})
+	}
+}
+
+func TestTokenPoolLiquidityConfig(t *testing.T) {
+	pool := utils.RandomAddress()
+	tests := []struct {
+		name   string
+		cfg    TokenPoolLiquidityConfig
+		errMsg string
+	}{
+		{"disabled", TokenPoolLiquidityConfig{}, ""},
+		{"default interval", TokenPoolLiquidityConfig{Pools: []common.Address{pool}}, ""},
+		{"interval", TokenPoolLiquidityConfig{Pools: []common.Address{pool}, IntervalSeconds: 30}, ""},
+		{"interval without pools", TokenPoolLiquidityConfig{IntervalSeconds: 30}, "IntervalSeconds must not be set without Pools"},
+		{"zero address", TokenPoolLiquidityConfig{Pools: []common.Address{pool, {}}}, "Pools must not contain the zero address"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.errMsg == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tt.errMsg)
+		})
+	}
+}
+
+func TestBatchingConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		cfg    BatchingConfig
+		errMsg string
+	}{
+		{"default", BatchingConfig{}, ""},
+		{"min batch size", BatchingConfig{MinBatchSize: 5, MaxWaitSeconds: 600}, ""},
+		{"without min size", BatchingConfig{MaxWaitSeconds: 600}, "MinBatchSize must be at least 2"},
+		{"min size of one", BatchingConfig{MinBatchSize: 1, MaxWaitSeconds: 600}, "MinBatchSize must be at least 2"},
+		{"without max wait", BatchingConfig{MinBatchSize: 5}, "MaxWaitSeconds is required with MinBatchSize"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.errMsg == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tt.errMsg)
+		})
+	}
+}
+
+func TestGasAttributionConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		cfg    GasAttributionConfig
+		errMsg string
+	}{
+		{"disabled", GasAttributionConfig{}, ""},
+		{"default interval", GasAttributionConfig{Enabled: true}, ""},
+		{"interval", GasAttributionConfig{Enabled: true, IntervalSeconds: 60}, ""},
+		{"interval when disabled", GasAttributionConfig{IntervalSeconds: 60}, "IntervalSeconds must not be set unless Enabled"},
+		{"retention", GasAttributionConfig{Enabled: true, RetentionHours: 24}, ""},
+		{"retention when disabled", GasAttributionConfig{RetentionHours: 24}, "RetentionHours must not be set unless Enabled"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.errMsg == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tt.errMsg)
+		})
+	}
+}
+
 func TestExecutionConfig(t *testing.T) {
 	exampleConfig := ExecPluginJobSpecConfig{
 		SourceStartBlock: 222,
@@ -0,0 +1,217 @@
+// Package discovery reads the contracts of a CCIP lane onchain, starting from the router of its dest chain, and
+// generates the job specs of the lane from them.
+package discovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	chainselectors "github.com/smartcontractkit/chain-selectors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/commit_store"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_offramp"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_onramp"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/price_registry_1_2_0"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/router"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+)
+
+// SupportedVersion is the version of the lane contracts which can be discovered, since their configs are read with
+// the wrappers of that version.
+var SupportedVersion = *semver.MustParse("1.5.0")
+
+// compatibleVersions are the older versions of the contracts which can be part of a SupportedVersion lane, because
+// their configs have the same ABI as those of the SupportedVersion. The 1.2.0 commit store only differs from 1.5.0 in
+// the name of its ARM proxy.
+var compatibleVersions = map[config.ContractType][]semver.Version{
+	config.CommitStore: {*semver.MustParse("1.2.0")},
+}
+
+// Lane holds the contracts of a CCIP lane, as read onchain.
+type Lane struct {
+	SourceChainSelector uint64           `json:"sourceChainSelector,string"`
+	SourceChainID       uint64           `json:"sourceChainID,string"`
+	DestChainSelector   uint64           `json:"destChainSelector,string"`
+	DestChainID         uint64           `json:"destChainID,string"`
+	SourceRouter        common.Address   `json:"sourceRouter"`
+	OnRamp              common.Address   `json:"onRamp"`
+	SourcePriceRegistry common.Address   `json:"sourcePriceRegistry"`
+	SourceWrappedNative common.Address   `json:"sourceWrappedNative"`
+	DestRouter          common.Address   `json:"destRouter"`
+	OffRamp             common.Address   `json:"offRamp"`
+	CommitStore         common.Address   `json:"commitStore"`
+	DestPriceRegistry   common.Address   `json:"destPriceRegistry"` // zero until the OCR2 configs of the lane are set
+	DestFeeTokens       []common.Address `json:"destFeeTokens"`
+}
+
+// Discover finds the lane from sourceChainSelector to destChainSelector served by destRouter, and checks that the
+// configs of its contracts agree with each other. The router may list several offRamps for the source chain while a
+// lane is being upgraded, in which case the lane of the onRamp currently used by the source router is returned.
+//
+// The dynamic configs of the offRamp and the commit store are only set along with their OCR2 configs, so they are only
+// checked once set.
+func Discover(ctx context.Context, sourceClient, destClient bind.ContractBackend, sourceChainSelector, destChainSelector uint64, destRouter common.Address) (Lane, error) {
+	sourceChainID, err := chainselectors.ChainIdFromSelector(sourceChainSelector)
+	if err != nil {
+		return Lane{}, err
+	}
+	destChainID, err := chainselectors.ChainIdFromSelector(destChainSelector)
+	if err != nil {
+		return Lane{}, err
+	}
+
+	opts := &bind.CallOpts{Context: ctx}
+	destRouterContract, err := router.NewRouter(destRouter, destClient)
+	if err != nil {
+		return Lane{}, err
+	}
+	offRamps, err := destRouterContract.GetOffRamps(opts)
+	if err != nil {
+		return Lane{}, fmt.Errorf("failed to get the offRamps of router %s: %w", destRouter, err)
+	}
+
+	var errs []error
+	for _, offRamp := range offRamps {
+		if offRamp.SourceChainSelector != sourceChainSelector {
+			continue
+		}
+		lane := Lane{
+			SourceChainSelector: sourceChainSelector,
+			SourceChainID:       sourceChainID,
+			DestChainSelector:   destChainSelector,
+			DestChainID:         destChainID,
+			DestRouter:          destRouter,
+			OffRamp:             offRamp.OffRamp,
+		}
+		active, err := lane.read(opts, sourceClient, destClient)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("offRamp %s: %w", offRamp.OffRamp, err))
+		} else if active {
+			return lane, nil
+		} else {
+			errs = append(errs, fmt.Errorf("offRamp %s: onRamp %s is not the onRamp of source router %s", offRamp.OffRamp, lane.OnRamp, lane.SourceRouter))
+		}
+	}
+	if len(errs) == 0 {
+		return Lane{}, fmt.Errorf("router %s has no offRamp for source chain selector %d", destRouter, sourceChainSelector)
+	}
+	return Lane{}, errors.Join(errs...)
+}
+
+// read fills the lane from its offRamp, and returns whether the onRamp of the lane is the one used by its router.
+func (l *Lane) read(opts *bind.CallOpts, sourceClient, destClient bind.ContractBackend) (active bool, err error) {
+	offRamp, err := newContract(l.OffRamp, destClient, config.EVM2EVMOffRamp, evm_2_evm_offramp.NewEVM2EVMOffRamp)
+	if err != nil {
+		return false, err
+	}
+	offRampStatic, err := offRamp.GetStaticConfig(opts)
+	if err != nil {
+		return false, fmt.Errorf("failed to get the static config of the offRamp: %w", err)
+	}
+	offRampDynamic, err := offRamp.GetDynamicConfig(opts)
+	if err != nil {
+		return false, fmt.Errorf("failed to get the dynamic config of the offRamp: %w", err)
+	}
+	if err = checkChainSelectors("offRamp", offRampStatic.SourceChainSelector, offRampStatic.ChainSelector, l.SourceChainSelector, l.DestChainSelector); err != nil {
+		return false, err
+	}
+	if offRampDynamic.Router != utils.ZeroAddress && offRampDynamic.Router != l.DestRouter {
+		return false, fmt.Errorf("offRamp is configured with router %s", offRampDynamic.Router)
+	}
+	l.CommitStore = offRampStatic.CommitStore
+	l.OnRamp = offRampStatic.OnRamp
+	l.DestPriceRegistry = offRampDynamic.PriceRegistry
+
+	commitStore, err := newContract(l.CommitStore, destClient, config.CommitStore, commit_store.NewCommitStore)
+	if err != nil {
+		return false, err
+	}
+	commitStoreStatic, err := commitStore.GetStaticConfig(opts)
+	if err != nil {
+		return false, fmt.Errorf("failed to get the static config of the commit store: %w", err)
+	}
+	commitStoreDynamic, err := commitStore.GetDynamicConfig(opts)
+	if err != nil {
+		return false, fmt.Errorf("failed to get the dynamic config of the commit store: %w", err)
+	}
+	if err = checkChainSelectors("commit store", commitStoreStatic.SourceChainSelector, commitStoreStatic.ChainSelector, l.SourceChainSelector, l.DestChainSelector); err != nil {
+		return false, err
+	}
+	if commitStoreStatic.OnRamp != l.OnRamp {
+		return false, fmt.Errorf("commit store %s is configured with onRamp %s, but the offRamp with onRamp %s", l.CommitStore, commitStoreStatic.OnRamp, l.OnRamp)
+	}
+	if commitStoreDynamic.PriceRegistry != utils.ZeroAddress && l.DestPriceRegistry != utils.ZeroAddress && commitStoreDynamic.PriceRegistry != l.DestPriceRegistry {
+		return false, fmt.Errorf("commit store %s is configured with price registry %s, but the offRamp with price registry %s", l.CommitStore, commitStoreDynamic.PriceRegistry, l.DestPriceRegistry)
+	}
+	if l.DestPriceRegistry == utils.ZeroAddress {
+		l.DestPriceRegistry = commitStoreDynamic.PriceRegistry
+	}
+
+	onRamp, err := newContract(l.OnRamp, sourceClient, config.EVM2EVMOnRamp, evm_2_evm_onramp.NewEVM2EVMOnRamp)
+	if err != nil {
+		return false, err
+	}
+	onRampStatic, err := onRamp.GetStaticConfig(opts)
+	if err != nil {
+		return false, fmt.Errorf("failed to get the static config of the onRamp: %w", err)
+	}
+	onRampDynamic, err := onRamp.GetDynamicConfig(opts)
+	if err != nil {
+		return false, fmt.Errorf("failed to get the dynamic config of the onRamp: %w", err)
+	}
+	if err = checkChainSelectors("onRamp", onRampStatic.ChainSelector, onRampStatic.DestChainSelector, l.SourceChainSelector, l.DestChainSelector); err != nil {
+		return false, err
+	}
+	l.SourceRouter = onRampDynamic.Router
+	l.SourcePriceRegistry = onRampDynamic.PriceRegistry
+
+	sourceRouter, err := router.NewRouter(l.SourceRouter, sourceClient)
+	if err != nil {
+		return false, err
+	}
+	activeOnRamp, err := sourceRouter.GetOnRamp(opts, l.DestChainSelector)
+	if err != nil {
+		return false, fmt.Errorf("failed to get the onRamp of source router %s: %w", l.SourceRouter, err)
+	}
+	if l.SourceWrappedNative, err = sourceRouter.GetWrappedNative(opts); err != nil {
+		return false, fmt.Errorf("failed to get the wrapped native token of source router %s: %w", l.SourceRouter, err)
+	}
+
+	if l.DestPriceRegistry != utils.ZeroAddress {
+		priceRegistry, err := price_registry_1_2_0.NewPriceRegistry(l.DestPriceRegistry, destClient)
+		if err != nil {
+			return false, err
+		}
+		if l.DestFeeTokens, err = priceRegistry.GetFeeTokens(opts); err != nil {
+			return false, fmt.Errorf("failed to get the fee tokens of dest price registry %s: %w", l.DestPriceRegistry, err)
+		}
+	}
+	return activeOnRamp == l.OnRamp, nil
+}
+
+// newContract binds the contract at addr, after checking that it is of the expected type and of the SupportedVersion,
+// or one of its compatibleVersions.
+func newContract[C any](addr common.Address, client bind.ContractBackend, contractType config.ContractType, newFn func(common.Address, bind.ContractBackend) (C, error)) (c C, err error) {
+	version, err := config.VerifyTypeAndVersion(addr, client, contractType)
+	if err != nil {
+		return c, fmt.Errorf("invalid %s %s: %w", contractType, addr, err)
+	}
+	if !version.Equal(&SupportedVersion) && !slices.ContainsFunc(compatibleVersions[contractType], func(v semver.Version) bool { return v.Equal(&version) }) {
+		return c, fmt.Errorf("%s %s has version %s, only %s lanes can be discovered", contractType, addr, version.String(), SupportedVersion.String())
+	}
+	return newFn(addr, client)
+}
+
+func checkChainSelectors(contract string, source, dest, expectedSource, expectedDest uint64) error {
+	if source != expectedSource || dest != expectedDest {
+		return fmt.Errorf("%s is configured for the lane from chain selector %d to %d", contract, source, dest)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package discovery_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pelletier/go-toml/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/discovery"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/testhelpers"
+)
+
+func TestDiscover(t *testing.T) {
+	ctx := testutils.Context(t)
+	c := testhelpers.SetupCCIPContracts(t, testhelpers.SourceChainID, testhelpers.SourceChainSelector, testhelpers.DestChainID, testhelpers.DestChainSelector, 10, 10)
+	discover := func() (discovery.Lane, error) {
+		return discovery.Discover(ctx, c.Source.Chain, c.Dest.Chain, testhelpers.SourceChainSelector, testhelpers.DestChainSelector, c.Dest.Router.Address())
+	}
+
+	lane, err := discover()
+	require.NoError(t, err)
+	assert.Equal(t, discovery.Lane{
+		SourceChainSelector: testhelpers.SourceChainSelector,
+		SourceChainID:       testhelpers.SourceChainID,
+		DestChainSelector:   testhelpers.DestChainSelector,
+		DestChainID:         testhelpers.DestChainID,
+		SourceRouter:        c.Source.Router.Address(),
+		OnRamp:              c.Source.OnRamp.Address(),
+		SourcePriceRegistry: c.Source.PriceRegistry.Address(),
+		SourceWrappedNative: c.Source.WrappedNative.Address(),
+		DestRouter:          c.Dest.Router.Address(),
+		OffRamp:             c.Dest.OffRamp.Address(),
+		CommitStore:         c.Dest.CommitStore.Address(),
+	}, lane)
+
+	t.Run("job specs", func(t *testing.T) {
+		lane := lane
+		lane.DestFeeTokens = []common.Address{c.Dest.LinkToken.Address()}
+
+		var spec struct {
+			Name         string                 `toml:"name"`
+			PluginType   string                 `toml:"pluginType"`
+			ContractID   string                 `toml:"contractID"`
+			PluginConfig map[string]interface{} `toml:"pluginConfig"`
+			RelayConfig  map[string]interface{} `toml:"relayConfig"`
+		}
+		commit, err := lane.CommitJobSpec()
+		require.NoError(t, err)
+		require.NoError(t, toml.Unmarshal([]byte(commit), &spec))
+		assert.Equal(t, "ccip-commit-1000-1337", spec.Name)
+		assert.Equal(t, "ccip-commit", spec.PluginType)
+		assert.Equal(t, c.Dest.CommitStore.Address().Hex(), spec.ContractID)
+		assert.Equal(t, c.Dest.OffRamp.Address().Hex(), spec.PluginConfig["offRamp"])
+		assert.EqualValues(t, testhelpers.DestChainID, spec.RelayConfig["chainID"])
+		assert.Contains(t, commit, c.Source.WrappedNative.Address().Hex()+", the wrapped native token of chain 1000")
+		assert.Contains(t, commit, c.Dest.LinkToken.Address().Hex()+", a fee token of chain 1337")
+
+		exec, err := lane.ExecJobSpec()
+		require.NoError(t, err)
+		require.NoError(t, toml.Unmarshal([]byte(exec), &spec))
+		assert.Equal(t, "ccip-exec-1000-1337", spec.Name)
+		assert.Equal(t, "ccip-execution", spec.PluginType)
+		assert.Equal(t, c.Dest.OffRamp.Address().Hex(), spec.ContractID)
+	})
+
+	t.Run("unknown source chain", func(t *testing.T) {
+		_, err := discovery.Discover(ctx, c.Source.Chain, c.Dest.Chain, testhelpers.DestChainSelector, testhelpers.DestChainSelector, c.Dest.Router.Address())
+		require.ErrorContains(t, err, "has no offRamp for source chain selector 3379446385462418246")
+	})
+
+	t.Run("onRamp replaced on the source router", func(t *testing.T) {
+		offRamp := c.Dest.OffRamp.Address()
+		c.DeployNewOnRamp(t)
+		c.EnableOnRamp(t)
+
+		_, err := discover()
+		require.ErrorContains(t, err, "offRamp "+offRamp.Hex()+": onRamp")
+		require.ErrorContains(t, err, "is not the onRamp of source router "+c.Source.Router.Address().Hex())
+	})
+}
@@ -0,0 +1,70 @@
+package discovery
+
+import (
+	"strings"
+	"text/template"
+)
+
+// The job specs leave the settings of the node to be filled in: its OCR2 key bundle, its transmitter and the bootstrap
+// peers of the DON, along with the prices of the commit plugin, which are configured off chain.
+var (
+	commitJobSpecTemplate = template.Must(template.New("commit").Parse(`type = "offchainreporting2"
+schemaVersion = 1
+name = "ccip-commit-{{ .SourceChainID }}-{{ .DestChainID }}"
+relay = "evm"
+pluginType = "ccip-commit"
+contractID = "{{ .CommitStore }}"
+contractConfigConfirmations = 1
+contractConfigTrackerPollInterval = "20s"
+# ocrKeyBundleID = ""
+# transmitterID = ""
+# p2pv2Bootstrappers = []
+
+[pluginConfig]
+offRamp = "{{ .OffRamp }}"
+# Either priceGetterConfig or tokenPricesUSDPipeline must be set, with the USD prices of:
+#   {{ .SourceWrappedNative }}, the wrapped native token of chain {{ .SourceChainID }}
+{{- range .DestFeeTokens }}
+#   {{ . }}, a fee token of chain {{ $.DestChainID }}
+{{- end }}
+
+[relayConfig]
+chainID = {{ .DestChainID }}
+`))
+
+	execJobSpecTemplate = template.Must(template.New("exec").Parse(`type = "offchainreporting2"
+schemaVersion = 1
+name = "ccip-exec-{{ .SourceChainID }}-{{ .DestChainID }}"
+relay = "evm"
+pluginType = "ccip-execution"
+contractID = "{{ .OffRamp }}"
+contractConfigConfirmations = 1
+contractConfigTrackerPollInterval = "20s"
+# ocrKeyBundleID = ""
+# transmitterID = ""
+# p2pv2Bootstrappers = []
+
+[pluginConfig]
+
+[relayConfig]
+chainID = {{ .DestChainID }}
+`))
+)
+
+// CommitJobSpec returns the skeleton of the commit job spec of the lane.
+func (l Lane) CommitJobSpec() (string, error) {
+	return l.executeTemplate(commitJobSpecTemplate)
+}
+
+// ExecJobSpec returns the skeleton of the execution job spec of the lane.
+func (l Lane) ExecJobSpec() (string, error) {
+	return l.executeTemplate(execJobSpecTemplate)
+}
+
+func (l Lane) executeTemplate(tmpl *template.Template) (string, error) {
+	var b strings.Builder
+	if err := tmpl.Execute(&b, l); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
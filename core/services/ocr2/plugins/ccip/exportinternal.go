@@ -96,6 +96,18 @@ func CloseUSDCReader(lggr logger.Logger, jobID string, transmitter common.Addres
 
 type USDCReaderImpl = ccipdata.USDCReaderImpl
 
+type ExecGasReader = ccipdata.ExecGasReader
+
+func NewEVMExecGasReader(lggr logger.Logger, lp logpoller.LogPoller, destClient client.Client, offRamp common.Address) *ccipdata.EVMExecGasReader {
+	return ccipdata.NewEVMExecGasReader(lggr, lp, destClient, offRamp)
+}
+
+type PriceUpdateFilter = ccipdata.PriceUpdateFilter
+
+func NewEVMPriceUpdateFilter(destClient client.Client, commitStore common.Address, refreshInterval time.Duration) (*ccipdata.EVMPriceUpdateFilter, error) {
+	return ccipdata.NewEVMPriceUpdateFilter(destClient, commitStore, refreshInterval)
+}
+
 var DefaultRpcBatchSizeLimit = rpclib.DefaultRpcBatchSizeLimit
 var DefaultRpcBatchBackOffMultiplier = rpclib.DefaultRpcBatchBackOffMultiplier
 var DefaultMaxParallelRpcCalls = rpclib.DefaultMaxParallelRpcCalls
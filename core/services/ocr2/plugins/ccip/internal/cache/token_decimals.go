@@ -0,0 +1,80 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
+)
+
+// TokenDecimals caches the decimals of the tokens of all chains for the lifetime of the node, so that the lanes to
+// the same chain share them instead of each fetching them every round.
+var TokenDecimals = NewTokenDecimalsCache()
+
+type tokenDecimalsKey struct {
+	chainSelector uint64
+	token         cciptypes.Address
+}
+
+// TokenDecimalsCache caches token decimals by chain and token. The decimals are fetched lazily from the price
+// registry of the chain, and kept until they are invalidated, which callers do when the token config of the chain
+// changes on-chain.
+type TokenDecimalsCache struct {
+	mu       sync.RWMutex
+	decimals map[tokenDecimalsKey]uint8
+}
+
+func NewTokenDecimalsCache() *TokenDecimalsCache {
+	return &TokenDecimalsCache{decimals: make(map[tokenDecimalsKey]uint8)}
+}
+
+// Get returns the decimals of the tokens of the chain, in the same order, fetching the ones not cached yet from the
+// price registry.
+func (c *TokenDecimalsCache) Get(ctx context.Context, chainSelector uint64, priceRegistry ccipdata.PriceRegistryReader, tokens []cciptypes.Address) ([]uint8, error) {
+	decimals := make([]uint8, len(tokens))
+	var missing []cciptypes.Address
+	var missingIdx []int
+
+	c.mu.RLock()
+	for i, token := range tokens {
+		if d, ok := c.decimals[tokenDecimalsKey{chainSelector, token}]; ok {
+			decimals[i] = d
+		} else {
+			missing = append(missing, token)
+			missingIdx = append(missingIdx, i)
+		}
+	}
+	c.mu.RUnlock()
+
+	if len(missing) == 0 {
+		return decimals, nil
+	}
+
+	fetched, err := priceRegistry.GetTokensDecimals(ctx, missing)
+	if err != nil {
+		return nil, err
+	}
+	if len(fetched) != len(missing) {
+		return nil, fmt.Errorf("got %d token decimals for %d tokens", len(fetched), len(missing))
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for j, token := range missing {
+		c.decimals[tokenDecimalsKey{chainSelector, token}] = fetched[j]
+		decimals[missingIdx[j]] = fetched[j]
+	}
+	return decimals, nil
+}
+
+// Invalidate drops the cached decimals of the tokens of the chain, so that they are fetched again on the next Get.
+func (c *TokenDecimalsCache) Invalidate(chainSelector uint64, tokens ...cciptypes.Address) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, token := range tokens {
+		delete(c.decimals, tokenDecimalsKey{chainSelector, token})
+	}
+}
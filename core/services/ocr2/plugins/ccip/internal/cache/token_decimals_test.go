@@ -0,0 +1,52 @@
+package cache
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/mocks"
+)
+
+func TestTokenDecimalsCache(t *testing.T) {
+	ctx := tests.Context(t)
+	tokenA, tokenB := cciptypes.Address("0xa"), cciptypes.Address("0xb")
+	c := NewTokenDecimalsCache()
+
+	priceRegistry := mocks.NewPriceRegistryReader(t)
+	priceRegistry.On("GetTokensDecimals", ctx, []cciptypes.Address{tokenA, tokenB}).Return([]uint8{18, 6}, nil).Once()
+	decimals, err := c.Get(ctx, 1, priceRegistry, []cciptypes.Address{tokenA, tokenB})
+	require.NoError(t, err)
+	assert.Equal(t, []uint8{18, 6}, decimals)
+
+	// cached, in the requested order
+	decimals, err = c.Get(ctx, 1, priceRegistry, []cciptypes.Address{tokenB, tokenA})
+	require.NoError(t, err)
+	assert.Equal(t, []uint8{6, 18}, decimals)
+
+	// keyed by chain
+	priceRegistry.On("GetTokensDecimals", ctx, []cciptypes.Address{tokenA}).Return([]uint8{8}, nil).Once()
+	decimals, err = c.Get(ctx, 2, priceRegistry, []cciptypes.Address{tokenA})
+	require.NoError(t, err)
+	assert.Equal(t, []uint8{8}, decimals)
+
+	// only the invalidated tokens are fetched again
+	c.Invalidate(1, tokenB)
+	priceRegistry.On("GetTokensDecimals", ctx, []cciptypes.Address{tokenB}).Return([]uint8{9}, nil).Once()
+	decimals, err = c.Get(ctx, 1, priceRegistry, []cciptypes.Address{tokenA, tokenB})
+	require.NoError(t, err)
+	assert.Equal(t, []uint8{18, 9}, decimals)
+
+	c.Invalidate(1, tokenA)
+	priceRegistry.On("GetTokensDecimals", ctx, []cciptypes.Address{tokenA}).Return(nil, errors.New("rpc down")).Once()
+	_, err = c.Get(ctx, 1, priceRegistry, []cciptypes.Address{tokenA})
+	require.ErrorContains(t, err, "rpc down")
+	priceRegistry.On("GetTokensDecimals", ctx, []cciptypes.Address{tokenA}).Return([]uint8{18, 6}, nil).Once()
+	_, err = c.Get(ctx, 1, priceRegistry, []cciptypes.Address{tokenA})
+	require.ErrorContains(t, err, "got 2 token decimals for 1 tokens")
+}
@@ -0,0 +1,48 @@
+package ccipcommon
+
+import (
+	"context"
+
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+// FactoryService is a reporting plugin factory running as a LOOP, along with the services it depends on, which run
+// alongside it in the LOOP.
+type FactoryService struct {
+	services.StateMachine
+	ocrtypes.ReportingPluginFactory
+	lggr logger.Logger
+	srvs []job.ServiceCtx
+	ms   services.MultiStart
+}
+
+func NewFactoryService(lggr logger.Logger, factory ocrtypes.ReportingPluginFactory, srvs []job.ServiceCtx) *FactoryService {
+	return &FactoryService{ReportingPluginFactory: factory, lggr: lggr, srvs: srvs}
+}
+
+func (f *FactoryService) Name() string { return f.lggr.Name() }
+
+// Start starts the services in order. If one fails to start, the ones already started are closed.
+func (f *FactoryService) Start(ctx context.Context) error {
+	return f.StartOnce(f.Name(), func() error {
+		srvs := make([]services.StartClose, len(f.srvs))
+		for i, srv := range f.srvs {
+			srvs[i] = srv
+		}
+		return f.ms.Start(ctx, srvs...)
+	})
+}
+
+// Close closes the services in reverse order.
+func (f *FactoryService) Close() error {
+	return f.StopOnce(f.Name(), f.ms.Close)
+}
+
+func (f *FactoryService) HealthReport() map[string]error {
+	return map[string]error{f.Name(): f.Healthy()}
+}
@@ -0,0 +1,58 @@
+package ccipcommon
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+type orderedService struct {
+	name     string
+	events   *[]string
+	startErr error
+}
+
+func (s orderedService) Start(context.Context) error {
+	*s.events = append(*s.events, "start "+s.name)
+	return s.startErr
+}
+
+func (s orderedService) Close() error {
+	*s.events = append(*s.events, "close "+s.name)
+	return nil
+}
+
+func TestFactoryService(t *testing.T) {
+	t.Run("starts and closes in order", func(t *testing.T) {
+		var events []string
+		f := NewFactoryService(logger.TestLogger(t), nil, []job.ServiceCtx{
+			orderedService{name: "a", events: &events},
+			orderedService{name: "b", events: &events},
+		})
+
+		require.NoError(t, f.Start(testutils.Context(t)))
+		require.NoError(t, f.Ready())
+		require.NoError(t, f.Close())
+		assert.Equal(t, []string{"start a", "start b", "close b", "close a"}, events)
+	})
+
+	t.Run("closes started services when one fails to start", func(t *testing.T) {
+		var events []string
+		f := NewFactoryService(logger.TestLogger(t), nil, []job.ServiceCtx{
+			orderedService{name: "a", events: &events},
+			orderedService{name: "b", events: &events},
+			orderedService{name: "c", events: &events, startErr: errors.New("boom")},
+			orderedService{name: "d", events: &events},
+		})
+
+		require.ErrorContains(t, f.Start(testutils.Context(t)), "boom")
+		assert.Equal(t, []string{"start a", "start b", "start c", "close b", "close a"}, events)
+	})
+}
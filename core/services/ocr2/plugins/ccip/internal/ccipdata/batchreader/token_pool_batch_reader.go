@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/big"
 	"sync"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/rpclib"
@@ -13,7 +14,9 @@ import (
 	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/burn_mint_token_pool_1_4_0"
 	type_and_version "github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/type_and_version_interface_wrapper"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/shared/generated/erc20"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/abihelpers"
 	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
@@ -25,6 +28,9 @@ import (
 
 var (
 	typeAndVersionABI = abihelpers.MustParseABI(type_and_version.TypeAndVersionInterfaceABI)
+	// tokenPoolABI is only used for getToken, which has the same signature in all the token pool versions.
+	tokenPoolABI = abihelpers.MustParseABI(burn_mint_token_pool_1_4_0.BurnMintTokenPoolABI)
+	erc20ABI     = abihelpers.MustParseABI(erc20.ERC20ABI)
 )
 
 type EVMTokenPoolBatchedReader struct {
@@ -41,7 +47,34 @@ type TokenPoolBatchedReader interface {
 	cciptypes.TokenPoolBatchedReader
 }
 
-var _ TokenPoolBatchedReader = (*EVMTokenPoolBatchedReader)(nil)
+// TokenPoolLiquidity is the liquidity and the inbound rate limiter state of a token pool.
+type TokenPoolLiquidity struct {
+	Pool     cciptypes.Address
+	PoolType string
+	Token    cciptypes.Address
+	// Balance is the balance of the pool in Token, which is the amount locked by a lock/release pool.
+	Balance   *big.Int
+	RateLimit cciptypes.TokenBucketRateLimit
+}
+
+// Available returns the amount the pool can release right now, limited by both its balance and its rate limiter.
+func (l TokenPoolLiquidity) Available() *big.Int {
+	if l.RateLimit.IsEnabled && l.RateLimit.Tokens != nil && l.RateLimit.Tokens.Cmp(l.Balance) < 0 {
+		return new(big.Int).Set(l.RateLimit.Tokens)
+	}
+	return new(big.Int).Set(l.Balance)
+}
+
+// TokenPoolLiquidityReader reads the liquidity of the token pools. It is only implemented by the in-process EVM
+// readers, as the token pool reader of the providers doesn't expose it.
+type TokenPoolLiquidityReader interface {
+	GetTokenPoolLiquidity(ctx context.Context, tokenPools []cciptypes.Address) ([]TokenPoolLiquidity, error)
+}
+
+var (
+	_ TokenPoolBatchedReader   = (*EVMTokenPoolBatchedReader)(nil)
+	_ TokenPoolLiquidityReader = (*EVMTokenPoolBatchedReader)(nil)
+)
 
 func NewEVMTokenPoolBatchedReader(lggr logger.Logger, remoteChainSelector uint64, offRampAddress cciptypes.Address, evmBatchCaller rpclib.EvmBatchCaller) (*EVMTokenPoolBatchedReader, error) {
 	offRampAddrEvm, err := ccipcalc.GenericAddrToEvm(offRampAddress)
@@ -63,32 +96,18 @@ func (br *EVMTokenPoolBatchedReader) GetInboundTokenPoolRateLimits(ctx context.C
 		return []cciptypes.TokenBucketRateLimit{}, nil
 	}
 
-	err := br.loadTokenPoolReaders(ctx, tokenPools)
+	tokenPoolReaders, err := br.getTokenPoolReaders(ctx, tokenPools)
 	if err != nil {
 		return nil, err
 	}
 
-	tokenPoolReaders := make([]ccipdata.TokenPoolReader, 0, len(tokenPools))
-	for _, poolAddress := range tokenPools {
-		br.tokenPoolReaderMu.RLock()
-		tokenPoolReader, exists := br.tokenPoolReaders[poolAddress]
-		br.tokenPoolReaderMu.RUnlock()
-		if !exists {
-			return nil, fmt.Errorf("token pool %s not found", poolAddress)
-		}
-		tokenPoolReaders = append(tokenPoolReaders, tokenPoolReader)
-	}
-
 	evmCalls := make([]rpclib.EvmCall, 0, len(tokenPoolReaders))
 	for _, poolReader := range tokenPoolReaders {
-		switch v := poolReader.(type) {
-		case *v1_2_0.TokenPool:
-			evmCalls = append(evmCalls, v1_2_0.GetInboundTokenPoolRateLimitCall(v.Address(), v.OffRampAddress))
-		case *v1_4_0.TokenPool:
-			evmCalls = append(evmCalls, v1_4_0.GetInboundTokenPoolRateLimitCall(v.Address(), v.RemoteChainSelector))
-		default:
-			return nil, fmt.Errorf("unsupported token pool version %T", v)
+		call, err2 := inboundRateLimitCall(poolReader)
+		if err2 != nil {
+			return nil, err2
 		}
+		evmCalls = append(evmCalls, call)
 	}
 
 	results, err := br.evmBatchCaller.BatchCall(ctx, 0, evmCalls)
@@ -105,6 +124,107 @@ func (br *EVMTokenPoolBatchedReader) GetInboundTokenPoolRateLimits(ctx context.C
 	return resultsParsed, nil
 }
 
+// GetTokenPoolLiquidity returns the liquidity and the inbound rate limiter state of the token pools. The liquidity is
+// the balance of the pool in its token, which is the amount locked by a lock/release pool. It is usually zero for
+// burn/mint pools.
+func (br *EVMTokenPoolBatchedReader) GetTokenPoolLiquidity(ctx context.Context, tokenPools []cciptypes.Address) ([]TokenPoolLiquidity, error) {
+	if len(tokenPools) == 0 {
+		return []TokenPoolLiquidity{}, nil
+	}
+
+	tokenPoolReaders, err := br.getTokenPoolReaders(ctx, tokenPools)
+	if err != nil {
+		return nil, err
+	}
+
+	// The token and the rate limiter state of each pool are fetched in a single batch, in this order.
+	evmCalls := make([]rpclib.EvmCall, 0, 2*len(tokenPoolReaders))
+	for _, poolReader := range tokenPoolReaders {
+		call, err2 := inboundRateLimitCall(poolReader)
+		if err2 != nil {
+			return nil, err2
+		}
+		evmCalls = append(evmCalls, rpclib.NewEvmCall(tokenPoolABI, "getToken", poolReader.Address()), call)
+	}
+
+	results, err := br.evmBatchCaller.BatchCall(ctx, 0, evmCalls)
+	if err != nil {
+		return nil, fmt.Errorf("batch call limit: %w", err)
+	}
+	if len(results) != len(evmCalls) {
+		return nil, fmt.Errorf("expected %d results, got %d", len(evmCalls), len(results))
+	}
+
+	liquidity := make([]TokenPoolLiquidity, len(tokenPoolReaders))
+	balanceCalls := make([]rpclib.EvmCall, 0, len(tokenPoolReaders))
+	for i, poolReader := range tokenPoolReaders {
+		token, err2 := rpclib.ParseOutput[common.Address](results[2*i], 0)
+		if err2 != nil {
+			return nil, fmt.Errorf("parse token of pool %s: %w", poolReader.Address(), err2)
+		}
+		rateLimit, err2 := rpclib.ParseOutput[cciptypes.TokenBucketRateLimit](results[2*i+1], 0)
+		if err2 != nil {
+			return nil, fmt.Errorf("parse rate limit of pool %s: %w", poolReader.Address(), err2)
+		}
+		liquidity[i] = TokenPoolLiquidity{
+			Pool:      ccipcalc.EvmAddrToGeneric(poolReader.Address()),
+			PoolType:  poolReader.Type(),
+			Token:     ccipcalc.EvmAddrToGeneric(token),
+			RateLimit: rateLimit,
+		}
+		balanceCalls = append(balanceCalls, rpclib.NewEvmCall(erc20ABI, "balanceOf", token, poolReader.Address()))
+	}
+
+	results, err = br.evmBatchCaller.BatchCall(ctx, 0, balanceCalls)
+	if err != nil {
+		return nil, fmt.Errorf("batch call limit: %w", err)
+	}
+	balances, err := rpclib.ParseOutputs[*big.Int](results, func(d rpclib.DataAndErr) (*big.Int, error) {
+		return rpclib.ParseOutput[*big.Int](d, 0)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("parse outputs: %w", err)
+	}
+	if len(balances) != len(liquidity) {
+		return nil, fmt.Errorf("expected %d balances, got %d", len(liquidity), len(balances))
+	}
+	for i := range liquidity {
+		liquidity[i].Balance = balances[i]
+	}
+	return liquidity, nil
+}
+
+// getTokenPoolReaders returns the readers of the token pools, in the same order.
+func (br *EVMTokenPoolBatchedReader) getTokenPoolReaders(ctx context.Context, tokenPools []cciptypes.Address) ([]ccipdata.TokenPoolReader, error) {
+	err := br.loadTokenPoolReaders(ctx, tokenPools)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenPoolReaders := make([]ccipdata.TokenPoolReader, 0, len(tokenPools))
+	br.tokenPoolReaderMu.RLock()
+	defer br.tokenPoolReaderMu.RUnlock()
+	for _, poolAddress := range tokenPools {
+		tokenPoolReader, exists := br.tokenPoolReaders[poolAddress]
+		if !exists {
+			return nil, fmt.Errorf("token pool %s not found", poolAddress)
+		}
+		tokenPoolReaders = append(tokenPoolReaders, tokenPoolReader)
+	}
+	return tokenPoolReaders, nil
+}
+
+func inboundRateLimitCall(poolReader ccipdata.TokenPoolReader) (rpclib.EvmCall, error) {
+	switch v := poolReader.(type) {
+	case *v1_2_0.TokenPool:
+		return v1_2_0.GetInboundTokenPoolRateLimitCall(v.Address(), v.OffRampAddress), nil
+	case *v1_4_0.TokenPool:
+		return v1_4_0.GetInboundTokenPoolRateLimitCall(v.Address(), v.RemoteChainSelector), nil
+	default:
+		return rpclib.EvmCall{}, fmt.Errorf("unsupported token pool version %T", v)
+	}
+}
+
 // loadTokenPoolReaders loads the token pools into the factory's cache
 func (br *EVMTokenPoolBatchedReader) loadTokenPoolReaders(ctx context.Context, tokenPoolAddresses []cciptypes.Address) error {
 	var missingTokens []common.Address
@@ -9,6 +9,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/rpclib"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/rpclib/rpclibmocks"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -84,3 +85,60 @@ func TestTokenPoolFactory(t *testing.T) {
 		}
 	}
 }
+
+func TestTokenPoolLiquidity(t *testing.T) {
+	lggr := logger.Test(t)
+	ctx := context.Background()
+	batchCallerMock := rpclibmocks.NewEvmBatchCaller(t)
+
+	tokenPoolBatchReader, err := NewEVMTokenPoolBatchedReader(lggr, uint64(2000), ccipcalc.EvmAddrToGeneric(utils.RandomAddress()), batchCallerMock)
+	require.NoError(t, err)
+
+	gotLiquidity, err := tokenPoolBatchReader.GetTokenPoolLiquidity(ctx, []cciptypes.Address{})
+	require.NoError(t, err)
+	assert.Empty(t, gotLiquidity)
+
+	pools := []cciptypes.Address{ccipcalc.EvmAddrToGeneric(utils.RandomAddress()), ccipcalc.EvmAddrToGeneric(utils.RandomAddress())}
+	tokens := []common.Address{utils.RandomAddress(), utils.RandomAddress()}
+	rateLimits := []cciptypes.TokenBucketRateLimit{
+		{Tokens: big.NewInt(100), Capacity: big.NewInt(1000), Rate: big.NewInt(1), IsEnabled: true},
+		{Tokens: big.NewInt(0), Capacity: big.NewInt(0), Rate: big.NewInt(0), IsEnabled: false},
+	}
+
+	// typeAndVersion
+	batchCallerMock.On("BatchCall", ctx, uint64(0), mock.Anything).Return([]rpclib.DataAndErr{
+		{Outputs: []any{"LockReleaseTokenPool " + ccipdata.V1_4_0}},
+		{Outputs: []any{"LockReleaseTokenPool " + ccipdata.V1_2_0}},
+	}, nil).Once()
+	// getToken and the rate limiter state
+	batchCallerMock.On("BatchCall", ctx, uint64(0), mock.Anything).Return([]rpclib.DataAndErr{
+		{Outputs: []any{tokens[0]}},
+		{Outputs: []any{rateLimits[0]}},
+		{Outputs: []any{tokens[1]}},
+		{Outputs: []any{rateLimits[1]}},
+	}, nil).Once()
+	// balanceOf
+	batchCallerMock.On("BatchCall", ctx, uint64(0), mock.Anything).Return([]rpclib.DataAndErr{
+		{Outputs: []any{big.NewInt(500)}},
+		{Outputs: []any{big.NewInt(50)}},
+	}, nil).Once()
+
+	gotLiquidity, err = tokenPoolBatchReader.GetTokenPoolLiquidity(ctx, pools)
+	require.NoError(t, err)
+	require.Len(t, gotLiquidity, 2)
+
+	assert.Equal(t, TokenPoolLiquidity{
+		Pool:      pools[0],
+		PoolType:  "LockReleaseTokenPool",
+		Token:     ccipcalc.EvmAddrToGeneric(tokens[0]),
+		Balance:   big.NewInt(500),
+		RateLimit: rateLimits[0],
+	}, gotLiquidity[0])
+	// limited by the rate limiter
+	assert.Equal(t, big.NewInt(100), gotLiquidity[0].Available())
+
+	assert.Equal(t, ccipcalc.EvmAddrToGeneric(tokens[1]), gotLiquidity[1].Token)
+	assert.Equal(t, big.NewInt(50), gotLiquidity[1].Balance)
+	// the rate limiter is disabled
+	assert.Equal(t, big.NewInt(50), gotLiquidity[1].Available())
+}
@@ -0,0 +1,89 @@
+package ccipdata_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/stretchr/testify/require"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_offramp"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_offramp_1_2_0"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/chainsdk/conformance"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
+)
+
+// TestReaderConformance runs the conformance tests of the chain family SDK against the EVM readers of the lanes
+// supported by the CCIP plugins, on simulated chains.
+func TestReaderConformance(t *testing.T) {
+	for _, version := range []string{ccipdata.V1_2_0, ccipdata.V1_5_0} {
+		version := version
+		t.Run("OnRampReader_"+version, func(t *testing.T) {
+			th := setupOnRampReaderTH(t, version)
+			conformance.TestOnRampReader(t, th.reader, conformance.OnRamp{Address: ccipcalc.EvmAddrToGeneric(th.address)})
+		})
+
+		t.Run("OffRampReader_"+version, func(t *testing.T) {
+			th := setupOffRampReaderTH(t, version)
+			conformance.TestOffRampReader(t, th.reader, conformance.OffRamp{
+				Address:      ccipcalc.EvmAddrToGeneric(th.address),
+				StaticConfig: deployedOffRampStaticConfig(t, th, version),
+			})
+		})
+	}
+
+	t.Run("PriceRegistryReader_"+ccipdata.V1_2_0, func(t *testing.T) {
+		th := setupPriceRegistryReaderTH(t)
+		var tokens []cciptypes.Address
+		for _, ts := range th.blockTs {
+			for _, update := range th.expectedTokenUpdates[ts] {
+				if !slices.Contains(tokens, update.Token) {
+					tokens = append(tokens, update.Token)
+				}
+			}
+		}
+		conformance.TestPriceRegistryReader(t, th.readers[ccipdata.V1_2_0], conformance.PriceRegistry{
+			Address: ccipcalc.EvmAddrToGeneric(th.addresses[ccipdata.V1_2_0]),
+			Tokens:  tokens,
+		})
+	})
+}
+
+// deployedOffRampStaticConfig reads the static config of the offRamp with its own bindings, rather than the reader's.
+func deployedOffRampStaticConfig(t *testing.T, th offRampReaderTH, version string) cciptypes.OffRampStaticConfig {
+	opts := &bind.CallOpts{Context: th.user.Context}
+	switch version {
+	case ccipdata.V1_2_0:
+		offRamp, err := evm_2_evm_offramp_1_2_0.NewEVM2EVMOffRamp(th.address, th.bc)
+		require.NoError(t, err)
+		c, err := offRamp.GetStaticConfig(opts)
+		require.NoError(t, err)
+		return cciptypes.OffRampStaticConfig{
+			CommitStore:         ccipcalc.EvmAddrToGeneric(c.CommitStore),
+			ChainSelector:       c.ChainSelector,
+			SourceChainSelector: c.SourceChainSelector,
+			OnRamp:              ccipcalc.EvmAddrToGeneric(c.OnRamp),
+			PrevOffRamp:         ccipcalc.EvmAddrToGeneric(c.PrevOffRamp),
+			ArmProxy:            ccipcalc.EvmAddrToGeneric(c.ArmProxy),
+		}
+	case ccipdata.V1_5_0:
+		offRamp, err := evm_2_evm_offramp.NewEVM2EVMOffRamp(th.address, th.bc)
+		require.NoError(t, err)
+		c, err := offRamp.GetStaticConfig(opts)
+		require.NoError(t, err)
+		return cciptypes.OffRampStaticConfig{
+			CommitStore:         ccipcalc.EvmAddrToGeneric(c.CommitStore),
+			ChainSelector:       c.ChainSelector,
+			SourceChainSelector: c.SourceChainSelector,
+			OnRamp:              ccipcalc.EvmAddrToGeneric(c.OnRamp),
+			PrevOffRamp:         ccipcalc.EvmAddrToGeneric(c.PrevOffRamp),
+			ArmProxy:            ccipcalc.EvmAddrToGeneric(c.RmnProxy),
+		}
+	default:
+		require.Fail(t, "Unknown version: ", version)
+		return cciptypes.OffRampStaticConfig{}
+	}
+}
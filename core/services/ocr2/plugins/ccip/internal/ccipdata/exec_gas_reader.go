@@ -0,0 +1,251 @@
+package ccipdata
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_offramp"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_offramp_1_2_0"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/abihelpers"
+)
+
+var (
+	// executionStateChanged is emitted by the offRamp, of all versions, once per message it executes, right after the
+	// executeSingleMessage call executing it.
+	executionStateChanged = evm_2_evm_offramp.EVM2EVMOffRampExecutionStateChanged{}.Topic()
+	// executeSingleMessageSelectors are the selectors of executeSingleMessage of the offRamp versions 1.2 to 1.5.
+	executeSingleMessageSelectors = [][]byte{
+		abihelpers.MustParseABI(evm_2_evm_offramp_1_2_0.EVM2EVMOffRampABI).Methods["executeSingleMessage"].ID,
+		abihelpers.MustParseABI(evm_2_evm_offramp.EVM2EVMOffRampABI).Methods["executeSingleMessage"].ID,
+	}
+)
+
+// MessageExecGas is the gas used to execute a message, as attributed within its execution transaction.
+type MessageExecGas struct {
+	SequenceNumber uint64
+	MessageID      [32]byte
+	TxHash         common.Hash
+	BlockNumber    uint64
+	// ExecGasUsed is the gas used by the executeSingleMessage call of the message, which runs its receiver and token
+	// transfers, or 0 if Traced is false.
+	ExecGasUsed uint64
+	// OverheadGasUsed is the share of the message in the gas used by the transaction outside executeSingleMessage calls,
+	// like its intrinsic gas and the verification of the merkle proofs, split evenly between its messages.
+	OverheadGasUsed   uint64
+	EffectiveGasPrice *big.Int
+	// Traced is whether the gas was attributed from the trace of the transaction. Otherwise, all its gas is overhead.
+	Traced bool
+}
+
+// ExecGasReader attributes the gas used by the execution transactions of an offRamp to the messages they executed.
+type ExecGasReader interface {
+	// LatestFinalizedBlock returns the latest finalized block of the dest chain.
+	LatestFinalizedBlock(ctx context.Context) (uint64, error)
+	// GetMessageExecGas returns the gas used by the messages executed by the offRamp in the transactions of the blocks
+	// from fromBlock to toBlock, inclusive, in execution order. The transactions are traced with debug_traceTransaction
+	// when the RPC supports it, and the gas of a transaction which can't be traced is split evenly between its messages.
+	GetMessageExecGas(ctx context.Context, fromBlock, toBlock uint64) ([]MessageExecGas, error)
+}
+
+var _ ExecGasReader = (*EVMExecGasReader)(nil)
+
+// EVMExecGasReader reads the execution transactions of the offRamp from the ExecutionStateChanged logs indexed by the
+// log poller, which the offRamp reader registers the filter of.
+type EVMExecGasReader struct {
+	lggr    logger.Logger
+	lp      logpoller.LogPoller
+	client  client.Client
+	offRamp common.Address
+
+	// traceUnsupported is set once the RPC rejected debug_traceTransaction, after which the transactions aren't traced.
+	traceUnsupported atomic.Bool
+}
+
+func NewEVMExecGasReader(lggr logger.Logger, lp logpoller.LogPoller, client client.Client, offRamp common.Address) *EVMExecGasReader {
+	return &EVMExecGasReader{
+		lggr:    logger.Named(lggr, "ExecGasReader"),
+		lp:      lp,
+		client:  client,
+		offRamp: offRamp,
+	}
+}
+
+func (r *EVMExecGasReader) LatestFinalizedBlock(ctx context.Context) (uint64, error) {
+	latest, err := r.lp.LatestBlock(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(latest.FinalizedBlockNumber), nil
+}
+
+func (r *EVMExecGasReader) GetMessageExecGas(ctx context.Context, fromBlock, toBlock uint64) ([]MessageExecGas, error) {
+	logs, err := r.lp.LogsWithSigs(ctx, int64(fromBlock), int64(toBlock), []common.Hash{executionStateChanged}, r.offRamp)
+	if err != nil {
+		return nil, fmt.Errorf("get ExecutionStateChanged logs: %w", err)
+	}
+
+	var gas []MessageExecGas
+	seen := make(map[common.Hash]struct{})
+	for _, l := range logs {
+		if _, ok := seen[l.TxHash]; ok {
+			continue
+		}
+		seen[l.TxHash] = struct{}{}
+		txGas, err := r.getTxExecGas(ctx, l.TxHash)
+		if err != nil {
+			return nil, fmt.Errorf("tx %s: %w", l.TxHash, err)
+		}
+		gas = append(gas, txGas...)
+	}
+	return gas, nil
+}
+
+// getTxExecGas attributes the gas used by the transaction to the messages it executed, which are read from its receipt
+// rather than the log poller, so that they are all attributed even if only some of its logs were indexed.
+func (r *EVMExecGasReader) getTxExecGas(ctx context.Context, txHash common.Hash) ([]MessageExecGas, error) {
+	receipt, err := r.client.TransactionReceipt(ctx, txHash)
+	if err != nil {
+		return nil, fmt.Errorf("get receipt: %w", err)
+	}
+	effectiveGasPrice := receipt.EffectiveGasPrice
+	if effectiveGasPrice == nil {
+		effectiveGasPrice = big.NewInt(0)
+	}
+	var msgs []MessageExecGas
+	for _, l := range receipt.Logs {
+		if l.Address != r.offRamp || len(l.Topics) < 3 || l.Topics[0] != executionStateChanged {
+			continue
+		}
+		msgs = append(msgs, MessageExecGas{
+			SequenceNumber:    l.Topics[1].Big().Uint64(),
+			MessageID:         l.Topics[2],
+			TxHash:            txHash,
+			BlockNumber:       receipt.BlockNumber.Uint64(),
+			EffectiveGasPrice: effectiveGasPrice,
+		})
+	}
+
+	trace, err := r.traceTransaction(ctx, txHash)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, err
+		}
+		// a transaction which can't be traced, e.g. because the node pruned the state of its block, would otherwise be
+		// retried forever, so it is recorded as untraced
+		r.lggr.Warnw("Failed to trace the execution transaction, splitting its gas evenly between its messages",
+			"txHash", txHash, "err", err)
+	} else if trace != nil {
+		err = attributeExecGas(r.offRamp, receipt, msgs, trace)
+		if err == nil {
+			return msgs, nil
+		}
+		r.lggr.Warnw("Failed to attribute the gas of the execution transaction from its trace, splitting it evenly between its messages",
+			"txHash", txHash, "err", err)
+	}
+	splitOverheadGas(msgs, receipt.GasUsed)
+	return msgs, nil
+}
+
+// callFrame is a call of the trace of a transaction, as returned by the callTracer.
+type callFrame struct {
+	From    common.Address `json:"from"`
+	To      common.Address `json:"to"`
+	GasUsed hexutil.Uint64 `json:"gasUsed"`
+	Input   hexutil.Bytes  `json:"input"`
+	Calls   []callFrame    `json:"calls"`
+}
+
+// traceTransaction returns the call tree of the transaction, or nil if the RPC doesn't support tracing. Tracing
+// re-executes the transaction on the state of its block, so it is sent to an archive node when there is one.
+func (r *EVMExecGasReader) traceTransaction(ctx context.Context, txHash common.Hash) (*callFrame, error) {
+	if r.traceUnsupported.Load() {
+		return nil, nil
+	}
+	var trace callFrame
+	err := r.client.CallContext(commonclient.CtxRequireArchive(ctx), &trace, "debug_traceTransaction", txHash, map[string]string{"tracer": "callTracer"})
+	if err != nil {
+		if isMethodUnsupported(err) {
+			r.lggr.Warnw("RPC does not support debug_traceTransaction, the gas of execution transactions is split evenly between their messages", "err", err)
+			r.traceUnsupported.Store(true)
+			return nil, nil
+		}
+		return nil, fmt.Errorf("trace: %w", err)
+	}
+	return &trace, nil
+}
+
+// isMethodUnsupported returns true if err rejects debug_traceTransaction itself, like geth's "the method
+// debug_traceTransaction does not exist/is not available", rather than the tracing of a transaction.
+func isMethodUnsupported(err error) bool {
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) && rpcErr.ErrorCode() == -32601 {
+		return true
+	}
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "method not found") ||
+		(strings.Contains(msg, "method debug_tracetransaction") && (strings.Contains(msg, "does not exist") || strings.Contains(msg, "not available")))
+}
+
+// attributeExecGas attributes to each message the gas used by its executeSingleMessage call, which the offRamp makes to
+// itself in the order of the ExecutionStateChanged logs, and splits the rest of the gas of the transaction evenly.
+func attributeExecGas(offRamp common.Address, receipt *types.Receipt, msgs []MessageExecGas, trace *callFrame) error {
+	var calls []callFrame
+	findExecuteSingleMessageCalls(offRamp, *trace, &calls)
+	if len(calls) != len(msgs) {
+		return fmt.Errorf("found %d executeSingleMessage calls for %d executed messages", len(calls), len(msgs))
+	}
+	var execGasUsed uint64
+	for _, c := range calls {
+		execGasUsed += uint64(c.GasUsed)
+	}
+	if execGasUsed > receipt.GasUsed {
+		return fmt.Errorf("executeSingleMessage calls used %d gas, more than the %d gas of the transaction", execGasUsed, receipt.GasUsed)
+	}
+	for i, c := range calls {
+		msgs[i].ExecGasUsed = uint64(c.GasUsed)
+		msgs[i].Traced = true
+	}
+	splitOverheadGas(msgs, receipt.GasUsed-execGasUsed)
+	return nil
+}
+
+func findExecuteSingleMessageCalls(offRamp common.Address, frame callFrame, calls *[]callFrame) {
+	if frame.From == offRamp && frame.To == offRamp && len(frame.Input) >= 4 {
+		for _, selector := range executeSingleMessageSelectors {
+			if bytes.Equal(frame.Input[:4], selector) {
+				*calls = append(*calls, frame)
+				return
+			}
+		}
+	}
+	for _, c := range frame.Calls {
+		findExecuteSingleMessageCalls(offRamp, c, calls)
+	}
+}
+
+// splitOverheadGas splits gas evenly between the messages, the first one taking the remainder.
+func splitOverheadGas(msgs []MessageExecGas, gas uint64) {
+	if len(msgs) == 0 {
+		return
+	}
+	share := gas / uint64(len(msgs))
+	for i := range msgs {
+		msgs[i].OverheadGasUsed = share
+	}
+	msgs[0].OverheadGasUsed += gas % uint64(len(msgs))
+}
@@ -0,0 +1,140 @@
+package ccipdata
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
+	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	lpmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+func TestEVMExecGasReader(t *testing.T) {
+	ctx := testutils.Context(t)
+	offRamp, forwarder, receiver := utils.RandomAddress(), utils.RandomAddress(), utils.RandomAddress()
+	txHash, messageID1, messageID2 := utils.RandomHash(), utils.RandomHash(), utils.RandomHash()
+
+	executionStateChangedLog := func(seqNum uint64, messageID common.Hash) *types.Log {
+		return &types.Log{Address: offRamp, Topics: []common.Hash{executionStateChanged, common.BigToHash(new(big.Int).SetUint64(seqNum)), messageID}}
+	}
+	receipt := &types.Receipt{
+		GasUsed:           200_000,
+		EffectiveGasPrice: big.NewInt(1e9),
+		BlockNumber:       big.NewInt(42),
+		Logs: []*types.Log{
+			{Address: receiver, Topics: []common.Hash{utils.RandomHash()}},
+			executionStateChangedLog(5, messageID1),
+			executionStateChangedLog(6, messageID2),
+		},
+	}
+	executeSingleMessage := func(gasUsed uint64) callFrame {
+		return callFrame{From: offRamp, To: offRamp, GasUsed: hexutil.Uint64(gasUsed), Input: executeSingleMessageSelectors[1],
+			Calls: []callFrame{{From: offRamp, To: receiver, GasUsed: hexutil.Uint64(gasUsed / 2)}}}
+	}
+	// the transaction of the OCR2 transmission through a forwarder
+	trace := callFrame{From: utils.RandomAddress(), To: forwarder, GasUsed: 200_000, Calls: []callFrame{{
+		From:    forwarder,
+		To:      offRamp,
+		GasUsed: 190_000,
+		Calls:   []callFrame{executeSingleMessage(50_000), executeSingleMessage(70_000)},
+	}}}
+
+	newReader := func(t *testing.T) (*EVMExecGasReader, *evmclimocks.Client) {
+		lp := lpmocks.NewLogPoller(t)
+		lp.On("LogsWithSigs", mock.Anything, int64(40), int64(45), []common.Hash{executionStateChanged}, offRamp).
+			Return([]logpoller.Log{{TxHash: txHash, BlockNumber: 42, LogIndex: 1}, {TxHash: txHash, BlockNumber: 42, LogIndex: 2}}, nil)
+		client := evmclimocks.NewClient(t)
+		client.On("TransactionReceipt", mock.Anything, txHash).Return(receipt, nil)
+		return NewEVMExecGasReader(logger.TestLogger(t), lp, client, offRamp), client
+	}
+
+	t.Run("traced", func(t *testing.T) {
+		reader, client := newReader(t)
+		archive := mock.MatchedBy(func(ctx context.Context) bool { return commonclient.CtxIsArchiveRequest(ctx) })
+		client.On("CallContext", archive, mock.Anything, "debug_traceTransaction", txHash, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			*args.Get(1).(*callFrame) = trace
+		}).Once()
+
+		gas, err := reader.GetMessageExecGas(ctx, 40, 45)
+		require.NoError(t, err)
+		assert.Equal(t, []MessageExecGas{
+			{SequenceNumber: 5, MessageID: messageID1, TxHash: txHash, BlockNumber: 42, ExecGasUsed: 50_000, OverheadGasUsed: 40_000, EffectiveGasPrice: big.NewInt(1e9), Traced: true},
+			{SequenceNumber: 6, MessageID: messageID2, TxHash: txHash, BlockNumber: 42, ExecGasUsed: 70_000, OverheadGasUsed: 40_000, EffectiveGasPrice: big.NewInt(1e9), Traced: true},
+		}, gas)
+	})
+
+	t.Run("tracing unsupported", func(t *testing.T) {
+		reader, client := newReader(t)
+		client.On("CallContext", mock.Anything, mock.Anything, "debug_traceTransaction", txHash, mock.Anything).
+			Return(errors.New("the method debug_traceTransaction does not exist/is not available")).Once()
+
+		for i := 0; i < 2; i++ {
+			gas, err := reader.GetMessageExecGas(ctx, 40, 45)
+			require.NoError(t, err)
+			require.Len(t, gas, 2)
+			for _, g := range gas {
+				assert.False(t, g.Traced)
+				assert.Equal(t, uint64(0), g.ExecGasUsed)
+				assert.Equal(t, uint64(100_000), g.OverheadGasUsed)
+			}
+		}
+	})
+
+	t.Run("trace failure", func(t *testing.T) {
+		reader, client := newReader(t)
+		client.On("CallContext", mock.Anything, mock.Anything, "debug_traceTransaction", txHash, mock.Anything).
+			Return(errors.New("missing trie node 1a2b3c (path ) state 0x1a2b3c is not available")).Twice()
+
+		// the transaction is recorded as untraced, and the next ones are still traced
+		for i := 0; i < 2; i++ {
+			gas, err := reader.GetMessageExecGas(ctx, 40, 45)
+			require.NoError(t, err)
+			require.Len(t, gas, 2)
+			assert.False(t, gas[0].Traced)
+			assert.Equal(t, uint64(100_000), gas[0].OverheadGasUsed)
+		}
+	})
+
+	t.Run("trace not matching the messages", func(t *testing.T) {
+		reader, client := newReader(t)
+		client.On("CallContext", mock.Anything, mock.Anything, "debug_traceTransaction", txHash, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			*args.Get(1).(*callFrame) = callFrame{From: utils.RandomAddress(), To: offRamp, GasUsed: 200_000, Calls: []callFrame{executeSingleMessage(50_000)}}
+		}).Once()
+
+		gas, err := reader.GetMessageExecGas(ctx, 40, 45)
+		require.NoError(t, err)
+		require.Len(t, gas, 2)
+		assert.False(t, gas[0].Traced)
+		assert.Equal(t, uint64(100_000), gas[0].OverheadGasUsed)
+	})
+}
+
+func TestIsMethodUnsupported(t *testing.T) {
+	assert.True(t, isMethodUnsupported(errors.New("the method debug_traceTransaction does not exist/is not available")))
+	assert.True(t, isMethodUnsupported(errors.New("Method not found")))
+	assert.False(t, isMethodUnsupported(errors.New("missing trie node 1a2b3c (path ) state 0x1a2b3c is not available")))
+	assert.False(t, isMethodUnsupported(errors.New("transaction 0x1a2b does not exist")))
+}
+
+func TestSplitOverheadGas(t *testing.T) {
+	msgs := make([]MessageExecGas, 3)
+	splitOverheadGas(msgs, 100)
+	assert.Equal(t, uint64(34), msgs[0].OverheadGasUsed)
+	assert.Equal(t, uint64(33), msgs[1].OverheadGasUsed)
+	assert.Equal(t, uint64(33), msgs[2].OverheadGasUsed)
+
+	splitOverheadGas(nil, 100)
+}
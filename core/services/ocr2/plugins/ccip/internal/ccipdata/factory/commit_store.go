@@ -80,27 +80,34 @@ func initOrCloseCommitStoreReader(lggr logger.Logger, versionFinder VersionFinde
 }
 
 func CommitReportToEthTxMeta(typ ccipconfig.ContractType, ver semver.Version) (func(report []byte) (*txmgr.TxMeta, error), error) {
+	decode, err := CommitReportDecoder(typ, ver)
+	if err != nil {
+		return nil, err
+	}
+	return func(report []byte) (*txmgr.TxMeta, error) {
+		commitReport, err := decode(report)
+		if err != nil {
+			return nil, err
+		}
+		return commitReportToEthTxMeta(commitReport)
+	}, nil
+}
+
+// CommitReportDecoder returns the decoder of the reports transmitted to the commit store of the given version.
+func CommitReportDecoder(typ ccipconfig.ContractType, ver semver.Version) (func(report []byte) (cciptypes.CommitStoreReport, error), error) {
 	if typ != ccipconfig.CommitStore {
 		return nil, errors.Errorf("expected %v got %v", ccipconfig.CommitStore, typ)
 	}
 	switch ver.String() {
 	case ccipdata.V1_0_0, ccipdata.V1_1_0:
-		commitStoreABI := abihelpers.MustParseABI(commit_store_1_0_0.CommitStoreABI)
-		return func(report []byte) (*txmgr.TxMeta, error) {
-			commitReport, err := v1_0_0.DecodeCommitReport(abihelpers.MustGetEventInputs(v1_0_0.ReportAccepted, commitStoreABI), report)
-			if err != nil {
-				return nil, err
-			}
-			return commitReportToEthTxMeta(commitReport)
+		commitReportArgs := abihelpers.MustGetEventInputs(v1_0_0.ReportAccepted, abihelpers.MustParseABI(commit_store_1_0_0.CommitStoreABI))
+		return func(report []byte) (cciptypes.CommitStoreReport, error) {
+			return v1_0_0.DecodeCommitReport(commitReportArgs, report)
 		}, nil
 	case ccipdata.V1_2_0, ccipdata.V1_5_0:
-		commitStoreABI := abihelpers.MustParseABI(commit_store.CommitStoreABI)
-		return func(report []byte) (*txmgr.TxMeta, error) {
-			commitReport, err := v1_2_0.DecodeCommitReport(abihelpers.MustGetEventInputs(v1_0_0.ReportAccepted, commitStoreABI), report)
-			if err != nil {
-				return nil, err
-			}
-			return commitReportToEthTxMeta(commitReport)
+		commitReportArgs := abihelpers.MustGetEventInputs(v1_0_0.ReportAccepted, abihelpers.MustParseABI(commit_store.CommitStoreABI))
+		return func(report []byte) (cciptypes.CommitStoreReport, error) {
+			return v1_2_0.DecodeCommitReport(commitReportArgs, report)
 		}, nil
 	default:
 		return nil, errors.Errorf("got unexpected version %v", ver.String())
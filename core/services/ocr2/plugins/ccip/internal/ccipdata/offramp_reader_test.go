@@ -37,8 +37,10 @@ import (
 )
 
 type offRampReaderTH struct {
-	user   *bind.TransactOpts
-	reader ccipdata.OffRampReader
+	user    *bind.TransactOpts
+	bc      *client.SimulatedBackendClient
+	reader  ccipdata.OffRampReader
+	address common.Address
 }
 
 func TestExecOnchainConfig100(t *testing.T) {
@@ -202,8 +204,10 @@ func setupOffRampReaderTH(t *testing.T, version string) offRampReaderTH {
 	require.Equal(t, ccipcalc.EvmAddrToGeneric(offRampAddress), addr)
 
 	return offRampReaderTH{
-		user:   user,
-		reader: reader,
+		user:    user,
+		bc:      bc,
+		reader:  reader,
+		address: offRampAddress,
 	}
 }
 
@@ -33,8 +33,9 @@ import (
 )
 
 type onRampReaderTH struct {
-	user   *bind.TransactOpts
-	reader ccipdata.OnRampReader
+	user    *bind.TransactOpts
+	reader  ccipdata.OnRampReader
+	address common.Address
 }
 
 func TestNewOnRampReader_noContractAtAddress(t *testing.T) {
@@ -117,8 +118,9 @@ func setupOnRampReaderTH(t *testing.T, version string) onRampReaderTH {
 	require.NoError(t, err)
 
 	return onRampReaderTH{
-		user:   user,
-		reader: reader,
+		user:    user,
+		reader:  reader,
+		address: onRampAddress,
 	}
 }
 
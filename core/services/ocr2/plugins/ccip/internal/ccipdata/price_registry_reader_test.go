@@ -41,6 +41,8 @@ type priceRegReaderTH struct {
 	lggr    logger.Logger
 	user    *bind.TransactOpts
 	readers map[string]ccipdata.PriceRegistryReader
+	// addresses are the addresses of the price registries of the readers.
+	addresses map[string]common.Address
 
 	// Expected state
 	blockTs              []uint64
@@ -155,6 +157,9 @@ func setupPriceRegistryReaderTH(t *testing.T) priceRegReaderTH {
 		readers: map[string]ccipdata.PriceRegistryReader{
 			ccipdata.V1_0_0: pr10r, ccipdata.V1_2_0: pr12r,
 		},
+		addresses: map[string]common.Address{
+			ccipdata.V1_0_0: addr, ccipdata.V1_2_0: addr2,
+		},
 		expectedFeeTokens: feeTokens,
 		expectedGasUpdates: map[uint64][]cciptypes.GasPrice{
 			b1: gasPriceUpdatesBlock1,
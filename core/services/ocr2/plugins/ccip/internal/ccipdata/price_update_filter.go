@@ -0,0 +1,111 @@
+package ccipdata
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/commit_store"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/price_registry_1_2_0"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+)
+
+// DefaultPriceRefreshInterval is the default age after which a price equal to the one of the PriceRegistry is updated
+// again, to refresh its timestamp.
+const DefaultPriceRefreshInterval = 5 * time.Minute
+
+// PriceUpdateFilter checks the prices of the price-only commit reports against the PriceRegistry of the lane, for the
+// reports which wouldn't change it not to be transmitted.
+type PriceUpdateFilter interface {
+	// HasUpdates returns whether any of the gas and token prices differs from the one of the PriceRegistry, or is older
+	// than the refresh interval there.
+	HasUpdates(ctx context.Context, gasPrices []cciptypes.GasPrice, tokenPrices []cciptypes.TokenPrice) (bool, error)
+}
+
+var _ PriceUpdateFilter = (*EVMPriceUpdateFilter)(nil)
+
+// EVMPriceUpdateFilter reads the PriceRegistry 1.2 of a CommitStore 1.2 or 1.5, which it reads from the dynamic config
+// of the CommitStore before each check, so that it follows the PriceRegistry changes.
+type EVMPriceUpdateFilter struct {
+	client      client.Client
+	commitStore *commit_store.CommitStoreCaller
+	// refreshInterval is the age after which a price equal to the one of the PriceRegistry is updated anyway. It must be
+	// lower than the gas and token price heartbeats, for their updates not to be skipped.
+	refreshInterval time.Duration
+}
+
+func NewEVMPriceUpdateFilter(client client.Client, commitStoreAddress common.Address, refreshInterval time.Duration) (*EVMPriceUpdateFilter, error) {
+	commitStore, err := commit_store.NewCommitStoreCaller(commitStoreAddress, client)
+	if err != nil {
+		return nil, err
+	}
+	if refreshInterval == 0 {
+		refreshInterval = DefaultPriceRefreshInterval
+	}
+	return &EVMPriceUpdateFilter{
+		client:          client,
+		commitStore:     commitStore,
+		refreshInterval: refreshInterval,
+	}, nil
+}
+
+func (f *EVMPriceUpdateFilter) HasUpdates(ctx context.Context, gasPrices []cciptypes.GasPrice, tokenPrices []cciptypes.TokenPrice) (bool, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	dynamicConfig, err := f.commitStore.GetDynamicConfig(opts)
+	if err != nil {
+		return false, fmt.Errorf("get the PriceRegistry of the CommitStore: %w", err)
+	}
+	priceRegistry, err := price_registry_1_2_0.NewPriceRegistryCaller(dynamicConfig.PriceRegistry, f.client)
+	if err != nil {
+		return false, err
+	}
+
+	now := time.Now()
+	isUpdate := func(current price_registry_1_2_0.InternalTimestampedPackedUint224, value *big.Int) bool {
+		return current.Value == nil || value == nil || current.Value.Cmp(value) != 0 ||
+			now.Sub(time.Unix(int64(current.Timestamp), 0)) >= f.refreshInterval
+	}
+
+	for _, gasPrice := range gasPrices {
+		current, err := priceRegistry.GetDestinationChainGasPrice(opts, gasPrice.DestChainSelector)
+		if err != nil {
+			return false, fmt.Errorf("get the gas price of chain %d: %w", gasPrice.DestChainSelector, err)
+		}
+		if isUpdate(current, gasPrice.Value) {
+			return true, nil
+		}
+	}
+
+	if len(tokenPrices) == 0 {
+		return false, nil
+	}
+	tokens := make([]cciptypes.Address, len(tokenPrices))
+	for i, tokenPrice := range tokenPrices {
+		tokens[i] = tokenPrice.Token
+	}
+	evmTokens, err := ccipcalc.GenericAddrsToEvm(tokens...)
+	if err != nil {
+		return false, err
+	}
+	// the token prices are read in a single call
+	current, err := priceRegistry.GetTokenPrices(opts, evmTokens)
+	if err != nil {
+		return false, fmt.Errorf("get the token prices: %w", err)
+	}
+	if len(current) != len(evmTokens) {
+		return false, fmt.Errorf("got %d token prices for %d tokens", len(current), len(evmTokens))
+	}
+	for i, tokenPrice := range tokenPrices {
+		if isUpdate(current[i], tokenPrice.Value) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
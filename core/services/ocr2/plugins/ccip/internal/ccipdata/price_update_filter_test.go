@@ -0,0 +1,143 @@
+package ccipdata
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/commit_store"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/price_registry_1_2_0"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/abihelpers"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+)
+
+func TestEVMPriceUpdateFilter_HasUpdates(t *testing.T) {
+	ctx := testutils.Context(t)
+	commitStoreABI := abihelpers.MustParseABI(commit_store.CommitStoreABI)
+	priceRegistryABI := abihelpers.MustParseABI(price_registry_1_2_0.PriceRegistryABI)
+	commitStoreAddress, priceRegistryAddress := utils.RandomAddress(), utils.RandomAddress()
+	token1, token2 := utils.RandomAddress(), utils.RandomAddress()
+	recent := uint32(time.Now().Add(-time.Minute).Unix())
+	old := uint32(time.Now().Add(-time.Hour).Unix())
+
+	gasPrices := []cciptypes.GasPrice{{DestChainSelector: 1, Value: big.NewInt(100)}, {DestChainSelector: 2, Value: big.NewInt(200)}}
+	tokenPrices := []cciptypes.TokenPrice{
+		{Token: ccipcalc.EvmAddrToGeneric(token1), Value: big.NewInt(10)},
+		{Token: ccipcalc.EvmAddrToGeneric(token2), Value: big.NewInt(20)},
+	}
+
+	// newFilter mocks the CommitStore and PriceRegistry calls, the PriceRegistry having the gasPrices and tokenPrices
+	// indexed by the chain selector and the token.
+	newFilter := func(t *testing.T, gasPrices map[uint64]price_registry_1_2_0.InternalTimestampedPackedUint224,
+		tokenPrices map[common.Address]price_registry_1_2_0.InternalTimestampedPackedUint224) *EVMPriceUpdateFilter {
+		client := evmclimocks.NewClient(t)
+		isCall := func(to common.Address, method string, abiMethods map[string][]byte) func(ethereum.CallMsg) bool {
+			return func(msg ethereum.CallMsg) bool {
+				return msg.To != nil && *msg.To == to && bytes.HasPrefix(msg.Data, abiMethods[method])
+			}
+		}
+		commitStoreMethods := map[string][]byte{"getDynamicConfig": commitStoreABI.Methods["getDynamicConfig"].ID}
+		priceRegistryMethods := map[string][]byte{}
+		for name, m := range priceRegistryABI.Methods {
+			priceRegistryMethods[name] = m.ID
+		}
+
+		dynamicConfig, err := commitStoreABI.Methods["getDynamicConfig"].Outputs.Pack(commit_store.CommitStoreDynamicConfig{PriceRegistry: priceRegistryAddress})
+		require.NoError(t, err)
+		client.On("CallContract", mock.Anything, mock.MatchedBy(isCall(commitStoreAddress, "getDynamicConfig", commitStoreMethods)), mock.Anything).
+			Return(dynamicConfig, nil).Maybe()
+		client.On("CallContract", mock.Anything, mock.MatchedBy(isCall(priceRegistryAddress, "getDestinationChainGasPrice", priceRegistryMethods)), mock.Anything).
+			Return(func(_ context.Context, msg ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+				args, err := priceRegistryABI.Methods["getDestinationChainGasPrice"].Inputs.Unpack(msg.Data[4:])
+				require.NoError(t, err)
+				written, ok := gasPrices[args[0].(uint64)]
+				if !ok {
+					written = price_registry_1_2_0.InternalTimestampedPackedUint224{Value: big.NewInt(0)}
+				}
+				return priceRegistryABI.Methods["getDestinationChainGasPrice"].Outputs.Pack(written)
+			}).Maybe()
+		client.On("CallContract", mock.Anything, mock.MatchedBy(isCall(priceRegistryAddress, "getTokenPrices", priceRegistryMethods)), mock.Anything).
+			Return(func(_ context.Context, msg ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+				args, err := priceRegistryABI.Methods["getTokenPrices"].Inputs.Unpack(msg.Data[4:])
+				require.NoError(t, err)
+				var written []price_registry_1_2_0.InternalTimestampedPackedUint224
+				for _, token := range args[0].([]common.Address) {
+					price, ok := tokenPrices[token]
+					if !ok {
+						price = price_registry_1_2_0.InternalTimestampedPackedUint224{Value: big.NewInt(0)}
+					}
+					written = append(written, price)
+				}
+				return priceRegistryABI.Methods["getTokenPrices"].Outputs.Pack(written)
+			}).Maybe()
+
+		filter, err := NewEVMPriceUpdateFilter(client, commitStoreAddress, 0)
+		require.NoError(t, err)
+		return filter
+	}
+
+	t.Run("has updates for the prices missing from the PriceRegistry", func(t *testing.T) {
+		filter := newFilter(t, nil, nil)
+
+		hasUpdates, err := filter.HasUpdates(ctx, gasPrices, tokenPrices)
+		require.NoError(t, err)
+		assert.True(t, hasUpdates)
+	})
+
+	current := func(gasPrice2, tokenPrice2 price_registry_1_2_0.InternalTimestampedPackedUint224) *EVMPriceUpdateFilter {
+		return newFilter(t,
+			map[uint64]price_registry_1_2_0.InternalTimestampedPackedUint224{
+				1: {Value: big.NewInt(100), Timestamp: recent},
+				2: gasPrice2,
+			},
+			map[common.Address]price_registry_1_2_0.InternalTimestampedPackedUint224{
+				token1: {Value: big.NewInt(10), Timestamp: recent},
+				token2: tokenPrice2,
+			})
+	}
+
+	tests := []struct {
+		name        string
+		filter      *EVMPriceUpdateFilter
+		gasPrices   []cciptypes.GasPrice
+		tokenPrices []cciptypes.TokenPrice
+		expected    bool
+	}{
+		{"no updates when all the prices are in the PriceRegistry",
+			current(price_registry_1_2_0.InternalTimestampedPackedUint224{Value: big.NewInt(200), Timestamp: recent}, price_registry_1_2_0.InternalTimestampedPackedUint224{Value: big.NewInt(20), Timestamp: recent}),
+			gasPrices, tokenPrices, false},
+		{"update of a different gas price",
+			current(price_registry_1_2_0.InternalTimestampedPackedUint224{Value: big.NewInt(199), Timestamp: recent}, price_registry_1_2_0.InternalTimestampedPackedUint224{Value: big.NewInt(20), Timestamp: recent}),
+			gasPrices, tokenPrices, true},
+		{"update of a different token price",
+			current(price_registry_1_2_0.InternalTimestampedPackedUint224{Value: big.NewInt(200), Timestamp: recent}, price_registry_1_2_0.InternalTimestampedPackedUint224{Value: big.NewInt(19), Timestamp: recent}),
+			gasPrices, tokenPrices, true},
+		// a price equal to the current one is refreshed once older than the refresh interval
+		{"update of an equal price older than the refresh interval",
+			current(price_registry_1_2_0.InternalTimestampedPackedUint224{Value: big.NewInt(200), Timestamp: old}, price_registry_1_2_0.InternalTimestampedPackedUint224{Value: big.NewInt(20), Timestamp: recent}),
+			gasPrices, tokenPrices, true},
+		{"no updates without prices",
+			current(price_registry_1_2_0.InternalTimestampedPackedUint224{}, price_registry_1_2_0.InternalTimestampedPackedUint224{}),
+			nil, nil, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			hasUpdates, err := tc.filter.HasUpdates(ctx, tc.gasPrices, tc.tokenPrices)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, hasUpdates)
+		})
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query"
 
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
@@ -442,14 +443,21 @@ func (o *OffRamp) GetExecutionStateChangesBetweenSeqNums(ctx context.Context, se
 		return nil, fmt.Errorf("get lp latest block: %w", err)
 	}
 
-	logs, err := o.lp.IndexedLogsTopicRange(
+	stateChangesQuery, err := logpoller.Where(
+		logpoller.NewAddressFilter(o.addr),
+		logpoller.NewEventSigFilter(o.eventSig),
+		logpoller.NewEventByTopicRangeFilter(uint64(o.eventIndex), logpoller.EvmWord(seqNumMin), logpoller.EvmWord(seqNumMax)),
+		logpoller.NewConfirmationsFilter(evmtypes.Confirmations(confs)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	logs, err := o.lp.FilteredLogs(
 		ctx,
-		o.eventSig,
-		o.addr,
-		o.eventIndex,
-		logpoller.EvmWord(seqNumMin),
-		logpoller.EvmWord(seqNumMax),
-		evmtypes.Confirmations(confs),
+		stateChangesQuery,
+		query.NewLimitAndSort(query.Limit{}, query.NewSortBySequence(query.Asc)),
+		"GetExecutionStateChangesBetweenSeqNums",
 	)
 	if err != nil {
 		return nil, err
@@ -13,6 +13,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query"
 
 	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
@@ -189,10 +190,18 @@ func Test_LogsAreProperlyMarkedAsFinalized(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			offrampAddress := utils.RandomAddress()
 
+			expectedQuery, err := logpoller.Where(
+				logpoller.NewAddressFilter(offrampAddress),
+				logpoller.NewEventSigFilter(ExecutionStateChangedEvent),
+				logpoller.NewEventByTopicRangeFilter(1, logpoller.EvmWord(minSeqNr), logpoller.EvmWord(maxSeqNr)),
+				logpoller.NewConfirmationsFilter(evmtypes.Confirmations(0)),
+			)
+			require.NoError(t, err)
+
 			lp := mocks.NewLogPoller(t)
 			lp.On("LatestBlock", mock.Anything).
 				Return(logpoller.LogPollerBlock{FinalizedBlockNumber: int64(tt.lastFinalizedBlock)}, nil)
-			lp.On("IndexedLogsTopicRange", mock.Anything, ExecutionStateChangedEvent, offrampAddress, 1, logpoller.EvmWord(minSeqNr), logpoller.EvmWord(maxSeqNr), evmtypes.Confirmations(0)).
+			lp.On("FilteredLogs", mock.Anything, expectedQuery, query.NewLimitAndSort(query.Limit{}, query.NewSortBySequence(query.Asc)), "GetExecutionStateChangesBetweenSeqNums").
 				Return(inputLogs, nil)
 
 			offRamp, err := NewOffRamp(logger.Test(t), offrampAddress, evmclimocks.NewClient(t), lp, nil, nil)
@@ -0,0 +1,51 @@
+package db
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	cciporm "github.com/smartcontractkit/chainlink/v2/core/services/ccip"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/prices"
+)
+
+var _ PriceService = (*priceReader)(nil)
+
+// priceReader is the PriceService of a Commit plugin running as a LOOP. It only reads the prices from the DB, while
+// the PriceService of the job keeps writing them from the node, since its price getters depend on the node.
+type priceReader struct {
+	services.StateMachine
+	orm cciporm.ORM
+}
+
+func NewPriceReader(orm cciporm.ORM) PriceService {
+	return &priceReader{orm: orm}
+}
+
+func (p *priceReader) Start(context.Context) error {
+	return p.StartOnce("PriceReader", func() error { return nil })
+}
+
+func (p *priceReader) Close() error {
+	return p.StopOnce("PriceReader", func() error { return nil })
+}
+
+func (p *priceReader) Name() string {
+	return "PriceReader"
+}
+
+func (p *priceReader) HealthReport() map[string]error {
+	return map[string]error{p.Name(): p.Healthy()}
+}
+
+// UpdateDynamicConfig is a no-op, the node updates the config of the PriceService writing the prices instead.
+func (p *priceReader) UpdateDynamicConfig(context.Context, prices.GasPriceEstimatorCommit, ccipdata.PriceRegistryReader) error {
+	return nil
+}
+
+func (p *priceReader) GetGasAndTokenPrices(ctx context.Context, destChainSelector uint64) (map[uint64]*big.Int, map[cciptypes.Address]*big.Int, error) {
+	return getGasAndTokenPrices(ctx, p.orm, destChainSelector)
+}
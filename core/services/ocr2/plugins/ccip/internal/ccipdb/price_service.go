@@ -2,13 +2,19 @@ package db
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"slices"
 	"sort"
+	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -16,9 +22,12 @@ import (
 	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
 
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/services/alerting"
 	cciporm "github.com/smartcontractkit/chainlink/v2/core/services/ccip"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
-	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/addrcodec"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/cache"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcommon"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/pricegetter"
@@ -41,7 +50,10 @@ type PriceService interface {
 	GetGasAndTokenPrices(ctx context.Context, destChainSelector uint64) (map[uint64]*big.Int, map[cciptypes.Address]*big.Int, error)
 }
 
-var _ PriceService = (*priceService)(nil)
+var (
+	_ PriceService            = (*priceService)(nil)
+	_ services.HealthReporter = (*priceService)(nil)
+)
 
 const (
 	// Gas prices are refreshed every 1 minute, they are sufficiently accurate, and consistent with Commit OCR round time.
@@ -49,29 +61,84 @@ const (
 	// Token prices are refreshed every 10 minutes, we only report prices for blue chip tokens, DS&A simulation show
 	// their prices are stable, 10-minute resolution is accurate enough.
 	tokenPriceUpdateInterval = 10 * time.Minute
+	// Stale prices are cleaned up by one of the lanes to a dest chain every 10 minutes.
+	priceCleanupInterval = 10 * time.Minute
+	// Prices which weren't updated for an hour, well over the update intervals, belong to lanes or tokens which were removed.
+	priceExpireThreshold = 1 * time.Hour
+	// The price service of the job leads the replicas of the node sharing the DB for the lease, which it renews every
+	// interval, so that a follower takes over within the lease when the leader goes away without releasing it.
+	leaderLease         = 30 * time.Second
+	leaderRenewInterval = 10 * time.Second
 )
 
+var priceCleanupRuns = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "ccip_price_cleanup_runs",
+	Help: "Number of stale price cleanups by dest chain and result, which is cleaned, skipped_locked, skipped_recent or error",
+}, []string{"destChainSelector", "result"})
+
+var priceServiceLeader = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "ccip_price_service_leader",
+	Help: "Whether the price service of the job is the leader writing the prices among the replicas of the node, 1 if it is",
+}, []string{"jobID", "destChainSelector"})
+
+// MaintenanceGate defers the cleanup of stale prices to maintenance windows.
+type MaintenanceGate interface {
+	// Open returns true if the cleanup may run now.
+	Open() bool
+}
+
+// UpdateIntervals are the intervals of the price updates, read before every update so that they can change at runtime.
+type UpdateIntervals interface {
+	GasPriceUpdateInterval() time.Duration
+	TokenPriceUpdateInterval() time.Duration
+}
+
+type fixedUpdateIntervals struct {
+	gas, token time.Duration
+}
+
+func (f fixedUpdateIntervals) GasPriceUpdateInterval() time.Duration   { return f.gas }
+func (f fixedUpdateIntervals) TokenPriceUpdateInterval() time.Duration { return f.token }
+
 type priceService struct {
-	gasUpdateInterval   time.Duration
-	tokenUpdateInterval time.Duration
+	intervals            UpdateIntervals
+	cleanupInterval      time.Duration
+	priceExpireThreshold time.Duration
 
 	lggr              logger.Logger
 	orm               cciporm.ORM
 	jobId             int32
 	destChainSelector uint64
+	cleanupGate       MaintenanceGate
 
 	sourceChainSelector     uint64
 	sourceNative            cciptypes.Address
 	priceGetter             pricegetter.AllTokensPriceGetter
 	offRampReader           ccipdata.OffRampReader
+	destAddressCodec        addrcodec.Codec
 	gasPriceEstimator       prices.GasPriceEstimatorCommit
 	destPriceRegistryReader ccipdata.PriceRegistryReader
+	tokenDecimals           *cache.TokenDecimalsCache
 
 	services.StateMachine
 	wg               *sync.WaitGroup
 	backgroundCtx    context.Context //nolint:containedctx
 	backgroundCancel context.CancelFunc
 	dynamicConfigMu  *sync.RWMutex
+
+	healthMu       sync.RWMutex
+	gasUpdateErr   error // from the last gas price update
+	tokenUpdateErr error // from the last token price update
+
+	destTokensMu sync.Mutex
+	destTokens   map[cciptypes.Address]struct{} // normalized on-chain dest tokens of the last token price update
+
+	// Only the leader among the replicas of the node runs the price updates and cleanups, the followers stay warm to
+	// take over.
+	owner               uuid.UUID
+	leaderLease         time.Duration
+	leaderRenewInterval time.Duration
+	leader              atomic.Bool
 }
 
 func NewPriceService(
@@ -84,22 +151,37 @@ func NewPriceService(
 	sourceNative cciptypes.Address,
 	priceGetter pricegetter.AllTokensPriceGetter,
 	offRampReader ccipdata.OffRampReader,
+	destAddressCodec addrcodec.Codec,
+	cleanupGate MaintenanceGate,
+	intervals UpdateIntervals,
 ) PriceService {
 	ctx, cancel := context.WithCancel(context.Background())
 
+	if intervals == nil {
+		intervals = fixedUpdateIntervals{gas: gasPriceUpdateInterval, token: tokenPriceUpdateInterval}
+	}
+
 	pw := &priceService{
-		gasUpdateInterval:   gasPriceUpdateInterval,
-		tokenUpdateInterval: tokenPriceUpdateInterval,
+		intervals:            intervals,
+		cleanupInterval:      priceCleanupInterval,
+		priceExpireThreshold: priceExpireThreshold,
 
 		lggr:              lggr,
 		orm:               orm,
 		jobId:             jobId,
 		destChainSelector: destChainSelector,
+		cleanupGate:       cleanupGate,
 
 		sourceChainSelector: sourceChainSelector,
 		sourceNative:        sourceNative,
 		priceGetter:         priceGetter,
 		offRampReader:       offRampReader,
+		destAddressCodec:    destAddressCodec,
+		tokenDecimals:       cache.TokenDecimals,
+
+		owner:               uuid.New(),
+		leaderLease:         leaderLease,
+		leaderRenewInterval: leaderRenewInterval,
 
 		wg:               new(sync.WaitGroup),
 		backgroundCtx:    ctx,
@@ -109,9 +191,11 @@ func NewPriceService(
 	return pw
 }
 
-func (p *priceService) Start(context.Context) error {
+func (p *priceService) Start(ctx context.Context) error {
 	return p.StateMachine.StartOnce("PriceService", func() error {
 		p.lggr.Info("Starting PriceService")
+		ccip.Lanes.Acquire(p.sourceChainSelector, p.destChainSelector)
+		p.elect(ctx)
 		p.wg.Add(1)
 		p.run()
 		return nil
@@ -123,64 +207,195 @@ func (p *priceService) Close() error {
 		p.lggr.Info("Closing PriceService")
 		p.backgroundCancel()
 		p.wg.Wait()
+		ccip.Lanes.Release(p.sourceChainSelector, p.destChainSelector)
+		if p.leader.Load() {
+			ctx, cancel := context.WithTimeout(context.Background(), p.leaderRenewInterval)
+			defer cancel()
+			if err := p.orm.ReleasePriceServiceLeader(ctx, p.jobId, p.destChainSelector, p.owner); err != nil {
+				// a follower takes over once the lease expires instead
+				p.lggr.Warnw("Failed to release price service leadership", "err", err)
+			}
+			p.setLeader(false)
+		}
 		return nil
 	})
 }
 
 func (p *priceService) run() {
-	gasUpdateTicker := time.NewTicker(utils.WithJitter(p.gasUpdateInterval))
-	tokenUpdateTicker := time.NewTicker(utils.WithJitter(p.tokenUpdateInterval))
+	gasUpdateTicker := time.NewTicker(utils.WithJitter(p.intervals.GasPriceUpdateInterval()))
+	tokenUpdateTicker := time.NewTicker(utils.WithJitter(p.intervals.TokenPriceUpdateInterval()))
+	cleanupTicker := time.NewTicker(utils.WithJitter(p.cleanupInterval))
+	leaderTicker := time.NewTicker(utils.WithJitter(p.leaderRenewInterval))
 
 	go func() {
 		defer p.wg.Done()
 		defer gasUpdateTicker.Stop()
 		defer tokenUpdateTicker.Stop()
+		defer cleanupTicker.Stop()
+		defer leaderTicker.Stop()
 
 		for {
 			select {
 			case <-p.backgroundCtx.Done():
 				return
+			case <-leaderTicker.C:
+				if p.elect(p.backgroundCtx) {
+					// the prices may be stale if the previous leader went away without releasing its lease
+					p.updateGasPrices()
+					p.updateTokenPrices()
+				}
 			case <-gasUpdateTicker.C:
-				err := p.runGasPriceUpdate(p.backgroundCtx)
-				if err != nil {
-					p.lggr.Errorw("Error when updating gas prices in the background", "err", err)
+				// the interval may have been reloaded since the last update
+				gasUpdateTicker.Reset(utils.WithJitter(p.intervals.GasPriceUpdateInterval()))
+				if p.leader.Load() {
+					p.updateGasPrices()
 				}
 			case <-tokenUpdateTicker.C:
-				err := p.runTokenPriceUpdate(p.backgroundCtx)
-				if err != nil {
-					p.lggr.Errorw("Error when updating token prices in the background", "err", err)
+				tokenUpdateTicker.Reset(utils.WithJitter(p.intervals.TokenPriceUpdateInterval()))
+				if p.leader.Load() {
+					p.updateTokenPrices()
+				}
+			case <-cleanupTicker.C:
+				if !p.leader.Load() {
+					continue
+				}
+				if p.cleanupGate != nil && !p.cleanupGate.Open() {
+					p.lggr.Debug("Deferring stale price cleanup until the next maintenance window")
+					continue
+				}
+				if err := p.runPriceCleanup(p.backgroundCtx); err != nil {
+					p.lggr.Errorw("Error when cleaning up stale prices in the background", "err", err)
 				}
 			}
 		}
 	}()
 }
 
+func (p *priceService) updateGasPrices() {
+	err := p.runGasPriceUpdate(p.backgroundCtx)
+	p.setUpdateErrs(&err, nil)
+	if err != nil {
+		p.lggr.Errorw("Error when updating gas prices in the background", "err", err)
+		p.alertUpdateFailure("gas", err)
+	}
+}
+
+func (p *priceService) updateTokenPrices() {
+	err := p.runTokenPriceUpdate(p.backgroundCtx)
+	p.setUpdateErrs(nil, &err)
+	if err != nil {
+		p.lggr.Errorw("Error when updating token prices in the background", "err", err)
+		p.alertUpdateFailure("token", err)
+	}
+}
+
+// elect renews the lease of the price service if it is the leader among the replicas of the node, or takes over if
+// the lease of the leader expired, and returns whether the price service just became the leader.
+func (p *priceService) elect(ctx context.Context) bool {
+	leader, err := p.orm.TryAcquirePriceServiceLeader(ctx, p.jobId, p.destChainSelector, p.owner, p.leaderLease)
+	if err != nil {
+		// the lease can't be renewed, step down rather than risk writing along with another leader
+		p.lggr.Errorw("Failed to elect price service leader", "err", err)
+		leader = false
+	}
+	if wasLeader := p.setLeader(leader); leader != wasLeader {
+		if leader {
+			p.lggr.Infow("Price service became the leader", "owner", p.owner)
+		} else {
+			p.lggr.Infow("Price service became a follower", "owner", p.owner)
+		}
+		return leader
+	}
+	return false
+}
+
+// setLeader records whether the price service is the leader, and returns whether it was.
+func (p *priceService) setLeader(leader bool) bool {
+	var v float64
+	if leader {
+		v = 1
+	}
+	priceServiceLeader.WithLabelValues(strconv.Itoa(int(p.jobId)), strconv.FormatUint(p.destChainSelector, 10)).Set(v)
+	return p.leader.Swap(leader)
+}
+
+// alertUpdateFailure emits an alert for a failed background update of the gas or token prices.
+func (p *priceService) alertUpdateFailure(kind string, err error) {
+	alerting.Emit(alerting.Alert{
+		Type:     alerting.EventPriceServiceFailure,
+		Severity: alerting.SeverityWarning,
+		Key:      fmt.Sprintf("%d-%d/%s", p.sourceChainSelector, p.destChainSelector, kind),
+		Summary:  fmt.Sprintf("CCIP price service failed to update %s prices", kind),
+		Details: map[string]string{
+			"jobID":               strconv.Itoa(int(p.jobId)),
+			"sourceChainSelector": strconv.FormatUint(p.sourceChainSelector, 10),
+			"destChainSelector":   strconv.FormatUint(p.destChainSelector, 10),
+			"error":               err.Error(),
+		},
+	})
+}
+
 func (p *priceService) UpdateDynamicConfig(ctx context.Context, gasPriceEstimator prices.GasPriceEstimatorCommit, destPriceRegistryReader ccipdata.PriceRegistryReader) error {
 	p.dynamicConfigMu.Lock()
 	p.gasPriceEstimator = gasPriceEstimator
 	p.destPriceRegistryReader = destPriceRegistryReader
 	p.dynamicConfigMu.Unlock()
 
+	if !p.leader.Load() {
+		// only the leader writes the prices, the followers keep their config up to date to take over
+		return nil
+	}
+
 	// Config update may substantially change the prices, refresh the prices immediately, this also makes testing easier
 	// for not having to wait to the full update interval.
-	if err := p.runGasPriceUpdate(ctx); err != nil {
-		p.lggr.Errorw("Error when updating gas prices after dynamic config update", "err", err)
+	gasErr := p.runGasPriceUpdate(ctx)
+	if gasErr != nil {
+		p.lggr.Errorw("Error when updating gas prices after dynamic config update", "err", gasErr)
 	}
-	if err := p.runTokenPriceUpdate(ctx); err != nil {
-		p.lggr.Errorw("Error when updating token prices after dynamic config update", "err", err)
+	tokenErr := p.runTokenPriceUpdate(ctx)
+	if tokenErr != nil {
+		p.lggr.Errorw("Error when updating token prices after dynamic config update", "err", tokenErr)
 	}
+	p.setUpdateErrs(&gasErr, &tokenErr)
 
 	return nil
 }
 
+// setUpdateErrs records the results of the latest price updates, ignoring nil pointers.
+func (p *priceService) setUpdateErrs(gasErr, tokenErr *error) {
+	p.healthMu.Lock()
+	defer p.healthMu.Unlock()
+	if gasErr != nil {
+		p.gasUpdateErr = *gasErr
+	}
+	if tokenErr != nil {
+		p.tokenUpdateErr = *tokenErr
+	}
+}
+
+func (p *priceService) Name() string {
+	return fmt.Sprintf("OCR2.%d.PriceService", p.jobId)
+}
+
+// HealthReport reports the PriceService as failing when the latest gas or token price update failed.
+func (p *priceService) HealthReport() map[string]error {
+	p.healthMu.RLock()
+	defer p.healthMu.RUnlock()
+	return map[string]error{p.Name(): errors.Join(p.Healthy(), p.gasUpdateErr, p.tokenUpdateErr)}
+}
+
 func (p *priceService) GetGasAndTokenPrices(ctx context.Context, destChainSelector uint64) (map[uint64]*big.Int, map[cciptypes.Address]*big.Int, error) {
+	return getGasAndTokenPrices(ctx, p.orm, destChainSelector)
+}
+
+func getGasAndTokenPrices(ctx context.Context, orm cciporm.ORM, destChainSelector uint64) (map[uint64]*big.Int, map[cciptypes.Address]*big.Int, error) {
 	eg := new(errgroup.Group)
 
 	var gasPricesInDB []cciporm.GasPrice
 	var tokenPricesInDB []cciporm.TokenPrice
 
 	eg.Go(func() error {
-		gasPrices, err := p.orm.GetGasPricesByDestChain(ctx, destChainSelector)
+		gasPrices, err := orm.GetGasPricesByDestChain(ctx, destChainSelector)
 		if err != nil {
 			return fmt.Errorf("failed to get gas prices from db: %w", err)
 		}
@@ -189,7 +404,7 @@ func (p *priceService) GetGasAndTokenPrices(ctx context.Context, destChainSelect
 	})
 
 	eg.Go(func() error {
-		tokenPrices, err := p.orm.GetTokenPricesByDestChain(ctx, destChainSelector)
+		tokenPrices, err := orm.GetTokenPricesByDestChain(ctx, destChainSelector)
 		if err != nil {
 			return fmt.Errorf("failed to get token prices from db: %w", err)
 		}
@@ -219,6 +434,25 @@ func (p *priceService) GetGasAndTokenPrices(ctx context.Context, destChainSelect
 	return gasPrices, tokenPrices, nil
 }
 
+// runPriceCleanup deletes the stale prices of the dest chain. The price services of all lanes to the dest chain attempt
+// it, but only one of them runs it per cleanupInterval.
+func (p *priceService) runPriceCleanup(ctx context.Context) error {
+	destChainSelector := strconv.FormatUint(p.destChainSelector, 10)
+	cleanup, err := p.orm.ClearStalePricesForDestChain(ctx, p.destChainSelector, p.cleanupInterval, p.priceExpireThreshold)
+	if err != nil {
+		priceCleanupRuns.WithLabelValues(destChainSelector, "error").Inc()
+		return fmt.Errorf("failed to clear stale prices from db: %w", err)
+	}
+	if cleanup.Skipped != "" {
+		priceCleanupRuns.WithLabelValues(destChainSelector, "skipped_"+string(cleanup.Skipped)).Inc()
+		p.lggr.Debugw("Skipped stale price cleanup", "reason", cleanup.Skipped)
+		return nil
+	}
+	priceCleanupRuns.WithLabelValues(destChainSelector, "cleaned").Inc()
+	p.lggr.Infow("Cleaned up stale prices", "gasPricesDeleted", cleanup.GasPricesDeleted, "tokenPricesDeleted", cleanup.TokenPricesDeleted)
+	return nil
+}
+
 func (p *priceService) runGasPriceUpdate(ctx context.Context) error {
 	// Protect against concurrent updates of `gasPriceEstimator` and `destPriceRegistryReader`
 	// Price updates happen infrequently - once every `gasPriceUpdateInterval` seconds.
@@ -302,6 +536,11 @@ func (p *priceService) observeGasPriceUpdates(
 	if err != nil {
 		return nil, err
 	}
+	ccip.Lanes.Update(p.sourceChainSelector, p.destChainSelector, func(status *ccip.LaneStatus) {
+		status.GasPriceObservedAt = time.Now()
+		status.SourceGasPrice = sourceGasPrice
+		status.SourceGasPriceUSD = sourceGasPriceUSD
+	})
 
 	lggr.Infow("PriceService observed latest gas price",
 		"sourceChainSelector", p.sourceChainSelector,
@@ -340,20 +579,20 @@ func (p *priceService) observeTokenPriceUpdates(
 
 	lggr.Infow("Raw token prices", "rawTokenPrices", rawTokenPricesUSD)
 
-	sourceNativeEvmAddr, err := ccipcalc.GenericAddrToEvm(p.sourceNative)
-	if err != nil {
-		return nil, fmt.Errorf("failed to convert source native to EVM address: %w", err)
-	}
+	// the source native token of a lane between chain families is never a destination token
+	sourceNative, srcErr := addrcodec.Normalize(p.destAddressCodec, p.sourceNative)
 
 	// Filter out source native token only if source native not in dest tokens
 	var finalDestTokens []cciptypes.Address
+	normalizedTokens := make(map[cciptypes.Address]cciptypes.Address, len(rawTokenPricesUSD))
 	for token := range rawTokenPricesUSD {
-		tokenEvmAddr, err2 := ccipcalc.GenericAddrToEvm(token)
+		normalizedToken, err2 := addrcodec.Normalize(p.destAddressCodec, token)
 		if err2 != nil {
-			return nil, fmt.Errorf("failed to convert token to EVM address: %w", err)
+			return nil, fmt.Errorf("failed to normalize token address: %w", err2)
 		}
+		normalizedTokens[token] = normalizedToken
 
-		if tokenEvmAddr != sourceNativeEvmAddr {
+		if srcErr != nil || normalizedToken != sourceNative {
 			finalDestTokens = append(finalDestTokens, token)
 		}
 	}
@@ -365,12 +604,12 @@ func (p *priceService) observeTokenPriceUpdates(
 	onchainDestTokens := ccipcommon.FlattenedAndSortedTokens(fee, bridged)
 	lggr.Debugw("Destination tokens", "destTokens", onchainDestTokens)
 
-	onchainTokensEvmAddr, err := ccipcalc.GenericAddrsToEvm(onchainDestTokens...)
+	normalizedOnchainTokens, err := addrcodec.NormalizeAll(p.destAddressCodec, onchainDestTokens...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to convert sorted lane tokens to EVM addresses: %w", err)
+		return nil, fmt.Errorf("failed to normalize lane token addresses: %w", err)
 	}
 	// Check for case where sourceNative has same address as one of the dest tokens (example: WETH in Base and Optimism)
-	hasSameDestAddress := slices.Contains(onchainTokensEvmAddr, sourceNativeEvmAddr)
+	hasSameDestAddress := srcErr == nil && slices.Contains(normalizedOnchainTokens, sourceNative)
 
 	if hasSameDestAddress {
 		finalDestTokens = append(finalDestTokens, p.sourceNative)
@@ -381,7 +620,21 @@ func (p *priceService) observeTokenPriceUpdates(
 		return finalDestTokens[i] < finalDestTokens[j]
 	})
 
-	destTokensDecimals, err := p.destPriceRegistryReader.GetTokensDecimals(ctx, finalDestTokens)
+	// The on-chain dest tokens are refreshed on the token config change events of the dest chain. The decimals of the
+	// tokens added or removed since the last update are invalidated, so that they are fetched again.
+	changedTokens := p.tokenConfigChanges(normalizedOnchainTokens)
+	var invalidated []cciptypes.Address
+	for _, token := range finalDestTokens {
+		if _, ok := changedTokens[normalizedTokens[token]]; ok {
+			invalidated = append(invalidated, token)
+		}
+	}
+	if len(invalidated) > 0 {
+		lggr.Infow("Token config changed, invalidating cached token decimals", "tokens", invalidated)
+		p.tokenDecimals.Invalidate(p.destChainSelector, invalidated...)
+	}
+
+	destTokensDecimals, err := p.tokenDecimals.Get(ctx, p.destChainSelector, p.destPriceRegistryReader, finalDestTokens)
 	if err != nil {
 		return nil, fmt.Errorf("get tokens decimals: %w", err)
 	}
@@ -403,6 +656,33 @@ func (p *priceService) observeTokenPriceUpdates(
 	return tokenPricesUSD, nil
 }
 
+// tokenConfigChanges returns the normalized tokens added to or removed from the dest chain since the last call. Nothing
+// changed on the first call.
+func (p *priceService) tokenConfigChanges(normalizedTokens []cciptypes.Address) map[cciptypes.Address]struct{} {
+	p.destTokensMu.Lock()
+	defer p.destTokensMu.Unlock()
+
+	current := make(map[cciptypes.Address]struct{}, len(normalizedTokens))
+	for _, token := range normalizedTokens {
+		current[token] = struct{}{}
+	}
+	changed := make(map[cciptypes.Address]struct{})
+	if p.destTokens != nil {
+		for token := range current {
+			if _, ok := p.destTokens[token]; !ok {
+				changed[token] = struct{}{}
+			}
+		}
+		for token := range p.destTokens {
+			if _, ok := current[token]; !ok {
+				changed[token] = struct{}{}
+			}
+		}
+	}
+	p.destTokens = current
+	return changed
+}
+
 func (p *priceService) writeGasPricesToDB(ctx context.Context, sourceGasPriceUSD *big.Int) error {
 	if sourceGasPriceUSD == nil {
 		return nil
@@ -436,7 +716,7 @@ func (p *priceService) writeTokenPricesToDB(ctx context.Context, tokenPricesUSD
 		return tokenPrices[i].TokenAddr < tokenPrices[j].TokenAddr
 	})
 
-	_, err := p.orm.UpsertTokenPricesForDestChain(ctx, p.destChainSelector, tokenPrices, p.tokenUpdateInterval)
+	_, err := p.orm.UpsertTokenPricesForDestChain(ctx, p.destChainSelector, tokenPrices, p.intervals.TokenPriceUpdateInterval())
 	return err
 }
 
@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"reflect"
@@ -10,6 +11,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -23,6 +25,8 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	cciporm "github.com/smartcontractkit/chainlink/v2/core/services/ccip"
 	ccipmocks "github.com/smartcontractkit/chainlink/v2/core/services/ccip/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/addrcodec"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/cache"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
 	ccipdatamocks "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/pricegetter"
@@ -82,6 +86,9 @@ func TestPriceService_writeGasPrices(t *testing.T) {
 				"",
 				nil,
 				nil,
+				addrcodec.EVM,
+				nil,
+				nil,
 			).(*priceService)
 			err := priceService.writeGasPricesToDB(ctx, gasPrice)
 			if tc.expectedErr {
@@ -154,6 +161,9 @@ func TestPriceService_writeTokenPrices(t *testing.T) {
 				"",
 				nil,
 				nil,
+				addrcodec.EVM,
+				nil,
+				nil,
 			).(*priceService)
 			err := priceService.writeTokenPricesToDB(ctx, tokenPrices)
 			if tc.expectedErr {
@@ -264,6 +274,9 @@ func TestPriceService_observeGasPriceUpdates(t *testing.T) {
 				tc.sourceNativeToken,
 				priceGetter,
 				nil,
+				addrcodec.EVM,
+				nil,
+				nil,
 			).(*priceService)
 			priceService.gasPriceEstimator = gasPriceEstimator
 
@@ -476,8 +489,12 @@ func TestPriceService_observeTokenPriceUpdates(t *testing.T) {
 				tc.sourceNativeToken,
 				priceGetter,
 				offRampReader,
+				addrcodec.EVM,
+				nil,
+				nil,
 			).(*priceService)
 			priceService.destPriceRegistryReader = destPriceReg
+			priceService.tokenDecimals = cache.NewTokenDecimalsCache()
 
 			tokenPricesUSD, err := priceService.observeTokenPriceUpdates(context.Background(), lggr)
 			if tc.expErr {
@@ -706,6 +723,9 @@ func TestPriceService_GetGasAndTokenPrices(t *testing.T) {
 				"",
 				nil,
 				nil,
+				addrcodec.EVM,
+				nil,
+				nil,
 			).(*priceService)
 			gasPricesResult, tokenPricesResult, err := priceService.GetGasAndTokenPrices(ctx, destChainSelector)
 			if tc.expectedErr {
@@ -817,15 +837,18 @@ func TestPriceService_priceWriteInBackground(t *testing.T) {
 		tokens[0],
 		priceGetter,
 		offRampReader,
+		addrcodec.EVM,
+		nil,
+		nil,
 	).(*priceService)
 
+	priceService.tokenDecimals = cache.NewTokenDecimalsCache()
+
 	gasUpdateInterval := 2000 * time.Millisecond
 	tokenUpdateInterval := 5000 * time.Millisecond
 
-	// run gas price task every 2 second
-	priceService.gasUpdateInterval = gasUpdateInterval
-	// run token price task every 5 second
-	priceService.tokenUpdateInterval = tokenUpdateInterval
+	// run gas price task every 2 second, token price task every 5 second
+	priceService.intervals = fixedUpdateIntervals{gas: gasUpdateInterval, token: tokenUpdateInterval}
 
 	// initially, db is empty
 	assert.NoError(t, checkResultLen(t, priceService, destChainSelector, 0, 0))
@@ -840,3 +863,122 @@ func TestPriceService_priceWriteInBackground(t *testing.T) {
 
 	assert.NoError(t, priceService.Close())
 }
+
+func TestPriceService_runPriceCleanup(t *testing.T) {
+	destChainSelector := uint64(5009297550715157269)
+	testCases := []struct {
+		name    string
+		cleanup cciporm.PriceCleanup
+		err     error
+		result  string
+	}{
+		{"cleaned", cciporm.PriceCleanup{GasPricesDeleted: 1, TokenPricesDeleted: 2}, nil, "cleaned"},
+		{"locked by another job", cciporm.PriceCleanup{Skipped: cciporm.PriceCleanupLocked}, nil, "skipped_locked"},
+		{"cleaned by another job", cciporm.PriceCleanup{Skipped: cciporm.PriceCleanupRecent}, nil, "skipped_recent"},
+		{"error", cciporm.PriceCleanup{}, errors.New("db error"), "error"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockOrm := ccipmocks.NewORM(t)
+			mockOrm.On("ClearStalePricesForDestChain", mock.Anything, destChainSelector, priceCleanupInterval, priceExpireThreshold).
+				Return(tc.cleanup, tc.err).Once()
+
+			priceService := NewPriceService(
+				logger.TestLogger(t),
+				mockOrm,
+				int32(1),
+				destChainSelector,
+				uint64(67890),
+				"",
+				nil,
+				nil,
+				addrcodec.EVM,
+				nil,
+				nil,
+			).(*priceService)
+
+			counter := priceCleanupRuns.WithLabelValues(fmt.Sprint(destChainSelector), tc.result)
+			before := testutil.ToFloat64(counter)
+			err := priceService.runPriceCleanup(tests.Context(t))
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, before+1, testutil.ToFloat64(counter))
+		})
+	}
+}
+
+func TestPriceService_HealthReport(t *testing.T) {
+	mockOrm := ccipmocks.NewORM(t)
+	mockOrm.On("TryAcquirePriceServiceLeader", mock.Anything, int32(7), uint64(12345), mock.Anything, leaderLease).Return(false, nil)
+	priceService := NewPriceService(
+		logger.TestLogger(t),
+		mockOrm,
+		int32(7),
+		uint64(12345),
+		uint64(67890),
+		cciptypes.Address(utils.RandomAddress().String()),
+		nil,
+		nil,
+		addrcodec.EVM,
+		nil,
+		nil,
+	).(*priceService)
+	require.Equal(t, "OCR2.7.PriceService", priceService.Name())
+	require.NoError(t, priceService.Start(tests.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, priceService.Close()) })
+	assert.Equal(t, map[string]error{"OCR2.7.PriceService": nil}, priceService.HealthReport())
+
+	gasErr := errors.New("gas price estimator unavailable")
+	priceService.setUpdateErrs(&gasErr, nil)
+	assert.ErrorIs(t, priceService.HealthReport()["OCR2.7.PriceService"], gasErr)
+
+	var noErr error
+	priceService.setUpdateErrs(&noErr, nil)
+	assert.NoError(t, priceService.HealthReport()["OCR2.7.PriceService"])
+}
+
+func TestPriceService_leaderElection(t *testing.T) {
+	ctx := tests.Context(t)
+	orm := setupORM(t)
+	jobID := int32(3)
+	destChainSelector := uint64(12345)
+	newPriceService := func() *priceService {
+		return NewPriceService(logger.TestLogger(t), orm, jobID, destChainSelector, uint64(67890),
+			cciptypes.Address(utils.RandomAddress().String()), nil, nil, addrcodec.EVM, nil, nil).(*priceService)
+	}
+	leaderGauge := priceServiceLeader.WithLabelValues("3", "12345")
+
+	replica1 := newPriceService()
+	require.NoError(t, replica1.Start(ctx))
+	assert.True(t, replica1.leader.Load())
+	assert.Equal(t, float64(1), testutil.ToFloat64(leaderGauge))
+
+	replica2 := newPriceService()
+	require.NoError(t, replica2.Start(ctx))
+	t.Cleanup(func() { assert.NoError(t, replica2.Close()) })
+	assert.False(t, replica2.leader.Load())
+	assert.False(t, replica2.elect(ctx))
+	// the follower only keeps its config up to date
+	require.NoError(t, replica2.UpdateDynamicConfig(ctx, nil, nil))
+
+	// the leader keeps its lease until it releases it
+	assert.False(t, replica1.elect(ctx))
+	assert.True(t, replica1.leader.Load())
+	require.NoError(t, replica1.Close())
+	assert.True(t, replica2.elect(ctx))
+	assert.True(t, replica2.leader.Load())
+	assert.Equal(t, float64(1), testutil.ToFloat64(leaderGauge))
+}
+
+func TestPriceService_tokenConfigChanges(t *testing.T) {
+	priceService := NewPriceService(logger.TestLogger(t), nil, int32(7), uint64(12345), uint64(67890),
+		cciptypes.Address(utils.RandomAddress().String()), nil, nil, addrcodec.EVM, nil, nil).(*priceService)
+
+	tokens := []cciptypes.Address{"0x1", "0x2", "0x3"}
+	assert.Empty(t, priceService.tokenConfigChanges(tokens[:2]))
+	assert.Empty(t, priceService.tokenConfigChanges(tokens[:2]))
+	assert.Equal(t, map[cciptypes.Address]struct{}{"0x1": {}, "0x3": {}}, priceService.tokenConfigChanges(tokens[1:]))
+}
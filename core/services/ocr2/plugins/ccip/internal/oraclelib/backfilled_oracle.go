@@ -97,6 +97,15 @@ func (r *BackfilledOracle) Run() {
 	}
 }
 
+// Promote promotes the oracle, if it is held in standby. The backfill is not awaited, so that an oracle promoted
+// during the backfill is started as soon as it completes.
+func (r *BackfilledOracle) Promote(ctx context.Context) error {
+	if p, ok := r.oracle.(job.Promotable); ok {
+		return p.Promote(ctx)
+	}
+	return nil
+}
+
 func (r *BackfilledOracle) Close() error {
 	if r.oracleStarted.Load() {
 		// If the oracle is running, it must be Closed/stopped
@@ -191,6 +200,15 @@ func (r *ChainAgnosticBackFilledOracle) run() {
 	}
 }
 
+// Promote promotes the oracle, if it is held in standby. The backfill is not awaited, so that an oracle promoted
+// during the backfill is started as soon as it completes.
+func (r *ChainAgnosticBackFilledOracle) Promote(ctx context.Context) error {
+	if p, ok := r.oracle.(job.Promotable); ok {
+		return p.Promote(ctx)
+	}
+	return nil
+}
+
 func (r *ChainAgnosticBackFilledOracle) Close() error {
 	if r.oracleStarted.Load() {
 		// If the oracle is running, it must be Closed/stopped
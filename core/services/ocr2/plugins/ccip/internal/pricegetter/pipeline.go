@@ -12,7 +12,7 @@ import (
 
 	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
-	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/addrcodec"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/parseutil"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 )
@@ -26,9 +26,11 @@ type PipelineGetter struct {
 	externalJobID uuid.UUID
 	name          string
 	lggr          logger.Logger
+	// addressCodec normalizes the token addresses returned by the pipeline.
+	addressCodec addrcodec.Codec
 }
 
-func NewPipelineGetter(source string, runner pipeline.Runner, jobID int32, externalJobID uuid.UUID, name string, lggr logger.Logger) (*PipelineGetter, error) {
+func NewPipelineGetter(source string, runner pipeline.Runner, jobID int32, externalJobID uuid.UUID, name string, lggr logger.Logger, addressCodec addrcodec.Codec) (*PipelineGetter, error) {
 	_, err := pipeline.Parse(source)
 	if err != nil {
 		return nil, err
@@ -41,6 +43,7 @@ func NewPipelineGetter(source string, runner pipeline.Runner, jobID int32, exter
 		externalJobID: externalJobID,
 		name:          name,
 		lggr:          lggr,
+		addressCodec:  addressCodec,
 	}, nil
 }
 
@@ -67,7 +70,7 @@ func (d *PipelineGetter) GetJobSpecTokenPricesUSD(ctx context.Context) (map[ccip
 
 	tokenPrices := make(map[cciptypes.Address]*big.Int)
 	for tokenAddressStr, rawPrice := range prices {
-		tokenAddressStr := ccipcalc.HexToAddress(tokenAddressStr)
+		tokenAddressStr := d.normalize(tokenAddressStr)
 		castedPrice, err := parseutil.ParseBigIntFromAny(rawPrice)
 		if err != nil {
 			return nil, err
@@ -88,7 +91,7 @@ func (d *PipelineGetter) TokenPricesUSD(ctx context.Context, tokens []cciptypes.
 	providedTokensSet := mapset.NewSet(tokens...)
 	tokenPrices := make(map[cciptypes.Address]*big.Int)
 	for tokenAddressStr, rawPrice := range prices {
-		tokenAddressStr := ccipcalc.HexToAddress(tokenAddressStr)
+		tokenAddressStr := d.normalize(tokenAddressStr)
 		castedPrice, err := parseutil.ParseBigIntFromAny(rawPrice)
 		if err != nil {
 			return nil, err
@@ -140,3 +143,13 @@ func (d *PipelineGetter) getPricesFromRunner(ctx context.Context) (map[string]in
 func (d *PipelineGetter) Close() error {
 	return d.runner.Close()
 }
+
+// normalize returns the canonical format of a token address of the pipeline results. Addresses the codec can't decode
+// are returned as is, since the source native token of a lane may belong to another chain family.
+func (d *PipelineGetter) normalize(addr string) cciptypes.Address {
+	normalized, err := addrcodec.Normalize(d.addressCodec, cciptypes.Address(addr))
+	if err != nil {
+		return cciptypes.Address(addr)
+	}
+	return normalized
+}
@@ -0,0 +1,117 @@
+package pricegetter
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/addrcodec"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+)
+
+var _ AllTokensPriceGetter = &QuoteCurrencyPriceGetter{}
+
+// QuoteCurrencyPriceGetter converts the USD prices of the wrapped price getter into a quote currency. The USD price of
+// one unit of the quote currency is resolved by the wrapped price getter as well, so that all prices are observed at
+// the same time.
+// Prices keep the 1e18 precision of USD prices, e.g. a token worth 0.5 ETH is priced 5e17 when quoted in ETH.
+type QuoteCurrencyPriceGetter struct {
+	AllTokensPriceGetter
+	currency   string
+	priceToken cciptypes.Address
+	// hidePriceToken excludes the price token from the job spec prices, since it is not a token of the lane.
+	hidePriceToken bool
+}
+
+// NewQuoteCurrencyPriceGetter wraps getter to quote its prices in the currency of cfg. The price of the native
+// currency is the one of sourceNative. The price token of cfg is normalized with codec, like the addresses returned by
+// getter. The getter is returned as is for USD.
+func NewQuoteCurrencyPriceGetter(getter AllTokensPriceGetter, cfg config.QuoteCurrencyConfig, sourceNative cciptypes.Address, codec addrcodec.Codec) (AllTokensPriceGetter, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	switch cfg.Currency {
+	case config.QuoteCurrencyUSD:
+		return getter, nil
+	case config.QuoteCurrencyNative:
+		return &QuoteCurrencyPriceGetter{AllTokensPriceGetter: getter, currency: cfg.Currency, priceToken: sourceNative}, nil
+	default:
+		// normalize the address, since prices are keyed by normalized addresses
+		priceToken, err := addrcodec.Normalize(codec, cfg.PriceToken)
+		if err != nil {
+			return nil, err
+		}
+		return &QuoteCurrencyPriceGetter{
+			AllTokensPriceGetter: getter,
+			currency:             cfg.Currency,
+			priceToken:           priceToken,
+			hidePriceToken:       true,
+		}, nil
+	}
+}
+
+// TokenPricesUSD returns the prices of tokens in the quote currency.
+func (q *QuoteCurrencyPriceGetter) TokenPricesUSD(ctx context.Context, tokens []cciptypes.Address) (map[cciptypes.Address]*big.Int, error) {
+	query := tokens
+	if !slices.Contains(tokens, q.priceToken) {
+		query = append(slices.Clone(tokens), q.priceToken)
+	}
+	prices, err := q.AllTokensPriceGetter.TokenPricesUSD(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	quotePrice, err := q.quotePrice(prices)
+	if err != nil {
+		return nil, err
+	}
+	converted := make(map[cciptypes.Address]*big.Int, len(tokens))
+	for _, token := range tokens {
+		if price, ok := prices[token]; ok {
+			converted[token] = convertPrice(price, quotePrice)
+		}
+	}
+	return converted, nil
+}
+
+// GetJobSpecTokenPricesUSD returns the prices of all tokens defined in the job spec in the quote currency.
+func (q *QuoteCurrencyPriceGetter) GetJobSpecTokenPricesUSD(ctx context.Context) (map[cciptypes.Address]*big.Int, error) {
+	prices, err := q.AllTokensPriceGetter.GetJobSpecTokenPricesUSD(ctx)
+	if err != nil {
+		return nil, err
+	}
+	quotePrice, err := q.quotePrice(prices)
+	if err != nil {
+		return nil, err
+	}
+	converted := make(map[cciptypes.Address]*big.Int, len(prices))
+	for token, price := range prices {
+		if q.hidePriceToken && token == q.priceToken {
+			continue
+		}
+		converted[token] = convertPrice(price, quotePrice)
+	}
+	return converted, nil
+}
+
+func (q *QuoteCurrencyPriceGetter) quotePrice(prices map[cciptypes.Address]*big.Int) (*big.Int, error) {
+	price, ok := prices[q.priceToken]
+	if !ok {
+		return nil, fmt.Errorf("missing USD price of quote currency %s (%s)", q.currency, q.priceToken)
+	}
+	if price == nil || price.Sign() <= 0 {
+		return nil, fmt.Errorf("invalid USD price of quote currency %s (%s): %v", q.currency, q.priceToken, price)
+	}
+	return price, nil
+}
+
+// convertPrice converts a USD price into the quote currency worth quotePrice USD, keeping the 1e18 precision.
+func convertPrice(price, quotePrice *big.Int) *big.Int {
+	if price == nil {
+		return nil
+	}
+	converted := new(big.Int).Mul(price, big.NewInt(1e18))
+	return converted.Div(converted, quotePrice)
+}
@@ -0,0 +1,75 @@
+package pricegetter_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/addrcodec"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/pricegetter"
+)
+
+func TestQuoteCurrencyPriceGetter(t *testing.T) {
+	ctx := testutils.Context(t)
+	link := ccipcalc.HexToAddress("0x1")
+	weth := ccipcalc.HexToAddress("0x2")
+	eurUSD := ccipcalc.HexToAddress("0xe0")
+	e18 := func(v int64) *big.Int { return new(big.Int).Mul(big.NewInt(v), big.NewInt(1e18)) }
+
+	t.Run("USD is not converted", func(t *testing.T) {
+		getter := pricegetter.NewMockAllTokensPriceGetter(t)
+		quoted, err := pricegetter.NewQuoteCurrencyPriceGetter(getter, config.QuoteCurrencyConfig{Currency: config.QuoteCurrencyUSD}, weth, addrcodec.EVM)
+		require.NoError(t, err)
+		assert.Equal(t, getter, quoted)
+	})
+
+	t.Run("EUR", func(t *testing.T) {
+		getter := pricegetter.NewMockAllTokensPriceGetter(t)
+		// the price token is normalized to the checksummed address
+		quoted, err := pricegetter.NewQuoteCurrencyPriceGetter(getter, config.QuoteCurrencyConfig{Currency: config.QuoteCurrencyEUR, PriceToken: "0x00000000000000000000000000000000000000e0"}, weth, addrcodec.EVM)
+		require.NoError(t, err)
+
+		// 1 EUR = 2 USD
+		getter.On("TokenPricesUSD", ctx, []cciptypes.Address{link, eurUSD}).
+			Return(map[cciptypes.Address]*big.Int{link: e18(10), eurUSD: e18(2)}, nil).Once()
+		prices, err := quoted.TokenPricesUSD(ctx, []cciptypes.Address{link})
+		require.NoError(t, err)
+		assert.Equal(t, map[cciptypes.Address]*big.Int{link: e18(5)}, prices)
+
+		getter.On("GetJobSpecTokenPricesUSD", ctx).
+			Return(map[cciptypes.Address]*big.Int{link: e18(10), weth: e18(3000), eurUSD: e18(2)}, nil).Once()
+		prices, err = quoted.GetJobSpecTokenPricesUSD(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, map[cciptypes.Address]*big.Int{link: e18(5), weth: e18(1500)}, prices)
+
+		getter.On("GetJobSpecTokenPricesUSD", ctx).
+			Return(map[cciptypes.Address]*big.Int{link: e18(10)}, nil).Once()
+		_, err = quoted.GetJobSpecTokenPricesUSD(ctx)
+		require.ErrorContains(t, err, "missing USD price of quote currency EUR")
+	})
+
+	t.Run("native", func(t *testing.T) {
+		getter := pricegetter.NewMockAllTokensPriceGetter(t)
+		quoted, err := pricegetter.NewQuoteCurrencyPriceGetter(getter, config.QuoteCurrencyConfig{Currency: config.QuoteCurrencyNative}, weth, addrcodec.EVM)
+		require.NoError(t, err)
+
+		// the source native is priced 1, and kept as a token of the job spec
+		getter.On("GetJobSpecTokenPricesUSD", ctx).
+			Return(map[cciptypes.Address]*big.Int{link: e18(20), weth: e18(4000)}, nil).Once()
+		prices, err := quoted.GetJobSpecTokenPricesUSD(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, map[cciptypes.Address]*big.Int{link: big.NewInt(5e15), weth: e18(1)}, prices)
+
+		getter.On("TokenPricesUSD", ctx, []cciptypes.Address{weth}).
+			Return(map[cciptypes.Address]*big.Int{weth: big.NewInt(0)}, nil).Once()
+		_, err = quoted.TokenPricesUSD(ctx, []cciptypes.Address{weth})
+		require.ErrorContains(t, err, "invalid USD price of quote currency native")
+	})
+}
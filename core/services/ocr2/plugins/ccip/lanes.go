@@ -0,0 +1,190 @@
+package ccip
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+)
+
+// LaneStatus is the state of a lane, as last observed by the CCIP plugins of the jobs running on this node.
+// Fields are left zero until the plugin observing them has run. The commit and execution observations are recorded by
+// the node even if the plugins run as LOOPs, the other fields are only recorded by plugins running in-process.
+type LaneStatus struct {
+	SourceChainSelector uint64
+	DestChainSelector   uint64
+
+	// Observed by the commit plugin.
+	CommitObservedAt      time.Time
+	LatestCommittedSeqNr  uint64 // the sequence number preceding the first one of the latest observed interval
+	PendingCommitMessages int    // messages sent on the source chain and not committed yet, up to the scan limit
+
+	// Observed by the execution plugin.
+	ExecObservedAt       time.Time
+	ExecutableMessages   int    // messages of the latest observed execution batch
+	LatestExecutedSeqNr  uint64 // the last sequence number of the latest accepted execution report, in-process only
+	UnexpiredCommitRoots int    // in-process only
+
+	// Observed by the price service of the commit plugin.
+	GasPriceObservedAt time.Time
+	SourceGasPrice     *big.Int // the source gas price given by the gas price estimator, in wei
+	SourceGasPriceUSD  *big.Int // SourceGasPrice denoted in USD, with 1e18 being $1
+}
+
+type laneKey struct {
+	source, dest uint64
+}
+
+type laneEntry struct {
+	status LaneStatus
+	// refs counts the services of the lane which are running, the lane is removed once they are all closed
+	refs int
+}
+
+// LaneStatuses holds the status of the lanes, which the plugins update as they observe them.
+type LaneStatuses struct {
+	mu    sync.RWMutex
+	lanes map[laneKey]*laneEntry
+}
+
+// Lanes holds the status of the lanes served by this node.
+var Lanes = NewLaneStatuses()
+
+func NewLaneStatuses() *LaneStatuses {
+	return &LaneStatuses{lanes: make(map[laneKey]*laneEntry)}
+}
+
+// Acquire registers a running service of the lane from sourceChainSelector to destChainSelector. It must be followed
+// by Release once the service is closed.
+func (l *LaneStatuses) Acquire(sourceChainSelector, destChainSelector uint64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	key := laneKey{source: sourceChainSelector, dest: destChainSelector}
+	entry, ok := l.lanes[key]
+	if !ok {
+		entry = &laneEntry{status: LaneStatus{SourceChainSelector: sourceChainSelector, DestChainSelector: destChainSelector}}
+		l.lanes[key] = entry
+	}
+	entry.refs++
+}
+
+// Release unregisters a service of the lane, and removes the lane once none are left.
+func (l *LaneStatuses) Release(sourceChainSelector, destChainSelector uint64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	key := laneKey{source: sourceChainSelector, dest: destChainSelector}
+	entry, ok := l.lanes[key]
+	if !ok {
+		return
+	}
+	entry.refs--
+	if entry.refs <= 0 {
+		delete(l.lanes, key)
+	}
+}
+
+// Update calls fn with the status of the lane from sourceChainSelector to destChainSelector, if it is acquired.
+func (l *LaneStatuses) Update(sourceChainSelector, destChainSelector uint64, fn func(*LaneStatus)) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if entry, ok := l.lanes[laneKey{source: sourceChainSelector, dest: destChainSelector}]; ok {
+		fn(&entry.status)
+	}
+}
+
+// List returns a copy of the status of all lanes, ordered by source and dest chain selector.
+func (l *LaneStatuses) List() []LaneStatus {
+	l.mu.RLock()
+	statuses := make([]LaneStatus, 0, len(l.lanes))
+	for _, entry := range l.lanes {
+		statuses = append(statuses, entry.status)
+	}
+	l.mu.RUnlock()
+
+	sort.Slice(statuses, func(i, j int) bool {
+		if statuses[i].SourceChainSelector != statuses[j].SourceChainSelector {
+			return statuses[i].SourceChainSelector < statuses[j].SourceChainSelector
+		}
+		return statuses[i].DestChainSelector < statuses[j].DestChainSelector
+	})
+	return statuses
+}
+
+// laneStatusFactory wraps the factory of the commit or execution plugins of a lane, to acquire the lane while its
+// plugins run and record their observations. It wraps the plugins from the node, so it covers LOOP plugins too.
+type laneStatusFactory struct {
+	types.ReportingPluginFactory
+	lanes        *LaneStatuses
+	source, dest uint64
+	observe      func(*LaneStatus, types.Observation) error
+}
+
+// NewCommitLaneStatusFactory returns a factory recording the observations of the commit plugins of the lane in Lanes.
+func NewCommitLaneStatusFactory(factory types.ReportingPluginFactory, sourceChainSelector, destChainSelector uint64) types.ReportingPluginFactory {
+	return &laneStatusFactory{ReportingPluginFactory: factory, lanes: Lanes, source: sourceChainSelector, dest: destChainSelector, observe: observeCommit}
+}
+
+// NewExecLaneStatusFactory returns a factory recording the observations of the execution plugins of the lane in Lanes.
+func NewExecLaneStatusFactory(factory types.ReportingPluginFactory, sourceChainSelector, destChainSelector uint64) types.ReportingPluginFactory {
+	return &laneStatusFactory{ReportingPluginFactory: factory, lanes: Lanes, source: sourceChainSelector, dest: destChainSelector, observe: observeExec}
+}
+
+func (f *laneStatusFactory) NewReportingPlugin(config types.ReportingPluginConfig) (types.ReportingPlugin, types.ReportingPluginInfo, error) {
+	plugin, info, err := f.ReportingPluginFactory.NewReportingPlugin(config)
+	if err != nil {
+		return nil, types.ReportingPluginInfo{}, err
+	}
+	f.lanes.Acquire(f.source, f.dest)
+	return &laneStatusPlugin{ReportingPlugin: plugin, factory: f}, info, nil
+}
+
+type laneStatusPlugin struct {
+	types.ReportingPlugin
+	factory   *laneStatusFactory
+	closeOnce sync.Once
+}
+
+func (p *laneStatusPlugin) Observation(ctx context.Context, ts types.ReportTimestamp, query types.Query) (types.Observation, error) {
+	observation, err := p.ReportingPlugin.Observation(ctx, ts, query)
+	if err != nil {
+		return observation, err
+	}
+	p.factory.lanes.Update(p.factory.source, p.factory.dest, func(status *LaneStatus) {
+		// an observation which can not be decoded leaves the status as it was
+		_ = p.factory.observe(status, observation)
+	})
+	return observation, nil
+}
+
+func (p *laneStatusPlugin) Close() error {
+	p.closeOnce.Do(func() { p.factory.lanes.Release(p.factory.source, p.factory.dest) })
+	return p.ReportingPlugin.Close()
+}
+
+func observeCommit(status *LaneStatus, observation types.Observation) error {
+	var obs CommitObservation
+	if err := json.Unmarshal(observation, &obs); err != nil {
+		return err
+	}
+	status.CommitObservedAt = time.Now()
+	status.PendingCommitMessages = 0
+	if interval := obs.Interval; interval.Min > 0 && interval.Max >= interval.Min {
+		status.LatestCommittedSeqNr = interval.Min - 1
+		status.PendingCommitMessages = int(interval.Max - interval.Min + 1)
+	}
+	return nil
+}
+
+func observeExec(status *LaneStatus, observation types.Observation) error {
+	var obs ExecutionObservation
+	if err := json.Unmarshal(observation, &obs); err != nil {
+		return err
+	}
+	status.ExecObservedAt = time.Now()
+	status.ExecutableMessages = len(obs.Messages)
+	return nil
+}
@@ -0,0 +1,135 @@
+package ccip
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+)
+
+func Test_LaneStatuses(t *testing.T) {
+	lanes := NewLaneStatuses()
+	assert.Empty(t, lanes.List())
+
+	// lanes which are not acquired are not updated
+	lanes.Update(2, 1, func(status *LaneStatus) {
+		status.LatestCommittedSeqNr = 10
+	})
+	assert.Empty(t, lanes.List())
+
+	lanes.Acquire(2, 1)
+	lanes.Acquire(2, 1)
+	lanes.Acquire(1, 2)
+	lanes.Update(2, 1, func(status *LaneStatus) {
+		status.LatestCommittedSeqNr = 10
+	})
+	lanes.Update(1, 2, func(status *LaneStatus) {
+		status.PendingCommitMessages = 5
+	})
+	lanes.Update(2, 1, func(status *LaneStatus) {
+		status.LatestExecutedSeqNr = 8
+	})
+
+	statuses := lanes.List()
+	require.Len(t, statuses, 2)
+	assert.Equal(t, LaneStatus{SourceChainSelector: 1, DestChainSelector: 2, PendingCommitMessages: 5}, statuses[0])
+	assert.Equal(t, LaneStatus{SourceChainSelector: 2, DestChainSelector: 1, LatestCommittedSeqNr: 10, LatestExecutedSeqNr: 8}, statuses[1])
+
+	// the statuses returned are copies
+	statuses[0].PendingCommitMessages = 0
+	assert.Equal(t, 5, lanes.List()[0].PendingCommitMessages)
+
+	// lanes are removed once all their services are released
+	lanes.Release(1, 2)
+	lanes.Release(2, 1)
+	require.Len(t, lanes.List(), 1)
+	lanes.Release(2, 1)
+	assert.Empty(t, lanes.List())
+	lanes.Release(2, 1)
+	assert.Empty(t, lanes.List())
+}
+
+type testLanePlugin struct {
+	types.ReportingPlugin
+	observation types.Observation
+}
+
+func (p *testLanePlugin) Observation(context.Context, types.ReportTimestamp, types.Query) (types.Observation, error) {
+	return p.observation, nil
+}
+
+func (p *testLanePlugin) Close() error { return nil }
+
+type testLanePluginFactory struct {
+	plugin *testLanePlugin
+}
+
+func (f *testLanePluginFactory) NewReportingPlugin(types.ReportingPluginConfig) (types.ReportingPlugin, types.ReportingPluginInfo, error) {
+	return f.plugin, types.ReportingPluginInfo{}, nil
+}
+
+func Test_LaneStatusFactory(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("commit", func(t *testing.T) {
+		lanes := NewLaneStatuses()
+		plugin := &testLanePlugin{}
+		factory := &laneStatusFactory{ReportingPluginFactory: &testLanePluginFactory{plugin: plugin}, lanes: lanes, source: 1, dest: 2, observe: observeCommit}
+
+		wrapped, _, err := factory.NewReportingPlugin(types.ReportingPluginConfig{})
+		require.NoError(t, err)
+
+		plugin.observation, err = json.Marshal(CommitObservation{Interval: cciptypes.CommitStoreInterval{Min: 11, Max: 15}})
+		require.NoError(t, err)
+		_, err = wrapped.Observation(ctx, types.ReportTimestamp{}, nil)
+		require.NoError(t, err)
+
+		statuses := lanes.List()
+		require.Len(t, statuses, 1)
+		assert.Equal(t, uint64(10), statuses[0].LatestCommittedSeqNr)
+		assert.Equal(t, 5, statuses[0].PendingCommitMessages)
+		assert.False(t, statuses[0].CommitObservedAt.IsZero())
+
+		// no pending messages keeps the latest committed sequence number
+		plugin.observation, err = json.Marshal(CommitObservation{})
+		require.NoError(t, err)
+		_, err = wrapped.Observation(ctx, types.ReportTimestamp{}, nil)
+		require.NoError(t, err)
+
+		statuses = lanes.List()
+		require.Len(t, statuses, 1)
+		assert.Equal(t, uint64(10), statuses[0].LatestCommittedSeqNr)
+		assert.Equal(t, 0, statuses[0].PendingCommitMessages)
+
+		require.NoError(t, wrapped.Close())
+		require.NoError(t, wrapped.Close())
+		assert.Empty(t, lanes.List())
+	})
+
+	t.Run("exec", func(t *testing.T) {
+		lanes := NewLaneStatuses()
+		plugin := &testLanePlugin{}
+		factory := &laneStatusFactory{ReportingPluginFactory: &testLanePluginFactory{plugin: plugin}, lanes: lanes, source: 1, dest: 2, observe: observeExec}
+
+		wrapped, _, err := factory.NewReportingPlugin(types.ReportingPluginConfig{})
+		require.NoError(t, err)
+
+		plugin.observation, err = NewExecutionObservation([]ObservedMessage{{SeqNr: 1}, {SeqNr: 2}}).Marshal()
+		require.NoError(t, err)
+		_, err = wrapped.Observation(ctx, types.ReportTimestamp{}, nil)
+		require.NoError(t, err)
+
+		statuses := lanes.List()
+		require.Len(t, statuses, 1)
+		assert.Equal(t, 2, statuses[0].ExecutableMessages)
+		assert.False(t, statuses[0].ExecObservedAt.IsZero())
+
+		require.NoError(t, wrapped.Close())
+		assert.Empty(t, lanes.List())
+	})
+}
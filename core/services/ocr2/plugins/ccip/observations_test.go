@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
 
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
@@ -284,11 +285,16 @@ func TestAddressEncodingBackwardsCompatibility(t *testing.T) {
 	// make this test pass or if you removed a field, remove it from the expected fields slice.
 
 	t.Run("job spec config", func(t *testing.T) {
-		exp := []string{"ccip.Address OffRamp"}
+		exp := []string{"ccip.Address OffRamp", "QuoteCurrencyccip.Address PriceToken"}
 
 		fields := testhelpers.FindStructFieldsOfCertainType(
 			"ccip.Address",
-			config.CommitPluginJobSpecConfig{PriceGetterConfig: &config.DynamicPriceGetterConfig{}},
+			config.CommitPluginJobSpecConfig{
+				PriceGetterConfig:            &config.DynamicPriceGetterConfig{},
+				QuoteCurrency:                &config.QuoteCurrencyConfig{},
+				PriceReportSuppressionWindow: &commonconfig.Duration{},
+				PriceUpdateFilter:            &config.PriceUpdateFilterConfig{},
+			},
 		)
 		assert.Equal(t, exp, fields)
 	})
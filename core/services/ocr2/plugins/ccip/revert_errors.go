@@ -0,0 +1,28 @@
+package ccip
+
+import (
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/revert"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/commit_store"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/commit_store_1_2_0"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_offramp"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_offramp_1_2_0"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_onramp"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_onramp_1_2_0"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/price_registry_1_2_0"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/router"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/token_pool"
+)
+
+// Registers the errors of the CCIP contracts, so that their reverts are decoded in the errors of failed calls and the
+// receipts of failed transactions.
+func init() {
+	revert.MustRegister("CommitStore", commit_store.CommitStoreABI)
+	revert.MustRegister("CommitStore_1_2_0", commit_store_1_2_0.CommitStoreABI)
+	revert.MustRegister("EVM2EVMOffRamp", evm_2_evm_offramp.EVM2EVMOffRampABI)
+	revert.MustRegister("EVM2EVMOffRamp_1_2_0", evm_2_evm_offramp_1_2_0.EVM2EVMOffRampABI)
+	revert.MustRegister("EVM2EVMOnRamp", evm_2_evm_onramp.EVM2EVMOnRampABI)
+	revert.MustRegister("EVM2EVMOnRamp_1_2_0", evm_2_evm_onramp_1_2_0.EVM2EVMOnRampABI)
+	revert.MustRegister("PriceRegistry", price_registry_1_2_0.PriceRegistryABI)
+	revert.MustRegister("Router", router.RouterABI)
+	revert.MustRegister("TokenPool", token_pool.TokenPoolABI)
+}
@@ -0,0 +1,245 @@
+package simulator
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/ethconfig"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// defaultBlockTime is the time after its parent which go-ethereum gives a generated block, before its offset.
+const defaultBlockTime = 10 * time.Second
+
+// configMu guards the swap of params.AllEthashProtocolChanges in newBackend.
+var configMu sync.Mutex
+
+// BlockConfig controls how the blocks of a Chain are produced. Block timestamps only depend on the genesis time, the
+// block time and the number of blocks mined, so that runs with the same config and transactions produce the same
+// chain.
+type BlockConfig struct {
+	// GenesisTime is the timestamp of the first block mined on top of genesis.
+	GenesisTime time.Time
+	// BlockTime is added to the timestamp of each block. It must be whole seconds, of at least a second.
+	BlockTime time.Duration
+	// Interval mines a block periodically, if positive. Blocks are otherwise only mined by AutoMine and Mine.
+	Interval time.Duration
+	// AutoMine mines a block after each transaction sent through the RPC server.
+	AutoMine bool
+	// FinalityDepth is the depth of the finalized block, reported for the finalized and safe block tags.
+	FinalityDepth uint32
+}
+
+func (c BlockConfig) validate() error {
+	if c.BlockTime < time.Second || c.BlockTime%time.Second != 0 {
+		return fmt.Errorf("block time must be whole seconds of at least 1s, got %s", c.BlockTime)
+	}
+	if c.Interval < 0 {
+		return fmt.Errorf("mining interval must not be negative, got %s", c.Interval)
+	}
+	return nil
+}
+
+// Chain is an in-memory EVM chain, whose blocks are only produced as configured by its BlockConfig.
+//
+// The blocks are mined by the Chain rather than by the Commit of its Backend, which times the blocks with transactions
+// 10s after their parent regardless of AdjustTime, so transactions must be sent with SendTransaction.
+type Chain struct {
+	ID       *big.Int
+	Selector uint64
+	Backend  *backends.SimulatedBackend
+	// Owner is funded at genesis and owns the contracts deployed by DeployLane.
+	Owner *bind.TransactOpts
+
+	lggr     logger.Logger
+	cfg      BlockConfig
+	db       ethdb.Database
+	mu       sync.Mutex
+	autoMine bool
+	next     uint64               // timestamp of the next block
+	pending  []*types.Transaction // of the next block, in the order they were sent
+}
+
+// NewChain creates a Chain with the chain ID id, whose owner key is derived from seed, so that the contracts deployed by
+// the owner get the same addresses on each run.
+func NewChain(lggr logger.Logger, id, selector uint64, seed string, cfg BlockConfig) (*Chain, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	key, err := ownerKey(seed, id)
+	if err != nil {
+		return nil, err
+	}
+	chainID := new(big.Int).SetUint64(id)
+	owner, err := bind.NewKeyedTransactorWithChainID(key, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	db := rawdb.NewMemoryDatabase()
+	backend := newBackend(chainID, db, core.GenesisAlloc{
+		owner.From: {Balance: new(big.Int).Mul(big.NewInt(1_000_000), big.NewInt(1e18))},
+	})
+	c := &Chain{
+		ID:       chainID,
+		Selector: selector,
+		Backend:  backend,
+		Owner:    owner,
+		lggr:     lggr.Named(fmt.Sprintf("Chain.%d", id)),
+		cfg:      cfg,
+		db:       db,
+		autoMine: cfg.AutoMine,
+		next:     uint64(cfg.GenesisTime.Unix()),
+	}
+
+	// the simulated backend starts its clock at the unix epoch
+	genesis := time.Unix(int64(backend.Blockchain().CurrentHeader().Time), 0)
+	if cfg.GenesisTime.Before(genesis.Add(time.Second)) {
+		return nil, fmt.Errorf("genesis time must be after %s", genesis)
+	}
+	c.Mine(1)
+	return c, nil
+}
+
+// newBackend creates a simulated backend with the chain ID id. The simulated backend always uses the chain config
+// params.AllEthashProtocolChanges, whose chain ID is 1337, so a copy with the chain ID is swapped in while the backend
+// is created. Each backend keeps the config it was created with.
+func newBackend(id *big.Int, db ethdb.Database, alloc core.GenesisAlloc) *backends.SimulatedBackend {
+	configMu.Lock()
+	defer configMu.Unlock()
+	orig := params.AllEthashProtocolChanges
+	defer func() { params.AllEthashProtocolChanges = orig }()
+
+	cfg := *orig
+	cfg.ChainID = id
+	params.AllEthashProtocolChanges = &cfg
+	return backends.NewSimulatedBackendWithDatabase(db, alloc, ethconfig.Defaults.Miner.GasCeil)
+}
+
+// ownerKey derives the owner key of a chain from the seed and the chain ID.
+func ownerKey(seed string, id uint64) (*ecdsa.PrivateKey, error) {
+	if seed == "" {
+		return nil, errors.New("seed must not be empty")
+	}
+	return crypto.ToECDSA(crypto.Keccak256([]byte(fmt.Sprintf("%s/%d", seed, id))))
+}
+
+// ContractBackend returns the backend to bind contracts with, which sends the transactions with SendTransaction.
+func (c *Chain) ContractBackend() bind.ContractBackend {
+	return contractBackend{c.Backend, c}
+}
+
+type contractBackend struct {
+	*backends.SimulatedBackend
+	c *Chain
+}
+
+func (b contractBackend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	return b.c.SendTransaction(ctx, tx)
+}
+
+// SendTransaction adds tx to the next block. The pending state of the Backend reflects it until the block is mined.
+func (c *Chain) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.Backend.SendTransaction(ctx, tx); err != nil {
+		return err
+	}
+	c.pending = append(c.pending, tx)
+	return nil
+}
+
+// Mine mines n blocks and returns the hash of the last one.
+func (c *Chain) Mine(n int) common.Hash {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var hash common.Hash
+	for i := 0; i < n; i++ {
+		hash = c.commit()
+	}
+	return hash
+}
+
+// commit mines the pending transactions in a block with the timestamp c.next, which then moves to the next block.
+func (c *Chain) commit() common.Hash {
+	bc := c.Backend.Blockchain()
+	head := bc.CurrentBlock()
+	parent := bc.GetBlock(head.Hash(), head.Number.Uint64())
+	// the offset is relative to the default time of the block, and may be negative
+	offset := int64(c.next-parent.Time()) - int64(defaultBlockTime/time.Second)
+	blocks, _ := core.GenerateChain(bc.Config(), parent, ethash.NewFaker(), c.db, 1, func(_ int, b *core.BlockGen) {
+		b.OffsetTime(offset)
+		for _, tx := range c.pending {
+			b.AddTxWithChain(bc, tx)
+		}
+	})
+	if _, err := bc.InsertChain(blocks); err != nil {
+		panic(err) // the transactions were already applied to the pending state, so this is a bug of the simulator
+	}
+	// moves the pending block of the backend on top of the new one
+	c.Backend.Rollback()
+	c.pending = nil
+	c.next += uint64(c.cfg.BlockTime / time.Second)
+	return blocks[0].Hash()
+}
+
+// IncreaseTime moves the timestamp of the next block, and thus of all the blocks after it, forward by d.
+func (c *Chain) IncreaseTime(d time.Duration) error {
+	if d < time.Second || d%time.Second != 0 {
+		return fmt.Errorf("time increase must be whole seconds of at least 1s, got %s", d)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.next += uint64(d / time.Second)
+	return nil
+}
+
+// SetAutoMine enables or disables mining a block after each transaction.
+func (c *Chain) SetAutoMine(enabled bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.autoMine = enabled
+}
+
+func (c *Chain) afterTransaction() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.autoMine {
+		c.commit()
+	}
+}
+
+// LatestBlock returns the number of the latest mined block.
+func (c *Chain) LatestBlock() uint64 {
+	return c.Backend.Blockchain().CurrentHeader().Number.Uint64()
+}
+
+// FinalizedBlock returns the number of the latest block deeper than the finality depth.
+func (c *Chain) FinalizedBlock() uint64 {
+	latest := c.LatestBlock()
+	if latest < uint64(c.cfg.FinalityDepth) {
+		return 0
+	}
+	return latest - uint64(c.cfg.FinalityDepth)
+}
+
+// Close closes the backend.
+func (c *Chain) Close() error {
+	return c.Backend.Close()
+}
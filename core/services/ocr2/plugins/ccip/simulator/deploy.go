@@ -0,0 +1,391 @@
+package simulator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/commit_store"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_offramp"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_onramp"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/lock_release_token_pool"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/maybe_revert_message_receiver"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/mock_rmn_contract"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/price_registry_1_2_0"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/rmn_proxy_contract"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/router"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/token_admin_registry"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/weth9"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/link_token_interface"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/abihelpers"
+)
+
+var (
+	// poolLiquidity is provided to the dest token pools, so that messages with tokens can be executed.
+	poolLiquidity = link(1_000)
+	// rateLimit is the capacity of the rate limiters of the ramps and the pools, refilled at a tenth per second.
+	rateLimit = link(10_000)
+)
+
+func link(amount int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(1e18), big.NewInt(amount))
+}
+
+// ChainContracts are the contracts deployed on both chains of a Lane.
+type ChainContracts struct {
+	ChainID            uint64         `json:"chainID"`
+	ChainSelector      uint64         `json:"chainSelector"`
+	Owner              common.Address `json:"owner"`
+	RMN                common.Address `json:"rmn"`
+	RMNProxy           common.Address `json:"rmnProxy"`
+	TokenAdminRegistry common.Address `json:"tokenAdminRegistry"`
+	LinkToken          common.Address `json:"linkToken"`
+	WrappedNative      common.Address `json:"wrappedNative"`
+	Router             common.Address `json:"router"`
+	PriceRegistry      common.Address `json:"priceRegistry"`
+	LinkTokenPool      common.Address `json:"linkTokenPool"`
+	WrappedNativePool  common.Address `json:"wrappedNativePool"`
+}
+
+// SourceContracts are the contracts of the source chain of a Lane.
+type SourceContracts struct {
+	ChainContracts
+	OnRamp common.Address `json:"onRamp"`
+}
+
+// DestContracts are the contracts of the dest chain of a Lane.
+type DestContracts struct {
+	ChainContracts
+	CommitStore common.Address `json:"commitStore"`
+	OffRamp     common.Address `json:"offRamp"`
+	// Receiver is a message receiver, which can be told to revert to exercise failed executions.
+	Receiver common.Address `json:"receiver"`
+}
+
+// Lane are the addresses of the contracts of a lane deployed by DeployLane.
+type Lane struct {
+	Source SourceContracts `json:"source"`
+	Dest   DestContracts   `json:"dest"`
+}
+
+// DeployLane deploys the contracts of a lane from source to dest, with LINK and the wrapped native token as fee and
+// transferable tokens. Each transaction is mined in a block of its own, so that the addresses of the contracts and the
+// blocks are the same on each run. The OCR2 configs of the commit store and the offramp depend on the keys of the
+// nodes, they are left to be set once the jobs are created.
+func DeployLane(ctx context.Context, source, dest *Chain) (Lane, error) {
+	var lane Lane
+	src, dst := &deployer{ctx: ctx, c: source}, &deployer{ctx: ctx, c: dest}
+	var err error
+	if lane.Source.ChainContracts, err = src.deployCommon(); err != nil {
+		return Lane{}, fmt.Errorf("failed to deploy source contracts: %w", err)
+	}
+	if lane.Dest.ChainContracts, err = dst.deployCommon(); err != nil {
+		return Lane{}, fmt.Errorf("failed to deploy dest contracts: %w", err)
+	}
+	if err = configurePools(src, lane.Source.ChainContracts, lane.Dest.ChainContracts); err != nil {
+		return Lane{}, fmt.Errorf("failed to configure source pools: %w", err)
+	}
+	if err = configurePools(dst, lane.Dest.ChainContracts, lane.Source.ChainContracts); err != nil {
+		return Lane{}, fmt.Errorf("failed to configure dest pools: %w", err)
+	}
+	if lane.Source.OnRamp, err = src.deployOnRamp(lane.Source.ChainContracts, dest.Selector); err != nil {
+		return Lane{}, fmt.Errorf("failed to deploy onramp: %w", err)
+	}
+	if err = dst.deployOffRamp(&lane.Dest, source.Selector, lane.Source.OnRamp); err != nil {
+		return Lane{}, fmt.Errorf("failed to deploy offramp: %w", err)
+	}
+	return lane, nil
+}
+
+// deployer deploys and configures the contracts of a chain with its owner.
+type deployer struct {
+	ctx context.Context //nolint:containedctx
+	c   *Chain
+}
+
+// confirm mines the transaction and checks it succeeded.
+func (d *deployer) confirm(name string, tx *types.Transaction, err error) error {
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	d.c.Mine(1)
+	receipt, err := d.c.Backend.TransactionReceipt(d.ctx, tx.Hash())
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("%s: transaction %s reverted", name, tx.Hash())
+	}
+	return nil
+}
+
+func (d *deployer) deploy(name string, addr common.Address, tx *types.Transaction, err error) (common.Address, error) {
+	return addr, d.confirm("deploy "+name, tx, err)
+}
+
+func (d *deployer) owner() *bind.TransactOpts {
+	return d.c.Owner
+}
+
+func (d *deployer) deployCommon() (cc ChainContracts, err error) {
+	cc.ChainID = d.c.ID.Uint64()
+	cc.ChainSelector = d.c.Selector
+	cc.Owner = d.c.Owner.From
+	b := d.c.ContractBackend()
+
+	addr, tx, _, err := mock_rmn_contract.DeployMockRMNContract(d.owner(), b)
+	if cc.RMN, err = d.deploy("RMN", addr, tx, err); err != nil {
+		return
+	}
+	addr, tx, _, err = rmn_proxy_contract.DeployRMNProxyContract(d.owner(), b, cc.RMN)
+	if cc.RMNProxy, err = d.deploy("RMNProxy", addr, tx, err); err != nil {
+		return
+	}
+	addr, tx, _, err = token_admin_registry.DeployTokenAdminRegistry(d.owner(), b)
+	if cc.TokenAdminRegistry, err = d.deploy("TokenAdminRegistry", addr, tx, err); err != nil {
+		return
+	}
+	addr, tx, _, err = link_token_interface.DeployLinkToken(d.owner(), b)
+	if cc.LinkToken, err = d.deploy("LinkToken", addr, tx, err); err != nil {
+		return
+	}
+	addr, tx, _, err = weth9.DeployWETH9(d.owner(), b)
+	if cc.WrappedNative, err = d.deploy("WETH9", addr, tx, err); err != nil {
+		return
+	}
+	addr, tx, _, err = router.DeployRouter(d.owner(), b, cc.WrappedNative, cc.RMNProxy)
+	if cc.Router, err = d.deploy("Router", addr, tx, err); err != nil {
+		return
+	}
+	if cc.LinkTokenPool, err = d.deployPool(cc, cc.LinkToken); err != nil {
+		return
+	}
+	if cc.WrappedNativePool, err = d.deployPool(cc, cc.WrappedNative); err != nil {
+		return
+	}
+	addr, tx, _, err = price_registry_1_2_0.DeployPriceRegistry(d.owner(), b, nil,
+		[]common.Address{cc.LinkToken, cc.WrappedNative}, 60*60*24*14) // two weeks
+	if cc.PriceRegistry, err = d.deploy("PriceRegistry", addr, tx, err); err != nil {
+		return
+	}
+	return cc, d.provideLiquidity(cc)
+}
+
+// deployPool deploys a lock/release pool for token, and registers it in the token admin registry.
+func (d *deployer) deployPool(cc ChainContracts, token common.Address) (common.Address, error) {
+	addr, tx, _, err := lock_release_token_pool.DeployLockReleaseTokenPool(d.owner(), d.c.ContractBackend(), token,
+		[]common.Address{}, cc.RMNProxy, true, cc.Router)
+	pool, err := d.deploy("LockReleaseTokenPool", addr, tx, err)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	registry, err := token_admin_registry.NewTokenAdminRegistry(cc.TokenAdminRegistry, d.c.ContractBackend())
+	if err != nil {
+		return common.Address{}, err
+	}
+	tx, err = registry.ProposeAdministrator(d.owner(), token, d.owner().From)
+	if err = d.confirm("propose token administrator", tx, err); err != nil {
+		return common.Address{}, err
+	}
+	tx, err = registry.AcceptAdminRole(d.owner(), token)
+	if err = d.confirm("accept token admin role", tx, err); err != nil {
+		return common.Address{}, err
+	}
+	tx, err = registry.SetPool(d.owner(), token, pool)
+	return pool, d.confirm("set token pool", tx, err)
+}
+
+// provideLiquidity funds the pools with poolLiquidity of their token, wrapping the native token of the owner.
+func (d *deployer) provideLiquidity(cc ChainContracts) error {
+	b := d.c.ContractBackend()
+	linkToken, err := link_token_interface.NewLinkToken(cc.LinkToken, b)
+	if err != nil {
+		return err
+	}
+	linkPool, err := lock_release_token_pool.NewLockReleaseTokenPool(cc.LinkTokenPool, b)
+	if err != nil {
+		return err
+	}
+	tx, err := linkPool.SetRebalancer(d.owner(), d.owner().From)
+	if err = d.confirm("set rebalancer", tx, err); err != nil {
+		return err
+	}
+	tx, err = linkToken.Approve(d.owner(), cc.LinkTokenPool, poolLiquidity)
+	if err = d.confirm("approve LINK", tx, err); err != nil {
+		return err
+	}
+	tx, err = linkPool.ProvideLiquidity(d.owner(), poolLiquidity)
+	if err = d.confirm("provide LINK liquidity", tx, err); err != nil {
+		return err
+	}
+
+	wrapped, err := weth9.NewWETH9(cc.WrappedNative, b)
+	if err != nil {
+		return err
+	}
+	opts := *d.owner()
+	opts.Value = poolLiquidity
+	tx, err = wrapped.Deposit(&opts)
+	if err = d.confirm("wrap native token", tx, err); err != nil {
+		return err
+	}
+	tx, err = wrapped.Transfer(d.owner(), cc.WrappedNativePool, poolLiquidity)
+	return d.confirm("transfer wrapped native token", tx, err)
+}
+
+// configurePools allows the pools of local to release and lock the tokens of the pools of remote.
+func configurePools(d *deployer, local, remote ChainContracts) error {
+	for _, p := range []struct{ pool, remotePool, remoteToken common.Address }{
+		{local.LinkTokenPool, remote.LinkTokenPool, remote.LinkToken},
+		{local.WrappedNativePool, remote.WrappedNativePool, remote.WrappedNative},
+	} {
+		pool, err := lock_release_token_pool.NewLockReleaseTokenPool(p.pool, d.c.ContractBackend())
+		if err != nil {
+			return err
+		}
+		remotePool, err := abihelpers.EncodeAddress(p.remotePool)
+		if err != nil {
+			return err
+		}
+		remoteToken, err := abihelpers.EncodeAddress(p.remoteToken)
+		if err != nil {
+			return err
+		}
+		limiter := lock_release_token_pool.RateLimiterConfig{IsEnabled: true, Capacity: rateLimit, Rate: new(big.Int).Div(rateLimit, big.NewInt(10))}
+		tx, err := pool.ApplyChainUpdates(d.owner(), []lock_release_token_pool.TokenPoolChainUpdate{{
+			RemoteChainSelector:       remote.ChainSelector,
+			RemotePoolAddress:         remotePool,
+			RemoteTokenAddress:        remoteToken,
+			Allowed:                   true,
+			OutboundRateLimiterConfig: limiter,
+			InboundRateLimiterConfig:  limiter,
+		}})
+		if err = d.confirm("apply pool chain updates", tx, err); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deployOnRamp deploys the onramp to destSelector, sets the initial prices of the fee tokens and of the dest gas, and
+// registers the onramp in the router.
+func (d *deployer) deployOnRamp(cc ChainContracts, destSelector uint64) (common.Address, error) {
+	b := d.c.ContractBackend()
+	prices, err := price_registry_1_2_0.NewPriceRegistry(cc.PriceRegistry, b)
+	if err != nil {
+		return common.Address{}, err
+	}
+	tx, err := prices.UpdatePrices(d.owner(), price_registry_1_2_0.InternalPriceUpdates{
+		TokenPriceUpdates: []price_registry_1_2_0.InternalTokenPriceUpdate{
+			{SourceToken: cc.LinkToken, UsdPerToken: link(20)},
+			{SourceToken: cc.WrappedNative, UsdPerToken: link(2000)},
+		},
+		GasPriceUpdates: []price_registry_1_2_0.InternalGasPriceUpdate{
+			{DestChainSelector: destSelector, UsdPerUnitGas: big.NewInt(20000e9)},
+		},
+	})
+	if err = d.confirm("update prices", tx, err); err != nil {
+		return common.Address{}, err
+	}
+
+	addr, tx, _, err := evm_2_evm_onramp.DeployEVM2EVMOnRamp(d.owner(), b,
+		evm_2_evm_onramp.EVM2EVMOnRampStaticConfig{
+			LinkToken:          cc.LinkToken,
+			ChainSelector:      cc.ChainSelector,
+			DestChainSelector:  destSelector,
+			DefaultTxGasLimit:  200_000,
+			MaxNopFeesJuels:    link(100_000_000),
+			RmnProxy:           cc.RMNProxy,
+			TokenAdminRegistry: cc.TokenAdminRegistry,
+		},
+		evm_2_evm_onramp.EVM2EVMOnRampDynamicConfig{
+			Router:                            cc.Router,
+			MaxNumberOfTokensPerMsg:           5,
+			DestGasOverhead:                   350_000,
+			DestGasPerPayloadByte:             16,
+			DestDataAvailabilityOverheadGas:   33_596,
+			DestGasPerDataAvailabilityByte:    16,
+			DestDataAvailabilityMultiplierBps: 6840,
+			PriceRegistry:                     cc.PriceRegistry,
+			MaxDataBytes:                      1e5,
+			MaxPerMsgGasLimit:                 4_000_000,
+			DefaultTokenFeeUSDCents:           50,
+			DefaultTokenDestGasOverhead:       125_000,
+		},
+		evm_2_evm_onramp.RateLimiterConfig{IsEnabled: true, Capacity: rateLimit, Rate: new(big.Int).Div(rateLimit, big.NewInt(10))},
+		[]evm_2_evm_onramp.EVM2EVMOnRampFeeTokenConfigArgs{
+			{Token: cc.LinkToken, NetworkFeeUSDCents: 1_00, GasMultiplierWeiPerEth: 1e18, PremiumMultiplierWeiPerEth: 9e17, Enabled: true},
+			{Token: cc.WrappedNative, NetworkFeeUSDCents: 1_00, GasMultiplierWeiPerEth: 1e18, PremiumMultiplierWeiPerEth: 1e18, Enabled: true},
+		},
+		[]evm_2_evm_onramp.EVM2EVMOnRampTokenTransferFeeConfigArgs{
+			{Token: cc.LinkToken, MinFeeUSDCents: 50, MaxFeeUSDCents: 1_000_000_00, DeciBps: 5_0, DestGasOverhead: 350_000, DestBytesOverhead: 32, AggregateRateLimitEnabled: true},
+		},
+		[]evm_2_evm_onramp.EVM2EVMOnRampNopAndWeight{},
+	)
+	onRamp, err := d.deploy("EVM2EVMOnRamp", addr, tx, err)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	r, err := router.NewRouter(cc.Router, b)
+	if err != nil {
+		return common.Address{}, err
+	}
+	tx, err = r.ApplyRampUpdates(d.owner(), []router.RouterOnRamp{{DestChainSelector: destSelector, OnRamp: onRamp}}, nil, nil)
+	return onRamp, d.confirm("register onramp", tx, err)
+}
+
+// deployOffRamp deploys the commit store and the offramp from the onramp of sourceSelector, lets the commit store
+// update the prices, registers the offramp in the router, and deploys a message receiver.
+func (d *deployer) deployOffRamp(dc *DestContracts, sourceSelector uint64, onRamp common.Address) (err error) {
+	b := d.c.ContractBackend()
+	addr, tx, _, err := commit_store.DeployCommitStore(d.owner(), b, commit_store.CommitStoreStaticConfig{
+		ChainSelector:       dc.ChainSelector,
+		SourceChainSelector: sourceSelector,
+		OnRamp:              onRamp,
+		RmnProxy:            dc.RMNProxy,
+	})
+	if dc.CommitStore, err = d.deploy("CommitStore", addr, tx, err); err != nil {
+		return
+	}
+	addr, tx, _, err = evm_2_evm_offramp.DeployEVM2EVMOffRamp(d.owner(), b,
+		evm_2_evm_offramp.EVM2EVMOffRampStaticConfig{
+			CommitStore:         dc.CommitStore,
+			ChainSelector:       dc.ChainSelector,
+			SourceChainSelector: sourceSelector,
+			OnRamp:              onRamp,
+			RmnProxy:            dc.RMNProxy,
+			TokenAdminRegistry:  dc.TokenAdminRegistry,
+		},
+		evm_2_evm_offramp.RateLimiterConfig{IsEnabled: true, Capacity: rateLimit, Rate: new(big.Int).Div(rateLimit, big.NewInt(10))},
+	)
+	if dc.OffRamp, err = d.deploy("EVM2EVMOffRamp", addr, tx, err); err != nil {
+		return
+	}
+
+	prices, err := price_registry_1_2_0.NewPriceRegistry(dc.PriceRegistry, b)
+	if err != nil {
+		return err
+	}
+	tx, err = prices.ApplyPriceUpdatersUpdates(d.owner(), []common.Address{dc.CommitStore}, []common.Address{})
+	if err = d.confirm("add commit store price updater", tx, err); err != nil {
+		return err
+	}
+	r, err := router.NewRouter(dc.Router, b)
+	if err != nil {
+		return err
+	}
+	tx, err = r.ApplyRampUpdates(d.owner(), nil, nil, []router.RouterOffRamp{{SourceChainSelector: sourceSelector, OffRamp: dc.OffRamp}})
+	if err = d.confirm("register offramp", tx, err); err != nil {
+		return err
+	}
+
+	addr, tx, _, err = maybe_revert_message_receiver.DeployMaybeRevertMessageReceiver(d.owner(), b, false)
+	dc.Receiver, err = d.deploy("MaybeRevertMessageReceiver", addr, tx, err)
+	return err
+}
@@ -0,0 +1,423 @@
+package simulator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// NewRPCServer returns a JSON-RPC server for the chain. It serves the eth, net and web3 methods used by the node, and
+// the sim methods, which control the production of blocks:
+//
+//	sim_mine(blocks)           mines the given number of blocks, or one, and returns the latest block number
+//	sim_increaseTime(seconds)  moves the timestamp of the next block forward
+//	sim_setAutoMine(enabled)   enables or disables mining a block after each transaction
+func NewRPCServer(c *Chain) (*rpc.Server, error) {
+	srv := rpc.NewServer()
+	apis := map[string]any{
+		"eth":  &ethAPI{c: c},
+		"net":  &netAPI{c: c},
+		"web3": &web3API{},
+		"sim":  &simAPI{c: c},
+	}
+	for name, api := range apis {
+		if err := srv.RegisterName(name, api); err != nil {
+			srv.Stop()
+			return nil, fmt.Errorf("failed to register %s API: %w", name, err)
+		}
+	}
+	return srv, nil
+}
+
+// rpcHandler serves srv over HTTP, and over websocket for the requests upgrading the connection.
+func rpcHandler(srv *rpc.Server) http.Handler {
+	ws := srv.WebsocketHandler([]string{"*"})
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+			ws.ServeHTTP(w, r)
+			return
+		}
+		srv.ServeHTTP(w, r)
+	})
+}
+
+type ethAPI struct {
+	c *Chain
+}
+
+func (api *ethAPI) ChainId() *hexutil.Big { //nolint:revive,stylecheck // named after eth_chainId
+	return (*hexutil.Big)(api.c.ID)
+}
+
+func (api *ethAPI) BlockNumber() hexutil.Uint64 {
+	return hexutil.Uint64(api.c.LatestBlock())
+}
+
+func (api *ethAPI) GasPrice(ctx context.Context) (*hexutil.Big, error) {
+	price, err := api.c.Backend.SuggestGasPrice(ctx)
+	return (*hexutil.Big)(price), err
+}
+
+func (api *ethAPI) MaxPriorityFeePerGas(ctx context.Context) (*hexutil.Big, error) {
+	tip, err := api.c.Backend.SuggestGasTipCap(ctx)
+	return (*hexutil.Big)(tip), err
+}
+
+func (api *ethAPI) GetBlockByNumber(ctx context.Context, number rpc.BlockNumber, fullTx bool) (map[string]any, error) {
+	block, err := api.c.Backend.BlockByNumber(ctx, api.blockNumber(number))
+	if errors.Is(err, ethereum.NotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return api.marshalBlock(block, fullTx)
+}
+
+func (api *ethAPI) GetBlockByHash(ctx context.Context, hash common.Hash, fullTx bool) (map[string]any, error) {
+	block, err := api.c.Backend.BlockByHash(ctx, hash)
+	if errors.Is(err, ethereum.NotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return api.marshalBlock(block, fullTx)
+}
+
+func (api *ethAPI) GetBalance(ctx context.Context, address common.Address, at rpc.BlockNumberOrHash) (*hexutil.Big, error) {
+	number, err := api.resolve(ctx, at)
+	if err != nil {
+		return nil, err
+	}
+	balance, err := api.c.Backend.BalanceAt(ctx, address, number)
+	return (*hexutil.Big)(balance), err
+}
+
+func (api *ethAPI) GetTransactionCount(ctx context.Context, address common.Address, at rpc.BlockNumberOrHash) (hexutil.Uint64, error) {
+	if isPending(at) {
+		nonce, err := api.c.Backend.PendingNonceAt(ctx, address)
+		return hexutil.Uint64(nonce), err
+	}
+	number, err := api.resolve(ctx, at)
+	if err != nil {
+		return 0, err
+	}
+	nonce, err := api.c.Backend.NonceAt(ctx, address, number)
+	return hexutil.Uint64(nonce), err
+}
+
+func (api *ethAPI) GetCode(ctx context.Context, address common.Address, at rpc.BlockNumberOrHash) (hexutil.Bytes, error) {
+	if isPending(at) {
+		return api.c.Backend.PendingCodeAt(ctx, address)
+	}
+	number, err := api.resolve(ctx, at)
+	if err != nil {
+		return nil, err
+	}
+	return api.c.Backend.CodeAt(ctx, address, number)
+}
+
+// Call returns the errors of the backend as they are, so that the revert reasons keep their error code and data.
+func (api *ethAPI) Call(ctx context.Context, args callArgs, at *rpc.BlockNumberOrHash) (hexutil.Bytes, error) {
+	if at == nil {
+		return api.c.Backend.CallContract(ctx, args.msg(), nil)
+	}
+	if isPending(*at) {
+		return api.c.Backend.PendingCallContract(ctx, args.msg())
+	}
+	number, err := api.resolve(ctx, *at)
+	if err != nil {
+		return nil, err
+	}
+	return api.c.Backend.CallContract(ctx, args.msg(), number)
+}
+
+// EstimateGas estimates the gas against the pending state, as the simulated backend doesn't support other blocks.
+func (api *ethAPI) EstimateGas(ctx context.Context, args callArgs, _ *rpc.BlockNumberOrHash) (hexutil.Uint64, error) {
+	gas, err := api.c.Backend.EstimateGas(ctx, args.msg())
+	return hexutil.Uint64(gas), err
+}
+
+func (api *ethAPI) SendRawTransaction(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(input); err != nil {
+		return common.Hash{}, err
+	}
+	if err := api.c.SendTransaction(ctx, tx); err != nil {
+		return common.Hash{}, err
+	}
+	api.c.afterTransaction()
+	return tx.Hash(), nil
+}
+
+func (api *ethAPI) GetTransactionByHash(ctx context.Context, hash common.Hash) (map[string]any, error) {
+	tx, pending, err := api.c.Backend.TransactionByHash(ctx, hash)
+	if errors.Is(err, ethereum.NotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if pending {
+		return api.marshalTx(tx, nil)
+	}
+	receipt, err := api.c.Backend.TransactionReceipt(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	return api.marshalTx(tx, receipt)
+}
+
+func (api *ethAPI) GetTransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+	receipt, err := api.c.Backend.TransactionReceipt(ctx, hash)
+	if errors.Is(err, ethereum.NotFound) {
+		return nil, nil
+	}
+	return receipt, err
+}
+
+func (api *ethAPI) GetLogs(ctx context.Context, crit filters.FilterCriteria) ([]types.Log, error) {
+	q := ethereum.FilterQuery(crit)
+	if q.BlockHash == nil {
+		// the block tags are decoded as negative numbers, which the simulated backend doesn't resolve to finalized blocks
+		q.FromBlock = api.resolveTag(q.FromBlock)
+		q.ToBlock = api.resolveTag(q.ToBlock)
+	}
+	logs, err := api.c.Backend.FilterLogs(ctx, q)
+	if logs == nil {
+		logs = []types.Log{}
+	}
+	return logs, err
+}
+
+// NewHeads serves eth_subscribe("newHeads").
+func (api *ethAPI) NewHeads(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, ok := rpc.NotifierFromContext(ctx)
+	if !ok {
+		return nil, rpc.ErrNotificationsUnsupported
+	}
+	sub := notifier.CreateSubscription()
+	heads := make(chan *types.Header, 16)
+	// ctx ends with the subscribe call, the backend subscription must last as long as the client's
+	headsSub, err := api.c.Backend.SubscribeNewHead(context.Background(), heads)
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		defer headsSub.Unsubscribe()
+		for {
+			select {
+			case h := <-heads:
+				_ = notifier.Notify(sub.ID, h)
+			case <-sub.Err():
+				return
+			case <-headsSub.Err():
+				return
+			}
+		}
+	}()
+	return sub, nil
+}
+
+// Logs serves eth_subscribe("logs").
+func (api *ethAPI) Logs(ctx context.Context, crit filters.FilterCriteria) (*rpc.Subscription, error) {
+	notifier, ok := rpc.NotifierFromContext(ctx)
+	if !ok {
+		return nil, rpc.ErrNotificationsUnsupported
+	}
+	sub := notifier.CreateSubscription()
+	logs := make(chan types.Log, 128)
+	logsSub, err := api.c.Backend.SubscribeFilterLogs(context.Background(), ethereum.FilterQuery(crit), logs)
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		defer logsSub.Unsubscribe()
+		for {
+			select {
+			case l := <-logs:
+				_ = notifier.Notify(sub.ID, &l)
+			case <-sub.Err():
+				return
+			case <-logsSub.Err():
+				return
+			}
+		}
+	}()
+	return sub, nil
+}
+
+// blockNumber returns the block number of the simulated backend for number, where nil is the latest block.
+func (api *ethAPI) blockNumber(number rpc.BlockNumber) *big.Int {
+	switch number {
+	case rpc.LatestBlockNumber, rpc.PendingBlockNumber:
+		return nil
+	case rpc.FinalizedBlockNumber, rpc.SafeBlockNumber:
+		return new(big.Int).SetUint64(api.c.FinalizedBlock())
+	default:
+		return big.NewInt(number.Int64())
+	}
+}
+
+func (api *ethAPI) resolveTag(number *big.Int) *big.Int {
+	if number == nil || number.Sign() >= 0 {
+		return number
+	}
+	if n := api.blockNumber(rpc.BlockNumber(number.Int64())); n != nil {
+		return n
+	}
+	return new(big.Int).SetUint64(api.c.LatestBlock())
+}
+
+func (api *ethAPI) resolve(ctx context.Context, at rpc.BlockNumberOrHash) (*big.Int, error) {
+	if hash, ok := at.Hash(); ok {
+		header, err := api.c.Backend.HeaderByHash(ctx, hash)
+		if err != nil {
+			return nil, err
+		}
+		return header.Number, nil
+	}
+	number, _ := at.Number()
+	return api.blockNumber(number), nil
+}
+
+func isPending(at rpc.BlockNumberOrHash) bool {
+	number, ok := at.Number()
+	return ok && number == rpc.PendingBlockNumber
+}
+
+// marshalBlock marshals a block like the eth_getBlockBy* methods of geth.
+func (api *ethAPI) marshalBlock(block *types.Block, fullTx bool) (map[string]any, error) {
+	fields, err := toFields(block.Header())
+	if err != nil {
+		return nil, err
+	}
+	fields["size"] = hexutil.Uint64(block.Size())
+	fields["uncles"] = []common.Hash{}
+	txs := make([]any, len(block.Transactions()))
+	for i, tx := range block.Transactions() {
+		if !fullTx {
+			txs[i] = tx.Hash()
+			continue
+		}
+		txs[i], err = api.marshalTx(tx, &types.Receipt{
+			BlockHash:        block.Hash(),
+			BlockNumber:      block.Number(),
+			TransactionIndex: uint(i),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	fields["transactions"] = txs
+	return fields, nil
+}
+
+// marshalTx marshals a transaction like eth_getTransactionByHash, with the block of the receipt, which is nil for
+// pending transactions.
+func (api *ethAPI) marshalTx(tx *types.Transaction, receipt *types.Receipt) (map[string]any, error) {
+	fields, err := toFields(tx)
+	if err != nil {
+		return nil, err
+	}
+	from, err := types.Sender(types.LatestSignerForChainID(api.c.ID), tx)
+	if err != nil {
+		return nil, err
+	}
+	fields["from"] = from
+	fields["blockHash"], fields["blockNumber"], fields["transactionIndex"] = nil, nil, nil
+	if receipt != nil {
+		fields["blockHash"] = receipt.BlockHash
+		fields["blockNumber"] = (*hexutil.Big)(receipt.BlockNumber)
+		fields["transactionIndex"] = hexutil.Uint(receipt.TransactionIndex)
+	}
+	return fields, nil
+}
+
+func toFields(v json.Marshaler) (map[string]any, error) {
+	b, err := v.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]any
+	return fields, json.Unmarshal(b, &fields)
+}
+
+// callArgs are the arguments of eth_call and eth_estimateGas.
+type callArgs struct {
+	From                 common.Address  `json:"from"`
+	To                   *common.Address `json:"to"`
+	Gas                  hexutil.Uint64  `json:"gas"`
+	GasPrice             *hexutil.Big    `json:"gasPrice"`
+	MaxFeePerGas         *hexutil.Big    `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big    `json:"maxPriorityFeePerGas"`
+	Value                *hexutil.Big    `json:"value"`
+	Data                 hexutil.Bytes   `json:"data"`
+	Input                hexutil.Bytes   `json:"input"`
+}
+
+func (a callArgs) msg() ethereum.CallMsg {
+	data := a.Input
+	if data == nil {
+		data = a.Data
+	}
+	return ethereum.CallMsg{
+		From:      a.From,
+		To:        a.To,
+		Gas:       uint64(a.Gas),
+		GasPrice:  a.GasPrice.ToInt(),
+		GasFeeCap: a.MaxFeePerGas.ToInt(),
+		GasTipCap: a.MaxPriorityFeePerGas.ToInt(),
+		Value:     a.Value.ToInt(),
+		Data:      data,
+	}
+}
+
+type netAPI struct {
+	c *Chain
+}
+
+func (api *netAPI) Version() string {
+	return api.c.ID.String()
+}
+
+func (api *netAPI) Listening() bool {
+	return true
+}
+
+type web3API struct{}
+
+func (web3API) ClientVersion() string {
+	return "chainlink-ccip-simulator"
+}
+
+type simAPI struct {
+	c *Chain
+}
+
+func (api *simAPI) Mine(blocks *hexutil.Uint64) hexutil.Uint64 {
+	n := 1
+	if blocks != nil {
+		n = int(*blocks)
+	}
+	api.c.Mine(n)
+	return hexutil.Uint64(api.c.LatestBlock())
+}
+
+func (api *simAPI) IncreaseTime(seconds hexutil.Uint64) error {
+	return api.c.IncreaseTime(time.Duration(seconds) * time.Second)
+}
+
+func (api *simAPI) SetAutoMine(enabled bool) {
+	api.c.SetAutoMine(enabled)
+}
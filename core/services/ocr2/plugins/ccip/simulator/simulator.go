@@ -0,0 +1,176 @@
+// Package simulator runs a CCIP lane on two in-memory EVM chains, served over JSON-RPC, so that the commit, exec and
+// price flows can be tested end to end against local nodes, without external testnets.
+package simulator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// ChainConfig configures one of the chains of the Simulator.
+type ChainConfig struct {
+	ChainID  uint64
+	Selector uint64
+	// ListenAddr is the address of the RPC server of the chain, like 127.0.0.1:8545.
+	ListenAddr string
+}
+
+// Config configures a Simulator.
+type Config struct {
+	Source ChainConfig
+	Dest   ChainConfig
+	// Seed derives the keys of the owners of the chains.
+	Seed  string
+	Block BlockConfig
+}
+
+// Simulator runs the source and dest chains of a lane, and serves each over HTTP and websocket.
+type Simulator struct {
+	services.StateMachine
+	lggr   logger.Logger
+	cfg    Config
+	Source *Chain
+	Dest   *Chain
+	Lane   Lane
+
+	servers []*http.Server
+	addrs   []net.Addr
+	wg      sync.WaitGroup
+	stopCh  services.StopChan
+}
+
+// New creates the chains and deploys the lane, mining the genesis and deployment blocks.
+func New(ctx context.Context, lggr logger.Logger, cfg Config) (*Simulator, error) {
+	lggr = lggr.Named("CCIPSimulator")
+	source, err := NewChain(lggr, cfg.Source.ChainID, cfg.Source.Selector, cfg.Seed, cfg.Block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create source chain: %w", err)
+	}
+	dest, err := NewChain(lggr, cfg.Dest.ChainID, cfg.Dest.Selector, cfg.Seed, cfg.Block)
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to create dest chain: %w", err), source.Close())
+	}
+	lane, err := DeployLane(ctx, source, dest)
+	if err != nil {
+		return nil, errors.Join(err, source.Close(), dest.Close())
+	}
+	// the plugins only read finalized blocks, bury the deployment under the finality depth
+	source.Mine(int(cfg.Block.FinalityDepth))
+	dest.Mine(int(cfg.Block.FinalityDepth))
+
+	return &Simulator{
+		lggr:   lggr,
+		cfg:    cfg,
+		Source: source,
+		Dest:   dest,
+		Lane:   lane,
+		stopCh: make(services.StopChan),
+	}, nil
+}
+
+// Start serves the RPC servers of the chains, and mines blocks periodically if the mining interval is set.
+func (s *Simulator) Start(context.Context) error {
+	return s.StartOnce("CCIPSimulator", func() error {
+		for _, c := range []struct {
+			chain *Chain
+			addr  string
+		}{{s.Source, s.cfg.Source.ListenAddr}, {s.Dest, s.cfg.Dest.ListenAddr}} {
+			if err := s.serve(c.chain, c.addr); err != nil {
+				return errors.Join(err, s.close())
+			}
+			if s.cfg.Block.Interval > 0 {
+				s.wg.Add(1)
+				go s.mine(c.chain)
+			}
+		}
+		return nil
+	})
+}
+
+func (s *Simulator) serve(c *Chain, addr string) error {
+	srv, err := NewRPCServer(c)
+	if err != nil {
+		return err
+	}
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		srv.Stop()
+		return fmt.Errorf("failed to listen on %s: %w", addr, err)
+	}
+	server := &http.Server{Handler: rpcHandler(srv), ReadHeaderTimeout: 10 * time.Second}
+	server.RegisterOnShutdown(srv.Stop)
+	s.servers = append(s.servers, server)
+	s.addrs = append(s.addrs, l.Addr())
+	s.lggr.Infow("Serving simulated chain", "chainID", c.ID, "selector", c.Selector,
+		"http", rpcURL("http", l.Addr()), "ws", rpcURL("ws", l.Addr()))
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		if err := server.Serve(l); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.lggr.Errorw("RPC server failed", "chainID", c.ID, "err", err)
+		}
+	}()
+	return nil
+}
+
+func (s *Simulator) mine(c *Chain) {
+	defer s.wg.Done()
+	ticker := time.NewTicker(s.cfg.Block.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.stopCh:
+			return
+		case <-ticker.C:
+			c.Mine(1)
+		}
+	}
+}
+
+// URLs returns the HTTP and websocket URLs of the RPC servers of the source and dest chains, once started.
+func (s *Simulator) URLs() (sourceHTTP, sourceWS, destHTTP, destWS string) {
+	if len(s.addrs) < 2 {
+		return
+	}
+	return rpcURL("http", s.addrs[0]), rpcURL("ws", s.addrs[0]), rpcURL("http", s.addrs[1]), rpcURL("ws", s.addrs[1])
+}
+
+func (s *Simulator) Close() error {
+	return s.StopOnce("CCIPSimulator", s.close)
+}
+
+func (s *Simulator) close() error {
+	close(s.stopCh)
+	var errs []error
+	for _, server := range s.servers {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		errs = append(errs, server.Shutdown(ctx))
+		cancel()
+	}
+	s.wg.Wait()
+	// the chains are closed last, so that no request is served by a closed backend
+	errs = append(errs, s.Source.Close(), s.Dest.Close())
+	return errors.Join(errs...)
+}
+
+// rpcURL returns the URL of the RPC server listening on addr, replacing unspecified hosts by the loopback address.
+func rpcURL(scheme string, addr net.Addr) string {
+	host, port, err := net.SplitHostPort(addr.String())
+	if err != nil {
+		return scheme + "://" + addr.String()
+	}
+	if ip := net.ParseIP(host); ip == nil || ip.IsUnspecified() {
+		host = "127.0.0.1"
+	}
+	return scheme + "://" + net.JoinHostPort(host, port)
+}
@@ -0,0 +1,135 @@
+package simulator
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+func newTestSimulator(t *testing.T, autoMine bool) *Simulator {
+	ctx := testutils.Context(t)
+	sim, err := New(ctx, logger.TestLogger(t), Config{
+		Source: ChainConfig{ChainID: 1000, Selector: 11787463284727550157, ListenAddr: "127.0.0.1:0"},
+		Dest:   ChainConfig{ChainID: 2000, Selector: 3379446385462418246, ListenAddr: "127.0.0.1:0"},
+		Seed:   "test",
+		Block: BlockConfig{
+			GenesisTime:   time.Unix(1_700_000_000, 0),
+			BlockTime:     2 * time.Second,
+			AutoMine:      autoMine,
+			FinalityDepth: 5,
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, sim.Start(ctx))
+	t.Cleanup(func() { assert.NoError(t, sim.Close()) })
+	return sim
+}
+
+func TestSimulator_Deterministic(t *testing.T) {
+	a, b := newTestSimulator(t, false), newTestSimulator(t, false)
+	assert.Equal(t, a.Lane, b.Lane)
+	assert.Equal(t, a.Source.LatestBlock(), b.Source.LatestBlock())
+	assert.Equal(t, a.Source.Backend.Blockchain().CurrentHeader().Hash(), b.Source.Backend.Blockchain().CurrentHeader().Hash())
+
+	// blocks are 2s apart, from the genesis time
+	header := a.Dest.Backend.Blockchain().CurrentHeader()
+	assert.Equal(t, uint64(1_700_000_000)+2*(header.Number.Uint64()-1), header.Time)
+}
+
+func TestChain_IncreaseTime(t *testing.T) {
+	c, err := NewChain(logger.TestLogger(t), 1000, 11787463284727550157, "test", BlockConfig{
+		GenesisTime: time.Unix(1_700_000_000, 0),
+		BlockTime:   2 * time.Second,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, c.Close()) })
+	headerTime := func() uint64 { return c.Backend.Blockchain().CurrentHeader().Time }
+
+	require.NoError(t, c.IncreaseTime(5*time.Second))
+	require.NoError(t, c.IncreaseTime(3*time.Second))
+	c.Mine(1)
+	assert.Equal(t, uint64(1_700_000_010), headerTime())
+
+	// the increase only shifts the blocks after it once
+	c.Mine(2)
+	assert.Equal(t, uint64(1_700_000_014), headerTime())
+}
+
+func TestSimulator_RPC(t *testing.T) {
+	ctx := testutils.Context(t)
+	sim := newTestSimulator(t, true)
+	sourceHTTP, _, _, destWS := sim.URLs()
+
+	source, err := ethclient.DialContext(ctx, sourceHTTP)
+	require.NoError(t, err)
+	defer source.Close()
+	dest, err := ethclient.DialContext(ctx, destWS)
+	require.NoError(t, err)
+	defer dest.Close()
+
+	chainID, err := source.ChainID(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1000), chainID.Int64())
+	chainID, err = dest.ChainID(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2000), chainID.Int64())
+
+	code, err := source.CodeAt(ctx, sim.Lane.Source.OnRamp, nil)
+	require.NoError(t, err)
+	assert.NotEmpty(t, code)
+	code, err = dest.CodeAt(ctx, sim.Lane.Dest.OffRamp, nil)
+	require.NoError(t, err)
+	assert.NotEmpty(t, code)
+
+	t.Run("sim_mine", func(t *testing.T) {
+		heads := make(chan *types.Header, 10)
+		sub, err := dest.SubscribeNewHead(ctx, heads)
+		require.NoError(t, err)
+		defer sub.Unsubscribe()
+
+		before, err := dest.BlockNumber(ctx)
+		require.NoError(t, err)
+		var latest hexutil.Uint64
+		require.NoError(t, dest.Client().CallContext(ctx, &latest, "sim_mine", hexutil.Uint64(3)))
+		assert.Equal(t, before+3, uint64(latest))
+		for i := uint64(1); i <= 3; i++ {
+			select {
+			case h := <-heads:
+				assert.Equal(t, before+i, h.Number.Uint64())
+			case <-ctx.Done():
+				t.Fatal("timed out waiting for head")
+			}
+		}
+
+		finalized, err := dest.HeaderByNumber(ctx, big.NewInt(int64(rpc.FinalizedBlockNumber)))
+		require.NoError(t, err)
+		assert.Equal(t, uint64(latest)-5, finalized.Number.Uint64())
+	})
+
+	t.Run("auto mine", func(t *testing.T) {
+		owner := sim.Source.Owner
+		nonce, err := source.PendingNonceAt(ctx, owner.From)
+		require.NoError(t, err)
+		tx, err := owner.Signer(owner.From, types.NewTransaction(nonce, owner.From, big.NewInt(1), 21_000, big.NewInt(1e9), nil))
+		require.NoError(t, err)
+		require.NoError(t, source.SendTransaction(ctx, tx))
+
+		receipt, err := source.TransactionReceipt(ctx, tx.Hash())
+		require.NoError(t, err)
+		assert.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+		got, pending, err := source.TransactionByHash(ctx, tx.Hash())
+		require.NoError(t, err)
+		assert.False(t, pending)
+		assert.Equal(t, tx.Hash(), got.Hash())
+	})
+}
@@ -0,0 +1,41 @@
+package deadlinewrapper
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+)
+
+var _ types.ReportingPluginFactory = &deadlineFactory{}
+
+type deadlineFactory struct {
+	wrapped            types.ReportingPluginFactory
+	lggr               logger.Logger
+	name               string
+	chainType          string
+	chainID            string
+	observationTimeout time.Duration
+	reportTimeout      time.Duration
+}
+
+func (f *deadlineFactory) NewReportingPlugin(config types.ReportingPluginConfig) (types.ReportingPlugin, types.ReportingPluginInfo, error) {
+	plugin, info, err := f.wrapped.NewReportingPlugin(config)
+	if err != nil {
+		return nil, types.ReportingPluginInfo{}, err
+	}
+	return New(plugin, f.lggr, f.name, f.chainType, f.chainID, f.observationTimeout, f.reportTimeout), info, nil
+}
+
+func NewDeadlineFactory(wrapped types.ReportingPluginFactory, lggr logger.Logger, name, chainType string, chainID string, observationTimeout, reportTimeout time.Duration) types.ReportingPluginFactory {
+	return &deadlineFactory{
+		wrapped:            wrapped,
+		lggr:               lggr,
+		name:               name,
+		chainType:          chainType,
+		chainID:            chainID,
+		observationTimeout: observationTimeout,
+		reportTimeout:      reportTimeout,
+	}
+}
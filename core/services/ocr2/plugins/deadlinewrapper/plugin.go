@@ -0,0 +1,110 @@
+// deadlinewrapper wraps another OCR2 reporting plugin to bound its Observation and Report phases with local deadlines,
+// and accounts for where the time of each phase was spent (RPC, DB or computation), so that phases exceeding their
+// deadline are reported rather than dropped silently.
+package deadlinewrapper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils/deadlinebudget"
+)
+
+const (
+	phaseObservation = "observation"
+	phaseReport      = "report"
+)
+
+var (
+	promBudgetSpent = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "ocr2_reporting_plugin_deadline_budget_spent_seconds",
+		Help:    "Time spent by the OCR2 plugin's Observation() and Report() methods, per category of work (rpc, db, computation)",
+		Buckets: []float64{0.001, 0.005, 0.01, 0.05, 0.1, 0.5, 1, 2, 5, 10, 30, 60},
+	}, []string{"chainType", "chainID", "plugin", "phase", "category"})
+	promDeadlineExceeded = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ocr2_reporting_plugin_deadline_exceeded_total",
+		Help: "Number of times the OCR2 plugin's Observation() or Report() methods exceeded their deadline",
+	}, []string{"chainType", "chainID", "plugin", "phase", "deadline"})
+)
+
+var _ types.ReportingPlugin = &deadlinePlugin{}
+
+type deadlinePlugin struct {
+	types.ReportingPlugin
+	lggr               logger.Logger
+	name               string
+	chainType          string
+	chainID            string
+	observationTimeout time.Duration
+	reportTimeout      time.Duration
+}
+
+// New wraps the plugin with the local deadlines. A zero timeout leaves the phase bound by the deadline of the contract
+// config only, but its budget is still accounted for.
+func New(plugin types.ReportingPlugin, lggr logger.Logger, name, chainType string, chainID string, observationTimeout, reportTimeout time.Duration) types.ReportingPlugin {
+	return &deadlinePlugin{
+		ReportingPlugin:    plugin,
+		lggr:               logger.Named(lggr, "DeadlineWrapper"),
+		name:               name,
+		chainType:          chainType,
+		chainID:            chainID,
+		observationTimeout: observationTimeout,
+		reportTimeout:      reportTimeout,
+	}
+}
+
+func (p *deadlinePlugin) Observation(ctx context.Context, timestamp types.ReportTimestamp, query types.Query) (obs types.Observation, err error) {
+	err = p.withDeadline(ctx, phaseObservation, p.observationTimeout, timestamp, func(ctx context.Context) (err error) {
+		obs, err = p.ReportingPlugin.Observation(ctx, timestamp, query)
+		return
+	})
+	return
+}
+
+func (p *deadlinePlugin) Report(ctx context.Context, timestamp types.ReportTimestamp, query types.Query, observations []types.AttributedObservation) (shouldReport bool, report types.Report, err error) {
+	err = p.withDeadline(ctx, phaseReport, p.reportTimeout, timestamp, func(ctx context.Context) (err error) {
+		shouldReport, report, err = p.ReportingPlugin.Report(ctx, timestamp, query, observations)
+		return
+	})
+	return
+}
+
+func (p *deadlinePlugin) withDeadline(ctx context.Context, phase string, timeout time.Duration, timestamp types.ReportTimestamp, fn func(context.Context) error) error {
+	phaseCtx := ctx
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		phaseCtx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	phaseCtx, budget := deadlinebudget.New(phaseCtx)
+
+	err := fn(phaseCtx)
+
+	spent := budget.Spent()
+	for category, d := range spent {
+		promBudgetSpent.WithLabelValues(p.chainType, p.chainID, p.name, phase, string(category)).Observe(d.Seconds())
+	}
+	if err == nil || !errors.Is(phaseCtx.Err(), context.DeadlineExceeded) {
+		return err
+	}
+
+	// the deadline of the contract config applies to the parent context, the local deadline to the phase context only
+	deadline := "local"
+	if ctx.Err() != nil {
+		deadline = "contract"
+	}
+	promDeadlineExceeded.WithLabelValues(p.chainType, p.chainID, p.name, phase, deadline).Inc()
+	logger.Sugared(p.lggr).Errorw("Plugin exceeded its deadline, dropping "+phase,
+		"plugin", p.name, "phase", phase, "deadline", deadline, "localTimeout", timeout,
+		"epoch", timestamp.Epoch, "round", timestamp.Round,
+		"rpc", spent[deadlinebudget.RPC], "db", spent[deadlinebudget.DB], "computation", spent[deadlinebudget.Computation],
+		"err", err)
+	return err
+}
@@ -0,0 +1,82 @@
+package deadlinewrapper
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils/deadlinebudget"
+)
+
+type slowPlugin struct {
+	types.ReportingPlugin
+	rpcTime time.Duration
+	block   bool
+}
+
+func (p *slowPlugin) Observation(ctx context.Context, _ types.ReportTimestamp, _ types.Query) (types.Observation, error) {
+	deadlinebudget.Spend(ctx, deadlinebudget.RPC, p.rpcTime)
+	if p.block {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	return types.Observation("observation"), nil
+}
+
+func (p *slowPlugin) Report(ctx context.Context, _ types.ReportTimestamp, _ types.Query, _ []types.AttributedObservation) (bool, types.Report, error) {
+	if p.block {
+		<-ctx.Done()
+		return false, nil, ctx.Err()
+	}
+	return true, types.Report("report"), nil
+}
+
+func TestDeadlinePlugin(t *testing.T) {
+	t.Run("passes results through within the deadline", func(t *testing.T) {
+		plugin := New(&slowPlugin{rpcTime: time.Second}, logger.Test(t), "passthrough", "evm", "1", time.Minute, time.Minute)
+
+		obs, err := plugin.Observation(tests.Context(t), types.ReportTimestamp{}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, types.Observation("observation"), obs)
+
+		shouldReport, report, err := plugin.Report(tests.Context(t), types.ReportTimestamp{}, nil, nil)
+		require.NoError(t, err)
+		assert.True(t, shouldReport)
+		assert.Equal(t, types.Report("report"), report)
+
+		// one series per phase and category
+		assert.Equal(t, 6, testutil.CollectAndCount(promBudgetSpent))
+		assert.Equal(t, float64(0), testutil.ToFloat64(promDeadlineExceeded.WithLabelValues("evm", "1", "passthrough", phaseObservation, "local")))
+	})
+
+	t.Run("applies the local deadline", func(t *testing.T) {
+		plugin := New(&slowPlugin{block: true}, logger.Test(t), "local", "evm", "1", 10*time.Millisecond, 10*time.Millisecond)
+
+		_, err := plugin.Observation(tests.Context(t), types.ReportTimestamp{}, nil)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		_, _, err = plugin.Report(tests.Context(t), types.ReportTimestamp{}, nil, nil)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		assert.Equal(t, float64(1), testutil.ToFloat64(promDeadlineExceeded.WithLabelValues("evm", "1", "local", phaseObservation, "local")))
+		assert.Equal(t, float64(1), testutil.ToFloat64(promDeadlineExceeded.WithLabelValues("evm", "1", "local", phaseReport, "local")))
+	})
+
+	t.Run("reports the contract deadline", func(t *testing.T) {
+		plugin := New(&slowPlugin{block: true}, logger.Test(t), "contract", "evm", "1", 0, 0)
+
+		ctx, cancel := context.WithTimeout(tests.Context(t), 10*time.Millisecond)
+		defer cancel()
+		_, err := plugin.Observation(ctx, types.ReportTimestamp{}, nil)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		assert.Equal(t, float64(1), testutil.ToFloat64(promDeadlineExceeded.WithLabelValues("evm", "1", "contract", phaseObservation, "contract")))
+	})
+}
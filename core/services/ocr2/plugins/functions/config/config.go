@@ -51,6 +51,14 @@ type PluginConfig struct {
 	DecryptionQueueConfig                    *DecryptionQueueConfig                    `json:"decryptionQueueConfig"`
 	ExternalAdapterMaxRetries                *uint32                                   `json:"externalAdapterMaxRetries"`
 	ExternalAdapterExponentialBackoffBaseSec *uint32                                   `json:"externalAdapterExponentialBackoffBaseSec"`
+	SubscriptionQuotas                       *SubscriptionQuotasConfig                 `json:"subscriptionQuotas"`
+}
+
+// SubscriptionQuotasConfig holds the default quotas of subscriptions, which can be overridden per subscription.
+// A zero limit is not enforced.
+type SubscriptionQuotasConfig struct {
+	RequestsPerMinute    uint32 `json:"requestsPerMinute"`
+	MonthlyComputeMillis uint64 `json:"monthlyComputeMillis"`
 }
 
 type DecryptionQueueConfig struct {
@@ -29,9 +29,11 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/reportkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/functions/config"
 	s4_plugin "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/s4"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/threshold"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
 	evmrelayTypes "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/services/s4"
 )
@@ -49,6 +51,8 @@ type FunctionsServicesConfig struct {
 	EthKeystore       keystore.Eth
 	ThresholdKeyShare []byte
 	LogPollerWrapper  evmrelayTypes.LogPollerWrapper
+	// ReportEncryptionKey encrypts the reports of the functions plugin, which carry the results of user requests.
+	ReportEncryptionKey *reportkey.Key
 }
 
 const (
@@ -124,6 +128,11 @@ func NewFunctionsServices(ctx context.Context, functionsOracleArgs, thresholdOra
 	}
 	conf.Logger.Debugf("external adapter exponentialBackoffBase configured to: %g sec", exponentialBackoffBase.Seconds())
 
+	var quotas *functions.SubscriptionQuotas
+	if pluginConfig.SubscriptionQuotas != nil {
+		quotas = functions.NewSubscriptionQuotas(functions.NewQuotaORM(conf.DS, common.HexToAddress(conf.ContractID)), *pluginConfig.SubscriptionQuotas)
+	}
+
 	bridgeAccessor := functions.NewBridgeAccessor(conf.BridgeORM, FunctionsBridgeName, MaxAdapterResponseBytes, maxRetries, exponentialBackoffBase)
 	functionsListener := functions.NewFunctionsListener(
 		conf.Job,
@@ -137,6 +146,7 @@ func NewFunctionsServices(ctx context.Context, functionsOracleArgs, thresholdOra
 		conf.URLsMonEndpoint,
 		decryptor,
 		conf.LogPollerWrapper,
+		quotas,
 	)
 	allServices = append(allServices, functionsListener)
 
@@ -147,6 +157,9 @@ func NewFunctionsServices(ctx context.Context, functionsOracleArgs, thresholdOra
 		ContractVersion:     pluginConfig.ContractVersion,
 		OffchainTransmitter: offchainTransmitter,
 	}
+	if conf.ReportEncryptionKey != nil {
+		ocrcommon.EncryptReports(functionsOracleArgs, *conf.ReportEncryptionKey)
+	}
 	functionsReportingPluginOracle, err := libocr2.NewOracle(*functionsOracleArgs)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to call NewOracle to create a Functions Reporting Plugin")
@@ -21,6 +21,10 @@ type PluginConfig struct {
 	JuelsPerFeeCoinPipeline  string `json:"juelsPerFeeCoinSource"`
 	// JuelsPerFeeCoinCache is disabled when nil
 	JuelsPerFeeCoinCache *JuelsPerFeeCoinCache `json:"juelsPerFeeCoinCache"`
+	// SecondarySourcePipeline is an optional pipeline which must agree with the job pipeline within
+	// SecondarySourceMaxDeviation, a fraction of the job pipeline result, for the observation to be used.
+	SecondarySourcePipeline     string  `json:"secondarySource"`
+	SecondarySourceMaxDeviation float64 `json:"secondarySourceMaxDeviation"`
 }
 
 type JuelsPerFeeCoinCache struct {
@@ -45,6 +49,15 @@ func (config *PluginConfig) ValidatePluginConfig() error {
 		}
 	}
 
+	if config.HasSecondarySourcePipeline() {
+		if _, err := pipeline.Parse(config.SecondarySourcePipeline); err != nil {
+			return errors.Wrap(err, "invalid secondarySource pipeline")
+		}
+		if maxDeviation := config.SecondarySourceMaxDeviation; maxDeviation <= 0 || maxDeviation >= 1 {
+			return errors.Errorf("secondarySourceMaxDeviation: %v must be above 0 and below 1", maxDeviation)
+		}
+	}
+
 	// Gas price pipeline is optional
 	if !config.HasGasPriceSubunitsPipeline() {
 		return nil
@@ -58,3 +71,7 @@ func (config *PluginConfig) ValidatePluginConfig() error {
 func (config *PluginConfig) HasGasPriceSubunitsPipeline() bool {
 	return strings.TrimSpace(config.GasPriceSubunitsPipeline) != ""
 }
+
+func (config *PluginConfig) HasSecondarySourcePipeline() bool {
+	return strings.TrimSpace(config.SecondarySourcePipeline) != ""
+}
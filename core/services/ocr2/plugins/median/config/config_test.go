@@ -43,6 +43,30 @@ func TestValidatePluginConfig(t *testing.T) {
 		}
 	})
 
+	t.Run("secondary source validation", func(t *testing.T) {
+		const validPipeline = `ds1 [type=bridge name=voter_turnout];`
+		for _, tc := range []struct {
+			name          string
+			pipeline      string
+			maxDeviation  float64
+			expectedError string
+		}{
+			{"invalid pipeline", "foo", 0.01, "invalid secondarySource pipeline: UnmarshalTaskFromMap: unknown task type: \"\""},
+			{"missing max deviation", validPipeline, 0, "secondarySourceMaxDeviation: 0 must be above 0 and below 1"},
+			{"max deviation of 1", validPipeline, 1, "secondarySourceMaxDeviation: 1 must be above 0 and below 1"},
+			{"valid", validPipeline, 0.01, ""},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				pc := PluginConfig{JuelsPerFeeCoinPipeline: validPipeline, SecondarySourcePipeline: tc.pipeline, SecondarySourceMaxDeviation: tc.maxDeviation}
+				if tc.expectedError == "" {
+					assert.NoError(t, pc.ValidatePluginConfig())
+				} else {
+					assert.EqualError(t, pc.ValidatePluginConfig(), tc.expectedError)
+				}
+			})
+		}
+	})
+
 	t.Run("valid values", func(t *testing.T) {
 		for _, s := range []testCase{
 			{"valid 0 cache duration and valid pipeline", `ds1 [type=bridge name=voter_turnout];`, 0, nil},
@@ -17,7 +17,10 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/deadlinewrapper"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/median/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/promwrapper"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/transmitschedule"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/plugins"
@@ -63,7 +66,7 @@ func NewMedianServices(ctx context.Context,
 	cfg MedianConfig,
 	chEnhancedTelem chan ocrcommon.EnhancedTelemetryData,
 	errorLog loop.ErrorLog,
-
+	drainGate *ocrcommon.DrainGate,
 ) (srvs []job.ServiceCtx, err error) {
 	var pluginConfig config.PluginConfig
 	err = json.Unmarshal(jb.OCR2OracleSpec.PluginConfig.Bytes(), &pluginConfig)
@@ -104,7 +107,7 @@ func NewMedianServices(ctx context.Context,
 	}
 
 	srvs = append(srvs, provider)
-	argsNoPlugin.ContractTransmitter = provider.ContractTransmitter()
+	argsNoPlugin.ContractTransmitter = drainGate.ContractTransmitter(provider.ContractTransmitter())
 	argsNoPlugin.ContractConfigTracker = provider.ContractConfigTracker()
 	argsNoPlugin.OffchainConfigDigester = provider.OffchainConfigDigester()
 
@@ -121,6 +124,16 @@ func NewMedianServices(ctx context.Context,
 		runSaver,
 		chEnhancedTelem)
 
+	if pluginConfig.HasSecondarySourcePipeline() {
+		lggr.Infow("Observations are checked against a secondary data source", "maxDeviation", pluginConfig.SecondarySourceMaxDeviation)
+		secondarySource := ocrcommon.NewInMemoryDataSource(pipelineRunner, jb, pipeline.Spec{
+			ID:           jb.ID,
+			DotDagSource: pluginConfig.SecondarySourcePipeline,
+			CreatedAt:    time.Now(),
+		}, lggr)
+		dataSource = ocrcommon.NewConsensusDataSource(dataSource, secondarySource, pluginConfig.SecondarySourceMaxDeviation, jb, lggr)
+	}
+
 	juelsPerFeeCoinSource := ocrcommon.NewInMemoryDataSource(pipelineRunner, jb, pipeline.Spec{
 		ID:           jb.ID,
 		DotDagSource: pluginConfig.JuelsPerFeeCoinPipeline,
@@ -179,13 +192,27 @@ func NewMedianServices(ctx context.Context,
 		}
 	}
 
+	argsNoPlugin.ReportingPluginFactory = promwrapper.NewPromFactory(argsNoPlugin.ReportingPluginFactory, "Median", spec.Relay, spec.ChainID, jb.ID)
+	argsNoPlugin.ReportingPluginFactory = deadlinewrapper.NewDeadlineFactory(argsNoPlugin.ReportingPluginFactory, lggr, "Median", spec.Relay, spec.ChainID,
+		spec.ObservationTimeout.Duration(), spec.ReportTimeout.Duration())
+	scheduleCfg, err := transmitschedule.ParseConfig(spec.TransmissionSchedule)
+	if err != nil {
+		abort()
+		return
+	}
+	argsNoPlugin.ReportingPluginFactory = transmitschedule.NewScheduleFactory(argsNoPlugin.ReportingPluginFactory, lggr, scheduleCfg, "Median", spec.Relay, spec.ChainID)
+
 	var oracle libocr.Oracle
 	oracle, err = libocr.NewOracle(argsNoPlugin)
 	if err != nil {
 		abort()
 		return
 	}
-	srvs = append(srvs, runSaver, job.NewServiceAdapter(oracle))
+	var oracleService job.ServiceCtx = job.NewServiceAdapter(oracle)
+	if spec.Standby {
+		oracleService = job.NewStandbyService(lggr, oracleService)
+	}
+	srvs = append(srvs, runSaver, job.NewOracleService(fmt.Sprintf("OCR2.%d.Median", jb.ID), oracleService))
 	if !jb.OCR2OracleSpec.CaptureEATelemetry {
 		lggr.Infof("Enhanced EA telemetry is disabled for job %s", jb.Name.ValueOrZero())
 	}
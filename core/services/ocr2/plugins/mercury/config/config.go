@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net/url"
 	"regexp"
+	"slices"
 	"sort"
 
 	pkgerrors "github.com/pkg/errors"
@@ -18,8 +19,8 @@ import (
 )
 
 type PluginConfig struct {
-	// Must either specify details for single server OR multiple servers.
-	// Specifying both is not valid.
+	// Must either specify details for single server OR multiple servers OR a server pool.
+	// Specifying more than one is not valid.
 
 	// Single mercury server
 	// LEGACY: This is the old way of specifying a mercury server
@@ -30,6 +31,12 @@ type PluginConfig struct {
 	// This is the preferred way to specify mercury server(s)
 	Servers map[string]utils.PlainHexBytes `json:"servers" toml:"servers"`
 
+	// Mercury server pool
+	// Unlike Servers, which each receive every report, reports are sent to
+	// one server of the pool at a time, failing over to the next server by
+	// weight when a server is unreachable.
+	ServerPool []PoolServer `json:"serverPool" toml:"serverPool"`
+
 	// InitialBlockNumber allows to set a custom "validFromBlockNumber" for
 	// the first ever report in the case of a brand new feed, where the mercury
 	// server does not have any previous reports. For a brand new feed, this
@@ -62,6 +69,39 @@ type Server struct {
 	PubKey utils.PlainHexBytes
 }
 
+// PoolServer is a member of a mercury server pool.
+type PoolServer struct {
+	URL    string              `json:"url" toml:"url"`
+	PubKey utils.PlainHexBytes `json:"pubKey" toml:"pubKey"`
+	// Weight orders the servers of the pool for failover, highest first.
+	Weight uint32 `json:"weight" toml:"weight"`
+	// FeedIDs routes only these feeds to the server. If empty, all feeds are routed to the server.
+	FeedIDs []mercuryutils.FeedID `json:"feedIDs" toml:"feedIDs"`
+}
+
+// Routes returns true if reports for feedID may be sent to s.
+func (s PoolServer) Routes(feedID mercuryutils.FeedID) bool {
+	return len(s.FeedIDs) == 0 || slices.Contains(s.FeedIDs, feedID)
+}
+
+// GetPoolServers returns the servers of the pool which feedID is routed to,
+// in failover order.
+func (p PluginConfig) GetPoolServers(feedID mercuryutils.FeedID) (servers []PoolServer) {
+	for _, s := range p.ServerPool {
+		if s.Routes(feedID) {
+			s.URL = wssRegexp.ReplaceAllString(s.URL, "")
+			servers = append(servers, s)
+		}
+	}
+	sort.SliceStable(servers, func(i, j int) bool {
+		if servers[i].Weight == servers[j].Weight {
+			return servers[i].URL < servers[j].URL
+		}
+		return servers[i].Weight > servers[j].Weight
+	})
+	return
+}
+
 func (p PluginConfig) GetServers() (servers []Server) {
 	if p.RawServerURL != "" {
 		return []Server{{URL: wssRegexp.ReplaceAllString(p.RawServerURL, ""), PubKey: p.ServerPubKey}}
@@ -76,7 +116,13 @@ func (p PluginConfig) GetServers() (servers []Server) {
 }
 
 func ValidatePluginConfig(config PluginConfig, feedID mercuryutils.FeedID) (merr error) {
-	if len(config.Servers) > 0 {
+	if len(config.ServerPool) > 0 {
+		if len(config.Servers) > 0 || config.RawServerURL != "" || len(config.ServerPubKey) != 0 {
+			merr = errors.Join(merr, errors.New("Mercury: ServerPool may not be specified together with Servers or RawServerURL/ServerPubKey"))
+		} else {
+			merr = errors.Join(merr, validateServerPool(config, feedID))
+		}
+	} else if len(config.Servers) > 0 {
 		if config.RawServerURL != "" || len(config.ServerPubKey) != 0 {
 			merr = errors.Join(merr, errors.New("Mercury: Servers and RawServerURL/ServerPubKey may not be specified together"))
 		} else {
@@ -125,5 +171,26 @@ func ValidatePluginConfig(config PluginConfig, feedID mercuryutils.FeedID) (merr
 	return merr
 }
 
+func validateServerPool(config PluginConfig, feedID mercuryutils.FeedID) (merr error) {
+	urls := make(map[string]struct{}, len(config.ServerPool))
+	for _, s := range config.ServerPool {
+		if err := validateURL(s.URL); err != nil {
+			merr = errors.Join(merr, pkgerrors.Wrap(err, "Mercury: invalid value for ServerPool URL"))
+		}
+		url := wssRegexp.ReplaceAllString(s.URL, "")
+		if _, ok := urls[url]; ok {
+			merr = errors.Join(merr, fmt.Errorf("Mercury: duplicate ServerPool URL %q", s.URL))
+		}
+		urls[url] = struct{}{}
+		if len(s.PubKey) != 32 {
+			merr = errors.Join(merr, fmt.Errorf("Mercury: ServerPool pubKey for %q must be a 32-byte hex string", s.URL))
+		}
+	}
+	if len(config.GetPoolServers(feedID)) == 0 {
+		merr = errors.Join(merr, fmt.Errorf("Mercury: no server in ServerPool routes feed %s", feedID))
+	}
+	return merr
+}
+
 var schemeRegexp = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9+.-]*://`)
 var wssRegexp = regexp.MustCompile(`^wss://`)
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	mercuryutils "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/utils"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
@@ -74,6 +75,79 @@ func Test_PluginConfig(t *testing.T) {
 			})
 		})
 
+		t.Run("with a server pool", func(t *testing.T) {
+			t.Run("with valid values", func(t *testing.T) {
+				rawToml := `
+					[[serverPool]]
+					url = "example.com:80"
+					pubKey = "724ff6eae9e900270edfff233e16322a70ec06e1a6e62a81ef13921f398f6c93"
+					weight = 10
+
+					[[serverPool]]
+					url = "wss://example2.invalid:1234"
+					pubKey = "524ff6eae9e900270edfff233e16322a70ec06e1a6e62a81ef13921f398f6c93"
+					feedIDs = ["0x00016b4aa7e57ca7b68ae1bf45653f56b656fd3aa335ef7fae696b663f1b8472"]
+			`
+
+				var mc PluginConfig
+				err := toml.Unmarshal([]byte(rawToml), &mc)
+				require.NoError(t, err)
+
+				require.Len(t, mc.ServerPool, 2)
+				assert.Equal(t, uint32(10), mc.ServerPool[0].Weight)
+				require.Len(t, mc.ServerPool[1].FeedIDs, 1)
+				assert.Equal(t, v1FeedId, [32]byte(mc.ServerPool[1].FeedIDs[0]))
+
+				err = ValidatePluginConfig(mc, v1FeedId)
+				require.NoError(t, err)
+			})
+			t.Run("if Servers or ServerURL is specified", func(t *testing.T) {
+				rawToml := `
+					ServerURL = "example.com:80"
+					[[serverPool]]
+					url = "example.com:80"
+					pubKey = "724ff6eae9e900270edfff233e16322a70ec06e1a6e62a81ef13921f398f6c93"
+			`
+				var mc PluginConfig
+				err := toml.Unmarshal([]byte(rawToml), &mc)
+				require.NoError(t, err)
+
+				err = ValidatePluginConfig(mc, v1FeedId)
+				require.EqualError(t, err, "Mercury: ServerPool may not be specified together with Servers or RawServerURL/ServerPubKey")
+			})
+			t.Run("with invalid values", func(t *testing.T) {
+				rawToml := `
+					[[serverPool]]
+					url = "http://example.com"
+					pubKey = "4242"
+
+					[[serverPool]]
+					url = "wss://example.com"
+					pubKey = "724ff6eae9e900270edfff233e16322a70ec06e1a6e62a81ef13921f398f6c93"
+					feedIDs = ["0x00026b4aa7e57ca7b68ae1bf45653f56b656fd3aa335ef7fae696b663f1b8472"]
+
+					[[serverPool]]
+					url = "example.com"
+					pubKey = "724ff6eae9e900270edfff233e16322a70ec06e1a6e62a81ef13921f398f6c93"
+					feedIDs = ["0x00026b4aa7e57ca7b68ae1bf45653f56b656fd3aa335ef7fae696b663f1b8472"]
+			`
+				var mc PluginConfig
+				err := toml.Unmarshal([]byte(rawToml), &mc)
+				require.NoError(t, err)
+
+				err = ValidatePluginConfig(mc, v1FeedId)
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), `Mercury: invalid scheme specified for MercuryServer, got: "http://example.com"`)
+				assert.Contains(t, err.Error(), `Mercury: ServerPool pubKey for "http://example.com" must be a 32-byte hex string`)
+				assert.Contains(t, err.Error(), `Mercury: duplicate ServerPool URL "example.com"`)
+				assert.NotContains(t, err.Error(), "no server in ServerPool routes feed")
+
+				mc.ServerPool = mc.ServerPool[1:]
+				err = ValidatePluginConfig(mc, v1FeedId)
+				assert.Contains(t, err.Error(), "Mercury: no server in ServerPool routes feed 0x00016b4aa7e57ca7b68ae1bf45653f56b656fd3aa335ef7fae696b663f1b8472")
+			})
+		})
+
 		t.Run("with invalid values", func(t *testing.T) {
 			rawToml := `
 				InitialBlockNumber = "invalid"
@@ -176,6 +250,27 @@ func Test_PluginConfig(t *testing.T) {
 	})
 }
 
+func Test_PluginConfig_GetPoolServers(t *testing.T) {
+	pc := PluginConfig{ServerPool: []PoolServer{
+		{URL: "wss://c.example.com", Weight: 1},
+		{URL: "b.example.com", Weight: 5, FeedIDs: []mercuryutils.FeedID{v2FeedId}},
+		{URL: "a.example.com", Weight: 1},
+		{URL: "d.example.com", Weight: 10},
+	}}
+
+	var urls []string
+	for _, s := range pc.GetPoolServers(v1FeedId) {
+		urls = append(urls, s.URL)
+	}
+	assert.Equal(t, []string{"d.example.com", "a.example.com", "c.example.com"}, urls)
+
+	urls = nil
+	for _, s := range pc.GetPoolServers(v2FeedId) {
+		urls = append(urls, s.URL)
+	}
+	assert.Equal(t, []string{"d.example.com", "b.example.com", "a.example.com", "c.example.com"}, urls)
+}
+
 func Test_PluginConfig_GetServers(t *testing.T) {
 	t.Run("with single server", func(t *testing.T) {
 		pubKey := utils.PlainHexBytes([]byte{1, 2, 3})
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/logprovider"
 )
 
 type Duration time.Duration
@@ -60,6 +62,11 @@ type PluginConfig struct {
 	ContractVersion string `json:"contractVersion"`
 	// CaptureAutomationCustomTelemetry is a bool flag to toggle Custom Telemetry Service
 	CaptureAutomationCustomTelemetry *bool `json:"captureAutomationCustomTelemetry,omitempty"`
+	// CCIPTriggers are log upkeeps triggered by CCIP messages sent from another
+	// chain of the node. Their log trigger must be the ReportAccepted event of the
+	// lane's CommitStore, of which only the logs committing a matching message
+	// are delivered. Only supported by v2.1+ registries.
+	CCIPTriggers []logprovider.CCIPTriggerSpec `json:"ccipTriggers,omitempty"`
 }
 
 func ValidatePluginConfig(cfg PluginConfig) error {
@@ -83,5 +90,18 @@ func ValidatePluginConfig(cfg PluginConfig) error {
 		return fmt.Errorf("service queue length cannot be less than zero")
 	}
 
+	upkeepIDs := make(map[string]struct{}, len(cfg.CCIPTriggers))
+	for _, spec := range cfg.CCIPTriggers {
+		trigger, err := spec.Config()
+		if err != nil {
+			return fmt.Errorf("invalid CCIP trigger: %w", err)
+		}
+		id := trigger.UpkeepID.String()
+		if _, ok := upkeepIDs[id]; ok {
+			return fmt.Errorf("duplicate CCIP trigger for upkeep %s", id)
+		}
+		upkeepIDs[id] = struct{}{}
+	}
+
 	return nil
 }
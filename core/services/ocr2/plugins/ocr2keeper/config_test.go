@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestUnmarshalDuration(t *testing.T) {
@@ -28,3 +29,30 @@ func TestUnmarshalConfig(t *testing.T) {
 	assert.Equal(t, 2*time.Second, config.CacheExpiration.Value())
 	assert.Equal(t, 42, config.MaxServiceWorkers)
 }
+
+func TestValidatePluginConfig_CCIPTriggers(t *testing.T) {
+	tests := []struct {
+		name   string
+		raw    string
+		errMsg string
+	}{
+		{"valid", `{"ccipTriggers":[{"upkeepID":"123","sourceChainSelector":5009297550715157269,"sender":"0x8Cd4e6D5d4b9E4B3C7e1E69bC2A8B1d2F4D9a1E7","messageIDPattern":"^0x00"}]}`, ""},
+		{"invalid upkeep ID", `{"ccipTriggers":[{"upkeepID":"0x7b","sourceChainSelector":5009297550715157269}]}`, "invalid upkeepID"},
+		{"unknown chain selector", `{"ccipTriggers":[{"upkeepID":"123","sourceChainSelector":1}]}`, "invalid sourceChainSelector"},
+		{"invalid sender", `{"ccipTriggers":[{"upkeepID":"123","sourceChainSelector":5009297550715157269,"sender":"0x1"}]}`, "invalid sender"},
+		{"invalid pattern", `{"ccipTriggers":[{"upkeepID":"123","sourceChainSelector":5009297550715157269,"messageIDPattern":"("}]}`, "invalid messageIDPattern"},
+		{"duplicate upkeep", `{"ccipTriggers":[{"upkeepID":"123","sourceChainSelector":5009297550715157269},{"upkeepID":"123","sourceChainSelector":5009297550715157269}]}`, "duplicate CCIP trigger"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var config PluginConfig
+			require.NoError(t, json.Unmarshal([]byte(tt.raw), &config))
+			err := ValidatePluginConfig(config)
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.errMsg)
+			}
+		})
+	}
+}
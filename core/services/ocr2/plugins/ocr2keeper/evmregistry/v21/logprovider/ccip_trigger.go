@@ -0,0 +1,428 @@
+package logprovider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	chainsel "github.com/smartcontractkit/chain-selectors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/commit_store"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_onramp"
+)
+
+// ccipSendRequestSeqNumIndex is the index of the sequence number in the data words of a CCIPSendRequested log.
+const ccipSendRequestSeqNumIndex = 4
+
+var (
+	ccipReportAcceptedSig   = commit_store.CommitStoreReportAccepted{}.Topic()
+	ccipSendRequestedSig    = evm_2_evm_onramp.EVM2EVMOnRampCCIPSendRequested{}.Topic()
+	ccipCommitStoreFilterer = must(commit_store.NewCommitStoreFilterer(common.Address{}, nil))
+	ccipOnRampFilterer      = must(evm_2_evm_onramp.NewEVM2EVMOnRampFilterer(common.Address{}, nil))
+)
+
+func must[T any](t T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// CCIPTriggerConfig configures an upkeep to be triggered when a matching CCIP message is committed on the chain of
+// the registry. The log trigger of the upkeep must be the ReportAccepted event of the lane's CommitStore. Its logs are
+// only delivered to the upkeep if they commit at least one matching message.
+type CCIPTriggerConfig struct {
+	UpkeepID            *big.Int
+	SourceChainSelector uint64
+	// Sender matches any sender if nil.
+	Sender *common.Address
+	// MessageIDPattern is matched against the hex encoded message ID, and matches any message if nil.
+	MessageIDPattern *regexp.Regexp
+}
+
+// CCIPTriggerSpec is the job spec form of a CCIPTriggerConfig.
+type CCIPTriggerSpec struct {
+	// UpkeepID is the decimal ID of the upkeep.
+	UpkeepID            string `json:"upkeepID"`
+	SourceChainSelector uint64 `json:"sourceChainSelector"`
+	Sender              string `json:"sender,omitempty"`
+	MessageIDPattern    string `json:"messageIDPattern,omitempty"`
+}
+
+// Config validates s and returns its CCIPTriggerConfig.
+func (s CCIPTriggerSpec) Config() (cfg CCIPTriggerConfig, err error) {
+	var ok bool
+	if cfg.UpkeepID, ok = new(big.Int).SetString(s.UpkeepID, 10); !ok {
+		return cfg, fmt.Errorf("invalid upkeepID %q", s.UpkeepID)
+	}
+	if _, err = chainsel.ChainIdFromSelector(s.SourceChainSelector); err != nil {
+		return cfg, fmt.Errorf("invalid sourceChainSelector for upkeep %s: %w", s.UpkeepID, err)
+	}
+	cfg.SourceChainSelector = s.SourceChainSelector
+	if s.Sender != "" {
+		if !common.IsHexAddress(s.Sender) {
+			return cfg, fmt.Errorf("invalid sender %q for upkeep %s", s.Sender, s.UpkeepID)
+		}
+		sender := common.HexToAddress(s.Sender)
+		cfg.Sender = &sender
+	}
+	if s.MessageIDPattern != "" {
+		if cfg.MessageIDPattern, err = regexp.Compile(s.MessageIDPattern); err != nil {
+			return cfg, fmt.Errorf("invalid messageIDPattern for upkeep %s: %w", s.UpkeepID, err)
+		}
+	}
+	return cfg, nil
+}
+
+// CCIPMessage is a CCIP message committed on the destination chain.
+type CCIPMessage struct {
+	SourceChainSelector uint64
+	SequenceNumber      uint64
+	MessageID           [32]byte
+	Sender              common.Address
+}
+
+// Matches returns true if msg matches the filter of c.
+func (c CCIPTriggerConfig) Matches(msg CCIPMessage) bool {
+	if msg.SourceChainSelector != c.SourceChainSelector {
+		return false
+	}
+	if c.Sender != nil && msg.Sender != *c.Sender {
+		return false
+	}
+	if c.MessageIDPattern != nil && !c.MessageIDPattern.MatchString(hexutil.Encode(msg.MessageID[:])) {
+		return false
+	}
+	return true
+}
+
+// CCIPMessageResolver resolves the messages committed by a CommitStore ReportAccepted log, from the messages of the
+// lanes registered by the upkeeps they trigger.
+type CCIPMessageResolver interface {
+	// RegisterLane starts reading the messages of the lane of commitStore for upkeepID, from the source chain block at
+	// the time of triggerBlock, the dest chain block of the log trigger config of the upkeep.
+	RegisterLane(ctx context.Context, upkeepID string, commitStore common.Address, triggerBlock uint64) error
+	// UnregisterLane stops reading the messages of the lane of upkeepID, once no other upkeep is triggered by them.
+	UnregisterLane(ctx context.Context, upkeepID string) error
+	CommittedMessages(ctx context.Context, log logpoller.Log) ([]CCIPMessage, error)
+}
+
+// CCIPTriggers selects the logs of the upkeeps triggered by CCIP messages.
+type CCIPTriggers struct {
+	lggr     logger.Logger
+	resolver CCIPMessageResolver
+	configs  map[string]CCIPTriggerConfig
+}
+
+func NewCCIPTriggers(lggr logger.Logger, resolver CCIPMessageResolver, configs ...CCIPTriggerConfig) *CCIPTriggers {
+	t := &CCIPTriggers{
+		lggr:     logger.Named(lggr, "CCIPTriggers"),
+		resolver: resolver,
+		configs:  make(map[string]CCIPTriggerConfig, len(configs)),
+	}
+	for _, cfg := range configs {
+		t.configs[cfg.UpkeepID.String()] = cfg
+	}
+	return t
+}
+
+// RegisterUpkeep registers the lane of the CCIP trigger of upkeepID, whose log trigger is the ReportAccepted event of
+// commitStore, configured at triggerBlock. It does nothing for the upkeeps without a CCIP trigger, and is safe to call
+// on a nil CCIPTriggers.
+func (t *CCIPTriggers) RegisterUpkeep(ctx context.Context, upkeepID *big.Int, commitStore common.Address, triggerBlock uint64) error {
+	if t == nil {
+		return nil
+	}
+	if _, ok := t.configs[upkeepID.String()]; !ok {
+		return nil
+	}
+	return t.resolver.RegisterLane(ctx, upkeepID.String(), commitStore, triggerBlock)
+}
+
+// UnregisterUpkeep unregisters the lane of the CCIP trigger of upkeepID. It does nothing for the upkeeps without a CCIP
+// trigger, and is safe to call on a nil CCIPTriggers.
+func (t *CCIPTriggers) UnregisterUpkeep(ctx context.Context, upkeepID *big.Int) error {
+	if t == nil {
+		return nil
+	}
+	if _, ok := t.configs[upkeepID.String()]; !ok {
+		return nil
+	}
+	return t.resolver.UnregisterLane(ctx, upkeepID.String())
+}
+
+// Select returns the logs which commit a message matching the CCIP trigger of upkeepID. The logs of upkeeps without a
+// CCIP trigger are returned as they are. It is safe to call on a nil CCIPTriggers.
+func (t *CCIPTriggers) Select(ctx context.Context, upkeepID *big.Int, logs ...logpoller.Log) []logpoller.Log {
+	if t == nil || len(logs) == 0 {
+		return logs
+	}
+	cfg, ok := t.configs[upkeepID.String()]
+	if !ok {
+		return logs
+	}
+	var selected []logpoller.Log
+	for _, log := range logs {
+		if log.EventSig != ccipReportAcceptedSig {
+			continue
+		}
+		msgs, err := t.resolver.CommittedMessages(ctx, log)
+		if err != nil {
+			// the log is read again within the reorg buffer, or else recovered
+			t.lggr.Warnw("Failed to resolve committed CCIP messages", "upkeepID", upkeepID.String(), "txHash", log.TxHash, "err", err)
+			continue
+		}
+		for _, msg := range msgs {
+			if cfg.Matches(msg) {
+				selected = append(selected, log)
+				break
+			}
+		}
+	}
+	return selected
+}
+
+type ccipLane struct {
+	sourceChainSelector uint64
+	onRamp              common.Address
+	poller              logpoller.LogPoller
+	client              client.Client
+	filterName          string
+	// fromBlock is the first source chain block the messages of the lane are read from.
+	fromBlock int64
+	upkeeps   map[string]struct{}
+}
+
+// CCIPSourceChain is the source chain of a lane, on which its messages are sent.
+type CCIPSourceChain struct {
+	Poller logpoller.LogPoller
+	Client client.Client
+}
+
+// ccipMessageResolver resolves committed messages from the CCIPSendRequested logs of the lane's OnRamp, which are read
+// from the source chain.
+type ccipMessageResolver struct {
+	lggr         logger.Logger
+	client       client.Client
+	sourceChains func(chainID string) (CCIPSourceChain, error)
+
+	mu    sync.Mutex
+	lanes map[common.Address]*ccipLane // by CommitStore
+	// upkeepLanes are the CommitStores of the lanes registered by each upkeep.
+	upkeepLanes map[string]common.Address
+}
+
+// NewCCIPMessageResolver returns a CCIPMessageResolver reading CommitStore configs and blocks with c, and the messages
+// of each lane from its source chain, which is returned by sourceChains.
+func NewCCIPMessageResolver(lggr logger.Logger, c client.Client, sourceChains func(chainID string) (CCIPSourceChain, error)) CCIPMessageResolver {
+	return &ccipMessageResolver{
+		lggr:         logger.Named(lggr, "CCIPMessageResolver"),
+		client:       c,
+		sourceChains: sourceChains,
+		lanes:        make(map[common.Address]*ccipLane),
+		upkeepLanes:  make(map[string]common.Address),
+	}
+}
+
+func (r *ccipMessageResolver) RegisterLane(ctx context.Context, upkeepID string, commitStore common.Address, triggerBlock uint64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if prev, ok := r.upkeepLanes[upkeepID]; ok && prev != commitStore {
+		// the log trigger of the upkeep moved to another lane
+		if err := r.unregisterLane(ctx, upkeepID); err != nil {
+			return err
+		}
+	}
+	l, ok := r.lanes[commitStore]
+	if !ok {
+		var err error
+		if l, err = r.newLane(ctx, commitStore); err != nil {
+			return err
+		}
+		r.lanes[commitStore] = l
+	}
+	l.upkeeps[upkeepID] = struct{}{}
+	r.upkeepLanes[upkeepID] = commitStore
+
+	fromBlock, err := r.sourceBlockAt(ctx, l, triggerBlock)
+	if err != nil {
+		return fmt.Errorf("failed to find the source chain block of trigger block %d: %w", triggerBlock, err)
+	}
+	if l.fromBlock == 0 || fromBlock < l.fromBlock {
+		r.lggr.Debugw("Replaying the messages of the lane", "upkeepID", upkeepID, "commitStore", commitStore, "onRamp", l.onRamp, "fromBlock", fromBlock)
+		l.poller.ReplayAsync(fromBlock)
+		l.fromBlock = fromBlock
+	}
+	return nil
+}
+
+// newLane returns the lane of commitStore, with a filter for its OnRamp registered on the source chain.
+func (r *ccipMessageResolver) newLane(ctx context.Context, commitStore common.Address) (*ccipLane, error) {
+	caller, err := commit_store.NewCommitStoreCaller(commitStore, r.client)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := caller.GetStaticConfig(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get static config of CommitStore %s: %w", commitStore, err)
+	}
+	chainID, err := chainsel.ChainIdFromSelector(cfg.SourceChainSelector)
+	if err != nil {
+		return nil, err
+	}
+	source, err := r.sourceChains(strconv.FormatUint(chainID, 10))
+	if err != nil {
+		return nil, fmt.Errorf("source chain %d of CommitStore %s is not available: %w", chainID, commitStore, err)
+	}
+	l := &ccipLane{
+		sourceChainSelector: cfg.SourceChainSelector,
+		onRamp:              cfg.OnRamp,
+		poller:              source.Poller,
+		client:              source.Client,
+		filterName:          logpoller.FilterName("AutomationCCIPTrigger", cfg.OnRamp),
+		upkeeps:             make(map[string]struct{}),
+	}
+	if source.Poller.HasFilter(l.filterName) {
+		// already registered in DB before, no need to replay
+		l.fromBlock = 1
+	}
+	err = source.Poller.RegisterFilter(ctx, logpoller.Filter{
+		Name:      l.filterName,
+		EventSigs: []common.Hash{ccipSendRequestedSig},
+		Addresses: []common.Address{cfg.OnRamp},
+		Retention: LogRetention,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// sourceBlockAt returns the block of the source chain of l to read the messages of an upkeep from: the block at the time
+// of the dest chain block triggerBlock, less the LogBackfillBuffer, as the messages committed after the trigger block
+// may have been sent before it.
+func (r *ccipMessageResolver) sourceBlockAt(ctx context.Context, l *ccipLane, triggerBlock uint64) (int64, error) {
+	at := time.Now()
+	if triggerBlock > 0 {
+		head, err := r.client.HeadByNumber(ctx, new(big.Int).SetUint64(triggerBlock))
+		if err != nil {
+			return 0, err
+		}
+		if head == nil {
+			return 0, fmt.Errorf("block %d not found", triggerBlock)
+		}
+		at = head.Timestamp
+	}
+	block, err := blockAt(ctx, l.client, at)
+	if err != nil {
+		return 0, err
+	}
+	return max(block-int64(LogBackfillBuffer), 1), nil
+}
+
+// blockAt returns the number of the last block of the chain of c mined at or before t, or 1 if t is before it.
+func blockAt(ctx context.Context, c client.Client, t time.Time) (int64, error) {
+	latest, err := c.HeadByNumber(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	if latest == nil {
+		return 0, errors.New("latest block not found")
+	}
+	if !latest.Timestamp.After(t) {
+		return latest.Number, nil
+	}
+	lo, hi := int64(1), latest.Number-1
+	for lo < hi {
+		mid := lo + (hi-lo+1)/2
+		head, err2 := c.HeadByNumber(ctx, big.NewInt(mid))
+		if err2 != nil {
+			return 0, err2
+		}
+		if head == nil {
+			return 0, fmt.Errorf("block %d not found", mid)
+		}
+		if head.Timestamp.After(t) {
+			hi = mid - 1
+		} else {
+			lo = mid
+		}
+	}
+	return lo, nil
+}
+
+func (r *ccipMessageResolver) UnregisterLane(ctx context.Context, upkeepID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.unregisterLane(ctx, upkeepID)
+}
+
+func (r *ccipMessageResolver) unregisterLane(ctx context.Context, upkeepID string) error {
+	commitStore, ok := r.upkeepLanes[upkeepID]
+	if !ok {
+		return nil
+	}
+	l := r.lanes[commitStore]
+	delete(l.upkeeps, upkeepID)
+	delete(r.upkeepLanes, upkeepID)
+	if len(l.upkeeps) > 0 {
+		return nil
+	}
+	delete(r.lanes, commitStore)
+	if l.poller.HasFilter(l.filterName) {
+		if err := l.poller.UnregisterFilter(ctx, l.filterName); err != nil {
+			return fmt.Errorf("failed to unregister the filter of OnRamp %s: %w", l.onRamp, err)
+		}
+	}
+	return nil
+}
+
+func (r *ccipMessageResolver) CommittedMessages(ctx context.Context, log logpoller.Log) ([]CCIPMessage, error) {
+	r.mu.Lock()
+	l, ok := r.lanes[log.Address]
+	r.mu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("no upkeep registered the lane of CommitStore %s", log.Address)
+	}
+	accepted, err := ccipCommitStoreFilterer.ParseReportAccepted(log.ToGethLog())
+	if err != nil {
+		return nil, err
+	}
+	interval := accepted.Report.Interval
+	sent, err := l.poller.LogsDataWordRange(ctx, ccipSendRequestedSig, l.onRamp, ccipSendRequestSeqNumIndex,
+		logpoller.EvmWord(interval.Min), logpoller.EvmWord(interval.Max), evmtypes.Finalized)
+	if err != nil {
+		return nil, err
+	}
+	if want := interval.Max - interval.Min + 1; uint64(len(sent)) < want {
+		return nil, fmt.Errorf("found %d of %d committed messages on source chain", len(sent), want)
+	}
+	msgs := make([]CCIPMessage, 0, len(sent))
+	for _, s := range sent {
+		req, err2 := ccipOnRampFilterer.ParseCCIPSendRequested(s.ToGethLog())
+		if err2 != nil {
+			return nil, err2
+		}
+		msgs = append(msgs, CCIPMessage{
+			SourceChainSelector: l.sourceChainSelector,
+			SequenceNumber:      req.Message.SequenceNumber,
+			MessageID:           req.Message.MessageId,
+			Sender:              req.Message.Sender,
+		})
+	}
+	return msgs, nil
+}
@@ -0,0 +1,181 @@
+package logprovider
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	chainsel "github.com/smartcontractkit/chain-selectors"
+
+	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	lpmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller/mocks"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/commit_store"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+type fakeCCIPMessageResolver struct {
+	msgs  map[common.Hash][]CCIPMessage
+	lanes map[string]common.Address
+}
+
+func (r *fakeCCIPMessageResolver) RegisterLane(_ context.Context, upkeepID string, commitStore common.Address, _ uint64) error {
+	r.lanes[upkeepID] = commitStore
+	return nil
+}
+
+func (r *fakeCCIPMessageResolver) UnregisterLane(_ context.Context, upkeepID string) error {
+	delete(r.lanes, upkeepID)
+	return nil
+}
+
+func (r *fakeCCIPMessageResolver) CommittedMessages(_ context.Context, log logpoller.Log) ([]CCIPMessage, error) {
+	msgs, ok := r.msgs[log.TxHash]
+	if !ok {
+		return nil, errors.New("messages not found")
+	}
+	return msgs, nil
+}
+
+func TestCCIPTriggerConfig_Matches(t *testing.T) {
+	sender := common.HexToAddress("0x8Cd4e6D5d4b9E4B3C7e1E69bC2A8B1d2F4D9a1E7")
+	msg := CCIPMessage{SourceChainSelector: 1, MessageID: [32]byte{0xab}, Sender: sender}
+
+	tests := []struct {
+		name string
+		cfg  CCIPTriggerConfig
+		want bool
+	}{
+		{"any message of the source chain", CCIPTriggerConfig{SourceChainSelector: 1}, true},
+		{"other source chain", CCIPTriggerConfig{SourceChainSelector: 2}, false},
+		{"sender", CCIPTriggerConfig{SourceChainSelector: 1, Sender: &sender}, true},
+		{"other sender", CCIPTriggerConfig{SourceChainSelector: 1, Sender: &common.Address{}}, false},
+		{"message ID", CCIPTriggerConfig{SourceChainSelector: 1, MessageIDPattern: regexp.MustCompile("^0xab")}, true},
+		{"other message ID", CCIPTriggerConfig{SourceChainSelector: 1, MessageIDPattern: regexp.MustCompile("^0xcd")}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.cfg.Matches(msg))
+		})
+	}
+}
+
+func TestCCIPTriggers_Select(t *testing.T) {
+	ctx := testutils.Context(t)
+	sender := common.HexToAddress("0x8Cd4e6D5d4b9E4B3C7e1E69bC2A8B1d2F4D9a1E7")
+	upkeepID := big.NewInt(123)
+
+	matching := logpoller.Log{TxHash: common.HexToHash("0x1"), EventSig: ccipReportAcceptedSig}
+	notMatching := logpoller.Log{TxHash: common.HexToHash("0x2"), EventSig: ccipReportAcceptedSig}
+	unresolved := logpoller.Log{TxHash: common.HexToHash("0x3"), EventSig: ccipReportAcceptedSig}
+	otherEvent := logpoller.Log{TxHash: common.HexToHash("0x1"), EventSig: common.HexToHash("0x4")}
+
+	triggers := NewCCIPTriggers(logger.TestLogger(t), &fakeCCIPMessageResolver{msgs: map[common.Hash][]CCIPMessage{
+		matching.TxHash: {
+			{SourceChainSelector: 1, SequenceNumber: 1},
+			{SourceChainSelector: 1, SequenceNumber: 2, Sender: sender},
+		},
+		notMatching.TxHash: {
+			{SourceChainSelector: 2, SequenceNumber: 1, Sender: sender},
+		},
+	}}, CCIPTriggerConfig{UpkeepID: upkeepID, SourceChainSelector: 1, Sender: &sender})
+
+	t.Run("selects logs committing a matching message", func(t *testing.T) {
+		logs := triggers.Select(ctx, upkeepID, matching, notMatching, unresolved, otherEvent)
+		assert.Equal(t, []logpoller.Log{matching}, logs)
+	})
+
+	t.Run("returns the logs of other upkeeps as they are", func(t *testing.T) {
+		logs := triggers.Select(ctx, big.NewInt(456), notMatching, otherEvent)
+		assert.Equal(t, []logpoller.Log{notMatching, otherEvent}, logs)
+	})
+
+	t.Run("nil triggers", func(t *testing.T) {
+		var nilTriggers *CCIPTriggers
+		logs := nilTriggers.Select(ctx, upkeepID, notMatching)
+		assert.Equal(t, []logpoller.Log{notMatching}, logs)
+	})
+}
+
+func TestCCIPTriggers_RegisterUpkeep(t *testing.T) {
+	ctx := testutils.Context(t)
+	commitStore := common.HexToAddress("0x1")
+	resolver := &fakeCCIPMessageResolver{lanes: map[string]common.Address{}}
+	triggers := NewCCIPTriggers(logger.TestLogger(t), resolver, CCIPTriggerConfig{UpkeepID: big.NewInt(123), SourceChainSelector: 1})
+
+	require.NoError(t, triggers.RegisterUpkeep(ctx, big.NewInt(123), commitStore, 10))
+	require.NoError(t, triggers.RegisterUpkeep(ctx, big.NewInt(456), commitStore, 10))
+	assert.Equal(t, map[string]common.Address{"123": commitStore}, resolver.lanes, "only upkeeps with a CCIP trigger register their lane")
+
+	require.NoError(t, triggers.UnregisterUpkeep(ctx, big.NewInt(123)))
+	assert.Empty(t, resolver.lanes)
+
+	var nilTriggers *CCIPTriggers
+	require.NoError(t, nilTriggers.RegisterUpkeep(ctx, big.NewInt(123), commitStore, 10))
+	require.NoError(t, nilTriggers.UnregisterUpkeep(ctx, big.NewInt(123)))
+}
+
+func TestCCIPMessageResolver_lanes(t *testing.T) {
+	ctx := testutils.Context(t)
+	commitStore := common.HexToAddress("0x1")
+	onRamp := common.HexToAddress("0x2")
+	filterName := logpoller.FilterName("AutomationCCIPTrigger", onRamp)
+
+	commitStoreABI, err := commit_store.CommitStoreMetaData.GetAbi()
+	require.NoError(t, err)
+	staticConfig, err := commitStoreABI.Methods["getStaticConfig"].Outputs.Pack(commit_store.CommitStoreStaticConfig{
+		ChainSelector:       1,
+		SourceChainSelector: chainsel.GETH_TESTNET.Selector,
+		OnRamp:              onRamp,
+	})
+	require.NoError(t, err)
+	dest := evmclimocks.NewClient(t)
+	dest.On("CallContract", mock.Anything, mock.Anything, mock.Anything).Return(staticConfig, nil).Once()
+	dest.On("HeadByNumber", mock.Anything, big.NewInt(50)).Return(&evmtypes.Head{Number: 50, Timestamp: time.Unix(1000, 0)}, nil)
+	dest.On("HeadByNumber", mock.Anything, big.NewInt(60)).Return(&evmtypes.Head{Number: 60, Timestamp: time.Unix(1200, 0)}, nil)
+
+	// the source chain mines a block every 2 seconds, up to block 1000
+	source := evmclimocks.NewClient(t)
+	source.On("HeadByNumber", mock.Anything, mock.Anything).Return(func(_ context.Context, n *big.Int) (*evmtypes.Head, error) {
+		number := int64(1000)
+		if n != nil {
+			number = n.Int64()
+		}
+		return &evmtypes.Head{Number: number, Timestamp: time.Unix(2*number, 0)}, nil
+	})
+	lp := lpmocks.NewLogPoller(t)
+	lp.On("HasFilter", filterName).Return(false).Once()
+	lp.On("RegisterFilter", mock.Anything, mock.MatchedBy(func(f logpoller.Filter) bool {
+		return f.Name == filterName && f.Addresses[0] == onRamp && f.EventSigs[0] == ccipSendRequestedSig
+	})).Return(nil).Once()
+	// trigger block 50 is at the time of source block 500
+	lp.On("ReplayAsync", int64(500-LogBackfillBuffer)).Once()
+
+	resolver := NewCCIPMessageResolver(logger.TestLogger(t), dest, func(chainID string) (CCIPSourceChain, error) {
+		assert.Equal(t, strconv.FormatUint(chainsel.GETH_TESTNET.EvmChainID, 10), chainID)
+		return CCIPSourceChain{Poller: lp, Client: source}, nil
+	})
+
+	require.NoError(t, resolver.RegisterLane(ctx, "1", commitStore, 50))
+	// the messages of the later trigger block are already read
+	require.NoError(t, resolver.RegisterLane(ctx, "2", commitStore, 60))
+
+	require.NoError(t, resolver.UnregisterLane(ctx, "1"))
+	lp.On("HasFilter", filterName).Return(true).Once()
+	lp.On("UnregisterFilter", mock.Anything, filterName).Return(nil).Once()
+	require.NoError(t, resolver.UnregisterLane(ctx, "2"))
+
+	_, err = resolver.CommittedMessages(ctx, logpoller.Log{Address: commitStore})
+	require.EqualError(t, err, "no upkeep registered the lane of CommitStore "+commitStore.String())
+}
@@ -13,10 +13,12 @@ import (
 
 // New creates a new log event provider and recoverer.
 // using default values for the options.
-func New(lggr logger.Logger, poller logpoller.LogPoller, c client.Client, stateStore core.UpkeepStateReader, finalityDepth uint32, chainID *big.Int) (LogEventProvider, LogRecoverer) {
+// ccipTriggers may be nil if no upkeep is triggered by CCIP messages.
+func New(lggr logger.Logger, poller logpoller.LogPoller, c client.Client, stateStore core.UpkeepStateReader, finalityDepth uint32, chainID *big.Int, ccipTriggers *CCIPTriggers) (LogEventProvider, LogRecoverer) {
 	filterStore := NewUpkeepFilterStore()
 	packer := NewLogEventsPacker()
 	opts := NewOptions(int64(finalityDepth), chainID)
+	opts.CCIPTriggers = ccipTriggers
 
 	provider := NewLogProvider(lggr, poller, chainID, packer, filterStore, opts)
 	recoverer := NewLogRecoverer(lggr, poller, c, stateStore, packer, filterStore, opts)
@@ -40,6 +42,9 @@ type LogTriggersOptions struct {
 	LogLimit uint32
 	// BlockRate determines the block window for log processing.
 	BlockRate uint32
+
+	// CCIPTriggers selects the logs of upkeeps triggered by CCIP messages, if any.
+	CCIPTriggers *CCIPTriggers
 }
 
 func NewOptions(finalityDepth int64, chainID *big.Int) LogTriggersOptions {
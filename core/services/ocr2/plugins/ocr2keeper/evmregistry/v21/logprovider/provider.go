@@ -488,7 +488,7 @@ func (p *logEventProvider) readLogs(ctx context.Context, latest int64, filters [
 			merr = errors.Join(merr, fmt.Errorf("failed to get logs for upkeep %s: %w", filter.upkeepID.String(), err))
 			continue
 		}
-		filteredLogs := filter.Select(logs...)
+		filteredLogs := p.opts.CCIPTriggers.Select(ctx, filter.upkeepID, filter.Select(logs...)...)
 
 		p.buffer.Enqueue(filter.upkeepID, filteredLogs...)
 
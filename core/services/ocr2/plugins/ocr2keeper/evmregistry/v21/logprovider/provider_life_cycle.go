@@ -95,6 +95,9 @@ func (p *logEventProvider) RegisterFilter(ctx context.Context, opts FilterOption
 	filter.addr = cfg.ContractAddress.Bytes()
 	filter.topics = []common.Hash{cfg.Topic0, cfg.Topic1, cfg.Topic2, cfg.Topic3}
 
+	if err := p.opts.CCIPTriggers.RegisterUpkeep(ctx, upkeepID, cfg.ContractAddress, opts.UpdateBlock); err != nil {
+		return fmt.Errorf("failed to register CCIP trigger of upkeep %s: %w", upkeepID.String(), err)
+	}
 	if err := p.register(ctx, lpFilter, filter); err != nil {
 		return fmt.Errorf("failed to register upkeep filter %s: %w", filter.upkeepID.String(), err)
 	}
@@ -150,6 +153,9 @@ func (p *logEventProvider) UnregisterFilter(ctx context.Context, upkeepID *big.I
 			return fmt.Errorf("failed to unregister upkeep filter %s: %w", upkeepID.String(), err)
 		}
 	}
+	if err := p.opts.CCIPTriggers.UnregisterUpkeep(ctx, upkeepID); err != nil {
+		return fmt.Errorf("failed to unregister CCIP trigger of upkeep %s: %w", upkeepID.String(), err)
+	}
 	p.filterStore.RemoveActiveUpkeeps(upkeepFilter{
 		upkeepID: upkeepID,
 	})
@@ -87,6 +87,7 @@ type logRecoverer struct {
 	poller            logpoller.LogPoller
 	client            client.Client
 	blockTimeResolver *blockTimeResolver
+	ccipTriggers      *CCIPTriggers
 
 	finalityDepth int64
 }
@@ -111,6 +112,7 @@ func NewLogRecoverer(lggr logger.Logger, poller logpoller.LogPoller, client clie
 		packer:            packer,
 		client:            client,
 		blockTimeResolver: newBlockTimeResolver(poller),
+		ccipTriggers:      opts.CCIPTriggers,
 
 		finalityDepth: opts.FinalityDepth,
 	}
@@ -263,7 +265,7 @@ func (r *logRecoverer) getLogTriggerCheckData(ctx context.Context, proposal ocr2
 	if err != nil {
 		return nil, fmt.Errorf("could not read logs: %w", err)
 	}
-	logs = filter.Select(logs...)
+	logs = r.ccipTriggers.Select(ctx, filter.upkeepID, filter.Select(logs...)...)
 
 	for _, log := range logs {
 		trigger := logToTrigger(log)
@@ -391,7 +393,7 @@ func (r *logRecoverer) recoverFilter(ctx context.Context, f upkeepFilter, startB
 	if err != nil {
 		return fmt.Errorf("could not read logs: %w", err)
 	}
-	logs = f.Select(logs...)
+	logs = r.ccipTriggers.Select(ctx, f.upkeepID, f.Select(logs...)...)
 
 	workIDs := make([]string, 0)
 	for _, log := range logs {
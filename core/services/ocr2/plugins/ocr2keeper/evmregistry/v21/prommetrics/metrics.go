@@ -9,6 +9,7 @@ import (
 const (
 	NamespaceAutomationLogTrigger = "automation_log_trigger"
 	NamespaceAutomationStreams    = "automation_streams"
+	NamespaceAutomationRegistry   = "automation_registry"
 )
 
 // Streams steps
@@ -36,6 +37,12 @@ const (
 	StreamsVersion03 = "v03"
 )
 
+// Check result cache lookup labels
+const (
+	CheckResultCacheHit  = "hit"
+	CheckResultCacheMiss = "miss"
+)
+
 // Metric labels
 const (
 	LogBufferFlowDirectionIngress = "ingress"
@@ -105,4 +112,13 @@ var (
 		"version",
 		"status",
 	})
+
+	// Registry metrics
+	AutomationCheckResultCacheLookups = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: NamespaceAutomationRegistry,
+		Name:      "check_result_cache_lookups",
+		Help:      "Count of the check result cache lookups, by result (hit or miss)",
+	}, []string{
+		"result",
+	})
 )
@@ -112,6 +112,7 @@ func NewEvmRegistry(
 		finalityDepth:    finalityDepth,
 		streams:          streams.NewStreamsLookup(mercuryConfig, blockSub, client.Client(), registry, lggr),
 		ge:               client.GasEstimator(),
+		checkResults:     newCheckResultCache(defaultCheckResultExpiration),
 	}
 }
 
@@ -194,6 +195,7 @@ type EvmRegistry struct {
 	finalityDepth    uint32
 	streams          streams.Lookup
 	ge               gas.EvmFeeEstimator
+	checkResults     *checkResultCache
 }
 
 func (r *EvmRegistry) Name() string {
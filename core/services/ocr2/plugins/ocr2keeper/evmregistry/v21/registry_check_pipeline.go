@@ -67,29 +67,52 @@ func (r *EvmRegistry) CheckUpkeeps(ctx context.Context, keys ...ocr2keepers.Upke
 }
 
 func (r *EvmRegistry) doCheck(ctx context.Context, keys []ocr2keepers.UpkeepPayload, chResult chan checkResult) {
-	upkeepResults, err := r.checkUpkeeps(ctx, keys)
-	if err != nil {
-		chResult <- checkResult{
-			err: err,
-		}
-		return
+	cached, missing := r.checkResults.get(keys)
+	if len(cached) > 0 {
+		r.lggr.Debugw("Reusing cached check results", "cached", len(cached), "missing", len(missing))
 	}
 
-	upkeepResults = r.streams.Lookup(ctx, upkeepResults)
-
-	upkeepResults, err = r.simulatePerformUpkeeps(ctx, upkeepResults)
-	if err != nil {
-		chResult <- checkResult{
-			err: err,
+	toCheck := make([]ocr2keepers.UpkeepPayload, len(missing))
+	for i, idx := range missing {
+		toCheck[i] = keys[idx]
+	}
+	var checked []ocr2keepers.CheckResult
+	if len(toCheck) > 0 {
+		var err error
+		checked, err = r.doCheckPipeline(ctx, toCheck)
+		if err != nil {
+			chResult <- checkResult{
+				err: err,
+			}
+			return
 		}
-		return
+		r.checkResults.set(toCheck, checked)
 	}
 
+	upkeepResults := make([]ocr2keepers.CheckResult, len(keys))
+	for idx, res := range cached {
+		upkeepResults[idx] = res
+	}
+	for i, idx := range missing {
+		upkeepResults[idx] = checked[i]
+	}
 	chResult <- checkResult{
 		cr: upkeepResults,
 	}
 }
 
+// doCheckPipeline runs checkUpkeep, the streams lookup and simulatePerformUpkeep for the given payloads.
+func (r *EvmRegistry) doCheckPipeline(ctx context.Context, keys []ocr2keepers.UpkeepPayload) ([]ocr2keepers.CheckResult, error) {
+	upkeepResults, err := r.checkUpkeeps(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	upkeepResults = r.streams.Lookup(ctx, upkeepResults)
+
+	return r.simulatePerformUpkeeps(ctx, upkeepResults)
+}
+
 // getBlockAndUpkeepId retrieves check block number, block hash from trigger and upkeep id
 func (r *EvmRegistry) getBlockAndUpkeepId(upkeepID ocr2keepers.UpkeepIdentifier, trigger ocr2keepers.Trigger) (*big.Int, common.Hash, *big.Int) {
 	block := new(big.Int).SetInt64(int64(trigger.BlockNumber))
@@ -0,0 +1,71 @@
+package evm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+
+	ocr2keepers "github.com/smartcontractkit/chainlink-common/pkg/types/automation"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/encoding"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/prommetrics"
+)
+
+const (
+	// defaultCheckResultExpiration decides how long a check result is reused for. It only has to outlive a single
+	// OCR round, since the same payloads are checked during the observation and the report phases.
+	defaultCheckResultExpiration = 2 * time.Minute
+	checkResultCleanupInterval   = time.Minute
+)
+
+// checkResultCache stores the outcome of the check pipeline (checkUpkeep, streams lookup and simulatePerformUpkeep)
+// per upkeep and check block hash, so that a payload checked again at the same block does not cost another round of
+// eth_calls. A nil cache is valid and never hits.
+type checkResultCache struct {
+	cache *cache.Cache
+}
+
+func newCheckResultCache(expiration time.Duration) *checkResultCache {
+	return &checkResultCache{cache: cache.New(expiration, checkResultCleanupInterval)}
+}
+
+// checkResultCacheKey returns the key of a payload. The check block hash pins the chain state the simulations ran
+// against, and the work ID tells apart the different logs of a log triggered upkeep checked at the same block.
+func checkResultCacheKey(p ocr2keepers.UpkeepPayload) string {
+	return fmt.Sprintf("%s:%x:%s", p.UpkeepID.String(), p.Trigger.BlockHash, p.WorkID)
+}
+
+// get returns the cached results, indexed like payloads, and the payloads which still have to be checked.
+func (c *checkResultCache) get(payloads []ocr2keepers.UpkeepPayload) (map[int]ocr2keepers.CheckResult, []int) {
+	cached := make(map[int]ocr2keepers.CheckResult)
+	missing := make([]int, 0, len(payloads))
+	for i, p := range payloads {
+		if c != nil {
+			if v, ok := c.cache.Get(checkResultCacheKey(p)); ok {
+				cached[i] = v.(ocr2keepers.CheckResult)
+				continue
+			}
+		}
+		missing = append(missing, i)
+	}
+	if c != nil {
+		prommetrics.AutomationCheckResultCacheLookups.WithLabelValues(prommetrics.CheckResultCacheHit).Add(float64(len(cached)))
+		prommetrics.AutomationCheckResultCacheLookups.WithLabelValues(prommetrics.CheckResultCacheMiss).Add(float64(len(missing)))
+	}
+	return cached, missing
+}
+
+// set stores the results which are final for their check block. Retryable results and pipeline failures, which are
+// mostly caused by RPC flakiness, are left out so that they get checked again.
+func (c *checkResultCache) set(payloads []ocr2keepers.UpkeepPayload, results []ocr2keepers.CheckResult) {
+	if c == nil {
+		return
+	}
+	for i, res := range results {
+		if res.Retryable || res.PipelineExecutionState != uint8(encoding.NoPipelineError) {
+			continue
+		}
+		c.cache.SetDefault(checkResultCacheKey(payloads[i]), res)
+	}
+}
@@ -0,0 +1,58 @@
+package evm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ocr2keepers "github.com/smartcontractkit/chainlink-common/pkg/types/automation"
+
+	types3 "github.com/smartcontractkit/chainlink-automation/pkg/v3/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/core"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/encoding"
+)
+
+func TestCheckResultCache(t *testing.T) {
+	uid0 := core.GenUpkeepID(types3.UpkeepType(0), "p0")
+	uid1 := core.GenUpkeepID(types3.UpkeepType(0), "p1")
+	payloads := []ocr2keepers.UpkeepPayload{
+		{UpkeepID: uid0, WorkID: "w0", Trigger: ocr2keepers.NewTrigger(10, [32]byte{1})},
+		{UpkeepID: uid1, WorkID: "w1", Trigger: ocr2keepers.NewTrigger(10, [32]byte{1})},
+	}
+	results := []ocr2keepers.CheckResult{
+		{UpkeepID: uid0, WorkID: "w0", Eligible: true, PerformData: []byte{1, 2, 3}},
+		{UpkeepID: uid1, WorkID: "w1", Retryable: true, PipelineExecutionState: uint8(encoding.RpcFlakyFailure)},
+	}
+
+	t.Run("nil cache never hits", func(t *testing.T) {
+		var c *checkResultCache
+		c.set(payloads, results)
+		cached, missing := c.get(payloads)
+		assert.Empty(t, cached)
+		assert.Equal(t, []int{0, 1}, missing)
+	})
+
+	t.Run("only final results are cached", func(t *testing.T) {
+		c := newCheckResultCache(time.Minute)
+		c.set(payloads, results)
+
+		cached, missing := c.get(payloads)
+		require.Len(t, cached, 1)
+		assert.Equal(t, results[0], cached[0])
+		assert.Equal(t, []int{1}, missing)
+	})
+
+	t.Run("results are keyed by check block hash", func(t *testing.T) {
+		c := newCheckResultCache(time.Minute)
+		c.set(payloads, results)
+
+		reorged := payloads[0]
+		reorged.Trigger = ocr2keepers.NewTrigger(10, [32]byte{2})
+		cached, missing := c.get([]ocr2keepers.UpkeepPayload{reorged})
+		assert.Empty(t, cached)
+		assert.Equal(t, []int{0}, missing)
+	})
+}
@@ -0,0 +1,95 @@
+package promwrapper
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// MetricsReporter is implemented by the reporting plugins which report custom per-round metrics, like the size of
+// the batches they build. RegisterMetrics is called once when the plugin is created, before its first round.
+type MetricsReporter interface {
+	RegisterMetrics(m *PluginMetrics) error
+}
+
+// PluginMetrics registers the custom metrics of a reporting plugin. The metrics are labelled with the chainType,
+// chainID, plugin and jobID of the plugin, and their series are deleted when the plugin is closed.
+//
+// Plugins of different jobs share a metric when they register it with the same name and help.
+type PluginMetrics struct {
+	registerer prometheus.Registerer
+	labels     prometheus.Labels
+
+	mu   sync.Mutex
+	vecs []interface{ Delete(prometheus.Labels) bool }
+}
+
+var customLabels = []string{"chainType", "chainID", "plugin", "jobID"}
+
+func newPluginMetrics(registerer prometheus.Registerer, chainType, chainID, plugin, jobID string) *PluginMetrics {
+	return &PluginMetrics{
+		registerer: registerer,
+		labels:     prometheus.Labels{"chainType": chainType, "chainID": chainID, "plugin": plugin, "jobID": jobID},
+	}
+}
+
+// NewCounter registers a counter of the plugin.
+func (m *PluginMetrics) NewCounter(name, help string) (prometheus.Counter, error) {
+	vec, err := register(m, prometheus.NewCounterVec(prometheus.CounterOpts{Name: name, Help: help}, customLabels))
+	if err != nil {
+		return nil, err
+	}
+	return vec.With(m.labels), nil
+}
+
+// NewGauge registers a gauge of the plugin.
+func (m *PluginMetrics) NewGauge(name, help string) (prometheus.Gauge, error) {
+	vec, err := register(m, prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: name, Help: help}, customLabels))
+	if err != nil {
+		return nil, err
+	}
+	return vec.With(m.labels), nil
+}
+
+// NewHistogram registers a histogram of the plugin, with prometheus.DefBuckets if buckets is empty.
+func (m *PluginMetrics) NewHistogram(name, help string, buckets []float64) (prometheus.Observer, error) {
+	vec, err := register(m, prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: name, Help: help, Buckets: buckets}, customLabels))
+	if err != nil {
+		return nil, err
+	}
+	return vec.With(m.labels), nil
+}
+
+// register registers vec, or returns the collector already registered with the same descriptor.
+func register[V interface {
+	prometheus.Collector
+	Delete(prometheus.Labels) bool
+}](m *PluginMetrics, vec V) (V, error) {
+	if err := m.registerer.Register(vec); err != nil {
+		var are prometheus.AlreadyRegisteredError
+		if !errors.As(err, &are) {
+			return vec, fmt.Errorf("failed to register plugin metric: %w", err)
+		}
+		existing, ok := are.ExistingCollector.(V)
+		if !ok {
+			return vec, fmt.Errorf("failed to register plugin metric: already registered as %T", are.ExistingCollector)
+		}
+		vec = existing
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.vecs = append(m.vecs, vec)
+	return vec, nil
+}
+
+// close deletes the series of the plugin.
+func (m *PluginMetrics) close() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, vec := range m.vecs {
+		vec.Delete(m.labels)
+	}
+	m.vecs = nil
+}
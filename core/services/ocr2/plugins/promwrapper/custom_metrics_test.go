@@ -0,0 +1,80 @@
+package promwrapper
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+)
+
+// metricsReportingPlugin reports the number of its rounds.
+type metricsReportingPlugin struct {
+	fakeReportingPlugin
+	rounds prometheus.Counter
+}
+
+func (p *metricsReportingPlugin) RegisterMetrics(m *PluginMetrics) (err error) {
+	p.rounds, err = m.NewCounter("test_plugin_rounds", "The number of rounds")
+	return err
+}
+
+type metricsReportingPluginFactory struct{}
+
+func (metricsReportingPluginFactory) NewReportingPlugin(types.ReportingPluginConfig) (types.ReportingPlugin, types.ReportingPluginInfo, error) {
+	return &metricsReportingPlugin{}, types.ReportingPluginInfo{}, nil
+}
+
+func TestPluginMetrics(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	newPlugin := func(jobID int32) *metricsReportingPlugin {
+		factory := NewPromFactory(metricsReportingPluginFactory{}, "test", "EVM", "1", jobID)
+		factory.(*promFactory).registerer = registry
+		plugin, _, err := factory.NewReportingPlugin(types.ReportingPluginConfig{})
+		require.NoError(t, err)
+		wrapped := plugin.(*promPlugin)
+		require.NotNil(t, wrapped.metrics)
+		return wrapped.wrapped.(*metricsReportingPlugin)
+	}
+
+	plugin1, plugin2 := newPlugin(1), newPlugin(2)
+	plugin1.rounds.Inc()
+	plugin2.rounds.Add(2)
+
+	assert.Equal(t, 2, testutil.CollectAndCount(registry, "test_plugin_rounds"))
+	assert.Equal(t, float64(1), testutil.ToFloat64(plugin1.rounds))
+	assert.Equal(t, float64(2), testutil.ToFloat64(plugin2.rounds))
+
+	t.Run("labels the metrics with the job", func(t *testing.T) {
+		families, err := registry.Gather()
+		require.NoError(t, err)
+		require.Len(t, families, 1)
+		for _, m := range families[0].GetMetric() {
+			labels := map[string]string{}
+			for _, l := range m.GetLabel() {
+				labels[l.GetName()] = l.GetValue()
+			}
+			assert.Equal(t, "EVM", labels["chainType"])
+			assert.Equal(t, "1", labels["chainID"])
+			assert.Equal(t, "test", labels["plugin"])
+			assert.Contains(t, []string{"1", "2"}, labels["jobID"])
+		}
+	})
+
+	t.Run("deletes the metrics of a closed plugin", func(t *testing.T) {
+		metrics := newPluginMetrics(registry, "EVM", "1", "test", "1")
+		_, err := metrics.NewCounter("test_plugin_rounds", "The number of rounds")
+		require.NoError(t, err)
+		metrics.close()
+		assert.Equal(t, 1, testutil.CollectAndCount(registry, "test_plugin_rounds"))
+	})
+
+	t.Run("fails to register a metric with another type", func(t *testing.T) {
+		metrics := newPluginMetrics(registry, "EVM", "1", "test", "3")
+		_, err := metrics.NewGauge("test_plugin_rounds", "The number of rounds")
+		require.Error(t, err)
+	})
+}
@@ -1,8 +1,9 @@
 package promwrapper
 
 import (
-	"math/big"
+	"errors"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
 )
 
@@ -12,7 +13,10 @@ type promFactory struct {
 	wrapped   types.ReportingPluginFactory
 	name      string
 	chainType string
-	chainID   *big.Int
+	chainID   string
+	jobID     int32
+	// registerer registers the custom metrics of the plugins.
+	registerer prometheus.Registerer
 }
 
 func (p *promFactory) NewReportingPlugin(config types.ReportingPluginConfig) (types.ReportingPlugin, types.ReportingPluginInfo, error) {
@@ -21,15 +25,22 @@ func (p *promFactory) NewReportingPlugin(config types.ReportingPluginConfig) (ty
 		return nil, types.ReportingPluginInfo{}, err
 	}
 
-	prom := New(plugin, p.name, p.chainType, p.chainID, config, nil)
+	prom := newPromPlugin(plugin, p.name, p.chainType, p.chainID, p.jobID, config, nil)
+	if err = prom.registerMetrics(p.registerer); err != nil {
+		return nil, types.ReportingPluginInfo{}, errors.Join(err, plugin.Close())
+	}
 	return prom, info, nil
 }
 
-func NewPromFactory(wrapped types.ReportingPluginFactory, name, chainType string, chainID *big.Int) types.ReportingPluginFactory {
+// NewPromFactory returns a factory wrapping the reporting plugins of job jobID with prometheus metrics. The plugins
+// implementing MetricsReporter register their custom metrics when they are created.
+func NewPromFactory(wrapped types.ReportingPluginFactory, name, chainType, chainID string, jobID int32) types.ReportingPluginFactory {
 	return &promFactory{
-		wrapped:   wrapped,
-		name:      name,
-		chainType: chainType,
-		chainID:   chainID,
+		wrapped:    wrapped,
+		name:       name,
+		chainType:  chainType,
+		chainID:    chainID,
+		jobID:      jobID,
+		registerer: prometheus.DefaultRegisterer,
 	}
 }
@@ -153,6 +153,40 @@ func (_c *PrometheusBackend_SetObservationToReportLatency_Call) RunAndReturn(run
 	return _c
 }
 
+// SetPhaseDuration provides a mock function with given fields: _a0, _a1
+func (_m *PrometheusBackend) SetPhaseDuration(_a0 []string, _a1 float64) {
+	_m.Called(_a0, _a1)
+}
+
+// PrometheusBackend_SetPhaseDuration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPhaseDuration'
+type PrometheusBackend_SetPhaseDuration_Call struct {
+	*mock.Call
+}
+
+// SetPhaseDuration is a helper method to define mock.On call
+//   - _a0 []string
+//   - _a1 float64
+func (_e *PrometheusBackend_Expecter) SetPhaseDuration(_a0 interface{}, _a1 interface{}) *PrometheusBackend_SetPhaseDuration_Call {
+	return &PrometheusBackend_SetPhaseDuration_Call{Call: _e.mock.On("SetPhaseDuration", _a0, _a1)}
+}
+
+func (_c *PrometheusBackend_SetPhaseDuration_Call) Run(run func(_a0 []string, _a1 float64)) *PrometheusBackend_SetPhaseDuration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]string), args[1].(float64))
+	})
+	return _c
+}
+
+func (_c *PrometheusBackend_SetPhaseDuration_Call) Return() *PrometheusBackend_SetPhaseDuration_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *PrometheusBackend_SetPhaseDuration_Call) RunAndReturn(run func([]string, float64)) *PrometheusBackend_SetPhaseDuration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetQueryDuration provides a mock function with given fields: _a0, _a1
 func (_m *PrometheusBackend) SetQueryDuration(_a0 []string, _a1 float64) {
 	_m.Called(_a0, _a1)
@@ -1,12 +1,15 @@
 // promwrapper wraps another OCR2 reporting plugin and provides standardized prometheus metrics
 // for each of the OCR2 phases (Query, Observation, Report, ShouldAcceptFinalizedReport,
 // ShouldTransmitAcceptedReport, and Close).
+//
+// The duration of each round phase is also reported per job, in seconds, by
+// ocr2_reporting_plugin_phase_duration_seconds, so that the phases of all plugins can be compared with one query.
 package promwrapper
 
 import (
 	"context"
 	"fmt"
-	"math/big"
+	"strconv"
 	"sync"
 	"time"
 
@@ -40,7 +43,7 @@ var (
 	getLabelsValues = func(p *promPlugin, t types.ReportTimestamp) []string {
 		return []string{
 			p.chainType,                         // chainType
-			p.chainID.String(),                  // chainID
+			p.chainID,                           // chainID
 			p.name,                              // plugin
 			p.oracleID,                          // oracleID
 			common.Bytes2Hex(t.ConfigDigest[:]), // configDigest
@@ -48,8 +51,25 @@ var (
 	}
 )
 
+// Round phases, as reported by ocr2_reporting_plugin_phase_duration_seconds.
+const (
+	PhaseQuery                        = "query"
+	PhaseObservation                  = "observation"
+	PhaseReport                       = "report"
+	PhaseShouldAcceptFinalizedReport  = "should_accept_finalized_report"
+	PhaseShouldTransmitAcceptedReport = "should_transmit_accepted_report"
+)
+
 // Prometheus queries.
 var (
+	promPhaseDuration = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "ocr2_reporting_plugin_phase_duration_seconds",
+			Help:    "The duration of each round phase of an OCR2 reporting plugin, by job",
+			Buckets: []float64{.001, .005, .01, .05, .1, .5, 1, 5, 10, 30, 60, 120, 300, 600},
+		},
+		[]string{"chainType", "chainID", "plugin", "jobID", "phase"},
+	)
 	promQuery = promauto.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Name:    "ocr2_reporting_plugin_query_time",
@@ -143,6 +163,9 @@ type (
 		SetShouldTransmitAcceptedReportDuration([]string, float64)
 		SetCloseDuration([]string, float64)
 
+		// Round phase duration in seconds, labelled with chainType, chainID, plugin, jobID and phase.
+		SetPhaseDuration([]string, float64)
+
 		// Inter-phase latency.
 		SetQueryToObservationLatency([]string, float64)
 		SetObservationToReportLatency([]string, float64)
@@ -157,7 +180,8 @@ type (
 		wrapped                       types.ReportingPlugin
 		name                          string
 		chainType                     string
-		chainID                       *big.Int
+		chainID                       string
+		jobID                         string
 		oracleID                      string
 		configDigest                  string
 		queryEndTimes                 sync.Map
@@ -165,6 +189,8 @@ type (
 		reportEndTimes                sync.Map
 		acceptFinalizedReportEndTimes sync.Map
 		prometheusBackend             PrometheusBackend
+		// metrics are the custom metrics of the wrapped plugin, nil if it doesn't report any.
+		metrics *PluginMetrics
 	}
 )
 
@@ -208,14 +234,31 @@ func (*defaultPrometheusBackend) SetAcceptFinalizedReportToTransmitAcceptedRepor
 	promAcceptFinalizedReportToTransmitAcceptedReportLatency.WithLabelValues(labelValues...).Observe(latency)
 }
 
+func (*defaultPrometheusBackend) SetPhaseDuration(labelValues []string, duration float64) {
+	promPhaseDuration.WithLabelValues(labelValues...).Observe(duration)
+}
+
 func New(
 	plugin types.ReportingPlugin,
 	name string,
 	chainType string,
-	chainID *big.Int,
+	chainID string,
+	jobID int32,
 	config types.ReportingPluginConfig,
 	backend PrometheusBackend,
 ) types.ReportingPlugin {
+	return newPromPlugin(plugin, name, chainType, chainID, jobID, config, backend)
+}
+
+func newPromPlugin(
+	plugin types.ReportingPlugin,
+	name string,
+	chainType string,
+	chainID string,
+	jobID int32,
+	config types.ReportingPluginConfig,
+	backend PrometheusBackend,
+) *promPlugin {
 	// Apply passed-in Prometheus backend if one is given.
 	var prometheusBackend PrometheusBackend = &defaultPrometheusBackend{}
 	if backend != nil {
@@ -227,6 +270,7 @@ func New(
 		name:              name,
 		chainType:         chainType,
 		chainID:           chainID,
+		jobID:             strconv.FormatInt(int64(jobID), 10),
 		oracleID:          fmt.Sprintf("%d", config.OracleID),
 		configDigest:      common.Bytes2Hex(config.ConfigDigest[:]),
 		prometheusBackend: prometheusBackend,
@@ -238,6 +282,7 @@ func (p *promPlugin) Query(ctx context.Context, timestamp types.ReportTimestamp)
 	defer func() {
 		duration := float64(time.Now().UTC().Sub(start))
 		p.prometheusBackend.SetQueryDuration(getLabelsValues(p, timestamp), duration)
+		p.setPhaseDuration(PhaseQuery, start)
 		p.queryEndTimes.Store(timestamp, time.Now().UTC()) // note time at end of Query()
 	}()
 
@@ -259,6 +304,7 @@ func (p *promPlugin) Observation(ctx context.Context, timestamp types.ReportTime
 	defer func() {
 		duration := float64(time.Now().UTC().Sub(start))
 		p.prometheusBackend.SetObservationDuration(labelValues, duration)
+		p.setPhaseDuration(PhaseObservation, start)
 		p.observationEndTimes.Store(timestamp, time.Now().UTC()) // note time at end of Observe()
 	}()
 
@@ -280,6 +326,7 @@ func (p *promPlugin) Report(ctx context.Context, timestamp types.ReportTimestamp
 	defer func() {
 		duration := float64(time.Now().UTC().Sub(start))
 		p.prometheusBackend.SetReportDuration(labelValues, duration)
+		p.setPhaseDuration(PhaseReport, start)
 		p.reportEndTimes.Store(timestamp, time.Now().UTC()) // note time at end of Report()
 	}()
 
@@ -301,6 +348,7 @@ func (p *promPlugin) ShouldAcceptFinalizedReport(ctx context.Context, timestamp
 	defer func() {
 		duration := float64(time.Now().UTC().Sub(start))
 		p.prometheusBackend.SetShouldAcceptFinalizedReportDuration(labelValues, duration)
+		p.setPhaseDuration(PhaseShouldAcceptFinalizedReport, start)
 		p.acceptFinalizedReportEndTimes.Store(timestamp, time.Now().UTC()) // note time at end of ShouldAcceptFinalizedReport()
 	}()
 
@@ -321,6 +369,7 @@ func (p *promPlugin) ShouldTransmitAcceptedReport(ctx context.Context, timestamp
 	defer func() {
 		duration := float64(time.Now().UTC().Sub(start))
 		p.prometheusBackend.SetShouldTransmitAcceptedReportDuration(labelValues, duration)
+		p.setPhaseDuration(PhaseShouldTransmitAcceptedReport, start)
 	}()
 
 	return p.wrapped.ShouldTransmitAcceptedReport(ctx, timestamp, report)
@@ -332,14 +381,38 @@ func (p *promPlugin) Close() error {
 	defer func() {
 		duration := float64(time.Now().UTC().Sub(start))
 		labelValues := []string{
-			p.chainType,        // chainType
-			p.chainID.String(), // chainID
-			p.name,             // plugin
-			p.oracleID,         // oracleID
-			p.configDigest,     // configDigest
+			p.chainType,    // chainType
+			p.chainID,      // chainID
+			p.name,         // plugin
+			p.oracleID,     // oracleID
+			p.configDigest, // configDigest
 		}
 		p.prometheusBackend.SetCloseDuration(labelValues, duration)
+		if p.metrics != nil {
+			p.metrics.close()
+		}
 	}()
 
 	return p.wrapped.Close()
 }
+
+// registerMetrics lets the wrapped plugin register its custom metrics with r, if it reports any.
+func (p *promPlugin) registerMetrics(r prometheus.Registerer) error {
+	reporter, ok := p.wrapped.(MetricsReporter)
+	if !ok {
+		return nil
+	}
+	metrics := newPluginMetrics(r, p.chainType, p.chainID, p.name, p.jobID)
+	if err := reporter.RegisterMetrics(metrics); err != nil {
+		metrics.close()
+		return err
+	}
+	p.metrics = metrics
+	return nil
+}
+
+// setPhaseDuration reports the duration of a round phase which began at start.
+func (p *promPlugin) setPhaseDuration(phase string, start time.Time) {
+	labelValues := []string{p.chainType, p.chainID, p.name, p.jobID, phase}
+	p.prometheusBackend.SetPhaseDuration(labelValues, time.Now().UTC().Sub(start).Seconds())
+}
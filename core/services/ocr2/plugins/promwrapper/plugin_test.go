@@ -2,7 +2,6 @@ package promwrapper
 
 import (
 	"context"
-	"math/big"
 	"testing"
 	"time"
 
@@ -68,12 +67,12 @@ var _ types.ReportingPlugin = &fakeReportingPlugin{}
 func TestPlugin_MustInstantiate(t *testing.T) {
 	// Ensure instantiation without panic for no override backend.
 	var reportingPlugin = &fakeReportingPlugin{}
-	promPlugin := New(reportingPlugin, "test", "EVM", big.NewInt(1), types.ReportingPluginConfig{}, nil)
+	promPlugin := New(reportingPlugin, "test", "EVM", "1", 1, types.ReportingPluginConfig{}, nil)
 	require.NotEqual(t, nil, promPlugin)
 
 	// Ensure instantiation without panic for override provided.
 	backend := mocks.NewPrometheusBackend(t)
-	promPlugin = New(reportingPlugin, "test-2", "EVM", big.NewInt(1), types.ReportingPluginConfig{}, backend)
+	promPlugin = New(reportingPlugin, "test-2", "EVM", "1", 1, types.ReportingPluginConfig{}, backend)
 	require.NotEqual(t, nil, promPlugin)
 }
 
@@ -167,6 +166,28 @@ func TestPlugin_GetLatencies(t *testing.T) {
 		require.Less(t, latency, cDuration)
 	}).Return()
 
+	// Assert per-job phase durations, in seconds.
+	phaseDurations := map[string][2]time.Duration{
+		PhaseQuery:                        {qDuration, oDuration},
+		PhaseObservation:                  {oDuration, rDuration},
+		PhaseReport:                       {rDuration, aDuration},
+		PhaseShouldAcceptFinalizedReport:  {aDuration, tDuration},
+		PhaseShouldTransmitAcceptedReport: {tDuration, cDuration},
+	}
+	var phases []string
+	backend.On("SetPhaseDuration", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		labelValues := args[0].([]string)
+		duration := time.Duration(args[1].(float64) * float64(time.Second))
+		require.Len(t, labelValues, 5)
+		require.Equal(t, []string{"EVM", "1", "test-plugin", "42"}, labelValues[:4])
+		phase := labelValues[4]
+		bounds, ok := phaseDurations[phase]
+		require.True(t, ok, phase)
+		require.Greater(t, duration, bounds[0])
+		require.Less(t, duration, bounds[1])
+		phases = append(phases, phase)
+	}).Return()
+
 	// Assert close correctly reported.
 	backend.On("SetCloseDuration", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
 		labelValues := args[0].([]string)
@@ -190,7 +211,8 @@ func TestPlugin_GetLatencies(t *testing.T) {
 		reportingPlugin,
 		"test-plugin",
 		"EVM",
-		big.NewInt(1),
+		"1",
+		42,
 		types.ReportingPluginConfig{ConfigDigest: reportTimestamp.ConfigDigest},
 		backend,
 	).(*promPlugin)
@@ -234,6 +256,8 @@ func TestPlugin_GetLatencies(t *testing.T) {
 	_, ok = promPlugin.acceptFinalizedReportEndTimes.Load(reportTimestamp)
 	require.Equal(t, false, ok)
 
+	require.Equal(t, []string{PhaseQuery, PhaseObservation, PhaseReport, PhaseShouldAcceptFinalizedReport, PhaseShouldTransmitAcceptedReport}, phases)
+
 	// Close.
 	err = promPlugin.Close()
 	require.NoError(t, err)
@@ -0,0 +1,94 @@
+package transmitschedule
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+// The strategies selectable with the strategy of the transmissionSchedule of OCR2 job specs.
+const (
+	// StrategyRoundRobin selects the oracles in turn, by epoch and round.
+	StrategyRoundRobin = "roundRobin"
+	// StrategyStakeWeighted selects an oracle at random for each round, in proportion to its weight. The randomness
+	// is derived from the config digest, the epoch and the round, so that all oracles select the same one.
+	StrategyStakeWeighted = "stakeWeighted"
+	// StrategyLatencyAware has each oracle wait in proportion to the latency it observes when checking whether to
+	// transmit, so that the best connected oracles transmit first.
+	StrategyLatencyAware = "latencyAware"
+)
+
+const (
+	defaultFallbackDelay     = 5 * time.Second
+	defaultLatencyMultiplier = 10
+)
+
+// Config is the transmissionSchedule of an OCR2 job spec. It is applied on top of the transmission schedule of the
+// contract config: the oracles not selected by the strategy wait FallbackDelay before checking whether the report
+// still has to be transmitted, so that it is only transmitted by one of them, unless the selected oracle fails to.
+// All the oracles of a DON must use the same config for their selections to match.
+type Config struct {
+	Strategy string `json:"strategy"`
+	// FallbackDelay is how long the oracles not selected wait before transmitting. It is capped to half of the time
+	// left by MaxDurationShouldTransmitAcceptedReport of the contract config.
+	FallbackDelay *commonconfig.Duration `json:"fallbackDelay,omitempty"`
+	// Weights are the weights of the oracles of stakeWeighted, by oracle ID.
+	Weights []uint64 `json:"weights,omitempty"`
+	// LatencyMultiplier multiplies the latency observed by the oracles of latencyAware to get their delay.
+	LatencyMultiplier uint32 `json:"latencyMultiplier,omitempty"`
+}
+
+// ParseConfig parses the transmissionSchedule of a job spec. An empty config disables the schedule, leaving the
+// transmissions to the contract config only.
+func ParseConfig(raw job.JSONConfig) (Config, error) {
+	var cfg Config
+	if len(raw) == 0 {
+		return cfg, nil
+	}
+	if err := json.Unmarshal(raw.Bytes(), &cfg); err != nil {
+		return cfg, fmt.Errorf("invalid transmissionSchedule: %w", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return cfg, fmt.Errorf("invalid transmissionSchedule: %w", err)
+	}
+	return cfg, nil
+}
+
+// Validate checks the strategy is known, and has the parameters it needs.
+func (c Config) Validate() error {
+	switch c.Strategy {
+	case StrategyRoundRobin, StrategyLatencyAware:
+	case StrategyStakeWeighted:
+		var total uint64
+		for _, w := range c.Weights {
+			total += w
+		}
+		if total == 0 {
+			return fmt.Errorf("strategy %s requires weights", c.Strategy)
+		}
+	default:
+		return fmt.Errorf("unknown strategy %q, must be one of %s, %s or %s", c.Strategy, StrategyRoundRobin, StrategyStakeWeighted, StrategyLatencyAware)
+	}
+	if len(c.Weights) > 0 && c.Strategy != StrategyStakeWeighted {
+		return fmt.Errorf("weights are only used by strategy %s", StrategyStakeWeighted)
+	}
+	return nil
+}
+
+func (c Config) fallbackDelay() time.Duration {
+	if c.FallbackDelay == nil {
+		return defaultFallbackDelay
+	}
+	return c.FallbackDelay.Duration()
+}
+
+func (c Config) latencyMultiplier() time.Duration {
+	if c.LatencyMultiplier == 0 {
+		return defaultLatencyMultiplier
+	}
+	return time.Duration(c.LatencyMultiplier)
+}
@@ -0,0 +1,42 @@
+package transmitschedule
+
+import (
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+)
+
+var _ types.ReportingPluginFactory = &scheduleFactory{}
+
+type scheduleFactory struct {
+	wrapped   types.ReportingPluginFactory
+	lggr      logger.Logger
+	cfg       Config
+	name      string
+	chainType string
+	chainID   string
+}
+
+func (f *scheduleFactory) NewReportingPlugin(config types.ReportingPluginConfig) (types.ReportingPlugin, types.ReportingPluginInfo, error) {
+	plugin, info, err := f.wrapped.NewReportingPlugin(config)
+	if err != nil {
+		return nil, types.ReportingPluginInfo{}, err
+	}
+	return New(plugin, f.lggr, f.cfg, config, f.name, f.chainType, f.chainID), info, nil
+}
+
+// NewScheduleFactory wraps the plugins of the factory with the transmission schedule of cfg, or returns the factory as
+// is if the schedule is disabled.
+func NewScheduleFactory(wrapped types.ReportingPluginFactory, lggr logger.Logger, cfg Config, name, chainType, chainID string) types.ReportingPluginFactory {
+	if cfg.Strategy == "" {
+		return wrapped
+	}
+	return &scheduleFactory{
+		wrapped:   wrapped,
+		lggr:      lggr,
+		cfg:       cfg,
+		name:      name,
+		chainType: chainType,
+		chainID:   chainID,
+	}
+}
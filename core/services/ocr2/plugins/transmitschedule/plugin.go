@@ -0,0 +1,149 @@
+// transmitschedule wraps another OCR2 reporting plugin to order the transmissions of its oracles with a strategy
+// selected per job, so that the oracles don't all race to transmit the same report, wasting gas on the reverted
+// transmissions.
+package transmitschedule
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/smartcontractkit/libocr/commontypes"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+)
+
+var promDeferred = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "ocr2_transmission_schedule_deferred_total",
+	Help: "Number of accepted reports whose transmission the oracle deferred, as another oracle was selected to transmit them first",
+}, []string{"chainType", "chainID", "plugin", "strategy"})
+
+// latencyAlpha is the weight of the latest latency in the moving average of latencyAware.
+const latencyAlpha = 0.2
+
+var _ types.ReportingPlugin = &schedulePlugin{}
+
+type schedulePlugin struct {
+	types.ReportingPlugin
+	lggr      logger.SugaredLogger
+	cfg       Config
+	name      string
+	chainType string
+	chainID   string
+
+	oracleID commontypes.OracleID
+	n        int
+	// weights are the weights of stakeWeighted, with their total
+	weights []uint64
+	total   uint64
+
+	latencyMu sync.Mutex
+	latency   time.Duration // moving average of latencyAware
+}
+
+// New wraps the plugin with the transmission schedule of cfg, for the oracle and the DON of the reporting plugin
+// config. If the weights of stakeWeighted don't match the oracles of the DON, the oracles are selected in turn.
+func New(plugin types.ReportingPlugin, lggr logger.Logger, cfg Config, config types.ReportingPluginConfig, name, chainType, chainID string) types.ReportingPlugin {
+	p := &schedulePlugin{
+		ReportingPlugin: plugin,
+		lggr:            logger.Sugared(logger.Named(lggr, "TransmitSchedule")),
+		cfg:             cfg,
+		name:            name,
+		chainType:       chainType,
+		chainID:         chainID,
+		oracleID:        config.OracleID,
+		n:               config.N,
+	}
+	if cfg.Strategy == StrategyStakeWeighted {
+		if len(cfg.Weights) == config.N {
+			p.weights = cfg.Weights
+			for _, w := range cfg.Weights {
+				p.total += w
+			}
+		} else {
+			p.lggr.Errorw("Weights don't match the oracles of the DON, selecting oracles in turn",
+				"weights", len(cfg.Weights), "oracles", config.N)
+		}
+	}
+	return p
+}
+
+// ShouldTransmitAcceptedReport delays the decision of the oracles not selected to transmit the report, so that the
+// wrapped plugin finds out the report was already transmitted by the selected one.
+func (p *schedulePlugin) ShouldTransmitAcceptedReport(ctx context.Context, timestamp types.ReportTimestamp, report types.Report) (bool, error) {
+	if delay := p.delay(timestamp); delay > 0 {
+		if deadline, ok := ctx.Deadline(); ok {
+			// leave the wrapped plugin enough time to check the report
+			delay = min(delay, time.Until(deadline)/2)
+		}
+		promDeferred.WithLabelValues(p.chainType, p.chainID, p.name, p.cfg.Strategy).Inc()
+		p.lggr.Debugw("Deferring transmission", "strategy", p.cfg.Strategy, "delay", delay,
+			"epoch", timestamp.Epoch, "round", timestamp.Round)
+		t := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return false, ctx.Err()
+		case <-t.C:
+		}
+	}
+
+	start := time.Now()
+	should, err := p.ReportingPlugin.ShouldTransmitAcceptedReport(ctx, timestamp, report)
+	if p.cfg.Strategy == StrategyLatencyAware && err == nil {
+		p.observeLatency(time.Since(start))
+	}
+	return should, err
+}
+
+// delay returns how long the oracle waits before checking whether to transmit the report of the round.
+func (p *schedulePlugin) delay(timestamp types.ReportTimestamp) time.Duration {
+	switch p.cfg.Strategy {
+	case StrategyLatencyAware:
+		p.latencyMu.Lock()
+		defer p.latencyMu.Unlock()
+		return min(p.latency*p.cfg.latencyMultiplier(), p.cfg.fallbackDelay())
+	default:
+		if p.selected(timestamp) == p.oracleID {
+			return 0
+		}
+		return p.cfg.fallbackDelay()
+	}
+}
+
+// selected returns the oracle selected to transmit first the report of the round.
+func (p *schedulePlugin) selected(timestamp types.ReportTimestamp) commontypes.OracleID {
+	if p.total == 0 {
+		// in turn, as an epoch has at most 255 rounds
+		turn := uint64(timestamp.Epoch)<<8 | uint64(timestamp.Round)
+		return commontypes.OracleID(turn % uint64(p.n))
+	}
+
+	var b [4 + 1]byte
+	binary.BigEndian.PutUint32(b[:4], timestamp.Epoch)
+	b[4] = timestamp.Round
+	h := sha256.Sum256(append(timestamp.ConfigDigest[:], b[:]...))
+	r := binary.BigEndian.Uint64(h[:8]) % p.total
+	for id, w := range p.weights {
+		if r < w {
+			return commontypes.OracleID(id)
+		}
+		r -= w
+	}
+	return commontypes.OracleID(len(p.weights) - 1)
+}
+
+func (p *schedulePlugin) observeLatency(d time.Duration) {
+	p.latencyMu.Lock()
+	defer p.latencyMu.Unlock()
+	if p.latency == 0 {
+		p.latency = d
+		return
+	}
+	p.latency = time.Duration(latencyAlpha*float64(d) + (1-latencyAlpha)*float64(p.latency))
+}
@@ -0,0 +1,137 @@
+package transmitschedule
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/smartcontractkit/libocr/commontypes"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+type transmitPlugin struct {
+	types.ReportingPlugin
+	latency time.Duration
+	calls   int
+}
+
+func (p *transmitPlugin) ShouldTransmitAcceptedReport(context.Context, types.ReportTimestamp, types.Report) (bool, error) {
+	p.calls++
+	time.Sleep(p.latency)
+	return true, nil
+}
+
+func newPlugins(t *testing.T, cfg Config, n int) []*schedulePlugin {
+	plugins := make([]*schedulePlugin, n)
+	for i := range plugins {
+		config := types.ReportingPluginConfig{OracleID: commontypes.OracleID(i), N: n, ConfigDigest: types.ConfigDigest{1, 2, 3}}
+		plugins[i] = New(&transmitPlugin{}, logger.Test(t), cfg, config, "test", "evm", "1").(*schedulePlugin)
+	}
+	return plugins
+}
+
+func TestSchedulePlugin_selected(t *testing.T) {
+	t.Run("round robin", func(t *testing.T) {
+		plugins := newPlugins(t, Config{Strategy: StrategyRoundRobin}, 4)
+		var selected []commontypes.OracleID
+		for round := uint8(1); round <= 8; round++ {
+			ts := types.ReportTimestamp{Epoch: 3, Round: round}
+			// all oracles select the same one
+			for _, p := range plugins {
+				assert.Equal(t, plugins[0].selected(ts), p.selected(ts))
+			}
+			selected = append(selected, plugins[0].selected(ts))
+		}
+		assert.Equal(t, []commontypes.OracleID{1, 2, 3, 0, 1, 2, 3, 0}, selected)
+	})
+
+	t.Run("stake weighted", func(t *testing.T) {
+		plugins := newPlugins(t, Config{Strategy: StrategyStakeWeighted, Weights: []uint64{0, 1, 3}}, 3)
+		counts := map[commontypes.OracleID]int{}
+		for epoch := uint32(1); epoch <= 1000; epoch++ {
+			ts := types.ReportTimestamp{ConfigDigest: types.ConfigDigest{1, 2, 3}, Epoch: epoch, Round: 1}
+			for _, p := range plugins {
+				assert.Equal(t, plugins[0].selected(ts), p.selected(ts))
+			}
+			counts[plugins[0].selected(ts)]++
+		}
+		assert.Zero(t, counts[0])
+		assert.InDelta(t, 250, counts[1], 60)
+		assert.InDelta(t, 750, counts[2], 60)
+	})
+
+	t.Run("stake weighted falls back to round robin if the weights don't match the DON", func(t *testing.T) {
+		plugins := newPlugins(t, Config{Strategy: StrategyStakeWeighted, Weights: []uint64{1, 3}}, 3)
+		assert.Equal(t, commontypes.OracleID(2), plugins[0].selected(types.ReportTimestamp{Epoch: 1, Round: 1}))
+	})
+}
+
+func TestSchedulePlugin_ShouldTransmitAcceptedReport(t *testing.T) {
+	cfg := Config{Strategy: StrategyRoundRobin, FallbackDelay: commonconfig.MustNewDuration(100 * time.Millisecond)}
+	plugins := newPlugins(t, cfg, 2)
+	ts := types.ReportTimestamp{Epoch: 1, Round: 1}
+	require.Equal(t, commontypes.OracleID(1), plugins[0].selected(ts))
+
+	start := time.Now()
+	should, err := plugins[1].ShouldTransmitAcceptedReport(tests.Context(t), ts, nil)
+	require.NoError(t, err)
+	assert.True(t, should)
+	assert.Less(t, time.Since(start), 100*time.Millisecond)
+
+	start = time.Now()
+	should, err = plugins[0].ShouldTransmitAcceptedReport(tests.Context(t), ts, nil)
+	require.NoError(t, err)
+	assert.True(t, should)
+	assert.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+	assert.Equal(t, float64(1), testutil.ToFloat64(promDeferred.WithLabelValues("evm", "1", "test", StrategyRoundRobin)))
+
+	t.Run("gives up with the context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(tests.Context(t))
+		cancel()
+		_, err := plugins[0].ShouldTransmitAcceptedReport(ctx, ts, nil)
+		require.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 1, plugins[0].ReportingPlugin.(*transmitPlugin).calls)
+	})
+}
+
+func TestSchedulePlugin_latencyAware(t *testing.T) {
+	cfg := Config{Strategy: StrategyLatencyAware, FallbackDelay: commonconfig.MustNewDuration(time.Second), LatencyMultiplier: 2}
+	plugin := newPlugins(t, cfg, 1)[0]
+	plugin.ReportingPlugin.(*transmitPlugin).latency = 20 * time.Millisecond
+
+	// the first check isn't delayed, as no latency was observed yet
+	assert.Zero(t, plugin.delay(types.ReportTimestamp{}))
+	_, err := plugin.ShouldTransmitAcceptedReport(tests.Context(t), types.ReportTimestamp{}, nil)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, plugin.delay(types.ReportTimestamp{}), 40*time.Millisecond)
+
+	plugin.latency = time.Minute
+	assert.Equal(t, time.Second, plugin.delay(types.ReportTimestamp{}))
+}
+
+func TestParseConfig(t *testing.T) {
+	cfg, err := ParseConfig(nil)
+	require.NoError(t, err)
+	assert.Empty(t, cfg.Strategy)
+
+	cfg, err = ParseConfig(job.JSONConfig{"strategy": "stakeWeighted", "weights": []any{1, 2}, "fallbackDelay": "3s"})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{1, 2}, cfg.Weights)
+	assert.Equal(t, 3*time.Second, cfg.fallbackDelay())
+
+	_, err = ParseConfig(job.JSONConfig{"strategy": "fastest"})
+	require.ErrorContains(t, err, `unknown strategy "fastest"`)
+	_, err = ParseConfig(job.JSONConfig{"strategy": "stakeWeighted"})
+	require.ErrorContains(t, err, "requires weights")
+	_, err = ParseConfig(job.JSONConfig{"strategy": "roundRobin", "weights": []any{1}})
+	require.ErrorContains(t, err, "only used by strategy stakeWeighted")
+}
@@ -0,0 +1,53 @@
+package validate
+
+import (
+	"reflect"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+	lloconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/llo/config"
+	mercuryconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/mercury/config"
+)
+
+// pluginTypes are the plugin types supported by validateSpec.
+var pluginTypes = []types.OCR2PluginType{
+	types.Median,
+	types.OCR2Keeper,
+	types.Functions,
+	types.Mercury,
+	types.CCIPExecution,
+	types.CCIPCommit,
+	types.LLO,
+	types.GenericPlugin,
+}
+
+// pluginConfigs are the configs decoded from the pluginConfig of the specs, for the plugin types whose config is
+// validated.
+var pluginConfigs = map[types.OCR2PluginType]any{
+	types.CCIPCommit:    config.CommitPluginJobSpecConfig{},
+	types.CCIPExecution: config.ExecPluginJobSpecConfig{},
+	types.GenericPlugin: OCR2GenericPluginConfig{},
+	types.LLO:           lloconfig.PluginConfig{},
+	types.Mercury:       mercuryconfig.PluginConfig{},
+}
+
+// OCR2SpecSchema returns the schema of the OCR2 specs, with the supported plugin types, and the fields of the
+// pluginConfig of the plugin types whose config is validated.
+func OCR2SpecSchema() (job.SpecSchema, map[types.OCR2PluginType][]job.SpecField) {
+	schema, _ := job.SchemaOf(job.OffchainReporting2)
+	for i := range schema.Fields {
+		if schema.Fields[i].Name == "pluginType" {
+			for _, t := range pluginTypes {
+				schema.Fields[i].Enum = append(schema.Fields[i].Enum, string(t))
+			}
+		}
+	}
+
+	configs := make(map[types.OCR2PluginType][]job.SpecField, len(pluginConfigs))
+	for t, cfg := range pluginConfigs {
+		configs[t] = job.StructFields(reflect.TypeOf(cfg), "json", nil)
+	}
+	return schema, configs
+}
@@ -20,9 +20,11 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/config/env"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/addrcodec"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
 	lloconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/llo/config"
 	mercuryconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/mercury/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/transmitschedule"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
@@ -70,12 +72,48 @@ func ValidatedOracleSpecToml(ctx context.Context, config OCR2Config, insConf Ins
 	if err = validateSpec(ctx, tree, jb, rc); err != nil {
 		return jb, err
 	}
+	if err = validateStandby(spec); err != nil {
+		return jb, err
+	}
+	if err = validateTransmissionSchedule(spec); err != nil {
+		return jb, err
+	}
+	if err = validateReportEncryption(spec); err != nil {
+		return jb, err
+	}
 	if err = validateTimingParameters(config, insConf, spec); err != nil {
 		return jb, err
 	}
 	return jb, nil
 }
 
+func validateTransmissionSchedule(spec job.OCR2OracleSpec) error {
+	cfg, err := transmitschedule.ParseConfig(spec.TransmissionSchedule)
+	if err != nil || cfg.Strategy == "" {
+		return err
+	}
+	switch spec.PluginType {
+	case types.Median, types.CCIPCommit, types.CCIPExecution:
+		return nil
+	default:
+		return pkgerrors.Errorf("transmissionSchedule is not supported for pluginType %s", spec.PluginType)
+	}
+}
+
+// validateReportEncryption checks that the reports of the plugin can be encrypted. The key itself is resolved when the
+// job starts, since it may be imported after the job is created.
+func validateReportEncryption(spec job.OCR2OracleSpec) error {
+	if !spec.ReportEncryptionKeyID.Valid {
+		return nil
+	}
+	switch spec.PluginType {
+	case types.Functions, types.GenericPlugin:
+		return nil
+	default:
+		return pkgerrors.Errorf("reportEncryptionKeyID is not supported for pluginType %s", spec.PluginType)
+	}
+}
+
 // Parameters that must be explicitly set by the operator.
 var (
 	params = map[string]struct{}{
@@ -92,7 +130,25 @@ var (
 	}
 )
 
+func validateStandby(spec job.OCR2OracleSpec) error {
+	if !spec.Standby {
+		return nil
+	}
+	switch spec.PluginType {
+	case types.Median, types.CCIPCommit, types.CCIPExecution:
+		return nil
+	default:
+		return pkgerrors.Errorf("standby is not supported for pluginType %s", spec.PluginType)
+	}
+}
+
 func validateTimingParameters(ocr2Conf OCR2Config, insConf InsecureConfig, spec job.OCR2OracleSpec) error {
+	if spec.ObservationTimeout.Duration() < 0 {
+		return pkgerrors.Errorf("observationTimeout must not be negative, got %s", spec.ObservationTimeout.Duration())
+	}
+	if spec.ReportTimeout.Duration() < 0 {
+		return pkgerrors.Errorf("reportTimeout must not be negative, got %s", spec.ReportTimeout.Duration())
+	}
 	lc, err := ToLocalConfig(ocr2Conf, insConf, spec)
 	if err != nil {
 		return err
@@ -121,7 +177,7 @@ func validateSpec(ctx context.Context, tree *toml.Tree, spec job.Job, rc plugins
 	case types.CCIPExecution:
 		return validateOCR2CCIPExecutionSpec(spec.OCR2OracleSpec.PluginConfig)
 	case types.CCIPCommit:
-		return validateOCR2CCIPCommitSpec(spec.OCR2OracleSpec.PluginConfig)
+		return validateOCR2CCIPCommitSpec(spec.OCR2OracleSpec.PluginConfig, spec.OCR2OracleSpec.Relay)
 	case types.LLO:
 		return validateOCR2LLOSpec(spec.OCR2OracleSpec.PluginConfig)
 	case types.GenericPlugin:
@@ -329,12 +385,14 @@ func validateOCR2CCIPExecutionSpec(jsonConfig job.JSONConfig) error {
 		return pkgerrors.Wrap(err, "error while unmarshalling plugin config")
 	}
 	if cfg.USDCConfig != (config.USDCConfig{}) {
-		return cfg.USDCConfig.ValidateUSDCConfig()
+		if err = cfg.USDCConfig.ValidateUSDCConfig(); err != nil {
+			return err
+		}
 	}
-	return nil
+	return pkgerrors.Wrap(cfg.FeeBoosting.Validate(), "invalid FeeBoosting")
 }
 
-func validateOCR2CCIPCommitSpec(jsonConfig job.JSONConfig) error {
+func validateOCR2CCIPCommitSpec(jsonConfig job.JSONConfig, destFamily string) error {
 	if jsonConfig == nil {
 		return errors.New("pluginConfig is empty")
 	}
@@ -366,6 +424,27 @@ func validateOCR2CCIPCommitSpec(jsonConfig job.JSONConfig) error {
 		}
 	}
 
+	destAddressCodec, err := addrcodec.ForFamily(destFamily)
+	if err != nil {
+		return err
+	}
+	if cfg.OffRamp != "" {
+		if err = addrcodec.Validate(destAddressCodec, cfg.OffRamp); err != nil {
+			return pkgerrors.Wrap(err, "invalid offRamp")
+		}
+	}
+
+	if cfg.QuoteCurrency != nil {
+		if err = cfg.QuoteCurrency.Validate(); err != nil {
+			return pkgerrors.Wrap(err, "invalid quoteCurrency")
+		}
+		if cfg.QuoteCurrency.PriceToken != "" {
+			if err = addrcodec.Validate(destAddressCodec, cfg.QuoteCurrency.PriceToken); err != nil {
+				return pkgerrors.Wrap(err, "invalid quoteCurrency priceToken")
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
 
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
@@ -253,6 +254,226 @@ evm = ""
 				require.Error(t, err)
 			},
 		},
+		{
+			name: "plugin deadlines",
+			toml: `
+type               = "offchainreporting2"
+pluginType         = "median"
+schemaVersion      = 1
+relay              = "evm"
+contractID         = "0x613a38AC1659769640aaE063C651F48E0250454C"
+p2pv2Bootstrappers = ["12D3KooWHfYFQ8hGttAYbMCevQVESEQhzJAqFZokMVtom8bNxwGq@127.0.0.1:5001"]
+observationTimeout = "20s"
+reportTimeout      = "5s"
+observationSource  = """
+ds1          [type=bridge name=voter_turnout];
+ds1_parse    [type=jsonparse path="one,two"];
+ds1_multiply [type=multiply times=1.23];
+ds1 -> ds1_parse -> ds1_multiply -> answer1;
+answer1      [type=median index=0];
+"""
+[relayConfig]
+chainID = 1337
+[onchainSigningStrategy]
+strategyName = "single-chain"
+[onchainSigningStrategy.config]
+evm = ""
+[pluginConfig]
+juelsPerFeeCoinSource = """
+ds1          [type=bridge name=voter_turnout];
+ds1_parse    [type=jsonparse path="one,two"];
+ds1_multiply [type=multiply times=1.23];
+ds1 -> ds1_parse -> ds1_multiply -> answer1;
+answer1      [type=median index=0];
+"""
+`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, 20*time.Second, os.OCR2OracleSpec.ObservationTimeout.Duration())
+				assert.Equal(t, 5*time.Second, os.OCR2OracleSpec.ReportTimeout.Duration())
+			},
+		},
+		{
+			name: "negative plugin deadlines",
+			toml: `
+type               = "offchainreporting2"
+pluginType         = "median"
+schemaVersion      = 1
+relay              = "evm"
+contractID         = "0x613a38AC1659769640aaE063C651F48E0250454C"
+p2pv2Bootstrappers = ["12D3KooWHfYFQ8hGttAYbMCevQVESEQhzJAqFZokMVtom8bNxwGq@127.0.0.1:5001"]
+observationTimeout = "-1s"
+observationSource  = """
+ds1          [type=bridge name=voter_turnout];
+ds1_parse    [type=jsonparse path="one,two"];
+ds1_multiply [type=multiply times=1.23];
+ds1 -> ds1_parse -> ds1_multiply -> answer1;
+answer1      [type=median index=0];
+"""
+[relayConfig]
+chainID = 1337
+[onchainSigningStrategy]
+strategyName = "single-chain"
+[onchainSigningStrategy.config]
+evm = ""
+[pluginConfig]
+juelsPerFeeCoinSource = """
+ds1          [type=bridge name=voter_turnout];
+ds1_parse    [type=jsonparse path="one,two"];
+ds1_multiply [type=multiply times=1.23];
+ds1 -> ds1_parse -> ds1_multiply -> answer1;
+answer1      [type=median index=0];
+"""
+`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.ErrorContains(t, err, "observationTimeout must not be negative")
+			},
+		},
+		{
+			name: "standby",
+			toml: `
+type               = "offchainreporting2"
+pluginType         = "median"
+schemaVersion      = 1
+relay              = "evm"
+contractID         = "0x613a38AC1659769640aaE063C651F48E0250454C"
+p2pv2Bootstrappers = ["12D3KooWHfYFQ8hGttAYbMCevQVESEQhzJAqFZokMVtom8bNxwGq@127.0.0.1:5001"]
+standby            = true
+observationSource  = """
+ds1          [type=bridge name=voter_turnout];
+ds1_parse    [type=jsonparse path="one,two"];
+ds1_multiply [type=multiply times=1.23];
+ds1 -> ds1_parse -> ds1_multiply -> answer1;
+answer1      [type=median index=0];
+"""
+[relayConfig]
+chainID = 1337
+[onchainSigningStrategy]
+strategyName = "single-chain"
+[onchainSigningStrategy.config]
+evm = ""
+[pluginConfig]
+juelsPerFeeCoinSource = """
+ds1          [type=bridge name=voter_turnout];
+ds1_parse    [type=jsonparse path="one,two"];
+ds1_multiply [type=multiply times=1.23];
+ds1 -> ds1_parse -> ds1_multiply -> answer1;
+answer1      [type=median index=0];
+"""
+`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.NoError(t, err)
+				assert.True(t, os.OCR2OracleSpec.Standby)
+			},
+		},
+		{
+			name: "standby unsupported",
+			toml: `
+type               = "offchainreporting2"
+pluginType         = "functions"
+schemaVersion      = 1
+relay              = "evm"
+contractID         = "0x613a38AC1659769640aaE063C651F48E0250454C"
+p2pv2Bootstrappers = ["12D3KooWHfYFQ8hGttAYbMCevQVESEQhzJAqFZokMVtom8bNxwGq@127.0.0.1:5001"]
+standby            = true
+[relayConfig]
+chainID = 1337
+`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.ErrorContains(t, err, "standby is not supported for pluginType functions")
+			},
+		},
+		{
+			name: "invalid transmission schedule",
+			toml: `
+type               = "offchainreporting2"
+pluginType         = "median"
+schemaVersion      = 1
+relay              = "evm"
+contractID         = "0x613a38AC1659769640aaE063C651F48E0250454C"
+p2pv2Bootstrappers = ["12D3KooWHfYFQ8hGttAYbMCevQVESEQhzJAqFZokMVtom8bNxwGq@127.0.0.1:5001"]
+observationSource  = """
+ds1          [type=bridge name=voter_turnout];
+ds1_parse    [type=jsonparse path="one,two"];
+ds1_multiply [type=multiply times=1.23];
+ds1 -> ds1_parse -> ds1_multiply -> answer1;
+answer1      [type=median index=0];
+"""
+[relayConfig]
+chainID = 1337
+[onchainSigningStrategy]
+strategyName = "single-chain"
+[onchainSigningStrategy.config]
+evm = ""
+[pluginConfig]
+juelsPerFeeCoinSource = """
+ds1          [type=bridge name=voter_turnout];
+ds1_parse    [type=jsonparse path="one,two"];
+ds1_multiply [type=multiply times=1.23];
+ds1 -> ds1_parse -> ds1_multiply -> answer1;
+answer1      [type=median index=0];
+"""
+[transmissionSchedule]
+strategy = "stakeWeighted"
+`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.ErrorContains(t, err, "invalid transmissionSchedule: strategy stakeWeighted requires weights")
+			},
+		},
+		{
+			name: "transmission schedule unsupported",
+			toml: `
+type               = "offchainreporting2"
+pluginType         = "functions"
+schemaVersion      = 1
+relay              = "evm"
+contractID         = "0x613a38AC1659769640aaE063C651F48E0250454C"
+p2pv2Bootstrappers = ["12D3KooWHfYFQ8hGttAYbMCevQVESEQhzJAqFZokMVtom8bNxwGq@127.0.0.1:5001"]
+[relayConfig]
+chainID = 1337
+[transmissionSchedule]
+strategy = "roundRobin"
+`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.ErrorContains(t, err, "transmissionSchedule is not supported for pluginType functions")
+			},
+		},
+		{
+			name: "report encryption unsupported",
+			toml: `
+type               = "offchainreporting2"
+pluginType         = "median"
+schemaVersion      = 1
+relay              = "evm"
+contractID         = "0x613a38AC1659769640aaE063C651F48E0250454C"
+p2pv2Bootstrappers = ["12D3KooWHfYFQ8hGttAYbMCevQVESEQhzJAqFZokMVtom8bNxwGq@127.0.0.1:5001"]
+reportEncryptionKeyID = "8f3ca0a3e2f2b1f0e0c7a1b5c6d1f0a0e4b9c8d7e6f5a4b3c2d1e0f9a8b7c6d5"
+observationSource  = """
+ds1          [type=bridge name=voter_turnout];
+ds1_parse    [type=jsonparse path="one,two"];
+ds1_multiply [type=multiply times=1.23];
+ds1 -> ds1_parse -> ds1_multiply -> answer1;
+answer1      [type=median index=0];
+"""
+[relayConfig]
+chainID = 1337
+[onchainSigningStrategy]
+strategyName = "single-chain"
+[onchainSigningStrategy.config]
+evm = ""
+[pluginConfig]
+juelsPerFeeCoinSource = """
+ds1          [type=bridge name=voter_turnout];
+ds1_parse    [type=jsonparse path="one,two"];
+ds1_multiply [type=multiply times=1.23];
+ds1 -> ds1_parse -> ds1_multiply -> answer1;
+answer1      [type=median index=0];
+"""
+`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.ErrorContains(t, err, "reportEncryptionKeyID is not supported for pluginType median")
+			},
+		},
 		{
 			name: "non-zero intervals",
 			toml: `
@@ -674,3 +895,23 @@ evm = "08d14c6eed757414d72055d28de6caf06535806c6a14e450f3a2f1c854420e17"
 	assert.False(t, oss.OnchainSigningStrategy.IsMultiChain())
 	assert.Equal(t, "08d14c6eed757414d72055d28de6caf06535806c6a14e450f3a2f1c854420e17", kbID)
 }
+
+func TestOCR2SpecSchema(t *testing.T) {
+	schema, pluginConfigs := validate.OCR2SpecSchema()
+	assert.Equal(t, job.OffchainReporting2, schema.Type)
+
+	var pluginType job.SpecField
+	for _, f := range schema.Fields {
+		if f.Name == "pluginType" {
+			pluginType = f
+		}
+	}
+	assert.True(t, pluginType.Required)
+	assert.Contains(t, pluginType.Enum, string(types.CCIPCommit))
+	assert.Contains(t, pluginType.Enum, string(types.CCIPExecution))
+
+	require.Contains(t, pluginConfigs, types.CCIPCommit)
+	assert.Contains(t, pluginConfigs[types.CCIPCommit], job.SpecField{Name: "offRamp", Type: job.FieldTypeString})
+	require.Contains(t, pluginConfigs, types.CCIPExecution)
+	assert.NotContains(t, pluginConfigs, types.Median)
+}
@@ -0,0 +1,136 @@
+package ocrcommon
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/smartcontractkit/libocr/commontypes"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+)
+
+// BootstrapperOverride changes the bootstrap peers of the OCR jobs of a DON, identified by its chain and contract,
+// without changing their specs. It is meant to recover quickly from bootstrapper outages or misconfigured announce
+// addresses.
+type BootstrapperOverride struct {
+	ChainID    string `db:"chain_id"`
+	ContractID string `db:"contract_id"`
+	// Added are the locators of the bootstrap peers to add, in the peerID@host:port format. They replace the
+	// bootstrap peers with the same peer ID.
+	Added pq.StringArray `db:"added"`
+	// Removed are the peer IDs of the bootstrap peers to remove.
+	Removed   pq.StringArray `db:"removed"`
+	UpdatedAt time.Time      `db:"updated_at"`
+}
+
+// Validate returns an error if the override is incomplete, or if its locators can't be parsed.
+func (o BootstrapperOverride) Validate() error {
+	if o.ChainID == "" {
+		return errors.New("chainID is required")
+	}
+	if o.ContractID == "" {
+		return errors.New("contractID is required")
+	}
+	if len(o.Added) == 0 && len(o.Removed) == 0 {
+		return errors.New("at least one bootstrap peer must be added or removed")
+	}
+	if _, err := ParseBootstrapPeers(o.Added); err != nil {
+		return fmt.Errorf("invalid added bootstrap peer: %w", err)
+	}
+	return nil
+}
+
+// Apply returns peers without the removed bootstrap peers and with the added ones. It errors if no bootstrap peer is
+// left.
+func (o BootstrapperOverride) Apply(peers []commontypes.BootstrapperLocator) ([]commontypes.BootstrapperLocator, error) {
+	added, err := ParseBootstrapPeers(o.Added)
+	if err != nil {
+		return nil, err
+	}
+	var result []commontypes.BootstrapperLocator
+	for _, p := range peers {
+		replaced := slices.ContainsFunc(added, func(a commontypes.BootstrapperLocator) bool { return a.PeerID == p.PeerID })
+		if !replaced && !slices.Contains(o.Removed, p.PeerID) {
+			result = append(result, p)
+		}
+	}
+	result = append(result, added...)
+	if len(result) == 0 {
+		return nil, errors.New("no bootstrappers left after applying the bootstrapper override")
+	}
+	return result, nil
+}
+
+// BootstrapperOverridesORM persists the BootstrapperOverrides.
+type BootstrapperOverridesORM struct {
+	ds sqlutil.DataSource
+}
+
+func NewBootstrapperOverridesORM(ds sqlutil.DataSource) *BootstrapperOverridesORM {
+	return &BootstrapperOverridesORM{ds: ds}
+}
+
+// Upsert stores the override, replacing any previous override of the same DON.
+func (o *BootstrapperOverridesORM) Upsert(ctx context.Context, override BootstrapperOverride) (BootstrapperOverride, error) {
+	var stored BootstrapperOverride
+	err := o.ds.GetContext(ctx, &stored, `
+INSERT INTO p2p_bootstrapper_overrides (chain_id, contract_id, added, removed, updated_at)
+VALUES ($1, $2, $3, $4, NOW()) ON CONFLICT (chain_id, contract_id) DO UPDATE SET
+added = EXCLUDED.added,
+removed = EXCLUDED.removed,
+updated_at = EXCLUDED.updated_at
+RETURNING *`, override.ChainID, override.ContractID, pq.StringArray(nonNil(override.Added)), pq.StringArray(nonNil(override.Removed)))
+	if err != nil {
+		return BootstrapperOverride{}, fmt.Errorf("failed to upsert bootstrapper override: %w", err)
+	}
+	return stored, nil
+}
+
+// Delete deletes the override of a DON. It returns sql.ErrNoRows if there is none.
+func (o *BootstrapperOverridesORM) Delete(ctx context.Context, chainID, contractID string) error {
+	res, err := o.ds.ExecContext(ctx, `DELETE FROM p2p_bootstrapper_overrides WHERE chain_id = $1 AND contract_id = $2`, chainID, contractID)
+	if err != nil {
+		return fmt.Errorf("failed to delete bootstrapper override: %w", err)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete bootstrapper override: %w", err)
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// Find returns the override of a DON, or nil if there is none.
+func (o *BootstrapperOverridesORM) Find(ctx context.Context, chainID, contractID string) (*BootstrapperOverride, error) {
+	var override BootstrapperOverride
+	err := o.ds.GetContext(ctx, &override, `SELECT * FROM p2p_bootstrapper_overrides WHERE chain_id = $1 AND contract_id = $2`, chainID, contractID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to find bootstrapper override: %w", err)
+	}
+	return &override, nil
+}
+
+// List returns all the overrides, sorted by chain and contract.
+func (o *BootstrapperOverridesORM) List(ctx context.Context) ([]BootstrapperOverride, error) {
+	var overrides []BootstrapperOverride
+	if err := o.ds.SelectContext(ctx, &overrides, `SELECT * FROM p2p_bootstrapper_overrides ORDER BY chain_id, contract_id`); err != nil {
+		return nil, fmt.Errorf("failed to list bootstrapper overrides: %w", err)
+	}
+	return overrides, nil
+}
+
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
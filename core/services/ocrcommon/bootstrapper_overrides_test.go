@@ -0,0 +1,93 @@
+package ocrcommon_test
+
+import (
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
+)
+
+func TestBootstrapperOverride_Apply(t *testing.T) {
+	peer1, peer2, peer3 := mustRandomP2PPeerID(t).Raw(), mustRandomP2PPeerID(t).Raw(), mustRandomP2PPeerID(t).Raw()
+	peers, err := ocrcommon.ParseBootstrapPeers([]string{peer1 + "@10.0.0.1:6690", peer2 + "@10.0.0.2:6690"})
+	require.NoError(t, err)
+
+	t.Run("adds, replaces and removes bootstrap peers", func(t *testing.T) {
+		override := ocrcommon.BootstrapperOverride{
+			Added:   pq.StringArray{peer2 + "@10.0.1.2:6690", peer3 + "@10.0.0.3:6690"},
+			Removed: pq.StringArray{peer1},
+		}
+		got, err := override.Apply(peers)
+		require.NoError(t, err)
+		want, err := ocrcommon.ParseBootstrapPeers(override.Added)
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("errors if no bootstrap peer is left", func(t *testing.T) {
+		override := ocrcommon.BootstrapperOverride{Removed: pq.StringArray{peer1, peer2}}
+		_, err := override.Apply(peers)
+		require.Error(t, err)
+	})
+}
+
+func TestBootstrapperOverride_Validate(t *testing.T) {
+	peer := mustRandomP2PPeerID(t).Raw()
+	valid := ocrcommon.BootstrapperOverride{ChainID: "1", ContractID: "0x01", Added: pq.StringArray{peer + "@10.0.0.1:6690"}}
+	require.NoError(t, valid.Validate())
+
+	empty := valid
+	empty.Added = nil
+	require.Error(t, empty.Validate())
+
+	invalid := valid
+	invalid.Added = pq.StringArray{"10.0.0.1:6690"}
+	require.Error(t, invalid.Validate())
+
+	noContract := valid
+	noContract.ContractID = ""
+	require.Error(t, noContract.Validate())
+}
+
+func TestBootstrapperOverridesORM(t *testing.T) {
+	ctx := testutils.Context(t)
+	orm := ocrcommon.NewBootstrapperOverridesORM(pgtest.NewSqlxDB(t))
+	peer := mustRandomP2PPeerID(t).Raw()
+
+	override, err := orm.Find(ctx, "1", "0x01")
+	require.NoError(t, err)
+	assert.Nil(t, override)
+
+	stored, err := orm.Upsert(ctx, ocrcommon.BootstrapperOverride{ChainID: "1", ContractID: "0x01", Removed: pq.StringArray{peer}})
+	require.NoError(t, err)
+	assert.Equal(t, pq.StringArray{}, stored.Added)
+	assert.Equal(t, pq.StringArray{peer}, stored.Removed)
+
+	_, err = orm.Upsert(ctx, ocrcommon.BootstrapperOverride{ChainID: "1", ContractID: "0x01", Added: pq.StringArray{peer + "@10.0.0.1:6690"}})
+	require.NoError(t, err)
+	_, err = orm.Upsert(ctx, ocrcommon.BootstrapperOverride{ChainID: "2", ContractID: "0x01", Removed: pq.StringArray{peer}})
+	require.NoError(t, err)
+
+	override, err = orm.Find(ctx, "1", "0x01")
+	require.NoError(t, err)
+	require.NotNil(t, override)
+	assert.Equal(t, pq.StringArray{peer + "@10.0.0.1:6690"}, override.Added)
+	assert.Equal(t, pq.StringArray{}, override.Removed)
+
+	overrides, err := orm.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, overrides, 2)
+	assert.Equal(t, "1", overrides[0].ChainID)
+	assert.Equal(t, "2", overrides[1].ChainID)
+
+	require.NoError(t, orm.Delete(ctx, "1", "0x01"))
+	require.Error(t, orm.Delete(ctx, "1", "0x01"))
+	overrides, err = orm.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, overrides, 1)
+}
@@ -0,0 +1,105 @@
+package ocrcommon
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+var (
+	promSourceDeviation = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ocr_median_source_deviation",
+		Help: "Deviation of the secondary data source from the primary one, as a fraction of the primary observation",
+	},
+		[]string{"job_id", "job_name"})
+	promSourceDivergences = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ocr_median_source_divergences",
+		Help: "Number of observations dropped, because the secondary data source deviated too much from the primary one",
+	},
+		[]string{"job_id", "job_name"})
+)
+
+// consensusDataSource only returns the observations of the primary data source, which the secondary one agrees with.
+type consensusDataSource struct {
+	primary      median.DataSource
+	secondary    median.DataSource
+	maxDeviation *big.Rat
+	jb           job.Job
+	lggr         logger.Logger
+}
+
+var _ median.DataSource = (*consensusDataSource)(nil)
+
+// NewConsensusDataSource returns a DataSource which observes both sources, and returns the primary observation only if
+// the secondary one deviates from it by at most maxDeviation, as a fraction of the primary observation. It protects
+// feeds from bugs in a single pipeline.
+func NewConsensusDataSource(primary, secondary median.DataSource, maxDeviation float64, jb job.Job, lggr logger.Logger) median.DataSource {
+	return &consensusDataSource{
+		primary:      primary,
+		secondary:    secondary,
+		maxDeviation: new(big.Rat).SetFloat64(maxDeviation),
+		jb:           jb,
+		lggr:         lggr.Named("ConsensusDataSource"),
+	}
+}
+
+type sourceObservation struct {
+	value *big.Int
+	err   error
+}
+
+func (ds *consensusDataSource) Observe(ctx context.Context, timestamp ocr2types.ReportTimestamp) (*big.Int, error) {
+	chSecondary := make(chan sourceObservation, 1)
+	go func() {
+		value, err := ds.secondary.Observe(ctx, timestamp)
+		chSecondary <- sourceObservation{value, err}
+	}()
+
+	primary, err := ds.primary.Observe(ctx, timestamp)
+	if err != nil {
+		return nil, err
+	}
+	var secondary sourceObservation
+	select {
+	case secondary = <-chSecondary:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if secondary.err != nil {
+		return nil, fmt.Errorf("secondary data source failed, observation can not be checked: %w", secondary.err)
+	}
+
+	deviation := relativeDeviation(primary, secondary.value)
+	jobID, jobName := fmt.Sprintf("%d", ds.jb.ID), ds.jb.Name.ValueOrZero()
+	deviationFloat, _ := deviation.Float64()
+	promSourceDeviation.WithLabelValues(jobID, jobName).Set(deviationFloat)
+	if deviation.Cmp(ds.maxDeviation) > 0 {
+		promSourceDivergences.WithLabelValues(jobID, jobName).Inc()
+		ds.lggr.Criticalw("Data sources diverged, dropping observation",
+			"primary", primary, "secondary", secondary.value, "deviation", deviation.FloatString(6), "maxDeviation", ds.maxDeviation.FloatString(6),
+			"epoch", timestamp.Epoch, "round", timestamp.Round)
+		return nil, fmt.Errorf("secondary data source observed %s, which deviates from %s by %s, more than %s",
+			secondary.value, primary, deviation.FloatString(6), ds.maxDeviation.FloatString(6))
+	}
+	return primary, nil
+}
+
+// relativeDeviation returns |a - b| / |a|, or 1 if a is zero and b is not.
+func relativeDeviation(a, b *big.Int) *big.Rat {
+	diff := new(big.Int).Sub(a, b)
+	if diff.Sign() == 0 {
+		return new(big.Rat)
+	}
+	if a.Sign() == 0 {
+		return big.NewRat(1, 1)
+	}
+	return new(big.Rat).SetFrac(diff.Abs(diff), new(big.Int).Abs(a))
+}
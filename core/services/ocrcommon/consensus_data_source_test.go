@@ -0,0 +1,56 @@
+package ocrcommon_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
+)
+
+type fixedDataSource struct {
+	value *big.Int
+	err   error
+}
+
+func (ds fixedDataSource) Observe(context.Context, types.ReportTimestamp) (*big.Int, error) {
+	return ds.value, ds.err
+}
+
+func Test_ConsensusDataSource(t *testing.T) {
+	errSource := errors.New("source failed")
+	for _, tc := range []struct {
+		name      string
+		primary   fixedDataSource
+		secondary fixedDataSource
+		expected  *big.Int
+		expectErr string
+	}{
+		{"equal", fixedDataSource{value: big.NewInt(1000)}, fixedDataSource{value: big.NewInt(1000)}, big.NewInt(1000), ""},
+		{"within tolerance", fixedDataSource{value: big.NewInt(1000)}, fixedDataSource{value: big.NewInt(1010)}, big.NewInt(1000), ""},
+		{"at tolerance", fixedDataSource{value: big.NewInt(-1000)}, fixedDataSource{value: big.NewInt(-980)}, big.NewInt(-1000), ""},
+		{"diverged", fixedDataSource{value: big.NewInt(1000)}, fixedDataSource{value: big.NewInt(1021)}, nil, "secondary data source observed 1021, which deviates from 1000 by 0.021000, more than 0.020000"},
+		{"diverged from zero", fixedDataSource{value: big.NewInt(0)}, fixedDataSource{value: big.NewInt(1)}, nil, "secondary data source observed 1, which deviates from 0 by 1.000000, more than 0.020000"},
+		{"primary failed", fixedDataSource{err: errSource}, fixedDataSource{value: big.NewInt(1000)}, nil, "source failed"},
+		{"secondary failed", fixedDataSource{value: big.NewInt(1000)}, fixedDataSource{err: errSource}, nil, "secondary data source failed, observation can not be checked: source failed"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ds := ocrcommon.NewConsensusDataSource(tc.primary, tc.secondary, 0.02, job.Job{}, logger.TestLogger(t))
+			val, err := ds.Observe(testutils.Context(t), types.ReportTimestamp{})
+			if tc.expectErr != "" {
+				require.EqualError(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, val)
+		})
+	}
+}
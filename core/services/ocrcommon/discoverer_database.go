@@ -88,3 +88,10 @@ func (d *DiscovererDatabase) ReadAnnouncements(ctx context.Context, peerIDs []st
 	}
 	return results, nil
 }
+
+// CountAnnouncements returns the number of announcements stored for the local peer.
+func (d *DiscovererDatabase) CountAnnouncements(ctx context.Context) (count int, err error) {
+	q := fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE local_peer_id = $1`, d.tableName)
+	err = d.ds.GetContext(ctx, &count, q, d.peerID)
+	return count, errors.Wrap(err, "DiscovererDatabase failed to CountAnnouncements")
+}
@@ -78,6 +78,16 @@ func Test_DiscovererDatabase(t *testing.T) {
 			assert.Equal(t, []byte{4, 5, 6}, announcements["remote1"])
 		})
 
+		t.Run(fmt.Sprintf("%s CountAnnouncements counts the values of the local peer ID", tt.name), func(t *testing.T) {
+			count, err := dd1.CountAnnouncements(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, 2, count)
+
+			count, err = dd2.CountAnnouncements(ctx)
+			require.NoError(t, err)
+			assert.Zero(t, count)
+		})
+
 		t.Run(fmt.Sprintf("%s is scoped to local peer ID", tt.name), func(t *testing.T) {
 			ann := []byte{10, 11, 12}
 			err := dd2.StoreAnnouncement(ctx, "remote1", ann)
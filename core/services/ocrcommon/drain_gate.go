@@ -0,0 +1,64 @@
+package ocrcommon
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+)
+
+// ErrDraining is returned for the transmissions of OCR reports while the node is draining.
+var ErrDraining = errors.New("node is draining, new OCR transmissions are refused")
+
+// DrainGate refuses the transmissions of OCR reports once the node is draining, so that no new transactions are created
+// while the transactions in flight are drained. The oracles keep running, and the reports are transmitted by the other
+// oracles of the DON.
+type DrainGate struct {
+	draining atomic.Bool
+}
+
+// Drain closes the gate.
+func (g *DrainGate) Drain() {
+	g.draining.Store(true)
+}
+
+// Draining reports whether the gate is closed.
+func (g *DrainGate) Draining() bool {
+	return g.draining.Load()
+}
+
+// ContractTransmitter returns ct, refusing transmissions with ErrDraining once the gate is closed.
+func (g *DrainGate) ContractTransmitter(ct ocrtypes.ContractTransmitter) ocrtypes.ContractTransmitter {
+	return &drainGatedContractTransmitter{ContractTransmitter: ct, gate: g}
+}
+
+type drainGatedContractTransmitter struct {
+	ocrtypes.ContractTransmitter
+	gate *DrainGate
+}
+
+func (t *drainGatedContractTransmitter) Transmit(ctx context.Context, reportCtx ocrtypes.ReportContext, report ocrtypes.Report, sigs []ocrtypes.AttributedOnchainSignature) error {
+	if t.gate.Draining() {
+		return ErrDraining
+	}
+	return t.ContractTransmitter.Transmit(ctx, reportCtx, report, sigs)
+}
+
+// DrainGatedOCR3ContractTransmitter returns ct, refusing transmissions with ErrDraining once g is closed.
+func DrainGatedOCR3ContractTransmitter[RI any](g *DrainGate, ct ocr3types.ContractTransmitter[RI]) ocr3types.ContractTransmitter[RI] {
+	return &drainGatedOCR3ContractTransmitter[RI]{ContractTransmitter: ct, gate: g}
+}
+
+type drainGatedOCR3ContractTransmitter[RI any] struct {
+	ocr3types.ContractTransmitter[RI]
+	gate *DrainGate
+}
+
+func (t *drainGatedOCR3ContractTransmitter[RI]) Transmit(ctx context.Context, digest ocrtypes.ConfigDigest, seqNr uint64, r ocr3types.ReportWithInfo[RI], sigs []ocrtypes.AttributedOnchainSignature) error {
+	if t.gate.Draining() {
+		return ErrDraining
+	}
+	return t.ContractTransmitter.Transmit(ctx, digest, seqNr, r, sigs)
+}
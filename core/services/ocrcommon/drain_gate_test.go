@@ -0,0 +1,63 @@
+package ocrcommon_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
+)
+
+type countingContractTransmitter struct {
+	ocrtypes.ContractTransmitter
+	transmitted int
+}
+
+func (f *countingContractTransmitter) Transmit(context.Context, ocrtypes.ReportContext, ocrtypes.Report, []ocrtypes.AttributedOnchainSignature) error {
+	f.transmitted++
+	return nil
+}
+
+type countingOCR3ContractTransmitter struct {
+	ocr3types.ContractTransmitter[struct{}]
+	transmitted int
+}
+
+func (f *countingOCR3ContractTransmitter) Transmit(context.Context, ocrtypes.ConfigDigest, uint64, ocr3types.ReportWithInfo[struct{}], []ocrtypes.AttributedOnchainSignature) error {
+	f.transmitted++
+	return nil
+}
+
+func TestDrainGate_ContractTransmitter(t *testing.T) {
+	ctx := testutils.Context(t)
+	var gate ocrcommon.DrainGate
+	fake := &countingContractTransmitter{}
+	ct := gate.ContractTransmitter(fake)
+
+	require.NoError(t, ct.Transmit(ctx, ocrtypes.ReportContext{}, nil, nil))
+	assert.Equal(t, 1, fake.transmitted)
+
+	gate.Drain()
+	assert.True(t, gate.Draining())
+	require.ErrorIs(t, ct.Transmit(ctx, ocrtypes.ReportContext{}, nil, nil), ocrcommon.ErrDraining)
+	assert.Equal(t, 1, fake.transmitted)
+}
+
+func TestDrainGate_OCR3ContractTransmitter(t *testing.T) {
+	ctx := testutils.Context(t)
+	var gate ocrcommon.DrainGate
+	fake := &countingOCR3ContractTransmitter{}
+	ct := ocrcommon.DrainGatedOCR3ContractTransmitter[struct{}](&gate, fake)
+
+	require.NoError(t, ct.Transmit(ctx, ocrtypes.ConfigDigest{}, 1, ocr3types.ReportWithInfo[struct{}]{}, nil))
+	assert.Equal(t, 1, fake.transmitted)
+
+	gate.Drain()
+	require.ErrorIs(t, ct.Transmit(ctx, ocrtypes.ConfigDigest{}, 2, ocr3types.ReportWithInfo[struct{}]{}, nil), ocrcommon.ErrDraining)
+	assert.Equal(t, 1, fake.transmitted)
+}
@@ -1,7 +1,15 @@
 package ocrcommon
 
-import ocrnetworking "github.com/smartcontractkit/libocr/networking"
+import (
+	"time"
+
+	ocrnetworking "github.com/smartcontractkit/libocr/networking"
+)
 
 func (p *SingletonPeerWrapper) PeerConfig() (ocrnetworking.PeerConfig, error) {
 	return p.peerConfig()
 }
+
+func (d *PeerDiagnostics) SetNow(now func() time.Time) {
+	d.now = now
+}
@@ -0,0 +1,208 @@
+package ocrcommon
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/smartcontractkit/libocr/commontypes"
+)
+
+// remotePeerIDLabel is the label of the per-peer ragep2p metrics registered by libocr
+const remotePeerIDLabel = "remote_peer_id"
+
+// PeerDiagnostics collects the state of the libocr peer, to diagnose OCR network formation problems without going
+// through the debug logs. It tracks the per-peer ragep2p metrics, which libocr registers with the Registerer of the
+// peer, and the dial failures it logs.
+type PeerDiagnostics struct {
+	registry *prometheus.Registry
+	now      func() time.Time
+
+	mu           sync.Mutex
+	dialFailures map[string]*dialFailures
+	sampledAt    time.Time
+	samples      map[string]map[string]float64
+}
+
+type dialFailures struct {
+	count  uint64
+	err    string
+	lastAt time.Time
+}
+
+// PeerStatus is the state of a remote peer.
+type PeerStatus struct {
+	PeerID string
+	// Connected reports whether any traffic was exchanged with the peer since the previous snapshot.
+	Connected bool
+	// Metrics are the ragep2p metrics of the peer, sorted by name.
+	Metrics           []PeerMetric
+	DialFailures      uint64
+	LastDialError     string
+	LastDialFailureAt time.Time
+}
+
+// PeerMetric is a ragep2p metric of a remote peer.
+type PeerMetric struct {
+	Name  string
+	Value float64
+	// Rate is the change of Value per second since the previous snapshot, or 0 for the first one.
+	Rate float64
+}
+
+func NewPeerDiagnostics() *PeerDiagnostics {
+	return &PeerDiagnostics{
+		registry:     prometheus.NewRegistry(),
+		now:          time.Now,
+		dialFailures: map[string]*dialFailures{},
+	}
+}
+
+// Registerer returns a prometheus.Registerer which registers collectors with both r and the diagnostics.
+func (d *PeerDiagnostics) Registerer(r prometheus.Registerer) prometheus.Registerer {
+	return &diagnosticsRegisterer{Registerer: r, registry: d.registry}
+}
+
+// Logger returns a commontypes.Logger which records the dial failures logged to l.
+func (d *PeerDiagnostics) Logger(l commontypes.Logger) commontypes.Logger {
+	return &diagnosticsLogger{Logger: l, d: d}
+}
+
+// Peers returns the status of the remote peers which the peer exchanged traffic with or failed to dial, sorted by
+// peer ID. Metric rates are computed since the previous call.
+func (d *PeerDiagnostics) Peers() ([]PeerStatus, error) {
+	families, err := d.registry.Gather()
+	if err != nil {
+		return nil, fmt.Errorf("failed to gather peer metrics: %w", err)
+	}
+	samples := map[string]map[string]float64{}
+	for _, family := range families {
+		for _, m := range family.GetMetric() {
+			var peerID string
+			for _, l := range m.GetLabel() {
+				if l.GetName() == remotePeerIDLabel {
+					peerID = l.GetValue()
+				}
+			}
+			if peerID == "" {
+				continue
+			}
+			var value float64
+			switch {
+			case m.Counter != nil:
+				value = m.Counter.GetValue()
+			case m.Gauge != nil:
+				value = m.Gauge.GetValue()
+			default:
+				continue
+			}
+			if samples[peerID] == nil {
+				samples[peerID] = map[string]float64{}
+			}
+			samples[peerID][family.GetName()] += value
+		}
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	now := d.now()
+	elapsed := now.Sub(d.sampledAt).Seconds()
+	peers := map[string]*PeerStatus{}
+	for peerID, metrics := range samples {
+		p := &PeerStatus{PeerID: peerID}
+		for name, value := range metrics {
+			metric := PeerMetric{Name: name, Value: value}
+			if prev, ok := d.samples[peerID][name]; ok && elapsed > 0 {
+				metric.Rate = (value - prev) / elapsed
+			}
+			p.Connected = p.Connected || metric.Rate > 0
+			p.Metrics = append(p.Metrics, metric)
+		}
+		sort.Slice(p.Metrics, func(i, j int) bool { return p.Metrics[i].Name < p.Metrics[j].Name })
+		peers[peerID] = p
+	}
+	for peerID, f := range d.dialFailures {
+		p, ok := peers[peerID]
+		if !ok {
+			p = &PeerStatus{PeerID: peerID}
+			peers[peerID] = p
+		}
+		p.DialFailures, p.LastDialError, p.LastDialFailureAt = f.count, f.err, f.lastAt
+	}
+	d.samples, d.sampledAt = samples, now
+
+	statuses := make([]PeerStatus, 0, len(peers))
+	for _, p := range peers {
+		statuses = append(statuses, *p)
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].PeerID < statuses[j].PeerID })
+	return statuses, nil
+}
+
+func (d *PeerDiagnostics) recordLog(msg string, fields commontypes.LogFields) {
+	peerID, ok := fields["remotePeerID"]
+	if !ok || !strings.Contains(strings.ToLower(msg), "dial") {
+		return
+	}
+	errMsg := msg
+	if err, ok := fields["error"]; ok {
+		errMsg = fmt.Sprintf("%s: %v", msg, err)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	key := fmt.Sprint(peerID)
+	f, ok := d.dialFailures[key]
+	if !ok {
+		f = &dialFailures{}
+		d.dialFailures[key] = f
+	}
+	f.count++
+	f.err = errMsg
+	f.lastAt = d.now()
+}
+
+type diagnosticsRegisterer struct {
+	prometheus.Registerer
+	registry *prometheus.Registry
+}
+
+func (r *diagnosticsRegisterer) Register(c prometheus.Collector) error {
+	if err := r.Registerer.Register(c); err != nil {
+		return err
+	}
+	// The diagnostics must not fail the peer
+	_ = r.registry.Register(c)
+	return nil
+}
+
+func (r *diagnosticsRegisterer) MustRegister(cs ...prometheus.Collector) {
+	for _, c := range cs {
+		if err := r.Register(c); err != nil {
+			panic(err)
+		}
+	}
+}
+
+func (r *diagnosticsRegisterer) Unregister(c prometheus.Collector) bool {
+	r.registry.Unregister(c)
+	return r.Registerer.Unregister(c)
+}
+
+// diagnosticsLogger records the dial failures among the warnings and errors about remote peers.
+type diagnosticsLogger struct {
+	commontypes.Logger
+	d *PeerDiagnostics
+}
+
+func (l *diagnosticsLogger) Warn(msg string, fields commontypes.LogFields) {
+	l.d.recordLog(msg, fields)
+	l.Logger.Warn(msg, fields)
+}
+
+func (l *diagnosticsLogger) Error(msg string, fields commontypes.LogFields) {
+	l.d.recordLog(msg, fields)
+	l.Logger.Error(msg, fields)
+}
@@ -0,0 +1,65 @@
+package ocrcommon_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/smartcontractkit/libocr/commontypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
+)
+
+func TestPeerDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	d := ocrcommon.NewPeerDiagnostics()
+	now := time.Unix(1700000000, 0)
+	d.SetNow(func() time.Time { return now })
+
+	registry := prometheus.NewRegistry()
+	registerer := d.Registerer(registry)
+	written := prometheus.NewCounter(prometheus.CounterOpts{
+		Name:        "ragep2p_peer_conn_written_bytes_total",
+		ConstLabels: prometheus.Labels{"remote_peer_id": "peer-a"},
+	})
+	registerer.MustRegister(written)
+	registerer.MustRegister(prometheus.NewCounter(prometheus.CounterOpts{Name: "unrelated_total"}))
+
+	lggr := d.Logger(logger.NewOCRWrapper(logger.Test(t), false, func(string) {}))
+	lggr.Warn("Dial failed", commontypes.LogFields{"remotePeerID": "peer-b", "error": errors.New("connection refused")})
+	lggr.Warn("Dial failed", commontypes.LogFields{"remotePeerID": "peer-b", "error": errors.New("i/o timeout")})
+	lggr.Warn("Unrelated warning", commontypes.LogFields{"remotePeerID": "peer-a"})
+
+	written.Add(100)
+	peers, err := d.Peers()
+	require.NoError(t, err)
+	require.Len(t, peers, 2)
+
+	assert.Equal(t, "peer-a", peers[0].PeerID)
+	assert.False(t, peers[0].Connected)
+	assert.Equal(t, []ocrcommon.PeerMetric{{Name: "ragep2p_peer_conn_written_bytes_total", Value: 100}}, peers[0].Metrics)
+	assert.Zero(t, peers[0].DialFailures)
+
+	assert.Equal(t, "peer-b", peers[1].PeerID)
+	assert.Equal(t, uint64(2), peers[1].DialFailures)
+	assert.Equal(t, "Dial failed: i/o timeout", peers[1].LastDialError)
+	assert.Equal(t, now, peers[1].LastDialFailureAt)
+
+	written.Add(50)
+	now = now.Add(10 * time.Second)
+	peers, err = d.Peers()
+	require.NoError(t, err)
+	assert.True(t, peers[0].Connected)
+	assert.Equal(t, []ocrcommon.PeerMetric{{Name: "ragep2p_peer_conn_written_bytes_total", Value: 150, Rate: 5}}, peers[0].Metrics)
+
+	// the metrics are registered with the wrapped registerer too
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	assert.Len(t, families, 2)
+}
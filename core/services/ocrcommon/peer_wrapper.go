@@ -50,6 +50,9 @@ type (
 		// Used at shutdown to stop all of this peer's goroutines
 		peerCloser io.Closer
 
+		diagnostics  *PeerDiagnostics
+		discovererDB *DiscovererDatabase
+
 		// OCR1 peer adapter
 		Peer1 *peerAdapterOCR1
 
@@ -70,11 +73,12 @@ func ValidatePeerWrapperConfig(config config.P2P) error {
 // It should be fairly easy to modify it to support multiple peerIDs/keys using e.g. a map
 func NewSingletonPeerWrapper(keyStore keystore.Master, p2pCfg config.P2P, ocrCfg PeerWrapperOCRConfig, ds sqlutil.DataSource, lggr logger.Logger) *SingletonPeerWrapper {
 	return &SingletonPeerWrapper{
-		keyStore: keyStore,
-		p2pCfg:   p2pCfg,
-		ocrCfg:   ocrCfg,
-		ds:       ds,
-		lggr:     lggr.Named("SingletonPeerWrapper"),
+		keyStore:    keyStore,
+		p2pCfg:      p2pCfg,
+		ocrCfg:      ocrCfg,
+		ds:          ds,
+		lggr:        lggr.Named("SingletonPeerWrapper"),
+		diagnostics: NewPeerDiagnostics(),
 	}
 }
 
@@ -118,25 +122,25 @@ func (p *SingletonPeerWrapper) peerConfig() (ocrnetworking.PeerConfig, error) {
 	}
 	p.PeerID = key.PeerID()
 
-	discovererDB := NewOCRDiscovererDatabase(p.ds, p.PeerID.Raw())
+	p.discovererDB = NewOCRDiscovererDatabase(p.ds, p.PeerID.Raw())
 
 	config := p.p2pCfg
 	peerConfig := ocrnetworking.PeerConfig{
 		PrivKey: key.PrivKey,
-		Logger:  commonlogger.NewOCRWrapper(p.lggr, p.ocrCfg.TraceLogging(), func(string) {}),
+		Logger:  p.diagnostics.Logger(commonlogger.NewOCRWrapper(p.lggr, p.ocrCfg.TraceLogging(), func(string) {})),
 
 		// V2 config
 		V2ListenAddresses:    config.V2().ListenAddresses(),
 		V2AnnounceAddresses:  config.V2().AnnounceAddresses(), // NewPeer will handle the fallback to listen addresses for us.
 		V2DeltaReconcile:     config.V2().DeltaReconcile().Duration(),
 		V2DeltaDial:          config.V2().DeltaDial().Duration(),
-		V2DiscovererDatabase: discovererDB,
+		V2DiscovererDatabase: p.discovererDB,
 
 		V2EndpointConfig: ocrnetworking.EndpointConfigV2{
 			IncomingMessageBufferSize: config.IncomingMessageBufferSize(),
 			OutgoingMessageBufferSize: config.OutgoingMessageBufferSize(),
 		},
-		MetricsRegisterer:            prometheus.DefaultRegisterer,
+		MetricsRegisterer:            p.diagnostics.Registerer(prometheus.DefaultRegisterer),
 		LatencyMetricsServiceConfigs: rageping.DefaultConfigs(),
 	}
 
@@ -164,3 +168,30 @@ func (p *SingletonPeerWrapper) HealthReport() map[string]error {
 func (p *SingletonPeerWrapper) P2PConfig() config.P2P {
 	return p.p2pCfg
 }
+
+// PeerWrapperDiagnostics is the state of the peer, for diagnosing OCR network formation problems.
+type PeerWrapperDiagnostics struct {
+	PeerID            p2pkey.PeerID
+	AnnounceAddresses []string
+	// DiscoveryTableSize is the number of peer announcements stored by the discoverer.
+	DiscoveryTableSize int
+	Peers              []PeerStatus
+}
+
+// Diagnostics returns the state of the peer. The rates of the metrics of the remote peers are computed since the
+// previous call.
+func (p *SingletonPeerWrapper) Diagnostics(ctx context.Context) (d PeerWrapperDiagnostics, err error) {
+	if err = p.Ready(); err != nil {
+		return d, err
+	}
+	d.PeerID = p.PeerID
+	d.AnnounceAddresses = p.p2pCfg.V2().AnnounceAddresses()
+	if len(d.AnnounceAddresses) == 0 {
+		d.AnnounceAddresses = p.p2pCfg.V2().ListenAddresses()
+	}
+	if d.DiscoveryTableSize, err = p.discovererDB.CountAnnouncements(ctx); err != nil {
+		return d, err
+	}
+	d.Peers, err = p.diagnostics.Peers()
+	return d, err
+}
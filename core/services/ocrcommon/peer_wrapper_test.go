@@ -53,6 +53,12 @@ func Test_SingletonPeerWrapper_Start(t *testing.T) {
 
 		servicetest.Run(t, pw)
 		require.Equal(t, k.PeerID(), pw.PeerID)
+
+		d, err := pw.Diagnostics(ctx)
+		require.NoError(t, err)
+		require.Equal(t, k.PeerID(), d.PeerID)
+		require.Equal(t, cfg.P2P().V2().ListenAddresses(), d.AnnounceAddresses)
+		require.Zero(t, d.DiscoveryTableSize)
 	})
 
 	t.Run("with one p2p key and mismatching P2P.PeerID returns error", func(t *testing.T) {
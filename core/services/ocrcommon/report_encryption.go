@@ -0,0 +1,121 @@
+package ocrcommon
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	libocr2 "github.com/smartcontractkit/libocr/offchainreporting2plus"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/reportkey"
+)
+
+// EncryptReports makes an OCR2 oracle encrypt the reports of its plugin with key, which must be shared by all the
+// oracles of the DON. Reports are encrypted as soon as the plugin generates them, so they are exchanged between the
+// oracles and persisted as ciphertext, and only decrypted to be checked by the plugin, signed, verified and
+// transmitted. The ReportingPluginFactory, OnchainKeyring and ContractTransmitter of args must be set.
+func EncryptReports(args *libocr2.OCR2OracleArgs, key reportkey.Key) {
+	args.ReportingPluginFactory = &encryptingReportingPluginFactory{args.ReportingPluginFactory, key}
+	args.OnchainKeyring = &decryptingOnchainKeyring{args.OnchainKeyring, key}
+	args.ContractTransmitter = &decryptingContractTransmitter{args.ContractTransmitter, key}
+}
+
+// reportAdditionalData binds an encrypted report to the round it was generated in, so that it can't be replayed in
+// another one.
+func reportAdditionalData(ts ocrtypes.ReportTimestamp) []byte {
+	ad := make([]byte, 0, len(ts.ConfigDigest)+4+1)
+	ad = append(ad, ts.ConfigDigest[:]...)
+	ad = binary.BigEndian.AppendUint32(ad, ts.Epoch)
+	return append(ad, ts.Round)
+}
+
+func decryptReport(key reportkey.Key, ts ocrtypes.ReportTimestamp, report ocrtypes.Report) (ocrtypes.Report, error) {
+	plaintext, err := key.Decrypt(report, reportAdditionalData(ts))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt report of epoch %d round %d: %w", ts.Epoch, ts.Round, err)
+	}
+	return plaintext, nil
+}
+
+type encryptingReportingPluginFactory struct {
+	factory ocrtypes.ReportingPluginFactory
+	key     reportkey.Key
+}
+
+func (f *encryptingReportingPluginFactory) NewReportingPlugin(config ocrtypes.ReportingPluginConfig) (ocrtypes.ReportingPlugin, ocrtypes.ReportingPluginInfo, error) {
+	plugin, info, err := f.factory.NewReportingPlugin(config)
+	if err != nil {
+		return nil, info, err
+	}
+	info.Limits.MaxReportLength += reportkey.Overhead
+	return &encryptingReportingPlugin{plugin, f.key}, info, nil
+}
+
+type encryptingReportingPlugin struct {
+	ocrtypes.ReportingPlugin
+	key reportkey.Key
+}
+
+func (p *encryptingReportingPlugin) Report(ctx context.Context, ts ocrtypes.ReportTimestamp, query ocrtypes.Query, observations []ocrtypes.AttributedObservation) (bool, ocrtypes.Report, error) {
+	shouldReport, report, err := p.ReportingPlugin.Report(ctx, ts, query, observations)
+	if err != nil || !shouldReport {
+		return shouldReport, report, err
+	}
+	encrypted, err := p.key.Encrypt(report, reportAdditionalData(ts))
+	if err != nil {
+		return false, nil, fmt.Errorf("failed to encrypt report: %w", err)
+	}
+	return true, encrypted, nil
+}
+
+func (p *encryptingReportingPlugin) ShouldAcceptFinalizedReport(ctx context.Context, ts ocrtypes.ReportTimestamp, report ocrtypes.Report) (bool, error) {
+	plaintext, err := decryptReport(p.key, ts, report)
+	if err != nil {
+		return false, err
+	}
+	return p.ReportingPlugin.ShouldAcceptFinalizedReport(ctx, ts, plaintext)
+}
+
+func (p *encryptingReportingPlugin) ShouldTransmitAcceptedReport(ctx context.Context, ts ocrtypes.ReportTimestamp, report ocrtypes.Report) (bool, error) {
+	plaintext, err := decryptReport(p.key, ts, report)
+	if err != nil {
+		return false, err
+	}
+	return p.ReportingPlugin.ShouldTransmitAcceptedReport(ctx, ts, plaintext)
+}
+
+// decryptingOnchainKeyring signs and verifies the plaintext of the reports, since the signatures are checked onchain.
+type decryptingOnchainKeyring struct {
+	ocrtypes.OnchainKeyring
+	key reportkey.Key
+}
+
+func (k *decryptingOnchainKeyring) Sign(reportCtx ocrtypes.ReportContext, report ocrtypes.Report) ([]byte, error) {
+	plaintext, err := decryptReport(k.key, reportCtx.ReportTimestamp, report)
+	if err != nil {
+		return nil, err
+	}
+	return k.OnchainKeyring.Sign(reportCtx, plaintext)
+}
+
+func (k *decryptingOnchainKeyring) Verify(publicKey ocrtypes.OnchainPublicKey, reportCtx ocrtypes.ReportContext, report ocrtypes.Report, signature []byte) bool {
+	plaintext, err := decryptReport(k.key, reportCtx.ReportTimestamp, report)
+	if err != nil {
+		return false
+	}
+	return k.OnchainKeyring.Verify(publicKey, reportCtx, plaintext, signature)
+}
+
+type decryptingContractTransmitter struct {
+	ocrtypes.ContractTransmitter
+	key reportkey.Key
+}
+
+func (t *decryptingContractTransmitter) Transmit(ctx context.Context, reportCtx ocrtypes.ReportContext, report ocrtypes.Report, signatures []ocrtypes.AttributedOnchainSignature) error {
+	plaintext, err := decryptReport(t.key, reportCtx.ReportTimestamp, report)
+	if err != nil {
+		return err
+	}
+	return t.ContractTransmitter.Transmit(ctx, reportCtx, plaintext, signatures)
+}
@@ -0,0 +1,123 @@
+package ocrcommon_test
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+
+	libocr2 "github.com/smartcontractkit/libocr/offchainreporting2plus"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/reportkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
+)
+
+type sensitiveReportingPlugin struct {
+	ocrtypes.ReportingPlugin
+	report   ocrtypes.Report
+	accepted ocrtypes.Report
+}
+
+func (p *sensitiveReportingPlugin) Report(context.Context, ocrtypes.ReportTimestamp, ocrtypes.Query, []ocrtypes.AttributedObservation) (bool, ocrtypes.Report, error) {
+	return true, p.report, nil
+}
+
+func (p *sensitiveReportingPlugin) ShouldAcceptFinalizedReport(_ context.Context, _ ocrtypes.ReportTimestamp, report ocrtypes.Report) (bool, error) {
+	p.accepted = report
+	return true, nil
+}
+
+type sensitiveReportingPluginFactory struct {
+	plugin *sensitiveReportingPlugin
+}
+
+func (f sensitiveReportingPluginFactory) NewReportingPlugin(ocrtypes.ReportingPluginConfig) (ocrtypes.ReportingPlugin, ocrtypes.ReportingPluginInfo, error) {
+	return f.plugin, ocrtypes.ReportingPluginInfo{Name: "sensitive", Limits: ocrtypes.ReportingPluginLimits{MaxReportLength: 100}}, nil
+}
+
+type recordingContractTransmitter struct {
+	ocrtypes.ContractTransmitter
+	transmitted ocrtypes.Report
+}
+
+func (t *recordingContractTransmitter) Transmit(_ context.Context, _ ocrtypes.ReportContext, report ocrtypes.Report, _ []ocrtypes.AttributedOnchainSignature) error {
+	t.transmitted = report
+	return nil
+}
+
+func newEncryptingOracleArgs(key reportkey.Key, plaintext ocrtypes.Report) (libocr2.OCR2OracleArgs, *sensitiveReportingPlugin, *recordingContractTransmitter, ocr2key.KeyBundle) {
+	plugin := &sensitiveReportingPlugin{report: plaintext}
+	transmitter := &recordingContractTransmitter{}
+	kb := ocr2key.MustNewInsecure(rand.Reader, chaintype.EVM)
+	args := libocr2.OCR2OracleArgs{
+		ReportingPluginFactory: sensitiveReportingPluginFactory{plugin},
+		OnchainKeyring:         kb,
+		ContractTransmitter:    transmitter,
+	}
+	ocrcommon.EncryptReports(&args, key)
+	return args, plugin, transmitter, kb
+}
+
+func TestEncryptReports(t *testing.T) {
+	ctx := testutils.Context(t)
+	key, err := reportkey.New()
+	require.NoError(t, err)
+	plaintext := ocrtypes.Report("sensitive report")
+
+	args1, plugin1, transmitter1, kb1 := newEncryptingOracleArgs(key, plaintext)
+	args2, _, _, _ := newEncryptingOracleArgs(key.Raw().Key(), plaintext)
+
+	p1, info, err := args1.ReportingPluginFactory.NewReportingPlugin(ocrtypes.ReportingPluginConfig{})
+	require.NoError(t, err)
+	assert.Equal(t, 100+reportkey.Overhead, info.Limits.MaxReportLength)
+	p2, _, err := args2.ReportingPluginFactory.NewReportingPlugin(ocrtypes.ReportingPluginConfig{})
+	require.NoError(t, err)
+
+	ts := ocrtypes.ReportTimestamp{ConfigDigest: ocrtypes.ConfigDigest{1}, Epoch: 2, Round: 3}
+	reportCtx := ocrtypes.ReportContext{ReportTimestamp: ts}
+	otherCtx := ocrtypes.ReportContext{ReportTimestamp: ocrtypes.ReportTimestamp{ConfigDigest: ts.ConfigDigest, Epoch: 2, Round: 4}}
+
+	shouldReport, report, err := p1.Report(ctx, ts, nil, nil)
+	require.NoError(t, err)
+	require.True(t, shouldReport)
+	assert.NotContains(t, string(report), string(plaintext))
+	_, report2, err := p2.Report(ctx, ts, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, report, report2, "oracles sharing the key must agree on the report")
+
+	t.Run("plugin checks the plaintext", func(t *testing.T) {
+		accept, err := p1.ShouldAcceptFinalizedReport(ctx, ts, report)
+		require.NoError(t, err)
+		assert.True(t, accept)
+		assert.Equal(t, plaintext, plugin1.accepted)
+
+		_, err = p1.ShouldAcceptFinalizedReport(ctx, otherCtx.ReportTimestamp, report)
+		require.ErrorContains(t, err, "failed to decrypt report of epoch 2 round 4")
+	})
+
+	t.Run("signatures are over the plaintext", func(t *testing.T) {
+		sig, err := args1.OnchainKeyring.Sign(reportCtx, report)
+		require.NoError(t, err)
+		assert.True(t, kb1.Verify(kb1.PublicKey(), reportCtx, plaintext, sig))
+		assert.True(t, args2.OnchainKeyring.Verify(kb1.PublicKey(), reportCtx, report, sig))
+		assert.False(t, args2.OnchainKeyring.Verify(kb1.PublicKey(), otherCtx, report, sig))
+
+		_, err = args1.OnchainKeyring.Sign(reportCtx, plaintext)
+		require.Error(t, err)
+	})
+
+	t.Run("transmits the plaintext", func(t *testing.T) {
+		require.NoError(t, args1.ContractTransmitter.Transmit(ctx, reportCtx, report, nil))
+		assert.Equal(t, plaintext, transmitter1.transmitted)
+
+		other, err := reportkey.New()
+		require.NoError(t, err)
+		args3, _, _, _ := newEncryptingOracleArgs(other, plaintext)
+		require.Error(t, args3.ContractTransmitter.Transmit(ctx, reportCtx, report, nil))
+	})
+}
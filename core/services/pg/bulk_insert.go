@@ -0,0 +1,154 @@
+package pg
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/stdlib"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+)
+
+// ErrCopyUnsupported is returned by BulkInserter.Transact when the driver of the database doesn't support COPY, like
+// the txdb driver of the tests, so that callers can fall back to INSERT statements.
+var ErrCopyUnsupported = errors.New("database driver does not support COPY")
+
+// BulkInserter inserts many rows at once with the COPY protocol of the pgx driver, which is much faster than INSERT
+// statements for thousands of rows. COPY is not part of database/sql, so it needs the *sqlx.DB itself, rather than a
+// sqlutil.DataSource, and its transactions are opened on connections of their own. The statements of the transactions,
+// COPY included, still run through the hooks of the application's DataSource, for their timeouts and logging.
+//
+// A COPY costs more round trips than a single INSERT statement, so callers should only copy sets of at least MinRows
+// rows, as reported by Copies. Rows are only batched within a call: callers need their rows stored by the time the
+// call returns, in the same transaction as their other statements.
+type BulkInserter struct {
+	db        *sqlx.DB
+	lggr      logger.Logger
+	hooks     []sqlutil.QueryHook
+	flushSize int
+	minRows   int
+}
+
+// NewBulkInserter returns a BulkInserter for db, running its statements through hooks, or nil if bulk inserts are
+// disabled.
+func NewBulkInserter(db *sqlx.DB, lggr logger.Logger, cfg config.BulkInsert, hooks ...sqlutil.QueryHook) *BulkInserter {
+	if !cfg.Enabled() {
+		return nil
+	}
+	return &BulkInserter{db: db, lggr: logger.Named(lggr, "BulkInserter"), hooks: hooks, flushSize: int(cfg.FlushSize()), minRows: int(cfg.MinRows())}
+}
+
+// Copies returns true if rows should be copied by b, that is if b is not nil and rows is at least MinRows.
+func (b *BulkInserter) Copies(rows int) bool {
+	return b != nil && rows >= b.minRows
+}
+
+// Transact runs fn in a transaction, on a connection which rows can be copied to with BulkTx.CopyFrom. It returns
+// ErrCopyUnsupported without calling fn if the driver of the connection doesn't support COPY.
+func (b *BulkInserter) Transact(ctx context.Context, fn func(tx *BulkTx) error) error {
+	conn, err := b.db.Connx(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	var supported bool
+	if err = conn.Raw(func(dc any) error {
+		_, supported = pgxConn(dc)
+		return nil
+	}); err != nil {
+		return err
+	}
+	if !supported {
+		return ErrCopyUnsupported
+	}
+
+	return sqlutil.TransactConn(ctx, func(ds sqlutil.DataSource) *BulkTx {
+		return &BulkTx{DataSource: sqlutil.WrapDataSource(ds, b.lggr, b.hooks...), bulk: b, conn: conn, used: map[string]bool{}}
+	}, conn, nil, fn)
+}
+
+// hook runs do through the hooks of b, like a statement of a wrapped DataSource.
+func (b *BulkInserter) hook(ctx context.Context, do func(context.Context) error, query string, args ...any) error {
+	for i := len(b.hooks) - 1; i >= 0; i-- {
+		next, prev := b.hooks[i], do
+		do = func(ctx context.Context) error {
+			return next(ctx, b.lggr, prev, query, args...)
+		}
+	}
+	return do(ctx)
+}
+
+// BulkTx is a transaction opened by BulkInserter.Transact.
+type BulkTx struct {
+	sqlutil.DataSource
+	bulk *BulkInserter
+	conn *sqlx.Conn
+	// used holds the temporary tables which rows were copied into by the transaction
+	used map[string]bool
+}
+
+// CopyFrom copies rows into a temporary table with the columns of table, in batches of at most FlushSize rows, and
+// runs query with args after each batch. COPY can't resolve conflicts, so query inserts the batch from the temporary
+// table, which is named by the %s verb of query, like:
+//
+//	INSERT INTO evm.logs (...) SELECT ... FROM %s ON CONFLICT DO NOTHING
+func (tx *BulkTx) CopyFrom(ctx context.Context, table string, columns []string, rows [][]any, query string, args ...any) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	temp := "bulk_" + strings.ReplaceAll(table, ".", "_")
+	// the temporary table is kept by the connection, and emptied at the end of each transaction
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`CREATE TEMP TABLE IF NOT EXISTS %s ON COMMIT DELETE ROWS AS SELECT %s FROM %s WITH NO DATA`,
+		temp, strings.Join(columns, ", "), table)); err != nil {
+		return fmt.Errorf("failed to create temporary table for %s: %w", table, err)
+	}
+
+	query = fmt.Sprintf(query, temp)
+	copyQuery := fmt.Sprintf("COPY %s (%s) FROM STDIN", temp, strings.Join(columns, ", "))
+	flushSize := tx.bulk.flushSize
+	for start := 0; start < len(rows); start += flushSize {
+		end := min(start+flushSize, len(rows))
+		// the temporary table is empty at the start of the transaction, until rows are copied into it
+		if tx.used[temp] {
+			if _, err := tx.ExecContext(ctx, `TRUNCATE `+temp); err != nil {
+				return fmt.Errorf("failed to truncate temporary table for %s: %w", table, err)
+			}
+		}
+		if err := tx.bulk.hook(ctx, func(ctx context.Context) error {
+			return tx.conn.Raw(func(dc any) error {
+				c, _ := pgxConn(dc)
+				_, err := c.CopyFrom(ctx, pgx.Identifier{temp}, columns, pgx.CopyFromRows(rows[start:end]))
+				return err
+			})
+		}, copyQuery); err != nil {
+			return fmt.Errorf("failed to copy %d rows into %s: %w", end-start, table, err)
+		}
+		tx.used[temp] = true
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("failed to insert %d rows into %s: %w", end-start, table, err)
+		}
+	}
+	return nil
+}
+
+// pgxConn returns the pgx connection of a driver connection, if it has one, unwrapping the connection of the otelsql
+// driver.
+func pgxConn(dc any) (*pgx.Conn, bool) {
+	if wrapped, ok := dc.(interface{ Raw() driver.Conn }); ok {
+		dc = wrapped.Raw()
+	}
+	c, ok := dc.(*stdlib.Conn)
+	if !ok {
+		return nil, false
+	}
+	return c.Conn(), true
+}
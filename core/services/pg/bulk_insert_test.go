@@ -0,0 +1,118 @@
+package pg_test
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonlogger "github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/utils/testutils/heavyweight"
+)
+
+type bulkInsertConfig struct {
+	enabled   bool
+	flushSize uint32
+	minRows   uint32
+}
+
+func (c bulkInsertConfig) Enabled() bool     { return c.enabled }
+func (c bulkInsertConfig) FlushSize() uint32 { return c.flushSize }
+func (c bulkInsertConfig) MinRows() uint32   { return c.minRows }
+
+func TestBulkInserter(t *testing.T) {
+	ctx := testutils.Context(t)
+
+	t.Run("disabled", func(t *testing.T) {
+		bulk := pg.NewBulkInserter(pgtest.NewSqlxDB(t), logger.TestLogger(t), bulkInsertConfig{enabled: false, flushSize: 2})
+		assert.Nil(t, bulk)
+		assert.False(t, bulk.Copies(1000))
+	})
+
+	t.Run("copies at least MinRows", func(t *testing.T) {
+		bulk := pg.NewBulkInserter(pgtest.NewSqlxDB(t), logger.TestLogger(t), bulkInsertConfig{enabled: true, flushSize: 2, minRows: 10})
+		assert.False(t, bulk.Copies(9))
+		assert.True(t, bulk.Copies(10))
+	})
+
+	t.Run("unsupported driver", func(t *testing.T) {
+		bulk := pg.NewBulkInserter(pgtest.NewSqlxDB(t), logger.TestLogger(t), bulkInsertConfig{enabled: true, flushSize: 2})
+		err := bulk.Transact(ctx, func(tx *pg.BulkTx) error {
+			t.Fatal("called in a transaction without COPY")
+			return nil
+		})
+		require.ErrorIs(t, err, pg.ErrCopyUnsupported)
+	})
+
+	// We need full db here, because COPY is not supported by the driver of pgtest.NewSqlxDB(t).
+	_, db := heavyweight.FullTestDBNoFixturesV2(t, func(c *chainlink.Config, s *chainlink.Secrets) {})
+	_, err := db.ExecContext(ctx, `CREATE TABLE bulk_insert_test (
+		id BIGINT PRIMARY KEY,
+		value TEXT NOT NULL,
+		created_at TIMESTAMPTZ NOT NULL
+	)`)
+	require.NoError(t, err)
+	var mu sync.Mutex
+	var queries []string
+	var hook sqlutil.QueryHook = func(ctx context.Context, lggr commonlogger.Logger, do func(context.Context) error, query string, args ...any) error {
+		mu.Lock()
+		queries = append(queries, query)
+		mu.Unlock()
+		return do(ctx)
+	}
+	bulk := pg.NewBulkInserter(db, logger.TestLogger(t), bulkInsertConfig{enabled: true, flushSize: 2}, hook)
+	copyRows := func(rows [][]any) error {
+		return bulk.Transact(ctx, func(tx *pg.BulkTx) error {
+			return tx.CopyFrom(ctx, "bulk_insert_test", []string{"id", "value"}, rows,
+				`INSERT INTO bulk_insert_test (id, value, created_at) SELECT id, value, NOW() FROM %s ON CONFLICT (id) DO UPDATE SET value = $1`, "updated")
+		})
+	}
+	values := func() map[int64]string {
+		var rows []struct {
+			ID    int64
+			Value string
+		}
+		require.NoError(t, db.SelectContext(ctx, &rows, `SELECT id, value FROM bulk_insert_test`))
+		values := map[int64]string{}
+		for _, r := range rows {
+			values[r.ID] = r.Value
+		}
+		return values
+	}
+
+	require.NoError(t, copyRows([][]any{{int64(1), "a"}, {int64(2), "b"}, {int64(3), "c"}}))
+	assert.Equal(t, map[int64]string{1: "a", 2: "b", 3: "c"}, values())
+
+	t.Run("runs the statements through the hooks", func(t *testing.T) {
+		mu.Lock()
+		defer mu.Unlock()
+		var copies int
+		for _, q := range queries {
+			if strings.HasPrefix(q, "COPY ") {
+				copies++
+			}
+		}
+		assert.Equal(t, 2, copies)
+		assert.Contains(t, queries, "TRUNCATE bulk_bulk_insert_test")
+	})
+
+	t.Run("resolves conflicts with the query", func(t *testing.T) {
+		require.NoError(t, copyRows([][]any{{int64(3), "c"}, {int64(4), "d"}}))
+		assert.Equal(t, map[int64]string{1: "a", 2: "b", 3: "updated", 4: "d"}, values())
+	})
+
+	t.Run("rolls back all the batches on error", func(t *testing.T) {
+		require.Error(t, copyRows([][]any{{int64(5), "e"}, {int64(6), "f"}, {int64(7), nil}}))
+		assert.Len(t, values(), 4)
+	})
+}
@@ -0,0 +1,34 @@
+package pg
+
+import (
+	"context"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+)
+
+const healthCheckTimeout = 5 * time.Second
+
+var _ services.HealthReporter = (*HealthReporter)(nil)
+
+// HealthReporter reports whether the database can be queried.
+type HealthReporter struct {
+	ds sqlutil.DataSource
+}
+
+func NewHealthReporter(ds sqlutil.DataSource) *HealthReporter {
+	return &HealthReporter{ds: ds}
+}
+
+func (h *HealthReporter) Name() string { return "Database" }
+
+func (h *HealthReporter) Ready() error { return nil }
+
+// HealthReport reports the database as failing if a trivial query does not succeed in time.
+func (h *HealthReporter) HealthReport() map[string]error {
+	ctx, cancel := context.WithTimeout(context.Background(), healthCheckTimeout)
+	defer cancel()
+	_, err := h.ds.ExecContext(ctx, "SELECT 1")
+	return map[string]error{h.Name(): err}
+}
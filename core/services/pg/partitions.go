@@ -0,0 +1,523 @@
+package pg
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// PartitionedTable is a table partitioned by ranges of an integer key. Rows outside of the ranges of the partitions
+// are stored in a default partition, from which they are moved once a partition is created for their range.
+type PartitionedTable struct {
+	Schema string
+	Name   string
+	// Key is the column which the table is partitioned by.
+	Key string
+	// Range is the range of keys of each partition.
+	Range uint64
+	// TimestampColumn is the column used to determine the age of the rows of a partition.
+	TimestampColumn string
+	// Retention is how long partitions are kept after their latest row. Partitions are kept forever if it is 0.
+	Retention time.Duration
+	// ActiveKeys returns the keys which are currently written to. Partitions are created ahead of them, and they are
+	// never dropped.
+	ActiveKeys func(ctx context.Context, ds sqlutil.DataSource) ([]int64, error)
+	// Retained returns whether the partition, given as a sanitized identifier, holds rows which must be kept past
+	// Retention. It is optional.
+	Retained func(ctx context.Context, ds sqlutil.DataSource, partition string) (bool, error)
+	// Dropped is called with the range of keys of a partition which was dropped past Retention, from lo up to hi,
+	// exclusive, in the same DB transaction. It is optional.
+	Dropped func(ctx context.Context, ds sqlutil.DataSource, lo, hi int64) error
+}
+
+// EVMLogsTable returns the PartitionedTable of the logs of the LogPoller, partitioned by block number. The latest
+// blocks of the chains are written to. Partitions are kept as long as they hold logs which are retained by a filter,
+// including logs of filters with no retention, which are kept forever.
+func EVMLogsTable(blockRange uint64, retention time.Duration) PartitionedTable {
+	return PartitionedTable{
+		Schema:          "evm",
+		Name:            "logs",
+		Key:             "block_number",
+		Range:           blockRange,
+		TimestampColumn: "block_timestamp",
+		Retention:       retention,
+		ActiveKeys: func(ctx context.Context, ds sqlutil.DataSource) (keys []int64, err error) {
+			err = ds.SelectContext(ctx, &keys, `SELECT MAX(block_number) FROM evm.log_poller_blocks GROUP BY evm_chain_id`)
+			return
+		},
+		Retained: func(ctx context.Context, ds sqlutil.DataSource, partition string) (retained bool, err error) {
+			// The complement of the logs deleted by the LogPoller ORM's DeleteExpiredLogs
+			err = ds.GetContext(ctx, &retained, fmt.Sprintf(`SELECT EXISTS (
+				SELECT 1 FROM %s l
+				JOIN evm.log_poller_filters f ON f.evm_chain_id = l.evm_chain_id AND f.address = l.address AND f.event = l.event_sig
+				WHERE (f.retention = 0 OR l.block_timestamp > STATEMENT_TIMESTAMP() - (f.retention / 10^9 * interval '1 second'))
+					AND (f.retention_blocks = 0 OR l.block_number > (
+						SELECT COALESCE(MAX(b.block_number), 0) FROM evm.log_poller_blocks b WHERE b.evm_chain_id = l.evm_chain_id
+					) - f.retention_blocks))`, partition))
+			return
+		},
+	}
+}
+
+// PipelineTaskRunsTable returns the PartitionedTable of the pipeline task runs, partitioned by ranges of pipeline run
+// IDs, so that the task runs of a run are in the same partition. The latest runs are written to. Partitions are kept as
+// long as they hold task runs of unfinished runs, or of runs within the retention of their job, and the runs of
+// dropped partitions are deleted along with them.
+//
+// The pipeline runs themselves are not partitioned, since they are referenced by foreign keys which cascade deletes.
+func PipelineTaskRunsTable(runRange uint64, retention time.Duration) PartitionedTable {
+	return PartitionedTable{
+		Schema:          "public",
+		Name:            "pipeline_task_runs",
+		Key:             "pipeline_run_id",
+		Range:           runRange,
+		TimestampColumn: "created_at",
+		Retention:       retention,
+		ActiveKeys: func(ctx context.Context, ds sqlutil.DataSource) (keys []int64, err error) {
+			err = ds.SelectContext(ctx, &keys, `SELECT last_value FROM pipeline_runs_id_seq`)
+			return
+		},
+		Retained: func(ctx context.Context, ds sqlutil.DataSource, partition string) (retained bool, err error) {
+			// The complement of the runs deleted by the pipeline ORM's DeleteRunsOlderThan, for jobs with a retention
+			err = ds.GetContext(ctx, &retained, fmt.Sprintf(`SELECT EXISTS (
+				SELECT 1 FROM %s tr
+				JOIN pipeline_runs r ON r.id = tr.pipeline_run_id
+				LEFT JOIN jobs j ON j.id = r.pruning_key
+				WHERE r.finished_at IS NULL OR r.finished_at >= STATEMENT_TIMESTAMP() - (CASE
+					WHEN r.state = 'errored' AND j.errored_run_retention > 0 THEN j.errored_run_retention
+					WHEN j.run_retention > 0 THEN j.run_retention
+					ELSE 0 END / 1000) * interval '1 microsecond')`, partition))
+			return
+		},
+		Dropped: func(ctx context.Context, ds sqlutil.DataSource, lo, hi int64) error {
+			// The task runs were dropped with their partition, so deleting the runs does not cascade to them
+			_, err := ds.ExecContext(ctx, `DELETE FROM pipeline_runs WHERE id >= $1 AND id < $2 AND finished_at IS NOT NULL`, lo, hi)
+			return err
+		},
+	}
+}
+
+func (t PartitionedTable) String() string {
+	return t.Schema + "." + t.Name
+}
+
+func (t PartitionedTable) ident() string {
+	return pgx.Identifier{t.Schema, t.Name}.Sanitize()
+}
+
+func (t PartitionedTable) partitionName(lo int64) string {
+	return fmt.Sprintf("%s_p%d", t.Name, lo)
+}
+
+func (t PartitionedTable) defaultName() string {
+	return t.Name + "_default"
+}
+
+// rangeOf returns the lower bound of the range of key.
+func (t PartitionedTable) rangeOf(key int64) int64 {
+	r := int64(t.Range)
+	lo := key / r * r
+	if key < 0 && key%r != 0 {
+		lo -= r
+	}
+	return lo
+}
+
+// neededRanges returns the lower bounds of the ranges of keys, and of the ranges following them, in order.
+func (t PartitionedTable) neededRanges(keys []int64) []int64 {
+	var los []int64
+	for _, key := range keys {
+		lo := t.rangeOf(key)
+		los = append(los, lo, lo+int64(t.Range))
+	}
+	slices.Sort(los)
+	return slices.Compact(los)
+}
+
+// partition is a partition of a PartitionedTable, covering keys from lo up to hi, exclusive.
+type partition struct {
+	name   string
+	lo, hi int64
+}
+
+func (p partition) overlaps(lo, hi int64) bool {
+	return p.lo < hi && lo < p.hi
+}
+
+var partitionBoundRegexp = regexp.MustCompile(`^FOR VALUES FROM \('?(-?\d+)'?\) TO \('?(-?\d+)'?\)$`)
+
+// parsePartitionBound parses the bound of a range partition, as formatted by pg_get_expr. It returns false for the
+// default partition, or bounds which are not integers.
+func parsePartitionBound(bound string) (lo, hi int64, ok bool) {
+	m := partitionBoundRegexp.FindStringSubmatch(bound)
+	if m == nil {
+		return 0, 0, false
+	}
+	lo, err := strconv.ParseInt(m[1], 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	hi, err = strconv.ParseInt(m[2], 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	return lo, hi, true
+}
+
+func (t PartitionedTable) isPartitioned(ctx context.Context, ds sqlutil.DataSource) (partitioned bool, err error) {
+	err = ds.GetContext(ctx, &partitioned, `SELECT EXISTS (
+		SELECT 1 FROM pg_partitioned_table pt
+		JOIN pg_class c ON c.oid = pt.partrelid
+		JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE n.nspname = $1 AND c.relname = $2)`, t.Schema, t.Name)
+	return
+}
+
+// partitions returns the range partitions of the table, in order, and whether it has a default partition.
+func (t PartitionedTable) partitions(ctx context.Context, ds sqlutil.DataSource) (parts []partition, hasDefault bool, err error) {
+	var rows []struct {
+		Name  string `db:"name"`
+		Bound string `db:"bound"`
+	}
+	err = ds.SelectContext(ctx, &rows, `SELECT c.relname AS name, pg_get_expr(c.relpartbound, c.oid) AS bound
+		FROM pg_inherits i
+		JOIN pg_class c ON c.oid = i.inhrelid
+		JOIN pg_class p ON p.oid = i.inhparent
+		JOIN pg_namespace n ON n.oid = p.relnamespace
+		WHERE n.nspname = $1 AND p.relname = $2`, t.Schema, t.Name)
+	if err != nil {
+		return nil, false, err
+	}
+	for _, row := range rows {
+		if row.Bound == "DEFAULT" {
+			hasDefault = true
+			continue
+		}
+		lo, hi, ok := parsePartitionBound(row.Bound)
+		if !ok {
+			return nil, false, fmt.Errorf("unsupported bound of partition %s: %s", row.Name, row.Bound)
+		}
+		parts = append(parts, partition{name: row.Name, lo: lo, hi: hi})
+	}
+	slices.SortFunc(parts, func(a, b partition) int { return cmp.Compare(a.lo, b.lo) })
+	return parts, hasDefault, nil
+}
+
+// createPartition creates the partition of the range starting at lo, and moves the rows of the range out of the
+// default partition.
+func (t PartitionedTable) createPartition(ctx context.Context, ds sqlutil.DataSource, lo int64, hasDefault bool) error {
+	hi := lo + int64(t.Range)
+	p := pgx.Identifier{t.Schema, t.partitionName(lo)}.Sanitize()
+	return sqlutil.TransactDataSource(ctx, ds, nil, func(tx sqlutil.DataSource) error {
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf(`CREATE TABLE %s (LIKE %s INCLUDING DEFAULTS INCLUDING CONSTRAINTS)`, p, t.ident())); err != nil {
+			return fmt.Errorf("failed to create partition: %w", err)
+		}
+		if hasDefault {
+			def := pgx.Identifier{t.Schema, t.defaultName()}.Sanitize()
+			key := pgx.Identifier{t.Key}.Sanitize()
+			stmt := fmt.Sprintf(`WITH moved AS (DELETE FROM %s WHERE %s >= $1 AND %s < $2 RETURNING *) INSERT INTO %s SELECT * FROM moved`, def, key, key, p)
+			if _, err := tx.ExecContext(ctx, stmt, lo, hi); err != nil {
+				return fmt.Errorf("failed to move rows out of default partition: %w", err)
+			}
+		}
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf(`ALTER TABLE %s ATTACH PARTITION %s FOR VALUES FROM (%d) TO (%d)`, t.ident(), p, lo, hi)); err != nil {
+			return fmt.Errorf("failed to attach partition: %w", err)
+		}
+		return nil
+	})
+}
+
+// dropPartition drops the partition, and calls Dropped with its range.
+func (t PartitionedTable) dropPartition(ctx context.Context, ds sqlutil.DataSource, p partition) error {
+	return sqlutil.TransactDataSource(ctx, ds, nil, func(tx sqlutil.DataSource) error {
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf(`DROP TABLE %s`, pgx.Identifier{t.Schema, p.name}.Sanitize())); err != nil {
+			return err
+		}
+		if t.Dropped != nil {
+			if err := t.Dropped(ctx, tx, p.lo, p.hi); err != nil {
+				return fmt.Errorf("failed to clean up after dropped partition: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// Convert converts the table to a partitioned table, keeping its rows, indexes, foreign keys and identity columns.
+// Identity columns are replaced by columns defaulting to a sequence, and the key is added to the primary key, as
+// required of partitioned tables. Unique indexes must already include the key, and the table must not be referenced by
+// foreign keys. The table is locked and copied, so it must only be run while the node is stopped.
+func (t PartitionedTable) Convert(ctx context.Context, ds sqlutil.DataSource) error {
+	if t.Range == 0 {
+		return errors.New("range must be positive")
+	}
+	return sqlutil.TransactDataSource(ctx, ds, nil, func(tx sqlutil.DataSource) error {
+		partitioned, err := t.isPartitioned(ctx, tx)
+		if err != nil {
+			return err
+		}
+		if partitioned {
+			return fmt.Errorf("%s is already partitioned", t)
+		}
+
+		var referenced bool
+		if err = tx.GetContext(ctx, &referenced, `SELECT EXISTS (
+			SELECT 1 FROM pg_constraint WHERE contype = 'f' AND confrelid = $1::regclass AND conrelid <> $1::regclass)`,
+			t.String()); err != nil {
+			return fmt.Errorf("failed to get referencing foreign keys: %w", err)
+		}
+		if referenced {
+			return fmt.Errorf("%s is referenced by foreign keys, which is not supported", t)
+		}
+
+		var indexes []string
+		if err = tx.SelectContext(ctx, &indexes, `SELECT indexdef FROM pg_indexes
+			WHERE schemaname = $1 AND tablename = $2
+			AND indexname NOT IN (SELECT conname FROM pg_constraint WHERE conrelid = $3::regclass AND contype = 'p')`,
+			t.Schema, t.Name, t.String()); err != nil {
+			return fmt.Errorf("failed to get indexes: %w", err)
+		}
+		var primaryKey []string
+		if err = tx.SelectContext(ctx, &primaryKey, `SELECT a.attname FROM pg_index i
+			JOIN pg_attribute a ON a.attrelid = i.indrelid AND a.attnum = ANY(i.indkey)
+			WHERE i.indrelid = $1::regclass AND i.indisprimary
+			ORDER BY array_position(i.indkey::int2[], a.attnum)`, t.String()); err != nil {
+			return fmt.Errorf("failed to get primary key: %w", err)
+		}
+		var foreignKeys []struct {
+			Name string `db:"name"`
+			Def  string `db:"def"`
+		}
+		if err = tx.SelectContext(ctx, &foreignKeys, `SELECT conname AS name, pg_get_constraintdef(oid) AS def FROM pg_constraint
+			WHERE conrelid = $1::regclass AND contype = 'f'`, t.String()); err != nil {
+			return fmt.Errorf("failed to get foreign keys: %w", err)
+		}
+		var identities []string
+		if err = tx.SelectContext(ctx, &identities, `SELECT attname FROM pg_attribute
+			WHERE attrelid = $1::regclass AND attidentity <> '' AND NOT attisdropped`, t.String()); err != nil {
+			return fmt.Errorf("failed to get identity columns: %w", err)
+		}
+		key := pgx.Identifier{t.Key}.Sanitize()
+		var buckets []int64
+		if err = tx.SelectContext(ctx, &buckets, fmt.Sprintf(`SELECT DISTINCT %s / $1 FROM %s`, key, t.ident()), int64(t.Range)); err != nil {
+			return fmt.Errorf("failed to get ranges: %w", err)
+		}
+
+		tmpName := t.Name + "_partitioned"
+		tmp := pgx.Identifier{t.Schema, tmpName}.Sanitize()
+		stmts := []string{
+			fmt.Sprintf(`CREATE TABLE %s (LIKE %s INCLUDING DEFAULTS INCLUDING CONSTRAINTS) PARTITION BY RANGE (%s)`, tmp, t.ident(), key),
+		}
+		los := map[int64]struct{}{}
+		for _, bucket := range buckets {
+			los[t.rangeOf(bucket*int64(t.Range))] = struct{}{}
+		}
+		for lo := range los {
+			stmts = append(stmts, fmt.Sprintf(`CREATE TABLE %s PARTITION OF %s FOR VALUES FROM (%d) TO (%d)`,
+				pgx.Identifier{t.Schema, t.partitionName(lo)}.Sanitize(), tmp, lo, lo+int64(t.Range)))
+		}
+		stmts = append(stmts,
+			fmt.Sprintf(`CREATE TABLE %s PARTITION OF %s DEFAULT`, pgx.Identifier{t.Schema, t.defaultName()}.Sanitize(), tmp),
+			fmt.Sprintf(`INSERT INTO %s SELECT * FROM %s`, tmp, t.ident()),
+			fmt.Sprintf(`DROP TABLE %s`, t.ident()),
+			fmt.Sprintf(`ALTER TABLE %s RENAME TO %s`, tmp, pgx.Identifier{t.Name}.Sanitize()),
+		)
+		for _, col := range identities {
+			seq := pgx.Identifier{t.Schema, fmt.Sprintf("%s_%s_seq", t.Name, col)}.Sanitize()
+			c := pgx.Identifier{col}.Sanitize()
+			stmts = append(stmts,
+				fmt.Sprintf(`CREATE SEQUENCE %s AS bigint OWNED BY %s.%s`, seq, t.ident(), c),
+				fmt.Sprintf(`SELECT setval('%s', COALESCE((SELECT MAX(%s) FROM %s), 0) + 1, false)`, seq, c, t.ident()),
+				fmt.Sprintf(`ALTER TABLE %s ALTER COLUMN %s SET DEFAULT nextval('%s')`, t.ident(), c, seq),
+			)
+		}
+		if len(primaryKey) > 0 {
+			if !slices.Contains(primaryKey, t.Key) {
+				primaryKey = append(primaryKey, t.Key)
+			}
+			cols := make([]string, len(primaryKey))
+			for i, col := range primaryKey {
+				cols[i] = pgx.Identifier{col}.Sanitize()
+			}
+			stmts = append(stmts, fmt.Sprintf(`ALTER TABLE %s ADD PRIMARY KEY (%s)`, t.ident(), strings.Join(cols, ", ")))
+		}
+		stmts = append(stmts, indexes...)
+		for _, fk := range foreignKeys {
+			stmts = append(stmts, fmt.Sprintf(`ALTER TABLE %s ADD CONSTRAINT %s %s`, t.ident(), pgx.Identifier{fk.Name}.Sanitize(), fk.Def))
+		}
+
+		for _, stmt := range stmts {
+			if _, err = tx.ExecContext(ctx, stmt); err != nil {
+				return fmt.Errorf("failed to convert %s: %s: %w", t, stmt, err)
+			}
+		}
+		return nil
+	})
+}
+
+// PartitionManager creates the partitions of PartitionedTables ahead of their active keys, moves rows out of their
+// default partitions, and drops their partitions which are past retention and do not hold retained rows. Tables which
+// are not partitioned are skipped.
+type PartitionManager struct {
+	services.StateMachine
+	lggr     logger.Logger
+	ds       sqlutil.DataSource
+	interval time.Duration
+	tables   []PartitionedTable
+	now      func() time.Time
+
+	stopCh services.StopChan
+	wg     sync.WaitGroup
+}
+
+func NewPartitionManager(lggr logger.Logger, ds sqlutil.DataSource, interval time.Duration, tables ...PartitionedTable) *PartitionManager {
+	return &PartitionManager{
+		lggr:     lggr.Named("PartitionManager"),
+		ds:       ds,
+		interval: interval,
+		tables:   tables,
+		now:      time.Now,
+		stopCh:   make(chan struct{}),
+	}
+}
+
+func (m *PartitionManager) Name() string {
+	return m.lggr.Name()
+}
+
+func (m *PartitionManager) HealthReport() map[string]error {
+	return map[string]error{m.Name(): m.Healthy()}
+}
+
+func (m *PartitionManager) Start(context.Context) error {
+	return m.StartOnce("PartitionManager", func() error {
+		m.wg.Add(1)
+		go m.run()
+		return nil
+	})
+}
+
+func (m *PartitionManager) Close() error {
+	return m.StopOnce("PartitionManager", func() error {
+		close(m.stopCh)
+		m.wg.Wait()
+		return nil
+	})
+}
+
+func (m *PartitionManager) run() {
+	defer m.wg.Done()
+	ctx, cancel := m.stopCh.NewCtx()
+	defer cancel()
+
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+	for {
+		for _, t := range m.tables {
+			if err := m.Maintain(ctx, t); err != nil && ctx.Err() == nil {
+				m.lggr.Errorw("Failed to maintain partitions", "table", t.String(), "err", err)
+			}
+		}
+		select {
+		case <-m.stopCh:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Maintain creates the partitions which are needed by the active keys of t, or to move rows out of its default
+// partition, and drops its partitions which are past retention and do not hold retained rows.
+func (m *PartitionManager) Maintain(ctx context.Context, t PartitionedTable) error {
+	partitioned, err := t.isPartitioned(ctx, m.ds)
+	if err != nil {
+		return err
+	}
+	if !partitioned {
+		m.lggr.Debugw("Table is not partitioned, skipping", "table", t.String())
+		return nil
+	}
+
+	keys, err := t.ActiveKeys(ctx, m.ds)
+	if err != nil {
+		return fmt.Errorf("failed to get active keys: %w", err)
+	}
+	parts, hasDefault, err := t.partitions(ctx, m.ds)
+	if err != nil {
+		return fmt.Errorf("failed to get partitions: %w", err)
+	}
+
+	needed := t.neededRanges(keys)
+	los := needed
+	if hasDefault {
+		var buckets []int64
+		stmt := fmt.Sprintf(`SELECT DISTINCT %s / $1 FROM %s`, pgx.Identifier{t.Key}.Sanitize(), pgx.Identifier{t.Schema, t.defaultName()}.Sanitize())
+		if err = m.ds.SelectContext(ctx, &buckets, stmt, int64(t.Range)); err != nil {
+			return fmt.Errorf("failed to get ranges of default partition: %w", err)
+		}
+		for _, bucket := range buckets {
+			los = append(los, t.rangeOf(bucket*int64(t.Range)))
+		}
+		slices.Sort(los)
+		los = slices.Compact(los)
+	}
+
+	for _, lo := range los {
+		hi := lo + int64(t.Range)
+		if slices.ContainsFunc(parts, func(p partition) bool { return p.overlaps(lo, hi) }) {
+			continue
+		}
+		if err = t.createPartition(ctx, m.ds, lo, hasDefault); err != nil {
+			return fmt.Errorf("failed to create partition [%d, %d): %w", lo, hi, err)
+		}
+		m.lggr.Infow("Created partition", "table", t.String(), "partition", t.partitionName(lo), "from", lo, "to", hi)
+	}
+
+	if t.Retention <= 0 {
+		return nil
+	}
+	cutoff := m.now().Add(-t.Retention)
+	for _, p := range parts {
+		if slices.ContainsFunc(needed, func(lo int64) bool { return p.overlaps(lo, lo+int64(t.Range)) }) {
+			continue
+		}
+		ident := pgx.Identifier{t.Schema, p.name}.Sanitize()
+		var latest sql.NullTime
+		stmt := fmt.Sprintf(`SELECT MAX(%s) FROM %s`, pgx.Identifier{t.TimestampColumn}.Sanitize(), ident)
+		if err = m.ds.GetContext(ctx, &latest, stmt); err != nil {
+			return fmt.Errorf("failed to get latest row of partition %s: %w", p.name, err)
+		}
+		if !latest.Valid || !latest.Time.Before(cutoff) {
+			continue
+		}
+		if t.Retained != nil {
+			var retained bool
+			if retained, err = t.Retained(ctx, m.ds, ident); err != nil {
+				return fmt.Errorf("failed to check retained rows of partition %s: %w", p.name, err)
+			}
+			if retained {
+				m.lggr.Debugw("Keeping partition past retention which holds retained rows", "table", t.String(), "partition", p.name)
+				continue
+			}
+		}
+		if err = t.dropPartition(ctx, m.ds, p); err != nil {
+			return fmt.Errorf("failed to drop partition %s: %w", p.name, err)
+		}
+		m.lggr.Infow("Dropped partition past retention", "table", t.String(), "partition", p.name, "latest", latest.Time)
+	}
+	return nil
+}
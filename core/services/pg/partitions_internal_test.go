@@ -0,0 +1,48 @@
+package pg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPartitionedTable_Ranges(t *testing.T) {
+	table := PartitionedTable{Name: "logs", Range: 100}
+
+	assert.Equal(t, int64(0), table.rangeOf(0))
+	assert.Equal(t, int64(0), table.rangeOf(99))
+	assert.Equal(t, int64(100), table.rangeOf(100))
+	assert.Equal(t, int64(-100), table.rangeOf(-1))
+
+	assert.Equal(t, []int64{0, 100, 1200, 1300}, table.neededRanges([]int64{1250, 50, 99}))
+	assert.Empty(t, table.neededRanges(nil))
+
+	assert.Equal(t, "logs_p1200", table.partitionName(1200))
+	assert.Equal(t, "logs_default", table.defaultName())
+}
+
+func TestParsePartitionBound(t *testing.T) {
+	lo, hi, ok := parsePartitionBound("FOR VALUES FROM ('1000000') TO ('2000000')")
+	assert.True(t, ok)
+	assert.Equal(t, int64(1_000_000), lo)
+	assert.Equal(t, int64(2_000_000), hi)
+
+	lo, hi, ok = parsePartitionBound("FOR VALUES FROM (0) TO (10)")
+	assert.True(t, ok)
+	assert.Equal(t, int64(0), lo)
+	assert.Equal(t, int64(10), hi)
+
+	_, _, ok = parsePartitionBound("DEFAULT")
+	assert.False(t, ok)
+	_, _, ok = parsePartitionBound("FOR VALUES FROM (MINVALUE) TO ('10')")
+	assert.False(t, ok)
+}
+
+func TestPartition_Overlaps(t *testing.T) {
+	p := partition{lo: 100, hi: 200}
+	assert.True(t, p.overlaps(100, 200))
+	assert.True(t, p.overlaps(150, 250))
+	assert.True(t, p.overlaps(50, 101))
+	assert.False(t, p.overlaps(200, 300))
+	assert.False(t, p.overlaps(0, 100))
+}
@@ -0,0 +1,225 @@
+package pg_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func partitionNames(t *testing.T, db *sqlx.DB, table string) (names []string) {
+	require.NoError(t, db.Select(&names, `SELECT c.relname FROM pg_inherits i
+		JOIN pg_class c ON c.oid = i.inhrelid
+		WHERE i.inhparent = $1::regclass
+		ORDER BY c.relname`, table))
+	return
+}
+
+func TestPartitionManager(t *testing.T) {
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+
+	_, err := db.ExecContext(ctx, `CREATE TABLE partition_test (
+		id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+		block_number BIGINT NOT NULL,
+		created_at TIMESTAMPTZ NOT NULL
+	);
+	CREATE UNIQUE INDEX idx_partition_test_block_id ON partition_test (block_number, id);
+	INSERT INTO partition_test (block_number, created_at) VALUES
+		(5, NOW() - INTERVAL '48 hours'), (15, NOW()), (25, NOW());`)
+	require.NoError(t, err)
+
+	active := []int64{25}
+	retainP0 := true
+	var dropped [][2]int64
+	table := pg.PartitionedTable{
+		Schema:          "public",
+		Name:            "partition_test",
+		Key:             "block_number",
+		Range:           10,
+		TimestampColumn: "created_at",
+		Retention:       24 * time.Hour,
+		ActiveKeys: func(context.Context, sqlutil.DataSource) ([]int64, error) {
+			return active, nil
+		},
+		Retained: func(_ context.Context, _ sqlutil.DataSource, partition string) (bool, error) {
+			return retainP0 && partition == `"public"."partition_test_p0"`, nil
+		},
+		Dropped: func(_ context.Context, _ sqlutil.DataSource, lo, hi int64) error {
+			dropped = append(dropped, [2]int64{lo, hi})
+			return nil
+		},
+	}
+	require.NoError(t, table.Convert(ctx, db))
+	require.Error(t, table.Convert(ctx, db), "already partitioned")
+
+	assert.Equal(t, []string{"partition_test_default", "partition_test_p0", "partition_test_p10", "partition_test_p20"}, partitionNames(t, db, "partition_test"))
+	var count int
+	require.NoError(t, db.Get(&count, `SELECT COUNT(*) FROM partition_test`))
+	assert.Equal(t, 3, count)
+
+	// The identity column is replaced by a sequence, and rows without a partition are stored in the default partition.
+	_, err = db.ExecContext(ctx, `INSERT INTO partition_test (block_number, created_at) VALUES (45, NOW())`)
+	require.NoError(t, err)
+	var maxID int64
+	require.NoError(t, db.Get(&maxID, `SELECT MAX(id) FROM partition_test`))
+	assert.Equal(t, int64(4), maxID)
+
+	m := pg.NewPartitionManager(logger.TestLogger(t), db, time.Hour, table)
+	require.NoError(t, m.Maintain(ctx, table))
+
+	// p0 is past retention but holds retained rows, p30 is created ahead of the active key, and p40 holds the row of
+	// the default partition.
+	assert.Equal(t, []string{"partition_test_default", "partition_test_p0", "partition_test_p10", "partition_test_p20", "partition_test_p30", "partition_test_p40"}, partitionNames(t, db, "partition_test"))
+	assert.Empty(t, dropped)
+
+	// p0 is dropped once its rows are no longer retained.
+	retainP0 = false
+	require.NoError(t, m.Maintain(ctx, table))
+	assert.Equal(t, []string{"partition_test_default", "partition_test_p10", "partition_test_p20", "partition_test_p30", "partition_test_p40"}, partitionNames(t, db, "partition_test"))
+	assert.Equal(t, [][2]int64{{0, 10}}, dropped)
+	require.NoError(t, db.Get(&count, `SELECT COUNT(*) FROM partition_test_default`))
+	assert.Equal(t, 0, count)
+	require.NoError(t, db.Get(&count, `SELECT COUNT(*) FROM partition_test_p40`))
+	assert.Equal(t, 1, count)
+
+	t.Run("skips tables which are not partitioned", func(t *testing.T) {
+		require.NoError(t, m.Maintain(ctx, pg.PartitionedTable{Schema: "public", Name: "not_partitioned", Range: 10}))
+	})
+}
+
+func TestPartitionedTable_Convert_ForeignKeys(t *testing.T) {
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+
+	_, err := db.ExecContext(ctx, `CREATE TABLE fk_parent (id BIGINT PRIMARY KEY);
+	CREATE TABLE fk_child (
+		id BIGINT PRIMARY KEY,
+		parent_id BIGINT NOT NULL REFERENCES fk_parent (id) ON DELETE CASCADE
+	);
+	INSERT INTO fk_parent VALUES (1), (2);
+	INSERT INTO fk_child VALUES (1, 1), (2, 2);`)
+	require.NoError(t, err)
+
+	parent := pg.PartitionedTable{Schema: "public", Name: "fk_parent", Key: "id", Range: 10}
+	require.ErrorContains(t, parent.Convert(ctx, db), "referenced by foreign keys")
+
+	child := pg.PartitionedTable{Schema: "public", Name: "fk_child", Key: "parent_id", Range: 10}
+	require.NoError(t, child.Convert(ctx, db))
+	assert.Equal(t, []string{"fk_child_default", "fk_child_p0"}, partitionNames(t, db, "fk_child"))
+
+	// The foreign key is kept, and still cascades deletes
+	var count int
+	require.NoError(t, db.Get(&count, `SELECT COUNT(*) FROM pg_constraint WHERE conrelid = 'fk_child'::regclass AND contype = 'f'`))
+	assert.Equal(t, 1, count)
+	_, err = db.ExecContext(ctx, `DELETE FROM fk_parent WHERE id = 1`)
+	require.NoError(t, err)
+	require.NoError(t, db.Get(&count, `SELECT COUNT(*) FROM fk_child`))
+	assert.Equal(t, 1, count)
+}
+
+func TestEVMLogsTable_Retained(t *testing.T) {
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	lggr := logger.TestLogger(t)
+	chainID := testutils.NewRandomEVMChainID()
+	orm := logpoller.NewORM(chainID, db, lggr)
+
+	forever, expiring := testutils.NewAddress(), testutils.NewAddress()
+	event := common.HexToHash("0x1234")
+	require.NoError(t, orm.InsertFilter(ctx, logpoller.Filter{Name: "forever", EventSigs: []common.Hash{event}, Addresses: []common.Address{forever}}))
+	require.NoError(t, orm.InsertFilter(ctx, logpoller.Filter{Name: "expiring", EventSigs: []common.Hash{event}, Addresses: []common.Address{expiring}, Retention: time.Hour}))
+
+	table := pg.EVMLogsTable(1_000_000, time.Hour)
+	ident := `"evm"."logs"`
+	insertLog := func(address common.Address, logIndex int64, blockTimestamp time.Time) {
+		require.NoError(t, orm.InsertLogs(ctx, []logpoller.Log{{
+			EvmChainId:     ubig.New(chainID),
+			LogIndex:       logIndex,
+			BlockHash:      utils.RandomBytes32(),
+			BlockNumber:    1,
+			EventSig:       event,
+			Topics:         [][]byte{event[:]},
+			Address:        address,
+			TxHash:         utils.RandomBytes32(),
+			Data:           []byte("hello"),
+			BlockTimestamp: blockTimestamp,
+		}}))
+	}
+
+	// Logs past the retention of their filter are not retained
+	insertLog(expiring, 0, time.Now().Add(-2*time.Hour))
+	retained, err := table.Retained(ctx, db, ident)
+	require.NoError(t, err)
+	assert.False(t, retained)
+
+	// Logs within the retention of their filter are retained
+	insertLog(expiring, 1, time.Now())
+	retained, err = table.Retained(ctx, db, ident)
+	require.NoError(t, err)
+	assert.True(t, retained)
+
+	// Logs of filters with no retention are retained forever
+	_, err = db.ExecContext(ctx, `DELETE FROM evm.logs WHERE evm_chain_id = $1`, ubig.New(chainID))
+	require.NoError(t, err)
+	insertLog(forever, 2, time.Now().Add(-24*365*time.Hour))
+	retained, err = table.Retained(ctx, db, ident)
+	require.NoError(t, err)
+	assert.True(t, retained)
+}
+
+func TestPipelineTaskRunsTable(t *testing.T) {
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	table := pg.PipelineTaskRunsTable(1_000_000, time.Hour)
+	ident := `"public"."pipeline_task_runs"`
+
+	createdJob, _ := cltest.MustInsertWebhookSpec(t, db)
+	insertRun := func(status pipeline.RunStatus) pipeline.Run {
+		run := cltest.MustInsertPipelineRunWithStatus(t, db, createdJob.PipelineSpecID, status, createdJob.ID)
+		cltest.MustInsertUnfinishedPipelineTaskRun(t, db, run.ID)
+		return run
+	}
+
+	// Task runs of unfinished runs are retained
+	running := insertRun(pipeline.RunStatusRunning)
+	retained, err := table.Retained(ctx, db, ident)
+	require.NoError(t, err)
+	assert.True(t, retained)
+
+	// Task runs of finished runs are not retained, unless their job has a retention
+	_, err = db.ExecContext(ctx, `DELETE FROM pipeline_runs WHERE id = $1`, running.ID)
+	require.NoError(t, err)
+	completed := insertRun(pipeline.RunStatusCompleted)
+	retained, err = table.Retained(ctx, db, ident)
+	require.NoError(t, err)
+	assert.False(t, retained)
+
+	_, err = db.ExecContext(ctx, `UPDATE jobs SET run_retention = $1 WHERE id = $2`, time.Hour.Nanoseconds(), createdJob.ID)
+	require.NoError(t, err)
+	retained, err = table.Retained(ctx, db, ident)
+	require.NoError(t, err)
+	assert.True(t, retained)
+
+	// The finished runs of a dropped partition are deleted
+	require.NoError(t, table.Dropped(ctx, db, completed.ID, completed.ID+1))
+	var count int
+	require.NoError(t, db.Get(&count, `SELECT COUNT(*) FROM pipeline_runs WHERE id = $1`, completed.ID))
+	assert.Equal(t, 0, count)
+}
@@ -0,0 +1,23 @@
+package pg
+
+import (
+	"context"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+)
+
+var _ sqlutil.QueryHook = QueryBudgetHook
+
+// QueryBudgetHook is a sqlutil.QueryHook which times out queries at the deadline of their context, if it has one,
+// instead of after the default query timeout. Callers with a budget for their work may then spend it on queries which
+// take longer than the default, and are no longer required to opt out with sqlutil.WithoutDefaultTimeout. Queries
+// without a deadline still time out after the default.
+//
+// It must precede sqlutil.TimeoutHook.
+func QueryBudgetHook(ctx context.Context, _ logger.Logger, do func(context.Context) error, _ string, _ ...any) error {
+	if _, ok := ctx.Deadline(); ok {
+		ctx = sqlutil.WithoutDefaultTimeout(ctx)
+	}
+	return do(ctx)
+}
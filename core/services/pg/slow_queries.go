@@ -0,0 +1,235 @@
+package pg
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	commonlogger "github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+const (
+	slowQueriesFlushInterval = time.Minute
+	// maxPendingSlowQueries bounds the fingerprints recorded between flushes. Queries with other fingerprints are
+	// dropped until the next flush.
+	maxPendingSlowQueries  = 1000
+	maxFingerprintQueryLen = 2000
+)
+
+var promDBSlowQueries = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "db_slow_queries",
+	Help: "The number of queries which took longer than the slow query threshold, or timed out.",
+}, []string{"timeout"})
+
+var (
+	fingerprintCommentRegexp    = regexp.MustCompile(`(?s)/\*.*?\*/|--[^\n]*`)
+	fingerprintStringRegexp     = regexp.MustCompile(`'(?:[^']|'')*'`)
+	fingerprintPlaceholderRegex = regexp.MustCompile(`\$\d+|\?`)
+	fingerprintNumberRegexp     = regexp.MustCompile(`\b-?\d+(?:\.\d+)?\b`)
+	fingerprintListRegexp       = regexp.MustCompile(`\(\s*\?(?:\s*,\s*\?)*\s*\)`)
+	fingerprintSpaceRegexp      = regexp.MustCompile(`\s+`)
+)
+
+// Fingerprint normalizes query, so that executions of the same statement with different arguments share a
+// fingerprint: comments are removed, literals and placeholders are replaced with ?, lists of them are collapsed and
+// whitespace is squashed. It returns the hex encoded hash of the normalized query, and the normalized query.
+func Fingerprint(query string) (fingerprint, normalized string) {
+	normalized = fingerprintCommentRegexp.ReplaceAllString(query, " ")
+	normalized = fingerprintStringRegexp.ReplaceAllString(normalized, "?")
+	normalized = fingerprintPlaceholderRegex.ReplaceAllString(normalized, "?")
+	normalized = fingerprintNumberRegexp.ReplaceAllString(normalized, "?")
+	normalized = fingerprintListRegexp.ReplaceAllString(normalized, "(...)")
+	normalized = strings.TrimSpace(fingerprintSpaceRegexp.ReplaceAllString(normalized, " "))
+	sum := sha256.Sum256([]byte(normalized))
+	if len(normalized) > maxFingerprintQueryLen {
+		normalized = normalized[:maxFingerprintQueryLen]
+	}
+	return hex.EncodeToString(sum[:8]), normalized
+}
+
+// SlowQuery is the telemetry of the slow queries sharing a fingerprint.
+type SlowQuery struct {
+	Fingerprint   string        `db:"fingerprint"`
+	Query         string        `db:"query"`
+	Calls         int64         `db:"calls"`
+	Timeouts      int64         `db:"timeouts"`
+	TotalDuration time.Duration `db:"total_duration"`
+	MaxDuration   time.Duration `db:"max_duration"`
+	FirstSeenAt   time.Time     `db:"first_seen_at"`
+	LastSeenAt    time.Time     `db:"last_seen_at"`
+}
+
+// TopSlowQueries returns the n slow query fingerprints which took the most time in total.
+func TopSlowQueries(ctx context.Context, ds sqlutil.DataSource, n int) (queries []SlowQuery, err error) {
+	err = ds.SelectContext(ctx, &queries, `SELECT fingerprint, query, calls, timeouts,
+		total_duration_ms * 1000000 AS total_duration, max_duration_ms * 1000000 AS max_duration, first_seen_at, last_seen_at
+		FROM slow_queries ORDER BY total_duration_ms DESC, fingerprint LIMIT $1`, n)
+	return
+}
+
+// SlowQueryRecorder records the queries which take longer than a threshold, or time out, in the slow_queries table,
+// aggregated by Fingerprint. Queries are buffered in memory, and flushed periodically.
+type SlowQueryRecorder struct {
+	services.StateMachine
+	lggr      logger.Logger
+	ds        sqlutil.DataSource
+	threshold time.Duration
+	now       func() time.Time
+
+	mu      sync.Mutex
+	pending map[string]*SlowQuery
+	dropped int
+
+	stopCh services.StopChan
+	wg     sync.WaitGroup
+}
+
+// NewSlowQueryRecorder returns a SlowQueryRecorder which flushes to ds. The queries of ds itself must not be hooked
+// to the recorder.
+func NewSlowQueryRecorder(lggr logger.Logger, ds sqlutil.DataSource, threshold time.Duration) *SlowQueryRecorder {
+	return &SlowQueryRecorder{
+		lggr:      lggr.Named("SlowQueryRecorder"),
+		ds:        ds,
+		threshold: threshold,
+		now:       time.Now,
+		pending:   make(map[string]*SlowQuery),
+		stopCh:    make(chan struct{}),
+	}
+}
+
+var _ sqlutil.QueryHook = (*SlowQueryRecorder)(nil).Hook
+
+// Hook is a sqlutil.QueryHook which records the slow queries. It must follow the hooks which set the timeout of
+// queries, so that timeouts are recognized.
+func (r *SlowQueryRecorder) Hook(ctx context.Context, _ commonlogger.Logger, do func(context.Context) error, query string, _ ...any) error {
+	start := time.Now()
+	err := do(ctx)
+	elapsed := time.Since(start)
+	timedOut := errors.Is(ctx.Err(), context.DeadlineExceeded)
+	if elapsed >= r.threshold || timedOut {
+		r.record(query, elapsed, timedOut)
+	}
+	return err
+}
+
+func (r *SlowQueryRecorder) record(query string, elapsed time.Duration, timedOut bool) {
+	promDBSlowQueries.WithLabelValues(fmt.Sprint(timedOut)).Inc()
+	fingerprint, normalized := Fingerprint(query)
+	now := r.now()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	q, ok := r.pending[fingerprint]
+	if !ok {
+		if len(r.pending) >= maxPendingSlowQueries {
+			r.dropped++
+			return
+		}
+		q = &SlowQuery{Fingerprint: fingerprint, Query: normalized, FirstSeenAt: now}
+		r.pending[fingerprint] = q
+	}
+	q.Calls++
+	if timedOut {
+		q.Timeouts++
+	}
+	q.TotalDuration += elapsed
+	q.MaxDuration = max(q.MaxDuration, elapsed)
+	q.LastSeenAt = now
+}
+
+func (r *SlowQueryRecorder) Name() string {
+	return r.lggr.Name()
+}
+
+func (r *SlowQueryRecorder) HealthReport() map[string]error {
+	return map[string]error{r.Name(): r.Healthy()}
+}
+
+func (r *SlowQueryRecorder) Start(context.Context) error {
+	return r.StartOnce("SlowQueryRecorder", func() error {
+		r.wg.Add(1)
+		go r.run()
+		return nil
+	})
+}
+
+func (r *SlowQueryRecorder) Close() error {
+	return r.StopOnce("SlowQueryRecorder", func() error {
+		close(r.stopCh)
+		r.wg.Wait()
+		return nil
+	})
+}
+
+func (r *SlowQueryRecorder) run() {
+	defer r.wg.Done()
+	ticker := time.NewTicker(slowQueriesFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.stopCh:
+			// flush what is left with a fresh context, since the stop channel is closed
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			r.flushAndLog(ctx)
+			cancel()
+			return
+		case <-ticker.C:
+			ctx, cancel := r.stopCh.NewCtx()
+			r.flushAndLog(ctx)
+			cancel()
+		}
+	}
+}
+
+func (r *SlowQueryRecorder) flushAndLog(ctx context.Context) {
+	if err := r.Flush(ctx); err != nil {
+		r.lggr.Errorw("Failed to flush slow queries", "err", err)
+	}
+}
+
+// Flush writes the slow queries recorded since the last flush to the database.
+func (r *SlowQueryRecorder) Flush(ctx context.Context) error {
+	r.mu.Lock()
+	pending, dropped := r.pending, r.dropped
+	r.pending, r.dropped = make(map[string]*SlowQuery), 0
+	r.mu.Unlock()
+
+	if dropped > 0 {
+		r.lggr.Warnw("Dropped slow queries, too many fingerprints were recorded", "dropped", dropped, "max", maxPendingSlowQueries)
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+	return sqlutil.TransactDataSource(ctx, r.ds, nil, func(tx sqlutil.DataSource) error {
+		for _, q := range pending {
+			_, err := tx.ExecContext(ctx, `INSERT INTO slow_queries
+				(fingerprint, query, calls, timeouts, total_duration_ms, max_duration_ms, first_seen_at, last_seen_at)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+				ON CONFLICT (fingerprint) DO UPDATE SET
+					calls = slow_queries.calls + EXCLUDED.calls,
+					timeouts = slow_queries.timeouts + EXCLUDED.timeouts,
+					total_duration_ms = slow_queries.total_duration_ms + EXCLUDED.total_duration_ms,
+					max_duration_ms = GREATEST(slow_queries.max_duration_ms, EXCLUDED.max_duration_ms),
+					last_seen_at = EXCLUDED.last_seen_at`,
+				q.Fingerprint, q.Query, q.Calls, q.Timeouts, q.TotalDuration.Milliseconds(), q.MaxDuration.Milliseconds(),
+				q.FirstSeenAt, q.LastSeenAt)
+			if err != nil {
+				return fmt.Errorf("failed to upsert slow query %s: %w", q.Fingerprint, err)
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,100 @@
+package pg_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+)
+
+func TestFingerprint(t *testing.T) {
+	fp1, normalized := pg.Fingerprint(`SELECT * FROM evm.logs -- by address
+		WHERE address = $1 AND block_number > 100 AND event_sig IN ($2, $3, $4) AND data = 'abc''d'`)
+	assert.Equal(t, "SELECT * FROM evm.logs WHERE address = ? AND block_number > ? AND event_sig IN (...) AND data = ?", normalized)
+
+	fp2, _ := pg.Fingerprint(`SELECT * FROM evm.logs WHERE address = $1 AND block_number > 7 AND event_sig IN ($2) AND data = 'x'`)
+	assert.Equal(t, fp1, fp2)
+
+	fp3, normalized := pg.Fingerprint(`SELECT * FROM ocr2_oracle_specs WHERE id = $1`)
+	assert.NotEqual(t, fp1, fp3)
+	assert.Equal(t, "SELECT * FROM ocr2_oracle_specs WHERE id = ?", normalized)
+}
+
+func TestQueryBudgetHook(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	timeoutHook := sqlutil.TimeoutHook(func() time.Duration { return time.Millisecond })
+	deadline := func(ctx context.Context) (d time.Time, ok bool) {
+		require.NoError(t, pg.QueryBudgetHook(ctx, lggr, func(ctx context.Context) error {
+			return timeoutHook(ctx, lggr, func(ctx context.Context) error {
+				d, ok = ctx.Deadline()
+				return nil
+			}, "SELECT 1")
+		}, "SELECT 1"))
+		return
+	}
+
+	// without a budget, the default timeout applies
+	d, ok := deadline(context.Background())
+	require.True(t, ok)
+	assert.WithinDuration(t, time.Now(), d, time.Second)
+
+	// with a budget, it replaces the default timeout
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	t.Cleanup(cancel)
+	budget, _ := ctx.Deadline()
+	d, ok = deadline(ctx)
+	require.True(t, ok)
+	assert.Equal(t, budget, d)
+}
+
+func TestSlowQueryRecorder(t *testing.T) {
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	lggr := logger.TestLogger(t)
+	recorder := pg.NewSlowQueryRecorder(lggr, db, 20*time.Millisecond)
+	ds := sqlutil.WrapDataSource(db, lggr, recorder.Hook)
+
+	for _, d := range []string{"0.05", "0.03"} {
+		_, err := ds.ExecContext(ctx, `SELECT pg_sleep(`+d+`)`)
+		require.NoError(t, err)
+	}
+	_, err := ds.ExecContext(ctx, `SELECT 1`)
+	require.NoError(t, err)
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	_, err = ds.ExecContext(timeoutCtx, `SELECT pg_sleep(1), $1::int`, 1)
+	cancel()
+	require.Error(t, err)
+
+	require.NoError(t, recorder.Flush(ctx))
+	queries, err := pg.TopSlowQueries(ctx, db, 10)
+	require.NoError(t, err)
+	require.Len(t, queries, 2)
+
+	assert.Equal(t, "SELECT pg_sleep(...)", queries[0].Query)
+	assert.Equal(t, int64(2), queries[0].Calls)
+	assert.Equal(t, int64(0), queries[0].Timeouts)
+	assert.GreaterOrEqual(t, queries[0].TotalDuration, 80*time.Millisecond)
+	assert.GreaterOrEqual(t, queries[0].MaxDuration, 50*time.Millisecond)
+
+	assert.Equal(t, "SELECT pg_sleep(...), ?::int", queries[1].Query)
+	assert.Equal(t, int64(1), queries[1].Calls)
+	assert.Equal(t, int64(1), queries[1].Timeouts)
+
+	// flushing again adds up the calls
+	_, err = ds.ExecContext(ctx, `SELECT pg_sleep(0.03)`)
+	require.NoError(t, err)
+	require.NoError(t, recorder.Flush(ctx))
+	queries, err = pg.TopSlowQueries(ctx, db, 1)
+	require.NoError(t, err)
+	require.Len(t, queries, 1)
+	assert.Equal(t, int64(3), queries[0].Calls)
+}
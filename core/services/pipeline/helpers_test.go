@@ -51,6 +51,12 @@ func (t *HTTPTask) HelperSetDependencies(config Config, restrictedHTTPClient, un
 	t.unrestrictedHTTPClient = unrestrictedHTTPClient
 }
 
+func (t *WebSocketTask) HelperSetDependencies(config Config, restrictedHTTPClient, unrestrictedHTTPClient *http.Client) {
+	t.config = config
+	t.httpClient = restrictedHTTPClient
+	t.unrestrictedHTTPClient = unrestrictedHTTPClient
+}
+
 func (t *ETHCallTask) HelperSetDependencies(legacyChains legacyevm.LegacyChainContainer, config Config, specGasLimit *uint32, jobType string) {
 	t.legacyChains = legacyChains
 	t.config = config
@@ -58,6 +64,10 @@ func (t *ETHCallTask) HelperSetDependencies(legacyChains legacyevm.LegacyChainCo
 	t.jobType = jobType
 }
 
+func (t *EVMCallTask) HelperSetDependencies(legacyChains legacyevm.LegacyChainContainer) {
+	t.legacyChains = legacyChains
+}
+
 func (t *ETHTxTask) HelperSetDependencies(legacyChains legacyevm.LegacyChainContainer, keyStore ETHKeyStore, specGasLimit *uint32, jobType string) {
 	t.legacyChains = legacyChains
 	t.keyStore = keyStore
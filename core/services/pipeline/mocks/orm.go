@@ -227,6 +227,52 @@ func (_c *ORM_DataSource_Call) RunAndReturn(run func() sqlutil.DataSource) *ORM_
 	return _c
 }
 
+// DeleteExpiredCachedTaskResults provides a mock function with given fields: ctx
+func (_m *ORM) DeleteExpiredCachedTaskResults(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpiredCachedTaskResults")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ORM_DeleteExpiredCachedTaskResults_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpiredCachedTaskResults'
+type ORM_DeleteExpiredCachedTaskResults_Call struct {
+	*mock.Call
+}
+
+// DeleteExpiredCachedTaskResults is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ORM_Expecter) DeleteExpiredCachedTaskResults(ctx interface{}) *ORM_DeleteExpiredCachedTaskResults_Call {
+	return &ORM_DeleteExpiredCachedTaskResults_Call{Call: _e.mock.On("DeleteExpiredCachedTaskResults", ctx)}
+}
+
+func (_c *ORM_DeleteExpiredCachedTaskResults_Call) Run(run func(ctx context.Context)) *ORM_DeleteExpiredCachedTaskResults_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ORM_DeleteExpiredCachedTaskResults_Call) Return(_a0 error) *ORM_DeleteExpiredCachedTaskResults_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ORM_DeleteExpiredCachedTaskResults_Call) RunAndReturn(run func(context.Context) error) *ORM_DeleteExpiredCachedTaskResults_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteRun provides a mock function with given fields: ctx, id
 func (_m *ORM) DeleteRun(ctx context.Context, id int64) error {
 	ret := _m.Called(ctx, id)
@@ -321,6 +367,63 @@ func (_c *ORM_DeleteRunsOlderThan_Call) RunAndReturn(run func(context.Context, t
 	return _c
 }
 
+// FindCachedTaskResult provides a mock function with given fields: ctx, key
+func (_m *ORM) FindCachedTaskResult(ctx context.Context, key []byte) (pipeline.CachedTaskResult, error) {
+	ret := _m.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindCachedTaskResult")
+	}
+
+	var r0 pipeline.CachedTaskResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) (pipeline.CachedTaskResult, error)); ok {
+		return rf(ctx, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) pipeline.CachedTaskResult); ok {
+		r0 = rf(ctx, key)
+	} else {
+		r0 = ret.Get(0).(pipeline.CachedTaskResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []byte) error); ok {
+		r1 = rf(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_FindCachedTaskResult_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindCachedTaskResult'
+type ORM_FindCachedTaskResult_Call struct {
+	*mock.Call
+}
+
+// FindCachedTaskResult is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key []byte
+func (_e *ORM_Expecter) FindCachedTaskResult(ctx interface{}, key interface{}) *ORM_FindCachedTaskResult_Call {
+	return &ORM_FindCachedTaskResult_Call{Call: _e.mock.On("FindCachedTaskResult", ctx, key)}
+}
+
+func (_c *ORM_FindCachedTaskResult_Call) Run(run func(ctx context.Context, key []byte)) *ORM_FindCachedTaskResult_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]byte))
+	})
+	return _c
+}
+
+func (_c *ORM_FindCachedTaskResult_Call) Return(_a0 pipeline.CachedTaskResult, _a1 error) *ORM_FindCachedTaskResult_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_FindCachedTaskResult_Call) RunAndReturn(run func(context.Context, []byte) (pipeline.CachedTaskResult, error)) *ORM_FindCachedTaskResult_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindRun provides a mock function with given fields: ctx, id
 func (_m *ORM) FindRun(ctx context.Context, id int64) (pipeline.Run, error) {
 	ret := _m.Called(ctx, id)
@@ -378,6 +481,63 @@ func (_c *ORM_FindRun_Call) RunAndReturn(run func(context.Context, int64) (pipel
 	return _c
 }
 
+// FindTaskRun provides a mock function with given fields: ctx, taskID
+func (_m *ORM) FindTaskRun(ctx context.Context, taskID uuid.UUID) (pipeline.TaskRun, error) {
+	ret := _m.Called(ctx, taskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTaskRun")
+	}
+
+	var r0 pipeline.TaskRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (pipeline.TaskRun, error)); ok {
+		return rf(ctx, taskID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) pipeline.TaskRun); ok {
+		r0 = rf(ctx, taskID)
+	} else {
+		r0 = ret.Get(0).(pipeline.TaskRun)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, taskID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_FindTaskRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTaskRun'
+type ORM_FindTaskRun_Call struct {
+	*mock.Call
+}
+
+// FindTaskRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID uuid.UUID
+func (_e *ORM_Expecter) FindTaskRun(ctx interface{}, taskID interface{}) *ORM_FindTaskRun_Call {
+	return &ORM_FindTaskRun_Call{Call: _e.mock.On("FindTaskRun", ctx, taskID)}
+}
+
+func (_c *ORM_FindTaskRun_Call) Run(run func(ctx context.Context, taskID uuid.UUID)) *ORM_FindTaskRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ORM_FindTaskRun_Call) Return(_a0 pipeline.TaskRun, _a1 error) *ORM_FindTaskRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_FindTaskRun_Call) RunAndReturn(run func(context.Context, uuid.UUID) (pipeline.TaskRun, error)) *ORM_FindTaskRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAllRuns provides a mock function with given fields: ctx
 func (_m *ORM) GetAllRuns(ctx context.Context) ([]pipeline.Run, error) {
 	ret := _m.Called(ctx)
@@ -915,6 +1075,65 @@ func (_c *ORM_StoreRun_Call) RunAndReturn(run func(context.Context, *pipeline.Ru
 	return _c
 }
 
+// SubscribeRunEvents provides a mock function with given fields:
+func (_m *ORM) SubscribeRunEvents() (<-chan pipeline.RunEvent, func()) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribeRunEvents")
+	}
+
+	var r0 <-chan pipeline.RunEvent
+	var r1 func()
+	if rf, ok := ret.Get(0).(func() (<-chan pipeline.RunEvent, func())); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() <-chan pipeline.RunEvent); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan pipeline.RunEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() func()); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+
+	return r0, r1
+}
+
+// ORM_SubscribeRunEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeRunEvents'
+type ORM_SubscribeRunEvents_Call struct {
+	*mock.Call
+}
+
+// SubscribeRunEvents is a helper method to define mock.On call
+func (_e *ORM_Expecter) SubscribeRunEvents() *ORM_SubscribeRunEvents_Call {
+	return &ORM_SubscribeRunEvents_Call{Call: _e.mock.On("SubscribeRunEvents")}
+}
+
+func (_c *ORM_SubscribeRunEvents_Call) Run(run func()) *ORM_SubscribeRunEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ORM_SubscribeRunEvents_Call) Return(_a0 <-chan pipeline.RunEvent, _a1 func()) *ORM_SubscribeRunEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_SubscribeRunEvents_Call) RunAndReturn(run func() (<-chan pipeline.RunEvent, func())) *ORM_SubscribeRunEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Transact provides a mock function with given fields: _a0, _a1
 func (_m *ORM) Transact(_a0 context.Context, _a1 func(pipeline.ORM) error) error {
 	ret := _m.Called(_a0, _a1)
@@ -1027,6 +1246,53 @@ func (_c *ORM_UpdateTaskRunResult_Call) RunAndReturn(run func(context.Context, u
 	return _c
 }
 
+// UpsertCachedTaskResults provides a mock function with given fields: ctx, results
+func (_m *ORM) UpsertCachedTaskResults(ctx context.Context, results []pipeline.CachedTaskResult) error {
+	ret := _m.Called(ctx, results)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertCachedTaskResults")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []pipeline.CachedTaskResult) error); ok {
+		r0 = rf(ctx, results)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ORM_UpsertCachedTaskResults_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertCachedTaskResults'
+type ORM_UpsertCachedTaskResults_Call struct {
+	*mock.Call
+}
+
+// UpsertCachedTaskResults is a helper method to define mock.On call
+//   - ctx context.Context
+//   - results []pipeline.CachedTaskResult
+func (_e *ORM_Expecter) UpsertCachedTaskResults(ctx interface{}, results interface{}) *ORM_UpsertCachedTaskResults_Call {
+	return &ORM_UpsertCachedTaskResults_Call{Call: _e.mock.On("UpsertCachedTaskResults", ctx, results)}
+}
+
+func (_c *ORM_UpsertCachedTaskResults_Call) Run(run func(ctx context.Context, results []pipeline.CachedTaskResult)) *ORM_UpsertCachedTaskResults_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]pipeline.CachedTaskResult))
+	})
+	return _c
+}
+
+func (_c *ORM_UpsertCachedTaskResults_Call) Return(_a0 error) *ORM_UpsertCachedTaskResults_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ORM_UpsertCachedTaskResults_Call) RunAndReturn(run func(context.Context, []pipeline.CachedTaskResult) error) *ORM_UpsertCachedTaskResults_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WithDataSource provides a mock function with given fields: _a0
 func (_m *ORM) WithDataSource(_a0 sqlutil.DataSource) pipeline.ORM {
 	ret := _m.Called(_a0)
@@ -71,6 +71,38 @@ func (_c *Runner_Close_Call) RunAndReturn(run func() error) *Runner_Close_Call {
 	return _c
 }
 
+// Drain provides a mock function with given fields:
+func (_m *Runner) Drain() {
+	_m.Called()
+}
+
+// Runner_Drain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Drain'
+type Runner_Drain_Call struct {
+	*mock.Call
+}
+
+// Drain is a helper method to define mock.On call
+func (_e *Runner_Expecter) Drain() *Runner_Drain_Call {
+	return &Runner_Drain_Call{Call: _e.mock.On("Drain")}
+}
+
+func (_c *Runner_Drain_Call) Run(run func()) *Runner_Drain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Runner_Drain_Call) Return() *Runner_Drain_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Runner_Drain_Call) RunAndReturn(run func()) *Runner_Drain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ExecuteAndInsertFinishedRun provides a mock function with given fields: ctx, spec, vars, saveSuccessfulTaskRuns
 func (_m *Runner) ExecuteAndInsertFinishedRun(ctx context.Context, spec pipeline.Spec, vars pipeline.Vars, saveSuccessfulTaskRuns bool) (int64, pipeline.TaskRunResults, error) {
 	ret := _m.Called(ctx, spec, vars, saveSuccessfulTaskRuns)
@@ -255,6 +287,51 @@ func (_c *Runner_HealthReport_Call) RunAndReturn(run func() map[string]error) *R
 	return _c
 }
 
+// InFlightRuns provides a mock function with given fields:
+func (_m *Runner) InFlightRuns() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for InFlightRuns")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// Runner_InFlightRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InFlightRuns'
+type Runner_InFlightRuns_Call struct {
+	*mock.Call
+}
+
+// InFlightRuns is a helper method to define mock.On call
+func (_e *Runner_Expecter) InFlightRuns() *Runner_InFlightRuns_Call {
+	return &Runner_InFlightRuns_Call{Call: _e.mock.On("InFlightRuns")}
+}
+
+func (_c *Runner_InFlightRuns_Call) Run(run func()) *Runner_InFlightRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Runner_InFlightRuns_Call) Return(_a0 int) *Runner_InFlightRuns_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Runner_InFlightRuns_Call) RunAndReturn(run func() int) *Runner_InFlightRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // InitializePipeline provides a mock function with given fields: spec
 func (_m *Runner) InitializePipeline(spec pipeline.Spec) (*pipeline.Pipeline, error) {
 	ret := _m.Called(spec)
@@ -26,6 +26,7 @@ type Spec struct {
 	MaxTaskDuration   models.Interval `json:"-"`
 	GasLimit          *uint32         `json:"-"`
 	ForwardingAllowed bool            `json:"-"`
+	MaxSuccessfulRuns *uint32         `json:"-"`
 
 	JobID   int32  `json:"-"`
 	JobName string `json:"-"`
@@ -311,6 +312,42 @@ func (tr *TaskRun) IsPending() bool {
 	return !tr.FinishedAt.Valid && tr.Output.Empty() && tr.Error.IsZero()
 }
 
+// RunEventType is the type of a RunEvent.
+type RunEventType string
+
+const (
+	// RunEventCreated is published when a run is created, before it finishes executing.
+	RunEventCreated RunEventType = "runCreated"
+	// RunEventCompleted is published when a run has finished executing, with or without errors. Runs executed in
+	// memory are only ever persisted once finished, so only have this event.
+	RunEventCompleted RunEventType = "runCompleted"
+)
+
+// RunEvent is published by the ORM when it persists a run.
+type RunEvent struct {
+	Type           RunEventType
+	RunID          int64
+	JobID          int32
+	PipelineSpecID int32
+	State          RunStatus
+	FatalErrors    RunErrors
+	CreatedAt      time.Time
+	FinishedAt     null.Time
+}
+
+func newRunEvent(typ RunEventType, run *Run) RunEvent {
+	return RunEvent{
+		Type:           typ,
+		RunID:          run.ID,
+		JobID:          run.PruningKey,
+		PipelineSpecID: run.PipelineSpecID,
+		State:          run.State,
+		FatalErrors:    run.FatalErrors,
+		CreatedAt:      run.CreatedAt,
+		FinishedAt:     run.FinishedAt,
+	}
+}
+
 // RunStatus represents the status of a run
 type RunStatus string
 
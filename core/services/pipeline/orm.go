@@ -17,6 +17,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 // KeepersObservationSource is the same for all keeper jobs and it is not persisted in DB
@@ -92,19 +93,33 @@ type ORM interface {
 
 	DeleteRunsOlderThan(context.Context, time.Duration) error
 	FindRun(ctx context.Context, id int64) (Run, error)
+	// FindTaskRun returns the task run with the pipeline spec of its run.
+	FindTaskRun(ctx context.Context, taskID uuid.UUID) (TaskRun, error)
 	GetAllRuns(ctx context.Context) ([]Run, error)
 	GetUnfinishedRuns(context.Context, time.Time, func(run Run) error) error
 
+	// FindCachedTaskResult returns the cached task result for key, or sql.ErrNoRows if there is none or it expired.
+	FindCachedTaskResult(ctx context.Context, key []byte) (CachedTaskResult, error)
+	UpsertCachedTaskResults(ctx context.Context, results []CachedTaskResult) error
+	DeleteExpiredCachedTaskResults(ctx context.Context) error
+
+	// SubscribeRunEvents subscribes to the runs created and completed from now on. The returned function unsubscribes.
+	SubscribeRunEvents() (<-chan RunEvent, func())
+
 	DataSource() sqlutil.DataSource
 	WithDataSource(sqlutil.DataSource) ORM
 	Transact(context.Context, func(ORM) error) error
 }
 
+// runEventsBufferSize is the number of run events buffered for each subscriber, beyond which events are dropped.
+const runEventsBufferSize = 100
+
 type orm struct {
 	services.StateMachine
 	ds                sqlutil.DataSource
 	lggr              logger.Logger
 	maxSuccessfulRuns uint64
+	runEvents         *utils.Broadcaster[RunEvent]
 	// jobID => count
 	pm     sync.Map
 	wg     sync.WaitGroup
@@ -118,6 +133,7 @@ func NewORM(ds sqlutil.DataSource, lggr logger.Logger, jobPipelineMaxSuccessfulR
 		ds:                ds,
 		lggr:              lggr.Named("PipelineORM"),
 		maxSuccessfulRuns: jobPipelineMaxSuccessfulRuns,
+		runEvents:         new(utils.Broadcaster[RunEvent]),
 		stopCh:            make(chan struct{}),
 	}
 }
@@ -176,10 +192,15 @@ func (o *orm) withDataSource(ds sqlutil.DataSource) *orm {
 		ds:                ds,
 		lggr:              o.lggr,
 		maxSuccessfulRuns: o.maxSuccessfulRuns,
+		runEvents:         o.runEvents,
 		stopCh:            make(chan struct{}),
 	}
 }
 
+func (o *orm) SubscribeRunEvents() (<-chan RunEvent, func()) {
+	return o.runEvents.Subscribe(runEventsBufferSize)
+}
+
 func (o *orm) transact(ctx context.Context, fn func(*orm) error) error {
 	return sqlutil.Transact(ctx, o.withDataSource, o.ds, nil, fn)
 }
@@ -217,8 +238,12 @@ func (o *orm) CreateRun(ctx context.Context, run *Run) (err error) {
 		_, err = tx.ds.NamedExecContext(ctx, sql, run.PipelineTaskRuns)
 		return err
 	})
+	if err != nil {
+		return errors.Wrap(err, "CreateRun failed")
+	}
 
-	return errors.Wrap(err, "CreateRun failed")
+	o.runEvents.Publish(newRunEvent(RunEventCreated, run))
+	return nil
 }
 
 // InsertRun inserts a run into the database
@@ -311,6 +336,9 @@ func (o *orm) StoreRun(ctx context.Context, run *Run) (restart bool, err error)
 		run.PipelineTaskRuns = taskRuns
 		return nil
 	})
+	if err == nil && !restart && run.FinishedAt.Valid {
+		o.runEvents.Publish(newRunEvent(RunEventCompleted, run))
+	}
 	return
 }
 
@@ -362,6 +390,7 @@ func (o *orm) UpdateTaskRunResult(ctx context.Context, taskID uuid.UUID, result
 
 // InsertFinishedRuns inserts all the given runs into the database.
 func (o *orm) InsertFinishedRuns(ctx context.Context, runs []*Run, saveSuccessfulTaskRuns bool) error {
+	var runIDs []int64
 	err := o.transact(ctx, func(tx *orm) error {
 		pipelineRunsQuery := `
 INSERT INTO pipeline_runs 
@@ -371,7 +400,7 @@ VALUES
 RETURNING id
 	`
 
-		var runIDs []int64
+		runIDs = nil
 		err := sqlutil.NamedQueryContext(ctx, tx.ds, pipelineRunsQuery, runs, func(row sqlutil.RowScanner) error {
 			var runID int64
 			if errS := row.Scan(&runID); errS != nil {
@@ -413,7 +442,16 @@ VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created
 		_, errE := tx.ds.NamedExecContext(ctx, pipelineTaskRunsQuery, pipelineTaskRuns)
 		return errors.Wrap(errE, "insert pipeline task runs")
 	})
-	return errors.Wrap(err, "InsertFinishedRuns failed")
+	if err != nil {
+		return errors.Wrap(err, "InsertFinishedRuns failed")
+	}
+
+	for i, run := range runs {
+		event := newRunEvent(RunEventCompleted, run)
+		event.RunID = runIDs[i]
+		o.runEvents.Publish(event)
+	}
+	return nil
 }
 
 func (o *orm) checkFinishedRun(run *Run, saveSuccessfulTaskRuns bool) error {
@@ -432,6 +470,15 @@ func (o *orm) checkFinishedRun(run *Run, saveSuccessfulTaskRuns bool) error {
 	return nil
 }
 
+// keepFinishedRun returns whether run is to be saved, according to the MaxSuccessfulRuns of its job if set, or the
+// default otherwise. Errored runs are kept if the job only keeps errored runs.
+func (o *orm) keepFinishedRun(run *Run) bool {
+	if maxRuns := run.PipelineSpec.MaxSuccessfulRuns; maxRuns != nil {
+		return *maxRuns > 0 || run.HasErrors()
+	}
+	return o.maxSuccessfulRuns > 0
+}
+
 // InsertFinishedRun inserts the given run into the database.
 // If saveSuccessfulTaskRuns = false, we only save errored runs.
 // That way if the job is run frequently (such as OCR) we avoid saving a large number of successful task runs
@@ -441,13 +488,18 @@ func (o *orm) InsertFinishedRun(ctx context.Context, run *Run, saveSuccessfulTas
 		return err
 	}
 
-	if o.maxSuccessfulRuns == 0 {
-		// optimisation: avoid persisting if we oughtn't to save any
+	if !o.keepFinishedRun(run) {
+		// optimisation: avoid persisting if we oughtn't to save it
 		return nil
 	}
 
 	err = o.insertFinishedRun(ctx, run, saveSuccessfulTaskRuns)
-	return errors.Wrap(err, "InsertFinishedRun failed")
+	if err != nil {
+		return errors.Wrap(err, "InsertFinishedRun failed")
+	}
+
+	o.runEvents.Publish(newRunEvent(RunEventCompleted, run))
+	return nil
 }
 
 // InsertFinishedRunWithSpec works like InsertFinishedRun but also inserts the pipeline spec.
@@ -456,8 +508,8 @@ func (o *orm) InsertFinishedRunWithSpec(ctx context.Context, run *Run, saveSucce
 		return err
 	}
 
-	if o.maxSuccessfulRuns == 0 {
-		// optimisation: avoid persisting if we oughtn't to save any
+	if !o.keepFinishedRun(run) {
+		// optimisation: avoid persisting if we oughtn't to save it
 		return nil
 	}
 
@@ -477,7 +529,12 @@ func (o *orm) InsertFinishedRunWithSpec(ctx context.Context, run *Run, saveSucce
 		}
 		return tx.insertFinishedRun(ctx, run, saveSuccessfulTaskRuns)
 	})
-	return errors.Wrap(err, "InsertFinishedRun failed")
+	if err != nil {
+		return errors.Wrap(err, "InsertFinishedRun failed")
+	}
+
+	o.runEvents.Publish(newRunEvent(RunEventCompleted, run))
+	return nil
 }
 
 func (o *orm) insertFinishedRun(ctx context.Context, run *Run, saveSuccessfulTaskRuns bool) error {
@@ -511,27 +568,43 @@ func (o *orm) insertFinishedRun(ctx context.Context, run *Run, saveSuccessfulTas
 	return errors.Wrap(err, "failed to insert pipeline_task_runs")
 }
 
-// DeleteRunsOlderThan deletes all pipeline_runs that have been finished for a certain threshold to free DB space
+// DeleteRunsOlderThan deletes all pipeline_runs that have been finished for a certain threshold to free DB space.
+// The runs of jobs with a RunRetention or an ErroredRunRetention are deleted after those instead.
 // Caller is expected to set timeout on calling context.
 func (o *orm) DeleteRunsOlderThan(ctx context.Context, threshold time.Duration) error {
 	start := time.Now()
 
-	queryThreshold := start.Add(-threshold)
+	// the shortest retention bounds the runs to consider, so that the index on finished_at is used
+	var minRetention time.Duration
+	if err := o.ds.GetContext(ctx, &minRetention, `SELECT COALESCE(MIN(LEAST(NULLIF(run_retention, 0), NULLIF(errored_run_retention, 0))), 0) FROM jobs`); err != nil {
+		return errors.Wrap(err, "DeleteRunsOlderThan failed to get the retention of jobs")
+	}
+	if minRetention <= 0 || minRetention > threshold {
+		minRetention = threshold
+	}
 
 	rowsDeleted := int64(0)
 
 	err := pg.Batch(func(_, limit uint) (count uint, err error) {
 		result, err := o.ds.ExecContext(ctx, `
 WITH batched_pipeline_runs AS (
-	SELECT * FROM pipeline_runs
-	WHERE finished_at < ($1)
-	ORDER BY finished_at ASC
-	LIMIT $2
+	SELECT pipeline_runs.id FROM pipeline_runs
+	LEFT JOIN jobs ON jobs.id = pipeline_runs.pruning_key
+	WHERE pipeline_runs.finished_at < $1
+	AND pipeline_runs.finished_at < $2::timestamptz - (CASE
+		WHEN pipeline_runs.state = $3 AND jobs.errored_run_retention > 0 THEN jobs.errored_run_retention
+		WHEN jobs.run_retention > 0 THEN jobs.run_retention
+		ELSE $4 END / 1000) * interval '1 microsecond'
+	ORDER BY pipeline_runs.finished_at ASC
+	LIMIT $5
 )
 DELETE FROM pipeline_runs
 USING batched_pipeline_runs
 WHERE pipeline_runs.id = batched_pipeline_runs.id`,
-			queryThreshold,
+			start.Add(-minRetention),
+			start,
+			RunStatusErrored,
+			threshold.Nanoseconds(),
 			limit,
 		)
 		if err != nil {
@@ -580,6 +653,17 @@ func (o *orm) FindRun(ctx context.Context, id int64) (r Run, err error) {
 	return *runs[0], err
 }
 
+func (o *orm) FindTaskRun(ctx context.Context, taskID uuid.UUID) (tr TaskRun, err error) {
+	sql := `
+	SELECT pipeline_task_runs.*, pipeline_specs.dot_dag_source "pipeline_run.pipeline_spec.dot_dag_source"
+	FROM pipeline_task_runs
+	JOIN pipeline_runs ON (pipeline_runs.id = pipeline_task_runs.pipeline_run_id)
+	JOIN pipeline_specs ON (pipeline_specs.id = pipeline_runs.pipeline_spec_id)
+	WHERE pipeline_task_runs.id = $1`
+	err = o.ds.GetContext(ctx, &tr, sql, taskID)
+	return tr, err
+}
+
 func (o *orm) GetAllRuns(ctx context.Context) (runs []Run, err error) {
 	var runsPtrs []*Run
 	err = o.transact(ctx, func(tx *orm) error {
@@ -679,6 +763,25 @@ func loadAssociations(ctx context.Context, ds sqlutil.DataSource, runs []*Run) e
 	return nil
 }
 
+func (o *orm) FindCachedTaskResult(ctx context.Context, key []byte) (result CachedTaskResult, err error) {
+	err = o.ds.GetContext(ctx, &result, `SELECT * FROM pipeline_task_result_cache WHERE key = $1 AND expires_at > NOW()`, key)
+	return result, err
+}
+
+func (o *orm) UpsertCachedTaskResults(ctx context.Context, results []CachedTaskResult) error {
+	sql := `INSERT INTO pipeline_task_result_cache (key, value, expires_at)
+		VALUES (:key, :value, :expires_at)
+		ON CONFLICT (key) DO UPDATE SET value = excluded.value, expires_at = excluded.expires_at
+		WHERE pipeline_task_result_cache.expires_at < excluded.expires_at`
+	_, err := o.ds.NamedExecContext(ctx, sql, results)
+	return errors.Wrap(err, "UpsertCachedTaskResults failed")
+}
+
+func (o *orm) DeleteExpiredCachedTaskResults(ctx context.Context) error {
+	_, err := o.ds.ExecContext(ctx, `DELETE FROM pipeline_task_result_cache WHERE expires_at <= NOW()`)
+	return errors.Wrap(err, "DeleteExpiredCachedTaskResults failed")
+}
+
 func (o *orm) loadCount(jobID int32) *atomic.Uint64 {
 	// fast path; avoids allocation
 	actual, exists := o.pm.Load(jobID)
@@ -702,6 +805,9 @@ const syncLimit = 1000
 //
 // Note this does not guarantee the pipeline_runs table is kept to exactly the
 // max length, rather that it doesn't excessively larger than it.
+//
+// The MaxSuccessfulRuns of the job takes precedence over maxSuccessfulRuns, but
+// sampling still follows maxSuccessfulRuns.
 func (o *orm) prune(ctx context.Context, tx sqlutil.DataSource, jobID int32) {
 	if jobID == 0 {
 		o.lggr.Panic("expected a non-zero job ID")
@@ -740,7 +846,7 @@ func (o *orm) execPrune(ctx context.Context, jobID int32) {
 SELECT id FROM pipeline_runs
 WHERE pruning_key = $1 AND state = $2
 ORDER BY id DESC
-LIMIT $3
+LIMIT COALESCE((SELECT max_successful_runs FROM jobs WHERE id = $1), $3)
 )`, jobID, RunStatusCompleted, o.maxSuccessfulRuns)
 	if err != nil {
 		o.lggr.Errorw("Failed to prune runs", "err", err, "jobID", jobID)
@@ -643,6 +643,40 @@ func Test_PipelineORM_DeleteRunsOlderThan(t *testing.T) {
 	}
 }
 
+func Test_PipelineORM_DeleteRunsOlderThan_JobRetention(t *testing.T) {
+	ctx := testutils.Context(t)
+	db, orm, jorm := setupLiteORM(t)
+
+	retained := mustInsertAsyncRun(t, orm, jorm)
+	_, err := db.ExecContext(ctx, `UPDATE jobs SET run_retention = $1, errored_run_retention = $2 WHERE id = $3`,
+		time.Hour.Nanoseconds(), (72 * time.Hour).Nanoseconds(), retained.PruningKey)
+	require.NoError(t, err)
+	other := mustInsertAsyncRun(t, orm, jorm)
+
+	insertRun := func(run *pipeline.Run, status pipeline.RunStatus, age time.Duration) int64 {
+		r := cltest.MustInsertPipelineRunWithStatus(t, db, run.PipelineSpecID, status, run.PruningKey)
+		_, err := db.ExecContext(ctx, `UPDATE pipeline_runs SET finished_at = $1 WHERE id = $2`, time.Now().Add(-age), r.ID)
+		require.NoError(t, err)
+		return r.ID
+	}
+	completedPastRetention := insertRun(retained, pipeline.RunStatusCompleted, 2*time.Hour)
+	erroredWithinRetention := insertRun(retained, pipeline.RunStatusErrored, 2*time.Hour)
+	erroredPastRetention := insertRun(retained, pipeline.RunStatusErrored, 100*time.Hour)
+	completedWithinThreshold := insertRun(other, pipeline.RunStatusCompleted, 2*time.Hour)
+	completedPastThreshold := insertRun(other, pipeline.RunStatusCompleted, 25*time.Hour)
+
+	require.NoError(t, orm.DeleteRunsOlderThan(ctx, 24*time.Hour))
+
+	for _, id := range []int64{erroredWithinRetention, completedWithinThreshold} {
+		_, err = orm.FindRun(ctx, id)
+		require.NoError(t, err)
+	}
+	for _, id := range []int64{completedPastRetention, erroredPastRetention, completedPastThreshold} {
+		_, err = orm.FindRun(ctx, id)
+		require.Error(t, err, "not found")
+	}
+}
+
 func Test_GetUnfinishedRuns_Keepers(t *testing.T) {
 	t.Parallel()
 	ctx := testutils.Context(t)
@@ -914,3 +948,25 @@ func Test_Prune(t *testing.T) {
 	cnt = pgtest.MustCount(t, db, "SELECT count(*) FROM pipeline_runs WHERE pipeline_spec_id = $1 AND state = $2", ps2.ID, pipeline.RunStatusSuspended)
 	assert.Equal(t, 3, cnt)
 }
+
+func Test_Prune_JobMaxSuccessfulRuns(t *testing.T) {
+	ctx := testutils.Context(t)
+	db, orm, jorm := setupLiteORM(t)
+
+	run := mustInsertAsyncRun(t, orm, jorm)
+	_, err := db.ExecContext(ctx, `UPDATE jobs SET max_successful_runs = 1 WHERE id = $1`, run.PruningKey)
+	require.NoError(t, err)
+	for i := 0; i < 5; i++ {
+		cltest.MustInsertPipelineRunWithStatus(t, db, run.PipelineSpecID, pipeline.RunStatusCompleted, run.PruningKey)
+		cltest.MustInsertPipelineRunWithStatus(t, db, run.PipelineSpecID, pipeline.RunStatusErrored, run.PruningKey)
+	}
+
+	// the default of 2 is overridden by the job
+	porm := pipeline.NewORM(db, logger.TestLogger(t), 2)
+	porm.Prune(ctx, run.PruningKey)
+
+	cnt := pgtest.MustCount(t, db, "SELECT count(*) FROM pipeline_runs WHERE pruning_key = $1 AND state = $2", run.PruningKey, pipeline.RunStatusCompleted)
+	assert.Equal(t, 1, cnt)
+	cnt = pgtest.MustCount(t, db, "SELECT count(*) FROM pipeline_runs WHERE pruning_key = $1 AND state = $2", run.PruningKey, pipeline.RunStatusErrored)
+	assert.Equal(t, 5, cnt)
+}
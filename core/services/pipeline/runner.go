@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
@@ -53,6 +54,12 @@ type Runner interface {
 
 	OnRunFinished(func(*Run))
 	InitializePipeline(spec Spec) (*Pipeline, error)
+
+	// Drain makes the runner refuse new runs with ErrRunnerDraining, while the runs in progress, including the async
+	// runs being resumed, carry on.
+	Drain()
+	// InFlightRuns returns the number of runs in progress.
+	InFlightRuns() int
 }
 
 type runner struct {
@@ -65,6 +72,7 @@ type runner struct {
 	ethKeyStore            ETHKeyStore
 	vrfKeyStore            VRFKeyStore
 	runReaperWorker        *commonutils.SleeperTask
+	taskCache              *taskResultCache
 	lggr                   logger.Logger
 	httpClient             *http.Client
 	unrestrictedHTTPClient *http.Client
@@ -72,6 +80,9 @@ type runner struct {
 	// test helper
 	runFinished func(*Run)
 
+	draining     atomic.Bool
+	inFlightRuns atomic.Int64
+
 	chStop services.StopChan
 	wgDone sync.WaitGroup
 }
@@ -134,6 +145,7 @@ func NewRunner(
 		httpClient:             httpClient,
 		unrestrictedHTTPClient: unrestrictedHTTPClient,
 	}
+	r.taskCache = newTaskResultCache(orm, lggr)
 
 	r.runReaperWorker = commonutils.NewSleeperTask(
 		commonutils.SleeperFuncTask(r.runReaper, "PipelineRunnerReaper"),
@@ -147,6 +159,8 @@ func (r *runner) Start(ctx context.Context) error {
 	return r.StartOnce("PipelineRunner", func() error {
 		r.wgDone.Add(1)
 		go r.scheduleUnfinishedRuns()
+		r.wgDone.Add(1)
+		go r.taskCache.flushLoop(r.chStop, &r.wgDone)
 		if r.config.ReaperInterval() != time.Duration(0) {
 			r.wgDone.Add(1)
 			go r.runReaperLoop()
@@ -225,6 +239,7 @@ type memoryTaskRun struct {
 	inputs   []Result // sorted by input index
 	vars     Vars
 	attempts uint
+	stop     services.StopChan // closed to cancel the task run, once its result is no longer awaited
 }
 
 // When a task panics, we catch the panic and wrap it in an error for reporting to the scheduler.
@@ -253,6 +268,17 @@ func (r *runner) OnRunFinished(fn func(*Run)) {
 	r.runFinished = fn
 }
 
+// ErrRunnerDraining is returned for the runs started while the node is draining.
+var ErrRunnerDraining = pkgerrors.New("node is draining, new pipeline runs are refused")
+
+func (r *runner) Drain() {
+	r.draining.Store(true)
+}
+
+func (r *runner) InFlightRuns() int {
+	return int(r.inFlightRuns.Load())
+}
+
 var (
 	// github.com/smartcontractkit/libocr/offchainreporting2plus/internal/protocol.ReportingPluginTimeoutWarningGracePeriod
 	overtime           = 100 * time.Millisecond
@@ -289,6 +315,10 @@ func overtimeContext(ctx context.Context) (context.Context, context.CancelFunc)
 }
 
 func (r *runner) ExecuteRun(ctx context.Context, spec Spec, vars Vars) (*Run, TaskRunResults, error) {
+	if r.draining.Load() {
+		return nil, nil, ErrRunnerDraining
+	}
+
 	// Pipeline runs may return results after the context is cancelled, so we modify the
 	// deadline to give them time to return before the parent context deadline.
 	var cancel func()
@@ -337,6 +367,10 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			task.(*HTTPTask).config = r.config
 			task.(*HTTPTask).httpClient = r.httpClient
 			task.(*HTTPTask).unrestrictedHTTPClient = r.unrestrictedHTTPClient
+		case TaskTypeWebSocket:
+			task.(*WebSocketTask).config = r.config
+			task.(*WebSocketTask).httpClient = r.httpClient
+			task.(*WebSocketTask).unrestrictedHTTPClient = r.unrestrictedHTTPClient
 		case TaskTypeBridge:
 			task.(*BridgeTask).config = r.config
 			task.(*BridgeTask).bridgeConfig = r.bridgeConfig
@@ -352,6 +386,8 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			task.(*ETHCallTask).config = r.config
 			task.(*ETHCallTask).specGasLimit = spec.GasLimit
 			task.(*ETHCallTask).jobType = spec.JobType
+		case TaskTypeEVMCall:
+			task.(*EVMCallTask).legacyChains = r.legacyEVMChains
 		case TaskTypeVRF:
 			task.(*VRFTask).keyStore = r.vrfKeyStore
 		case TaskTypeVRFV2:
@@ -376,6 +412,9 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 }
 
 func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Vars) TaskRunResults {
+	r.inFlightRuns.Add(1)
+	defer r.inFlightRuns.Add(-1)
+
 	l := r.lggr.With("run.ID", run.ID, "executionID", uuid.New(), "specID", run.PipelineSpecID, "jobID", run.PipelineSpec.JobID, "jobName", run.PipelineSpec.JobName)
 	l.Debug("Initiating tasks for pipeline run of spec")
 
@@ -533,6 +572,7 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 
 	// Task timeout will be whichever of the following timesout/cancels first:
 	// - Pipeline-level timeout
+	// - Deadline of a descendant task (task.Deadline)
 	// - Specific task timeout (task.TaskTimeout)
 	// - Job level task timeout (spec.MaxTaskDuration)
 	// - Passed in context
@@ -543,6 +583,8 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 	// an extremely good reason to change it.
 	ctx, cancel := r.chStop.Ctx(ctx)
 	defer cancel()
+	ctx, cancel = taskRun.stop.Ctx(ctx)
+	defer cancel()
 	if taskTimeout, isSet := taskRun.task.TaskTimeout(); isSet && taskTimeout > 0 {
 		ctx, cancel = context.WithTimeout(ctx, taskTimeout)
 		defer cancel()
@@ -552,7 +594,30 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 		defer cancel()
 	}
 
+	var cacheKey []byte
+	cacheTTL, isSet := taskRun.task.Base().TaskResultCacheTTL()
+	if isSet && cacheTTL > 0 {
+		var err error
+		if cacheKey, err = taskResultCacheKey(taskRun.task, taskRun.vars, taskRun.inputs); err != nil {
+			l.Warnw("Task result can not be cached", "err", err)
+		} else if val, ok := r.taskCache.get(ctx, cacheKey); ok {
+			if r.config.VerboseLogging() {
+				l.Tracew("Pipeline task result served from cache", "resultValue", val)
+			}
+			return TaskRunResult{
+				ID:         taskRun.task.Base().uuid,
+				Task:       taskRun.task,
+				Result:     Result{Value: val},
+				CreatedAt:  start,
+				FinishedAt: null.TimeFrom(time.Now()),
+			}
+		}
+	}
+
 	result, runInfo := taskRun.task.Run(ctx, l, taskRun.vars, taskRun.inputs)
+	if cacheKey != nil && result.Error == nil && !runInfo.IsPending {
+		r.taskCache.set(cacheKey, result.Value, cacheTTL)
+	}
 	loggerFields := []interface{}{"runInfo", runInfo,
 		"resultValue", result.Value,
 		"resultError", result.Error,
@@ -626,6 +691,11 @@ func (r *runner) ExecuteAndInsertFinishedRun(ctx context.Context, spec Spec, var
 }
 
 func (r *runner) Run(ctx context.Context, run *Run, saveSuccessfulTaskRuns bool, fn func(tx sqlutil.DataSource) error) (incomplete bool, err error) {
+	// resumed runs have an ID, and are finished even while draining
+	if run.ID == 0 && r.draining.Load() {
+		return false, ErrRunnerDraining
+	}
+
 	pipeline, err := r.InitializePipeline(run.PipelineSpec)
 	if err != nil {
 		return false, err
@@ -2,6 +2,7 @@ package pipeline_test
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -9,6 +10,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -1056,3 +1058,93 @@ succeed;
 		assert.Equal(t, "1", trrs[0].Result.Value.(pipeline.ObjectParam).DecimalValue.Decimal().String())
 	})
 }
+
+func Test_PipelineRunner_Drain(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	r, _ := newRunner(t, db, bridgesMocks.NewORM(t), cfg)
+
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		<-release
+		_, _ = w.Write([]byte(`{"result": 42}`))
+	}))
+	defer server.Close()
+
+	spec := pipeline.Spec{DotDagSource: fmt.Sprintf(`
+fetch [type=http method=GET url="%s"]
+parse [type=jsonparse path="result"]
+fetch -> parse
+`, server.URL)}
+	vars := pipeline.NewVarsFrom(nil)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, trrs, err := r.ExecuteRun(testutils.Context(t), spec, vars)
+		assert.NoError(t, err)
+		assert.NoError(t, trrs.FinalResult().CombinedError())
+	}()
+	require.Eventually(t, func() bool { return r.InFlightRuns() == 1 }, testutils.WaitTimeout(t), 10*time.Millisecond)
+
+	r.Drain()
+	_, _, err := r.ExecuteRun(testutils.Context(t), spec, vars)
+	require.ErrorIs(t, err, pipeline.ErrRunnerDraining)
+	_, err = r.Run(testutils.Context(t), pipeline.NewRun(spec, vars), false, nil)
+	require.ErrorIs(t, err, pipeline.ErrRunnerDraining)
+
+	// the run in progress carries on
+	close(release)
+	<-done
+	assert.Equal(t, 0, r.InFlightRuns())
+}
+
+func Test_PipelineRunner_TaskResultCache(t *testing.T) {
+	var requests atomic.Int32
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		_, _ = io.WriteString(w, `{"price": 42}`)
+	}))
+	t.Cleanup(s.Close)
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	c := clhttptest.NewTestLocalOnlyHTTPClient()
+	spec := pipeline.Spec{DotDagSource: `
+ds    [type=http method=GET url="$(url)" resultCacheTTL="1m"]
+parse [type=jsonparse path="price"]
+ds -> parse
+`}
+
+	t.Run("caches in memory", func(t *testing.T) {
+		requests.Store(0)
+		orm := mocks.NewORM(t)
+		orm.On("FindCachedTaskResult", mock.Anything, mock.Anything).Return(pipeline.CachedTaskResult{}, sql.ErrNoRows).Twice()
+		r := pipeline.NewRunner(orm, nil, cfg.JobPipeline(), cfg.WebServer(), nil, nil, nil, logger.TestLogger(t), c, c)
+
+		for _, symbol := range []string{"ETH", "ETH", "BTC"} {
+			_, trrs, err := r.ExecuteRun(testutils.Context(t), spec, pipeline.NewVarsFrom(map[string]interface{}{"url": s.URL + "?symbol=" + symbol}))
+			require.NoError(t, err)
+			result, err := trrs.FinalResult().SingularResult()
+			require.NoError(t, err)
+			assert.Equal(t, int64(42), result.Value)
+		}
+		assert.Equal(t, int32(2), requests.Load())
+	})
+
+	t.Run("falls back to the database", func(t *testing.T) {
+		requests.Store(0)
+		orm := mocks.NewORM(t)
+		orm.On("FindCachedTaskResult", mock.Anything, mock.Anything).Return(pipeline.CachedTaskResult{
+			Value:     jsonserializable.JSONSerializable{Val: `{"price": 43}`, Valid: true},
+			ExpiresAt: time.Now().Add(time.Minute),
+		}, nil).Once()
+		r := pipeline.NewRunner(orm, nil, cfg.JobPipeline(), cfg.WebServer(), nil, nil, nil, logger.TestLogger(t), c, c)
+
+		_, trrs, err := r.ExecuteRun(testutils.Context(t), spec, pipeline.NewVarsFrom(map[string]interface{}{"url": s.URL + "?symbol=ETH"}))
+		require.NoError(t, err)
+		result, err := trrs.FinalResult().SingularResult()
+		require.NoError(t, err)
+		assert.Equal(t, int64(43), result.Value)
+		assert.Equal(t, int32(0), requests.Load())
+	})
+}
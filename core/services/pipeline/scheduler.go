@@ -9,6 +9,8 @@ import (
 	"github.com/pkg/errors"
 	"gopkg.in/guregu/null.v4"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 )
 
@@ -60,6 +62,9 @@ type scheduler struct {
 	pending bool
 	exiting bool
 
+	stops     map[int]services.StopChan // by task ID, for tasks scheduled at least once
+	abandoned map[int]bool              // tasks cancelled by the deadline of a descendant task
+
 	taskCh   chan *memoryTaskRun
 	resultCh chan TaskRunResult
 }
@@ -79,6 +84,8 @@ func newScheduler(p *Pipeline, run *Run, vars Vars, lggr logger.Logger) *schedul
 		results:      make(map[int]TaskRunResult, len(p.Tasks)),
 		vars:         vars,
 		logger:       lggr,
+		stops:        make(map[int]services.StopChan),
+		abandoned:    make(map[int]bool),
 
 		// taskCh should never block
 		taskCh:   make(chan *memoryTaskRun, len(dependencies)),
@@ -100,15 +107,26 @@ func newScheduler(p *Pipeline, run *Run, vars Vars, lggr logger.Logger) *schedul
 			continue
 		}
 
-		run := s.newMemoryTaskRun(task, s.vars.Copy())
+		s.schedule(s.newMemoryTaskRun(task, s.vars.Copy()))
+	}
 
-		lggr.Tracew("scheduling task run", "dot_id", task.DotID(), "attempts", run.attempts)
+	return s
+}
 
-		s.taskCh <- run
-		s.waiting++
+// schedule sends the task run to be executed, with the stop channel of the task to cancel it once its result is no
+// longer awaited.
+func (s *scheduler) schedule(run *memoryTaskRun) {
+	id := run.task.ID()
+	stop, ok := s.stops[id]
+	if !ok {
+		stop = make(services.StopChan)
+		s.stops[id] = stop
 	}
+	run.stop = stop
 
-	return s
+	s.logger.Tracew("scheduling task run", "dot_id", run.task.DotID(), "attempts", run.attempts)
+	s.taskCh <- run
+	s.waiting++
 }
 
 func (s *scheduler) reconstructResults() {
@@ -163,17 +181,37 @@ func (s *scheduler) reconstructResults() {
 func (s *scheduler) Run() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
+
+	deadlineCh := make(chan int, len(s.pipeline.Tasks))
+	for id, task := range s.pipeline.Tasks {
+		if deadline, isSet := task.Base().TaskDeadline(); isSet {
+			timer := time.AfterFunc(deadline, func() { deadlineCh <- id })
+			defer timer.Stop()
+		}
+	}
+
 	for s.waiting > 0 {
 		// we don't "for result in resultCh" because it would stall if the
 		// pipeline is completely empty
 
-		result := <-s.resultCh
+		var result TaskRunResult
+		select {
+		case result = <-s.resultCh:
+		case id := <-deadlineCh:
+			s.deadlineExceeded(id)
+			continue
+		}
 		// TODO: if for some reason the cleanup didn't succeed and we're stuck waiting for reports forever
 		// we should be able to timeout and finish shutting down
 		// See: https://smartcontract-it.atlassian.net/browse/BCF-994
 
 		s.waiting--
 
+		if s.abandoned[result.Task.ID()] {
+			// the task was cancelled by a deadline, and its result already recorded
+			continue
+		}
+
 		// retrieve previous attempt count
 		result.Attempts = s.results[result.Task.ID()].Attempts
 
@@ -231,8 +269,18 @@ func (s *scheduler) Run() {
 				Max:    result.Task.TaskMaxBackoff(),
 			}
 
+			stop := s.stops[result.Task.ID()]
 			go func(vars Vars) {
 				select {
+				case <-stop:
+					// report back so the waiting counter gets decreased
+					now := time.Now()
+					s.report(context.Background(), TaskRunResult{
+						Task:       result.Task,
+						Result:     Result{Error: ErrDeadlineExceeded},
+						CreatedAt:  now,
+						FinishedAt: null.TimeFrom(now),
+					})
 				case <-ctx.Done():
 					// report back so the waiting counter gets decreased
 					now := time.Now()
@@ -246,6 +294,7 @@ func (s *scheduler) Run() {
 					// schedule a new attempt
 					run := s.newMemoryTaskRun(result.Task, vars)
 					run.attempts = result.Attempts
+					run.stop = stop
 					s.logger.Tracew("scheduling task run", "dot_id", run.task.DotID(), "attempts", run.attempts)
 					s.taskCh <- run
 				}
@@ -260,13 +309,8 @@ func (s *scheduler) Run() {
 			s.dependencies[id]--
 
 			// if all dependencies are done, schedule task run
-			if s.dependencies[id] == 0 {
-				task := s.pipeline.Tasks[id]
-				run := s.newMemoryTaskRun(task, s.vars.Copy())
-
-				s.logger.Tracew("scheduling task run", "dot_id", run.task.DotID(), "attempts", run.attempts)
-				s.taskCh <- run
-				s.waiting++
+			if s.dependencies[id] == 0 && !s.abandoned[id] {
+				s.schedule(s.newMemoryTaskRun(s.pipeline.Tasks[id], s.vars.Copy()))
 			}
 		}
 	}
@@ -274,6 +318,65 @@ func (s *scheduler) Run() {
 	close(s.taskCh)
 }
 
+// deadlineExceeded schedules the task with the partial results of its inputs, if it is still waiting on them. The
+// ancestors of the task which are not complete yet are cancelled, and result in ErrDeadlineExceeded.
+func (s *scheduler) deadlineExceeded(id int) {
+	if _, scheduled := s.stops[id]; scheduled || s.exiting || s.abandoned[id] {
+		return
+	}
+	if _, done := s.results[id]; done {
+		return
+	}
+
+	var incomplete []Task
+	visited := make(map[int]bool)
+	queue := []Task{s.pipeline.Tasks[id]}
+	for len(queue) > 0 {
+		task := queue[0]
+		queue = queue[1:]
+		for _, input := range task.Inputs() {
+			inputID := input.InputTask.ID()
+			if visited[inputID] {
+				continue
+			}
+			visited[inputID] = true
+			if _, done := s.results[inputID]; done {
+				continue
+			}
+			incomplete = append(incomplete, input.InputTask)
+			queue = append(queue, input.InputTask)
+		}
+	}
+	s.logger.Debugw("Task deadline exceeded, cancelling incomplete inputs", "dot_id", s.pipeline.Tasks[id].DotID(), "incomplete", len(incomplete))
+
+	now := time.Now()
+	for _, task := range incomplete {
+		s.abandoned[task.ID()] = true
+		if stop, ok := s.stops[task.ID()]; ok {
+			close(stop)
+		}
+		s.results[task.ID()] = TaskRunResult{
+			Task:       task,
+			Result:     Result{Error: ErrDeadlineExceeded},
+			CreatedAt:  now,
+			FinishedAt: null.TimeFrom(now),
+		}
+		if err := s.vars.Set(task.DotID(), ErrDeadlineExceeded); err != nil {
+			s.logger.Panicf("Vars.Set error: %v", err)
+		}
+	}
+
+	for _, task := range incomplete {
+		for _, output := range task.Outputs() {
+			outputID := output.ID()
+			s.dependencies[outputID]--
+			if s.dependencies[outputID] == 0 && !s.abandoned[outputID] {
+				s.schedule(s.newMemoryTaskRun(output, s.vars.Copy()))
+			}
+		}
+	}
+}
+
 func (s *scheduler) markRemaining(err error) {
 	now := time.Now()
 	for _, task := range s.pipeline.Tasks {
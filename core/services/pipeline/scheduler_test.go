@@ -167,3 +167,72 @@ func TestScheduler(t *testing.T) {
 		test.assertion(t, *p, s.results)
 	}
 }
+
+func TestScheduler_Deadline(t *testing.T) {
+	p, err := Parse(`
+	a [type=median]
+	b [type=median]
+	c [type=median deadline="50ms" index=0]
+	a -> c
+	b -> c`)
+	require.NoError(t, err)
+	vars := NewVarsFrom(nil)
+	run := NewRun(Spec{}, vars)
+	s := newScheduler(p, run, vars, logger.TestLogger(t))
+
+	go s.Run()
+
+	report := func(taskRun *memoryTaskRun, result Result) {
+		now := time.Now()
+		s.report(testutils.Context(t), TaskRunResult{
+			ID:         uuid.New(),
+			Task:       taskRun.task,
+			Result:     result,
+			FinishedAt: null.TimeFrom(now),
+			CreatedAt:  now,
+		})
+	}
+	next := func() *memoryTaskRun {
+		select {
+		case taskRun := <-s.taskCh:
+			return taskRun
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for task run")
+			return nil
+		}
+	}
+
+	runs := map[string]*memoryTaskRun{}
+	for i := 0; i < 2; i++ {
+		taskRun := next()
+		runs[taskRun.task.DotID()] = taskRun
+	}
+	require.Contains(t, runs, "a")
+	require.Contains(t, runs, "b")
+	report(runs["a"], Result{Value: 1})
+
+	// b does not respond in time, so c runs with the partial results
+	c := next()
+	require.Equal(t, "c", c.task.DotID())
+	require.Len(t, c.inputs, 2)
+	require.Equal(t, 1, c.inputs[0].Value)
+	require.ErrorIs(t, c.inputs[1].Error, ErrDeadlineExceeded)
+	select {
+	case <-runs["b"].stop:
+	default:
+		t.Fatal("b was not cancelled")
+	}
+
+	// the late result of b is ignored
+	report(runs["b"], Result{Value: 2})
+	report(c, Result{Value: 1})
+
+	select {
+	case _, ok := <-s.taskCh:
+		require.Falsef(t, ok, "scheduler has more tasks to schedule")
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for scheduler to halt")
+	}
+	require.ErrorIs(t, s.results[p.ByDotID("b").ID()].Result.Error, ErrDeadlineExceeded)
+	require.Equal(t, 1, s.results[p.ByDotID("c").ID()].Result.Value)
+}
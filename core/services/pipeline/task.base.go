@@ -17,6 +17,12 @@ type BaseTask struct {
 	Index     int32          `mapstructure:"index" json:"-" `
 	Timeout   *time.Duration `mapstructure:"timeout"`
 	FailEarly bool           `mapstructure:"failEarly"`
+	// Deadline, since the start of the run, after which the task runs with the partial results of its inputs. The
+	// inputs still incomplete are cancelled, and result in ErrDeadlineExceeded.
+	Deadline *time.Duration `mapstructure:"deadline"`
+	// ResultCacheTTL, if set, caches the successful results of the task for this long. Runs reuse the cached result
+	// of the task with the same config, variables and inputs instead of running it.
+	ResultCacheTTL *time.Duration `mapstructure:"resultCacheTTL"`
 
 	Retries    null.Uint32   `mapstructure:"retries"`
 	MinBackoff time.Duration `mapstructure:"minBackoff"`
@@ -62,6 +68,20 @@ func (t BaseTask) TaskTimeout() (time.Duration, bool) {
 	return *t.Timeout, true
 }
 
+func (t BaseTask) TaskDeadline() (time.Duration, bool) {
+	if t.Deadline == nil {
+		return time.Duration(0), false
+	}
+	return *t.Deadline, true
+}
+
+func (t BaseTask) TaskResultCacheTTL() (time.Duration, bool) {
+	if t.ResultCacheTTL == nil {
+		return time.Duration(0), false
+	}
+	return *t.ResultCacheTTL, true
+}
+
 func (t BaseTask) TaskRetries() uint32 {
 	return t.Retries.Uint32
 }
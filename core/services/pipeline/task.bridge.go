@@ -2,7 +2,9 @@ package pipeline
 
 import (
 	"context"
+	cryptorand "crypto/rand"
 	"database/sql"
+	"encoding/hex"
 	"encoding/json"
 	"net/http"
 	"net/url"
@@ -49,6 +51,12 @@ var (
 	},
 		[]string{"name"},
 	)
+	promBridgeSignatureErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "bridge_signature_errors_total",
+		Help: "Bridge response signature verification failures count scoped by name",
+	},
+		[]string{"name"},
+	)
 )
 
 // Return types:
@@ -112,10 +120,11 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	overtimeCtx, cancel := overtimeContext(ctx)
 	defer cancel()
 
-	url, err := t.getBridgeURLFromName(overtimeCtx, name)
+	bt, err := t.orm.FindBridge(overtimeCtx, bridges.BridgeName(name))
 	if err != nil {
-		return Result{Error: err}, runInfo
+		return Result{Error: errors.Wrapf(err, "could not find bridge with name '%s'", name)}, runInfo
 	}
+	url := URLParam(bt.URL)
 
 	var metaMap MapParam
 
@@ -169,8 +178,29 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		cacheDuration = stalenessCap
 	}
 
+	// signed responses are bound to the task run and a fresh nonce, so they can't be replayed for other requests
+	var requestID, nonce string
+	if len(bt.ResponsePublicKey) != 0 {
+		requestID = t.uuid.String()
+		nonceBytes := make([]byte, 16)
+		if _, err = cryptorand.Read(nonceBytes); err != nil {
+			return Result{Error: errors.Wrap(err, "failed to generate bridge request nonce")}, runInfo
+		}
+		nonce = hex.EncodeToString(nonceBytes)
+		reqHeaders = append(reqHeaders[:len(reqHeaders):len(reqHeaders)],
+			bridges.RequestIDHeader, requestID, bridges.RequestNonceHeader, nonce)
+	}
+
 	var cachedResponse bool
 	responseBytes, statusCode, headers, elapsed, err := makeHTTPRequest(requestCtx, lggr, "POST", url, reqHeaders, requestData, t.httpClient, t.config.DefaultHTTPLimit())
+	if err == nil {
+		// unsigned or badly signed responses are rejected, like failed requests
+		if err = bt.VerifyResponse(requestID, nonce, responseBytes, headers); err != nil {
+			promBridgeSignatureErrors.WithLabelValues(t.Name).Inc()
+			lggr.Errorw("Bridge task: response signature verification failed", "err", err, "url", url.String())
+			responseBytes, statusCode = nil, 0
+		}
+	}
 
 	// check for external adapter response object status
 	if code, ok := eautils.BestEffortExtractEAStatus(responseBytes); ok {
@@ -248,14 +278,6 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	return result, runInfo
 }
 
-func (t *BridgeTask) getBridgeURLFromName(ctx context.Context, name StringParam) (URLParam, error) {
-	bt, err := t.orm.FindBridge(ctx, bridges.BridgeName(name))
-	if err != nil {
-		return URLParam{}, errors.Wrapf(err, "could not find bridge with name '%s'", name)
-	}
-	return URLParam(bt.URL), nil
-}
-
 func withRunInfo(request MapParam, meta MapParam) MapParam {
 	output := make(MapParam)
 	for k, v := range request {
@@ -2,6 +2,9 @@ package pipeline_test
 
 import (
 	"context"
+	"crypto/ed25519"
+	cryptorand "crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -11,6 +14,7 @@ import (
 	"net/url"
 	"os"
 	"sort"
+	"strconv"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -36,6 +40,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline/eautils"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/utils/crypto"
 )
 
 // ethUSDPairing has the ETH/USD parameters needed when POSTing to the price
@@ -250,6 +255,62 @@ func TestBridgeTask_Happy(t *testing.T) {
 	require.Equal(t, decimal.NewFromInt(9700), x.Data.Result)
 }
 
+func TestBridgeTask_SignedResponses(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	pub, priv, err := ed25519.GenerateKey(cryptorand.Reader)
+	require.NoError(t, err)
+
+	body := []byte(`{"data":{"result":9700}}`)
+	var replayed atomic.Pointer[http.Header]
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header := w.Header()
+		if h := replayed.Load(); h != nil {
+			for k, v := range *h {
+				header[k] = v
+			}
+		} else {
+			timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+			payload := bridges.ResponseSignaturePayload(r.Header.Get(bridges.RequestIDHeader), r.Header.Get(bridges.RequestNonceHeader), timestamp, body)
+			header.Set(bridges.ResponseTimestampHeader, timestamp)
+			header.Set(bridges.ResponseSignatureHeader, hex.EncodeToString(ed25519.Sign(priv, payload)))
+			replayed.Store(ptr(header.Clone()))
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(body)
+	}))
+	defer s.Close()
+
+	orm := bridges.NewORM(db)
+	_, bridge := cltest.NewBridgeType(t, cltest.BridgeOpts{URL: s.URL})
+	bridge.ResponsePublicKey = crypto.PublicKey(pub)
+	require.NoError(t, orm.CreateBridgeType(testutils.Context(t), bridge))
+
+	trORM := pipeline.NewORM(db, logger.TestLogger(t), cfg.JobPipeline().MaxSuccessfulRuns())
+	specID, err := trORM.CreateSpec(testutils.Context(t), pipeline.Pipeline{}, *models.NewInterval(5 * time.Minute))
+	require.NoError(t, err)
+	run := func() pipeline.Result {
+		task := pipeline.BridgeTask{
+			BaseTask:    pipeline.NewBaseTask(0, "bridge", nil, nil, 0),
+			Name:        bridge.Name.String(),
+			RequestData: btcUSDPairing,
+		}
+		task.HelperSetDependencies(cfg.JobPipeline(), cfg.WebServer(), orm, specID, uuid.New(), clhttptest.NewTestLocalOnlyHTTPClient())
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		return result
+	}
+
+	result := run()
+	require.NoError(t, result.Error)
+	require.Equal(t, string(body), result.Value)
+
+	// the signed response of the first request is rejected for the next one
+	result = run()
+	require.Error(t, result.Error)
+}
+
 func TestBridgeTask_HandlesIntermittentFailure(t *testing.T) {
 	t.Parallel()
 
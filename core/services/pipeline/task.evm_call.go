@@ -0,0 +1,246 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// EVMCallTask reads contracts of a chain directly: it ABI encodes the arguments of each call, sends all calls as a
+// single JSON-RPC batch of eth_call, and ABI decodes the return values.
+//
+// A single call is described by the contract, abi, data and returns params:
+//
+//	read [type="evmcall" contract="0x..." abi="balanceOf(address account)" data=<{"account": $(account)}> returns="uint256 balance"]
+//
+// Several calls are batched with the calls param, a JSON list of objects with the same keys. The contract of a call
+// defaults to the contract param:
+//
+//	read [type="evmcall" contract="0x..." calls=<[{"abi": "decimals()", "returns": "uint8 decimals"}, {"abi": "latestAnswer()", "returns": "int256 answer"}]>]
+//
+// Return types:
+//
+//	map[string]interface{} with any geth/abigen value type, for a single call
+//	[]interface{} of map[string]interface{}, in order of the calls, for batched calls
+type EVMCallTask struct {
+	BaseTask   `mapstructure:",squash"`
+	Contract   string `json:"contract"`
+	ABI        string `json:"abi"`
+	Data       string `json:"data"`
+	Returns    string `json:"returns"`
+	Calls      string `json:"calls"`
+	EVMChainID string `json:"evmChainID" mapstructure:"evmChainID"`
+	Block      string `json:"block"`
+
+	legacyChains legacyevm.LegacyChainContainer
+}
+
+var _ Task = (*EVMCallTask)(nil)
+
+type evmCall struct {
+	contract common.Address
+	data     []byte
+	returns  abi.Arguments
+}
+
+func (t *EVMCallTask) Type() TaskType {
+	return TaskTypeEVMCall
+}
+
+func (t *EVMCallTask) getEvmChainID() string {
+	if t.EVMChainID == "" {
+		t.EVMChainID = "$(jobSpec.evmChainID)"
+	}
+	return t.EVMChainID
+}
+
+func (t *EVMCallTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		contractAddr AddressParam
+		chainID      StringParam
+		block        StringParam
+		calls        SliceParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&contractAddr, From(VarExpr(t.Contract, vars), NonemptyString(t.Contract), utils.ZeroAddress)), "contract"),
+		errors.Wrap(ResolveParam(&chainID, From(VarExpr(t.getEvmChainID(), vars), NonemptyString(t.getEvmChainID()), "")), "evmChainID"),
+		errors.Wrap(ResolveParam(&block, From(VarExpr(t.Block, vars), t.Block)), "block"),
+		errors.Wrap(ResolveParam(&calls, From(VarExpr(t.Calls, vars), JSONWithVarExprs(t.Calls, vars, false), nil)), "calls"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	batched := len(calls) > 0
+	var evmCalls []evmCall
+	if batched {
+		for i, c := range calls {
+			m, ok := c.(map[string]interface{})
+			if !ok {
+				return Result{Error: errors.Wrapf(ErrBadInput, "calls: expected call %d to be an object, got %T", i, c)}, runInfo
+			}
+			call, err2 := newEVMCall(common.Address(contractAddr), m["contract"], m["abi"], m["data"], m["returns"])
+			if err2 != nil {
+				return Result{Error: errors.Wrapf(err2, "calls: call %d", i)}, runInfo
+			}
+			evmCalls = append(evmCalls, call)
+		}
+	} else {
+		var (
+			theABI  StringParam
+			data    MapParam
+			returns StringParam
+		)
+		err = multierr.Combine(
+			errors.Wrap(ResolveParam(&theABI, From(NonemptyString(t.ABI))), "abi"),
+			errors.Wrap(ResolveParam(&data, From(VarExpr(t.Data, vars), JSONWithVarExprs(t.Data, vars, false), nil)), "data"),
+			errors.Wrap(ResolveParam(&returns, From(NonemptyString(t.Returns))), "returns"),
+		)
+		if err != nil {
+			return Result{Error: err}, runInfo
+		}
+		call, err2 := newEVMCall(common.Address(contractAddr), nil, string(theABI), data.Map(), string(returns))
+		if err2 != nil {
+			return Result{Error: err2}, runInfo
+		}
+		evmCalls = append(evmCalls, call)
+	}
+
+	blockArg, err := evmCallBlockArg(block.String())
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "block")}, runInfo
+	}
+
+	chain, err := t.legacyChains.Get(string(chainID))
+	if err != nil {
+		err = fmt.Errorf("%w: %s: %w", ErrInvalidEVMChainID, chainID, err)
+		return Result{Error: err}, runInfo
+	}
+
+	reqs := make([]rpc.BatchElem, len(evmCalls))
+	for i, call := range evmCalls {
+		reqs[i] = rpc.BatchElem{
+			Method: "eth_call",
+			Args: []interface{}{
+				map[string]interface{}{
+					"to":   call.contract.Hex(),
+					"data": hexutil.Bytes(call.data),
+				},
+				blockArg,
+			},
+			Result: new(hexutil.Bytes),
+		}
+	}
+	if err = chain.Client().BatchCallContext(ctx, reqs); err != nil {
+		return Result{Error: err}, retryableRunInfo()
+	}
+
+	results := make([]interface{}, len(evmCalls))
+	for i, req := range reqs {
+		if req.Error != nil {
+			lggr.Debugw("EVMCall: eth_call failed", "contract", evmCalls[i].contract, "err", req.Error)
+			return Result{Error: errors.Wrapf(req.Error, "call %d", i)}, retryableRunInfo()
+		}
+		out := make(map[string]interface{})
+		if err = evmCalls[i].returns.UnpackIntoMap(out, *req.Result.(*hexutil.Bytes)); err != nil {
+			return Result{Error: errors.Wrapf(ErrBadInput, "EVMCall: could not ABI decode return values of call %d: %v", i, err)}, runInfo
+		}
+		results[i] = out
+	}
+
+	if !batched {
+		return Result{Value: results[0]}, runInfo
+	}
+	return Result{Value: results}, runInfo
+}
+
+// newEVMCall ABI encodes a call of the method described by theABI, with the arguments found in data.
+func newEVMCall(defaultContract common.Address, contract, theABI, data, returns interface{}) (call evmCall, err error) {
+	var (
+		contractAddr = AddressParam(defaultContract)
+		abiStr       StringParam
+		args         MapParam
+		returnsStr   StringParam
+	)
+	if contract != nil {
+		err = errors.Wrap(ResolveParam(&contractAddr, From(contract)), "contract")
+	}
+	err = multierr.Combine(err,
+		errors.Wrap(ResolveParam(&abiStr, From(theABI)), "abi"),
+		errors.Wrap(ResolveParam(&args, From(data)), "data"),
+		errors.Wrap(ResolveParam(&returnsStr, From(returns)), "returns"),
+	)
+	if err != nil {
+		return call, err
+	}
+	if common.Address(contractAddr) == utils.ZeroAddress {
+		return call, errors.Wrap(ErrBadInput, "contract param must not be empty")
+	}
+
+	methodName, inputs, _, err := parseETHABIString([]byte(abiStr), false)
+	if err != nil {
+		return call, errors.Wrapf(ErrBadInput, "EVMCall: while parsing ABI string: %v", err)
+	} else if methodName == "" {
+		return call, errors.Wrapf(ErrBadInput, "EVMCall: missing method name in ABI string: %s", abiStr)
+	}
+	outputs, _, err := ParseETHABIArgsString([]byte(returnsStr), false)
+	if err != nil {
+		return call, errors.Wrapf(ErrBadInput, "EVMCall: while parsing returns: %v", err)
+	}
+	method := abi.NewMethod(methodName, methodName, abi.Function, "view", false, false, inputs, outputs)
+
+	var vals []interface{}
+	for _, arg := range inputs {
+		val, exists := args[arg.Name]
+		if !exists {
+			return call, errors.Wrapf(ErrBadInput, "EVMCall: argument '%v' is missing", arg.Name)
+		}
+		val, err = convertToETHABIType(val, arg.Type)
+		if err != nil {
+			return call, errors.Wrapf(ErrBadInput, "EVMCall: while converting argument '%v' from %T to %v: %v", arg.Name, val, arg.Type, err)
+		}
+		vals = append(vals, val)
+	}
+	argsEncoded, err := method.Inputs.Pack(vals...)
+	if err != nil {
+		return call, errors.Wrapf(ErrBadInput, "EVMCall: could not ABI encode values: %v", err)
+	}
+
+	return evmCall{
+		contract: common.Address(contractAddr),
+		data:     append(method.ID, argsEncoded...),
+		returns:  outputs,
+	}, nil
+}
+
+// evmCallBlockArg returns the block parameter of eth_call: latest (the default), pending, or a block number.
+func evmCallBlockArg(block string) (string, error) {
+	switch strings.ToLower(block) {
+	case "", "latest":
+		return "latest", nil
+	case "pending":
+		return "pending", nil
+	}
+	n, ok := new(big.Int).SetString(block, 0)
+	if !ok || n.Sign() < 0 {
+		return "", errors.Wrapf(ErrBadInput, "expected latest, pending or a block number, got %s", block)
+	}
+	return hexutil.EncodeBig(n), nil
+}
@@ -0,0 +1,173 @@
+package pipeline_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestEVMCallTask(t *testing.T) {
+	t.Parallel()
+
+	const contract = "0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF"
+	account := common.HexToAddress("0x0000000000000000000000000000000000000001")
+
+	// balanceOf(address) and decimals()
+	balanceOfData := hexutil.MustDecode("0x70a08231" + "0000000000000000000000000000000000000000000000000000000000000001")
+	decimalsData := hexutil.MustDecode("0x313ce567")
+	word := func(i int64) hexutil.Bytes { return common.LeftPadBytes(big.NewInt(i).Bytes(), 32) }
+
+	tests := []struct {
+		name                  string
+		task                  pipeline.EVMCallTask
+		vars                  pipeline.Vars
+		expectedCalls         []map[string]interface{}
+		expectedBlock         string
+		responses             []hexutil.Bytes
+		expected              interface{}
+		expectedErrorCause    error
+		expectedErrorContains string
+	}{
+		{
+			name: "single call",
+			task: pipeline.EVMCallTask{
+				Contract: contract,
+				ABI:      "balanceOf(address account)",
+				Data:     `{"account": $(account)}`,
+				Returns:  "uint256 balance",
+			},
+			vars:          pipeline.NewVarsFrom(map[string]interface{}{"account": account.Hex()}),
+			expectedCalls: []map[string]interface{}{{"to": common.HexToAddress(contract).Hex(), "data": hexutil.Bytes(balanceOfData)}},
+			expectedBlock: "latest",
+			responses:     []hexutil.Bytes{word(42)},
+			expected:      map[string]interface{}{"balance": big.NewInt(42)},
+		},
+		{
+			name: "batched calls at block number",
+			task: pipeline.EVMCallTask{
+				Contract: contract,
+				Calls:    `[{"abi": "decimals()", "returns": "uint8 decimals"}, {"contract": $(token), "abi": "balanceOf(address account)", "data": {"account": $(account)}, "returns": "uint256 balance"}]`,
+				Block:    "$(block)",
+			},
+			vars: pipeline.NewVarsFrom(map[string]interface{}{
+				"token":   "0x0000000000000000000000000000000000000002",
+				"account": account.Hex(),
+				"block":   "100",
+			}),
+			expectedCalls: []map[string]interface{}{
+				{"to": common.HexToAddress(contract).Hex(), "data": hexutil.Bytes(decimalsData)},
+				{"to": common.HexToAddress("0x0000000000000000000000000000000000000002").Hex(), "data": hexutil.Bytes(balanceOfData)},
+			},
+			expectedBlock: "0x64",
+			responses:     []hexutil.Bytes{word(18), word(42)},
+			expected: []interface{}{
+				map[string]interface{}{"decimals": uint8(18)},
+				map[string]interface{}{"balance": big.NewInt(42)},
+			},
+		},
+		{
+			name: "missing argument",
+			task: pipeline.EVMCallTask{
+				Contract: contract,
+				ABI:      "balanceOf(address account)",
+				Returns:  "uint256 balance",
+			},
+			vars:                  pipeline.NewVarsFrom(nil),
+			expectedErrorCause:    pipeline.ErrBadInput,
+			expectedErrorContains: "argument 'account' is missing",
+		},
+		{
+			name: "missing contract",
+			task: pipeline.EVMCallTask{
+				Calls: `[{"abi": "decimals()", "returns": "uint8 decimals"}]`,
+			},
+			vars:                  pipeline.NewVarsFrom(nil),
+			expectedErrorCause:    pipeline.ErrBadInput,
+			expectedErrorContains: "contract param must not be empty",
+		},
+		{
+			name: "bad block",
+			task: pipeline.EVMCallTask{
+				Contract: contract,
+				ABI:      "decimals()",
+				Returns:  "uint8 decimals",
+				Block:    "earliest",
+			},
+			vars:                  pipeline.NewVarsFrom(nil),
+			expectedErrorCause:    pipeline.ErrBadInput,
+			expectedErrorContains: "block",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			task := test.task
+			task.BaseTask = pipeline.NewBaseTask(0, "evmcall", nil, nil, 0)
+			task.EVMChainID = "0"
+
+			ethClient := evmclimocks.NewClient(t)
+			if test.responses != nil {
+				ethClient.On("BatchCallContext", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					reqs := args.Get(1).([]rpc.BatchElem)
+					require.Len(t, reqs, len(test.expectedCalls))
+					for i, req := range reqs {
+						assert.Equal(t, "eth_call", req.Method)
+						assert.Equal(t, []interface{}{test.expectedCalls[i], test.expectedBlock}, req.Args)
+						*req.Result.(*hexutil.Bytes) = test.responses[i]
+					}
+				}).Return(nil).Once()
+			}
+			cfg := configtest.NewGeneralConfig(t, nil)
+			task.HelperSetDependencies(cltest.NewLegacyChainsWithMockChain(t, ethClient, cfg))
+
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), test.vars, nil)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+
+			if test.expectedErrorCause != nil {
+				require.Nil(t, result.Value)
+				require.Equal(t, test.expectedErrorCause, errors.Cause(result.Error))
+				require.Contains(t, result.Error.Error(), test.expectedErrorContains)
+			} else {
+				require.NoError(t, result.Error)
+				require.Equal(t, test.expected, result.Value)
+			}
+		})
+	}
+
+	t.Run("call error", func(t *testing.T) {
+		ethClient := evmclimocks.NewClient(t)
+		ethClient.On("BatchCallContext", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			args.Get(1).([]rpc.BatchElem)[0].Error = errors.New("execution reverted")
+		}).Return(nil).Once()
+		cfg := configtest.NewGeneralConfig(t, nil)
+
+		task := pipeline.EVMCallTask{
+			BaseTask:   pipeline.NewBaseTask(0, "evmcall", nil, nil, 0),
+			Contract:   contract,
+			ABI:        "decimals()",
+			Returns:    "uint8 decimals",
+			EVMChainID: "0",
+		}
+		task.HelperSetDependencies(cltest.NewLegacyChainsWithMockChain(t, ethClient, cfg))
+
+		result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.ErrorContains(t, result.Error, "execution reverted")
+		assert.True(t, runInfo.IsRetryable)
+	})
+}
@@ -17,6 +17,7 @@ type MeanTask struct {
 	BaseTask      `mapstructure:",squash"`
 	Values        string `json:"values"`
 	AllowedFaults string `json:"allowedFaults"`
+	MinResponses  string `json:"minResponses"`
 	Precision     string `json:"precision"`
 }
 
@@ -29,6 +30,7 @@ func (t *MeanTask) Type() TaskType {
 func (t *MeanTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
 	var (
 		maybeAllowedFaults MaybeUint64Param
+		maybeMinResponses  MaybeUint64Param
 		maybePrecision     MaybeInt32Param
 		valuesAndErrs      SliceParam
 		decimalValues      DecimalSliceParam
@@ -37,6 +39,7 @@ func (t *MeanTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 	)
 	err := multierr.Combine(
 		errors.Wrap(ResolveParam(&maybeAllowedFaults, From(t.AllowedFaults)), "allowedFaults"),
+		errors.Wrap(ResolveParam(&maybeMinResponses, From(t.MinResponses)), "minResponses"),
 		errors.Wrap(ResolveParam(&maybePrecision, From(VarExpr(t.Precision, vars), t.Precision)), "precision"),
 		errors.Wrap(ResolveParam(&valuesAndErrs, From(VarExpr(t.Values, vars), JSONWithVarExprs(t.Values, vars, true), Inputs(inputs))), "values"),
 	)
@@ -53,6 +56,8 @@ func (t *MeanTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 	values, faults := valuesAndErrs.FilterErrors()
 	if faults > allowedFaults {
 		return Result{Error: errors.Wrapf(ErrTooManyErrors, "Number of faulty inputs %v to mean task > number allowed faults %v", faults, allowedFaults)}, runInfo
+	} else if minResponses, isSet := maybeMinResponses.Uint64(); isSet && uint64(len(values)) < minResponses {
+		return Result{Error: errors.Wrapf(ErrTooManyErrors, "Number of responses %v to mean task < minimum responses %v", len(values), minResponses)}, runInfo
 	} else if len(values) == 0 {
 		return Result{Error: errors.Wrap(ErrWrongInputCardinality, "values")}, runInfo
 	}
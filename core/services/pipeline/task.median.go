@@ -18,6 +18,7 @@ type MedianTask struct {
 	BaseTask      `mapstructure:",squash"`
 	Values        string `json:"values"`
 	AllowedFaults string `json:"allowedFaults"`
+	MinResponses  string `json:"minResponses"`
 }
 
 var _ Task = (*MedianTask)(nil)
@@ -29,6 +30,7 @@ func (t *MedianTask) Type() TaskType {
 func (t *MedianTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
 	var (
 		maybeAllowedFaults MaybeUint64Param
+		maybeMinResponses  MaybeUint64Param
 		valuesAndErrs      SliceParam
 		decimalValues      DecimalSliceParam
 		allowedFaults      int
@@ -36,6 +38,7 @@ func (t *MedianTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs [
 	)
 	err := multierr.Combine(
 		errors.Wrap(ResolveParam(&maybeAllowedFaults, From(t.AllowedFaults)), "allowedFaults"),
+		errors.Wrap(ResolveParam(&maybeMinResponses, From(t.MinResponses)), "minResponses"),
 		errors.Wrap(ResolveParam(&valuesAndErrs, From(VarExpr(t.Values, vars), JSONWithVarExprs(t.Values, vars, true), Inputs(inputs))), "values"),
 	)
 	if err != nil {
@@ -51,6 +54,8 @@ func (t *MedianTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs [
 	values, faults := valuesAndErrs.FilterErrors()
 	if faults > allowedFaults {
 		return Result{Error: errors.Wrapf(ErrTooManyErrors, "Number of faulty inputs %v to median task > number allowed faults %v", faults, allowedFaults)}, runInfo
+	} else if minResponses, isSet := maybeMinResponses.Uint64(); isSet && uint64(len(values)) < minResponses {
+		return Result{Error: errors.Wrapf(ErrTooManyErrors, "Number of responses %v to median task < minimum responses %v", len(values), minResponses)}, runInfo
 	} else if len(values) == 0 {
 		return Result{Error: errors.Wrap(ErrWrongInputCardinality, "no values to medianize")}, runInfo
 	}
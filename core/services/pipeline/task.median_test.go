@@ -203,3 +203,21 @@ func TestMedianTask_AllowedFaults_Unmarshal(t *testing.T) {
 		}
 	}
 }
+
+func TestMedianTask_MinResponses(t *testing.T) {
+	t.Parallel()
+
+	inputs := []pipeline.Result{{Error: pipeline.ErrDeadlineExceeded}, {Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "4")}}
+	task := pipeline.MedianTask{
+		BaseTask:     pipeline.NewBaseTask(0, "task", nil, nil, 0),
+		MinResponses: "2",
+	}
+	output, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), inputs)
+	require.NoError(t, output.Error)
+	require.Equal(t, "3", output.Value.(decimal.Decimal).String())
+
+	task.MinResponses = "3"
+	output, _ = task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), inputs)
+	require.ErrorIs(t, output.Error, pipeline.ErrTooManyErrors)
+	require.ErrorContains(t, output.Error, "Number of responses 2 to median task < minimum responses 3")
+}
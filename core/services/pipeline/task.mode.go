@@ -23,6 +23,7 @@ type ModeTask struct {
 	BaseTask      `mapstructure:",squash"`
 	Values        string `json:"values"`
 	AllowedFaults string `json:"allowedFaults"`
+	MinResponses  string `json:"minResponses"`
 }
 
 var _ Task = (*ModeTask)(nil)
@@ -34,12 +35,14 @@ func (t *ModeTask) Type() TaskType {
 func (t *ModeTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
 	var (
 		maybeAllowedFaults MaybeUint64Param
+		maybeMinResponses  MaybeUint64Param
 		valuesAndErrs      SliceParam
 		allowedFaults      int
 		faults             int
 	)
 	err := multierr.Combine(
 		errors.Wrap(ResolveParam(&maybeAllowedFaults, From(t.AllowedFaults)), "allowedFaults"),
+		errors.Wrap(ResolveParam(&maybeMinResponses, From(t.MinResponses)), "minResponses"),
 		errors.Wrap(ResolveParam(&valuesAndErrs, From(VarExpr(t.Values, vars), JSONWithVarExprs(t.Values, vars, true), Inputs(inputs))), "values"),
 	)
 	if err != nil {
@@ -55,6 +58,8 @@ func (t *ModeTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []R
 	values, faults := valuesAndErrs.FilterErrors()
 	if faults > allowedFaults {
 		return Result{Error: errors.Wrapf(ErrTooManyErrors, "Number of faulty inputs %v to mode task > number allowed faults %v", faults, allowedFaults)}, runInfo
+	} else if minResponses, isSet := maybeMinResponses.Uint64(); isSet && uint64(len(values)) < minResponses {
+		return Result{Error: errors.Wrapf(ErrTooManyErrors, "Number of responses %v to mode task < minimum responses %v", len(values), minResponses)}, runInfo
 	} else if len(values) == 0 {
 		return Result{Error: errors.Wrap(ErrWrongInputCardinality, "values")}, runInfo
 	}
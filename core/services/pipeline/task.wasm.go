@@ -0,0 +1,115 @@
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+const (
+	// defaultWASMFuel bounds the number of instructions a module may execute in a single run.
+	defaultWASMFuel = 100_000_000
+	// defaultWASMMaxMemoryMB bounds the linear memory a module may grow to.
+	defaultWASMMaxMemoryMB = 16
+)
+
+// WASMTask runs a user supplied WebAssembly module over its input, for bespoke transformations like the math of a
+// custom price index, which would otherwise need an external adapter.
+//
+//	index [type="wasm" module="0x0061736d..." input=<{"prices": [$(price1), $(price2)]}>]
+//
+// The input defaults to the list of the values of the task inputs. It is passed to the module as JSON, where decimals
+// are encoded as strings so that no precision is lost, and the module returns its result as JSON. The module must
+// export:
+//
+//	memory
+//	allocate(size i32) i32, returning a pointer to size bytes where the input is written
+//	run(ptr i32, len i32) i64, returning the pointer to the result in the high 32 bits and its length in the low 32 bits
+//
+// Modules run sandboxed and deterministically: no imports are provided, so they have no access to the host, clock or
+// randomness, NaNs are canonicalized, and threads and relaxed SIMD are disabled. A run fails once it has used up its
+// fuel, roughly the number of instructions it may execute, or tries to grow its memory past maxMemoryMB. It is also
+// interrupted once the deadline of the task passes.
+//
+// Modules are run by wasmtime, which requires cgo. Builds without cgo fail the runs of the task.
+//
+// Return types:
+//
+//	int64
+//	float64
+//	string
+//	bool
+//	map[string]interface{}
+//	[]interface{}
+//	nil
+type WASMTask struct {
+	BaseTask    `mapstructure:",squash"`
+	Module      string `json:"module"`
+	Input       string `json:"input"`
+	Fuel        string `json:"fuel"`
+	MaxMemoryMB string `json:"maxMemoryMB" mapstructure:"maxMemoryMB"`
+}
+
+var _ Task = (*WASMTask)(nil)
+
+func (t *WASMTask) Type() TaskType {
+	return TaskTypeWASM
+}
+
+func (t *WASMTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		module      BytesParam
+		input       ObjectParam
+		fuel        Uint64Param
+		maxMemoryMB Uint64Param
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&module, From(VarExpr(t.Module, vars), NonemptyString(t.Module))), "module"),
+		errors.Wrap(ResolveParam(&input, From(VarExpr(t.Input, vars), JSONWithVarExprs(t.Input, vars, false), Inputs(inputs))), "input"),
+		errors.Wrap(ResolveParam(&fuel, From(NonemptyString(t.Fuel), defaultWASMFuel)), "fuel"),
+		errors.Wrap(ResolveParam(&maxMemoryMB, From(NonemptyString(t.MaxMemoryMB), defaultWASMMaxMemoryMB)), "maxMemoryMB"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	if fuel == 0 {
+		return Result{Error: errors.Wrap(ErrBadInput, "fuel must be greater than 0")}, runInfo
+	}
+	if maxMemoryMB == 0 {
+		return Result{Error: errors.Wrap(ErrBadInput, "maxMemoryMB must be greater than 0")}, runInfo
+	}
+
+	inputJSON, err := json.Marshal(input)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "failed to marshal input")}, runInfo
+	}
+
+	output, err := runWASM(ctx, module, inputJSON, uint64(fuel), int64(maxMemoryMB)<<20)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "wasm")}, runInfo
+	}
+	lggr.Debugw("WASM task: module returned", "output", string(output), "dotID", t.DotID())
+
+	var decoded interface{}
+	d := json.NewDecoder(bytes.NewReader(output))
+	d.UseNumber()
+	if err = d.Decode(&decoded); err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "module returned invalid JSON: %v", err)}, runInfo
+	}
+	decoded, err = jsonserializable.ReinterpretJSONNumbers(decoded)
+	if err != nil {
+		return Result{Error: multierr.Combine(ErrBadInput, err)}, runInfo
+	}
+	return Result{Value: decoded}, runInfo
+}
@@ -0,0 +1,180 @@
+//go:build cgo
+
+package pipeline
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/bytecodealliance/wasmtime-go/v23"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/pkg/errors"
+)
+
+const (
+	// wasmModuleCacheSize is the number of compiled modules kept for the next runs.
+	wasmModuleCacheSize = 64
+	// wasmEpochInterval is the resolution of the deadlines of the runs.
+	wasmEpochInterval = 10 * time.Millisecond
+)
+
+var (
+	wasmEngineOnce sync.Once
+	wasmEngine     *wasmtime.Engine
+	// wasmModules holds the most recently used compiled modules by the SHA-256 of their bytecode, so that a module is
+	// only compiled once for the runs of a job, while the modules of the runs resolving it from a variable don't
+	// accumulate.
+	wasmModules = func() *lru.Cache {
+		c, err := lru.New(wasmModuleCacheSize)
+		if err != nil {
+			panic(err)
+		}
+		return c
+	}()
+)
+
+func getWASMEngine() *wasmtime.Engine {
+	wasmEngineOnce.Do(func() {
+		cfg := wasmtime.NewConfig()
+		cfg.SetConsumeFuel(true)
+		cfg.SetEpochInterruption(true)
+		cfg.SetWasmThreads(false)
+		cfg.SetWasmRelaxedSIMD(false)
+		cfg.SetCraneliftFlag("enable_nan_canonicalization", "true")
+		wasmEngine = wasmtime.NewEngineWithConfig(cfg)
+		// the epoch of the engine is the clock of the deadlines of the runs, for as long as the process lives
+		go func() {
+			ticker := time.NewTicker(wasmEpochInterval)
+			defer ticker.Stop()
+			for range ticker.C {
+				wasmEngine.IncrementEpoch()
+			}
+		}()
+	})
+	return wasmEngine
+}
+
+func compileWASMModule(bytecode []byte) (*wasmtime.Module, error) {
+	key := sha256.Sum256(bytecode)
+	if m, ok := wasmModules.Get(key); ok {
+		return m.(*wasmtime.Module), nil
+	}
+	m, err := wasmtime.NewModule(getWASMEngine(), bytecode)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to compile module")
+	}
+	wasmModules.Add(key, m)
+	return m, nil
+}
+
+// wasmEpochDeadline returns the number of epochs until the deadline of ctx, if it has one.
+func wasmEpochDeadline(ctx context.Context) uint64 {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return math.MaxUint32
+	}
+	return uint64(max(time.Until(deadline), 0)/wasmEpochInterval) + 1
+}
+
+// runWASM runs the module until it returns or ctx is done. The module is interrupted at the deadline of ctx, while
+// on cancellation it is left to run out of fuel in the background.
+func runWASM(ctx context.Context, bytecode []byte, input []byte, fuel uint64, maxMemory int64) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	type result struct {
+		output []byte
+		err    error
+	}
+	done := make(chan result, 1)
+	go func() {
+		output, err := execWASM(bytecode, input, fuel, maxMemory, wasmEpochDeadline(ctx))
+		done <- result{output, err}
+	}()
+	select {
+	case r := <-done:
+		return r.output, r.err
+	case <-ctx.Done():
+		return nil, errors.Wrap(context.Cause(ctx), "run interrupted")
+	}
+}
+
+// execWASM instantiates the module in a fresh store, writes the input to its memory and calls run.
+func execWASM(bytecode []byte, input []byte, fuel uint64, maxMemory int64, epochDeadline uint64) ([]byte, error) {
+	module, err := compileWASMModule(bytecode)
+	if err != nil {
+		return nil, err
+	}
+
+	store := wasmtime.NewStore(getWASMEngine())
+	defer store.Close()
+	if err = store.SetFuel(fuel); err != nil {
+		return nil, errors.Wrap(err, "failed to set fuel")
+	}
+	store.SetEpochDeadline(epochDeadline)
+	store.Limiter(maxMemory, -1, 1, 1, 1)
+
+	instance, err := wasmtime.NewInstance(store, module, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to instantiate module")
+	}
+	memExport := instance.GetExport(store, "memory")
+	if memExport == nil || memExport.Memory() == nil {
+		return nil, errors.New("module does not export memory")
+	}
+	memory := memExport.Memory()
+	allocate := instance.GetFunc(store, "allocate")
+	if allocate == nil {
+		return nil, errors.New("module does not export allocate")
+	}
+	run := instance.GetFunc(store, "run")
+	if run == nil {
+		return nil, errors.New("module does not export run")
+	}
+
+	ret, err := allocate.Call(store, int32(len(input)))
+	if err != nil {
+		return nil, errors.Wrap(wasmCallError(err), "allocate")
+	}
+	ptr, ok := ret.(int32)
+	if !ok {
+		return nil, fmt.Errorf("allocate returned %T instead of i32", ret)
+	}
+	data := memory.UnsafeData(store)
+	if ptr < 0 || int64(ptr)+int64(len(input)) > int64(len(data)) {
+		return nil, fmt.Errorf("allocate returned out of bounds pointer %d", ptr)
+	}
+	copy(data[ptr:], input)
+
+	ret, err = run.Call(store, ptr, int32(len(input)))
+	if err != nil {
+		return nil, errors.Wrap(wasmCallError(err), "run")
+	}
+	packed, ok := ret.(int64)
+	if !ok {
+		return nil, fmt.Errorf("run returned %T instead of i64", ret)
+	}
+	outPtr, outLen := uint64(packed)>>32, uint64(packed)&0xffffffff
+	// run may have grown the memory
+	data = memory.UnsafeData(store)
+	if outPtr+outLen > uint64(len(data)) {
+		return nil, fmt.Errorf("run returned out of bounds result [%d, %d)", outPtr, outPtr+outLen)
+	}
+	output := make([]byte, outLen)
+	copy(output, data[outPtr:outPtr+outLen])
+	return output, nil
+}
+
+// wasmCallError returns context.DeadlineExceeded for the calls interrupted at their epoch deadline, which may be a
+// little before the deadline of their context.
+func wasmCallError(err error) error {
+	var trap *wasmtime.Trap
+	if errors.As(err, &trap) && trap.Code() != nil && *trap.Code() == wasmtime.Interrupt {
+		return context.DeadlineExceeded
+	}
+	return err
+}
@@ -0,0 +1,14 @@
+//go:build !cgo
+
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// runWASM fails, as wasmtime, which runs the modules, requires cgo.
+func runWASM(ctx context.Context, bytecode []byte, input []byte, fuel uint64, maxMemory int64) ([]byte, error) {
+	return nil, errors.New("wasm task not supported in this build")
+}
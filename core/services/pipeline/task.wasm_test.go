@@ -0,0 +1,149 @@
+//go:build cgo
+
+package pipeline_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bytecodealliance/wasmtime-go/v23"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+// wasmEcho returns its input unchanged.
+const wasmEcho = `(module
+  (memory (export "memory") 1)
+  (func (export "allocate") (param i32) (result i32) i32.const 1024)
+  (func (export "run") (param $ptr i32) (param $len i32) (result i64)
+    (i64.or
+      (i64.shl (i64.extend_i32_u (local.get $ptr)) (i64.const 32))
+      (i64.extend_i32_u (local.get $len)))))`
+
+// wasmConstant ignores its input and returns {"answer": 42}.
+const wasmConstant = `(module
+  (memory (export "memory") 1)
+  (data (i32.const 0) "{\"answer\": 42}")
+  (func (export "allocate") (param i32) (result i32) i32.const 1024)
+  (func (export "run") (param i32 i32) (result i64) i64.const 14))`
+
+// wasmLoop never returns.
+const wasmLoop = `(module
+  (memory (export "memory") 1)
+  (func (export "allocate") (param i32) (result i32) i32.const 1024)
+  (func (export "run") (param i32 i32) (result i64) (loop $l (br $l)) i64.const 0))`
+
+// wasmImport needs the host to provide a clock.
+const wasmImport = `(module
+  (import "env" "now" (func $now (result i64)))
+  (memory (export "memory") 1)
+  (func (export "allocate") (param i32) (result i32) i32.const 1024)
+  (func (export "run") (param i32 i32) (result i64) call $now))`
+
+// wasmLargeMemory starts with 32MB of memory.
+const wasmLargeMemory = `(module
+  (memory (export "memory") 512)
+  (func (export "allocate") (param i32) (result i32) i32.const 1024)
+  (func (export "run") (param i32 i32) (result i64) i64.const 0))`
+
+func wat2hex(t *testing.T, wat string) string {
+	b, err := wasmtime.Wat2Wasm(wat)
+	require.NoError(t, err)
+	return hexutil.Encode(b)
+}
+
+func TestWASMTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		module      string
+		input       string
+		fuel        string
+		maxMemoryMB string
+		vars        pipeline.Vars
+		inputs      []pipeline.Result
+		want        interface{}
+		wantErr     string
+	}{
+		{"echo input", wasmEcho, `{"prices": [$(a), $(b)]}`, "", "", pipeline.NewVarsFrom(map[string]interface{}{"a": 1.5, "b": "foo"}), nil,
+			map[string]interface{}{"prices": []interface{}{1.5, "foo"}}, ""},
+		{"echo task inputs", wasmEcho, "", "", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: "foo"}, {Value: true}},
+			[]interface{}{"foo", true}, ""},
+		{"echo decimal", wasmEcho, "$(a)", "", "", pipeline.NewVarsFrom(map[string]interface{}{"a": decimal.RequireFromString("1.000000000000000001")}), nil,
+			"1.000000000000000001", ""},
+		{"constant", wasmConstant, "null", "", "", pipeline.NewVarsFrom(nil), nil,
+			map[string]interface{}{"answer": int64(42)}, ""},
+		{"out of fuel", wasmLoop, "null", "1000", "", pipeline.NewVarsFrom(nil), nil, nil, "all fuel consumed"},
+		{"imports are not provided", wasmImport, "null", "", "", pipeline.NewVarsFrom(nil), nil, nil, "failed to instantiate module"},
+		{"memory limit", wasmLargeMemory, "null", "", "", pipeline.NewVarsFrom(nil), nil, nil, "failed to instantiate module"},
+		{"memory limit raised", wasmLargeMemory, "null", "", "64", pipeline.NewVarsFrom(nil), nil, nil, "module returned invalid JSON"},
+		{"zero fuel", wasmEcho, "null", "0", "", pipeline.NewVarsFrom(nil), nil, nil, "fuel must be greater than 0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := pipeline.WASMTask{
+				BaseTask:    pipeline.NewBaseTask(0, "wasm", nil, nil, 0),
+				Module:      wat2hex(t, tt.module),
+				Input:       tt.input,
+				Fuel:        tt.fuel,
+				MaxMemoryMB: tt.maxMemoryMB,
+			}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), tt.vars, tt.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, result.Error, tt.wantErr)
+				return
+			}
+			require.NoError(t, result.Error)
+			assert.Equal(t, tt.want, result.Value)
+		})
+	}
+
+	t.Run("deadline", func(t *testing.T) {
+		task := pipeline.WASMTask{
+			BaseTask: pipeline.NewBaseTask(0, "wasm", nil, nil, 0),
+			Module:   wat2hex(t, wasmLoop),
+			Input:    "null",
+			Fuel:     "1000000000000000",
+		}
+		ctx, cancel := context.WithTimeout(testutils.Context(t), 100*time.Millisecond)
+		defer cancel()
+		start := time.Now()
+		result, _ := task.Run(ctx, logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.ErrorIs(t, result.Error, context.DeadlineExceeded)
+		assert.Less(t, time.Since(start), 5*time.Second)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		task := pipeline.WASMTask{
+			BaseTask: pipeline.NewBaseTask(0, "wasm", nil, nil, 0),
+			Module:   wat2hex(t, wasmLoop),
+			Input:    "null",
+			Fuel:     "100000000",
+		}
+		ctx, cancel := context.WithCancel(testutils.Context(t))
+		time.AfterFunc(50*time.Millisecond, cancel)
+		result, _ := task.Run(ctx, logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.ErrorIs(t, result.Error, context.Canceled)
+	})
+
+	t.Run("invalid module", func(t *testing.T) {
+		task := pipeline.WASMTask{
+			BaseTask: pipeline.NewBaseTask(0, "wasm", nil, nil, 0),
+			Module:   "0x0061736d",
+			Input:    "null",
+		}
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.ErrorContains(t, result.Error, "failed to compile module")
+	})
+}
@@ -0,0 +1,301 @@
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math/big"
+	"net"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	clhttp "github.com/smartcontractkit/chainlink/v2/core/utils/http"
+)
+
+const (
+	websocketDefaultWindow = time.Second
+	websocketMaxWindow     = time.Minute
+	// websocketMaxSamples bounds the memory used by a task subscribed to a busy feed, unless the task sets maxSamples
+	websocketMaxSamples = 10_000
+
+	websocketAggregationMedian = "median"
+	websocketAggregationTWAP   = "twap"
+)
+
+// WebSocketTask subscribes to a websocket feed, like the trade stream of an exchange, for a bounded window and returns
+// an aggregate of the prices it received. After connecting, the task sends the subscribe message if one is set, and
+// then reads the price at path from every message until the window ends or maxSamples prices were read. Messages
+// which don't contain path, like subscription confirmations and heartbeats, are ignored.
+//
+// The aggregation is either the median of the prices, or their time weighted average (twap), where each price is
+// weighted by the time it was the latest one, from the moment it was received until the next price or the end of the
+// window.
+//
+// e.g. [type=websocket url="wss://stream.binance.com:9443/ws/ethusdt@trade" path="p" window="2s" aggregation=twap]
+//
+// Return types:
+//
+//	decimal.Decimal
+type WebSocketTask struct {
+	BaseTask                       `mapstructure:",squash"`
+	URL                            string
+	Subscribe                      string `json:"subscribe"`
+	Path                           string `json:"path"`
+	Separator                      string `json:"separator"`
+	Window                         string `json:"window"`
+	Aggregation                    string `json:"aggregation"`
+	MinSamples                     string `json:"minSamples"`
+	MaxSamples                     string `json:"maxSamples"`
+	AllowUnrestrictedNetworkAccess string
+
+	config                 Config
+	httpClient             *http.Client
+	unrestrictedHTTPClient *http.Client
+}
+
+var _ Task = (*WebSocketTask)(nil)
+
+func (t *WebSocketTask) Type() TaskType {
+	return TaskTypeWebSocket
+}
+
+type websocketSample struct {
+	price    decimal.Decimal
+	received time.Time
+}
+
+func (t *WebSocketTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var sep StringParam
+	err = errors.Wrap(ResolveParam(&sep, From(t.Separator)), "separator")
+	var (
+		url                            URLParam
+		subscribe                      MapParam
+		path                           = NewJSONPathParam(string(sep))
+		window                         StringParam
+		aggregation                    StringParam
+		minSamples                     Uint64Param
+		maxSamples                     Uint64Param
+		allowUnrestrictedNetworkAccess BoolParam
+	)
+	err = multierr.Combine(err,
+		errors.Wrap(ResolveParam(&url, From(VarExpr(t.URL, vars), NonemptyString(t.URL))), "url"),
+		errors.Wrap(ResolveParam(&subscribe, From(VarExpr(t.Subscribe, vars), JSONWithVarExprs(t.Subscribe, vars, false), nil)), "subscribe"),
+		errors.Wrap(ResolveParam(&path, From(VarExpr(t.Path, vars), NonemptyString(t.Path))), "path"),
+		errors.Wrap(ResolveParam(&window, From(NonemptyString(t.Window), websocketDefaultWindow.String())), "window"),
+		errors.Wrap(ResolveParam(&aggregation, From(NonemptyString(t.Aggregation), websocketAggregationMedian)), "aggregation"),
+		errors.Wrap(ResolveParam(&minSamples, From(NonemptyString(t.MinSamples), 1)), "minSamples"),
+		errors.Wrap(ResolveParam(&maxSamples, From(NonemptyString(t.MaxSamples), websocketMaxSamples)), "maxSamples"),
+		// Like the http task, only variable-interpolated URLs are restricted by default
+		errors.Wrap(ResolveParam(&allowUnrestrictedNetworkAccess, From(NonemptyString(t.AllowUnrestrictedNetworkAccess), !variableRegexp.MatchString(t.URL))), "allowUnrestrictedNetworkAccess"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	if url.Scheme != "ws" && url.Scheme != "wss" {
+		return Result{Error: errors.Wrapf(ErrBadInput, "url scheme must be ws or wss, got %q", url.Scheme)}, runInfo
+	}
+	windowDuration, err := time.ParseDuration(string(window))
+	if err != nil {
+		return Result{Error: multierr.Combine(ErrBadInput, errors.Wrap(err, "window"))}, runInfo
+	}
+	if windowDuration <= 0 || windowDuration > websocketMaxWindow {
+		return Result{Error: errors.Wrapf(ErrBadInput, "window must be positive and at most %s", websocketMaxWindow)}, runInfo
+	}
+	if aggregation != websocketAggregationMedian && aggregation != websocketAggregationTWAP {
+		return Result{Error: errors.Wrapf(ErrBadInput, "aggregation must be %s or %s, got %q", websocketAggregationMedian, websocketAggregationTWAP, aggregation)}, runInfo
+	}
+	if minSamples == 0 || maxSamples < minSamples || maxSamples > websocketMaxSamples {
+		return Result{Error: errors.Wrapf(ErrBadInput, "minSamples must be positive, and at most maxSamples which must be at most %d", websocketMaxSamples)}, runInfo
+	}
+
+	client := t.httpClient
+	if allowUnrestrictedNetworkAccess {
+		client = t.unrestrictedHTTPClient
+	}
+	dialer := websocket.Dialer{
+		NetDialContext:   dialContextOf(client),
+		HandshakeTimeout: windowDuration,
+	}
+	lggr.Debugw("WebSocket task: subscribing",
+		"url", url.String(),
+		"window", windowDuration,
+		"allowUnrestrictedNetworkAccess", allowUnrestrictedNetworkAccess,
+	)
+	conn, resp, err := dialer.DialContext(ctx, url.String(), nil)
+	if resp != nil && resp.Body != nil {
+		resp.Body.Close()
+	}
+	if err != nil {
+		if errors.Is(err, clhttp.ErrDisallowedIP) {
+			err = errors.Wrap(err, `connections to local resources are disabled by default, if you are sure this is safe, you can enable on a per-task basis by setting allowUnrestrictedNetworkAccess="true" in the pipeline task spec`)
+		}
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		return Result{Error: errors.Wrap(err, "failed to connect")}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err)}
+	}
+	defer conn.Close()
+	conn.SetReadLimit(t.config.DefaultHTTPLimit())
+
+	if subscribe != nil {
+		if err = conn.WriteJSON(subscribe); err != nil {
+			return Result{Error: errors.Wrap(err, "failed to subscribe")}, RunInfo{IsRetryable: true}
+		}
+	}
+
+	samples, end, err := t.collect(ctx, conn, path, windowDuration, int(maxSamples))
+	if err != nil {
+		return Result{Error: err}, RunInfo{IsRetryable: true}
+	}
+	_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(time.Second))
+
+	lggr.Debugw("WebSocket task: window ended",
+		"url", url.String(),
+		"samples", len(samples),
+		"dotID", t.DotID(),
+	)
+	if uint64(len(samples)) < uint64(minSamples) {
+		return Result{Error: errors.Wrapf(ErrTooManyErrors, "received %d prices in the window, fewer than minSamples %d", len(samples), minSamples)}, RunInfo{IsRetryable: true}
+	}
+
+	if aggregation == websocketAggregationTWAP {
+		return Result{Value: twap(samples, end)}, runInfo
+	}
+	return Result{Value: medianOfSamples(samples)}, runInfo
+}
+
+// collect reads prices from conn until the window ends, maxSamples prices were read or ctx is done, and returns them
+// with the time the collection ended.
+func (t *WebSocketTask) collect(ctx context.Context, conn *websocket.Conn, path JSONPathParam, window time.Duration, maxSamples int) ([]websocketSample, time.Time, error) {
+	deadline := time.Now().Add(window)
+	if err := conn.SetReadDeadline(deadline); err != nil {
+		return nil, time.Time{}, err
+	}
+	// Unblock the read if the task is cancelled before the window ends
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	var samples []websocketSample
+	for len(samples) < maxSamples {
+		_, msg, err := conn.ReadMessage()
+		if ctx.Err() != nil {
+			return nil, time.Time{}, ctx.Err()
+		}
+		var netErr net.Error
+		if errors.As(err, &netErr) && netErr.Timeout() {
+			return samples, deadline, nil
+		} else if err != nil {
+			return nil, time.Time{}, errors.Wrap(err, "failed to read message")
+		}
+		received := time.Now()
+
+		price, found, err := priceAtPath(msg, path)
+		if err != nil {
+			return nil, time.Time{}, err
+		} else if found {
+			samples = append(samples, websocketSample{price, received})
+		}
+	}
+	return samples, time.Now(), nil
+}
+
+// priceAtPath returns the price at path in msg, or false if msg doesn't contain path.
+func priceAtPath(msg []byte, path JSONPathParam) (decimal.Decimal, bool, error) {
+	var decoded interface{}
+	d := json.NewDecoder(bytes.NewReader(msg))
+	d.UseNumber()
+	if err := d.Decode(&decoded); err != nil {
+		// Feeds may send non-JSON heartbeats
+		return decimal.Decimal{}, false, nil
+	}
+
+	for _, part := range path {
+		switch v := decoded.(type) {
+		case map[string]interface{}:
+			var exists bool
+			if decoded, exists = v[part]; !exists {
+				return decimal.Decimal{}, false, nil
+			}
+		case []interface{}:
+			index, ok := big.NewInt(0).SetString(part, 10)
+			if !ok || !index.IsInt64() || index.Int64() < 0 || index.Int64() >= int64(len(v)) {
+				return decimal.Decimal{}, false, nil
+			}
+			decoded = v[index.Int64()]
+		default:
+			return decimal.Decimal{}, false, nil
+		}
+	}
+
+	if n, ok := decoded.(json.Number); ok {
+		decoded = n.String()
+	}
+	var price DecimalParam
+	if err := price.UnmarshalPipelineParam(decoded); err != nil {
+		return decimal.Decimal{}, false, errors.Wrapf(err, "invalid price at path %v in %s", path, msg)
+	}
+	return price.Decimal(), true, nil
+}
+
+func medianOfSamples(samples []websocketSample) decimal.Decimal {
+	prices := make([]decimal.Decimal, len(samples))
+	for i, s := range samples {
+		prices[i] = s.price
+	}
+	sort.Slice(prices, func(i, j int) bool {
+		return prices[i].LessThan(prices[j])
+	})
+	k := len(prices) / 2
+	if len(prices)%2 == 1 {
+		return prices[k]
+	}
+	return prices[k].Add(prices[k-1]).Div(decimal.NewFromInt(2))
+}
+
+// twap weights every price by the time until the next one, or until end for the last one. If all the prices were
+// received at the same time, it falls back to their mean.
+func twap(samples []websocketSample, end time.Time) decimal.Decimal {
+	var sum, total decimal.Decimal
+	for i, s := range samples {
+		until := end
+		if i+1 < len(samples) {
+			until = samples[i+1].received
+		}
+		weight := decimal.NewFromInt(until.Sub(s.received).Nanoseconds())
+		sum = sum.Add(s.price.Mul(weight))
+		total = total.Add(weight)
+	}
+	if total.IsPositive() {
+		return sum.Div(total)
+	}
+	sum = decimal.Zero
+	for _, s := range samples {
+		sum = sum.Add(s.price)
+	}
+	return sum.Div(decimal.NewFromInt(int64(len(samples))))
+}
+
+// dialContextOf returns the function client opens connections with, so that websocket connections are subject to the
+// same network restrictions as HTTP requests.
+func dialContextOf(client *http.Client) func(ctx context.Context, network, addr string) (net.Conn, error) {
+	if client != nil {
+		if tr, ok := client.Transport.(*http.Transport); ok && tr.DialContext != nil {
+			return tr.DialContext
+		}
+	}
+	return (&net.Dialer{}).DialContext
+}
@@ -0,0 +1,182 @@
+package pipeline_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	clhttptest "github.com/smartcontractkit/chainlink/v2/core/internal/testutils/httptest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	clhttp "github.com/smartcontractkit/chainlink/v2/core/utils/http"
+)
+
+// newTradeStream serves a websocket feed which sends messages once it received the subscribe message, and then keeps
+// the connection open.
+func newTradeStream(t *testing.T, subscribe string, messages ...string) string {
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		if subscribe != "" {
+			_, msg, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			assert.JSONEq(t, subscribe, string(msg))
+		}
+		for _, msg := range messages {
+			if err := conn.WriteMessage(websocket.TextMessage, []byte(msg)); err != nil {
+				return
+			}
+		}
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	t.Cleanup(server.Close)
+	return "ws" + strings.TrimPrefix(server.URL, "http")
+}
+
+func TestWebSocketTask(t *testing.T) {
+	t.Parallel()
+
+	config := configtest.NewTestGeneralConfig(t)
+	subscribe := `{"method": "SUBSCRIBE", "params": ["ethusdt@trade"], "id": 1}`
+	url := newTradeStream(t, subscribe,
+		`{"result": null, "id": 1}`,
+		`{"e": "trade", "p": "100.5"}`,
+		`heartbeat`,
+		`{"e": "trade", "p": 98}`,
+		`{"e": "trade", "p": "101"}`,
+		`{"e": "trade", "p": "99.5"}`,
+	)
+
+	tests := []struct {
+		name     string
+		task     pipeline.WebSocketTask
+		expected decimal.Decimal
+		errorMsg string
+	}{
+		{"median", pipeline.WebSocketTask{Subscribe: subscribe, Path: "p", Window: "200ms"}, decimal.RequireFromString("100"), ""},
+		{"maxSamples ends the window early", pipeline.WebSocketTask{Subscribe: subscribe, Path: "p", Window: "1m", MaxSamples: "3"}, decimal.RequireFromString("100.5"), ""},
+		{"too few samples", pipeline.WebSocketTask{Subscribe: subscribe, Path: "p", Window: "200ms", MinSamples: "5"}, decimal.Decimal{}, "received 4 prices in the window, fewer than minSamples 5"},
+		{"no prices at path", pipeline.WebSocketTask{Subscribe: subscribe, Path: "q", Window: "200ms"}, decimal.Decimal{}, "received 0 prices in the window"},
+		{"invalid price", pipeline.WebSocketTask{Subscribe: subscribe, Path: "e", Window: "200ms"}, decimal.Decimal{}, "invalid price at path"},
+		{"invalid aggregation", pipeline.WebSocketTask{Path: "p", Aggregation: "mean"}, decimal.Decimal{}, `aggregation must be median or twap, got "mean"`},
+		{"window too long", pipeline.WebSocketTask{Path: "p", Window: "1h"}, decimal.Decimal{}, "window must be positive and at most 1m0s"},
+		{"missing path", pipeline.WebSocketTask{}, decimal.Decimal{}, "path"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := test.task
+			task.BaseTask = pipeline.NewBaseTask(0, "ws", nil, nil, 0)
+			task.URL = url
+			c := clhttptest.NewTestLocalOnlyHTTPClient()
+			task.HelperSetDependencies(config.JobPipeline(), c, c)
+
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+			assert.False(t, runInfo.IsPending)
+			if test.errorMsg != "" {
+				require.ErrorContains(t, result.Error, test.errorMsg)
+				return
+			}
+			require.NoError(t, result.Error)
+			assert.Equal(t, test.expected.String(), result.Value.(decimal.Decimal).String())
+		})
+	}
+
+	t.Run("rejects non websocket urls", func(t *testing.T) {
+		task := pipeline.WebSocketTask{
+			BaseTask: pipeline.NewBaseTask(0, "ws", nil, nil, 0),
+			URL:      "https://chain.link",
+			Path:     "p",
+		}
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.ErrorIs(t, result.Error, pipeline.ErrBadInput)
+		require.ErrorContains(t, result.Error, `url scheme must be ws or wss, got "https"`)
+	})
+}
+
+func TestWebSocketTask_TWAP(t *testing.T) {
+	t.Parallel()
+
+	config := configtest.NewTestGeneralConfig(t)
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		// 100 is the latest price for most of the window, so the twap is much closer to it than the median of 150
+		for _, msg := range []string{`{"p": 100}`, `{"p": 200}`} {
+			if err := conn.WriteMessage(websocket.TextMessage, []byte(msg)); err != nil {
+				return
+			}
+			time.Sleep(400 * time.Millisecond)
+		}
+	}))
+	defer server.Close()
+
+	task := pipeline.WebSocketTask{
+		BaseTask:    pipeline.NewBaseTask(0, "ws", nil, nil, 0),
+		URL:         "ws" + strings.TrimPrefix(server.URL, "http"),
+		Path:        "p",
+		Window:      "500ms",
+		Aggregation: "twap",
+	}
+	c := clhttptest.NewTestLocalOnlyHTTPClient()
+	task.HelperSetDependencies(config.JobPipeline(), c, c)
+
+	result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.NoError(t, result.Error)
+	twap := result.Value.(decimal.Decimal)
+	assert.True(t, twap.GreaterThan(decimal.NewFromInt(100)), twap)
+	assert.True(t, twap.LessThan(decimal.NewFromInt(150)), twap)
+}
+
+func TestWebSocketTask_RestrictedURL(t *testing.T) {
+	t.Parallel()
+
+	config := configtest.NewTestGeneralConfig(t)
+	url := newTradeStream(t, "", `{"p": "42"}`)
+
+	task := pipeline.WebSocketTask{
+		BaseTask: pipeline.NewBaseTask(0, "ws", nil, nil, 0),
+		URL:      "$(url)",
+		Path:     "p",
+		Window:   "200ms",
+	}
+	// Use real clients here to actually test the local connection blocking
+	r := clhttp.NewRestrictedHTTPClient(config.Database(), logger.TestLogger(t))
+	u := clhttp.NewUnrestrictedHTTPClient()
+	task.HelperSetDependencies(config.JobPipeline(), r, u)
+
+	vars := pipeline.NewVarsFrom(map[string]interface{}{"url": url})
+	result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+	require.ErrorContains(t, result.Error, "Connections to local/private and multicast networks are disabled")
+
+	task.AllowUnrestrictedNetworkAccess = "true"
+	result, _ = task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+	require.NoError(t, result.Error)
+	assert.Equal(t, "42", result.Value.(decimal.Decimal).String())
+}
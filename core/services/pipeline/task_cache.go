@@ -0,0 +1,198 @@
+package pipeline
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// taskResultCacheFlushInterval is how often the results cached in memory are persisted.
+const taskResultCacheFlushInterval = 5 * time.Second
+
+// CachedTaskResult is the result of a task persisted by the task result cache.
+type CachedTaskResult struct {
+	Key       []byte                            `db:"key"`
+	Value     jsonserializable.JSONSerializable `db:"value"`
+	ExpiresAt time.Time                         `db:"expires_at"`
+}
+
+// taskResultCache caches the successful results of the tasks which set resultCacheTTL, so that identical tasks of
+// frequent runs reuse them. Results are kept in memory, and persisted in the background so that they survive restarts
+// and are shared by the nodes of the same database. Results read back from the database are JSON values, i.e. bytes
+// become hex strings and numbers become decimals.
+type taskResultCache struct {
+	orm  ORM
+	lggr logger.Logger
+
+	mu      sync.RWMutex
+	entries map[string]CachedTaskResult
+	dirty   map[string]struct{}
+}
+
+func newTaskResultCache(orm ORM, lggr logger.Logger) *taskResultCache {
+	return &taskResultCache{
+		orm:     orm,
+		lggr:    lggr.Named("TaskResultCache"),
+		entries: make(map[string]CachedTaskResult),
+		dirty:   make(map[string]struct{}),
+	}
+}
+
+// get returns the cached value for key, if it has not expired.
+func (c *taskResultCache) get(ctx context.Context, key []byte) (interface{}, bool) {
+	now := time.Now()
+	c.mu.RLock()
+	entry, ok := c.entries[string(key)]
+	c.mu.RUnlock()
+	if ok && entry.ExpiresAt.After(now) {
+		return entry.Value.Val, true
+	}
+
+	entry, err := c.orm.FindCachedTaskResult(ctx, key)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			c.lggr.Warnw("Failed to load cached task result", "err", err)
+		}
+		return nil, false
+	}
+	c.mu.Lock()
+	if cur, ok := c.entries[string(key)]; !ok || cur.ExpiresAt.Before(entry.ExpiresAt) {
+		c.entries[string(key)] = entry
+	}
+	c.mu.Unlock()
+	return entry.Value.Val, true
+}
+
+// set caches value for key until ttl elapses.
+func (c *taskResultCache) set(key []byte, value interface{}, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[string(key)] = CachedTaskResult{
+		Key:       key,
+		Value:     jsonserializable.JSONSerializable{Val: value, Valid: true},
+		ExpiresAt: time.Now().Add(ttl),
+	}
+	c.dirty[string(key)] = struct{}{}
+}
+
+// flush persists the results cached since the last flush, and evicts the expired ones.
+func (c *taskResultCache) flush(ctx context.Context) {
+	now := time.Now()
+	var results []CachedTaskResult
+	c.mu.Lock()
+	for key := range c.dirty {
+		if entry := c.entries[key]; entry.ExpiresAt.After(now) {
+			results = append(results, entry)
+		}
+	}
+	c.dirty = make(map[string]struct{})
+	for key, entry := range c.entries {
+		if !entry.ExpiresAt.After(now) {
+			delete(c.entries, key)
+		}
+	}
+	c.mu.Unlock()
+
+	if len(results) == 0 {
+		return
+	}
+	// skip the values which can not be persisted, rather than failing the whole batch
+	persisted := results[:0]
+	for _, result := range results {
+		if _, err := json.Marshal(result.Value); err != nil {
+			c.lggr.Debugw("Task result can not be persisted", "err", err)
+			continue
+		}
+		persisted = append(persisted, result)
+	}
+	if len(persisted) == 0 {
+		return
+	}
+	if err := c.orm.UpsertCachedTaskResults(ctx, persisted); err != nil {
+		c.lggr.Warnw("Failed to persist cached task results", "err", err)
+	}
+	if err := c.orm.DeleteExpiredCachedTaskResults(ctx); err != nil {
+		c.lggr.Warnw("Failed to delete expired cached task results", "err", err)
+	}
+}
+
+func (c *taskResultCache) flushLoop(stop services.StopChan, wg *sync.WaitGroup) {
+	defer wg.Done()
+	ctx, cancel := stop.NewCtx()
+	defer cancel()
+
+	ticker := services.NewTicker(taskResultCacheFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			c.flush(ctx)
+		}
+	}
+}
+
+// taskImplicitVars are the variables read by tasks without being referenced by their config.
+var taskImplicitVars = map[TaskType][]string{
+	TaskTypeBridge: {"jobRun.meta"},
+}
+
+// taskResultCacheKey identifies a task result by the type and config of the task, the values of the variables it
+// references explicitly or implicitly, and its inputs.
+func taskResultCacheKey(task Task, vars Vars, inputs []Result) ([]byte, error) {
+	config, err := json.Marshal(task)
+	if err != nil {
+		return nil, err
+	}
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\x00%s\x00", task.Type(), config)
+	for _, expr := range variableRegexp.FindAll(config, -1) {
+		val, err := vars.Get(strings.TrimSpace(string(expr[2 : len(expr)-1])))
+		if err != nil {
+			return nil, err
+		}
+		if err = writeCacheKeyValue(h, val); err != nil {
+			return nil, err
+		}
+	}
+	for _, keypath := range taskImplicitVars[task.Type()] {
+		// implicit variables are optional, a missing one is keyed as null
+		val, _ := vars.Get(keypath)
+		if err = writeCacheKeyValue(h, val); err != nil {
+			return nil, err
+		}
+	}
+	for _, input := range inputs {
+		if input.Error != nil {
+			fmt.Fprintf(h, "error:%s\x00", input.Error)
+			continue
+		}
+		if err = writeCacheKeyValue(h, input.Value); err != nil {
+			return nil, err
+		}
+	}
+	return h.Sum(nil), nil
+}
+
+func writeCacheKeyValue(h hash.Hash, val interface{}) error {
+	b, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	_, err = h.Write(append(b, 0))
+	return err
+}
@@ -0,0 +1,28 @@
+package pipeline
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_taskResultCacheKey(t *testing.T) {
+	t.Parallel()
+
+	task := &BridgeTask{Name: "price", RequestData: `{"symbol": $(symbol)}`}
+	key := func(vars map[string]interface{}) []byte {
+		k, err := taskResultCacheKey(task, NewVarsFrom(vars), nil)
+		require.NoError(t, err)
+		return k
+	}
+
+	eth := key(map[string]interface{}{"symbol": "ETH"})
+	assert.Equal(t, eth, key(map[string]interface{}{"symbol": "ETH"}))
+	assert.NotEqual(t, eth, key(map[string]interface{}{"symbol": "BTC"}))
+
+	// the bridge sends jobRun.meta along with the request without it being referenced by the task config
+	withMeta := key(map[string]interface{}{"symbol": "ETH", "jobRun": map[string]interface{}{"meta": map[string]interface{}{"round": 1}}})
+	assert.NotEqual(t, eth, withMeta)
+	assert.NotEqual(t, withMeta, key(map[string]interface{}{"symbol": "ETH", "jobRun": map[string]interface{}{"meta": map[string]interface{}{"round": 2}}}))
+}
@@ -0,0 +1,141 @@
+package relay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+)
+
+// ErrAtomicBatchNotSupported is returned when a batch requires atomicity, but the relay cannot pack its writes into a
+// single transaction.
+var ErrAtomicBatchNotSupported = errors.New("atomic batch not supported")
+
+// Atomicity hints how the writes of a batch are submitted.
+type Atomicity int
+
+const (
+	// AtomicityNone submits each write in its own transaction.
+	AtomicityNone Atomicity = iota
+	// AtomicityPreferred packs the writes into a single transaction if the relay can, otherwise it submits them as a
+	// set of transactions.
+	AtomicityPreferred
+	// AtomicityRequired packs the writes into a single transaction, or fails with ErrAtomicBatchNotSupported.
+	AtomicityRequired
+)
+
+func (a Atomicity) String() string {
+	switch a {
+	case AtomicityNone:
+		return "none"
+	case AtomicityPreferred:
+		return "preferred"
+	case AtomicityRequired:
+		return "required"
+	default:
+		return fmt.Sprintf("Atomicity(%d)", int(a))
+	}
+}
+
+// Write is a logical write of a batch, with the arguments of ChainWriter.SubmitTransaction.
+type Write struct {
+	Contract      string
+	Method        string
+	Args          any
+	TransactionID string
+	ToAddress     string
+	Meta          *commontypes.TxMeta
+	Value         *big.Int
+}
+
+// Batch is a set of writes submitted together.
+type Batch struct {
+	// ID is used as the transaction ID when the writes are packed into a single transaction.
+	ID        string
+	Writes    []Write
+	Atomicity Atomicity
+}
+
+// BatchWriter is implemented by the ChainWriters of relays which support batches natively.
+type BatchWriter interface {
+	commontypes.ChainWriter
+
+	// SubmitBatch submits the writes of the batch and returns the ID of the transaction of each write, in order. Writes
+	// packed into a single transaction share the batch ID.
+	SubmitBatch(ctx context.Context, batch Batch) ([]string, error)
+}
+
+// SubmitBatch submits the batch through cw. If cw does not implement BatchWriter, the writes are submitted one by one,
+// which fails for batches requiring atomicity. On failure, the writes before the failing one may have been submitted.
+func SubmitBatch(ctx context.Context, cw commontypes.ChainWriter, batch Batch) ([]string, error) {
+	if bw, ok := cw.(BatchWriter); ok {
+		return bw.SubmitBatch(ctx, batch)
+	}
+	if batch.Atomicity == AtomicityRequired {
+		return nil, ErrAtomicBatchNotSupported
+	}
+	return SubmitWrites(ctx, cw, batch.Writes)
+}
+
+// SubmitWrites submits each write in its own transaction, stopping at the first failure.
+func SubmitWrites(ctx context.Context, cw commontypes.ChainWriter, writes []Write) ([]string, error) {
+	txIDs := make([]string, 0, len(writes))
+	for i, w := range writes {
+		if err := cw.SubmitTransaction(ctx, w.Contract, w.Method, w.Args, w.TransactionID, w.ToAddress, w.Meta, w.Value); err != nil {
+			return txIDs, fmt.Errorf("failed to submit write %d of %d: %w", i+1, len(writes), err)
+		}
+		txIDs = append(txIDs, w.TransactionID)
+	}
+	return txIDs, nil
+}
+
+// WriteStatusFunc is called when the status of the transaction of a write changes.
+type WriteStatusFunc func(index int, write Write, status commontypes.TransactionStatus)
+
+// TrackBatch polls the status of the transactions of a submitted batch every pollPeriod, and calls onStatus for each
+// write whose status changed, until all of them are finalized, failed or fatal, or ctx is done.
+func TrackBatch(ctx context.Context, cw commontypes.ChainWriter, batch Batch, txIDs []string, pollPeriod time.Duration, onStatus WriteStatusFunc) error {
+	if len(txIDs) != len(batch.Writes) {
+		return fmt.Errorf("got %d transaction IDs for %d writes", len(txIDs), len(batch.Writes))
+	}
+	statuses := make([]commontypes.TransactionStatus, len(txIDs))
+
+	ticker := time.NewTicker(pollPeriod)
+	defer ticker.Stop()
+	for {
+		// Writes packed into a single transaction share its status.
+		polled := make(map[string]commontypes.TransactionStatus)
+		done := true
+		for i, txID := range txIDs {
+			status, ok := polled[txID]
+			if !ok {
+				var err error
+				if status, err = cw.GetTransactionStatus(ctx, txID); err != nil {
+					return fmt.Errorf("failed to get status of transaction %s: %w", txID, err)
+				}
+				polled[txID] = status
+			}
+			if status != statuses[i] {
+				statuses[i] = status
+				onStatus(i, batch.Writes[i], status)
+			}
+			done = done && isFinalStatus(status)
+		}
+		if done {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func isFinalStatus(status commontypes.TransactionStatus) bool {
+	return status == commontypes.Finalized || status == commontypes.Failed || status == commontypes.Fatal
+}
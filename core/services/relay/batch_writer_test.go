@@ -0,0 +1,131 @@
+package relay_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
+)
+
+type fakeChainWriter struct {
+	services.Service
+	submitted []string
+	failOn    string
+	statuses  map[string][]commontypes.TransactionStatus
+}
+
+func (f *fakeChainWriter) SubmitTransaction(_ context.Context, _, _ string, _ any, transactionID string, _ string, _ *commontypes.TxMeta, _ *big.Int) error {
+	if transactionID == f.failOn {
+		return errors.New("boom")
+	}
+	f.submitted = append(f.submitted, transactionID)
+	return nil
+}
+
+// GetTransactionStatus returns the next status of the transaction, then keeps returning its last status.
+func (f *fakeChainWriter) GetTransactionStatus(_ context.Context, transactionID string) (commontypes.TransactionStatus, error) {
+	statuses := f.statuses[transactionID]
+	if len(statuses) == 0 {
+		return commontypes.Unknown, errors.New("not found")
+	}
+	status := statuses[0]
+	if len(statuses) > 1 {
+		f.statuses[transactionID] = statuses[1:]
+	}
+	return status, nil
+}
+
+func (f *fakeChainWriter) GetFeeComponents(context.Context) (*commontypes.ChainFeeComponents, error) {
+	return nil, errors.New("not implemented")
+}
+
+func newBatch(atomicity relay.Atomicity, txIDs ...string) relay.Batch {
+	batch := relay.Batch{ID: "batch", Atomicity: atomicity}
+	for _, txID := range txIDs {
+		batch.Writes = append(batch.Writes, relay.Write{Contract: "offRamp", Method: "execute", TransactionID: txID})
+	}
+	return batch
+}
+
+func TestSubmitBatch(t *testing.T) {
+	ctx := testutils.Context(t)
+
+	t.Run("submits the writes one by one", func(t *testing.T) {
+		cw := &fakeChainWriter{}
+		txIDs, err := relay.SubmitBatch(ctx, cw, newBatch(relay.AtomicityPreferred, "a", "b"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "b"}, txIDs)
+		assert.Equal(t, []string{"a", "b"}, cw.submitted)
+	})
+
+	t.Run("stops at the first failure", func(t *testing.T) {
+		cw := &fakeChainWriter{failOn: "b"}
+		txIDs, err := relay.SubmitBatch(ctx, cw, newBatch(relay.AtomicityNone, "a", "b", "c"))
+		require.Error(t, err)
+		assert.Equal(t, []string{"a"}, txIDs)
+	})
+
+	t.Run("fails if atomicity is required", func(t *testing.T) {
+		cw := &fakeChainWriter{}
+		_, err := relay.SubmitBatch(ctx, cw, newBatch(relay.AtomicityRequired, "a", "b"))
+		require.ErrorIs(t, err, relay.ErrAtomicBatchNotSupported)
+		assert.Empty(t, cw.submitted)
+	})
+}
+
+func TestTrackBatch(t *testing.T) {
+	ctx := testutils.Context(t)
+
+	type update struct {
+		index  int
+		status commontypes.TransactionStatus
+	}
+
+	t.Run("reports status changes until all writes are final", func(t *testing.T) {
+		cw := &fakeChainWriter{statuses: map[string][]commontypes.TransactionStatus{
+			"a": {commontypes.Pending, commontypes.Unconfirmed, commontypes.Finalized},
+			"b": {commontypes.Pending, commontypes.Pending, commontypes.Pending, commontypes.Failed},
+		}}
+		var updates []update
+		err := relay.TrackBatch(ctx, cw, newBatch(relay.AtomicityNone, "a", "b"), []string{"a", "b"}, time.Millisecond, func(i int, _ relay.Write, status commontypes.TransactionStatus) {
+			updates = append(updates, update{i, status})
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []update{
+			{0, commontypes.Pending}, {1, commontypes.Pending},
+			{0, commontypes.Unconfirmed},
+			{0, commontypes.Finalized},
+			{1, commontypes.Failed},
+		}, updates)
+	})
+
+	t.Run("reports the status of a packed transaction for each write", func(t *testing.T) {
+		cw := &fakeChainWriter{statuses: map[string][]commontypes.TransactionStatus{
+			"batch": {commontypes.Unconfirmed, commontypes.Finalized},
+		}}
+		var updates []update
+		err := relay.TrackBatch(ctx, cw, newBatch(relay.AtomicityRequired, "a", "b"), []string{"batch", "batch"}, time.Millisecond, func(i int, _ relay.Write, status commontypes.TransactionStatus) {
+			updates = append(updates, update{i, status})
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []update{
+			{0, commontypes.Unconfirmed}, {1, commontypes.Unconfirmed},
+			{0, commontypes.Finalized}, {1, commontypes.Finalized},
+		}, updates)
+	})
+
+	t.Run("fails on mismatched transaction IDs", func(t *testing.T) {
+		err := relay.TrackBatch(ctx, &fakeChainWriter{}, newBatch(relay.AtomicityNone, "a", "b"), []string{"a"}, time.Millisecond, nil)
+		require.Error(t, err)
+	})
+}
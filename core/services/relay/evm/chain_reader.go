@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"maps"
 	"slices"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -31,8 +33,20 @@ import (
 type ChainReaderService interface {
 	services.ServiceCtx
 	commontypes.ContractReader
+	// LatestFinalizedHead returns the latest finalized head according to the chain's finality configuration.
+	LatestFinalizedHead(ctx context.Context) (commontypes.Head, error)
+	// Subscribe streams the decoded finalized events of the key of the contract matching the filter, in sequence
+	// order, replaying the events from fromBlock. The stream is closed when ctx is done or the ChainReader closes.
+	Subscribe(ctx context.Context, contract commontypes.BoundContract, filter query.KeyFilter, fromBlock int64, sequenceDataType any) (<-chan commontypes.Sequence, error)
 }
 
+const (
+	// subscriptionPollPeriod is the period at which subscriptions poll the LogPoller for new events.
+	subscriptionPollPeriod = time.Second
+	// subscriptionBatchSize is the maximum number of events a subscription reads from the LogPoller at once.
+	subscriptionBatchSize = 1000
+)
+
 type chainReader struct {
 	commontypes.UnimplementedContractReader
 	lggr     logger.Logger
@@ -43,6 +57,9 @@ type chainReader struct {
 	bindings *read.BindingsRegistry
 	codec    commontypes.RemoteCodec
 	commonservices.StateMachine
+
+	stopCh commonservices.StopChan
+	wg     sync.WaitGroup
 }
 
 var _ ChainReaderService = (*chainReader)(nil)
@@ -55,6 +72,7 @@ func NewChainReaderService(_ context.Context, lggr logger.Logger, lp logpoller.L
 		lggr:     logger.Named(lggr, "ChainReader"),
 		ht:       ht,
 		lp:       lp,
+		stopCh:   make(chan struct{}),
 		client:   client,
 		bindings: read.NewBindingsRegistry(),
 		parsed:   &codec.ParsedTypes{EncoderDefs: map[string]types.CodecEntry{}, DecoderDefs: map[string]types.CodecEntry{}},
@@ -69,14 +87,26 @@ func NewChainReaderService(_ context.Context, lggr logger.Logger, lp logpoller.L
 		return nil, err
 	}
 
-	cr.bindings.SetBatchCaller(read.NewDynamicLimitedBatchCaller(
-		cr.lggr,
-		cr.codec,
-		cr.client,
-		read.DefaultRpcBatchSizeLimit,
-		read.DefaultRpcBatchBackOffMultiplier,
-		read.DefaultMaxParallelRpcCalls,
-	))
+	if config.MulticallAddress != nil {
+		cr.bindings.SetBatchCaller(read.NewMulticallBatchCaller(
+			cr.lggr,
+			cr.codec,
+			cr.client,
+			*config.MulticallAddress,
+			read.DefaultRpcBatchSizeLimit,
+			read.DefaultRpcBatchBackOffMultiplier,
+			read.DefaultMaxParallelRpcCalls,
+		))
+	} else {
+		cr.bindings.SetBatchCaller(read.NewDynamicLimitedBatchCaller(
+			cr.lggr,
+			cr.codec,
+			cr.client,
+			read.DefaultRpcBatchSizeLimit,
+			read.DefaultRpcBatchBackOffMultiplier,
+			read.DefaultMaxParallelRpcCalls,
+		))
+	}
 
 	cr.bindings.SetCodecAll(cr.codec)
 
@@ -150,6 +180,9 @@ func (cr *chainReader) Start(ctx context.Context) error {
 // Close unregisters polling filters for bound contracts.
 func (cr *chainReader) Close() error {
 	return cr.StopOnce("ChainReader", func() error {
+		close(cr.stopCh)
+		cr.wg.Wait()
+
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 		defer cancel()
 		return cr.bindings.UnregisterAll(ctx, cr.lp)
@@ -204,6 +237,21 @@ func (cr *chainReader) BatchGetLatestValues(ctx context.Context, request commont
 	return cr.bindings.BatchGetLatestValues(ctx, request)
 }
 
+// LatestFinalizedHead returns the latest finalized head as tracked by the HeadTracker. Depending on the chain
+// configuration it is derived from the finalized tag, from FinalityDepth or from the tag with a fallback to depth.
+func (cr *chainReader) LatestFinalizedHead(ctx context.Context) (commontypes.Head, error) {
+	_, finalized, err := cr.ht.LatestAndFinalizedBlock(ctx)
+	if err != nil {
+		return commontypes.Head{}, fmt.Errorf("failed to get latest finalized head: %w", err)
+	}
+
+	return commontypes.Head{
+		Height:    strconv.FormatInt(finalized.Number, 10),
+		Hash:      finalized.Hash.Bytes(),
+		Timestamp: uint64(finalized.Timestamp.Unix()),
+	}, nil
+}
+
 func (cr *chainReader) QueryKey(
 	ctx context.Context,
 	contract commontypes.BoundContract,
@@ -247,6 +295,93 @@ func (cr *chainReader) QueryKey(
 	return sequenceOfValues, nil
 }
 
+// Subscribe streams the events polled by the LogPoller, so only finalized events are delivered, which are never
+// reorged out of the stream. A sequenceDataType of *values.Value streams the events wrapped in values, like QueryKey.
+func (cr *chainReader) Subscribe(ctx context.Context, contract commontypes.BoundContract, filter query.KeyFilter, fromBlock int64, sequenceDataType any) (<-chan commontypes.Sequence, error) {
+	readIdentifier := contract.ReadIdentifier(filter.Key)
+	reader, address, err := cr.bindings.GetReader(readIdentifier)
+	if err != nil {
+		return nil, err
+	}
+	binding, isEvent := reader.(*read.EventBinding)
+	if !isEvent {
+		return nil, fmt.Errorf("%w: %s is not an event", commontypes.ErrInvalidType, readIdentifier)
+	}
+
+	_, wrapValues := sequenceDataType.(*values.Value)
+	if wrapValues {
+		if sequenceDataType, err = cr.CreateContractType(readIdentifier, false); err != nil {
+			return nil, err
+		}
+	}
+
+	poll := func(ctx context.Context, cursor string) ([]commontypes.Sequence, error) {
+		sequences, err := binding.QueryKeyFollowing(ctx, common.HexToAddress(address), filter, cursor, fromBlock, subscriptionBatchSize, sequenceDataType)
+		if err != nil || !wrapValues {
+			return sequences, err
+		}
+		for i := range sequences {
+			value, err := values.Wrap(sequences[i].Data)
+			if err != nil {
+				return nil, err
+			}
+			sequences[i].Data = &value
+		}
+		return sequences, nil
+	}
+
+	// the first batch is read before returning, so that the subscriptions of invalid filters fail right away
+	sequences, err := poll(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan commontypes.Sequence)
+	cr.wg.Add(1)
+	go func() {
+		defer cr.wg.Done()
+		defer close(ch)
+
+		ctx, cancel := cr.stopCh.Ctx(ctx)
+		defer cancel()
+
+		lggr := logger.With(cr.lggr, "readIdentifier", readIdentifier, "fromBlock", fromBlock)
+		ticker := time.NewTicker(subscriptionPollPeriod)
+		defer ticker.Stop()
+
+		var cursor string
+		for {
+			for _, sequence := range sequences {
+				select {
+				case ch <- sequence:
+					cursor = sequence.Cursor
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			// read the next batch right away if the batch was full, as the subscription is catching up
+			if len(sequences) < subscriptionBatchSize {
+				select {
+				case <-ticker.C:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			sequences, err = poll(ctx, cursor)
+			if err != nil {
+				if ctx.Err() == nil {
+					lggr.Errorw("Failed to query events of subscription", "cursor", cursor, "err", err)
+				}
+				sequences = nil
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
 func (cr *chainReader) CreateContractType(readIdentifier string, forEncoding bool) (any, error) {
 	return cr.codec.CreateType(cr.bindings.ReadTypeIdentifier(readIdentifier, forEncoding), forEncoding)
 }
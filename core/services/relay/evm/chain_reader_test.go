@@ -0,0 +1,189 @@
+package evm_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/mailbox/mailboxtest"
+
+	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	lpmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller/mocks"
+	evmtestutils "github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
+)
+
+func TestChainReader_LatestFinalizedHead(t *testing.T) {
+	t.Parallel()
+
+	h11 := evmtestutils.Head(11)
+	h12 := evmtestutils.Head(12)
+	h12.ParentHash = h11.Hash
+	h13 := evmtestutils.Head(13)
+	h13.ParentHash = h12.Hash
+
+	newChainReader := func(t *testing.T, finalityDepthFallback bool) (evm.ChainReaderService, *evmclimocks.Client) {
+		evmcfg := evmtestutils.NewTestChainScopedConfig(t, func(c *toml.EVMConfig) {
+			c.FinalityTagEnabled = ptr(true)
+			c.FinalityDepth = ptr[uint32](2)
+			c.HeadTracker.FinalityDepthFallback = ptr(finalityDepthFallback)
+		})
+		db := pgtest.NewSqlxDB(t)
+		orm := headtracker.NewORM(*evmtestutils.FixtureChainID, db)
+		for _, h := range []*evmtypes.Head{h11, h12, h13} {
+			require.NoError(t, orm.IdempotentInsertHead(testutils.Context(t), h))
+		}
+
+		lggr := logger.TestLogger(t)
+		ethClient := evmtestutils.NewEthClientMockWithDefaultChain(t)
+		hs := headtracker.NewHeadSaver(lggr, orm, evmcfg.EVM(), evmcfg.EVM().HeadTracker())
+		_, err := hs.Load(testutils.Context(t), 0)
+		require.NoError(t, err)
+		ht := headtracker.NewHeadTracker(lggr, ethClient, evmcfg.EVM(), evmcfg.EVM().HeadTracker(),
+			headtracker.NewHeadBroadcaster(lggr), headtracker.NewReorgBus(lggr), hs, mailboxtest.NewMonitor(t))
+
+		cr, err := evm.NewChainReaderService(testutils.Context(t), lggr, nil, ht, nil, types.ChainReaderConfig{})
+		require.NoError(t, err)
+		return cr, ethClient
+	}
+
+	t.Run("finality tag", func(t *testing.T) {
+		cr, ethClient := newChainReader(t, false)
+		ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(h13, nil).Once()
+		ethClient.On("LatestFinalizedBlock", mock.Anything).Return(h12, nil).Once()
+
+		head, err := cr.LatestFinalizedHead(testutils.Context(t))
+		require.NoError(t, err)
+		assert.Equal(t, "12", head.Height)
+		assert.Equal(t, h12.Hash.Bytes(), head.Hash)
+	})
+
+	t.Run("finality tag fails without fallback", func(t *testing.T) {
+		cr, ethClient := newChainReader(t, false)
+		ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(h13, nil).Once()
+		ethClient.On("LatestFinalizedBlock", mock.Anything).Return(nil, errors.New("finalized tag is not supported")).Once()
+
+		_, err := cr.LatestFinalizedHead(testutils.Context(t))
+		require.ErrorContains(t, err, "failed to get latest finalized head")
+	})
+
+	t.Run("finality depth fallback", func(t *testing.T) {
+		cr, ethClient := newChainReader(t, true)
+		ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(h13, nil).Once()
+		ethClient.On("LatestFinalizedBlock", mock.Anything).Return(nil, errors.New("finalized tag is not supported")).Once()
+
+		head, err := cr.LatestFinalizedHead(testutils.Context(t))
+		require.NoError(t, err)
+		assert.Equal(t, "11", head.Height)
+		assert.Equal(t, h11.Hash.Bytes(), head.Hash)
+	})
+}
+
+func TestChainReader_Subscribe(t *testing.T) {
+	t.Parallel()
+
+	const pingABI = `[{"anonymous":false,"inputs":[{"indexed":false,"internalType":"uint256","name":"value","type":"uint256"}],"name":"Ping","type":"event"}]`
+	type ping struct {
+		Value *big.Int
+	}
+
+	address := common.HexToAddress("0x1234")
+	pingSig := crypto.Keccak256Hash([]byte("Ping(uint256)"))
+	logs := make([]logpoller.Log, 3)
+	for i := range logs {
+		logs[i] = logpoller.Log{
+			EvmChainId:     ubig.New(evmtestutils.FixtureChainID),
+			LogIndex:       1,
+			BlockHash:      common.BigToHash(big.NewInt(int64(10 + i))),
+			BlockNumber:    int64(10 + i),
+			BlockTimestamp: time.Unix(int64(1000+i), 0),
+			Topics:         [][]byte{pingSig.Bytes()},
+			EventSig:       pingSig,
+			Address:        address,
+			TxHash:         common.BigToHash(big.NewInt(int64(100 + i))),
+			Data:           common.LeftPadBytes(big.NewInt(int64(i)).Bytes(), 32),
+		}
+	}
+
+	lp := lpmocks.NewLogPoller(t)
+	lp.On("FilteredLogs", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, expressions []query.Expression, limitAndSort query.LimitAndSort, _ string) ([]logpoller.Log, error) {
+			// only finalized events are streamed
+			assert.Contains(t, expressions, logpoller.NewConfirmationsFilter(evmtypes.Finalized))
+			switch limitAndSort.Limit.Cursor {
+			case "":
+				return logs[:2], nil
+			case logpoller.FormatContractReaderCursor(logs[1]):
+				return logs[2:], nil
+			}
+			return nil, nil
+		})
+
+	cr, err := evm.NewChainReaderService(testutils.Context(t), logger.TestLogger(t), lp, nil, nil, types.ChainReaderConfig{
+		Contracts: map[string]types.ChainContractReader{
+			"Pinger": {
+				ContractABI: pingABI,
+				ContractPollingFilter: types.ContractPollingFilter{
+					GenericEventNames: []string{"Ping"},
+				},
+				Configs: map[string]*types.ChainReaderDefinition{
+					"Ping": {ChainSpecificName: "Ping", ReadType: types.Event},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	contract := commontypes.BoundContract{Address: address.Hex(), Name: "Pinger"}
+	require.NoError(t, cr.Bind(testutils.Context(t), []commontypes.BoundContract{contract}))
+
+	t.Run("unknown event", func(t *testing.T) {
+		_, err := cr.Subscribe(testutils.Context(t), contract, query.KeyFilter{Key: "Pong"}, 0, &ping{})
+		require.Error(t, err)
+	})
+
+	t.Run("streams events in order", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(testutils.Context(t))
+		ch, err := cr.Subscribe(ctx, contract, query.KeyFilter{Key: "Ping"}, 10, &ping{})
+		require.NoError(t, err)
+
+		for i := range logs {
+			select {
+			case sequence := <-ch:
+				assert.Equal(t, logpoller.FormatContractReaderCursor(logs[i]), sequence.Cursor)
+				assert.Equal(t, logs[i].BlockHash.Bytes(), sequence.Head.Hash)
+				require.IsType(t, &ping{}, sequence.Data)
+				assert.Equal(t, int64(i), sequence.Data.(*ping).Value.Int64())
+			case <-time.After(testutils.WaitTimeout(t)):
+				t.Fatalf("event %d was not streamed", i)
+			}
+		}
+
+		cancel()
+		select {
+		case _, ok := <-ch:
+			assert.False(t, ok, "no events are streamed after the last one")
+		case <-time.After(testutils.WaitTimeout(t)):
+			t.Fatal("stream was not closed")
+		}
+	})
+}
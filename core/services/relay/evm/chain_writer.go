@@ -2,6 +2,7 @@ package evm
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"strings"
@@ -20,7 +21,9 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
 	evmtxmgr "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/services"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/codec"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/read"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
 )
 
@@ -29,9 +32,14 @@ type ChainWriterService interface {
 	commontypes.ChainWriter
 }
 
+// multicallCallOverheadGas is a conservative upper bound on the gas used by aggregate3 for each packed call.
+const multicallCallOverheadGas = 10_000
+
 // Compile-time assertion that chainWriter implements the ChainWriterService interface.
 var _ ChainWriterService = (*chainWriter)(nil)
 
+var _ relay.BatchWriter = (*chainWriter)(nil)
+
 func NewChainWriterService(logger logger.Logger, client evmclient.Client, txm evmtxmgr.TxManager, estimator gas.EvmFeeEstimator, config types.ChainWriterConfig) (ChainWriterService, error) {
 	if config.MaxGasPrice == nil {
 		return nil, fmt.Errorf("max gas price is required")
@@ -44,6 +52,8 @@ func NewChainWriterService(logger logger.Logger, client evmclient.Client, txm ev
 		ge:          estimator,
 		maxGasPrice: config.MaxGasPrice,
 
+		multicallAddress: config.MulticallAddress,
+
 		contracts:       config.Contracts,
 		parsedContracts: &codec.ParsedTypes{EncoderDefs: map[string]types.CodecEntry{}, DecoderDefs: map[string]types.CodecEntry{}},
 	}
@@ -69,6 +79,8 @@ type chainWriter struct {
 	ge          gas.EvmFeeEstimator
 	maxGasPrice *assets.Wei
 
+	multicallAddress *common.Address
+
 	contracts       map[string]*types.ContractConfig
 	parsedContracts *codec.ParsedTypes
 
@@ -81,23 +93,74 @@ type chainWriter struct {
 // `nil` values, including for slices. Until the bug is fixed we need to ensure that there are no
 // `nil` values passed in the request.
 func (w *chainWriter) SubmitTransaction(ctx context.Context, contract, method string, args any, transactionID string, toAddress string, meta *commontypes.TxMeta, value *big.Int) error {
-	if !common.IsHexAddress(toAddress) {
-		return fmt.Errorf("toAddress is not a valid ethereum address: %v", toAddress)
+	req, err := w.newTxRequest(ctx, relay.Write{
+		Contract:      contract,
+		Method:        method,
+		Args:          args,
+		TransactionID: transactionID,
+		ToAddress:     toAddress,
+		Meta:          meta,
+		Value:         value,
+	})
+	if err != nil {
+		return err
 	}
 
-	contractConfig, ok := w.contracts[contract]
+	_, err = w.txm.CreateTransaction(ctx, req)
+	if err != nil {
+		return fmt.Errorf("%w; failed to create tx", err)
+	}
+
+	return nil
+}
+
+// SubmitBatch submits each write in its own transaction, unless the batch prefers or requires atomicity. The writes of
+// those batches are packed into a single aggregate3 transaction of the configured Multicall3 contract, in which any
+// reverting write reverts the batch. Packed writes are sent by Multicall3, so they may only target methods which do
+// not restrict their caller.
+func (w *chainWriter) SubmitBatch(ctx context.Context, batch relay.Batch) ([]string, error) {
+	if batch.Atomicity == relay.AtomicityNone || len(batch.Writes) < 2 {
+		return relay.SubmitWrites(ctx, w, batch.Writes)
+	}
+
+	req, err := w.newBatchTxRequest(ctx, batch)
+	if err != nil {
+		if batch.Atomicity == relay.AtomicityPreferred && errors.Is(err, relay.ErrAtomicBatchNotSupported) {
+			w.logger.Debugw("Submitting batch writes in separate transactions", "batchID", batch.ID, "err", err)
+			return relay.SubmitWrites(ctx, w, batch.Writes)
+		}
+		return nil, err
+	}
+
+	if _, err = w.txm.CreateTransaction(ctx, req); err != nil {
+		return nil, fmt.Errorf("%w; failed to create tx", err)
+	}
+
+	txIDs := make([]string, len(batch.Writes))
+	for i := range txIDs {
+		txIDs[i] = batch.ID
+	}
+	return txIDs, nil
+}
+
+func (w *chainWriter) newTxRequest(ctx context.Context, write relay.Write) (evmtxmgr.TxRequest, error) {
+	if !common.IsHexAddress(write.ToAddress) {
+		return evmtxmgr.TxRequest{}, fmt.Errorf("toAddress is not a valid ethereum address: %v", write.ToAddress)
+	}
+
+	contractConfig, ok := w.contracts[write.Contract]
 	if !ok {
-		return fmt.Errorf("contract config not found: %v", contract)
+		return evmtxmgr.TxRequest{}, fmt.Errorf("contract config not found: %v", write.Contract)
 	}
 
-	methodConfig, ok := contractConfig.Configs[method]
+	methodConfig, ok := contractConfig.Configs[write.Method]
 	if !ok {
-		return fmt.Errorf("method config not found: %v", method)
+		return evmtxmgr.TxRequest{}, fmt.Errorf("method config not found: %v", write.Method)
 	}
 
-	calldata, err := w.encoder.Encode(ctx, args, codec.WrapItemType(contract, method, true))
+	calldata, err := w.encoder.Encode(ctx, write.Args, codec.WrapItemType(write.Contract, write.Method, true))
 	if err != nil {
-		return fmt.Errorf("%w: failed to encode args", err)
+		return evmtxmgr.TxRequest{}, fmt.Errorf("%w: failed to encode args", err)
 	}
 
 	var checker evmtxmgr.TransmitCheckerSpec
@@ -106,25 +169,33 @@ func (w *chainWriter) SubmitTransaction(ctx context.Context, contract, method st
 	}
 
 	v := big.NewInt(0)
-	if value != nil {
-		v = value
+	if write.Value != nil {
+		v = write.Value
 	}
 
+	meta := write.Meta
 	var txMeta *txmgrtypes.TxMeta[common.Address, common.Hash]
 	if meta != nil && meta.WorkflowExecutionID != nil {
 		txMeta = &txmgrtypes.TxMeta[common.Address, common.Hash]{
 			WorkflowExecutionID: meta.WorkflowExecutionID,
 		}
 	}
+	if methodConfig.GasEstimatorProfile != "" {
+		if txMeta == nil {
+			txMeta = &txmgrtypes.TxMeta[common.Address, common.Hash]{}
+		}
+		txMeta.GasEstimatorProfile = &methodConfig.GasEstimatorProfile
+	}
 
 	gasLimit := methodConfig.GasLimit
 	if meta != nil && meta.GasLimit != nil {
 		gasLimit = meta.GasLimit.Uint64()
 	}
 
-	req := evmtxmgr.TxRequest{
+	transactionID := write.TransactionID
+	return evmtxmgr.TxRequest{
 		FromAddress:    methodConfig.FromAddress,
-		ToAddress:      common.HexToAddress(toAddress),
+		ToAddress:      common.HexToAddress(write.ToAddress),
 		EncodedPayload: calldata,
 		FeeLimit:       gasLimit,
 		Meta:           txMeta,
@@ -132,14 +203,68 @@ func (w *chainWriter) SubmitTransaction(ctx context.Context, contract, method st
 		Strategy:       txmgr.NewSendEveryStrategy(),
 		Checker:        checker,
 		Value:          *v,
+		AllowBundling:  methodConfig.AllowBundling,
+	}, nil
+}
+
+// newBatchTxRequest packs the writes of the batch into a single aggregate3 transaction. It returns an error wrapping
+// relay.ErrAtomicBatchNotSupported if the writes cannot be packed.
+func (w *chainWriter) newBatchTxRequest(ctx context.Context, batch relay.Batch) (evmtxmgr.TxRequest, error) {
+	if w.multicallAddress == nil {
+		return evmtxmgr.TxRequest{}, fmt.Errorf("%w: no multicall address configured", relay.ErrAtomicBatchNotSupported)
 	}
 
-	_, err = w.txm.CreateTransaction(ctx, req)
+	var batchReq evmtxmgr.TxRequest
+	calls := make([]read.Multicall3Call, len(batch.Writes))
+	for i, write := range batch.Writes {
+		req, err := w.newTxRequest(ctx, write)
+		if err != nil {
+			return evmtxmgr.TxRequest{}, fmt.Errorf("%w: write %d of batch", err, i)
+		}
+		if req.Value.Sign() != 0 {
+			return evmtxmgr.TxRequest{}, fmt.Errorf("%w: write %d transfers value", relay.ErrAtomicBatchNotSupported, i)
+		}
+		if i == 0 {
+			batchReq = req
+		} else {
+			if req.FromAddress != batchReq.FromAddress {
+				return evmtxmgr.TxRequest{}, fmt.Errorf("%w: writes are sent from different addresses", relay.ErrAtomicBatchNotSupported)
+			}
+			batchReq.FeeLimit += req.FeeLimit
+			// The checker and gas estimator profile of the batch are only kept if all writes agree on them.
+			if req.Checker.CheckerType != batchReq.Checker.CheckerType {
+				batchReq.Checker = evmtxmgr.TransmitCheckerSpec{}
+			}
+			if req.Meta == nil || batchReq.Meta == nil || !equalProfiles(req.Meta.GasEstimatorProfile, batchReq.Meta.GasEstimatorProfile) {
+				batchReq.Meta = nil
+			}
+		}
+		calls[i] = read.Multicall3Call{Target: req.ToAddress, CallData: req.EncodedPayload}
+	}
+
+	payload, err := read.Multicall3.Pack("aggregate3", calls)
 	if err != nil {
-		return fmt.Errorf("%w; failed to create tx", err)
+		return evmtxmgr.TxRequest{}, fmt.Errorf("%w: failed to pack aggregate3", err)
 	}
 
-	return nil
+	batchID := batch.ID
+	batchReq.ToAddress = *w.multicallAddress
+	batchReq.EncodedPayload = payload
+	batchReq.FeeLimit += uint64(len(calls)) * multicallCallOverheadGas
+	batchReq.IdempotencyKey = &batchID
+	// the batch is already packed into a single aggregate3 call
+	batchReq.AllowBundling = false
+	if batchReq.Meta != nil {
+		batchReq.Meta = &txmgrtypes.TxMeta[common.Address, common.Hash]{GasEstimatorProfile: batchReq.Meta.GasEstimatorProfile}
+	}
+	return batchReq, nil
+}
+
+func equalProfiles(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
 }
 
 func (w *chainWriter) parseContracts() error {
@@ -1,6 +1,7 @@
 package evm
 
 import (
+	"bytes"
 	"fmt"
 	"math/big"
 	"testing"
@@ -17,10 +18,13 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
 	gasmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/mocks"
 	rollupmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/rollups/mocks"
+	evmtxmgr "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	txmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/keystone/generated/forwarder"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/read"
 	relayevmtypes "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
 )
 
@@ -61,6 +65,67 @@ func TestChainWriter(t *testing.T) {
 		// TODO: implement
 	})
 
+	t.Run("SubmitBatch", func(t *testing.T) {
+		args := map[string]any{
+			"Receiver":      testutils.NewAddress(),
+			"RawReport":     []byte{0x1},
+			"ReportContext": []byte{0x2},
+			"Signatures":    [][]byte{{0x3}},
+		}
+		newBatch := func(atomicity relay.Atomicity) relay.Batch {
+			batch := relay.Batch{ID: uuid.NewString(), Atomicity: atomicity}
+			for i := 0; i < 2; i++ {
+				batch.Writes = append(batch.Writes, relay.Write{
+					Contract:      "forwarder",
+					Method:        "report",
+					Args:          args,
+					TransactionID: uuid.NewString(),
+					ToAddress:     testutils.NewAddress().Hex(),
+				})
+			}
+			return batch
+		}
+
+		t.Run("Submits separate transactions without atomicity", func(t *testing.T) {
+			batch := newBatch(relay.AtomicityNone)
+			txm.On("CreateTransaction", mock.Anything, mock.Anything).Return(evmtxmgr.Tx{}, nil).Twice()
+			txIDs, err := cw.(relay.BatchWriter).SubmitBatch(ctx, batch)
+			require.NoError(t, err)
+			assert.Equal(t, []string{batch.Writes[0].TransactionID, batch.Writes[1].TransactionID}, txIDs)
+		})
+
+		t.Run("Falls back to separate transactions without multicall address", func(t *testing.T) {
+			batch := newBatch(relay.AtomicityPreferred)
+			txm.On("CreateTransaction", mock.Anything, mock.Anything).Return(evmtxmgr.Tx{}, nil).Twice()
+			txIDs, err := cw.(relay.BatchWriter).SubmitBatch(ctx, batch)
+			require.NoError(t, err)
+			assert.Equal(t, []string{batch.Writes[0].TransactionID, batch.Writes[1].TransactionID}, txIDs)
+
+			_, err = cw.(relay.BatchWriter).SubmitBatch(ctx, newBatch(relay.AtomicityRequired))
+			require.ErrorIs(t, err, relay.ErrAtomicBatchNotSupported)
+		})
+
+		t.Run("Packs atomic batches into a multicall", func(t *testing.T) {
+			multicallAddress := testutils.NewAddress()
+			multicallConfig := modifyChainWriterConfig(newBaseChainWriterConfig(), func(cfg *relayevmtypes.ChainWriterConfig) {
+				cfg.MulticallAddress = &multicallAddress
+			})
+			multicallWriter, err := NewChainWriterService(lggr, client, txm, ge, multicallConfig)
+			require.NoError(t, err)
+
+			batch := newBatch(relay.AtomicityRequired)
+			txm.On("CreateTransaction", mock.Anything, mock.MatchedBy(func(req evmtxmgr.TxRequest) bool {
+				return req.ToAddress == multicallAddress &&
+					*req.IdempotencyKey == batch.ID &&
+					req.FeeLimit == 2*(200_000+multicallCallOverheadGas) &&
+					bytes.HasPrefix(req.EncodedPayload, read.Multicall3.Methods["aggregate3"].ID)
+			})).Return(evmtxmgr.Tx{}, nil).Once()
+			txIDs, err := multicallWriter.(relay.BatchWriter).SubmitBatch(ctx, batch)
+			require.NoError(t, err)
+			assert.Equal(t, []string{batch.ID, batch.ID}, txIDs)
+		})
+	})
+
 	t.Run("GetTransactionStatus", func(t *testing.T) {
 		txs := []struct {
 			txid   string
@@ -62,7 +62,7 @@ type DstCommitProvider struct {
 	startBlock          uint64
 	client              client.Client
 	lp                  logpoller.LogPoller
-	contractTransmitter *contractTransmitter
+	contractTransmitter ocrtypes.ContractTransmitter
 	configWatcher       *configWatcher
 	gasEstimator        gas.EvmFeeEstimator
 	maxGasPrice         big.Int
@@ -80,7 +80,7 @@ func NewDstCommitProvider(
 	lp logpoller.LogPoller,
 	gasEstimator gas.EvmFeeEstimator,
 	maxGasPrice big.Int,
-	contractTransmitter contractTransmitter,
+	contractTransmitter ocrtypes.ContractTransmitter,
 	configWatcher *configWatcher,
 ) commontypes.CCIPCommitProvider {
 	return &DstCommitProvider{
@@ -89,7 +89,7 @@ func NewDstCommitProvider(
 		startBlock:          startBlock,
 		client:              client,
 		lp:                  lp,
-		contractTransmitter: &contractTransmitter,
+		contractTransmitter: contractTransmitter,
 		configWatcher:       configWatcher,
 		gasEstimator:        gasEstimator,
 		maxGasPrice:         maxGasPrice,
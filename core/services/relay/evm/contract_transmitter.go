@@ -125,11 +125,39 @@ func NewOCRContractTransmitter(
 
 // Transmit sends the report to the on-chain smart contract's Transmit method.
 func (oc *contractTransmitter) Transmit(ctx context.Context, reportCtx ocrtypes.ReportContext, report ocrtypes.Report, signatures []ocrtypes.AttributedOnchainSignature) error {
+	payload, err := oc.transmitPayload(reportCtx, report, signatures)
+	if err != nil {
+		return err
+	}
+
+	txMeta, err := oc.reportToEvmTxMeta(report)
+	if err != nil {
+		oc.lggr.Warnw("failed to generate tx metadata for report", "err", err)
+	}
+
+	oc.lggr.Debugw("Transmitting report", "report", hex.EncodeToString(report), "rawReportCtx", evmutil.RawReportContext(reportCtx), "contractAddress", oc.contractAddress, "txMeta", txMeta)
+
+	return errors.Wrap(oc.transmitter.CreateEthTransaction(ctx, oc.contractAddress, payload, txMeta), "failed to send Eth transaction")
+}
+
+// Simulate calls the on-chain smart contract's Transmit method with the report from the transmitter address, without
+// sending a transaction, and returns the error of the call if it reverts.
+func (oc *contractTransmitter) Simulate(ctx context.Context, reportCtx ocrtypes.ReportContext, report ocrtypes.Report, signatures []ocrtypes.AttributedOnchainSignature) error {
+	payload, err := oc.transmitPayload(reportCtx, report, signatures)
+	if err != nil {
+		return err
+	}
+	from := oc.transmitter.FromAddress()
+	_, err = oc.contractReader.CallContract(ctx, ethereum.CallMsg{From: from, To: &oc.contractAddress, Data: payload}, nil)
+	return errors.Wrap(err, "transmit call failed")
+}
+
+func (oc *contractTransmitter) transmitPayload(reportCtx ocrtypes.ReportContext, report ocrtypes.Report, signatures []ocrtypes.AttributedOnchainSignature) ([]byte, error) {
 	var rs [][32]byte
 	var ss [][32]byte
 	var vs [32]byte
 	if len(signatures) > 32 {
-		return errors.New("too many signatures, maximum is 32")
+		return nil, errors.New("too many signatures, maximum is 32")
 	}
 	for i, as := range signatures {
 		r, s, v, err := evmutil.SplitSignature(as.Signature)
@@ -144,19 +172,11 @@ func (oc *contractTransmitter) Transmit(ctx context.Context, reportCtx ocrtypes.
 	}
 	rawReportCtx := evmutil.RawReportContext(reportCtx)
 
-	txMeta, err := oc.reportToEvmTxMeta(report)
-	if err != nil {
-		oc.lggr.Warnw("failed to generate tx metadata for report", "err", err)
-	}
-
-	oc.lggr.Debugw("Transmitting report", "report", hex.EncodeToString(report), "rawReportCtx", rawReportCtx, "contractAddress", oc.contractAddress, "txMeta", txMeta)
-
 	payload, err := oc.contractABI.Pack("transmit", rawReportCtx, []byte(report), rs, ss, vs)
 	if err != nil {
-		return errors.Wrap(err, "abi.Pack failed")
+		return nil, errors.Wrap(err, "abi.Pack failed")
 	}
-
-	return errors.Wrap(oc.transmitter.CreateEthTransaction(ctx, oc.contractAddress, payload, txMeta), "failed to send Eth transaction")
+	return payload, nil
 }
 
 type contractReader interface {
@@ -1,11 +1,14 @@
 package evm
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
+	"errors"
 	"strings"
 	"testing"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
@@ -132,6 +135,40 @@ func Test_contractTransmitter_Transmit_SignaturesAreTransmitted(t *testing.T) {
 	require.Equal(t, transmitter.lastPayload, withSignaturesPayload)
 }
 
+func Test_contractTransmitter_Simulate(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	reportCtx := types.ReportContext{}
+	report := types.Report{1, 2, 3}
+	signatures := oneSignature()
+
+	contractABI, err := abi.JSON(strings.NewReader(ocr2aggregator.OCR2AggregatorMetaData.ABI))
+	require.NoError(t, err)
+	lp := lpmocks.NewLogPoller(t)
+	lp.On("RegisterFilter", mock.Anything, mock.Anything).Return(nil)
+	client := evmclimocks.NewClient(t)
+	transmitter := &mockTransmitter{}
+	oc, err := NewOCRContractTransmitter(ctx, sampleAddress, client, contractABI, transmitter, lp, logger.TestLogger(t))
+	require.NoError(t, err)
+
+	rs, ss, vs := signaturesAsPayload(t, signatures)
+	payload, err := contractABI.Pack("transmit", evmutil.RawReportContext(reportCtx), []byte(report), rs, ss, vs)
+	require.NoError(t, err)
+	isTransmitCall := mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+		return msg.From == sampleAddress && msg.To != nil && *msg.To == sampleAddress && bytes.Equal(msg.Data, payload)
+	})
+
+	client.On("CallContract", mock.Anything, isTransmitCall, mock.Anything).Return([]byte{}, nil).Once()
+	require.NoError(t, oc.Simulate(ctx, reportCtx, report, signatures))
+
+	client.On("CallContract", mock.Anything, isTransmitCall, mock.Anything).Return(nil, errors.New("execution reverted")).Once()
+	require.ErrorContains(t, oc.Simulate(ctx, reportCtx, report, signatures), "execution reverted")
+
+	// the simulation doesn't send a transaction
+	assert.Empty(t, transmitter.lastPayload)
+}
+
 func signaturesAsPayload(t *testing.T, signatures []ocrtypes.AttributedOnchainSignature) ([][32]byte, [][32]byte, [32]byte) {
 	var rs [][32]byte
 	var ss [][32]byte
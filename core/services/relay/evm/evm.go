@@ -34,7 +34,9 @@ import (
 	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
 	coretypes "github.com/smartcontractkit/chainlink-common/pkg/types/core"
 
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
 	txm "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
@@ -145,6 +147,7 @@ type Relayer struct {
 	mercuryPool          wsrpc.Pool
 	codec                commontypes.Codec
 	capabilitiesRegistry coretypes.CapabilitiesRegistry
+	legacyChains         legacyevm.LegacyChainContainer
 
 	// Mercury
 	mercuryORM        mercury.ORM
@@ -167,6 +170,8 @@ type RelayerOpts struct {
 	TransmitterConfig    mercury.TransmitterConfig
 	CapabilitiesRegistry coretypes.CapabilitiesRegistry
 	HTTPClient           *http.Client
+	// LegacyChains are the other chains of the node, used by automation upkeeps triggered from other chains.
+	LegacyChains legacyevm.LegacyChainContainer
 }
 
 func (c RelayerOpts) Validate() error {
@@ -211,6 +216,7 @@ func NewRelayer(lggr logger.Logger, chain legacyevm.Chain, opts RelayerOpts) (*R
 		mercuryORM:           mercuryORM,
 		transmitterCfg:       opts.TransmitterConfig,
 		capabilitiesRegistry: opts.CapabilitiesRegistry,
+		legacyChains:         opts.LegacyChains,
 	}
 
 	// Initialize write target capability if configuration is defined
@@ -398,12 +404,25 @@ func (r *Relayer) NewMercuryProvider(rargs commontypes.RelayArgs, pargs commonty
 	}
 
 	clients := make(map[string]wsrpc.Client)
-	for _, server := range mercuryConfig.GetServers() {
-		client, err := r.mercuryPool.Checkout(context.Background(), privKey, server.PubKey, server.URL)
-		if err != nil {
-			return nil, err
+	if len(mercuryConfig.ServerPool) > 0 {
+		var servers []mercury.PoolServer
+		for _, server := range mercuryConfig.GetPoolServers(feedID) {
+			client, err := r.mercuryPool.Checkout(context.Background(), privKey, server.PubKey, server.URL)
+			if err != nil {
+				return nil, err
+			}
+			servers = append(servers, mercury.PoolServer{URL: server.URL, Client: client})
+		}
+		pool := mercury.NewServerPool(lggr, feedID.Hex(), servers)
+		clients[pool.ServerURL()] = pool
+	} else {
+		for _, server := range mercuryConfig.GetServers() {
+			client, err := r.mercuryPool.Checkout(context.Background(), privKey, server.PubKey, server.URL)
+			if err != nil {
+				return nil, err
+			}
+			clients[server.URL] = client
 		}
-		clients[server.URL] = client
 	}
 
 	// initialize trigger capability service lazily
@@ -741,13 +760,21 @@ func generateTransmitterFrom(ctx context.Context, rargs commontypes.RelayArgs, e
 		gasLimit = uint64(*opts.pluginGasLimit)
 	}
 
+	txManager := configWatcher.chain.TxManager()
+	if profile := relayConfig.GasEstimatorProfile; profile != "" {
+		if _, ok := configWatcher.chain.Config().EVM().GasEstimatorProfiles()[profile]; !ok {
+			return nil, fmt.Errorf("unknown gas estimator profile %q", profile)
+		}
+		txManager = &profiledTxManager{TxManager: txManager, profile: profile}
+	}
+
 	var transmitter Transmitter
 	var err error
 
 	switch commontypes.OCR2PluginType(rargs.ProviderType) {
 	case commontypes.Median:
 		transmitter, err = ocrcommon.NewOCR2FeedsTransmitter(
-			configWatcher.chain.TxManager(),
+			txManager,
 			fromAddresses,
 			common.HexToAddress(rargs.ContractID),
 			gasLimit,
@@ -759,7 +786,7 @@ func generateTransmitterFrom(ctx context.Context, rargs commontypes.RelayArgs, e
 		)
 	case commontypes.CCIPExecution:
 		transmitter, err = cciptransmitter.NewTransmitterWithStatusChecker(
-			configWatcher.chain.TxManager(),
+			txManager,
 			fromAddresses,
 			gasLimit,
 			effectiveTransmitterAddress,
@@ -770,7 +797,7 @@ func generateTransmitterFrom(ctx context.Context, rargs commontypes.RelayArgs, e
 		)
 	default:
 		transmitter, err = ocrcommon.NewTransmitter(
-			configWatcher.chain.TxManager(),
+			txManager,
 			fromAddresses,
 			gasLimit,
 			effectiveTransmitterAddress,
@@ -786,6 +813,22 @@ func generateTransmitterFrom(ctx context.Context, rargs commontypes.RelayArgs, e
 	return transmitter, nil
 }
 
+// profiledTxManager prices the transactions it creates with a gas estimator profile of the chain.
+type profiledTxManager struct {
+	txm.TxManager
+	profile string
+}
+
+func (m *profiledTxManager) CreateTransaction(ctx context.Context, txRequest txm.TxRequest) (txm.Tx, error) {
+	meta := txm.TxMeta{}
+	if txRequest.Meta != nil {
+		meta = *txRequest.Meta
+	}
+	meta.GasEstimatorProfile = &m.profile
+	txRequest.Meta = &meta
+	return m.TxManager.CreateTransaction(ctx, txRequest)
+}
+
 func (r *Relayer) NewChainWriter(_ context.Context, config []byte) (commontypes.ChainWriter, error) {
 	var cfg types.ChainWriterConfig
 	if err := json.Unmarshal(config, &cfg); err != nil {
@@ -879,7 +922,7 @@ func (r *Relayer) NewMedianProvider(rargs commontypes.RelayArgs, pargs commontyp
 
 func (r *Relayer) NewAutomationProvider(rargs commontypes.RelayArgs, pargs commontypes.PluginArgs) (commontypes.AutomationProvider, error) {
 	lggr := logger.Sugared(r.lggr).Named("AutomationProvider").Named(rargs.ExternalJobID.String())
-	ocr2keeperRelayer := NewOCR2KeeperRelayer(r.ds, r.chain, lggr.Named("OCR2KeeperRelayer"), r.ks.Eth())
+	ocr2keeperRelayer := NewOCR2KeeperRelayer(r.ds, r.chain, r.legacyChains, lggr.Named("OCR2KeeperRelayer"), r.ks.Eth())
 
 	return ocr2keeperRelayer.NewOCR2KeeperProvider(rargs, pargs)
 }
@@ -904,6 +947,7 @@ func chainToUUID(chainID *big.Int) uuid.UUID {
 func (r *Relayer) NewCCIPCommitProvider(rargs commontypes.RelayArgs, pargs commontypes.PluginArgs) (commontypes.CCIPCommitProvider, error) {
 	// TODO https://smartcontract-it.atlassian.net/browse/BCF-2887
 	ctx := context.Background()
+	ccipClient := evmclient.NewRequestSourceClient(r.chain.Client(), commonclient.RequestSourceCCIP)
 
 	versionFinder := ccip.NewEvmVersionFinder()
 
@@ -921,7 +965,7 @@ func (r *Relayer) NewCCIPCommitProvider(rargs commontypes.RelayArgs, pargs commo
 		return NewSrcCommitProvider(
 			r.lggr,
 			sourceStartBlock,
-			r.chain.Client(),
+			ccipClient,
 			r.chain.LogPoller(),
 			r.chain.GasEstimator(),
 			r.chain.Config().EVM().GasEstimator().PriceMax().ToInt(),
@@ -934,7 +978,7 @@ func (r *Relayer) NewCCIPCommitProvider(rargs commontypes.RelayArgs, pargs commo
 		return nil, err
 	}
 	address := common.HexToAddress(relayOpts.ContractID)
-	typ, ver, err := ccipconfig.TypeAndVersion(address, r.chain.Client())
+	typ, ver, err := ccipconfig.TypeAndVersion(address, ccipClient)
 	if err != nil {
 		return nil, err
 	}
@@ -949,16 +993,27 @@ func (r *Relayer) NewCCIPCommitProvider(rargs commontypes.RelayArgs, pargs commo
 	if err != nil {
 		return nil, err
 	}
+	var transmitter ocrtypes.ContractTransmitter = contractTransmitter
+	if filterConfig := commitPluginConfig.PriceUpdateFilter; filterConfig != nil {
+		filter, err2 := ccip.NewEVMPriceUpdateFilter(ccipClient, address, filterConfig.RefreshInterval.Duration())
+		if err2 != nil {
+			return nil, err2
+		}
+		transmitter, err = ccipcommit.NewPriceUpdateTransmitter(r.lggr, contractTransmitter, filter, typ, ver)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	return NewDstCommitProvider(
 		r.lggr,
 		versionFinder,
 		destStartBlock,
-		r.chain.Client(),
+		ccipClient,
 		r.chain.LogPoller(),
 		r.chain.GasEstimator(),
 		*r.chain.Config().EVM().GasEstimator().PriceMax().ToInt(),
-		*contractTransmitter,
+		transmitter,
 		configWatcher,
 	), nil
 }
@@ -971,6 +1026,7 @@ func (r *Relayer) NewCCIPCommitProvider(rargs commontypes.RelayArgs, pargs commo
 func (r *Relayer) NewCCIPExecProvider(rargs commontypes.RelayArgs, pargs commontypes.PluginArgs) (commontypes.CCIPExecProvider, error) {
 	// TODO https://smartcontract-it.atlassian.net/browse/BCF-2887
 	ctx := context.Background()
+	ccipClient := evmclient.NewRequestSourceClient(r.chain.Client(), commonclient.RequestSourceCCIP)
 
 	versionFinder := ccip.NewEvmVersionFinder()
 
@@ -988,7 +1044,7 @@ func (r *Relayer) NewCCIPExecProvider(rargs commontypes.RelayArgs, pargs commont
 		return NewSrcExecProvider(
 			r.lggr,
 			versionFinder,
-			r.chain.Client(),
+			ccipClient,
 			r.chain.GasEstimator(),
 			r.chain.Config().EVM().GasEstimator().PriceMax().ToInt(),
 			r.chain.LogPoller(),
@@ -1007,7 +1063,7 @@ func (r *Relayer) NewCCIPExecProvider(rargs commontypes.RelayArgs, pargs commont
 		return nil, err
 	}
 	address := common.HexToAddress(relayOpts.ContractID)
-	typ, ver, err := ccipconfig.TypeAndVersion(address, r.chain.Client())
+	typ, ver, err := ccipconfig.TypeAndVersion(address, ccipClient)
 	if err != nil {
 		return nil, err
 	}
@@ -1026,7 +1082,7 @@ func (r *Relayer) NewCCIPExecProvider(rargs commontypes.RelayArgs, pargs commont
 	return NewDstExecProvider(
 		r.lggr,
 		versionFinder,
-		r.chain.Client(),
+		ccipClient,
 		r.chain.LogPoller(),
 		execPluginConfig.DestStartBlock,
 		contractTransmitter,
@@ -1,8 +1,10 @@
 package evmtesting
 
 import (
+	"context"
 	"math/big"
 	"reflect"
+	"strconv"
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -15,6 +17,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/types/query"
 	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/read"
 
 	. "github.com/smartcontractkit/chainlink-common/pkg/types/interfacetests" //nolint common practice to import test mods with .
@@ -136,6 +139,61 @@ func RunContractReaderEvmTests[T TestingT[T]](t T, it *EVMChainComponentsInterfa
 		assert.Equal(t, [32]uint8{5}, latest.Field3)
 	})
 
+	t.Run("Subscribe streams finalized events and replays them from a block", func(t T) {
+		it.Setup(t)
+		ctx := it.Helper.Context(t)
+		cr := it.GetContractReader(t).(evm.ChainReaderService)
+		bindings := it.GetBindings(t)
+		require.NoError(t, cr.Bind(ctx, bindings))
+		bound := BindingsByName(bindings, AnyContractName)[0]
+
+		triggerFourTopics(t, it, int32(1), int32(2), int32(3))
+		triggerFourTopics(t, it, int32(2), int32(2), int32(3))
+		for i := 0; i < finalityDepth+1; i++ {
+			it.Helper.Commit()
+		}
+
+		type fourTopics struct{ Field1, Field2, Field3 int32 }
+		filter := query.KeyFilter{Key: triggerWithAllTopics}
+		receive := func(ch <-chan types.Sequence) types.Sequence {
+			select {
+			case sequence, ok := <-ch:
+				require.True(t, ok)
+				return sequence
+			case <-time.After(it.MaxWaitTimeForEvents() + 5*time.Second):
+				require.FailNow(t, "timed out waiting for event")
+				return types.Sequence{}
+			}
+		}
+
+		subCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		ch, err := cr.Subscribe(subCtx, bound, filter, 0, &fourTopics{})
+		require.NoError(t, err)
+		first := receive(ch)
+		assert.Equal(t, &fourTopics{Field1: 1, Field2: 2, Field3: 3}, first.Data)
+		second := receive(ch)
+		assert.Equal(t, &fourTopics{Field1: 2, Field2: 2, Field3: 3}, second.Data)
+
+		triggerFourTopics(t, it, int32(3), int32(2), int32(3))
+		for i := 0; i < finalityDepth+1; i++ {
+			it.Helper.Commit()
+		}
+		assert.Equal(t, &fourTopics{Field1: 3, Field2: 2, Field3: 3}, receive(ch).Data)
+
+		fromBlock, err := strconv.ParseInt(second.Head.Height, 10, 64)
+		require.NoError(t, err)
+		replay, err := cr.Subscribe(subCtx, bound, filter, fromBlock, &fourTopics{})
+		require.NoError(t, err)
+		assert.Equal(t, second.Cursor, receive(replay).Cursor)
+
+		cancel()
+		require.Eventually(t, func() bool {
+			_, ok := <-ch
+			return !ok
+		}, it.MaxWaitTimeForEvents(), 10*time.Millisecond)
+	})
+
 	t.Run("Bind returns error on missing contract at address", func(t T) {
 		it.Setup(t)
 
@@ -386,6 +386,16 @@ func (d *DstExecProvider) NewTokenPoolBatchedReader(ctx context.Context, offRamp
 	return
 }
 
+// NewExecGasReader returns the reader of the gas used by the execution transactions of the offRamp, which the execution
+// plugin attributes to the messages they executed.
+func (d *DstExecProvider) NewExecGasReader(ctx context.Context, offRampAddress cciptypes.Address) (ccip.ExecGasReader, error) {
+	offRamp, err := ccip.GenericAddrToEvm(offRampAddress)
+	if err != nil {
+		return nil, err
+	}
+	return ccip.NewEVMExecGasReader(d.lggr, d.lp, d.client, offRamp), nil
+}
+
 func (d *DstExecProvider) SourceNativeToken(ctx context.Context, addr cciptypes.Address) (cciptypes.Address, error) {
 	return "", fmt.Errorf("invalid: SourceNativeToken called on DstExecProvider. It should only be called on SrcExecProvider")
 }
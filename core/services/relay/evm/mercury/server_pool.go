@@ -0,0 +1,206 @@
+package mercury
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc/pb"
+)
+
+// poolServerCooldown is how long a server of the pool is skipped after a failed request, unless all servers are failing.
+const poolServerCooldown = 30 * time.Second
+
+var serverPoolErrorCount = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "mercury_server_pool_error_count",
+	Help: "Number of failed requests to a server of a pool, after which the next server of the pool is tried",
+},
+	[]string{"feedID", "serverURL"},
+)
+
+var _ wsrpc.Client = (*serverPool)(nil)
+
+// PoolServer is a member of a server pool, connected with client.
+type PoolServer struct {
+	URL    string
+	Client wsrpc.Client
+}
+
+// serverPool is a wsrpc.Client sending each request to the first healthy server of the pool, in failover order, and
+// failing over to the next server if the request fails. The health of each server is tracked independently.
+type serverPool struct {
+	services.StateMachine
+	lggr    logger.SugaredLogger
+	url     string
+	members []*poolMember
+	now     func() time.Time
+}
+
+type poolMember struct {
+	url    string
+	client wsrpc.Client
+
+	mu       sync.RWMutex
+	lastErr  error
+	failedAt time.Time
+	errCount prometheus.Counter
+}
+
+// NewServerPool returns a wsrpc.Client for the servers of a pool, which must be in failover order.
+func NewServerPool(lggr logger.Logger, feedIDHex string, servers []PoolServer) wsrpc.Client {
+	p := &serverPool{
+		lggr: logger.Sugared(lggr).Named("ServerPool"),
+		now:  time.Now,
+	}
+	urls := make([]string, len(servers))
+	for i, s := range servers {
+		urls[i] = s.URL
+		p.members = append(p.members, &poolMember{
+			url:      s.URL,
+			client:   s.Client,
+			errCount: serverPoolErrorCount.WithLabelValues(feedIDHex, s.URL),
+		})
+	}
+	// persisted transmissions are keyed by the URL, so it must not depend on the failover order
+	p.url = ServerPoolURL(urls)
+	return p
+}
+
+// ServerPoolURL returns the URL identifying a pool of servers, for persistence and metrics.
+func ServerPoolURL(urls []string) string {
+	sorted := make([]string, len(urls))
+	copy(sorted, urls)
+	slices.Sort(sorted)
+	return "pool:" + strings.Join(sorted, ",")
+}
+
+func (p *serverPool) Start(ctx context.Context) error {
+	return p.StartOnce("ServerPool", func() error {
+		clients := make([]services.StartClose, len(p.members))
+		for i, m := range p.members {
+			clients[i] = m.client
+		}
+		return (&services.MultiStart{}).Start(ctx, clients...)
+	})
+}
+
+func (p *serverPool) Close() error {
+	return p.StopOnce("ServerPool", func() error {
+		var errs []error
+		for _, m := range p.members {
+			errs = append(errs, m.client.Close())
+		}
+		return errors.Join(errs...)
+	})
+}
+
+func (p *serverPool) Name() string { return p.lggr.Name() }
+
+// HealthReport reports each server of the pool, and fails the pool only if no server is healthy.
+func (p *serverPool) HealthReport() map[string]error {
+	report := map[string]error{}
+	var healthy bool
+	for _, m := range p.members {
+		err := p.memberHealth(m)
+		report[p.Name()+"."+m.url] = err
+		if err == nil {
+			healthy = true
+		}
+	}
+	err := p.Healthy()
+	if err == nil && !healthy {
+		err = errors.New("no healthy server in pool")
+	}
+	report[p.Name()] = err
+	return report
+}
+
+// memberHealth returns the connection health of m, or its last error if it failed recently.
+func (p *serverPool) memberHealth(m *poolMember) error {
+	if err := m.client.HealthReport()[m.client.Name()]; err != nil {
+		return err
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.lastErr != nil && p.now().Sub(m.failedAt) < poolServerCooldown {
+		return m.lastErr
+	}
+	return nil
+}
+
+// candidates returns the healthy servers in failover order, followed by the unhealthy ones, so that a request is
+// still attempted if all servers are failing.
+func (p *serverPool) candidates() []*poolMember {
+	var healthy, unhealthy []*poolMember
+	for _, m := range p.members {
+		if p.memberHealth(m) == nil {
+			healthy = append(healthy, m)
+		} else {
+			unhealthy = append(unhealthy, m)
+		}
+	}
+	return append(healthy, unhealthy...)
+}
+
+func (p *serverPool) recordResult(m *poolMember, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err == nil {
+		m.lastErr = nil
+		return
+	}
+	m.lastErr = err
+	m.failedAt = p.now()
+}
+
+// do calls f with each candidate server until one succeeds or ctx expires.
+func do[T any](ctx context.Context, p *serverPool, f func(wsrpc.Client) (T, error)) (res T, err error) {
+	var errs []error
+	for i, m := range p.candidates() {
+		if i > 0 {
+			if ctx.Err() != nil {
+				break
+			}
+			p.lggr.Debugw("Failing over to next server", "serverURL", m.url)
+		}
+		res, err = f(m.client)
+		p.recordResult(m, err)
+		if err == nil {
+			return res, nil
+		}
+		m.errCount.Inc()
+		p.lggr.Warnw("Request to server failed", "serverURL", m.url, "err", err)
+		errs = append(errs, fmt.Errorf("%s: %w", m.url, err))
+	}
+	return res, fmt.Errorf("all servers in pool failed: %w", errors.Join(errs...))
+}
+
+func (p *serverPool) Transmit(ctx context.Context, req *pb.TransmitRequest) (*pb.TransmitResponse, error) {
+	return do(ctx, p, func(c wsrpc.Client) (*pb.TransmitResponse, error) {
+		return c.Transmit(ctx, req)
+	})
+}
+
+func (p *serverPool) LatestReport(ctx context.Context, req *pb.LatestReportRequest) (*pb.LatestReportResponse, error) {
+	return do(ctx, p, func(c wsrpc.Client) (*pb.LatestReportResponse, error) {
+		return c.LatestReport(ctx, req)
+	})
+}
+
+func (p *serverPool) ServerURL() string { return p.url }
+
+// RawClient returns the raw client of the first healthy server.
+func (p *serverPool) RawClient() pb.MercuryClient {
+	return p.candidates()[0].client.RawClient()
+}
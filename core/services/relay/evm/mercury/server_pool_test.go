@@ -0,0 +1,102 @@
+package mercury
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc/pb"
+)
+
+func newTestPoolClient(transmitted *[]string, url string, fail *bool) *mocks.MockWSRPCClient {
+	return &mocks.MockWSRPCClient{
+		TransmitF: func(ctx context.Context, in *pb.TransmitRequest) (*pb.TransmitResponse, error) {
+			*transmitted = append(*transmitted, url)
+			if *fail {
+				return nil, errors.New("connection refused")
+			}
+			return &pb.TransmitResponse{}, nil
+		},
+	}
+}
+
+func Test_ServerPool(t *testing.T) {
+	var transmitted []string
+	var primaryFails, secondaryFails bool
+	pool := NewServerPool(logger.TestLogger(t), "0x1", []PoolServer{
+		{URL: "primary.example.com", Client: newTestPoolClient(&transmitted, "primary.example.com", &primaryFails)},
+		{URL: "secondary.example.com", Client: newTestPoolClient(&transmitted, "secondary.example.com", &secondaryFails)},
+	}).(*serverPool)
+	now := time.Now()
+	pool.now = func() time.Time { return now }
+	servicetest.Run(t, pool)
+	ctx := testutils.Context(t)
+
+	assert.Equal(t, "pool:primary.example.com,secondary.example.com", pool.ServerURL())
+
+	t.Run("sends to the first server", func(t *testing.T) {
+		transmitted = nil
+		_, err := pool.Transmit(ctx, &pb.TransmitRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"primary.example.com"}, transmitted)
+		assert.NoError(t, pool.HealthReport()[pool.Name()])
+	})
+
+	t.Run("fails over to the next server", func(t *testing.T) {
+		transmitted = nil
+		primaryFails = true
+		_, err := pool.Transmit(ctx, &pb.TransmitRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"primary.example.com", "secondary.example.com"}, transmitted)
+
+		report := pool.HealthReport()
+		assert.ErrorContains(t, report[pool.Name()+".primary.example.com"], "connection refused")
+		assert.NoError(t, report[pool.Name()+".secondary.example.com"])
+		assert.NoError(t, report[pool.Name()])
+
+		// the failing server is skipped until its cooldown has passed
+		transmitted = nil
+		_, err = pool.Transmit(ctx, &pb.TransmitRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"secondary.example.com"}, transmitted)
+	})
+
+	t.Run("returns to the first server once it recovers", func(t *testing.T) {
+		transmitted = nil
+		primaryFails = false
+		now = now.Add(poolServerCooldown)
+		_, err := pool.Transmit(ctx, &pb.TransmitRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"primary.example.com"}, transmitted)
+		assert.NoError(t, pool.HealthReport()[pool.Name()+".primary.example.com"])
+	})
+
+	t.Run("fails if all servers fail", func(t *testing.T) {
+		transmitted = nil
+		primaryFails, secondaryFails = true, true
+		_, err := pool.Transmit(ctx, &pb.TransmitRequest{})
+		require.ErrorContains(t, err, "all servers in pool failed")
+		assert.ErrorContains(t, err, "primary.example.com: connection refused")
+		assert.ErrorContains(t, err, "secondary.example.com: connection refused")
+		assert.EqualError(t, pool.HealthReport()[pool.Name()], "no healthy server in pool")
+
+		// failing servers are still tried, in failover order
+		transmitted = nil
+		_, err = pool.Transmit(ctx, &pb.TransmitRequest{})
+		require.Error(t, err)
+		assert.Equal(t, []string{"primary.example.com", "secondary.example.com"}, transmitted)
+	})
+}
+
+func Test_ServerPoolURL(t *testing.T) {
+	assert.Equal(t, ServerPoolURL([]string{"a.example.com", "b.example.com"}), ServerPoolURL([]string{"b.example.com", "a.example.com"}))
+}
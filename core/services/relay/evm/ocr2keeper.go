@@ -66,15 +66,18 @@ type OCR2KeeperRelayer interface {
 type ocr2keeperRelayer struct {
 	ds          sqlutil.DataSource
 	chain       legacyevm.Chain
+	chains      legacyevm.LegacyChainContainer
 	lggr        logger.Logger
 	ethKeystore keystore.Eth
 }
 
-// NewOCR2KeeperRelayer is the constructor of ocr2keeperRelayer
-func NewOCR2KeeperRelayer(ds sqlutil.DataSource, chain legacyevm.Chain, lggr logger.Logger, ethKeystore keystore.Eth) OCR2KeeperRelayer {
+// NewOCR2KeeperRelayer is the constructor of ocr2keeperRelayer. chains provides the source chains of CCIP triggers,
+// which are not supported if it is nil.
+func NewOCR2KeeperRelayer(ds sqlutil.DataSource, chain legacyevm.Chain, chains legacyevm.LegacyChainContainer, lggr logger.Logger, ethKeystore keystore.Eth) OCR2KeeperRelayer {
 	return &ocr2keeperRelayer{
 		ds:          ds,
 		chain:       chain,
+		chains:      chains,
 		lggr:        lggr,
 		ethKeystore: ethKeystore,
 	}
@@ -126,7 +129,11 @@ func (r *ocr2keeperRelayer) NewOCR2KeeperProvider(rargs commontypes.RelayArgs, p
 	scanner := upkeepstate.NewPerformedEventsScanner(r.lggr, client.LogPoller(), addr, finalityDepth)
 	services.upkeepStateStore = upkeepstate.NewUpkeepStateStore(orm, r.lggr, scanner)
 
-	logProvider, logRecoverer := logprovider.New(r.lggr, client.LogPoller(), client.Client(), services.upkeepStateStore, finalityDepth, client.ID())
+	ccipTriggers, err := r.newCCIPTriggers(pargs.PluginConfig)
+	if err != nil {
+		return nil, err
+	}
+	logProvider, logRecoverer := logprovider.New(r.lggr, client.LogPoller(), client.Client(), services.upkeepStateStore, finalityDepth, client.ID(), ccipTriggers)
 	services.logEventProvider = logProvider
 	services.logRecoverer = logRecoverer
 	blockSubscriber := evm.NewBlockSubscriber(client.HeadBroadcaster(), client.LogPoller(), finalityDepth, r.lggr)
@@ -144,6 +151,40 @@ func (r *ocr2keeperRelayer) NewOCR2KeeperProvider(rargs commontypes.RelayArgs, p
 	return services, nil
 }
 
+// newCCIPTriggers returns the CCIP triggers of the plugin config, or nil if there are none.
+func (r *ocr2keeperRelayer) newCCIPTriggers(pluginConfig []byte) (*logprovider.CCIPTriggers, error) {
+	var cfg struct {
+		CCIPTriggers []logprovider.CCIPTriggerSpec `json:"ccipTriggers"`
+	}
+	if len(pluginConfig) > 0 {
+		if err := json.Unmarshal(pluginConfig, &cfg); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal plugin config: %w", err)
+		}
+	}
+	if len(cfg.CCIPTriggers) == 0 {
+		return nil, nil
+	}
+	if r.chains == nil {
+		return nil, errors.New("CCIP triggers are not supported by this relayer")
+	}
+	configs := make([]logprovider.CCIPTriggerConfig, len(cfg.CCIPTriggers))
+	for i, spec := range cfg.CCIPTriggers {
+		c, err := spec.Config()
+		if err != nil {
+			return nil, err
+		}
+		configs[i] = c
+	}
+	resolver := logprovider.NewCCIPMessageResolver(r.lggr, r.chain.Client(), func(chainID string) (logprovider.CCIPSourceChain, error) {
+		chain, err := r.chains.Get(chainID)
+		if err != nil {
+			return logprovider.CCIPSourceChain{}, err
+		}
+		return logprovider.CCIPSourceChain{Poller: chain.LogPoller(), Client: chain.Client()}, nil
+	})
+	return logprovider.NewCCIPTriggers(r.lggr, resolver, configs...), nil
+}
+
 type ocr3keeperProviderContractTransmitter struct {
 	contractTransmitter ocrtypes.ContractTransmitter
 }
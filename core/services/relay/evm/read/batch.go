@@ -83,6 +83,15 @@ func NewDynamicLimitedBatchCaller(lggr logger.Logger, codec types.Codec, evmClie
 	}
 }
 
+// NewMulticallBatchCaller returns a BatchCaller that packs each batch of calls, across any number of contracts and
+// methods, into a single aggregate3 eth_call to the Multicall3 contract deployed at multicallAddress.
+// Failing calls don't fail the batch, their error is returned in the result of the call.
+func NewMulticallBatchCaller(lggr logger.Logger, codec types.Codec, evmClient client.Client, multicallAddress common.Address, batchSizeLimit, backOffMultiplier, parallelRpcCallsLimit uint) BatchCaller {
+	bc := newDefaultEvmBatchCaller(lggr, evmClient, codec, batchSizeLimit, backOffMultiplier, parallelRpcCallsLimit)
+	bc.multicallAddress = &multicallAddress
+	return &dynamicLimitedBatchCaller{bc: bc}
+}
+
 func (c *dynamicLimitedBatchCaller) BatchCall(ctx context.Context, blockNumber uint64, reqs BatchCall) (BatchResult, error) {
 	return c.bc.batchCallDynamicLimitRetries(ctx, blockNumber, reqs)
 }
@@ -94,6 +103,8 @@ type defaultEvmBatchCaller struct {
 	batchSizeLimit        uint
 	parallelRpcCallsLimit uint
 	backOffMultiplier     uint
+	// multicallAddress is set when calls should be aggregated through Multicall3 rather than a JSON-RPC batch
+	multicallAddress *common.Address
 }
 
 // NewDefaultEvmBatchCaller returns a new batch caller instance.
@@ -132,6 +143,10 @@ func (c *defaultEvmBatchCaller) batchCall(ctx context.Context, blockNumber uint6
 		return nil, nil
 	}
 
+	if c.multicallAddress != nil {
+		return c.multicall(ctx, blockNumber, batchCall)
+	}
+
 	packedOutputs := make([]string, len(batchCall))
 	rpcBatchCalls := make([]rpc.BatchElem, len(batchCall))
 	for i, call := range batchCall {
@@ -140,11 +155,6 @@ func (c *defaultEvmBatchCaller) batchCall(ctx context.Context, blockNumber uint6
 			return nil, err
 		}
 
-		blockNumStr := "latest"
-		if blockNumber > 0 {
-			blockNumStr = hexutil.EncodeBig(big.NewInt(0).SetUint64(blockNumber))
-		}
-
 		rpcBatchCalls[i] = rpc.BatchElem{
 			Method: "eth_call",
 			Args: []any{
@@ -153,7 +163,7 @@ func (c *defaultEvmBatchCaller) batchCall(ctx context.Context, blockNumber uint6
 					"to":   call.ContractAddress,
 					"data": hexutil.Bytes(data),
 				},
-				blockNumStr,
+				blockNumberArg(blockNumber),
 			},
 			Result: &packedOutputs[i],
 		}
@@ -188,20 +198,30 @@ func (c *defaultEvmBatchCaller) batchCall(ctx context.Context, blockNumber uint6
 			return nil, fmt.Errorf("decode result %s: packedOutputs %s: %w", call, packedOutputs[i], err)
 		}
 
-		if err = c.codec.Decode(ctx, b, call.ReturnVal, codec.WrapItemType(call.ContractName, call.MethodName, false)); err != nil {
-			if len(b) == 0 {
-				results[i].err = fmt.Errorf("unpack result %s: %s: %w", call, err.Error(), errEmptyOutput)
-			} else {
-				results[i].err = fmt.Errorf("unpack result %s: %w", call, err)
-			}
-			continue
-		}
-		results[i].returnVal = call.ReturnVal
+		results[i].err = c.decodeResult(ctx, call, b)
 	}
 
 	return results, nil
 }
 
+// decodeResult decodes the packed output of the call into its ReturnVal
+func (c *defaultEvmBatchCaller) decodeResult(ctx context.Context, call Call, b []byte) error {
+	if err := c.codec.Decode(ctx, b, call.ReturnVal, codec.WrapItemType(call.ContractName, call.MethodName, false)); err != nil {
+		if len(b) == 0 {
+			return fmt.Errorf("unpack result %s: %s: %w", call, err.Error(), errEmptyOutput)
+		}
+		return fmt.Errorf("unpack result %s: %w", call, err)
+	}
+	return nil
+}
+
+func blockNumberArg(blockNumber uint64) string {
+	if blockNumber > 0 {
+		return hexutil.EncodeBig(big.NewInt(0).SetUint64(blockNumber))
+	}
+	return "latest"
+}
+
 func (c *defaultEvmBatchCaller) batchCallDynamicLimitRetries(ctx context.Context, blockNumber uint64, calls BatchCall) (BatchResult, error) {
 	lim := c.batchSizeLimit
 	// Limit the batch size to the number of calls
@@ -3,9 +3,12 @@ package read_test
 import (
 	"fmt"
 	"math/big"
+	"strings"
 	"testing"
 
 	"github.com/cometbft/cometbft/libs/rand"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/pkg/errors"
@@ -189,3 +192,80 @@ func TestDefaultEvmBatchCaller_batchCallLimit(t *testing.T) {
 		})
 	}
 }
+
+func TestMulticallBatchCaller_BatchCall(t *testing.T) {
+	ctx := testutils.Context(t)
+
+	type MethodParam struct {
+		A uint64
+	}
+	type MethodReturn struct {
+		B uint64
+	}
+	paramABI := `[{"type":"uint64","name":"A"}]`
+	returnABI := `[{"type":"uint64","name":"B"}]`
+	codecConfig := evmtypes.CodecConfig{Configs: map[string]evmtypes.ChainCodecConfig{
+		"params.tokenA.decimals": {TypeABI: paramABI},
+		"return.tokenA.decimals": {TypeABI: returnABI},
+		"params.feeQuoter.fee":   {TypeABI: paramABI},
+		"return.feeQuoter.fee":   {TypeABI: returnABI},
+	}}
+	testCodec, err := codec.NewCodec(codecConfig)
+	require.NoError(t, err)
+
+	multicallABI, err := abi.JSON(strings.NewReader(`[{"inputs":[{"components":[{"name":"target","type":"address"},{"name":"allowFailure","type":"bool"},{"name":"callData","type":"bytes"}],"name":"calls","type":"tuple[]"}],"name":"aggregate3","outputs":[{"components":[{"name":"success","type":"bool"},{"name":"returnData","type":"bytes"}],"name":"returnData","type":"tuple[]"}],"type":"function"}]`))
+	require.NoError(t, err)
+	type call3 struct {
+		Target       common.Address
+		AllowFailure bool
+		CallData     []byte
+	}
+	type result struct {
+		Success    bool
+		ReturnData []byte
+	}
+
+	multicallAddress := testutils.NewAddress()
+	calls := read.BatchCall{
+		{ContractAddress: testutils.NewAddress(), ContractName: "tokenA", MethodName: "decimals", Params: &MethodParam{A: 18}, ReturnVal: new(MethodReturn)},
+		{ContractAddress: testutils.NewAddress(), ContractName: "feeQuoter", MethodName: "fee", Params: &MethodParam{A: 42}, ReturnVal: new(MethodReturn)},
+		{ContractAddress: testutils.NewAddress(), ContractName: "feeQuoter", MethodName: "fee", Params: &MethodParam{A: 0}, ReturnVal: new(MethodReturn)},
+	}
+
+	ec := chainmocks.NewClient(t)
+	ec.On("CallContext", mock.Anything, mock.Anything, "eth_call", mock.Anything, "latest").
+		Run(func(args mock.Arguments) {
+			callArgs := args.Get(3).(map[string]interface{})
+			require.Equal(t, &multicallAddress, callArgs["to"])
+
+			unpacked, err := multicallABI.Methods["aggregate3"].Inputs.Unpack(callArgs["data"].(hexutil.Bytes)[4:])
+			require.NoError(t, err)
+			packedCalls := *abi.ConvertType(unpacked[0], new([]call3)).(*[]call3)
+			require.Len(t, packedCalls, len(calls))
+
+			results := make([]result, len(packedCalls))
+			for i, c := range packedCalls {
+				require.Equal(t, calls[i].ContractAddress, c.Target)
+				require.True(t, c.AllowFailure)
+				// echo the param back, and make the call with a zero param revert
+				param := new(big.Int).SetBytes(c.CallData[24:]).Uint64()
+				results[i] = result{Success: param != 0, ReturnData: common.LeftPadBytes(c.CallData[24:], 32)}
+			}
+			packed, err := multicallABI.Methods["aggregate3"].Outputs.Pack(results)
+			require.NoError(t, err)
+			*args.Get(1).(*string) = hexutil.Encode(packed)
+		}).Return(nil).Once()
+
+	bc := read.NewMulticallBatchCaller(logger.TestLogger(t), testCodec, ec, multicallAddress, 0, 0, 0)
+	results, err := bc.BatchCall(ctx, 0, calls)
+	require.NoError(t, err)
+
+	require.Len(t, results["tokenA"], 1)
+	require.NoError(t, results["tokenA"][0].Err)
+	assert.Equal(t, uint64(18), results["tokenA"][0].ReturnValue.(*MethodReturn).B)
+
+	require.Len(t, results["feeQuoter"], 2)
+	require.NoError(t, results["feeQuoter"][0].Err)
+	assert.Equal(t, uint64(42), results["feeQuoter"][0].ReturnValue.(*MethodReturn).B)
+	assert.ErrorContains(t, results["feeQuoter"][1].Err, "reverted")
+}
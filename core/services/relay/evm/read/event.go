@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -283,14 +285,41 @@ func (b *EventBinding) QueryKey(ctx context.Context, address common.Address, fil
 		return nil, err
 	}
 
+	return b.queryRemapped(ctx, address, remapped.Expressions, limitAndSort, sequenceDataType)
+}
+
+// QueryKeyFollowing returns up to count finalized events matching the filter in sequence order, following the cursor,
+// or starting at fromBlock if the cursor is empty. It is used to stream the events of a subscription.
+func (b *EventBinding) QueryKeyFollowing(ctx context.Context, address common.Address, filter query.KeyFilter, cursor string, fromBlock int64, count uint64, sequenceDataType any) ([]commontypes.Sequence, error) {
+	if err := b.validateBound(address); err != nil {
+		return nil, err
+	}
+
+	remapped, err := b.remap(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// cursor queries are limited to finalized logs, which also keeps the stream free of reorged events
+	expressions := append(slices.Clone(remapped.Expressions), logpoller.NewConfirmationsFilter(evmtypes.Finalized))
+	limitAndSort := query.NewLimitAndSort(query.CursorLimit(cursor, query.CursorFollowing, count))
+	if cursor == "" {
+		expressions = append(expressions, query.Block(strconv.FormatInt(fromBlock, 10), primitives.Gte))
+		limitAndSort = query.NewLimitAndSort(query.CountLimit(count), query.NewSortBySequence(query.Asc))
+	}
+
+	return b.queryRemapped(ctx, address, expressions, limitAndSort, sequenceDataType)
+}
+
+func (b *EventBinding) queryRemapped(ctx context.Context, address common.Address, expressions []query.Expression, limitAndSort query.LimitAndSort, sequenceDataType any) ([]commontypes.Sequence, error) {
 	// filter should always use the address and event sig
 	defaultExpressions := []query.Expression{
 		logpoller.NewAddressFilter(address),
 		logpoller.NewEventSigFilter(b.hash),
 	}
-	remapped.Expressions = append(defaultExpressions, remapped.Expressions...)
+	expressions = append(defaultExpressions, expressions...)
 
-	logs, err := b.lp.FilteredLogs(ctx, remapped.Expressions, limitAndSort, b.contractName+"-"+address.String()+"-"+b.eventName)
+	logs, err := b.lp.FilteredLogs(ctx, expressions, limitAndSort, b.contractName+"-"+address.String()+"-"+b.eventName)
 	if err != nil {
 		return nil, err
 	}
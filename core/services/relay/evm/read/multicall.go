@@ -0,0 +1,101 @@
+package read
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/codec"
+)
+
+// multicall3ABI contains only the aggregate3 method of the canonical Multicall3 contract.
+const multicall3ABI = `[{"inputs":[{"components":[{"internalType":"address","name":"target","type":"address"},{"internalType":"bool","name":"allowFailure","type":"bool"},{"internalType":"bytes","name":"callData","type":"bytes"}],"internalType":"struct Multicall3.Call3[]","name":"calls","type":"tuple[]"}],"name":"aggregate3","outputs":[{"components":[{"internalType":"bool","name":"success","type":"bool"},{"internalType":"bytes","name":"returnData","type":"bytes"}],"internalType":"struct Multicall3.Result[]","name":"returnData","type":"tuple[]"}],"stateMutability":"payable","type":"function"}]`
+
+// Multicall3 is the parsed ABI of the aggregate3 method of the canonical Multicall3 contract.
+var Multicall3 = mustParseABI(multicall3ABI)
+
+func mustParseABI(abiStr string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(abiStr))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+// Multicall3Call is a call of aggregate3.
+type Multicall3Call struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+type multicall3Result struct {
+	Success    bool
+	ReturnData []byte
+}
+
+// multicall executes the batch as a single aggregate3 eth_call. Calls are allowed to fail individually,
+// in which case the error is reported in the result of that call only.
+func (c *defaultEvmBatchCaller) multicall(ctx context.Context, blockNumber uint64, batchCall BatchCall) ([]dataAndErr, error) {
+	calls := make([]Multicall3Call, len(batchCall))
+	for i, call := range batchCall {
+		data, err := c.codec.Encode(ctx, call.Params, codec.WrapItemType(call.ContractName, call.MethodName, true))
+		if err != nil {
+			return nil, err
+		}
+		calls[i] = Multicall3Call{Target: call.ContractAddress, AllowFailure: true, CallData: data}
+	}
+
+	payload, err := Multicall3.Pack("aggregate3", calls)
+	if err != nil {
+		return nil, fmt.Errorf("pack aggregate3: %w", err)
+	}
+
+	var packedOutput string
+	if err = c.evmClient.CallContext(ctx, &packedOutput, "eth_call", map[string]interface{}{
+		"from": common.Address{},
+		"to":   c.multicallAddress,
+		"data": hexutil.Bytes(payload),
+	}, blockNumberArg(blockNumber)); err != nil {
+		return nil, fmt.Errorf("multicall: %w", err)
+	}
+
+	b, err := hexutil.Decode(packedOutput)
+	if err != nil {
+		return nil, fmt.Errorf("decode multicall result: packedOutput %s: %w", packedOutput, err)
+	}
+	unpacked, err := Multicall3.Unpack("aggregate3", b)
+	if err != nil {
+		return nil, fmt.Errorf("unpack aggregate3 result: %w", err)
+	}
+	var callResults []multicall3Result
+	if len(unpacked) > 0 {
+		callResults = *abi.ConvertType(unpacked[0], new([]multicall3Result)).(*[]multicall3Result)
+	}
+	if len(callResults) != len(batchCall) {
+		return nil, fmt.Errorf("multicall returned %d results for %d calls", len(callResults), len(batchCall))
+	}
+
+	results := make([]dataAndErr, len(batchCall))
+	for i, call := range batchCall {
+		results[i] = dataAndErr{
+			address:      call.ContractAddress.Hex(),
+			contractName: call.ContractName,
+			methodName:   call.MethodName,
+			returnVal:    call.ReturnVal,
+		}
+
+		if !callResults[i].Success {
+			results[i].err = fmt.Errorf("call %s reverted: returnData %s", call, hexutil.Encode(callResults[i].ReturnData))
+			continue
+		}
+
+		results[i].err = c.decodeResult(ctx, call, callResults[i].ReturnData)
+	}
+
+	return results, nil
+}
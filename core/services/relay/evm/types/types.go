@@ -26,6 +26,9 @@ import (
 type ChainWriterConfig struct {
 	Contracts   map[string]*ContractConfig
 	MaxGasPrice *assets.Wei
+	// MulticallAddress is an optional Multicall3 deployment. When set, batches which prefer or require atomicity are
+	// packed into a single aggregate3 transaction.
+	MulticallAddress *common.Address `json:",omitempty"`
 }
 
 type ContractConfig struct {
@@ -41,11 +44,20 @@ type ChainWriterDefinition struct {
 	FromAddress        common.Address        `json:"fromAddress"`
 	GasLimit           uint64                `json:"gasLimit"` // TODO(archseer): what if this has to be configured per call?
 	InputModifications codec.ModifiersConfig `json:"inputModifications,omitempty"`
+	// GasEstimatorProfile optionally names the chain's gas estimator profile used to price the transactions.
+	GasEstimatorProfile string `json:"gasEstimatorProfile,omitempty"`
+	// AllowBundling lets the TxManager bundle the transactions of this method with others from the same address into a
+	// single Multicall3 transaction when EVM.Transactions.Bundler is enabled. Bundled calls are sent by Multicall3 and
+	// revert together, so only set it for methods which do not restrict their caller and are not expected to revert.
+	AllowBundling bool `json:"allowBundling,omitempty"`
 }
 
 type ChainReaderConfig struct {
 	// Contracts key is contract name
 	Contracts map[string]ChainContractReader `json:"contracts" toml:"contracts"`
+	// MulticallAddress is an optional Multicall3 deployment. When set, batched reads are packed
+	// into a single aggregate3 eth_call instead of a JSON-RPC batch of eth_calls.
+	MulticallAddress *common.Address `json:"multicallAddress,omitempty" toml:"multicallAddress,omitempty"`
 }
 
 type CodecConfig struct {
@@ -183,6 +195,8 @@ type RelayConfig struct {
 
 	DefaultTransactionQueueDepth uint32 `json:"defaultTransactionQueueDepth"`
 	SimulateTransactions         bool   `json:"simulateTransactions"`
+	// GasEstimatorProfile optionally names the chain's gas estimator profile used to price the transmissions.
+	GasEstimatorProfile string `json:"gasEstimatorProfile,omitempty"`
 
 	// Contract-specific
 	SendingKeys pq.StringArray `json:"sendingKeys"`
@@ -0,0 +1,263 @@
+package reports
+
+import (
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/lib/pq"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_offramp"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_onramp"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_onramp_1_1_0"
+)
+
+// pageSize is the number of rows loaded at once while generating a report.
+const pageSize = 1000
+
+// generator writes the rows of a report to w, and returns their number.
+type generator func(ctx context.Context, ds sqlutil.DataSource, params Params, w *csv.Writer) (int64, error)
+
+var generators = map[Type]generator{
+	TypeEthTransactions: generateEthTransactions,
+	TypePipelineRuns:    generatePipelineRuns,
+	TypeCCIPMessages:    generateCCIPMessages,
+}
+
+func formatNullTime(t sql.NullTime) string {
+	if !t.Valid {
+		return ""
+	}
+	return t.Time.UTC().Format(time.RFC3339Nano)
+}
+
+func formatNullInt(i sql.NullInt64) string {
+	if !i.Valid {
+		return ""
+	}
+	return strconv.FormatInt(i.Int64, 10)
+}
+
+// optionalFilters returns the chain and address params as query args, nil if not set.
+func optionalFilters(params Params) (chainID, address any) {
+	if params.EVMChainID != nil {
+		chainID = params.EVMChainID.String()
+	}
+	if params.Address != nil {
+		address = params.Address.Bytes()
+	}
+	return
+}
+
+func generateEthTransactions(ctx context.Context, ds sqlutil.DataSource, params Params, w *csv.Writer) (int64, error) {
+	if err := w.Write([]string{"id", "evm_chain_id", "nonce", "from_address", "to_address", "value", "gas_limit",
+		"state", "error", "idempotency_key", "created_at", "broadcast_at"}); err != nil {
+		return 0, err
+	}
+	chainID, address := optionalFilters(params)
+	var n, lastID int64
+	for {
+		var rows []struct {
+			ID             int64          `db:"id"`
+			EVMChainID     string         `db:"evm_chain_id"`
+			Nonce          sql.NullInt64  `db:"nonce"`
+			FromAddress    common.Address `db:"from_address"`
+			ToAddress      common.Address `db:"to_address"`
+			Value          string         `db:"value"`
+			GasLimit       int64          `db:"gas_limit"`
+			State          string         `db:"state"`
+			Error          sql.NullString `db:"error"`
+			IdempotencyKey sql.NullString `db:"idempotency_key"`
+			CreatedAt      time.Time      `db:"created_at"`
+			BroadcastAt    sql.NullTime   `db:"broadcast_at"`
+		}
+		err := ds.SelectContext(ctx, &rows, `SELECT id, evm_chain_id::text AS evm_chain_id, nonce, from_address, to_address,
+	value::text AS value, gas_limit, state, error, idempotency_key, created_at, broadcast_at
+FROM evm.txes
+WHERE id > $1 AND ($2::numeric IS NULL OR evm_chain_id = $2) AND ($3::bytea IS NULL OR from_address = $3)
+ORDER BY id LIMIT $4`, lastID, chainID, address, pageSize)
+		if err != nil {
+			return n, fmt.Errorf("failed to load transactions: %w", err)
+		}
+		for _, r := range rows {
+			if err = w.Write([]string{strconv.FormatInt(r.ID, 10), r.EVMChainID, formatNullInt(r.Nonce), r.FromAddress.Hex(),
+				r.ToAddress.Hex(), r.Value, strconv.FormatInt(r.GasLimit, 10), r.State, r.Error.String,
+				r.IdempotencyKey.String, r.CreatedAt.UTC().Format(time.RFC3339Nano), formatNullTime(r.BroadcastAt)}); err != nil {
+				return n, err
+			}
+			n++
+			lastID = r.ID
+		}
+		if len(rows) < pageSize {
+			return n, nil
+		}
+	}
+}
+
+func generatePipelineRuns(ctx context.Context, ds sqlutil.DataSource, params Params, w *csv.Writer) (int64, error) {
+	if err := w.Write([]string{"id", "job_id", "state", "created_at", "finished_at", "outputs", "all_errors", "fatal_errors"}); err != nil {
+		return 0, err
+	}
+	var n, lastID int64
+	for {
+		var rows []struct {
+			ID          int64          `db:"id"`
+			State       string         `db:"state"`
+			CreatedAt   time.Time      `db:"created_at"`
+			FinishedAt  sql.NullTime   `db:"finished_at"`
+			Outputs     sql.NullString `db:"outputs"`
+			AllErrors   sql.NullString `db:"all_errors"`
+			FatalErrors sql.NullString `db:"fatal_errors"`
+		}
+		err := ds.SelectContext(ctx, &rows, `SELECT pipeline_runs.id, pipeline_runs.state, pipeline_runs.created_at,
+	pipeline_runs.finished_at, pipeline_runs.outputs::text AS outputs, pipeline_runs.all_errors::text AS all_errors,
+	pipeline_runs.fatal_errors::text AS fatal_errors
+FROM pipeline_runs
+JOIN job_pipeline_specs USING (pipeline_spec_id)
+WHERE job_pipeline_specs.job_id = $1 AND pipeline_runs.id > $2
+ORDER BY pipeline_runs.id LIMIT $3`, params.JobID, lastID, pageSize)
+		if err != nil {
+			return n, fmt.Errorf("failed to load pipeline runs: %w", err)
+		}
+		jobID := strconv.FormatInt(int64(params.JobID), 10)
+		for _, r := range rows {
+			if err = w.Write([]string{strconv.FormatInt(r.ID, 10), jobID, r.State, r.CreatedAt.UTC().Format(time.RFC3339Nano),
+				formatNullTime(r.FinishedAt), r.Outputs.String, r.AllErrors.String, r.FatalErrors.String}); err != nil {
+				return n, err
+			}
+			n++
+			lastID = r.ID
+		}
+		if len(rows) < pageSize {
+			return n, nil
+		}
+	}
+}
+
+// ccipEvent decodes the sequence number, message ID and execution state of a CCIP event log. The state is empty for
+// sent messages.
+type ccipEvent struct {
+	name   string
+	decode func(types.Log) (seqNr uint64, messageID [32]byte, state string, err error)
+}
+
+func ccipEvents() (map[common.Hash]ccipEvent, error) {
+	onRamp, err := evm_2_evm_onramp.NewEVM2EVMOnRampFilterer(common.Address{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	onRamp110, err := evm_2_evm_onramp_1_1_0.NewEVM2EVMOnRampFilterer(common.Address{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	offRamp, err := evm_2_evm_offramp.NewEVM2EVMOffRampFilterer(common.Address{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return map[common.Hash]ccipEvent{
+		evm_2_evm_onramp.EVM2EVMOnRampCCIPSendRequested{}.Topic(): {"CCIPSendRequested", func(l types.Log) (uint64, [32]byte, string, error) {
+			e, err := onRamp.ParseCCIPSendRequested(l)
+			if err != nil {
+				return 0, [32]byte{}, "", err
+			}
+			return e.Message.SequenceNumber, e.Message.MessageId, "", nil
+		}},
+		// the event of the onramps before v1.2
+		evm_2_evm_onramp_1_1_0.EVM2EVMOnRampCCIPSendRequested{}.Topic(): {"CCIPSendRequested", func(l types.Log) (uint64, [32]byte, string, error) {
+			e, err := onRamp110.ParseCCIPSendRequested(l)
+			if err != nil {
+				return 0, [32]byte{}, "", err
+			}
+			return e.Message.SequenceNumber, e.Message.MessageId, "", nil
+		}},
+		evm_2_evm_offramp.EVM2EVMOffRampExecutionStateChanged{}.Topic(): {"ExecutionStateChanged", func(l types.Log) (uint64, [32]byte, string, error) {
+			e, err := offRamp.ParseExecutionStateChanged(l)
+			if err != nil {
+				return 0, [32]byte{}, "", err
+			}
+			return e.SequenceNumber, e.MessageId, ccipExecutionState(e.State), nil
+		}},
+	}, nil
+}
+
+// ccipExecutionState names the states of the Internal.MessageExecutionState enum of the offramps.
+func ccipExecutionState(state uint8) string {
+	switch state {
+	case 0:
+		return "untouched"
+	case 1:
+		return "in_progress"
+	case 2:
+		return "success"
+	case 3:
+		return "failure"
+	default:
+		return strconv.Itoa(int(state))
+	}
+}
+
+func generateCCIPMessages(ctx context.Context, ds sqlutil.DataSource, params Params, w *csv.Writer) (int64, error) {
+	events, err := ccipEvents()
+	if err != nil {
+		return 0, err
+	}
+	sigs := make([][]byte, 0, len(events))
+	for sig := range events {
+		sigs = append(sigs, sig.Bytes())
+	}
+	if err = w.Write([]string{"evm_chain_id", "block_number", "block_timestamp", "tx_hash", "address", "event",
+		"sequence_number", "message_id", "state"}); err != nil {
+		return 0, err
+	}
+	chainID, address := optionalFilters(params)
+	var n, lastID int64
+	for {
+		var rows []struct {
+			ID             int64          `db:"id"`
+			EVMChainID     string         `db:"evm_chain_id"`
+			BlockNumber    int64          `db:"block_number"`
+			BlockTimestamp time.Time      `db:"block_timestamp"`
+			TxHash         common.Hash    `db:"tx_hash"`
+			Address        common.Address `db:"address"`
+			EventSig       common.Hash    `db:"event_sig"`
+			Topics         pq.ByteaArray  `db:"topics"`
+			Data           []byte         `db:"data"`
+		}
+		err = ds.SelectContext(ctx, &rows, `SELECT id, evm_chain_id::text AS evm_chain_id, block_number, block_timestamp,
+	tx_hash, address, event_sig, topics, data
+FROM evm.logs
+WHERE id > $1 AND event_sig = ANY($2) AND ($3::numeric IS NULL OR evm_chain_id = $3) AND ($4::bytea IS NULL OR address = $4)
+ORDER BY id LIMIT $5`, lastID, pq.ByteaArray(sigs), chainID, address, pageSize)
+		if err != nil {
+			return n, fmt.Errorf("failed to load CCIP logs: %w", err)
+		}
+		for _, r := range rows {
+			lastID = r.ID
+			l := types.Log{Address: r.Address, Data: r.Data, TxHash: r.TxHash}
+			for _, topic := range r.Topics {
+				l.Topics = append(l.Topics, common.BytesToHash(topic))
+			}
+			event := events[r.EventSig]
+			seqNr, messageID, state, err2 := event.decode(l)
+			if err2 != nil {
+				return n, fmt.Errorf("failed to decode %s log %d: %w", event.name, r.ID, err2)
+			}
+			if err = w.Write([]string{r.EVMChainID, strconv.FormatInt(r.BlockNumber, 10), r.BlockTimestamp.UTC().Format(time.RFC3339Nano),
+				r.TxHash.Hex(), r.Address.Hex(), event.name, strconv.FormatUint(seqNr, 10), hexutil.Encode(messageID[:]), state}); err != nil {
+				return n, err
+			}
+			n++
+		}
+		if len(rows) < pageSize {
+			return n, nil
+		}
+	}
+}
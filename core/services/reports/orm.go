@@ -0,0 +1,207 @@
+package reports
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+)
+
+// ErrNotFound is returned when a report doesn't exist.
+var ErrNotFound = errors.New("report not found")
+
+// ORM stores the reports and their results.
+type ORM interface {
+	// CreateReport queues a report.
+	CreateReport(ctx context.Context, typ Type, params Params) (Report, error)
+	FindReport(ctx context.Context, id int64) (Report, error)
+	// ListReports returns a page of the reports, newest first, and the total number of reports.
+	ListReports(ctx context.Context, offset, limit int) ([]Report, int, error)
+	// DeleteReport deletes a report and its result.
+	DeleteReport(ctx context.Context, id int64) error
+	// ReportResult returns the CSV result of a completed report.
+	ReportResult(ctx context.Context, id int64) ([]byte, error)
+
+	// ClaimNextReport marks the oldest queued report as running and returns it, or returns false if none is queued.
+	ClaimNextReport(ctx context.Context) (Report, bool, error)
+	// CompleteReport stores the result of a running report.
+	CompleteReport(ctx context.Context, id int64, result []byte, rows int64) error
+	// FailReport marks a running report as errored.
+	FailReport(ctx context.Context, id int64, reportErr string) error
+	// RequeueRunningReports queues the reports left running, e.g. by a restart, again.
+	RequeueRunningReports(ctx context.Context) (int64, error)
+	// DeleteReportsFinishedBefore deletes the completed and errored reports which finished before t.
+	DeleteReportsFinishedBefore(ctx context.Context, t time.Time) (int64, error)
+}
+
+type orm struct {
+	ds sqlutil.DataSource
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(ds sqlutil.DataSource) ORM {
+	return &orm{ds: ds}
+}
+
+type dbReport struct {
+	ID         int64       `db:"id"`
+	Type       string      `db:"type"`
+	Params     []byte      `db:"params"`
+	State      string      `db:"state"`
+	Error      null.String `db:"error"`
+	Rows       null.Int    `db:"rows"`
+	CreatedAt  time.Time   `db:"created_at"`
+	StartedAt  null.Time   `db:"started_at"`
+	FinishedAt null.Time   `db:"finished_at"`
+}
+
+func (r dbReport) toReport() (Report, error) {
+	report := Report{
+		ID:         r.ID,
+		Type:       Type(r.Type),
+		State:      State(r.State),
+		Error:      r.Error,
+		Rows:       r.Rows,
+		CreatedAt:  r.CreatedAt,
+		StartedAt:  r.StartedAt,
+		FinishedAt: r.FinishedAt,
+	}
+	if err := json.Unmarshal(r.Params, &report.Params); err != nil {
+		return Report{}, fmt.Errorf("invalid params of report %d: %w", r.ID, err)
+	}
+	return report, nil
+}
+
+// reportColumns excludes the result, which is only loaded by ReportResult.
+const reportColumns = `id, type, params, state, error, rows, created_at, started_at, finished_at`
+
+func (o *orm) CreateReport(ctx context.Context, typ Type, params Params) (Report, error) {
+	b, err := json.Marshal(params)
+	if err != nil {
+		return Report{}, err
+	}
+	var row dbReport
+	err = o.ds.GetContext(ctx, &row, `INSERT INTO report_jobs (type, params, state, created_at)
+VALUES ($1, $2, 'queued', NOW()) RETURNING `+reportColumns, typ, b)
+	if err != nil {
+		return Report{}, fmt.Errorf("failed to create report: %w", err)
+	}
+	return row.toReport()
+}
+
+func (o *orm) FindReport(ctx context.Context, id int64) (Report, error) {
+	var row dbReport
+	err := o.ds.GetContext(ctx, &row, `SELECT `+reportColumns+` FROM report_jobs WHERE id = $1`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Report{}, ErrNotFound
+	} else if err != nil {
+		return Report{}, fmt.Errorf("failed to load report: %w", err)
+	}
+	return row.toReport()
+}
+
+func (o *orm) ListReports(ctx context.Context, offset, limit int) ([]Report, int, error) {
+	var count int
+	if err := o.ds.GetContext(ctx, &count, `SELECT count(*) FROM report_jobs`); err != nil {
+		return nil, 0, fmt.Errorf("failed to count reports: %w", err)
+	}
+	var rows []dbReport
+	err := o.ds.SelectContext(ctx, &rows, `SELECT `+reportColumns+` FROM report_jobs ORDER BY id DESC OFFSET $1 LIMIT $2`, offset, limit)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to load reports: %w", err)
+	}
+	reports := make([]Report, len(rows))
+	for i, row := range rows {
+		r, err := row.toReport()
+		if err != nil {
+			return nil, 0, err
+		}
+		reports[i] = r
+	}
+	return reports, count, nil
+}
+
+func (o *orm) DeleteReport(ctx context.Context, id int64) error {
+	res, err := o.ds.ExecContext(ctx, `DELETE FROM report_jobs WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete report: %w", err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (o *orm) ReportResult(ctx context.Context, id int64) ([]byte, error) {
+	var row struct {
+		State  string `db:"state"`
+		Result []byte `db:"result"`
+	}
+	err := o.ds.GetContext(ctx, &row, `SELECT state, result FROM report_jobs WHERE id = $1`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to load report result: %w", err)
+	}
+	if State(row.State) != StateCompleted {
+		return nil, fmt.Errorf("report %d is %s, not %s", id, row.State, StateCompleted)
+	}
+	return row.Result, nil
+}
+
+func (o *orm) ClaimNextReport(ctx context.Context) (Report, bool, error) {
+	var row dbReport
+	err := o.ds.GetContext(ctx, &row, `UPDATE report_jobs SET state = 'running', started_at = NOW()
+WHERE id = (SELECT id FROM report_jobs WHERE state = 'queued' ORDER BY id LIMIT 1 FOR UPDATE SKIP LOCKED)
+RETURNING `+reportColumns)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Report{}, false, nil
+	} else if err != nil {
+		return Report{}, false, fmt.Errorf("failed to claim report: %w", err)
+	}
+	r, err := row.toReport()
+	return r, err == nil, err
+}
+
+func (o *orm) CompleteReport(ctx context.Context, id int64, result []byte, rows int64) error {
+	_, err := o.ds.ExecContext(ctx, `UPDATE report_jobs SET state = 'completed', result = $2, rows = $3, finished_at = NOW()
+WHERE id = $1 AND state = 'running'`, id, result, rows)
+	if err != nil {
+		return fmt.Errorf("failed to complete report: %w", err)
+	}
+	return nil
+}
+
+func (o *orm) FailReport(ctx context.Context, id int64, reportErr string) error {
+	_, err := o.ds.ExecContext(ctx, `UPDATE report_jobs SET state = 'errored', error = $2, finished_at = NOW()
+WHERE id = $1 AND state = 'running'`, id, reportErr)
+	if err != nil {
+		return fmt.Errorf("failed to fail report: %w", err)
+	}
+	return nil
+}
+
+func (o *orm) RequeueRunningReports(ctx context.Context) (int64, error) {
+	res, err := o.ds.ExecContext(ctx, `UPDATE report_jobs SET state = 'queued', started_at = NULL WHERE state = 'running'`)
+	if err != nil {
+		return 0, fmt.Errorf("failed to requeue reports: %w", err)
+	}
+	return res.RowsAffected()
+}
+
+func (o *orm) DeleteReportsFinishedBefore(ctx context.Context, t time.Time) (int64, error) {
+	res, err := o.ds.ExecContext(ctx, `DELETE FROM report_jobs WHERE state IN ('completed', 'errored') AND finished_at < $1`, t)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete reports: %w", err)
+	}
+	return res.RowsAffected()
+}
@@ -0,0 +1,77 @@
+// Package reports generates the results of expensive queries in the background, so that they can be downloaded once
+// ready instead of timing out HTTP handlers.
+package reports
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+)
+
+// Type is the type of a report.
+type Type string
+
+const (
+	// TypeEthTransactions is the history of the EVM transactions, optionally of a chain and a from address.
+	TypeEthTransactions Type = "eth_transactions"
+	// TypePipelineRuns is the history of the pipeline runs of a job.
+	TypePipelineRuns Type = "pipeline_runs"
+	// TypeCCIPMessages is the history of the CCIP messages sent and executed, from the logs of the on and off ramps,
+	// optionally of a chain and a ramp address.
+	TypeCCIPMessages Type = "ccip_messages"
+)
+
+// State is the state of a report.
+type State string
+
+const (
+	StateQueued    State = "queued"
+	StateRunning   State = "running"
+	StateCompleted State = "completed"
+	StateErrored   State = "errored"
+)
+
+// Params are the parameters of a report, the ones supported depend on its type.
+type Params struct {
+	EVMChainID *big.Big        `json:"evmChainID,omitempty"`
+	Address    *common.Address `json:"address,omitempty"`
+	JobID      int32           `json:"jobID,omitempty"`
+}
+
+// Validate returns an error if the params aren't supported by the report type.
+func (p Params) Validate(typ Type) error {
+	switch typ {
+	case TypeEthTransactions, TypeCCIPMessages:
+		if p.JobID != 0 {
+			return fmt.Errorf("jobID is not supported by %s reports", typ)
+		}
+	case TypePipelineRuns:
+		if p.JobID == 0 {
+			return errors.New("jobID is required")
+		}
+		if p.EVMChainID != nil || p.Address != nil {
+			return fmt.Errorf("only jobID is supported by %s reports", typ)
+		}
+	default:
+		return fmt.Errorf("unknown report type %q, must be one of %s, %s or %s", typ, TypeEthTransactions, TypePipelineRuns, TypeCCIPMessages)
+	}
+	return nil
+}
+
+// Report is a report generated in the background. The CSV result of completed reports is loaded separately.
+type Report struct {
+	ID         int64
+	Type       Type
+	Params     Params
+	State      State
+	Error      null.String
+	Rows       null.Int
+	CreatedAt  time.Time
+	StartedAt  null.Time
+	FinishedAt null.Time
+}
@@ -0,0 +1,186 @@
+package reports
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+)
+
+const (
+	// pollInterval is the interval between checks for queued reports, which are also picked up as soon as they are
+	// submitted to the Runner.
+	pollInterval = 30 * time.Second
+	// reportTimeout bounds the time to generate a report.
+	reportTimeout = time.Hour
+	// maxResultSize bounds the size of the result of a report, to keep it within the limits of the database.
+	maxResultSize = 256 << 20
+	// retention is how long the reports are kept once finished.
+	retention = 7 * 24 * time.Hour
+)
+
+var promReportsFinished = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "report_jobs_finished_total",
+	Help: "The total number of background reports generated, by type and final state",
+}, []string{"type", "state"})
+
+// Runner generates the queued reports in the background, one at a time so that expensive queries don't pile up on the
+// database. Reports left running by a restart are generated again, and finished reports are deleted after a week.
+type Runner struct {
+	services.Service
+	eng *services.Engine
+
+	orm        ORM
+	ds         sqlutil.DataSource
+	generators map[Type]generator
+	wake       chan struct{}
+}
+
+func NewRunner(lggr logger.Logger, ds sqlutil.DataSource) *Runner {
+	r := &Runner{
+		orm:        NewORM(ds),
+		ds:         ds,
+		generators: generators,
+		wake:       make(chan struct{}, 1),
+	}
+	r.Service, r.eng = services.Config{
+		Name:  "ReportRunner",
+		Start: r.start,
+	}.NewServiceEngine(lggr)
+	return r
+}
+
+// ORM returns the ORM of the reports.
+func (r *Runner) ORM() ORM {
+	return r.orm
+}
+
+// Submit validates and queues a report, to be generated in the background.
+func (r *Runner) Submit(ctx context.Context, typ Type, params Params) (Report, error) {
+	if err := params.Validate(typ); err != nil {
+		return Report{}, err
+	}
+	report, err := r.orm.CreateReport(ctx, typ, params)
+	if err != nil {
+		return Report{}, err
+	}
+	select {
+	case r.wake <- struct{}{}:
+	default:
+	}
+	return report, nil
+}
+
+func (r *Runner) start(ctx context.Context) error {
+	if n, err := r.orm.RequeueRunningReports(ctx); err != nil {
+		return err
+	} else if n > 0 {
+		r.eng.Infow("Requeued reports interrupted by a restart", "count", n)
+	}
+	r.eng.Go(func(ctx context.Context) {
+		ticker := services.NewTicker(pollInterval)
+		defer ticker.Stop()
+		for {
+			r.runQueued(ctx)
+			r.deleteExpired(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			case <-r.wake:
+			}
+		}
+	})
+	return nil
+}
+
+// runQueued generates the queued reports until none is left.
+func (r *Runner) runQueued(ctx context.Context) {
+	for ctx.Err() == nil {
+		report, ok, err := r.orm.ClaimNextReport(ctx)
+		if err != nil {
+			r.eng.Errorw("Failed to claim report", "err", err)
+			return
+		} else if !ok {
+			return
+		}
+		r.run(ctx, report)
+	}
+}
+
+func (r *Runner) run(ctx context.Context, report Report) {
+	lggr := logger.With(r.eng, "reportID", report.ID, "type", report.Type)
+	lggr.Infow("Generating report", "params", report.Params)
+	start := time.Now()
+
+	result, rows, err := r.generate(ctx, report)
+	if err != nil {
+		if ctx.Err() != nil {
+			// shutting down, the report is requeued on the next start
+			return
+		}
+		lggr.Errorw("Failed to generate report", "err", err)
+		promReportsFinished.WithLabelValues(string(report.Type), string(StateErrored)).Inc()
+		if err = r.orm.FailReport(ctx, report.ID, err.Error()); err != nil {
+			lggr.Errorw("Failed to save report error", "err", err)
+		}
+		return
+	}
+	if err = r.orm.CompleteReport(ctx, report.ID, result, rows); err != nil {
+		lggr.Errorw("Failed to save report", "err", err)
+		return
+	}
+	promReportsFinished.WithLabelValues(string(report.Type), string(StateCompleted)).Inc()
+	lggr.Infow("Generated report", "rows", rows, "bytes", len(result), "elapsed", time.Since(start))
+}
+
+func (r *Runner) generate(ctx context.Context, report Report) ([]byte, int64, error) {
+	gen, ok := r.generators[report.Type]
+	if !ok {
+		return nil, 0, fmt.Errorf("unknown report type %q", report.Type)
+	}
+	ctx, cancel := context.WithTimeout(ctx, reportTimeout)
+	defer cancel()
+
+	var b bytes.Buffer
+	w := csv.NewWriter(&limitedWriter{w: &b, n: maxResultSize})
+	rows, err := gen(ctx, r.ds, report.Params, w)
+	if err != nil {
+		return nil, 0, err
+	}
+	w.Flush()
+	if err = w.Error(); err != nil {
+		return nil, 0, err
+	}
+	return b.Bytes(), rows, nil
+}
+
+func (r *Runner) deleteExpired(ctx context.Context) {
+	n, err := r.orm.DeleteReportsFinishedBefore(ctx, time.Now().Add(-retention))
+	if err != nil {
+		r.eng.Errorw("Failed to delete expired reports", "err", err)
+	} else if n > 0 {
+		r.eng.Debugw("Deleted expired reports", "count", n)
+	}
+}
+
+// limitedWriter fails once more than n bytes are written.
+type limitedWriter struct {
+	w *bytes.Buffer
+	n int
+}
+
+func (l *limitedWriter) Write(p []byte) (int, error) {
+	if l.w.Len()+len(p) > l.n {
+		return 0, fmt.Errorf("report exceeds the maximum size of %d bytes, narrow it down with its params", l.n)
+	}
+	return l.w.Write(p)
+}
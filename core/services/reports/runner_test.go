@@ -0,0 +1,154 @@
+package reports_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/services/reports"
+)
+
+func TestParams_Validate(t *testing.T) {
+	address := common.HexToAddress("0x1")
+	tests := []struct {
+		name   string
+		typ    reports.Type
+		params reports.Params
+		errMsg string
+	}{
+		{"all transactions", reports.TypeEthTransactions, reports.Params{}, ""},
+		{"transactions of an address", reports.TypeEthTransactions, reports.Params{EVMChainID: big.NewI(1), Address: &address}, ""},
+		{"transactions of a job", reports.TypeEthTransactions, reports.Params{JobID: 1}, "jobID is not supported by eth_transactions reports"},
+		{"pipeline runs", reports.TypePipelineRuns, reports.Params{JobID: 1}, ""},
+		{"pipeline runs without job", reports.TypePipelineRuns, reports.Params{}, "jobID is required"},
+		{"pipeline runs of a chain", reports.TypePipelineRuns, reports.Params{JobID: 1, EVMChainID: big.NewI(1)}, "only jobID is supported by pipeline_runs reports"},
+		{"ccip messages", reports.TypeCCIPMessages, reports.Params{EVMChainID: big.NewI(1)}, ""},
+		{"unknown", "logs", reports.Params{}, `unknown report type "logs", must be one of eth_transactions, pipeline_runs or ccip_messages`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.params.Validate(tt.typ)
+			if tt.errMsg == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tt.errMsg)
+		})
+	}
+}
+
+func waitForReport(t *testing.T, orm reports.ORM, id int64) reports.Report {
+	var report reports.Report
+	require.Eventually(t, func() bool {
+		var err error
+		report, err = orm.FindReport(testutils.Context(t), id)
+		require.NoError(t, err)
+		return report.State == reports.StateCompleted || report.State == reports.StateErrored
+	}, testutils.WaitTimeout(t), 100*time.Millisecond)
+	return report
+}
+
+func TestRunner(t *testing.T) {
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	jb, _ := cltest.MustInsertWebhookSpec(t, db)
+	completed := cltest.MustInsertPipelineRunWithStatus(t, db, jb.PipelineSpecID, pipeline.RunStatusCompleted, jb.ID)
+	errored := cltest.MustInsertPipelineRunWithStatus(t, db, jb.PipelineSpecID, pipeline.RunStatusErrored, jb.ID)
+
+	r := reports.NewRunner(logger.TestLogger(t), db)
+	servicetest.Run(t, r)
+	orm := r.ORM()
+
+	_, err := r.Submit(ctx, reports.TypePipelineRuns, reports.Params{})
+	require.EqualError(t, err, "jobID is required")
+
+	t.Run("pipeline runs", func(t *testing.T) {
+		report, err := r.Submit(ctx, reports.TypePipelineRuns, reports.Params{JobID: jb.ID})
+		require.NoError(t, err)
+		assert.Equal(t, reports.StateQueued, report.State)
+
+		report = waitForReport(t, orm, report.ID)
+		require.Equal(t, reports.StateCompleted, report.State, report.Error.String)
+		assert.Equal(t, int64(2), report.Rows.Int64)
+
+		result, err := orm.ReportResult(ctx, report.ID)
+		require.NoError(t, err)
+		lines := strings.Split(strings.TrimSpace(string(result)), "\n")
+		require.Len(t, lines, 3)
+		assert.Equal(t, "id,job_id,state,created_at,finished_at,outputs,all_errors,fatal_errors", lines[0])
+		assert.True(t, strings.HasPrefix(lines[1], strconv.FormatInt(completed.ID, 10)+","+strconv.Itoa(int(jb.ID))+",completed,"))
+		assert.True(t, strings.HasPrefix(lines[2], strconv.FormatInt(errored.ID, 10)+","+strconv.Itoa(int(jb.ID))+",errored,"))
+	})
+
+	t.Run("empty reports", func(t *testing.T) {
+		address := common.HexToAddress("0x1")
+		for _, typ := range []reports.Type{reports.TypeEthTransactions, reports.TypeCCIPMessages} {
+			report, err := r.Submit(ctx, typ, reports.Params{EVMChainID: big.NewI(1), Address: &address})
+			require.NoError(t, err)
+			report = waitForReport(t, orm, report.ID)
+			require.Equal(t, reports.StateCompleted, report.State, report.Error.String)
+			assert.Equal(t, int64(0), report.Rows.Int64)
+		}
+	})
+
+	t.Run("lists and deletes reports", func(t *testing.T) {
+		rs, count, err := orm.ListReports(ctx, 0, 2)
+		require.NoError(t, err)
+		assert.Equal(t, 3, count)
+		require.Len(t, rs, 2)
+		assert.Greater(t, rs[0].ID, rs[1].ID)
+
+		require.NoError(t, orm.DeleteReport(ctx, rs[0].ID))
+		_, err = orm.FindReport(ctx, rs[0].ID)
+		require.ErrorIs(t, err, reports.ErrNotFound)
+		require.ErrorIs(t, orm.DeleteReport(ctx, rs[0].ID), reports.ErrNotFound)
+	})
+}
+
+func TestORM_RequeueRunningReports(t *testing.T) {
+	ctx := testutils.Context(t)
+	orm := reports.NewORM(pgtest.NewSqlxDB(t))
+
+	report, err := orm.CreateReport(ctx, reports.TypePipelineRuns, reports.Params{JobID: 1})
+	require.NoError(t, err)
+	claimed, ok, err := orm.ClaimNextReport(ctx)
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, report.ID, claimed.ID)
+	assert.Equal(t, reports.StateRunning, claimed.State)
+	_, ok, err = orm.ClaimNextReport(ctx)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	_, err = orm.ReportResult(ctx, report.ID)
+	require.Error(t, err)
+
+	n, err := orm.RequeueRunningReports(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), n)
+	claimed, ok, err = orm.ClaimNextReport(ctx)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	require.NoError(t, orm.FailReport(ctx, claimed.ID, "boom"))
+	failed, err := orm.FindReport(ctx, report.ID)
+	require.NoError(t, err)
+	assert.Equal(t, reports.StateErrored, failed.State)
+	assert.Equal(t, "boom", failed.Error.String)
+
+	n, err = orm.DeleteReportsFinishedBefore(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), n)
+}
@@ -19,8 +19,21 @@ func NewTestTelemetryIngressClient(t *testing.T, url *url.URL, serverPubKeyHex s
 
 // NewTestTelemetryIngressBatchClient calls NewTelemetryIngressBatchClient and injects telemClient.
 func NewTestTelemetryIngressBatchClient(t *testing.T, url *url.URL, serverPubKeyHex string, ks keystore.CSA, logging bool, telemClient telemPb.TelemClient, sendInterval time.Duration, uniconn bool) TelemetryService {
-	tc := NewTelemetryIngressBatchClient(url, serverPubKeyHex, ks, logging, logger.TestLogger(t), 100, 50, sendInterval, time.Second, uniconn)
+	tc := NewTelemetryIngressBatchClient(url, serverPubKeyHex, ks, logging, logger.TestLogger(t), 100, 50, sendInterval, time.Second, uniconn, nil)
 	tc.(*telemetryIngressBatchClient).closeFn = func() error { return nil }
 	tc.(*telemetryIngressBatchClient).telemClient = telemClient
 	return tc
 }
+
+// NewTestBufferedTelemetryIngressBatchClient calls NewTelemetryIngressBatchClient with buffer and injects telemClient.
+func NewTestBufferedTelemetryIngressBatchClient(t *testing.T, ks keystore.CSA, telemClient telemPb.TelemClient, sendInterval time.Duration, buffer *TelemetryDiskBuffer) TelemetryService {
+	tc := NewTelemetryIngressBatchClient(&url.URL{}, "33333333333", ks, false, logger.TestLogger(t), 100, 50, sendInterval, time.Second, false, buffer)
+	tc.(*telemetryIngressBatchClient).closeFn = func() error { return nil }
+	tc.(*telemetryIngressBatchClient).telemClient = telemClient
+	return tc
+}
+
+// SetTelemetryDiskBufferNow overrides the clock of b.
+func SetTelemetryDiskBufferNow(b *TelemetryDiskBuffer, now func() time.Time) {
+	b.now = now
+}
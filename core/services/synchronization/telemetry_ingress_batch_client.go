@@ -11,6 +11,7 @@ import (
 
 	"github.com/smartcontractkit/wsrpc"
 	"github.com/smartcontractkit/wsrpc/examples/simple/keys"
+	"golang.org/x/time/rate"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
@@ -60,11 +61,15 @@ type telemetryIngressBatchClient struct {
 	workersMutex sync.Mutex
 
 	useUniConn bool
+
+	buffer        *TelemetryDiskBuffer // optional
+	replayLimiter *rate.Limiter
 }
 
 // NewTelemetryIngressBatchClient returns a client backed by wsrpc that
-// can send telemetry to the telemetry ingress server
-func NewTelemetryIngressBatchClient(url *url.URL, serverPubKeyHex string, ks keystore.CSA, logging bool, lggr logger.Logger, telemBufferSize uint, telemMaxBatchSize uint, telemSendInterval time.Duration, telemSendTimeout time.Duration, useUniconn bool) TelemetryService {
+// can send telemetry to the telemetry ingress server. If buffer is not nil,
+// telemetry which cannot be sent is persisted to it, and replayed later.
+func NewTelemetryIngressBatchClient(url *url.URL, serverPubKeyHex string, ks keystore.CSA, logging bool, lggr logger.Logger, telemBufferSize uint, telemMaxBatchSize uint, telemSendInterval time.Duration, telemSendTimeout time.Duration, useUniconn bool, buffer *TelemetryDiskBuffer) TelemetryService {
 	c := &telemetryIngressBatchClient{
 		telemBufferSize:   telemBufferSize,
 		telemMaxBatchSize: telemMaxBatchSize,
@@ -76,6 +81,10 @@ func NewTelemetryIngressBatchClient(url *url.URL, serverPubKeyHex string, ks key
 		logging:           logging,
 		workers:           make(map[string]*telemetryIngressBatchWorker),
 		useUniConn:        useUniconn,
+		buffer:            buffer,
+	}
+	if buffer != nil {
+		c.replayLimiter = rate.NewLimiter(rate.Limit(buffer.ReplayRate()), 1)
 	}
 	c.Service, c.eng = services.Config{
 		Name:  "TelemetryIngressBatchClient",
@@ -130,9 +139,53 @@ func (tc *telemetryIngressBatchClient) start(ctx context.Context) error {
 		}
 	}
 
+	if tc.buffer != nil {
+		tc.eng.GoTick(timeutil.NewTicker(func() time.Duration {
+			return tc.telemSendInterval
+		}), tc.replay)
+	}
+
 	return nil
 }
 
+// replay sends buffered telemetry, oldest first and rate limited, until the buffer is empty or a send fails.
+func (tc *telemetryIngressBatchClient) replay(ctx context.Context) {
+	if tc.useUniConn && !tc.connected.Load() {
+		return
+	}
+	for {
+		req, name, ok, err := tc.buffer.Oldest()
+		if err != nil {
+			tc.eng.Errorw("Dropping unreadable buffered telemetry", "err", err)
+			if err = tc.buffer.Remove(name); err != nil {
+				tc.eng.Errorw("Failed to remove buffered telemetry", "err", err)
+				return
+			}
+			continue
+		}
+		if !ok {
+			return
+		}
+		if err = tc.replayLimiter.Wait(ctx); err != nil {
+			return
+		}
+		sendCtx, cancel := context.WithTimeout(ctx, tc.telemSendTimeout)
+		_, err = tc.telemClient.TelemBatch(sendCtx, req)
+		cancel()
+		if err != nil {
+			tc.eng.Debugw("Could not replay buffered telemetry, retrying later", "err", err, "buffered", tc.buffer.Len())
+			return
+		}
+		if err = tc.buffer.Remove(name); err != nil {
+			tc.eng.Errorw("Failed to remove replayed telemetry", "err", err)
+			return
+		}
+		if tc.buffer.Len() == 0 {
+			tc.eng.Infow("Finished replaying buffered telemetry")
+		}
+	}
+}
+
 // Close disconnects the wsrpc client from the ingress server and waits for all workers to exit
 func (tc *telemetryIngressBatchClient) close() error {
 	if (tc.useUniConn && tc.connected.Load()) || !tc.useUniConn {
@@ -159,6 +212,17 @@ func (tc *telemetryIngressBatchClient) getCSAPrivateKey() (privkey []byte, err e
 // and a warning is logged.
 func (tc *telemetryIngressBatchClient) Send(ctx context.Context, telemData []byte, contractID string, telemType TelemetryType) {
 	if tc.useUniConn && !tc.connected.Load() {
+		if tc.buffer != nil {
+			if err := tc.buffer.Add(&telemPb.TelemBatchRequest{
+				ContractId:    contractID,
+				TelemetryType: string(telemType),
+				Telemetry:     [][]byte{telemData},
+				SentAt:        time.Now().UnixNano(),
+			}); err != nil {
+				tc.eng.Errorw("Failed to buffer telemetry, dropping message", "err", err, "endpoint", tc.url.String())
+			}
+			return
+		}
 		tc.eng.Warnw("not connected to telemetry endpoint", "endpoint", tc.url.String())
 		return
 	}
@@ -197,6 +261,7 @@ func (tc *telemetryIngressBatchClient) findOrCreateWorker(payload TelemPayload)
 			payload.TelemType,
 			tc.eng,
 			tc.logging,
+			tc.buffer,
 		)
 		tc.eng.GoTick(timeutil.NewTicker(func() time.Duration {
 			return tc.telemSendInterval
@@ -25,6 +25,7 @@ type telemetryIngressBatchWorker struct {
 	logging           bool
 	lggr              logger.Logger
 	dropMessageCount  atomic.Uint32
+	buffer            *TelemetryDiskBuffer // optional
 }
 
 // NewTelemetryIngressBatchWorker returns a worker for a given contractID that can send
@@ -38,6 +39,7 @@ func NewTelemetryIngressBatchWorker(
 	telemType TelemetryType,
 	lggr logger.Logger,
 	logging bool,
+	buffer *TelemetryDiskBuffer,
 ) *telemetryIngressBatchWorker {
 	return &telemetryIngressBatchWorker{
 		telemSendTimeout:  telemSendTimeout,
@@ -48,6 +50,7 @@ func NewTelemetryIngressBatchWorker(
 		telemType:         telemType,
 		logging:           logging,
 		lggr:              logger.Named(lggr, "TelemetryIngressBatchWorker"),
+		buffer:            buffer,
 	}
 }
 
@@ -65,6 +68,7 @@ func (tw *telemetryIngressBatchWorker) Send(ctx context.Context) {
 
 	if err != nil {
 		tw.lggr.Warnf("Could not send telemetry: %v", err)
+		tw.bufferBatch(telemBatchReq)
 		return
 	}
 	if tw.logging {
@@ -72,6 +76,16 @@ func (tw *telemetryIngressBatchWorker) Send(ctx context.Context) {
 	}
 }
 
+// bufferBatch persists a batch which could not be sent, to be replayed later, if the disk buffer is enabled.
+func (tw *telemetryIngressBatchWorker) bufferBatch(req *telemPb.TelemBatchRequest) {
+	if tw.buffer == nil {
+		return
+	}
+	if err := tw.buffer.Add(req); err != nil {
+		tw.lggr.Errorw("Failed to buffer telemetry, dropping batch", "err", err, "contractID", req.ContractId, "telemType", req.TelemetryType)
+	}
+}
+
 // logBufferFullWithExpBackoff logs messages at
 // 1
 // 2
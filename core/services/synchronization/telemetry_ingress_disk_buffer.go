@@ -0,0 +1,206 @@
+package synchronization
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	telemPb "github.com/smartcontractkit/chainlink/v2/core/services/synchronization/telem"
+)
+
+const diskBufferExt = ".pb"
+
+// TelemetryDiskBuffer persists telemetry batches which could not be sent to the ingress server, one file per batch,
+// so that they can be replayed once the server is reachable again. The oldest batches are evicted to keep the buffer
+// within its max size, and batches older than the max age are evicted.
+type TelemetryDiskBuffer struct {
+	dir        string
+	maxSize    int64
+	maxAge     time.Duration
+	replayRate uint
+	lggr       logger.Logger
+	now        func() time.Time
+
+	mu      sync.Mutex
+	entries []diskBufferEntry // oldest first
+	size    int64
+	seq     uint64
+}
+
+type diskBufferEntry struct {
+	name string
+	size int64
+	at   time.Time
+}
+
+// NewTelemetryDiskBuffer returns a TelemetryDiskBuffer in dir, which is created if necessary. Batches left in dir by
+// a previous run are kept for replay.
+func NewTelemetryDiskBuffer(dir string, maxSize int64, maxAge time.Duration, replayRate uint, lggr logger.Logger) (*TelemetryDiskBuffer, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create telemetry buffer directory: %w", err)
+	}
+	b := &TelemetryDiskBuffer{
+		dir:        dir,
+		maxSize:    maxSize,
+		maxAge:     maxAge,
+		replayRate: replayRate,
+		lggr:       logger.Named(lggr, "TelemetryDiskBuffer"),
+		now:        time.Now,
+	}
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read telemetry buffer directory: %w", err)
+	}
+	for _, f := range files {
+		at, ok := parseDiskBufferName(f.Name())
+		if !ok || f.IsDir() {
+			continue
+		}
+		info, err := f.Info()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read buffered telemetry %s: %w", f.Name(), err)
+		}
+		b.entries = append(b.entries, diskBufferEntry{name: f.Name(), size: info.Size(), at: at})
+		b.size += info.Size()
+	}
+	// names sort by creation time
+	slices.SortFunc(b.entries, func(a, b diskBufferEntry) int { return strings.Compare(a.name, b.name) })
+	b.mu.Lock()
+	b.evict()
+	b.mu.Unlock()
+	if n := len(b.entries); n > 0 {
+		b.lggr.Infow("Found buffered telemetry to replay", "batches", n, "bytes", b.size)
+	}
+	return b, nil
+}
+
+func parseDiskBufferName(name string) (time.Time, bool) {
+	base, ok := strings.CutSuffix(name, diskBufferExt)
+	if !ok {
+		return time.Time{}, false
+	}
+	ts, _, ok := strings.Cut(base, "-")
+	if !ok {
+		return time.Time{}, false
+	}
+	nanos, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(0, nanos), true
+}
+
+// Add persists a batch, evicting the oldest batches if the buffer is full.
+func (b *TelemetryDiskBuffer) Add(req *telemPb.TelemBatchRequest) error {
+	data, err := proto.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("failed to marshal telemetry batch: %w", err)
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if int64(len(data)) > b.maxSize {
+		return fmt.Errorf("telemetry batch of %d bytes exceeds the buffer max size of %d bytes", len(data), b.maxSize)
+	}
+	now := b.now()
+	b.seq++
+	name := fmt.Sprintf("%020d-%06d%s", now.UnixNano(), b.seq%1_000_000, diskBufferExt)
+	tmp := filepath.Join(b.dir, name+".tmp")
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return fmt.Errorf("failed to write telemetry batch: %w", err)
+	}
+	if err := os.Rename(tmp, filepath.Join(b.dir, name)); err != nil {
+		return fmt.Errorf("failed to write telemetry batch: %w", err)
+	}
+	b.entries = append(b.entries, diskBufferEntry{name: name, size: int64(len(data)), at: now})
+	b.size += int64(len(data))
+	b.evict()
+	return nil
+}
+
+// Oldest returns the oldest batch, and its name for Remove. It returns false if the buffer is empty.
+func (b *TelemetryDiskBuffer) Oldest() (*telemPb.TelemBatchRequest, string, bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.evict()
+	if len(b.entries) == 0 {
+		return nil, "", false, nil
+	}
+	e := b.entries[0]
+	data, err := os.ReadFile(filepath.Join(b.dir, e.name))
+	if err != nil {
+		return nil, e.name, false, fmt.Errorf("failed to read buffered telemetry %s: %w", e.name, err)
+	}
+	var req telemPb.TelemBatchRequest
+	if err := proto.Unmarshal(data, &req); err != nil {
+		return nil, e.name, false, fmt.Errorf("failed to unmarshal buffered telemetry %s: %w", e.name, err)
+	}
+	return &req, e.name, true, nil
+}
+
+// Remove deletes a batch, once it has been replayed, or if it cannot be read.
+func (b *TelemetryDiskBuffer) Remove(name string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	i := slices.IndexFunc(b.entries, func(e diskBufferEntry) bool { return e.name == name })
+	if i < 0 {
+		return nil // already evicted
+	}
+	if err := b.remove(b.entries[i]); err != nil {
+		return err
+	}
+	b.entries = slices.Delete(b.entries, i, i+1)
+	return nil
+}
+
+// Len returns the number of buffered batches.
+func (b *TelemetryDiskBuffer) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.entries)
+}
+
+// ReplayRate returns the maximum number of batches to replay per second.
+func (b *TelemetryDiskBuffer) ReplayRate() uint {
+	return b.replayRate
+}
+
+// evict removes batches older than maxAge, and then the oldest batches until the buffer is within maxSize.
+// b.mu must be held.
+func (b *TelemetryDiskBuffer) evict() {
+	var evicted int
+	cutoff := b.now().Add(-b.maxAge)
+	for len(b.entries) > 0 {
+		e := b.entries[0]
+		if b.size <= b.maxSize && !e.at.Before(cutoff) {
+			break
+		}
+		if err := b.remove(e); err != nil {
+			b.lggr.Errorw("Failed to evict buffered telemetry", "file", e.name, "err", err)
+			break
+		}
+		b.entries = b.entries[1:]
+		evicted++
+	}
+	if evicted > 0 {
+		b.lggr.Warnw("Evicted buffered telemetry", "batches", evicted, "remaining", len(b.entries))
+	}
+}
+
+// remove deletes the file of e. b.mu must be held.
+func (b *TelemetryDiskBuffer) remove(e diskBufferEntry) error {
+	if err := os.Remove(filepath.Join(b.dir, e.name)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	b.size -= e.size
+	return nil
+}
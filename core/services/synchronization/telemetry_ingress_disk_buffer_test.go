@@ -0,0 +1,142 @@
+package synchronization_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/csakey"
+	ksmocks "github.com/smartcontractkit/chainlink/v2/core/services/keystore/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/synchronization"
+	"github.com/smartcontractkit/chainlink/v2/core/services/synchronization/mocks"
+	telemPb "github.com/smartcontractkit/chainlink/v2/core/services/synchronization/telem"
+)
+
+func batch(contractID string, telem ...string) *telemPb.TelemBatchRequest {
+	req := &telemPb.TelemBatchRequest{ContractId: contractID, TelemetryType: string(synchronization.OCR)}
+	for _, s := range telem {
+		req.Telemetry = append(req.Telemetry, []byte(s))
+	}
+	return req
+}
+
+func TestTelemetryDiskBuffer(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	b, err := synchronization.NewTelemetryDiskBuffer(dir, 1024, time.Hour, 10, logger.TestLogger(t))
+	require.NoError(t, err)
+
+	_, _, ok, err := b.Oldest()
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	require.NoError(t, b.Add(batch("0x1", "a")))
+	require.NoError(t, b.Add(batch("0x2", "b", "c")))
+	assert.Equal(t, 2, b.Len())
+
+	req, name, ok, err := b.Oldest()
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, "0x1", req.ContractId)
+	assert.Equal(t, [][]byte{[]byte("a")}, req.Telemetry)
+	require.NoError(t, b.Remove(name))
+
+	// buffered batches survive a restart
+	b, err = synchronization.NewTelemetryDiskBuffer(dir, 1024, time.Hour, 10, logger.TestLogger(t))
+	require.NoError(t, err)
+	assert.Equal(t, 1, b.Len())
+	req, _, ok, err = b.Oldest()
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, "0x2", req.ContractId)
+	assert.Equal(t, [][]byte{[]byte("b"), []byte("c")}, req.Telemetry)
+}
+
+func TestTelemetryDiskBuffer_evict(t *testing.T) {
+	t.Parallel()
+
+	t.Run("max size", func(t *testing.T) {
+		b, err := synchronization.NewTelemetryDiskBuffer(t.TempDir(), 64, time.Hour, 10, logger.TestLogger(t))
+		require.NoError(t, err)
+		for _, id := range []string{"0x1", "0x2", "0x3"} {
+			require.NoError(t, b.Add(batch(id, "0123456789")))
+		}
+		assert.Equal(t, 2, b.Len())
+		req, _, ok, err := b.Oldest()
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.Equal(t, "0x2", req.ContractId)
+
+		assert.Error(t, b.Add(batch("0x4", string(make([]byte, 100)))))
+	})
+
+	t.Run("max age", func(t *testing.T) {
+		dir := t.TempDir()
+		b, err := synchronization.NewTelemetryDiskBuffer(dir, 1024, time.Hour, 10, logger.TestLogger(t))
+		require.NoError(t, err)
+		now := time.Now()
+		synchronization.SetTelemetryDiskBufferNow(b, func() time.Time { return now })
+		require.NoError(t, b.Add(batch("0x1", "a")))
+		now = now.Add(30 * time.Minute)
+		require.NoError(t, b.Add(batch("0x2", "b")))
+		now = now.Add(45 * time.Minute)
+
+		req, _, ok, err := b.Oldest()
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.Equal(t, "0x2", req.ContractId)
+		assert.Equal(t, 1, b.Len())
+		files, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		assert.Len(t, files, 1)
+	})
+}
+
+func TestTelemetryIngressBatchClient_replay(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	telemClient := mocks.NewTelemClient(t)
+	csaKeystore := new(ksmocks.CSA)
+	csaKeystore.On("GetAll").Return([]csakey.KeyV2{cltest.DefaultCSAKey}, nil)
+
+	buffer, err := synchronization.NewTelemetryDiskBuffer(t.TempDir(), 1024, time.Hour, 100, logger.TestLogger(t))
+	require.NoError(t, err)
+
+	// the ingress server is unreachable at first
+	var reachable atomic.Bool
+	var received atomic.Uint32
+	telemClient.On("TelemBatch", mock.Anything, mock.Anything).Return(func(_ context.Context, req *telemPb.TelemBatchRequest) (*telemPb.TelemResponse, error) {
+		if !reachable.Load() {
+			return nil, errors.New("unreachable")
+		}
+		received.Add(uint32(len(req.Telemetry)))
+		return nil, nil
+	})
+
+	sendInterval := 5 * time.Millisecond
+	telemIngressClient := synchronization.NewTestBufferedTelemetryIngressBatchClient(t, csaKeystore, telemClient, sendInterval, buffer)
+
+	servicetest.Run(t, telemIngressClient)
+
+	ctx := testutils.Context(t)
+	telemIngressClient.Send(ctx, []byte("telem 1"), "0x1", synchronization.OCR)
+	g.Eventually(buffer.Len).Should(gomega.Equal(1))
+	telemIngressClient.Send(ctx, []byte("telem 2"), "0x1", synchronization.OCR)
+	g.Eventually(buffer.Len).Should(gomega.Equal(2))
+
+	reachable.Store(true)
+	g.Eventually(buffer.Len).Should(gomega.Equal(0))
+	g.Eventually(received.Load).Should(gomega.Equal(uint32(2)))
+}
@@ -2,6 +2,7 @@ package telemetry
 
 import (
 	"net/url"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -110,7 +111,16 @@ func (m *Manager) newEndpoint(e config.TelemetryIngressEndpoint, lggr logger.Log
 	lggr = logger.Sugared(lggr).Named(e.Network()).Named(e.ChainID())
 	var tClient synchronization.TelemetryService
 	if m.useBatchSend {
-		tClient = synchronization.NewTelemetryIngressBatchClient(e.URL(), e.ServerPubKey(), m.ks, cfg.Logging(), lggr, cfg.BufferSize(), cfg.MaxBatchSize(), cfg.SendInterval(), cfg.SendTimeout(), cfg.UniConn())
+		var buffer *synchronization.TelemetryDiskBuffer
+		if b := cfg.DiskBuffer(); b.Enabled() {
+			dir := filepath.Join(b.Dir(), strings.ToLower(e.Network())+"-"+strings.ToLower(e.ChainID()))
+			var err error
+			buffer, err = synchronization.NewTelemetryDiskBuffer(dir, int64(b.MaxSize()), b.MaxAge(), b.ReplayRate(), lggr)
+			if err != nil {
+				return nil, errors.Wrapf(err, "cannot add telemetry endpoint for network %q and chainID %q", e.Network(), e.ChainID())
+			}
+		}
+		tClient = synchronization.NewTelemetryIngressBatchClient(e.URL(), e.ServerPubKey(), m.ks, cfg.Logging(), lggr, cfg.BufferSize(), cfg.MaxBatchSize(), cfg.SendInterval(), cfg.SendTimeout(), cfg.UniConn(), buffer)
 	} else {
 		tClient = synchronization.NewTelemetryIngressClient(e.URL(), e.ServerPubKey(), m.ks, cfg.Logging(), lggr, cfg.BufferSize())
 	}
@@ -32,6 +32,9 @@ func setupMockConfig(t *testing.T, useBatchSend bool) *mocks.TelemetryIngress {
 	tic.On("SendTimeout").Return(time.Second * 7)
 	tic.On("UniConn").Return(true)
 	tic.On("UseBatchSend").Return(useBatchSend)
+	diskBuffer := mocks.NewTelemetryIngressDiskBuffer(t)
+	diskBuffer.On("Enabled").Return(false).Maybe()
+	tic.On("DiskBuffer").Return(diskBuffer).Maybe()
 
 	return tic
 }
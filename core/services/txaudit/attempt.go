@@ -0,0 +1,115 @@
+package txaudit
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Attempt is a broadcast attempt of a finalized transaction, along with the receipt of the attempt if it was included
+// on chain. Attempts after the first one of a transaction are gas bumps.
+type Attempt struct {
+	EVMChainID  string
+	TxID        int64
+	Nonce       *int64
+	FromAddress common.Address
+	ToAddress   common.Address
+	TxState     string
+	TxError     *string
+	TxCreatedAt time.Time
+
+	AttemptID int64
+	// BumpIndex is 0 for the first attempt of the transaction, and is incremented by each bump.
+	BumpIndex               int64
+	Hash                    common.Hash
+	AttemptState            string
+	TxType                  int64
+	GasPrice                *big.Int
+	GasTipCap               *big.Int
+	GasFeeCap               *big.Int
+	GasLimit                int64
+	BroadcastBeforeBlockNum *int64
+	IsPurgeAttempt          bool
+	AttemptCreatedAt        time.Time
+
+	BlockNumber   *int64
+	BlockHash     *common.Hash
+	GasUsed       *int64
+	ReceiptStatus *int64
+	// EffectiveGasPrice is the price per gas paid by the attempt, or nil if the attempt wasn't included or its receipt
+	// has no effective gas price.
+	EffectiveGasPrice *big.Int
+}
+
+type kind int
+
+const (
+	kindInt64 kind = iota
+	kindString
+	kindBool
+	kindTime
+)
+
+// column is a column of the exported files. value returns an int64, string, bool or time.Time according to kind, or
+// nil if the value is null, which is only allowed for optional columns.
+type column struct {
+	name     string
+	kind     kind
+	optional bool
+	value    func(a *Attempt) any
+}
+
+// columns are the columns of the exported files, in order.
+var columns = []column{
+	{"evm_chain_id", kindString, false, func(a *Attempt) any { return a.EVMChainID }},
+	{"tx_id", kindInt64, false, func(a *Attempt) any { return a.TxID }},
+	{"nonce", kindInt64, true, func(a *Attempt) any { return optInt64(a.Nonce) }},
+	{"from_address", kindString, false, func(a *Attempt) any { return a.FromAddress.Hex() }},
+	{"to_address", kindString, false, func(a *Attempt) any { return a.ToAddress.Hex() }},
+	{"tx_state", kindString, false, func(a *Attempt) any { return a.TxState }},
+	{"tx_error", kindString, true, func(a *Attempt) any {
+		if a.TxError == nil {
+			return nil
+		}
+		return *a.TxError
+	}},
+	{"tx_created_at", kindTime, false, func(a *Attempt) any { return a.TxCreatedAt }},
+	{"attempt_id", kindInt64, false, func(a *Attempt) any { return a.AttemptID }},
+	{"bump_index", kindInt64, false, func(a *Attempt) any { return a.BumpIndex }},
+	{"attempt_hash", kindString, false, func(a *Attempt) any { return a.Hash.Hex() }},
+	{"attempt_state", kindString, false, func(a *Attempt) any { return a.AttemptState }},
+	{"tx_type", kindInt64, false, func(a *Attempt) any { return a.TxType }},
+	{"gas_price_wei", kindString, true, func(a *Attempt) any { return optBig(a.GasPrice) }},
+	{"gas_tip_cap_wei", kindString, true, func(a *Attempt) any { return optBig(a.GasTipCap) }},
+	{"gas_fee_cap_wei", kindString, true, func(a *Attempt) any { return optBig(a.GasFeeCap) }},
+	{"gas_limit", kindInt64, false, func(a *Attempt) any { return a.GasLimit }},
+	{"broadcast_before_block_num", kindInt64, true, func(a *Attempt) any { return optInt64(a.BroadcastBeforeBlockNum) }},
+	{"is_purge_attempt", kindBool, false, func(a *Attempt) any { return a.IsPurgeAttempt }},
+	{"attempt_created_at", kindTime, false, func(a *Attempt) any { return a.AttemptCreatedAt }},
+	{"block_number", kindInt64, true, func(a *Attempt) any { return optInt64(a.BlockNumber) }},
+	{"block_hash", kindString, true, func(a *Attempt) any {
+		if a.BlockHash == nil {
+			return nil
+		}
+		return a.BlockHash.Hex()
+	}},
+	{"gas_used", kindInt64, true, func(a *Attempt) any { return optInt64(a.GasUsed) }},
+	{"receipt_status", kindInt64, true, func(a *Attempt) any { return optInt64(a.ReceiptStatus) }},
+	{"effective_gas_price_wei", kindString, true, func(a *Attempt) any { return optBig(a.EffectiveGasPrice) }},
+}
+
+func optInt64(v *int64) any {
+	if v == nil {
+		return nil
+	}
+	return *v
+}
+
+// optBig renders wei amounts as decimal strings, since they may overflow an int64.
+func optBig(v *big.Int) any {
+	if v == nil {
+		return nil
+	}
+	return v.String()
+}
@@ -0,0 +1,44 @@
+package txaudit
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// WriteCSV writes attempts as CSV, with a header row. Null values are empty, and timestamps are RFC3339 in UTC.
+func WriteCSV(w io.Writer, attempts []Attempt) error {
+	cw := csv.NewWriter(w)
+	record := make([]string, len(columns))
+	for i, c := range columns {
+		record[i] = c.name
+	}
+	if err := cw.Write(record); err != nil {
+		return err
+	}
+	for i := range attempts {
+		for j, c := range columns {
+			switch v := c.value(&attempts[i]).(type) {
+			case nil:
+				record[j] = ""
+			case int64:
+				record[j] = strconv.FormatInt(v, 10)
+			case string:
+				record[j] = v
+			case bool:
+				record[j] = strconv.FormatBool(v)
+			case time.Time:
+				record[j] = v.UTC().Format(time.RFC3339Nano)
+			default:
+				return fmt.Errorf("unexpected value of column %s: %T", c.name, v)
+			}
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
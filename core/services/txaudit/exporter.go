@@ -0,0 +1,208 @@
+package txaudit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+)
+
+const (
+	FormatCSV     = "csv"
+	FormatParquet = "parquet"
+)
+
+const dateLayout = time.DateOnly
+
+// checkInterval is the interval between checks for days to export.
+const checkInterval = 10 * time.Minute
+
+var promAttemptsExported = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "tx_audit_export_attempts_total",
+	Help: "The total number of finalized transaction attempts exported",
+}, []string{"evmChainID"})
+
+// Path returns the path of the export of chainID for the UTC day of date, partitioned by chain and date, e.g.
+// dir/chain_id=1/date=2024-10-01/attempts.parquet.
+func Path(dir string, chainID *big.Int, date time.Time, format string) string {
+	return filepath.Join(dir, "chain_id="+chainID.String(), "date="+date.UTC().Format(dateLayout), "attempts."+format)
+}
+
+// Export writes the attempts of the transactions of chainID, which were finalized within the UTC day of date, to the
+// Path of the export. It returns the path and the number of exported attempts.
+func Export(ctx context.Context, orm ORM, dir string, chainID *big.Int, date time.Time, format string) (string, int, error) {
+	var write func(io.Writer, []Attempt) error
+	switch format {
+	case FormatCSV:
+		write = WriteCSV
+	case FormatParquet:
+		write = WriteParquet
+	default:
+		return "", 0, fmt.Errorf("unsupported format %q, must be %s or %s", format, FormatCSV, FormatParquet)
+	}
+
+	from := startOfDay(date)
+	attempts, err := orm.FinalizedAttempts(ctx, chainID, from, from.AddDate(0, 0, 1))
+	if err != nil {
+		return "", 0, err
+	}
+
+	path := Path(dir, chainID, date, format)
+	if err = os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return "", 0, err
+	}
+	// write to a temporary file first, so that readers never see a partial export
+	f, err := os.CreateTemp(filepath.Dir(path), ".attempts-*")
+	if err != nil {
+		return "", 0, err
+	}
+	defer os.Remove(f.Name())
+	if err = write(f, attempts); err != nil {
+		f.Close()
+		return "", 0, fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	if err = f.Close(); err != nil {
+		return "", 0, err
+	}
+	if err = os.Rename(f.Name(), path); err != nil {
+		return "", 0, err
+	}
+	promAttemptsExported.WithLabelValues(chainID.String()).Add(float64(len(attempts)))
+	return path, len(attempts), nil
+}
+
+func startOfDay(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
+
+// lastCompleteDay returns the latest UTC day which ended at least delay before now.
+func lastCompleteDay(now time.Time, delay time.Duration) time.Time {
+	return startOfDay(now.Add(-delay)).AddDate(0, 0, -1)
+}
+
+// Exporter exports the attempt histories of the transactions finalized each day, for every chain, once the day ended
+// at least delay ago. Transactions are exported with the day they were finalized, so those finalized long after their
+// receipt are not missed. Each chain is exported from the day after its latest export, or from the day of its first
+// finalized transaction, so that days missed while the node was down are backfilled. Days without finalized
+// transactions are exported as empty files, which marks them as done.
+type Exporter struct {
+	services.Service
+	eng *services.Engine
+
+	orm    ORM
+	dir    string
+	format string
+	delay  time.Duration
+}
+
+func NewExporter(lggr logger.Logger, orm ORM, dir, format string, delay time.Duration) *Exporter {
+	e := &Exporter{
+		orm:    orm,
+		dir:    dir,
+		format: format,
+		delay:  delay,
+	}
+	e.Service, e.eng = services.Config{
+		Name:  "TxAuditExporter",
+		Start: e.start,
+	}.NewServiceEngine(lggr)
+	return e
+}
+
+func (e *Exporter) start(context.Context) error {
+	e.eng.Go(func(ctx context.Context) {
+		ticker := services.NewTicker(checkInterval)
+		defer ticker.Stop()
+		for {
+			e.export(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	})
+	return nil
+}
+
+func (e *Exporter) export(ctx context.Context) {
+	last := lastCompleteDay(time.Now(), e.delay)
+	chainIDs, err := e.orm.ChainIDs(ctx, time.Time{}, last.AddDate(0, 0, 1))
+	if err != nil {
+		e.eng.Errorw("Failed to load chains to export", "err", err)
+		return
+	}
+	for _, chainID := range chainIDs {
+		day, err := e.nextDay(ctx, chainID)
+		if err != nil {
+			e.eng.Errorw("Failed to find the next day to export", "evmChainID", chainID, "err", err)
+			continue
+		}
+		for ; !day.After(last) && ctx.Err() == nil; day = day.AddDate(0, 0, 1) {
+			path, n, err := Export(ctx, e.orm, e.dir, chainID, day, e.format)
+			if err != nil {
+				// stop at the first failure, so that the next check retries the day instead of skipping it
+				e.eng.Errorw("Failed to export finalized transaction attempts", "evmChainID", chainID, "date", day.Format(dateLayout), "err", err)
+				break
+			}
+			e.eng.Infow("Exported finalized transaction attempts", "evmChainID", chainID, "date", day.Format(dateLayout), "path", path, "count", n)
+		}
+	}
+}
+
+// nextDay returns the day after the latest export of chainID, or the day of its first finalized transaction if it was
+// never exported.
+func (e *Exporter) nextDay(ctx context.Context, chainID *big.Int) (time.Time, error) {
+	latest, err := latestExport(e.dir, chainID, e.format)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !latest.IsZero() {
+		return latest.AddDate(0, 0, 1), nil
+	}
+	first, err := e.orm.FirstFinalizedAt(ctx, chainID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return startOfDay(first), nil
+}
+
+// latestExport returns the day of the latest export of chainID in dir, or the zero time if there is none.
+func latestExport(dir string, chainID *big.Int, format string) (time.Time, error) {
+	entries, err := os.ReadDir(filepath.Dir(filepath.Dir(Path(dir, chainID, time.Time{}, format))))
+	if errors.Is(err, fs.ErrNotExist) {
+		return time.Time{}, nil
+	} else if err != nil {
+		return time.Time{}, err
+	}
+	var latest time.Time
+	for _, entry := range entries {
+		date, ok := strings.CutPrefix(entry.Name(), "date=")
+		if !entry.IsDir() || !ok {
+			continue
+		}
+		day, err := time.Parse(dateLayout, date)
+		if err != nil || !day.After(latest) {
+			continue
+		}
+		if _, err = os.Stat(Path(dir, chainID, day, format)); errors.Is(err, fs.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return time.Time{}, err
+		}
+		latest = day
+	}
+	return latest, nil
+}
@@ -0,0 +1,196 @@
+package txaudit
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+type stubORM struct {
+	chainIDs  []*big.Int
+	firstAt   time.Time
+	attempts  []Attempt
+	from, to  time.Time
+	exportedN int
+}
+
+func (s *stubORM) FinalizedAttempts(_ context.Context, _ *big.Int, from, to time.Time) ([]Attempt, error) {
+	s.from, s.to = from, to
+	s.exportedN++
+	return s.attempts, nil
+}
+
+func (s *stubORM) ChainIDs(context.Context, time.Time, time.Time) ([]*big.Int, error) {
+	return s.chainIDs, nil
+}
+
+func (s *stubORM) FirstFinalizedAt(context.Context, *big.Int) (time.Time, error) {
+	return s.firstAt, nil
+}
+
+func testAttempts() []Attempt {
+	created := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+	nonce, block, gasUsed, status := int64(7), int64(100), int64(21000), int64(1)
+	blockHash := common.HexToHash("0xb1")
+	return []Attempt{
+		{
+			EVMChainID: "1", TxID: 1, Nonce: &nonce, FromAddress: common.HexToAddress("0xf1"), ToAddress: common.HexToAddress("0xa1"),
+			TxState: "finalized", TxCreatedAt: created, AttemptID: 10, Hash: common.HexToHash("0x10"), AttemptState: "broadcast",
+			TxType: 2, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(100), GasLimit: 50000, AttemptCreatedAt: created,
+		},
+		{
+			EVMChainID: "1", TxID: 1, Nonce: &nonce, FromAddress: common.HexToAddress("0xf1"), ToAddress: common.HexToAddress("0xa1"),
+			TxState: "finalized", TxCreatedAt: created, AttemptID: 11, BumpIndex: 1, Hash: common.HexToHash("0x11"), AttemptState: "broadcast",
+			TxType: 2, GasTipCap: big.NewInt(2), GasFeeCap: big.NewInt(120), GasLimit: 50000, AttemptCreatedAt: created.Add(time.Minute),
+			BlockNumber: &block, BlockHash: &blockHash, GasUsed: &gasUsed, ReceiptStatus: &status, EffectiveGasPrice: big.NewInt(52),
+		},
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	var b strings.Builder
+	require.NoError(t, WriteCSV(&b, testAttempts()))
+
+	addrs := common.HexToAddress("0xf1").Hex() + "," + common.HexToAddress("0xa1").Hex()
+	lines := strings.Split(strings.TrimSpace(b.String()), "\n")
+	require.Len(t, lines, 3)
+	assert.Equal(t, "evm_chain_id,tx_id,nonce,from_address,to_address,tx_state,tx_error,tx_created_at,attempt_id,bump_index,attempt_hash,attempt_state,tx_type,gas_price_wei,gas_tip_cap_wei,gas_fee_cap_wei,gas_limit,broadcast_before_block_num,is_purge_attempt,attempt_created_at,block_number,block_hash,gas_used,receipt_status,effective_gas_price_wei", lines[0])
+	assert.Equal(t, "1,1,7,"+addrs+",finalized,,2024-10-01T12:00:00Z,10,0,0x0000000000000000000000000000000000000000000000000000000000000010,broadcast,2,,1,100,50000,,false,2024-10-01T12:00:00Z,,,,,", lines[1])
+	assert.Equal(t, "1,1,7,"+addrs+",finalized,,2024-10-01T12:00:00Z,11,1,0x0000000000000000000000000000000000000000000000000000000000000011,broadcast,2,,2,120,50000,,false,2024-10-01T12:01:00Z,100,0x00000000000000000000000000000000000000000000000000000000000000b1,21000,1,52", lines[2])
+}
+
+func TestEffectiveGasPrice(t *testing.T) {
+	block := int64(1)
+	legacy := Attempt{BlockNumber: &block, GasPrice: big.NewInt(30)}
+	dynamic := Attempt{BlockNumber: &block, GasTipCap: big.NewInt(2), GasFeeCap: big.NewInt(50)}
+
+	assert.Equal(t, big.NewInt(30), effectiveGasPrice(legacy, nil))
+	assert.Equal(t, big.NewInt(28), effectiveGasPrice(legacy, big.NewInt(28)))
+	assert.Equal(t, big.NewInt(12), effectiveGasPrice(dynamic, big.NewInt(12)))
+	assert.Nil(t, effectiveGasPrice(dynamic, nil))
+	assert.Nil(t, effectiveGasPrice(Attempt{GasPrice: big.NewInt(30)}, big.NewInt(30)))
+}
+
+func TestDBAttempt_toAttempt(t *testing.T) {
+	r := dbAttempt{
+		EVMChainID:        "1",
+		GasTipCap:         sql.NullString{String: "2", Valid: true},
+		GasFeeCap:         sql.NullString{String: "50", Valid: true},
+		BlockNumber:       sql.NullInt64{Int64: 100, Valid: true},
+		GasUsed:           sql.NullString{String: "0x5208", Valid: true},
+		ReceiptStatus:     sql.NullString{String: "0x1", Valid: true},
+		EffectiveGasPrice: sql.NullString{String: "0xc", Valid: true},
+	}
+	a, err := r.toAttempt()
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(12), a.EffectiveGasPrice)
+	assert.Equal(t, int64(21000), *a.GasUsed)
+
+	r.EffectiveGasPrice = sql.NullString{String: "12", Valid: true}
+	_, err = r.toAttempt()
+	require.ErrorContains(t, err, "receipt effective gas price")
+}
+
+func TestLastCompleteDay(t *testing.T) {
+	now := time.Date(2024, 10, 2, 0, 30, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2024, 9, 30, 0, 0, 0, 0, time.UTC), lastCompleteDay(now, time.Hour))
+	assert.Equal(t, time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC), lastCompleteDay(now, 30*time.Minute))
+}
+
+func TestExport(t *testing.T) {
+	ctx := testutils.Context(t)
+	dir := t.TempDir()
+	orm := &stubORM{attempts: testAttempts()}
+	date := time.Date(2024, 10, 1, 15, 0, 0, 0, time.UTC)
+
+	path, n, err := Export(ctx, orm, dir, big.NewInt(1), date, FormatCSV)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, filepath.Join(dir, "chain_id=1", "date=2024-10-01", "attempts.csv"), path)
+	assert.Equal(t, time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC), orm.from)
+	assert.Equal(t, time.Date(2024, 10, 2, 0, 0, 0, 0, time.UTC), orm.to)
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Len(t, strings.Split(strings.TrimSpace(string(b)), "\n"), 3)
+
+	path, n, err = Export(ctx, orm, dir, big.NewInt(1), date, FormatParquet)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, filepath.Join(dir, "chain_id=1", "date=2024-10-01", "attempts.parquet"), path)
+	b, err = os.ReadFile(path)
+	require.NoError(t, err)
+	_, rows := readParquet(t, b)
+	assert.Len(t, rows, 2)
+
+	_, _, err = Export(ctx, orm, dir, big.NewInt(1), date, "json")
+	assert.EqualError(t, err, `unsupported format "json", must be csv or parquet`)
+}
+
+func TestExporter_export(t *testing.T) {
+	ctx := testutils.Context(t)
+	dir := t.TempDir()
+	last := lastCompleteDay(time.Now(), time.Hour)
+	orm := &stubORM{chainIDs: []*big.Int{big.NewInt(1), big.NewInt(10)}, firstAt: last.AddDate(0, 0, -1).Add(time.Hour), attempts: testAttempts()}
+	e := NewExporter(logger.TestLogger(t), orm, dir, FormatParquet, time.Hour)
+
+	// chain 10 was last exported 3 days ago, existing exports are not overwritten
+	existing := Path(dir, big.NewInt(10), last.AddDate(0, 0, -3), FormatParquet)
+	require.NoError(t, os.MkdirAll(filepath.Dir(existing), 0o700))
+	require.NoError(t, os.WriteFile(existing, nil, 0o600))
+
+	e.export(ctx)
+
+	// chain 1 is exported from the day of its first finalized transaction, and the days missed by chain 10 are backfilled
+	for _, day := range []time.Time{last.AddDate(0, 0, -1), last} {
+		info, err := os.Stat(Path(dir, big.NewInt(1), day, FormatParquet))
+		require.NoError(t, err)
+		assert.Positive(t, info.Size())
+	}
+	for _, day := range []time.Time{last.AddDate(0, 0, -2), last.AddDate(0, 0, -1), last} {
+		info, err := os.Stat(Path(dir, big.NewInt(10), day, FormatParquet))
+		require.NoError(t, err)
+		assert.Positive(t, info.Size())
+	}
+	info, err := os.Stat(existing)
+	require.NoError(t, err)
+	assert.Zero(t, info.Size())
+	assert.Equal(t, 5, orm.exportedN)
+
+	// exported days are not exported again
+	e.export(ctx)
+	assert.Equal(t, 5, orm.exportedN)
+}
+
+func TestLatestExport(t *testing.T) {
+	dir := t.TempDir()
+	latest, err := latestExport(dir, big.NewInt(1), FormatCSV)
+	require.NoError(t, err)
+	assert.True(t, latest.IsZero())
+
+	day := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+	for _, path := range []string{
+		Path(dir, big.NewInt(1), day, FormatCSV),
+		// other formats, other chains and partial exports are ignored
+		Path(dir, big.NewInt(1), day.AddDate(0, 0, 1), FormatParquet),
+		Path(dir, big.NewInt(2), day.AddDate(0, 0, 2), FormatCSV),
+		filepath.Join(filepath.Dir(Path(dir, big.NewInt(1), day.AddDate(0, 0, 3), FormatCSV)), ".attempts-1"),
+	} {
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o700))
+		require.NoError(t, os.WriteFile(path, nil, 0o600))
+	}
+	latest, err = latestExport(dir, big.NewInt(1), FormatCSV)
+	require.NoError(t, err)
+	assert.Equal(t, day, latest)
+}
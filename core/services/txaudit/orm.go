@@ -0,0 +1,254 @@
+package txaudit
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+)
+
+// ORM reads the attempt histories of finalized transactions.
+type ORM interface {
+	// FinalizedAttempts returns the attempts of the transactions of chainID finalized within [from, to), ordered by
+	// transaction and attempt.
+	FinalizedAttempts(ctx context.Context, chainID *big.Int, from, to time.Time) ([]Attempt, error)
+	// ChainIDs returns the chains with transactions finalized within [from, to).
+	ChainIDs(ctx context.Context, from, to time.Time) ([]*big.Int, error)
+	// FirstFinalizedAt returns when the first transaction of chainID was finalized, or the zero time if there is none.
+	FirstFinalizedAt(ctx context.Context, chainID *big.Int) (time.Time, error)
+}
+
+type orm struct {
+	ds sqlutil.DataSource
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(ds sqlutil.DataSource) ORM {
+	return &orm{ds: ds}
+}
+
+type dbAttempt struct {
+	EVMChainID  string         `db:"evm_chain_id"`
+	TxID        int64          `db:"tx_id"`
+	Nonce       sql.NullInt64  `db:"nonce"`
+	FromAddress common.Address `db:"from_address"`
+	ToAddress   common.Address `db:"to_address"`
+	TxState     string         `db:"tx_state"`
+	TxError     sql.NullString `db:"tx_error"`
+	TxCreatedAt time.Time      `db:"tx_created_at"`
+
+	AttemptID               int64          `db:"attempt_id"`
+	BumpIndex               int64          `db:"bump_index"`
+	Hash                    common.Hash    `db:"hash"`
+	AttemptState            string         `db:"attempt_state"`
+	TxType                  int64          `db:"tx_type"`
+	GasPrice                sql.NullString `db:"gas_price"`
+	GasTipCap               sql.NullString `db:"gas_tip_cap"`
+	GasFeeCap               sql.NullString `db:"gas_fee_cap"`
+	GasLimit                int64          `db:"gas_limit"`
+	BroadcastBeforeBlockNum sql.NullInt64  `db:"broadcast_before_block_num"`
+	IsPurgeAttempt          bool           `db:"is_purge_attempt"`
+	AttemptCreatedAt        time.Time      `db:"attempt_created_at"`
+
+	BlockNumber   sql.NullInt64  `db:"block_number"`
+	BlockHash     []byte         `db:"block_hash"`
+	GasUsed       sql.NullString `db:"gas_used"`
+	ReceiptStatus sql.NullString `db:"receipt_status"`
+	// EffectiveGasPrice is stored with the receipt by the RPCs which return it.
+	EffectiveGasPrice sql.NullString `db:"effective_gas_price"`
+}
+
+// Several receipts may be stored for the same attempt after a re-org, the one of the highest block is the canonical
+// one since the transaction is finalized.
+const finalizedAttemptsQuery = `
+SELECT
+	txes.evm_chain_id::text AS evm_chain_id,
+	txes.id AS tx_id,
+	txes.nonce,
+	txes.from_address,
+	txes.to_address,
+	txes.state AS tx_state,
+	txes.error AS tx_error,
+	txes.created_at AS tx_created_at,
+	a.id AS attempt_id,
+	ROW_NUMBER() OVER (PARTITION BY txes.id ORDER BY a.id) - 1 AS bump_index,
+	a.hash,
+	a.state AS attempt_state,
+	a.tx_type,
+	a.gas_price::text AS gas_price,
+	a.gas_tip_cap::text AS gas_tip_cap,
+	a.gas_fee_cap::text AS gas_fee_cap,
+	a.chain_specific_gas_limit AS gas_limit,
+	a.broadcast_before_block_num,
+	a.is_purge_attempt,
+	a.created_at AS attempt_created_at,
+	r.block_number,
+	r.block_hash,
+	r.receipt->>'gasUsed' AS gas_used,
+	r.receipt->>'status' AS receipt_status,
+	r.receipt->>'effectiveGasPrice' AS effective_gas_price
+FROM evm.txes
+JOIN evm.tx_attempts a ON a.eth_tx_id = txes.id
+LEFT JOIN LATERAL (
+	SELECT block_number, block_hash, receipt FROM evm.receipts WHERE tx_hash = a.hash ORDER BY block_number DESC LIMIT 1
+) r ON TRUE
+WHERE txes.evm_chain_id = $1 AND txes.state = 'finalized' AND txes.finalized_at >= $2 AND txes.finalized_at < $3
+ORDER BY txes.id, a.id`
+
+func (o *orm) FinalizedAttempts(ctx context.Context, chainID *big.Int, from, to time.Time) ([]Attempt, error) {
+	var rows []dbAttempt
+	if err := o.ds.SelectContext(ctx, &rows, finalizedAttemptsQuery, chainID.String(), from, to); err != nil {
+		return nil, fmt.Errorf("failed to load finalized attempts: %w", err)
+	}
+	attempts := make([]Attempt, len(rows))
+	for i, row := range rows {
+		a, err := row.toAttempt()
+		if err != nil {
+			return nil, fmt.Errorf("invalid attempt %d: %w", row.AttemptID, err)
+		}
+		attempts[i] = a
+	}
+	return attempts, nil
+}
+
+func (o *orm) ChainIDs(ctx context.Context, from, to time.Time) ([]*big.Int, error) {
+	var ids []string
+	err := o.ds.SelectContext(ctx, &ids, `
+SELECT DISTINCT evm_chain_id::text FROM evm.txes
+WHERE state = 'finalized' AND finalized_at >= $1 AND finalized_at < $2
+ORDER BY 1`, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load chain IDs: %w", err)
+	}
+	chainIDs := make([]*big.Int, len(ids))
+	for i, id := range ids {
+		chainID, ok := new(big.Int).SetString(id, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid chain ID: %s", id)
+		}
+		chainIDs[i] = chainID
+	}
+	return chainIDs, nil
+}
+
+func (o *orm) FirstFinalizedAt(ctx context.Context, chainID *big.Int) (time.Time, error) {
+	var first sql.NullTime
+	err := o.ds.GetContext(ctx, &first, `
+SELECT MIN(finalized_at) FROM evm.txes WHERE evm_chain_id = $1 AND state = 'finalized'`, chainID.String())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to load first finalized transaction: %w", err)
+	}
+	return first.Time, nil
+}
+
+func (r dbAttempt) toAttempt() (a Attempt, err error) {
+	a = Attempt{
+		EVMChainID:       r.EVMChainID,
+		TxID:             r.TxID,
+		Nonce:            nullInt64(r.Nonce),
+		FromAddress:      r.FromAddress,
+		ToAddress:        r.ToAddress,
+		TxState:          r.TxState,
+		TxCreatedAt:      r.TxCreatedAt,
+		AttemptID:        r.AttemptID,
+		BumpIndex:        r.BumpIndex,
+		Hash:             r.Hash,
+		AttemptState:     r.AttemptState,
+		TxType:           r.TxType,
+		GasLimit:         r.GasLimit,
+		IsPurgeAttempt:   r.IsPurgeAttempt,
+		AttemptCreatedAt: r.AttemptCreatedAt,
+		BlockNumber:      nullInt64(r.BlockNumber),
+
+		BroadcastBeforeBlockNum: nullInt64(r.BroadcastBeforeBlockNum),
+	}
+	if r.TxError.Valid {
+		a.TxError = &r.TxError.String
+	}
+	if r.BlockHash != nil {
+		h := common.BytesToHash(r.BlockHash)
+		a.BlockHash = &h
+	}
+	if a.GasPrice, err = nullBig(r.GasPrice); err != nil {
+		return a, fmt.Errorf("gas price: %w", err)
+	}
+	if a.GasTipCap, err = nullBig(r.GasTipCap); err != nil {
+		return a, fmt.Errorf("gas tip cap: %w", err)
+	}
+	if a.GasFeeCap, err = nullBig(r.GasFeeCap); err != nil {
+		return a, fmt.Errorf("gas fee cap: %w", err)
+	}
+	if a.GasUsed, err = nullHexInt64(r.GasUsed); err != nil {
+		return a, fmt.Errorf("receipt gas used: %w", err)
+	}
+	if a.ReceiptStatus, err = nullHexInt64(r.ReceiptStatus); err != nil {
+		return a, fmt.Errorf("receipt status: %w", err)
+	}
+	receiptPrice, err := nullHexBig(r.EffectiveGasPrice)
+	if err != nil {
+		return a, fmt.Errorf("receipt effective gas price: %w", err)
+	}
+	a.EffectiveGasPrice = effectiveGasPrice(a, receiptPrice)
+	return a, nil
+}
+
+// effectiveGasPrice returns the price per gas paid by an included attempt: the effective gas price of its receipt, or
+// the gas price of legacy transactions if the RPC didn't return it. It returns nil if the attempt wasn't included, or
+// is a dynamic fee transaction whose receipt has no effective gas price.
+func effectiveGasPrice(a Attempt, receiptPrice *big.Int) *big.Int {
+	if a.BlockNumber == nil {
+		return nil
+	}
+	if receiptPrice != nil {
+		return receiptPrice
+	}
+	return a.GasPrice
+}
+
+func nullInt64(v sql.NullInt64) *int64 {
+	if !v.Valid {
+		return nil
+	}
+	return &v.Int64
+}
+
+func nullBig(v sql.NullString) (*big.Int, error) {
+	if !v.Valid {
+		return nil, nil
+	}
+	i, ok := new(big.Int).SetString(v.String, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid number: %s", v.String)
+	}
+	return i, nil
+}
+
+func nullHexBig(v sql.NullString) (*big.Int, error) {
+	if !v.Valid {
+		return nil, nil
+	}
+	i, err := hexutil.DecodeBig(v.String)
+	if err != nil {
+		return nil, err
+	}
+	return i, nil
+}
+
+func nullHexInt64(v sql.NullString) (*int64, error) {
+	if !v.Valid {
+		return nil, nil
+	}
+	u, err := hexutil.DecodeUint64(v.String)
+	if err != nil {
+		return nil, err
+	}
+	i := int64(u)
+	return &i, nil
+}
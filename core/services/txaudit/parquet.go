@@ -0,0 +1,357 @@
+package txaudit
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"time"
+)
+
+// The subset of the Parquet format written by WriteParquet: a flat schema, row groups of up to parquetRowGroupSize rows,
+// one uncompressed data page per column chunk with PLAIN encoded values, and RLE encoded definition levels of optional
+// columns.
+// See https://github.com/apache/parquet-format.
+
+const parquetMagic = "PAR1"
+
+// parquetRowGroupSize bounds the rows of a row group, and so the size of its pages, which readers load whole.
+const parquetRowGroupSize = 10_000
+
+// parquet physical types
+const (
+	parquetBoolean   = 0
+	parquetInt64     = 2
+	parquetByteArray = 6
+)
+
+// parquet converted types
+const (
+	parquetUTF8            = 0
+	parquetTimestampMillis = 9
+)
+
+const (
+	parquetRequired = 0
+	parquetOptional = 1
+
+	parquetPlain = 0
+	parquetRLE   = 3
+
+	parquetUncompressed = 0
+	parquetDataPage     = 0
+)
+
+// WriteParquet writes attempts as a Parquet file. Timestamps are milliseconds since the epoch in UTC.
+func WriteParquet(w io.Writer, attempts []Attempt) error {
+	pw := &positionWriter{w: w}
+	if _, err := io.WriteString(pw, parquetMagic); err != nil {
+		return err
+	}
+
+	// a file without rows has no row group, and so no column chunks
+	var rowGroups []parquetRowGroup
+	for start := 0; start < len(attempts); start += parquetRowGroupSize {
+		rows := attempts[start:min(start+parquetRowGroupSize, len(attempts))]
+		rg := parquetRowGroup{numRows: len(rows), chunks: make([]parquetChunk, len(columns))}
+		for i, c := range columns {
+			chunk, err := writeParquetChunk(pw, c, rows)
+			if err != nil {
+				return err
+			}
+			rg.chunks[i] = chunk
+		}
+		rowGroups = append(rowGroups, rg)
+	}
+
+	meta := parquetFileMetaData(rowGroups, len(attempts))
+	if _, err := pw.Write(meta); err != nil {
+		return err
+	}
+	if err := binary.Write(pw, binary.LittleEndian, uint32(len(meta))); err != nil {
+		return err
+	}
+	_, err := io.WriteString(pw, parquetMagic)
+	return err
+}
+
+// writeParquetChunk writes the column chunk of c, a single data page, for rows.
+func writeParquetChunk(pw *positionWriter, c column, rows []Attempt) (parquetChunk, error) {
+	page, err := encodeParquetPage(c, rows)
+	if err != nil {
+		return parquetChunk{}, err
+	}
+	var header compactWriter
+	header.beginStruct()
+	header.i32(1, parquetDataPage)
+	header.i32(2, int32(len(page)))
+	header.i32(3, int32(len(page)))
+	header.structField(5)
+	header.i32(1, int32(len(rows)))
+	header.i32(2, parquetPlain)
+	header.i32(3, parquetRLE)
+	header.i32(4, parquetRLE)
+	header.endStruct()
+	header.endStruct()
+
+	chunk := parquetChunk{offset: pw.n, size: int64(header.Len() + len(page))}
+	if _, err := pw.Write(header.Bytes()); err != nil {
+		return parquetChunk{}, err
+	}
+	if _, err := pw.Write(page); err != nil {
+		return parquetChunk{}, err
+	}
+	return chunk, nil
+}
+
+type parquetRowGroup struct {
+	numRows int
+	chunks  []parquetChunk
+}
+
+type parquetChunk struct {
+	offset, size int64
+}
+
+func parquetType(k kind) (physical int32, converted int32, hasConverted bool) {
+	switch k {
+	case kindInt64:
+		return parquetInt64, 0, false
+	case kindString:
+		return parquetByteArray, parquetUTF8, true
+	case kindBool:
+		return parquetBoolean, 0, false
+	case kindTime:
+		return parquetInt64, parquetTimestampMillis, true
+	default:
+		panic(fmt.Sprintf("unexpected kind %d", k))
+	}
+}
+
+// encodeParquetPage returns the definition levels, if the column is optional, followed by the PLAIN encoded non-null
+// values of the column.
+func encodeParquetPage(c column, attempts []Attempt) ([]byte, error) {
+	var levels []bool
+	var values bytes.Buffer
+	var bits []bool
+	for i := range attempts {
+		v := c.value(&attempts[i])
+		if c.optional {
+			levels = append(levels, v != nil)
+		}
+		switch v := v.(type) {
+		case nil:
+			if !c.optional {
+				return nil, fmt.Errorf("null value of required column %s", c.name)
+			}
+		case int64:
+			values.Write(binary.LittleEndian.AppendUint64(nil, uint64(v)))
+		case time.Time:
+			values.Write(binary.LittleEndian.AppendUint64(nil, uint64(v.UnixMilli())))
+		case string:
+			values.Write(binary.LittleEndian.AppendUint32(nil, uint32(len(v))))
+			values.WriteString(v)
+		case bool:
+			bits = append(bits, v)
+		default:
+			return nil, fmt.Errorf("unexpected value of column %s: %T", c.name, v)
+		}
+	}
+	if c.kind == kindBool {
+		// booleans are bit-packed, least significant bit first
+		packed := make([]byte, (len(bits)+7)/8)
+		for i, b := range bits {
+			if b {
+				packed[i/8] |= 1 << (i % 8)
+			}
+		}
+		values.Write(packed)
+	}
+
+	var page bytes.Buffer
+	if c.optional {
+		rle := encodeRLELevels(levels)
+		page.Write(binary.LittleEndian.AppendUint32(nil, uint32(len(rle))))
+		page.Write(rle)
+	}
+	page.Write(values.Bytes())
+	return page.Bytes(), nil
+}
+
+// encodeRLELevels encodes definition levels of bit width 1 as RLE runs of the RLE/bit-packing hybrid encoding.
+func encodeRLELevels(levels []bool) []byte {
+	var b []byte
+	for i := 0; i < len(levels); {
+		j := i
+		for j < len(levels) && levels[j] == levels[i] {
+			j++
+		}
+		b = binary.AppendUvarint(b, uint64(j-i)<<1)
+		if levels[i] {
+			b = append(b, 1)
+		} else {
+			b = append(b, 0)
+		}
+		i = j
+	}
+	return b
+}
+
+func parquetFileMetaData(rowGroups []parquetRowGroup, numRows int) []byte {
+	var w compactWriter
+	w.beginStruct()
+	w.i32(1, 1) // version
+
+	w.listField(2, compactStruct, len(columns)+1)
+	w.beginStruct()
+	w.binary(4, "schema")
+	w.i32(5, int32(len(columns)))
+	w.endStruct()
+	for _, c := range columns {
+		physical, converted, hasConverted := parquetType(c.kind)
+		repetition := int32(parquetRequired)
+		if c.optional {
+			repetition = parquetOptional
+		}
+		w.beginStruct()
+		w.i32(1, physical)
+		w.i32(3, repetition)
+		w.binary(4, c.name)
+		if hasConverted {
+			w.i32(6, converted)
+		}
+		w.endStruct()
+	}
+
+	w.i64(3, int64(numRows))
+
+	w.listField(4, compactStruct, len(rowGroups))
+	for _, rg := range rowGroups {
+		var totalSize int64
+		for _, c := range rg.chunks {
+			totalSize += c.size
+		}
+		w.beginStruct()
+		w.listField(1, compactStruct, len(columns))
+		for i, c := range columns {
+			physical, _, _ := parquetType(c.kind)
+			encodings := []int32{parquetPlain}
+			if c.optional {
+				encodings = append(encodings, parquetRLE)
+			}
+			w.beginStruct()
+			w.i64(2, rg.chunks[i].offset)
+			w.structField(3)
+			w.i32(1, physical)
+			w.listField(2, compactI32, len(encodings))
+			for _, e := range encodings {
+				w.varint(zigzag(int64(e)))
+			}
+			w.listField(3, compactBinary, 1)
+			w.varint(uint64(len(c.name)))
+			w.WriteString(c.name)
+			w.i32(4, parquetUncompressed)
+			w.i64(5, int64(rg.numRows))
+			w.i64(6, rg.chunks[i].size)
+			w.i64(7, rg.chunks[i].size)
+			w.i64(9, rg.chunks[i].offset)
+			w.endStruct()
+			w.endStruct()
+		}
+		w.i64(2, totalSize)
+		w.i64(3, int64(rg.numRows))
+		w.endStruct()
+	}
+
+	w.binary(6, "chainlink txaudit")
+	w.endStruct()
+	return w.Bytes()
+}
+
+// compact protocol types
+const (
+	compactI32    = 5
+	compactI64    = 6
+	compactBinary = 8
+	compactList   = 9
+	compactStruct = 12
+)
+
+// compactWriter writes the Thrift compact protocol, which encodes the Parquet metadata.
+type compactWriter struct {
+	bytes.Buffer
+	lastID  int16
+	idStack []int16
+}
+
+func (w *compactWriter) beginStruct() {
+	w.idStack = append(w.idStack, w.lastID)
+	w.lastID = 0
+}
+
+func (w *compactWriter) endStruct() {
+	w.WriteByte(0) // stop
+	w.lastID = w.idStack[len(w.idStack)-1]
+	w.idStack = w.idStack[:len(w.idStack)-1]
+}
+
+func (w *compactWriter) fieldHeader(typ byte, id int16) {
+	if delta := id - w.lastID; delta > 0 && delta <= 15 {
+		w.WriteByte(byte(delta)<<4 | typ)
+	} else {
+		w.WriteByte(typ)
+		w.varint(zigzag(int64(id)))
+	}
+	w.lastID = id
+}
+
+func (w *compactWriter) varint(v uint64) {
+	w.Write(binary.AppendUvarint(nil, v))
+}
+
+func zigzag(v int64) uint64 {
+	return uint64((v << 1) ^ (v >> 63))
+}
+
+func (w *compactWriter) i32(id int16, v int32) {
+	w.fieldHeader(compactI32, id)
+	w.varint(zigzag(int64(v)))
+}
+
+func (w *compactWriter) i64(id int16, v int64) {
+	w.fieldHeader(compactI64, id)
+	w.varint(zigzag(v))
+}
+
+func (w *compactWriter) binary(id int16, s string) {
+	w.fieldHeader(compactBinary, id)
+	w.varint(uint64(len(s)))
+	w.WriteString(s)
+}
+
+func (w *compactWriter) structField(id int16) {
+	w.fieldHeader(compactStruct, id)
+	w.beginStruct()
+}
+
+// listField writes the header of a list field, which must be followed by size elements of type elemType.
+func (w *compactWriter) listField(id int16, elemType byte, size int) {
+	w.fieldHeader(compactList, id)
+	if size < 15 {
+		w.WriteByte(byte(size)<<4 | elemType)
+	} else {
+		w.WriteByte(0xf0 | elemType)
+		w.varint(uint64(size))
+	}
+}
+
+type positionWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (p *positionWriter) Write(b []byte) (int, error) {
+	n, err := p.w.Write(b)
+	p.n += int64(n)
+	return n, err
+}
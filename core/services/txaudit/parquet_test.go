@@ -0,0 +1,284 @@
+package txaudit
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteParquet(t *testing.T) {
+	for _, attempts := range [][]Attempt{testAttempts(), nil} {
+		var b bytes.Buffer
+		require.NoError(t, WriteParquet(&b, attempts))
+
+		file := b.Bytes()
+		require.Greater(t, len(file), 12)
+		assert.Equal(t, parquetMagic, string(file[:4]))
+		assert.Equal(t, parquetMagic, string(file[len(file)-4:]))
+
+		metaLen := int(binary.LittleEndian.Uint32(file[len(file)-8:]))
+		require.LessOrEqual(t, metaLen, len(file)-12)
+		meta := file[len(file)-8-metaLen : len(file)-8]
+		for _, c := range columns {
+			assert.True(t, bytes.Contains(meta, []byte(c.name)), c.name)
+		}
+		// FileMetaData starts with version 1
+		assert.Equal(t, []byte{0x15, 0x02}, meta[:2])
+		assert.Equal(t, byte(0), meta[len(meta)-1])
+	}
+}
+
+func TestWriteParquet_roundTrip(t *testing.T) {
+	// more rows than fit in a row group
+	var many []Attempt
+	for len(many) <= parquetRowGroupSize {
+		many = append(many, testAttempts()...)
+	}
+	for i := range many {
+		many[i].TxID = int64(i)
+	}
+	for _, attempts := range [][]Attempt{testAttempts(), nil, many} {
+		var b bytes.Buffer
+		require.NoError(t, WriteParquet(&b, attempts))
+
+		names, rows := readParquet(t, b.Bytes())
+		expNames := make([]string, len(columns))
+		for i, c := range columns {
+			expNames[i] = c.name
+		}
+		assert.Equal(t, expNames, names)
+		require.Len(t, rows, len(attempts))
+		for i := range attempts {
+			for j, c := range columns {
+				exp := c.value(&attempts[i])
+				if tm, ok := exp.(time.Time); ok {
+					exp = tm.UnixMilli()
+				}
+				assert.Equal(t, exp, rows[i][j], "row %d column %s", i, c.name)
+			}
+		}
+	}
+}
+
+func TestEncodeRLELevels(t *testing.T) {
+	// runs of 3 defined, 1 null and 2 defined values
+	assert.Equal(t, []byte{0x06, 0x01, 0x02, 0x00, 0x04, 0x01}, encodeRLELevels([]bool{true, true, true, false, true, true}))
+	assert.Empty(t, encodeRLELevels(nil))
+}
+
+func TestCompactWriter(t *testing.T) {
+	var w compactWriter
+	w.beginStruct()
+	w.i32(1, -1)
+	w.binary(4, "ab")
+	w.i64(20, 3)
+	w.structField(21)
+	w.endStruct()
+	w.listField(22, compactI32, 2)
+	w.varint(zigzag(1))
+	w.varint(zigzag(2))
+	w.endStruct()
+
+	assert.Equal(t, []byte{
+		0x15, 0x01, // field 1, i32 -1
+		0x38, 0x02, 'a', 'b', // field 4 (delta 3), binary "ab"
+		0x06, 0x28, 0x06, // field 20 (delta 16), i64 3
+		0x1c, 0x00, // field 21, empty struct
+		0x19, 0x25, 0x02, 0x04, // field 22, list of 2 i32
+		0x00, // stop
+	}, w.Bytes())
+}
+
+// readParquet decodes the files written by WriteParquet independently of the writer, following the Parquet and Thrift
+// compact protocol specifications. It returns the column names and the rows, with null values as nil, timestamps as
+// int64 milliseconds, and UTF8 byte arrays as strings.
+func readParquet(t *testing.T, file []byte) ([]string, [][]any) {
+	t.Helper()
+	require.Greater(t, len(file), 12)
+	require.Equal(t, parquetMagic, string(file[:4]))
+	require.Equal(t, parquetMagic, string(file[len(file)-4:]))
+	metaLen := int(binary.LittleEndian.Uint32(file[len(file)-8:]))
+	meta := thriftStruct(t, bytes.NewReader(file[len(file)-8-metaLen:len(file)-8]))
+
+	schema := meta[2].([]any)
+	require.Len(t, schema, len(columns)+1)
+	require.EqualValues(t, len(columns), schema[0].(map[int16]any)[5])
+	numRows := int(meta[3].(int64))
+	var names []string
+	for _, elem := range schema[1:] {
+		names = append(names, string(elem.(map[int16]any)[4].([]byte)))
+	}
+
+	rows := make([][]any, 0, numRows)
+	for _, rowGroup := range meta[4].([]any) {
+		rg := rowGroup.(map[int16]any)
+		chunks := rg[1].([]any)
+		require.Len(t, chunks, len(columns))
+		rgRows := make([][]any, rg[3].(int64))
+		require.NotEmpty(t, rgRows)
+		for i := range rgRows {
+			rgRows[i] = make([]any, len(columns))
+		}
+		for i, elem := range schema[1:] {
+			readParquetChunk(t, file, elem.(map[int16]any), chunks[i].(map[int16]any)[3].(map[int16]any), rgRows, i)
+		}
+		rows = append(rows, rgRows...)
+	}
+	require.Len(t, rows, numRows)
+	return names, rows
+}
+
+// readParquetChunk decodes the column chunk of the i-th column, described by the schema element el and the column
+// metadata colMeta, into rows.
+func readParquetChunk(t *testing.T, file []byte, el, colMeta map[int16]any, rows [][]any, i int) {
+	t.Helper()
+	physical := el[1].(int64)
+	optional := el[3].(int64) == parquetOptional
+	utf8 := el[6] == int64(parquetUTF8)
+	numRows := len(rows)
+
+	require.EqualValues(t, parquetUncompressed, colMeta[4])
+	require.EqualValues(t, numRows, colMeta[5])
+	r := bytes.NewReader(file[colMeta[9].(int64):])
+	header := thriftStruct(t, r)
+	require.EqualValues(t, parquetDataPage, header[1])
+	page := make([]byte, header[3].(int64))
+	_, err := r.Read(page)
+	require.NoError(t, err)
+	dataHeader := header[5].(map[int16]any)
+	require.EqualValues(t, numRows, dataHeader[1])
+	require.EqualValues(t, parquetPlain, dataHeader[2])
+
+	defined := make([]bool, numRows)
+	for j := range defined {
+		defined[j] = true
+	}
+	if optional {
+		n := binary.LittleEndian.Uint32(page)
+		defined = readLevels(t, page[4:4+n], numRows)
+		page = page[4+n:]
+	}
+	bit := 0
+	for j := range rows {
+		if !defined[j] {
+			continue
+		}
+		switch physical {
+		case parquetInt64:
+			rows[j][i] = int64(binary.LittleEndian.Uint64(page))
+			page = page[8:]
+		case parquetByteArray:
+			n := binary.LittleEndian.Uint32(page)
+			v := page[4 : 4+n]
+			if utf8 {
+				rows[j][i] = string(v)
+			} else {
+				rows[j][i] = v
+			}
+			page = page[4+n:]
+		case parquetBoolean:
+			rows[j][i] = page[bit/8]&(1<<(bit%8)) != 0
+			bit++
+		default:
+			t.Fatalf("unexpected physical type %d", physical)
+		}
+	}
+}
+
+// readLevels decodes n definition levels of bit width 1 from the RLE/bit-packing hybrid encoding.
+func readLevels(t *testing.T, b []byte, n int) []bool {
+	r := bytes.NewReader(b)
+	var levels []bool
+	for len(levels) < n {
+		header, err := binary.ReadUvarint(r)
+		require.NoError(t, err)
+		if header&1 == 0 {
+			v, err := r.ReadByte()
+			require.NoError(t, err)
+			for k := uint64(0); k < header>>1; k++ {
+				levels = append(levels, v == 1)
+			}
+			continue
+		}
+		for k := uint64(0); k < header>>1; k++ {
+			v, err := r.ReadByte()
+			require.NoError(t, err)
+			for bit := 0; bit < 8; bit++ {
+				levels = append(levels, v&(1<<bit) != 0)
+			}
+		}
+	}
+	return levels[:n]
+}
+
+// thriftStruct reads a struct of the Thrift compact protocol, as a map of field IDs to values.
+func thriftStruct(t *testing.T, r *bytes.Reader) map[int16]any {
+	fields := map[int16]any{}
+	var id int16
+	for {
+		b, err := r.ReadByte()
+		require.NoError(t, err)
+		if b == 0 {
+			return fields
+		}
+		if delta := int16(b >> 4); delta != 0 {
+			id += delta
+		} else {
+			id = int16(thriftZigzag(t, r))
+		}
+		fields[id] = thriftValue(t, r, b&0x0f)
+	}
+}
+
+func thriftValue(t *testing.T, r *bytes.Reader, typ byte) any {
+	switch typ {
+	case 1, 2:
+		return typ == 1
+	case 3:
+		b, err := r.ReadByte()
+		require.NoError(t, err)
+		return int64(int8(b))
+	case 4, compactI32, compactI64:
+		return thriftZigzag(t, r)
+	case 7:
+		var f uint64
+		require.NoError(t, binary.Read(r, binary.LittleEndian, &f))
+		return math.Float64frombits(f)
+	case compactBinary:
+		n, err := binary.ReadUvarint(r)
+		require.NoError(t, err)
+		b := make([]byte, n)
+		_, err = r.Read(b)
+		require.NoError(t, err)
+		return b
+	case compactList:
+		b, err := r.ReadByte()
+		require.NoError(t, err)
+		size := uint64(b >> 4)
+		if size == 15 {
+			size, err = binary.ReadUvarint(r)
+			require.NoError(t, err)
+		}
+		list := make([]any, size)
+		for i := range list {
+			list[i] = thriftValue(t, r, b&0x0f)
+		}
+		return list
+	case compactStruct:
+		return thriftStruct(t, r)
+	default:
+		panic(fmt.Sprintf("unexpected compact type %d", typ))
+	}
+}
+
+func thriftZigzag(t *testing.T, r *bytes.Reader) int64 {
+	u, err := binary.ReadUvarint(r)
+	require.NoError(t, err)
+	return int64(u>>1) ^ -int64(u&1)
+}
@@ -116,6 +116,51 @@ func (_c *FeeConfig_LimitJobType_Call) RunAndReturn(run func() config.LimitJobTy
 	return _c
 }
 
+// LimitMax provides a mock function with given fields:
+func (_m *FeeConfig) LimitMax() uint64 {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for LimitMax")
+	}
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	return r0
+}
+
+// FeeConfig_LimitMax_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LimitMax'
+type FeeConfig_LimitMax_Call struct {
+	*mock.Call
+}
+
+// LimitMax is a helper method to define mock.On call
+func (_e *FeeConfig_Expecter) LimitMax() *FeeConfig_LimitMax_Call {
+	return &FeeConfig_LimitMax_Call{Call: _e.mock.On("LimitMax")}
+}
+
+func (_c *FeeConfig_LimitMax_Call) Run(run func()) *FeeConfig_LimitMax_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *FeeConfig_LimitMax_Call) Return(_a0 uint64) *FeeConfig_LimitMax_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FeeConfig_LimitMax_Call) RunAndReturn(run func() uint64) *FeeConfig_LimitMax_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PriceMaxKey provides a mock function with given fields: addr
 func (_m *FeeConfig) PriceMaxKey(addr common.Address) *assets.Wei {
 	ret := _m.Called(addr)
@@ -0,0 +1,108 @@
+package v2
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
+)
+
+// batchFulfillmentOverheadGas is a conservative upper bound on the gas used by a batch
+// fulfillment on top of its callbacks.
+const batchFulfillmentOverheadGas = 400_000
+
+// batchScheduler holds back confirmed requests across blocks, so that they are fulfilled in
+// fewer and fuller batches. The requests of a subscription are due once their estimated gas
+// fills a batch, once its oldest request has waited maxWaitBlocks since it was confirmed, or
+// if one of them is being retried.
+type batchScheduler struct {
+	gasCeiling    uint64
+	maxWaitBlocks uint64
+}
+
+// scheduledSub holds the confirmed requests of a subscription.
+type scheduledSub struct {
+	subID string
+	reqs  []pendingRequest
+	// waited is the number of blocks that the oldest request has waited since it was confirmed.
+	waited uint64
+	// gas is the estimated gas needed to fulfill all requests in batches.
+	gas uint64
+}
+
+// requestBatchGas estimates the gas used by a request in a batch fulfillment.
+func requestBatchGas(req pendingRequest) uint64 {
+	return uint64(req.req.CallbackGasLimit()) + uint64(GasProofVerification) + BatchFulfillmentIterationGasCost
+}
+
+// schedule returns the subscriptions which are due for fulfillment at latestHead, in order of
+// priority: the subscription whose oldest request has waited the longest comes first, then the
+// one with the most gas to fulfill. It also returns the number of requests held back.
+func (s batchScheduler) schedule(latestHead uint64, confirmed map[string][]pendingRequest) (due []scheduledSub, deferred int) {
+	for subID, reqs := range confirmed {
+		sub := scheduledSub{subID: subID, reqs: reqs}
+		var retrying bool
+		for _, req := range reqs {
+			sub.gas += requestBatchGas(req)
+			if req.confirmedAtBlock <= latestHead {
+				sub.waited = max(sub.waited, latestHead-req.confirmedAtBlock)
+			}
+			retrying = retrying || req.attempts > 0
+		}
+		if !retrying && sub.waited < s.maxWaitBlocks && sub.gas < s.gasCeiling {
+			deferred += len(reqs)
+			continue
+		}
+		due = append(due, sub)
+	}
+	slices.SortFunc(due, func(a, b scheduledSub) int {
+		if c := cmp.Compare(b.waited, a.waited); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(b.gas, a.gas); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.subID, b.subID)
+	})
+	return due, deferred
+}
+
+// batchSchedulingEnabled returns true if confirmed requests are held back to be batched with the
+// requests of later blocks.
+func (lsn *listenerV2) batchSchedulingEnabled() bool {
+	return lsn.job.VRFSpec.BatchFulfillmentEnabled &&
+		lsn.job.VRFSpec.BatchFulfillmentMaxWaitBlocks > 0 &&
+		lsn.batchCoordinator != nil &&
+		lsn.coordinator.Version() == vrfcommon.V2Plus
+}
+
+// batchGasCeiling returns the maximum gas of the callbacks of a batch, derived from the gas limit of the transactions
+// of the keys of the job, i.e. the VRF gas limit of the gas estimator, or its max gas limit, leaving room for the gas
+// multiplier and the batch overhead. If that leaves no room for a callback of the max gas limit of the coordinator,
+// batches are bounded by the max gas limit of the coordinator, as without scheduling, not to be of a single request.
+func (lsn *listenerV2) batchGasCeiling(maxCallbackGasLimit uint32) uint32 {
+	gasLimit := lsn.feeCfg.LimitMax()
+	if vrfLimit := lsn.feeCfg.LimitJobType().VRF(); vrfLimit != nil {
+		gasLimit = uint64(*vrfLimit)
+	}
+	multiplier := max(float64(lsn.job.VRFSpec.BatchFulfillmentGasMultiplier), 1)
+	ceiling := float64(gasLimit)/multiplier - batchFulfillmentOverheadGas
+	if ceiling < float64(uint64(maxCallbackGasLimit)+uint64(GasProofVerification)+BatchFulfillmentIterationGasCost) {
+		return maxCallbackGasLimit + batchFulfillmentOverheadGas
+	}
+	return uint32(min(ceiling, math.MaxUint32))
+}
+
+func (lsn *listenerV2) newBatchScheduler(maxCallbackGasLimit uint32) batchScheduler {
+	return batchScheduler{
+		gasCeiling:    uint64(lsn.batchGasCeiling(maxCallbackGasLimit)),
+		maxWaitBlocks: uint64(lsn.job.VRFSpec.BatchFulfillmentMaxWaitBlocks),
+	}
+}
+
+// batchFeeLimit returns the gas limit of the transaction of a batch, which covers at least the gas limits of all of its
+// requests, and the batch overhead, whatever the gas limit the batch was filled up to.
+func batchFeeLimit(batch *batchFulfillment, estimate uint32) uint64 {
+	return max(uint64(estimate), batch.totalGasLimit+batchFulfillmentOverheadGas+uint64(len(batch.proofs))*BatchFulfillmentIterationGasCost)
+}
@@ -0,0 +1,110 @@
+package v2
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/vrf_coordinator_v2_5"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	vrfmocks "github.com/smartcontractkit/chainlink/v2/core/services/vrf/mocks"
+)
+
+func newScheduledRequest(callbackGasLimit uint32, confirmedAtBlock uint64, attempts int) pendingRequest {
+	return pendingRequest{
+		confirmedAtBlock: confirmedAtBlock,
+		attempts:         attempts,
+		req: NewV2_5RandomWordsRequested(&vrf_coordinator_v2_5.VRFCoordinatorV25RandomWordsRequested{
+			RequestId:        big.NewInt(1),
+			CallbackGasLimit: callbackGasLimit,
+		}),
+	}
+}
+
+func Test_BatchScheduler_Schedule(t *testing.T) {
+	reqGas := requestBatchGas(newScheduledRequest(100_000, 0, 0))
+	s := batchScheduler{gasCeiling: 3 * reqGas, maxWaitBlocks: 5}
+
+	subIDs := func(subs []scheduledSub) (ids []string) {
+		for _, sub := range subs {
+			ids = append(ids, sub.subID)
+		}
+		return
+	}
+
+	t.Run("holds back requests until they fill a batch", func(t *testing.T) {
+		due, deferred := s.schedule(100, map[string][]pendingRequest{
+			"1": {newScheduledRequest(100_000, 99, 0), newScheduledRequest(100_000, 100, 0)},
+			"2": {newScheduledRequest(100_000, 98, 0), newScheduledRequest(100_000, 99, 0), newScheduledRequest(100_000, 100, 0)},
+		})
+		assert.Equal(t, []string{"2"}, subIDs(due))
+		assert.Equal(t, 2, deferred)
+	})
+
+	t.Run("releases requests after the max wait", func(t *testing.T) {
+		due, deferred := s.schedule(100, map[string][]pendingRequest{
+			"1": {newScheduledRequest(100_000, 95, 0)},
+			"2": {newScheduledRequest(100_000, 96, 0)},
+		})
+		assert.Equal(t, []string{"1"}, subIDs(due))
+		assert.Equal(t, 1, deferred)
+	})
+
+	t.Run("does not hold back retried requests", func(t *testing.T) {
+		due, deferred := s.schedule(100, map[string][]pendingRequest{
+			"1": {newScheduledRequest(100_000, 100, 1)},
+		})
+		assert.Equal(t, []string{"1"}, subIDs(due))
+		assert.Equal(t, 0, deferred)
+	})
+
+	t.Run("prioritizes the longest waiting subscriptions, then the most gas", func(t *testing.T) {
+		due, _ := s.schedule(100, map[string][]pendingRequest{
+			"1": {newScheduledRequest(100_000, 94, 0)},
+			"2": {newScheduledRequest(100_000, 90, 0)},
+			"3": {newScheduledRequest(1_000_000, 100, 0)},
+			"4": {newScheduledRequest(900_000, 100, 0)},
+		})
+		assert.Equal(t, []string{"2", "1", "3", "4"}, subIDs(due))
+	})
+}
+
+type vrfLimitJobType struct {
+	config.LimitJobType
+	vrf *uint32
+}
+
+func (l vrfLimitJobType) VRF() *uint32 { return l.vrf }
+
+func Test_ListenerV2_BatchGasCeiling(t *testing.T) {
+	newListener := func(limitMax uint64, vrfLimit *uint32) *listenerV2 {
+		feeCfg := vrfmocks.NewFeeConfig(t)
+		feeCfg.On("LimitMax").Return(limitMax).Maybe()
+		feeCfg.On("LimitJobType").Return(vrfLimitJobType{vrf: vrfLimit})
+		return &listenerV2{feeCfg: feeCfg, job: job.Job{VRFSpec: &job.VRFSpec{BatchFulfillmentGasMultiplier: 1.25}}}
+	}
+
+	t.Run("derives the ceiling from the VRF gas limit", func(t *testing.T) {
+		vrfLimit := uint32(12_500_000)
+		assert.Equal(t, uint32(9_600_000), newListener(500_000, &vrfLimit).batchGasCeiling(2_500_000))
+	})
+
+	t.Run("derives the ceiling from the max gas limit", func(t *testing.T) {
+		assert.Equal(t, uint32(9_600_000), newListener(12_500_000, nil).batchGasCeiling(2_500_000))
+	})
+
+	t.Run("falls back to the max gas limit of the coordinator", func(t *testing.T) {
+		// 500_000 / 1.25 - 400_000 doesn't fit a single callback
+		assert.Equal(t, uint32(2_500_000+batchFulfillmentOverheadGas), newListener(500_000, nil).batchGasCeiling(2_500_000))
+	})
+}
+
+func Test_BatchFeeLimit(t *testing.T) {
+	batch := &batchFulfillment{proofs: make([]VRFProof, 2), totalGasLimit: 3_000_000}
+
+	// the fee limit covers the gas limits of the requests, even if the estimate doesn't
+	assert.Equal(t, uint64(3_000_000+batchFulfillmentOverheadGas+2*BatchFulfillmentIterationGasCost), batchFeeLimit(batch, 1_000_000))
+	assert.Equal(t, uint64(5_000_000), batchFeeLimit(batch, 5_000_000))
+}
@@ -135,7 +135,26 @@ func (lsn *listenerV2) processPendingVRFRequests(ctx context.Context, pendingReq
 		lsn.l.Infow("No pending requests ready for processing")
 		return
 	}
-	for subID, reqs := range confirmed {
+	subs := make([]scheduledSub, 0, len(confirmed))
+	scheduled := false
+	if lsn.batchSchedulingEnabled() {
+		if config, err := lsn.coordinator.GetConfig(&bind.CallOpts{Context: ctx}); err != nil {
+			// the requests are all due, as without scheduling
+			lsn.l.Errorw("Couldn't get config from coordinator to schedule batch fulfillments", "err", err)
+		} else {
+			var deferred int
+			subs, deferred = lsn.newBatchScheduler(config.MaxGasLimit()).schedule(lsn.getLatestHead(), confirmed)
+			lsn.l.Debugw("Scheduled batch fulfillments", "dueSubs", len(subs), "deferredReqs", deferred)
+			scheduled = true
+		}
+	}
+	if !scheduled {
+		for subID, reqs := range confirmed {
+			subs = append(subs, scheduledSub{subID: subID, reqs: reqs})
+		}
+	}
+	for _, s := range subs {
+		subID, reqs := s.subID, s.reqs
 		l := lsn.l.With("subID", subID, "startTime", time.Now(), "numReqsForSub", len(reqs))
 		// Get the balance of the subscription and also it's active status.
 		// The reason we need both is that we cannot determine if a subscription
@@ -305,7 +324,11 @@ func (lsn *listenerV2) processRequestsPerSubBatchHelper(
 	}
 
 	// Add very conservative upper bound estimate on verification costs.
-	batchMaxGas := config.MaxGasLimit() + 400_000
+	batchMaxGas := config.MaxGasLimit() + batchFulfillmentOverheadGas
+	if lsn.batchSchedulingEnabled() {
+		// Batches of scheduled requests are filled up to the gas limit of the keys of the job instead.
+		batchMaxGas = lsn.batchGasCeiling(config.MaxGasLimit())
+	}
 
 	l := lsn.l.With(
 		"subID", subID,
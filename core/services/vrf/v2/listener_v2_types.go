@@ -240,7 +240,7 @@ func (lsn *listenerV2) processBatch(
 			FromAddress:    fromAddress,
 			ToAddress:      lsn.batchCoordinator.Address(),
 			EncodedPayload: payload,
-			FeeLimit:       uint64(totalGasLimitBumped),
+			FeeLimit:       batchFeeLimit(batch, totalGasLimitBumped),
 			Strategy:       txmgrcommon.NewSendEveryStrategy(),
 			Meta: &txmgr.TxMeta{
 				RequestIDs:      reqIDHashes,
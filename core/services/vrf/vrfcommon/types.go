@@ -22,5 +22,6 @@ type Config interface {
 type FeeConfig interface {
 	LimitDefault() uint64
 	LimitJobType() config.LimitJobType
+	LimitMax() uint64
 	PriceMaxKey(addr common.Address) *assets.Wei
 }
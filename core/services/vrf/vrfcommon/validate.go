@@ -62,6 +62,10 @@ func ValidatedVRFSpec(tomlString string) (job.Job, error) {
 		return jb, errors.Wrap(ErrKeyNotSet, "batch coordinator address must be provided if batchFulfillmentEnabled = true")
 	}
 
+	if spec.BatchFulfillmentMaxWaitBlocks > 0 && !spec.BatchFulfillmentEnabled {
+		return jb, errors.New("batchFulfillmentMaxWaitBlocks requires batchFulfillmentEnabled = true")
+	}
+
 	if spec.BatchFulfillmentGasMultiplier <= 0 {
 		spec.BatchFulfillmentGasMultiplier = 1.15
 	}
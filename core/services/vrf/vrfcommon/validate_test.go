@@ -433,6 +433,75 @@ decode_log->vrf->encode_tx->submit_tx
 				require.Equal(t, "0xB3b7874F13387D44a3398D298B075B7A3505D8d4", os.VRFSpec.BatchCoordinatorAddress.String())
 			},
 		},
+		{
+			name: "batch fulfillment max wait blocks, batch fulfillment disabled",
+			toml: `
+			type            = "vrf"
+			schemaVersion   = 1
+			minIncomingConfirmations = 10
+			batchFulfillmentMaxWaitBlocks = 3
+			publicKey = "0x79BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F8179800"
+			coordinatorAddress = "0xB3b7874F13387D44a3398D298B075B7A3505D8d4"
+			externalJobID = "0eec7e1d-d0d2-476c-a1a8-72dfb6633f46"
+			observationSource = """
+			decode_log   [type=ethabidecodelog
+						  abi="RandomnessRequest(bytes32 keyHash,uint256 seed,bytes32 indexed jobID,address sender,uint256 fee,bytes32 requestID)"
+						  data="$(jobRun.logData)"
+						  topics="$(jobRun.logTopics)"]
+			vrf          [type=vrf
+						  publicKey="$(jobSpec.publicKey)"
+						  requestBlockHash="$(jobRun.logBlockHash)"
+						  requestBlockNumber="$(jobRun.logBlockNumber)"
+						  topics="$(jobRun.logTopics)"]
+			encode_tx    [type=ethabiencode
+						  abi="fulfillRandomnessRequest(bytes proof)"
+						  data="{\\"proof\\": $(vrf)}"]
+			submit_tx  [type=ethtx to="%s"
+						data="$(encode_tx)"
+						txMeta="{\\"requestTxHash\\": $(jobRun.logTxHash),\\"requestID\\": $(decode_log.requestID),\\"jobID\\": $(jobSpec.databaseID)}"]
+			decode_log->vrf->encode_tx->submit_tx
+			"""
+			`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.ErrorContains(t, err, "batchFulfillmentMaxWaitBlocks requires batchFulfillmentEnabled")
+			},
+		},
+		{
+			name: "batch fulfillment max wait blocks, batch fulfillment enabled",
+			toml: `
+			type            = "vrf"
+			schemaVersion   = 1
+			minIncomingConfirmations = 10
+			batchFulfillmentEnabled = true
+			batchFulfillmentMaxWaitBlocks = 3
+			batchCoordinatorAddress = "0xB3b7874F13387D44a3398D298B075B7A3505D8d4"
+			publicKey = "0x79BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F8179800"
+			coordinatorAddress = "0xB3b7874F13387D44a3398D298B075B7A3505D8d4"
+			externalJobID = "0eec7e1d-d0d2-476c-a1a8-72dfb6633f46"
+			observationSource = """
+			decode_log   [type=ethabidecodelog
+						  abi="RandomnessRequest(bytes32 keyHash,uint256 seed,bytes32 indexed jobID,address sender,uint256 fee,bytes32 requestID)"
+						  data="$(jobRun.logData)"
+						  topics="$(jobRun.logTopics)"]
+			vrf          [type=vrf
+						  publicKey="$(jobSpec.publicKey)"
+						  requestBlockHash="$(jobRun.logBlockHash)"
+						  requestBlockNumber="$(jobRun.logBlockNumber)"
+						  topics="$(jobRun.logTopics)"]
+			encode_tx    [type=ethabiencode
+						  abi="fulfillRandomnessRequest(bytes proof)"
+						  data="{\\"proof\\": $(vrf)}"]
+			submit_tx  [type=ethtx to="%s"
+						data="$(encode_tx)"
+						txMeta="{\\"requestTxHash\\": $(jobRun.logTxHash),\\"requestID\\": $(decode_log.requestID),\\"jobID\\": $(jobSpec.databaseID)}"]
+			decode_log->vrf->encode_tx->submit_tx
+			"""
+			`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.NoError(t, err)
+				require.Equal(t, uint32(3), os.VRFSpec.BatchFulfillmentMaxWaitBlocks)
+			},
+		},
 		{
 			name: "initial delay must be <= max delay, invalid",
 			toml: `
@@ -0,0 +1,72 @@
+package apitokens
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/lib/pq"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/auth"
+)
+
+// Scope is a set of permissions granted by an API token. The permissions of a token are further limited by the role
+// of its owner.
+type Scope string
+
+const (
+	// ScopeReadOnly grants read access to the API. It is implied by all other scopes.
+	ScopeReadOnly Scope = "read-only"
+	// ScopeJobManagement grants the management of jobs, job runs, bridges and external initiators.
+	ScopeJobManagement Scope = "job-management"
+	// ScopeKeyManagement grants the management of keys.
+	ScopeKeyManagement Scope = "key-management"
+	// ScopeTxManagement grants the creation of transactions and the management of forwarders and chain data.
+	ScopeTxManagement Scope = "tx-management"
+)
+
+// Scopes lists all the scopes an API token can be granted.
+var Scopes = []Scope{ScopeReadOnly, ScopeJobManagement, ScopeKeyManagement, ScopeTxManagement}
+
+// ParseScope returns the Scope named s.
+func ParseScope(s string) (Scope, error) {
+	scope := Scope(s)
+	if !slices.Contains(Scopes, scope) {
+		return "", fmt.Errorf("invalid API token scope %q, must be one of %v", s, Scopes)
+	}
+	return scope, nil
+}
+
+// APIToken is a scoped API token of a user.
+type APIToken struct {
+	ID           int64
+	Name         string
+	UserEmail    string
+	AccessKey    string
+	Salt         string
+	HashedSecret string
+	Scopes       pq.StringArray
+	ExpiresAt    null.Time
+	CreatedAt    time.Time
+}
+
+// HasScope returns true if the token is granted scope.
+func (t *APIToken) HasScope(scope Scope) bool {
+	return scope == ScopeReadOnly || slices.Contains(t.Scopes, string(scope))
+}
+
+// Expired returns true if the token has expired at now.
+func (t *APIToken) Expired(now time.Time) bool {
+	return t.ExpiresAt.Valid && !now.Before(t.ExpiresAt.Time)
+}
+
+// Authenticate returns true if token is the secret of t.
+func (t *APIToken) Authenticate(token *auth.Token) (bool, error) {
+	hashedSecret, err := auth.HashedSecret(token, t.Salt)
+	if err != nil {
+		return false, err
+	}
+	return subtle.ConstantTimeCompare([]byte(hashedSecret), []byte(t.HashedSecret)) == 1, nil
+}
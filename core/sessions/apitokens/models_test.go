@@ -0,0 +1,33 @@
+package apitokens_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/apitokens"
+)
+
+func TestParseScope(t *testing.T) {
+	t.Parallel()
+
+	for _, scope := range apitokens.Scopes {
+		parsed, err := apitokens.ParseScope(string(scope))
+		require.NoError(t, err)
+		assert.Equal(t, scope, parsed)
+	}
+	_, err := apitokens.ParseScope("admin")
+	require.Error(t, err)
+}
+
+func TestAPIToken_Expired(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	assert.False(t, (&apitokens.APIToken{}).Expired(now))
+	assert.False(t, (&apitokens.APIToken{ExpiresAt: null.TimeFrom(now.Add(time.Second))}).Expired(now))
+	assert.True(t, (&apitokens.APIToken{ExpiresAt: null.TimeFrom(now)}).Expired(now))
+}
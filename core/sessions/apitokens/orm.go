@@ -0,0 +1,83 @@
+package apitokens
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+	pkgerrors "github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+type ORM interface {
+	// CreateAPIToken creates a new token for the user with email, and returns it along with its secret, which is not
+	// stored.
+	CreateAPIToken(ctx context.Context, email, name string, scopes []Scope, expiresAt null.Time) (APIToken, *auth.Token, error)
+	// FindAPIToken returns the token with accessKey.
+	FindAPIToken(ctx context.Context, accessKey string) (APIToken, error)
+	// ListAPITokens returns the tokens of the user with email.
+	ListAPITokens(ctx context.Context, email string) ([]APIToken, error)
+	// DeleteAPIToken deletes the token with id of the user with email.
+	DeleteAPIToken(ctx context.Context, email string, id int64) error
+}
+
+type orm struct {
+	ds sqlutil.DataSource
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(ds sqlutil.DataSource) ORM {
+	return &orm{ds: ds}
+}
+
+func (o *orm) CreateAPIToken(ctx context.Context, email, name string, scopes []Scope, expiresAt null.Time) (APIToken, *auth.Token, error) {
+	token := auth.NewToken()
+	salt := utils.NewSecret(utils.DefaultSecretSize)
+	hashedSecret, err := auth.HashedSecret(token, salt)
+	if err != nil {
+		return APIToken{}, nil, pkgerrors.Wrap(err, "failed to hash API token secret")
+	}
+	scopeNames := make(pq.StringArray, len(scopes))
+	for i, scope := range scopes {
+		scopeNames[i] = string(scope)
+	}
+
+	var apiToken APIToken
+	stmt := `INSERT INTO api_tokens (name, user_email, access_key, salt, hashed_secret, scopes, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING *`
+	if err = o.ds.GetContext(ctx, &apiToken, stmt, name, email, token.AccessKey, salt, hashedSecret, scopeNames, expiresAt); err != nil {
+		return APIToken{}, nil, pkgerrors.Wrap(err, "failed to create API token")
+	}
+	return apiToken, token, nil
+}
+
+func (o *orm) FindAPIToken(ctx context.Context, accessKey string) (apiToken APIToken, err error) {
+	err = o.ds.GetContext(ctx, &apiToken, `SELECT * FROM api_tokens WHERE access_key = $1`, accessKey)
+	return
+}
+
+func (o *orm) ListAPITokens(ctx context.Context, email string) (apiTokens []APIToken, err error) {
+	err = o.ds.SelectContext(ctx, &apiTokens, `SELECT * FROM api_tokens WHERE lower(user_email) = lower($1) ORDER BY id`, email)
+	return
+}
+
+func (o *orm) DeleteAPIToken(ctx context.Context, email string, id int64) error {
+	result, err := o.ds.ExecContext(ctx, `DELETE FROM api_tokens WHERE id = $1 AND lower(user_email) = lower($2)`, id, email)
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed to delete API token")
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
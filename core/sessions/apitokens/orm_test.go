@@ -0,0 +1,47 @@
+package apitokens_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/apitokens"
+)
+
+func TestORM_APITokens(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	orm := apitokens.NewORM(pgtest.NewSqlxDB(t))
+	email := "token-owner@example.com"
+	expiresAt := null.TimeFrom(time.Now().Add(time.Hour))
+
+	created, secret, err := orm.CreateAPIToken(ctx, email, "ci", []apitokens.Scope{apitokens.ScopeJobManagement}, expiresAt)
+	require.NoError(t, err)
+	assert.Equal(t, "ci", created.Name)
+	assert.Equal(t, secret.AccessKey, created.AccessKey)
+	assert.True(t, created.HasScope(apitokens.ScopeJobManagement))
+	assert.False(t, created.HasScope(apitokens.ScopeKeyManagement))
+
+	found, err := orm.FindAPIToken(ctx, secret.AccessKey)
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, found.ID)
+	ok, err := found.Authenticate(secret)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	tokens, err := orm.ListAPITokens(ctx, "Token-Owner@example.com")
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+
+	require.ErrorIs(t, orm.DeleteAPIToken(ctx, "someone-else@example.com", created.ID), sql.ErrNoRows)
+	require.NoError(t, orm.DeleteAPIToken(ctx, email, created.ID))
+	_, err = orm.FindAPIToken(ctx, secret.AccessKey)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/v2/core/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
@@ -15,6 +16,7 @@ type AuthenticationProviderName string
 const (
 	LocalAuth AuthenticationProviderName = "local"
 	LDAPAuth  AuthenticationProviderName = "ldap"
+	OIDCAuth  AuthenticationProviderName = "oidc"
 )
 
 // ErrUserSessionExpired defines the error triggered when the user session has expired
@@ -46,6 +48,7 @@ type AuthenticationProvider interface {
 	AuthorizedUserWithSession(ctx context.Context, sessionID string) (User, error)
 	DeleteUser(ctx context.Context, email string) error
 	DeleteUserSession(ctx context.Context, sessionID string) error
+	RevokeUserSessions(ctx context.Context, email string) error
 	CreateSession(ctx context.Context, sr SessionRequest) (string, error)
 	ClearNonCurrentSessions(ctx context.Context, sessionID string) error
 	CreateUser(ctx context.Context, user *User) error
@@ -61,3 +64,26 @@ type AuthenticationProvider interface {
 
 	FindExternalInitiator(ctx context.Context, eia *auth.Token) (initiator *bridges.ExternalInitiator, err error)
 }
+
+// OIDCLogin is a login started at the identity provider. It is kept in a cookie of the browser which started it, so
+// that only that browser can complete the login
+type OIDCLogin struct {
+	State        string
+	Nonce        string
+	CodeVerifier string
+	ExpiresAt    time.Time
+}
+
+// OIDCAuthenticationProvider is the AuthenticationProvider of the 'oidc' AuthenticationMethod, which logs users in
+// through the authorization code flow of an OpenID Connect identity provider instead of with a password
+type OIDCAuthenticationProvider interface {
+	AuthenticationProvider
+	// LoginURL starts a login, returning the URL of the identity provider to redirect the user to, and the login to
+	// keep in the browser of the user until the callback
+	LoginURL(ctx context.Context) (string, OIDCLogin, error)
+	// CreateOIDCSession completes the login started by the browser of the user redirected back by the identity
+	// provider with the state and authorization code, and returns the ID of the new session
+	CreateOIDCSession(ctx context.Context, login OIDCLogin, state, code string) (string, error)
+	// BackchannelLogout revokes the sessions identified by a logout token sent by the identity provider
+	BackchannelLogout(ctx context.Context, logoutToken string) error
+}
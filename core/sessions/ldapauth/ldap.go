@@ -382,6 +382,12 @@ func (l *ldapAuthenticator) DeleteUserSession(ctx context.Context, sessionID str
 	return err
 }
 
+// RevokeUserSessions removes all ldap_sessions entries of the user by email
+func (l *ldapAuthenticator) RevokeUserSessions(ctx context.Context, email string) error {
+	_, err := l.ds.ExecContext(ctx, "DELETE FROM ldap_sessions WHERE lower(user_email) = lower($1)", email)
+	return err
+}
+
 // GetUserWebAuthn returns an empty stub, MFA token prompt is handled either by the upstream
 // server blocking callback, or an error code to pass a OTP
 func (l *ldapAuthenticator) GetUserWebAuthn(ctx context.Context, email string) ([]sessions.WebAuthn, error) {
@@ -122,6 +122,12 @@ func (o *orm) DeleteUserSession(ctx context.Context, sessionID string) error {
 	return err
 }
 
+// RevokeUserSessions will delete all the sessions of a user by email.
+func (o *orm) RevokeUserSessions(ctx context.Context, email string) error {
+	_, err := o.ds.ExecContext(ctx, "DELETE FROM sessions WHERE lower(email) = lower($1)", email)
+	return err
+}
+
 // GetUserWebAuthn will return a list of structures representing all enrolled WebAuthn
 // tokens for the user. This list must be used when logging in (for obvious reasons) but
 // must also be used for registration to prevent the user from enrolling the same hardware
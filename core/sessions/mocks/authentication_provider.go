@@ -724,6 +724,53 @@ func (_c *AuthenticationProvider_ListUsers_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// RevokeUserSessions provides a mock function with given fields: ctx, email
+func (_m *AuthenticationProvider) RevokeUserSessions(ctx context.Context, email string) error {
+	ret := _m.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeUserSessions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthenticationProvider_RevokeUserSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeUserSessions'
+type AuthenticationProvider_RevokeUserSessions_Call struct {
+	*mock.Call
+}
+
+// RevokeUserSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *AuthenticationProvider_Expecter) RevokeUserSessions(ctx interface{}, email interface{}) *AuthenticationProvider_RevokeUserSessions_Call {
+	return &AuthenticationProvider_RevokeUserSessions_Call{Call: _e.mock.On("RevokeUserSessions", ctx, email)}
+}
+
+func (_c *AuthenticationProvider_RevokeUserSessions_Call) Run(run func(ctx context.Context, email string)) *AuthenticationProvider_RevokeUserSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AuthenticationProvider_RevokeUserSessions_Call) Return(_a0 error) *AuthenticationProvider_RevokeUserSessions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthenticationProvider_RevokeUserSessions_Call) RunAndReturn(run func(context.Context, string) error) *AuthenticationProvider_RevokeUserSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SaveWebAuthn provides a mock function with given fields: ctx, token
 func (_m *AuthenticationProvider) SaveWebAuthn(ctx context.Context, token *sessions.WebAuthn) error {
 	ret := _m.Called(ctx, token)
@@ -0,0 +1,547 @@
+/*
+The OIDC authentication package logs users in through the authorization code flow of a configured upstream
+OpenID Connect identity provider, instead of with a password
+
+	/oidc/login:    Redirects the user to the identity provider with the state, nonce and PKCE challenge of the login
+	/oidc/callback: Exchanges the authorization code for the ID token of the user, and creates the session
+
+The role of the user is mapped from the groups listed in the GroupsClaim of the ID token, and is cached with the
+session in the oidc_sessions table. Sessions expire after the configured SessionTimeout of idle time, and are revoked
+by the identity provider with OpenID Connect back-channel logout, or by an admin of the node.
+
+The users table of local admin users is still supported, for the initial node setup and the CLI: local users log in
+with their password and own the sessions and API tokens of the localauth implementation. Users of the identity
+provider are read only; user mutation actions such as Create are not supported.
+
+The state, nonce and PKCE code verifier of a login are kept in a cookie of the browser which started it, and the state
+must match at the callback, so that a login can only be completed by that browser. Logout tokens are only accepted
+once, the IDs of the accepted ones being kept in memory until they expire.
+*/
+package oidcauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink/v2/core/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+const (
+	// loginTimeout is how long the user has to log in at the identity provider
+	loginTimeout = 10 * time.Minute
+	// logoutTokenMaxAge is how long logout tokens without an expiry are accepted after they were issued
+	logoutTokenMaxAge = 10 * time.Minute
+	// maxUsedLogoutTokens bounds the IDs of the accepted logout tokens kept in memory until they expire
+	maxUsedLogoutTokens = 10_000
+	// backchannelLogoutEvent is the event of the logout tokens sent by the identity provider
+	backchannelLogoutEvent = "http://schemas.openid.net/event/backchannel-logout"
+)
+
+var ErrUserNoOIDCGroups = errors.New("user authenticated, but matching no role groups assigned")
+var ErrLoginExpired = errors.New("login expired or unknown, please login again")
+var ErrLoginStateMismatch = errors.New("login was not started by this browser, please login again")
+
+type oidcAuthenticator struct {
+	ds          sqlutil.DataSource
+	local       sessions.AuthenticationProvider
+	idp         *identityProvider
+	config      config.OIDC
+	lggr        logger.Logger
+	auditLogger audit.AuditLogger
+
+	mu               sync.Mutex
+	usedLogoutTokens map[string]time.Time // expiry by jti
+}
+
+// oidcAuthenticator implements sessions.OIDCAuthenticationProvider interface
+var _ sessions.OIDCAuthenticationProvider = (*oidcAuthenticator)(nil)
+
+// NewOIDCAuthenticator returns the OIDC authentication provider, local handling the local admin users.
+func NewOIDCAuthenticator(
+	ds sqlutil.DataSource,
+	oidcCfg config.OIDC,
+	local sessions.AuthenticationProvider,
+	dev bool,
+	lggr logger.Logger,
+	auditLogger audit.AuditLogger,
+) (*oidcAuthenticator, error) {
+	if oidcCfg.IssuerURL() == nil || oidcCfg.ClientID() == "" || oidcCfg.RedirectURL() == nil {
+		return nil, errors.New("OIDC IssuerURL, ClientID and RedirectURL config required")
+	}
+	// If not chainlink dev and not tls, error
+	if !dev && oidcCfg.IssuerURL().Scheme != "https" {
+		return nil, errors.New("OIDC Authentication driver requires an https IssuerURL when running in Production mode")
+	}
+	// Ensure all RBAC role mappings to OIDC groups are defined, or error on startup
+	if oidcCfg.AdminUserGroup() == "" || oidcCfg.EditUserGroup() == "" ||
+		oidcCfg.RunUserGroup() == "" || oidcCfg.ReadUserGroup() == "" {
+		return nil, errors.New("OIDC group mapping from identity provider group name for all local RBAC role required. Set group names for `_UserGroup` fields")
+	}
+
+	return &oidcAuthenticator{
+		ds:    ds,
+		local: local,
+		idp: newIdentityProvider(oidcCfg.IssuerURL(), oidcCfg.ClientID(), oidcCfg.ClientSecret(),
+			oidcCfg.RedirectURL(), oidcCfg.Scopes(), oidcCfg.QueryTimeout()),
+		config:           oidcCfg,
+		lggr:             lggr.Named("OIDCAuthenticationProvider"),
+		auditLogger:      auditLogger,
+		usedLogoutTokens: make(map[string]time.Time),
+	}, nil
+}
+
+// LoginURL starts a login, returning the URL of the identity provider to redirect the user to, and the login to keep
+// in the browser of the user
+func (o *oidcAuthenticator) LoginURL(ctx context.Context) (string, sessions.OIDCLogin, error) {
+	login := sessions.OIDCLogin{
+		State:        randomString(),
+		Nonce:        randomString(),
+		CodeVerifier: randomString(),
+		ExpiresAt:    time.Now().Add(loginTimeout),
+	}
+	u, err := o.idp.authCodeURL(ctx, login.State, login.Nonce, login.CodeVerifier)
+	if err != nil {
+		o.lggr.Errorf("unable to start OIDC login: %v", err)
+		return "", sessions.OIDCLogin{}, errors.New("unable to reach OIDC identity provider")
+	}
+	return u, login, nil
+}
+
+// CreateOIDCSession completes the login kept by the browser of the user, if the state returned by the identity
+// provider matches it, exchanging the authorization code for the ID token of the user, and saves the session with the
+// role mapped from the groups of the user
+func (o *oidcAuthenticator) CreateOIDCSession(ctx context.Context, login sessions.OIDCLogin, state, code string) (string, error) {
+	if login.State == "" || time.Now().After(login.ExpiresAt) {
+		return "", ErrLoginExpired
+	}
+	if subtle.ConstantTimeCompare([]byte(state), []byte(login.State)) != 1 {
+		o.lggr.Infof("Rejected OIDC login callback with a state not matching the login of the browser")
+		return "", ErrLoginStateMismatch
+	}
+
+	rawIDToken, err := o.idp.exchange(ctx, code, login.CodeVerifier)
+	if err != nil {
+		o.lggr.Infof("Error exchanging OIDC authorization code: %v", err)
+		return "", errors.New("unable to log in with OIDC identity provider")
+	}
+	claims, err := o.idp.verify(ctx, rawIDToken, jwt.WithExpirationRequired(), jwt.WithIssuedAt())
+	if err != nil {
+		o.lggr.Infof("Error verifying OIDC ID token: %v", err)
+		return "", errors.New("unable to log in with OIDC identity provider, invalid ID token")
+	}
+	if nonce, _ := claims["nonce"].(string); subtle.ConstantTimeCompare([]byte(nonce), []byte(login.Nonce)) != 1 {
+		return "", errors.New("unable to log in with OIDC identity provider, invalid ID token nonce")
+	}
+
+	email, _ := claims[o.config.EmailClaim()].(string)
+	email = strings.ToLower(email)
+	if email == "" {
+		return "", fmt.Errorf("ID token has no %s claim", o.config.EmailClaim())
+	}
+	// the email must be verified by the identity provider, which is unknown when the claim is missing
+	if verified, _ := claims["email_verified"].(bool); !verified {
+		o.auditLogger.Audit(audit.AuthLoginFailedEmail, map[string]interface{}{"email": email})
+		return "", errors.New("email of the user is not verified by the OIDC identity provider")
+	}
+	subject, _ := claims["sub"].(string)
+	idpSessionID, _ := claims["sid"].(string)
+
+	role, err := o.groupsToUserRole(claimStrings(claims[o.config.GroupsClaim()]))
+	if err != nil {
+		o.lggr.Infof("Successful OIDC login, but no assigned groups to assume role: user: %s", email)
+		return "", errors.New("log in successful, but no assigned groups to assume role")
+	}
+
+	o.lggr.Infof("Successful OIDC login request for user %s - %s", email, role)
+
+	// Save session, user, and role to database. Given a session ID for future queries, the identity provider will not
+	// be queried
+	session := sessions.NewSession()
+	_, err = o.ds.ExecContext(ctx,
+		"INSERT INTO oidc_sessions (id, user_email, user_role, subject, idp_session_id, last_used, created_at) VALUES ($1, $2, $3, $4, $5, now(), now())",
+		session.ID, email, role, subject, sql.NullString{String: idpSessionID, Valid: idpSessionID != ""},
+	)
+	if err != nil {
+		o.lggr.Errorf("unable to create new session in oidc_sessions table %v", err)
+		return "", fmt.Errorf("error creating local OIDC session: %w", err)
+	}
+
+	o.auditLogger.Audit(audit.AuthLoginSuccessNo2FA, map[string]interface{}{"email": email})
+	return session.ID, nil
+}
+
+// BackchannelLogout revokes the sessions of the identity provider session or user identified by the logout token,
+// which is only accepted once
+func (o *oidcAuthenticator) BackchannelLogout(ctx context.Context, logoutToken string) error {
+	claims, err := o.idp.verify(ctx, logoutToken, jwt.WithIssuedAt())
+	if err != nil {
+		return fmt.Errorf("invalid logout token: %w", err)
+	}
+	if events, _ := claims["events"].(map[string]interface{}); events == nil || events[backchannelLogoutEvent] == nil {
+		return errors.New("invalid logout token: missing back-channel logout event")
+	}
+	if _, ok := claims["nonce"]; ok {
+		return errors.New("invalid logout token: nonce is not allowed")
+	}
+	subject, _ := claims["sub"].(string)
+	idpSessionID, _ := claims["sid"].(string)
+	if subject == "" && idpSessionID == "" {
+		return errors.New("invalid logout token: missing sub and sid claims")
+	}
+
+	jti, _ := claims["jti"].(string)
+	if jti == "" {
+		return errors.New("invalid logout token: missing jti claim")
+	}
+	expiresAt, err := logoutTokenExpiry(claims)
+	if err != nil {
+		return err
+	}
+	if err = o.useLogoutToken(jti, expiresAt); err != nil {
+		return err
+	}
+
+	var result sql.Result
+	switch {
+	case idpSessionID != "" && subject != "":
+		result, err = o.ds.ExecContext(ctx, "DELETE FROM oidc_sessions WHERE idp_session_id = $1 AND subject = $2", idpSessionID, subject)
+	case idpSessionID != "":
+		result, err = o.ds.ExecContext(ctx, "DELETE FROM oidc_sessions WHERE idp_session_id = $1", idpSessionID)
+	default:
+		result, err = o.ds.ExecContext(ctx, "DELETE FROM oidc_sessions WHERE subject = $1", subject)
+	}
+	if err != nil {
+		// Let the identity provider retry the logout with the same token
+		o.forgetLogoutToken(jti)
+		return fmt.Errorf("error revoking OIDC sessions: %w", err)
+	}
+	revoked, _ := result.RowsAffected()
+	o.auditLogger.Audit(audit.AuthSessionsRevoked, map[string]interface{}{"subject": subject, "sid": idpSessionID, "revoked": revoked})
+	return nil
+}
+
+// FindUser returns the local admin user of the email, or the user of its latest OIDC session
+func (o *oidcAuthenticator) FindUser(ctx context.Context, email string) (sessions.User, error) {
+	user, err := o.local.FindUser(ctx, email)
+	if err == nil {
+		return user, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		o.lggr.Errorf("error searching users table: %v", err)
+		return sessions.User{}, errors.New("error Finding user")
+	}
+
+	var foundSession struct {
+		UserEmail string
+		UserRole  sessions.UserRole
+	}
+	err = o.ds.GetContext(ctx, &foundSession,
+		"SELECT user_email, user_role FROM oidc_sessions WHERE lower(user_email) = lower($1) ORDER BY created_at DESC LIMIT 1", email)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return sessions.User{}, errors.New("no users found with provided email")
+		}
+		return sessions.User{}, err
+	}
+	return sessions.User{Email: foundSession.UserEmail, Role: foundSession.UserRole}, nil
+}
+
+// FindUserByAPIToken retrieves the local admin user of the API token, as only local users can create API tokens
+func (o *oidcAuthenticator) FindUserByAPIToken(ctx context.Context, apiToken string) (sessions.User, error) {
+	return o.local.FindUserByAPIToken(ctx, apiToken)
+}
+
+// ListUsers returns the local admin users, extended with the users of the OIDC sessions
+func (o *oidcAuthenticator) ListUsers(ctx context.Context) ([]sessions.User, error) {
+	users, err := o.local.ListUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var sessionUsers []struct {
+		UserEmail string
+		UserRole  sessions.UserRole
+	}
+	err = o.ds.SelectContext(ctx, &sessionUsers, `SELECT DISTINCT ON (lower(user_email)) user_email, user_role FROM oidc_sessions
+		WHERE lower(user_email) NOT IN (SELECT lower(email) FROM users) ORDER BY lower(user_email), created_at DESC`)
+	if err != nil {
+		o.lggr.Error("error extending local admin users with OIDC session users: ", err)
+		return users, nil
+	}
+	for _, u := range sessionUsers {
+		users = append(users, sessions.User{Email: u.UserEmail, Role: u.UserRole})
+	}
+	return users, nil
+}
+
+// AuthorizedUserWithSession will return the user of the OIDC session or the local session of the ID, if it exists and
+// hasn't expired, and update the session's last_used field
+func (o *oidcAuthenticator) AuthorizedUserWithSession(ctx context.Context, sessionID string) (sessions.User, error) {
+	if len(sessionID) == 0 {
+		return sessions.User{}, sessions.ErrEmptySessionID
+	}
+	var foundSession struct {
+		UserEmail string
+		UserRole  sessions.UserRole
+	}
+	err := o.ds.GetContext(ctx, &foundSession,
+		"UPDATE oidc_sessions SET last_used = now() WHERE id = $1 AND last_used + $2 >= now() RETURNING user_email, user_role",
+		sessionID, o.config.SessionTimeout().Duration(),
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		// Not an active OIDC session, so a session of a local admin user or expired
+		return o.local.AuthorizedUserWithSession(ctx, sessionID)
+	}
+	if err != nil {
+		return sessions.User{}, err
+	}
+	return sessions.User{
+		Email: foundSession.UserEmail,
+		Role:  foundSession.UserRole,
+	}, nil
+}
+
+// DeleteUser deletes a local admin user, as the users of the identity provider are read only
+func (o *oidcAuthenticator) DeleteUser(ctx context.Context, email string) error {
+	if err := o.requireLocalUser(ctx, email); err != nil {
+		return err
+	}
+	return o.local.DeleteUser(ctx, email)
+}
+
+// DeleteUserSession removes an OIDC or local session by ID
+func (o *oidcAuthenticator) DeleteUserSession(ctx context.Context, sessionID string) error {
+	if _, err := o.ds.ExecContext(ctx, "DELETE FROM oidc_sessions WHERE id = $1", sessionID); err != nil {
+		return err
+	}
+	return o.local.DeleteUserSession(ctx, sessionID)
+}
+
+// RevokeUserSessions removes all the OIDC and local sessions of the user by email
+func (o *oidcAuthenticator) RevokeUserSessions(ctx context.Context, email string) error {
+	if _, err := o.ds.ExecContext(ctx, "DELETE FROM oidc_sessions WHERE lower(user_email) = lower($1)", email); err != nil {
+		return err
+	}
+	return o.local.RevokeUserSessions(ctx, email)
+}
+
+// GetUserWebAuthn returns the WebAuthn tokens of local admin users logging in with their password
+func (o *oidcAuthenticator) GetUserWebAuthn(ctx context.Context, email string) ([]sessions.WebAuthn, error) {
+	return o.local.GetUserWebAuthn(ctx, email)
+}
+
+// CreateSession logs in a local admin user with their password. Users of the identity provider log in with
+// CreateOIDCSession instead
+func (o *oidcAuthenticator) CreateSession(ctx context.Context, sr sessions.SessionRequest) (string, error) {
+	return o.local.CreateSession(ctx, sr)
+}
+
+// ClearNonCurrentSessions removes all OIDC and local sessions but the id passed in.
+func (o *oidcAuthenticator) ClearNonCurrentSessions(ctx context.Context, sessionID string) error {
+	if _, err := o.ds.ExecContext(ctx, "DELETE FROM oidc_sessions WHERE id != $1", sessionID); err != nil {
+		return err
+	}
+	return o.local.ClearNonCurrentSessions(ctx, sessionID)
+}
+
+// CreateUser is not supported for read only OIDC
+func (o *oidcAuthenticator) CreateUser(ctx context.Context, user *sessions.User) error {
+	return sessions.ErrNotSupported
+}
+
+// UpdateRole is not supported for read only OIDC
+func (o *oidcAuthenticator) UpdateRole(ctx context.Context, email, newRole string) (sessions.User, error) {
+	return sessions.User{}, sessions.ErrNotSupported
+}
+
+// SetAuthToken updates the local admin user to use the given Authentication Token.
+func (o *oidcAuthenticator) SetAuthToken(ctx context.Context, user *sessions.User, token *auth.Token) error {
+	if err := o.requireLocalUser(ctx, user.Email); err != nil {
+		return err
+	}
+	return o.local.SetAuthToken(ctx, user, token)
+}
+
+// CreateAndSetAuthToken generates a new credential token for the local admin user
+func (o *oidcAuthenticator) CreateAndSetAuthToken(ctx context.Context, user *sessions.User) (*auth.Token, error) {
+	if err := o.requireLocalUser(ctx, user.Email); err != nil {
+		return nil, err
+	}
+	return o.local.CreateAndSetAuthToken(ctx, user)
+}
+
+// DeleteAuthToken clears and disables the local admin user's Authentication Token.
+func (o *oidcAuthenticator) DeleteAuthToken(ctx context.Context, user *sessions.User) error {
+	if err := o.requireLocalUser(ctx, user.Email); err != nil {
+		return err
+	}
+	return o.local.DeleteAuthToken(ctx, user)
+}
+
+// SetPassword updates the password of a local admin user, as the users of the identity provider have no password
+func (o *oidcAuthenticator) SetPassword(ctx context.Context, user *sessions.User, newPassword string) error {
+	if err := o.requireLocalUser(ctx, user.Email); err != nil {
+		return err
+	}
+	return o.local.SetPassword(ctx, user, newPassword)
+}
+
+// TestPassword tests the password of a local admin user, returns nil if success
+func (o *oidcAuthenticator) TestPassword(ctx context.Context, email, password string) error {
+	return o.local.TestPassword(ctx, email, password)
+}
+
+// Sessions returns all OIDC sessions limited by the parameters.
+func (o *oidcAuthenticator) Sessions(ctx context.Context, offset, limit int) ([]sessions.Session, error) {
+	var oidcSessions []sessions.Session
+	err := o.ds.SelectContext(ctx, &oidcSessions,
+		`SELECT id, user_email AS email, last_used, created_at FROM oidc_sessions ORDER BY created_at, id LIMIT $1 OFFSET $2`,
+		limit, offset)
+	return oidcSessions, err
+}
+
+// SaveWebAuthn saves the WebAuthn token of a local admin user
+func (o *oidcAuthenticator) SaveWebAuthn(ctx context.Context, token *sessions.WebAuthn) error {
+	if err := o.requireLocalUser(ctx, token.Email); err != nil {
+		return err
+	}
+	return o.local.SaveWebAuthn(ctx, token)
+}
+
+// FindExternalInitiator supports the 'Run' role external intiator header auth functionality
+func (o *oidcAuthenticator) FindExternalInitiator(ctx context.Context, eia *auth.Token) (*bridges.ExternalInitiator, error) {
+	return o.local.FindExternalInitiator(ctx, eia)
+}
+
+// requireLocalUser returns sessions.ErrNotSupported unless the email is of a local admin user in the users table
+func (o *oidcAuthenticator) requireLocalUser(ctx context.Context, email string) error {
+	var isLocalUser bool
+	if err := o.ds.GetContext(ctx, &isLocalUser, "SELECT EXISTS (SELECT 1 FROM users WHERE lower(email) = lower($1))", email); err != nil {
+		return fmt.Errorf("error checking user presence in users table: %w", err)
+	}
+	if !isLocalUser {
+		return sessions.ErrNotSupported
+	}
+	return nil
+}
+
+// useLogoutToken records the jti of an accepted logout token until it expires, and errors if it was already used
+func (o *oidcAuthenticator) useLogoutToken(jti string, expiresAt time.Time) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	now := time.Now()
+	for id, exp := range o.usedLogoutTokens {
+		if now.After(exp) {
+			delete(o.usedLogoutTokens, id)
+		}
+	}
+	if _, ok := o.usedLogoutTokens[jti]; ok {
+		return errors.New("invalid logout token: already used")
+	}
+	if len(o.usedLogoutTokens) >= maxUsedLogoutTokens {
+		return errors.New("too many logout tokens, please try again later")
+	}
+	o.usedLogoutTokens[jti] = expiresAt
+	return nil
+}
+
+func (o *oidcAuthenticator) forgetLogoutToken(jti string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	delete(o.usedLogoutTokens, jti)
+}
+
+// logoutTokenExpiry returns the expiry of the logout token, which is limited to logoutTokenMaxAge after it was issued
+// for tokens without an exp claim
+func logoutTokenExpiry(claims jwt.MapClaims) (time.Time, error) {
+	exp, err := claims.GetExpirationTime()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid logout token: %w", err)
+	}
+	if exp != nil {
+		return exp.Time, nil
+	}
+	iat, err := claims.GetIssuedAt()
+	if err != nil || iat == nil {
+		return time.Time{}, errors.New("invalid logout token: missing exp and iat claims")
+	}
+	expiresAt := iat.Add(logoutTokenMaxAge)
+	if time.Now().After(expiresAt) {
+		return time.Time{}, errors.New("invalid logout token: issued too long ago")
+	}
+	return expiresAt, nil
+}
+
+// groupsToUserRole returns the highest role mapped from the groups of the user
+func (o *oidcAuthenticator) groupsToUserRole(groups []string) (sessions.UserRole, error) {
+	return GroupsToUserRole(groups, o.config.AdminUserGroup(), o.config.EditUserGroup(), o.config.RunUserGroup(), o.config.ReadUserGroup())
+}
+
+func GroupsToUserRole(groups []string, adminGroup, editGroup, runGroup, readGroup string) (sessions.UserRole, error) {
+	for _, mapping := range []struct {
+		group string
+		role  sessions.UserRole
+	}{
+		{adminGroup, sessions.UserRoleAdmin},
+		{editGroup, sessions.UserRoleEdit},
+		{runGroup, sessions.UserRoleRun},
+		{readGroup, sessions.UserRoleView},
+	} {
+		for _, group := range groups {
+			if group == mapping.group {
+				return mapping.role, nil
+			}
+		}
+	}
+	// No role group found, error
+	return sessions.UserRoleView, ErrUserNoOIDCGroups
+}
+
+// claimStrings returns the strings of a claim which is either a string or a list of strings
+func claimStrings(claim interface{}) []string {
+	switch v := claim.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		strs := make([]string, 0, len(v))
+		for _, elem := range v {
+			if s, ok := elem.(string); ok {
+				strs = append(strs, s)
+			}
+		}
+		return strs
+	default:
+		return nil
+	}
+}
+
+// randomString returns 32 random bytes, encoded in the URL safe format of the state, nonce and PKCE code verifier
+func randomString() string {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		panic(fmt.Errorf("generating random string failed: %w", err))
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// codeChallenge returns the S256 PKCE challenge of the code verifier
+func codeChallenge(codeVerifier string) string {
+	sum := sha256.Sum256([]byte(codeVerifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
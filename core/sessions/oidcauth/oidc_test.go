@@ -0,0 +1,467 @@
+package oidcauth_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jmoiron/sqlx"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/localauth"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/oidcauth"
+)
+
+const (
+	testClientID     = "chainlink-node"
+	testClientSecret = "secret"
+	testKeyID        = "test-key"
+)
+
+// Implements config.OIDC
+type testConfig struct {
+	issuer *url.URL
+}
+
+func (c *testConfig) IssuerURL() *url.URL  { return c.issuer }
+func (c *testConfig) ClientID() string     { return testClientID }
+func (c *testConfig) ClientSecret() string { return testClientSecret }
+func (c *testConfig) RedirectURL() *url.URL {
+	return &url.URL{Scheme: "https", Host: "node.example.com", Path: "/oidc/callback"}
+}
+func (c *testConfig) Scopes() []string       { return []string{"openid", "email", "groups"} }
+func (c *testConfig) EmailClaim() string     { return "email" }
+func (c *testConfig) GroupsClaim() string    { return "groups" }
+func (c *testConfig) AdminUserGroup() string { return "NodeAdmins" }
+func (c *testConfig) EditUserGroup() string  { return "NodeEditors" }
+func (c *testConfig) RunUserGroup() string   { return "NodeRunners" }
+func (c *testConfig) ReadUserGroup() string  { return "NodeReadOnly" }
+func (c *testConfig) SessionTimeout() commonconfig.Duration {
+	return *commonconfig.MustNewDuration(15 * time.Minute)
+}
+func (c *testConfig) QueryTimeout() time.Duration { return 5 * time.Second }
+
+// testIdentityProvider is a fake OIDC identity provider, serving its configuration, signing key, and the ID token of
+// the next login from its token endpoint
+type testIdentityProvider struct {
+	*httptest.Server
+	key *rsa.PrivateKey
+
+	mu            sync.Mutex
+	claims        jwt.MapClaims
+	codeChallenge string
+}
+
+func newTestIdentityProvider(t *testing.T) *testIdentityProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	idp := &testIdentityProvider{key: key}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, map[string]string{
+			"issuer":                 idp.URL,
+			"authorization_endpoint": idp.URL + "/authorize",
+			"token_endpoint":         idp.URL + "/token",
+			"jwks_uri":               idp.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, map[string]interface{}{"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": testKeyID,
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		clientID, clientSecret, _ := r.BasicAuth()
+		if clientID != testClientID || clientSecret != testClientSecret {
+			w.WriteHeader(http.StatusUnauthorized)
+			writeJSON(t, w, map[string]string{"error": "invalid_client"})
+			return
+		}
+		idp.mu.Lock()
+		defer idp.mu.Unlock()
+		sum := sha256.Sum256([]byte(r.PostFormValue("code_verifier")))
+		if r.PostFormValue("code") != "code" || base64.RawURLEncoding.EncodeToString(sum[:]) != idp.codeChallenge {
+			w.WriteHeader(http.StatusBadRequest)
+			writeJSON(t, w, map[string]string{"error": "invalid_grant"})
+			return
+		}
+		writeJSON(t, w, map[string]string{"id_token": idp.sign(t, idp.key, idp.claims)})
+	})
+	idp.Server = httptest.NewServer(mux)
+	t.Cleanup(idp.Close)
+	return idp
+}
+
+func (idp *testIdentityProvider) sign(t *testing.T, key *rsa.PrivateKey, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = testKeyID
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
+
+// idTokenClaims returns the claims of a valid ID token of the user
+func (idp *testIdentityProvider) idTokenClaims(subject, email string, groups ...string) jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":            idp.URL,
+		"aud":            testClientID,
+		"sub":            subject,
+		"email":          email,
+		"email_verified": true,
+		"groups":         groups,
+		"iat":            time.Now().Unix(),
+		"exp":            time.Now().Add(time.Hour).Unix(),
+	}
+}
+
+// logoutTokenClaims returns the claims of a valid logout token of the user or identity provider session
+func (idp *testIdentityProvider) logoutTokenClaims(subject, sid string) jwt.MapClaims {
+	claims := jwt.MapClaims{
+		"iss":    idp.URL,
+		"aud":    testClientID,
+		"iat":    time.Now().Unix(),
+		"jti":    uuid.NewString(),
+		"events": map[string]interface{}{"http://schemas.openid.net/event/backchannel-logout": map[string]interface{}{}},
+	}
+	if subject != "" {
+		claims["sub"] = subject
+	}
+	if sid != "" {
+		claims["sid"] = sid
+	}
+	return claims
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	require.NoError(t, json.NewEncoder(w).Encode(v))
+}
+
+// Setup OIDC Auth authenticator
+func setupAuthenticationProvider(t *testing.T) (*sqlx.DB, *testIdentityProvider, sessions.OIDCAuthenticationProvider) {
+	t.Helper()
+
+	idp := newTestIdentityProvider(t)
+	issuer, err := url.Parse(idp.URL)
+	require.NoError(t, err)
+
+	db := pgtest.NewSqlxDB(t)
+	lggr := logger.TestLogger(t)
+	local := localauth.NewORM(db, time.Minute, lggr, &audit.AuditLoggerService{})
+	provider, err := oidcauth.NewOIDCAuthenticator(db, &testConfig{issuer: issuer}, local, true, lggr, &audit.AuditLoggerService{})
+	require.NoError(t, err)
+	return db, idp, provider
+}
+
+// login logs in through the fake identity provider, which issues an ID token of the claims completed with the nonce
+// of the login, unless set
+func login(t *testing.T, idp *testIdentityProvider, provider sessions.OIDCAuthenticationProvider, claims jwt.MapClaims) (string, error) {
+	t.Helper()
+	ctx := testutils.Context(t)
+
+	loginURL, oidcLogin, err := provider.LoginURL(ctx)
+	require.NoError(t, err)
+	u, err := url.Parse(loginURL)
+	require.NoError(t, err)
+	query := u.Query()
+	assert.Equal(t, idp.URL+"/authorize", u.Scheme+"://"+u.Host+u.Path)
+	assert.Equal(t, oidcLogin.State, query.Get("state"))
+	assert.Equal(t, oidcLogin.Nonce, query.Get("nonce"))
+	assert.Equal(t, testClientID, query.Get("client_id"))
+	assert.Equal(t, "openid email groups", query.Get("scope"))
+	assert.Equal(t, "S256", query.Get("code_challenge_method"))
+
+	if _, ok := claims["nonce"]; !ok {
+		claims["nonce"] = query.Get("nonce")
+	}
+	idp.mu.Lock()
+	idp.claims, idp.codeChallenge = claims, query.Get("code_challenge")
+	idp.mu.Unlock()
+
+	return provider.CreateOIDCSession(ctx, oidcLogin, query.Get("state"), "code")
+}
+
+func TestOIDC_NewOIDCAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	lggr := logger.TestLogger(t)
+	local := localauth.NewORM(db, time.Minute, lggr, &audit.AuditLoggerService{})
+	cfg := &testConfig{issuer: &url.URL{Scheme: "http", Host: "idp.example.com"}}
+
+	_, err := oidcauth.NewOIDCAuthenticator(db, cfg, local, false, lggr, &audit.AuditLoggerService{})
+	require.ErrorContains(t, err, "requires an https IssuerURL")
+
+	_, err = oidcauth.NewOIDCAuthenticator(db, cfg, local, true, lggr, &audit.AuditLoggerService{})
+	require.NoError(t, err)
+}
+
+func TestOIDC_CreateOIDCSession(t *testing.T) {
+	t.Parallel()
+
+	_, idp, provider := setupAuthenticationProvider(t)
+
+	tests := []struct {
+		name   string
+		groups []string
+		role   sessions.UserRole
+	}{
+		{"admin", []string{"NodeAdmins"}, sessions.UserRoleAdmin},
+		{"edit", []string{"Other", "NodeEditors"}, sessions.UserRoleEdit},
+		{"run", []string{"NodeRunners", "NodeReadOnly"}, sessions.UserRoleRun},
+		{"view", []string{"NodeReadOnly"}, sessions.UserRoleView},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			ctx := testutils.Context(t)
+			user := cltest.MustRandomUser(t)
+
+			sessionID, err := login(t, idp, provider, idp.idTokenClaims(test.name, user.Email, test.groups...))
+			require.NoError(t, err)
+
+			// The session is authorized without querying the identity provider
+			authorized, err := provider.AuthorizedUserWithSession(ctx, sessionID)
+			require.NoError(t, err)
+			assert.Equal(t, user.Email, authorized.Email)
+			assert.Equal(t, test.role, authorized.Role)
+
+			found, err := provider.FindUser(ctx, user.Email)
+			require.NoError(t, err)
+			assert.Equal(t, test.role, found.Role)
+
+			users, err := provider.ListUsers(ctx)
+			require.NoError(t, err)
+			assert.Contains(t, users, sessions.User{Email: user.Email, Role: test.role})
+		})
+	}
+}
+
+func TestOIDC_CreateOIDCSession_Invalid(t *testing.T) {
+	t.Parallel()
+
+	_, idp, provider := setupAuthenticationProvider(t)
+
+	tests := []struct {
+		name   string
+		claims func(claims jwt.MapClaims)
+		err    string
+	}{
+		{"wrong nonce", func(claims jwt.MapClaims) { claims["nonce"] = "wrong" }, "invalid ID token nonce"},
+		{"expired", func(claims jwt.MapClaims) { claims["exp"] = time.Now().Add(-time.Hour).Unix() }, "invalid ID token"},
+		{"no expiry", func(claims jwt.MapClaims) { delete(claims, "exp") }, "invalid ID token"},
+		{"wrong audience", func(claims jwt.MapClaims) { claims["aud"] = "other-client" }, "invalid ID token"},
+		{"wrong issuer", func(claims jwt.MapClaims) { claims["iss"] = "https://other.example.com" }, "invalid ID token"},
+		{"wrong authorized party", func(claims jwt.MapClaims) {
+			claims["aud"] = []string{testClientID, "other-client"}
+			claims["azp"] = "other-client"
+		}, "invalid ID token"},
+		{"no email", func(claims jwt.MapClaims) { delete(claims, "email") }, "ID token has no email claim"},
+		{"unverified email", func(claims jwt.MapClaims) { claims["email_verified"] = false }, "email of the user is not verified"},
+		{"email without verification", func(claims jwt.MapClaims) { delete(claims, "email_verified") }, "email of the user is not verified"},
+		{"no groups", func(claims jwt.MapClaims) { claims["groups"] = []string{"Other"} }, "no assigned groups to assume role"},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			claims := idp.idTokenClaims(test.name, cltest.MustRandomUser(t).Email, "NodeAdmins")
+			test.claims(claims)
+			_, err := login(t, idp, provider, claims)
+			require.ErrorContains(t, err, test.err)
+		})
+	}
+
+	t.Run("wrong code", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		loginURL, oidcLogin, err := provider.LoginURL(ctx)
+		require.NoError(t, err)
+		u, err := url.Parse(loginURL)
+		require.NoError(t, err)
+
+		_, err = provider.CreateOIDCSession(ctx, oidcLogin, u.Query().Get("state"), "wrong-code")
+		require.ErrorContains(t, err, "unable to log in with OIDC identity provider")
+	})
+
+	t.Run("login of another browser", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		// The attacker starts a login, and completes it at the identity provider
+		attackerURL, _, err := provider.LoginURL(ctx)
+		require.NoError(t, err)
+		u, err := url.Parse(attackerURL)
+		require.NoError(t, err)
+		idp.mu.Lock()
+		idp.claims = idp.idTokenClaims("attacker", cltest.MustRandomUser(t).Email, "NodeAdmins")
+		idp.claims["nonce"] = u.Query().Get("nonce")
+		idp.codeChallenge = u.Query().Get("code_challenge")
+		idp.mu.Unlock()
+
+		// The callback of the attacker is rejected in the browser of the victim, with or without a login of its own
+		_, victimLogin, err := provider.LoginURL(ctx)
+		require.NoError(t, err)
+		_, err = provider.CreateOIDCSession(ctx, victimLogin, u.Query().Get("state"), "code")
+		require.ErrorIs(t, err, oidcauth.ErrLoginStateMismatch)
+		_, err = provider.CreateOIDCSession(ctx, sessions.OIDCLogin{}, u.Query().Get("state"), "code")
+		require.ErrorIs(t, err, oidcauth.ErrLoginExpired)
+	})
+
+	t.Run("expired login", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		loginURL, oidcLogin, err := provider.LoginURL(ctx)
+		require.NoError(t, err)
+		u, err := url.Parse(loginURL)
+		require.NoError(t, err)
+
+		oidcLogin.ExpiresAt = time.Now().Add(-time.Second)
+		_, err = provider.CreateOIDCSession(ctx, oidcLogin, u.Query().Get("state"), "code")
+		require.ErrorIs(t, err, oidcauth.ErrLoginExpired)
+	})
+}
+
+func TestOIDC_BackchannelLogout(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	_, idp, provider := setupAuthenticationProvider(t)
+	email := cltest.MustRandomUser(t).Email
+
+	// Two sessions of the user, logged in through two sessions at the identity provider
+	claims := idp.idTokenClaims("subject", email, "NodeAdmins")
+	claims["sid"] = "sid-1"
+	sessionID1, err := login(t, idp, provider, claims)
+	require.NoError(t, err)
+	claims = idp.idTokenClaims("subject", email, "NodeAdmins")
+	claims["sid"] = "sid-2"
+	sessionID2, err := login(t, idp, provider, claims)
+	require.NoError(t, err)
+
+	// Invalid logout tokens revoke no sessions
+	invalid := idp.logoutTokenClaims("subject", "")
+	invalid["nonce"] = "nonce"
+	require.ErrorContains(t, provider.BackchannelLogout(ctx, idp.sign(t, idp.key, invalid)), "nonce is not allowed")
+	invalid = idp.logoutTokenClaims("subject", "")
+	delete(invalid, "events")
+	require.ErrorContains(t, provider.BackchannelLogout(ctx, idp.sign(t, idp.key, invalid)), "missing back-channel logout event")
+	require.ErrorContains(t, provider.BackchannelLogout(ctx, idp.sign(t, idp.key, idp.logoutTokenClaims("", ""))), "missing sub and sid claims")
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	require.ErrorContains(t, provider.BackchannelLogout(ctx, idp.sign(t, otherKey, idp.logoutTokenClaims("subject", ""))), "invalid logout token")
+	invalid = idp.logoutTokenClaims("subject", "")
+	delete(invalid, "jti")
+	require.ErrorContains(t, provider.BackchannelLogout(ctx, idp.sign(t, idp.key, invalid)), "missing jti claim")
+	invalid = idp.logoutTokenClaims("subject", "")
+	invalid["iat"] = time.Now().Add(-time.Hour).Unix()
+	require.ErrorContains(t, provider.BackchannelLogout(ctx, idp.sign(t, idp.key, invalid)), "issued too long ago")
+	_, err = provider.AuthorizedUserWithSession(ctx, sessionID1)
+	require.NoError(t, err)
+
+	// Logout of the identity provider session revokes its session only
+	logoutToken := idp.sign(t, idp.key, idp.logoutTokenClaims("subject", "sid-1"))
+	require.NoError(t, provider.BackchannelLogout(ctx, logoutToken))
+	_, err = provider.AuthorizedUserWithSession(ctx, sessionID1)
+	require.Error(t, err)
+	_, err = provider.AuthorizedUserWithSession(ctx, sessionID2)
+	require.NoError(t, err)
+
+	// Logout tokens are only accepted once
+	require.ErrorContains(t, provider.BackchannelLogout(ctx, logoutToken), "already used")
+
+	// Logout of the user revokes all of its sessions
+	require.NoError(t, provider.BackchannelLogout(ctx, idp.sign(t, idp.key, idp.logoutTokenClaims("subject", ""))))
+	_, err = provider.AuthorizedUserWithSession(ctx, sessionID2)
+	require.Error(t, err)
+}
+
+func TestOIDC_RevokeUserSessions(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	_, idp, provider := setupAuthenticationProvider(t)
+	email := cltest.MustRandomUser(t).Email
+	otherEmail := cltest.MustRandomUser(t).Email
+
+	sessionID, err := login(t, idp, provider, idp.idTokenClaims("subject", email, "NodeAdmins"))
+	require.NoError(t, err)
+	otherSessionID, err := login(t, idp, provider, idp.idTokenClaims("other", otherEmail, "NodeAdmins"))
+	require.NoError(t, err)
+
+	require.NoError(t, provider.RevokeUserSessions(ctx, email))
+	_, err = provider.AuthorizedUserWithSession(ctx, sessionID)
+	require.Error(t, err)
+	_, err = provider.AuthorizedUserWithSession(ctx, otherSessionID)
+	require.NoError(t, err)
+}
+
+func TestOIDC_LocalUsers(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	db, idp, provider := setupAuthenticationProvider(t)
+	local := localauth.NewORM(db, time.Minute, logger.TestLogger(t), &audit.AuditLoggerService{})
+	localUser := cltest.MustRandomUser(t)
+	require.NoError(t, local.CreateUser(ctx, &localUser))
+
+	// Local admin users log in with their password
+	sessionID, err := provider.CreateSession(ctx, sessions.SessionRequest{Email: localUser.Email, Password: cltest.Password})
+	require.NoError(t, err)
+	authorized, err := provider.AuthorizedUserWithSession(ctx, sessionID)
+	require.NoError(t, err)
+	assert.Equal(t, localUser.Email, authorized.Email)
+	_, err = provider.CreateAndSetAuthToken(ctx, &localUser)
+	require.NoError(t, err)
+
+	// Users of the identity provider are read only
+	oidcUser := sessions.User{Email: cltest.MustRandomUser(t).Email}
+	_, err = login(t, idp, provider, idp.idTokenClaims("subject", oidcUser.Email, "NodeAdmins"))
+	require.NoError(t, err)
+	require.ErrorIs(t, provider.CreateUser(ctx, &oidcUser), sessions.ErrNotSupported)
+	require.ErrorIs(t, provider.SetPassword(ctx, &oidcUser, "password"), sessions.ErrNotSupported)
+	_, err = provider.CreateAndSetAuthToken(ctx, &oidcUser)
+	require.ErrorIs(t, err, sessions.ErrNotSupported)
+	require.ErrorIs(t, provider.DeleteUser(ctx, oidcUser.Email), sessions.ErrNotSupported)
+}
+
+func TestGroupsToUserRole(t *testing.T) {
+	t.Parallel()
+
+	role, err := oidcauth.GroupsToUserRole([]string{"read", "edit"}, "admin", "edit", "run", "read")
+	require.NoError(t, err)
+	assert.Equal(t, sessions.UserRoleEdit, role)
+
+	role, err = oidcauth.GroupsToUserRole([]string{"admin", "read"}, "admin", "edit", "run", "read")
+	require.NoError(t, err)
+	assert.Equal(t, sessions.UserRoleAdmin, role)
+
+	_, err = oidcauth.GroupsToUserRole([]string{"other"}, "admin", "edit", "run", "read")
+	require.ErrorIs(t, err, oidcauth.ErrUserNoOIDCGroups)
+
+	_, err = oidcauth.GroupsToUserRole(nil, "admin", "edit", "run", "read")
+	require.ErrorIs(t, err, oidcauth.ErrUserNoOIDCGroups)
+}
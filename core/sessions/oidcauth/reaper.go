@@ -0,0 +1,51 @@
+package oidcauth
+
+import (
+	"context"
+	"time"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+type sessionReaper struct {
+	ds     sqlutil.DataSource
+	config SessionReaperConfig
+	lggr   logger.Logger
+}
+
+type SessionReaperConfig interface {
+	SessionTimeout() commonconfig.Duration
+	SessionReaperExpiration() commonconfig.Duration
+	OIDC() config.OIDC
+}
+
+// NewSessionReaper creates a reaper that cleans the expired OIDC sessions, and the stale sessions of the local admin
+// users, from the store.
+func NewSessionReaper(ds sqlutil.DataSource, config SessionReaperConfig, lggr logger.Logger) *utils.SleeperTask {
+	return utils.NewSleeperTask(&sessionReaper{
+		ds,
+		config,
+		lggr.Named("OIDCSessionReaper"),
+	})
+}
+
+func (sr *sessionReaper) Name() string {
+	return "OIDCSessionReaper"
+}
+
+func (sr *sessionReaper) Work() {
+	ctx := context.Background() //TODO https://smartcontract-it.atlassian.net/browse/BCF-2887
+	if _, err := sr.ds.ExecContext(ctx, "DELETE FROM oidc_sessions WHERE last_used < $1",
+		sr.config.OIDC().SessionTimeout().Before(time.Now())); err != nil {
+		sr.lggr.Error("unable to expire OIDC sessions: ", err)
+	}
+	recordCreationStaleThreshold := sr.config.SessionReaperExpiration().Before(
+		sr.config.SessionTimeout().Before(time.Now()))
+	if _, err := sr.ds.ExecContext(ctx, "DELETE FROM sessions WHERE last_used < $1", recordCreationStaleThreshold); err != nil {
+		sr.lggr.Error("unable to reap stale sessions: ", err)
+	}
+}
@@ -0,0 +1,142 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+)
+
+// Schema maps the objects of a database, like "column evm.logs.address", to their definitions. The partitions of
+// partitioned tables are left out, since they are managed by the node rather than by migrations.
+type Schema map[string]string
+
+const userSchemas = `n.nspname NOT IN ('pg_catalog', 'information_schema') AND n.nspname NOT LIKE 'pg\_toast%' AND n.nspname NOT LIKE 'pg\_temp%'`
+
+var schemaQueries = []struct {
+	kind  string
+	query string
+}{
+	{"relation", `SELECT n.nspname || '.' || c.relname, CASE c.relkind WHEN 'r' THEN 'table' WHEN 'p' THEN 'partitioned table' WHEN 'v' THEN 'view' WHEN 'm' THEN 'materialized view' WHEN 'S' THEN 'sequence' END
+		FROM pg_class c JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE c.relkind IN ('r', 'p', 'v', 'm', 'S') AND NOT c.relispartition AND ` + userSchemas},
+	{"column", `SELECT n.nspname || '.' || c.relname || '.' || a.attname,
+			format_type(a.atttypid, a.atttypmod) || CASE WHEN a.attnotnull THEN ' NOT NULL' ELSE '' END || COALESCE(' DEFAULT ' || pg_get_expr(d.adbin, d.adrelid), '')
+		FROM pg_attribute a
+		JOIN pg_class c ON c.oid = a.attrelid
+		JOIN pg_namespace n ON n.oid = c.relnamespace
+		LEFT JOIN pg_attrdef d ON d.adrelid = a.attrelid AND d.adnum = a.attnum
+		WHERE a.attnum > 0 AND NOT a.attisdropped AND c.relkind IN ('r', 'p', 'v', 'm') AND NOT c.relispartition AND ` + userSchemas},
+	{"index", `SELECT n.nspname || '.' || i.relname, pg_get_indexdef(i.oid)
+		FROM pg_index x
+		JOIN pg_class i ON i.oid = x.indexrelid
+		JOIN pg_class c ON c.oid = x.indrelid
+		JOIN pg_namespace n ON n.oid = i.relnamespace
+		WHERE NOT c.relispartition AND ` + userSchemas},
+	{"constraint", `SELECT n.nspname || '.' || c.relname || '.' || con.conname, pg_get_constraintdef(con.oid)
+		FROM pg_constraint con
+		JOIN pg_class c ON c.oid = con.conrelid
+		JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE NOT c.relispartition AND ` + userSchemas},
+	{"trigger", `SELECT n.nspname || '.' || c.relname || '.' || t.tgname, pg_get_triggerdef(t.oid)
+		FROM pg_trigger t
+		JOIN pg_class c ON c.oid = t.tgrelid
+		JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE NOT t.tgisinternal AND NOT c.relispartition AND ` + userSchemas},
+	{"type", `SELECT n.nspname || '.' || t.typname, array_to_string(array_agg(e.enumlabel ORDER BY e.enumsortorder), ', ')
+		FROM pg_type t
+		JOIN pg_enum e ON e.enumtypid = t.oid
+		JOIN pg_namespace n ON n.oid = t.typnamespace
+		WHERE ` + userSchemas + `
+		GROUP BY n.nspname, t.typname`},
+}
+
+// LoadSchema returns the Schema of db.
+func LoadSchema(ctx context.Context, db *sql.DB) (Schema, error) {
+	schema := Schema{}
+	for _, q := range schemaQueries {
+		rows, err := db.QueryContext(ctx, q.query)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load %ss: %w", q.kind, err)
+		}
+		for rows.Next() {
+			var name, def string
+			if err = rows.Scan(&name, &def); err != nil {
+				rows.Close()
+				return nil, fmt.Errorf("failed to load %ss: %w", q.kind, err)
+			}
+			schema[q.kind+" "+name] = def
+		}
+		if err = rows.Close(); err != nil {
+			return nil, err
+		}
+		if err = rows.Err(); err != nil {
+			return nil, fmt.Errorf("failed to load %ss: %w", q.kind, err)
+		}
+	}
+	return schema, nil
+}
+
+// Drift is an object whose definition differs from the one created by the migrations.
+type Drift struct {
+	Object string
+	// Expected is the definition created by the migrations, or empty if the object is unexpected.
+	Expected string
+	// Actual is the definition in the database, or empty if the object is missing.
+	Actual string
+}
+
+func (d Drift) String() string {
+	switch {
+	case d.Expected == "":
+		return fmt.Sprintf("unexpected %s: %s", d.Object, d.Actual)
+	case d.Actual == "":
+		return fmt.Sprintf("missing %s: %s", d.Object, d.Expected)
+	default:
+		return fmt.Sprintf("changed %s: expected %s, got %s", d.Object, d.Expected, d.Actual)
+	}
+}
+
+// CompareSchemas returns the drifts of actual from expected, sorted by object.
+func CompareSchemas(expected, actual Schema) []Drift {
+	var drifts []Drift
+	for object, def := range expected {
+		if got, ok := actual[object]; !ok {
+			drifts = append(drifts, Drift{Object: object, Expected: def})
+		} else if got != def {
+			drifts = append(drifts, Drift{Object: object, Expected: def, Actual: got})
+		}
+	}
+	for object, def := range actual {
+		if _, ok := expected[object]; !ok {
+			drifts = append(drifts, Drift{Object: object, Actual: def})
+		}
+	}
+	sort.Slice(drifts, func(i, j int) bool { return drifts[i].Object < drifts[j].Object })
+	return drifts
+}
+
+// DetectDrift compares the schema of db with the schema created by its applied migrations, which are applied to the
+// empty scratch database to that end.
+func DetectDrift(ctx context.Context, db *sql.DB, scratch *sql.DB) ([]Drift, error) {
+	version, err := Current(ctx, db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the database version: %w", err)
+	}
+	provider, err := NewProvider(ctx, scratch)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = provider.UpTo(ctx, version); err != nil {
+		return nil, fmt.Errorf("failed to migrate the scratch database to version %d: %w", version, err)
+	}
+	expected, err := LoadSchema(ctx, scratch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the expected schema: %w", err)
+	}
+	actual, err := LoadSchema(ctx, db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the database schema: %w", err)
+	}
+	return CompareSchemas(expected, actual), nil
+}
@@ -5,8 +5,10 @@ import (
 	"database/sql"
 	"embed"
 	"fmt"
+	"io"
 	"io/fs"
 	"os"
+	"path"
 	"strconv"
 	"strings"
 
@@ -182,6 +184,57 @@ func Status(ctx context.Context, db *sql.DB) error {
 	return nil
 }
 
+// DryRun writes the statements of the pending migrations to w, without applying them, and returns how many are pending.
+// The statements of Go migrations are computed when they run, so only their names are written.
+func DryRun(ctx context.Context, db *sql.DB, w io.Writer) (int, error) {
+	provider, err := NewProvider(ctx, db)
+	if err != nil {
+		return 0, err
+	}
+	migrations, err := provider.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var pending int
+	for _, m := range migrations {
+		if m.State != goose.StatePending {
+			continue
+		}
+		pending++
+		name := path.Base(m.Source.Path)
+		if m.Source.Type != goose.TypeSQL {
+			fmt.Fprintf(w, "-- Migration %d (%s): Go migration, its statements are computed when it runs\n\n", m.Source.Version, name)
+			continue
+		}
+		b, err := fs.ReadFile(embedMigrations, path.Join(MIGRATIONS_DIR, name))
+		if err != nil {
+			return pending, fmt.Errorf("failed to read migration %s: %w", name, err)
+		}
+		fmt.Fprintf(w, "-- Migration %d (%s)\n%s\n", m.Source.Version, name, upStatements(string(b)))
+	}
+	return pending, nil
+}
+
+// upStatements returns the Up section of a goose SQL migration.
+func upStatements(migration string) string {
+	var b strings.Builder
+	var up bool
+	for _, line := range strings.SplitAfter(migration, "\n") {
+		switch annotation := strings.ToLower(strings.TrimSpace(line)); {
+		case strings.HasPrefix(annotation, "-- +goose up"):
+			up = true
+			continue
+		case strings.HasPrefix(annotation, "-- +goose down"):
+			up = false
+			continue
+		}
+		if up {
+			b.WriteString(line)
+		}
+	}
+	return strings.TrimSpace(b.String()) + "\n"
+}
+
 func Create(db *sql.DB, name, migrationType string) error {
 	return goose.Create(db, "core/store/migrate/migrations", name, migrationType)
 }
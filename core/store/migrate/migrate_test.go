@@ -3,6 +3,7 @@ package migrate_test
 import (
 	"math/big"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -421,6 +422,76 @@ func TestMigrate(t *testing.T) {
 	require.Equal(t, int64(99), ver)
 }
 
+func TestDryRun(t *testing.T) {
+	ctx := testutils.Context(t)
+	_, db := heavyweight.FullTestDBEmptyV2(t, nil)
+
+	p, err := migrate.NewProvider(ctx, db.DB)
+	require.NoError(t, err)
+	_, err = p.UpTo(ctx, 98)
+	require.NoError(t, err)
+
+	var out strings.Builder
+	pending, err := migrate.DryRun(ctx, db.DB, &out)
+	require.NoError(t, err)
+	sources := p.ListSources()
+	assert.Equal(t, len(sources)-98, pending)
+	assert.Contains(t, out.String(), "-- Migration 99 (")
+	assert.Contains(t, out.String(), "-- Migration 100 (")
+	assert.NotContains(t, out.String(), "-- Migration 98 (")
+	assert.NotContains(t, out.String(), "-- +goose Up")
+	assert.NotContains(t, out.String(), "-- +goose Down")
+
+	ver, err := migrate.Current(ctx, db.DB)
+	require.NoError(t, err)
+	require.Equal(t, int64(98), ver)
+}
+
+func TestDetectDrift(t *testing.T) {
+	ctx := testutils.Context(t)
+	_, db := heavyweight.FullTestDBEmptyV2(t, nil)
+	_, scratch := heavyweight.FullTestDBEmptyV2(t, nil)
+
+	p, err := migrate.NewProvider(ctx, db.DB)
+	require.NoError(t, err)
+	_, err = p.UpTo(ctx, 100)
+	require.NoError(t, err)
+
+	drifts, err := migrate.DetectDrift(ctx, db.DB, scratch.DB)
+	require.NoError(t, err)
+	assert.Empty(t, drifts)
+	ver, err := migrate.Current(ctx, scratch.DB)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), ver)
+
+	_, err = db.ExecContext(ctx, `ALTER TABLE users ADD COLUMN nickname text NOT NULL DEFAULT 'anon';
+CREATE INDEX idx_users_nickname ON users (nickname);
+ALTER TABLE users ALTER COLUMN email SET DEFAULT 'admin';`)
+	require.NoError(t, err)
+
+	drifts, err = migrate.DetectDrift(ctx, db.DB, scratch.DB)
+	require.NoError(t, err)
+	assert.Equal(t, []migrate.Drift{
+		{Object: "column public.users.email", Expected: "text NOT NULL", Actual: "text NOT NULL DEFAULT 'admin'::text"},
+		{Object: "column public.users.nickname", Actual: "text NOT NULL DEFAULT 'anon'::text"},
+		{Object: "index public.idx_users_nickname", Actual: "CREATE INDEX idx_users_nickname ON public.users USING btree (nickname)"},
+	}, drifts)
+	assert.Equal(t, "changed column public.users.email: expected text NOT NULL, got text NOT NULL DEFAULT 'admin'::text", drifts[0].String())
+	assert.Equal(t, "unexpected column public.users.nickname: text NOT NULL DEFAULT 'anon'::text", drifts[1].String())
+}
+
+func TestCompareSchemas(t *testing.T) {
+	expected := migrate.Schema{"relation public.a": "table", "column public.a.x": "bigint"}
+	actual := migrate.Schema{"relation public.a": "table", "column public.a.y": "bigint"}
+	drifts := migrate.CompareSchemas(expected, actual)
+	assert.Equal(t, []migrate.Drift{
+		{Object: "column public.a.x", Expected: "bigint"},
+		{Object: "column public.a.y", Actual: "bigint"},
+	}, drifts)
+	assert.Equal(t, "missing column public.a.x: bigint", drifts[0].String())
+	assert.Empty(t, migrate.CompareSchemas(expected, expected))
+}
+
 func TestSetMigrationENVVars(t *testing.T) {
 	t.Run("ValidEVMConfig", func(t *testing.T) {
 		chainID := ubig.New(big.NewInt(1337))
@@ -0,0 +1,11 @@
+-- +goose Up
+
+-- retention_blocks allows a filter to bound log retention by chain depth rather than (or in addition to) wall-clock age.
+-- A filter with both retention and retention_blocks set to 0 keeps matching logs forever.
+ALTER TABLE evm.log_poller_filters
+    ADD COLUMN retention_blocks BIGINT NOT NULL DEFAULT 0;
+
+-- +goose Down
+
+ALTER TABLE evm.log_poller_filters
+    DROP COLUMN retention_blocks;
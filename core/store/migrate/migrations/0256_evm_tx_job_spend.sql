@@ -0,0 +1,19 @@
+-- +goose Up
+
+-- tx_job_spend keeps the fees spent by transactions created on behalf of a job.
+-- It intentionally has no foreign key to evm.txes, so that totals survive reaping of the tx history.
+CREATE TABLE evm.tx_job_spend (
+    tx_id BIGINT PRIMARY KEY,
+    evm_chain_id NUMERIC(78,0) NOT NULL,
+    job_id INTEGER NOT NULL,
+    attempted_fee NUMERIC(78,0) NOT NULL DEFAULT 0,
+    mined_fee NUMERIC(78,0),
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_tx_job_spend_chain_job_created_at ON evm.tx_job_spend (evm_chain_id, job_id, created_at);
+
+-- +goose Down
+
+DROP TABLE evm.tx_job_spend;
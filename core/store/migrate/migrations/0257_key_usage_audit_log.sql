@@ -0,0 +1,19 @@
+-- +goose Up
+
+-- key_usage_audit_log records every signing operation of the keystore. It is capped in size by the keystore, which
+-- deletes the oldest entries on insert.
+CREATE TABLE key_usage_audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    key_type TEXT NOT NULL,
+    key_id TEXT NOT NULL,
+    service TEXT NOT NULL,
+    job_id INTEGER,
+    payload_digest BYTEA NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_key_usage_audit_log_key_id_created_at ON key_usage_audit_log (key_id, created_at);
+
+-- +goose Down
+
+DROP TABLE key_usage_audit_log;
@@ -0,0 +1,16 @@
+-- +goose Up
+
+-- key_nonce_reservations coordinates the nonces of sending keys shared by multiple nodes, such as active and standby nodes.
+-- next_nonce is the first nonce not reserved by any node, and owner is the node which reserved the previous one.
+CREATE TABLE evm.key_nonce_reservations (
+    address BYTEA NOT NULL,
+    evm_chain_id NUMERIC(78,0) NOT NULL,
+    next_nonce BIGINT NOT NULL,
+    owner UUID NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (evm_chain_id, address)
+);
+
+-- +goose Down
+
+DROP TABLE evm.key_nonce_reservations;
@@ -0,0 +1,12 @@
+-- +goose Up
+
+-- observation_timeout and report_timeout are local deadlines of the reporting plugin, zero meaning none.
+ALTER TABLE ocr2_oracle_specs
+    ADD COLUMN observation_timeout BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN report_timeout BIGINT NOT NULL DEFAULT 0;
+
+-- +goose Down
+
+ALTER TABLE ocr2_oracle_specs
+    DROP COLUMN observation_timeout,
+    DROP COLUMN report_timeout;
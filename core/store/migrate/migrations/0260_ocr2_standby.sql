@@ -0,0 +1,8 @@
+-- +goose Up
+
+-- standby jobs warm up their plugin instances without starting the oracle, until promoted.
+ALTER TABLE ocr2_oracle_specs ADD COLUMN standby BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +goose Down
+
+ALTER TABLE ocr2_oracle_specs DROP COLUMN standby;
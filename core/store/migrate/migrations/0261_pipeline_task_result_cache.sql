@@ -0,0 +1,14 @@
+-- +goose Up
+
+-- results of the pipeline tasks which set resultCacheTTL, keyed by a hash of the task config, variables and inputs.
+CREATE TABLE pipeline_task_result_cache (
+    key bytea PRIMARY KEY,
+    value jsonb NOT NULL,
+    expires_at timestamptz NOT NULL
+);
+
+CREATE INDEX idx_pipeline_task_result_cache_expires_at ON pipeline_task_result_cache USING btree (expires_at);
+
+-- +goose Down
+
+DROP TABLE pipeline_task_result_cache;
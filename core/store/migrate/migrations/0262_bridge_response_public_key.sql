@@ -0,0 +1,8 @@
+-- +goose Up
+
+-- ed25519 public key the bridge signs its responses with, verified by bridge tasks when set.
+ALTER TABLE bridge_types ADD COLUMN response_public_key bytea;
+
+-- +goose Down
+
+ALTER TABLE bridge_types DROP COLUMN response_public_key;
@@ -0,0 +1,8 @@
+-- +goose Up
+
+-- Paused jobs are not started by the job spawner until resumed.
+ALTER TABLE jobs ADD COLUMN paused bool NOT NULL DEFAULT false;
+
+-- +goose Down
+
+ALTER TABLE jobs DROP COLUMN paused;
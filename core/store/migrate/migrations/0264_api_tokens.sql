@@ -0,0 +1,21 @@
+-- +goose Up
+
+-- Scoped API tokens, of which users can hold several, each granting a subset of the permissions of its owner.
+-- user_email is not a foreign key, as users of external authentication providers are not stored in the users table.
+CREATE TABLE api_tokens (
+    id BIGSERIAL PRIMARY KEY,
+    name text NOT NULL,
+    user_email text NOT NULL,
+    access_key text NOT NULL UNIQUE,
+    salt text NOT NULL,
+    hashed_secret text NOT NULL,
+    scopes text[] NOT NULL,
+    expires_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_api_tokens_user_email ON api_tokens (lower(user_email));
+
+-- +goose Down
+
+DROP TABLE api_tokens;
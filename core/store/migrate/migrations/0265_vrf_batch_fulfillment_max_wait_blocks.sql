@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE vrf_specs
+	ADD COLUMN batch_fulfillment_max_wait_blocks bigint NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE vrf_specs
+	DROP COLUMN batch_fulfillment_max_wait_blocks;
@@ -0,0 +1,24 @@
+-- +goose Up
+
+-- Quotas of Functions subscriptions overriding the defaults of the job's plugin config. A NULL limit uses the default.
+CREATE TABLE functions_subscription_quotas (
+    contract_address bytea NOT NULL,
+    subscription_id bigint NOT NULL,
+    requests_per_minute integer,
+    monthly_compute_millis bigint,
+    updated_at timestamptz NOT NULL,
+    PRIMARY KEY (contract_address, subscription_id)
+);
+
+-- Compute time used by Functions subscriptions, per calendar month (UTC).
+CREATE TABLE functions_subscription_usage (
+    contract_address bytea NOT NULL,
+    subscription_id bigint NOT NULL,
+    month date NOT NULL,
+    compute_millis bigint NOT NULL DEFAULT 0,
+    PRIMARY KEY (contract_address, subscription_id, month)
+);
+
+-- +goose Down
+DROP TABLE functions_subscription_usage;
+DROP TABLE functions_subscription_quotas;
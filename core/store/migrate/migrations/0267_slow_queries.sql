@@ -0,0 +1,16 @@
+-- +goose Up
+
+-- Telemetry of the queries which took longer than Database.SlowQueries.Threshold, or timed out, by fingerprint.
+CREATE TABLE slow_queries (
+    fingerprint text PRIMARY KEY,
+    query text NOT NULL,
+    calls bigint NOT NULL,
+    timeouts bigint NOT NULL,
+    total_duration_ms bigint NOT NULL,
+    max_duration_ms bigint NOT NULL,
+    first_seen_at timestamptz NOT NULL,
+    last_seen_at timestamptz NOT NULL
+);
+
+-- +goose Down
+DROP TABLE slow_queries;
@@ -0,0 +1,13 @@
+-- +goose Up
+-- Retention of the pipeline runs of jobs overriding JobPipeline.MaxSuccessfulRuns and JobPipeline.ReaperThreshold.
+-- Retentions are in nanoseconds, and 0 uses the default.
+ALTER TABLE jobs
+	ADD COLUMN max_successful_runs bigint,
+	ADD COLUMN run_retention bigint NOT NULL DEFAULT 0,
+	ADD COLUMN errored_run_retention bigint NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE jobs
+	DROP COLUMN max_successful_runs,
+	DROP COLUMN run_retention,
+	DROP COLUMN errored_run_retention;
@@ -0,0 +1,13 @@
+-- +goose Up
+-- The TOML of every version of a job spec. Updating a job replaces it with a new job using the same id, so versions
+-- are not removed along with the job.
+CREATE TABLE job_spec_versions (
+	job_id integer NOT NULL,
+	version integer NOT NULL,
+	toml text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY (job_id, version)
+);
+
+-- +goose Down
+DROP TABLE job_spec_versions;
@@ -0,0 +1,11 @@
+-- +goose Up
+-- When the stale prices of each dest chain were last cleaned up, so that the jobs of all lanes to a dest chain clean up
+-- at most once per interval.
+CREATE TABLE ccip.price_cleanups
+(
+    chain_selector NUMERIC(20, 0) NOT NULL PRIMARY KEY,
+    cleaned_at     TIMESTAMPTZ    NOT NULL
+);
+
+-- +goose Down
+DROP TABLE ccip.price_cleanups;
@@ -0,0 +1,14 @@
+-- +goose Up
+-- Runtime overrides of the bootstrap peers of the OCR jobs of a DON, which take precedence over the job specs.
+CREATE TABLE p2p_bootstrapper_overrides
+(
+    chain_id    TEXT        NOT NULL,
+    contract_id TEXT        NOT NULL,
+    added       TEXT[]      NOT NULL,
+    removed     TEXT[]      NOT NULL,
+    updated_at  TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (chain_id, contract_id)
+);
+
+-- +goose Down
+DROP TABLE p2p_bootstrapper_overrides;
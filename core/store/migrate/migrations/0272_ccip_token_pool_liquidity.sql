@@ -0,0 +1,19 @@
+-- +goose Up
+-- The latest liquidity and inbound rate limiter state of the token pools of each lane, as recorded by the execution
+-- plugins.
+CREATE TABLE ccip.token_pool_liquidity
+(
+    chain_selector        NUMERIC(20, 0) NOT NULL,
+    source_chain_selector NUMERIC(20, 0) NOT NULL,
+    pool_addr             TEXT           NOT NULL,
+    token_addr            TEXT           NOT NULL,
+    balance               NUMERIC(78, 0) NOT NULL,
+    rate_limit_enabled    BOOLEAN        NOT NULL,
+    rate_limit_tokens     NUMERIC(78, 0) NOT NULL,
+    rate_limit_capacity   NUMERIC(78, 0) NOT NULL,
+    updated_at            TIMESTAMPTZ    NOT NULL,
+    PRIMARY KEY (chain_selector, source_chain_selector, pool_addr)
+);
+
+-- +goose Down
+DROP TABLE ccip.token_pool_liquidity;
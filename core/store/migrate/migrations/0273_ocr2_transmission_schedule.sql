@@ -0,0 +1,8 @@
+-- +goose Up
+
+-- the strategy ordering the transmissions of the oracles of the job, applied on top of the contract config.
+ALTER TABLE ocr2_oracle_specs ADD COLUMN transmission_schedule JSONB NOT NULL DEFAULT '{}';
+
+-- +goose Down
+
+ALTER TABLE ocr2_oracle_specs DROP COLUMN transmission_schedule;
@@ -0,0 +1,18 @@
+-- +goose Up
+-- The reports accepted for transmission by the execution plugin of each lane, kept until they expire so that the plugin
+-- keeps accounting for their messages as inflight after a restart.
+CREATE TABLE ccip.exec_inflight_reports
+(
+    chain_selector NUMERIC(20, 0) NOT NULL,
+    offramp_addr   TEXT           NOT NULL,
+    min_seq_num    NUMERIC(20, 0) NOT NULL,
+    messages       JSONB          NOT NULL,
+    created_at     TIMESTAMPTZ    NOT NULL,
+    expires_at     TIMESTAMPTZ    NOT NULL,
+    PRIMARY KEY (chain_selector, offramp_addr, min_seq_num)
+);
+
+CREATE INDEX idx_exec_inflight_reports_expires_at ON ccip.exec_inflight_reports (chain_selector, expires_at);
+
+-- +goose Down
+DROP TABLE ccip.exec_inflight_reports;
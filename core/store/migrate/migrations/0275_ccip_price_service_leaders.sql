@@ -0,0 +1,14 @@
+-- +goose Up
+-- The leases of the price services of each commit job and dest chain, so that only one of the replicas of a node
+-- sharing the database writes the prices of the lane.
+CREATE TABLE ccip.price_service_leaders
+(
+    job_id         INTEGER        NOT NULL,
+    chain_selector NUMERIC(20, 0) NOT NULL,
+    owner          UUID           NOT NULL,
+    expires_at     TIMESTAMPTZ    NOT NULL,
+    PRIMARY KEY (job_id, chain_selector)
+);
+
+-- +goose Down
+DROP TABLE ccip.price_service_leaders;
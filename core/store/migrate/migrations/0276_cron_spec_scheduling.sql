@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE cron_specs
+    ADD COLUMN jitter BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN catch_up TEXT NOT NULL DEFAULT 'skip',
+    ADD COLUMN prevent_overlap BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN last_run_at TIMESTAMPTZ;
+
+-- +goose Down
+ALTER TABLE cron_specs
+    DROP COLUMN jitter,
+    DROP COLUMN catch_up,
+    DROP COLUMN prevent_overlap,
+    DROP COLUMN last_run_at;
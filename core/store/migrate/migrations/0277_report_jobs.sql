@@ -0,0 +1,21 @@
+-- +goose Up
+-- Reports generated in the background for expensive queries, with their CSV result once completed.
+CREATE TABLE report_jobs
+(
+    id          BIGSERIAL PRIMARY KEY,
+    type        TEXT        NOT NULL,
+    params      JSONB       NOT NULL DEFAULT '{}',
+    state       TEXT        NOT NULL DEFAULT 'queued' CHECK (state IN ('queued', 'running', 'completed', 'errored')),
+    error       TEXT,
+    result      BYTEA,
+    rows        BIGINT,
+    created_at  TIMESTAMPTZ NOT NULL,
+    started_at  TIMESTAMPTZ,
+    finished_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_report_jobs_state ON report_jobs (state, id);
+CREATE INDEX idx_report_jobs_finished_at ON report_jobs (finished_at);
+
+-- +goose Down
+DROP TABLE report_jobs;
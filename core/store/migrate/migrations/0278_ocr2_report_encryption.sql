@@ -0,0 +1,8 @@
+-- +goose Up
+
+-- the ID of the report encryption key shared by the oracles of the job, to encrypt its reports before they leave the plugin.
+ALTER TABLE ocr2_oracle_specs ADD COLUMN report_encryption_key_id TEXT;
+
+-- +goose Down
+
+ALTER TABLE ocr2_oracle_specs DROP COLUMN report_encryption_key_id;
@@ -0,0 +1,36 @@
+-- +goose Up
+-- The gas used by each message executed by the offramp of each lane, as attributed within its execution transaction by
+-- the execution plugins. A message executed more than once, e.g. manually after failing, has a row per transaction.
+CREATE TABLE ccip.message_exec_gas
+(
+    chain_selector      NUMERIC(20, 0) NOT NULL,
+    offramp_addr        TEXT           NOT NULL,
+    seq_num             NUMERIC(20, 0) NOT NULL,
+    tx_hash             TEXT           NOT NULL,
+    message_id          TEXT           NOT NULL,
+    block_number        BIGINT         NOT NULL,
+    exec_gas_used       BIGINT         NOT NULL,
+    overhead_gas_used   BIGINT         NOT NULL,
+    effective_gas_price NUMERIC(78, 0) NOT NULL,
+    traced              BOOLEAN        NOT NULL,
+    created_at          TIMESTAMPTZ    NOT NULL,
+    PRIMARY KEY (chain_selector, offramp_addr, seq_num, tx_hash)
+);
+
+CREATE INDEX idx_message_exec_gas_block_number ON ccip.message_exec_gas (chain_selector, offramp_addr, block_number);
+CREATE INDEX idx_message_exec_gas_created_at ON ccip.message_exec_gas (chain_selector, offramp_addr, created_at);
+
+-- The next block to attribute the gas of execution transactions of, for the offramp of each lane, so that the execution
+-- plugins resume where they stopped whether or not messages were executed in the blocks they attributed.
+CREATE TABLE ccip.message_exec_gas_cursors
+(
+    chain_selector NUMERIC(20, 0) NOT NULL,
+    offramp_addr   TEXT           NOT NULL,
+    next_block     BIGINT         NOT NULL,
+    updated_at     TIMESTAMPTZ    NOT NULL,
+    PRIMARY KEY (chain_selector, offramp_addr)
+);
+
+-- +goose Down
+DROP TABLE ccip.message_exec_gas_cursors;
+DROP TABLE ccip.message_exec_gas;
@@ -0,0 +1,19 @@
+-- +goose Up
+-- The sessions of the users logged in through the OIDC identity provider, with the role mapped from their groups at
+-- login. subject and idp_session_id are the sub and sid claims of the ID token, by which the identity provider revokes
+-- the sessions with back-channel logout.
+CREATE TABLE IF NOT EXISTS oidc_sessions (
+    id             TEXT PRIMARY KEY,
+    user_email     TEXT        NOT NULL,
+    user_role      user_roles  NOT NULL,
+    subject        TEXT        NOT NULL,
+    idp_session_id TEXT,
+    last_used      TIMESTAMPTZ NOT NULL,
+    created_at     TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_oidc_sessions_user_email ON oidc_sessions (lower(user_email));
+CREATE INDEX idx_oidc_sessions_subject ON oidc_sessions (subject);
+
+-- +goose Down
+DROP TABLE oidc_sessions;
@@ -0,0 +1,12 @@
+-- +goose Up
+-- Unconfirmed transactions which the stuck transaction detector found blocked by a lower nonce which was never mined.
+-- These cannot be purged, so they are recorded to be listed alongside the transactions being purged.
+CREATE TABLE evm.tx_nonce_gaps
+(
+    tx_id          BIGINT      PRIMARY KEY REFERENCES evm.txes (id) ON DELETE CASCADE,
+    on_chain_nonce BIGINT      NOT NULL,
+    detected_at    TIMESTAMPTZ NOT NULL
+);
+
+-- +goose Down
+DROP TABLE evm.tx_nonce_gaps;
@@ -0,0 +1,15 @@
+-- +goose Up
+-- When transactions were finalized, which the transaction audit export is partitioned by.
+ALTER TABLE evm.txes ADD COLUMN finalized_at TIMESTAMPTZ;
+
+-- Transactions finalized before are assumed finalized when their latest receipt was stored.
+UPDATE evm.txes SET finalized_at = (
+    SELECT MAX(r.created_at) FROM evm.tx_attempts a
+    JOIN evm.receipts r ON r.tx_hash = a.hash
+    WHERE a.eth_tx_id = evm.txes.id
+) WHERE state = 'finalized';
+
+CREATE INDEX idx_evm_txes_evm_chain_id_finalized_at ON evm.txes (evm_chain_id, finalized_at) WHERE finalized_at IS NOT NULL;
+
+-- +goose Down
+ALTER TABLE evm.txes DROP COLUMN finalized_at;
@@ -0,0 +1,13 @@
+-- +goose Up
+-- Idempotency keys of the requests aggregated into a bundled transaction, so that their status can be looked up by the bundle.
+-- Rows are removed along with the bundle when it is reaped.
+CREATE TABLE evm.tx_bundle_keys
+(
+    idempotency_key VARCHAR(2000) PRIMARY KEY,
+    bundle_tx_id    BIGINT        NOT NULL REFERENCES evm.txes (id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_tx_bundle_keys_bundle_tx_id ON evm.tx_bundle_keys (bundle_tx_id);
+
+-- +goose Down
+DROP TABLE evm.tx_bundle_keys;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- The sidecar of the blobs carried by EIP-4844 blob transactions, which every attempt of the transaction must carry.
+ALTER TABLE evm.txes ADD COLUMN blob_sidecar BYTEA;
+
+-- +goose Down
+ALTER TABLE evm.txes DROP COLUMN blob_sidecar;
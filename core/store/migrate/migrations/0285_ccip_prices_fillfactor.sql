@@ -0,0 +1,10 @@
+-- +goose Up
+-- The CCIP gas and token prices are not partitioned, since they hold a single row per chain and token, which is updated
+-- on every price update. Leaving room in their pages lets the updates be HOT, so that they do not bloat the primary
+-- keys, and the dead rows they leave are vacuumed as soon as a few percent of the rows are dead.
+ALTER TABLE ccip.observed_gas_prices SET (fillfactor = 50, autovacuum_vacuum_scale_factor = 0.02, autovacuum_analyze_scale_factor = 0.05);
+ALTER TABLE ccip.observed_token_prices SET (fillfactor = 50, autovacuum_vacuum_scale_factor = 0.02, autovacuum_analyze_scale_factor = 0.05);
+
+-- +goose Down
+ALTER TABLE ccip.observed_gas_prices RESET (fillfactor, autovacuum_vacuum_scale_factor, autovacuum_analyze_scale_factor);
+ALTER TABLE ccip.observed_token_prices RESET (fillfactor, autovacuum_vacuum_scale_factor, autovacuum_analyze_scale_factor);
@@ -0,0 +1,14 @@
+-- +goose Up
+-- The results of the immutable reads of the EVM clients, so that they are not read again after a restart. See the
+-- callCache of core/chains/evm/client.
+CREATE TABLE evm.call_cache (
+    evm_chain_id numeric(78,0) NOT NULL,
+    key bytea NOT NULL,
+    result bytea NOT NULL,
+    expires_at timestamptz NOT NULL,
+    PRIMARY KEY (evm_chain_id, key)
+);
+CREATE INDEX idx_evm_call_cache_expires_at ON evm.call_cache (expires_at);
+
+-- +goose Down
+DROP TABLE evm.call_cache;
@@ -256,6 +256,10 @@ type SendEtherRequest struct {
 	AllowHigherAmounts bool           `json:"allowHigherAmounts"`
 	SkipWaitTxAttempt  bool           `json:"skipWaitTxAttempt"`
 	WaitAttemptTimeout *time.Duration `json:"waitAttemptTimeout"`
+	// IdempotencyKey is an optional key set by the caller, so that retried requests with the same key return the
+	// transaction created by the first request instead of sending again. Keys are unique across all the transactions
+	// of the node.
+	IdempotencyKey *string `json:"idempotencyKey,omitempty"`
 }
 
 // AddressCollection is an array of common.Address
@@ -0,0 +1,46 @@
+package utils
+
+import "sync"
+
+// Broadcaster fans out the values published to it to all of its subscribers. Publishing never blocks: values are
+// dropped for the subscribers which are not keeping up.
+//
+// The zero value is ready to use.
+type Broadcaster[T any] struct {
+	mu   sync.RWMutex
+	subs map[chan T]struct{}
+}
+
+// Subscribe returns a channel receiving the values published from now on, buffering up to size values, and a function
+// to unsubscribe, which closes the channel.
+func (b *Broadcaster[T]) Subscribe(size int) (<-chan T, func()) {
+	ch := make(chan T, size)
+	b.mu.Lock()
+	if b.subs == nil {
+		b.subs = make(map[chan T]struct{})
+	}
+	b.subs[ch] = struct{}{}
+	b.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			b.mu.Lock()
+			delete(b.subs, ch)
+			b.mu.Unlock()
+			close(ch)
+		})
+	}
+}
+
+// Publish sends v to all subscribers.
+func (b *Broadcaster[T]) Publish(v T) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for ch := range b.subs {
+		select {
+		case ch <- v:
+		default:
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func TestBroadcaster(t *testing.T) {
+	t.Parallel()
+
+	var b utils.Broadcaster[int]
+	b.Publish(0) // no subscribers
+
+	ch1, unsub1 := b.Subscribe(1)
+	ch2, unsub2 := b.Subscribe(2)
+
+	b.Publish(1)
+	b.Publish(2) // dropped for ch1, which is full
+
+	assert.Equal(t, 1, <-ch1)
+	assert.Equal(t, 1, <-ch2)
+	assert.Equal(t, 2, <-ch2)
+
+	unsub1()
+	unsub1()
+	_, ok := <-ch1
+	require.False(t, ok)
+
+	b.Publish(3)
+	assert.Equal(t, 3, <-ch2)
+	unsub2()
+}
@@ -0,0 +1,58 @@
+// Package deadlinebudget accounts for where the time of a deadline-bound operation, such as an OCR observation, was spent.
+// The operation carries a Budget in its context, and RPC clients and DB hooks report the time spent in their calls to it.
+package deadlinebudget
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+// Category is a kind of work a budget is spent on
+type Category string
+
+const (
+	RPC         Category = "rpc"
+	DB          Category = "db"
+	Computation Category = "computation"
+)
+
+// Budget accumulates the time spent on RPC and DB calls since its creation. The time spent on calls made concurrently is
+// summed up, so it may exceed the elapsed time.
+type Budget struct {
+	start time.Time
+	rpc   atomic.Int64
+	db    atomic.Int64
+}
+
+type budgetKey struct{}
+
+// New returns a copy of ctx carrying a new Budget, and the Budget
+func New(ctx context.Context) (context.Context, *Budget) {
+	b := &Budget{start: time.Now()}
+	return context.WithValue(ctx, budgetKey{}, b), b
+}
+
+// Spend records that d was spent on category, if ctx carries a Budget. Computation is derived rather than recorded.
+func Spend(ctx context.Context, category Category, d time.Duration) {
+	b, ok := ctx.Value(budgetKey{}).(*Budget)
+	if !ok {
+		return
+	}
+	switch category {
+	case RPC:
+		b.rpc.Add(int64(d))
+	case DB:
+		b.db.Add(int64(d))
+	}
+}
+
+// Spent returns the time spent per category so far. Computation is the elapsed time not spent on RPC or DB calls.
+func (b *Budget) Spent() map[Category]time.Duration {
+	rpc, db := time.Duration(b.rpc.Load()), time.Duration(b.db.Load())
+	return map[Category]time.Duration{
+		RPC:         rpc,
+		DB:          db,
+		Computation: max(time.Since(b.start)-rpc-db, 0),
+	}
+}
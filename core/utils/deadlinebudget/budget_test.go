@@ -0,0 +1,30 @@
+package deadlinebudget
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+)
+
+func TestBudget(t *testing.T) {
+	// spending without a budget is a no-op
+	Spend(context.Background(), RPC, time.Second)
+
+	ctx, b := New(context.Background())
+	Spend(ctx, RPC, 2*time.Millisecond)
+	Spend(ctx, RPC, 3*time.Millisecond)
+	require.NoError(t, QueryHook(ctx, logger.Test(t), func(context.Context) error {
+		time.Sleep(10 * time.Millisecond)
+		return nil
+	}, "SELECT 1"))
+
+	spent := b.Spent()
+	assert.Equal(t, 5*time.Millisecond, spent[RPC])
+	assert.GreaterOrEqual(t, spent[DB], 10*time.Millisecond)
+	assert.GreaterOrEqual(t, spent[Computation], time.Duration(0))
+}
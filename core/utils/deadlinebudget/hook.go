@@ -0,0 +1,18 @@
+package deadlinebudget
+
+import (
+	"context"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+)
+
+var _ sqlutil.QueryHook = QueryHook
+
+// QueryHook is a sqlutil.QueryHook which spends the duration of queries on the DB category of the Budget of their context
+func QueryHook(ctx context.Context, _ logger.Logger, do func(context.Context) error, _ string, _ ...any) error {
+	start := time.Now()
+	defer func() { Spend(ctx, DB, time.Since(start)) }()
+	return do(ctx)
+}
@@ -0,0 +1,207 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/apitokens"
+)
+
+// SessionAPITokenKey is the scoped API token key in the session map
+const SessionAPITokenKey = "api_token"
+
+// APITokenFinder finds scoped API tokens.
+type APITokenFinder interface {
+	FindAPIToken(ctx context.Context, accessKey string) (apitokens.APIToken, error)
+}
+
+// AuthenticateByScopedToken returns an authMethod authenticating the owner of a scoped API token, which must not have
+// expired. Requests authenticated this way must be permitted by the scopes of the token, see RequiresAPITokenScope.
+func AuthenticateByScopedToken(tokens APITokenFinder) authMethod {
+	return func(c *gin.Context, authr Authenticator) error {
+		ctx := c.Request.Context()
+		token := &auth.Token{
+			AccessKey: c.GetHeader(APIKey),
+			Secret:    c.GetHeader(APISecret),
+		}
+		if token.AccessKey == "" {
+			return auth.ErrorAuthFailed
+		}
+
+		apiToken, err := tokens.FindAPIToken(ctx, token.AccessKey)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return auth.ErrorAuthFailed
+			}
+			return err
+		}
+		if apiToken.Expired(time.Now()) {
+			return auth.ErrorAuthFailed
+		}
+		ok, err := apiToken.Authenticate(token)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return auth.ErrorAuthFailed
+		}
+
+		user, err := authr.FindUser(ctx, apiToken.UserEmail)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return auth.ErrorAuthFailed
+			}
+			return err
+		}
+
+		c.Set(SessionUserKey, &user)
+		c.Set(SessionAPITokenKey, &apiToken)
+
+		return nil
+	}
+}
+
+// GetAuthenticatedAPIToken extracts the scoped API token the request was authenticated with from the context.
+func GetAuthenticatedAPIToken(c *gin.Context) (*apitokens.APIToken, bool) {
+	obj, ok := c.Get(SessionAPITokenKey)
+	if !ok {
+		return nil, false
+	}
+
+	apiToken, ok := obj.(*apitokens.APIToken)
+
+	return apiToken, ok
+}
+
+// apiTokenReadOnlyRoutes lists the GET routes which only read and do not disclose secrets, which all scoped API tokens
+// can request. The other GET routes require the scope of their path, or cannot be requested by scoped API tokens, e.g.
+// job log captures, the key audit log, users and API tokens, and the debug routes.
+var apiTokenReadOnlyRoutes = map[string]struct{}{
+	"/v2/ping":                             {},
+	"/v2/external_initiators":              {},
+	"/v2/bridge_types":                     {},
+	"/v2/bridge_types/:BridgeName":         {},
+	"/v2/config":                           {},
+	"/v2/config/v2":                        {},
+	"/v2/drain":                            {},
+	"/v2/tx_attempts":                      {},
+	"/v2/tx_attempts/evm":                  {},
+	"/v2/transactions/evm":                 {},
+	"/v2/transactions/evm/stuck":           {},
+	"/v2/transactions/evm/circuit_breaker": {},
+	"/v2/transactions/evm/idempotency_keys/:IdempotencyKey": {},
+	"/v2/transactions/evm/job_spend/:JobID":                 {},
+	"/v2/transactions/evm/:TxHash":                          {},
+	"/v2/transactions":                                      {},
+	"/v2/transactions/:TxHash":                              {},
+	"/v2/keys/csa":                                          {},
+	"/v2/keys/eth":                                          {},
+	"/v2/keys/evm":                                          {},
+	"/v2/keys/ocr":                                          {},
+	"/v2/keys/ocr2":                                         {},
+	"/v2/keys/p2p":                                          {},
+	"/v2/keys/solana":                                       {},
+	"/v2/keys/cosmos":                                       {},
+	"/v2/keys/starknet":                                     {},
+	"/v2/keys/aptos":                                        {},
+	"/v2/keys/tron":                                         {},
+	"/v2/keys/report":                                       {},
+	"/v2/keys/tls":                                          {},
+	"/v2/keys/vrf":                                          {},
+	"/v2/jobs":                                              {},
+	"/v2/jobs/:ID":                                          {},
+	"/v2/jobs/schemas":                                      {},
+	"/v2/jobs/schemas/:type":                                {},
+	"/v2/jobs/:ID/versions":                                 {},
+	"/v2/jobs/:ID/versions/:version/diff":                   {},
+	"/v2/jobs/events":                                       {},
+	"/v2/pipeline/runs":                                     {},
+	"/v2/jobs/:ID/runs":                                     {},
+	"/v2/jobs/:ID/runs/:runID":                              {},
+	"/v2/reports":                                           {},
+	"/v2/reports/:ID":                                       {},
+	"/v2/reports/:ID/download":                              {},
+	"/v2/features":                                          {},
+	"/v2/ccip/lanes":                                        {},
+	"/v2/ccip/lanes/discover":                               {},
+	"/v2/p2p/diagnostics":                                   {},
+	"/v2/p2p/bootstrapper_overrides":                        {},
+	"/v2/functions/quotas/:contractAddress":                 {},
+	"/v2/slow_queries":                                      {},
+	"/v2/log":                                               {},
+	"/v2/chains/evm":                                        {},
+	"/v2/chains/evm/:ID":                                    {},
+	"/v2/chains/evm/:ID/nodes":                              {},
+	"/v2/chains/solana":                                     {},
+	"/v2/chains/solana/:ID":                                 {},
+	"/v2/chains/solana/:ID/nodes":                           {},
+	"/v2/chains/starknet":                                   {},
+	"/v2/chains/starknet/:ID":                               {},
+	"/v2/chains/starknet/:ID/nodes":                         {},
+	"/v2/chains/cosmos":                                     {},
+	"/v2/chains/cosmos/:ID":                                 {},
+	"/v2/chains/cosmos/:ID/nodes":                           {},
+	"/v2/nodes":                                             {},
+	"/v2/nodes/evm":                                         {},
+	"/v2/nodes/solana":                                      {},
+	"/v2/nodes/starknet":                                    {},
+	"/v2/nodes/cosmos":                                      {},
+	"/v2/nodes/evm/forwarders":                              {},
+	"/v2/build_info":                                        {},
+}
+
+var apiTokenScopePaths = []struct {
+	prefix string
+	scope  apitokens.Scope
+}{
+	{"/v2/jobs", apitokens.ScopeJobManagement},
+	{"/v2/pipeline", apitokens.ScopeJobManagement},
+	{"/v2/bridge_types", apitokens.ScopeJobManagement},
+	{"/v2/external_initiators", apitokens.ScopeJobManagement},
+	{"/v2/keys", apitokens.ScopeKeyManagement},
+	{"/v2/transfers", apitokens.ScopeTxManagement},
+	{"/v2/nodes/evm/forwarders", apitokens.ScopeTxManagement},
+	{"/v2/replay_from_block", apitokens.ScopeTxManagement},
+	{"/v2/recover_finality", apitokens.ScopeTxManagement},
+}
+
+// APITokenScopeFor returns the scope a scoped API token needs to be granted to make a request with method to route, the
+// path the request matched with its parameters unexpanded, or false if no scope grants it, in which case only sessions
+// and user API tokens can make it.
+func APITokenScopeFor(method, route string) (apitokens.Scope, bool) {
+	if method == http.MethodGet {
+		if _, ok := apiTokenReadOnlyRoutes[route]; ok {
+			return apitokens.ScopeReadOnly, true
+		}
+	}
+	for _, p := range apiTokenScopePaths {
+		if route == p.prefix || strings.HasPrefix(route, p.prefix+"/") {
+			return p.scope, true
+		}
+	}
+	return "", false
+}
+
+// RequiresAPITokenScope is middleware which, for requests authenticated by a scoped API token, asserts the token is
+// granted the scope the request needs. The role of the owner of the token is enforced as for other requests.
+func RequiresAPITokenScope(c *gin.Context) {
+	apiToken, ok := GetAuthenticatedAPIToken(c)
+	if !ok {
+		c.Next()
+		return
+	}
+	scope, ok := APITokenScopeFor(c.Request.Method, c.FullPath())
+	if !ok || !apiToken.HasScope(scope) {
+		c.Abort()
+		jsonAPIError(c, http.StatusForbidden, errors.New("Forbidden: API token is not granted the required scope"))
+		return
+	}
+	c.Next()
+}
@@ -0,0 +1,141 @@
+package auth_test
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/apitokens"
+	webauth "github.com/smartcontractkit/chainlink/v2/core/web/auth"
+)
+
+type apiTokenFinder map[string]apitokens.APIToken
+
+func (f apiTokenFinder) FindAPIToken(ctx context.Context, accessKey string) (apitokens.APIToken, error) {
+	apiToken, ok := f[accessKey]
+	if !ok {
+		return apitokens.APIToken{}, sql.ErrNoRows
+	}
+	return apiToken, nil
+}
+
+func mustAPIToken(t *testing.T, expiresAt null.Time, scopes ...apitokens.Scope) (apitokens.APIToken, *auth.Token) {
+	token := auth.NewToken()
+	salt := uuid.New().String()
+	hashedSecret, err := auth.HashedSecret(token, salt)
+	require.NoError(t, err)
+	var scopeNames pq.StringArray
+	for _, scope := range scopes {
+		scopeNames = append(scopeNames, string(scope))
+	}
+	return apitokens.APIToken{
+		AccessKey:    token.AccessKey,
+		Salt:         salt,
+		HashedSecret: hashedSecret,
+		Scopes:       scopeNames,
+		ExpiresAt:    expiresAt,
+	}, token
+}
+
+func TestAuthenticateByScopedToken(t *testing.T) {
+	t.Parallel()
+
+	user := cltest.MustRandomUser(t)
+	authr := userFindSuccesser{user: user}
+
+	jobsToken, jobsSecret := mustAPIToken(t, null.Time{}, apitokens.ScopeJobManagement)
+	readToken, readSecret := mustAPIToken(t, null.Time{})
+	expiredToken, expiredSecret := mustAPIToken(t, null.TimeFrom(time.Now().Add(-time.Minute)), apitokens.ScopeJobManagement)
+	tokens := apiTokenFinder{
+		jobsToken.AccessKey:    jobsToken,
+		readToken.AccessKey:    readToken,
+		expiredToken.AccessKey: expiredToken,
+	}
+
+	router := gin.New()
+	v2 := router.Group("/v2", webauth.Authenticate(authr, webauth.AuthenticateByScopedToken(tokens)), webauth.RequiresAPITokenScope)
+	v2.GET("/jobs", func(c *gin.Context) { c.String(http.StatusOK, "") })
+	v2.POST("/jobs", func(c *gin.Context) { c.String(http.StatusOK, "") })
+	v2.POST("/keys/eth", func(c *gin.Context) { c.String(http.StatusOK, "") })
+	v2.GET("/jobs/:ID/log_capture/download", func(c *gin.Context) { c.String(http.StatusOK, "") })
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		token  *auth.Token
+		code   int
+	}{
+		{"read", "GET", "/v2/jobs", readSecret, http.StatusOK},
+		{"read denied mutation", "POST", "/v2/jobs", readSecret, http.StatusForbidden},
+		{"read denied log capture", "GET", "/v2/jobs/1/log_capture/download", readSecret, http.StatusForbidden},
+		{"granted log capture", "GET", "/v2/jobs/1/log_capture/download", jobsSecret, http.StatusOK},
+		{"granted mutation", "POST", "/v2/jobs", jobsSecret, http.StatusOK},
+		{"not granted mutation", "POST", "/v2/keys/eth", jobsSecret, http.StatusForbidden},
+		{"expired", "GET", "/v2/jobs", expiredSecret, http.StatusUnauthorized},
+		{"wrong secret", "GET", "/v2/jobs", &auth.Token{AccessKey: readSecret.AccessKey, Secret: jobsSecret.Secret}, http.StatusUnauthorized},
+		{"unknown", "GET", "/v2/jobs", auth.NewToken(), http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := mustRequest(t, tt.method, tt.path, nil)
+			req.Header.Set(webauth.APIKey, tt.token.AccessKey)
+			req.Header.Set(webauth.APISecret, tt.token.Secret)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusText(tt.code), http.StatusText(w.Code))
+		})
+	}
+}
+
+func TestAPITokenScopeFor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		method string
+		route  string
+		scope  apitokens.Scope
+		ok     bool
+	}{
+		{"GET", "/v2/jobs", apitokens.ScopeReadOnly, true},
+		{"GET", "/v2/jobs/:ID", apitokens.ScopeReadOnly, true},
+		{"GET", "/v2/keys/evm", apitokens.ScopeReadOnly, true},
+		{"GET", "/v2/chains/evm/:ID/nodes", apitokens.ScopeReadOnly, true},
+		{"HEAD", "/v2/jobs", apitokens.ScopeJobManagement, true},
+		{"GET", "/v2/jobs/:ID/log_capture", apitokens.ScopeJobManagement, true},
+		{"GET", "/v2/jobs/:ID/log_capture/download", apitokens.ScopeJobManagement, true},
+		{"GET", "/v2/keys/audit_log", apitokens.ScopeKeyManagement, true},
+		{"POST", "/v2/jobs", apitokens.ScopeJobManagement, true},
+		{"DELETE", "/v2/jobs/:ID", apitokens.ScopeJobManagement, true},
+		{"PATCH", "/v2/bridge_types/:BridgeName", apitokens.ScopeJobManagement, true},
+		{"POST", "/v2/keys/evm/import", apitokens.ScopeKeyManagement, true},
+		{"POST", "/v2/keys/eth/export/:address", apitokens.ScopeKeyManagement, true},
+		{"POST", "/v2/transfers/evm", apitokens.ScopeTxManagement, true},
+		{"POST", "/v2/replay_from_block/:number", apitokens.ScopeTxManagement, true},
+		{"GET", "/v2/users", "", false},
+		{"GET", "/v2/user/api_tokens", "", false},
+		{"GET", "/v2/enroll_webauthn", "", false},
+		{"GET", "/v2/debug/pprof/profile", "", false},
+		{"POST", "/v2/jobsfoo", "", false},
+		{"POST", "/v2/users", "", false},
+		{"POST", "/v2/user/api_tokens", "", false},
+	}
+	for _, tt := range tests {
+		scope, ok := webauth.APITokenScopeFor(tt.method, tt.route)
+		assert.Equal(t, tt.ok, ok, "%s %s", tt.method, tt.route)
+		assert.Equal(t, tt.scope, scope, "%s %s", tt.method, tt.route)
+	}
+}
@@ -2,8 +2,11 @@ package auth
 
 import (
 	"context"
+	"crypto/tls"
 	"database/sql"
 	"net/http"
+	"sync"
+	"time"
 
 	"github.com/gin-contrib/sessions"
 	"github.com/gin-gonic/gin"
@@ -25,6 +28,9 @@ const (
 	// SessionName is the session name
 	SessionName = "clsession"
 
+	// OIDCLoginSessionName is the name of the session keeping the OIDC login started by the browser
+	OIDCLoginSessionName = "clsession_oidc"
+
 	// SessionIDKey is the session ID key in the session map
 	SessionIDKey = "clsession_id"
 
@@ -33,6 +39,13 @@ const (
 
 	// SessionExternalInitiatorKey is the External Initiator key in the session map
 	SessionExternalInitiatorKey = "external_initiator"
+
+	// ClientCertificate is the header which must be set to "true" for the request to be authenticated by the client
+	// certificate of the TLS connection.
+	ClientCertificate = "X-CLIENT-CERTIFICATE"
+
+	// ClientCertificateUserTTL is how long the Users of the client certificates are cached.
+	ClientCertificateUserTTL = time.Minute
 )
 
 // Authenticator defines the interface to authenticate requests against a
@@ -113,6 +126,93 @@ func AuthenticateByToken(c *gin.Context, authr Authenticator) error {
 
 var _ authMethod = AuthenticateByToken
 
+// AuthenticateByClientCertificate returns an authMethod authenticating a User by the client certificate of the TLS
+// connection, which the HTTPS server verified against its client CAs. The User is the one of the first email address of
+// the certificate, or of its subject common name if it has none.
+//
+// Browsers present their client certificate on any request to the node, including the cross-site ones, so the
+// certificate only authenticates the requests setting the ClientCertificate header, which cross-site requests can't set.
+// The Users found, or not found, are cached for ttl not to query the authentication provider, e.g. LDAP, on every
+// request, so that role changes apply to certificates after at most ttl.
+func AuthenticateByClientCertificate(ttl time.Duration) authMethod {
+	users := &clientCertificateUsers{ttl: ttl, entries: map[string]clientCertificateUser{}}
+	return func(c *gin.Context, authr Authenticator) error {
+		if c.GetHeader(ClientCertificate) != "true" {
+			return auth.ErrorAuthFailed
+		}
+		email, ok := clientCertificateEmail(c.Request.TLS)
+		if !ok {
+			return auth.ErrorAuthFailed
+		}
+
+		user, found, err := users.find(c.Request.Context(), authr, email)
+		if err != nil || !found {
+			// any failure falls through to the other authMethods
+			return auth.ErrorAuthFailed
+		}
+
+		c.Set(SessionUserKey, &user)
+
+		return nil
+	}
+}
+
+type clientCertificateUser struct {
+	user      clsessions.User
+	found     bool
+	expiresAt time.Time
+}
+
+// clientCertificateUsers caches the Users of the client certificates by email.
+type clientCertificateUsers struct {
+	ttl     time.Duration
+	mu      sync.Mutex
+	entries map[string]clientCertificateUser
+}
+
+func (u *clientCertificateUsers) find(ctx context.Context, authr Authenticator, email string) (clsessions.User, bool, error) {
+	now := time.Now()
+	u.mu.Lock()
+	entry, ok := u.entries[email]
+	u.mu.Unlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.user, entry.found, nil
+	}
+
+	user, err := authr.FindUser(ctx, email)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		// errors other than a missing user aren't cached
+		return clsessions.User{}, false, err
+	}
+	entry = clientCertificateUser{user: user, found: err == nil, expiresAt: now.Add(u.ttl)}
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	for e, cached := range u.entries {
+		if !now.Before(cached.expiresAt) {
+			delete(u.entries, e)
+		}
+	}
+	u.entries[email] = entry
+	return entry.user, entry.found, nil
+}
+
+// clientCertificateEmail returns the email address of the verified client certificate of the connection, if any.
+func clientCertificateEmail(state *tls.ConnectionState) (string, bool) {
+	// the chains are only set for the certificates verified by the server
+	if state == nil || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return "", false
+	}
+	leaf := state.VerifiedChains[0][0]
+	if len(leaf.EmailAddresses) > 0 {
+		return leaf.EmailAddresses[0], true
+	}
+	if cn := leaf.Subject.CommonName; cn != "" {
+		return cn, true
+	}
+	return "", false
+}
+
 // AuthenticateExternalInitiator authenticates an external initiator request.
 //
 // Implements authMethod
@@ -2,11 +2,16 @@ package auth_test
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"database/sql"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -133,6 +138,82 @@ func TestAuthenticateByToken_RejectsBlankAccessKey(t *testing.T) {
 	assert.Equal(t, http.StatusText(http.StatusUnauthorized), http.StatusText(w.Code))
 }
 
+func TestAuthenticateByClientCertificate(t *testing.T) {
+	user := cltest.MustRandomUser(t)
+	verified := &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{{EmailAddresses: []string{user.Email}}}}}
+
+	tests := []struct {
+		name     string
+		authr    webauth.Authenticator
+		state    *tls.ConnectionState
+		header   string
+		expected int
+	}{
+		{"verified certificate of a user", userFindSuccesser{user: user}, verified, "true", http.StatusOK},
+		{"verified certificate with a common name", userFindSuccesser{user: user},
+			&tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{{Subject: pkix.Name{CommonName: user.Email}}}}}, "true", http.StatusOK},
+		{"verified certificate without the header", userFindSuccesser{user: user}, verified, "", http.StatusUnauthorized},
+		{"verified certificate of an unknown user", userFindFailer{err: sql.ErrNoRows}, verified, "true", http.StatusUnauthorized},
+		{"verified certificate with a failing lookup", userFindFailer{err: errors.New("ldap unavailable")}, verified, "true", http.StatusUnauthorized},
+		{"unverified certificate", userFindSuccesser{user: user},
+			&tls.ConnectionState{PeerCertificates: []*x509.Certificate{{EmailAddresses: []string{user.Email}}}}, "true", http.StatusUnauthorized},
+		{"no TLS", userFindSuccesser{user: user}, nil, "true", http.StatusUnauthorized},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var authenticated *sessions.User
+			router := gin.New()
+			router.Use(webauth.Authenticate(tc.authr, webauth.AuthenticateByClientCertificate(time.Minute)))
+			router.GET("/", func(c *gin.Context) {
+				authenticated, _ = webauth.GetAuthenticatedUser(c)
+				c.String(http.StatusOK, "")
+			})
+
+			w := httptest.NewRecorder()
+			req := mustRequest(t, "GET", "/", nil)
+			req.TLS = tc.state
+			if tc.header != "" {
+				req.Header.Set(webauth.ClientCertificate, tc.header)
+			}
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusText(tc.expected), http.StatusText(w.Code))
+			if tc.expected == http.StatusOK {
+				require.NotNil(t, authenticated)
+				assert.Equal(t, user.Email, authenticated.Email)
+			}
+		})
+	}
+
+	t.Run("caches the users", func(t *testing.T) {
+		authr := &userFindCounter{user: user}
+		router := gin.New()
+		router.Use(webauth.Authenticate(authr, webauth.AuthenticateByClientCertificate(time.Minute)))
+		router.GET("/", func(c *gin.Context) { c.String(http.StatusOK, "") })
+
+		for i := 0; i < 3; i++ {
+			w := httptest.NewRecorder()
+			req := mustRequest(t, "GET", "/", nil)
+			req.TLS = verified
+			req.Header.Set(webauth.ClientCertificate, "true")
+			router.ServeHTTP(w, req)
+			require.Equal(t, http.StatusOK, w.Code)
+		}
+		assert.Equal(t, 1, authr.calls)
+	})
+}
+
+type userFindCounter struct {
+	sessions.AuthenticationProvider
+	user  sessions.User
+	calls int
+}
+
+func (u *userFindCounter) FindUser(ctx context.Context, email string) (sessions.User, error) {
+	u.calls++
+	return u.user, nil
+}
+
 func TestRequireAuth_NoneRequired(t *testing.T) {
 	called := false
 	var authr webauth.Authenticator
@@ -222,9 +303,13 @@ var routesRolesMap = [...]routeRules{
 	{"POST", "/v2/users", false, false, false},
 	{"PATCH", "/v2/users", false, false, false},
 	{"DELETE", "/v2/users/MOCK", false, false, false},
+	{"DELETE", "/v2/users/MOCK/sessions", false, false, false},
 	{"PATCH", "/v2/user/password", true, true, true},
 	{"POST", "/v2/user/token", true, true, true},
 	{"POST", "/v2/user/token/delete", true, true, true},
+	{"GET", "/v2/user/api_tokens", true, true, true},
+	{"POST", "/v2/user/api_tokens", true, true, true},
+	{"DELETE", "/v2/user/api_tokens/MOCK", true, true, true},
 	{"GET", "/v2/enroll_webauthn", true, true, true},
 	{"POST", "/v2/enroll_webauthn", true, true, true},
 	{"GET", "/v2/external_initiators", true, true, true},
@@ -241,13 +326,19 @@ var routesRolesMap = [...]routeRules{
 	{"POST", "/v2/transfers/solana", false, false, false},
 	{"GET", "/v2/config", true, true, true},
 	{"GET", "/v2/config/v2", true, true, true},
+	{"POST", "/v2/config/reload", false, false, false},
+	{"GET", "/v2/drain", true, true, true},
+	{"POST", "/v2/drain", false, false, false},
 	{"GET", "/v2/tx_attempts", true, true, true},
 	{"GET", "/v2/tx_attempts/evm", true, true, true},
 	{"GET", "/v2/transactions/evm", true, true, true},
+	{"GET", "/v2/transactions/evm/circuit_breaker", true, true, true},
+	{"POST", "/v2/transactions/evm/circuit_breaker/reset", false, false, false},
 	{"GET", "/v2/transactions/evm/MOCK", true, true, true},
 	{"GET", "/v2/transactions", true, true, true},
 	{"GET", "/v2/transactions/MOCK", true, true, true},
 	{"POST", "/v2/replay_from_block/MOCK", false, true, true},
+	{"GET", "/v2/keys/audit_log", false, false, false},
 	{"GET", "/v2/keys/csa", true, true, true},
 	{"POST", "/v2/keys/csa", false, false, true},
 	{"POST", "/v2/keys/csa/import", false, false, false},
@@ -267,6 +358,8 @@ var routesRolesMap = [...]routeRules{
 	{"DELETE", "/v2/keys/ocr2/MOCK", false, false, false},
 	{"POST", "/v2/keys/ocr2/import", false, false, false},
 	{"POST", "/v2/keys/ocr2/export/MOCK", false, false, false},
+	{"POST", "/v2/keys/ocr2/bundles/import", false, false, false},
+	{"POST", "/v2/keys/ocr2/bundles/export", false, false, false},
 	{"GET", "/v2/keys/p2p", true, true, true},
 	{"POST", "/v2/keys/p2p", false, false, true},
 	{"DELETE", "/v2/keys/p2p/MOCK", false, false, false},
@@ -276,22 +369,39 @@ var routesRolesMap = [...]routeRules{
 	{"GET", "/v2/keys/cosmos", true, true, true},
 	{"GET", "/v2/keys/starknet", true, true, true},
 	{"GET", "/v2/keys/aptos", true, true, true},
+	{"GET", "/v2/keys/tron", true, true, true},
+	{"GET", "/v2/keys/report", true, true, true},
+	{"GET", "/v2/keys/tls", true, true, true},
 	{"POST", "/v2/keys/solana", false, false, true},
 	{"POST", "/v2/keys/cosmos", false, false, true},
 	{"POST", "/v2/keys/starknet", false, false, true},
 	{"POST", "/v2/keys/aptos", false, false, true},
+	{"POST", "/v2/keys/tron", false, false, true},
+	{"POST", "/v2/keys/report", false, false, true},
+	{"POST", "/v2/keys/tls", false, false, true},
 	{"DELETE", "/v2/keys/solana/MOCK", false, false, false},
 	{"DELETE", "/v2/keys/cosmos/MOCK", false, false, false},
 	{"DELETE", "/v2/keys/starknet/MOCK", false, false, false},
 	{"DELETE", "/v2/keys/aptos/MOCK", false, false, false},
+	{"DELETE", "/v2/keys/tron/MOCK", false, false, false},
+	{"DELETE", "/v2/keys/report/MOCK", false, false, false},
+	{"DELETE", "/v2/keys/tls/MOCK", false, false, false},
 	{"POST", "/v2/keys/solana/import", false, false, false},
 	{"POST", "/v2/keys/cosmos/import", false, false, false},
 	{"POST", "/v2/keys/starknet/import", false, false, false},
 	{"POST", "/v2/keys/aptos/import", false, false, false},
+	{"POST", "/v2/keys/tron/import", false, false, false},
+	{"POST", "/v2/keys/report/import", false, false, false},
+	{"POST", "/v2/keys/tls/import", false, false, false},
 	{"POST", "/v2/keys/solana/export/MOCK", false, false, false},
 	{"POST", "/v2/keys/cosmos/export/MOCK", false, false, false},
 	{"POST", "/v2/keys/starknet/export/MOCK", false, false, false},
 	{"POST", "/v2/keys/aptos/export/MOCK", false, false, false},
+	{"POST", "/v2/keys/tron/export/MOCK", false, false, false},
+	{"POST", "/v2/keys/report/export/MOCK", false, false, false},
+	{"POST", "/v2/keys/tls/export/MOCK", false, false, false},
+	{"POST", "/v2/keys/tls/MOCK/csr", false, false, true},
+	{"PUT", "/v2/keys/tls/MOCK/certificate", false, false, false},
 	{"GET", "/v2/keys/vrf", true, true, true},
 	{"POST", "/v2/keys/vrf", false, false, true},
 	{"DELETE", "/v2/keys/vrf/MOCK", false, false, false},
@@ -300,12 +410,36 @@ var routesRolesMap = [...]routeRules{
 	{"GET", "/v2/jobs", true, true, true},
 	{"GET", "/v2/jobs/MOCK", true, true, true},
 	{"POST", "/v2/jobs", false, false, true},
+	{"POST", "/v2/jobs/bulk", false, false, true},
+	{"POST", "/v2/jobs/validate", true, true, true},
+	{"GET", "/v2/jobs/schemas", true, true, true},
+	{"GET", "/v2/jobs/schemas/MOCK", true, true, true},
 	{"DELETE", "/v2/jobs/MOCK", false, false, true},
+	{"POST", "/v2/jobs/MOCK/promote", false, false, true},
+	{"GET", "/v2/jobs/MOCK/versions", true, true, true},
+	{"GET", "/v2/jobs/MOCK/versions/MOCK/diff", true, true, true},
+	{"POST", "/v2/jobs/MOCK/versions/MOCK/rollback", false, false, true},
+	{"GET", "/v2/jobs/events", true, true, true},
 	{"GET", "/v2/pipeline/runs", true, true, true},
 	{"GET", "/v2/jobs/MOCK/runs", true, true, true},
 	{"GET", "/v2/jobs/MOCK/runs/MOCK", true, true, true},
+	{"GET", "/v2/reports", true, true, true},
+	{"POST", "/v2/reports", false, false, true},
+	{"GET", "/v2/reports/MOCK", true, true, true},
+	{"GET", "/v2/reports/MOCK/download", true, true, true},
+	{"DELETE", "/v2/reports/MOCK", false, false, true},
 	{"GET", "/v2/features", true, true, true},
+	{"GET", "/v2/ccip/lanes", true, true, true},
+	{"GET", "/v2/ccip/lanes/discover", true, true, true},
+	{"GET", "/v2/p2p/diagnostics", true, true, true},
+	{"GET", "/v2/p2p/bootstrapper_overrides", true, true, true},
+	{"PUT", "/v2/p2p/bootstrapper_overrides/MOCK/MOCK", false, false, true},
+	{"DELETE", "/v2/p2p/bootstrapper_overrides/MOCK/MOCK", false, false, true},
+	{"GET", "/v2/functions/quotas/MOCK", true, true, true},
+	{"PUT", "/v2/functions/quotas/MOCK/MOCK", false, false, false},
+	{"DELETE", "/v2/functions/quotas/MOCK/MOCK", false, false, false},
 	{"DELETE", "/v2/pipeline/job_spec_errors/MOCK", false, false, true},
+	{"GET", "/v2/slow_queries", true, true, true},
 	{"GET", "/v2/log", true, true, true},
 	{"PATCH", "/v2/log", false, false, false},
 	{"GET", "/v2/chains/evm", true, true, true},
@@ -50,6 +50,9 @@ func ValidateBridgeType(bt *bridges.BridgeTypeRequest) error {
 		bt.MinimumContractPayment.Cmp(assets.NewLinkFromJuels(0)) < 0 {
 		fe.Add("MinimumContractPayment must be positive")
 	}
+	if err := bridges.ValidateResponsePublicKey(bt.ResponsePublicKey); err != nil {
+		fe.Add(err.Error())
+	}
 	return fe.CoerceEmptyToNil()
 }
 
@@ -0,0 +1,105 @@
+package web
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+
+	chainselectors "github.com/smartcontractkit/chain-selectors"
+
+	cciporm "github.com/smartcontractkit/chainlink/v2/core/services/ccip"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/discovery"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// CCIPLanesController reports the status of the CCIP lanes served by the node.
+type CCIPLanesController struct {
+	App chainlink.Application
+}
+
+// Index lists the status of the CCIP lanes, as last observed by the CCIP plugins of the node, along with the times
+// their prices were last updated.
+// Example:
+// "GET <application>/ccip/lanes"
+func (cc *CCIPLanesController) Index(c *gin.Context) {
+	orm, err := cciporm.NewORM(cc.App.GetDB(), cc.App.GetLogger())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	resources := []presenters.CCIPLaneResource{}
+	for _, status := range ccip.Lanes.List() {
+		priceUpdates, err := orm.GetPriceUpdateTimes(c.Request.Context(), status.DestChainSelector, status.SourceChainSelector)
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		resources = append(resources, presenters.NewCCIPLaneResource(status, priceUpdates))
+	}
+
+	jsonAPIResponse(c, resources, "ccipLanes")
+}
+
+// Discover reads the contracts of a CCIP lane onchain, from the router of its dest chain, and returns them along with
+// the skeletons of the commit and execution job specs of the lane. Both chains must be configured on the node.
+// Example:
+// "GET <application>/ccip/lanes/discover?sourceChainSelector=5009297550715157269&destChainSelector=4949039107694359620&router=0x..."
+func (cc *CCIPLanesController) Discover(c *gin.Context) {
+	sourceChainSelector, err := strconv.ParseUint(c.Query("sourceChainSelector"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, fmt.Errorf("invalid sourceChainSelector: %w", err))
+		return
+	}
+	destChainSelector, err := strconv.ParseUint(c.Query("destChainSelector"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, fmt.Errorf("invalid destChainSelector: %w", err))
+		return
+	}
+	if !common.IsHexAddress(c.Query("router")) {
+		jsonAPIError(c, http.StatusBadRequest, fmt.Errorf("invalid router address %q", c.Query("router")))
+		return
+	}
+
+	sourceClient, err := cc.chainClient(sourceChainSelector)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	destClient, err := cc.chainClient(destChainSelector)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	lane, err := discovery.Discover(c.Request.Context(), sourceClient, destClient, sourceChainSelector, destChainSelector, common.HexToAddress(c.Query("router")))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	resource, err := presenters.NewCCIPLaneDiscoveryResource(lane)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, resource, "ccipLaneDiscoveries")
+}
+
+func (cc *CCIPLanesController) chainClient(chainSelector uint64) (bind.ContractBackend, error) {
+	chainID, err := chainselectors.ChainIdFromSelector(chainSelector)
+	if err != nil {
+		return nil, err
+	}
+	chain, err := cc.App.GetRelayers().LegacyEVMChains().Get(strconv.FormatUint(chainID, 10))
+	if err != nil {
+		return nil, fmt.Errorf("chain %d of chain selector %d is not available: %w", chainID, chainSelector, err)
+	}
+	return chain.Client(), nil
+}
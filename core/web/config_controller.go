@@ -41,6 +41,35 @@ func (cc *ConfigController) Show(c *gin.Context) {
 	jsonAPIResponse(c, ConfigV2Resource{toml}, "config")
 }
 
+// Reload re-reads the config files and applies changes to the hot-reloadable
+// fields, without restarting the node.
+// Example:
+//
+//	"<application>/config/reload"
+func (cc *ConfigController) Reload(c *gin.Context) {
+	changed, err := cc.App.ReloadConfig(c.Request.Context())
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if changed == nil {
+		changed = []string{}
+	}
+	jsonAPIResponse(c, ConfigReloadResource{Changed: changed}, "configReload")
+}
+
+type ConfigReloadResource struct {
+	Changed []string `json:"changed"`
+}
+
+func (c ConfigReloadResource) GetID() string {
+	return utils.NewBytes32ID()
+}
+
+func (c *ConfigReloadResource) SetID(string) error {
+	return nil
+}
+
 type ConfigV2Resource struct {
 	Config string `json:"config"`
 }
@@ -0,0 +1,31 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// DrainController drains the node before it exits.
+type DrainController struct {
+	App chainlink.Application
+}
+
+// Show returns the status of the drain.
+// Example:
+// "GET <application>/drain"
+func (dc *DrainController) Show(c *gin.Context) {
+	jsonAPIResponse(c, presenters.NewDrainResource(dc.App.GetDrainer().Status()), "drain")
+}
+
+// Create starts draining the node, which stops its jobs, and returns the status of the drain. The node exits once
+// drained if it was started with `chainlink node start`.
+// Example:
+// "POST <application>/drain"
+func (dc *DrainController) Create(c *gin.Context) {
+	status := dc.App.GetDrainer().Drain()
+	jsonAPIResponseWithStatus(c, presenters.NewDrainResource(status), "drain", http.StatusAccepted)
+}
@@ -0,0 +1,44 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/drain"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestDrainController(t *testing.T) {
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	show := func() presenters.DrainResource {
+		resp, cleanup := client.Get("/v2/drain")
+		defer cleanup()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var status presenters.DrainResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &status))
+		return status
+	}
+	assert.Equal(t, drain.PhaseNotDraining, show().Phase)
+
+	resp, cleanup := client.Post("/v2/drain", nil)
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+	var status presenters.DrainResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &status))
+	assert.NotEqual(t, drain.PhaseNotDraining, status.Phase)
+	require.NotNil(t, status.Deadline)
+
+	require.Eventually(t, func() bool {
+		return show().Phase == drain.PhaseDrained
+	}, testutils.WaitTimeout(t), 100*time.Millisecond)
+	assert.False(t, show().TimedOut)
+}
@@ -0,0 +1,102 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+)
+
+// CircuitBreakerController shows and resets the broadcast circuit breakers of the EVM chains.
+type CircuitBreakerController struct {
+	App chainlink.Application
+}
+
+// Show returns the state of the circuit breaker of a chain
+// Example:
+//
+//	"<application>/v2/transactions/evm/circuit_breaker?evmChainID=1"
+func (cbc *CircuitBreakerController) Show(c *gin.Context) {
+	cb, chainID, ok := cbc.circuitBreaker(c)
+	if !ok {
+		return
+	}
+	jsonAPIResponse(c, newCircuitBreakerResponse(chainID, cb.Status()), "circuit_breaker")
+}
+
+// Reset closes the circuit breaker of a chain, to resume broadcasting after an RPC incident
+// Example:
+//
+//	"<application>/v2/transactions/evm/circuit_breaker/reset?evmChainID=1"
+func (cbc *CircuitBreakerController) Reset(c *gin.Context) {
+	cb, chainID, ok := cbc.circuitBreaker(c)
+	if !ok {
+		return
+	}
+	cb.Reset()
+	jsonAPIResponse(c, newCircuitBreakerResponse(chainID, cb.Status()), "circuit_breaker")
+}
+
+func (cbc *CircuitBreakerController) circuitBreaker(c *gin.Context) (*txmgrcommon.CircuitBreaker, *big.Big, bool) {
+	chain, err := getChain(cbc.App.GetRelayers().LegacyEVMChains(), c.Query("evmChainID"))
+	if err != nil {
+		if errors.Is(err, ErrInvalidChainID) || errors.Is(err, ErrMultipleChains) || errors.Is(err, ErrMissingChainID) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return nil, nil, false
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return nil, nil, false
+	}
+	cb := chain.TxManager().CircuitBreaker()
+	if cb == nil {
+		jsonAPIError(c, http.StatusNotFound, fmt.Errorf("circuit breaker is disabled for chain %s", chain.ID()))
+		return nil, nil, false
+	}
+	return cb, big.New(chain.ID()), true
+}
+
+type CircuitBreakerResponse struct {
+	EVMChainID                   *big.Big   `json:"evmChainID"`
+	State                        string     `json:"state"`
+	ConsecutiveBroadcastFailures uint32     `json:"consecutiveBroadcastFailures"`
+	ConsecutiveReceiptFailures   uint32     `json:"consecutiveReceiptFailures"`
+	OpenedAt                     *time.Time `json:"openedAt"`
+	LastError                    string     `json:"lastError"`
+}
+
+func newCircuitBreakerResponse(chainID *big.Big, status txmgrcommon.CircuitBreakerStatus) *CircuitBreakerResponse {
+	r := &CircuitBreakerResponse{
+		EVMChainID:                   chainID,
+		State:                        string(status.State),
+		ConsecutiveBroadcastFailures: status.ConsecutiveBroadcastFailures,
+		ConsecutiveReceiptFailures:   status.ConsecutiveReceiptFailures,
+		LastError:                    status.LastError,
+	}
+	if !status.OpenedAt.IsZero() {
+		r.OpenedAt = &status.OpenedAt
+	}
+	return r
+}
+
+// GetID returns the jsonapi ID.
+func (r CircuitBreakerResponse) GetID() string {
+	return r.EVMChainID.String()
+}
+
+// GetName returns the collection name for jsonapi.
+func (CircuitBreakerResponse) GetName() string {
+	return "circuit_breakers"
+}
+
+// SetID is used to conform to the UnmarshallIdentifier interface for
+// deserializing from jsonapi documents.
+func (r *CircuitBreakerResponse) SetID(value string) error {
+	r.EVMChainID = new(big.Big)
+	return r.EVMChainID.UnmarshalText([]byte(value))
+}
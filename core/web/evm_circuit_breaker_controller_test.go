@@ -0,0 +1,65 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+)
+
+func TestCircuitBreakerController(t *testing.T) {
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].Transactions.CircuitBreaker.Enabled = ptr(true)
+		c.EVM[0].Transactions.CircuitBreaker.BroadcastFailureThreshold = ptr[uint32](1)
+	})
+	app := cltest.NewApplicationWithConfigAndKey(t, cfg, setupEthClientForControllerTests(t))
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+	chainID := cltest.FixtureChainID.String()
+
+	resp, cleanup := client.Get("/v2/transactions/evm/circuit_breaker?evmChainID=" + chainID)
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var status web.CircuitBreakerResponse
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &status))
+	assert.Equal(t, chainID, status.EVMChainID.String())
+	assert.Equal(t, "closed", status.State)
+	assert.Nil(t, status.OpenedAt)
+
+	chain, err := app.GetRelayers().LegacyEVMChains().Get(chainID)
+	require.NoError(t, err)
+	cb := chain.TxManager().CircuitBreaker()
+	cb.RecordBroadcastFailure(assert.AnError)
+	require.False(t, cb.Allow())
+
+	resp, cleanup = client.Post("/v2/transactions/evm/circuit_breaker/reset?evmChainID="+chainID, nil)
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &status))
+	assert.Equal(t, "closed", status.State)
+	assert.Zero(t, status.ConsecutiveBroadcastFailures)
+	assert.Equal(t, assert.AnError.Error(), status.LastError)
+	assert.True(t, cb.Allow())
+
+	resp, cleanup = client.Get("/v2/transactions/evm/circuit_breaker?evmChainID=1")
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+}
+
+func TestCircuitBreakerController_Disabled(t *testing.T) {
+	cfg := configtest.NewGeneralConfig(t, nil)
+	app := cltest.NewApplicationWithConfigAndKey(t, cfg, setupEthClientForControllerTests(t))
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	resp, cleanup := client.Get("/v2/transactions/evm/circuit_breaker?evmChainID=" + cltest.FixtureChainID.String())
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
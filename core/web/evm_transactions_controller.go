@@ -3,6 +3,8 @@ package web
 import (
 	"database/sql"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
@@ -28,6 +30,20 @@ func (tc *TransactionsController) Index(c *gin.Context, size, page, offset int)
 	paginatedResponse(c, "transactions", size, page, ptxs, count, err)
 }
 
+// Stuck returns paginated transactions which were detected as terminally stuck, and are being purged or blocked by a nonce gap
+// Example:
+//
+//	"<application>/transactions/evm/stuck"
+func (tc *TransactionsController) Stuck(c *gin.Context, size, page, offset int) {
+	txs, count, err := tc.App.TxmStorageService().StuckTransactions(c, offset, size)
+	ptxs := make([]presenters.EthTxResource, len(txs))
+	for i, tx := range txs {
+		tx.TxAttempts[0].Tx = tx
+		ptxs[i] = presenters.NewEthTxResourceFromAttempt(tx.TxAttempts[0])
+	}
+	paginatedResponse(c, "transactions", size, page, ptxs, count, err)
+}
+
 // Show returns the details of a Ethereum Transaction details.
 // Example:
 //
@@ -47,3 +63,94 @@ func (tc *TransactionsController) Show(c *gin.Context) {
 
 	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(*ethTxAttempt), "transaction")
 }
+
+// ShowByIdempotencyKey returns the transaction created with an idempotency key, so that callers retrying a transfer
+// can look up its state.
+// Example:
+//
+//	"<application>/transactions/evm/idempotency_keys/:IdempotencyKey?evmChainID=1"
+func (tc *TransactionsController) ShowByIdempotencyKey(c *gin.Context) {
+	chain, err := getChain(tc.App.GetRelayers().LegacyEVMChains(), c.Query("evmChainID"))
+	if err != nil {
+		if errors.Is(err, ErrInvalidChainID) || errors.Is(err, ErrMultipleChains) || errors.Is(err, ErrMissingChainID) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	txStore := tc.App.TxmStorageService()
+	idempotencyKey := c.Param("IdempotencyKey")
+	tx, err := txStore.FindTxWithIdempotencyKey(c, idempotencyKey, chain.ID())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if tx == nil {
+		// bundled requests are looked up by the bundle they were aggregated into
+		var bundleKey *string
+		bundleKey, err = txStore.FindBundleIdempotencyKey(c, idempotencyKey)
+		if err == nil && bundleKey != nil {
+			tx, err = txStore.FindTxWithIdempotencyKey(c, *bundleKey, chain.ID())
+		}
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+	}
+	if tx == nil {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Transaction not found"))
+		return
+	}
+
+	if len(tx.TxAttempts) == 0 {
+		r := presenters.NewEthTxResource(*tx)
+		r.JAID = presenters.NewJAID(strconv.FormatInt(tx.ID, 10))
+		jsonAPIResponse(c, r, "transaction")
+		return
+	}
+	attempt := tx.TxAttempts[0]
+	attempt.Tx = *tx
+	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(attempt), "transaction")
+}
+
+// JobSpend returns the fees spent on transactions created on behalf of a job since the given RFC3339 time, or within the
+// JobSpendWindow of the chain if it is omitted.
+// Example:
+//
+//	"<application>/transactions/evm/job_spend/:JobID?evmChainID=1&since=2024-01-02T15:04:05Z"
+func (tc *TransactionsController) JobSpend(c *gin.Context) {
+	jobID, err := strconv.ParseInt(c.Param("JobID"), 10, 32)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid job ID"))
+		return
+	}
+
+	chain, err := getChain(tc.App.GetRelayers().LegacyEVMChains(), c.Query("evmChainID"))
+	if err != nil {
+		if errors.Is(err, ErrInvalidChainID) || errors.Is(err, ErrMultipleChains) || errors.Is(err, ErrMissingChainID) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	since := time.Now().Add(-chain.Config().EVM().Transactions().JobSpendWindow())
+	if s := c.Query("since"); s != "" {
+		since, err = time.Parse(time.RFC3339, s)
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid since"))
+			return
+		}
+	}
+
+	spend, err := tc.App.TxmStorageService().FindJobSpend(c, int32(jobID), since, chain.ID())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewEVMJobSpendResource(spend, chain.ID(), since), "evm_job_spend")
+}
@@ -3,11 +3,16 @@ package web_test
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 	"testing"
+	"time"
 
+	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
 	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
@@ -77,6 +82,46 @@ func TestTransactionsController_Index_Error(t *testing.T) {
 	cltest.AssertServerResponse(t, resp, 422)
 }
 
+func TestTransactionsController_Stuck_Success(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	ctx := testutils.Context(t)
+	require.NoError(t, app.Start(ctx))
+
+	db := app.GetDB()
+	txStore := cltest.NewTestTxStore(t, app.GetDB())
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	client := app.NewHTTPClient(nil)
+	_, from := cltest.MustInsertRandomKey(t, ethKeyStore)
+
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 0, from)
+	stuckTx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 1, from)
+
+	// add purge attempt for the stuck tx
+	attempt := cltest.NewLegacyEthTxAttempt(t, stuckTx.ID)
+	attempt.State = txmgrtypes.TxAttemptBroadcast
+	attempt.IsPurgeAttempt = true
+	require.NoError(t, txStore.InsertTxAttempt(ctx, &attempt))
+
+	// add a tx blocked by a nonce gap
+	gapTx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 2, from)
+	require.NoError(t, txStore.SaveNonceGap(ctx, gapTx.ID, evmtypes.Nonce(1)))
+
+	resp, cleanup := client.Get("/v2/transactions/evm/stuck")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var links jsonapi.Links
+	var txs []presenters.EthTxResource
+	body := cltest.ParseResponseBody(t, resp)
+	require.NoError(t, web.ParsePaginatedResponse(body, &txs, &links))
+
+	require.Len(t, txs, 2)
+	assert.Equal(t, "2", txs[0].Nonce)
+	assert.Equal(t, "1", txs[1].Nonce)
+}
+
 func TestTransactionsController_Show_Success(t *testing.T) {
 	t.Parallel()
 
@@ -129,3 +174,91 @@ func TestTransactionsController_Show_NotFound(t *testing.T) {
 	t.Cleanup(cleanup)
 	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
 }
+
+func TestTransactionsController_ShowByIdempotencyKey_Bundled(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	ctx := testutils.Context(t)
+	require.NoError(t, app.Start(ctx))
+
+	client := app.NewHTTPClient(nil)
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth())
+	chainID := testutils.FixtureChainID
+
+	bundleKey := "bundle"
+	bundle, err := app.TxmStorageService().CreateTransaction(ctx, txmgr.TxRequest{
+		FromAddress:            from,
+		IdempotencyKey:         &bundleKey,
+		Strategy:               txmgrcommon.NewSendEveryStrategy(),
+		BundledIdempotencyKeys: []string{"write-1", "write-2"},
+	}, chainID)
+	require.NoError(t, err)
+
+	// bundled requests return the bundle they were aggregated into
+	resp, cleanup := client.Get("/v2/transactions/evm/idempotency_keys/write-2?evmChainID=" + chainID.String())
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var tx presenters.EthTxResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &tx))
+	assert.Equal(t, strconv.FormatInt(bundle.ID, 10), tx.ID)
+	assert.Equal(t, bundleKey, *tx.IdempotencyKey)
+
+	resp, cleanup = client.Get("/v2/transactions/evm/idempotency_keys/write-3?evmChainID=" + chainID.String())
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
+
+func TestTransactionsController_JobSpend(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	ctx := testutils.Context(t)
+	require.NoError(t, app.Start(ctx))
+
+	client := app.NewHTTPClient(nil)
+	chainID := testutils.FixtureChainID.String()
+	_, err := app.GetDB().ExecContext(ctx, `
+INSERT INTO evm.tx_job_spend (tx_id, evm_chain_id, job_id, attempted_fee, mined_fee, created_at, updated_at) VALUES
+	(1, $1, 7, 100, NULL, NOW(), NOW()),
+	(2, $1, 7, 100, 40, NOW(), NOW()),
+	(3, $1, 7, 100, 60, NOW() - interval '48 hours', NOW()),
+	(4, $1, 8, 100, 10, NOW(), NOW())`, chainID)
+	require.NoError(t, err)
+
+	t.Run("within the job spend window", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/transactions/evm/job_spend/7?evmChainID=" + chainID)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var spend presenters.EVMJobSpendResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &spend))
+		assert.Equal(t, int32(7), spend.JobID)
+		assert.Equal(t, "100", spend.Attempted)
+		assert.Equal(t, "40", spend.Mined)
+		assert.Equal(t, "140", spend.Total)
+	})
+
+	t.Run("since", func(t *testing.T) {
+		since := time.Now().Add(-72 * time.Hour).UTC().Format(time.RFC3339)
+		resp, cleanup := client.Get("/v2/transactions/evm/job_spend/7?evmChainID=" + chainID + "&since=" + since)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var spend presenters.EVMJobSpendResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &spend))
+		assert.Equal(t, "100", spend.Mined)
+		assert.Equal(t, "200", spend.Total)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/transactions/evm/job_spend/seven?evmChainID=" + chainID)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+		resp, cleanup = client.Get("/v2/transactions/evm/job_spend/7?evmChainID=" + chainID + "&since=yesterday")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	})
+}
@@ -53,23 +53,48 @@ func (tc *EVMTransfersController) Create(c *gin.Context) {
 		return
 	}
 
-	if !tr.AllowHigherAmounts {
-		err = ValidateEthBalanceForTransfer(c, chain, tr.FromAddress, tr.Amount, tr.DestinationAddress)
+	// A retried transfer returns the transaction created by the first request, before its amount is checked against
+	// the balance it may already have spent
+	var existing *txmgr.Tx
+	if tr.IdempotencyKey != nil {
+		existing, err = tc.App.TxmStorageService().FindTxWithIdempotencyKey(c, *tr.IdempotencyKey, chain.ID())
 		if err != nil {
-			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("transaction failed: %v", err))
+			jsonAPIError(c, http.StatusInternalServerError, err)
 			return
 		}
 	}
 
-	etx, err := chain.TxManager().SendNativeToken(c, chain.ID(), tr.FromAddress, tr.DestinationAddress, *tr.Amount.ToInt(), chain.Config().EVM().GasEstimator().LimitTransfer())
+	var etx txmgr.Tx
+	if existing != nil {
+		etx, err = matchIdempotentTransfer(*existing, tr)
+	} else {
+		if !tr.AllowHigherAmounts {
+			err = ValidateEthBalanceForTransfer(c, chain, tr.FromAddress, tr.Amount, tr.DestinationAddress)
+			if err != nil {
+				jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("transaction failed: %v", err))
+				return
+			}
+		}
+		if tr.IdempotencyKey != nil {
+			etx, err = tc.sendNativeTokenWithIdempotencyKey(c, chain, tr)
+		} else {
+			etx, err = chain.TxManager().SendNativeToken(c, chain.ID(), tr.FromAddress, tr.DestinationAddress, *tr.Amount.ToInt(), chain.Config().EVM().GasEstimator().LimitTransfer())
+		}
+	}
+	if errors.Is(err, ErrIdempotencyKeyConflict) {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	}
 	if err != nil {
 		jsonAPIError(c, http.StatusBadRequest, errors.Errorf("transaction failed: %v", err))
 		return
 	}
 
-	tc.App.GetAuditLogger().Audit(audit.EthTransactionCreated, map[string]interface{}{
-		"ethTX": etx,
-	})
+	if existing == nil {
+		tc.App.GetAuditLogger().Audit(audit.EthTransactionCreated, map[string]interface{}{
+			"ethTX": etx,
+		})
+	}
 
 	// skip waiting for txmgr to create TxAttempt
 	if tr.SkipWaitTxAttempt {
@@ -91,6 +116,44 @@ func (tc *EVMTransfersController) Create(c *gin.Context) {
 	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(attempt), "eth_tx")
 }
 
+// ErrIdempotencyKeyConflict is returned when the idempotency key of a transfer was already used for another transaction.
+var ErrIdempotencyKeyConflict = errors.New("idempotency key was already used for a different transaction")
+
+// sendNativeTokenWithIdempotencyKey creates the transfer with the idempotency key of the request, or returns the
+// transaction already created with the key if it is the same transfer, so that retried requests don't send twice.
+func (tc *EVMTransfersController) sendNativeTokenWithIdempotencyKey(ctx context.Context, chain legacyevm.Chain, tr models.SendEtherRequest) (txmgr.Tx, error) {
+	if tr.DestinationAddress == utils.ZeroAddress {
+		return txmgr.Tx{}, errors.New("cannot send native token to zero address")
+	}
+	etx, err := chain.TxManager().CreateTransaction(ctx, txmgr.TxRequest{
+		IdempotencyKey: tr.IdempotencyKey,
+		FromAddress:    tr.FromAddress,
+		ToAddress:      tr.DestinationAddress,
+		EncodedPayload: []byte{},
+		Value:          *tr.Amount.ToInt(),
+		FeeLimit:       chain.Config().EVM().GasEstimator().LimitTransfer(),
+		Strategy:       commontxmgr.NewSendEveryStrategy(),
+	})
+	if err != nil {
+		// a concurrent request with the same key may have created the transaction first
+		existing, findErr := tc.App.TxmStorageService().FindTxWithIdempotencyKey(ctx, *tr.IdempotencyKey, chain.ID())
+		if findErr != nil || existing == nil {
+			return txmgr.Tx{}, err
+		}
+		etx = *existing
+	}
+	return matchIdempotentTransfer(etx, tr)
+}
+
+// matchIdempotentTransfer returns the transaction created with the idempotency key of the transfer, if it is the same
+// transfer
+func matchIdempotentTransfer(etx txmgr.Tx, tr models.SendEtherRequest) (txmgr.Tx, error) {
+	if etx.FromAddress != tr.FromAddress || etx.ToAddress != tr.DestinationAddress || etx.Value.Cmp(tr.Amount.ToInt()) != 0 {
+		return txmgr.Tx{}, fmt.Errorf("%w: %s", ErrIdempotencyKeyConflict, *tr.IdempotencyKey)
+	}
+	return etx, nil
+}
+
 // ValidateEthBalanceForTransfer validates that the current balance can cover the transaction amount
 func ValidateEthBalanceForTransfer(c *gin.Context, chain legacyevm.Chain, fromAddr common.Address, amount assets.Eth, toAddr common.Address) error {
 	var err error
@@ -12,6 +12,7 @@ import (
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
@@ -74,6 +75,82 @@ func TestTransfersController_CreateSuccess_From(t *testing.T) {
 	validateTxCount(t, app.GetDB(), 1)
 }
 
+func TestTransfersController_CreateSuccess_IdempotencyKey(t *testing.T) {
+	t.Parallel()
+
+	key := cltest.MustGenerateRandomKey(t)
+
+	ethClient := cltest.NewEthMocksWithTransactionsOnBlocksAssertions(t)
+
+	balance, err := assets.NewEthValueS("200")
+	require.NoError(t, err)
+
+	ethClient.On("PendingNonceAt", mock.Anything, key.Address).Return(uint64(1), nil)
+	ethClient.On("BalanceAt", mock.Anything, key.Address, (*big.Int)(nil)).Return(balance.ToInt(), nil)
+
+	app := cltest.NewApplicationWithKey(t, ethClient, key)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(nil)
+
+	amount, err := assets.NewEthValueS("100")
+	require.NoError(t, err)
+
+	idempotencyKey := "withdrawal-42"
+	chainID := evmtest.MustGetDefaultChainID(t, app.Config.EVMConfigs())
+	request := models.SendEtherRequest{
+		DestinationAddress: common.HexToAddress("0xFA01FA015C8A5332987319823728982379128371"),
+		FromAddress:        key.Address,
+		Amount:             amount,
+		SkipWaitTxAttempt:  true,
+		EVMChainID:         ubig.New(chainID),
+		IdempotencyKey:     &idempotencyKey,
+	}
+
+	// retried requests return the same transaction
+	for i := 0; i < 2; i++ {
+		body, err := json.Marshal(&request)
+		require.NoError(t, err)
+
+		resp, cleanup := client.Post("/v2/transfers", bytes.NewBuffer(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var tx presenters.EthTxResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &tx))
+		require.NotNil(t, tx.IdempotencyKey)
+		assert.Equal(t, idempotencyKey, *tx.IdempotencyKey)
+	}
+	validateTxCount(t, app.GetDB(), 1)
+
+	t.Run("conflict", func(t *testing.T) {
+		conflicting := request
+		conflicting.Amount = assets.NewEthValue(1)
+		body, err := json.Marshal(&conflicting)
+		require.NoError(t, err)
+
+		resp, cleanup := client.Post("/v2/transfers", bytes.NewBuffer(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusConflict)
+		validateTxCount(t, app.GetDB(), 1)
+	})
+
+	t.Run("lookup", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/transactions/evm/idempotency_keys/" + idempotencyKey + "?evmChainID=" + chainID.String())
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var tx presenters.EthTxResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &tx))
+		assert.Equal(t, key.Address, *tx.From)
+		assert.Equal(t, idempotencyKey, *tx.IdempotencyKey)
+
+		resp, cleanup = client.Get("/v2/transactions/evm/idempotency_keys/unknown?evmChainID=" + chainID.String())
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	})
+}
+
 func TestTransfersController_CreateSuccess_From_WEI(t *testing.T) {
 	t.Parallel()
 
@@ -222,6 +299,64 @@ func TestTransfersController_TransferBalanceToLowError(t *testing.T) {
 	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
 }
 
+func TestTransfersController_TransferBalanceToLowError_IdempotencyKeyRetry(t *testing.T) {
+	t.Parallel()
+
+	key := cltest.MustGenerateRandomKey(t)
+
+	ethClient := cltest.NewEthMocksWithTransactionsOnBlocksAssertions(t)
+
+	ethClient.On("PendingNonceAt", mock.Anything, key.Address).Return(uint64(1), nil)
+	ethClient.On("BalanceAt", mock.Anything, key.Address, (*big.Int)(nil)).Return(assets.NewEth(10).ToInt(), nil)
+
+	app := cltest.NewApplicationWithKey(t, ethClient, key)
+	ctx := testutils.Context(t)
+	require.NoError(t, app.Start(ctx))
+
+	client := app.NewHTTPClient(nil)
+
+	amount, err := assets.NewEthValueS("100")
+	require.NoError(t, err)
+
+	// the first request was sent, and spent the balance
+	idempotencyKey := "withdrawal-43"
+	chainID := evmtest.MustGetDefaultChainID(t, app.Config.EVMConfigs())
+	destination := common.HexToAddress("0xFA01FA015C8A5332987319823728982379128371")
+	etx, err := app.TxmStorageService().CreateTransaction(ctx, txmgr.TxRequest{
+		IdempotencyKey: &idempotencyKey,
+		FromAddress:    key.Address,
+		ToAddress:      destination,
+		EncodedPayload: []byte{},
+		Value:          *amount.ToInt(),
+		Strategy:       txmgrcommon.NewSendEveryStrategy(),
+	}, chainID)
+	require.NoError(t, err)
+
+	request := models.SendEtherRequest{
+		FromAddress:        key.Address,
+		DestinationAddress: destination,
+		Amount:             amount,
+		SkipWaitTxAttempt:  true,
+		EVMChainID:         ubig.New(chainID),
+		IdempotencyKey:     &idempotencyKey,
+	}
+
+	body, err := json.Marshal(&request)
+	require.NoError(t, err)
+
+	// the retry returns it rather than failing the balance check
+	resp, cleanup := client.Post("/v2/transfers", bytes.NewBuffer(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var tx presenters.EthTxResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &tx))
+	require.NotNil(t, tx.IdempotencyKey)
+	assert.Equal(t, idempotencyKey, *tx.IdempotencyKey)
+	assert.Equal(t, string(etx.State), tx.State)
+	validateTxCount(t, app.GetDB(), 1)
+}
+
 func TestTransfersController_TransferBalanceToLowError_ZeroBalance(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,152 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/functions"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// FunctionsQuotasController manages the quotas of the subscriptions of Functions router contracts. Subscriptions
+// without a quota use the defaults of the job.
+type FunctionsQuotasController struct {
+	App chainlink.Application
+}
+
+// FunctionsQuotaRequest sets the quota of a subscription. A null limit uses the default of the job.
+type FunctionsQuotaRequest struct {
+	RequestsPerMinute    *uint32 `json:"requestsPerMinute"`
+	MonthlyComputeMillis *uint64 `json:"monthlyComputeMillis"`
+}
+
+// Index lists the quotas of the subscriptions of a contract, along with their compute time used in the current month.
+// Example:
+// "GET <application>/functions/quotas/:contractAddress"
+func (fqc *FunctionsQuotasController) Index(c *gin.Context) {
+	orm, ok := fqc.quotaORM(c)
+	if !ok {
+		return
+	}
+	ctx := c.Request.Context()
+	quotas, err := orm.ListQuotas(ctx)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	month := functions.StartOfMonth(time.Now())
+	resources := []presenters.FunctionsQuotaResource{}
+	for _, quota := range quotas {
+		used, err := orm.GetComputeUsage(ctx, quota.SubscriptionID, month)
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		resources = append(resources, presenters.NewFunctionsQuotaResource(quota, used))
+	}
+
+	jsonAPIResponse(c, resources, "functionsQuotas")
+}
+
+// Update sets the quota of a subscription.
+// Example:
+// "PUT <application>/functions/quotas/:contractAddress/:subscriptionID"
+func (fqc *FunctionsQuotasController) Update(c *gin.Context) {
+	orm, ok := fqc.quotaORM(c)
+	if !ok {
+		return
+	}
+	subscriptionID, ok := parseSubscriptionID(c)
+	if !ok {
+		return
+	}
+	var request FunctionsQuotaRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	err := orm.UpsertQuota(ctx, functions.SubscriptionQuota{
+		SubscriptionID:       subscriptionID,
+		RequestsPerMinute:    request.RequestsPerMinute,
+		MonthlyComputeMillis: request.MonthlyComputeMillis,
+	})
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	quota, err := orm.GetQuota(ctx, subscriptionID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	used, err := orm.GetComputeUsage(ctx, subscriptionID, functions.StartOfMonth(time.Now()))
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	fqc.App.GetAuditLogger().Audit(audit.FunctionsQuotaUpdated, map[string]interface{}{
+		"contractAddress": c.Param("contractAddress"),
+		"subscriptionID":  subscriptionID,
+		"request":         request,
+	})
+	jsonAPIResponse(c, presenters.NewFunctionsQuotaResource(*quota, used), "functionsQuotas")
+}
+
+// Delete removes the quota of a subscription, so that it uses the defaults of the job.
+// Example:
+// "DELETE <application>/functions/quotas/:contractAddress/:subscriptionID"
+func (fqc *FunctionsQuotasController) Delete(c *gin.Context) {
+	orm, ok := fqc.quotaORM(c)
+	if !ok {
+		return
+	}
+	subscriptionID, ok := parseSubscriptionID(c)
+	if !ok {
+		return
+	}
+
+	if err := orm.DeleteQuota(c.Request.Context(), subscriptionID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("quota not found"))
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	fqc.App.GetAuditLogger().Audit(audit.FunctionsQuotaDeleted, map[string]interface{}{
+		"contractAddress": c.Param("contractAddress"),
+		"subscriptionID":  subscriptionID,
+	})
+	jsonAPIResponseWithStatus(c, nil, "functionsQuotas", http.StatusNoContent)
+}
+
+func (fqc *FunctionsQuotasController) quotaORM(c *gin.Context) (functions.QuotaORM, bool) {
+	contractAddress := c.Param("contractAddress")
+	if !common.IsHexAddress(contractAddress) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid contract address: %s", contractAddress))
+		return nil, false
+	}
+	return functions.NewQuotaORM(fqc.App.GetDB(), common.HexToAddress(contractAddress)), true
+}
+
+func parseSubscriptionID(c *gin.Context) (uint64, bool) {
+	subscriptionID, err := strconv.ParseUint(c.Param("subscriptionID"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid subscription ID"))
+		return 0, false
+	}
+	return subscriptionID, true
+}
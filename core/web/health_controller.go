@@ -7,6 +7,8 @@ import (
 	"net/http"
 	"slices"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"golang.org/x/exp/maps"
@@ -17,6 +19,12 @@ import (
 
 type HealthController struct {
 	App chainlink.Application
+
+	failures *failureTracker
+}
+
+func NewHealthController(app chainlink.Application) *HealthController {
+	return &HealthController{App: app, failures: newFailureTracker()}
 }
 
 const (
@@ -24,10 +32,34 @@ const (
 	HealthStatusFailing = "failing"
 )
 
+// Readiness states, for orchestrators to decide whether a rollout may proceed.
+const (
+	// ReadinessReady means that all services are started, and all checks are passing.
+	ReadinessReady = "ready"
+	// ReadinessDegraded means that all services are started, but some checks are failing.
+	ReadinessDegraded = "degraded"
+	// ReadinessNotReady means that some services are not started.
+	ReadinessNotReady = "not_ready"
+)
+
+func readinessState(ready, healthy bool) string {
+	switch {
+	case !ready:
+		return ReadinessNotReady
+	case !healthy:
+		return ReadinessDegraded
+	default:
+		return ReadinessReady
+	}
+}
+
 // NOTE: We only implement the k8s readiness check, *not* the liveness check. Liveness checks are only recommended in cases
 // where the app doesn't crash itself on panic, and if implemented incorrectly can cause cascading failures.
 // See the following for more information:
 // - https://srcco.de/posts/kubernetes-liveness-probes-are-dangerous.html
+//
+// With ?state, the readiness state is returned as JSON, i.e. one of ReadinessReady, ReadinessDegraded, or
+// ReadinessNotReady.
 func (hc *HealthController) Readyz(c *gin.Context) {
 	status := http.StatusOK
 
@@ -39,6 +71,13 @@ func (hc *HealthController) Readyz(c *gin.Context) {
 		status = http.StatusServiceUnavailable
 	}
 
+	if _, ok := c.GetQuery("state"); ok {
+		healthy, errs := checker.IsHealthy()
+		hc.failures.observe(errs)
+		c.JSON(status, gin.H{"state": readinessState(ready, healthy)})
+		return
+	}
+
 	c.Status(status)
 
 	if _, ok := c.GetQuery("full"); !ok {
@@ -68,6 +107,8 @@ func (hc *HealthController) Readyz(c *gin.Context) {
 	jsonAPIResponse(c, checks, "checks")
 }
 
+// Health reports all checks. With ?tree, they are returned as a JSON tree of dependencies, with the causes and
+// durations of failures, and the readiness state.
 func (hc *HealthController) Health(c *gin.Context) {
 	_, failing := c.GetQuery("failing")
 
@@ -81,6 +122,8 @@ func (hc *HealthController) Health(c *gin.Context) {
 		status = http.StatusMultiStatus
 	}
 
+	since, now := hc.failures.observe(errors)
+
 	c.Status(status)
 
 	checks := make([]presenters.Check, 0, len(errors))
@@ -103,6 +146,15 @@ func (hc *HealthController) Health(c *gin.Context) {
 		})
 	}
 
+	if _, tree := c.GetQuery("tree"); tree {
+		ready, _ := checker.IsReady()
+		c.JSON(status, presenters.HealthTree{
+			State:        readinessState(ready, healthy),
+			Dependencies: newHealthDependencies(checks, since, now),
+		})
+		return
+	}
+
 	switch c.NegotiateFormat(gin.MIMEJSON, gin.MIMEHTML, gin.MIMEPlain) {
 	case gin.MIMEJSON:
 		break // default
@@ -151,6 +203,94 @@ func writeTextTo(w io.Writer, checks []presenters.Check) error {
 	return nil
 }
 
+// failureTracker records when each check was first observed failing, so that the duration of failures can be
+// reported. Checks are observed when the health endpoints are requested.
+type failureTracker struct {
+	mu    sync.Mutex
+	since map[string]time.Time
+	now   func() time.Time
+}
+
+func newFailureTracker() *failureTracker {
+	return &failureTracker{since: make(map[string]time.Time), now: time.Now}
+}
+
+// observe updates the tracker with the latest checks, and returns when each failing check started failing.
+func (f *failureTracker) observe(errs map[string]error) (since map[string]time.Time, now time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	now = f.now()
+	for name, err := range errs {
+		if err == nil {
+			delete(f.since, name)
+		} else if _, ok := f.since[name]; !ok {
+			f.since[name] = now
+		}
+	}
+	for name := range f.since {
+		if _, ok := errs[name]; !ok {
+			delete(f.since, name) // no longer reported
+		}
+	}
+	return maps.Clone(f.since), now
+}
+
+type dependencyNode struct {
+	dep  presenters.HealthDependency
+	subs map[string]*dependencyNode
+}
+
+// newHealthDependencies returns the checks as a tree of dependencies, by splitting their dotted names.
+func newHealthDependencies(checks []presenters.Check, since map[string]time.Time, now time.Time) []presenters.HealthDependency {
+	root := make(map[string]*dependencyNode)
+	for _, ch := range checks {
+		parts := strings.Split(ch.Name, ".")
+		nodes := root
+		var node *dependencyNode
+		for i, short := range parts {
+			n, ok := nodes[short]
+			if !ok {
+				n = &dependencyNode{
+					dep: presenters.HealthDependency{
+						Name:   short,
+						ID:     strings.Join(parts[:i+1], "."),
+						Status: HealthStatusPassing,
+					},
+					subs: make(map[string]*dependencyNode),
+				}
+				nodes[short] = n
+			}
+			node, nodes = n, n.subs
+		}
+		node.dep.Status = ch.Status
+		node.dep.Cause = ch.Output
+		if t, ok := since[ch.Name]; ok && ch.Status == HealthStatusFailing {
+			node.dep.FailingSince = &t
+			node.dep.FailingFor = now.Sub(t).Round(time.Second).String()
+		}
+	}
+	return dependencyList(root)
+}
+
+func dependencyList(nodes map[string]*dependencyNode) []presenters.HealthDependency {
+	keys := maps.Keys(nodes)
+	slices.Sort(keys)
+	deps := make([]presenters.HealthDependency, 0, len(keys))
+	for _, short := range keys {
+		n := nodes[short]
+		if len(n.subs) > 0 {
+			n.dep.Dependencies = dependencyList(n.subs)
+			for _, sub := range n.dep.Dependencies {
+				if sub.Status == HealthStatusFailing {
+					n.dep.Status = HealthStatusFailing
+				}
+			}
+		}
+		deps = append(deps, n.dep)
+	}
+	return deps
+}
+
 type checkNode struct {
 	Name   string // full
 	Status string
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	_ "embed"
 	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
 	"strings"
@@ -16,6 +17,8 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/services/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
 func TestHealthController_Readyz(t *testing.T) {
@@ -90,6 +93,93 @@ func TestHealthController_Health_status(t *testing.T) {
 	}
 }
 
+func TestHealthController_Readyz_state(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		ready    bool
+		healthy  bool
+		status   int
+		expState string
+	}{
+		{"not ready", false, false, http.StatusServiceUnavailable, web.ReadinessNotReady},
+		{"degraded", true, false, http.StatusOK, web.ReadinessDegraded},
+		{"ready", true, true, http.StatusOK, web.ReadinessReady},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			app := cltest.NewApplicationWithKey(t)
+			healthChecker := new(mocks.Checker)
+			healthChecker.On("Start").Return(nil).Once()
+			healthChecker.On("IsReady").Return(tc.ready, nil).Once()
+			healthChecker.On("IsHealthy").Return(tc.healthy, nil).Once()
+			healthChecker.On("Close").Return(nil).Once()
+
+			app.HealthChecker = healthChecker
+			require.NoError(t, app.Start(testutils.Context(t)))
+
+			client := app.NewHTTPClient(nil)
+			resp, cleanup := client.Get("/readyz?state")
+			t.Cleanup(cleanup)
+			assert.Equal(t, tc.status, resp.StatusCode)
+			var body struct{ State string }
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+			assert.Equal(t, tc.expState, body.State)
+		})
+	}
+}
+
+func TestHealthController_Health_tree(t *testing.T) {
+	app := cltest.NewApplicationWithKey(t)
+	healthChecker := new(mocks.Checker)
+	healthChecker.On("Start").Return(nil).Once()
+	healthChecker.On("IsHealthy").Return(false, map[string]error{
+		"Database":               nil,
+		"EVM.1":                  nil,
+		"EVM.1.MultiNode":        errors.New("no live nodes available"),
+		"EVM.1.MultiNode.node-1": errors.New("node is Unreachable"),
+		"OCR2.7.Median":          nil,
+	}).Once()
+	healthChecker.On("IsReady").Return(true, nil).Once()
+	healthChecker.On("Close").Return(nil).Once()
+
+	app.HealthChecker = healthChecker
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(nil)
+	resp, cleanup := client.Get("/health?tree")
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusMultiStatus, resp.StatusCode)
+
+	var tree presenters.HealthTree
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&tree))
+	assert.Equal(t, web.ReadinessDegraded, tree.State)
+	require.Len(t, tree.Dependencies, 3)
+
+	db, evm, ocr := tree.Dependencies[0], tree.Dependencies[1], tree.Dependencies[2]
+	assert.Equal(t, "Database", db.ID)
+	assert.Equal(t, web.HealthStatusPassing, db.Status)
+	assert.Nil(t, db.FailingSince)
+	assert.Equal(t, "OCR2", ocr.ID)
+	assert.Equal(t, web.HealthStatusPassing, ocr.Status)
+
+	assert.Equal(t, "EVM", evm.ID)
+	assert.Equal(t, web.HealthStatusFailing, evm.Status)
+	require.Len(t, evm.Dependencies, 1)
+	chain := evm.Dependencies[0]
+	assert.Equal(t, "1", chain.Name)
+	assert.Equal(t, "EVM.1", chain.ID)
+	assert.Equal(t, web.HealthStatusFailing, chain.Status)
+	assert.Empty(t, chain.Cause)
+	require.Len(t, chain.Dependencies, 1)
+	pool := chain.Dependencies[0]
+	assert.Equal(t, "EVM.1.MultiNode", pool.ID)
+	assert.Equal(t, web.HealthStatusFailing, pool.Status)
+	assert.Equal(t, "no live nodes available", pool.Cause)
+	assert.NotNil(t, pool.FailingSince)
+	assert.NotEmpty(t, pool.FailingFor)
+	require.Len(t, pool.Dependencies, 1)
+	assert.Equal(t, "node is Unreachable", pool.Dependencies[0].Cause)
+}
+
 var (
 	//go:embed testdata/body/health.json
 	bodyJSON string
@@ -3,7 +3,9 @@ package web
 import (
 	"bytes"
 	_ "embed"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -51,3 +53,29 @@ func Test_writeTextTo(t *testing.T) {
 	got := b.String()
 	require.Equalf(t, healthTXT, got, "got: %s", got)
 }
+
+func TestFailureTracker(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := start
+	f := newFailureTracker()
+	f.now = func() time.Time { return now }
+
+	since, _ := f.observe(map[string]error{"foo": errors.New("failing"), "bar": nil})
+	require.Equal(t, map[string]time.Time{"foo": start}, since)
+
+	now = start.Add(time.Minute)
+	since, _ = f.observe(map[string]error{"foo": errors.New("still failing"), "bar": errors.New("failing")})
+	require.Equal(t, map[string]time.Time{"foo": start, "bar": now}, since)
+
+	deps := newHealthDependencies([]presenters.Check{
+		{Name: "foo", Status: HealthStatusFailing, Output: "still failing"},
+		{Name: "foo.baz", Status: HealthStatusPassing},
+	}, since, now.Add(30*time.Second))
+	require.Len(t, deps, 1)
+	require.Equal(t, "still failing", deps[0].Cause)
+	require.Equal(t, "1m30s", deps[0].FailingFor)
+	require.Equal(t, "foo.baz", deps[0].Dependencies[0].ID)
+
+	since, _ = f.observe(map[string]error{"foo": nil})
+	require.Empty(t, since)
+}
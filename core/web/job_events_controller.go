@@ -0,0 +1,122 @@
+package web
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+const (
+	jobEventsPingInterval = 30 * time.Second
+	jobEventsWriteTimeout = 10 * time.Second
+)
+
+// JobEventsController streams job events over a WebSocket connection.
+type JobEventsController struct {
+	App chainlink.Application
+}
+
+// Stream upgrades the connection to a WebSocket, and sends a presenters.JobEvent message each time a job run is
+// created or completed, or a job records an error. Only the events of the jobs given by jobID parameters are sent,
+// or all events if there are none. Events are dropped for the clients which do not keep up.
+// Example:
+// "GET <application>/jobs/events?jobID=1&jobID=2"
+func (jec *JobEventsController) Stream(c *gin.Context) {
+	var jobIDs []int32
+	for _, param := range c.QueryArray("jobID") {
+		for _, s := range strings.Split(param, ",") {
+			jobID, err := strconv.ParseInt(s, 10, 32)
+			if err != nil {
+				jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid jobID %q", s))
+				return
+			}
+			jobIDs = append(jobIDs, int32(jobID))
+		}
+	}
+
+	// subscribe before upgrading, so that the client does not miss the events following the handshake
+	runEvents, unsubscribeRuns := jec.App.PipelineORM().SubscribeRunEvents()
+	defer unsubscribeRuns()
+	specErrors, unsubscribeErrors := jec.App.JobORM().SubscribeSpecErrors()
+	defer unsubscribeErrors()
+
+	upgrader := websocket.Upgrader{CheckOrigin: jec.checkOrigin}
+	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		// the upgrader has already replied with an error
+		return
+	}
+	defer conn.Close()
+	lggr := jec.App.GetLogger().Named("JobEventsController")
+
+	// the client is not expected to send anything, but reading is required to process control messages and
+	// detect when the connection is closed
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	ping := time.NewTicker(jobEventsPingInterval)
+	defer ping.Stop()
+	for {
+		var event presenters.JobEvent
+		select {
+		case <-closed:
+			return
+		case <-ping.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(jobEventsWriteTimeout)); err != nil {
+				return
+			}
+			continue
+		case e, ok := <-runEvents:
+			if !ok {
+				return
+			}
+			event = presenters.NewRunJobEvent(e)
+		case e, ok := <-specErrors:
+			if !ok {
+				return
+			}
+			event = presenters.NewSpecErrorJobEvent(e)
+		}
+		if len(jobIDs) > 0 && !slices.Contains(jobIDs, event.JobID) {
+			continue
+		}
+
+		if err := conn.SetWriteDeadline(time.Now().Add(jobEventsWriteTimeout)); err != nil {
+			return
+		}
+		if err := conn.WriteJSON(event); err != nil {
+			lggr.Debugw("Failed to write job event", "err", err)
+			return
+		}
+	}
+}
+
+// checkOrigin allows the same origins as the CORS configuration of the web server, as well as requests without an
+// Origin header, which are not sent by browsers.
+func (jec *JobEventsController) checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	allowOrigins := jec.App.GetConfig().WebServer().AllowOrigins()
+	if allowOrigins == "*" {
+		return true
+	}
+	return slices.Contains(strings.Split(allowOrigins, ","), origin)
+}
@@ -0,0 +1,52 @@
+package web_test
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestJobEventsController_Stream(t *testing.T) {
+	ctx := testutils.Context(t)
+	app, _, _, jobID, _, jobID2 := setupJobSpecsControllerTestsWithJobs(t)
+
+	user, err := clsessions.NewUser("viewer@chainlink.test", cltest.Password, clsessions.UserRoleView)
+	require.NoError(t, err)
+	require.NoError(t, app.BasicAdminUsersORM().CreateUser(ctx, &user))
+	cookie := cltest.MustGenerateSessionCookie(t, app.MustSeedNewSession(user.Email))
+
+	url := fmt.Sprintf("ws%s/v2/jobs/events?jobID=%d", strings.TrimPrefix(app.Server.URL, "http"), jobID2)
+	conn, resp, err := websocket.DefaultDialer.DialContext(ctx, url, http.Header{"Cookie": {cookie.String()}})
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	t.Cleanup(func() { assert.NoError(t, conn.Close()) })
+
+	require.NoError(t, app.JobORM().RecordError(ctx, jobID, "filtered out"))
+	require.NoError(t, app.JobORM().RecordError(ctx, jobID2, "streamed"))
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(testutils.WaitTimeout(t))))
+	var event presenters.JobEvent
+	require.NoError(t, conn.ReadJSON(&event))
+	assert.Equal(t, presenters.JobEventTypeSpecError, event.Type)
+	assert.Equal(t, jobID2, event.JobID)
+	require.NotNil(t, event.Error)
+	assert.Equal(t, "streamed", event.Error.Description)
+	assert.Nil(t, event.Run)
+
+	// invalid filters are rejected before upgrading
+	_, resp, err = websocket.DefaultDialer.DialContext(ctx, url+",x", http.Header{"Cookie": {cookie.String()}})
+	require.ErrorIs(t, err, websocket.ErrBadHandshake)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+}
@@ -0,0 +1,188 @@
+package web
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+// JobLogCaptureController raises the log level of a single job for a bounded duration, and captures its logs apart
+// from the log output.
+type JobLogCaptureController struct {
+	App      chainlink.Application
+	Captures *logger.DebugCaptures
+}
+
+// JobLogCaptureRequest starts a log capture of a job.
+type JobLogCaptureRequest struct {
+	// Level defaults to debug.
+	Level    string `json:"level"`
+	Duration string `json:"duration"`
+}
+
+// Show returns the status of the log capture of a job.
+// Example:
+//
+//	"GET <application>/v2/jobs/:ID/log_capture"
+func (lcc *JobLogCaptureController) Show(c *gin.Context) {
+	jobID, ok := lcc.jobID(c)
+	if !ok {
+		return
+	}
+	status, ok := lcc.Captures.Status(jobID)
+	if !ok {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job logs are not captured"))
+		return
+	}
+	jsonAPIResponse(c, newJobLogCaptureResource(status), "log_capture")
+}
+
+// Create starts capturing the logs of a job at a level for a duration, replacing any previous capture of the job.
+// Example:
+//
+//	"POST <application>/v2/jobs/:ID/log_capture"
+func (lcc *JobLogCaptureController) Create(c *gin.Context) {
+	jobID, ok := lcc.jobID(c)
+	if !ok {
+		return
+	}
+	request := JobLogCaptureRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	lvl := zapcore.DebugLevel
+	if request.Level != "" {
+		if err := lvl.UnmarshalText([]byte(request.Level)); err != nil {
+			jsonAPIError(c, http.StatusBadRequest, err)
+			return
+		}
+	}
+	duration, err := time.ParseDuration(request.Duration)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, fmt.Errorf("invalid duration: %w", err))
+		return
+	}
+	status, err := lcc.Captures.Start(jobID, lvl, duration)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	lcc.App.GetAuditLogger().Audit(audit.JobLogCaptureStarted, map[string]interface{}{"jobID": jobID, "logLevel": lvl.String(), "duration": duration.String()})
+	jsonAPIResponseWithStatus(c, newJobLogCaptureResource(status), "log_capture", http.StatusCreated)
+}
+
+// Download returns the captured logs of a job, oldest first, one JSON object per line.
+// Example:
+//
+//	"GET <application>/v2/jobs/:ID/log_capture/download"
+func (lcc *JobLogCaptureController) Download(c *gin.Context) {
+	jobID, ok := lcc.jobID(c)
+	if !ok {
+		return
+	}
+	logs, ok := lcc.Captures.Logs(jobID)
+	if !ok {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job logs are not captured"))
+		return
+	}
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=job-%d.log", jobID))
+	c.Data(http.StatusOK, "application/x-ndjson", logs)
+}
+
+// Delete stops the log capture of a job and drops its logs.
+// Example:
+//
+//	"DELETE <application>/v2/jobs/:ID/log_capture"
+func (lcc *JobLogCaptureController) Delete(c *gin.Context) {
+	jobID, ok := lcc.jobID(c)
+	if !ok {
+		return
+	}
+	if !lcc.Captures.Stop(jobID) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job logs are not captured"))
+		return
+	}
+
+	lcc.App.GetAuditLogger().Audit(audit.JobLogCaptureStopped, map[string]interface{}{"jobID": jobID})
+	jsonAPIResponseWithStatus(c, nil, "log_capture", http.StatusNoContent)
+}
+
+// jobID returns the ID of the job of the request, which is either its ID or its external job ID.
+func (lcc *JobLogCaptureController) jobID(c *gin.Context) (int32, bool) {
+	ctx := c.Request.Context()
+	var (
+		jb  job.Job
+		err error
+	)
+	if externalJobID, pErr := uuid.Parse(c.Param("ID")); pErr == nil {
+		jb, err = lcc.App.JobORM().FindJobByExternalJobID(ctx, externalJobID)
+	} else if pErr = jb.SetID(c.Param("ID")); pErr == nil {
+		jb, err = lcc.App.JobORM().FindJob(ctx, jb.ID)
+	} else {
+		jsonAPIError(c, http.StatusUnprocessableEntity, pErr)
+		return 0, false
+	}
+	if err != nil {
+		if errors.Is(errors.Cause(err), sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		} else {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+		}
+		return 0, false
+	}
+	return jb.ID, true
+}
+
+type JobLogCaptureResource struct {
+	JobID     int32     `json:"jobID"`
+	Level     string    `json:"level"`
+	StartedAt time.Time `json:"startedAt"`
+	Until     time.Time `json:"until"`
+	Active    bool      `json:"active"`
+	Lines     int       `json:"lines"`
+	Dropped   uint64    `json:"dropped"`
+}
+
+func newJobLogCaptureResource(status logger.DebugCaptureStatus) *JobLogCaptureResource {
+	return &JobLogCaptureResource{
+		JobID:     status.JobID,
+		Level:     status.Level.String(),
+		StartedAt: status.StartedAt,
+		Until:     status.Until,
+		Active:    status.Active(time.Now()),
+		Lines:     status.Lines,
+		Dropped:   status.Dropped,
+	}
+}
+
+// GetID returns the jsonapi ID.
+func (r JobLogCaptureResource) GetID() string {
+	return strconv.Itoa(int(r.JobID))
+}
+
+// GetName returns the collection name for jsonapi.
+func (JobLogCaptureResource) GetName() string {
+	return "log_captures"
+}
+
+// SetID is used to conform to the UnmarshallIdentifier interface for
+// deserializing from jsonapi documents.
+func (r *JobLogCaptureResource) SetID(value string) error {
+	id, err := strconv.ParseInt(value, 10, 32)
+	r.JobID = int32(id)
+	return err
+}
@@ -0,0 +1,60 @@
+package web_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+)
+
+func TestJobLogCaptureController(t *testing.T) {
+	_, client, _, jobID, _, _ := setupJobSpecsControllerTestsWithJobs(t)
+	path := fmt.Sprintf("/v2/jobs/%d/log_capture", jobID)
+
+	resp, cleanup := client.Get(path)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+
+	resp, cleanup = client.Post(path, bytes.NewBufferString(`{"level":"debug","duration":"48h"}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+
+	resp, cleanup = client.Post(path, bytes.NewBufferString(`{"duration":"10m"}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusCreated)
+	var capture web.JobLogCaptureResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &capture))
+	assert.Equal(t, jobID, capture.JobID)
+	assert.Equal(t, "debug", capture.Level)
+	assert.True(t, capture.Active)
+
+	resp, cleanup = client.Get(path)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	resp, cleanup = client.Get(path + "/download")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	assert.Equal(t, "application/x-ndjson", resp.Header.Get("Content-Type"))
+	_, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	resp, cleanup = client.Delete(path)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+
+	resp, cleanup = client.Get(path + "/download")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+
+	resp, cleanup = client.Get("/v2/jobs/999999/log_capture")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
@@ -5,12 +5,14 @@ import (
 	"database/sql"
 	"encoding/json"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
 
 	ccip "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/validate"
 	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
@@ -107,38 +109,86 @@ func (jc *JobsController) Create(c *gin.Context) {
 		return
 	}
 
-	jb, status, err := jc.validateJobSpec(c.Request.Context(), request.TOML)
+	jb, status, err := jc.createJob(c.Request.Context(), request.TOML, false)
 	if err != nil {
 		jsonAPIError(c, status, err)
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
-	defer cancel()
-	err = jc.App.AddJobV2(ctx, &jb)
-	if err != nil {
-		if errors.Is(errors.Cause(err), job.ErrNoSuchKeyBundle) || errors.As(err, &keystore.KeyNotFoundError{}) || errors.Is(errors.Cause(err), job.ErrNoSuchTransmitterKey) || errors.Is(errors.Cause(err), job.ErrNoSuchSendingKey) {
-			jsonAPIError(c, http.StatusBadRequest, err)
-			return
-		}
-		jsonAPIError(c, http.StatusInternalServerError, err)
+	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
+}
+
+// Validate validates a job spec without creating the job, and responds with the job it would create.
+// Example:
+// "POST <application>/jobs/validate"
+func (jc *JobsController) Validate(c *gin.Context) {
+	request := CreateJobRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
 		return
 	}
 
-	jbj, err := json.Marshal(jb)
-	if err == nil {
-		jc.App.GetAuditLogger().Audit(audit.JobCreated, map[string]interface{}{"job": string(jbj)})
-	} else {
-		jc.App.GetLogger().Errorw("Could not send audit log for JobCreation", "err", err)
+	jb, status, err := jc.createJob(c.Request.Context(), request.TOML, true)
+	if err != nil {
+		jsonAPIError(c, status, err)
+		return
 	}
 
 	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
 }
 
+// Schemas lists the schemas of the specs of the job types which can be created.
+// Example:
+// "GET <application>/jobs/schemas"
+func (jc *JobsController) Schemas(c *gin.Context) {
+	schemas := job.SpecSchemas()
+	resources := make([]presenters.JobSpecSchemaResource, 0, len(schemas))
+	for _, schema := range schemas {
+		resources = append(resources, newJobSpecSchemaResource(schema))
+	}
+
+	jsonAPIResponse(c, resources, "jobSpecSchemas")
+}
+
+// Schema returns the schema of the specs of a job type. The schema of the offchainreporting2 specs includes the fields
+// of the pluginConfig of each plugin type, like ccip-commit and ccip-execution.
+// Example:
+// "GET <application>/jobs/schemas/:type"
+func (jc *JobsController) Schema(c *gin.Context) {
+	schema, ok := job.SchemaOf(job.Type(c.Param("type")))
+	if !ok {
+		jsonAPIError(c, http.StatusNotFound, errors.Errorf("unknown job type: %s", c.Param("type")))
+		return
+	}
+
+	jsonAPIResponse(c, newJobSpecSchemaResource(schema), "jobSpecSchemas")
+}
+
+func newJobSpecSchemaResource(schema job.SpecSchema) presenters.JobSpecSchemaResource {
+	if schema.Type == job.OffchainReporting2 {
+		return presenters.NewJobSpecSchemaResource(validate.OCR2SpecSchema())
+	}
+	return presenters.NewJobSpecSchemaResource(schema, nil)
+}
+
 // Delete hard deletes a job spec.
 // Example:
 // "DELETE <application>/specs/:ID"
 func (jc *JobsController) Delete(c *gin.Context) {
+	_, status, err := jc.deleteJob(c.Request.Context(), c.Param("ID"), false)
+	if err != nil {
+		jsonAPIError(c, status, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "job", http.StatusNoContent)
+}
+
+// Promote promotes an OCR2 job held in standby to active.
+// Example:
+// "POST <application>/jobs/:ID/promote"
+func (jc *JobsController) Promote(c *gin.Context) {
+	ctx := c.Request.Context()
 	j := job.Job{}
 	err := j.SetID(c.Param("ID"))
 	if err != nil {
@@ -146,10 +196,9 @@ func (jc *JobsController) Delete(c *gin.Context) {
 		return
 	}
 
-	// Delete the job
-	err = jc.App.DeleteJob(c.Request.Context(), j.ID)
-	if errors.Is(err, sql.ErrNoRows) {
-		jsonAPIError(c, http.StatusNotFound, errors.New("JobSpec not found"))
+	err = jc.App.PromoteJob(ctx, j.ID)
+	if errors.Is(errors.Cause(err), sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
 		return
 	}
 	if err != nil {
@@ -157,8 +206,14 @@ func (jc *JobsController) Delete(c *gin.Context) {
 		return
 	}
 
-	jc.App.GetAuditLogger().Audit(audit.JobDeleted, map[string]interface{}{"id": j.ID})
-	jsonAPIResponseWithStatus(c, nil, "job", http.StatusNoContent)
+	jb, err := jc.App.JobORM().FindJobTx(ctx, j.ID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jc.App.GetAuditLogger().Audit(audit.JobPromoted, map[string]interface{}{"id": j.ID})
+	jsonAPIResponse(c, presenters.NewJobResource(jb), "jobs")
 }
 
 // UpdateJobRequest represents a request to update a job with new toml and start a job (V2).
@@ -176,19 +231,250 @@ func (jc *JobsController) Update(c *gin.Context) {
 		return
 	}
 
-	jb, status, err := jc.validateJobSpec(c.Request.Context(), request.TOML)
+	jb, status, err := jc.updateJob(c.Request.Context(), c.Param("ID"), request.TOML, false)
+	if err != nil {
+		jsonAPIError(c, status, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
+}
+
+// Versions lists the versions of a job spec, oldest first. Versions are
+// recorded whenever the job is created or updated through the API.
+// Example:
+// "GET <application>/jobs/:ID/versions"
+func (jc *JobsController) Versions(c *gin.Context) {
+	j := job.Job{}
+	if err := j.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	versions, err := jc.App.JobORM().FindSpecVersions(c.Request.Context(), j.ID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobSpecVersionResources(versions), "jobSpecVersions")
+}
+
+// Diff responds with the unified diff between a version of a job spec and
+// the version given by the "to" query parameter, which defaults to the
+// latest one.
+// Example:
+// "GET <application>/jobs/:ID/versions/:version/diff?to=:toVersion"
+func (jc *JobsController) Diff(c *gin.Context) {
+	ctx := c.Request.Context()
+	j := job.Job{}
+	if err := j.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	from, status, err := jc.findSpecVersion(ctx, j.ID, c.Param("version"))
+	if err != nil {
+		jsonAPIError(c, status, err)
+		return
+	}
+
+	var to job.SpecVersion
+	if toVersion := c.Query("to"); toVersion != "" {
+		to, status, err = jc.findSpecVersion(ctx, j.ID, toVersion)
+		if err != nil {
+			jsonAPIError(c, status, err)
+			return
+		}
+	} else {
+		versions, err := jc.App.JobORM().FindSpecVersions(ctx, j.ID)
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		// from exists, so there is at least one version
+		to = versions[len(versions)-1]
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(from.TOML),
+		B:        difflib.SplitLines(to.TOML),
+		FromFile: "version " + strconv.Itoa(int(from.Version)),
+		ToFile:   "version " + strconv.Itoa(int(to.Version)),
+		Context:  3,
+	})
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.JobSpecDiffResource{
+		JAID: presenters.NewJAIDInt32(j.ID),
+		From: from.Version,
+		To:   to.Version,
+		Diff: diff,
+	}, "jobSpecDiffs")
+}
+
+// Rollback updates a job with a prior version of its spec, which is validated
+// again and recorded as the latest version.
+// Example:
+// "POST <application>/jobs/:ID/versions/:version/rollback"
+func (jc *JobsController) Rollback(c *gin.Context) {
+	ctx := c.Request.Context()
+	j := job.Job{}
+	if err := j.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	v, status, err := jc.findSpecVersion(ctx, j.ID, c.Param("version"))
 	if err != nil {
 		jsonAPIError(c, status, err)
 		return
 	}
 
-	err = jb.SetID(c.Param("ID"))
+	jb, status, err := jc.updateJob(ctx, c.Param("ID"), v.TOML, false)
 	if err != nil {
+		jsonAPIError(c, status, err)
+		return
+	}
+
+	jc.App.GetAuditLogger().Audit(audit.JobRolledBack, map[string]interface{}{"id": jb.ID, "version": v.Version})
+	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
+}
+
+// BulkJobAction is the action of an operation of a bulk job request.
+type BulkJobAction string
+
+const (
+	BulkJobActionCreate BulkJobAction = "create"
+	BulkJobActionUpdate BulkJobAction = "update"
+	BulkJobActionPause  BulkJobAction = "pause"
+	BulkJobActionResume BulkJobAction = "resume"
+	BulkJobActionDelete BulkJobAction = "delete"
+)
+
+// BulkJobOperation represents an operation on a single job of a bulk job
+// request. ID is required by all actions but create, and TOML by create and
+// update.
+type BulkJobOperation struct {
+	Action BulkJobAction `json:"action"`
+	ID     string        `json:"id"`
+	TOML   string        `json:"toml"`
+}
+
+// BulkJobRequest represents a request to create, update, pause, resume and
+// delete jobs in bulk. With DryRun set, the operations are only validated.
+type BulkJobRequest struct {
+	DryRun     bool               `json:"dryRun"`
+	Operations []BulkJobOperation `json:"operations"`
+}
+
+// Bulk applies the operations of a bulk job request one after the other, in
+// order, and responds with the result of each operation. An operation failing
+// does not prevent the next ones from being applied.
+// Example:
+// "POST <application>/jobs/bulk"
+func (jc *JobsController) Bulk(c *gin.Context) {
+	request := BulkJobRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
 		jsonAPIError(c, http.StatusUnprocessableEntity, err)
 		return
 	}
+	if len(request.Operations) == 0 {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("no operations"))
+		return
+	}
+
+	ctx := c.Request.Context()
+	results := make([]presenters.BulkJobResultResource, len(request.Operations))
+	for i, op := range request.Operations {
+		var (
+			jb     job.Job
+			status int
+			err    error
+		)
+		switch op.Action {
+		case BulkJobActionCreate:
+			jb, status, err = jc.createJob(ctx, op.TOML, request.DryRun)
+		case BulkJobActionUpdate:
+			jb, status, err = jc.updateJob(ctx, op.ID, op.TOML, request.DryRun)
+		case BulkJobActionPause:
+			jb, status, err = jc.pauseJob(ctx, op.ID, true, request.DryRun)
+		case BulkJobActionResume:
+			jb, status, err = jc.pauseJob(ctx, op.ID, false, request.DryRun)
+		case BulkJobActionDelete:
+			jb.ID, status, err = jc.deleteJob(ctx, op.ID, request.DryRun)
+		default:
+			status, err = http.StatusUnprocessableEntity, errors.Errorf("unknown action %q", op.Action)
+		}
+
+		result := presenters.BulkJobResultResource{
+			JAID:   presenters.NewJAIDInt32(int32(i)),
+			Action: string(op.Action),
+			Status: status,
+		}
+		if jb.ID != 0 {
+			result.JobID = strconv.Itoa(int(jb.ID))
+		}
+		if err != nil {
+			result.Error = err.Error()
+		} else if op.Action != BulkJobActionDelete {
+			result.Job = presenters.NewJobResource(jb)
+		}
+		results[i] = result
+	}
+
+	jsonAPIResponse(c, results, "bulkJobResults")
+}
+
+// createJob validates a new job and, unless dryRun is set, saves and starts it.
+func (jc *JobsController) createJob(ctx context.Context, tomlString string, dryRun bool) (jb job.Job, statusCode int, err error) {
+	jb, statusCode, err = jc.validateJobSpec(ctx, tomlString)
+	if err != nil {
+		return jb, statusCode, err
+	}
+	if dryRun {
+		return jb, http.StatusOK, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	err = jc.App.AddJobV2(ctx, &jb)
+	if err != nil {
+		return jb, addJobErrorStatus(err), err
+	}
+	jc.recordSpecVersion(ctx, jb.ID, tomlString)
+
+	jbj, err := json.Marshal(jb)
+	if err == nil {
+		jc.App.GetAuditLogger().Audit(audit.JobCreated, map[string]interface{}{"job": string(jbj)})
+	} else {
+		jc.App.GetLogger().Errorw("Could not send audit log for JobCreation", "err", err)
+	}
+
+	return jb, http.StatusOK, nil
+}
+
+// updateJob validates a new TOML for an existing job and, unless dryRun is set, stops and deletes the existing job,
+// saves and starts a new job.
+func (jc *JobsController) updateJob(ctx context.Context, id string, tomlString string, dryRun bool) (jb job.Job, statusCode int, err error) {
+	jb, statusCode, err = jc.validateJobSpec(ctx, tomlString)
+	if err != nil {
+		return jb, statusCode, err
+	}
+
+	err = jb.SetID(id)
+	if err != nil {
+		return jb, http.StatusUnprocessableEntity, err
+	}
+	if dryRun {
+		_, statusCode, err = jc.findJob(ctx, jb.ID)
+		return jb, statusCode, err
+	}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
 	// If the provided job id is not matching any job, delete will fail with 404 leaving state unchanged.
@@ -196,24 +482,116 @@ func (jc *JobsController) Update(c *gin.Context) {
 	// Error can be either come from ORM or from the activeJobs map.
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) || strings.Contains(err.Error(), "job not found") {
-			jsonAPIError(c, http.StatusNotFound, errors.Wrap(err, "failed to update job"))
-			return
+			return jb, http.StatusNotFound, errors.Wrap(err, "failed to update job")
 		}
-		jsonAPIError(c, http.StatusInternalServerError, err)
-		return
+		return jb, http.StatusInternalServerError, err
 	}
 
 	err = jc.App.AddJobV2(ctx, &jb)
 	if err != nil {
-		if errors.Is(errors.Cause(err), job.ErrNoSuchKeyBundle) || errors.As(err, &keystore.KeyNotFoundError{}) || errors.Is(errors.Cause(err), job.ErrNoSuchTransmitterKey) || errors.Is(errors.Cause(err), job.ErrNoSuchSendingKey) {
-			jsonAPIError(c, http.StatusBadRequest, err)
-			return
+		return jb, addJobErrorStatus(err), err
+	}
+	jc.recordSpecVersion(ctx, jb.ID, tomlString)
+
+	return jb, http.StatusOK, nil
+}
+
+// recordSpecVersion records the TOML a job was created or updated with, so that the job can be rolled back to it. The
+// job is already running at this point, so failing to record the version is only logged.
+func (jc *JobsController) recordSpecVersion(ctx context.Context, jobID int32, tomlString string) {
+	if _, err := jc.App.JobORM().CreateSpecVersion(ctx, jobID, tomlString); err != nil {
+		jc.App.GetLogger().Errorw("Failed to record job spec version", "jobID", jobID, "err", err)
+	}
+}
+
+// pauseJob pauses or resumes an existing job, unless dryRun is set.
+func (jc *JobsController) pauseJob(ctx context.Context, id string, paused bool, dryRun bool) (job.Job, int, error) {
+	j := job.Job{}
+	err := j.SetID(id)
+	if err != nil {
+		return j, http.StatusUnprocessableEntity, err
+	}
+
+	if !dryRun {
+		event := audit.JobPaused
+		if paused {
+			err = jc.App.PauseJob(ctx, j.ID)
+		} else {
+			event = audit.JobResumed
+			err = jc.App.ResumeJob(ctx, j.ID)
 		}
-		jsonAPIError(c, http.StatusInternalServerError, err)
-		return
+		if errors.Is(errors.Cause(err), sql.ErrNoRows) {
+			return j, http.StatusNotFound, errors.New("job not found")
+		}
+		if err != nil {
+			return j, http.StatusInternalServerError, err
+		}
+		jc.App.GetAuditLogger().Audit(event, map[string]interface{}{"id": j.ID})
 	}
 
-	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
+	return jc.findJob(ctx, j.ID)
+}
+
+// deleteJob hard deletes an existing job, unless dryRun is set.
+func (jc *JobsController) deleteJob(ctx context.Context, id string, dryRun bool) (int32, int, error) {
+	j := job.Job{}
+	err := j.SetID(id)
+	if err != nil {
+		return 0, http.StatusUnprocessableEntity, err
+	}
+	if dryRun {
+		// report the status of the delete, not of the lookup
+		if _, statusCode, err := jc.findJob(ctx, j.ID); err != nil {
+			return j.ID, statusCode, err
+		}
+		return j.ID, http.StatusNoContent, nil
+	}
+
+	// Delete the job
+	err = jc.App.DeleteJob(ctx, j.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return j.ID, http.StatusNotFound, errors.New("JobSpec not found")
+	}
+	if err != nil {
+		return j.ID, http.StatusInternalServerError, err
+	}
+
+	jc.App.GetAuditLogger().Audit(audit.JobDeleted, map[string]interface{}{"id": j.ID})
+	return j.ID, http.StatusNoContent, nil
+}
+
+func (jc *JobsController) findJob(ctx context.Context, id int32) (job.Job, int, error) {
+	jb, err := jc.App.JobORM().FindJob(ctx, id)
+	if err != nil {
+		if errors.Is(errors.Cause(err), sql.ErrNoRows) {
+			return jb, http.StatusNotFound, errors.New("job not found")
+		}
+		return jb, http.StatusInternalServerError, err
+	}
+	return jb, http.StatusOK, nil
+}
+
+func (jc *JobsController) findSpecVersion(ctx context.Context, jobID int32, version string) (job.SpecVersion, int, error) {
+	n, err := strconv.ParseInt(version, 10, 32)
+	if err != nil {
+		return job.SpecVersion{}, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid version")
+	}
+	v, err := jc.App.JobORM().FindSpecVersion(ctx, jobID, int32(n))
+	if err != nil {
+		if errors.Is(errors.Cause(err), sql.ErrNoRows) {
+			return v, http.StatusNotFound, errors.New("job spec version not found")
+		}
+		return v, http.StatusInternalServerError, err
+	}
+	return v, http.StatusOK, nil
+}
+
+// addJobErrorStatus returns the status code of an error adding a job.
+func addJobErrorStatus(err error) int {
+	if errors.Is(errors.Cause(err), job.ErrNoSuchKeyBundle) || errors.As(err, &keystore.KeyNotFoundError{}) || errors.Is(errors.Cause(err), job.ErrNoSuchTransmitterKey) || errors.Is(errors.Cause(err), job.ErrNoSuchSendingKey) {
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
 }
 
 func (jc *JobsController) validateJobSpec(ctx context.Context, tomlString string) (jb job.Job, statusCode int, err error) {
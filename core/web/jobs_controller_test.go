@@ -2,6 +2,7 @@ package web_test
 
 import (
 	"bytes"
+	"database/sql"
 	_ "embed"
 	"encoding/hex"
 	"encoding/json"
@@ -742,6 +743,149 @@ func TestJobsController_Update_NonExistentID(t *testing.T) {
 	cltest.AssertServerResponse(t, response, http.StatusNotFound)
 }
 
+func TestJobsController_Bulk(t *testing.T) {
+	ctx := testutils.Context(t)
+	app, client, _, jobID, _, ereJobID := setupJobSpecsControllerTestsWithJobs(t)
+
+	bulk := func(t *testing.T, request web.BulkJobRequest) []presenters.BulkJobResultResource {
+		body, err := json.Marshal(request)
+		require.NoError(t, err)
+		response, cleanup := client.Post("/v2/jobs/bulk", bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		var results []presenters.BulkJobResultResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &results))
+		require.Len(t, results, len(request.Operations))
+		return results
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		results := bulk(t, web.BulkJobRequest{
+			DryRun: true,
+			Operations: []web.BulkJobOperation{
+				{Action: web.BulkJobActionPause, ID: fmt.Sprintf("%v", jobID)},
+				{Action: web.BulkJobActionDelete, ID: fmt.Sprintf("%v", ereJobID)},
+				{Action: web.BulkJobActionDelete, ID: "99999"},
+				{Action: web.BulkJobActionCreate, TOML: "invalid"},
+			},
+		})
+		assert.Equal(t, http.StatusOK, results[0].Status)
+		assert.False(t, results[0].Job.Paused)
+		assert.Equal(t, http.StatusNoContent, results[1].Status)
+		assert.Equal(t, http.StatusNotFound, results[2].Status)
+		assert.Equal(t, http.StatusUnprocessableEntity, results[3].Status)
+		assert.NotEmpty(t, results[3].Error)
+
+		_, err := app.JobORM().FindJob(ctx, ereJobID)
+		require.NoError(t, err)
+		assert.Contains(t, app.JobSpawner().ActiveJobs(), jobID)
+	})
+
+	t.Run("pause, resume and delete", func(t *testing.T) {
+		results := bulk(t, web.BulkJobRequest{
+			Operations: []web.BulkJobOperation{
+				{Action: web.BulkJobActionPause, ID: fmt.Sprintf("%v", jobID)},
+				{Action: web.BulkJobActionDelete, ID: fmt.Sprintf("%v", ereJobID)},
+				{Action: "restart", ID: fmt.Sprintf("%v", jobID)},
+			},
+		})
+		assert.Equal(t, http.StatusOK, results[0].Status)
+		assert.True(t, results[0].Job.Paused)
+		assert.Equal(t, http.StatusNoContent, results[1].Status)
+		assert.Equal(t, http.StatusUnprocessableEntity, results[2].Status)
+
+		assert.NotContains(t, app.JobSpawner().ActiveJobs(), jobID)
+		_, err := app.JobORM().FindJob(ctx, ereJobID)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		results = bulk(t, web.BulkJobRequest{
+			Operations: []web.BulkJobOperation{
+				{Action: web.BulkJobActionResume, ID: fmt.Sprintf("%v", jobID)},
+			},
+		})
+		assert.Equal(t, http.StatusOK, results[0].Status)
+		assert.False(t, results[0].Job.Paused)
+		assert.Contains(t, app.JobSpawner().ActiveJobs(), jobID)
+	})
+}
+
+func TestJobsController_Versions(t *testing.T) {
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+
+	_, fetchBridge := cltest.MustCreateBridge(t, app.GetDB(), cltest.BridgeOpts{})
+	_, submitBridge := cltest.MustCreateBridge(t, app.GetDB(), cltest.BridgeOpts{})
+
+	client := app.NewHTTPClient(nil)
+
+	externalJobID := uuid.New()
+	v1 := testspecs.GetWebhookSpecNoBody(externalJobID, fetchBridge.Name.String(), submitBridge.Name.String())
+	v2 := testspecs.GetWebhookSpecNoBody(externalJobID, submitBridge.Name.String(), fetchBridge.Name.String())
+
+	body, _ := json.Marshal(web.CreateJobRequest{TOML: v1})
+	response, cleanup := client.Post("/v2/jobs", bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	resource := presenters.JobResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+	jobPath := "/v2/jobs/" + resource.ID
+
+	body, _ = json.Marshal(web.UpdateJobRequest{TOML: v2})
+	response, cleanup = client.Put(jobPath, bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	versions := func(t *testing.T) []presenters.JobSpecVersionResource {
+		response, cleanup := client.Get(jobPath + "/versions")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+		var resources []presenters.JobSpecVersionResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resources))
+		return resources
+	}
+
+	t.Run("versions", func(t *testing.T) {
+		resources := versions(t)
+		require.Len(t, resources, 2)
+		assert.Equal(t, "1", resources[0].ID)
+		assert.Equal(t, v1, resources[0].TOML)
+		assert.Equal(t, "2", resources[1].ID)
+		assert.Equal(t, v2, resources[1].TOML)
+	})
+
+	t.Run("diff", func(t *testing.T) {
+		response, cleanup := client.Get(jobPath + "/versions/1/diff")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+		var diff presenters.JobSpecDiffResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &diff))
+		assert.Equal(t, int32(1), diff.From)
+		assert.Equal(t, int32(2), diff.To)
+		assert.Contains(t, diff.Diff, "--- version 1")
+		assert.Contains(t, diff.Diff, "+++ version 2")
+
+		response, cleanup = client.Get(jobPath + "/versions/3/diff")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusNotFound)
+	})
+
+	t.Run("rollback", func(t *testing.T) {
+		response, cleanup := client.Post(jobPath+"/versions/1/rollback", nil)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		resources := versions(t)
+		require.Len(t, resources, 3)
+		assert.Equal(t, v1, resources[2].TOML)
+
+		response, cleanup = client.Post(jobPath+"/versions/99/rollback", nil)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusNotFound)
+	})
+}
+
 func runOCRJobSpecAssertions(t *testing.T, ocrJobSpecFromFileDB job.Job, ocrJobSpecFromServer presenters.JobResource) {
 	ocrJobSpecFromFile := ocrJobSpecFromFileDB.OCROracleSpec
 	assert.Equal(t, ocrJobSpecFromFile.ContractAddress, ocrJobSpecFromServer.OffChainReportingSpec.ContractAddress)
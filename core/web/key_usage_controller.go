@@ -0,0 +1,25 @@
+package web
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// KeyUsageController exports the key usage audit log of the keystore.
+type KeyUsageController struct {
+	App chainlink.Application
+}
+
+// Index returns the paginated key usage audit log, most recent first
+// Example:
+// "GET <application>/keys/audit_log"
+func (kuc *KeyUsageController) Index(c *gin.Context, size, page, offset int) {
+	usages, count, err := kuc.App.GetKeyStore().KeyUsages(c.Request.Context(), offset, size)
+	resources := make([]presenters.KeyUsageResource, len(usages))
+	for i, usage := range usages {
+		resources[i] = *presenters.NewKeyUsageResource(usage)
+	}
+	paginatedResponse(c, "keyUsages", size, page, resources, count, err)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 )
@@ -50,6 +51,40 @@ func (bdc *LCAController) FindLCA(c *gin.Context) {
 	jsonAPIResponse(c, &response, "response")
 }
 
+// RecoverFinality rewrites LogPoller state after a finality violation was detected and returns the logs which changed
+// Example:
+//
+//	"<application>/v2/recover_finality"
+func (bdc *LCAController) RecoverFinality(c *gin.Context) {
+	chain, err := getChain(bdc.App.GetRelayers().LegacyEVMChains(), c.Query("evmChainID"))
+	if err != nil {
+		if errors.Is(err, ErrInvalidChainID) || errors.Is(err, ErrMultipleChains) || errors.Is(err, ErrMissingChainID) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	chainID := chain.ID()
+
+	report, err := bdc.App.RecoverLogPollerFinalityViolation(c.Request.Context(), chainID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	response := FinalityRecoveryResponse{
+		LCABlockNumber: report.LCA.BlockNumber,
+		LCAHash:        report.LCA.BlockHash.String(),
+		FromBlock:      report.FromBlock,
+		ToBlock:        report.ToBlock,
+		RemovedLogs:    newChangedLogs(report.RemovedLogs),
+		AddedLogs:      newChangedLogs(report.AddedLogs),
+		EVMChainID:     big.New(chainID),
+	}
+	jsonAPIResponse(c, &response, "response")
+}
+
 type LCAResponse struct {
 	BlockNumber int64    `json:"blockNumber"`
 	Hash        string   `json:"hash"`
@@ -71,3 +106,54 @@ func (LCAResponse) GetName() string {
 func (*LCAResponse) SetID(string) error {
 	return nil
 }
+
+// ChangedLog identifies a log which was removed or added during finality violation recovery.
+type ChangedLog struct {
+	BlockNumber int64  `json:"blockNumber"`
+	BlockHash   string `json:"blockHash"`
+	LogIndex    int64  `json:"logIndex"`
+	TxHash      string `json:"txHash"`
+	Address     string `json:"address"`
+	EventSig    string `json:"eventSig"`
+}
+
+func newChangedLogs(logs []logpoller.Log) []ChangedLog {
+	changed := make([]ChangedLog, len(logs))
+	for i, l := range logs {
+		changed[i] = ChangedLog{
+			BlockNumber: l.BlockNumber,
+			BlockHash:   l.BlockHash.String(),
+			LogIndex:    l.LogIndex,
+			TxHash:      l.TxHash.String(),
+			Address:     l.Address.String(),
+			EventSig:    l.EventSig.String(),
+		}
+	}
+	return changed
+}
+
+type FinalityRecoveryResponse struct {
+	LCABlockNumber int64        `json:"lcaBlockNumber"`
+	LCAHash        string       `json:"lcaHash"`
+	FromBlock      int64        `json:"fromBlock"`
+	ToBlock        int64        `json:"toBlock"`
+	RemovedLogs    []ChangedLog `json:"removedLogs"`
+	AddedLogs      []ChangedLog `json:"addedLogs"`
+	EVMChainID     *big.Big     `json:"evmChainID"`
+}
+
+// GetID returns the jsonapi ID.
+func (s FinalityRecoveryResponse) GetID() string {
+	return "FinalityRecoveryResponseID"
+}
+
+// GetName returns the collection name for jsonapi.
+func (FinalityRecoveryResponse) GetName() string {
+	return "finality_recovery_response"
+}
+
+// SetID is used to conform to the UnmarshallIdentifier interface for
+// deserializing from jsonapi documents.
+func (*FinalityRecoveryResponse) SetID(string) error {
+	return nil
+}
@@ -126,3 +126,49 @@ func (ocr2kc *OCR2KeysController) Export(c *gin.Context) {
 	ocr2kc.App.GetAuditLogger().Audit(audit.OCR2KeyBundleExported, map[string]interface{}{"keyID": stringID})
 	c.Data(http.StatusOK, MediaType, bytes)
 }
+
+// ImportBundles imports the OCR2 key bundles of an encrypted envelope
+// Example:
+// "Post <application>/keys/ocr2/bundles/import"
+func (ocr2kc *OCR2KeysController) ImportBundles(c *gin.Context) {
+	defer ocr2kc.App.GetLogger().ErrorIfFn(c.Request.Body.Close, "Error closing ImportBundles request body")
+	ctx := c.Request.Context()
+
+	bytes, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	oldPassword := c.Query("oldpassword")
+	keyBundles, err := ocr2kc.App.GetKeyStore().OCR2().ImportBundles(ctx, bytes, oldPassword)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	ids := make([]string, len(keyBundles))
+	for i, keyBundle := range keyBundles {
+		ids[i] = keyBundle.ID()
+	}
+	ocr2kc.App.GetAuditLogger().Audit(audit.OCR2KeyBundlesImported, map[string]interface{}{"ocr2KeyIDs": ids})
+
+	jsonAPIResponse(c, presenters.NewOCR2KeysBundleResources(keyBundles), "offChainReporting2KeyBundle")
+}
+
+// ExportBundles exports the OCR2 key bundles with the given ids, or all of them, as an encrypted envelope
+// Example:
+// "Post <application>/keys/ocr2/bundles/export?id=<id>&id=<id>"
+func (ocr2kc *OCR2KeysController) ExportBundles(c *gin.Context) {
+	defer ocr2kc.App.GetLogger().ErrorIfFn(c.Request.Body.Close, "Error closing ExportBundles response body")
+
+	ids := c.QueryArray("id")
+	newPassword := c.Query("newpassword")
+	bytes, err := ocr2kc.App.GetKeyStore().OCR2().ExportBundles(ids, newPassword)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	ocr2kc.App.GetAuditLogger().Audit(audit.OCR2KeyBundlesExported, map[string]interface{}{"keyIDs": ids})
+	c.Data(http.StatusOK, MediaType, bytes)
+}
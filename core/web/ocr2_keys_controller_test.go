@@ -1,6 +1,7 @@
 package web_test
 
 import (
+	"bytes"
 	"fmt"
 	"net/http"
 	"testing"
@@ -97,6 +98,36 @@ func TestOCR2KeysController_Delete_HappyPath(t *testing.T) {
 	assert.Equal(t, initialLength, len(keys))
 }
 
+func TestOCR2KeysController_ExportImportBundles_HappyPath(t *testing.T) {
+	ctx := testutils.Context(t)
+	client, OCRKeyStore := setupOCR2KeysControllerTests(t)
+
+	key, err := OCRKeyStore.Create(ctx, chaintype.Solana)
+	require.NoError(t, err)
+
+	response, cleanup := client.Post("/v2/keys/ocr2/bundles/export?newpassword=secret&id="+key.ID(), nil)
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	envelope := cltest.ParseResponseBody(t, response)
+
+	require.NoError(t, OCRKeyStore.Delete(ctx, key.ID()))
+
+	response, cleanup = client.Post("/v2/keys/ocr2/bundles/import?oldpassword=secret", bytes.NewReader(envelope))
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusOK, response.StatusCode)
+
+	var resources []presenters.OCR2KeysBundleResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resources))
+	require.Len(t, resources, 1)
+	assert.Equal(t, key.ID(), resources[0].ID)
+	_, err = OCRKeyStore.Get(key.ID())
+	require.NoError(t, err)
+
+	response, cleanup = client.Post("/v2/keys/ocr2/bundles/import?oldpassword=wrong", bytes.NewReader(envelope))
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusInternalServerError, response.StatusCode)
+}
+
 func setupOCR2KeysControllerTests(t *testing.T) (cltest.HTTPClientCleaner, keystore.OCR2) {
 	t.Parallel()
 	ctx := testutils.Context(t)
@@ -0,0 +1,133 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
+)
+
+var errOIDCNotEnabled = errors.New("OIDC authentication is not enabled, set WebServer.AuthenticationMethod to oidc")
+
+// The keys of the OIDC login in the auth.OIDCLoginSessionName session
+const (
+	oidcStateKey        = "state"
+	oidcNonceKey        = "nonce"
+	oidcCodeVerifierKey = "code_verifier"
+	oidcExpiresAtKey    = "expires_at"
+)
+
+// OIDCController manages the logins through the OIDC identity provider.
+type OIDCController struct {
+	App chainlink.Application
+}
+
+// Login redirects the user to log in at the identity provider, keeping the login in a cookie of the browser.
+func (oc *OIDCController) Login(c *gin.Context) {
+	provider, ok := oc.App.AuthenticationProvider().(clsessions.OIDCAuthenticationProvider)
+	if !ok {
+		jsonAPIError(c, http.StatusNotFound, errOIDCNotEnabled)
+		return
+	}
+
+	loginURL, login, err := provider.LoginURL(c.Request.Context())
+	if err != nil {
+		jsonAPIError(c, http.StatusServiceUnavailable, err)
+		return
+	}
+
+	session := sessions.DefaultMany(c, auth.OIDCLoginSessionName)
+	session.Set(oidcStateKey, login.State)
+	session.Set(oidcNonceKey, login.Nonce)
+	session.Set(oidcCodeVerifierKey, login.CodeVerifier)
+	session.Set(oidcExpiresAtKey, login.ExpiresAt.Unix())
+	session.Options(oc.loginSessionOptions(int(time.Until(login.ExpiresAt).Seconds())))
+	if err = session.Save(); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, multierr.Append(errors.New("unable to save OIDC login"), err))
+		return
+	}
+	c.Redirect(http.StatusFound, loginURL)
+}
+
+// Callback creates a session for the user redirected back by the identity provider, returns it in a cookie, and
+// redirects the user to the operator UI.
+func (oc *OIDCController) Callback(c *gin.Context) {
+	defer oc.App.WakeSessionReaper()
+	provider, ok := oc.App.AuthenticationProvider().(clsessions.OIDCAuthenticationProvider)
+	if !ok {
+		jsonAPIError(c, http.StatusNotFound, errOIDCNotEnabled)
+		return
+	}
+
+	// The login can only be completed once, so it is cleared from the browser whatever the outcome
+	loginSession := sessions.DefaultMany(c, auth.OIDCLoginSessionName)
+	login := clsessions.OIDCLogin{}
+	login.State, _ = loginSession.Get(oidcStateKey).(string)
+	login.Nonce, _ = loginSession.Get(oidcNonceKey).(string)
+	login.CodeVerifier, _ = loginSession.Get(oidcCodeVerifierKey).(string)
+	expiresAt, _ := loginSession.Get(oidcExpiresAtKey).(int64)
+	login.ExpiresAt = time.Unix(expiresAt, 0)
+	loginSession.Clear()
+	loginSession.Options(oc.loginSessionOptions(-1))
+	if err := loginSession.Save(); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, multierr.Append(errors.New("unable to clear OIDC login"), err))
+		return
+	}
+
+	if errCode := c.Query("error"); errCode != "" {
+		jsonAPIError(c, http.StatusUnauthorized, fmt.Errorf("login failed at OIDC identity provider: %s: %s", errCode, c.Query("error_description")))
+		return
+	}
+	sid, err := provider.CreateOIDCSession(c.Request.Context(), login, c.Query("state"), c.Query("code"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	session := sessions.DefaultMany(c, auth.SessionName)
+	// The session cookie is sent with all the requests to the node, not only the ones under /oidc
+	opts := oc.App.GetConfig().WebServer().SessionOptions()
+	opts.Path = "/"
+	session.Options(opts)
+	if err := saveSessionID(session, sid); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, multierr.Append(errors.New("unable to save session id"), err))
+		return
+	}
+	c.Redirect(http.StatusFound, "/")
+}
+
+// loginSessionOptions returns the options of the cookie keeping the login until the callback. Unlike the session
+// cookie, it is sent along the redirect from the identity provider, so it must not be strictly same site.
+func (oc *OIDCController) loginSessionOptions(maxAge int) sessions.Options {
+	opts := oc.App.GetConfig().WebServer().SessionOptions()
+	opts.Path = "/oidc"
+	opts.MaxAge = maxAge
+	opts.SameSite = http.SameSiteLaxMode
+	return opts
+}
+
+// BackchannelLogout revokes the sessions identified by the logout token the identity provider sends when the user
+// logs out, or is logged out, at the identity provider.
+func (oc *OIDCController) BackchannelLogout(c *gin.Context) {
+	c.Header("Cache-Control", "no-store")
+	provider, ok := oc.App.AuthenticationProvider().(clsessions.OIDCAuthenticationProvider)
+	if !ok {
+		c.JSON(http.StatusNotFound, gin.H{"error": "invalid_request", "error_description": errOIDCNotEnabled.Error()})
+		return
+	}
+
+	if err := provider.BackchannelLogout(c.Request.Context(), c.PostForm("logout_token")); err != nil {
+		oc.App.GetLogger().Infof("Rejected OIDC back-channel logout: %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid_request", "error_description": err.Error()})
+		return
+	}
+	c.Status(http.StatusOK)
+}
@@ -0,0 +1,126 @@
+package web_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-contrib/sessions/cookie"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/oidcauth"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
+)
+
+type oidcTestApp struct {
+	chainlink.Application
+	cfg      chainlink.GeneralConfig
+	provider clsessions.AuthenticationProvider
+}
+
+func (a oidcTestApp) GetConfig() chainlink.GeneralConfig { return a.cfg }
+
+func (a oidcTestApp) AuthenticationProvider() clsessions.AuthenticationProvider { return a.provider }
+
+func (a oidcTestApp) WakeSessionReaper() {}
+
+// oidcTestProvider completes the logins whose state matches the one kept by the browser.
+type oidcTestProvider struct {
+	clsessions.OIDCAuthenticationProvider
+	login clsessions.OIDCLogin
+}
+
+func (p *oidcTestProvider) LoginURL(ctx context.Context) (string, clsessions.OIDCLogin, error) {
+	return "https://idp.example.com/authorize?state=" + p.login.State, p.login, nil
+}
+
+func (p *oidcTestProvider) CreateOIDCSession(ctx context.Context, login clsessions.OIDCLogin, state, code string) (string, error) {
+	if login.State == "" || time.Now().After(login.ExpiresAt) {
+		return "", oidcauth.ErrLoginExpired
+	}
+	if login.State != state || login.Nonce != p.login.Nonce || login.CodeVerifier != p.login.CodeVerifier {
+		return "", oidcauth.ErrLoginStateMismatch
+	}
+	return "session-id", nil
+}
+
+// Assert that the OIDC callback only completes the login kept in the cookie of the browser which started it, and clears
+// that cookie whatever the outcome.
+func TestOIDCController_Callback_BoundToBrowser(t *testing.T) {
+	t.Parallel()
+
+	provider := &oidcTestProvider{login: clsessions.OIDCLogin{
+		State:        "state",
+		Nonce:        "nonce",
+		CodeVerifier: "verifier",
+		ExpiresAt:    time.Now().Add(time.Minute),
+	}}
+	app := oidcTestApp{cfg: configtest.NewGeneralConfig(t, nil), provider: provider}
+	oc := web.OIDCController{App: app}
+
+	router := gin.New()
+	store := cookie.NewStore([]byte("0123456789abcdef0123456789abcdef"))
+	oidcLogin := router.Group("/oidc", sessions.SessionsMany([]string{auth.SessionName, auth.OIDCLoginSessionName}, store))
+	oidcLogin.GET("/login", oc.Login)
+	oidcLogin.GET("/callback", oc.Callback)
+
+	serve := func(path string, cookies []*http.Cookie) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		for _, c := range cookies {
+			req.AddCookie(c)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+	cookieNamed := func(w *httptest.ResponseRecorder, name string) *http.Cookie {
+		for _, c := range w.Result().Cookies() {
+			if c.Name == name {
+				return c
+			}
+		}
+		return nil
+	}
+
+	w := serve("/oidc/login", nil)
+	require.Equal(t, http.StatusFound, w.Code)
+	loginCookie := cookieNamed(w, auth.OIDCLoginSessionName)
+	require.NotNil(t, loginCookie)
+	assert.Equal(t, "/oidc", loginCookie.Path)
+	assert.Equal(t, http.SameSiteLaxMode, loginCookie.SameSite)
+
+	t.Run("callback in another browser", func(t *testing.T) {
+		w := serve("/oidc/callback?state=state&code=code", nil)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Nil(t, cookieNamed(w, auth.SessionName))
+	})
+
+	t.Run("callback with another state", func(t *testing.T) {
+		w := serve("/oidc/callback?state=other&code=code", []*http.Cookie{loginCookie})
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Nil(t, cookieNamed(w, auth.SessionName))
+		cleared := cookieNamed(w, auth.OIDCLoginSessionName)
+		require.NotNil(t, cleared)
+		assert.Negative(t, cleared.MaxAge)
+	})
+
+	t.Run("callback in the browser of the login", func(t *testing.T) {
+		w := serve("/oidc/callback?state=state&code=code", []*http.Cookie{loginCookie})
+		require.Equal(t, http.StatusFound, w.Code)
+		session := cookieNamed(w, auth.SessionName)
+		require.NotNil(t, session)
+		assert.Equal(t, "/", session.Path)
+		cleared := cookieNamed(w, auth.OIDCLoginSessionName)
+		require.NotNil(t, cleared)
+		assert.Negative(t, cleared.MaxAge)
+	})
+}
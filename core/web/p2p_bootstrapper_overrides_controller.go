@@ -0,0 +1,131 @@
+package web
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// P2PBootstrapperOverridesController manages the bootstrapper overrides of the OCR2 DONs, which add or remove
+// bootstrap peers of their jobs without changing the job specs. The active jobs of a DON are restarted whenever its
+// override changes.
+type P2PBootstrapperOverridesController struct {
+	App chainlink.Application
+}
+
+// P2PBootstrapperOverrideRequest sets the bootstrapper override of a DON.
+type P2PBootstrapperOverrideRequest struct {
+	// Added are the locators of the bootstrap peers to add, in the peerID@host:port format.
+	Added []string `json:"added"`
+	// Removed are the peer IDs of the bootstrap peers to remove.
+	Removed []string `json:"removed"`
+}
+
+// Index lists the bootstrapper overrides.
+// Example:
+// "GET <application>/p2p/bootstrapper_overrides"
+func (bc *P2PBootstrapperOverridesController) Index(c *gin.Context) {
+	overrides, err := ocrcommon.NewBootstrapperOverridesORM(bc.App.GetDB()).List(c.Request.Context())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewP2PBootstrapperOverrideResources(overrides), "p2pBootstrapperOverrides")
+}
+
+// Update sets the bootstrapper override of a DON and restarts its jobs.
+// Example:
+// "PUT <application>/p2p/bootstrapper_overrides/:chainID/:contractID"
+func (bc *P2PBootstrapperOverridesController) Update(c *gin.Context) {
+	var request P2PBootstrapperOverrideRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	override := ocrcommon.BootstrapperOverride{
+		ChainID:    c.Param("chainID"),
+		ContractID: c.Param("contractID"),
+		Added:      request.Added,
+		Removed:    request.Removed,
+	}
+	if err := override.Validate(); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	override, err := ocrcommon.NewBootstrapperOverridesORM(bc.App.GetDB()).Upsert(ctx, override)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	bc.App.GetAuditLogger().Audit(audit.BootstrapperOverrideUpdated, map[string]interface{}{
+		"chainID":    override.ChainID,
+		"contractID": override.ContractID,
+		"request":    request,
+	})
+	if err = bc.restartJobs(ctx, override.ChainID, override.ContractID); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewP2PBootstrapperOverrideResource(override), "p2pBootstrapperOverrides")
+}
+
+// Delete removes the bootstrapper override of a DON and restarts its jobs, so that they use the bootstrap peers of
+// their specs again.
+// Example:
+// "DELETE <application>/p2p/bootstrapper_overrides/:chainID/:contractID"
+func (bc *P2PBootstrapperOverridesController) Delete(c *gin.Context) {
+	chainID, contractID := c.Param("chainID"), c.Param("contractID")
+	ctx := c.Request.Context()
+	if err := ocrcommon.NewBootstrapperOverridesORM(bc.App.GetDB()).Delete(ctx, chainID, contractID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("bootstrapper override not found"))
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	bc.App.GetAuditLogger().Audit(audit.BootstrapperOverrideDeleted, map[string]interface{}{
+		"chainID":    chainID,
+		"contractID": contractID,
+	})
+	if err := bc.restartJobs(ctx, chainID, contractID); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponseWithStatus(c, nil, "p2pBootstrapperOverrides", http.StatusNoContent)
+}
+
+// restartJobs restarts the active OCR2 jobs of the DON, so that they pick up its bootstrapper override.
+func (bc *P2PBootstrapperOverridesController) restartJobs(ctx context.Context, chainID, contractID string) error {
+	var errs error
+	for id, jb := range bc.App.JobSpawner().ActiveJobs() {
+		if jb.Type != job.OffchainReporting2 || jb.OCR2OracleSpec == nil || jb.OCR2OracleSpec.ContractID != contractID {
+			continue
+		}
+		rid, err := jb.OCR2OracleSpec.RelayID()
+		if err != nil || rid.ChainID != chainID {
+			continue
+		}
+		if err = bc.App.JobSpawner().RestartJob(ctx, id); err != nil {
+			errs = errors.Join(errs, err)
+		}
+	}
+	if errs != nil {
+		return fmt.Errorf("bootstrapper override was saved, but failed to restart jobs: %w", errs)
+	}
+	return nil
+}
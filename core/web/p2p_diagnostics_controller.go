@@ -0,0 +1,34 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// P2PDiagnosticsController reports the state of the OCR peer of the node.
+type P2PDiagnosticsController struct {
+	App chainlink.Application
+}
+
+// Show returns the state of the OCR peer: its remote peers and their traffic rates, the dial failures and the size of
+// the discovery table. The rates are computed since the previous request.
+// Example:
+// "GET <application>/p2p/diagnostics"
+func (pc *P2PDiagnosticsController) Show(c *gin.Context) {
+	pw := pc.App.GetPeerWrapper()
+	if pw == nil {
+		jsonAPIError(c, http.StatusNotFound, errors.New("the P2P stack is not enabled"))
+		return
+	}
+	d, err := pw.Diagnostics(c.Request.Context())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewP2PDiagnosticsResource(d), "p2pDiagnostics")
+}
@@ -1,6 +1,8 @@
 package web
 
 import (
+	"context"
+	"database/sql"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -11,6 +13,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
@@ -165,9 +168,22 @@ func (prc *PipelineRunsController) Resume(c *gin.Context) {
 		return
 	}
 
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if err = prc.verifyResume(c.Request.Context(), taskID, body, c.Request.Header); err != nil {
+		if errors.Is(err, errResumeUnverified) {
+			jsonAPIError(c, http.StatusUnauthorized, err)
+		} else {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
 	rr := pipeline.ResumeRequest{}
-	decoder := json.NewDecoder(c.Request.Body)
-	err = errors.Wrap(decoder.Decode(&rr), "failed to unmarshal JSON body")
+	err = errors.Wrap(json.Unmarshal(body, &rr), "failed to unmarshal JSON body")
 	if err != nil {
 		jsonAPIError(c, http.StatusUnprocessableEntity, err)
 		return
@@ -186,3 +202,35 @@ func (prc *PipelineRunsController) Resume(c *gin.Context) {
 	prc.App.GetAuditLogger().Audit(audit.UnauthedRunResumed, map[string]interface{}{"runID": c.Param("runID")})
 	c.Status(http.StatusOK)
 }
+
+var errResumeUnverified = errors.New("resume request is not signed by the bridge")
+
+// verifyResume checks the signature of the result of an async bridge task, if its bridge signs its responses. The
+// result is signed for the ID of the task run, without a nonce.
+func (prc *PipelineRunsController) verifyResume(ctx context.Context, taskID uuid.UUID, body []byte, header http.Header) error {
+	tr, err := prc.App.PipelineORM().FindTaskRun(ctx, taskID)
+	if errors.Is(err, sql.ErrNoRows) {
+		// left to ResumeJobV2, which rejects unknown tasks
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "failed to find task run")
+	}
+	p, err := pipeline.Parse(tr.PipelineRun.PipelineSpec.DotDagSource)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse pipeline spec")
+	}
+	task, ok := p.ByDotID(tr.DotID).(*pipeline.BridgeTask)
+	if !ok {
+		return nil
+	}
+	bt, err := prc.App.BridgeORM().FindBridge(ctx, bridges.BridgeName(task.Name))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "failed to find bridge")
+	}
+	if err = bt.VerifyResponse(taskID.String(), "", body, header); err != nil {
+		return errors.Wrap(errResumeUnverified, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package presenters
+
+import (
+	"strconv"
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/apitokens"
+)
+
+// APITokenResource represents a scoped API token JSONAPI resource. The secret
+// of the token is only present when the token is created.
+type APITokenResource struct {
+	JAID
+	Name      string    `json:"name"`
+	AccessKey string    `json:"accessKey"`
+	Secret    string    `json:"secret,omitempty"`
+	Scopes    []string  `json:"scopes"`
+	ExpiresAt null.Time `json:"expiresAt"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r APITokenResource) GetName() string {
+	return "apiTokens"
+}
+
+// NewAPITokenResource constructs a new APITokenResource.
+func NewAPITokenResource(t apitokens.APIToken) *APITokenResource {
+	scopes := []string{}
+	scopes = append(scopes, t.Scopes...)
+	return &APITokenResource{
+		JAID:      NewJAID(strconv.FormatInt(t.ID, 10)),
+		Name:      t.Name,
+		AccessKey: t.AccessKey,
+		Scopes:    scopes,
+		ExpiresAt: t.ExpiresAt,
+		CreatedAt: t.CreatedAt,
+	}
+}
+
+// NewAPITokenResources constructs a slice of APITokenResources.
+func NewAPITokenResources(ts []apitokens.APIToken) []APITokenResource {
+	rs := []APITokenResource{}
+	for _, t := range ts {
+		rs = append(rs, *NewAPITokenResource(t))
+	}
+	return rs
+}
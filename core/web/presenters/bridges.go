@@ -5,6 +5,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/utils/crypto"
 )
 
 // BridgeResource represents a Bridge JSONAPI resource.
@@ -14,10 +15,11 @@ type BridgeResource struct {
 	URL           string `json:"url"`
 	Confirmations uint32 `json:"confirmations"`
 	// The IncomingToken is only provided when creating a Bridge
-	IncomingToken          string       `json:"incomingToken,omitempty"`
-	OutgoingToken          string       `json:"outgoingToken"`
-	MinimumContractPayment *assets.Link `json:"minimumContractPayment"`
-	CreatedAt              time.Time    `json:"createdAt"`
+	IncomingToken          string           `json:"incomingToken,omitempty"`
+	OutgoingToken          string           `json:"outgoingToken"`
+	MinimumContractPayment *assets.Link     `json:"minimumContractPayment"`
+	ResponsePublicKey      crypto.PublicKey `json:"responsePublicKey,omitempty"`
+	CreatedAt              time.Time        `json:"createdAt"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -35,6 +37,7 @@ func NewBridgeResource(b bridges.BridgeType) *BridgeResource {
 		Confirmations:          b.Confirmations,
 		OutgoingToken:          b.OutgoingToken,
 		MinimumContractPayment: b.MinimumContractPayment,
+		ResponsePublicKey:      b.ResponsePublicKey,
 		CreatedAt:              b.CreatedAt,
 	}
 }
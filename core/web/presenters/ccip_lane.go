@@ -0,0 +1,95 @@
+package presenters
+
+import (
+	"fmt"
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	cciporm "github.com/smartcontractkit/chainlink/v2/core/services/ccip"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/discovery"
+)
+
+// CCIPLaneResource is the status of a CCIP lane JSONAPI resource.
+type CCIPLaneResource struct {
+	JAID
+	SourceChainSelector   uint64    `json:"sourceChainSelector,string"`
+	DestChainSelector     uint64    `json:"destChainSelector,string"`
+	CommitObservedAt      null.Time `json:"commitObservedAt"`
+	LatestCommittedSeqNr  uint64    `json:"latestCommittedSeqNr"`
+	PendingCommitMessages int       `json:"pendingCommitMessages"`
+	ExecObservedAt        null.Time `json:"execObservedAt"`
+	LatestExecutedSeqNr   uint64    `json:"latestExecutedSeqNr"`
+	ExecutableMessages    int       `json:"executableMessages"`
+	UnexpiredCommitRoots  int       `json:"unexpiredCommitRoots"`
+	GasPriceObservedAt    null.Time `json:"gasPriceObservedAt"`
+	SourceGasPrice        *big.Big  `json:"sourceGasPrice"`
+	SourceGasPriceUSD     *big.Big  `json:"sourceGasPriceUSD"`
+	GasPriceUpdatedAt     null.Time `json:"gasPriceUpdatedAt"`
+	TokenPricesUpdatedAt  null.Time `json:"tokenPricesUpdatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r CCIPLaneResource) GetName() string {
+	return "ccipLanes"
+}
+
+// NewCCIPLaneResource returns a new CCIPLaneResource for the status of a lane, and the times its prices were last
+// updated.
+func NewCCIPLaneResource(status ccip.LaneStatus, priceUpdates cciporm.PriceUpdateTimes) CCIPLaneResource {
+	return CCIPLaneResource{
+		JAID:                  NewJAID(fmt.Sprintf("%d-%d", status.SourceChainSelector, status.DestChainSelector)),
+		SourceChainSelector:   status.SourceChainSelector,
+		DestChainSelector:     status.DestChainSelector,
+		CommitObservedAt:      nullTime(status.CommitObservedAt),
+		LatestCommittedSeqNr:  status.LatestCommittedSeqNr,
+		PendingCommitMessages: status.PendingCommitMessages,
+		ExecObservedAt:        nullTime(status.ExecObservedAt),
+		LatestExecutedSeqNr:   status.LatestExecutedSeqNr,
+		ExecutableMessages:    status.ExecutableMessages,
+		UnexpiredCommitRoots:  status.UnexpiredCommitRoots,
+		GasPriceObservedAt:    nullTime(status.GasPriceObservedAt),
+		SourceGasPrice:        big.New(status.SourceGasPrice),
+		SourceGasPriceUSD:     big.New(status.SourceGasPriceUSD),
+		GasPriceUpdatedAt:     priceUpdates.GasPrice,
+		TokenPricesUpdatedAt:  priceUpdates.TokenPrices,
+	}
+}
+
+func nullTime(t time.Time) null.Time {
+	return null.NewTime(t, !t.IsZero())
+}
+
+// CCIPLaneDiscoveryResource is a JSONAPI resource for the contracts of a CCIP lane as read onchain, along with the
+// skeletons of its job specs.
+type CCIPLaneDiscoveryResource struct {
+	JAID
+	discovery.Lane
+	CommitJobSpec string `json:"commitJobSpec"`
+	ExecJobSpec   string `json:"execJobSpec"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r CCIPLaneDiscoveryResource) GetName() string {
+	return "ccipLaneDiscoveries"
+}
+
+// NewCCIPLaneDiscoveryResource returns a new CCIPLaneDiscoveryResource for a discovered lane.
+func NewCCIPLaneDiscoveryResource(lane discovery.Lane) (CCIPLaneDiscoveryResource, error) {
+	commitJobSpec, err := lane.CommitJobSpec()
+	if err != nil {
+		return CCIPLaneDiscoveryResource{}, err
+	}
+	execJobSpec, err := lane.ExecJobSpec()
+	if err != nil {
+		return CCIPLaneDiscoveryResource{}, err
+	}
+	return CCIPLaneDiscoveryResource{
+		JAID:          NewJAID(fmt.Sprintf("%d-%d", lane.SourceChainSelector, lane.DestChainSelector)),
+		Lane:          lane,
+		CommitJobSpec: commitJobSpec,
+		ExecJobSpec:   execJobSpec,
+	}, nil
+}
@@ -1,6 +1,9 @@
 package presenters
 
-import "cmp"
+import (
+	"cmp"
+	"time"
+)
 
 type Check struct {
 	JAID
@@ -16,3 +19,21 @@ func (c Check) GetName() string {
 func CmpCheckName(a, b Check) int {
 	return cmp.Compare(a.Name, b.Name)
 }
+
+// HealthTree is the health of the node as a tree of dependencies, along with its readiness state.
+type HealthTree struct {
+	State        string             `json:"state"`
+	Dependencies []HealthDependency `json:"dependencies"`
+}
+
+// HealthDependency is a node of a HealthTree. A dependency is failing if its own check, or any of its dependencies
+// is failing.
+type HealthDependency struct {
+	Name         string             `json:"name"`
+	ID           string             `json:"id"`
+	Status       string             `json:"status"`
+	Cause        string             `json:"cause,omitempty"`
+	FailingSince *time.Time         `json:"failingSince,omitempty"`
+	FailingFor   string             `json:"failingFor,omitempty"`
+	Dependencies []HealthDependency `json:"dependencies,omitempty"`
+}
@@ -0,0 +1,24 @@
+package presenters
+
+import (
+	"github.com/smartcontractkit/chainlink/v2/core/services/drain"
+)
+
+// DrainResource is the status of the drain of the node JSONAPI resource.
+type DrainResource struct {
+	JAID
+	drain.Status
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r DrainResource) GetName() string {
+	return "drain"
+}
+
+// NewDrainResource returns a new DrainResource for the status of the drain.
+func NewDrainResource(status drain.Status) *DrainResource {
+	return &DrainResource{
+		JAID:   NewJAID("drain"),
+		Status: status,
+	}
+}
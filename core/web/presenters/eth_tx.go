@@ -1,7 +1,9 @@
 package presenters
 
 import (
+	mathbig "math/big"
 	"strconv"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -26,6 +28,8 @@ type EthTxResource struct {
 	To         *common.Address `json:"to"`
 	Value      string          `json:"value"`
 	EVMChainID big.Big         `json:"evmChainID"`
+	// IdempotencyKey is only set for the transactions created with an idempotency key.
+	IdempotencyKey *string `json:"idempotencyKey,omitempty"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -47,6 +51,8 @@ func NewEthTxResource(tx txmgr.Tx) EthTxResource {
 		State:    string(tx.State),
 		To:       &tx.ToAddress,
 		Value:    v.String(),
+
+		IdempotencyKey: tx.IdempotencyKey,
 	}
 
 	if tx.ChainID != nil {
@@ -77,3 +83,34 @@ func NewEthTxResourceFromAttempt(txa txmgr.TxAttempt) EthTxResource {
 	}
 	return r
 }
+
+// EVMJobSpendResource represents the fees spent on transactions created on behalf of a job since a point in time.
+type EVMJobSpendResource struct {
+	JAID
+	JobID      int32     `json:"jobID"`
+	EVMChainID big.Big   `json:"evmChainID"`
+	Since      time.Time `json:"since"`
+	// Attempted is the highest possible fee, in wei, of broadcast transactions which are not mined yet.
+	Attempted string `json:"attempted"`
+	// Mined is the fee, in wei, of mined transactions.
+	Mined string `json:"mined"`
+	Total string `json:"total"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (EVMJobSpendResource) GetName() string {
+	return "evm_job_spends"
+}
+
+// NewEVMJobSpendResource generates a EVMJobSpendResource from a txmgr.JobSpend.
+func NewEVMJobSpendResource(spend txmgr.JobSpend, chainID *mathbig.Int, since time.Time) EVMJobSpendResource {
+	return EVMJobSpendResource{
+		JAID:       NewPrefixedJAID(strconv.FormatInt(int64(spend.JobID), 10), chainID.String()),
+		JobID:      spend.JobID,
+		EVMChainID: *big.New(chainID),
+		Since:      since,
+		Attempted:  spend.Attempted.String(),
+		Mined:      spend.Mined.String(),
+		Total:      spend.Total().String(),
+	}
+}
@@ -0,0 +1,36 @@
+package presenters
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/functions"
+)
+
+// FunctionsQuotaResource is the quota of a Functions subscription JSONAPI resource.
+type FunctionsQuotaResource struct {
+	JAID
+	SubscriptionID       uint64    `json:"subscriptionID,string"`
+	RequestsPerMinute    *uint32   `json:"requestsPerMinute"`
+	MonthlyComputeMillis *uint64   `json:"monthlyComputeMillis"`
+	ComputeMillisUsed    int64     `json:"computeMillisUsed"`
+	UpdatedAt            time.Time `json:"updatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r FunctionsQuotaResource) GetName() string {
+	return "functionsQuotas"
+}
+
+// NewFunctionsQuotaResource returns a new FunctionsQuotaResource for the quota of a subscription, and the compute time
+// it has used in the current month.
+func NewFunctionsQuotaResource(quota functions.SubscriptionQuota, used time.Duration) FunctionsQuotaResource {
+	return FunctionsQuotaResource{
+		JAID:                 NewJAID(fmt.Sprintf("%d", quota.SubscriptionID)),
+		SubscriptionID:       quota.SubscriptionID,
+		RequestsPerMinute:    quota.RequestsPerMinute,
+		MonthlyComputeMillis: quota.MonthlyComputeMillis,
+		ComputeMillisUsed:    used.Milliseconds(),
+		UpdatedAt:            quota.UpdatedAt,
+	}
+}
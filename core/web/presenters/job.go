@@ -1,6 +1,7 @@
 package presenters
 
 import (
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
@@ -173,12 +174,16 @@ type OffChainReporting2Spec struct {
 	OCRKeyBundleID                    null.String            `json:"ocrKeyBundleID"`
 	TransmitterID                     null.String            `json:"transmitterID"`
 	ObservationTimeout                models.Interval        `json:"observationTimeout"`
+	ReportTimeout                     models.Interval        `json:"reportTimeout"`
 	BlockchainTimeout                 models.Interval        `json:"blockchainTimeout"`
 	ContractConfigTrackerPollInterval models.Interval        `json:"contractConfigTrackerPollInterval"`
 	ContractConfigConfirmations       uint16                 `json:"contractConfigConfirmations"`
 	CreatedAt                         time.Time              `json:"createdAt"`
 	UpdatedAt                         time.Time              `json:"updatedAt"`
 	CollectTelemetry                  bool                   `json:"collectTelemetry"`
+	Standby                           bool                   `json:"standby"`
+	TransmissionSchedule              map[string]interface{} `json:"transmissionSchedule"`
+	ReportEncryptionKeyID             *string                `json:"reportEncryptionKeyID"`
 }
 
 // NewOffChainReporting2Spec initializes a new OffChainReportingSpec from a
@@ -191,12 +196,17 @@ func NewOffChainReporting2Spec(spec *job.OCR2OracleSpec) *OffChainReporting2Spec
 		P2PV2Bootstrappers:                spec.P2PV2Bootstrappers,
 		OCRKeyBundleID:                    spec.OCRKeyBundleID,
 		TransmitterID:                     spec.TransmitterID,
+		ObservationTimeout:                spec.ObservationTimeout,
+		ReportTimeout:                     spec.ReportTimeout,
 		BlockchainTimeout:                 spec.BlockchainTimeout,
 		ContractConfigTrackerPollInterval: spec.ContractConfigTrackerPollInterval,
 		ContractConfigConfirmations:       spec.ContractConfigConfirmations,
 		CreatedAt:                         spec.CreatedAt,
 		UpdatedAt:                         spec.UpdatedAt,
 		CollectTelemetry:                  spec.CaptureEATelemetry,
+		Standby:                           spec.Standby,
+		TransmissionSchedule:              spec.TransmissionSchedule,
+		ReportEncryptionKeyID:             spec.ReportEncryptionKeyID.Ptr(),
 	}
 }
 
@@ -252,19 +262,27 @@ func NewWebhookSpec(spec *job.WebhookSpec) *WebhookSpec {
 
 // CronSpec defines the spec details of a Cron Job
 type CronSpec struct {
-	CronSchedule string    `json:"schedule"`
-	CreatedAt    time.Time `json:"createdAt"`
-	UpdatedAt    time.Time `json:"updatedAt"`
-	EVMChainID   *big.Big  `json:"evmChainID"`
+	CronSchedule   string          `json:"schedule"`
+	Jitter         models.Interval `json:"jitter"`
+	CatchUp        job.CronCatchUp `json:"catchUp"`
+	PreventOverlap bool            `json:"preventOverlap"`
+	LastRunAt      *time.Time      `json:"lastRunAt"`
+	CreatedAt      time.Time       `json:"createdAt"`
+	UpdatedAt      time.Time       `json:"updatedAt"`
+	EVMChainID     *big.Big        `json:"evmChainID"`
 }
 
 // NewCronSpec generates a new CronSpec from a job.CronSpec
 func NewCronSpec(spec *job.CronSpec) *CronSpec {
 	return &CronSpec{
-		CronSchedule: spec.CronSchedule,
-		CreatedAt:    spec.CreatedAt,
-		UpdatedAt:    spec.UpdatedAt,
-		EVMChainID:   spec.EVMChainID,
+		CronSchedule:   spec.CronSchedule,
+		Jitter:         spec.Jitter,
+		CatchUp:        spec.CatchUp,
+		PreventOverlap: spec.PreventOverlap,
+		LastRunAt:      spec.LastRunAt,
+		CreatedAt:      spec.CreatedAt,
+		UpdatedAt:      spec.UpdatedAt,
+		EVMChainID:     spec.EVMChainID,
 	}
 }
 
@@ -273,6 +291,7 @@ type VRFSpec struct {
 	BatchFulfillmentEnabled       bool                  `json:"batchFulfillmentEnabled"`
 	CustomRevertsPipelineEnabled  *bool                 `json:"customRevertsPipelineEnabled,omitempty"`
 	BatchFulfillmentGasMultiplier float64               `json:"batchFulfillmentGasMultiplier"`
+	BatchFulfillmentMaxWaitBlocks uint32                `json:"batchFulfillmentMaxWaitBlocks"`
 	CoordinatorAddress            types.EIP55Address    `json:"coordinatorAddress"`
 	PublicKey                     secp256k1.PublicKey   `json:"publicKey"`
 	FromAddresses                 []types.EIP55Address  `json:"fromAddresses"`
@@ -295,6 +314,7 @@ func NewVRFSpec(spec *job.VRFSpec) *VRFSpec {
 		BatchCoordinatorAddress:       spec.BatchCoordinatorAddress,
 		BatchFulfillmentEnabled:       spec.BatchFulfillmentEnabled,
 		BatchFulfillmentGasMultiplier: float64(spec.BatchFulfillmentGasMultiplier),
+		BatchFulfillmentMaxWaitBlocks: spec.BatchFulfillmentMaxWaitBlocks,
 		CustomRevertsPipelineEnabled:  &spec.CustomRevertsPipelineEnabled,
 		CoordinatorAddress:            spec.CoordinatorAddress,
 		PublicKey:                     spec.PublicKey,
@@ -517,6 +537,9 @@ type JobResource struct {
 	GasLimit                 clnull.Uint32             `json:"gasLimit"`
 	ForwardingAllowed        bool                      `json:"forwardingAllowed"`
 	MaxTaskDuration          models.Interval           `json:"maxTaskDuration"`
+	MaxSuccessfulRuns        clnull.Uint32             `json:"maxSuccessfulRuns"`
+	RunRetention             models.Interval           `json:"runRetention"`
+	ErroredRunRetention      models.Interval           `json:"erroredRunRetention"`
 	ExternalJobID            uuid.UUID                 `json:"externalJobID"`
 	DirectRequestSpec        *DirectRequestSpec        `json:"directRequestSpec"`
 	FluxMonitorSpec          *FluxMonitorSpec          `json:"fluxMonitorSpec"`
@@ -534,22 +557,27 @@ type JobResource struct {
 	StandardCapabilitiesSpec *StandardCapabilitiesSpec `json:"standardCapabilitiesSpec"`
 	CCIPSpec                 *CCIPSpec                 `json:"ccipSpec"`
 	PipelineSpec             PipelineSpec              `json:"pipelineSpec"`
+	Paused                   bool                      `json:"paused"`
 	Errors                   []JobError                `json:"errors"`
 }
 
 // NewJobResource initializes a new JSONAPI job resource
 func NewJobResource(j job.Job) *JobResource {
 	resource := &JobResource{
-		JAID:              NewJAIDInt32(j.ID),
-		Name:              j.Name.ValueOrZero(),
-		StreamID:          j.StreamID,
-		Type:              JobSpecType(j.Type),
-		SchemaVersion:     j.SchemaVersion,
-		GasLimit:          j.GasLimit,
-		ForwardingAllowed: j.ForwardingAllowed,
-		MaxTaskDuration:   j.MaxTaskDuration,
-		PipelineSpec:      NewPipelineSpec(j.PipelineSpec),
-		ExternalJobID:     j.ExternalJobID,
+		JAID:                NewJAIDInt32(j.ID),
+		Name:                j.Name.ValueOrZero(),
+		StreamID:            j.StreamID,
+		Type:                JobSpecType(j.Type),
+		SchemaVersion:       j.SchemaVersion,
+		GasLimit:            j.GasLimit,
+		ForwardingAllowed:   j.ForwardingAllowed,
+		MaxTaskDuration:     j.MaxTaskDuration,
+		MaxSuccessfulRuns:   j.MaxSuccessfulRuns,
+		RunRetention:        j.RunRetention,
+		ErroredRunRetention: j.ErroredRunRetention,
+		PipelineSpec:        NewPipelineSpec(j.PipelineSpec),
+		ExternalJobID:       j.ExternalJobID,
+		Paused:              j.Paused,
 	}
 
 	switch j.Type {
@@ -602,3 +630,66 @@ func NewJobResource(j job.Job) *JobResource {
 func (r JobResource) GetName() string {
 	return "jobs"
 }
+
+// BulkJobResultResource represents the result of an operation of a bulk job
+// request. Its ID is the index of the operation in the request.
+type BulkJobResultResource struct {
+	JAID
+	Action string       `json:"action"`
+	JobID  string       `json:"jobID,omitempty"`
+	Status int          `json:"status"`
+	Error  string       `json:"error,omitempty"`
+	Job    *JobResource `json:"job,omitempty"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r BulkJobResultResource) GetName() string {
+	return "bulkJobResults"
+}
+
+// JobSpecVersionResource represents a version of a job spec. Its ID is the
+// version number.
+type JobSpecVersionResource struct {
+	JAID
+	JobID     string    `json:"jobID"`
+	TOML      string    `json:"toml"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobSpecVersionResource) GetName() string {
+	return "jobSpecVersions"
+}
+
+// NewJobSpecVersionResource constructs a new JobSpecVersionResource.
+func NewJobSpecVersionResource(v job.SpecVersion) *JobSpecVersionResource {
+	return &JobSpecVersionResource{
+		JAID:      NewJAIDInt32(v.Version),
+		JobID:     strconv.Itoa(int(v.JobID)),
+		TOML:      v.TOML,
+		CreatedAt: v.CreatedAt,
+	}
+}
+
+// NewJobSpecVersionResources constructs a slice of JobSpecVersionResources.
+func NewJobSpecVersionResources(versions []job.SpecVersion) []JobSpecVersionResource {
+	rs := []JobSpecVersionResource{}
+	for _, v := range versions {
+		rs = append(rs, *NewJobSpecVersionResource(v))
+	}
+	return rs
+}
+
+// JobSpecDiffResource represents the unified diff between two versions of a
+// job spec. Its ID is the job ID.
+type JobSpecDiffResource struct {
+	JAID
+	From int32  `json:"from"`
+	To   int32  `json:"to"`
+	Diff string `json:"diff"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobSpecDiffResource) GetName() string {
+	return "jobSpecDiffs"
+}
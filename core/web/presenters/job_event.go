@@ -0,0 +1,55 @@
+package presenters
+
+import (
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+// JobEventTypeSpecError is the type of the events streamed when a job records an error.
+const JobEventTypeSpecError = "jobSpecError"
+
+// JobEvent is a message of the job events stream. Type is either a pipeline.RunEventType, with Run set, or
+// JobEventTypeSpecError, with Error set.
+type JobEvent struct {
+	Type  string       `json:"type"`
+	JobID int32        `json:"jobID"`
+	Run   *JobRunEvent `json:"run,omitempty"`
+	Error *JobError    `json:"error,omitempty"`
+}
+
+// JobRunEvent describes the run of a JobEvent.
+type JobRunEvent struct {
+	ID          int64     `json:"id"`
+	State       string    `json:"state"`
+	FatalErrors []*string `json:"fatalErrors"`
+	CreatedAt   time.Time `json:"createdAt"`
+	FinishedAt  null.Time `json:"finishedAt"`
+}
+
+func NewRunJobEvent(e pipeline.RunEvent) JobEvent {
+	run := pipeline.Run{FatalErrors: e.FatalErrors}
+	return JobEvent{
+		Type:  string(e.Type),
+		JobID: e.JobID,
+		Run: &JobRunEvent{
+			ID:          e.RunID,
+			State:       string(e.State),
+			FatalErrors: run.StringFatalErrors(),
+			CreatedAt:   e.CreatedAt,
+			FinishedAt:  e.FinishedAt,
+		},
+	}
+}
+
+func NewSpecErrorJobEvent(e job.SpecError) JobEvent {
+	jobErr := NewJobError(e)
+	return JobEvent{
+		Type:  JobEventTypeSpecError,
+		JobID: e.JobID,
+		Error: &jobErr,
+	}
+}
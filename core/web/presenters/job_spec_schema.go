@@ -0,0 +1,30 @@
+package presenters
+
+import (
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+// JobSpecSchemaResource represents the schema of the TOML specs of a job type JSONAPI resource. Its ID is the job
+// type.
+type JobSpecSchemaResource struct {
+	JAID
+	Fields []job.SpecField `json:"fields"`
+	// PluginConfigs are the fields of the pluginConfig of the OCR2 specs, by plugin type.
+	PluginConfigs map[types.OCR2PluginType][]job.SpecField `json:"pluginConfigs,omitempty"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (JobSpecSchemaResource) GetName() string {
+	return "jobSpecSchemas"
+}
+
+// NewJobSpecSchemaResource returns a new JobSpecSchemaResource for the schema.
+func NewJobSpecSchemaResource(schema job.SpecSchema, pluginConfigs map[types.OCR2PluginType][]job.SpecField) JobSpecSchemaResource {
+	return JobSpecSchemaResource{
+		JAID:          NewJAID(string(schema.Type)),
+		Fields:        schema.Fields,
+		PluginConfigs: pluginConfigs,
+	}
+}
@@ -120,6 +120,9 @@ func TestJob(t *testing.T) {
 						"fluxMonitorSpec": null,
 						"gasLimit": 1000,
 						"forwardingAllowed": false,
+						"maxSuccessfulRuns": null,
+						"runRetention": "0s",
+						"erroredRunRetention": "0s",
 						"keeperSpec": null,
                         "cronSpec": null,
                         "vrfSpec": null,
@@ -131,6 +134,7 @@ func TestJob(t *testing.T) {
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
 						"ccipSpec": null,
+						"paused": false,
 						"errors": []
 					}
 				}
@@ -196,6 +200,9 @@ func TestJob(t *testing.T) {
 						},
 						"gasLimit": null,
 						"forwardingAllowed": false,
+						"maxSuccessfulRuns": null,
+						"runRetention": "0s",
+						"erroredRunRetention": "0s",
 						"offChainReportingOracleSpec": null,
 						"offChainReporting2OracleSpec": null,
 						"directRequestSpec": null,
@@ -210,6 +217,7 @@ func TestJob(t *testing.T) {
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
 						"ccipSpec": null,
+						"paused": false,
 						"errors": []
 					}
 				}
@@ -287,6 +295,9 @@ func TestJob(t *testing.T) {
 						"fluxMonitorSpec": null,
 						"gasLimit": 123,
 						"forwardingAllowed": true,
+						"maxSuccessfulRuns": null,
+						"runRetention": "0s",
+						"erroredRunRetention": "0s",
 						"directRequestSpec": null,
 						"keeperSpec": null,
                         "cronSpec": null,
@@ -299,6 +310,7 @@ func TestJob(t *testing.T) {
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
 						"ccipSpec": null,
+						"paused": false,
 						"errors": []
 					}
 				}
@@ -351,6 +363,9 @@ func TestJob(t *testing.T) {
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
 						"forwardingAllowed": false,
+						"maxSuccessfulRuns": null,
+						"runRetention": "0s",
+						"erroredRunRetention": "0s",
 						"directRequestSpec": null,
 						"cronSpec": null,
 						"webhookSpec": null,
@@ -365,6 +380,7 @@ func TestJob(t *testing.T) {
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
 						"ccipSpec": null,
+						"paused": false,
 						"errors": []
 					}
 				}
@@ -408,6 +424,10 @@ func TestJob(t *testing.T) {
                         },
                         "cronSpec": {
                             "schedule": "%s",
+                            "jitter": "0s",
+                            "catchUp": "",
+                            "preventOverlap": false,
+                            "lastRunAt": null,
                             "createdAt":"2000-01-01T00:00:00Z",
                             "updatedAt":"2000-01-01T00:00:00Z",
                             "evmChainID":"42"
@@ -415,6 +435,9 @@ func TestJob(t *testing.T) {
                         "fluxMonitorSpec": null,
 						"gasLimit": null,
 						"forwardingAllowed": false,
+						"maxSuccessfulRuns": null,
+						"runRetention": "0s",
+						"erroredRunRetention": "0s",
                         "directRequestSpec": null,
                         "keeperSpec": null,
                         "offChainReportingOracleSpec": null,
@@ -428,6 +451,7 @@ func TestJob(t *testing.T) {
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
 						"ccipSpec": null,
+                        "paused": false,
                         "errors": []
                     }
                 }
@@ -475,6 +499,9 @@ func TestJob(t *testing.T) {
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
 						"forwardingAllowed": false,
+						"maxSuccessfulRuns": null,
+						"runRetention": "0s",
+						"erroredRunRetention": "0s",
 						"directRequestSpec": null,
 						"keeperSpec": null,
 						"cronSpec": null,
@@ -487,6 +514,7 @@ func TestJob(t *testing.T) {
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
 						"ccipSpec": null,
+						"paused": false,
 						"errors": []
 					}
 				}
@@ -537,6 +565,9 @@ func TestJob(t *testing.T) {
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
 						"forwardingAllowed": false,
+						"maxSuccessfulRuns": null,
+						"runRetention": "0s",
+						"erroredRunRetention": "0s",
 						"cronSpec": null,
 						"offChainReportingOracleSpec": null,
 						"offChainReporting2OracleSpec": null,
@@ -557,6 +588,7 @@ func TestJob(t *testing.T) {
 							"requestTimeout":                "0s",
 							"chunkSize":                     25,
 							"batchFulfillmentGasMultiplier": 1,
+							"batchFulfillmentMaxWaitBlocks": 0,
 							"backoffInitialDelay":           "0s",
 							"backoffMaxDelay":               "0s",
 							"gasLanePrice":                  "200 gwei"
@@ -575,6 +607,7 @@ func TestJob(t *testing.T) {
 						"standardCapabilitiesSpec": null,
 						"standardCapabilitiesSpec": null,
 						"ccipSpec": null,
+						"paused": false,
 						"errors": []
 					}
 				}
@@ -624,6 +657,9 @@ func TestJob(t *testing.T) {
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
 						"forwardingAllowed": false,
+						"maxSuccessfulRuns": null,
+						"runRetention": "0s",
+						"erroredRunRetention": "0s",
 						"cronSpec": null,
 						"offChainReportingOracleSpec": null,
 						"offChainReporting2OracleSpec": null,
@@ -658,6 +694,7 @@ func TestJob(t *testing.T) {
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
 						"ccipSpec": null,
+						"paused": false,
 						"errors": []
 					}
 				}
@@ -707,6 +744,9 @@ func TestJob(t *testing.T) {
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
 						"forwardingAllowed": false,
+						"maxSuccessfulRuns": null,
+						"runRetention": "0s",
+						"erroredRunRetention": "0s",
 						"cronSpec": null,
 						"offChainReportingOracleSpec": null,
 						"offChainReporting2OracleSpec": null,
@@ -741,6 +781,7 @@ func TestJob(t *testing.T) {
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
 						"ccipSpec": null,
+						"paused": false,
 						"errors": []
 					}
 				}
@@ -780,6 +821,9 @@ func TestJob(t *testing.T) {
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
 						"forwardingAllowed": false,
+						"maxSuccessfulRuns": null,
+						"runRetention": "0s",
+						"erroredRunRetention": "0s",
 						"cronSpec": null,
 						"offChainReportingOracleSpec": null,
 						"offChainReporting2OracleSpec": null,
@@ -808,6 +852,7 @@ func TestJob(t *testing.T) {
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
 						"ccipSpec": null,
+						"paused": false,
 						"errors": []
 					}
 				}
@@ -847,6 +892,9 @@ func TestJob(t *testing.T) {
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
 						"forwardingAllowed": false,
+						"maxSuccessfulRuns": null,
+						"runRetention": "0s",
+						"erroredRunRetention": "0s",
 						"cronSpec": null,
 						"offChainReportingOracleSpec": null,
 						"offChainReporting2OracleSpec": null,
@@ -872,6 +920,7 @@ func TestJob(t *testing.T) {
 							"jobID": 0,
 							"dotDagSource": ""
 						},
+						"paused": false,
 						"errors": []
 					}
 				}
@@ -912,6 +961,9 @@ func TestJob(t *testing.T) {
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
 						"forwardingAllowed": false,
+						"maxSuccessfulRuns": null,
+						"runRetention": "0s",
+						"erroredRunRetention": "0s",
 						"cronSpec": null,
 						"offChainReportingOracleSpec": null,
 						"offChainReporting2OracleSpec": null,
@@ -937,6 +989,7 @@ func TestJob(t *testing.T) {
 							"jobID": 0,
 							"dotDagSource": ""
 						},
+						"paused": false,
 						"errors": []
 					}
 				}
@@ -975,6 +1028,9 @@ func TestJob(t *testing.T) {
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
 						"forwardingAllowed": false,
+						"maxSuccessfulRuns": null,
+						"runRetention": "0s",
+						"erroredRunRetention": "0s",
 						"cronSpec": null,
 						"offChainReportingOracleSpec": null,
 						"offChainReporting2OracleSpec": null,
@@ -998,6 +1054,7 @@ func TestJob(t *testing.T) {
 							"jobID": 0,
 							"dotDagSource": ""
 						},
+						"paused": false,
 						"errors": []
 					}
 				}
@@ -1038,6 +1095,9 @@ func TestJob(t *testing.T) {
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
 						"forwardingAllowed": false,
+						"maxSuccessfulRuns": null,
+						"runRetention": "0s",
+						"erroredRunRetention": "0s",
 						"cronSpec": null,
 						"offChainReportingOracleSpec": null,
 						"offChainReporting2OracleSpec": null,
@@ -1063,6 +1123,7 @@ func TestJob(t *testing.T) {
 							"jobID": 0,
 							"dotDagSource": ""
 						},
+						"paused": false,
 						"errors": []
 					}
 				}
@@ -1125,6 +1186,9 @@ func TestJob(t *testing.T) {
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
 						"forwardingAllowed": false,
+						"maxSuccessfulRuns": null,
+						"runRetention": "0s",
+						"erroredRunRetention": "0s",
 						"directRequestSpec": null,
 						"cronSpec": null,
 						"webhookSpec": null,
@@ -1138,6 +1202,7 @@ func TestJob(t *testing.T) {
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
 						"ccipSpec": null,
+						"paused": false,
 						"errors": [{
 							"id": 200,
 							"description": "some error",
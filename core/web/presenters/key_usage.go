@@ -0,0 +1,39 @@
+package presenters
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+)
+
+// KeyUsageResource represents an entry of the key usage audit log JSONAPI resource.
+type KeyUsageResource struct {
+	JAID
+	KeyType       string    `json:"keyType"`
+	KeyID         string    `json:"keyID"`
+	Service       string    `json:"service"`
+	JobID         *int32    `json:"jobID"`
+	PayloadDigest string    `json:"payloadDigest"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (KeyUsageResource) GetName() string {
+	return "keyUsages"
+}
+
+// NewKeyUsageResource constructs a new KeyUsageResource
+func NewKeyUsageResource(usage keystore.KeyUsage) *KeyUsageResource {
+	return &KeyUsageResource{
+		JAID:          NewJAID(strconv.FormatInt(usage.ID, 10)),
+		KeyType:       usage.KeyType,
+		KeyID:         usage.KeyID,
+		Service:       usage.Service,
+		JobID:         usage.JobID,
+		PayloadDigest: hexutil.Encode(usage.PayloadDigest),
+		CreatedAt:     usage.CreatedAt,
+	}
+}
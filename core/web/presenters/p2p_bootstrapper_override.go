@@ -0,0 +1,43 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
+)
+
+// P2PBootstrapperOverrideResource represents the bootstrapper override of a DON JSONAPI resource.
+type P2PBootstrapperOverrideResource struct {
+	JAID
+	ChainID    string    `json:"chainID"`
+	ContractID string    `json:"contractID"`
+	Added      []string  `json:"added"`
+	Removed    []string  `json:"removed"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (P2PBootstrapperOverrideResource) GetName() string {
+	return "p2pBootstrapperOverrides"
+}
+
+// NewP2PBootstrapperOverrideResource returns a new P2PBootstrapperOverrideResource for the override.
+func NewP2PBootstrapperOverrideResource(o ocrcommon.BootstrapperOverride) P2PBootstrapperOverrideResource {
+	return P2PBootstrapperOverrideResource{
+		JAID:       NewJAID(o.ChainID + "/" + o.ContractID),
+		ChainID:    o.ChainID,
+		ContractID: o.ContractID,
+		Added:      o.Added,
+		Removed:    o.Removed,
+		UpdatedAt:  o.UpdatedAt,
+	}
+}
+
+// NewP2PBootstrapperOverrideResources returns a slice of P2PBootstrapperOverrideResources.
+func NewP2PBootstrapperOverrideResources(overrides []ocrcommon.BootstrapperOverride) []P2PBootstrapperOverrideResource {
+	rs := []P2PBootstrapperOverrideResource{}
+	for _, o := range overrides {
+		rs = append(rs, NewP2PBootstrapperOverrideResource(o))
+	}
+	return rs
+}
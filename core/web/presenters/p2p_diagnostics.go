@@ -0,0 +1,70 @@
+package presenters
+
+import (
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
+)
+
+// P2PDiagnosticsResource represents the state of the OCR peer JSONAPI resource.
+type P2PDiagnosticsResource struct {
+	JAID
+	PeerID             string          `json:"peerId"`
+	AnnounceAddresses  []string        `json:"announceAddresses"`
+	DiscoveryTableSize int             `json:"discoveryTableSize"`
+	ConnectedPeers     int             `json:"connectedPeers"`
+	Peers              []P2PPeerStatus `json:"peers"`
+}
+
+// P2PPeerStatus is the state of a remote peer of the OCR peer.
+type P2PPeerStatus struct {
+	PeerID            string          `json:"peerId"`
+	Connected         bool            `json:"connected"`
+	Metrics           []P2PPeerMetric `json:"metrics"`
+	DialFailures      uint64          `json:"dialFailures"`
+	LastDialError     string          `json:"lastDialError"`
+	LastDialFailureAt null.Time       `json:"lastDialFailureAt"`
+}
+
+// P2PPeerMetric is a networking metric of a remote peer, with its rate per second.
+type P2PPeerMetric struct {
+	Name  string  `json:"name"`
+	Value float64 `json:"value"`
+	Rate  float64 `json:"rate"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (P2PDiagnosticsResource) GetName() string {
+	return "p2pDiagnostics"
+}
+
+// NewP2PDiagnosticsResource returns a new P2PDiagnosticsResource for the diagnostics of the peer.
+func NewP2PDiagnosticsResource(d ocrcommon.PeerWrapperDiagnostics) *P2PDiagnosticsResource {
+	r := &P2PDiagnosticsResource{
+		JAID:               NewJAID(d.PeerID.String()),
+		PeerID:             d.PeerID.String(),
+		AnnounceAddresses:  d.AnnounceAddresses,
+		DiscoveryTableSize: d.DiscoveryTableSize,
+		Peers:              []P2PPeerStatus{},
+	}
+	for _, p := range d.Peers {
+		status := P2PPeerStatus{
+			PeerID:        p.PeerID,
+			Connected:     p.Connected,
+			Metrics:       []P2PPeerMetric{},
+			DialFailures:  p.DialFailures,
+			LastDialError: p.LastDialError,
+		}
+		if !p.LastDialFailureAt.IsZero() {
+			status.LastDialFailureAt = null.TimeFrom(p.LastDialFailureAt)
+		}
+		for _, m := range p.Metrics {
+			status.Metrics = append(status.Metrics, P2PPeerMetric{Name: m.Name, Value: m.Value, Rate: m.Rate})
+		}
+		if p.Connected {
+			r.ConnectedPeers++
+		}
+		r.Peers = append(r.Peers, status)
+	}
+	return r
+}
@@ -0,0 +1,51 @@
+package presenters
+
+import (
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/reports"
+)
+
+// ReportResource represents a report generated in the background.
+type ReportResource struct {
+	JAID
+	Type       reports.Type   `json:"type"`
+	Params     reports.Params `json:"params"`
+	State      reports.State  `json:"state"`
+	Error      null.String    `json:"error"`
+	Rows       null.Int       `json:"rows"`
+	CreatedAt  time.Time      `json:"createdAt"`
+	StartedAt  null.Time      `json:"startedAt"`
+	FinishedAt null.Time      `json:"finishedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r ReportResource) GetName() string {
+	return "reports"
+}
+
+// NewReportResource constructs a new ReportResource.
+func NewReportResource(r reports.Report) *ReportResource {
+	return &ReportResource{
+		JAID:       NewJAIDInt64(r.ID),
+		Type:       r.Type,
+		Params:     r.Params,
+		State:      r.State,
+		Error:      r.Error,
+		Rows:       r.Rows,
+		CreatedAt:  r.CreatedAt,
+		StartedAt:  r.StartedAt,
+		FinishedAt: r.FinishedAt,
+	}
+}
+
+// NewReportResources constructs a slice of ReportResources.
+func NewReportResources(rs []reports.Report) []ReportResource {
+	res := []ReportResource{}
+	for _, r := range rs {
+		res = append(res, *NewReportResource(r))
+	}
+	return res
+}
@@ -0,0 +1,29 @@
+package presenters
+
+import "github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/reportkey"
+
+// ReportKeyResource represents a report encryption key JSONAPI resource. The ID is a fingerprint of the shared
+// secret, so that the nodes sharing a key can check they hold the same one.
+type ReportKeyResource struct {
+	JAID
+}
+
+// GetName implements the api2go EntityNamer interface
+func (ReportKeyResource) GetName() string {
+	return "encryptedReportKeys"
+}
+
+func NewReportKeyResource(key reportkey.Key) *ReportKeyResource {
+	return &ReportKeyResource{
+		JAID: JAID{ID: key.ID()},
+	}
+}
+
+func NewReportKeyResources(keys []reportkey.Key) []ReportKeyResource {
+	rs := []ReportKeyResource{}
+	for _, key := range keys {
+		rs = append(rs, *NewReportKeyResource(key))
+	}
+
+	return rs
+}
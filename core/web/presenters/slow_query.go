@@ -0,0 +1,44 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+)
+
+// SlowQueryResource is the telemetry of the slow queries sharing a fingerprint JSONAPI resource.
+type SlowQueryResource struct {
+	JAID
+	Query           string    `json:"query"`
+	Calls           int64     `json:"calls"`
+	Timeouts        int64     `json:"timeouts"`
+	TotalDurationMS int64     `json:"totalDurationMS"`
+	MaxDurationMS   int64     `json:"maxDurationMS"`
+	MeanDurationMS  int64     `json:"meanDurationMS"`
+	FirstSeenAt     time.Time `json:"firstSeenAt"`
+	LastSeenAt      time.Time `json:"lastSeenAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r SlowQueryResource) GetName() string {
+	return "slowQueries"
+}
+
+// NewSlowQueryResource returns a new SlowQueryResource for the slow queries of a fingerprint.
+func NewSlowQueryResource(q pg.SlowQuery) SlowQueryResource {
+	var mean time.Duration
+	if q.Calls > 0 {
+		mean = q.TotalDuration / time.Duration(q.Calls)
+	}
+	return SlowQueryResource{
+		JAID:            NewJAID(q.Fingerprint),
+		Query:           q.Query,
+		Calls:           q.Calls,
+		Timeouts:        q.Timeouts,
+		TotalDurationMS: q.TotalDuration.Milliseconds(),
+		MaxDurationMS:   q.MaxDuration.Milliseconds(),
+		MeanDurationMS:  mean.Milliseconds(),
+		FirstSeenAt:     q.FirstSeenAt,
+		LastSeenAt:      q.LastSeenAt,
+	}
+}
@@ -0,0 +1,51 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/tlskey"
+)
+
+// TLSKeyResource represents a TLS key JSONAPI resource.
+type TLSKeyResource struct {
+	JAID
+	PubKey      string    `json:"publicKey"`
+	Subject     string    `json:"subject"`
+	Issuer      string    `json:"issuer"`
+	DNSNames    []string  `json:"dnsNames"`
+	NotBefore   time.Time `json:"notBefore"`
+	NotAfter    time.Time `json:"notAfter"`
+	SelfSigned  bool      `json:"selfSigned"`
+	Certificate string    `json:"certificate"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (TLSKeyResource) GetName() string {
+	return "encryptedTLSKeys"
+}
+
+func NewTLSKeyResource(key tlskey.Key) *TLSKeyResource {
+	cert := key.Certificate()
+	r := &TLSKeyResource{
+		JAID:        JAID{ID: key.ID()},
+		PubKey:      key.PublicKeyStr(),
+		Subject:     cert.Subject.String(),
+		Issuer:      cert.Issuer.String(),
+		DNSNames:    cert.DNSNames,
+		NotBefore:   cert.NotBefore,
+		NotAfter:    cert.NotAfter,
+		SelfSigned:  key.SelfSigned(),
+		Certificate: string(key.CertificatePEM()),
+	}
+
+	return r
+}
+
+func NewTLSKeyResources(keys []tlskey.Key) []TLSKeyResource {
+	rs := []TLSKeyResource{}
+	for _, key := range keys {
+		rs = append(rs, *NewTLSKeyResource(key))
+	}
+
+	return rs
+}
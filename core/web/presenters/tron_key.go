@@ -0,0 +1,34 @@
+package presenters
+
+import "github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/tronkey"
+
+// TronKeyResource represents a Tron key JSONAPI resource.
+type TronKeyResource struct {
+	JAID
+	Address string `json:"address"`
+	PubKey  string `json:"publicKey"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (TronKeyResource) GetName() string {
+	return "encryptedTronKeys"
+}
+
+func NewTronKeyResource(key tronkey.Key) *TronKeyResource {
+	r := &TronKeyResource{
+		JAID:    JAID{ID: key.ID()},
+		Address: key.Base58Address(),
+		PubKey:  key.PublicKeyStr(),
+	}
+
+	return r
+}
+
+func NewTronKeyResources(keys []tronkey.Key) []TronKeyResource {
+	rs := []TronKeyResource{}
+	for _, key := range keys {
+		rs = append(rs, *NewTronKeyResource(key))
+	}
+
+	return rs
+}
@@ -0,0 +1,12 @@
+package web
+
+import (
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/reportkey"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func NewReportKeysController(app chainlink.Application) KeysController {
+	return NewKeysController[reportkey.Key, presenters.ReportKeyResource](app.GetKeyStore().Report(), app.GetLogger(), app.GetAuditLogger(),
+		"reportKey", presenters.NewReportKeyResource, presenters.NewReportKeyResources)
+}
@@ -0,0 +1,91 @@
+package web_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestReportKeysController_Index_HappyPath(t *testing.T) {
+	t.Parallel()
+
+	client, keyStore := setupReportKeysControllerTests(t)
+	keys, _ := keyStore.Report().GetAll()
+
+	response, cleanup := client.Get("/v2/keys/report")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	resources := []presenters.ReportKeyResource{}
+	err := web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resources)
+	assert.NoError(t, err)
+
+	require.Len(t, resources, len(keys))
+
+	assert.Equal(t, keys[0].ID(), resources[0].ID)
+}
+
+func TestReportKeysController_Create_HappyPath(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+	keyStore := app.GetKeyStore()
+
+	response, cleanup := client.Post("/v2/keys/report", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	keys, _ := keyStore.Report().GetAll()
+	require.Len(t, keys, 1)
+
+	resource := presenters.ReportKeyResource{}
+	err := web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource)
+	assert.NoError(t, err)
+
+	assert.Equal(t, keys[0].ID(), resource.ID)
+}
+
+func TestReportKeysController_Delete_HappyPath(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	client, keyStore := setupReportKeysControllerTests(t)
+
+	keys, _ := keyStore.Report().GetAll()
+	initialLength := len(keys)
+	key, _ := keyStore.Report().Create(ctx)
+
+	response, cleanup := client.Delete(fmt.Sprintf("/v2/keys/report/%s", key.ID()))
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Error(t, utils.JustError(keyStore.Report().Get(key.ID())))
+
+	keys, _ = keyStore.Report().GetAll()
+	assert.Equal(t, initialLength, len(keys))
+}
+
+func setupReportKeysControllerTests(t *testing.T) (cltest.HTTPClientCleaner, keystore.Master) {
+	t.Helper()
+	ctx := testutils.Context(t)
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+	_, err := app.GetKeyStore().Report().Create(ctx)
+	require.NoError(t, err)
+
+	client := app.NewHTTPClient(nil)
+
+	return client, app.GetKeyStore()
+}
@@ -0,0 +1,118 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/reports"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// ReportsController manages the reports generated in the background for expensive queries.
+type ReportsController struct {
+	App chainlink.Application
+}
+
+// CreateReportRequest is the request to submit a report.
+type CreateReportRequest struct {
+	Type   reports.Type   `json:"type"`
+	Params reports.Params `json:"params"`
+}
+
+// Index lists the reports, newest first.
+// Example:
+// "GET <application>/reports"
+func (rc *ReportsController) Index(c *gin.Context, size, page, offset int) {
+	rs, count, err := rc.App.GetReportRunner().ORM().ListReports(c.Request.Context(), offset, size)
+	paginatedResponse(c, "reports", size, page, presenters.NewReportResources(rs), count, err)
+}
+
+// Create submits a report, to be generated in the background.
+// Example:
+// "POST <application>/reports"
+func (rc *ReportsController) Create(c *gin.Context) {
+	var req CreateReportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	report, err := rc.App.GetReportRunner().Submit(c.Request.Context(), req.Type, req.Params)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, presenters.NewReportResource(report), "reports", http.StatusAccepted)
+}
+
+// Show returns the state of a report.
+// Example:
+// "GET <application>/reports/:ID"
+func (rc *ReportsController) Show(c *gin.Context) {
+	report, ok := rc.findReport(c)
+	if !ok {
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewReportResource(report), "reports")
+}
+
+// Download returns the CSV result of a completed report.
+// Example:
+// "GET <application>/reports/:ID/download"
+func (rc *ReportsController) Download(c *gin.Context) {
+	report, ok := rc.findReport(c)
+	if !ok {
+		return
+	}
+	if report.State != reports.StateCompleted {
+		jsonAPIError(c, http.StatusConflict, fmt.Errorf("report %d is %s", report.ID, report.State))
+		return
+	}
+	result, err := rc.App.GetReportRunner().ORM().ReportResult(c.Request.Context(), report.ID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="report-%d-%s.csv"`, report.ID, report.Type))
+	c.Data(http.StatusOK, "text/csv", result)
+}
+
+// Delete deletes a report and its result.
+// Example:
+// "DELETE <application>/reports/:ID"
+func (rc *ReportsController) Delete(c *gin.Context) {
+	report, ok := rc.findReport(c)
+	if !ok {
+		return
+	}
+	if err := rc.App.GetReportRunner().ORM().DeleteReport(c.Request.Context(), report.ID); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "reports", http.StatusNoContent)
+}
+
+func (rc *ReportsController) findReport(c *gin.Context) (reports.Report, bool) {
+	id, err := strconv.ParseInt(c.Param("ID"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return reports.Report{}, false
+	}
+	report, err := rc.App.GetReportRunner().ORM().FindReport(c.Request.Context(), id)
+	if errors.Is(err, reports.ErrNotFound) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return reports.Report{}, false
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return reports.Report{}, false
+	}
+	return report, true
+}
@@ -0,0 +1,74 @@
+package web_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/services/reports"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestReportsController(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	jb, _ := cltest.MustInsertWebhookSpec(t, app.GetDB())
+	run := cltest.MustInsertPipelineRunWithStatus(t, app.GetDB(), jb.PipelineSpecID, pipeline.RunStatusCompleted, jb.ID)
+
+	resp, cleanup := client.Post("/v2/reports", strings.NewReader(`{"type": "pipeline_runs"}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+
+	resp, cleanup = client.Post("/v2/reports", strings.NewReader(fmt.Sprintf(`{"type": "pipeline_runs", "params": {"jobID": %d}}`, jb.ID)))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusAccepted)
+	var report presenters.ReportResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &report))
+	assert.Equal(t, reports.TypePipelineRuns, report.Type)
+	assert.Equal(t, jb.ID, report.Params.JobID)
+
+	require.Eventually(t, func() bool {
+		resp, cleanup := client.Get("/v2/reports/" + report.ID)
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &report))
+		return report.State == reports.StateCompleted
+	}, testutils.WaitTimeout(t), 100*time.Millisecond)
+	assert.Equal(t, int64(1), report.Rows.Int64)
+
+	resp, cleanup = client.Get("/v2/reports/" + report.ID + "/download")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	assert.Equal(t, "text/csv", resp.Header.Get("Content-Type"))
+	b, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(b), fmt.Sprintf("\n%d,%d,completed,", run.ID, jb.ID))
+
+	resp, cleanup = client.Get("/v2/reports")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var list []presenters.ReportResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &list))
+	require.Len(t, list, 1)
+
+	resp, cleanup = client.Delete("/v2/reports/" + report.ID)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+
+	resp, cleanup = client.Get("/v2/reports/" + report.ID + "/download")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
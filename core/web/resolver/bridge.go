@@ -54,6 +54,15 @@ func (r *BridgeResolver) MinimumContractPayment() string {
 	return r.bridge.MinimumContractPayment.String()
 }
 
+// ResponsePublicKey resolves the bridge's response public key.
+func (r *BridgeResolver) ResponsePublicKey() *string {
+	if len(r.bridge.ResponsePublicKey) == 0 {
+		return nil
+	}
+	key := r.bridge.ResponsePublicKey.String()
+	return &key
+}
+
 // CreatedAt resolves the bridge's created at field.
 func (r *BridgeResolver) CreatedAt() graphql.Time {
 	return graphql.Time{Time: r.bridge.CreatedAt}
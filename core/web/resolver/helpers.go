@@ -85,6 +85,9 @@ func ValidateBridgeType(bt *bridges.BridgeTypeRequest) error {
 		bt.MinimumContractPayment.Cmp(assets.NewLinkFromJuels(0)) < 0 {
 		return errors.New("MinimumContractPayment must be positive")
 	}
+	if err := bridges.ValidateResponsePublicKey(bt.ResponsePublicKey); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -59,6 +59,7 @@ type createBridgeInput struct {
 	URL                    string
 	Confirmations          int32
 	MinimumContractPayment string
+	ResponsePublicKey      *string
 }
 
 // CreateBridge creates a new bridge.
@@ -79,12 +80,21 @@ func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBr
 	if err := minContractPayment.UnmarshalText([]byte(args.Input.MinimumContractPayment)); err != nil {
 		return nil, err
 	}
+	var responsePublicKey crypto.PublicKey
+	if args.Input.ResponsePublicKey != nil {
+		key, err := crypto.PublicKeyFromHex(*args.Input.ResponsePublicKey)
+		if err != nil {
+			return nil, err
+		}
+		responsePublicKey = *key
+	}
 
 	btr := &bridges.BridgeTypeRequest{
 		Name:                   bridges.BridgeName(args.Input.Name),
 		URL:                    webURL,
 		Confirmations:          uint32(args.Input.Confirmations),
 		MinimumContractPayment: minContractPayment,
+		ResponsePublicKey:      responsePublicKey,
 	}
 
 	bta, bt, err := bridges.NewBridgeType(btr)
@@ -452,6 +462,7 @@ type updateBridgeInput struct {
 	URL                    string
 	Confirmations          int32
 	MinimumContractPayment string
+	ResponsePublicKey      *string
 }
 
 func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
@@ -474,12 +485,21 @@ func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 	if err := minContractPayment.UnmarshalText([]byte(args.Input.MinimumContractPayment)); err != nil {
 		return nil, err
 	}
+	var responsePublicKey crypto.PublicKey
+	if args.Input.ResponsePublicKey != nil {
+		key, err := crypto.PublicKeyFromHex(*args.Input.ResponsePublicKey)
+		if err != nil {
+			return nil, err
+		}
+		responsePublicKey = *key
+	}
 
 	btr := &bridges.BridgeTypeRequest{
 		Name:                   bridges.BridgeName(args.Input.Name),
 		URL:                    webURL,
 		Confirmations:          uint32(args.Input.Confirmations),
 		MinimumContractPayment: minContractPayment,
+		ResponsePublicKey:      responsePublicKey,
 	}
 
 	taskType, err := bridges.ParseBridgeName(string(args.ID))
@@ -141,6 +141,24 @@ func (r *CronSpecResolver) Schedule() string {
 	return r.spec.CronSchedule
 }
 
+// Jitter resolves the spec's jitter.
+func (r *CronSpecResolver) Jitter() string {
+	return r.spec.Jitter.Duration().String()
+}
+
+// CatchUp resolves the spec's catch-up policy.
+func (r *CronSpecResolver) CatchUp() string {
+	if r.spec.CatchUp == "" {
+		return string(job.CronCatchUpSkip)
+	}
+	return string(r.spec.CatchUp)
+}
+
+// PreventOverlap resolves the spec's overlap protection.
+func (r *CronSpecResolver) PreventOverlap() bool {
+	return r.spec.PreventOverlap
+}
+
 // EVMChainID resolves the spec's evm chain id.
 func (r *CronSpecResolver) EVMChainID() *string {
 	if r.spec.EVMChainID == nil {
@@ -484,6 +502,28 @@ func (r *OCR2SpecResolver) BlockchainTimeout() *string {
 	return &timeout
 }
 
+// ObservationTimeout resolves the spec's local observation deadline.
+func (r *OCR2SpecResolver) ObservationTimeout() *string {
+	if r.spec.ObservationTimeout.Duration() == 0 {
+		return nil
+	}
+
+	timeout := r.spec.ObservationTimeout.Duration().String()
+
+	return &timeout
+}
+
+// ReportTimeout resolves the spec's local report deadline.
+func (r *OCR2SpecResolver) ReportTimeout() *string {
+	if r.spec.ReportTimeout.Duration() == 0 {
+		return nil
+	}
+
+	timeout := r.spec.ReportTimeout.Duration().String()
+
+	return &timeout
+}
+
 // ContractID resolves the spec's contract address.
 func (r *OCR2SpecResolver) ContractID() string {
 	return r.spec.ContractID
@@ -576,6 +616,21 @@ func (r *OCR2SpecResolver) TransmitterID() *string {
 	return &addr
 }
 
+// Standby resolves whether the spec is held in standby.
+func (r *OCR2SpecResolver) Standby() bool {
+	return r.spec.Standby
+}
+
+// TransmissionSchedule resolves the spec's transmission schedule
+func (r *OCR2SpecResolver) TransmissionSchedule() gqlscalar.Map {
+	return gqlscalar.Map(r.spec.TransmissionSchedule)
+}
+
+// ReportEncryptionKeyID resolves the spec's report encryption key ID
+func (r *OCR2SpecResolver) ReportEncryptionKeyID() *string {
+	return r.spec.ReportEncryptionKeyID.Ptr()
+}
+
 // FeedID resolves the spec's feed ID
 func (r *OCR2SpecResolver) FeedID() *string {
 	if r.spec.FeedID == nil {
@@ -668,6 +723,11 @@ func (r *VRFSpecResolver) BatchFulfillmentGasMultiplier() float64 {
 	return float64(r.spec.BatchFulfillmentGasMultiplier)
 }
 
+// BatchFulfillmentMaxWaitBlocks resolves the spec's batch fulfillment max wait blocks.
+func (r *VRFSpecResolver) BatchFulfillmentMaxWaitBlocks() int32 {
+	return int32(r.spec.BatchFulfillmentMaxWaitBlocks)
+}
+
 // CustomRevertsPipelineEnabled resolves the spec's custom reverts pipeline enabled flag.
 func (r *VRFSpecResolver) CustomRevertsPipelineEnabled() *bool {
 	return &r.spec.CustomRevertsPipelineEnabled
@@ -41,9 +41,12 @@ func TestResolver_CronSpec(t *testing.T) {
 				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", mock.Anything, id).Return(job.Job{
 					Type: job.Cron,
 					CronSpec: &job.CronSpec{
-						CronSchedule: "CRON_TZ=UTC 0 0 1 1 *",
-						EVMChainID:   ubig.NewI(42),
-						CreatedAt:    f.Timestamp(),
+						CronSchedule:   "CRON_TZ=UTC 0 0 1 1 *",
+						Jitter:         models.Interval(30 * time.Second),
+						CatchUp:        job.CronCatchUpRunOnce,
+						PreventOverlap: true,
+						EVMChainID:     ubig.NewI(42),
+						CreatedAt:      f.Timestamp(),
 					},
 				}, nil)
 			},
@@ -55,6 +58,9 @@ func TestResolver_CronSpec(t *testing.T) {
 								__typename
 								... on CronSpec {
 									schedule
+									jitter
+									catchUp
+									preventOverlap
 									evmChainID
 									createdAt
 								}
@@ -69,6 +75,9 @@ func TestResolver_CronSpec(t *testing.T) {
 						"spec": {
 							"__typename": "CronSpec",
 							"schedule": "CRON_TZ=UTC 0 0 1 1 *",
+							"jitter": "30s",
+							"catchUp": "run-once",
+							"preventOverlap": true,
 							"evmChainID": "42",
 							"createdAt": "2021-01-01T00:00:00Z"
 						}
@@ -489,12 +498,17 @@ func TestResolver_OCR2Spec(t *testing.T) {
 						CreatedAt:                         f.Timestamp(),
 						OCRKeyBundleID:                    null.StringFrom(keyBundleID.String()),
 						MonitoringEndpoint:                null.StringFrom("https://monitor.endpoint"),
+						ObservationTimeout:                models.Interval(20 * time.Second),
 						P2PV2Bootstrappers:                pq.StringArray{"12D3KooWL3XJ9EMCyZvmmGXL2LMiVBtrVa2BuESsJiXkSj7333Jw@localhost:5001"},
 						Relay:                             relay.NetworkEVM,
 						RelayConfig:                       relayConfig,
+						ReportTimeout:                     models.Interval(5 * time.Second),
 						TransmitterID:                     null.StringFrom(transmitterAddress.String()),
 						PluginType:                        types.Median,
 						PluginConfig:                      pluginConfig,
+						Standby:                           true,
+						TransmissionSchedule:              map[string]interface{}{"strategy": "roundRobin"},
+						ReportEncryptionKeyID:             null.StringFrom("reportKeyID"),
 					},
 				}, nil)
 			},
@@ -512,12 +526,17 @@ func TestResolver_OCR2Spec(t *testing.T) {
 									createdAt
 									ocrKeyBundleID
 									monitoringEndpoint
+									observationTimeout
 									p2pv2Bootstrappers
 									relay
 									relayConfig
+									reportTimeout
 									transmitterID
 									pluginType
 									pluginConfig
+									standby
+									transmissionSchedule
+									reportEncryptionKeyID
 								}
 							}
 						}
@@ -536,16 +555,23 @@ func TestResolver_OCR2Spec(t *testing.T) {
 							"createdAt": "2021-01-01T00:00:00Z",
 							"ocrKeyBundleID": "f5bf259689b26f1374efb3c9a9868796953a0f814bb2d39b968d0e61b58620a5",
 							"monitoringEndpoint": "https://monitor.endpoint",
+							"observationTimeout": "20s",
 							"p2pv2Bootstrappers": ["12D3KooWL3XJ9EMCyZvmmGXL2LMiVBtrVa2BuESsJiXkSj7333Jw@localhost:5001"],
 							"relay": "evm",
 							"relayConfig": {
 								"chainID": 1337
 							},
+							"reportTimeout": "5s",
 							"transmitterID": "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42",
 							"pluginType": "median",
 							"pluginConfig": {
 								"juelsPerFeeCoinSource": 100000000
-							}
+							},
+							"standby": true,
+							"transmissionSchedule": {
+								"strategy": "roundRobin"
+							},
+							"reportEncryptionKeyID": "reportKeyID"
 						}
 					}
 				}
@@ -598,6 +624,7 @@ func TestResolver_VRFSpec(t *testing.T) {
 						RequestTimeout:                24 * time.Hour,
 						ChunkSize:                     25,
 						BatchFulfillmentGasMultiplier: 1,
+						BatchFulfillmentMaxWaitBlocks: 3,
 						BackoffInitialDelay:           time.Minute,
 						BackoffMaxDelay:               time.Hour,
 						GasLanePrice:                  assets.GWei(200),
@@ -623,6 +650,7 @@ func TestResolver_VRFSpec(t *testing.T) {
 									batchCoordinatorAddress
 									batchFulfillmentEnabled
 									batchFulfillmentGasMultiplier
+									batchFulfillmentMaxWaitBlocks
 									customRevertsPipelineEnabled
 									chunkSize
 									backoffInitialDelay
@@ -651,6 +679,7 @@ func TestResolver_VRFSpec(t *testing.T) {
 							"batchCoordinatorAddress": "0x0ad9FE7a58216242a8475ca92F222b0640E26B63",
 							"batchFulfillmentEnabled": true,
 							"batchFulfillmentGasMultiplier": 1,
+							"batchFulfillmentMaxWaitBlocks": 3,
 							"customRevertsPipelineEnabled": true,
 							"chunkSize": 25,
 							"backoffInitialDelay": "1m0s",
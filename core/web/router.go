@@ -87,7 +87,7 @@ func NewRouter(app chainlink.Application, prometheus *ginprom.Prometheus) (*gin.
 
 	debugRoutes(app, api)
 	healthRoutes(app, api)
-	sessionRoutes(app, api)
+	sessionRoutes(app, api, sessionStore)
 	v2Routes(app, api)
 	loopRoutes(app, api)
 
@@ -202,7 +202,7 @@ func ginHandlerFromHTTP(h http.HandlerFunc) gin.HandlerFunc {
 	}
 }
 
-func sessionRoutes(app chainlink.Application, r *gin.RouterGroup) {
+func sessionRoutes(app chainlink.Application, r *gin.RouterGroup, sessionStore sessions.Store) {
 	config := app.GetConfig()
 	rl := config.WebServer().RateLimit()
 	unauth := r.Group("/", rateLimiter(
@@ -211,12 +211,20 @@ func sessionRoutes(app chainlink.Application, r *gin.RouterGroup) {
 	))
 	sc := NewSessionsController(app)
 	unauth.POST("/sessions", sc.Create)
+
+	oc := OIDCController{app}
+	// The OIDC login is kept in its own session, next to the session created at the callback
+	oidcLogin := unauth.Group("/oidc", sessions.SessionsMany([]string{auth.SessionName, auth.OIDCLoginSessionName}, sessionStore))
+	oidcLogin.GET("/login", oc.Login)
+	oidcLogin.GET("/callback", oc.Callback)
+	unauth.POST("/oidc/backchannel-logout", oc.BackchannelLogout)
+
 	auth := r.Group("/", auth.Authenticate(app.AuthenticationProvider(), auth.AuthenticateBySession))
 	auth.DELETE("/sessions", sc.Destroy)
 }
 
 func healthRoutes(app chainlink.Application, r *gin.RouterGroup) {
-	hc := HealthController{app}
+	hc := NewHealthController(app)
 	r.GET("/readyz", hc.Readyz)
 	r.GET("/health", hc.Health)
 	r.GET("/health.txt", func(context *gin.Context) {
@@ -232,6 +240,7 @@ func loopRoutes(app chainlink.Application, r *gin.RouterGroup) {
 
 func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 	unauthedv2 := r.Group("/v2")
+	clientCertificateAuth := auth.AuthenticateByClientCertificate(auth.ClientCertificateUserTTL)
 
 	prc := PipelineRunsController{app}
 	psec := PipelineJobSpecErrorsController{app}
@@ -239,17 +248,23 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 
 	authv2 := r.Group("/v2", auth.Authenticate(app.AuthenticationProvider(),
 		auth.AuthenticateByToken,
+		auth.AuthenticateByScopedToken(app.APITokensORM()),
+		clientCertificateAuth,
 		auth.AuthenticateBySession,
-	))
+	), auth.RequiresAPITokenScope)
 	{
 		uc := UserController{app}
 		authv2.GET("/users", auth.RequiresAdminRole(uc.Index))
 		authv2.POST("/users", auth.RequiresAdminRole(uc.Create))
 		authv2.PATCH("/users", auth.RequiresAdminRole(uc.UpdateRole))
 		authv2.DELETE("/users/:email", auth.RequiresAdminRole(uc.Delete))
+		authv2.DELETE("/users/:email/sessions", auth.RequiresAdminRole(uc.RevokeSessions))
 		authv2.PATCH("/user/password", uc.UpdatePassword)
 		authv2.POST("/user/token", uc.NewAPIToken)
 		authv2.POST("/user/token/delete", uc.DeleteAPIToken)
+		authv2.GET("/user/api_tokens", uc.IndexScopedAPITokens)
+		authv2.POST("/user/api_tokens", uc.CreateScopedAPIToken)
+		authv2.DELETE("/user/api_tokens/:id", uc.DeleteScopedAPIToken)
 
 		wa := NewWebAuthnController(app)
 		authv2.GET("/enroll_webauthn", wa.BeginRegistration)
@@ -278,6 +293,11 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		cc := ConfigController{app}
 		authv2.GET("/config", cc.Show)
 		authv2.GET("/config/v2", cc.Show)
+		authv2.POST("/config/reload", auth.RequiresAdminRole(cc.Reload))
+
+		dc := DrainController{app}
+		authv2.GET("/drain", dc.Show)
+		authv2.POST("/drain", auth.RequiresAdminRole(dc.Create))
 
 		tas := TxAttemptsController{app}
 		authv2.GET("/tx_attempts", paginatedRequest(tas.Index))
@@ -285,6 +305,12 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 
 		txs := TransactionsController{app}
 		authv2.GET("/transactions/evm", paginatedRequest(txs.Index))
+		authv2.GET("/transactions/evm/stuck", paginatedRequest(txs.Stuck))
+		cbc := CircuitBreakerController{app}
+		authv2.GET("/transactions/evm/circuit_breaker", cbc.Show)
+		authv2.POST("/transactions/evm/circuit_breaker/reset", auth.RequiresAdminRole(cbc.Reset))
+		authv2.GET("/transactions/evm/idempotency_keys/:IdempotencyKey", txs.ShowByIdempotencyKey)
+		authv2.GET("/transactions/evm/job_spend/:JobID", txs.JobSpend)
 		authv2.GET("/transactions/evm/:TxHash", txs.Show)
 		authv2.GET("/transactions", paginatedRequest(txs.Index))
 		authv2.GET("/transactions/:TxHash", txs.Show)
@@ -293,6 +319,10 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.POST("/replay_from_block/:number", auth.RequiresRunRole(rc.ReplayFromBlock))
 		lcaC := LCAController{app}
 		authv2.GET("/find_lca", auth.RequiresRunRole(lcaC.FindLCA))
+		authv2.POST("/recover_finality", auth.RequiresAdminRole(lcaC.RecoverFinality))
+
+		kuc := KeyUsageController{app}
+		authv2.GET("/keys/audit_log", auth.RequiresAdminRole(paginatedRequest(kuc.Index)))
 
 		csakc := CSAKeysController{app}
 		authv2.GET("/keys/csa", csakc.Index)
@@ -311,6 +341,8 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 
 		ethKeysGroup := authv2.Group("", auth.Authenticate(app.AuthenticationProvider(),
 			auth.AuthenticateByToken,
+			auth.AuthenticateByScopedToken(app.APITokensORM()),
+			clientCertificateAuth,
 			auth.AuthenticateBySession,
 		))
 
@@ -335,6 +367,8 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.DELETE("/keys/ocr2/:keyID", auth.RequiresAdminRole(ocr2kc.Delete))
 		authv2.POST("/keys/ocr2/import", auth.RequiresAdminRole(ocr2kc.Import))
 		authv2.POST("/keys/ocr2/export/:ID", auth.RequiresAdminRole(ocr2kc.Export))
+		authv2.POST("/keys/ocr2/bundles/import", auth.RequiresAdminRole(ocr2kc.ImportBundles))
+		authv2.POST("/keys/ocr2/bundles/export", auth.RequiresAdminRole(ocr2kc.ExportBundles))
 
 		p2pkc := P2PKeysController{app}
 		authv2.GET("/keys/p2p", p2pkc.Index)
@@ -343,6 +377,10 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.POST("/keys/p2p/import", auth.RequiresAdminRole(p2pkc.Import))
 		authv2.POST("/keys/p2p/export/:ID", auth.RequiresAdminRole(p2pkc.Export))
 
+		tlskc := NewTLSKeysController(app)
+		authv2.POST("/keys/tls/:keyID/csr", auth.RequiresEditRole(tlskc.CreateCSR))
+		authv2.PUT("/keys/tls/:keyID/certificate", auth.RequiresAdminRole(tlskc.SetCertificate))
+
 		for _, keys := range []struct {
 			path string
 			kc   KeysController
@@ -351,6 +389,9 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 			{"cosmos", NewCosmosKeysController(app)},
 			{"starknet", NewStarkNetKeysController(app)},
 			{"aptos", NewAptosKeysController(app)},
+			{"tron", NewTronKeysController(app)},
+			{"report", NewReportKeysController(app)},
+			{"tls", tlskc},
 		} {
 			authv2.GET("/keys/"+keys.path, keys.kc.Index)
 			authv2.POST("/keys/"+keys.path, auth.RequiresEditRole(keys.kc.Create))
@@ -370,25 +411,69 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/jobs", paginatedRequest(jc.Index))
 		authv2.GET("/jobs/:ID", jc.Show)
 		authv2.POST("/jobs", auth.RequiresEditRole(jc.Create))
+		authv2.POST("/jobs/bulk", auth.RequiresEditRole(jc.Bulk))
+		authv2.POST("/jobs/validate", jc.Validate)
+		authv2.GET("/jobs/schemas", jc.Schemas)
+		authv2.GET("/jobs/schemas/:type", jc.Schema)
 		authv2.PUT("/jobs/:ID", auth.RequiresEditRole(jc.Update))
 		authv2.DELETE("/jobs/:ID", auth.RequiresEditRole(jc.Delete))
+		authv2.POST("/jobs/:ID/promote", auth.RequiresEditRole(jc.Promote))
+		authv2.GET("/jobs/:ID/versions", jc.Versions)
+		authv2.GET("/jobs/:ID/versions/:version/diff", jc.Diff)
+		authv2.POST("/jobs/:ID/versions/:version/rollback", auth.RequiresEditRole(jc.Rollback))
+
+		jec := JobEventsController{app}
+		authv2.GET("/jobs/events", jec.Stream)
 
 		// PipelineRunsController
 		authv2.GET("/pipeline/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs/:runID", prc.Show)
 
+		rptc := ReportsController{app}
+		authv2.GET("/reports", paginatedRequest(rptc.Index))
+		authv2.POST("/reports", auth.RequiresEditRole(rptc.Create))
+		authv2.GET("/reports/:ID", rptc.Show)
+		authv2.GET("/reports/:ID/download", rptc.Download)
+		authv2.DELETE("/reports/:ID", auth.RequiresEditRole(rptc.Delete))
+
 		// FeaturesController
 		fc := FeaturesController{app}
 		authv2.GET("/features", fc.Index)
 
+		ccipc := CCIPLanesController{app}
+		authv2.GET("/ccip/lanes", ccipc.Index)
+		authv2.GET("/ccip/lanes/discover", ccipc.Discover)
+
+		p2pdc := P2PDiagnosticsController{app}
+		authv2.GET("/p2p/diagnostics", p2pdc.Show)
+
+		p2pboc := P2PBootstrapperOverridesController{app}
+		authv2.GET("/p2p/bootstrapper_overrides", p2pboc.Index)
+		authv2.PUT("/p2p/bootstrapper_overrides/:chainID/:contractID", auth.RequiresEditRole(p2pboc.Update))
+		authv2.DELETE("/p2p/bootstrapper_overrides/:chainID/:contractID", auth.RequiresEditRole(p2pboc.Delete))
+
+		fqc := FunctionsQuotasController{app}
+		authv2.GET("/functions/quotas/:contractAddress", fqc.Index)
+		authv2.PUT("/functions/quotas/:contractAddress/:subscriptionID", auth.RequiresAdminRole(fqc.Update))
+		authv2.DELETE("/functions/quotas/:contractAddress/:subscriptionID", auth.RequiresAdminRole(fqc.Delete))
+
 		// PipelineJobSpecErrorsController
 		authv2.DELETE("/pipeline/job_spec_errors/:ID", auth.RequiresEditRole(psec.Destroy))
 
+		sqc := SlowQueriesController{app}
+		authv2.GET("/slow_queries", sqc.Index)
+
 		lgc := LogController{app}
 		authv2.GET("/log", lgc.Get)
 		authv2.PATCH("/log", auth.RequiresAdminRole(lgc.Patch))
 
+		lcc := JobLogCaptureController{app, logger.JobDebugCaptures()}
+		authv2.GET("/jobs/:ID/log_capture", lcc.Show)
+		authv2.POST("/jobs/:ID/log_capture", auth.RequiresAdminRole(lcc.Create))
+		authv2.GET("/jobs/:ID/log_capture/download", lcc.Download)
+		authv2.DELETE("/jobs/:ID/log_capture", auth.RequiresAdminRole(lcc.Delete))
+
 		chains := authv2.Group("chains")
 		for _, chain := range []struct {
 			path string
@@ -437,8 +522,10 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 	userOrEI := r.Group("/v2", auth.Authenticate(app.AuthenticationProvider(),
 		auth.AuthenticateExternalInitiator,
 		auth.AuthenticateByToken,
+		auth.AuthenticateByScopedToken(app.APITokensORM()),
+		clientCertificateAuth,
 		auth.AuthenticateBySession,
-	))
+	), auth.RequiresAPITokenScope)
 	userOrEI.GET("/ping", ping.Show)
 	userOrEI.POST("/jobs/:ID/runs", auth.RequiresRunRole(prc.Create))
 }
@@ -0,0 +1,52 @@
+package web
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+const (
+	defaultSlowQueriesLimit = 10
+	maxSlowQueriesLimit     = 100
+)
+
+// SlowQueriesController reports the slow queries recorded when Database.SlowQueries is enabled.
+type SlowQueriesController struct {
+	App chainlink.Application
+}
+
+// Index lists the fingerprints of the slow queries which took the most time in total, up to limit. Queries are
+// recorded in batches, so the latest ones may not be listed yet.
+// Example:
+// "GET <application>/slow_queries?limit=10"
+func (sqc *SlowQueriesController) Index(c *gin.Context) {
+	limit := defaultSlowQueriesLimit
+	if s := c.Query("limit"); s != "" {
+		var err error
+		limit, err = strconv.Atoi(s)
+		if err != nil || limit <= 0 || limit > maxSlowQueriesLimit {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("limit must be between 1 and %d", maxSlowQueriesLimit))
+			return
+		}
+	}
+
+	queries, err := pg.TopSlowQueries(c.Request.Context(), sqc.App.GetDB(), limit)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	resources := []presenters.SlowQueryResource{}
+	for _, q := range queries {
+		resources = append(resources, presenters.NewSlowQueryResource(q))
+	}
+
+	jsonAPIResponse(c, resources, "slowQueries")
+}
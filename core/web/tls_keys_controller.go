@@ -0,0 +1,79 @@
+package web
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/tlskey"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// TLSKeysController manages the TLS identities of the node. On top of the common key operations, it creates
+// certificate signing requests for the keys and replaces their self-signed certificates with the issued ones.
+type TLSKeysController struct {
+	KeysController
+	App chainlink.Application
+}
+
+func NewTLSKeysController(app chainlink.Application) *TLSKeysController {
+	return &TLSKeysController{
+		KeysController: NewKeysController[tlskey.Key, presenters.TLSKeyResource](app.GetKeyStore().TLS(), app.GetLogger(), app.GetAuditLogger(),
+			"tlsKey", presenters.NewTLSKeyResource, presenters.NewTLSKeyResources),
+		App: app,
+	}
+}
+
+// TLSKeyCSRRequest is the request body to create a certificate signing request.
+type TLSKeyCSRRequest struct {
+	Hosts []string `json:"hosts"`
+}
+
+// CreateCSR returns a PEM encoded certificate signing request of the key for the requested hosts.
+// Example:
+// "POST <application>/keys/tls/:keyID/csr"
+func (tkc *TLSKeysController) CreateCSR(c *gin.Context) {
+	var req TLSKeyCSRRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	csr, err := tkc.App.GetKeyStore().TLS().CreateCSR(c.Param("keyID"), req.Hosts)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.Data(http.StatusOK, "application/x-pem-file", csr)
+}
+
+// SetCertificate replaces the certificate of the key with the PEM encoded certificate chain in the request body.
+// Example:
+// "PUT <application>/keys/tls/:keyID/certificate"
+func (tkc *TLSKeysController) SetCertificate(c *gin.Context) {
+	defer tkc.App.GetLogger().ErrorIfFn(c.Request.Body.Close, "Error closing SetCertificate request body")
+	ctx := c.Request.Context()
+
+	chainPEM, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	keyID := c.Param("keyID")
+	key, err := tkc.App.GetKeyStore().TLS().SetCertificate(ctx, keyID, chainPEM)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	tkc.App.GetAuditLogger().Audit(audit.KeyUpdated, map[string]interface{}{
+		"type":     "TLS",
+		"id":       key.ID(),
+		"notAfter": key.Certificate().NotAfter,
+	})
+
+	jsonAPIResponse(c, presenters.NewTLSKeyResource(key), "tlsKey")
+}
@@ -0,0 +1,154 @@
+package web_test
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/tlskey"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestTLSKeysController_Index_HappyPath(t *testing.T) {
+	t.Parallel()
+
+	client, keyStore, key := setupTLSKeysControllerTests(t)
+
+	response, cleanup := client.Get("/v2/keys/tls")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	resources := []presenters.TLSKeyResource{}
+	err := web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resources)
+	assert.NoError(t, err)
+
+	keys, _ := keyStore.TLS().GetAll()
+	require.Len(t, resources, len(keys))
+
+	assert.Equal(t, key.ID(), resources[0].ID)
+	assert.Equal(t, key.PublicKeyStr(), resources[0].PubKey)
+	assert.Equal(t, string(key.CertificatePEM()), resources[0].Certificate)
+	assert.True(t, resources[0].SelfSigned)
+}
+
+func TestTLSKeysController_Create_HappyPath(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+	keyStore := app.GetKeyStore()
+
+	response, cleanup := client.Post("/v2/keys/tls", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	keys, _ := keyStore.TLS().GetAll()
+	require.Len(t, keys, 1)
+
+	resource := presenters.TLSKeyResource{}
+	err := web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource)
+	assert.NoError(t, err)
+
+	assert.Equal(t, keys[0].ID(), resource.ID)
+	assert.Equal(t, []string{"localhost"}, resource.DNSNames)
+}
+
+func TestTLSKeysController_CreateCSR_SetCertificate(t *testing.T) {
+	t.Parallel()
+
+	client, keyStore, key := setupTLSKeysControllerTests(t)
+
+	response, cleanup := client.Post("/v2/keys/tls/"+key.ID()+"/csr", strings.NewReader(`{"hosts": []}`))
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+
+	response, cleanup = client.Post("/v2/keys/tls/"+key.ID()+"/csr", strings.NewReader(`{"hosts": ["node.example.com"]}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	csrPEM, err := io.ReadAll(response.Body)
+	require.NoError(t, err)
+
+	response, cleanup = client.Put("/v2/keys/tls/"+key.ID()+"/certificate", strings.NewReader("not a certificate"))
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+
+	response, cleanup = client.Put("/v2/keys/tls/"+key.ID()+"/certificate", bytes.NewReader(signTLSTestCSR(t, csrPEM)))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	resource := presenters.TLSKeyResource{}
+	err = web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource)
+	require.NoError(t, err)
+	assert.Equal(t, key.ID(), resource.ID)
+	assert.False(t, resource.SelfSigned)
+	assert.Equal(t, []string{"node.example.com"}, resource.DNSNames)
+
+	signed, err := keyStore.TLS().Get(key.ID())
+	require.NoError(t, err)
+	assert.False(t, signed.SelfSigned())
+}
+
+func setupTLSKeysControllerTests(t *testing.T) (cltest.HTTPClientCleaner, keystore.Master, tlskey.Key) {
+	t.Helper()
+	ctx := testutils.Context(t)
+
+	app := cltest.NewApplication(t)
+	require.NoError(t, app.Start(ctx))
+	key, err := app.GetKeyStore().TLS().Create(ctx)
+	require.NoError(t, err)
+
+	client := app.NewHTTPClient(nil)
+
+	return client, app.GetKeyStore(), key
+}
+
+// signTLSTestCSR signs the PEM encoded CSR with a new CA, and returns the PEM encoded certificate.
+func signTLSTestCSR(t *testing.T, csrPEM []byte) []byte {
+	block, _ := pem.Decode(csrPEM)
+	require.NotNil(t, block)
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	require.NoError(t, err)
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	caCert, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+	der, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      csr.Subject,
+		DNSNames:     csr.DNSNames,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}, caCert, csr.PublicKey, caKey)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
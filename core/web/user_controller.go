@@ -1,18 +1,23 @@
 package web
 
 import (
+	"database/sql"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gin-contrib/sessions"
 	"github.com/gin-gonic/gin"
 	"github.com/jackc/pgconn"
 	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/v2/core/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	clsession "github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/apitokens"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 	webauth "github.com/smartcontractkit/chainlink/v2/core/web/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
@@ -198,6 +203,27 @@ func (u *UserController) Delete(c *gin.Context) {
 	jsonAPIResponse(c, presenters.NewUserResource(user), "user")
 }
 
+// RevokeSessions deletes all the sessions of an API user by email, logging the user out
+func (u *UserController) RevokeSessions(c *gin.Context) {
+	ctx := c.Request.Context()
+	email := c.Param("email")
+
+	user, err := u.App.AuthenticationProvider().FindUser(ctx, email)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, errors.Errorf("specified user not found: %s", email))
+		return
+	}
+
+	if err = u.App.AuthenticationProvider().RevokeUserSessions(ctx, email); err != nil {
+		u.App.GetLogger().Errorw("Error revoking API user sessions", "err", err)
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("error revoking API user sessions"))
+		return
+	}
+
+	u.App.GetAuditLogger().Audit(audit.AuthSessionsRevoked, map[string]interface{}{"email": email})
+	jsonAPIResponse(c, presenters.NewUserResource(user), "user")
+}
+
 // UpdatePassword changes the password for the current User.
 func (u *UserController) UpdatePassword(c *gin.Context) {
 	ctx := c.Request.Context()
@@ -329,6 +355,113 @@ func (u *UserController) DeleteAPIToken(c *gin.Context) {
 	}
 }
 
+// CreateScopedAPITokenRequest defines the request to create a scoped API
+// token for the current session's User.
+type CreateScopedAPITokenRequest struct {
+	Password  string    `json:"password"`
+	Name      string    `json:"name"`
+	Scopes    []string  `json:"scopes"`
+	ExpiresAt null.Time `json:"expiresAt"`
+}
+
+// IndexScopedAPITokens lists the scoped API tokens of the current session's User.
+func (u *UserController) IndexScopedAPITokens(c *gin.Context) {
+	sessionUser, ok := webauth.GetAuthenticatedUser(c)
+	if !ok {
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return
+	}
+	tokens, err := u.App.APITokensORM().ListAPITokens(c.Request.Context(), sessionUser.Email)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewAPITokenResources(tokens), "apiTokens")
+}
+
+// CreateScopedAPIToken creates a scoped API token for the current session's
+// User. The secret of the token is only returned in the response.
+func (u *UserController) CreateScopedAPIToken(c *gin.Context) {
+	ctx := c.Request.Context()
+	var request CreateScopedAPITokenRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.Name == "" {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("name is required"))
+		return
+	}
+	scopes := make([]apitokens.Scope, len(request.Scopes))
+	for i, s := range request.Scopes {
+		scope, err := apitokens.ParseScope(s)
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		scopes[i] = scope
+	}
+	if request.ExpiresAt.Valid && !request.ExpiresAt.Time.After(time.Now()) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("expiresAt must be in the future"))
+		return
+	}
+
+	sessionUser, ok := webauth.GetAuthenticatedUser(c)
+	if !ok {
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return
+	}
+	// In order to create an API token, login validation with provided password must succeed
+	if err := u.App.AuthenticationProvider().TestPassword(ctx, sessionUser.Email, request.Password); err != nil {
+		u.App.GetAuditLogger().Audit(audit.ScopedAPITokenCreateAttemptPasswordMismatch, map[string]interface{}{"user": sessionUser.Email})
+		jsonAPIError(c, http.StatusUnauthorized, errors.New("incorrect password"))
+		return
+	}
+
+	apiToken, token, err := u.App.APITokensORM().CreateAPIToken(ctx, sessionUser.Email, request.Name, scopes, request.ExpiresAt)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	u.App.GetAuditLogger().Audit(audit.ScopedAPITokenCreated, map[string]interface{}{
+		"user":      sessionUser.Email,
+		"name":      apiToken.Name,
+		"accessKey": apiToken.AccessKey,
+		"scopes":    apiToken.Scopes,
+		"expiresAt": apiToken.ExpiresAt,
+	})
+	resource := presenters.NewAPITokenResource(apiToken)
+	resource.Secret = token.Secret
+	jsonAPIResponseWithStatus(c, resource, "apiTokens", http.StatusCreated)
+}
+
+// DeleteScopedAPIToken deletes a scoped API token of the current session's User.
+func (u *UserController) DeleteScopedAPIToken(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	sessionUser, ok := webauth.GetAuthenticatedUser(c)
+	if !ok {
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return
+	}
+	if err = u.App.APITokensORM().DeleteAPIToken(c.Request.Context(), sessionUser.Email, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("API token not found"))
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	u.App.GetAuditLogger().Audit(audit.ScopedAPITokenDeleted, map[string]interface{}{"user": sessionUser.Email, "id": id})
+	jsonAPIResponseWithStatus(c, nil, "apiTokens", http.StatusNoContent)
+}
+
 func getCurrentSessionID(c *gin.Context) (string, error) {
 	session := sessions.Default(c)
 	sessionID, ok := session.Get(webauth.SessionIDKey).(string)
@@ -255,6 +255,43 @@ func TestUserController_DeleteUser(t *testing.T) {
 	assert.Contains(t, errors.Errors[0].Detail, "specified user not found")
 }
 
+func TestUserController_RevokeSessions(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(nil)
+	user := cltest.MustRandomUser(t)
+	require.NoError(t, app.AuthenticationProvider().CreateUser(ctx, &user))
+	sessionID, err := app.AuthenticationProvider().CreateSession(ctx, sessions.SessionRequest{
+		Email:    user.Email,
+		Password: cltest.Password,
+	})
+	require.NoError(t, err)
+
+	resp, cleanup := client.Delete(fmt.Sprintf("/v2/users/%s/sessions", url.QueryEscape(user.Email)))
+	t.Cleanup(cleanup)
+	errors := cltest.ParseJSONAPIErrors(t, resp.Body)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Empty(t, errors.Errors)
+
+	_, err = app.AuthenticationProvider().AuthorizedUserWithSession(ctx, sessionID)
+	require.Error(t, err)
+
+	// the user is kept
+	_, err = app.AuthenticationProvider().FindUser(ctx, user.Email)
+	require.NoError(t, err)
+
+	resp, cleanup = client.Delete(fmt.Sprintf("/v2/users/%s/sessions", url.QueryEscape("unknown@chainlink.test")))
+	t.Cleanup(cleanup)
+	errors = cltest.ParseJSONAPIErrors(t, resp.Body)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Len(t, errors.Errors, 1)
+	assert.Contains(t, errors.Errors[0].Detail, "specified user not found")
+}
+
 func TestUserController_NewAPIToken(t *testing.T) {
 	t.Parallel()
 
@@ -22,6 +22,7 @@ func NewCmdFactory(register func(id string) (*RegisteredLoop, error), lcfg CmdCo
 		cmd := exec.Command(lcfg.Cmd) //#nosec G204 -- we control the value of the cmd so the lint/sec error is a false positive
 		cmd.Env = append(cmd.Env, lcfg.Env...)
 		cmd.Env = append(cmd.Env, registeredLoop.EnvCfg.AsCmdEnv()...)
+		cmd.Env = append(cmd.Env, registeredLoop.Env...)
 		return cmd
 	}, nil
 }
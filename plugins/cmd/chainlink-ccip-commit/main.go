@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-plugin"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/loop"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/alerting"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/ccipcommit"
+)
+
+const (
+	loggerName = "PluginCCIPCommit"
+)
+
+func main() {
+	s := loop.MustNewStartedServer(loggerName)
+	defer s.Stop()
+
+	lggr, closeLggr := logger.NewLogger()
+	defer s.Logger.ErrorIfFn(closeLggr, "Failed to close logger")
+
+	// the alerts emitted in the plugin are delivered from its process, with the alerting config of the node
+	alertingService, err := alerting.NewLOOPService(lggr)
+	if err != nil {
+		s.Logger.Fatalw("Failed to create alerting", "err", err)
+	}
+	if alertingService != nil {
+		if err = alertingService.Start(context.Background()); err != nil {
+			s.Logger.Fatalw("Failed to start alerting", "err", err)
+		}
+		defer s.Logger.ErrorIfFn(alertingService.Close, "Failed to close alerting")
+		s.MustRegister(alertingService)
+	}
+
+	stop := make(chan struct{})
+	defer close(stop)
+
+	plugin.Serve(&plugin.ServeConfig{
+		HandshakeConfig: loop.PluginCCIPCommitHandshakeConfig(),
+		Plugins: map[string]plugin.Plugin{
+			loop.CCIPCommitLOOPName: &loop.CommitLoop{
+				PluginServer: ccipcommit.NewFactoryGenerator(lggr.Named(loggerName)),
+				BrokerConfig: loop.BrokerConfig{
+					Logger:   s.Logger,
+					StopCh:   stop,
+					GRPCOpts: s.GRPCOpts,
+				},
+			},
+		},
+		GRPCServer: s.GRPCOpts.NewServer,
+	})
+}
@@ -19,6 +19,8 @@ var ErrExists = errors.New("plugin already registered")
 type RegisteredLoop struct {
 	Name   string
 	EnvCfg loop.EnvConfig
+	// Env holds the environment variables of the node's services passed to the plugin, see [LoopRegistry.AddEnv].
+	Env []string
 }
 
 // LoopRegistry is responsible for assigning ports to plugins that are to be used for the
@@ -30,6 +32,7 @@ type LoopRegistry struct {
 	lggr         logger.Logger
 	cfgTracing   config.Tracing
 	cfgTelemetry config.Telemetry
+	env          []string
 }
 
 func NewLoopRegistry(lggr logger.Logger, tracing config.Tracing, telemetry config.Telemetry) *LoopRegistry {
@@ -41,6 +44,14 @@ func NewLoopRegistry(lggr logger.Logger, tracing config.Tracing, telemetry confi
 	}
 }
 
+// AddEnv adds environment variables, as described in [exec.Cmd.Env], to the plugins registered from now on.
+// Safe for concurrent use.
+func (m *LoopRegistry) AddEnv(env ...string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.env = append(m.env, env...)
+}
+
 // Register creates a port of the plugin. It is not idempotent. Duplicate calls to Register will return [ErrExists]
 // Safe for concurrent use.
 func (m *LoopRegistry) Register(id string) (*RegisteredLoop, error) {
@@ -76,7 +87,7 @@ func (m *LoopRegistry) Register(id string) (*RegisteredLoop, error) {
 		envCfg.TelemetryTraceSampleRatio = m.cfgTelemetry.TraceSampleRatio()
 	}
 
-	m.registry[id] = &RegisteredLoop{Name: id, EnvCfg: envCfg}
+	m.registry[id] = &RegisteredLoop{Name: id, EnvCfg: envCfg, Env: append([]string(nil), m.env...)}
 	m.lggr.Debugf("Registered loopp %q with config %v, port %d", id, envCfg, envCfg.PrometheusPort)
 	return m.registry[id], nil
 }